@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/math"
 	didtypes "github.com/cheqd/cheqd-node/x/did/types"
+	oracletypes "github.com/cheqd/cheqd-node/x/oracle/types"
 	resourcetypes "github.com/cheqd/cheqd-node/x/resource/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -46,6 +47,8 @@ type OracleKeeper interface {
 	GetEMA(ctx sdk.Context, denom string) (math.LegacyDec, bool)
 	GetExchangeRate(ctx sdk.Context, denom string) (math.LegacyDec, error)
 	GetWMA(ctx sdk.Context, denom string, strategy string) (math.LegacyDec, bool)
+	GetFeeConversionPrice(ctx sdk.Context, symbol string) (oracletypes.ConversionPrice, error)
+	IsPriceHealthy(ctx sdk.Context, denom string) bool
 }
 
 type PriceFeeder interface {
@@ -0,0 +1,218 @@
+package ante
+
+import (
+	"errors"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cheqd/cheqd-node/util"
+	didtypes "github.com/cheqd/cheqd-node/x/did/types"
+	oracletypes "github.com/cheqd/cheqd-node/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	feeabskeeper "github.com/osmosis-labs/fee-abstraction/v8/x/feeabs/keeper"
+)
+
+// maxEstimatedFeeAmount bounds the search for the lowest accepted fee
+var maxEstimatedFeeAmount = sdkmath.NewIntWithDecimal(1, 40)
+
+// TaxableMsgsFeeEstimate is the fee charged by the ante and post handlers for the identity messages of a transaction
+type TaxableMsgsFeeEstimate struct {
+	// MinFee is the lowest fee in the requested denom accepted for all identity messages
+	MinFee sdk.Coin
+	// MaxFees is the sum of the highest fees charged per message, nil if any message is unbounded
+	MaxFees sdk.Coins
+	// Reward and Burn are the portions charged when paying MinFee, in the denoms of the fee ranges
+	Reward sdk.Coins
+	Burn   sdk.Coins
+	// CheqPrice is the CHEQ price the fees are computed with
+	CheqPrice sdkmath.LegacyDec
+	// FromOracle is false if the fallback ICQ price is used
+	FromOracle bool
+}
+
+// EstimateTaxableMsgsFee estimates the fee for the identity messages by running the same checks IsTaxableTx applies
+func EstimateTaxableMsgsFee(ctx sdk.Context, didKeeper DidKeeper, resourceKeeper ResourceKeeper, oracleKeeper OracleKeeper, feeabsKeeper feeabskeeper.Keeper, pricefeeder PriceFeeder, msgs []sdk.Msg, feeDenom string) (TaxableMsgsFeeEstimate, error) {
+	if err := sdk.ValidateDenom(feeDenom); err != nil {
+		return TaxableMsgsFeeEstimate{}, err
+	}
+
+	hasIdentityMsg := false
+	for _, msg := range msgs {
+		if GetTaxableMsg(msg) {
+			hasIdentityMsg = true
+			break
+		}
+	}
+	if !hasIdentityMsg {
+		return TaxableMsgsFeeEstimate{}, errors.New("no identity messages to estimate the fee for")
+	}
+
+	_ = checkFeeParamsFromSubspace(ctx, didKeeper, resourceKeeper)
+	ncheqPrice, fromOracle := GetCheqPrice(ctx, oracleKeeper, pricefeeder)
+
+	charge := func(amount sdkmath.Int) (sdk.Coins, sdk.Coins, error) {
+		return GetTaxableMsgsFee(ctx, msgs, ncheqPrice, sdk.NewCoins(sdk.NewCoin(feeDenom, amount)), feeabsKeeper)
+	}
+
+	accepted, err := findMinAcceptedFee(feeDenom, func(amount sdkmath.Int) error {
+		_, _, err := charge(amount)
+		return err
+	})
+	if err != nil {
+		return TaxableMsgsFeeEstimate{}, err
+	}
+
+	reward, burn, err := charge(accepted)
+	if err != nil {
+		return TaxableMsgsFeeEstimate{}, err
+	}
+
+	maxFees, err := getTaxableMsgsMaxFee(msgs, ncheqPrice)
+	if err != nil {
+		return TaxableMsgsFeeEstimate{}, err
+	}
+
+	return TaxableMsgsFeeEstimate{
+		MinFee:     sdk.NewCoin(feeDenom, accepted),
+		MaxFees:    maxFees,
+		Reward:     reward,
+		Burn:       burn,
+		CheqPrice:  ncheqPrice,
+		FromOracle: fromOracle,
+	}, nil
+}
+
+// EstimateFeeRangesMinFee estimates the lowest fee in the requested denom accepted for the fee ranges,
+// converted with the same CHEQ price and checks the identity messages are charged with
+func EstimateFeeRangesMinFee(ctx sdk.Context, oracleKeeper OracleKeeper, feeabsKeeper feeabskeeper.Keeper, pricefeeder PriceFeeder, feeRanges []didtypes.FeeRange, feeDenom string) (sdk.Coin, error) {
+	if err := sdk.ValidateDenom(feeDenom); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ncheqPrice, _ := GetCheqPrice(ctx, oracleKeeper, pricefeeder)
+
+	accepted, err := findMinAcceptedFee(feeDenom, func(amount sdkmath.Int) error {
+		userFee := sdk.NewCoins(sdk.NewCoin(feeDenom, amount))
+		nativeFees, err := getNativeFees(ctx, userFee, feeabsKeeper)
+		if err != nil {
+			return err
+		}
+
+		_, err = GetFeeForMsg(userFee, feeRanges, ncheqPrice, nativeFees)
+		return err
+	})
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return sdk.NewCoin(feeDenom, accepted), nil
+}
+
+// findMinAcceptedFee returns the lowest amount the charge accepts.
+// Accepted fees are all amounts above a minimum, so double until one is accepted and then bisect.
+func findMinAcceptedFee(feeDenom string, charge func(amount sdkmath.Int) error) (sdkmath.Int, error) {
+	rejected := sdkmath.ZeroInt()
+	accepted := sdkmath.OneInt()
+	for {
+		err := charge(accepted)
+		if err == nil {
+			break
+		}
+		if accepted.GT(maxEstimatedFeeAmount) {
+			return sdkmath.Int{}, fmt.Errorf("no fee in %s is accepted: %w", feeDenom, err)
+		}
+		rejected = accepted
+		accepted = accepted.MulRaw(2)
+	}
+	for accepted.Sub(rejected).GT(sdkmath.OneInt()) {
+		mid := rejected.Add(accepted).QuoRaw(2)
+		if err := charge(mid); err == nil {
+			accepted = mid
+		} else {
+			rejected = mid
+		}
+	}
+
+	return accepted, nil
+}
+
+// getTaxableMsgsMaxFee sums the highest fee charged per identity message, nil if any of them is unbounded
+func getTaxableMsgsMaxFee(msgs []sdk.Msg, cheqEmaPrice sdkmath.LegacyDec) (sdk.Coins, error) {
+	maxFees := sdk.Coins{}
+	for _, msg := range msgs {
+		feeRanges, _, isIdentityMsg := GetTaxableMsgFeeRanges(msg)
+		if !isIdentityMsg {
+			continue
+		}
+
+		maxFee, err := GetMaxFeeForMsg(feeRanges, cheqEmaPrice)
+		if err != nil {
+			return nil, err
+		}
+		if maxFee == nil {
+			return nil, nil
+		}
+
+		maxFees = maxFees.Add(*maxFee)
+	}
+
+	return maxFees, nil
+}
+
+// GetMaxFeeForMsg returns the highest fee GetFeeForMsg charges for the fee ranges, or nil if it is unbounded
+func GetMaxFeeForMsg(feeRanges []didtypes.FeeRange, cheqEmaPrice sdkmath.LegacyDec) (*sdk.Coin, error) {
+	if len(feeRanges) == 0 {
+		return nil, errors.New("fee ranges empty")
+	}
+
+	// for fixed fees
+	for _, fr := range feeRanges {
+		if fr.MinAmount != nil && fr.MaxAmount != nil && fr.MinAmount.Equal(*fr.MaxAmount) {
+			fixedFee := sdk.NewCoin(fr.Denom, *fr.MinAmount)
+			return &fixedFee, nil
+		}
+	}
+
+	// Fallback: If CHEQ price is not available
+	if cheqEmaPrice.IsZero() {
+		for _, fr := range feeRanges {
+			if fr.Denom != oracletypes.CheqdDenom {
+				continue
+			}
+			if fr.MinAmount != nil {
+				fallbackFee := sdk.NewCoin(fr.Denom, *fr.MinAmount)
+				return &fallbackFee, nil
+			}
+			if fr.MaxAmount != nil {
+				fallbackFee := sdk.NewCoin(fr.Denom, *fr.MaxAmount)
+				return &fallbackFee, nil
+			}
+		}
+
+		return nil, errors.New("cheq price not available and no ncheq fallback fee defined")
+	}
+
+	ranges, _, overlapMax, err := getUSDFeeOverlap(feeRanges, cheqEmaPrice)
+	if err != nil {
+		return nil, err
+	}
+	if overlapMax == nil {
+		return nil, nil
+	}
+
+	var maxFee sdk.Coin
+	switch chosen := chooseUSDRange(ranges); chosen.denom {
+	case oracletypes.CheqdDenom:
+		cheqAmt, err := oracletypes.MicroUSDToNcheq(*overlapMax, cheqEmaPrice)
+		if err != nil {
+			return nil, err
+		}
+		maxFee = sdk.NewCoin(oracletypes.CheqdDenom, cheqAmt)
+	case oracletypes.UsdDenom:
+		maxFee = sdk.NewCoin(oracletypes.UsdDenom, overlapMax.Mul(util.UsdFrom18To6))
+	default:
+		return nil, fmt.Errorf("unsupported fee range denom: %s", chosen.denom)
+	}
+
+	return &maxFee, nil
+}
@@ -0,0 +1,231 @@
+package ante_test
+
+import (
+	"cosmossdk.io/math"
+	cheqdante "github.com/cheqd/cheqd-node/ante"
+	cheqdpost "github.com/cheqd/cheqd-node/post"
+	"github.com/cheqd/cheqd-node/util"
+	didtypes "github.com/cheqd/cheqd-node/x/did/types"
+	oraclekeeper "github.com/cheqd/cheqd-node/x/oracle/keeper"
+	oracletypes "github.com/cheqd/cheqd-node/x/oracle/types"
+	resourcetypes "github.com/cheqd/cheqd-node/x/resource/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("EstimateTaxableMsgsFee", func() {
+	s := new(AnteTestSuite)
+	ncheqPrice := math.LegacyMustNewDecFromStr("0.016")
+
+	BeforeEach(func() {
+		err := s.SetupTest(true)
+		Expect(err).To(BeNil(), "Error on creating test app")
+
+		s.app.OracleKeeper.SetAverage(s.ctx, oraclekeeper.KeyWMAWithStrategy(didtypes.BaseDenom, string(oraclekeeper.WmaStrategyBalanced)), ncheqPrice)
+	})
+
+	estimate := func(feeDenom string, msgs ...sdk.Msg) (cheqdante.TaxableMsgsFeeEstimate, error) {
+		return cheqdante.EstimateTaxableMsgsFee(s.ctx, s.app.DidKeeper, s.app.ResourceKeeper, s.app.OracleKeeper, s.app.FeeabsKeeper, s.app.OracleKeeper.PriceFeeder, msgs, feeDenom)
+	}
+
+	It("returns the lowest fee in ncheq accepted by the ante handler", func() {
+		msg := SandboxDidDoc()
+
+		res, err := estimate(didtypes.BaseMinimalDenom, msg)
+		Expect(err).To(BeNil())
+		Expect(res.FromOracle).To(BeTrue())
+		Expect(res.CheqPrice).To(Equal(ncheqPrice))
+		Expect(res.MinFee.Denom).To(Equal(didtypes.BaseMinimalDenom))
+
+		reward, burn, err := cheqdante.GetTaxableMsgsFee(s.ctx, []sdk.Msg{msg}, ncheqPrice, sdk.NewCoins(res.MinFee), s.app.FeeabsKeeper)
+		Expect(err).To(BeNil())
+		Expect(reward).To(Equal(res.Reward))
+		Expect(burn).To(Equal(res.Burn))
+
+		below := sdk.NewCoins(sdk.NewCoin(didtypes.BaseMinimalDenom, res.MinFee.Amount.SubRaw(1)))
+		_, _, err = cheqdante.GetTaxableMsgsFee(s.ctx, []sdk.Msg{msg}, ncheqPrice, below, s.app.FeeabsKeeper)
+		Expect(err).NotTo(BeNil())
+
+		Expect(res.MaxFees).NotTo(BeNil())
+	})
+
+	It("sums the fees of all identity messages of a tx", func() {
+		single, err := estimate(didtypes.BaseMinimalDenom, SandboxDidDoc())
+		Expect(err).To(BeNil())
+
+		double, err := estimate(didtypes.BaseMinimalDenom, SandboxDidDoc(), SandboxDidDoc())
+		Expect(err).To(BeNil())
+		Expect(double.Reward.Add(double.Burn...)).To(Equal(single.Reward.Add(single.Burn...).Add(single.Reward.Add(single.Burn...)...)))
+	})
+
+	It("fails for usd, which fee ranges are not paid in", func() {
+		_, err := estimate(oracletypes.UsdDenom, SandboxDidDoc())
+		Expect(err).NotTo(BeNil())
+	})
+
+	It("falls back to the ncheq fees while the price circuit breaker is tripped", func() {
+		err := s.app.OracleKeeper.UpdatePriceCircuitBreaker(s.ctx, oracletypes.CheqdSymbol, ncheqPrice, true, ncheqPrice.MulInt64(10))
+		Expect(err).To(BeNil())
+
+		price, fromOracle := cheqdante.GetCheqPrice(s.ctx, s.app.OracleKeeper, s.app.OracleKeeper.PriceFeeder)
+		Expect(price.IsZero()).To(BeTrue())
+		Expect(fromOracle).To(BeFalse())
+
+		didParams, err := s.app.DidKeeper.GetParams(s.ctx)
+		Expect(err).To(BeNil())
+		var ncheqFee math.Int
+		for _, fr := range didParams.CreateDid {
+			if fr.Denom == didtypes.BaseMinimalDenom {
+				ncheqFee = *fr.MinAmount
+			}
+		}
+
+		res, err := estimate(didtypes.BaseMinimalDenom, SandboxDidDoc())
+		Expect(err).To(BeNil())
+		Expect(res.FromOracle).To(BeFalse())
+		Expect(res.MinFee).To(Equal(sdk.NewCoin(didtypes.BaseMinimalDenom, ncheqFee)))
+	})
+
+	It("fails without identity messages", func() {
+		_, err := estimate(didtypes.BaseMinimalDenom, &banktypes.MsgSend{})
+		Expect(err).NotTo(BeNil())
+	})
+
+	It("fails for an invalid denom", func() {
+		_, err := estimate("!", SandboxDidDoc())
+		Expect(err).NotTo(BeNil())
+	})
+
+	It("fails for an IBC denom not registered with fee abstraction", func() {
+		_, err := estimate("ibc/ED07A3391A112B175915CD8FAF43A2DA8E4790EDE12566649D0C2F97716B8518", SandboxDidDoc())
+		Expect(err).NotTo(BeNil())
+	})
+
+	Describe("FeeEstimator", func() {
+		var estimator cheqdpost.FeeEstimator
+
+		BeforeEach(func() {
+			estimator = cheqdpost.NewFeeEstimator(s.app.DidKeeper, s.app.ResourceKeeper, s.app.OracleKeeper, s.app.FeeabsKeeper, s.app.OracleKeeper.PriceFeeder, s.app.TxConfig().TxDecoder(), s.app.InterfaceRegistry())
+		})
+
+		It("estimates the fee of a message type", func() {
+			res, err := estimator.EstimateIdentityFee(s.ctx, &didtypes.QueryEstimateIdentityFeeRequest{
+				MsgTypeUrl: sdk.MsgTypeURL(&didtypes.MsgCreateDidDoc{}),
+				FeeDenom:   didtypes.BaseMinimalDenom,
+			})
+			Expect(err).To(BeNil())
+			Expect(res.PriceSource).To(Equal(cheqdpost.PriceSourceOracle))
+			Expect(res.Price).To(Equal(ncheqPrice))
+			Expect(res.MaxFee).NotTo(BeNil())
+			Expect(res.MaxFee.Amount.GTE(res.MinFee.Amount)).To(BeTrue())
+			Expect(res.Reward.Add(res.Burn...).AmountOf(didtypes.BaseMinimalDenom).LTE(res.MinFee.Amount)).To(BeTrue())
+		})
+
+		It("quotes the fee in usd", func() {
+			ncheqRes, err := estimator.EstimateIdentityFee(s.ctx, &didtypes.QueryEstimateIdentityFeeRequest{
+				MsgTypeUrl: sdk.MsgTypeURL(&didtypes.MsgCreateDidDoc{}),
+				FeeDenom:   didtypes.BaseMinimalDenom,
+			})
+			Expect(err).To(BeNil())
+
+			res, err := estimator.EstimateIdentityFee(s.ctx, &didtypes.QueryEstimateIdentityFeeRequest{
+				MsgTypeUrl: sdk.MsgTypeURL(&didtypes.MsgCreateDidDoc{}),
+				FeeDenom:   oracletypes.UsdDenom,
+			})
+			Expect(err).To(BeNil())
+			Expect(res.MinFee.Denom).To(Equal(oracletypes.UsdDenom))
+			Expect(res.MaxFee.Amount.GTE(res.MinFee.Amount)).To(BeTrue())
+			Expect(res.Reward).To(Equal(ncheqRes.Reward))
+			Expect(res.Burn).To(Equal(ncheqRes.Burn))
+		})
+
+		It("estimates the fee of the identity messages of a tx", func() {
+			txBuilder := s.app.TxConfig().NewTxBuilder()
+			Expect(txBuilder.SetMsgs(SandboxDidDoc())).To(BeNil())
+			txBytes, err := s.app.TxConfig().TxEncoder()(txBuilder.GetTx())
+			Expect(err).To(BeNil())
+
+			res, err := estimator.EstimateIdentityFee(s.ctx, &didtypes.QueryEstimateIdentityFeeRequest{
+				TxBytes:  txBytes,
+				FeeDenom: didtypes.BaseMinimalDenom,
+			})
+			Expect(err).To(BeNil())
+			Expect(res.MinFee.IsPositive()).To(BeTrue())
+		})
+
+		It("quotes the ncheq fee only while the price circuit breaker is tripped", func() {
+			err := s.app.OracleKeeper.UpdatePriceCircuitBreaker(s.ctx, oracletypes.CheqdSymbol, ncheqPrice, true, ncheqPrice.MulInt64(10))
+			Expect(err).To(BeNil())
+
+			res, err := estimator.EstimateIdentityFee(s.ctx, &didtypes.QueryEstimateIdentityFeeRequest{
+				MsgTypeUrl: sdk.MsgTypeURL(&didtypes.MsgCreateDidDoc{}),
+				FeeDenom:   didtypes.BaseMinimalDenom,
+			})
+			Expect(err).To(BeNil())
+			Expect(res.PriceSource).To(Equal(cheqdpost.PriceSourceFrozen))
+
+			_, err = estimator.EstimateIdentityFee(s.ctx, &didtypes.QueryEstimateIdentityFeeRequest{
+				MsgTypeUrl: sdk.MsgTypeURL(&didtypes.MsgCreateDidDoc{}),
+				FeeDenom:   oracletypes.UsdDenom,
+			})
+			Expect(status.Code(err)).To(Equal(codes.Unavailable))
+		})
+
+		It("estimates the ncheq fee of a resource accepted by the ante handler", func() {
+			params, err := s.app.ResourceKeeper.GetParams(s.ctx)
+			Expect(err).To(BeNil())
+			params.JsonSizePricing = &resourcetypes.SizePricing{
+				Base:   []didtypes.FeeRange{{Denom: didtypes.BaseMinimalDenom, MinAmount: util.PtrInt(1e9), MaxAmount: util.PtrInt(1e9)}},
+				PerKib: []didtypes.FeeRange{{Denom: didtypes.BaseMinimalDenom, MinAmount: util.PtrInt(1e7), MaxAmount: util.PtrInt(1e7)}},
+			}
+			Expect(s.app.ResourceKeeper.SetParams(s.ctx, params)).To(Succeed())
+
+			res, err := estimator.EstimateResourceFee(s.ctx, &resourcetypes.QueryEstimateResourceFeeRequest{
+				MediaType: "application/json",
+				Size_:     200 * resourcetypes.KiB,
+			})
+			Expect(err).To(BeNil())
+			Expect(res.SizeTiered).To(BeTrue())
+			Expect(res.Fee).To(Equal(sdk.NewCoin(didtypes.BaseMinimalDenom, math.NewInt(3e9))))
+			Expect(res.AcceptedDenoms).To(Equal([]string{didtypes.BaseMinimalDenom}))
+		})
+
+		It("converts the usd fee of a resource to ncheq", func() {
+			params, err := s.app.ResourceKeeper.GetParams(s.ctx)
+			Expect(err).To(BeNil())
+
+			res, err := estimator.EstimateResourceFee(s.ctx, &resourcetypes.QueryEstimateResourceFeeRequest{
+				MediaType: "image/png",
+				Size_:     resourcetypes.KiB,
+			})
+			Expect(err).To(BeNil())
+			Expect(res.SizeTiered).To(BeFalse())
+			Expect(res.Fee.Denom).To(Equal(didtypes.BaseMinimalDenom))
+
+			_, err = cheqdante.GetFeeForMsg(sdk.NewCoins(res.Fee), params.Image, ncheqPrice, nil)
+			Expect(err).To(BeNil())
+			_, err = cheqdante.GetFeeForMsg(sdk.NewCoins(sdk.NewCoin(didtypes.BaseMinimalDenom, res.Fee.Amount.SubRaw(1))), params.Image, ncheqPrice, nil)
+			Expect(err).NotTo(BeNil())
+		})
+
+		It("rejects unknown message types", func() {
+			_, err := estimator.EstimateIdentityFee(s.ctx, &didtypes.QueryEstimateIdentityFeeRequest{
+				MsgTypeUrl: "/cheqd.did.v2.Unknown",
+				FeeDenom:   didtypes.BaseMinimalDenom,
+			})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+
+		It("rejects non-identity message types", func() {
+			_, err := estimator.EstimateIdentityFee(s.ctx, &didtypes.QueryEstimateIdentityFeeRequest{
+				MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}),
+				FeeDenom:   didtypes.BaseMinimalDenom,
+			})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+	})
+})
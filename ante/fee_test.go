@@ -6,8 +6,10 @@ import (
 	"cosmossdk.io/math"
 	cheqdante "github.com/cheqd/cheqd-node/ante"
 	cheqdpost "github.com/cheqd/cheqd-node/post"
+	didkeeper "github.com/cheqd/cheqd-node/x/did/keeper"
 	didtypes "github.com/cheqd/cheqd-node/x/did/types"
 	oraclekeeper "github.com/cheqd/cheqd-node/x/oracle/keeper"
+	abci "github.com/cometbft/cometbft/abci/types"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
@@ -17,6 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/gogoproto/proto"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	solomachine "github.com/cosmos/ibc-go/v8/modules/light-clients/06-solomachine"
@@ -520,6 +523,114 @@ var _ = Describe("Fee tests on DeliverTx", func() {
 		oracleModule := s.app.AccountKeeper.GetModuleAddress("oracle")
 		oracleBalance := s.app.BankKeeper.GetBalance(s.ctx, oracleModule, didtypes.BaseMinimalDenom)
 		Expect(oracleBalance.Amount).To(Equal(oracleShare), "Oracle module did not receive the correct reward share")
+
+		// check that the fee paid event has been emitted
+		var feePaidEvent *didtypes.EventIdentityFeePaid
+		for _, event := range s.ctx.EventManager().Events() {
+			if event.Type != proto.MessageName(&didtypes.EventIdentityFeePaid{}) {
+				continue
+			}
+			parsed, err := sdk.ParseTypedEvent(abci.Event(event))
+			Expect(err).To(BeNil())
+			feePaidEvent = parsed.(*didtypes.EventIdentityFeePaid)
+		}
+		Expect(feePaidEvent).ToNot(BeNil(), "Fee paid event was not emitted")
+		Expect(feePaidEvent.FeePayer).To(Equal(addr1.String()))
+		Expect(feePaidEvent.Reward).To(Equal(reward))
+		Expect(feePaidEvent.Burn).To(Equal(burnt))
+	})
+
+	It("TaxableTx Lifecycle - DID: MsgCreateDidDoc with fee discount and identity credits", func() {
+		s.app.OracleKeeper.SetAverage(s.ctx, oraclekeeper.KeyWMAWithStrategy(didtypes.BaseDenom, string(oraclekeeper.WmaStrategyBalanced)), math.LegacyMustNewDecFromStr("0.016"))
+
+		priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+		// allow-list the fee payer with a 50% discount
+		feeParams, err := s.app.DidKeeper.GetParams(s.ctx)
+		Expect(err).To(BeNil())
+		discount := math.LegacyMustNewDecFromStr("0.5")
+		feeParams.IssuerDiscounts = []didtypes.IssuerDiscount{{Address: addr1.String(), Discount: discount}}
+		Expect(s.app.DidKeeper.SetParams(s.ctx, feeParams)).To(BeNil())
+
+		// msg and signatures
+		msg := SandboxDidDoc()
+		feeAmount := sdk.NewCoins(sdk.NewCoin(didtypes.BaseMinimalDenom, math.NewInt(75_000_000_000)))
+
+		gasLimit := testdata.NewTestGasLimit()
+		Expect(s.txBuilder.SetMsgs(msg)).To(BeNil())
+		s.txBuilder.SetFeeAmount(feeAmount)
+		s.txBuilder.SetGasLimit(gasLimit)
+		s.txBuilder.SetFeePayer(addr1)
+
+		privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+		tx, err := s.CreateTestTx(privs, accNums, accSeqs, s.ctx.ChainID())
+		Expect(err).To(BeNil())
+
+		// set account with sufficient funds
+		acc := s.app.AccountKeeper.NewAccountWithAddress(s.ctx, addr1)
+		s.app.AccountKeeper.SetAccount(s.ctx, acc)
+		amount := math.NewInt(100_000_000_000)
+		err = testutil.FundAccount(s.ctx, s.app.BankKeeper, addr1, sdk.NewCoins(sdk.NewCoin(didtypes.BaseMinimalDenom, amount)))
+		Expect(err).To(BeNil())
+
+		// purchase identity credits covering part of the discounted fee
+		credits := sdk.NewCoin(didtypes.BaseMinimalDenom, math.NewInt(10_000_000_000))
+		_, err = didkeeper.NewMsgServer(s.app.DidKeeper).PurchaseIdentityCredits(s.ctx, didtypes.NewMsgPurchaseIdentityCredits(addr1.String(), credits))
+		Expect(err).To(BeNil())
+
+		dfd := cheqdante.NewOverAllDecorator(decorators...)
+		antehandler := sdk.ChainAnteDecorators(dfd)
+		taxDecorator := cheqdpost.NewTaxDecorator(s.app.AccountKeeper, s.app.BankKeeper, s.app.FeeGrantKeeper, s.app.DidKeeper, s.app.ResourceKeeper, s.app.FeeMarketKeeper, s.app.OracleKeeper, s.app.FeeabsKeeper, s.app.OracleKeeper.PriceFeeder, s.app.GlobalFeeKeeper)
+		posthandler := sdk.ChainPostDecorators(taxDecorator)
+
+		supplyBeforeDeflation, _, err := s.app.BankKeeper.GetPaginatedTotalSupply(s.ctx, &query.PageRequest{})
+		Expect(err).To(BeNil())
+
+		_, err = antehandler(s.ctx, tx, false)
+		Expect(err).To(BeNil(), "Tx errored when taxable on deliverTx")
+
+		_, err = posthandler(s.ctx, tx, false, true)
+		Expect(err).To(BeNil(), "Tx errored when drawing the discounted tax from identity credits on deliverTx")
+
+		// the discount is waived from both the burnt and the rewarded portions
+		burnt := didtypes.ApplyFeeDiscount(cheqdante.GetBurnFeePortion(feeParams.BurnFactor, feeAmount), discount)
+		reward := didtypes.ApplyFeeDiscount(cheqdante.GetRewardPortion(feeAmount, cheqdante.GetBurnFeePortion(feeParams.BurnFactor, feeAmount)), discount)
+		tax := reward.Add(burnt...)
+
+		// the credits are drawn first and the fee payer is charged the rest
+		balance := s.app.BankKeeper.GetBalance(s.ctx, addr1, didtypes.BaseMinimalDenom)
+		Expect(balance.Amount).To(Equal(amount.Sub(credits.Amount).Sub(tax.AmountOf(didtypes.BaseMinimalDenom).Sub(credits.Amount))))
+
+		account, err := s.app.DidKeeper.GetIdentityCreditAccount(s.ctx, addr1.String())
+		Expect(err).To(BeNil())
+		Expect(account.Credits.IsZero()).To(BeTrue())
+
+		supplyAfterDeflation, _, err := s.app.BankKeeper.GetPaginatedTotalSupply(s.ctx, &query.PageRequest{})
+		Expect(err).To(BeNil())
+		Expect(supplyBeforeDeflation.Sub(supplyAfterDeflation...)).To(Equal(burnt), "Supply was not deflated by the discounted burn")
+
+		var feePaidEvent *didtypes.EventIdentityFeePaid
+		var creditsConsumedEvent *didtypes.EventIdentityCreditsConsumed
+		for _, event := range s.ctx.EventManager().Events() {
+			switch event.Type {
+			case proto.MessageName(&didtypes.EventIdentityFeePaid{}):
+				parsed, err := sdk.ParseTypedEvent(abci.Event(event))
+				Expect(err).To(BeNil())
+				feePaidEvent = parsed.(*didtypes.EventIdentityFeePaid)
+			case proto.MessageName(&didtypes.EventIdentityCreditsConsumed{}):
+				parsed, err := sdk.ParseTypedEvent(abci.Event(event))
+				Expect(err).To(BeNil())
+				creditsConsumedEvent = parsed.(*didtypes.EventIdentityCreditsConsumed)
+			}
+		}
+		Expect(feePaidEvent).ToNot(BeNil(), "Fee paid event was not emitted")
+		Expect(feePaidEvent.Discount).To(Equal(discount))
+		Expect(feePaidEvent.Credits).To(Equal(credits))
+		Expect(feePaidEvent.Reward).To(Equal(reward))
+		Expect(feePaidEvent.Burn).To(Equal(burnt))
+		Expect(creditsConsumedEvent).ToNot(BeNil(), "Credits consumed event was not emitted")
+		Expect(creditsConsumedEvent.Address).To(Equal(addr1.String()))
+		Expect(creditsConsumedEvent.Amount).To(Equal(credits))
 	})
 
 	It("TaxableTx Lifecycle - DLR: MsgCreateResource JSON", func() {
@@ -3,13 +3,11 @@ package ante
 import (
 	"errors"
 	"fmt"
-	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	"github.com/cheqd/cheqd-node/util"
 	didtypes "github.com/cheqd/cheqd-node/x/did/types"
-	oraclekeeper "github.com/cheqd/cheqd-node/x/oracle/keeper"
 	oracletypes "github.com/cheqd/cheqd-node/x/oracle/types"
 	resourcetypes "github.com/cheqd/cheqd-node/x/resource/types"
 	resourceutils "github.com/cheqd/cheqd-node/x/resource/utils"
@@ -25,6 +23,9 @@ const (
 	MsgCreateResourceDefault
 	MsgCreateResourceImage
 	MsgCreateResourceJSON
+	MsgUpdateResourceMetadata
+	MsgDeprecateResource
+	MsgBeginResourceUpload
 
 	TaxableMsgFeeCount
 )
@@ -41,14 +42,20 @@ type TaxableMsgFee = [TaxableMsgFeeCount][]didtypes.FeeRange
 type BurnFactor = [BurnFactorCount]sdkmath.LegacyDec
 
 var TaxableMsgFees = TaxableMsgFee{
-	MsgCreateDidDoc:          []didtypes.FeeRange{},
-	MsgUpdateDidDoc:          []didtypes.FeeRange{},
-	MsgDeactivateDidDoc:      []didtypes.FeeRange{},
-	MsgCreateResourceDefault: []didtypes.FeeRange{},
-	MsgCreateResourceImage:   []didtypes.FeeRange{},
-	MsgCreateResourceJSON:    []didtypes.FeeRange{},
+	MsgCreateDidDoc:           []didtypes.FeeRange{},
+	MsgUpdateDidDoc:           []didtypes.FeeRange{},
+	MsgDeactivateDidDoc:       []didtypes.FeeRange{},
+	MsgCreateResourceDefault:  []didtypes.FeeRange{},
+	MsgCreateResourceImage:    []didtypes.FeeRange{},
+	MsgCreateResourceJSON:     []didtypes.FeeRange{},
+	MsgUpdateResourceMetadata: []didtypes.FeeRange{},
+	MsgDeprecateResource:      []didtypes.FeeRange{},
+	MsgBeginResourceUpload:    []didtypes.FeeRange{},
 }
 
+// ResourceFeeParams holds the resource fee params, as the fee of resource creation depends on the media type and size of the data
+var ResourceFeeParams = resourcetypes.FeeParams{}
+
 var BurnFactors = BurnFactor{
 	BurnFactorDid:      sdkmath.LegacyNewDec(0),
 	BurnFactorResource: sdkmath.LegacyNewDec(0),
@@ -62,49 +69,89 @@ func GetTaxableMsg(msg interface{}) bool {
 		return true
 	case *didtypes.MsgDeactivateDidDoc:
 		return true
+	case *didtypes.MsgBatchCreateDidDocs:
+		return true
+	case *didtypes.MsgRecoverDidDoc:
+		return true
 	case *resourcetypes.MsgCreateResource:
 		return true
+	case *resourcetypes.MsgBatchCreateResources:
+		return true
+	case *resourcetypes.MsgUpdateResourceMetadata:
+		return true
+	case *resourcetypes.MsgDeprecateResource:
+		return true
+	case *resourcetypes.MsgBeginResourceUpload:
+		return true
 	default:
 		return false
 	}
 }
 
 func GetTaxableMsgFeeWithBurnPortion(ctx sdk.Context, msg interface{}, ncheqPrice sdkmath.LegacyDec, userFee sdk.Coins, fak feeabskeeper.Keeper) (sdk.Coins, sdk.Coins, bool, error) {
-	denom := userFee[0].Denom
-	var nativeFees sdk.Coins
-	if hostChainConfig, found := fak.GetHostZoneConfig(ctx, denom); found {
-		var err error
-		nativeFees, err = fak.CalculateNativeFromIBCCoins(ctx, userFee, hostChainConfig)
-		if err != nil {
-			return nil, nil, true, fmt.Errorf("failed to convert IBC fee to native denom: %w", err)
-		}
+	nativeFees, err := getNativeFees(ctx, userFee, fak)
+	if err != nil {
+		return nil, nil, true, err
+	}
+	feeRanges, burnFactor, isIdentityMsg := GetTaxableMsgFeeRanges(msg)
+	if !isIdentityMsg {
+		return nil, nil, false, nil
+	}
+
+	fee, err := GetFeeForMsg(userFee, feeRanges, ncheqPrice, nativeFees)
+	if err != nil {
+		return nil, nil, true, err
+	}
+	burnPortion := GetBurnFeePortion(BurnFactors[burnFactor], fee)
+	return GetRewardPortion(fee, burnPortion), burnPortion, true, nil
+}
+
+// getNativeFees converts a user fee in an IBC denom registered with fee abstraction to the native denom,
+// or returns nil for other denoms
+func getNativeFees(ctx sdk.Context, userFee sdk.Coins, fak feeabskeeper.Keeper) (sdk.Coins, error) {
+	hostChainConfig, found := fak.GetHostZoneConfig(ctx, userFee[0].Denom)
+	if !found {
+		return nil, nil
 	}
+
+	nativeFees, err := fak.CalculateNativeFromIBCCoins(ctx, userFee, hostChainConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert IBC fee to native denom: %w", err)
+	}
+
+	return nativeFees, nil
+}
+
+// GetTaxableMsgFeeRanges returns the fee ranges of an identity message and the index of its burn factor in BurnFactors
+func GetTaxableMsgFeeRanges(msg interface{}) ([]didtypes.FeeRange, int, bool) {
 	switch msg := msg.(type) {
 	case *didtypes.MsgCreateDidDoc:
-		fee, err := GetFeeForMsg(userFee, TaxableMsgFees[MsgCreateDidDoc], ncheqPrice, nativeFees)
-		if err != nil {
-			return nil, nil, true, err
-		}
-		burnPortion := GetBurnFeePortion(BurnFactors[BurnFactorDid], fee)
-		return GetRewardPortion(fee, burnPortion), burnPortion, true, nil
+		return TaxableMsgFees[MsgCreateDidDoc], BurnFactorDid, true
 	case *didtypes.MsgUpdateDidDoc:
-		fee, err := GetFeeForMsg(userFee, TaxableMsgFees[MsgUpdateDidDoc], ncheqPrice, nativeFees)
-		if err != nil {
-			return nil, nil, true, err
-		}
-		burnPortion := GetBurnFeePortion(BurnFactors[BurnFactorDid], fee)
-		return GetRewardPortion(fee, burnPortion), burnPortion, true, nil
+		return TaxableMsgFees[MsgUpdateDidDoc], BurnFactorDid, true
 	case *didtypes.MsgDeactivateDidDoc:
-		fee, err := GetFeeForMsg(userFee, TaxableMsgFees[MsgDeactivateDidDoc], ncheqPrice, nativeFees)
-		if err != nil {
-			return nil, nil, true, err
+		return TaxableMsgFees[MsgDeactivateDidDoc], BurnFactorDid, true
+	case *didtypes.MsgBatchCreateDidDocs:
+		feeRanges := make([][]didtypes.FeeRange, 0, len(msg.GetPayload().GetPayloads()))
+		for range msg.GetPayload().GetPayloads() {
+			feeRanges = append(feeRanges, TaxableMsgFees[MsgCreateDidDoc])
 		}
-		burnPortion := GetBurnFeePortion(BurnFactors[BurnFactorDid], fee)
-		return GetRewardPortion(fee, burnPortion), burnPortion, true, nil
+		return SumFeeRanges(feeRanges...), BurnFactorDid, true
+	case *didtypes.MsgRecoverDidDoc:
+		// Recovery replaces the DID Document the same way as an update does
+		return TaxableMsgFees[MsgUpdateDidDoc], BurnFactorDid, true
 	case *resourcetypes.MsgCreateResource:
-		return GetResourceTaxableMsgFee(ctx, msg, ncheqPrice, userFee, nativeFees)
+		return GetResourceFeeRanges(msg.GetPayload().GetData()), BurnFactorResource, true
+	case *resourcetypes.MsgBatchCreateResources:
+		return GetBatchResourceFeeRanges(msg), BurnFactorResource, true
+	case *resourcetypes.MsgUpdateResourceMetadata:
+		return TaxableMsgFees[MsgUpdateResourceMetadata], BurnFactorResource, true
+	case *resourcetypes.MsgDeprecateResource:
+		return TaxableMsgFees[MsgDeprecateResource], BurnFactorResource, true
+	case *resourcetypes.MsgBeginResourceUpload:
+		return GetResourceUploadFeeRanges(msg), BurnFactorResource, true
 	default:
-		return nil, nil, false, nil
+		return nil, 0, false
 	}
 }
 
@@ -116,36 +163,103 @@ func GetRewardPortion(total sdk.Coins, burnPortion sdk.Coins) sdk.Coins {
 }
 
 func GetResourceTaxableMsgFee(ctx sdk.Context, msg *resourcetypes.MsgCreateResource, ncheqPrice sdkmath.LegacyDec, userFee sdk.Coins, nativeFee sdk.Coins) (sdk.Coins, sdk.Coins, bool, error) {
-	mediaType := resourceutils.DetectMediaType(msg.GetPayload().ToResource().Resource.Data)
+	fee, err := GetFeeForMsg(userFee, GetResourceFeeRanges(msg.GetPayload().GetData()), ncheqPrice, nativeFee)
+	if err != nil {
+		return nil, nil, true, err
+	}
 
-	// Mime type image
-	if strings.HasPrefix(mediaType, "image/") {
-		fee, err := GetFeeForMsg(userFee, TaxableMsgFees[MsgCreateResourceImage], ncheqPrice, nativeFee)
-		if err != nil {
-			return nil, nil, true, err
-		}
-		burnPortion := GetBurnFeePortion(BurnFactors[BurnFactorResource], fee)
-		return GetRewardPortion(fee, burnPortion), burnPortion, true, nil
+	burnPortion := GetBurnFeePortion(BurnFactors[BurnFactorResource], fee)
+	return GetRewardPortion(fee, burnPortion), burnPortion, true, nil
+}
+
+// GetBatchResourceFeeRanges returns the sum of the fee ranges of all resources in the batch, each according to its media type
+func GetBatchResourceFeeRanges(msg *resourcetypes.MsgBatchCreateResources) []didtypes.FeeRange {
+	feeRanges := make([][]didtypes.FeeRange, 0, len(msg.GetPayload().GetResources()))
+	for _, resource := range msg.GetPayload().GetResources() {
+		feeRanges = append(feeRanges, GetResourceFeeRanges(resource.GetData()))
 	}
 
-	// Mime type json
-	if strings.HasPrefix(mediaType, "application/json") {
-		fee, err := GetFeeForMsg(userFee, TaxableMsgFees[MsgCreateResourceJSON], ncheqPrice, nativeFee)
-		if err != nil {
-			return nil, nil, true, err
+	return SumFeeRanges(feeRanges...)
+}
+
+// GetResourceUploadFeeRanges returns the fee ranges per MiB scaled to every started MiB of the declared upload size, at least one
+func GetResourceUploadFeeRanges(msg *resourcetypes.MsgBeginResourceUpload) []didtypes.FeeRange {
+	size := msg.GetPayload().GetSize_()
+	mebibytes := size / resourcetypes.MiB
+	if size%resourcetypes.MiB != 0 || mebibytes == 0 {
+		mebibytes++
+	}
+
+	return ScaleFeeRanges(TaxableMsgFees[MsgBeginResourceUpload], mebibytes)
+}
+
+// GetResourceFeeRanges returns the fee ranges for creating a resource with the given data,
+// by the media type detected from the data and its size
+func GetResourceFeeRanges(data []byte) []didtypes.FeeRange {
+	fee, _ := ResourceFeeParams.GetCreateResourceFee(resourceutils.DetectMediaType(data), uint64(len(data)))
+	return fee
+}
+
+// SumFeeRanges adds up the fee ranges of several messages denom by denom.
+// Only denoms defined for all messages are kept, and an unbounded side stays unbounded.
+func SumFeeRanges(feeRanges ...[]didtypes.FeeRange) []didtypes.FeeRange {
+	if len(feeRanges) == 0 {
+		return nil
+	}
+
+	sum := feeRanges[0]
+	for _, ranges := range feeRanges[1:] {
+		next := make([]didtypes.FeeRange, 0, len(sum))
+		for _, acc := range sum {
+			for _, fr := range ranges {
+				if fr.Denom != acc.Denom {
+					continue
+				}
+
+				next = append(next, didtypes.FeeRange{
+					Denom:     acc.Denom,
+					MinAmount: addFeeBound(acc.MinAmount, fr.MinAmount),
+					MaxAmount: addFeeBound(acc.MaxAmount, fr.MaxAmount),
+				})
+				break
+			}
 		}
-		burnPortion := GetBurnFeePortion(BurnFactors[BurnFactorResource], fee)
-		return GetRewardPortion(fee, burnPortion), burnPortion, true, nil
+		sum = next
 	}
 
-	fee, err := GetFeeForMsg(userFee, TaxableMsgFees[MsgCreateResourceDefault], ncheqPrice, nativeFee)
-	if err != nil {
-		return nil, nil, true, err
+	return sum
+}
+
+// ScaleFeeRanges multiplies the bounds of the fee ranges by the given factor
+func ScaleFeeRanges(feeRanges []didtypes.FeeRange, factor uint64) []didtypes.FeeRange {
+	scaled := make([]didtypes.FeeRange, 0, len(feeRanges))
+	for _, fr := range feeRanges {
+		scaled = append(scaled, didtypes.FeeRange{
+			Denom:     fr.Denom,
+			MinAmount: scaleFeeBound(fr.MinAmount, factor),
+			MaxAmount: scaleFeeBound(fr.MaxAmount, factor),
+		})
 	}
 
-	// Default mime type
-	burnPortion := GetBurnFeePortion(BurnFactors[BurnFactorResource], fee)
-	return GetRewardPortion(fee, burnPortion), burnPortion, true, nil
+	return scaled
+}
+
+func scaleFeeBound(bound *sdkmath.Int, factor uint64) *sdkmath.Int {
+	if bound == nil {
+		return nil
+	}
+
+	scaled := bound.Mul(sdkmath.NewIntFromUint64(factor))
+	return &scaled
+}
+
+func addFeeBound(a, b *sdkmath.Int) *sdkmath.Int {
+	if a == nil || b == nil {
+		return nil
+	}
+
+	sum := a.Add(*b)
+	return &sum
 }
 
 func checkFeeParamsFromSubspace(ctx sdk.Context, didKeeper DidKeeper, resourceKeeper ResourceKeeper) bool {
@@ -164,6 +278,11 @@ func checkFeeParamsFromSubspace(ctx sdk.Context, didKeeper DidKeeper, resourceKe
 	TaxableMsgFees[MsgCreateResourceImage] = resourceParams.Image
 	TaxableMsgFees[MsgCreateResourceJSON] = resourceParams.Json
 	TaxableMsgFees[MsgCreateResourceDefault] = resourceParams.Default
+	TaxableMsgFees[MsgUpdateResourceMetadata] = resourceParams.UpdateResourceMetadata
+	TaxableMsgFees[MsgDeprecateResource] = resourceParams.DeprecateResource
+	TaxableMsgFees[MsgBeginResourceUpload] = resourceParams.UploadPerMib
+
+	ResourceFeeParams = resourceParams
 
 	BurnFactors[BurnFactorDid] = didParams.BurnFactor
 	BurnFactors[BurnFactorResource] = resourceParams.BurnFactor
@@ -172,25 +291,52 @@ func checkFeeParamsFromSubspace(ctx sdk.Context, didKeeper DidKeeper, resourceKe
 }
 
 func IsTaxableTx(ctx sdk.Context, didKeeper DidKeeper, resourceKeeper ResourceKeeper, tx sdk.Tx, oracleKeeper OracleKeeper, feeabsKeeper feeabskeeper.Keeper, pricefeeder PriceFeeder) (bool, sdk.Coins, sdk.Coins, error) {
-	ncheqPrice, exist := oracleKeeper.GetWMA(ctx, oracletypes.CheqdSymbol, string(oraclekeeper.WmaStrategyBalanced))
-	if !exist {
-		// fallback to fixed ICQ fixed price
-		ncheqPrice = pricefeeder.GetOracle().GetICQPrice()
-	}
+	ncheqPrice, _ := GetCheqPrice(ctx, oracleKeeper, pricefeeder)
 	_ = checkFeeParamsFromSubspace(ctx, didKeeper, resourceKeeper)
 
 	feeTx, ok := tx.(sdk.FeeTx)
 	if !ok {
 		return false, sdk.Coins{}, sdk.Coins{}, errorsmod.Wrapf(sdkerrors.ErrTxDecode, "invalid transaction type: %T, must implement FeeTx", tx)
 	}
+
+	reward, burn, err := GetTaxableMsgsFee(ctx, tx.GetMsgs(), ncheqPrice, feeTx.GetFee(), feeabsKeeper)
+	if err != nil {
+		return true, nil, nil, err
+	}
+
+	if !reward.IsZero() {
+		return true, reward, burn, nil
+	}
+
+	return false, nil, nil, nil
+}
+
+// GetCheqPrice returns the CHEQ price identity fees are computed with: the fee price average of the oracle,
+// or the ICQ price of the price feeder as a fallback. The flag is false if the fallback is used.
+// A zero price is returned while the price circuit breaker is tripped, so that the ncheq fees are charged.
+func GetCheqPrice(ctx sdk.Context, oracleKeeper OracleKeeper, pricefeeder PriceFeeder) (sdkmath.LegacyDec, bool) {
+	if !oracleKeeper.IsPriceHealthy(ctx, oracletypes.CheqdSymbol) {
+		return sdkmath.LegacyZeroDec(), false
+	}
+
+	ncheqPrice, err := oracleKeeper.GetFeeConversionPrice(ctx, oracletypes.CheqdSymbol)
+	if err != nil {
+		// fallback to fixed ICQ fixed price
+		return pricefeeder.GetOracle().GetICQPrice(), false
+	}
+
+	return ncheqPrice.Price, true
+}
+
+// GetTaxableMsgsFee sums up the reward and burn portions of the fees of all identity messages for the given user fee
+func GetTaxableMsgsFee(ctx sdk.Context, msgs []sdk.Msg, ncheqPrice sdkmath.LegacyDec, userFee sdk.Coins, feeabsKeeper feeabskeeper.Keeper) (sdk.Coins, sdk.Coins, error) {
 	reward := (sdk.Coins)(nil)
 	burn := (sdk.Coins)(nil)
-	msgs := tx.GetMsgs()
 	for _, msg := range msgs {
 
-		rewardPortion, burnPortion, isIdentityMsg, err := GetTaxableMsgFeeWithBurnPortion(ctx, msg, ncheqPrice, feeTx.GetFee(), feeabsKeeper)
+		rewardPortion, burnPortion, isIdentityMsg, err := GetTaxableMsgFeeWithBurnPortion(ctx, msg, ncheqPrice, userFee, feeabsKeeper)
 		if err != nil {
-			return true, nil, nil, err
+			return nil, nil, err
 		}
 		if !isIdentityMsg {
 			continue
@@ -201,11 +347,7 @@ func IsTaxableTx(ctx sdk.Context, didKeeper DidKeeper, resourceKeeper ResourceKe
 		}
 	}
 
-	if !reward.IsZero() {
-		return true, reward, burn, nil
-	}
-
-	return false, nil, nil, nil
+	return reward, burn, nil
 }
 
 func IsTaxableTxLite(tx sdk.Tx) bool {
@@ -220,14 +362,6 @@ func IsTaxableTxLite(tx sdk.Tx) bool {
 }
 
 func GetFeeForMsg(txFee sdk.Coins, feeRanges []didtypes.FeeRange, cheqEmaPrice sdkmath.LegacyDec, nativeFees sdk.Coins) (sdk.Coins, error) {
-	type usdRange struct {
-		denom   string
-		minUSD  *sdkmath.Int
-		maxUSD  *sdkmath.Int
-		minCoin *sdkmath.Int
-		maxCoin *sdkmath.Int
-	}
-
 	if len(feeRanges) == 0 {
 		return nil, errors.New("fee ranges empty")
 	}
@@ -253,6 +387,97 @@ func GetFeeForMsg(txFee sdk.Coins, feeRanges []didtypes.FeeRange, cheqEmaPrice s
 		return getFallbackFee(txFee, feeRanges)
 	}
 
+	ranges, overlapMin, overlapMax, err := getUSDFeeOverlap(feeRanges, cheqEmaPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	// Select denom to compute with (prefer USD)
+	chosen := chooseUSDRange(ranges)
+
+	// Convert user fee into USD and validate against overlap
+	var userUsdAmount *sdkmath.Int
+	var handled bool
+
+	for _, coin := range txFee {
+		if coin.Denom == oracletypes.CheqdDenom {
+			usdVal, err := oracletypes.NcheqToMicroUSD(coin.Amount, cheqEmaPrice)
+			if err != nil {
+				return nil, err
+			}
+			userUsdAmount = &usdVal
+			handled = true
+			break
+		}
+	}
+
+	// IBC fallback: use converted nativeFees (always in ncheq)
+	if !handled && len(nativeFees) > 0 {
+		nativeCoin := nativeFees[0]
+		if nativeCoin.Denom != oracletypes.CheqdDenom {
+			return nil, fmt.Errorf("unexpected native denom: %s", nativeCoin.Denom)
+		}
+
+		usd, err := oracletypes.NcheqToMicroUSD(nativeCoin.Amount, cheqEmaPrice)
+		if err != nil {
+			return nil, err
+		}
+		userUsdAmount = &usd
+		handled = true
+	}
+
+	if !handled || userUsdAmount == nil {
+		return nil, fmt.Errorf("user fee denom not supported and no nativeFees fallback")
+	}
+
+	// Reject if below minimum
+	if overlapMin != nil && userUsdAmount.LT(*overlapMin) {
+		return nil, fmt.Errorf("fee too low: expected ≥ %s USD, got %s USD", overlapMin, userUsdAmount)
+	}
+
+	// Use userUsdAmount directly, capped to overlapMax if needed
+	effectiveUsd := *userUsdAmount
+	if overlapMax != nil && effectiveUsd.GT(*overlapMax) {
+		effectiveUsd = *overlapMin // ← Or cap to overlapMax if desired instead
+	}
+
+	// Convert effectiveUsd to target denom
+	var finalAmount sdkmath.Int
+	var finalDenom string
+
+	// Use same denom as user for simplicity
+	switch chosen.denom {
+	case oracletypes.CheqdDenom:
+		cheqAmt, err := oracletypes.MicroUSDToNcheq(effectiveUsd, cheqEmaPrice)
+		if err != nil {
+			return nil, err
+		}
+		finalAmount = cheqAmt
+		finalDenom = oracletypes.CheqdDenom
+
+	case oracletypes.UsdDenom:
+		finalAmount = effectiveUsd.Mul(util.UsdFrom18To6)
+		finalDenom = oracletypes.UsdDenom
+
+	default:
+		return nil, fmt.Errorf("unsupported user fee denom: %s", txFee[0].Denom)
+	}
+
+	// Return final fee to be deducted (not necessarily full user amount)
+	return sdk.NewCoins(sdk.NewCoin(finalDenom, finalAmount)), nil
+}
+
+// usdRange is a fee range with its bounds converted to µUSD
+type usdRange struct {
+	denom   string
+	minUSD  *sdkmath.Int
+	maxUSD  *sdkmath.Int
+	minCoin *sdkmath.Int
+	maxCoin *sdkmath.Int
+}
+
+// getUSDFeeOverlap converts the fee ranges to µUSD and returns them along with the µUSD range accepted by all of them
+func getUSDFeeOverlap(feeRanges []didtypes.FeeRange, cheqEmaPrice sdkmath.LegacyDec) ([]usdRange, *sdkmath.Int, *sdkmath.Int, error) {
 	var ranges []usdRange
 
 	// Convert all ranges to scaled USD values
@@ -266,11 +491,17 @@ func GetFeeForMsg(txFee sdk.Coins, feeRanges []didtypes.FeeRange, cheqEmaPrice s
 		switch fr.Denom {
 		case oracletypes.CheqdDenom:
 			if fr.MinAmount != nil {
-				usd := sdkmath.LegacyNewDecFromInt(*fr.MinAmount).QuoInt(util.CheqScale).Mul(cheqEmaPrice).MulInt(util.UsdScale).TruncateInt()
+				usd, err := oracletypes.NcheqToMicroUSD(*fr.MinAmount, cheqEmaPrice)
+				if err != nil {
+					return nil, nil, nil, err
+				}
 				minUSD = &usd
 			}
 			if fr.MaxAmount != nil {
-				usd := sdkmath.LegacyNewDecFromInt(*fr.MaxAmount).QuoInt(util.CheqScale).Mul(cheqEmaPrice).MulInt(util.UsdScale).TruncateInt()
+				usd, err := oracletypes.NcheqToMicroUSD(*fr.MaxAmount, cheqEmaPrice)
+				if err != nil {
+					return nil, nil, nil, err
+				}
 				maxUSD = &usd
 			}
 		case oracletypes.UsdDenom:
@@ -296,7 +527,7 @@ func GetFeeForMsg(txFee sdk.Coins, feeRanges []didtypes.FeeRange, cheqEmaPrice s
 	}
 
 	if len(ranges) == 0 {
-		return nil, errors.New("no valid fee ranges could be converted")
+		return nil, nil, nil, errors.New("no valid fee ranges could be converted")
 	}
 
 	// Find overlapping range
@@ -314,89 +545,21 @@ func GetFeeForMsg(txFee sdk.Coins, feeRanges []didtypes.FeeRange, cheqEmaPrice s
 		}
 	}
 	if overlapMin != nil && overlapMax != nil && overlapMin.GT(*overlapMax) {
-		return nil, errors.New("no valid overlapping USD range")
+		return nil, nil, nil, errors.New("no valid overlapping USD range")
 	}
 
-	// Select denom to compute with (prefer USD)
-	var chosen usdRange
+	return ranges, overlapMin, overlapMax, nil
+}
+
+// chooseUSDRange returns the range whose denom the fee is charged in, USD if available
+func chooseUSDRange(ranges []usdRange) usdRange {
 	for _, r := range ranges {
 		if r.denom == oracletypes.UsdDenom {
-			chosen = r
-			break
+			return r
 		}
 	}
-	if chosen.denom == "" {
-		chosen = ranges[0]
-	}
-	// Convert user fee into USD and validate against overlap
-	var userUsdAmount *sdkmath.Int
-	var handled bool
-
-	for _, coin := range txFee {
-		if coin.Denom == oracletypes.CheqdDenom {
-			cheqDec := sdkmath.LegacyNewDecFromInt(coin.Amount).QuoInt(util.CheqScale)
-			usd := cheqDec.Mul(cheqEmaPrice).MulInt(util.UsdScale)
-			usdVal := usd.TruncateInt()
-			userUsdAmount = &usdVal
-			handled = true
-			break
-		}
-	}
-
-	// IBC fallback: use converted nativeFees (always in ncheq)
-	if !handled && len(nativeFees) > 0 {
-		nativeCoin := nativeFees[0]
-		if nativeCoin.Denom != oracletypes.CheqdDenom {
-			return nil, fmt.Errorf("unexpected native denom: %s", nativeCoin.Denom)
-		}
 
-		cheqDec := sdkmath.LegacyNewDecFromInt(nativeCoin.Amount).QuoInt(util.CheqScale)
-		usd := cheqDec.Mul(cheqEmaPrice).MulInt(util.UsdScale)
-		usd1 := usd.TruncateInt()
-		userUsdAmount = &usd1
-		handled = true
-	}
-
-	if !handled || userUsdAmount == nil {
-		return nil, fmt.Errorf("user fee denom not supported and no nativeFees fallback")
-	}
-
-	// Reject if below minimum
-	if overlapMin != nil && userUsdAmount.LT(*overlapMin) {
-		return nil, fmt.Errorf("fee too low: expected ≥ %s USD, got %s USD", overlapMin, userUsdAmount)
-	}
-
-	// Use userUsdAmount directly, capped to overlapMax if needed
-	effectiveUsd := *userUsdAmount
-	if overlapMax != nil && effectiveUsd.GT(*overlapMax) {
-		effectiveUsd = *overlapMin // ← Or cap to overlapMax if desired instead
-	}
-
-	// Convert effectiveUsd to target denom
-	var finalAmount sdkmath.Int
-	var finalDenom string
-
-	// Use same denom as user for simplicity
-	switch chosen.denom {
-	case oracletypes.CheqdDenom:
-		cheqAmt := sdkmath.LegacyNewDecFromInt(effectiveUsd).
-			Quo(cheqEmaPrice).
-			MulInt(util.CheqScale).
-			QuoInt(util.UsdScale).
-			TruncateInt()
-		finalAmount = cheqAmt
-		finalDenom = oracletypes.CheqdDenom
-
-	case oracletypes.UsdDenom:
-		finalAmount = effectiveUsd.Mul(util.UsdFrom18To6)
-		finalDenom = oracletypes.UsdDenom
-
-	default:
-		return nil, fmt.Errorf("unsupported user fee denom: %s", txFee[0].Denom)
-	}
-
-	// Return final fee to be deducted (not necessarily full user amount)
-	return sdk.NewCoins(sdk.NewCoin(finalDenom, finalAmount)), nil
+	return ranges[0]
 }
 
 func getFallbackFee(txFee sdk.Coins, feeRanges []didtypes.FeeRange) (sdk.Coins, error) {
@@ -440,8 +603,10 @@ func validateCrossDenomFixedFee(
 			if cheqEmaPrice.IsZero() {
 				return nil, errors.New("cannot verify cross-denom fixed fee: cheq price not available")
 			}
-			usdAmount := sdkmath.LegacyNewDecFromInt(fixedFee.Amount).QuoInt(util.UsdFrom18To6) // 1e18 → 1e6
-			requiredCheq := usdAmount.Quo(cheqEmaPrice).MulInt(util.CheqScale).QuoInt(util.UsdScale).TruncateInt()
+			requiredCheq, err := oracletypes.USDToNcheq(fixedFee.Amount, cheqEmaPrice)
+			if err != nil {
+				return nil, err
+			}
 
 			if coin.Amount.LT(requiredCheq) {
 				return nil, fmt.Errorf("insufficient fee: need at least %s, got %s", requiredCheq, coin.Amount)
@@ -459,9 +624,11 @@ func validateCrossDenomFixedFee(
 					return nil, errors.New("cannot verify fixed fee for IBC denom: cheq price not available")
 				}
 
-				// Step 1: Convert nativeCoin (e.g., ncheq) into USD
-				nativeDec := sdkmath.LegacyNewDecFromInt(nativeCoin)
-				nativeUsd := nativeDec.QuoInt(util.CheqScale).Mul(cheqEmaPrice).MulInt(util.UsdScale).TruncateInt() // in µUSD
+				// Step 1: Convert nativeCoin (e.g., ncheq) into µUSD
+				nativeUsd, err := oracletypes.NcheqToMicroUSD(nativeCoin, cheqEmaPrice)
+				if err != nil {
+					return nil, err
+				}
 
 				// Step 2: Convert required USD fee to µUSD
 				requiredUsd := sdkmath.LegacyNewDecFromInt(fixedFee.Amount).QuoInt(util.UsdFrom18To6).TruncateInt() // 18-dec → 6-dec
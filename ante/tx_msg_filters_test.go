@@ -2,6 +2,7 @@ package ante_test
 
 import (
 	"encoding/base64"
+	"strings"
 
 	"cosmossdk.io/math"
 	"github.com/cheqd/cheqd-node/ante"
@@ -61,6 +62,19 @@ var _ = Describe("TxMsgFilters", func() {
 					MaxAmount: util.PtrInt(100e9),
 				},
 			},
+			ante.MsgBeginResourceUpload: []didtypes.FeeRange{
+				{
+					Denom:     didtypes.BaseMinimalDenom,
+					MinAmount: util.PtrInt(resourcetypes.DefaultCreateResourceDefaultFee),
+					MaxAmount: util.PtrInt(100e9),
+				},
+			},
+		}
+
+		ante.ResourceFeeParams = resourcetypes.FeeParams{
+			Default: ante.TaxableMsgFees[ante.MsgCreateResourceDefault],
+			Image:   ante.TaxableMsgFees[ante.MsgCreateResourceImage],
+			Json:    ante.TaxableMsgFees[ante.MsgCreateResourceJSON],
 		}
 
 		ante.BurnFactors = ante.BurnFactor{
@@ -69,6 +83,181 @@ var _ = Describe("TxMsgFilters", func() {
 		}
 	})
 
+	Describe("GetTaxableMsg", func() {
+		It("should mark resource metadata update and deprecation as taxable", func() {
+			Expect(ante.GetTaxableMsg(&resourcetypes.MsgUpdateResourceMetadata{})).To(BeTrue())
+			Expect(ante.GetTaxableMsg(&resourcetypes.MsgDeprecateResource{})).To(BeTrue())
+			Expect(ante.GetTaxableMsg(&resourcetypes.MsgUpdateParams{})).To(BeFalse())
+		})
+
+		It("should mark batch creation messages as taxable", func() {
+			Expect(ante.GetTaxableMsg(&didtypes.MsgBatchCreateDidDocs{})).To(BeTrue())
+			Expect(ante.GetTaxableMsg(&resourcetypes.MsgBatchCreateResources{})).To(BeTrue())
+		})
+
+		It("should mark DID recovery as taxable", func() {
+			Expect(ante.GetTaxableMsg(&didtypes.MsgRecoverDidDoc{})).To(BeTrue())
+		})
+
+		It("should only mark the beginning of a chunked upload as taxable", func() {
+			Expect(ante.GetTaxableMsg(&resourcetypes.MsgBeginResourceUpload{})).To(BeTrue())
+			Expect(ante.GetTaxableMsg(&resourcetypes.MsgAppendResourceChunk{})).To(BeFalse())
+			Expect(ante.GetTaxableMsg(&resourcetypes.MsgFinalizeResourceUpload{})).To(BeFalse())
+		})
+	})
+
+	Describe("ScaleFeeRanges", func() {
+		It("should multiply both bounds and keep unbounded sides unbounded", func() {
+			scaled := ante.ScaleFeeRanges([]didtypes.FeeRange{
+				{Denom: didtypes.BaseMinimalDenom, MinAmount: util.PtrInt(1e9), MaxAmount: util.PtrInt(2e9)},
+				{Denom: "usd", MinAmount: util.PtrInt(1e18)},
+			}, 3)
+			Expect(scaled).To(Equal([]didtypes.FeeRange{
+				{Denom: didtypes.BaseMinimalDenom, MinAmount: util.PtrInt(3e9), MaxAmount: util.PtrInt(6e9)},
+				{Denom: "usd", MinAmount: util.PtrInt(3e18)},
+			}))
+		})
+	})
+
+	Describe("GetTaxableMsgFeeRanges of a chunked upload", func() {
+		uploadMsg := func(size uint64) *resourcetypes.MsgBeginResourceUpload {
+			return &resourcetypes.MsgBeginResourceUpload{
+				Payload: &resourcetypes.MsgBeginResourceUploadPayload{Size_: size},
+			}
+		}
+
+		It("should charge the fee per MiB for every started MiB", func() {
+			userFee := sdk.NewCoins(sdk.NewCoin(didtypes.BaseMinimalDenom, math.NewInt(3*resourcetypes.DefaultCreateResourceDefaultFee)))
+
+			feeRanges, burnFactor, ok := ante.GetTaxableMsgFeeRanges(uploadMsg(2*resourcetypes.MiB + 1))
+			Expect(ok).To(BeTrue())
+			Expect(burnFactor).To(Equal(ante.BurnFactorResource))
+
+			fee, err := ante.GetFeeForMsg(userFee, feeRanges, math.LegacyZeroDec(), nil)
+			Expect(err).To(BeNil())
+			Expect(fee).To(Equal(userFee))
+		})
+
+		It("should fail if the fee does not cover the declared size", func() {
+			userFee := sdk.NewCoins(sdk.NewCoin(didtypes.BaseMinimalDenom, math.NewInt(resourcetypes.DefaultCreateResourceDefaultFee)))
+
+			feeRanges, _, ok := ante.GetTaxableMsgFeeRanges(uploadMsg(resourcetypes.MiB + 1))
+			Expect(ok).To(BeTrue())
+
+			_, err := ante.GetFeeForMsg(userFee, feeRanges, math.LegacyZeroDec(), nil)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("SumFeeRanges", func() {
+		It("should add up the ranges of the same denom", func() {
+			sum := ante.SumFeeRanges(
+				[]didtypes.FeeRange{{Denom: didtypes.BaseMinimalDenom, MinAmount: util.PtrInt(1e9), MaxAmount: util.PtrInt(2e9)}},
+				[]didtypes.FeeRange{{Denom: didtypes.BaseMinimalDenom, MinAmount: util.PtrInt(3e9), MaxAmount: util.PtrInt(4e9)}},
+			)
+			Expect(sum).To(Equal([]didtypes.FeeRange{{Denom: didtypes.BaseMinimalDenom, MinAmount: util.PtrInt(4e9), MaxAmount: util.PtrInt(6e9)}}))
+		})
+
+		It("should keep unbounded sides unbounded", func() {
+			sum := ante.SumFeeRanges(
+				[]didtypes.FeeRange{{Denom: didtypes.BaseMinimalDenom, MinAmount: util.PtrInt(1e9)}},
+				[]didtypes.FeeRange{{Denom: didtypes.BaseMinimalDenom, MinAmount: util.PtrInt(3e9), MaxAmount: util.PtrInt(4e9)}},
+			)
+			Expect(sum).To(Equal([]didtypes.FeeRange{{Denom: didtypes.BaseMinimalDenom, MinAmount: util.PtrInt(4e9)}}))
+		})
+
+		It("should drop denoms not defined for all messages", func() {
+			sum := ante.SumFeeRanges(
+				[]didtypes.FeeRange{
+					{Denom: didtypes.BaseMinimalDenom, MinAmount: util.PtrInt(1e9), MaxAmount: util.PtrInt(2e9)},
+					{Denom: "usd", MinAmount: util.PtrInt(1e18), MaxAmount: util.PtrInt(1e18)},
+				},
+				[]didtypes.FeeRange{{Denom: didtypes.BaseMinimalDenom, MinAmount: util.PtrInt(3e9), MaxAmount: util.PtrInt(4e9)}},
+			)
+			Expect(sum).To(Equal([]didtypes.FeeRange{{Denom: didtypes.BaseMinimalDenom, MinAmount: util.PtrInt(4e9), MaxAmount: util.PtrInt(6e9)}}))
+		})
+	})
+
+	Describe("GetTaxableMsgFeeRanges of a resource batch", func() {
+		batchMsg := resourcetypes.MsgBatchCreateResources{
+			Payload: &resourcetypes.MsgBatchCreateResourcesPayload{
+				Resources: []*resourcetypes.MsgCreateResourcePayload{
+					{Data: []byte(`{"key": "value"}`)},
+					{Data: []byte("plain text")},
+				},
+			},
+		}
+
+		It("should charge the sum of the fees of all resources by media type", func() {
+			userFee := sdk.NewCoins(sdk.NewCoin(didtypes.BaseMinimalDenom, math.NewInt(10e9)))
+
+			feeRanges, burnFactor, ok := ante.GetTaxableMsgFeeRanges(&batchMsg)
+			Expect(ok).To(BeTrue())
+			Expect(burnFactor).To(Equal(ante.BurnFactorResource))
+
+			fee, err := ante.GetFeeForMsg(userFee, feeRanges, math.LegacyZeroDec(), nil)
+			Expect(err).To(BeNil())
+
+			expected := sdk.NewCoins(sdk.NewCoin(didtypes.BaseMinimalDenom, math.NewInt(resourcetypes.DefaultCreateResourceJSONFee+resourcetypes.DefaultCreateResourceDefaultFee)))
+			Expect(fee).To(Equal(expected))
+		})
+
+		It("should fail if the fee does not cover all resources", func() {
+			userFee := sdk.NewCoins(sdk.NewCoin(didtypes.BaseMinimalDenom, math.NewInt(resourcetypes.DefaultCreateResourceDefaultFee)))
+
+			feeRanges, _, ok := ante.GetTaxableMsgFeeRanges(&batchMsg)
+			Expect(ok).To(BeTrue())
+
+			_, err := ante.GetFeeForMsg(userFee, feeRanges, math.LegacyZeroDec(), nil)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("GetResourceFeeRanges", func() {
+		content := []byte(`{"key": "value"}`)
+
+		It("should return the fixed fee of the media type without size-tiered pricing", func() {
+			Expect(ante.GetResourceFeeRanges(content)).To(Equal(ante.TaxableMsgFees[ante.MsgCreateResourceJSON]))
+		})
+
+		It("should charge the base fee plus the fee per started KiB with size-tiered pricing", func() {
+			ante.ResourceFeeParams.JsonSizePricing = &resourcetypes.SizePricing{
+				Base:   []didtypes.FeeRange{{Denom: didtypes.BaseMinimalDenom, MinAmount: util.PtrInt(1e9), MaxAmount: util.PtrInt(2e9)}},
+				PerKib: []didtypes.FeeRange{{Denom: didtypes.BaseMinimalDenom, MinAmount: util.PtrInt(1e8), MaxAmount: util.PtrInt(1e8)}},
+			}
+
+			large := []byte(`{"key": "` + strings.Repeat("a", 2*resourcetypes.KiB) + `"}`)
+			Expect(ante.GetResourceFeeRanges(content)).To(Equal([]didtypes.FeeRange{
+				{Denom: didtypes.BaseMinimalDenom, MinAmount: util.PtrInt(11e8), MaxAmount: util.PtrInt(21e8)},
+			}))
+			Expect(ante.GetResourceFeeRanges(large)).To(Equal([]didtypes.FeeRange{
+				{Denom: didtypes.BaseMinimalDenom, MinAmount: util.PtrInt(13e8), MaxAmount: util.PtrInt(23e8)},
+			}))
+		})
+
+		It("should fail if the fee does not cover the size of the resource", func() {
+			ante.ResourceFeeParams.JsonSizePricing = &resourcetypes.SizePricing{
+				Base:   []didtypes.FeeRange{{Denom: didtypes.BaseMinimalDenom, MinAmount: util.PtrInt(1e9), MaxAmount: util.PtrInt(1e9)}},
+				PerKib: []didtypes.FeeRange{{Denom: didtypes.BaseMinimalDenom, MinAmount: util.PtrInt(1e9), MaxAmount: util.PtrInt(1e9)}},
+			}
+
+			resourceMsg := resourcetypes.MsgCreateResource{
+				Payload: &resourcetypes.MsgCreateResourcePayload{Data: content},
+			}
+
+			userFee := sdk.NewCoins(sdk.NewCoin(didtypes.BaseMinimalDenom, math.NewInt(1e9)))
+			_, _, ok, err := ante.GetResourceTaxableMsgFee(sdk.Context{}, &resourceMsg, math.LegacyZeroDec(), userFee, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(ok).To(BeTrue())
+
+			userFee = sdk.NewCoins(sdk.NewCoin(didtypes.BaseMinimalDenom, math.NewInt(2e9)))
+			reward, burn, ok, err := ante.GetResourceTaxableMsgFee(sdk.Context{}, &resourceMsg, math.LegacyZeroDec(), userFee, nil)
+			Expect(err).To(BeNil())
+			Expect(ok).To(BeTrue())
+			Expect(reward.Add(burn...)).To(Equal(userFee))
+		})
+	})
+
 	Describe("GetResourceTaxableMsgFee", func() {
 		It("should return the correct fee for image mimetype - 1mn rounds", func() {
 			// define byte content, base64-encoded png
@@ -497,6 +497,7 @@ var (
 	fd_DidDoc_key_agreement         protoreflect.FieldDescriptor
 	fd_DidDoc_service               protoreflect.FieldDescriptor
 	fd_DidDoc_also_known_as         protoreflect.FieldDescriptor
+	fd_DidDoc_controller_threshold  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -513,6 +514,7 @@ func init() {
 	fd_DidDoc_key_agreement = md_DidDoc.Fields().ByName("key_agreement")
 	fd_DidDoc_service = md_DidDoc.Fields().ByName("service")
 	fd_DidDoc_also_known_as = md_DidDoc.Fields().ByName("also_known_as")
+	fd_DidDoc_controller_threshold = md_DidDoc.Fields().ByName("controller_threshold")
 }
 
 var _ protoreflect.Message = (*fastReflection_DidDoc)(nil)
@@ -646,6 +648,12 @@ func (x *fastReflection_DidDoc) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.ControllerThreshold != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ControllerThreshold)
+		if !f(fd_DidDoc_controller_threshold, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -683,6 +691,8 @@ func (x *fastReflection_DidDoc) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.Service) != 0
 	case "cheqd.did.v2.DidDoc.also_known_as":
 		return len(x.AlsoKnownAs) != 0
+	case "cheqd.did.v2.DidDoc.controller_threshold":
+		return x.ControllerThreshold != uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDoc"))
@@ -721,6 +731,8 @@ func (x *fastReflection_DidDoc) Clear(fd protoreflect.FieldDescriptor) {
 		x.Service = nil
 	case "cheqd.did.v2.DidDoc.also_known_as":
 		x.AlsoKnownAs = nil
+	case "cheqd.did.v2.DidDoc.controller_threshold":
+		x.ControllerThreshold = uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDoc"))
@@ -800,6 +812,9 @@ func (x *fastReflection_DidDoc) Get(descriptor protoreflect.FieldDescriptor) pro
 		}
 		listValue := &_DidDoc_11_list{list: &x.AlsoKnownAs}
 		return protoreflect.ValueOfList(listValue)
+	case "cheqd.did.v2.DidDoc.controller_threshold":
+		value := x.ControllerThreshold
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDoc"))
@@ -862,6 +877,8 @@ func (x *fastReflection_DidDoc) Set(fd protoreflect.FieldDescriptor, value proto
 		lv := value.List()
 		clv := lv.(*_DidDoc_11_list)
 		x.AlsoKnownAs = *clv.list
+	case "cheqd.did.v2.DidDoc.controller_threshold":
+		x.ControllerThreshold = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDoc"))
@@ -944,6 +961,8 @@ func (x *fastReflection_DidDoc) Mutable(fd protoreflect.FieldDescriptor) protore
 		return protoreflect.ValueOfList(value)
 	case "cheqd.did.v2.DidDoc.id":
 		panic(fmt.Errorf("field id of message cheqd.did.v2.DidDoc is not mutable"))
+	case "cheqd.did.v2.DidDoc.controller_threshold":
+		panic(fmt.Errorf("field controller_threshold of message cheqd.did.v2.DidDoc is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDoc"))
@@ -989,6 +1008,8 @@ func (x *fastReflection_DidDoc) NewField(fd protoreflect.FieldDescriptor) protor
 	case "cheqd.did.v2.DidDoc.also_known_as":
 		list := []string{}
 		return protoreflect.ValueOfList(&_DidDoc_11_list{list: &list})
+	case "cheqd.did.v2.DidDoc.controller_threshold":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDoc"))
@@ -1122,6 +1143,9 @@ func (x *fastReflection_DidDoc) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.ControllerThreshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.ControllerThreshold))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1151,6 +1175,11 @@ func (x *fastReflection_DidDoc) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.ControllerThreshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ControllerThreshold))
+			i--
+			dAtA[i] = 0x60
+		}
 		if len(x.AlsoKnownAs) > 0 {
 			for iNdEx := len(x.AlsoKnownAs) - 1; iNdEx >= 0; iNdEx-- {
 				i -= len(x.AlsoKnownAs[iNdEx])
@@ -1667,6 +1696,25 @@ func (x *fastReflection_DidDoc) ProtoMethods() *protoiface.Methods {
 				}
 				x.AlsoKnownAs = append(x.AlsoKnownAs, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ControllerThreshold", wireType)
+				}
+				x.ControllerThreshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ControllerThreshold |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2498,15 +2546,67 @@ func (x *_Service_6_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Service_8_list)(nil)
+
+type _Service_8_list struct {
+	list *[]*ServiceEndpointMap
+}
+
+func (x *_Service_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Service_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Service_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ServiceEndpointMap)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Service_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ServiceEndpointMap)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Service_8_list) AppendMutable() protoreflect.Value {
+	v := new(ServiceEndpointMap)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Service_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Service_8_list) NewElement() protoreflect.Value {
+	v := new(ServiceEndpointMap)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Service_8_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Service                  protoreflect.MessageDescriptor
-	fd_Service_id               protoreflect.FieldDescriptor
-	fd_Service_service_type     protoreflect.FieldDescriptor
-	fd_Service_service_endpoint protoreflect.FieldDescriptor
-	fd_Service_recipient_keys   protoreflect.FieldDescriptor
-	fd_Service_routing_keys     protoreflect.FieldDescriptor
-	fd_Service_accept           protoreflect.FieldDescriptor
-	fd_Service_priority         protoreflect.FieldDescriptor
+	md_Service                       protoreflect.MessageDescriptor
+	fd_Service_id                    protoreflect.FieldDescriptor
+	fd_Service_service_type          protoreflect.FieldDescriptor
+	fd_Service_service_endpoint      protoreflect.FieldDescriptor
+	fd_Service_recipient_keys        protoreflect.FieldDescriptor
+	fd_Service_routing_keys          protoreflect.FieldDescriptor
+	fd_Service_accept                protoreflect.FieldDescriptor
+	fd_Service_priority              protoreflect.FieldDescriptor
+	fd_Service_service_endpoint_maps protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -2519,6 +2619,7 @@ func init() {
 	fd_Service_routing_keys = md_Service.Fields().ByName("routing_keys")
 	fd_Service_accept = md_Service.Fields().ByName("accept")
 	fd_Service_priority = md_Service.Fields().ByName("priority")
+	fd_Service_service_endpoint_maps = md_Service.Fields().ByName("service_endpoint_maps")
 }
 
 var _ protoreflect.Message = (*fastReflection_Service)(nil)
@@ -2628,6 +2729,12 @@ func (x *fastReflection_Service) Range(f func(protoreflect.FieldDescriptor, prot
 			return
 		}
 	}
+	if len(x.ServiceEndpointMaps) != 0 {
+		value := protoreflect.ValueOfList(&_Service_8_list{list: &x.ServiceEndpointMaps})
+		if !f(fd_Service_service_endpoint_maps, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2657,6 +2764,8 @@ func (x *fastReflection_Service) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.Accept) != 0
 	case "cheqd.did.v2.Service.priority":
 		return x.Priority != uint32(0)
+	case "cheqd.did.v2.Service.service_endpoint_maps":
+		return len(x.ServiceEndpointMaps) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.Service"))
@@ -2687,6 +2796,8 @@ func (x *fastReflection_Service) Clear(fd protoreflect.FieldDescriptor) {
 		x.Accept = nil
 	case "cheqd.did.v2.Service.priority":
 		x.Priority = uint32(0)
+	case "cheqd.did.v2.Service.service_endpoint_maps":
+		x.ServiceEndpointMaps = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.Service"))
@@ -2736,6 +2847,12 @@ func (x *fastReflection_Service) Get(descriptor protoreflect.FieldDescriptor) pr
 	case "cheqd.did.v2.Service.priority":
 		value := x.Priority
 		return protoreflect.ValueOfUint32(value)
+	case "cheqd.did.v2.Service.service_endpoint_maps":
+		if len(x.ServiceEndpointMaps) == 0 {
+			return protoreflect.ValueOfList(&_Service_8_list{})
+		}
+		listValue := &_Service_8_list{list: &x.ServiceEndpointMaps}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.Service"))
@@ -2778,6 +2895,10 @@ func (x *fastReflection_Service) Set(fd protoreflect.FieldDescriptor, value prot
 		x.Accept = *clv.list
 	case "cheqd.did.v2.Service.priority":
 		x.Priority = uint32(value.Uint())
+	case "cheqd.did.v2.Service.service_endpoint_maps":
+		lv := value.List()
+		clv := lv.(*_Service_8_list)
+		x.ServiceEndpointMaps = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.Service"))
@@ -2822,6 +2943,12 @@ func (x *fastReflection_Service) Mutable(fd protoreflect.FieldDescriptor) protor
 		}
 		value := &_Service_6_list{list: &x.Accept}
 		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.Service.service_endpoint_maps":
+		if x.ServiceEndpointMaps == nil {
+			x.ServiceEndpointMaps = []*ServiceEndpointMap{}
+		}
+		value := &_Service_8_list{list: &x.ServiceEndpointMaps}
+		return protoreflect.ValueOfList(value)
 	case "cheqd.did.v2.Service.id":
 		panic(fmt.Errorf("field id of message cheqd.did.v2.Service is not mutable"))
 	case "cheqd.did.v2.Service.service_type":
@@ -2859,6 +2986,9 @@ func (x *fastReflection_Service) NewField(fd protoreflect.FieldDescriptor) proto
 		return protoreflect.ValueOfList(&_Service_6_list{list: &list})
 	case "cheqd.did.v2.Service.priority":
 		return protoreflect.ValueOfUint32(uint32(0))
+	case "cheqd.did.v2.Service.service_endpoint_maps":
+		list := []*ServiceEndpointMap{}
+		return protoreflect.ValueOfList(&_Service_8_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.Service"))
@@ -2963,6 +3093,12 @@ func (x *fastReflection_Service) ProtoMethods() *protoiface.Methods {
 		if x.Priority != 0 {
 			n += 1 + runtime.Sov(uint64(x.Priority))
 		}
+		if len(x.ServiceEndpointMaps) > 0 {
+			for _, e := range x.ServiceEndpointMaps {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2992,6 +3128,22 @@ func (x *fastReflection_Service) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.ServiceEndpointMaps) > 0 {
+			for iNdEx := len(x.ServiceEndpointMaps) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ServiceEndpointMaps[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
 		if x.Priority != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.Priority))
 			i--
@@ -3307,6 +3459,40 @@ func (x *fastReflection_Service) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServiceEndpointMaps", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ServiceEndpointMaps = append(x.ServiceEndpointMaps, &ServiceEndpointMap{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ServiceEndpointMaps[len(x.ServiceEndpointMaps)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3342,120 +3528,2933 @@ func (x *fastReflection_Service) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var (
-	md_DidDocWithMetadata          protoreflect.MessageDescriptor
-	fd_DidDocWithMetadata_did_doc  protoreflect.FieldDescriptor
-	fd_DidDocWithMetadata_metadata protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_ServiceEndpointMap_2_list)(nil)
 
-func init() {
-	file_cheqd_did_v2_diddoc_proto_init()
-	md_DidDocWithMetadata = File_cheqd_did_v2_diddoc_proto.Messages().ByName("DidDocWithMetadata")
-	fd_DidDocWithMetadata_did_doc = md_DidDocWithMetadata.Fields().ByName("did_doc")
-	fd_DidDocWithMetadata_metadata = md_DidDocWithMetadata.Fields().ByName("metadata")
+type _ServiceEndpointMap_2_list struct {
+	list *[]string
 }
 
-var _ protoreflect.Message = (*fastReflection_DidDocWithMetadata)(nil)
+func (x *_ServiceEndpointMap_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-type fastReflection_DidDocWithMetadata DidDocWithMetadata
+func (x *_ServiceEndpointMap_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
 
-func (x *DidDocWithMetadata) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_DidDocWithMetadata)(x)
+func (x *_ServiceEndpointMap_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
 }
 
-func (x *DidDocWithMetadata) slowProtoReflect() protoreflect.Message {
-	mi := &file_cheqd_did_v2_diddoc_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *_ServiceEndpointMap_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
 }
 
-var _fastReflection_DidDocWithMetadata_messageType fastReflection_DidDocWithMetadata_messageType
-var _ protoreflect.MessageType = fastReflection_DidDocWithMetadata_messageType{}
+func (x *_ServiceEndpointMap_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ServiceEndpointMap at list field Accept as it is not of Message kind"))
+}
 
-type fastReflection_DidDocWithMetadata_messageType struct{}
+func (x *_ServiceEndpointMap_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
 
-func (x fastReflection_DidDocWithMetadata_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_DidDocWithMetadata)(nil)
+func (x *_ServiceEndpointMap_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
 }
-func (x fastReflection_DidDocWithMetadata_messageType) New() protoreflect.Message {
-	return new(fastReflection_DidDocWithMetadata)
+
+func (x *_ServiceEndpointMap_2_list) IsValid() bool {
+	return x.list != nil
 }
-func (x fastReflection_DidDocWithMetadata_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_DidDocWithMetadata
+
+var _ protoreflect.List = (*_ServiceEndpointMap_3_list)(nil)
+
+type _ServiceEndpointMap_3_list struct {
+	list *[]string
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_DidDocWithMetadata) Descriptor() protoreflect.MessageDescriptor {
-	return md_DidDocWithMetadata
+func (x *_ServiceEndpointMap_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_DidDocWithMetadata) Type() protoreflect.MessageType {
-	return _fastReflection_DidDocWithMetadata_messageType
+func (x *_ServiceEndpointMap_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_DidDocWithMetadata) New() protoreflect.Message {
-	return new(fastReflection_DidDocWithMetadata)
+func (x *_ServiceEndpointMap_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_DidDocWithMetadata) Interface() protoreflect.ProtoMessage {
-	return (*DidDocWithMetadata)(x)
+func (x *_ServiceEndpointMap_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_DidDocWithMetadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.DidDoc != nil {
-		value := protoreflect.ValueOfMessage(x.DidDoc.ProtoReflect())
-		if !f(fd_DidDocWithMetadata_did_doc, value) {
-			return
-		}
-	}
-	if x.Metadata != nil {
-		value := protoreflect.ValueOfMessage(x.Metadata.ProtoReflect())
-		if !f(fd_DidDocWithMetadata_metadata, value) {
-			return
-		}
-	}
+func (x *_ServiceEndpointMap_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ServiceEndpointMap at list field RoutingKeys as it is not of Message kind"))
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_DidDocWithMetadata) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "cheqd.did.v2.DidDocWithMetadata.did_doc":
-		return x.DidDoc != nil
-	case "cheqd.did.v2.DidDocWithMetadata.metadata":
-		return x.Metadata != nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDocWithMetadata"))
-		}
-		panic(fmt.Errorf("message cheqd.did.v2.DidDocWithMetadata does not contain field %s", fd.FullName()))
+func (x *_ServiceEndpointMap_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ServiceEndpointMap_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ServiceEndpointMap_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_ServiceEndpointMap_4_list)(nil)
+
+type _ServiceEndpointMap_4_list struct {
+	list *[]string
+}
+
+func (x *_ServiceEndpointMap_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ServiceEndpointMap_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ServiceEndpointMap_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ServiceEndpointMap_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ServiceEndpointMap_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ServiceEndpointMap at list field Origins as it is not of Message kind"))
+}
+
+func (x *_ServiceEndpointMap_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ServiceEndpointMap_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ServiceEndpointMap_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_ServiceEndpointMap_5_list)(nil)
+
+type _ServiceEndpointMap_5_list struct {
+	list *[]*ServiceEndpointProperty
+}
+
+func (x *_ServiceEndpointMap_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ServiceEndpointMap_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ServiceEndpointMap_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ServiceEndpointProperty)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ServiceEndpointMap_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ServiceEndpointProperty)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ServiceEndpointMap_5_list) AppendMutable() protoreflect.Value {
+	v := new(ServiceEndpointProperty)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ServiceEndpointMap_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ServiceEndpointMap_5_list) NewElement() protoreflect.Value {
+	v := new(ServiceEndpointProperty)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ServiceEndpointMap_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ServiceEndpointMap              protoreflect.MessageDescriptor
+	fd_ServiceEndpointMap_uri          protoreflect.FieldDescriptor
+	fd_ServiceEndpointMap_accept       protoreflect.FieldDescriptor
+	fd_ServiceEndpointMap_routing_keys protoreflect.FieldDescriptor
+	fd_ServiceEndpointMap_origins      protoreflect.FieldDescriptor
+	fd_ServiceEndpointMap_properties   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cheqd_did_v2_diddoc_proto_init()
+	md_ServiceEndpointMap = File_cheqd_did_v2_diddoc_proto.Messages().ByName("ServiceEndpointMap")
+	fd_ServiceEndpointMap_uri = md_ServiceEndpointMap.Fields().ByName("uri")
+	fd_ServiceEndpointMap_accept = md_ServiceEndpointMap.Fields().ByName("accept")
+	fd_ServiceEndpointMap_routing_keys = md_ServiceEndpointMap.Fields().ByName("routing_keys")
+	fd_ServiceEndpointMap_origins = md_ServiceEndpointMap.Fields().ByName("origins")
+	fd_ServiceEndpointMap_properties = md_ServiceEndpointMap.Fields().ByName("properties")
+}
+
+var _ protoreflect.Message = (*fastReflection_ServiceEndpointMap)(nil)
+
+type fastReflection_ServiceEndpointMap ServiceEndpointMap
+
+func (x *ServiceEndpointMap) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ServiceEndpointMap)(x)
+}
+
+func (x *ServiceEndpointMap) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_diddoc_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ServiceEndpointMap_messageType fastReflection_ServiceEndpointMap_messageType
+var _ protoreflect.MessageType = fastReflection_ServiceEndpointMap_messageType{}
+
+type fastReflection_ServiceEndpointMap_messageType struct{}
+
+func (x fastReflection_ServiceEndpointMap_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ServiceEndpointMap)(nil)
+}
+func (x fastReflection_ServiceEndpointMap_messageType) New() protoreflect.Message {
+	return new(fastReflection_ServiceEndpointMap)
+}
+func (x fastReflection_ServiceEndpointMap_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ServiceEndpointMap
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ServiceEndpointMap) Descriptor() protoreflect.MessageDescriptor {
+	return md_ServiceEndpointMap
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ServiceEndpointMap) Type() protoreflect.MessageType {
+	return _fastReflection_ServiceEndpointMap_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ServiceEndpointMap) New() protoreflect.Message {
+	return new(fastReflection_ServiceEndpointMap)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ServiceEndpointMap) Interface() protoreflect.ProtoMessage {
+	return (*ServiceEndpointMap)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ServiceEndpointMap) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Uri != "" {
+		value := protoreflect.ValueOfString(x.Uri)
+		if !f(fd_ServiceEndpointMap_uri, value) {
+			return
+		}
+	}
+	if len(x.Accept) != 0 {
+		value := protoreflect.ValueOfList(&_ServiceEndpointMap_2_list{list: &x.Accept})
+		if !f(fd_ServiceEndpointMap_accept, value) {
+			return
+		}
+	}
+	if len(x.RoutingKeys) != 0 {
+		value := protoreflect.ValueOfList(&_ServiceEndpointMap_3_list{list: &x.RoutingKeys})
+		if !f(fd_ServiceEndpointMap_routing_keys, value) {
+			return
+		}
+	}
+	if len(x.Origins) != 0 {
+		value := protoreflect.ValueOfList(&_ServiceEndpointMap_4_list{list: &x.Origins})
+		if !f(fd_ServiceEndpointMap_origins, value) {
+			return
+		}
+	}
+	if len(x.Properties) != 0 {
+		value := protoreflect.ValueOfList(&_ServiceEndpointMap_5_list{list: &x.Properties})
+		if !f(fd_ServiceEndpointMap_properties, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ServiceEndpointMap) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cheqd.did.v2.ServiceEndpointMap.uri":
+		return x.Uri != ""
+	case "cheqd.did.v2.ServiceEndpointMap.accept":
+		return len(x.Accept) != 0
+	case "cheqd.did.v2.ServiceEndpointMap.routing_keys":
+		return len(x.RoutingKeys) != 0
+	case "cheqd.did.v2.ServiceEndpointMap.origins":
+		return len(x.Origins) != 0
+	case "cheqd.did.v2.ServiceEndpointMap.properties":
+		return len(x.Properties) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.ServiceEndpointMap"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.ServiceEndpointMap does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceEndpointMap) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.ServiceEndpointMap.uri":
+		x.Uri = ""
+	case "cheqd.did.v2.ServiceEndpointMap.accept":
+		x.Accept = nil
+	case "cheqd.did.v2.ServiceEndpointMap.routing_keys":
+		x.RoutingKeys = nil
+	case "cheqd.did.v2.ServiceEndpointMap.origins":
+		x.Origins = nil
+	case "cheqd.did.v2.ServiceEndpointMap.properties":
+		x.Properties = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.ServiceEndpointMap"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.ServiceEndpointMap does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ServiceEndpointMap) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cheqd.did.v2.ServiceEndpointMap.uri":
+		value := x.Uri
+		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.ServiceEndpointMap.accept":
+		if len(x.Accept) == 0 {
+			return protoreflect.ValueOfList(&_ServiceEndpointMap_2_list{})
+		}
+		listValue := &_ServiceEndpointMap_2_list{list: &x.Accept}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.did.v2.ServiceEndpointMap.routing_keys":
+		if len(x.RoutingKeys) == 0 {
+			return protoreflect.ValueOfList(&_ServiceEndpointMap_3_list{})
+		}
+		listValue := &_ServiceEndpointMap_3_list{list: &x.RoutingKeys}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.did.v2.ServiceEndpointMap.origins":
+		if len(x.Origins) == 0 {
+			return protoreflect.ValueOfList(&_ServiceEndpointMap_4_list{})
+		}
+		listValue := &_ServiceEndpointMap_4_list{list: &x.Origins}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.did.v2.ServiceEndpointMap.properties":
+		if len(x.Properties) == 0 {
+			return protoreflect.ValueOfList(&_ServiceEndpointMap_5_list{})
+		}
+		listValue := &_ServiceEndpointMap_5_list{list: &x.Properties}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.ServiceEndpointMap"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.ServiceEndpointMap does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceEndpointMap) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.ServiceEndpointMap.uri":
+		x.Uri = value.Interface().(string)
+	case "cheqd.did.v2.ServiceEndpointMap.accept":
+		lv := value.List()
+		clv := lv.(*_ServiceEndpointMap_2_list)
+		x.Accept = *clv.list
+	case "cheqd.did.v2.ServiceEndpointMap.routing_keys":
+		lv := value.List()
+		clv := lv.(*_ServiceEndpointMap_3_list)
+		x.RoutingKeys = *clv.list
+	case "cheqd.did.v2.ServiceEndpointMap.origins":
+		lv := value.List()
+		clv := lv.(*_ServiceEndpointMap_4_list)
+		x.Origins = *clv.list
+	case "cheqd.did.v2.ServiceEndpointMap.properties":
+		lv := value.List()
+		clv := lv.(*_ServiceEndpointMap_5_list)
+		x.Properties = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.ServiceEndpointMap"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.ServiceEndpointMap does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceEndpointMap) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.ServiceEndpointMap.accept":
+		if x.Accept == nil {
+			x.Accept = []string{}
+		}
+		value := &_ServiceEndpointMap_2_list{list: &x.Accept}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.ServiceEndpointMap.routing_keys":
+		if x.RoutingKeys == nil {
+			x.RoutingKeys = []string{}
+		}
+		value := &_ServiceEndpointMap_3_list{list: &x.RoutingKeys}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.ServiceEndpointMap.origins":
+		if x.Origins == nil {
+			x.Origins = []string{}
+		}
+		value := &_ServiceEndpointMap_4_list{list: &x.Origins}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.ServiceEndpointMap.properties":
+		if x.Properties == nil {
+			x.Properties = []*ServiceEndpointProperty{}
+		}
+		value := &_ServiceEndpointMap_5_list{list: &x.Properties}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.ServiceEndpointMap.uri":
+		panic(fmt.Errorf("field uri of message cheqd.did.v2.ServiceEndpointMap is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.ServiceEndpointMap"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.ServiceEndpointMap does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ServiceEndpointMap) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.ServiceEndpointMap.uri":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.ServiceEndpointMap.accept":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ServiceEndpointMap_2_list{list: &list})
+	case "cheqd.did.v2.ServiceEndpointMap.routing_keys":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ServiceEndpointMap_3_list{list: &list})
+	case "cheqd.did.v2.ServiceEndpointMap.origins":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ServiceEndpointMap_4_list{list: &list})
+	case "cheqd.did.v2.ServiceEndpointMap.properties":
+		list := []*ServiceEndpointProperty{}
+		return protoreflect.ValueOfList(&_ServiceEndpointMap_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.ServiceEndpointMap"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.ServiceEndpointMap does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ServiceEndpointMap) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.ServiceEndpointMap", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ServiceEndpointMap) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceEndpointMap) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ServiceEndpointMap) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ServiceEndpointMap) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ServiceEndpointMap)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Uri)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Accept) > 0 {
+			for _, s := range x.Accept {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RoutingKeys) > 0 {
+			for _, s := range x.RoutingKeys {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Origins) > 0 {
+			for _, s := range x.Origins {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Properties) > 0 {
+			for _, e := range x.Properties {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ServiceEndpointMap)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Properties) > 0 {
+			for iNdEx := len(x.Properties) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Properties[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.Origins) > 0 {
+			for iNdEx := len(x.Origins) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Origins[iNdEx])
+				copy(dAtA[i:], x.Origins[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Origins[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.RoutingKeys) > 0 {
+			for iNdEx := len(x.RoutingKeys) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.RoutingKeys[iNdEx])
+				copy(dAtA[i:], x.RoutingKeys[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RoutingKeys[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Accept) > 0 {
+			for iNdEx := len(x.Accept) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Accept[iNdEx])
+				copy(dAtA[i:], x.Accept[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Accept[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Uri) > 0 {
+			i -= len(x.Uri)
+			copy(dAtA[i:], x.Uri)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Uri)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ServiceEndpointMap)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ServiceEndpointMap: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ServiceEndpointMap: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Uri = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Accept", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Accept = append(x.Accept, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RoutingKeys", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RoutingKeys = append(x.RoutingKeys, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Origins", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Origins = append(x.Origins, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Properties", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Properties = append(x.Properties, &ServiceEndpointProperty{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Properties[len(x.Properties)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ServiceEndpointProperty       protoreflect.MessageDescriptor
+	fd_ServiceEndpointProperty_key   protoreflect.FieldDescriptor
+	fd_ServiceEndpointProperty_value protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cheqd_did_v2_diddoc_proto_init()
+	md_ServiceEndpointProperty = File_cheqd_did_v2_diddoc_proto.Messages().ByName("ServiceEndpointProperty")
+	fd_ServiceEndpointProperty_key = md_ServiceEndpointProperty.Fields().ByName("key")
+	fd_ServiceEndpointProperty_value = md_ServiceEndpointProperty.Fields().ByName("value")
+}
+
+var _ protoreflect.Message = (*fastReflection_ServiceEndpointProperty)(nil)
+
+type fastReflection_ServiceEndpointProperty ServiceEndpointProperty
+
+func (x *ServiceEndpointProperty) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ServiceEndpointProperty)(x)
+}
+
+func (x *ServiceEndpointProperty) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_diddoc_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ServiceEndpointProperty_messageType fastReflection_ServiceEndpointProperty_messageType
+var _ protoreflect.MessageType = fastReflection_ServiceEndpointProperty_messageType{}
+
+type fastReflection_ServiceEndpointProperty_messageType struct{}
+
+func (x fastReflection_ServiceEndpointProperty_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ServiceEndpointProperty)(nil)
+}
+func (x fastReflection_ServiceEndpointProperty_messageType) New() protoreflect.Message {
+	return new(fastReflection_ServiceEndpointProperty)
+}
+func (x fastReflection_ServiceEndpointProperty_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ServiceEndpointProperty
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ServiceEndpointProperty) Descriptor() protoreflect.MessageDescriptor {
+	return md_ServiceEndpointProperty
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ServiceEndpointProperty) Type() protoreflect.MessageType {
+	return _fastReflection_ServiceEndpointProperty_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ServiceEndpointProperty) New() protoreflect.Message {
+	return new(fastReflection_ServiceEndpointProperty)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ServiceEndpointProperty) Interface() protoreflect.ProtoMessage {
+	return (*ServiceEndpointProperty)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ServiceEndpointProperty) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Key != "" {
+		value := protoreflect.ValueOfString(x.Key)
+		if !f(fd_ServiceEndpointProperty_key, value) {
+			return
+		}
+	}
+	if x.Value != "" {
+		value := protoreflect.ValueOfString(x.Value)
+		if !f(fd_ServiceEndpointProperty_value, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ServiceEndpointProperty) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cheqd.did.v2.ServiceEndpointProperty.key":
+		return x.Key != ""
+	case "cheqd.did.v2.ServiceEndpointProperty.value":
+		return x.Value != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.ServiceEndpointProperty"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.ServiceEndpointProperty does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceEndpointProperty) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.ServiceEndpointProperty.key":
+		x.Key = ""
+	case "cheqd.did.v2.ServiceEndpointProperty.value":
+		x.Value = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.ServiceEndpointProperty"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.ServiceEndpointProperty does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ServiceEndpointProperty) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cheqd.did.v2.ServiceEndpointProperty.key":
+		value := x.Key
+		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.ServiceEndpointProperty.value":
+		value := x.Value
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.ServiceEndpointProperty"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.ServiceEndpointProperty does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceEndpointProperty) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.ServiceEndpointProperty.key":
+		x.Key = value.Interface().(string)
+	case "cheqd.did.v2.ServiceEndpointProperty.value":
+		x.Value = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.ServiceEndpointProperty"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.ServiceEndpointProperty does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceEndpointProperty) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.ServiceEndpointProperty.key":
+		panic(fmt.Errorf("field key of message cheqd.did.v2.ServiceEndpointProperty is not mutable"))
+	case "cheqd.did.v2.ServiceEndpointProperty.value":
+		panic(fmt.Errorf("field value of message cheqd.did.v2.ServiceEndpointProperty is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.ServiceEndpointProperty"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.ServiceEndpointProperty does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ServiceEndpointProperty) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.ServiceEndpointProperty.key":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.ServiceEndpointProperty.value":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.ServiceEndpointProperty"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.ServiceEndpointProperty does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ServiceEndpointProperty) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.ServiceEndpointProperty", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ServiceEndpointProperty) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceEndpointProperty) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ServiceEndpointProperty) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ServiceEndpointProperty) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ServiceEndpointProperty)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Key)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Value)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ServiceEndpointProperty)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Value) > 0 {
+			i -= len(x.Value)
+			copy(dAtA[i:], x.Value)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Value)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Key) > 0 {
+			i -= len(x.Key)
+			copy(dAtA[i:], x.Key)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Key)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ServiceEndpointProperty)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ServiceEndpointProperty: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ServiceEndpointProperty: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Key = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Value = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_DidDocWithMetadata          protoreflect.MessageDescriptor
+	fd_DidDocWithMetadata_did_doc  protoreflect.FieldDescriptor
+	fd_DidDocWithMetadata_metadata protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cheqd_did_v2_diddoc_proto_init()
+	md_DidDocWithMetadata = File_cheqd_did_v2_diddoc_proto.Messages().ByName("DidDocWithMetadata")
+	fd_DidDocWithMetadata_did_doc = md_DidDocWithMetadata.Fields().ByName("did_doc")
+	fd_DidDocWithMetadata_metadata = md_DidDocWithMetadata.Fields().ByName("metadata")
+}
+
+var _ protoreflect.Message = (*fastReflection_DidDocWithMetadata)(nil)
+
+type fastReflection_DidDocWithMetadata DidDocWithMetadata
+
+func (x *DidDocWithMetadata) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DidDocWithMetadata)(x)
+}
+
+func (x *DidDocWithMetadata) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_diddoc_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DidDocWithMetadata_messageType fastReflection_DidDocWithMetadata_messageType
+var _ protoreflect.MessageType = fastReflection_DidDocWithMetadata_messageType{}
+
+type fastReflection_DidDocWithMetadata_messageType struct{}
+
+func (x fastReflection_DidDocWithMetadata_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DidDocWithMetadata)(nil)
+}
+func (x fastReflection_DidDocWithMetadata_messageType) New() protoreflect.Message {
+	return new(fastReflection_DidDocWithMetadata)
+}
+func (x fastReflection_DidDocWithMetadata_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DidDocWithMetadata
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DidDocWithMetadata) Descriptor() protoreflect.MessageDescriptor {
+	return md_DidDocWithMetadata
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DidDocWithMetadata) Type() protoreflect.MessageType {
+	return _fastReflection_DidDocWithMetadata_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DidDocWithMetadata) New() protoreflect.Message {
+	return new(fastReflection_DidDocWithMetadata)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DidDocWithMetadata) Interface() protoreflect.ProtoMessage {
+	return (*DidDocWithMetadata)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DidDocWithMetadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DidDoc != nil {
+		value := protoreflect.ValueOfMessage(x.DidDoc.ProtoReflect())
+		if !f(fd_DidDocWithMetadata_did_doc, value) {
+			return
+		}
+	}
+	if x.Metadata != nil {
+		value := protoreflect.ValueOfMessage(x.Metadata.ProtoReflect())
+		if !f(fd_DidDocWithMetadata_metadata, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DidDocWithMetadata) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cheqd.did.v2.DidDocWithMetadata.did_doc":
+		return x.DidDoc != nil
+	case "cheqd.did.v2.DidDocWithMetadata.metadata":
+		return x.Metadata != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDocWithMetadata"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.DidDocWithMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DidDocWithMetadata) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.DidDocWithMetadata.did_doc":
+		x.DidDoc = nil
+	case "cheqd.did.v2.DidDocWithMetadata.metadata":
+		x.Metadata = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDocWithMetadata"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.DidDocWithMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DidDocWithMetadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cheqd.did.v2.DidDocWithMetadata.did_doc":
+		value := x.DidDoc
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cheqd.did.v2.DidDocWithMetadata.metadata":
+		value := x.Metadata
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDocWithMetadata"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.DidDocWithMetadata does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DidDocWithMetadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.DidDocWithMetadata.did_doc":
+		x.DidDoc = value.Message().Interface().(*DidDoc)
+	case "cheqd.did.v2.DidDocWithMetadata.metadata":
+		x.Metadata = value.Message().Interface().(*Metadata)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDocWithMetadata"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.DidDocWithMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DidDocWithMetadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.DidDocWithMetadata.did_doc":
+		if x.DidDoc == nil {
+			x.DidDoc = new(DidDoc)
+		}
+		return protoreflect.ValueOfMessage(x.DidDoc.ProtoReflect())
+	case "cheqd.did.v2.DidDocWithMetadata.metadata":
+		if x.Metadata == nil {
+			x.Metadata = new(Metadata)
+		}
+		return protoreflect.ValueOfMessage(x.Metadata.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDocWithMetadata"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.DidDocWithMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DidDocWithMetadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.DidDocWithMetadata.did_doc":
+		m := new(DidDoc)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cheqd.did.v2.DidDocWithMetadata.metadata":
+		m := new(Metadata)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDocWithMetadata"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.DidDocWithMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DidDocWithMetadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.DidDocWithMetadata", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DidDocWithMetadata) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DidDocWithMetadata) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DidDocWithMetadata) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DidDocWithMetadata) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DidDocWithMetadata)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.DidDoc != nil {
+			l = options.Size(x.DidDoc)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Metadata != nil {
+			l = options.Size(x.Metadata)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DidDocWithMetadata)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Metadata != nil {
+			encoded, err := options.Marshal(x.Metadata)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.DidDoc != nil {
+			encoded, err := options.Marshal(x.DidDoc)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DidDocWithMetadata)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DidDocWithMetadata: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DidDocWithMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DidDoc", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DidDoc == nil {
+					x.DidDoc = &DidDoc{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DidDoc); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Metadata == nil {
+					x.Metadata = &Metadata{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Metadata); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Metadata                     protoreflect.MessageDescriptor
+	fd_Metadata_created             protoreflect.FieldDescriptor
+	fd_Metadata_updated             protoreflect.FieldDescriptor
+	fd_Metadata_deactivated         protoreflect.FieldDescriptor
+	fd_Metadata_version_id          protoreflect.FieldDescriptor
+	fd_Metadata_next_version_id     protoreflect.FieldDescriptor
+	fd_Metadata_previous_version_id protoreflect.FieldDescriptor
+	fd_Metadata_recovery_commitment protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cheqd_did_v2_diddoc_proto_init()
+	md_Metadata = File_cheqd_did_v2_diddoc_proto.Messages().ByName("Metadata")
+	fd_Metadata_created = md_Metadata.Fields().ByName("created")
+	fd_Metadata_updated = md_Metadata.Fields().ByName("updated")
+	fd_Metadata_deactivated = md_Metadata.Fields().ByName("deactivated")
+	fd_Metadata_version_id = md_Metadata.Fields().ByName("version_id")
+	fd_Metadata_next_version_id = md_Metadata.Fields().ByName("next_version_id")
+	fd_Metadata_previous_version_id = md_Metadata.Fields().ByName("previous_version_id")
+	fd_Metadata_recovery_commitment = md_Metadata.Fields().ByName("recovery_commitment")
+}
+
+var _ protoreflect.Message = (*fastReflection_Metadata)(nil)
+
+type fastReflection_Metadata Metadata
+
+func (x *Metadata) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Metadata)(x)
+}
+
+func (x *Metadata) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_diddoc_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Metadata_messageType fastReflection_Metadata_messageType
+var _ protoreflect.MessageType = fastReflection_Metadata_messageType{}
+
+type fastReflection_Metadata_messageType struct{}
+
+func (x fastReflection_Metadata_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Metadata)(nil)
+}
+func (x fastReflection_Metadata_messageType) New() protoreflect.Message {
+	return new(fastReflection_Metadata)
+}
+func (x fastReflection_Metadata_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Metadata
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Metadata) Descriptor() protoreflect.MessageDescriptor {
+	return md_Metadata
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Metadata) Type() protoreflect.MessageType {
+	return _fastReflection_Metadata_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Metadata) New() protoreflect.Message {
+	return new(fastReflection_Metadata)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Metadata) Interface() protoreflect.ProtoMessage {
+	return (*Metadata)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Metadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Created != nil {
+		value := protoreflect.ValueOfMessage(x.Created.ProtoReflect())
+		if !f(fd_Metadata_created, value) {
+			return
+		}
+	}
+	if x.Updated != nil {
+		value := protoreflect.ValueOfMessage(x.Updated.ProtoReflect())
+		if !f(fd_Metadata_updated, value) {
+			return
+		}
+	}
+	if x.Deactivated != false {
+		value := protoreflect.ValueOfBool(x.Deactivated)
+		if !f(fd_Metadata_deactivated, value) {
+			return
+		}
+	}
+	if x.VersionId != "" {
+		value := protoreflect.ValueOfString(x.VersionId)
+		if !f(fd_Metadata_version_id, value) {
+			return
+		}
+	}
+	if x.NextVersionId != "" {
+		value := protoreflect.ValueOfString(x.NextVersionId)
+		if !f(fd_Metadata_next_version_id, value) {
+			return
+		}
+	}
+	if x.PreviousVersionId != "" {
+		value := protoreflect.ValueOfString(x.PreviousVersionId)
+		if !f(fd_Metadata_previous_version_id, value) {
+			return
+		}
+	}
+	if x.RecoveryCommitment != "" {
+		value := protoreflect.ValueOfString(x.RecoveryCommitment)
+		if !f(fd_Metadata_recovery_commitment, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Metadata) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cheqd.did.v2.Metadata.created":
+		return x.Created != nil
+	case "cheqd.did.v2.Metadata.updated":
+		return x.Updated != nil
+	case "cheqd.did.v2.Metadata.deactivated":
+		return x.Deactivated != false
+	case "cheqd.did.v2.Metadata.version_id":
+		return x.VersionId != ""
+	case "cheqd.did.v2.Metadata.next_version_id":
+		return x.NextVersionId != ""
+	case "cheqd.did.v2.Metadata.previous_version_id":
+		return x.PreviousVersionId != ""
+	case "cheqd.did.v2.Metadata.recovery_commitment":
+		return x.RecoveryCommitment != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.Metadata"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.Metadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Metadata) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.Metadata.created":
+		x.Created = nil
+	case "cheqd.did.v2.Metadata.updated":
+		x.Updated = nil
+	case "cheqd.did.v2.Metadata.deactivated":
+		x.Deactivated = false
+	case "cheqd.did.v2.Metadata.version_id":
+		x.VersionId = ""
+	case "cheqd.did.v2.Metadata.next_version_id":
+		x.NextVersionId = ""
+	case "cheqd.did.v2.Metadata.previous_version_id":
+		x.PreviousVersionId = ""
+	case "cheqd.did.v2.Metadata.recovery_commitment":
+		x.RecoveryCommitment = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.Metadata"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.Metadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Metadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cheqd.did.v2.Metadata.created":
+		value := x.Created
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cheqd.did.v2.Metadata.updated":
+		value := x.Updated
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cheqd.did.v2.Metadata.deactivated":
+		value := x.Deactivated
+		return protoreflect.ValueOfBool(value)
+	case "cheqd.did.v2.Metadata.version_id":
+		value := x.VersionId
+		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.Metadata.next_version_id":
+		value := x.NextVersionId
+		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.Metadata.previous_version_id":
+		value := x.PreviousVersionId
+		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.Metadata.recovery_commitment":
+		value := x.RecoveryCommitment
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.Metadata"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.Metadata does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Metadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.Metadata.created":
+		x.Created = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cheqd.did.v2.Metadata.updated":
+		x.Updated = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cheqd.did.v2.Metadata.deactivated":
+		x.Deactivated = value.Bool()
+	case "cheqd.did.v2.Metadata.version_id":
+		x.VersionId = value.Interface().(string)
+	case "cheqd.did.v2.Metadata.next_version_id":
+		x.NextVersionId = value.Interface().(string)
+	case "cheqd.did.v2.Metadata.previous_version_id":
+		x.PreviousVersionId = value.Interface().(string)
+	case "cheqd.did.v2.Metadata.recovery_commitment":
+		x.RecoveryCommitment = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.Metadata"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.Metadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Metadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.Metadata.created":
+		if x.Created == nil {
+			x.Created = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Created.ProtoReflect())
+	case "cheqd.did.v2.Metadata.updated":
+		if x.Updated == nil {
+			x.Updated = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Updated.ProtoReflect())
+	case "cheqd.did.v2.Metadata.deactivated":
+		panic(fmt.Errorf("field deactivated of message cheqd.did.v2.Metadata is not mutable"))
+	case "cheqd.did.v2.Metadata.version_id":
+		panic(fmt.Errorf("field version_id of message cheqd.did.v2.Metadata is not mutable"))
+	case "cheqd.did.v2.Metadata.next_version_id":
+		panic(fmt.Errorf("field next_version_id of message cheqd.did.v2.Metadata is not mutable"))
+	case "cheqd.did.v2.Metadata.previous_version_id":
+		panic(fmt.Errorf("field previous_version_id of message cheqd.did.v2.Metadata is not mutable"))
+	case "cheqd.did.v2.Metadata.recovery_commitment":
+		panic(fmt.Errorf("field recovery_commitment of message cheqd.did.v2.Metadata is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.Metadata"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.Metadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Metadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.Metadata.created":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cheqd.did.v2.Metadata.updated":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cheqd.did.v2.Metadata.deactivated":
+		return protoreflect.ValueOfBool(false)
+	case "cheqd.did.v2.Metadata.version_id":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.Metadata.next_version_id":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.Metadata.previous_version_id":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.Metadata.recovery_commitment":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.Metadata"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.Metadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Metadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.Metadata", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Metadata) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Metadata) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Metadata) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Metadata)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Created != nil {
+			l = options.Size(x.Created)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Updated != nil {
+			l = options.Size(x.Updated)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Deactivated {
+			n += 2
+		}
+		l = len(x.VersionId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NextVersionId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PreviousVersionId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RecoveryCommitment)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Metadata)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RecoveryCommitment) > 0 {
+			i -= len(x.RecoveryCommitment)
+			copy(dAtA[i:], x.RecoveryCommitment)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecoveryCommitment)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.PreviousVersionId) > 0 {
+			i -= len(x.PreviousVersionId)
+			copy(dAtA[i:], x.PreviousVersionId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PreviousVersionId)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.NextVersionId) > 0 {
+			i -= len(x.NextVersionId)
+			copy(dAtA[i:], x.NextVersionId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NextVersionId)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.VersionId) > 0 {
+			i -= len(x.VersionId)
+			copy(dAtA[i:], x.VersionId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VersionId)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Deactivated {
+			i--
+			if x.Deactivated {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Updated != nil {
+			encoded, err := options.Marshal(x.Updated)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Created != nil {
+			encoded, err := options.Marshal(x.Created)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Metadata)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Created == nil {
+					x.Created = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Created); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Updated == nil {
+					x.Updated = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Updated); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deactivated", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Deactivated = bool(v != 0)
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VersionId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextVersionId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NextVersionId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousVersionId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PreviousVersionId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecoveryCommitment", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RecoveryCommitment = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_DidDocTombstone_2_list)(nil)
+
+type _DidDocTombstone_2_list struct {
+	list *[]*PrunedDidDocVersion
+}
+
+func (x *_DidDocTombstone_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DidDocTombstone_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_DidDocTombstone_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PrunedDidDocVersion)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DidDocTombstone_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PrunedDidDocVersion)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DidDocTombstone_2_list) AppendMutable() protoreflect.Value {
+	v := new(PrunedDidDocVersion)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DidDocTombstone_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DidDocTombstone_2_list) NewElement() protoreflect.Value {
+	v := new(PrunedDidDocVersion)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DidDocTombstone_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_DidDocTombstone                 protoreflect.MessageDescriptor
+	fd_DidDocTombstone_id              protoreflect.FieldDescriptor
+	fd_DidDocTombstone_pruned_versions protoreflect.FieldDescriptor
+	fd_DidDocTombstone_chain_hash      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cheqd_did_v2_diddoc_proto_init()
+	md_DidDocTombstone = File_cheqd_did_v2_diddoc_proto.Messages().ByName("DidDocTombstone")
+	fd_DidDocTombstone_id = md_DidDocTombstone.Fields().ByName("id")
+	fd_DidDocTombstone_pruned_versions = md_DidDocTombstone.Fields().ByName("pruned_versions")
+	fd_DidDocTombstone_chain_hash = md_DidDocTombstone.Fields().ByName("chain_hash")
+}
+
+var _ protoreflect.Message = (*fastReflection_DidDocTombstone)(nil)
+
+type fastReflection_DidDocTombstone DidDocTombstone
+
+func (x *DidDocTombstone) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DidDocTombstone)(x)
+}
+
+func (x *DidDocTombstone) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_diddoc_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DidDocTombstone_messageType fastReflection_DidDocTombstone_messageType
+var _ protoreflect.MessageType = fastReflection_DidDocTombstone_messageType{}
+
+type fastReflection_DidDocTombstone_messageType struct{}
+
+func (x fastReflection_DidDocTombstone_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DidDocTombstone)(nil)
+}
+func (x fastReflection_DidDocTombstone_messageType) New() protoreflect.Message {
+	return new(fastReflection_DidDocTombstone)
+}
+func (x fastReflection_DidDocTombstone_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DidDocTombstone
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DidDocTombstone) Descriptor() protoreflect.MessageDescriptor {
+	return md_DidDocTombstone
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DidDocTombstone) Type() protoreflect.MessageType {
+	return _fastReflection_DidDocTombstone_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DidDocTombstone) New() protoreflect.Message {
+	return new(fastReflection_DidDocTombstone)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DidDocTombstone) Interface() protoreflect.ProtoMessage {
+	return (*DidDocTombstone)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DidDocTombstone) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != "" {
+		value := protoreflect.ValueOfString(x.Id)
+		if !f(fd_DidDocTombstone_id, value) {
+			return
+		}
+	}
+	if len(x.PrunedVersions) != 0 {
+		value := protoreflect.ValueOfList(&_DidDocTombstone_2_list{list: &x.PrunedVersions})
+		if !f(fd_DidDocTombstone_pruned_versions, value) {
+			return
+		}
+	}
+	if x.ChainHash != "" {
+		value := protoreflect.ValueOfString(x.ChainHash)
+		if !f(fd_DidDocTombstone_chain_hash, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DidDocTombstone) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cheqd.did.v2.DidDocTombstone.id":
+		return x.Id != ""
+	case "cheqd.did.v2.DidDocTombstone.pruned_versions":
+		return len(x.PrunedVersions) != 0
+	case "cheqd.did.v2.DidDocTombstone.chain_hash":
+		return x.ChainHash != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDocTombstone"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.DidDocTombstone does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3465,17 +6464,19 @@ func (x *fastReflection_DidDocWithMetadata) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DidDocWithMetadata) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_DidDocTombstone) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cheqd.did.v2.DidDocWithMetadata.did_doc":
-		x.DidDoc = nil
-	case "cheqd.did.v2.DidDocWithMetadata.metadata":
-		x.Metadata = nil
+	case "cheqd.did.v2.DidDocTombstone.id":
+		x.Id = ""
+	case "cheqd.did.v2.DidDocTombstone.pruned_versions":
+		x.PrunedVersions = nil
+	case "cheqd.did.v2.DidDocTombstone.chain_hash":
+		x.ChainHash = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDocWithMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDocTombstone"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.DidDocWithMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.DidDocTombstone does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3485,19 +6486,25 @@ func (x *fastReflection_DidDocWithMetadata) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_DidDocWithMetadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DidDocTombstone) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cheqd.did.v2.DidDocWithMetadata.did_doc":
-		value := x.DidDoc
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cheqd.did.v2.DidDocWithMetadata.metadata":
-		value := x.Metadata
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cheqd.did.v2.DidDocTombstone.id":
+		value := x.Id
+		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.DidDocTombstone.pruned_versions":
+		if len(x.PrunedVersions) == 0 {
+			return protoreflect.ValueOfList(&_DidDocTombstone_2_list{})
+		}
+		listValue := &_DidDocTombstone_2_list{list: &x.PrunedVersions}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.did.v2.DidDocTombstone.chain_hash":
+		value := x.ChainHash
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDocWithMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDocTombstone"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.DidDocWithMetadata does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.DidDocTombstone does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3511,17 +6518,21 @@ func (x *fastReflection_DidDocWithMetadata) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DidDocWithMetadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_DidDocTombstone) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cheqd.did.v2.DidDocWithMetadata.did_doc":
-		x.DidDoc = value.Message().Interface().(*DidDoc)
-	case "cheqd.did.v2.DidDocWithMetadata.metadata":
-		x.Metadata = value.Message().Interface().(*Metadata)
+	case "cheqd.did.v2.DidDocTombstone.id":
+		x.Id = value.Interface().(string)
+	case "cheqd.did.v2.DidDocTombstone.pruned_versions":
+		lv := value.List()
+		clv := lv.(*_DidDocTombstone_2_list)
+		x.PrunedVersions = *clv.list
+	case "cheqd.did.v2.DidDocTombstone.chain_hash":
+		x.ChainHash = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDocWithMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDocTombstone"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.DidDocWithMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.DidDocTombstone does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3535,52 +6546,53 @@ func (x *fastReflection_DidDocWithMetadata) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DidDocWithMetadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DidDocTombstone) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.did.v2.DidDocWithMetadata.did_doc":
-		if x.DidDoc == nil {
-			x.DidDoc = new(DidDoc)
+	case "cheqd.did.v2.DidDocTombstone.pruned_versions":
+		if x.PrunedVersions == nil {
+			x.PrunedVersions = []*PrunedDidDocVersion{}
 		}
-		return protoreflect.ValueOfMessage(x.DidDoc.ProtoReflect())
-	case "cheqd.did.v2.DidDocWithMetadata.metadata":
-		if x.Metadata == nil {
-			x.Metadata = new(Metadata)
-		}
-		return protoreflect.ValueOfMessage(x.Metadata.ProtoReflect())
+		value := &_DidDocTombstone_2_list{list: &x.PrunedVersions}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.DidDocTombstone.id":
+		panic(fmt.Errorf("field id of message cheqd.did.v2.DidDocTombstone is not mutable"))
+	case "cheqd.did.v2.DidDocTombstone.chain_hash":
+		panic(fmt.Errorf("field chain_hash of message cheqd.did.v2.DidDocTombstone is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDocWithMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDocTombstone"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.DidDocWithMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.DidDocTombstone does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_DidDocWithMetadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DidDocTombstone) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.did.v2.DidDocWithMetadata.did_doc":
-		m := new(DidDoc)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cheqd.did.v2.DidDocWithMetadata.metadata":
-		m := new(Metadata)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cheqd.did.v2.DidDocTombstone.id":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.DidDocTombstone.pruned_versions":
+		list := []*PrunedDidDocVersion{}
+		return protoreflect.ValueOfList(&_DidDocTombstone_2_list{list: &list})
+	case "cheqd.did.v2.DidDocTombstone.chain_hash":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDocWithMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDocTombstone"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.DidDocWithMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.DidDocTombstone does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_DidDocWithMetadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_DidDocTombstone) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.DidDocWithMetadata", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.DidDocTombstone", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3588,7 +6600,7 @@ func (x *fastReflection_DidDocWithMetadata) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_DidDocWithMetadata) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_DidDocTombstone) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3599,7 +6611,7 @@ func (x *fastReflection_DidDocWithMetadata) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DidDocWithMetadata) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_DidDocTombstone) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3611,7 +6623,7 @@ func (x *fastReflection_DidDocWithMetadata) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_DidDocWithMetadata) IsValid() bool {
+func (x *fastReflection_DidDocTombstone) IsValid() bool {
 	return x != nil
 }
 
@@ -3621,9 +6633,9 @@ func (x *fastReflection_DidDocWithMetadata) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_DidDocWithMetadata) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_DidDocTombstone) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*DidDocWithMetadata)
+		x := input.Message.Interface().(*DidDocTombstone)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3635,12 +6647,18 @@ func (x *fastReflection_DidDocWithMetadata) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.DidDoc != nil {
-			l = options.Size(x.DidDoc)
+		l = len(x.Id)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Metadata != nil {
-			l = options.Size(x.Metadata)
+		if len(x.PrunedVersions) > 0 {
+			for _, e := range x.PrunedVersions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.ChainHash)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -3653,7 +6671,7 @@ func (x *fastReflection_DidDocWithMetadata) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*DidDocWithMetadata)
+		x := input.Message.Interface().(*DidDocTombstone)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3672,31 +6690,33 @@ func (x *fastReflection_DidDocWithMetadata) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Metadata != nil {
-			encoded, err := options.Marshal(x.Metadata)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.ChainHash) > 0 {
+			i -= len(x.ChainHash)
+			copy(dAtA[i:], x.ChainHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChainHash)))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1a
 		}
-		if x.DidDoc != nil {
-			encoded, err := options.Marshal(x.DidDoc)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.PrunedVersions) > 0 {
+			for iNdEx := len(x.PrunedVersions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PrunedVersions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		}
+		if len(x.Id) > 0 {
+			i -= len(x.Id)
+			copy(dAtA[i:], x.Id)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -3711,7 +6731,7 @@ func (x *fastReflection_DidDocWithMetadata) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*DidDocWithMetadata)
+		x := input.Message.Interface().(*DidDocTombstone)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3743,17 +6763,17 @@ func (x *fastReflection_DidDocWithMetadata) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DidDocWithMetadata: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DidDocTombstone: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DidDocWithMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DidDocTombstone: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DidDoc", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3763,31 +6783,27 @@ func (x *fastReflection_DidDocWithMetadata) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.DidDoc == nil {
-					x.DidDoc = &DidDoc{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DidDoc); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Id = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrunedVersions", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3814,13 +6830,43 @@ func (x *fastReflection_DidDocWithMetadata) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Metadata == nil {
-					x.Metadata = &Metadata{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Metadata); err != nil {
+				x.PrunedVersions = append(x.PrunedVersions, &PrunedDidDocVersion{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PrunedVersions[len(x.PrunedVersions)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChainHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3857,36 +6903,32 @@ func (x *fastReflection_DidDocWithMetadata) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Metadata                     protoreflect.MessageDescriptor
-	fd_Metadata_created             protoreflect.FieldDescriptor
-	fd_Metadata_updated             protoreflect.FieldDescriptor
-	fd_Metadata_deactivated         protoreflect.FieldDescriptor
-	fd_Metadata_version_id          protoreflect.FieldDescriptor
-	fd_Metadata_next_version_id     protoreflect.FieldDescriptor
-	fd_Metadata_previous_version_id protoreflect.FieldDescriptor
+	md_PrunedDidDocVersion             protoreflect.MessageDescriptor
+	fd_PrunedDidDocVersion_version_id  protoreflect.FieldDescriptor
+	fd_PrunedDidDocVersion_active_from protoreflect.FieldDescriptor
+	fd_PrunedDidDocVersion_checksum    protoreflect.FieldDescriptor
+	fd_PrunedDidDocVersion_chain_hash  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cheqd_did_v2_diddoc_proto_init()
-	md_Metadata = File_cheqd_did_v2_diddoc_proto.Messages().ByName("Metadata")
-	fd_Metadata_created = md_Metadata.Fields().ByName("created")
-	fd_Metadata_updated = md_Metadata.Fields().ByName("updated")
-	fd_Metadata_deactivated = md_Metadata.Fields().ByName("deactivated")
-	fd_Metadata_version_id = md_Metadata.Fields().ByName("version_id")
-	fd_Metadata_next_version_id = md_Metadata.Fields().ByName("next_version_id")
-	fd_Metadata_previous_version_id = md_Metadata.Fields().ByName("previous_version_id")
+	md_PrunedDidDocVersion = File_cheqd_did_v2_diddoc_proto.Messages().ByName("PrunedDidDocVersion")
+	fd_PrunedDidDocVersion_version_id = md_PrunedDidDocVersion.Fields().ByName("version_id")
+	fd_PrunedDidDocVersion_active_from = md_PrunedDidDocVersion.Fields().ByName("active_from")
+	fd_PrunedDidDocVersion_checksum = md_PrunedDidDocVersion.Fields().ByName("checksum")
+	fd_PrunedDidDocVersion_chain_hash = md_PrunedDidDocVersion.Fields().ByName("chain_hash")
 }
 
-var _ protoreflect.Message = (*fastReflection_Metadata)(nil)
+var _ protoreflect.Message = (*fastReflection_PrunedDidDocVersion)(nil)
 
-type fastReflection_Metadata Metadata
+type fastReflection_PrunedDidDocVersion PrunedDidDocVersion
 
-func (x *Metadata) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Metadata)(x)
+func (x *PrunedDidDocVersion) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PrunedDidDocVersion)(x)
 }
 
-func (x *Metadata) slowProtoReflect() protoreflect.Message {
-	mi := &file_cheqd_did_v2_diddoc_proto_msgTypes[4]
+func (x *PrunedDidDocVersion) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_diddoc_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3897,84 +6939,72 @@ func (x *Metadata) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Metadata_messageType fastReflection_Metadata_messageType
-var _ protoreflect.MessageType = fastReflection_Metadata_messageType{}
+var _fastReflection_PrunedDidDocVersion_messageType fastReflection_PrunedDidDocVersion_messageType
+var _ protoreflect.MessageType = fastReflection_PrunedDidDocVersion_messageType{}
 
-type fastReflection_Metadata_messageType struct{}
+type fastReflection_PrunedDidDocVersion_messageType struct{}
 
-func (x fastReflection_Metadata_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Metadata)(nil)
+func (x fastReflection_PrunedDidDocVersion_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PrunedDidDocVersion)(nil)
 }
-func (x fastReflection_Metadata_messageType) New() protoreflect.Message {
-	return new(fastReflection_Metadata)
+func (x fastReflection_PrunedDidDocVersion_messageType) New() protoreflect.Message {
+	return new(fastReflection_PrunedDidDocVersion)
 }
-func (x fastReflection_Metadata_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Metadata
+func (x fastReflection_PrunedDidDocVersion_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PrunedDidDocVersion
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Metadata) Descriptor() protoreflect.MessageDescriptor {
-	return md_Metadata
+func (x *fastReflection_PrunedDidDocVersion) Descriptor() protoreflect.MessageDescriptor {
+	return md_PrunedDidDocVersion
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Metadata) Type() protoreflect.MessageType {
-	return _fastReflection_Metadata_messageType
+func (x *fastReflection_PrunedDidDocVersion) Type() protoreflect.MessageType {
+	return _fastReflection_PrunedDidDocVersion_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Metadata) New() protoreflect.Message {
-	return new(fastReflection_Metadata)
+func (x *fastReflection_PrunedDidDocVersion) New() protoreflect.Message {
+	return new(fastReflection_PrunedDidDocVersion)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Metadata) Interface() protoreflect.ProtoMessage {
-	return (*Metadata)(x)
+func (x *fastReflection_PrunedDidDocVersion) Interface() protoreflect.ProtoMessage {
+	return (*PrunedDidDocVersion)(x)
 }
-
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_Metadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Created != nil {
-		value := protoreflect.ValueOfMessage(x.Created.ProtoReflect())
-		if !f(fd_Metadata_created, value) {
-			return
-		}
-	}
-	if x.Updated != nil {
-		value := protoreflect.ValueOfMessage(x.Updated.ProtoReflect())
-		if !f(fd_Metadata_updated, value) {
-			return
-		}
-	}
-	if x.Deactivated != false {
-		value := protoreflect.ValueOfBool(x.Deactivated)
-		if !f(fd_Metadata_deactivated, value) {
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PrunedDidDocVersion) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.VersionId != "" {
+		value := protoreflect.ValueOfString(x.VersionId)
+		if !f(fd_PrunedDidDocVersion_version_id, value) {
 			return
 		}
 	}
-	if x.VersionId != "" {
-		value := protoreflect.ValueOfString(x.VersionId)
-		if !f(fd_Metadata_version_id, value) {
+	if x.ActiveFrom != nil {
+		value := protoreflect.ValueOfMessage(x.ActiveFrom.ProtoReflect())
+		if !f(fd_PrunedDidDocVersion_active_from, value) {
 			return
 		}
 	}
-	if x.NextVersionId != "" {
-		value := protoreflect.ValueOfString(x.NextVersionId)
-		if !f(fd_Metadata_next_version_id, value) {
+	if x.Checksum != "" {
+		value := protoreflect.ValueOfString(x.Checksum)
+		if !f(fd_PrunedDidDocVersion_checksum, value) {
 			return
 		}
 	}
-	if x.PreviousVersionId != "" {
-		value := protoreflect.ValueOfString(x.PreviousVersionId)
-		if !f(fd_Metadata_previous_version_id, value) {
+	if x.ChainHash != "" {
+		value := protoreflect.ValueOfString(x.ChainHash)
+		if !f(fd_PrunedDidDocVersion_chain_hash, value) {
 			return
 		}
 	}
@@ -3991,25 +7021,21 @@ func (x *fastReflection_Metadata) Range(f func(protoreflect.FieldDescriptor, pro
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Metadata) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_PrunedDidDocVersion) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cheqd.did.v2.Metadata.created":
-		return x.Created != nil
-	case "cheqd.did.v2.Metadata.updated":
-		return x.Updated != nil
-	case "cheqd.did.v2.Metadata.deactivated":
-		return x.Deactivated != false
-	case "cheqd.did.v2.Metadata.version_id":
+	case "cheqd.did.v2.PrunedDidDocVersion.version_id":
 		return x.VersionId != ""
-	case "cheqd.did.v2.Metadata.next_version_id":
-		return x.NextVersionId != ""
-	case "cheqd.did.v2.Metadata.previous_version_id":
-		return x.PreviousVersionId != ""
+	case "cheqd.did.v2.PrunedDidDocVersion.active_from":
+		return x.ActiveFrom != nil
+	case "cheqd.did.v2.PrunedDidDocVersion.checksum":
+		return x.Checksum != ""
+	case "cheqd.did.v2.PrunedDidDocVersion.chain_hash":
+		return x.ChainHash != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.Metadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.PrunedDidDocVersion"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.Metadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.PrunedDidDocVersion does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4019,25 +7045,21 @@ func (x *fastReflection_Metadata) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Metadata) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_PrunedDidDocVersion) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cheqd.did.v2.Metadata.created":
-		x.Created = nil
-	case "cheqd.did.v2.Metadata.updated":
-		x.Updated = nil
-	case "cheqd.did.v2.Metadata.deactivated":
-		x.Deactivated = false
-	case "cheqd.did.v2.Metadata.version_id":
+	case "cheqd.did.v2.PrunedDidDocVersion.version_id":
 		x.VersionId = ""
-	case "cheqd.did.v2.Metadata.next_version_id":
-		x.NextVersionId = ""
-	case "cheqd.did.v2.Metadata.previous_version_id":
-		x.PreviousVersionId = ""
+	case "cheqd.did.v2.PrunedDidDocVersion.active_from":
+		x.ActiveFrom = nil
+	case "cheqd.did.v2.PrunedDidDocVersion.checksum":
+		x.Checksum = ""
+	case "cheqd.did.v2.PrunedDidDocVersion.chain_hash":
+		x.ChainHash = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.Metadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.PrunedDidDocVersion"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.Metadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.PrunedDidDocVersion does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4047,31 +7069,25 @@ func (x *fastReflection_Metadata) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Metadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PrunedDidDocVersion) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cheqd.did.v2.Metadata.created":
-		value := x.Created
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cheqd.did.v2.Metadata.updated":
-		value := x.Updated
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cheqd.did.v2.Metadata.deactivated":
-		value := x.Deactivated
-		return protoreflect.ValueOfBool(value)
-	case "cheqd.did.v2.Metadata.version_id":
+	case "cheqd.did.v2.PrunedDidDocVersion.version_id":
 		value := x.VersionId
 		return protoreflect.ValueOfString(value)
-	case "cheqd.did.v2.Metadata.next_version_id":
-		value := x.NextVersionId
+	case "cheqd.did.v2.PrunedDidDocVersion.active_from":
+		value := x.ActiveFrom
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cheqd.did.v2.PrunedDidDocVersion.checksum":
+		value := x.Checksum
 		return protoreflect.ValueOfString(value)
-	case "cheqd.did.v2.Metadata.previous_version_id":
-		value := x.PreviousVersionId
+	case "cheqd.did.v2.PrunedDidDocVersion.chain_hash":
+		value := x.ChainHash
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.Metadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.PrunedDidDocVersion"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.Metadata does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.PrunedDidDocVersion does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4085,25 +7101,21 @@ func (x *fastReflection_Metadata) Get(descriptor protoreflect.FieldDescriptor) p
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Metadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_PrunedDidDocVersion) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cheqd.did.v2.Metadata.created":
-		x.Created = value.Message().Interface().(*timestamppb.Timestamp)
-	case "cheqd.did.v2.Metadata.updated":
-		x.Updated = value.Message().Interface().(*timestamppb.Timestamp)
-	case "cheqd.did.v2.Metadata.deactivated":
-		x.Deactivated = value.Bool()
-	case "cheqd.did.v2.Metadata.version_id":
+	case "cheqd.did.v2.PrunedDidDocVersion.version_id":
 		x.VersionId = value.Interface().(string)
-	case "cheqd.did.v2.Metadata.next_version_id":
-		x.NextVersionId = value.Interface().(string)
-	case "cheqd.did.v2.Metadata.previous_version_id":
-		x.PreviousVersionId = value.Interface().(string)
+	case "cheqd.did.v2.PrunedDidDocVersion.active_from":
+		x.ActiveFrom = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cheqd.did.v2.PrunedDidDocVersion.checksum":
+		x.Checksum = value.Interface().(string)
+	case "cheqd.did.v2.PrunedDidDocVersion.chain_hash":
+		x.ChainHash = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.Metadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.PrunedDidDocVersion"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.Metadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.PrunedDidDocVersion does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4117,68 +7129,56 @@ func (x *fastReflection_Metadata) Set(fd protoreflect.FieldDescriptor, value pro
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Metadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PrunedDidDocVersion) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.did.v2.Metadata.created":
-		if x.Created == nil {
-			x.Created = new(timestamppb.Timestamp)
-		}
-		return protoreflect.ValueOfMessage(x.Created.ProtoReflect())
-	case "cheqd.did.v2.Metadata.updated":
-		if x.Updated == nil {
-			x.Updated = new(timestamppb.Timestamp)
-		}
-		return protoreflect.ValueOfMessage(x.Updated.ProtoReflect())
-	case "cheqd.did.v2.Metadata.deactivated":
-		panic(fmt.Errorf("field deactivated of message cheqd.did.v2.Metadata is not mutable"))
-	case "cheqd.did.v2.Metadata.version_id":
-		panic(fmt.Errorf("field version_id of message cheqd.did.v2.Metadata is not mutable"))
-	case "cheqd.did.v2.Metadata.next_version_id":
-		panic(fmt.Errorf("field next_version_id of message cheqd.did.v2.Metadata is not mutable"))
-	case "cheqd.did.v2.Metadata.previous_version_id":
-		panic(fmt.Errorf("field previous_version_id of message cheqd.did.v2.Metadata is not mutable"))
+	case "cheqd.did.v2.PrunedDidDocVersion.active_from":
+		if x.ActiveFrom == nil {
+			x.ActiveFrom = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ActiveFrom.ProtoReflect())
+	case "cheqd.did.v2.PrunedDidDocVersion.version_id":
+		panic(fmt.Errorf("field version_id of message cheqd.did.v2.PrunedDidDocVersion is not mutable"))
+	case "cheqd.did.v2.PrunedDidDocVersion.checksum":
+		panic(fmt.Errorf("field checksum of message cheqd.did.v2.PrunedDidDocVersion is not mutable"))
+	case "cheqd.did.v2.PrunedDidDocVersion.chain_hash":
+		panic(fmt.Errorf("field chain_hash of message cheqd.did.v2.PrunedDidDocVersion is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.Metadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.PrunedDidDocVersion"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.Metadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.PrunedDidDocVersion does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Metadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PrunedDidDocVersion) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.did.v2.Metadata.created":
-		m := new(timestamppb.Timestamp)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cheqd.did.v2.Metadata.updated":
+	case "cheqd.did.v2.PrunedDidDocVersion.version_id":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.PrunedDidDocVersion.active_from":
 		m := new(timestamppb.Timestamp)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cheqd.did.v2.Metadata.deactivated":
-		return protoreflect.ValueOfBool(false)
-	case "cheqd.did.v2.Metadata.version_id":
-		return protoreflect.ValueOfString("")
-	case "cheqd.did.v2.Metadata.next_version_id":
+	case "cheqd.did.v2.PrunedDidDocVersion.checksum":
 		return protoreflect.ValueOfString("")
-	case "cheqd.did.v2.Metadata.previous_version_id":
+	case "cheqd.did.v2.PrunedDidDocVersion.chain_hash":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.Metadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.PrunedDidDocVersion"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.Metadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.PrunedDidDocVersion does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Metadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_PrunedDidDocVersion) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.Metadata", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.PrunedDidDocVersion", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4186,7 +7186,7 @@ func (x *fastReflection_Metadata) WhichOneof(d protoreflect.OneofDescriptor) pro
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Metadata) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_PrunedDidDocVersion) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4197,7 +7197,7 @@ func (x *fastReflection_Metadata) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Metadata) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_PrunedDidDocVersion) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4209,7 +7209,7 @@ func (x *fastReflection_Metadata) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Metadata) IsValid() bool {
+func (x *fastReflection_PrunedDidDocVersion) IsValid() bool {
 	return x != nil
 }
 
@@ -4219,9 +7219,9 @@ func (x *fastReflection_Metadata) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_PrunedDidDocVersion) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Metadata)
+		x := input.Message.Interface().(*PrunedDidDocVersion)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4233,26 +7233,19 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Created != nil {
-			l = options.Size(x.Created)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Updated != nil {
-			l = options.Size(x.Updated)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Deactivated {
-			n += 2
-		}
 		l = len(x.VersionId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.NextVersionId)
+		if x.ActiveFrom != nil {
+			l = options.Size(x.ActiveFrom)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Checksum)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.PreviousVersionId)
+		l = len(x.ChainHash)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -4266,7 +7259,7 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Metadata)
+		x := input.Message.Interface().(*PrunedDidDocVersion)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4285,39 +7278,22 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.PreviousVersionId) > 0 {
-			i -= len(x.PreviousVersionId)
-			copy(dAtA[i:], x.PreviousVersionId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PreviousVersionId)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if len(x.NextVersionId) > 0 {
-			i -= len(x.NextVersionId)
-			copy(dAtA[i:], x.NextVersionId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NextVersionId)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.VersionId) > 0 {
-			i -= len(x.VersionId)
-			copy(dAtA[i:], x.VersionId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VersionId)))
+		if len(x.ChainHash) > 0 {
+			i -= len(x.ChainHash)
+			copy(dAtA[i:], x.ChainHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChainHash)))
 			i--
 			dAtA[i] = 0x22
 		}
-		if x.Deactivated {
-			i--
-			if x.Deactivated {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
+		if len(x.Checksum) > 0 {
+			i -= len(x.Checksum)
+			copy(dAtA[i:], x.Checksum)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Checksum)))
 			i--
-			dAtA[i] = 0x18
+			dAtA[i] = 0x1a
 		}
-		if x.Updated != nil {
-			encoded, err := options.Marshal(x.Updated)
+		if x.ActiveFrom != nil {
+			encoded, err := options.Marshal(x.ActiveFrom)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4330,17 +7306,10 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x12
 		}
-		if x.Created != nil {
-			encoded, err := options.Marshal(x.Created)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.VersionId) > 0 {
+			i -= len(x.VersionId)
+			copy(dAtA[i:], x.VersionId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VersionId)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -4355,7 +7324,7 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Metadata)
+		x := input.Message.Interface().(*PrunedDidDocVersion)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4387,89 +7356,17 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PrunedDidDocVersion: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PrunedDidDocVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Created == nil {
-					x.Created = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Created); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Updated == nil {
-					x.Updated = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Updated); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deactivated", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
 				}
-				var v int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4479,17 +7376,29 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.Deactivated = bool(v != 0)
-			case 4:
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VersionId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActiveFrom", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4499,27 +7408,31 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.VersionId = string(dAtA[iNdEx:postIndex])
+				if x.ActiveFrom == nil {
+					x.ActiveFrom = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ActiveFrom); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 5:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextVersionId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4547,11 +7460,11 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.NextVersionId = string(dAtA[iNdEx:postIndex])
+				x.Checksum = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 6:
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousVersionId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainHash", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4579,7 +7492,7 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PreviousVersionId = string(dAtA[iNdEx:postIndex])
+				x.ChainHash = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -4666,6 +7579,10 @@ type DidDoc struct {
 	Service []*Service `protobuf:"bytes,10,rep,name=service,proto3" json:"service,omitempty"`
 	// alsoKnownAs is a list of DIDs that are known to refer to the same DID subject.
 	AlsoKnownAs []string `protobuf:"bytes,11,rep,name=also_known_as,json=alsoKnownAs,proto3" json:"also_known_as,omitempty"`
+	// controllerThreshold is the minimum number of controllers that must sign on behalf of the DID subject
+	// to update or deactivate the DID Document and to create resources in its collection.
+	// Zero requires signatures of all controllers.
+	ControllerThreshold uint32 `protobuf:"varint,12,opt,name=controller_threshold,json=controllerThreshold,proto3" json:"controller_threshold,omitempty"`
 }
 
 func (x *DidDoc) Reset() {
@@ -4765,6 +7682,13 @@ func (x *DidDoc) GetAlsoKnownAs() []string {
 	return nil
 }
 
+func (x *DidDoc) GetControllerThreshold() uint32 {
+	if x != nil {
+		return x.ControllerThreshold
+	}
+	return 0
+}
+
 // VerificationMethod defines a verification method, as defined in the DID Core specification.
 // Documentation: https://www.w3.org/TR/did-core/#verification-methods
 type VerificationMethod struct {
@@ -4782,7 +7706,7 @@ type VerificationMethod struct {
 	// Format: did:cheqd:<namespace>:<unique-identifier>
 	Controller string `protobuf:"bytes,3,opt,name=controller,proto3" json:"controller,omitempty"`
 	// verification_material is the public key of the verification method.
-	// Commonly used verification material types: publicJwk, publicKeyBase58, publicKeyMultibase
+	// Commonly used verification material types: publicJwk, publicKeyBase58, publicKeyMultibase, blockchainAccountId
 	VerificationMaterial string `protobuf:"bytes,4,opt,name=verification_material,json=verificationMaterial,proto3" json:"verification_material,omitempty"`
 }
 
@@ -4861,6 +7785,10 @@ type Service struct {
 	Accept []string `protobuf:"bytes,6,rep,name=accept,proto3" json:"accept,omitempty"`
 	// priority: An integer defining the priority of this service entry.
 	Priority uint32 `protobuf:"varint,7,opt,name=priority,proto3" json:"priority,omitempty"`
+	// serviceEndpointMaps are map-style endpoints of the service, which are part of
+	// serviceEndpoint in the DID Core representation, following the string endpoints.
+	// Example: [{"uri": "https://example.com/didcomm", "accept": ["didcomm/v2"]}]
+	ServiceEndpointMaps []*ServiceEndpointMap `protobuf:"bytes,8,rep,name=service_endpoint_maps,json=serviceEndpointMaps,proto3" json:"service_endpoint_maps,omitempty"`
 }
 
 func (x *Service) Reset() {
@@ -4932,6 +7860,139 @@ func (x *Service) GetPriority() uint32 {
 	return 0
 }
 
+func (x *Service) GetServiceEndpointMaps() []*ServiceEndpointMap {
+	if x != nil {
+		return x.ServiceEndpointMaps
+	}
+	return nil
+}
+
+// ServiceEndpointMap defines a map-style service endpoint, as defined in the DID Core specification.
+// Documentation: https://www.w3.org/TR/did-core/#dfn-serviceendpoint
+type ServiceEndpointMap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// uri is the URI of the endpoint, e.g. of a DIDComm v2 endpoint.
+	// Example: https://example.com/didcomm
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// accept is a list of MIME types or protocol formats the endpoint supports.
+	// Example: ["didcomm/v2"]
+	Accept []string `protobuf:"bytes,2,rep,name=accept,proto3" json:"accept,omitempty"`
+	// routingKeys is a list of mediator or relay keys of the endpoint.
+	// Format: did:cheqd:<namespace>:<unique-identifier>#<key-id> or did:key:<identifier>
+	RoutingKeys []string `protobuf:"bytes,3,rep,name=routing_keys,json=routingKeys,proto3" json:"routing_keys,omitempty"`
+	// origins is a list of web origins, e.g. of a LinkedDomains endpoint.
+	// Example: ["https://example.com"]
+	Origins []string `protobuf:"bytes,4,rep,name=origins,proto3" json:"origins,omitempty"`
+	// properties are other string properties of the endpoint, e.g. of a LinkedResourceMetadata endpoint.
+	// A list is used instead of a map to keep the encoding deterministic.
+	// Example: [{"key": "resourceType", "value": "String"}]
+	Properties []*ServiceEndpointProperty `protobuf:"bytes,5,rep,name=properties,proto3" json:"properties,omitempty"`
+}
+
+func (x *ServiceEndpointMap) Reset() {
+	*x = ServiceEndpointMap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cheqd_did_v2_diddoc_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceEndpointMap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceEndpointMap) ProtoMessage() {}
+
+// Deprecated: Use ServiceEndpointMap.ProtoReflect.Descriptor instead.
+func (*ServiceEndpointMap) Descriptor() ([]byte, []int) {
+	return file_cheqd_did_v2_diddoc_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ServiceEndpointMap) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *ServiceEndpointMap) GetAccept() []string {
+	if x != nil {
+		return x.Accept
+	}
+	return nil
+}
+
+func (x *ServiceEndpointMap) GetRoutingKeys() []string {
+	if x != nil {
+		return x.RoutingKeys
+	}
+	return nil
+}
+
+func (x *ServiceEndpointMap) GetOrigins() []string {
+	if x != nil {
+		return x.Origins
+	}
+	return nil
+}
+
+func (x *ServiceEndpointMap) GetProperties() []*ServiceEndpointProperty {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+// ServiceEndpointProperty defines a string property of a map-style service endpoint.
+type ServiceEndpointProperty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// key is the name of the property.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// value is the value of the property.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *ServiceEndpointProperty) Reset() {
+	*x = ServiceEndpointProperty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cheqd_did_v2_diddoc_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceEndpointProperty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceEndpointProperty) ProtoMessage() {}
+
+// Deprecated: Use ServiceEndpointProperty.ProtoReflect.Descriptor instead.
+func (*ServiceEndpointProperty) Descriptor() ([]byte, []int) {
+	return file_cheqd_did_v2_diddoc_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ServiceEndpointProperty) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ServiceEndpointProperty) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
 // DidDocWithMetadata defines a DID Document with metadata, as defined in the DID Core specification.
 // Contains the DID Document, as well as DID Document metadata.
 type DidDocWithMetadata struct {
@@ -4948,7 +8009,7 @@ type DidDocWithMetadata struct {
 func (x *DidDocWithMetadata) Reset() {
 	*x = DidDocWithMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cheqd_did_v2_diddoc_proto_msgTypes[3]
+		mi := &file_cheqd_did_v2_diddoc_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4962,7 +8023,7 @@ func (*DidDocWithMetadata) ProtoMessage() {}
 
 // Deprecated: Use DidDocWithMetadata.ProtoReflect.Descriptor instead.
 func (*DidDocWithMetadata) Descriptor() ([]byte, []int) {
-	return file_cheqd_did_v2_diddoc_proto_rawDescGZIP(), []int{3}
+	return file_cheqd_did_v2_diddoc_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *DidDocWithMetadata) GetDidDoc() *DidDoc {
@@ -5009,12 +8070,17 @@ type Metadata struct {
 	// Format: UUID
 	// Example: 123e4567-e89b-12d3-a456-426655440000
 	PreviousVersionId string `protobuf:"bytes,6,opt,name=previous_version_id,json=previousVersionId,proto3" json:"previous_version_id,omitempty"`
+	// recovery_commitment is a commitment to a pre-rotated recovery key of the DID Document.
+	// It is the hex encoded SHA-256 hash of the recovery key type and verification material,
+	// which allows the DID Document to be recovered with Msg/RecoverDidDoc if its controller keys are lost.
+	// Format: <hex-encoded-sha256>
+	RecoveryCommitment string `protobuf:"bytes,7,opt,name=recovery_commitment,json=recoveryCommitment,proto3" json:"recovery_commitment,omitempty"`
 }
 
 func (x *Metadata) Reset() {
 	*x = Metadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cheqd_did_v2_diddoc_proto_msgTypes[4]
+		mi := &file_cheqd_did_v2_diddoc_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5028,7 +8094,7 @@ func (*Metadata) ProtoMessage() {}
 
 // Deprecated: Use Metadata.ProtoReflect.Descriptor instead.
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return file_cheqd_did_v2_diddoc_proto_rawDescGZIP(), []int{4}
+	return file_cheqd_did_v2_diddoc_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Metadata) GetCreated() *timestamppb.Timestamp {
@@ -5073,6 +8139,140 @@ func (x *Metadata) GetPreviousVersionId() string {
 	return ""
 }
 
+func (x *Metadata) GetRecoveryCommitment() string {
+	if x != nil {
+		return x.RecoveryCommitment
+	}
+	return ""
+}
+
+// DidDocTombstone records the versions of a DID Document that were pruned according to the version retention policy.
+// The pruned versions form a hash chain, which allows auditing the version history against off-chain copies.
+type DidDocTombstone struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the DID of the DID Document.
+	// Format: did:cheqd:<namespace>:<unique-identifier>
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// pruned_versions is the list of pruned versions in the order of the version chain.
+	PrunedVersions []*PrunedDidDocVersion `protobuf:"bytes,2,rep,name=pruned_versions,json=prunedVersions,proto3" json:"pruned_versions,omitempty"`
+	// chain_hash is the hash of the last pruned version in the hash chain.
+	// Format: <hex-encoded-sha256>
+	ChainHash string `protobuf:"bytes,3,opt,name=chain_hash,json=chainHash,proto3" json:"chain_hash,omitempty"`
+}
+
+func (x *DidDocTombstone) Reset() {
+	*x = DidDocTombstone{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cheqd_did_v2_diddoc_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DidDocTombstone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DidDocTombstone) ProtoMessage() {}
+
+// Deprecated: Use DidDocTombstone.ProtoReflect.Descriptor instead.
+func (*DidDocTombstone) Descriptor() ([]byte, []int) {
+	return file_cheqd_did_v2_diddoc_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DidDocTombstone) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DidDocTombstone) GetPrunedVersions() []*PrunedDidDocVersion {
+	if x != nil {
+		return x.PrunedVersions
+	}
+	return nil
+}
+
+func (x *DidDocTombstone) GetChainHash() string {
+	if x != nil {
+		return x.ChainHash
+	}
+	return ""
+}
+
+// PrunedDidDocVersion defines a pruned version of a DID Document.
+type PrunedDidDocVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// version_id is the version identifier of the pruned version.
+	// Format: UUID
+	VersionId string `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// active_from is the time the pruned version became active (created or updated time).
+	// Format: RFC3339
+	ActiveFrom *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=active_from,json=activeFrom,proto3" json:"active_from,omitempty"`
+	// checksum is the SHA-256 hash of the protobuf encoded version (DidDocWithMetadata).
+	// Format: <hex-encoded-sha256>
+	Checksum string `protobuf:"bytes,3,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// chain_hash is the SHA-256 hash of the chain hash of the previous pruned version concatenated with the checksum.
+	// For the first pruned version the previous chain hash is empty.
+	// Format: <hex-encoded-sha256>
+	ChainHash string `protobuf:"bytes,4,opt,name=chain_hash,json=chainHash,proto3" json:"chain_hash,omitempty"`
+}
+
+func (x *PrunedDidDocVersion) Reset() {
+	*x = PrunedDidDocVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cheqd_did_v2_diddoc_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrunedDidDocVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrunedDidDocVersion) ProtoMessage() {}
+
+// Deprecated: Use PrunedDidDocVersion.ProtoReflect.Descriptor instead.
+func (*PrunedDidDocVersion) Descriptor() ([]byte, []int) {
+	return file_cheqd_did_v2_diddoc_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PrunedDidDocVersion) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+func (x *PrunedDidDocVersion) GetActiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActiveFrom
+	}
+	return nil
+}
+
+func (x *PrunedDidDocVersion) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+func (x *PrunedDidDocVersion) GetChainHash() string {
+	if x != nil {
+		return x.ChainHash
+	}
+	return ""
+}
+
 var File_cheqd_did_v2_diddoc_proto protoreflect.FileDescriptor
 
 var file_cheqd_did_v2_diddoc_proto_rawDesc = []byte{
@@ -5082,7 +8282,7 @@ var file_cheqd_did_v2_diddoc_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0xdc, 0x03, 0x0a, 0x06, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x22, 0x8f, 0x04, 0x0a, 0x06, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x63,
 	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
 	0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
@@ -5111,83 +8311,141 @@ var file_cheqd_did_v2_diddoc_proto_rawDesc = []byte{
 	0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
 	0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x61,
 	0x6c, 0x73, 0x6f, 0x5f, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x61, 0x73, 0x18, 0x0b, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0b, 0x61, 0x6c, 0x73, 0x6f, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x41, 0x73, 0x22,
-	0xc7, 0x01, 0x0a, 0x12, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4c, 0x0a, 0x18, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xea, 0xde, 0x1f, 0x0e, 0x74, 0x79,
-	0x70, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x16, 0x76, 0x65,
-	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x6c, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x15, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x14, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x22, 0xdf, 0x02, 0x0a, 0x07, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x28, 0x09, 0x52, 0x0b, 0x61, 0x6c, 0x73, 0x6f, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x41, 0x73, 0x12,
+	0x31, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x22, 0xc7, 0x01, 0x0a, 0x12, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4c, 0x0a, 0x18, 0x76, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
 	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xea, 0xde, 0x1f,
 	0x0e, 0x74, 0x79, 0x70, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52,
-	0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x10,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45,
-	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x69, 0x70,
-	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42,
-	0x1b, 0xea, 0xde, 0x1f, 0x17, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65,
-	0x79, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x0d, 0x72, 0x65,
-	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x3c, 0x0a, 0x0c, 0x72,
-	0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x09, 0x42, 0x19, 0xea, 0xde, 0x1f, 0x15, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x4b, 0x65,
-	0x79, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x0b, 0x72, 0x6f,
-	0x75, 0x74, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x2c, 0x0a, 0x06, 0x61, 0x63, 0x63,
-	0x65, 0x70, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x14, 0xea, 0xde, 0x1f, 0x10, 0x61,
-	0x63, 0x63, 0x65, 0x70, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52,
-	0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x12, 0x32, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72,
-	0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x16, 0xea, 0xde, 0x1f, 0x12, 0x70,
-	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74,
-	0x79, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0xa1, 0x01, 0x0a, 0x12,
-	0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x3e, 0x0a, 0x07, 0x64, 0x69, 0x64, 0x5f, 0x64, 0x6f, 0x63, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e,
-	0x76, 0x32, 0x2e, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x42, 0x0f, 0xea, 0xde, 0x1f, 0x0b, 0x64,
-	0x69, 0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x64, 0x69, 0x64, 0x44,
-	0x6f, 0x63, 0x12, 0x4b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64,
-	0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x17, 0xea, 0xde,
-	0x1f, 0x13, 0x64, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22,
-	0xaf, 0x02, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3e, 0x0a, 0x07,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90,
-	0xdf, 0x1f, 0x01, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x07,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x01, 0x90,
-	0xdf, 0x1f, 0x01, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b,
-	0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0b, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1d,
-	0x0a, 0x0a, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2c, 0x0a,
-	0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x01, 0x52, 0x0d, 0x6e, 0x65,
-	0x78, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x13, 0x70,
-	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x01, 0x52, 0x11,
-	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49,
-	0x64, 0x42, 0xa8, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e,
-	0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x42, 0x0b, 0x44, 0x69, 0x64, 0x64, 0x6f, 0x63, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2f, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2d, 0x6e, 0x6f,
-	0x64, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2f,
-	0x64, 0x69, 0x64, 0x2f, 0x76, 0x32, 0x3b, 0x64, 0x69, 0x64, 0x76, 0x32, 0xa2, 0x02, 0x03, 0x43,
-	0x44, 0x58, 0xaa, 0x02, 0x0c, 0x43, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x44, 0x69, 0x64, 0x2e, 0x56,
-	0x32, 0xca, 0x02, 0x0c, 0x43, 0x68, 0x65, 0x71, 0x64, 0x5c, 0x44, 0x69, 0x64, 0x5c, 0x56, 0x32,
-	0xe2, 0x02, 0x18, 0x43, 0x68, 0x65, 0x71, 0x64, 0x5c, 0x44, 0x69, 0x64, 0x5c, 0x56, 0x32, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x43, 0x68,
-	0x65, 0x71, 0x64, 0x3a, 0x3a, 0x44, 0x69, 0x64, 0x3a, 0x3a, 0x56, 0x32, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x16, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x15, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x22, 0xd8, 0x03, 0x0a,
+	0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12,
+	0xea, 0xde, 0x1f, 0x0e, 0x74, 0x79, 0x70, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x29, 0x0a, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x0e, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x1b, 0xea, 0xde, 0x1f, 0x17, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x4b, 0x65, 0x79, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52,
+	0x0d, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x3c,
+	0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x19, 0xea, 0xde, 0x1f, 0x15, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e,
+	0x67, 0x4b, 0x65, 0x79, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52,
+	0x0b, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x2c, 0x0a, 0x06,
+	0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x14, 0xea, 0xde,
+	0x1f, 0x10, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x12, 0x32, 0x0a, 0x08, 0x70, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x16, 0xea, 0xde,
+	0x1f, 0x12, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x77,
+	0x0a, 0x15, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x42,
+	0x21, 0xea, 0xde, 0x1f, 0x1d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x52, 0x13, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x73, 0x22, 0xb7, 0x02, 0x0a, 0x12, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x12, 0x23,
+	0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xea, 0xde, 0x1f,
+	0x0d, 0x75, 0x72, 0x69, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x03,
+	0x75, 0x72, 0x69, 0x12, 0x2c, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x14, 0xea, 0xde, 0x1f, 0x10, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x2c,
+	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x12, 0x3c, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x19, 0xea, 0xde, 0x1f, 0x15, 0x72, 0x6f, 0x75,
+	0x74, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x73, 0x12,
+	0x2f, 0x0a, 0x07, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x15, 0xea, 0xde, 0x1f, 0x11, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x2c, 0x6f, 0x6d,
+	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x07, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73,
+	0x12, 0x5f, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64,
+	0x2e, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x42, 0x18, 0xea, 0xde, 0x1f,
+	0x14, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65,
+	0x73, 0x22, 0x41, 0x0a, 0x17, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0xa1, 0x01, 0x0a, 0x12, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x57,
+	0x69, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3e, 0x0a, 0x07, 0x64,
+	0x69, 0x64, 0x5f, 0x64, 0x6f, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63,
+	0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x64, 0x44,
+	0x6f, 0x63, 0x42, 0x0f, 0xea, 0xde, 0x1f, 0x0b, 0x64, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x06, 0x64, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x12, 0x4b, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x17, 0xea, 0xde, 0x1f, 0x13, 0x64, 0x69, 0x64, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xe6, 0x02, 0x0a, 0x08, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3e, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x01, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x07, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x01, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x01, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x13, 0x72, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x01, 0x52, 0x12, 0x72,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x22, 0x8c, 0x01, 0x0a, 0x0f, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x54, 0x6f, 0x6d, 0x62,
+	0x73, 0x74, 0x6f, 0x6e, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4a, 0x0a, 0x0f, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x72,
+	0x75, 0x6e, 0x65, 0x64, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x0e, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x48, 0x61, 0x73, 0x68,
+	0x22, 0xb6, 0x01, 0x0a, 0x13, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x44, 0x69, 0x64, 0x44, 0x6f,
+	0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf,
+	0x1f, 0x01, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x42, 0xa8, 0x01, 0x0a, 0x10, 0x63, 0x6f,
+	0x6d, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x42, 0x0b,
+	0x44, 0x69, 0x64, 0x64, 0x6f, 0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2f,
+	0x63, 0x68, 0x65, 0x71, 0x64, 0x2d, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x32, 0x2f, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76, 0x32, 0x3b, 0x64,
+	0x69, 0x64, 0x76, 0x32, 0xa2, 0x02, 0x03, 0x43, 0x44, 0x58, 0xaa, 0x02, 0x0c, 0x43, 0x68, 0x65,
+	0x71, 0x64, 0x2e, 0x44, 0x69, 0x64, 0x2e, 0x56, 0x32, 0xca, 0x02, 0x0c, 0x43, 0x68, 0x65, 0x71,
+	0x64, 0x5c, 0x44, 0x69, 0x64, 0x5c, 0x56, 0x32, 0xe2, 0x02, 0x18, 0x43, 0x68, 0x65, 0x71, 0x64,
+	0x5c, 0x44, 0x69, 0x64, 0x5c, 0x56, 0x32, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x43, 0x68, 0x65, 0x71, 0x64, 0x3a, 0x3a, 0x44, 0x69, 0x64,
+	0x3a, 0x3a, 0x56, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5202,27 +8460,35 @@ func file_cheqd_did_v2_diddoc_proto_rawDescGZIP() []byte {
 	return file_cheqd_did_v2_diddoc_proto_rawDescData
 }
 
-var file_cheqd_did_v2_diddoc_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_cheqd_did_v2_diddoc_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_cheqd_did_v2_diddoc_proto_goTypes = []interface{}{
-	(*DidDoc)(nil),                // 0: cheqd.did.v2.DidDoc
-	(*VerificationMethod)(nil),    // 1: cheqd.did.v2.VerificationMethod
-	(*Service)(nil),               // 2: cheqd.did.v2.Service
-	(*DidDocWithMetadata)(nil),    // 3: cheqd.did.v2.DidDocWithMetadata
-	(*Metadata)(nil),              // 4: cheqd.did.v2.Metadata
-	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+	(*DidDoc)(nil),                  // 0: cheqd.did.v2.DidDoc
+	(*VerificationMethod)(nil),      // 1: cheqd.did.v2.VerificationMethod
+	(*Service)(nil),                 // 2: cheqd.did.v2.Service
+	(*ServiceEndpointMap)(nil),      // 3: cheqd.did.v2.ServiceEndpointMap
+	(*ServiceEndpointProperty)(nil), // 4: cheqd.did.v2.ServiceEndpointProperty
+	(*DidDocWithMetadata)(nil),      // 5: cheqd.did.v2.DidDocWithMetadata
+	(*Metadata)(nil),                // 6: cheqd.did.v2.Metadata
+	(*DidDocTombstone)(nil),         // 7: cheqd.did.v2.DidDocTombstone
+	(*PrunedDidDocVersion)(nil),     // 8: cheqd.did.v2.PrunedDidDocVersion
+	(*timestamppb.Timestamp)(nil),   // 9: google.protobuf.Timestamp
 }
 var file_cheqd_did_v2_diddoc_proto_depIdxs = []int32{
-	1, // 0: cheqd.did.v2.DidDoc.verification_method:type_name -> cheqd.did.v2.VerificationMethod
-	2, // 1: cheqd.did.v2.DidDoc.service:type_name -> cheqd.did.v2.Service
-	0, // 2: cheqd.did.v2.DidDocWithMetadata.did_doc:type_name -> cheqd.did.v2.DidDoc
-	4, // 3: cheqd.did.v2.DidDocWithMetadata.metadata:type_name -> cheqd.did.v2.Metadata
-	5, // 4: cheqd.did.v2.Metadata.created:type_name -> google.protobuf.Timestamp
-	5, // 5: cheqd.did.v2.Metadata.updated:type_name -> google.protobuf.Timestamp
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	1,  // 0: cheqd.did.v2.DidDoc.verification_method:type_name -> cheqd.did.v2.VerificationMethod
+	2,  // 1: cheqd.did.v2.DidDoc.service:type_name -> cheqd.did.v2.Service
+	3,  // 2: cheqd.did.v2.Service.service_endpoint_maps:type_name -> cheqd.did.v2.ServiceEndpointMap
+	4,  // 3: cheqd.did.v2.ServiceEndpointMap.properties:type_name -> cheqd.did.v2.ServiceEndpointProperty
+	0,  // 4: cheqd.did.v2.DidDocWithMetadata.did_doc:type_name -> cheqd.did.v2.DidDoc
+	6,  // 5: cheqd.did.v2.DidDocWithMetadata.metadata:type_name -> cheqd.did.v2.Metadata
+	9,  // 6: cheqd.did.v2.Metadata.created:type_name -> google.protobuf.Timestamp
+	9,  // 7: cheqd.did.v2.Metadata.updated:type_name -> google.protobuf.Timestamp
+	8,  // 8: cheqd.did.v2.DidDocTombstone.pruned_versions:type_name -> cheqd.did.v2.PrunedDidDocVersion
+	9,  // 9: cheqd.did.v2.PrunedDidDocVersion.active_from:type_name -> google.protobuf.Timestamp
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_cheqd_did_v2_diddoc_proto_init() }
@@ -5268,7 +8534,7 @@ func file_cheqd_did_v2_diddoc_proto_init() {
 			}
 		}
 		file_cheqd_did_v2_diddoc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DidDocWithMetadata); i {
+			switch v := v.(*ServiceEndpointMap); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5280,6 +8546,30 @@ func file_cheqd_did_v2_diddoc_proto_init() {
 			}
 		}
 		file_cheqd_did_v2_diddoc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceEndpointProperty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cheqd_did_v2_diddoc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DidDocWithMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cheqd_did_v2_diddoc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Metadata); i {
 			case 0:
 				return &v.state
@@ -5291,6 +8581,30 @@ func file_cheqd_did_v2_diddoc_proto_init() {
 				return nil
 			}
 		}
+		file_cheqd_did_v2_diddoc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DidDocTombstone); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cheqd_did_v2_diddoc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrunedDidDocVersion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -5298,7 +8612,7 @@ func file_cheqd_did_v2_diddoc_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cheqd_did_v2_diddoc_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
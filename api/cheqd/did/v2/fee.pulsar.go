@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
@@ -716,12 +717,166 @@ func (x *_FeeParams_3_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_FeeParams_7_list)(nil)
+
+type _FeeParams_7_list struct {
+	list *[]string
+}
+
+func (x *_FeeParams_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeeParams_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_FeeParams_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeeParams_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeeParams_7_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message FeeParams at list field AllowedServiceEndpointSchemes as it is not of Message kind"))
+}
+
+func (x *_FeeParams_7_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeeParams_7_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_FeeParams_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_FeeParams_8_list)(nil)
+
+type _FeeParams_8_list struct {
+	list *[]*VolumeDiscountTier
+}
+
+func (x *_FeeParams_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeeParams_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FeeParams_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VolumeDiscountTier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeeParams_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VolumeDiscountTier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeeParams_8_list) AppendMutable() protoreflect.Value {
+	v := new(VolumeDiscountTier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeParams_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeeParams_8_list) NewElement() protoreflect.Value {
+	v := new(VolumeDiscountTier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeParams_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_FeeParams_9_list)(nil)
+
+type _FeeParams_9_list struct {
+	list *[]*IssuerDiscount
+}
+
+func (x *_FeeParams_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeeParams_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FeeParams_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IssuerDiscount)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeeParams_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IssuerDiscount)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeeParams_9_list) AppendMutable() protoreflect.Value {
+	v := new(IssuerDiscount)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeParams_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeeParams_9_list) NewElement() protoreflect.Value {
+	v := new(IssuerDiscount)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeParams_9_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_FeeParams                protoreflect.MessageDescriptor
-	fd_FeeParams_create_did     protoreflect.FieldDescriptor
-	fd_FeeParams_update_did     protoreflect.FieldDescriptor
-	fd_FeeParams_deactivate_did protoreflect.FieldDescriptor
-	fd_FeeParams_burn_factor    protoreflect.FieldDescriptor
+	md_FeeParams                                  protoreflect.MessageDescriptor
+	fd_FeeParams_create_did                       protoreflect.FieldDescriptor
+	fd_FeeParams_update_did                       protoreflect.FieldDescriptor
+	fd_FeeParams_deactivate_did                   protoreflect.FieldDescriptor
+	fd_FeeParams_burn_factor                      protoreflect.FieldDescriptor
+	fd_FeeParams_max_batch_size                   protoreflect.FieldDescriptor
+	fd_FeeParams_version_retention_period         protoreflect.FieldDescriptor
+	fd_FeeParams_allowed_service_endpoint_schemes protoreflect.FieldDescriptor
+	fd_FeeParams_volume_discount_tiers            protoreflect.FieldDescriptor
+	fd_FeeParams_issuer_discounts                 protoreflect.FieldDescriptor
+	fd_FeeParams_volume_discount_window           protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -731,6 +886,12 @@ func init() {
 	fd_FeeParams_update_did = md_FeeParams.Fields().ByName("update_did")
 	fd_FeeParams_deactivate_did = md_FeeParams.Fields().ByName("deactivate_did")
 	fd_FeeParams_burn_factor = md_FeeParams.Fields().ByName("burn_factor")
+	fd_FeeParams_max_batch_size = md_FeeParams.Fields().ByName("max_batch_size")
+	fd_FeeParams_version_retention_period = md_FeeParams.Fields().ByName("version_retention_period")
+	fd_FeeParams_allowed_service_endpoint_schemes = md_FeeParams.Fields().ByName("allowed_service_endpoint_schemes")
+	fd_FeeParams_volume_discount_tiers = md_FeeParams.Fields().ByName("volume_discount_tiers")
+	fd_FeeParams_issuer_discounts = md_FeeParams.Fields().ByName("issuer_discounts")
+	fd_FeeParams_volume_discount_window = md_FeeParams.Fields().ByName("volume_discount_window")
 }
 
 var _ protoreflect.Message = (*fastReflection_FeeParams)(nil)
@@ -822,6 +983,42 @@ func (x *fastReflection_FeeParams) Range(f func(protoreflect.FieldDescriptor, pr
 			return
 		}
 	}
+	if x.MaxBatchSize != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxBatchSize)
+		if !f(fd_FeeParams_max_batch_size, value) {
+			return
+		}
+	}
+	if x.VersionRetentionPeriod != nil {
+		value := protoreflect.ValueOfMessage(x.VersionRetentionPeriod.ProtoReflect())
+		if !f(fd_FeeParams_version_retention_period, value) {
+			return
+		}
+	}
+	if len(x.AllowedServiceEndpointSchemes) != 0 {
+		value := protoreflect.ValueOfList(&_FeeParams_7_list{list: &x.AllowedServiceEndpointSchemes})
+		if !f(fd_FeeParams_allowed_service_endpoint_schemes, value) {
+			return
+		}
+	}
+	if len(x.VolumeDiscountTiers) != 0 {
+		value := protoreflect.ValueOfList(&_FeeParams_8_list{list: &x.VolumeDiscountTiers})
+		if !f(fd_FeeParams_volume_discount_tiers, value) {
+			return
+		}
+	}
+	if len(x.IssuerDiscounts) != 0 {
+		value := protoreflect.ValueOfList(&_FeeParams_9_list{list: &x.IssuerDiscounts})
+		if !f(fd_FeeParams_issuer_discounts, value) {
+			return
+		}
+	}
+	if x.VolumeDiscountWindow != nil {
+		value := protoreflect.ValueOfMessage(x.VolumeDiscountWindow.ProtoReflect())
+		if !f(fd_FeeParams_volume_discount_window, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -845,6 +1042,18 @@ func (x *fastReflection_FeeParams) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.DeactivateDid) != 0
 	case "cheqd.did.v2.FeeParams.burn_factor":
 		return x.BurnFactor != ""
+	case "cheqd.did.v2.FeeParams.max_batch_size":
+		return x.MaxBatchSize != uint32(0)
+	case "cheqd.did.v2.FeeParams.version_retention_period":
+		return x.VersionRetentionPeriod != nil
+	case "cheqd.did.v2.FeeParams.allowed_service_endpoint_schemes":
+		return len(x.AllowedServiceEndpointSchemes) != 0
+	case "cheqd.did.v2.FeeParams.volume_discount_tiers":
+		return len(x.VolumeDiscountTiers) != 0
+	case "cheqd.did.v2.FeeParams.issuer_discounts":
+		return len(x.IssuerDiscounts) != 0
+	case "cheqd.did.v2.FeeParams.volume_discount_window":
+		return x.VolumeDiscountWindow != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.FeeParams"))
@@ -869,6 +1078,18 @@ func (x *fastReflection_FeeParams) Clear(fd protoreflect.FieldDescriptor) {
 		x.DeactivateDid = nil
 	case "cheqd.did.v2.FeeParams.burn_factor":
 		x.BurnFactor = ""
+	case "cheqd.did.v2.FeeParams.max_batch_size":
+		x.MaxBatchSize = uint32(0)
+	case "cheqd.did.v2.FeeParams.version_retention_period":
+		x.VersionRetentionPeriod = nil
+	case "cheqd.did.v2.FeeParams.allowed_service_endpoint_schemes":
+		x.AllowedServiceEndpointSchemes = nil
+	case "cheqd.did.v2.FeeParams.volume_discount_tiers":
+		x.VolumeDiscountTiers = nil
+	case "cheqd.did.v2.FeeParams.issuer_discounts":
+		x.IssuerDiscounts = nil
+	case "cheqd.did.v2.FeeParams.volume_discount_window":
+		x.VolumeDiscountWindow = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.FeeParams"))
@@ -906,6 +1127,33 @@ func (x *fastReflection_FeeParams) Get(descriptor protoreflect.FieldDescriptor)
 	case "cheqd.did.v2.FeeParams.burn_factor":
 		value := x.BurnFactor
 		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.FeeParams.max_batch_size":
+		value := x.MaxBatchSize
+		return protoreflect.ValueOfUint32(value)
+	case "cheqd.did.v2.FeeParams.version_retention_period":
+		value := x.VersionRetentionPeriod
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cheqd.did.v2.FeeParams.allowed_service_endpoint_schemes":
+		if len(x.AllowedServiceEndpointSchemes) == 0 {
+			return protoreflect.ValueOfList(&_FeeParams_7_list{})
+		}
+		listValue := &_FeeParams_7_list{list: &x.AllowedServiceEndpointSchemes}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.did.v2.FeeParams.volume_discount_tiers":
+		if len(x.VolumeDiscountTiers) == 0 {
+			return protoreflect.ValueOfList(&_FeeParams_8_list{})
+		}
+		listValue := &_FeeParams_8_list{list: &x.VolumeDiscountTiers}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.did.v2.FeeParams.issuer_discounts":
+		if len(x.IssuerDiscounts) == 0 {
+			return protoreflect.ValueOfList(&_FeeParams_9_list{})
+		}
+		listValue := &_FeeParams_9_list{list: &x.IssuerDiscounts}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.did.v2.FeeParams.volume_discount_window":
+		value := x.VolumeDiscountWindow
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.FeeParams"))
@@ -940,6 +1188,24 @@ func (x *fastReflection_FeeParams) Set(fd protoreflect.FieldDescriptor, value pr
 		x.DeactivateDid = *clv.list
 	case "cheqd.did.v2.FeeParams.burn_factor":
 		x.BurnFactor = value.Interface().(string)
+	case "cheqd.did.v2.FeeParams.max_batch_size":
+		x.MaxBatchSize = uint32(value.Uint())
+	case "cheqd.did.v2.FeeParams.version_retention_period":
+		x.VersionRetentionPeriod = value.Message().Interface().(*durationpb.Duration)
+	case "cheqd.did.v2.FeeParams.allowed_service_endpoint_schemes":
+		lv := value.List()
+		clv := lv.(*_FeeParams_7_list)
+		x.AllowedServiceEndpointSchemes = *clv.list
+	case "cheqd.did.v2.FeeParams.volume_discount_tiers":
+		lv := value.List()
+		clv := lv.(*_FeeParams_8_list)
+		x.VolumeDiscountTiers = *clv.list
+	case "cheqd.did.v2.FeeParams.issuer_discounts":
+		lv := value.List()
+		clv := lv.(*_FeeParams_9_list)
+		x.IssuerDiscounts = *clv.list
+	case "cheqd.did.v2.FeeParams.volume_discount_window":
+		x.VolumeDiscountWindow = value.Message().Interface().(*durationpb.Duration)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.FeeParams"))
@@ -978,8 +1244,38 @@ func (x *fastReflection_FeeParams) Mutable(fd protoreflect.FieldDescriptor) prot
 		}
 		value := &_FeeParams_3_list{list: &x.DeactivateDid}
 		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.FeeParams.version_retention_period":
+		if x.VersionRetentionPeriod == nil {
+			x.VersionRetentionPeriod = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.VersionRetentionPeriod.ProtoReflect())
+	case "cheqd.did.v2.FeeParams.allowed_service_endpoint_schemes":
+		if x.AllowedServiceEndpointSchemes == nil {
+			x.AllowedServiceEndpointSchemes = []string{}
+		}
+		value := &_FeeParams_7_list{list: &x.AllowedServiceEndpointSchemes}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.FeeParams.volume_discount_tiers":
+		if x.VolumeDiscountTiers == nil {
+			x.VolumeDiscountTiers = []*VolumeDiscountTier{}
+		}
+		value := &_FeeParams_8_list{list: &x.VolumeDiscountTiers}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.FeeParams.issuer_discounts":
+		if x.IssuerDiscounts == nil {
+			x.IssuerDiscounts = []*IssuerDiscount{}
+		}
+		value := &_FeeParams_9_list{list: &x.IssuerDiscounts}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.FeeParams.volume_discount_window":
+		if x.VolumeDiscountWindow == nil {
+			x.VolumeDiscountWindow = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.VolumeDiscountWindow.ProtoReflect())
 	case "cheqd.did.v2.FeeParams.burn_factor":
 		panic(fmt.Errorf("field burn_factor of message cheqd.did.v2.FeeParams is not mutable"))
+	case "cheqd.did.v2.FeeParams.max_batch_size":
+		panic(fmt.Errorf("field max_batch_size of message cheqd.did.v2.FeeParams is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.FeeParams"))
@@ -1004,6 +1300,23 @@ func (x *fastReflection_FeeParams) NewField(fd protoreflect.FieldDescriptor) pro
 		return protoreflect.ValueOfList(&_FeeParams_3_list{list: &list})
 	case "cheqd.did.v2.FeeParams.burn_factor":
 		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.FeeParams.max_batch_size":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cheqd.did.v2.FeeParams.version_retention_period":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cheqd.did.v2.FeeParams.allowed_service_endpoint_schemes":
+		list := []string{}
+		return protoreflect.ValueOfList(&_FeeParams_7_list{list: &list})
+	case "cheqd.did.v2.FeeParams.volume_discount_tiers":
+		list := []*VolumeDiscountTier{}
+		return protoreflect.ValueOfList(&_FeeParams_8_list{list: &list})
+	case "cheqd.did.v2.FeeParams.issuer_discounts":
+		list := []*IssuerDiscount{}
+		return protoreflect.ValueOfList(&_FeeParams_9_list{list: &list})
+	case "cheqd.did.v2.FeeParams.volume_discount_window":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.FeeParams"))
@@ -1095,6 +1408,35 @@ func (x *fastReflection_FeeParams) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.MaxBatchSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxBatchSize))
+		}
+		if x.VersionRetentionPeriod != nil {
+			l = options.Size(x.VersionRetentionPeriod)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.AllowedServiceEndpointSchemes) > 0 {
+			for _, s := range x.AllowedServiceEndpointSchemes {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.VolumeDiscountTiers) > 0 {
+			for _, e := range x.VolumeDiscountTiers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.IssuerDiscounts) > 0 {
+			for _, e := range x.IssuerDiscounts {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.VolumeDiscountWindow != nil {
+			l = options.Size(x.VolumeDiscountWindow)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1124,6 +1466,80 @@ func (x *fastReflection_FeeParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.VolumeDiscountWindow != nil {
+			encoded, err := options.Marshal(x.VolumeDiscountWindow)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.IssuerDiscounts) > 0 {
+			for iNdEx := len(x.IssuerDiscounts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.IssuerDiscounts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.VolumeDiscountTiers) > 0 {
+			for iNdEx := len(x.VolumeDiscountTiers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VolumeDiscountTiers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.AllowedServiceEndpointSchemes) > 0 {
+			for iNdEx := len(x.AllowedServiceEndpointSchemes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedServiceEndpointSchemes[iNdEx])
+				copy(dAtA[i:], x.AllowedServiceEndpointSchemes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedServiceEndpointSchemes[iNdEx])))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if x.VersionRetentionPeriod != nil {
+			encoded, err := options.Marshal(x.VersionRetentionPeriod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.MaxBatchSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxBatchSize))
+			i--
+			dAtA[i] = 0x28
+		}
 		if len(x.BurnFactor) > 0 {
 			i -= len(x.BurnFactor)
 			copy(dAtA[i:], x.BurnFactor)
@@ -1362,47 +1778,1190 @@ func (x *fastReflection_FeeParams) ProtoMethods() *protoiface.Methods {
 				}
 				x.BurnFactor = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBatchSize", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				x.MaxBatchSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxBatchSize |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VersionRetentionPeriod", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if x.VersionRetentionPeriod == nil {
+					x.VersionRetentionPeriod = &durationpb.Duration{}
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: cheqd/did/v2/fee.proto
-
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VersionRetentionPeriod); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedServiceEndpointSchemes", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedServiceEndpointSchemes = append(x.AllowedServiceEndpointSchemes, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VolumeDiscountTiers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VolumeDiscountTiers = append(x.VolumeDiscountTiers, &VolumeDiscountTier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VolumeDiscountTiers[len(x.VolumeDiscountTiers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IssuerDiscounts", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.IssuerDiscounts = append(x.IssuerDiscounts, &IssuerDiscount{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.IssuerDiscounts[len(x.IssuerDiscounts)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VolumeDiscountWindow", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.VolumeDiscountWindow == nil {
+					x.VolumeDiscountWindow = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VolumeDiscountWindow); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_VolumeDiscountTier          protoreflect.MessageDescriptor
+	fd_VolumeDiscountTier_min_txs  protoreflect.FieldDescriptor
+	fd_VolumeDiscountTier_discount protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cheqd_did_v2_fee_proto_init()
+	md_VolumeDiscountTier = File_cheqd_did_v2_fee_proto.Messages().ByName("VolumeDiscountTier")
+	fd_VolumeDiscountTier_min_txs = md_VolumeDiscountTier.Fields().ByName("min_txs")
+	fd_VolumeDiscountTier_discount = md_VolumeDiscountTier.Fields().ByName("discount")
+}
+
+var _ protoreflect.Message = (*fastReflection_VolumeDiscountTier)(nil)
+
+type fastReflection_VolumeDiscountTier VolumeDiscountTier
+
+func (x *VolumeDiscountTier) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VolumeDiscountTier)(x)
+}
+
+func (x *VolumeDiscountTier) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_fee_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VolumeDiscountTier_messageType fastReflection_VolumeDiscountTier_messageType
+var _ protoreflect.MessageType = fastReflection_VolumeDiscountTier_messageType{}
+
+type fastReflection_VolumeDiscountTier_messageType struct{}
+
+func (x fastReflection_VolumeDiscountTier_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VolumeDiscountTier)(nil)
+}
+func (x fastReflection_VolumeDiscountTier_messageType) New() protoreflect.Message {
+	return new(fastReflection_VolumeDiscountTier)
+}
+func (x fastReflection_VolumeDiscountTier_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VolumeDiscountTier
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VolumeDiscountTier) Descriptor() protoreflect.MessageDescriptor {
+	return md_VolumeDiscountTier
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VolumeDiscountTier) Type() protoreflect.MessageType {
+	return _fastReflection_VolumeDiscountTier_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VolumeDiscountTier) New() protoreflect.Message {
+	return new(fastReflection_VolumeDiscountTier)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VolumeDiscountTier) Interface() protoreflect.ProtoMessage {
+	return (*VolumeDiscountTier)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VolumeDiscountTier) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MinTxs != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinTxs)
+		if !f(fd_VolumeDiscountTier_min_txs, value) {
+			return
+		}
+	}
+	if x.Discount != "" {
+		value := protoreflect.ValueOfString(x.Discount)
+		if !f(fd_VolumeDiscountTier_discount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VolumeDiscountTier) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cheqd.did.v2.VolumeDiscountTier.min_txs":
+		return x.MinTxs != uint64(0)
+	case "cheqd.did.v2.VolumeDiscountTier.discount":
+		return x.Discount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.VolumeDiscountTier"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.VolumeDiscountTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VolumeDiscountTier) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.VolumeDiscountTier.min_txs":
+		x.MinTxs = uint64(0)
+	case "cheqd.did.v2.VolumeDiscountTier.discount":
+		x.Discount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.VolumeDiscountTier"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.VolumeDiscountTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VolumeDiscountTier) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cheqd.did.v2.VolumeDiscountTier.min_txs":
+		value := x.MinTxs
+		return protoreflect.ValueOfUint64(value)
+	case "cheqd.did.v2.VolumeDiscountTier.discount":
+		value := x.Discount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.VolumeDiscountTier"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.VolumeDiscountTier does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VolumeDiscountTier) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.VolumeDiscountTier.min_txs":
+		x.MinTxs = value.Uint()
+	case "cheqd.did.v2.VolumeDiscountTier.discount":
+		x.Discount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.VolumeDiscountTier"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.VolumeDiscountTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VolumeDiscountTier) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.VolumeDiscountTier.min_txs":
+		panic(fmt.Errorf("field min_txs of message cheqd.did.v2.VolumeDiscountTier is not mutable"))
+	case "cheqd.did.v2.VolumeDiscountTier.discount":
+		panic(fmt.Errorf("field discount of message cheqd.did.v2.VolumeDiscountTier is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.VolumeDiscountTier"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.VolumeDiscountTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VolumeDiscountTier) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.VolumeDiscountTier.min_txs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cheqd.did.v2.VolumeDiscountTier.discount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.VolumeDiscountTier"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.VolumeDiscountTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VolumeDiscountTier) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.VolumeDiscountTier", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VolumeDiscountTier) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VolumeDiscountTier) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VolumeDiscountTier) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VolumeDiscountTier) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VolumeDiscountTier)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.MinTxs != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinTxs))
+		}
+		l = len(x.Discount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VolumeDiscountTier)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Discount) > 0 {
+			i -= len(x.Discount)
+			copy(dAtA[i:], x.Discount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Discount)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.MinTxs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinTxs))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VolumeDiscountTier)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VolumeDiscountTier: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VolumeDiscountTier: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinTxs", wireType)
+				}
+				x.MinTxs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinTxs |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Discount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_IssuerDiscount          protoreflect.MessageDescriptor
+	fd_IssuerDiscount_address  protoreflect.FieldDescriptor
+	fd_IssuerDiscount_discount protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cheqd_did_v2_fee_proto_init()
+	md_IssuerDiscount = File_cheqd_did_v2_fee_proto.Messages().ByName("IssuerDiscount")
+	fd_IssuerDiscount_address = md_IssuerDiscount.Fields().ByName("address")
+	fd_IssuerDiscount_discount = md_IssuerDiscount.Fields().ByName("discount")
+}
+
+var _ protoreflect.Message = (*fastReflection_IssuerDiscount)(nil)
+
+type fastReflection_IssuerDiscount IssuerDiscount
+
+func (x *IssuerDiscount) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_IssuerDiscount)(x)
+}
+
+func (x *IssuerDiscount) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_fee_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_IssuerDiscount_messageType fastReflection_IssuerDiscount_messageType
+var _ protoreflect.MessageType = fastReflection_IssuerDiscount_messageType{}
+
+type fastReflection_IssuerDiscount_messageType struct{}
+
+func (x fastReflection_IssuerDiscount_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_IssuerDiscount)(nil)
+}
+func (x fastReflection_IssuerDiscount_messageType) New() protoreflect.Message {
+	return new(fastReflection_IssuerDiscount)
+}
+func (x fastReflection_IssuerDiscount_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_IssuerDiscount
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_IssuerDiscount) Descriptor() protoreflect.MessageDescriptor {
+	return md_IssuerDiscount
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_IssuerDiscount) Type() protoreflect.MessageType {
+	return _fastReflection_IssuerDiscount_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_IssuerDiscount) New() protoreflect.Message {
+	return new(fastReflection_IssuerDiscount)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_IssuerDiscount) Interface() protoreflect.ProtoMessage {
+	return (*IssuerDiscount)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_IssuerDiscount) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_IssuerDiscount_address, value) {
+			return
+		}
+	}
+	if x.Discount != "" {
+		value := protoreflect.ValueOfString(x.Discount)
+		if !f(fd_IssuerDiscount_discount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_IssuerDiscount) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cheqd.did.v2.IssuerDiscount.address":
+		return x.Address != ""
+	case "cheqd.did.v2.IssuerDiscount.discount":
+		return x.Discount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.IssuerDiscount"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.IssuerDiscount does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IssuerDiscount) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.IssuerDiscount.address":
+		x.Address = ""
+	case "cheqd.did.v2.IssuerDiscount.discount":
+		x.Discount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.IssuerDiscount"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.IssuerDiscount does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_IssuerDiscount) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cheqd.did.v2.IssuerDiscount.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.IssuerDiscount.discount":
+		value := x.Discount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.IssuerDiscount"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.IssuerDiscount does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IssuerDiscount) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.IssuerDiscount.address":
+		x.Address = value.Interface().(string)
+	case "cheqd.did.v2.IssuerDiscount.discount":
+		x.Discount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.IssuerDiscount"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.IssuerDiscount does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IssuerDiscount) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.IssuerDiscount.address":
+		panic(fmt.Errorf("field address of message cheqd.did.v2.IssuerDiscount is not mutable"))
+	case "cheqd.did.v2.IssuerDiscount.discount":
+		panic(fmt.Errorf("field discount of message cheqd.did.v2.IssuerDiscount is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.IssuerDiscount"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.IssuerDiscount does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_IssuerDiscount) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.IssuerDiscount.address":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.IssuerDiscount.discount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.IssuerDiscount"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.IssuerDiscount does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_IssuerDiscount) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.IssuerDiscount", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_IssuerDiscount) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IssuerDiscount) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_IssuerDiscount) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_IssuerDiscount) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*IssuerDiscount)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Discount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*IssuerDiscount)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Discount) > 0 {
+			i -= len(x.Discount)
+			copy(dAtA[i:], x.Discount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Discount)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*IssuerDiscount)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IssuerDiscount: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IssuerDiscount: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Discount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: cheqd/did/v2/fee.proto
+
 const (
 	// Verify that this generated code is sufficiently up-to-date.
 	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
@@ -1479,6 +3038,35 @@ type FeeParams struct {
 	//
 	// Default: 0.5 (50%)
 	BurnFactor string `protobuf:"bytes,4,opt,name=burn_factor,json=burnFactor,proto3" json:"burn_factor,omitempty"`
+	// Maximum number of DID Documents created by a single batch message.
+	// Zero disables batch creation.
+	//
+	// Default: 50
+	MaxBatchSize uint32 `protobuf:"varint,5,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
+	// Time intermediate versions of DID Documents are retained after being superseded by a newer version.
+	// Older intermediate versions are pruned, keeping the first, the latest and deactivation versions
+	// as well as a hash chain of the pruned versions in the tombstone of the DID Document.
+	// Zero disables pruning.
+	//
+	// Default: 0 (disabled)
+	VersionRetentionPeriod *durationpb.Duration `protobuf:"bytes,6,opt,name=version_retention_period,json=versionRetentionPeriod,proto3" json:"version_retention_period,omitempty"`
+	// URI schemes allowed in service endpoints of DID Documents, in lowercase.
+	// An empty list allows any scheme.
+	//
+	// Default: ["http", "https", "ws", "wss", "did"]
+	AllowedServiceEndpointSchemes []string `protobuf:"bytes,7,rep,name=allowed_service_endpoint_schemes,json=allowedServiceEndpointSchemes,proto3" json:"allowed_service_endpoint_schemes,omitempty"`
+	// Discount tiers for accounts paying identity fees, by the number of identity
+	// transactions they paid within the volume discount window.
+	// The largest discount of the tiers reached applies.
+	VolumeDiscountTiers []*VolumeDiscountTier `protobuf:"bytes,8,rep,name=volume_discount_tiers,json=volumeDiscountTiers,proto3" json:"volume_discount_tiers,omitempty"`
+	// Discounts for allow-listed issuer accounts.
+	// The larger of the issuer discount and the volume discount of an account applies.
+	IssuerDiscounts []*IssuerDiscount `protobuf:"bytes,9,rep,name=issuer_discounts,json=issuerDiscounts,proto3" json:"issuer_discounts,omitempty"`
+	// Window in which identity transactions are counted for volume discounts.
+	// Zero disables volume discounts.
+	//
+	// Default: 720h (30 days)
+	VolumeDiscountWindow *durationpb.Duration `protobuf:"bytes,10,opt,name=volume_discount_window,json=volumeDiscountWindow,proto3" json:"volume_discount_window,omitempty"`
 }
 
 func (x *FeeParams) Reset() {
@@ -1529,6 +3117,141 @@ func (x *FeeParams) GetBurnFactor() string {
 	return ""
 }
 
+func (x *FeeParams) GetMaxBatchSize() uint32 {
+	if x != nil {
+		return x.MaxBatchSize
+	}
+	return 0
+}
+
+func (x *FeeParams) GetVersionRetentionPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.VersionRetentionPeriod
+	}
+	return nil
+}
+
+func (x *FeeParams) GetAllowedServiceEndpointSchemes() []string {
+	if x != nil {
+		return x.AllowedServiceEndpointSchemes
+	}
+	return nil
+}
+
+func (x *FeeParams) GetVolumeDiscountTiers() []*VolumeDiscountTier {
+	if x != nil {
+		return x.VolumeDiscountTiers
+	}
+	return nil
+}
+
+func (x *FeeParams) GetIssuerDiscounts() []*IssuerDiscount {
+	if x != nil {
+		return x.IssuerDiscounts
+	}
+	return nil
+}
+
+func (x *FeeParams) GetVolumeDiscountWindow() *durationpb.Duration {
+	if x != nil {
+		return x.VolumeDiscountWindow
+	}
+	return nil
+}
+
+// VolumeDiscountTier is a discount on identity fees for accounts with a minimum
+// number of identity transactions within the volume discount window
+type VolumeDiscountTier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Minimum number of identity transactions paid within the window
+	MinTxs uint64 `protobuf:"varint,1,opt,name=min_txs,json=minTxs,proto3" json:"min_txs,omitempty"`
+	// Fraction of the identity fee waived, positive and < 1
+	Discount string `protobuf:"bytes,2,opt,name=discount,proto3" json:"discount,omitempty"`
+}
+
+func (x *VolumeDiscountTier) Reset() {
+	*x = VolumeDiscountTier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cheqd_did_v2_fee_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VolumeDiscountTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeDiscountTier) ProtoMessage() {}
+
+// Deprecated: Use VolumeDiscountTier.ProtoReflect.Descriptor instead.
+func (*VolumeDiscountTier) Descriptor() ([]byte, []int) {
+	return file_cheqd_did_v2_fee_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *VolumeDiscountTier) GetMinTxs() uint64 {
+	if x != nil {
+		return x.MinTxs
+	}
+	return 0
+}
+
+func (x *VolumeDiscountTier) GetDiscount() string {
+	if x != nil {
+		return x.Discount
+	}
+	return ""
+}
+
+// IssuerDiscount is a discount on identity fees for an allow-listed issuer account
+type IssuerDiscount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Bech32 address of the account paying the identity fees
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Fraction of the identity fee waived, positive and < 1
+	Discount string `protobuf:"bytes,2,opt,name=discount,proto3" json:"discount,omitempty"`
+}
+
+func (x *IssuerDiscount) Reset() {
+	*x = IssuerDiscount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cheqd_did_v2_fee_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssuerDiscount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssuerDiscount) ProtoMessage() {}
+
+// Deprecated: Use IssuerDiscount.ProtoReflect.Descriptor instead.
+func (*IssuerDiscount) Descriptor() ([]byte, []int) {
+	return file_cheqd_did_v2_fee_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *IssuerDiscount) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *IssuerDiscount) GetDiscount() string {
+	if x != nil {
+		return x.Discount
+	}
+	return ""
+}
+
 var File_cheqd_did_v2_fee_proto protoreflect.FileDescriptor
 
 var file_cheqd_did_v2_fee_proto_rawDesc = []byte{
@@ -1538,7 +3261,9 @@ var file_cheqd_did_v2_fee_proto_rawDesc = []byte{
 	0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9c, 0x01, 0x0a, 0x08, 0x46,
+	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9c, 0x01, 0x0a, 0x08, 0x46,
 	0x65, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x3c, 0x0a,
 	0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
@@ -1548,7 +3273,7 @@ var file_cheqd_did_v2_fee_proto_rawDesc = []byte{
 	0x61, 0x78, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
 	0x1d, 0xc8, 0xde, 0x1f, 0x01, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
 	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x09,
-	0x6d, 0x61, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa3, 0x02, 0x0a, 0x09, 0x46, 0x65,
+	0x6d, 0x61, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf7, 0x05, 0x0a, 0x09, 0x46, 0x65,
 	0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x3b, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
 	0x65, 0x5f, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68,
 	0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x61,
@@ -1566,19 +3291,66 @@ var file_cheqd_did_v2_fee_proto_rawDesc = []byte{
 	0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
 	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
 	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7,
-	0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x62, 0x75, 0x72, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x42,
-	0xa9, 0x01, 0xa8, 0xe2, 0x1e, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x68, 0x65, 0x71,
-	0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x42, 0x08, 0x46, 0x65, 0x65, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2f, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2d, 0x6e, 0x6f, 0x64,
-	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2f, 0x64,
-	0x69, 0x64, 0x2f, 0x76, 0x32, 0x3b, 0x64, 0x69, 0x64, 0x76, 0x32, 0xa2, 0x02, 0x03, 0x43, 0x44,
-	0x58, 0xaa, 0x02, 0x0c, 0x43, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x44, 0x69, 0x64, 0x2e, 0x56, 0x32,
-	0xca, 0x02, 0x0c, 0x43, 0x68, 0x65, 0x71, 0x64, 0x5c, 0x44, 0x69, 0x64, 0x5c, 0x56, 0x32, 0xe2,
-	0x02, 0x18, 0x43, 0x68, 0x65, 0x71, 0x64, 0x5c, 0x44, 0x69, 0x64, 0x5c, 0x56, 0x32, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x43, 0x68, 0x65,
-	0x71, 0x64, 0x3a, 0x3a, 0x44, 0x69, 0x64, 0x3a, 0x3a, 0x56, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x62, 0x75, 0x72, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12,
+	0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x5d, 0x0a, 0x18, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x16, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x12, 0x47, 0x0a, 0x20, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x1d,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x12, 0x5a, 0x0a,
+	0x15, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x74, 0x69, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63,
+	0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x69, 0x65, 0x72, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x44, 0x69, 0x73, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x54, 0x69, 0x65, 0x72, 0x73, 0x12, 0x4d, 0x0a, 0x10, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e,
+	0x76, 0x32, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x44,
+	0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x59, 0x0a, 0x16, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x14, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x22, 0x81, 0x01, 0x0a, 0x12, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x44, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x69, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x69,
+	0x6e, 0x5f, 0x74, 0x78, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6d, 0x69, 0x6e,
+	0x54, 0x78, 0x73, 0x12, 0x52, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08, 0x64,
+	0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x98, 0x01, 0x0a, 0x0e, 0x49, 0x73, 0x73, 0x75,
+	0x65, 0x72, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x52,
+	0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x42, 0xa9, 0x01, 0xa8, 0xe2, 0x1e, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x63,
+	0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x42, 0x08, 0x46, 0x65, 0x65,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2f, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2d,
+	0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x63, 0x68, 0x65, 0x71,
+	0x64, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76, 0x32, 0x3b, 0x64, 0x69, 0x64, 0x76, 0x32, 0xa2, 0x02,
+	0x03, 0x43, 0x44, 0x58, 0xaa, 0x02, 0x0c, 0x43, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x44, 0x69, 0x64,
+	0x2e, 0x56, 0x32, 0xca, 0x02, 0x0c, 0x43, 0x68, 0x65, 0x71, 0x64, 0x5c, 0x44, 0x69, 0x64, 0x5c,
+	0x56, 0x32, 0xe2, 0x02, 0x18, 0x43, 0x68, 0x65, 0x71, 0x64, 0x5c, 0x44, 0x69, 0x64, 0x5c, 0x56,
+	0x32, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e,
+	0x43, 0x68, 0x65, 0x71, 0x64, 0x3a, 0x3a, 0x44, 0x69, 0x64, 0x3a, 0x3a, 0x56, 0x32, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1593,20 +3365,27 @@ func file_cheqd_did_v2_fee_proto_rawDescGZIP() []byte {
 	return file_cheqd_did_v2_fee_proto_rawDescData
 }
 
-var file_cheqd_did_v2_fee_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cheqd_did_v2_fee_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_cheqd_did_v2_fee_proto_goTypes = []interface{}{
-	(*FeeRange)(nil),  // 0: cheqd.did.v2.FeeRange
-	(*FeeParams)(nil), // 1: cheqd.did.v2.FeeParams
+	(*FeeRange)(nil),            // 0: cheqd.did.v2.FeeRange
+	(*FeeParams)(nil),           // 1: cheqd.did.v2.FeeParams
+	(*VolumeDiscountTier)(nil),  // 2: cheqd.did.v2.VolumeDiscountTier
+	(*IssuerDiscount)(nil),      // 3: cheqd.did.v2.IssuerDiscount
+	(*durationpb.Duration)(nil), // 4: google.protobuf.Duration
 }
 var file_cheqd_did_v2_fee_proto_depIdxs = []int32{
 	0, // 0: cheqd.did.v2.FeeParams.create_did:type_name -> cheqd.did.v2.FeeRange
 	0, // 1: cheqd.did.v2.FeeParams.update_did:type_name -> cheqd.did.v2.FeeRange
 	0, // 2: cheqd.did.v2.FeeParams.deactivate_did:type_name -> cheqd.did.v2.FeeRange
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 3: cheqd.did.v2.FeeParams.version_retention_period:type_name -> google.protobuf.Duration
+	2, // 4: cheqd.did.v2.FeeParams.volume_discount_tiers:type_name -> cheqd.did.v2.VolumeDiscountTier
+	3, // 5: cheqd.did.v2.FeeParams.issuer_discounts:type_name -> cheqd.did.v2.IssuerDiscount
+	4, // 6: cheqd.did.v2.FeeParams.volume_discount_window:type_name -> google.protobuf.Duration
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_cheqd_did_v2_fee_proto_init() }
@@ -1639,6 +3418,30 @@ func file_cheqd_did_v2_fee_proto_init() {
 				return nil
 			}
 		}
+		file_cheqd_did_v2_fee_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VolumeDiscountTier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cheqd_did_v2_fee_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssuerDiscount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1646,7 +3449,7 @@ func file_cheqd_did_v2_fee_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cheqd_did_v2_fee_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
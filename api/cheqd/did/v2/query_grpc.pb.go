@@ -22,6 +22,18 @@ const (
 	Query_DidDoc_FullMethodName                    = "/cheqd.did.v2.Query/DidDoc"
 	Query_DidDocVersion_FullMethodName             = "/cheqd.did.v2.Query/DidDocVersion"
 	Query_AllDidDocVersionsMetadata_FullMethodName = "/cheqd.did.v2.Query/AllDidDocVersionsMetadata"
+	Query_DidDocAtTime_FullMethodName              = "/cheqd.did.v2.Query/DidDocAtTime"
+	Query_Tombstone_FullMethodName                 = "/cheqd.did.v2.Query/Tombstone"
+	Query_ResolveDid_FullMethodName                = "/cheqd.did.v2.Query/ResolveDid"
+	Query_DereferenceDidUrl_FullMethodName         = "/cheqd.did.v2.Query/DereferenceDidUrl"
+	Query_DidsByController_FullMethodName          = "/cheqd.did.v2.Query/DidsByController"
+	Query_DidsByKey_FullMethodName                 = "/cheqd.did.v2.Query/DidsByKey"
+	Query_DidsByAlias_FullMethodName               = "/cheqd.did.v2.Query/DidsByAlias"
+	Query_AllDids_FullMethodName                   = "/cheqd.did.v2.Query/AllDids"
+	Query_Stats_FullMethodName                     = "/cheqd.did.v2.Query/Stats"
+	Query_EstimateIdentityFee_FullMethodName       = "/cheqd.did.v2.Query/EstimateIdentityFee"
+	Query_IdentityCredits_FullMethodName           = "/cheqd.did.v2.Query/IdentityCredits"
+	Query_AllIdentityCredits_FullMethodName        = "/cheqd.did.v2.Query/AllIdentityCredits"
 	Query_Params_FullMethodName                    = "/cheqd.did.v2.Query/Params"
 )
 
@@ -37,6 +49,30 @@ type QueryClient interface {
 	DidDocVersion(ctx context.Context, in *QueryDidDocVersionRequest, opts ...grpc.CallOption) (*QueryDidDocVersionResponse, error)
 	// Fetch list of all versions of DID Documents for a given DID
 	AllDidDocVersionsMetadata(ctx context.Context, in *QueryAllDidDocVersionsMetadataRequest, opts ...grpc.CallOption) (*QueryAllDidDocVersionsMetadataResponse, error)
+	// Fetch the version of a DID Document that was active at a given time
+	DidDocAtTime(ctx context.Context, in *QueryDidDocAtTimeRequest, opts ...grpc.CallOption) (*QueryDidDocAtTimeResponse, error)
+	// Fetch the hash chain of pruned versions of a DID Document
+	Tombstone(ctx context.Context, in *QueryTombstoneRequest, opts ...grpc.CallOption) (*QueryTombstoneResponse, error)
+	// Resolve a DID into a W3C DID Resolution result
+	ResolveDid(ctx context.Context, in *QueryResolveDidRequest, opts ...grpc.CallOption) (*QueryResolveDidResponse, error)
+	// Dereference a DID URL into a verification method, service, DID Document or DID-Linked Resource
+	DereferenceDidUrl(ctx context.Context, in *QueryDereferenceDidUrlRequest, opts ...grpc.CallOption) (*QueryDereferenceDidUrlResponse, error)
+	// List DIDs whose DID Documents list a given DID as controller
+	DidsByController(ctx context.Context, in *QueryDidsByControllerRequest, opts ...grpc.CallOption) (*QueryDidsByControllerResponse, error)
+	// List verification methods of DID Documents that use a given public key
+	DidsByKey(ctx context.Context, in *QueryDidsByKeyRequest, opts ...grpc.CallOption) (*QueryDidsByKeyResponse, error)
+	// List DIDs whose DID Documents list a given alsoKnownAs alias
+	DidsByAlias(ctx context.Context, in *QueryDidsByAliasRequest, opts ...grpc.CallOption) (*QueryDidsByAliasResponse, error)
+	// List the latest versions of all DID Documents
+	AllDids(ctx context.Context, in *QueryAllDidsRequest, opts ...grpc.CallOption) (*QueryAllDidsResponse, error)
+	// Fetch the numbers of DIDs, DID Document versions and deactivated DIDs
+	Stats(ctx context.Context, in *QueryStatsRequest, opts ...grpc.CallOption) (*QueryStatsResponse, error)
+	// Estimate the fee charged for the identity messages of a transaction in a given fee denom
+	EstimateIdentityFee(ctx context.Context, in *QueryEstimateIdentityFeeRequest, opts ...grpc.CallOption) (*QueryEstimateIdentityFeeResponse, error)
+	// Fetch the prepaid identity credits, volume and fee discount of an account
+	IdentityCredits(ctx context.Context, in *QueryIdentityCreditsRequest, opts ...grpc.CallOption) (*QueryIdentityCreditsResponse, error)
+	// Fetch the identity credit accounts
+	AllIdentityCredits(ctx context.Context, in *QueryAllIdentityCreditsRequest, opts ...grpc.CallOption) (*QueryAllIdentityCreditsResponse, error)
 	// Params queries params of the did module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -79,6 +115,126 @@ func (c *queryClient) AllDidDocVersionsMetadata(ctx context.Context, in *QueryAl
 	return out, nil
 }
 
+func (c *queryClient) DidDocAtTime(ctx context.Context, in *QueryDidDocAtTimeRequest, opts ...grpc.CallOption) (*QueryDidDocAtTimeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDidDocAtTimeResponse)
+	err := c.cc.Invoke(ctx, Query_DidDocAtTime_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Tombstone(ctx context.Context, in *QueryTombstoneRequest, opts ...grpc.CallOption) (*QueryTombstoneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTombstoneResponse)
+	err := c.cc.Invoke(ctx, Query_Tombstone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResolveDid(ctx context.Context, in *QueryResolveDidRequest, opts ...grpc.CallOption) (*QueryResolveDidResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryResolveDidResponse)
+	err := c.cc.Invoke(ctx, Query_ResolveDid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DereferenceDidUrl(ctx context.Context, in *QueryDereferenceDidUrlRequest, opts ...grpc.CallOption) (*QueryDereferenceDidUrlResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDereferenceDidUrlResponse)
+	err := c.cc.Invoke(ctx, Query_DereferenceDidUrl_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DidsByController(ctx context.Context, in *QueryDidsByControllerRequest, opts ...grpc.CallOption) (*QueryDidsByControllerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDidsByControllerResponse)
+	err := c.cc.Invoke(ctx, Query_DidsByController_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DidsByKey(ctx context.Context, in *QueryDidsByKeyRequest, opts ...grpc.CallOption) (*QueryDidsByKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDidsByKeyResponse)
+	err := c.cc.Invoke(ctx, Query_DidsByKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DidsByAlias(ctx context.Context, in *QueryDidsByAliasRequest, opts ...grpc.CallOption) (*QueryDidsByAliasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDidsByAliasResponse)
+	err := c.cc.Invoke(ctx, Query_DidsByAlias_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllDids(ctx context.Context, in *QueryAllDidsRequest, opts ...grpc.CallOption) (*QueryAllDidsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAllDidsResponse)
+	err := c.cc.Invoke(ctx, Query_AllDids_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Stats(ctx context.Context, in *QueryStatsRequest, opts ...grpc.CallOption) (*QueryStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryStatsResponse)
+	err := c.cc.Invoke(ctx, Query_Stats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateIdentityFee(ctx context.Context, in *QueryEstimateIdentityFeeRequest, opts ...grpc.CallOption) (*QueryEstimateIdentityFeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEstimateIdentityFeeResponse)
+	err := c.cc.Invoke(ctx, Query_EstimateIdentityFee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IdentityCredits(ctx context.Context, in *QueryIdentityCreditsRequest, opts ...grpc.CallOption) (*QueryIdentityCreditsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryIdentityCreditsResponse)
+	err := c.cc.Invoke(ctx, Query_IdentityCredits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllIdentityCredits(ctx context.Context, in *QueryAllIdentityCreditsRequest, opts ...grpc.CallOption) (*QueryAllIdentityCreditsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAllIdentityCreditsResponse)
+	err := c.cc.Invoke(ctx, Query_AllIdentityCredits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryParamsResponse)
@@ -101,6 +257,30 @@ type QueryServer interface {
 	DidDocVersion(context.Context, *QueryDidDocVersionRequest) (*QueryDidDocVersionResponse, error)
 	// Fetch list of all versions of DID Documents for a given DID
 	AllDidDocVersionsMetadata(context.Context, *QueryAllDidDocVersionsMetadataRequest) (*QueryAllDidDocVersionsMetadataResponse, error)
+	// Fetch the version of a DID Document that was active at a given time
+	DidDocAtTime(context.Context, *QueryDidDocAtTimeRequest) (*QueryDidDocAtTimeResponse, error)
+	// Fetch the hash chain of pruned versions of a DID Document
+	Tombstone(context.Context, *QueryTombstoneRequest) (*QueryTombstoneResponse, error)
+	// Resolve a DID into a W3C DID Resolution result
+	ResolveDid(context.Context, *QueryResolveDidRequest) (*QueryResolveDidResponse, error)
+	// Dereference a DID URL into a verification method, service, DID Document or DID-Linked Resource
+	DereferenceDidUrl(context.Context, *QueryDereferenceDidUrlRequest) (*QueryDereferenceDidUrlResponse, error)
+	// List DIDs whose DID Documents list a given DID as controller
+	DidsByController(context.Context, *QueryDidsByControllerRequest) (*QueryDidsByControllerResponse, error)
+	// List verification methods of DID Documents that use a given public key
+	DidsByKey(context.Context, *QueryDidsByKeyRequest) (*QueryDidsByKeyResponse, error)
+	// List DIDs whose DID Documents list a given alsoKnownAs alias
+	DidsByAlias(context.Context, *QueryDidsByAliasRequest) (*QueryDidsByAliasResponse, error)
+	// List the latest versions of all DID Documents
+	AllDids(context.Context, *QueryAllDidsRequest) (*QueryAllDidsResponse, error)
+	// Fetch the numbers of DIDs, DID Document versions and deactivated DIDs
+	Stats(context.Context, *QueryStatsRequest) (*QueryStatsResponse, error)
+	// Estimate the fee charged for the identity messages of a transaction in a given fee denom
+	EstimateIdentityFee(context.Context, *QueryEstimateIdentityFeeRequest) (*QueryEstimateIdentityFeeResponse, error)
+	// Fetch the prepaid identity credits, volume and fee discount of an account
+	IdentityCredits(context.Context, *QueryIdentityCreditsRequest) (*QueryIdentityCreditsResponse, error)
+	// Fetch the identity credit accounts
+	AllIdentityCredits(context.Context, *QueryAllIdentityCreditsRequest) (*QueryAllIdentityCreditsResponse, error)
 	// Params queries params of the did module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	mustEmbedUnimplementedQueryServer()
@@ -122,6 +302,42 @@ func (UnimplementedQueryServer) DidDocVersion(context.Context, *QueryDidDocVersi
 func (UnimplementedQueryServer) AllDidDocVersionsMetadata(context.Context, *QueryAllDidDocVersionsMetadataRequest) (*QueryAllDidDocVersionsMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllDidDocVersionsMetadata not implemented")
 }
+func (UnimplementedQueryServer) DidDocAtTime(context.Context, *QueryDidDocAtTimeRequest) (*QueryDidDocAtTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DidDocAtTime not implemented")
+}
+func (UnimplementedQueryServer) Tombstone(context.Context, *QueryTombstoneRequest) (*QueryTombstoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tombstone not implemented")
+}
+func (UnimplementedQueryServer) ResolveDid(context.Context, *QueryResolveDidRequest) (*QueryResolveDidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveDid not implemented")
+}
+func (UnimplementedQueryServer) DereferenceDidUrl(context.Context, *QueryDereferenceDidUrlRequest) (*QueryDereferenceDidUrlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DereferenceDidUrl not implemented")
+}
+func (UnimplementedQueryServer) DidsByController(context.Context, *QueryDidsByControllerRequest) (*QueryDidsByControllerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DidsByController not implemented")
+}
+func (UnimplementedQueryServer) DidsByKey(context.Context, *QueryDidsByKeyRequest) (*QueryDidsByKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DidsByKey not implemented")
+}
+func (UnimplementedQueryServer) DidsByAlias(context.Context, *QueryDidsByAliasRequest) (*QueryDidsByAliasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DidsByAlias not implemented")
+}
+func (UnimplementedQueryServer) AllDids(context.Context, *QueryAllDidsRequest) (*QueryAllDidsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllDids not implemented")
+}
+func (UnimplementedQueryServer) Stats(context.Context, *QueryStatsRequest) (*QueryStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+}
+func (UnimplementedQueryServer) EstimateIdentityFee(context.Context, *QueryEstimateIdentityFeeRequest) (*QueryEstimateIdentityFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateIdentityFee not implemented")
+}
+func (UnimplementedQueryServer) IdentityCredits(context.Context, *QueryIdentityCreditsRequest) (*QueryIdentityCreditsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IdentityCredits not implemented")
+}
+func (UnimplementedQueryServer) AllIdentityCredits(context.Context, *QueryAllIdentityCreditsRequest) (*QueryAllIdentityCreditsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllIdentityCredits not implemented")
+}
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -200,6 +416,222 @@ func _Query_AllDidDocVersionsMetadata_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DidDocAtTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDidDocAtTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DidDocAtTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DidDocAtTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DidDocAtTime(ctx, req.(*QueryDidDocAtTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Tombstone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTombstoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Tombstone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Tombstone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Tombstone(ctx, req.(*QueryTombstoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResolveDid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResolveDidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResolveDid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ResolveDid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResolveDid(ctx, req.(*QueryResolveDidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DereferenceDidUrl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDereferenceDidUrlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DereferenceDidUrl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DereferenceDidUrl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DereferenceDidUrl(ctx, req.(*QueryDereferenceDidUrlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DidsByController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDidsByControllerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DidsByController(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DidsByController_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DidsByController(ctx, req.(*QueryDidsByControllerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DidsByKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDidsByKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DidsByKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DidsByKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DidsByKey(ctx, req.(*QueryDidsByKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DidsByAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDidsByAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DidsByAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DidsByAlias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DidsByAlias(ctx, req.(*QueryDidsByAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllDids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllDidsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllDids(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AllDids_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllDids(ctx, req.(*QueryAllDidsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Stats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Stats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Stats(ctx, req.(*QueryStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateIdentityFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateIdentityFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateIdentityFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EstimateIdentityFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateIdentityFee(ctx, req.(*QueryEstimateIdentityFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IdentityCredits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIdentityCreditsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IdentityCredits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_IdentityCredits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IdentityCredits(ctx, req.(*QueryIdentityCreditsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllIdentityCredits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllIdentityCreditsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllIdentityCredits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AllIdentityCredits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllIdentityCredits(ctx, req.(*QueryAllIdentityCreditsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -237,6 +669,54 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllDidDocVersionsMetadata",
 			Handler:    _Query_AllDidDocVersionsMetadata_Handler,
 		},
+		{
+			MethodName: "DidDocAtTime",
+			Handler:    _Query_DidDocAtTime_Handler,
+		},
+		{
+			MethodName: "Tombstone",
+			Handler:    _Query_Tombstone_Handler,
+		},
+		{
+			MethodName: "ResolveDid",
+			Handler:    _Query_ResolveDid_Handler,
+		},
+		{
+			MethodName: "DereferenceDidUrl",
+			Handler:    _Query_DereferenceDidUrl_Handler,
+		},
+		{
+			MethodName: "DidsByController",
+			Handler:    _Query_DidsByController_Handler,
+		},
+		{
+			MethodName: "DidsByKey",
+			Handler:    _Query_DidsByKey_Handler,
+		},
+		{
+			MethodName: "DidsByAlias",
+			Handler:    _Query_DidsByAlias_Handler,
+		},
+		{
+			MethodName: "AllDids",
+			Handler:    _Query_AllDids_Handler,
+		},
+		{
+			MethodName: "Stats",
+			Handler:    _Query_Stats_Handler,
+		},
+		{
+			MethodName: "EstimateIdentityFee",
+			Handler:    _Query_EstimateIdentityFee_Handler,
+		},
+		{
+			MethodName: "IdentityCredits",
+			Handler:    _Query_IdentityCredits_Handler,
+		},
+		{
+			MethodName: "AllIdentityCredits",
+			Handler:    _Query_AllIdentityCredits_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -1740,6 +1740,7 @@ var (
 	md_SignInfo                        protoreflect.MessageDescriptor
 	fd_SignInfo_verification_method_id protoreflect.FieldDescriptor
 	fd_SignInfo_signature              protoreflect.FieldDescriptor
+	fd_SignInfo_version_id             protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1747,6 +1748,7 @@ func init() {
 	md_SignInfo = File_cheqd_did_v2_tx_proto.Messages().ByName("SignInfo")
 	fd_SignInfo_verification_method_id = md_SignInfo.Fields().ByName("verification_method_id")
 	fd_SignInfo_signature = md_SignInfo.Fields().ByName("signature")
+	fd_SignInfo_version_id = md_SignInfo.Fields().ByName("version_id")
 }
 
 var _ protoreflect.Message = (*fastReflection_SignInfo)(nil)
@@ -1826,6 +1828,12 @@ func (x *fastReflection_SignInfo) Range(f func(protoreflect.FieldDescriptor, pro
 			return
 		}
 	}
+	if x.VersionId != "" {
+		value := protoreflect.ValueOfString(x.VersionId)
+		if !f(fd_SignInfo_version_id, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1845,6 +1853,8 @@ func (x *fastReflection_SignInfo) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.VerificationMethodId != ""
 	case "cheqd.did.v2.SignInfo.signature":
 		return len(x.Signature) != 0
+	case "cheqd.did.v2.SignInfo.version_id":
+		return x.VersionId != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.SignInfo"))
@@ -1865,6 +1875,8 @@ func (x *fastReflection_SignInfo) Clear(fd protoreflect.FieldDescriptor) {
 		x.VerificationMethodId = ""
 	case "cheqd.did.v2.SignInfo.signature":
 		x.Signature = nil
+	case "cheqd.did.v2.SignInfo.version_id":
+		x.VersionId = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.SignInfo"))
@@ -1887,6 +1899,9 @@ func (x *fastReflection_SignInfo) Get(descriptor protoreflect.FieldDescriptor) p
 	case "cheqd.did.v2.SignInfo.signature":
 		value := x.Signature
 		return protoreflect.ValueOfBytes(value)
+	case "cheqd.did.v2.SignInfo.version_id":
+		value := x.VersionId
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.SignInfo"))
@@ -1911,6 +1926,8 @@ func (x *fastReflection_SignInfo) Set(fd protoreflect.FieldDescriptor, value pro
 		x.VerificationMethodId = value.Interface().(string)
 	case "cheqd.did.v2.SignInfo.signature":
 		x.Signature = value.Bytes()
+	case "cheqd.did.v2.SignInfo.version_id":
+		x.VersionId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.SignInfo"))
@@ -1935,6 +1952,8 @@ func (x *fastReflection_SignInfo) Mutable(fd protoreflect.FieldDescriptor) proto
 		panic(fmt.Errorf("field verification_method_id of message cheqd.did.v2.SignInfo is not mutable"))
 	case "cheqd.did.v2.SignInfo.signature":
 		panic(fmt.Errorf("field signature of message cheqd.did.v2.SignInfo is not mutable"))
+	case "cheqd.did.v2.SignInfo.version_id":
+		panic(fmt.Errorf("field version_id of message cheqd.did.v2.SignInfo is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.SignInfo"))
@@ -1952,6 +1971,8 @@ func (x *fastReflection_SignInfo) NewField(fd protoreflect.FieldDescriptor) prot
 		return protoreflect.ValueOfString("")
 	case "cheqd.did.v2.SignInfo.signature":
 		return protoreflect.ValueOfBytes(nil)
+	case "cheqd.did.v2.SignInfo.version_id":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.SignInfo"))
@@ -2029,6 +2050,10 @@ func (x *fastReflection_SignInfo) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.VersionId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2058,6 +2083,13 @@ func (x *fastReflection_SignInfo) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.VersionId) > 0 {
+			i -= len(x.VersionId)
+			copy(dAtA[i:], x.VersionId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VersionId)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if len(x.Signature) > 0 {
 			i -= len(x.Signature)
 			copy(dAtA[i:], x.Signature)
@@ -2187,6 +2219,38 @@ func (x *fastReflection_SignInfo) ProtoMethods() *protoiface.Methods {
 					x.Signature = []byte{}
 				}
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VersionId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2706,6 +2770,8 @@ var (
 	fd_MsgCreateDidDocPayload_service               protoreflect.FieldDescriptor
 	fd_MsgCreateDidDocPayload_also_known_as         protoreflect.FieldDescriptor
 	fd_MsgCreateDidDocPayload_version_id            protoreflect.FieldDescriptor
+	fd_MsgCreateDidDocPayload_recovery_commitment   protoreflect.FieldDescriptor
+	fd_MsgCreateDidDocPayload_controller_threshold  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -2723,6 +2789,8 @@ func init() {
 	fd_MsgCreateDidDocPayload_service = md_MsgCreateDidDocPayload.Fields().ByName("service")
 	fd_MsgCreateDidDocPayload_also_known_as = md_MsgCreateDidDocPayload.Fields().ByName("also_known_as")
 	fd_MsgCreateDidDocPayload_version_id = md_MsgCreateDidDocPayload.Fields().ByName("version_id")
+	fd_MsgCreateDidDocPayload_recovery_commitment = md_MsgCreateDidDocPayload.Fields().ByName("recovery_commitment")
+	fd_MsgCreateDidDocPayload_controller_threshold = md_MsgCreateDidDocPayload.Fields().ByName("controller_threshold")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgCreateDidDocPayload)(nil)
@@ -2862,6 +2930,18 @@ func (x *fastReflection_MsgCreateDidDocPayload) Range(f func(protoreflect.FieldD
 			return
 		}
 	}
+	if x.RecoveryCommitment != "" {
+		value := protoreflect.ValueOfString(x.RecoveryCommitment)
+		if !f(fd_MsgCreateDidDocPayload_recovery_commitment, value) {
+			return
+		}
+	}
+	if x.ControllerThreshold != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ControllerThreshold)
+		if !f(fd_MsgCreateDidDocPayload_controller_threshold, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2901,6 +2981,10 @@ func (x *fastReflection_MsgCreateDidDocPayload) Has(fd protoreflect.FieldDescrip
 		return len(x.AlsoKnownAs) != 0
 	case "cheqd.did.v2.MsgCreateDidDocPayload.version_id":
 		return x.VersionId != ""
+	case "cheqd.did.v2.MsgCreateDidDocPayload.recovery_commitment":
+		return x.RecoveryCommitment != ""
+	case "cheqd.did.v2.MsgCreateDidDocPayload.controller_threshold":
+		return x.ControllerThreshold != uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgCreateDidDocPayload"))
@@ -2941,6 +3025,10 @@ func (x *fastReflection_MsgCreateDidDocPayload) Clear(fd protoreflect.FieldDescr
 		x.AlsoKnownAs = nil
 	case "cheqd.did.v2.MsgCreateDidDocPayload.version_id":
 		x.VersionId = ""
+	case "cheqd.did.v2.MsgCreateDidDocPayload.recovery_commitment":
+		x.RecoveryCommitment = ""
+	case "cheqd.did.v2.MsgCreateDidDocPayload.controller_threshold":
+		x.ControllerThreshold = uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgCreateDidDocPayload"))
@@ -3023,6 +3111,12 @@ func (x *fastReflection_MsgCreateDidDocPayload) Get(descriptor protoreflect.Fiel
 	case "cheqd.did.v2.MsgCreateDidDocPayload.version_id":
 		value := x.VersionId
 		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.MsgCreateDidDocPayload.recovery_commitment":
+		value := x.RecoveryCommitment
+		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.MsgCreateDidDocPayload.controller_threshold":
+		value := x.ControllerThreshold
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgCreateDidDocPayload"))
@@ -3087,6 +3181,10 @@ func (x *fastReflection_MsgCreateDidDocPayload) Set(fd protoreflect.FieldDescrip
 		x.AlsoKnownAs = *clv.list
 	case "cheqd.did.v2.MsgCreateDidDocPayload.version_id":
 		x.VersionId = value.Interface().(string)
+	case "cheqd.did.v2.MsgCreateDidDocPayload.recovery_commitment":
+		x.RecoveryCommitment = value.Interface().(string)
+	case "cheqd.did.v2.MsgCreateDidDocPayload.controller_threshold":
+		x.ControllerThreshold = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgCreateDidDocPayload"))
@@ -3171,6 +3269,10 @@ func (x *fastReflection_MsgCreateDidDocPayload) Mutable(fd protoreflect.FieldDes
 		panic(fmt.Errorf("field id of message cheqd.did.v2.MsgCreateDidDocPayload is not mutable"))
 	case "cheqd.did.v2.MsgCreateDidDocPayload.version_id":
 		panic(fmt.Errorf("field version_id of message cheqd.did.v2.MsgCreateDidDocPayload is not mutable"))
+	case "cheqd.did.v2.MsgCreateDidDocPayload.recovery_commitment":
+		panic(fmt.Errorf("field recovery_commitment of message cheqd.did.v2.MsgCreateDidDocPayload is not mutable"))
+	case "cheqd.did.v2.MsgCreateDidDocPayload.controller_threshold":
+		panic(fmt.Errorf("field controller_threshold of message cheqd.did.v2.MsgCreateDidDocPayload is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgCreateDidDocPayload"))
@@ -3218,6 +3320,10 @@ func (x *fastReflection_MsgCreateDidDocPayload) NewField(fd protoreflect.FieldDe
 		return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_11_list{list: &list})
 	case "cheqd.did.v2.MsgCreateDidDocPayload.version_id":
 		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.MsgCreateDidDocPayload.recovery_commitment":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.MsgCreateDidDocPayload.controller_threshold":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgCreateDidDocPayload"))
@@ -3355,6 +3461,13 @@ func (x *fastReflection_MsgCreateDidDocPayload) ProtoMethods() *protoiface.Metho
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.RecoveryCommitment)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ControllerThreshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.ControllerThreshold))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3384,6 +3497,18 @@ func (x *fastReflection_MsgCreateDidDocPayload) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.ControllerThreshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ControllerThreshold))
+			i--
+			dAtA[i] = 0x70
+		}
+		if len(x.RecoveryCommitment) > 0 {
+			i -= len(x.RecoveryCommitment)
+			copy(dAtA[i:], x.RecoveryCommitment)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecoveryCommitment)))
+			i--
+			dAtA[i] = 0x6a
+		}
 		if len(x.VersionId) > 0 {
 			i -= len(x.VersionId)
 			copy(dAtA[i:], x.VersionId)
@@ -3939,6 +4064,57 @@ func (x *fastReflection_MsgCreateDidDocPayload) ProtoMethods() *protoiface.Metho
 				}
 				x.VersionId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecoveryCommitment", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RecoveryCommitment = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ControllerThreshold", wireType)
+				}
+				x.ControllerThreshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ControllerThreshold |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4880,19 +5056,22 @@ func (x *_MsgUpdateDidDocPayload_11_list) IsValid() bool {
 }
 
 var (
-	md_MsgUpdateDidDocPayload                       protoreflect.MessageDescriptor
-	fd_MsgUpdateDidDocPayload_context               protoreflect.FieldDescriptor
-	fd_MsgUpdateDidDocPayload_id                    protoreflect.FieldDescriptor
-	fd_MsgUpdateDidDocPayload_controller            protoreflect.FieldDescriptor
-	fd_MsgUpdateDidDocPayload_verification_method   protoreflect.FieldDescriptor
-	fd_MsgUpdateDidDocPayload_authentication        protoreflect.FieldDescriptor
-	fd_MsgUpdateDidDocPayload_assertion_method      protoreflect.FieldDescriptor
-	fd_MsgUpdateDidDocPayload_capability_invocation protoreflect.FieldDescriptor
-	fd_MsgUpdateDidDocPayload_capability_delegation protoreflect.FieldDescriptor
-	fd_MsgUpdateDidDocPayload_key_agreement         protoreflect.FieldDescriptor
-	fd_MsgUpdateDidDocPayload_service               protoreflect.FieldDescriptor
-	fd_MsgUpdateDidDocPayload_also_known_as         protoreflect.FieldDescriptor
-	fd_MsgUpdateDidDocPayload_version_id            protoreflect.FieldDescriptor
+	md_MsgUpdateDidDocPayload                           protoreflect.MessageDescriptor
+	fd_MsgUpdateDidDocPayload_context                   protoreflect.FieldDescriptor
+	fd_MsgUpdateDidDocPayload_id                        protoreflect.FieldDescriptor
+	fd_MsgUpdateDidDocPayload_controller                protoreflect.FieldDescriptor
+	fd_MsgUpdateDidDocPayload_verification_method       protoreflect.FieldDescriptor
+	fd_MsgUpdateDidDocPayload_authentication            protoreflect.FieldDescriptor
+	fd_MsgUpdateDidDocPayload_assertion_method          protoreflect.FieldDescriptor
+	fd_MsgUpdateDidDocPayload_capability_invocation     protoreflect.FieldDescriptor
+	fd_MsgUpdateDidDocPayload_capability_delegation     protoreflect.FieldDescriptor
+	fd_MsgUpdateDidDocPayload_key_agreement             protoreflect.FieldDescriptor
+	fd_MsgUpdateDidDocPayload_service                   protoreflect.FieldDescriptor
+	fd_MsgUpdateDidDocPayload_also_known_as             protoreflect.FieldDescriptor
+	fd_MsgUpdateDidDocPayload_version_id                protoreflect.FieldDescriptor
+	fd_MsgUpdateDidDocPayload_recovery_commitment       protoreflect.FieldDescriptor
+	fd_MsgUpdateDidDocPayload_clear_recovery_commitment protoreflect.FieldDescriptor
+	fd_MsgUpdateDidDocPayload_controller_threshold      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -4910,6 +5089,9 @@ func init() {
 	fd_MsgUpdateDidDocPayload_service = md_MsgUpdateDidDocPayload.Fields().ByName("service")
 	fd_MsgUpdateDidDocPayload_also_known_as = md_MsgUpdateDidDocPayload.Fields().ByName("also_known_as")
 	fd_MsgUpdateDidDocPayload_version_id = md_MsgUpdateDidDocPayload.Fields().ByName("version_id")
+	fd_MsgUpdateDidDocPayload_recovery_commitment = md_MsgUpdateDidDocPayload.Fields().ByName("recovery_commitment")
+	fd_MsgUpdateDidDocPayload_clear_recovery_commitment = md_MsgUpdateDidDocPayload.Fields().ByName("clear_recovery_commitment")
+	fd_MsgUpdateDidDocPayload_controller_threshold = md_MsgUpdateDidDocPayload.Fields().ByName("controller_threshold")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgUpdateDidDocPayload)(nil)
@@ -5049,6 +5231,24 @@ func (x *fastReflection_MsgUpdateDidDocPayload) Range(f func(protoreflect.FieldD
 			return
 		}
 	}
+	if x.RecoveryCommitment != "" {
+		value := protoreflect.ValueOfString(x.RecoveryCommitment)
+		if !f(fd_MsgUpdateDidDocPayload_recovery_commitment, value) {
+			return
+		}
+	}
+	if x.ClearRecoveryCommitment != false {
+		value := protoreflect.ValueOfBool(x.ClearRecoveryCommitment)
+		if !f(fd_MsgUpdateDidDocPayload_clear_recovery_commitment, value) {
+			return
+		}
+	}
+	if x.ControllerThreshold != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ControllerThreshold)
+		if !f(fd_MsgUpdateDidDocPayload_controller_threshold, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -5088,6 +5288,12 @@ func (x *fastReflection_MsgUpdateDidDocPayload) Has(fd protoreflect.FieldDescrip
 		return len(x.AlsoKnownAs) != 0
 	case "cheqd.did.v2.MsgUpdateDidDocPayload.version_id":
 		return x.VersionId != ""
+	case "cheqd.did.v2.MsgUpdateDidDocPayload.recovery_commitment":
+		return x.RecoveryCommitment != ""
+	case "cheqd.did.v2.MsgUpdateDidDocPayload.clear_recovery_commitment":
+		return x.ClearRecoveryCommitment != false
+	case "cheqd.did.v2.MsgUpdateDidDocPayload.controller_threshold":
+		return x.ControllerThreshold != uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateDidDocPayload"))
@@ -5128,6 +5334,12 @@ func (x *fastReflection_MsgUpdateDidDocPayload) Clear(fd protoreflect.FieldDescr
 		x.AlsoKnownAs = nil
 	case "cheqd.did.v2.MsgUpdateDidDocPayload.version_id":
 		x.VersionId = ""
+	case "cheqd.did.v2.MsgUpdateDidDocPayload.recovery_commitment":
+		x.RecoveryCommitment = ""
+	case "cheqd.did.v2.MsgUpdateDidDocPayload.clear_recovery_commitment":
+		x.ClearRecoveryCommitment = false
+	case "cheqd.did.v2.MsgUpdateDidDocPayload.controller_threshold":
+		x.ControllerThreshold = uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateDidDocPayload"))
@@ -5210,6 +5422,15 @@ func (x *fastReflection_MsgUpdateDidDocPayload) Get(descriptor protoreflect.Fiel
 	case "cheqd.did.v2.MsgUpdateDidDocPayload.version_id":
 		value := x.VersionId
 		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.MsgUpdateDidDocPayload.recovery_commitment":
+		value := x.RecoveryCommitment
+		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.MsgUpdateDidDocPayload.clear_recovery_commitment":
+		value := x.ClearRecoveryCommitment
+		return protoreflect.ValueOfBool(value)
+	case "cheqd.did.v2.MsgUpdateDidDocPayload.controller_threshold":
+		value := x.ControllerThreshold
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateDidDocPayload"))
@@ -5274,6 +5495,12 @@ func (x *fastReflection_MsgUpdateDidDocPayload) Set(fd protoreflect.FieldDescrip
 		x.AlsoKnownAs = *clv.list
 	case "cheqd.did.v2.MsgUpdateDidDocPayload.version_id":
 		x.VersionId = value.Interface().(string)
+	case "cheqd.did.v2.MsgUpdateDidDocPayload.recovery_commitment":
+		x.RecoveryCommitment = value.Interface().(string)
+	case "cheqd.did.v2.MsgUpdateDidDocPayload.clear_recovery_commitment":
+		x.ClearRecoveryCommitment = value.Bool()
+	case "cheqd.did.v2.MsgUpdateDidDocPayload.controller_threshold":
+		x.ControllerThreshold = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateDidDocPayload"))
@@ -5358,6 +5585,12 @@ func (x *fastReflection_MsgUpdateDidDocPayload) Mutable(fd protoreflect.FieldDes
 		panic(fmt.Errorf("field id of message cheqd.did.v2.MsgUpdateDidDocPayload is not mutable"))
 	case "cheqd.did.v2.MsgUpdateDidDocPayload.version_id":
 		panic(fmt.Errorf("field version_id of message cheqd.did.v2.MsgUpdateDidDocPayload is not mutable"))
+	case "cheqd.did.v2.MsgUpdateDidDocPayload.recovery_commitment":
+		panic(fmt.Errorf("field recovery_commitment of message cheqd.did.v2.MsgUpdateDidDocPayload is not mutable"))
+	case "cheqd.did.v2.MsgUpdateDidDocPayload.clear_recovery_commitment":
+		panic(fmt.Errorf("field clear_recovery_commitment of message cheqd.did.v2.MsgUpdateDidDocPayload is not mutable"))
+	case "cheqd.did.v2.MsgUpdateDidDocPayload.controller_threshold":
+		panic(fmt.Errorf("field controller_threshold of message cheqd.did.v2.MsgUpdateDidDocPayload is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateDidDocPayload"))
@@ -5405,6 +5638,12 @@ func (x *fastReflection_MsgUpdateDidDocPayload) NewField(fd protoreflect.FieldDe
 		return protoreflect.ValueOfList(&_MsgUpdateDidDocPayload_11_list{list: &list})
 	case "cheqd.did.v2.MsgUpdateDidDocPayload.version_id":
 		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.MsgUpdateDidDocPayload.recovery_commitment":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.MsgUpdateDidDocPayload.clear_recovery_commitment":
+		return protoreflect.ValueOfBool(false)
+	case "cheqd.did.v2.MsgUpdateDidDocPayload.controller_threshold":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateDidDocPayload"))
@@ -5542,6 +5781,16 @@ func (x *fastReflection_MsgUpdateDidDocPayload) ProtoMethods() *protoiface.Metho
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.RecoveryCommitment)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ClearRecoveryCommitment {
+			n += 2
+		}
+		if x.ControllerThreshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.ControllerThreshold))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5571,6 +5820,28 @@ func (x *fastReflection_MsgUpdateDidDocPayload) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.ControllerThreshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ControllerThreshold))
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.ClearRecoveryCommitment {
+			i--
+			if x.ClearRecoveryCommitment {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x70
+		}
+		if len(x.RecoveryCommitment) > 0 {
+			i -= len(x.RecoveryCommitment)
+			copy(dAtA[i:], x.RecoveryCommitment)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecoveryCommitment)))
+			i--
+			dAtA[i] = 0x6a
+		}
 		if len(x.VersionId) > 0 {
 			i -= len(x.VersionId)
 			copy(dAtA[i:], x.VersionId)
@@ -6126,6 +6397,77 @@ func (x *fastReflection_MsgUpdateDidDocPayload) ProtoMethods() *protoiface.Metho
 				}
 				x.VersionId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecoveryCommitment", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RecoveryCommitment = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClearRecoveryCommitment", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.ClearRecoveryCommitment = bool(v != 0)
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ControllerThreshold", wireType)
+				}
+				x.ControllerThreshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ControllerThreshold |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6596,28 +6938,79 @@ func (x *fastReflection_MsgUpdateDidDocResponse) ProtoMethods() *protoiface.Meth
 	}
 }
 
+var _ protoreflect.List = (*_MsgRecoverDidDoc_2_list)(nil)
+
+type _MsgRecoverDidDoc_2_list struct {
+	list *[]*SignInfo
+}
+
+func (x *_MsgRecoverDidDoc_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgRecoverDidDoc_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgRecoverDidDoc_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SignInfo)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgRecoverDidDoc_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SignInfo)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgRecoverDidDoc_2_list) AppendMutable() protoreflect.Value {
+	v := new(SignInfo)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgRecoverDidDoc_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgRecoverDidDoc_2_list) NewElement() protoreflect.Value {
+	v := new(SignInfo)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgRecoverDidDoc_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MsgDeactivateDidDocPayload            protoreflect.MessageDescriptor
-	fd_MsgDeactivateDidDocPayload_id         protoreflect.FieldDescriptor
-	fd_MsgDeactivateDidDocPayload_version_id protoreflect.FieldDescriptor
+	md_MsgRecoverDidDoc            protoreflect.MessageDescriptor
+	fd_MsgRecoverDidDoc_payload    protoreflect.FieldDescriptor
+	fd_MsgRecoverDidDoc_signatures protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cheqd_did_v2_tx_proto_init()
-	md_MsgDeactivateDidDocPayload = File_cheqd_did_v2_tx_proto.Messages().ByName("MsgDeactivateDidDocPayload")
-	fd_MsgDeactivateDidDocPayload_id = md_MsgDeactivateDidDocPayload.Fields().ByName("id")
-	fd_MsgDeactivateDidDocPayload_version_id = md_MsgDeactivateDidDocPayload.Fields().ByName("version_id")
+	md_MsgRecoverDidDoc = File_cheqd_did_v2_tx_proto.Messages().ByName("MsgRecoverDidDoc")
+	fd_MsgRecoverDidDoc_payload = md_MsgRecoverDidDoc.Fields().ByName("payload")
+	fd_MsgRecoverDidDoc_signatures = md_MsgRecoverDidDoc.Fields().ByName("signatures")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgDeactivateDidDocPayload)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgRecoverDidDoc)(nil)
 
-type fastReflection_MsgDeactivateDidDocPayload MsgDeactivateDidDocPayload
+type fastReflection_MsgRecoverDidDoc MsgRecoverDidDoc
 
-func (x *MsgDeactivateDidDocPayload) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgDeactivateDidDocPayload)(x)
+func (x *MsgRecoverDidDoc) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRecoverDidDoc)(x)
 }
 
-func (x *MsgDeactivateDidDocPayload) slowProtoReflect() protoreflect.Message {
+func (x *MsgRecoverDidDoc) slowProtoReflect() protoreflect.Message {
 	mi := &file_cheqd_did_v2_tx_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6629,43 +7022,43 @@ func (x *MsgDeactivateDidDocPayload) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgDeactivateDidDocPayload_messageType fastReflection_MsgDeactivateDidDocPayload_messageType
-var _ protoreflect.MessageType = fastReflection_MsgDeactivateDidDocPayload_messageType{}
+var _fastReflection_MsgRecoverDidDoc_messageType fastReflection_MsgRecoverDidDoc_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRecoverDidDoc_messageType{}
 
-type fastReflection_MsgDeactivateDidDocPayload_messageType struct{}
+type fastReflection_MsgRecoverDidDoc_messageType struct{}
 
-func (x fastReflection_MsgDeactivateDidDocPayload_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgDeactivateDidDocPayload)(nil)
+func (x fastReflection_MsgRecoverDidDoc_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRecoverDidDoc)(nil)
 }
-func (x fastReflection_MsgDeactivateDidDocPayload_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgDeactivateDidDocPayload)
+func (x fastReflection_MsgRecoverDidDoc_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRecoverDidDoc)
 }
-func (x fastReflection_MsgDeactivateDidDocPayload_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgDeactivateDidDocPayload
+func (x fastReflection_MsgRecoverDidDoc_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRecoverDidDoc
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgDeactivateDidDocPayload) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgDeactivateDidDocPayload
+func (x *fastReflection_MsgRecoverDidDoc) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRecoverDidDoc
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgDeactivateDidDocPayload) Type() protoreflect.MessageType {
-	return _fastReflection_MsgDeactivateDidDocPayload_messageType
+func (x *fastReflection_MsgRecoverDidDoc) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRecoverDidDoc_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgDeactivateDidDocPayload) New() protoreflect.Message {
-	return new(fastReflection_MsgDeactivateDidDocPayload)
+func (x *fastReflection_MsgRecoverDidDoc) New() protoreflect.Message {
+	return new(fastReflection_MsgRecoverDidDoc)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgDeactivateDidDocPayload) Interface() protoreflect.ProtoMessage {
-	return (*MsgDeactivateDidDocPayload)(x)
+func (x *fastReflection_MsgRecoverDidDoc) Interface() protoreflect.ProtoMessage {
+	return (*MsgRecoverDidDoc)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6673,16 +7066,16 @@ func (x *fastReflection_MsgDeactivateDidDocPayload) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgDeactivateDidDocPayload) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Id != "" {
-		value := protoreflect.ValueOfString(x.Id)
-		if !f(fd_MsgDeactivateDidDocPayload_id, value) {
+func (x *fastReflection_MsgRecoverDidDoc) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Payload != nil {
+		value := protoreflect.ValueOfMessage(x.Payload.ProtoReflect())
+		if !f(fd_MsgRecoverDidDoc_payload, value) {
 			return
 		}
 	}
-	if x.VersionId != "" {
-		value := protoreflect.ValueOfString(x.VersionId)
-		if !f(fd_MsgDeactivateDidDocPayload_version_id, value) {
+	if len(x.Signatures) != 0 {
+		value := protoreflect.ValueOfList(&_MsgRecoverDidDoc_2_list{list: &x.Signatures})
+		if !f(fd_MsgRecoverDidDoc_signatures, value) {
 			return
 		}
 	}
@@ -6699,17 +7092,17 @@ func (x *fastReflection_MsgDeactivateDidDocPayload) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgDeactivateDidDocPayload) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgRecoverDidDoc) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgDeactivateDidDocPayload.id":
-		return x.Id != ""
-	case "cheqd.did.v2.MsgDeactivateDidDocPayload.version_id":
-		return x.VersionId != ""
+	case "cheqd.did.v2.MsgRecoverDidDoc.payload":
+		return x.Payload != nil
+	case "cheqd.did.v2.MsgRecoverDidDoc.signatures":
+		return len(x.Signatures) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocPayload"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgRecoverDidDoc"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocPayload does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgRecoverDidDoc does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6719,17 +7112,17 @@ func (x *fastReflection_MsgDeactivateDidDocPayload) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDeactivateDidDocPayload) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgRecoverDidDoc) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgDeactivateDidDocPayload.id":
-		x.Id = ""
-	case "cheqd.did.v2.MsgDeactivateDidDocPayload.version_id":
-		x.VersionId = ""
+	case "cheqd.did.v2.MsgRecoverDidDoc.payload":
+		x.Payload = nil
+	case "cheqd.did.v2.MsgRecoverDidDoc.signatures":
+		x.Signatures = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocPayload"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgRecoverDidDoc"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocPayload does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgRecoverDidDoc does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6739,19 +7132,22 @@ func (x *fastReflection_MsgDeactivateDidDocPayload) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgDeactivateDidDocPayload) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRecoverDidDoc) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cheqd.did.v2.MsgDeactivateDidDocPayload.id":
-		value := x.Id
-		return protoreflect.ValueOfString(value)
-	case "cheqd.did.v2.MsgDeactivateDidDocPayload.version_id":
-		value := x.VersionId
-		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.MsgRecoverDidDoc.payload":
+		value := x.Payload
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cheqd.did.v2.MsgRecoverDidDoc.signatures":
+		if len(x.Signatures) == 0 {
+			return protoreflect.ValueOfList(&_MsgRecoverDidDoc_2_list{})
+		}
+		listValue := &_MsgRecoverDidDoc_2_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocPayload"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgRecoverDidDoc"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocPayload does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgRecoverDidDoc does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6765,17 +7161,19 @@ func (x *fastReflection_MsgDeactivateDidDocPayload) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDeactivateDidDocPayload) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgRecoverDidDoc) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgDeactivateDidDocPayload.id":
-		x.Id = value.Interface().(string)
-	case "cheqd.did.v2.MsgDeactivateDidDocPayload.version_id":
-		x.VersionId = value.Interface().(string)
+	case "cheqd.did.v2.MsgRecoverDidDoc.payload":
+		x.Payload = value.Message().Interface().(*MsgRecoverDidDocPayload)
+	case "cheqd.did.v2.MsgRecoverDidDoc.signatures":
+		lv := value.List()
+		clv := lv.(*_MsgRecoverDidDoc_2_list)
+		x.Signatures = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocPayload"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgRecoverDidDoc"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocPayload does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgRecoverDidDoc does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6789,44 +7187,53 @@ func (x *fastReflection_MsgDeactivateDidDocPayload) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDeactivateDidDocPayload) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRecoverDidDoc) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgDeactivateDidDocPayload.id":
-		panic(fmt.Errorf("field id of message cheqd.did.v2.MsgDeactivateDidDocPayload is not mutable"))
-	case "cheqd.did.v2.MsgDeactivateDidDocPayload.version_id":
-		panic(fmt.Errorf("field version_id of message cheqd.did.v2.MsgDeactivateDidDocPayload is not mutable"))
+	case "cheqd.did.v2.MsgRecoverDidDoc.payload":
+		if x.Payload == nil {
+			x.Payload = new(MsgRecoverDidDocPayload)
+		}
+		return protoreflect.ValueOfMessage(x.Payload.ProtoReflect())
+	case "cheqd.did.v2.MsgRecoverDidDoc.signatures":
+		if x.Signatures == nil {
+			x.Signatures = []*SignInfo{}
+		}
+		value := &_MsgRecoverDidDoc_2_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocPayload"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgRecoverDidDoc"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocPayload does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgRecoverDidDoc does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgDeactivateDidDocPayload) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRecoverDidDoc) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgDeactivateDidDocPayload.id":
-		return protoreflect.ValueOfString("")
-	case "cheqd.did.v2.MsgDeactivateDidDocPayload.version_id":
-		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.MsgRecoverDidDoc.payload":
+		m := new(MsgRecoverDidDocPayload)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cheqd.did.v2.MsgRecoverDidDoc.signatures":
+		list := []*SignInfo{}
+		return protoreflect.ValueOfList(&_MsgRecoverDidDoc_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocPayload"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgRecoverDidDoc"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocPayload does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgRecoverDidDoc does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgDeactivateDidDocPayload) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgRecoverDidDoc) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.MsgDeactivateDidDocPayload", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.MsgRecoverDidDoc", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6834,7 +7241,7 @@ func (x *fastReflection_MsgDeactivateDidDocPayload) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgDeactivateDidDocPayload) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgRecoverDidDoc) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6845,7 +7252,7 @@ func (x *fastReflection_MsgDeactivateDidDocPayload) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDeactivateDidDocPayload) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgRecoverDidDoc) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6857,7 +7264,7 @@ func (x *fastReflection_MsgDeactivateDidDocPayload) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgDeactivateDidDocPayload) IsValid() bool {
+func (x *fastReflection_MsgRecoverDidDoc) IsValid() bool {
 	return x != nil
 }
 
@@ -6867,9 +7274,9 @@ func (x *fastReflection_MsgDeactivateDidDocPayload) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgDeactivateDidDocPayload) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgRecoverDidDoc) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgDeactivateDidDocPayload)
+		x := input.Message.Interface().(*MsgRecoverDidDoc)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6881,13 +7288,15 @@ func (x *fastReflection_MsgDeactivateDidDocPayload) ProtoMethods() *protoiface.M
 		var n int
 		var l int
 		_ = l
-		l = len(x.Id)
-		if l > 0 {
+		if x.Payload != nil {
+			l = options.Size(x.Payload)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.VersionId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Signatures) > 0 {
+			for _, e := range x.Signatures {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -6899,7 +7308,7 @@ func (x *fastReflection_MsgDeactivateDidDocPayload) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgDeactivateDidDocPayload)
+		x := input.Message.Interface().(*MsgRecoverDidDoc)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6918,17 +7327,33 @@ func (x *fastReflection_MsgDeactivateDidDocPayload) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.VersionId) > 0 {
-			i -= len(x.VersionId)
-			copy(dAtA[i:], x.VersionId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VersionId)))
-			i--
-			dAtA[i] = 0x12
+		if len(x.Signatures) > 0 {
+			for iNdEx := len(x.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Signatures[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
 		}
-		if len(x.Id) > 0 {
-			i -= len(x.Id)
-			copy(dAtA[i:], x.Id)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
+		if x.Payload != nil {
+			encoded, err := options.Marshal(x.Payload)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -6943,7 +7368,7 @@ func (x *fastReflection_MsgDeactivateDidDocPayload) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgDeactivateDidDocPayload)
+		x := input.Message.Interface().(*MsgRecoverDidDoc)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6975,17 +7400,17 @@ func (x *fastReflection_MsgDeactivateDidDocPayload) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDeactivateDidDocPayload: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRecoverDidDoc: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDeactivateDidDocPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRecoverDidDoc: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6995,29 +7420,33 @@ func (x *fastReflection_MsgDeactivateDidDocPayload) ProtoMethods() *protoiface.M
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Id = string(dAtA[iNdEx:postIndex])
+				if x.Payload == nil {
+					x.Payload = &MsgRecoverDidDocPayload{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Payload); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7027,23 +7456,25 @@ func (x *fastReflection_MsgDeactivateDidDocPayload) ProtoMethods() *protoiface.M
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.VersionId = string(dAtA[iNdEx:postIndex])
+				x.Signatures = append(x.Signatures, &SignInfo{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Signatures[len(x.Signatures)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -7080,88 +7511,4938 @@ func (x *fastReflection_MsgDeactivateDidDocPayload) ProtoMethods() *protoiface.M
 	}
 }
 
-var (
-	md_MsgDeactivateDidDocResponse       protoreflect.MessageDescriptor
-	fd_MsgDeactivateDidDocResponse_value protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_MsgRecoverDidDocPayload_2_list)(nil)
 
-func init() {
-	file_cheqd_did_v2_tx_proto_init()
-	md_MsgDeactivateDidDocResponse = File_cheqd_did_v2_tx_proto.Messages().ByName("MsgDeactivateDidDocResponse")
-	fd_MsgDeactivateDidDocResponse_value = md_MsgDeactivateDidDocResponse.Fields().ByName("value")
+type _MsgRecoverDidDocPayload_2_list struct {
+	list *[]*VerificationMethod
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgDeactivateDidDocResponse)(nil)
-
-type fastReflection_MsgDeactivateDidDocResponse MsgDeactivateDidDocResponse
-
-func (x *MsgDeactivateDidDocResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgDeactivateDidDocResponse)(x)
+func (x *_MsgRecoverDidDocPayload_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-func (x *MsgDeactivateDidDocResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cheqd_did_v2_tx_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *_MsgRecoverDidDocPayload_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-var _fastReflection_MsgDeactivateDidDocResponse_messageType fastReflection_MsgDeactivateDidDocResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgDeactivateDidDocResponse_messageType{}
+func (x *_MsgRecoverDidDocPayload_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VerificationMethod)
+	(*x.list)[i] = concreteValue
+}
 
-type fastReflection_MsgDeactivateDidDocResponse_messageType struct{}
+func (x *_MsgRecoverDidDocPayload_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VerificationMethod)
+	*x.list = append(*x.list, concreteValue)
+}
 
-func (x fastReflection_MsgDeactivateDidDocResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgDeactivateDidDocResponse)(nil)
+func (x *_MsgRecoverDidDocPayload_2_list) AppendMutable() protoreflect.Value {
+	v := new(VerificationMethod)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
-func (x fastReflection_MsgDeactivateDidDocResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgDeactivateDidDocResponse)
+
+func (x *_MsgRecoverDidDocPayload_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
 }
-func (x fastReflection_MsgDeactivateDidDocResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgDeactivateDidDocResponse
+
+func (x *_MsgRecoverDidDocPayload_2_list) NewElement() protoreflect.Value {
+	v := new(VerificationMethod)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_MsgDeactivateDidDocResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgDeactivateDidDocResponse
+func (x *_MsgRecoverDidDocPayload_2_list) IsValid() bool {
+	return x.list != nil
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgDeactivateDidDocResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgDeactivateDidDocResponse_messageType
+var _ protoreflect.List = (*_MsgRecoverDidDocPayload_3_list)(nil)
+
+type _MsgRecoverDidDocPayload_3_list struct {
+	list *[]string
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgDeactivateDidDocResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgDeactivateDidDocResponse)
+func (x *_MsgRecoverDidDocPayload_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgDeactivateDidDocResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgDeactivateDidDocResponse)(x)
+func (x *_MsgRecoverDidDocPayload_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
+func (x *_MsgRecoverDidDocPayload_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgRecoverDidDocPayload_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgRecoverDidDocPayload_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgRecoverDidDocPayload at list field Authentication as it is not of Message kind"))
+}
+
+func (x *_MsgRecoverDidDocPayload_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgRecoverDidDocPayload_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgRecoverDidDocPayload_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgRecoverDidDocPayload_4_list)(nil)
+
+type _MsgRecoverDidDocPayload_4_list struct {
+	list *[]string
+}
+
+func (x *_MsgRecoverDidDocPayload_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgRecoverDidDocPayload_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgRecoverDidDocPayload_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgRecoverDidDocPayload_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgRecoverDidDocPayload_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgRecoverDidDocPayload at list field AssertionMethod as it is not of Message kind"))
+}
+
+func (x *_MsgRecoverDidDocPayload_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgRecoverDidDocPayload_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgRecoverDidDocPayload_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgRecoverDidDocPayload_5_list)(nil)
+
+type _MsgRecoverDidDocPayload_5_list struct {
+	list *[]string
+}
+
+func (x *_MsgRecoverDidDocPayload_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgRecoverDidDocPayload_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgRecoverDidDocPayload_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgRecoverDidDocPayload_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgRecoverDidDocPayload_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgRecoverDidDocPayload at list field CapabilityInvocation as it is not of Message kind"))
+}
+
+func (x *_MsgRecoverDidDocPayload_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgRecoverDidDocPayload_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgRecoverDidDocPayload_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgRecoverDidDocPayload_6_list)(nil)
+
+type _MsgRecoverDidDocPayload_6_list struct {
+	list *[]string
+}
+
+func (x *_MsgRecoverDidDocPayload_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgRecoverDidDocPayload_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgRecoverDidDocPayload_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgRecoverDidDocPayload_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgRecoverDidDocPayload_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgRecoverDidDocPayload at list field CapabilityDelegation as it is not of Message kind"))
+}
+
+func (x *_MsgRecoverDidDocPayload_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgRecoverDidDocPayload_6_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgRecoverDidDocPayload_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgRecoverDidDocPayload_7_list)(nil)
+
+type _MsgRecoverDidDocPayload_7_list struct {
+	list *[]string
+}
+
+func (x *_MsgRecoverDidDocPayload_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgRecoverDidDocPayload_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgRecoverDidDocPayload_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgRecoverDidDocPayload_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgRecoverDidDocPayload_7_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgRecoverDidDocPayload at list field KeyAgreement as it is not of Message kind"))
+}
+
+func (x *_MsgRecoverDidDocPayload_7_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgRecoverDidDocPayload_7_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgRecoverDidDocPayload_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgRecoverDidDocPayload                          protoreflect.MessageDescriptor
+	fd_MsgRecoverDidDocPayload_id                       protoreflect.FieldDescriptor
+	fd_MsgRecoverDidDocPayload_verification_method      protoreflect.FieldDescriptor
+	fd_MsgRecoverDidDocPayload_authentication           protoreflect.FieldDescriptor
+	fd_MsgRecoverDidDocPayload_assertion_method         protoreflect.FieldDescriptor
+	fd_MsgRecoverDidDocPayload_capability_invocation    protoreflect.FieldDescriptor
+	fd_MsgRecoverDidDocPayload_capability_delegation    protoreflect.FieldDescriptor
+	fd_MsgRecoverDidDocPayload_key_agreement            protoreflect.FieldDescriptor
+	fd_MsgRecoverDidDocPayload_version_id               protoreflect.FieldDescriptor
+	fd_MsgRecoverDidDocPayload_recovery_key             protoreflect.FieldDescriptor
+	fd_MsgRecoverDidDocPayload_next_recovery_commitment protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cheqd_did_v2_tx_proto_init()
+	md_MsgRecoverDidDocPayload = File_cheqd_did_v2_tx_proto.Messages().ByName("MsgRecoverDidDocPayload")
+	fd_MsgRecoverDidDocPayload_id = md_MsgRecoverDidDocPayload.Fields().ByName("id")
+	fd_MsgRecoverDidDocPayload_verification_method = md_MsgRecoverDidDocPayload.Fields().ByName("verification_method")
+	fd_MsgRecoverDidDocPayload_authentication = md_MsgRecoverDidDocPayload.Fields().ByName("authentication")
+	fd_MsgRecoverDidDocPayload_assertion_method = md_MsgRecoverDidDocPayload.Fields().ByName("assertion_method")
+	fd_MsgRecoverDidDocPayload_capability_invocation = md_MsgRecoverDidDocPayload.Fields().ByName("capability_invocation")
+	fd_MsgRecoverDidDocPayload_capability_delegation = md_MsgRecoverDidDocPayload.Fields().ByName("capability_delegation")
+	fd_MsgRecoverDidDocPayload_key_agreement = md_MsgRecoverDidDocPayload.Fields().ByName("key_agreement")
+	fd_MsgRecoverDidDocPayload_version_id = md_MsgRecoverDidDocPayload.Fields().ByName("version_id")
+	fd_MsgRecoverDidDocPayload_recovery_key = md_MsgRecoverDidDocPayload.Fields().ByName("recovery_key")
+	fd_MsgRecoverDidDocPayload_next_recovery_commitment = md_MsgRecoverDidDocPayload.Fields().ByName("next_recovery_commitment")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRecoverDidDocPayload)(nil)
+
+type fastReflection_MsgRecoverDidDocPayload MsgRecoverDidDocPayload
+
+func (x *MsgRecoverDidDocPayload) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRecoverDidDocPayload)(x)
+}
+
+func (x *MsgRecoverDidDocPayload) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_tx_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRecoverDidDocPayload_messageType fastReflection_MsgRecoverDidDocPayload_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRecoverDidDocPayload_messageType{}
+
+type fastReflection_MsgRecoverDidDocPayload_messageType struct{}
+
+func (x fastReflection_MsgRecoverDidDocPayload_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRecoverDidDocPayload)(nil)
+}
+func (x fastReflection_MsgRecoverDidDocPayload_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRecoverDidDocPayload)
+}
+func (x fastReflection_MsgRecoverDidDocPayload_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRecoverDidDocPayload
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRecoverDidDocPayload) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRecoverDidDocPayload
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRecoverDidDocPayload) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRecoverDidDocPayload_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRecoverDidDocPayload) New() protoreflect.Message {
+	return new(fastReflection_MsgRecoverDidDocPayload)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRecoverDidDocPayload) Interface() protoreflect.ProtoMessage {
+	return (*MsgRecoverDidDocPayload)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRecoverDidDocPayload) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != "" {
+		value := protoreflect.ValueOfString(x.Id)
+		if !f(fd_MsgRecoverDidDocPayload_id, value) {
+			return
+		}
+	}
+	if len(x.VerificationMethod) != 0 {
+		value := protoreflect.ValueOfList(&_MsgRecoverDidDocPayload_2_list{list: &x.VerificationMethod})
+		if !f(fd_MsgRecoverDidDocPayload_verification_method, value) {
+			return
+		}
+	}
+	if len(x.Authentication) != 0 {
+		value := protoreflect.ValueOfList(&_MsgRecoverDidDocPayload_3_list{list: &x.Authentication})
+		if !f(fd_MsgRecoverDidDocPayload_authentication, value) {
+			return
+		}
+	}
+	if len(x.AssertionMethod) != 0 {
+		value := protoreflect.ValueOfList(&_MsgRecoverDidDocPayload_4_list{list: &x.AssertionMethod})
+		if !f(fd_MsgRecoverDidDocPayload_assertion_method, value) {
+			return
+		}
+	}
+	if len(x.CapabilityInvocation) != 0 {
+		value := protoreflect.ValueOfList(&_MsgRecoverDidDocPayload_5_list{list: &x.CapabilityInvocation})
+		if !f(fd_MsgRecoverDidDocPayload_capability_invocation, value) {
+			return
+		}
+	}
+	if len(x.CapabilityDelegation) != 0 {
+		value := protoreflect.ValueOfList(&_MsgRecoverDidDocPayload_6_list{list: &x.CapabilityDelegation})
+		if !f(fd_MsgRecoverDidDocPayload_capability_delegation, value) {
+			return
+		}
+	}
+	if len(x.KeyAgreement) != 0 {
+		value := protoreflect.ValueOfList(&_MsgRecoverDidDocPayload_7_list{list: &x.KeyAgreement})
+		if !f(fd_MsgRecoverDidDocPayload_key_agreement, value) {
+			return
+		}
+	}
+	if x.VersionId != "" {
+		value := protoreflect.ValueOfString(x.VersionId)
+		if !f(fd_MsgRecoverDidDocPayload_version_id, value) {
+			return
+		}
+	}
+	if x.RecoveryKey != nil {
+		value := protoreflect.ValueOfMessage(x.RecoveryKey.ProtoReflect())
+		if !f(fd_MsgRecoverDidDocPayload_recovery_key, value) {
+			return
+		}
+	}
+	if x.NextRecoveryCommitment != "" {
+		value := protoreflect.ValueOfString(x.NextRecoveryCommitment)
+		if !f(fd_MsgRecoverDidDocPayload_next_recovery_commitment, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRecoverDidDocPayload) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.id":
+		return x.Id != ""
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.verification_method":
+		return len(x.VerificationMethod) != 0
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.authentication":
+		return len(x.Authentication) != 0
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.assertion_method":
+		return len(x.AssertionMethod) != 0
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.capability_invocation":
+		return len(x.CapabilityInvocation) != 0
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.capability_delegation":
+		return len(x.CapabilityDelegation) != 0
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.key_agreement":
+		return len(x.KeyAgreement) != 0
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.version_id":
+		return x.VersionId != ""
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.recovery_key":
+		return x.RecoveryKey != nil
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.next_recovery_commitment":
+		return x.NextRecoveryCommitment != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgRecoverDidDocPayload"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgRecoverDidDocPayload does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRecoverDidDocPayload) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.id":
+		x.Id = ""
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.verification_method":
+		x.VerificationMethod = nil
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.authentication":
+		x.Authentication = nil
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.assertion_method":
+		x.AssertionMethod = nil
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.capability_invocation":
+		x.CapabilityInvocation = nil
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.capability_delegation":
+		x.CapabilityDelegation = nil
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.key_agreement":
+		x.KeyAgreement = nil
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.version_id":
+		x.VersionId = ""
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.recovery_key":
+		x.RecoveryKey = nil
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.next_recovery_commitment":
+		x.NextRecoveryCommitment = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgRecoverDidDocPayload"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgRecoverDidDocPayload does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRecoverDidDocPayload) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.id":
+		value := x.Id
+		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.verification_method":
+		if len(x.VerificationMethod) == 0 {
+			return protoreflect.ValueOfList(&_MsgRecoverDidDocPayload_2_list{})
+		}
+		listValue := &_MsgRecoverDidDocPayload_2_list{list: &x.VerificationMethod}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.authentication":
+		if len(x.Authentication) == 0 {
+			return protoreflect.ValueOfList(&_MsgRecoverDidDocPayload_3_list{})
+		}
+		listValue := &_MsgRecoverDidDocPayload_3_list{list: &x.Authentication}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.assertion_method":
+		if len(x.AssertionMethod) == 0 {
+			return protoreflect.ValueOfList(&_MsgRecoverDidDocPayload_4_list{})
+		}
+		listValue := &_MsgRecoverDidDocPayload_4_list{list: &x.AssertionMethod}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.capability_invocation":
+		if len(x.CapabilityInvocation) == 0 {
+			return protoreflect.ValueOfList(&_MsgRecoverDidDocPayload_5_list{})
+		}
+		listValue := &_MsgRecoverDidDocPayload_5_list{list: &x.CapabilityInvocation}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.capability_delegation":
+		if len(x.CapabilityDelegation) == 0 {
+			return protoreflect.ValueOfList(&_MsgRecoverDidDocPayload_6_list{})
+		}
+		listValue := &_MsgRecoverDidDocPayload_6_list{list: &x.CapabilityDelegation}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.key_agreement":
+		if len(x.KeyAgreement) == 0 {
+			return protoreflect.ValueOfList(&_MsgRecoverDidDocPayload_7_list{})
+		}
+		listValue := &_MsgRecoverDidDocPayload_7_list{list: &x.KeyAgreement}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.version_id":
+		value := x.VersionId
+		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.recovery_key":
+		value := x.RecoveryKey
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.next_recovery_commitment":
+		value := x.NextRecoveryCommitment
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgRecoverDidDocPayload"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgRecoverDidDocPayload does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRecoverDidDocPayload) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.id":
+		x.Id = value.Interface().(string)
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.verification_method":
+		lv := value.List()
+		clv := lv.(*_MsgRecoverDidDocPayload_2_list)
+		x.VerificationMethod = *clv.list
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.authentication":
+		lv := value.List()
+		clv := lv.(*_MsgRecoverDidDocPayload_3_list)
+		x.Authentication = *clv.list
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.assertion_method":
+		lv := value.List()
+		clv := lv.(*_MsgRecoverDidDocPayload_4_list)
+		x.AssertionMethod = *clv.list
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.capability_invocation":
+		lv := value.List()
+		clv := lv.(*_MsgRecoverDidDocPayload_5_list)
+		x.CapabilityInvocation = *clv.list
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.capability_delegation":
+		lv := value.List()
+		clv := lv.(*_MsgRecoverDidDocPayload_6_list)
+		x.CapabilityDelegation = *clv.list
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.key_agreement":
+		lv := value.List()
+		clv := lv.(*_MsgRecoverDidDocPayload_7_list)
+		x.KeyAgreement = *clv.list
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.version_id":
+		x.VersionId = value.Interface().(string)
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.recovery_key":
+		x.RecoveryKey = value.Message().Interface().(*VerificationMethod)
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.next_recovery_commitment":
+		x.NextRecoveryCommitment = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgRecoverDidDocPayload"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgRecoverDidDocPayload does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRecoverDidDocPayload) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.verification_method":
+		if x.VerificationMethod == nil {
+			x.VerificationMethod = []*VerificationMethod{}
+		}
+		value := &_MsgRecoverDidDocPayload_2_list{list: &x.VerificationMethod}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.authentication":
+		if x.Authentication == nil {
+			x.Authentication = []string{}
+		}
+		value := &_MsgRecoverDidDocPayload_3_list{list: &x.Authentication}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.assertion_method":
+		if x.AssertionMethod == nil {
+			x.AssertionMethod = []string{}
+		}
+		value := &_MsgRecoverDidDocPayload_4_list{list: &x.AssertionMethod}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.capability_invocation":
+		if x.CapabilityInvocation == nil {
+			x.CapabilityInvocation = []string{}
+		}
+		value := &_MsgRecoverDidDocPayload_5_list{list: &x.CapabilityInvocation}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.capability_delegation":
+		if x.CapabilityDelegation == nil {
+			x.CapabilityDelegation = []string{}
+		}
+		value := &_MsgRecoverDidDocPayload_6_list{list: &x.CapabilityDelegation}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.key_agreement":
+		if x.KeyAgreement == nil {
+			x.KeyAgreement = []string{}
+		}
+		value := &_MsgRecoverDidDocPayload_7_list{list: &x.KeyAgreement}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.recovery_key":
+		if x.RecoveryKey == nil {
+			x.RecoveryKey = new(VerificationMethod)
+		}
+		return protoreflect.ValueOfMessage(x.RecoveryKey.ProtoReflect())
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.id":
+		panic(fmt.Errorf("field id of message cheqd.did.v2.MsgRecoverDidDocPayload is not mutable"))
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.version_id":
+		panic(fmt.Errorf("field version_id of message cheqd.did.v2.MsgRecoverDidDocPayload is not mutable"))
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.next_recovery_commitment":
+		panic(fmt.Errorf("field next_recovery_commitment of message cheqd.did.v2.MsgRecoverDidDocPayload is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgRecoverDidDocPayload"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgRecoverDidDocPayload does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRecoverDidDocPayload) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.id":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.verification_method":
+		list := []*VerificationMethod{}
+		return protoreflect.ValueOfList(&_MsgRecoverDidDocPayload_2_list{list: &list})
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.authentication":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgRecoverDidDocPayload_3_list{list: &list})
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.assertion_method":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgRecoverDidDocPayload_4_list{list: &list})
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.capability_invocation":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgRecoverDidDocPayload_5_list{list: &list})
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.capability_delegation":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgRecoverDidDocPayload_6_list{list: &list})
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.key_agreement":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgRecoverDidDocPayload_7_list{list: &list})
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.version_id":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.recovery_key":
+		m := new(VerificationMethod)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cheqd.did.v2.MsgRecoverDidDocPayload.next_recovery_commitment":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgRecoverDidDocPayload"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgRecoverDidDocPayload does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRecoverDidDocPayload) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.MsgRecoverDidDocPayload", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRecoverDidDocPayload) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRecoverDidDocPayload) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRecoverDidDocPayload) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRecoverDidDocPayload) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRecoverDidDocPayload)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Id)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.VerificationMethod) > 0 {
+			for _, e := range x.VerificationMethod {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Authentication) > 0 {
+			for _, s := range x.Authentication {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AssertionMethod) > 0 {
+			for _, s := range x.AssertionMethod {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.CapabilityInvocation) > 0 {
+			for _, s := range x.CapabilityInvocation {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.CapabilityDelegation) > 0 {
+			for _, s := range x.CapabilityDelegation {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.KeyAgreement) > 0 {
+			for _, s := range x.KeyAgreement {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.VersionId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RecoveryKey != nil {
+			l = options.Size(x.RecoveryKey)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NextRecoveryCommitment)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRecoverDidDocPayload)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.NextRecoveryCommitment) > 0 {
+			i -= len(x.NextRecoveryCommitment)
+			copy(dAtA[i:], x.NextRecoveryCommitment)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NextRecoveryCommitment)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.RecoveryKey != nil {
+			encoded, err := options.Marshal(x.RecoveryKey)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.VersionId) > 0 {
+			i -= len(x.VersionId)
+			copy(dAtA[i:], x.VersionId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VersionId)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.KeyAgreement) > 0 {
+			for iNdEx := len(x.KeyAgreement) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.KeyAgreement[iNdEx])
+				copy(dAtA[i:], x.KeyAgreement[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.KeyAgreement[iNdEx])))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.CapabilityDelegation) > 0 {
+			for iNdEx := len(x.CapabilityDelegation) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.CapabilityDelegation[iNdEx])
+				copy(dAtA[i:], x.CapabilityDelegation[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CapabilityDelegation[iNdEx])))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.CapabilityInvocation) > 0 {
+			for iNdEx := len(x.CapabilityInvocation) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.CapabilityInvocation[iNdEx])
+				copy(dAtA[i:], x.CapabilityInvocation[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CapabilityInvocation[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.AssertionMethod) > 0 {
+			for iNdEx := len(x.AssertionMethod) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AssertionMethod[iNdEx])
+				copy(dAtA[i:], x.AssertionMethod[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AssertionMethod[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Authentication) > 0 {
+			for iNdEx := len(x.Authentication) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Authentication[iNdEx])
+				copy(dAtA[i:], x.Authentication[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authentication[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.VerificationMethod) > 0 {
+			for iNdEx := len(x.VerificationMethod) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VerificationMethod[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Id) > 0 {
+			i -= len(x.Id)
+			copy(dAtA[i:], x.Id)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRecoverDidDocPayload)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRecoverDidDocPayload: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRecoverDidDocPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Id = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationMethod", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VerificationMethod = append(x.VerificationMethod, &VerificationMethod{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VerificationMethod[len(x.VerificationMethod)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authentication", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authentication = append(x.Authentication, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AssertionMethod", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AssertionMethod = append(x.AssertionMethod, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CapabilityInvocation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CapabilityInvocation = append(x.CapabilityInvocation, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CapabilityDelegation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CapabilityDelegation = append(x.CapabilityDelegation, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeyAgreement", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.KeyAgreement = append(x.KeyAgreement, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VersionId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecoveryKey", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RecoveryKey == nil {
+					x.RecoveryKey = &VerificationMethod{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RecoveryKey); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextRecoveryCommitment", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NextRecoveryCommitment = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRecoverDidDocResponse       protoreflect.MessageDescriptor
+	fd_MsgRecoverDidDocResponse_value protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cheqd_did_v2_tx_proto_init()
+	md_MsgRecoverDidDocResponse = File_cheqd_did_v2_tx_proto.Messages().ByName("MsgRecoverDidDocResponse")
+	fd_MsgRecoverDidDocResponse_value = md_MsgRecoverDidDocResponse.Fields().ByName("value")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRecoverDidDocResponse)(nil)
+
+type fastReflection_MsgRecoverDidDocResponse MsgRecoverDidDocResponse
+
+func (x *MsgRecoverDidDocResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRecoverDidDocResponse)(x)
+}
+
+func (x *MsgRecoverDidDocResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_tx_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRecoverDidDocResponse_messageType fastReflection_MsgRecoverDidDocResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRecoverDidDocResponse_messageType{}
+
+type fastReflection_MsgRecoverDidDocResponse_messageType struct{}
+
+func (x fastReflection_MsgRecoverDidDocResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRecoverDidDocResponse)(nil)
+}
+func (x fastReflection_MsgRecoverDidDocResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRecoverDidDocResponse)
+}
+func (x fastReflection_MsgRecoverDidDocResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRecoverDidDocResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRecoverDidDocResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRecoverDidDocResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRecoverDidDocResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRecoverDidDocResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRecoverDidDocResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRecoverDidDocResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRecoverDidDocResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRecoverDidDocResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRecoverDidDocResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Value != nil {
+		value := protoreflect.ValueOfMessage(x.Value.ProtoReflect())
+		if !f(fd_MsgRecoverDidDocResponse_value, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRecoverDidDocResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgRecoverDidDocResponse.value":
+		return x.Value != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgRecoverDidDocResponse"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgRecoverDidDocResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRecoverDidDocResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgRecoverDidDocResponse.value":
+		x.Value = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgRecoverDidDocResponse"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgRecoverDidDocResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRecoverDidDocResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cheqd.did.v2.MsgRecoverDidDocResponse.value":
+		value := x.Value
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgRecoverDidDocResponse"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgRecoverDidDocResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRecoverDidDocResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgRecoverDidDocResponse.value":
+		x.Value = value.Message().Interface().(*DidDocWithMetadata)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgRecoverDidDocResponse"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgRecoverDidDocResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRecoverDidDocResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgRecoverDidDocResponse.value":
+		if x.Value == nil {
+			x.Value = new(DidDocWithMetadata)
+		}
+		return protoreflect.ValueOfMessage(x.Value.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgRecoverDidDocResponse"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgRecoverDidDocResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRecoverDidDocResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgRecoverDidDocResponse.value":
+		m := new(DidDocWithMetadata)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgRecoverDidDocResponse"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgRecoverDidDocResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRecoverDidDocResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.MsgRecoverDidDocResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRecoverDidDocResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRecoverDidDocResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRecoverDidDocResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRecoverDidDocResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRecoverDidDocResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Value != nil {
+			l = options.Size(x.Value)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRecoverDidDocResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Value != nil {
+			encoded, err := options.Marshal(x.Value)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRecoverDidDocResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRecoverDidDocResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRecoverDidDocResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Value == nil {
+					x.Value = &DidDocWithMetadata{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Value); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgDeactivateDidDocPayload            protoreflect.MessageDescriptor
+	fd_MsgDeactivateDidDocPayload_id         protoreflect.FieldDescriptor
+	fd_MsgDeactivateDidDocPayload_version_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cheqd_did_v2_tx_proto_init()
+	md_MsgDeactivateDidDocPayload = File_cheqd_did_v2_tx_proto.Messages().ByName("MsgDeactivateDidDocPayload")
+	fd_MsgDeactivateDidDocPayload_id = md_MsgDeactivateDidDocPayload.Fields().ByName("id")
+	fd_MsgDeactivateDidDocPayload_version_id = md_MsgDeactivateDidDocPayload.Fields().ByName("version_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgDeactivateDidDocPayload)(nil)
+
+type fastReflection_MsgDeactivateDidDocPayload MsgDeactivateDidDocPayload
+
+func (x *MsgDeactivateDidDocPayload) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgDeactivateDidDocPayload)(x)
+}
+
+func (x *MsgDeactivateDidDocPayload) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_tx_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgDeactivateDidDocPayload_messageType fastReflection_MsgDeactivateDidDocPayload_messageType
+var _ protoreflect.MessageType = fastReflection_MsgDeactivateDidDocPayload_messageType{}
+
+type fastReflection_MsgDeactivateDidDocPayload_messageType struct{}
+
+func (x fastReflection_MsgDeactivateDidDocPayload_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgDeactivateDidDocPayload)(nil)
+}
+func (x fastReflection_MsgDeactivateDidDocPayload_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgDeactivateDidDocPayload)
+}
+func (x fastReflection_MsgDeactivateDidDocPayload_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDeactivateDidDocPayload
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgDeactivateDidDocPayload) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDeactivateDidDocPayload
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgDeactivateDidDocPayload) Type() protoreflect.MessageType {
+	return _fastReflection_MsgDeactivateDidDocPayload_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgDeactivateDidDocPayload) New() protoreflect.Message {
+	return new(fastReflection_MsgDeactivateDidDocPayload)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgDeactivateDidDocPayload) Interface() protoreflect.ProtoMessage {
+	return (*MsgDeactivateDidDocPayload)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgDeactivateDidDocPayload) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != "" {
+		value := protoreflect.ValueOfString(x.Id)
+		if !f(fd_MsgDeactivateDidDocPayload_id, value) {
+			return
+		}
+	}
+	if x.VersionId != "" {
+		value := protoreflect.ValueOfString(x.VersionId)
+		if !f(fd_MsgDeactivateDidDocPayload_version_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgDeactivateDidDocPayload) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgDeactivateDidDocPayload.id":
+		return x.Id != ""
+	case "cheqd.did.v2.MsgDeactivateDidDocPayload.version_id":
+		return x.VersionId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocPayload"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocPayload does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDeactivateDidDocPayload) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgDeactivateDidDocPayload.id":
+		x.Id = ""
+	case "cheqd.did.v2.MsgDeactivateDidDocPayload.version_id":
+		x.VersionId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocPayload"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocPayload does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgDeactivateDidDocPayload) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cheqd.did.v2.MsgDeactivateDidDocPayload.id":
+		value := x.Id
+		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.MsgDeactivateDidDocPayload.version_id":
+		value := x.VersionId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocPayload"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocPayload does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDeactivateDidDocPayload) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgDeactivateDidDocPayload.id":
+		x.Id = value.Interface().(string)
+	case "cheqd.did.v2.MsgDeactivateDidDocPayload.version_id":
+		x.VersionId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocPayload"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocPayload does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDeactivateDidDocPayload) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgDeactivateDidDocPayload.id":
+		panic(fmt.Errorf("field id of message cheqd.did.v2.MsgDeactivateDidDocPayload is not mutable"))
+	case "cheqd.did.v2.MsgDeactivateDidDocPayload.version_id":
+		panic(fmt.Errorf("field version_id of message cheqd.did.v2.MsgDeactivateDidDocPayload is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocPayload"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocPayload does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgDeactivateDidDocPayload) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgDeactivateDidDocPayload.id":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.MsgDeactivateDidDocPayload.version_id":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocPayload"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocPayload does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgDeactivateDidDocPayload) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.MsgDeactivateDidDocPayload", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgDeactivateDidDocPayload) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDeactivateDidDocPayload) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgDeactivateDidDocPayload) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgDeactivateDidDocPayload) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgDeactivateDidDocPayload)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Id)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VersionId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDeactivateDidDocPayload)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.VersionId) > 0 {
+			i -= len(x.VersionId)
+			copy(dAtA[i:], x.VersionId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VersionId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Id) > 0 {
+			i -= len(x.Id)
+			copy(dAtA[i:], x.Id)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDeactivateDidDocPayload)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDeactivateDidDocPayload: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDeactivateDidDocPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Id = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VersionId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgDeactivateDidDocResponse       protoreflect.MessageDescriptor
+	fd_MsgDeactivateDidDocResponse_value protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cheqd_did_v2_tx_proto_init()
+	md_MsgDeactivateDidDocResponse = File_cheqd_did_v2_tx_proto.Messages().ByName("MsgDeactivateDidDocResponse")
+	fd_MsgDeactivateDidDocResponse_value = md_MsgDeactivateDidDocResponse.Fields().ByName("value")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgDeactivateDidDocResponse)(nil)
+
+type fastReflection_MsgDeactivateDidDocResponse MsgDeactivateDidDocResponse
+
+func (x *MsgDeactivateDidDocResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgDeactivateDidDocResponse)(x)
+}
+
+func (x *MsgDeactivateDidDocResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_tx_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgDeactivateDidDocResponse_messageType fastReflection_MsgDeactivateDidDocResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgDeactivateDidDocResponse_messageType{}
+
+type fastReflection_MsgDeactivateDidDocResponse_messageType struct{}
+
+func (x fastReflection_MsgDeactivateDidDocResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgDeactivateDidDocResponse)(nil)
+}
+func (x fastReflection_MsgDeactivateDidDocResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgDeactivateDidDocResponse)
+}
+func (x fastReflection_MsgDeactivateDidDocResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDeactivateDidDocResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgDeactivateDidDocResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDeactivateDidDocResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgDeactivateDidDocResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgDeactivateDidDocResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgDeactivateDidDocResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgDeactivateDidDocResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgDeactivateDidDocResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgDeactivateDidDocResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgDeactivateDidDocResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Value != nil {
+		value := protoreflect.ValueOfMessage(x.Value.ProtoReflect())
+		if !f(fd_MsgDeactivateDidDocResponse_value, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgDeactivateDidDocResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgDeactivateDidDocResponse.value":
+		return x.Value != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocResponse"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDeactivateDidDocResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgDeactivateDidDocResponse.value":
+		x.Value = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocResponse"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgDeactivateDidDocResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cheqd.did.v2.MsgDeactivateDidDocResponse.value":
+		value := x.Value
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocResponse"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDeactivateDidDocResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgDeactivateDidDocResponse.value":
+		x.Value = value.Message().Interface().(*DidDocWithMetadata)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocResponse"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDeactivateDidDocResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgDeactivateDidDocResponse.value":
+		if x.Value == nil {
+			x.Value = new(DidDocWithMetadata)
+		}
+		return protoreflect.ValueOfMessage(x.Value.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocResponse"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgDeactivateDidDocResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgDeactivateDidDocResponse.value":
+		m := new(DidDocWithMetadata)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocResponse"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgDeactivateDidDocResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.MsgDeactivateDidDocResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgDeactivateDidDocResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDeactivateDidDocResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgDeactivateDidDocResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgDeactivateDidDocResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgDeactivateDidDocResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Value != nil {
+			l = options.Size(x.Value)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDeactivateDidDocResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Value != nil {
+			encoded, err := options.Marshal(x.Value)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDeactivateDidDocResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDeactivateDidDocResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDeactivateDidDocResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Value == nil {
+					x.Value = &DidDocWithMetadata{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Value); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgBatchCreateDidDocs_2_list)(nil)
+
+type _MsgBatchCreateDidDocs_2_list struct {
+	list *[]*SignInfo
+}
+
+func (x *_MsgBatchCreateDidDocs_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgBatchCreateDidDocs_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgBatchCreateDidDocs_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SignInfo)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgBatchCreateDidDocs_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SignInfo)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgBatchCreateDidDocs_2_list) AppendMutable() protoreflect.Value {
+	v := new(SignInfo)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgBatchCreateDidDocs_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgBatchCreateDidDocs_2_list) NewElement() protoreflect.Value {
+	v := new(SignInfo)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgBatchCreateDidDocs_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgBatchCreateDidDocs            protoreflect.MessageDescriptor
+	fd_MsgBatchCreateDidDocs_payload    protoreflect.FieldDescriptor
+	fd_MsgBatchCreateDidDocs_signatures protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cheqd_did_v2_tx_proto_init()
+	md_MsgBatchCreateDidDocs = File_cheqd_did_v2_tx_proto.Messages().ByName("MsgBatchCreateDidDocs")
+	fd_MsgBatchCreateDidDocs_payload = md_MsgBatchCreateDidDocs.Fields().ByName("payload")
+	fd_MsgBatchCreateDidDocs_signatures = md_MsgBatchCreateDidDocs.Fields().ByName("signatures")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgBatchCreateDidDocs)(nil)
+
+type fastReflection_MsgBatchCreateDidDocs MsgBatchCreateDidDocs
+
+func (x *MsgBatchCreateDidDocs) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgBatchCreateDidDocs)(x)
+}
+
+func (x *MsgBatchCreateDidDocs) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_tx_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgBatchCreateDidDocs_messageType fastReflection_MsgBatchCreateDidDocs_messageType
+var _ protoreflect.MessageType = fastReflection_MsgBatchCreateDidDocs_messageType{}
+
+type fastReflection_MsgBatchCreateDidDocs_messageType struct{}
+
+func (x fastReflection_MsgBatchCreateDidDocs_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgBatchCreateDidDocs)(nil)
+}
+func (x fastReflection_MsgBatchCreateDidDocs_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgBatchCreateDidDocs)
+}
+func (x fastReflection_MsgBatchCreateDidDocs_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBatchCreateDidDocs
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgBatchCreateDidDocs) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBatchCreateDidDocs
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgBatchCreateDidDocs) Type() protoreflect.MessageType {
+	return _fastReflection_MsgBatchCreateDidDocs_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgBatchCreateDidDocs) New() protoreflect.Message {
+	return new(fastReflection_MsgBatchCreateDidDocs)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgBatchCreateDidDocs) Interface() protoreflect.ProtoMessage {
+	return (*MsgBatchCreateDidDocs)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgBatchCreateDidDocs) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Payload != nil {
+		value := protoreflect.ValueOfMessage(x.Payload.ProtoReflect())
+		if !f(fd_MsgBatchCreateDidDocs_payload, value) {
+			return
+		}
+	}
+	if len(x.Signatures) != 0 {
+		value := protoreflect.ValueOfList(&_MsgBatchCreateDidDocs_2_list{list: &x.Signatures})
+		if !f(fd_MsgBatchCreateDidDocs_signatures, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgBatchCreateDidDocs) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgBatchCreateDidDocs.payload":
+		return x.Payload != nil
+	case "cheqd.did.v2.MsgBatchCreateDidDocs.signatures":
+		return len(x.Signatures) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBatchCreateDidDocs"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgBatchCreateDidDocs does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBatchCreateDidDocs) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgBatchCreateDidDocs.payload":
+		x.Payload = nil
+	case "cheqd.did.v2.MsgBatchCreateDidDocs.signatures":
+		x.Signatures = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBatchCreateDidDocs"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgBatchCreateDidDocs does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgBatchCreateDidDocs) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cheqd.did.v2.MsgBatchCreateDidDocs.payload":
+		value := x.Payload
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cheqd.did.v2.MsgBatchCreateDidDocs.signatures":
+		if len(x.Signatures) == 0 {
+			return protoreflect.ValueOfList(&_MsgBatchCreateDidDocs_2_list{})
+		}
+		listValue := &_MsgBatchCreateDidDocs_2_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBatchCreateDidDocs"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgBatchCreateDidDocs does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBatchCreateDidDocs) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgBatchCreateDidDocs.payload":
+		x.Payload = value.Message().Interface().(*MsgBatchCreateDidDocsPayload)
+	case "cheqd.did.v2.MsgBatchCreateDidDocs.signatures":
+		lv := value.List()
+		clv := lv.(*_MsgBatchCreateDidDocs_2_list)
+		x.Signatures = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBatchCreateDidDocs"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgBatchCreateDidDocs does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBatchCreateDidDocs) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgBatchCreateDidDocs.payload":
+		if x.Payload == nil {
+			x.Payload = new(MsgBatchCreateDidDocsPayload)
+		}
+		return protoreflect.ValueOfMessage(x.Payload.ProtoReflect())
+	case "cheqd.did.v2.MsgBatchCreateDidDocs.signatures":
+		if x.Signatures == nil {
+			x.Signatures = []*SignInfo{}
+		}
+		value := &_MsgBatchCreateDidDocs_2_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBatchCreateDidDocs"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgBatchCreateDidDocs does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgBatchCreateDidDocs) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgBatchCreateDidDocs.payload":
+		m := new(MsgBatchCreateDidDocsPayload)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cheqd.did.v2.MsgBatchCreateDidDocs.signatures":
+		list := []*SignInfo{}
+		return protoreflect.ValueOfList(&_MsgBatchCreateDidDocs_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBatchCreateDidDocs"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgBatchCreateDidDocs does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgBatchCreateDidDocs) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.MsgBatchCreateDidDocs", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgBatchCreateDidDocs) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBatchCreateDidDocs) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgBatchCreateDidDocs) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgBatchCreateDidDocs) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgBatchCreateDidDocs)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Payload != nil {
+			l = options.Size(x.Payload)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Signatures) > 0 {
+			for _, e := range x.Signatures {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgBatchCreateDidDocs)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Signatures) > 0 {
+			for iNdEx := len(x.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Signatures[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Payload != nil {
+			encoded, err := options.Marshal(x.Payload)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgBatchCreateDidDocs)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBatchCreateDidDocs: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBatchCreateDidDocs: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Payload == nil {
+					x.Payload = &MsgBatchCreateDidDocsPayload{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Payload); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signatures = append(x.Signatures, &SignInfo{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Signatures[len(x.Signatures)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgBatchCreateDidDocsPayload_1_list)(nil)
+
+type _MsgBatchCreateDidDocsPayload_1_list struct {
+	list *[]*MsgCreateDidDocPayload
+}
+
+func (x *_MsgBatchCreateDidDocsPayload_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgBatchCreateDidDocsPayload_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgBatchCreateDidDocsPayload_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgCreateDidDocPayload)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgBatchCreateDidDocsPayload_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgCreateDidDocPayload)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgBatchCreateDidDocsPayload_1_list) AppendMutable() protoreflect.Value {
+	v := new(MsgCreateDidDocPayload)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgBatchCreateDidDocsPayload_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgBatchCreateDidDocsPayload_1_list) NewElement() protoreflect.Value {
+	v := new(MsgCreateDidDocPayload)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgBatchCreateDidDocsPayload_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgBatchCreateDidDocsPayload          protoreflect.MessageDescriptor
+	fd_MsgBatchCreateDidDocsPayload_payloads protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cheqd_did_v2_tx_proto_init()
+	md_MsgBatchCreateDidDocsPayload = File_cheqd_did_v2_tx_proto.Messages().ByName("MsgBatchCreateDidDocsPayload")
+	fd_MsgBatchCreateDidDocsPayload_payloads = md_MsgBatchCreateDidDocsPayload.Fields().ByName("payloads")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgBatchCreateDidDocsPayload)(nil)
+
+type fastReflection_MsgBatchCreateDidDocsPayload MsgBatchCreateDidDocsPayload
+
+func (x *MsgBatchCreateDidDocsPayload) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgBatchCreateDidDocsPayload)(x)
+}
+
+func (x *MsgBatchCreateDidDocsPayload) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_tx_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgBatchCreateDidDocsPayload_messageType fastReflection_MsgBatchCreateDidDocsPayload_messageType
+var _ protoreflect.MessageType = fastReflection_MsgBatchCreateDidDocsPayload_messageType{}
+
+type fastReflection_MsgBatchCreateDidDocsPayload_messageType struct{}
+
+func (x fastReflection_MsgBatchCreateDidDocsPayload_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgBatchCreateDidDocsPayload)(nil)
+}
+func (x fastReflection_MsgBatchCreateDidDocsPayload_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgBatchCreateDidDocsPayload)
+}
+func (x fastReflection_MsgBatchCreateDidDocsPayload_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBatchCreateDidDocsPayload
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgBatchCreateDidDocsPayload) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBatchCreateDidDocsPayload
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgBatchCreateDidDocsPayload) Type() protoreflect.MessageType {
+	return _fastReflection_MsgBatchCreateDidDocsPayload_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgBatchCreateDidDocsPayload) New() protoreflect.Message {
+	return new(fastReflection_MsgBatchCreateDidDocsPayload)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgBatchCreateDidDocsPayload) Interface() protoreflect.ProtoMessage {
+	return (*MsgBatchCreateDidDocsPayload)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgBatchCreateDidDocsPayload) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Payloads) != 0 {
+		value := protoreflect.ValueOfList(&_MsgBatchCreateDidDocsPayload_1_list{list: &x.Payloads})
+		if !f(fd_MsgBatchCreateDidDocsPayload_payloads, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgBatchCreateDidDocsPayload) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgBatchCreateDidDocsPayload.payloads":
+		return len(x.Payloads) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBatchCreateDidDocsPayload"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgBatchCreateDidDocsPayload does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBatchCreateDidDocsPayload) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgBatchCreateDidDocsPayload.payloads":
+		x.Payloads = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBatchCreateDidDocsPayload"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgBatchCreateDidDocsPayload does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgBatchCreateDidDocsPayload) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cheqd.did.v2.MsgBatchCreateDidDocsPayload.payloads":
+		if len(x.Payloads) == 0 {
+			return protoreflect.ValueOfList(&_MsgBatchCreateDidDocsPayload_1_list{})
+		}
+		listValue := &_MsgBatchCreateDidDocsPayload_1_list{list: &x.Payloads}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBatchCreateDidDocsPayload"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgBatchCreateDidDocsPayload does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBatchCreateDidDocsPayload) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgBatchCreateDidDocsPayload.payloads":
+		lv := value.List()
+		clv := lv.(*_MsgBatchCreateDidDocsPayload_1_list)
+		x.Payloads = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBatchCreateDidDocsPayload"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgBatchCreateDidDocsPayload does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBatchCreateDidDocsPayload) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgBatchCreateDidDocsPayload.payloads":
+		if x.Payloads == nil {
+			x.Payloads = []*MsgCreateDidDocPayload{}
+		}
+		value := &_MsgBatchCreateDidDocsPayload_1_list{list: &x.Payloads}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBatchCreateDidDocsPayload"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgBatchCreateDidDocsPayload does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgBatchCreateDidDocsPayload) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgBatchCreateDidDocsPayload.payloads":
+		list := []*MsgCreateDidDocPayload{}
+		return protoreflect.ValueOfList(&_MsgBatchCreateDidDocsPayload_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBatchCreateDidDocsPayload"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgBatchCreateDidDocsPayload does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgBatchCreateDidDocsPayload) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.MsgBatchCreateDidDocsPayload", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgBatchCreateDidDocsPayload) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBatchCreateDidDocsPayload) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgBatchCreateDidDocsPayload) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgBatchCreateDidDocsPayload) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgBatchCreateDidDocsPayload)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Payloads) > 0 {
+			for _, e := range x.Payloads {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgBatchCreateDidDocsPayload)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Payloads) > 0 {
+			for iNdEx := len(x.Payloads) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Payloads[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgBatchCreateDidDocsPayload)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBatchCreateDidDocsPayload: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBatchCreateDidDocsPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Payloads", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Payloads = append(x.Payloads, &MsgCreateDidDocPayload{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Payloads[len(x.Payloads)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgBatchCreateDidDocsResponse_1_list)(nil)
+
+type _MsgBatchCreateDidDocsResponse_1_list struct {
+	list *[]*DidDocWithMetadata
+}
+
+func (x *_MsgBatchCreateDidDocsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgBatchCreateDidDocsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgBatchCreateDidDocsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DidDocWithMetadata)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgBatchCreateDidDocsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DidDocWithMetadata)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgBatchCreateDidDocsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(DidDocWithMetadata)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgBatchCreateDidDocsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgBatchCreateDidDocsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(DidDocWithMetadata)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgBatchCreateDidDocsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgBatchCreateDidDocsResponse        protoreflect.MessageDescriptor
+	fd_MsgBatchCreateDidDocsResponse_values protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cheqd_did_v2_tx_proto_init()
+	md_MsgBatchCreateDidDocsResponse = File_cheqd_did_v2_tx_proto.Messages().ByName("MsgBatchCreateDidDocsResponse")
+	fd_MsgBatchCreateDidDocsResponse_values = md_MsgBatchCreateDidDocsResponse.Fields().ByName("values")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgBatchCreateDidDocsResponse)(nil)
+
+type fastReflection_MsgBatchCreateDidDocsResponse MsgBatchCreateDidDocsResponse
+
+func (x *MsgBatchCreateDidDocsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgBatchCreateDidDocsResponse)(x)
+}
+
+func (x *MsgBatchCreateDidDocsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_tx_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgBatchCreateDidDocsResponse_messageType fastReflection_MsgBatchCreateDidDocsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgBatchCreateDidDocsResponse_messageType{}
+
+type fastReflection_MsgBatchCreateDidDocsResponse_messageType struct{}
+
+func (x fastReflection_MsgBatchCreateDidDocsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgBatchCreateDidDocsResponse)(nil)
+}
+func (x fastReflection_MsgBatchCreateDidDocsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgBatchCreateDidDocsResponse)
+}
+func (x fastReflection_MsgBatchCreateDidDocsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBatchCreateDidDocsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgBatchCreateDidDocsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBatchCreateDidDocsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgBatchCreateDidDocsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgBatchCreateDidDocsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgBatchCreateDidDocsResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgBatchCreateDidDocsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgBatchCreateDidDocsResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgBatchCreateDidDocsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgBatchCreateDidDocsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Values) != 0 {
+		value := protoreflect.ValueOfList(&_MsgBatchCreateDidDocsResponse_1_list{list: &x.Values})
+		if !f(fd_MsgBatchCreateDidDocsResponse_values, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgBatchCreateDidDocsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgBatchCreateDidDocsResponse.values":
+		return len(x.Values) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBatchCreateDidDocsResponse"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgBatchCreateDidDocsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBatchCreateDidDocsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgBatchCreateDidDocsResponse.values":
+		x.Values = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBatchCreateDidDocsResponse"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgBatchCreateDidDocsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgBatchCreateDidDocsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cheqd.did.v2.MsgBatchCreateDidDocsResponse.values":
+		if len(x.Values) == 0 {
+			return protoreflect.ValueOfList(&_MsgBatchCreateDidDocsResponse_1_list{})
+		}
+		listValue := &_MsgBatchCreateDidDocsResponse_1_list{list: &x.Values}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBatchCreateDidDocsResponse"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgBatchCreateDidDocsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBatchCreateDidDocsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgBatchCreateDidDocsResponse.values":
+		lv := value.List()
+		clv := lv.(*_MsgBatchCreateDidDocsResponse_1_list)
+		x.Values = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBatchCreateDidDocsResponse"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgBatchCreateDidDocsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBatchCreateDidDocsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgBatchCreateDidDocsResponse.values":
+		if x.Values == nil {
+			x.Values = []*DidDocWithMetadata{}
+		}
+		value := &_MsgBatchCreateDidDocsResponse_1_list{list: &x.Values}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBatchCreateDidDocsResponse"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgBatchCreateDidDocsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgBatchCreateDidDocsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgBatchCreateDidDocsResponse.values":
+		list := []*DidDocWithMetadata{}
+		return protoreflect.ValueOfList(&_MsgBatchCreateDidDocsResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBatchCreateDidDocsResponse"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgBatchCreateDidDocsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgBatchCreateDidDocsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.MsgBatchCreateDidDocsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgBatchCreateDidDocsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBatchCreateDidDocsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgBatchCreateDidDocsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgBatchCreateDidDocsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgBatchCreateDidDocsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Values) > 0 {
+			for _, e := range x.Values {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgBatchCreateDidDocsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Values) > 0 {
+			for iNdEx := len(x.Values) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Values[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgBatchCreateDidDocsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBatchCreateDidDocsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBatchCreateDidDocsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Values = append(x.Values, &DidDocWithMetadata{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Values[len(x.Values)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgBurn_2_list)(nil)
+
+type _MsgBurn_2_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgBurn_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgBurn_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgBurn_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgBurn_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgBurn_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgBurn_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgBurn_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgBurn_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgBurn              protoreflect.MessageDescriptor
+	fd_MsgBurn_from_address protoreflect.FieldDescriptor
+	fd_MsgBurn_amount       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cheqd_did_v2_tx_proto_init()
+	md_MsgBurn = File_cheqd_did_v2_tx_proto.Messages().ByName("MsgBurn")
+	fd_MsgBurn_from_address = md_MsgBurn.Fields().ByName("from_address")
+	fd_MsgBurn_amount = md_MsgBurn.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgBurn)(nil)
+
+type fastReflection_MsgBurn MsgBurn
+
+func (x *MsgBurn) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgBurn)(x)
+}
+
+func (x *MsgBurn) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_tx_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgBurn_messageType fastReflection_MsgBurn_messageType
+var _ protoreflect.MessageType = fastReflection_MsgBurn_messageType{}
+
+type fastReflection_MsgBurn_messageType struct{}
+
+func (x fastReflection_MsgBurn_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgBurn)(nil)
+}
+func (x fastReflection_MsgBurn_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgBurn)
+}
+func (x fastReflection_MsgBurn_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBurn
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgBurn) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBurn
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgBurn) Type() protoreflect.MessageType {
+	return _fastReflection_MsgBurn_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgBurn) New() protoreflect.Message {
+	return new(fastReflection_MsgBurn)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgBurn) Interface() protoreflect.ProtoMessage {
+	return (*MsgBurn)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgBurn) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.FromAddress != "" {
+		value := protoreflect.ValueOfString(x.FromAddress)
+		if !f(fd_MsgBurn_from_address, value) {
+			return
+		}
+	}
+	if len(x.Amount) != 0 {
+		value := protoreflect.ValueOfList(&_MsgBurn_2_list{list: &x.Amount})
+		if !f(fd_MsgBurn_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgBurn) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgBurn.from_address":
+		return x.FromAddress != ""
+	case "cheqd.did.v2.MsgBurn.amount":
+		return len(x.Amount) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBurn"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgBurn does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBurn) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgBurn.from_address":
+		x.FromAddress = ""
+	case "cheqd.did.v2.MsgBurn.amount":
+		x.Amount = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBurn"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgBurn does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgBurn) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cheqd.did.v2.MsgBurn.from_address":
+		value := x.FromAddress
+		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.MsgBurn.amount":
+		if len(x.Amount) == 0 {
+			return protoreflect.ValueOfList(&_MsgBurn_2_list{})
+		}
+		listValue := &_MsgBurn_2_list{list: &x.Amount}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBurn"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgBurn does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBurn) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgBurn.from_address":
+		x.FromAddress = value.Interface().(string)
+	case "cheqd.did.v2.MsgBurn.amount":
+		lv := value.List()
+		clv := lv.(*_MsgBurn_2_list)
+		x.Amount = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBurn"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgBurn does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBurn) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgBurn.amount":
+		if x.Amount == nil {
+			x.Amount = []*v1beta1.Coin{}
+		}
+		value := &_MsgBurn_2_list{list: &x.Amount}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.MsgBurn.from_address":
+		panic(fmt.Errorf("field from_address of message cheqd.did.v2.MsgBurn is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBurn"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgBurn does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgBurn) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgBurn.from_address":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.MsgBurn.amount":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgBurn_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBurn"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgBurn does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgBurn) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.MsgBurn", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgBurn) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBurn) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgBurn) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgBurn) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgBurn)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.FromAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Amount) > 0 {
+			for _, e := range x.Amount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgBurn)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Amount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.FromAddress) > 0 {
+			i -= len(x.FromAddress)
+			copy(dAtA[i:], x.FromAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FromAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgBurn)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBurn: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FromAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = append(x.Amount, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgBurnResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_cheqd_did_v2_tx_proto_init()
+	md_MsgBurnResponse = File_cheqd_did_v2_tx_proto.Messages().ByName("MsgBurnResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgBurnResponse)(nil)
+
+type fastReflection_MsgBurnResponse MsgBurnResponse
+
+func (x *MsgBurnResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgBurnResponse)(x)
+}
+
+func (x *MsgBurnResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_tx_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgBurnResponse_messageType fastReflection_MsgBurnResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgBurnResponse_messageType{}
+
+type fastReflection_MsgBurnResponse_messageType struct{}
+
+func (x fastReflection_MsgBurnResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgBurnResponse)(nil)
+}
+func (x fastReflection_MsgBurnResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgBurnResponse)
+}
+func (x fastReflection_MsgBurnResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBurnResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgBurnResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBurnResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgBurnResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgBurnResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgBurnResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgBurnResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgBurnResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgBurnResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgDeactivateDidDocResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Value != nil {
-		value := protoreflect.ValueOfMessage(x.Value.ProtoReflect())
-		if !f(fd_MsgDeactivateDidDocResponse_value, value) {
-			return
-		}
-	}
+func (x *fastReflection_MsgBurnResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -7175,15 +12456,13 @@ func (x *fastReflection_MsgDeactivateDidDocResponse) Range(f func(protoreflect.F
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgDeactivateDidDocResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgBurnResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgDeactivateDidDocResponse.value":
-		return x.Value != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBurnResponse"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgBurnResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7193,15 +12472,13 @@ func (x *fastReflection_MsgDeactivateDidDocResponse) Has(fd protoreflect.FieldDe
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDeactivateDidDocResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgBurnResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgDeactivateDidDocResponse.value":
-		x.Value = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBurnResponse"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgBurnResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7211,16 +12488,13 @@ func (x *fastReflection_MsgDeactivateDidDocResponse) Clear(fd protoreflect.Field
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgDeactivateDidDocResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgBurnResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cheqd.did.v2.MsgDeactivateDidDocResponse.value":
-		value := x.Value
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBurnResponse"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgBurnResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7234,15 +12508,13 @@ func (x *fastReflection_MsgDeactivateDidDocResponse) Get(descriptor protoreflect
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDeactivateDidDocResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgBurnResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgDeactivateDidDocResponse.value":
-		x.Value = value.Message().Interface().(*DidDocWithMetadata)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBurnResponse"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgBurnResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7256,44 +12528,36 @@ func (x *fastReflection_MsgDeactivateDidDocResponse) Set(fd protoreflect.FieldDe
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDeactivateDidDocResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgBurnResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgDeactivateDidDocResponse.value":
-		if x.Value == nil {
-			x.Value = new(DidDocWithMetadata)
-		}
-		return protoreflect.ValueOfMessage(x.Value.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBurnResponse"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgBurnResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgDeactivateDidDocResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgBurnResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgDeactivateDidDocResponse.value":
-		m := new(DidDocWithMetadata)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBurnResponse"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgBurnResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgDeactivateDidDocResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgBurnResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.MsgDeactivateDidDocResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.MsgBurnResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7301,7 +12565,7 @@ func (x *fastReflection_MsgDeactivateDidDocResponse) WhichOneof(d protoreflect.O
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgDeactivateDidDocResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgBurnResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7312,7 +12576,7 @@ func (x *fastReflection_MsgDeactivateDidDocResponse) GetUnknown() protoreflect.R
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDeactivateDidDocResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgBurnResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7324,7 +12588,7 @@ func (x *fastReflection_MsgDeactivateDidDocResponse) SetUnknown(fields protorefl
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgDeactivateDidDocResponse) IsValid() bool {
+func (x *fastReflection_MsgBurnResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7334,9 +12598,9 @@ func (x *fastReflection_MsgDeactivateDidDocResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgDeactivateDidDocResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgBurnResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgDeactivateDidDocResponse)
+		x := input.Message.Interface().(*MsgBurnResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7348,10 +12612,6 @@ func (x *fastReflection_MsgDeactivateDidDocResponse) ProtoMethods() *protoiface.
 		var n int
 		var l int
 		_ = l
-		if x.Value != nil {
-			l = options.Size(x.Value)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -7362,7 +12622,7 @@ func (x *fastReflection_MsgDeactivateDidDocResponse) ProtoMethods() *protoiface.
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgDeactivateDidDocResponse)
+		x := input.Message.Interface().(*MsgBurnResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7381,20 +12641,6 @@ func (x *fastReflection_MsgDeactivateDidDocResponse) ProtoMethods() *protoiface.
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Value != nil {
-			encoded, err := options.Marshal(x.Value)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -7406,7 +12652,7 @@ func (x *fastReflection_MsgDeactivateDidDocResponse) ProtoMethods() *protoiface.
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgDeactivateDidDocResponse)
+		x := input.Message.Interface().(*MsgBurnResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7438,48 +12684,12 @@ func (x *fastReflection_MsgDeactivateDidDocResponse) ProtoMethods() *protoiface.
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDeactivateDidDocResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDeactivateDidDocResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Value == nil {
-					x.Value = &DidDocWithMetadata{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Value); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7515,80 +12725,82 @@ func (x *fastReflection_MsgDeactivateDidDocResponse) ProtoMethods() *protoiface.
 	}
 }
 
-var _ protoreflect.List = (*_MsgBurn_2_list)(nil)
+var _ protoreflect.List = (*_MsgMint_3_list)(nil)
 
-type _MsgBurn_2_list struct {
+type _MsgMint_3_list struct {
 	list *[]*v1beta1.Coin
 }
 
-func (x *_MsgBurn_2_list) Len() int {
+func (x *_MsgMint_3_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_MsgBurn_2_list) Get(i int) protoreflect.Value {
+func (x *_MsgMint_3_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_MsgBurn_2_list) Set(i int, value protoreflect.Value) {
+func (x *_MsgMint_3_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_MsgBurn_2_list) Append(value protoreflect.Value) {
+func (x *_MsgMint_3_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_MsgBurn_2_list) AppendMutable() protoreflect.Value {
+func (x *_MsgMint_3_list) AppendMutable() protoreflect.Value {
 	v := new(v1beta1.Coin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_MsgBurn_2_list) Truncate(n int) {
+func (x *_MsgMint_3_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_MsgBurn_2_list) NewElement() protoreflect.Value {
+func (x *_MsgMint_3_list) NewElement() protoreflect.Value {
 	v := new(v1beta1.Coin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_MsgBurn_2_list) IsValid() bool {
+func (x *_MsgMint_3_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_MsgBurn              protoreflect.MessageDescriptor
-	fd_MsgBurn_from_address protoreflect.FieldDescriptor
-	fd_MsgBurn_amount       protoreflect.FieldDescriptor
+	md_MsgMint            protoreflect.MessageDescriptor
+	fd_MsgMint_authority  protoreflect.FieldDescriptor
+	fd_MsgMint_to_address protoreflect.FieldDescriptor
+	fd_MsgMint_amount     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cheqd_did_v2_tx_proto_init()
-	md_MsgBurn = File_cheqd_did_v2_tx_proto.Messages().ByName("MsgBurn")
-	fd_MsgBurn_from_address = md_MsgBurn.Fields().ByName("from_address")
-	fd_MsgBurn_amount = md_MsgBurn.Fields().ByName("amount")
+	md_MsgMint = File_cheqd_did_v2_tx_proto.Messages().ByName("MsgMint")
+	fd_MsgMint_authority = md_MsgMint.Fields().ByName("authority")
+	fd_MsgMint_to_address = md_MsgMint.Fields().ByName("to_address")
+	fd_MsgMint_amount = md_MsgMint.Fields().ByName("amount")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgBurn)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgMint)(nil)
 
-type fastReflection_MsgBurn MsgBurn
+type fastReflection_MsgMint MsgMint
 
-func (x *MsgBurn) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgBurn)(x)
+func (x *MsgMint) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgMint)(x)
 }
 
-func (x *MsgBurn) slowProtoReflect() protoreflect.Message {
-	mi := &file_cheqd_did_v2_tx_proto_msgTypes[10]
+func (x *MsgMint) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_tx_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7599,43 +12811,43 @@ func (x *MsgBurn) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgBurn_messageType fastReflection_MsgBurn_messageType
-var _ protoreflect.MessageType = fastReflection_MsgBurn_messageType{}
+var _fastReflection_MsgMint_messageType fastReflection_MsgMint_messageType
+var _ protoreflect.MessageType = fastReflection_MsgMint_messageType{}
 
-type fastReflection_MsgBurn_messageType struct{}
+type fastReflection_MsgMint_messageType struct{}
 
-func (x fastReflection_MsgBurn_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgBurn)(nil)
+func (x fastReflection_MsgMint_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgMint)(nil)
 }
-func (x fastReflection_MsgBurn_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgBurn)
+func (x fastReflection_MsgMint_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgMint)
 }
-func (x fastReflection_MsgBurn_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgBurn
+func (x fastReflection_MsgMint_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgMint
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgBurn) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgBurn
+func (x *fastReflection_MsgMint) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgMint
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgBurn) Type() protoreflect.MessageType {
-	return _fastReflection_MsgBurn_messageType
+func (x *fastReflection_MsgMint) Type() protoreflect.MessageType {
+	return _fastReflection_MsgMint_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgBurn) New() protoreflect.Message {
-	return new(fastReflection_MsgBurn)
+func (x *fastReflection_MsgMint) New() protoreflect.Message {
+	return new(fastReflection_MsgMint)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgBurn) Interface() protoreflect.ProtoMessage {
-	return (*MsgBurn)(x)
+func (x *fastReflection_MsgMint) Interface() protoreflect.ProtoMessage {
+	return (*MsgMint)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7643,16 +12855,22 @@ func (x *fastReflection_MsgBurn) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgBurn) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.FromAddress != "" {
-		value := protoreflect.ValueOfString(x.FromAddress)
-		if !f(fd_MsgBurn_from_address, value) {
+func (x *fastReflection_MsgMint) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgMint_authority, value) {
+			return
+		}
+	}
+	if x.ToAddress != "" {
+		value := protoreflect.ValueOfString(x.ToAddress)
+		if !f(fd_MsgMint_to_address, value) {
 			return
 		}
 	}
 	if len(x.Amount) != 0 {
-		value := protoreflect.ValueOfList(&_MsgBurn_2_list{list: &x.Amount})
-		if !f(fd_MsgBurn_amount, value) {
+		value := protoreflect.ValueOfList(&_MsgMint_3_list{list: &x.Amount})
+		if !f(fd_MsgMint_amount, value) {
 			return
 		}
 	}
@@ -7669,17 +12887,19 @@ func (x *fastReflection_MsgBurn) Range(f func(protoreflect.FieldDescriptor, prot
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgBurn) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgMint) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgBurn.from_address":
-		return x.FromAddress != ""
-	case "cheqd.did.v2.MsgBurn.amount":
+	case "cheqd.did.v2.MsgMint.authority":
+		return x.Authority != ""
+	case "cheqd.did.v2.MsgMint.to_address":
+		return x.ToAddress != ""
+	case "cheqd.did.v2.MsgMint.amount":
 		return len(x.Amount) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBurn"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgMint"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgBurn does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgMint does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7689,17 +12909,19 @@ func (x *fastReflection_MsgBurn) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgBurn) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgMint) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgBurn.from_address":
-		x.FromAddress = ""
-	case "cheqd.did.v2.MsgBurn.amount":
+	case "cheqd.did.v2.MsgMint.authority":
+		x.Authority = ""
+	case "cheqd.did.v2.MsgMint.to_address":
+		x.ToAddress = ""
+	case "cheqd.did.v2.MsgMint.amount":
 		x.Amount = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBurn"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgMint"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgBurn does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgMint does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7709,22 +12931,25 @@ func (x *fastReflection_MsgBurn) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgBurn) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgMint) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cheqd.did.v2.MsgBurn.from_address":
-		value := x.FromAddress
+	case "cheqd.did.v2.MsgMint.authority":
+		value := x.Authority
 		return protoreflect.ValueOfString(value)
-	case "cheqd.did.v2.MsgBurn.amount":
+	case "cheqd.did.v2.MsgMint.to_address":
+		value := x.ToAddress
+		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.MsgMint.amount":
 		if len(x.Amount) == 0 {
-			return protoreflect.ValueOfList(&_MsgBurn_2_list{})
+			return protoreflect.ValueOfList(&_MsgMint_3_list{})
 		}
-		listValue := &_MsgBurn_2_list{list: &x.Amount}
+		listValue := &_MsgMint_3_list{list: &x.Amount}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBurn"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgMint"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgBurn does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgMint does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7738,19 +12963,21 @@ func (x *fastReflection_MsgBurn) Get(descriptor protoreflect.FieldDescriptor) pr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgBurn) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgMint) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgBurn.from_address":
-		x.FromAddress = value.Interface().(string)
-	case "cheqd.did.v2.MsgBurn.amount":
+	case "cheqd.did.v2.MsgMint.authority":
+		x.Authority = value.Interface().(string)
+	case "cheqd.did.v2.MsgMint.to_address":
+		x.ToAddress = value.Interface().(string)
+	case "cheqd.did.v2.MsgMint.amount":
 		lv := value.List()
-		clv := lv.(*_MsgBurn_2_list)
+		clv := lv.(*_MsgMint_3_list)
 		x.Amount = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBurn"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgMint"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgBurn does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgMint does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7764,49 +12991,53 @@ func (x *fastReflection_MsgBurn) Set(fd protoreflect.FieldDescriptor, value prot
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgBurn) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgMint) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgBurn.amount":
+	case "cheqd.did.v2.MsgMint.amount":
 		if x.Amount == nil {
 			x.Amount = []*v1beta1.Coin{}
 		}
-		value := &_MsgBurn_2_list{list: &x.Amount}
+		value := &_MsgMint_3_list{list: &x.Amount}
 		return protoreflect.ValueOfList(value)
-	case "cheqd.did.v2.MsgBurn.from_address":
-		panic(fmt.Errorf("field from_address of message cheqd.did.v2.MsgBurn is not mutable"))
+	case "cheqd.did.v2.MsgMint.authority":
+		panic(fmt.Errorf("field authority of message cheqd.did.v2.MsgMint is not mutable"))
+	case "cheqd.did.v2.MsgMint.to_address":
+		panic(fmt.Errorf("field to_address of message cheqd.did.v2.MsgMint is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBurn"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgMint"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgBurn does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgMint does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgBurn) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgMint) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgBurn.from_address":
+	case "cheqd.did.v2.MsgMint.authority":
 		return protoreflect.ValueOfString("")
-	case "cheqd.did.v2.MsgBurn.amount":
+	case "cheqd.did.v2.MsgMint.to_address":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.MsgMint.amount":
 		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_MsgBurn_2_list{list: &list})
+		return protoreflect.ValueOfList(&_MsgMint_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBurn"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgMint"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgBurn does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgMint does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgBurn) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgMint) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.MsgBurn", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.MsgMint", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7814,7 +13045,7 @@ func (x *fastReflection_MsgBurn) WhichOneof(d protoreflect.OneofDescriptor) prot
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgBurn) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgMint) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7825,7 +13056,7 @@ func (x *fastReflection_MsgBurn) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgBurn) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgMint) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7837,7 +13068,7 @@ func (x *fastReflection_MsgBurn) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgBurn) IsValid() bool {
+func (x *fastReflection_MsgMint) IsValid() bool {
 	return x != nil
 }
 
@@ -7847,9 +13078,9 @@ func (x *fastReflection_MsgBurn) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgBurn) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgMint) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgBurn)
+		x := input.Message.Interface().(*MsgMint)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7861,7 +13092,11 @@ func (x *fastReflection_MsgBurn) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.FromAddress)
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ToAddress)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -7881,7 +13116,7 @@ func (x *fastReflection_MsgBurn) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgBurn)
+		x := input.Message.Interface().(*MsgMint)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7913,13 +13148,20 @@ func (x *fastReflection_MsgBurn) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x12
+				dAtA[i] = 0x1a
 			}
 		}
-		if len(x.FromAddress) > 0 {
-			i -= len(x.FromAddress)
-			copy(dAtA[i:], x.FromAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FromAddress)))
+		if len(x.ToAddress) > 0 {
+			i -= len(x.ToAddress)
+			copy(dAtA[i:], x.ToAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ToAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -7934,7 +13176,7 @@ func (x *fastReflection_MsgBurn) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgBurn)
+		x := input.Message.Interface().(*MsgMint)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7966,15 +13208,15 @@ func (x *fastReflection_MsgBurn) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBurn: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgMint: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgMint: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -8002,9 +13244,41 @@ func (x *fastReflection_MsgBurn) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.FromAddress = string(dAtA[iNdEx:postIndex])
+				x.Authority = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ToAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 				}
@@ -8074,24 +13348,24 @@ func (x *fastReflection_MsgBurn) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgBurnResponse protoreflect.MessageDescriptor
+	md_MsgMintResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_cheqd_did_v2_tx_proto_init()
-	md_MsgBurnResponse = File_cheqd_did_v2_tx_proto.Messages().ByName("MsgBurnResponse")
+	md_MsgMintResponse = File_cheqd_did_v2_tx_proto.Messages().ByName("MsgMintResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgBurnResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgMintResponse)(nil)
 
-type fastReflection_MsgBurnResponse MsgBurnResponse
+type fastReflection_MsgMintResponse MsgMintResponse
 
-func (x *MsgBurnResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgBurnResponse)(x)
+func (x *MsgMintResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgMintResponse)(x)
 }
 
-func (x *MsgBurnResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cheqd_did_v2_tx_proto_msgTypes[11]
+func (x *MsgMintResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_tx_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8102,43 +13376,43 @@ func (x *MsgBurnResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgBurnResponse_messageType fastReflection_MsgBurnResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgBurnResponse_messageType{}
+var _fastReflection_MsgMintResponse_messageType fastReflection_MsgMintResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgMintResponse_messageType{}
 
-type fastReflection_MsgBurnResponse_messageType struct{}
+type fastReflection_MsgMintResponse_messageType struct{}
 
-func (x fastReflection_MsgBurnResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgBurnResponse)(nil)
+func (x fastReflection_MsgMintResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgMintResponse)(nil)
 }
-func (x fastReflection_MsgBurnResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgBurnResponse)
+func (x fastReflection_MsgMintResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgMintResponse)
 }
-func (x fastReflection_MsgBurnResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgBurnResponse
+func (x fastReflection_MsgMintResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgMintResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgBurnResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgBurnResponse
+func (x *fastReflection_MsgMintResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgMintResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgBurnResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgBurnResponse_messageType
+func (x *fastReflection_MsgMintResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgMintResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgBurnResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgBurnResponse)
+func (x *fastReflection_MsgMintResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgMintResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgBurnResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgBurnResponse)(x)
+func (x *fastReflection_MsgMintResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgMintResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8146,7 +13420,7 @@ func (x *fastReflection_MsgBurnResponse) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgBurnResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgMintResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -8160,13 +13434,13 @@ func (x *fastReflection_MsgBurnResponse) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgBurnResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgMintResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBurnResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgMintResponse"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgBurnResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgMintResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8176,13 +13450,13 @@ func (x *fastReflection_MsgBurnResponse) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgBurnResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgMintResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBurnResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgMintResponse"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgBurnResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgMintResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8192,13 +13466,13 @@ func (x *fastReflection_MsgBurnResponse) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgBurnResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgMintResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBurnResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgMintResponse"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgBurnResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgMintResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8212,13 +13486,13 @@ func (x *fastReflection_MsgBurnResponse) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgBurnResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgMintResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBurnResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgMintResponse"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgBurnResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgMintResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8232,36 +13506,36 @@ func (x *fastReflection_MsgBurnResponse) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgBurnResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgMintResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBurnResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgMintResponse"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgBurnResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgMintResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgBurnResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgMintResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgBurnResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgMintResponse"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgBurnResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgMintResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgBurnResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgMintResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.MsgBurnResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.MsgMintResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8269,7 +13543,7 @@ func (x *fastReflection_MsgBurnResponse) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgBurnResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgMintResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8280,7 +13554,7 @@ func (x *fastReflection_MsgBurnResponse) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgBurnResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgMintResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8292,7 +13566,7 @@ func (x *fastReflection_MsgBurnResponse) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgBurnResponse) IsValid() bool {
+func (x *fastReflection_MsgMintResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -8302,9 +13576,9 @@ func (x *fastReflection_MsgBurnResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgBurnResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgMintResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgBurnResponse)
+		x := input.Message.Interface().(*MsgMintResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8326,7 +13600,7 @@ func (x *fastReflection_MsgBurnResponse) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgBurnResponse)
+		x := input.Message.Interface().(*MsgMintResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8356,7 +13630,7 @@ func (x *fastReflection_MsgBurnResponse) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgBurnResponse)
+		x := input.Message.Interface().(*MsgMintResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8388,10 +13662,10 @@ func (x *fastReflection_MsgBurnResponse) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgMintResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -8429,82 +13703,29 @@ func (x *fastReflection_MsgBurnResponse) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_MsgMint_3_list)(nil)
-
-type _MsgMint_3_list struct {
-	list *[]*v1beta1.Coin
-}
-
-func (x *_MsgMint_3_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_MsgMint_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_MsgMint_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_MsgMint_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_MsgMint_3_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MsgMint_3_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_MsgMint_3_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MsgMint_3_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_MsgMint            protoreflect.MessageDescriptor
-	fd_MsgMint_authority  protoreflect.FieldDescriptor
-	fd_MsgMint_to_address protoreflect.FieldDescriptor
-	fd_MsgMint_amount     protoreflect.FieldDescriptor
+	md_MsgUpdateParams           protoreflect.MessageDescriptor
+	fd_MsgUpdateParams_authority protoreflect.FieldDescriptor
+	fd_MsgUpdateParams_params    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cheqd_did_v2_tx_proto_init()
-	md_MsgMint = File_cheqd_did_v2_tx_proto.Messages().ByName("MsgMint")
-	fd_MsgMint_authority = md_MsgMint.Fields().ByName("authority")
-	fd_MsgMint_to_address = md_MsgMint.Fields().ByName("to_address")
-	fd_MsgMint_amount = md_MsgMint.Fields().ByName("amount")
+	md_MsgUpdateParams = File_cheqd_did_v2_tx_proto.Messages().ByName("MsgUpdateParams")
+	fd_MsgUpdateParams_authority = md_MsgUpdateParams.Fields().ByName("authority")
+	fd_MsgUpdateParams_params = md_MsgUpdateParams.Fields().ByName("params")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgMint)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateParams)(nil)
 
-type fastReflection_MsgMint MsgMint
+type fastReflection_MsgUpdateParams MsgUpdateParams
 
-func (x *MsgMint) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgMint)(x)
+func (x *MsgUpdateParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParams)(x)
 }
 
-func (x *MsgMint) slowProtoReflect() protoreflect.Message {
-	mi := &file_cheqd_did_v2_tx_proto_msgTypes[12]
+func (x *MsgUpdateParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_tx_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8515,43 +13736,43 @@ func (x *MsgMint) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgMint_messageType fastReflection_MsgMint_messageType
-var _ protoreflect.MessageType = fastReflection_MsgMint_messageType{}
+var _fastReflection_MsgUpdateParams_messageType fastReflection_MsgUpdateParams_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateParams_messageType{}
 
-type fastReflection_MsgMint_messageType struct{}
+type fastReflection_MsgUpdateParams_messageType struct{}
 
-func (x fastReflection_MsgMint_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgMint)(nil)
+func (x fastReflection_MsgUpdateParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParams)(nil)
 }
-func (x fastReflection_MsgMint_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgMint)
+func (x fastReflection_MsgUpdateParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParams)
 }
-func (x fastReflection_MsgMint_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgMint
+func (x fastReflection_MsgUpdateParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParams
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgMint) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgMint
+func (x *fastReflection_MsgUpdateParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParams
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgMint) Type() protoreflect.MessageType {
-	return _fastReflection_MsgMint_messageType
+func (x *fastReflection_MsgUpdateParams) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateParams_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgMint) New() protoreflect.Message {
-	return new(fastReflection_MsgMint)
+func (x *fastReflection_MsgUpdateParams) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParams)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgMint) Interface() protoreflect.ProtoMessage {
-	return (*MsgMint)(x)
+func (x *fastReflection_MsgUpdateParams) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateParams)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8559,22 +13780,16 @@ func (x *fastReflection_MsgMint) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgMint) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgUpdateParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Authority != "" {
 		value := protoreflect.ValueOfString(x.Authority)
-		if !f(fd_MsgMint_authority, value) {
-			return
-		}
-	}
-	if x.ToAddress != "" {
-		value := protoreflect.ValueOfString(x.ToAddress)
-		if !f(fd_MsgMint_to_address, value) {
+		if !f(fd_MsgUpdateParams_authority, value) {
 			return
 		}
 	}
-	if len(x.Amount) != 0 {
-		value := protoreflect.ValueOfList(&_MsgMint_3_list{list: &x.Amount})
-		if !f(fd_MsgMint_amount, value) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_MsgUpdateParams_params, value) {
 			return
 		}
 	}
@@ -8591,19 +13806,17 @@ func (x *fastReflection_MsgMint) Range(f func(protoreflect.FieldDescriptor, prot
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgMint) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateParams) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgMint.authority":
+	case "cheqd.did.v2.MsgUpdateParams.authority":
 		return x.Authority != ""
-	case "cheqd.did.v2.MsgMint.to_address":
-		return x.ToAddress != ""
-	case "cheqd.did.v2.MsgMint.amount":
-		return len(x.Amount) != 0
+	case "cheqd.did.v2.MsgUpdateParams.params":
+		return x.Params != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgMint"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateParams"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgMint does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgUpdateParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8613,19 +13826,17 @@ func (x *fastReflection_MsgMint) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgMint) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateParams) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgMint.authority":
+	case "cheqd.did.v2.MsgUpdateParams.authority":
 		x.Authority = ""
-	case "cheqd.did.v2.MsgMint.to_address":
-		x.ToAddress = ""
-	case "cheqd.did.v2.MsgMint.amount":
-		x.Amount = nil
+	case "cheqd.did.v2.MsgUpdateParams.params":
+		x.Params = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgMint"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateParams"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgMint does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgUpdateParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8635,25 +13846,19 @@ func (x *fastReflection_MsgMint) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgMint) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cheqd.did.v2.MsgMint.authority":
+	case "cheqd.did.v2.MsgUpdateParams.authority":
 		value := x.Authority
 		return protoreflect.ValueOfString(value)
-	case "cheqd.did.v2.MsgMint.to_address":
-		value := x.ToAddress
-		return protoreflect.ValueOfString(value)
-	case "cheqd.did.v2.MsgMint.amount":
-		if len(x.Amount) == 0 {
-			return protoreflect.ValueOfList(&_MsgMint_3_list{})
-		}
-		listValue := &_MsgMint_3_list{list: &x.Amount}
-		return protoreflect.ValueOfList(listValue)
+	case "cheqd.did.v2.MsgUpdateParams.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgMint"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateParams"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgMint does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgUpdateParams does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8667,21 +13872,17 @@ func (x *fastReflection_MsgMint) Get(descriptor protoreflect.FieldDescriptor) pr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgMint) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgMint.authority":
+	case "cheqd.did.v2.MsgUpdateParams.authority":
 		x.Authority = value.Interface().(string)
-	case "cheqd.did.v2.MsgMint.to_address":
-		x.ToAddress = value.Interface().(string)
-	case "cheqd.did.v2.MsgMint.amount":
-		lv := value.List()
-		clv := lv.(*_MsgMint_3_list)
-		x.Amount = *clv.list
+	case "cheqd.did.v2.MsgUpdateParams.params":
+		x.Params = value.Message().Interface().(*FeeParams)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgMint"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateParams"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgMint does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgUpdateParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8695,53 +13896,48 @@ func (x *fastReflection_MsgMint) Set(fd protoreflect.FieldDescriptor, value prot
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgMint) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgMint.amount":
-		if x.Amount == nil {
-			x.Amount = []*v1beta1.Coin{}
+	case "cheqd.did.v2.MsgUpdateParams.params":
+		if x.Params == nil {
+			x.Params = new(FeeParams)
 		}
-		value := &_MsgMint_3_list{list: &x.Amount}
-		return protoreflect.ValueOfList(value)
-	case "cheqd.did.v2.MsgMint.authority":
-		panic(fmt.Errorf("field authority of message cheqd.did.v2.MsgMint is not mutable"))
-	case "cheqd.did.v2.MsgMint.to_address":
-		panic(fmt.Errorf("field to_address of message cheqd.did.v2.MsgMint is not mutable"))
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "cheqd.did.v2.MsgUpdateParams.authority":
+		panic(fmt.Errorf("field authority of message cheqd.did.v2.MsgUpdateParams is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgMint"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateParams"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgMint does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgUpdateParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgMint) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgMint.authority":
-		return protoreflect.ValueOfString("")
-	case "cheqd.did.v2.MsgMint.to_address":
+	case "cheqd.did.v2.MsgUpdateParams.authority":
 		return protoreflect.ValueOfString("")
-	case "cheqd.did.v2.MsgMint.amount":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_MsgMint_3_list{list: &list})
+	case "cheqd.did.v2.MsgUpdateParams.params":
+		m := new(FeeParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgMint"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateParams"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgMint does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgUpdateParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgMint) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.MsgMint", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.MsgUpdateParams", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8749,7 +13945,7 @@ func (x *fastReflection_MsgMint) WhichOneof(d protoreflect.OneofDescriptor) prot
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgMint) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateParams) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8760,7 +13956,7 @@ func (x *fastReflection_MsgMint) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgMint) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateParams) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8772,7 +13968,7 @@ func (x *fastReflection_MsgMint) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgMint) IsValid() bool {
+func (x *fastReflection_MsgUpdateParams) IsValid() bool {
 	return x != nil
 }
 
@@ -8782,9 +13978,9 @@ func (x *fastReflection_MsgMint) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgMint) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgMint)
+		x := input.Message.Interface().(*MsgUpdateParams)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8800,16 +13996,10 @@ func (x *fastReflection_MsgMint) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.ToAddress)
-		if l > 0 {
+		if x.Params != nil {
+			l = options.Size(x.Params)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Amount) > 0 {
-			for _, e := range x.Amount {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -8820,7 +14010,7 @@ func (x *fastReflection_MsgMint) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgMint)
+		x := input.Message.Interface().(*MsgUpdateParams)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8839,26 +14029,17 @@ func (x *fastReflection_MsgMint) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Amount) > 0 {
-			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Amount[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1a
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
-		}
-		if len(x.ToAddress) > 0 {
-			i -= len(x.ToAddress)
-			copy(dAtA[i:], x.ToAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ToAddress)))
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -8880,7 +14061,7 @@ func (x *fastReflection_MsgMint) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgMint)
+		x := input.Message.Interface().(*MsgUpdateParams)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8912,47 +14093,15 @@ func (x *fastReflection_MsgMint) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgMint: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgMint: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Authority = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -8980,11 +14129,11 @@ func (x *fastReflection_MsgMint) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ToAddress = string(dAtA[iNdEx:postIndex])
+				x.Authority = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -9011,8 +14160,10 @@ func (x *fastReflection_MsgMint) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Amount = append(x.Amount, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
+				if x.Params == nil {
+					x.Params = &FeeParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -9052,24 +14203,24 @@ func (x *fastReflection_MsgMint) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgMintResponse protoreflect.MessageDescriptor
+	md_MsgUpdateParamsResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_cheqd_did_v2_tx_proto_init()
-	md_MsgMintResponse = File_cheqd_did_v2_tx_proto.Messages().ByName("MsgMintResponse")
+	md_MsgUpdateParamsResponse = File_cheqd_did_v2_tx_proto.Messages().ByName("MsgUpdateParamsResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgMintResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateParamsResponse)(nil)
 
-type fastReflection_MsgMintResponse MsgMintResponse
+type fastReflection_MsgUpdateParamsResponse MsgUpdateParamsResponse
 
-func (x *MsgMintResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgMintResponse)(x)
+func (x *MsgUpdateParamsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParamsResponse)(x)
 }
 
-func (x *MsgMintResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cheqd_did_v2_tx_proto_msgTypes[13]
+func (x *MsgUpdateParamsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_tx_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9080,43 +14231,43 @@ func (x *MsgMintResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgMintResponse_messageType fastReflection_MsgMintResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgMintResponse_messageType{}
+var _fastReflection_MsgUpdateParamsResponse_messageType fastReflection_MsgUpdateParamsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateParamsResponse_messageType{}
 
-type fastReflection_MsgMintResponse_messageType struct{}
+type fastReflection_MsgUpdateParamsResponse_messageType struct{}
 
-func (x fastReflection_MsgMintResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgMintResponse)(nil)
+func (x fastReflection_MsgUpdateParamsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParamsResponse)(nil)
 }
-func (x fastReflection_MsgMintResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgMintResponse)
+func (x fastReflection_MsgUpdateParamsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParamsResponse)
 }
-func (x fastReflection_MsgMintResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgMintResponse
+func (x fastReflection_MsgUpdateParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParamsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgMintResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgMintResponse
+func (x *fastReflection_MsgUpdateParamsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParamsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgMintResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgMintResponse_messageType
+func (x *fastReflection_MsgUpdateParamsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateParamsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgMintResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgMintResponse)
+func (x *fastReflection_MsgUpdateParamsResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParamsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgMintResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgMintResponse)(x)
+func (x *fastReflection_MsgUpdateParamsResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateParamsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9124,7 +14275,7 @@ func (x *fastReflection_MsgMintResponse) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgMintResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgUpdateParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -9138,13 +14289,13 @@ func (x *fastReflection_MsgMintResponse) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgMintResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgMintResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateParamsResponse"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgMintResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9154,13 +14305,13 @@ func (x *fastReflection_MsgMintResponse) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgMintResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgMintResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateParamsResponse"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgMintResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9170,13 +14321,13 @@ func (x *fastReflection_MsgMintResponse) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgMintResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgMintResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateParamsResponse"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgMintResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgUpdateParamsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9190,13 +14341,13 @@ func (x *fastReflection_MsgMintResponse) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgMintResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgMintResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateParamsResponse"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgMintResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9210,36 +14361,36 @@ func (x *fastReflection_MsgMintResponse) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgMintResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgMintResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateParamsResponse"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgMintResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgMintResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgMintResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateParamsResponse"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgMintResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgMintResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.MsgMintResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.MsgUpdateParamsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9247,7 +14398,7 @@ func (x *fastReflection_MsgMintResponse) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgMintResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateParamsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9258,7 +14409,7 @@ func (x *fastReflection_MsgMintResponse) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgMintResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateParamsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9270,7 +14421,7 @@ func (x *fastReflection_MsgMintResponse) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgMintResponse) IsValid() bool {
+func (x *fastReflection_MsgUpdateParamsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -9280,9 +14431,9 @@ func (x *fastReflection_MsgMintResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgMintResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgMintResponse)
+		x := input.Message.Interface().(*MsgUpdateParamsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9304,7 +14455,7 @@ func (x *fastReflection_MsgMintResponse) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgMintResponse)
+		x := input.Message.Interface().(*MsgUpdateParamsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9334,7 +14485,7 @@ func (x *fastReflection_MsgMintResponse) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgMintResponse)
+		x := input.Message.Interface().(*MsgUpdateParamsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9366,10 +14517,10 @@ func (x *fastReflection_MsgMintResponse) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgMintResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -9408,28 +14559,28 @@ func (x *fastReflection_MsgMintResponse) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgUpdateParams           protoreflect.MessageDescriptor
-	fd_MsgUpdateParams_authority protoreflect.FieldDescriptor
-	fd_MsgUpdateParams_params    protoreflect.FieldDescriptor
+	md_MsgPurchaseIdentityCredits        protoreflect.MessageDescriptor
+	fd_MsgPurchaseIdentityCredits_buyer  protoreflect.FieldDescriptor
+	fd_MsgPurchaseIdentityCredits_amount protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cheqd_did_v2_tx_proto_init()
-	md_MsgUpdateParams = File_cheqd_did_v2_tx_proto.Messages().ByName("MsgUpdateParams")
-	fd_MsgUpdateParams_authority = md_MsgUpdateParams.Fields().ByName("authority")
-	fd_MsgUpdateParams_params = md_MsgUpdateParams.Fields().ByName("params")
+	md_MsgPurchaseIdentityCredits = File_cheqd_did_v2_tx_proto.Messages().ByName("MsgPurchaseIdentityCredits")
+	fd_MsgPurchaseIdentityCredits_buyer = md_MsgPurchaseIdentityCredits.Fields().ByName("buyer")
+	fd_MsgPurchaseIdentityCredits_amount = md_MsgPurchaseIdentityCredits.Fields().ByName("amount")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateParams)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgPurchaseIdentityCredits)(nil)
 
-type fastReflection_MsgUpdateParams MsgUpdateParams
+type fastReflection_MsgPurchaseIdentityCredits MsgPurchaseIdentityCredits
 
-func (x *MsgUpdateParams) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateParams)(x)
+func (x *MsgPurchaseIdentityCredits) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgPurchaseIdentityCredits)(x)
 }
 
-func (x *MsgUpdateParams) slowProtoReflect() protoreflect.Message {
-	mi := &file_cheqd_did_v2_tx_proto_msgTypes[14]
+func (x *MsgPurchaseIdentityCredits) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_tx_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9440,43 +14591,43 @@ func (x *MsgUpdateParams) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateParams_messageType fastReflection_MsgUpdateParams_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateParams_messageType{}
+var _fastReflection_MsgPurchaseIdentityCredits_messageType fastReflection_MsgPurchaseIdentityCredits_messageType
+var _ protoreflect.MessageType = fastReflection_MsgPurchaseIdentityCredits_messageType{}
 
-type fastReflection_MsgUpdateParams_messageType struct{}
+type fastReflection_MsgPurchaseIdentityCredits_messageType struct{}
 
-func (x fastReflection_MsgUpdateParams_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateParams)(nil)
+func (x fastReflection_MsgPurchaseIdentityCredits_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgPurchaseIdentityCredits)(nil)
 }
-func (x fastReflection_MsgUpdateParams_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateParams)
+func (x fastReflection_MsgPurchaseIdentityCredits_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgPurchaseIdentityCredits)
 }
-func (x fastReflection_MsgUpdateParams_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateParams
+func (x fastReflection_MsgPurchaseIdentityCredits_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgPurchaseIdentityCredits
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateParams) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateParams
+func (x *fastReflection_MsgPurchaseIdentityCredits) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgPurchaseIdentityCredits
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateParams) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateParams_messageType
+func (x *fastReflection_MsgPurchaseIdentityCredits) Type() protoreflect.MessageType {
+	return _fastReflection_MsgPurchaseIdentityCredits_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateParams) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateParams)
+func (x *fastReflection_MsgPurchaseIdentityCredits) New() protoreflect.Message {
+	return new(fastReflection_MsgPurchaseIdentityCredits)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateParams) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateParams)(x)
+func (x *fastReflection_MsgPurchaseIdentityCredits) Interface() protoreflect.ProtoMessage {
+	return (*MsgPurchaseIdentityCredits)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9484,16 +14635,16 @@ func (x *fastReflection_MsgUpdateParams) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Authority != "" {
-		value := protoreflect.ValueOfString(x.Authority)
-		if !f(fd_MsgUpdateParams_authority, value) {
+func (x *fastReflection_MsgPurchaseIdentityCredits) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Buyer != "" {
+		value := protoreflect.ValueOfString(x.Buyer)
+		if !f(fd_MsgPurchaseIdentityCredits_buyer, value) {
 			return
 		}
 	}
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_MsgUpdateParams_params, value) {
+	if x.Amount != nil {
+		value := protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+		if !f(fd_MsgPurchaseIdentityCredits_amount, value) {
 			return
 		}
 	}
@@ -9510,17 +14661,17 @@ func (x *fastReflection_MsgUpdateParams) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateParams) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgPurchaseIdentityCredits) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgUpdateParams.authority":
-		return x.Authority != ""
-	case "cheqd.did.v2.MsgUpdateParams.params":
-		return x.Params != nil
+	case "cheqd.did.v2.MsgPurchaseIdentityCredits.buyer":
+		return x.Buyer != ""
+	case "cheqd.did.v2.MsgPurchaseIdentityCredits.amount":
+		return x.Amount != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgPurchaseIdentityCredits"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgPurchaseIdentityCredits does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9530,17 +14681,17 @@ func (x *fastReflection_MsgUpdateParams) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParams) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgPurchaseIdentityCredits) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgUpdateParams.authority":
-		x.Authority = ""
-	case "cheqd.did.v2.MsgUpdateParams.params":
-		x.Params = nil
+	case "cheqd.did.v2.MsgPurchaseIdentityCredits.buyer":
+		x.Buyer = ""
+	case "cheqd.did.v2.MsgPurchaseIdentityCredits.amount":
+		x.Amount = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgPurchaseIdentityCredits"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgPurchaseIdentityCredits does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9550,19 +14701,19 @@ func (x *fastReflection_MsgUpdateParams) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgPurchaseIdentityCredits) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cheqd.did.v2.MsgUpdateParams.authority":
-		value := x.Authority
+	case "cheqd.did.v2.MsgPurchaseIdentityCredits.buyer":
+		value := x.Buyer
 		return protoreflect.ValueOfString(value)
-	case "cheqd.did.v2.MsgUpdateParams.params":
-		value := x.Params
+	case "cheqd.did.v2.MsgPurchaseIdentityCredits.amount":
+		value := x.Amount
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgPurchaseIdentityCredits"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgUpdateParams does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgPurchaseIdentityCredits does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9576,17 +14727,17 @@ func (x *fastReflection_MsgUpdateParams) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgPurchaseIdentityCredits) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgUpdateParams.authority":
-		x.Authority = value.Interface().(string)
-	case "cheqd.did.v2.MsgUpdateParams.params":
-		x.Params = value.Message().Interface().(*FeeParams)
+	case "cheqd.did.v2.MsgPurchaseIdentityCredits.buyer":
+		x.Buyer = value.Interface().(string)
+	case "cheqd.did.v2.MsgPurchaseIdentityCredits.amount":
+		x.Amount = value.Message().Interface().(*v1beta1.Coin)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgPurchaseIdentityCredits"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgPurchaseIdentityCredits does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9600,48 +14751,48 @@ func (x *fastReflection_MsgUpdateParams) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgPurchaseIdentityCredits) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgUpdateParams.params":
-		if x.Params == nil {
-			x.Params = new(FeeParams)
+	case "cheqd.did.v2.MsgPurchaseIdentityCredits.amount":
+		if x.Amount == nil {
+			x.Amount = new(v1beta1.Coin)
 		}
-		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-	case "cheqd.did.v2.MsgUpdateParams.authority":
-		panic(fmt.Errorf("field authority of message cheqd.did.v2.MsgUpdateParams is not mutable"))
+		return protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+	case "cheqd.did.v2.MsgPurchaseIdentityCredits.buyer":
+		panic(fmt.Errorf("field buyer of message cheqd.did.v2.MsgPurchaseIdentityCredits is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgPurchaseIdentityCredits"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgPurchaseIdentityCredits does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgPurchaseIdentityCredits) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgUpdateParams.authority":
+	case "cheqd.did.v2.MsgPurchaseIdentityCredits.buyer":
 		return protoreflect.ValueOfString("")
-	case "cheqd.did.v2.MsgUpdateParams.params":
-		m := new(FeeParams)
+	case "cheqd.did.v2.MsgPurchaseIdentityCredits.amount":
+		m := new(v1beta1.Coin)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgPurchaseIdentityCredits"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgPurchaseIdentityCredits does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgPurchaseIdentityCredits) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.MsgUpdateParams", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.MsgPurchaseIdentityCredits", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9649,7 +14800,7 @@ func (x *fastReflection_MsgUpdateParams) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateParams) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgPurchaseIdentityCredits) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9660,7 +14811,7 @@ func (x *fastReflection_MsgUpdateParams) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParams) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgPurchaseIdentityCredits) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9672,7 +14823,7 @@ func (x *fastReflection_MsgUpdateParams) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateParams) IsValid() bool {
+func (x *fastReflection_MsgPurchaseIdentityCredits) IsValid() bool {
 	return x != nil
 }
 
@@ -9682,9 +14833,9 @@ func (x *fastReflection_MsgUpdateParams) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgPurchaseIdentityCredits) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateParams)
+		x := input.Message.Interface().(*MsgPurchaseIdentityCredits)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9696,12 +14847,12 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Authority)
+		l = len(x.Buyer)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Params != nil {
-			l = options.Size(x.Params)
+		if x.Amount != nil {
+			l = options.Size(x.Amount)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -9714,7 +14865,7 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateParams)
+		x := input.Message.Interface().(*MsgPurchaseIdentityCredits)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9733,8 +14884,8 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
+		if x.Amount != nil {
+			encoded, err := options.Marshal(x.Amount)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9747,10 +14898,10 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Authority) > 0 {
-			i -= len(x.Authority)
-			copy(dAtA[i:], x.Authority)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+		if len(x.Buyer) > 0 {
+			i -= len(x.Buyer)
+			copy(dAtA[i:], x.Buyer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Buyer)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -9765,7 +14916,7 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateParams)
+		x := input.Message.Interface().(*MsgPurchaseIdentityCredits)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9797,15 +14948,15 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPurchaseIdentityCredits: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPurchaseIdentityCredits: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -9833,11 +14984,11 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Authority = string(dAtA[iNdEx:postIndex])
+				x.Buyer = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -9864,10 +15015,10 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &FeeParams{}
+				if x.Amount == nil {
+					x.Amount = &v1beta1.Coin{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -9907,24 +15058,26 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgUpdateParamsResponse protoreflect.MessageDescriptor
+	md_MsgPurchaseIdentityCreditsResponse         protoreflect.MessageDescriptor
+	fd_MsgPurchaseIdentityCreditsResponse_balance protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cheqd_did_v2_tx_proto_init()
-	md_MsgUpdateParamsResponse = File_cheqd_did_v2_tx_proto.Messages().ByName("MsgUpdateParamsResponse")
+	md_MsgPurchaseIdentityCreditsResponse = File_cheqd_did_v2_tx_proto.Messages().ByName("MsgPurchaseIdentityCreditsResponse")
+	fd_MsgPurchaseIdentityCreditsResponse_balance = md_MsgPurchaseIdentityCreditsResponse.Fields().ByName("balance")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateParamsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgPurchaseIdentityCreditsResponse)(nil)
 
-type fastReflection_MsgUpdateParamsResponse MsgUpdateParamsResponse
+type fastReflection_MsgPurchaseIdentityCreditsResponse MsgPurchaseIdentityCreditsResponse
 
-func (x *MsgUpdateParamsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateParamsResponse)(x)
+func (x *MsgPurchaseIdentityCreditsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgPurchaseIdentityCreditsResponse)(x)
 }
 
-func (x *MsgUpdateParamsResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cheqd_did_v2_tx_proto_msgTypes[15]
+func (x *MsgPurchaseIdentityCreditsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_tx_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9935,43 +15088,43 @@ func (x *MsgUpdateParamsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateParamsResponse_messageType fastReflection_MsgUpdateParamsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateParamsResponse_messageType{}
+var _fastReflection_MsgPurchaseIdentityCreditsResponse_messageType fastReflection_MsgPurchaseIdentityCreditsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgPurchaseIdentityCreditsResponse_messageType{}
 
-type fastReflection_MsgUpdateParamsResponse_messageType struct{}
+type fastReflection_MsgPurchaseIdentityCreditsResponse_messageType struct{}
 
-func (x fastReflection_MsgUpdateParamsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateParamsResponse)(nil)
+func (x fastReflection_MsgPurchaseIdentityCreditsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgPurchaseIdentityCreditsResponse)(nil)
 }
-func (x fastReflection_MsgUpdateParamsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateParamsResponse)
+func (x fastReflection_MsgPurchaseIdentityCreditsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgPurchaseIdentityCreditsResponse)
 }
-func (x fastReflection_MsgUpdateParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateParamsResponse
+func (x fastReflection_MsgPurchaseIdentityCreditsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgPurchaseIdentityCreditsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateParamsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateParamsResponse
+func (x *fastReflection_MsgPurchaseIdentityCreditsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgPurchaseIdentityCreditsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateParamsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateParamsResponse_messageType
+func (x *fastReflection_MsgPurchaseIdentityCreditsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgPurchaseIdentityCreditsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateParamsResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateParamsResponse)
+func (x *fastReflection_MsgPurchaseIdentityCreditsResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgPurchaseIdentityCreditsResponse)
 }
 
 // Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateParamsResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateParamsResponse)(x)
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgPurchaseIdentityCreditsResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgPurchaseIdentityCreditsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9979,7 +15132,13 @@ func (x *fastReflection_MsgUpdateParamsResponse) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgPurchaseIdentityCreditsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Balance != nil {
+		value := protoreflect.ValueOfMessage(x.Balance.ProtoReflect())
+		if !f(fd_MsgPurchaseIdentityCreditsResponse_balance, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -9993,13 +15152,15 @@ func (x *fastReflection_MsgUpdateParamsResponse) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgPurchaseIdentityCreditsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "cheqd.did.v2.MsgPurchaseIdentityCreditsResponse.balance":
+		return x.Balance != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgPurchaseIdentityCreditsResponse"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgPurchaseIdentityCreditsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10009,13 +15170,15 @@ func (x *fastReflection_MsgUpdateParamsResponse) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgPurchaseIdentityCreditsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "cheqd.did.v2.MsgPurchaseIdentityCreditsResponse.balance":
+		x.Balance = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgPurchaseIdentityCreditsResponse"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgPurchaseIdentityCreditsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10025,13 +15188,16 @@ func (x *fastReflection_MsgUpdateParamsResponse) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgPurchaseIdentityCreditsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "cheqd.did.v2.MsgPurchaseIdentityCreditsResponse.balance":
+		value := x.Balance
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgPurchaseIdentityCreditsResponse"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgUpdateParamsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgPurchaseIdentityCreditsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10045,13 +15211,15 @@ func (x *fastReflection_MsgUpdateParamsResponse) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgPurchaseIdentityCreditsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "cheqd.did.v2.MsgPurchaseIdentityCreditsResponse.balance":
+		x.Balance = value.Message().Interface().(*v1beta1.Coin)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgPurchaseIdentityCreditsResponse"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgPurchaseIdentityCreditsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10065,36 +15233,44 @@ func (x *fastReflection_MsgUpdateParamsResponse) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgPurchaseIdentityCreditsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cheqd.did.v2.MsgPurchaseIdentityCreditsResponse.balance":
+		if x.Balance == nil {
+			x.Balance = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Balance.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgPurchaseIdentityCreditsResponse"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgPurchaseIdentityCreditsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgPurchaseIdentityCreditsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cheqd.did.v2.MsgPurchaseIdentityCreditsResponse.balance":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgPurchaseIdentityCreditsResponse"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgPurchaseIdentityCreditsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgPurchaseIdentityCreditsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.MsgUpdateParamsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.MsgPurchaseIdentityCreditsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10102,7 +15278,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateParamsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgPurchaseIdentityCreditsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10113,7 +15289,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParamsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgPurchaseIdentityCreditsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10125,7 +15301,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateParamsResponse) IsValid() bool {
+func (x *fastReflection_MsgPurchaseIdentityCreditsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -10135,9 +15311,9 @@ func (x *fastReflection_MsgUpdateParamsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgPurchaseIdentityCreditsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		x := input.Message.Interface().(*MsgPurchaseIdentityCreditsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10149,6 +15325,10 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
+		if x.Balance != nil {
+			l = options.Size(x.Balance)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -10159,7 +15339,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		x := input.Message.Interface().(*MsgPurchaseIdentityCreditsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10178,6 +15358,20 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Balance != nil {
+			encoded, err := options.Marshal(x.Balance)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -10189,7 +15383,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		x := input.Message.Interface().(*MsgPurchaseIdentityCreditsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10221,12 +15415,48 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPurchaseIdentityCreditsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPurchaseIdentityCreditsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Balance == nil {
+					x.Balance = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Balance); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -10426,6 +15656,11 @@ type SignInfo struct {
 	VerificationMethodId string `protobuf:"bytes,1,opt,name=verification_method_id,json=verificationMethodId,proto3" json:"verification_method_id,omitempty"`
 	// Signature of the DID Document controller
 	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	// Version ID of the DID Document the verification method belongs to. OPTIONAL.
+	// On DID Document updates it identifies whether the signature is made by the verification method
+	// of the existing or of the updated version. If not set, the signature is checked against both versions.
+	// For all other DID Documents it must match the latest version, if set.
+	VersionId string `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
 }
 
 func (x *SignInfo) Reset() {
@@ -10462,6 +15697,13 @@ func (x *SignInfo) GetSignature() []byte {
 	return nil
 }
 
+func (x *SignInfo) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
 // MsgCreateDidDocPayload defines the structure of the payload for creating a new DID document
 type MsgCreateDidDocPayload struct {
 	state         protoimpl.MessageState
@@ -10482,7 +15724,7 @@ type MsgCreateDidDocPayload struct {
 	//
 	// Required fields:
 	// - id: A unique identifier for the verification method
-	// - type: A supported verification method type (supported: Ed25519VerificationKey2018, Ed25519VerificationKey2020, JsonWebKey2020)
+	// - type: A supported verification method type (supported: Ed25519VerificationKey2018, Ed25519VerificationKey2020, JsonWebKey2020, EcdsaSecp256k1VerificationKey2019, EcdsaSecp256k1RecoveryMethod2020, Multikey)
 	// - controller: DID of the controller of the verification method
 	// - verification_material: Public key of the verification method (supported: publicJwk, publicKeyBase58, publicKeyMultibase)
 	VerificationMethod []*VerificationMethod `protobuf:"bytes,4,rep,name=verification_method,json=verificationMethod,proto3" json:"verification_method,omitempty"`
@@ -10515,6 +15757,14 @@ type MsgCreateDidDocPayload struct {
 	//
 	// Format: <uuid>
 	VersionId string `protobuf:"bytes,12,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// recovery_commitment is a commitment to a pre-rotated recovery key. OPTIONAL.
+	// It is the hex encoded SHA-256 hash of the recovery key type and verification material.
+	//
+	// Format: <hex-encoded-sha256>
+	RecoveryCommitment string `protobuf:"bytes,13,opt,name=recovery_commitment,json=recoveryCommitment,proto3" json:"recovery_commitment,omitempty"`
+	// controllerThreshold is the minimum number of controllers that must sign on behalf of the DID subject. OPTIONAL.
+	// Zero requires signatures of all controllers.
+	ControllerThreshold uint32 `protobuf:"varint,14,opt,name=controller_threshold,json=controllerThreshold,proto3" json:"controller_threshold,omitempty"`
 }
 
 func (x *MsgCreateDidDocPayload) Reset() {
@@ -10621,6 +15871,20 @@ func (x *MsgCreateDidDocPayload) GetVersionId() string {
 	return ""
 }
 
+func (x *MsgCreateDidDocPayload) GetRecoveryCommitment() string {
+	if x != nil {
+		return x.RecoveryCommitment
+	}
+	return ""
+}
+
+func (x *MsgCreateDidDocPayload) GetControllerThreshold() uint32 {
+	if x != nil {
+		return x.ControllerThreshold
+	}
+	return 0
+}
+
 // MsgCreateDidDocResponse defines response type for Msg/CreateDidDoc.
 type MsgCreateDidDocResponse struct {
 	state         protoimpl.MessageState
@@ -10678,7 +15942,7 @@ type MsgUpdateDidDocPayload struct {
 	//
 	// Required fields:
 	// - id: A unique identifier for the verification method
-	// - type: A supported verification method type (supported: Ed25519VerificationKey2018, Ed25519VerificationKey2020, JsonWebKey2020)
+	// - type: A supported verification method type (supported: Ed25519VerificationKey2018, Ed25519VerificationKey2020, JsonWebKey2020, EcdsaSecp256k1VerificationKey2019, EcdsaSecp256k1RecoveryMethod2020, Multikey)
 	// - controller: DID of the controller of the verification method
 	// - verification_material: Public key of the verification method (supported: publicJwk, publicKeyBase58, publicKeyMultibase)
 	VerificationMethod []*VerificationMethod `protobuf:"bytes,4,rep,name=verification_method,json=verificationMethod,proto3" json:"verification_method,omitempty"`
@@ -10712,6 +15976,18 @@ type MsgUpdateDidDocPayload struct {
 	//
 	// Format: <uuid>
 	VersionId string `protobuf:"bytes,12,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// recovery_commitment is a commitment to a pre-rotated recovery key. OPTIONAL.
+	// It is the hex encoded SHA-256 hash of the recovery key type and verification material.
+	// If not set, the current commitment is kept.
+	//
+	// Format: <hex-encoded-sha256>
+	RecoveryCommitment string `protobuf:"bytes,13,opt,name=recovery_commitment,json=recoveryCommitment,proto3" json:"recovery_commitment,omitempty"`
+	// clear_recovery_commitment removes the current recovery commitment, so the DID Document can't be recovered anymore.
+	// OPTIONAL. Can't be combined with recovery_commitment.
+	ClearRecoveryCommitment bool `protobuf:"varint,14,opt,name=clear_recovery_commitment,json=clearRecoveryCommitment,proto3" json:"clear_recovery_commitment,omitempty"`
+	// controllerThreshold is the minimum number of controllers that must sign on behalf of the DID subject. OPTIONAL.
+	// Zero requires signatures of all controllers.
+	ControllerThreshold uint32 `protobuf:"varint,15,opt,name=controller_threshold,json=controllerThreshold,proto3" json:"controller_threshold,omitempty"`
 }
 
 func (x *MsgUpdateDidDocPayload) Reset() {
@@ -10818,6 +16094,27 @@ func (x *MsgUpdateDidDocPayload) GetVersionId() string {
 	return ""
 }
 
+func (x *MsgUpdateDidDocPayload) GetRecoveryCommitment() string {
+	if x != nil {
+		return x.RecoveryCommitment
+	}
+	return ""
+}
+
+func (x *MsgUpdateDidDocPayload) GetClearRecoveryCommitment() bool {
+	if x != nil {
+		return x.ClearRecoveryCommitment
+	}
+	return false
+}
+
+func (x *MsgUpdateDidDocPayload) GetControllerThreshold() uint32 {
+	if x != nil {
+		return x.ControllerThreshold
+	}
+	return 0
+}
+
 type MsgUpdateDidDocResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -10847,7 +16144,222 @@ func (*MsgUpdateDidDocResponse) Descriptor() ([]byte, []int) {
 	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *MsgUpdateDidDocResponse) GetValue() *DidDocWithMetadata {
+func (x *MsgUpdateDidDocResponse) GetValue() *DidDocWithMetadata {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// MsgRecoverDidDoc defines the Msg/RecoverDidDoc request type.
+// It describes the parameters of a request for recovering a DID document with its pre-rotated recovery key.
+type MsgRecoverDidDoc struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Payload containing the new verification methods of the DID Document and the revealed recovery key
+	Payload *MsgRecoverDidDocPayload `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Signatures of the revealed recovery key and of the controllers of the new verification methods
+	Signatures []*SignInfo `protobuf:"bytes,2,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (x *MsgRecoverDidDoc) Reset() {
+	*x = MsgRecoverDidDoc{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cheqd_did_v2_tx_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRecoverDidDoc) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRecoverDidDoc) ProtoMessage() {}
+
+// Deprecated: Use MsgRecoverDidDoc.ProtoReflect.Descriptor instead.
+func (*MsgRecoverDidDoc) Descriptor() ([]byte, []int) {
+	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MsgRecoverDidDoc) GetPayload() *MsgRecoverDidDocPayload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *MsgRecoverDidDoc) GetSignatures() []*SignInfo {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+// MsgRecoverDidDocPayload defines the structure of the payload for recovering a DID document.
+//
+// The recovery key must match the recovery_commitment stored in the DID Document metadata.
+// The controllers of the current version don't have to sign, so the DID Document can be recovered
+// if their keys are lost. Only the verification methods and verification relationships are replaced.
+// Controllers, controllerThreshold, context, services and alsoKnownAs of the current version are kept,
+// so recovery can't bypass the controller policy of the DID Document.
+type MsgRecoverDidDocPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the DID of the DID document to be recovered.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// verificationMethod is the list of verification methods replacing the current ones.
+	VerificationMethod []*VerificationMethod `protobuf:"bytes,2,rep,name=verification_method,json=verificationMethod,proto3" json:"verification_method,omitempty"`
+	// authentication is a list of verification methods used for authenticating the DID subject.
+	Authentication []string `protobuf:"bytes,3,rep,name=authentication,proto3" json:"authentication,omitempty"`
+	// assertionMethod is a list of verification methods used for asserting claims.
+	AssertionMethod []string `protobuf:"bytes,4,rep,name=assertion_method,json=assertionMethod,proto3" json:"assertion_method,omitempty"`
+	// capabilityInvocation is a list of verification methods used for invoking capabilities.
+	CapabilityInvocation []string `protobuf:"bytes,5,rep,name=capability_invocation,json=capabilityInvocation,proto3" json:"capability_invocation,omitempty"`
+	// capabilityDelegation is a list of verification methods used for delegating capabilities.
+	CapabilityDelegation []string `protobuf:"bytes,6,rep,name=capability_delegation,json=capabilityDelegation,proto3" json:"capability_delegation,omitempty"`
+	// keyAgreement is a list of verification methods used for key agreement.
+	KeyAgreement []string `protobuf:"bytes,7,rep,name=key_agreement,json=keyAgreement,proto3" json:"key_agreement,omitempty"`
+	// Updated version ID of the DID Document.
+	// Links to next/previous versions of the DID Document will be automatically updated.
+	//
+	// Format: <uuid>
+	VersionId string `protobuf:"bytes,8,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// recovery_key is the revealed pre-rotated key. Its id must be a DID URL of the DID being recovered
+	// and the request must be signed with it.
+	RecoveryKey *VerificationMethod `protobuf:"bytes,9,opt,name=recovery_key,json=recoveryKey,proto3" json:"recovery_key,omitempty"`
+	// next_recovery_commitment is a commitment to the next pre-rotated recovery key. OPTIONAL.
+	// If not set, the DID Document can't be recovered again until a new commitment is set by an update.
+	//
+	// Format: <hex-encoded-sha256>
+	NextRecoveryCommitment string `protobuf:"bytes,10,opt,name=next_recovery_commitment,json=nextRecoveryCommitment,proto3" json:"next_recovery_commitment,omitempty"`
+}
+
+func (x *MsgRecoverDidDocPayload) Reset() {
+	*x = MsgRecoverDidDocPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cheqd_did_v2_tx_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRecoverDidDocPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRecoverDidDocPayload) ProtoMessage() {}
+
+// Deprecated: Use MsgRecoverDidDocPayload.ProtoReflect.Descriptor instead.
+func (*MsgRecoverDidDocPayload) Descriptor() ([]byte, []int) {
+	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MsgRecoverDidDocPayload) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MsgRecoverDidDocPayload) GetVerificationMethod() []*VerificationMethod {
+	if x != nil {
+		return x.VerificationMethod
+	}
+	return nil
+}
+
+func (x *MsgRecoverDidDocPayload) GetAuthentication() []string {
+	if x != nil {
+		return x.Authentication
+	}
+	return nil
+}
+
+func (x *MsgRecoverDidDocPayload) GetAssertionMethod() []string {
+	if x != nil {
+		return x.AssertionMethod
+	}
+	return nil
+}
+
+func (x *MsgRecoverDidDocPayload) GetCapabilityInvocation() []string {
+	if x != nil {
+		return x.CapabilityInvocation
+	}
+	return nil
+}
+
+func (x *MsgRecoverDidDocPayload) GetCapabilityDelegation() []string {
+	if x != nil {
+		return x.CapabilityDelegation
+	}
+	return nil
+}
+
+func (x *MsgRecoverDidDocPayload) GetKeyAgreement() []string {
+	if x != nil {
+		return x.KeyAgreement
+	}
+	return nil
+}
+
+func (x *MsgRecoverDidDocPayload) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+func (x *MsgRecoverDidDocPayload) GetRecoveryKey() *VerificationMethod {
+	if x != nil {
+		return x.RecoveryKey
+	}
+	return nil
+}
+
+func (x *MsgRecoverDidDocPayload) GetNextRecoveryCommitment() string {
+	if x != nil {
+		return x.NextRecoveryCommitment
+	}
+	return ""
+}
+
+// MsgRecoverDidDocResponse defines response type for Msg/RecoverDidDoc.
+type MsgRecoverDidDocResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Return the recovered DID Document with metadata
+	Value *DidDocWithMetadata `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *MsgRecoverDidDocResponse) Reset() {
+	*x = MsgRecoverDidDocResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cheqd_did_v2_tx_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRecoverDidDocResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRecoverDidDocResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgRecoverDidDocResponse.ProtoReflect.Descriptor instead.
+func (*MsgRecoverDidDocResponse) Descriptor() ([]byte, []int) {
+	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MsgRecoverDidDocResponse) GetValue() *DidDocWithMetadata {
 	if x != nil {
 		return x.Value
 	}
@@ -10870,7 +16382,7 @@ type MsgDeactivateDidDocPayload struct {
 func (x *MsgDeactivateDidDocPayload) Reset() {
 	*x = MsgDeactivateDidDocPayload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cheqd_did_v2_tx_proto_msgTypes[8]
+		mi := &file_cheqd_did_v2_tx_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10884,7 +16396,7 @@ func (*MsgDeactivateDidDocPayload) ProtoMessage() {}
 
 // Deprecated: Use MsgDeactivateDidDocPayload.ProtoReflect.Descriptor instead.
 func (*MsgDeactivateDidDocPayload) Descriptor() ([]byte, []int) {
-	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{8}
+	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *MsgDeactivateDidDocPayload) GetId() string {
@@ -10914,7 +16426,7 @@ type MsgDeactivateDidDocResponse struct {
 func (x *MsgDeactivateDidDocResponse) Reset() {
 	*x = MsgDeactivateDidDocResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cheqd_did_v2_tx_proto_msgTypes[9]
+		mi := &file_cheqd_did_v2_tx_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10928,7 +16440,7 @@ func (*MsgDeactivateDidDocResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgDeactivateDidDocResponse.ProtoReflect.Descriptor instead.
 func (*MsgDeactivateDidDocResponse) Descriptor() ([]byte, []int) {
-	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{9}
+	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *MsgDeactivateDidDocResponse) GetValue() *DidDocWithMetadata {
@@ -10938,6 +16450,132 @@ func (x *MsgDeactivateDidDocResponse) GetValue() *DidDocWithMetadata {
 	return nil
 }
 
+// MsgBatchCreateDidDocs defines the Msg/BatchCreateDidDocs request type.
+// It describes the parameters of a request for creating multiple new DID documents at once.
+type MsgBatchCreateDidDocs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Payload containing the DID Documents to be created
+	Payload *MsgBatchCreateDidDocsPayload `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Signatures of the controller(s) of all DID Documents over the whole payload
+	Signatures []*SignInfo `protobuf:"bytes,2,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (x *MsgBatchCreateDidDocs) Reset() {
+	*x = MsgBatchCreateDidDocs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cheqd_did_v2_tx_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgBatchCreateDidDocs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgBatchCreateDidDocs) ProtoMessage() {}
+
+// Deprecated: Use MsgBatchCreateDidDocs.ProtoReflect.Descriptor instead.
+func (*MsgBatchCreateDidDocs) Descriptor() ([]byte, []int) {
+	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MsgBatchCreateDidDocs) GetPayload() *MsgBatchCreateDidDocsPayload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *MsgBatchCreateDidDocs) GetSignatures() []*SignInfo {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+// MsgBatchCreateDidDocsPayload defines the structure of the payload for creating multiple new DID documents.
+//
+// Either all DID Documents are created or none of them. DID Documents in the batch
+// may be controlled by other DID Documents created in the same batch.
+//
+// The number of DID Documents is limited by the max_batch_size module parameter.
+type MsgBatchCreateDidDocsPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Payloads of the DID Documents to be created
+	Payloads []*MsgCreateDidDocPayload `protobuf:"bytes,1,rep,name=payloads,proto3" json:"payloads,omitempty"`
+}
+
+func (x *MsgBatchCreateDidDocsPayload) Reset() {
+	*x = MsgBatchCreateDidDocsPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cheqd_did_v2_tx_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgBatchCreateDidDocsPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgBatchCreateDidDocsPayload) ProtoMessage() {}
+
+// Deprecated: Use MsgBatchCreateDidDocsPayload.ProtoReflect.Descriptor instead.
+func (*MsgBatchCreateDidDocsPayload) Descriptor() ([]byte, []int) {
+	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MsgBatchCreateDidDocsPayload) GetPayloads() []*MsgCreateDidDocPayload {
+	if x != nil {
+		return x.Payloads
+	}
+	return nil
+}
+
+// MsgBatchCreateDidDocsResponse defines response type for Msg/BatchCreateDidDocs.
+type MsgBatchCreateDidDocsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Return the created DID Documents with metadata
+	Values []*DidDocWithMetadata `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *MsgBatchCreateDidDocsResponse) Reset() {
+	*x = MsgBatchCreateDidDocsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cheqd_did_v2_tx_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgBatchCreateDidDocsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgBatchCreateDidDocsResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgBatchCreateDidDocsResponse.ProtoReflect.Descriptor instead.
+func (*MsgBatchCreateDidDocsResponse) Descriptor() ([]byte, []int) {
+	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MsgBatchCreateDidDocsResponse) GetValues() []*DidDocWithMetadata {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
 // MsgBurn represents a message to burn coins from the message signer account
 type MsgBurn struct {
 	state         protoimpl.MessageState
@@ -10951,7 +16589,7 @@ type MsgBurn struct {
 func (x *MsgBurn) Reset() {
 	*x = MsgBurn{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cheqd_did_v2_tx_proto_msgTypes[10]
+		mi := &file_cheqd_did_v2_tx_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10965,7 +16603,7 @@ func (*MsgBurn) ProtoMessage() {}
 
 // Deprecated: Use MsgBurn.ProtoReflect.Descriptor instead.
 func (*MsgBurn) Descriptor() ([]byte, []int) {
-	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{10}
+	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *MsgBurn) GetFromAddress() string {
@@ -10991,7 +16629,7 @@ type MsgBurnResponse struct {
 func (x *MsgBurnResponse) Reset() {
 	*x = MsgBurnResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cheqd_did_v2_tx_proto_msgTypes[11]
+		mi := &file_cheqd_did_v2_tx_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11005,7 +16643,7 @@ func (*MsgBurnResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgBurnResponse.ProtoReflect.Descriptor instead.
 func (*MsgBurnResponse) Descriptor() ([]byte, []int) {
-	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{11}
+	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{17}
 }
 
 // MsgMint is the sdk.Msg type for allowing an admin account to mint
@@ -11025,7 +16663,7 @@ type MsgMint struct {
 func (x *MsgMint) Reset() {
 	*x = MsgMint{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cheqd_did_v2_tx_proto_msgTypes[12]
+		mi := &file_cheqd_did_v2_tx_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11039,7 +16677,7 @@ func (*MsgMint) ProtoMessage() {}
 
 // Deprecated: Use MsgMint.ProtoReflect.Descriptor instead.
 func (*MsgMint) Descriptor() ([]byte, []int) {
-	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{12}
+	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *MsgMint) GetAuthority() string {
@@ -11072,7 +16710,7 @@ type MsgMintResponse struct {
 func (x *MsgMintResponse) Reset() {
 	*x = MsgMintResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cheqd_did_v2_tx_proto_msgTypes[13]
+		mi := &file_cheqd_did_v2_tx_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11086,7 +16724,7 @@ func (*MsgMintResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgMintResponse.ProtoReflect.Descriptor instead.
 func (*MsgMintResponse) Descriptor() ([]byte, []int) {
-	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{13}
+	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{19}
 }
 
 type MsgUpdateParams struct {
@@ -11105,7 +16743,7 @@ type MsgUpdateParams struct {
 func (x *MsgUpdateParams) Reset() {
 	*x = MsgUpdateParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cheqd_did_v2_tx_proto_msgTypes[14]
+		mi := &file_cheqd_did_v2_tx_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11119,7 +16757,7 @@ func (*MsgUpdateParams) ProtoMessage() {}
 
 // Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{14}
+	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *MsgUpdateParams) GetAuthority() string {
@@ -11149,7 +16787,7 @@ type MsgUpdateParamsResponse struct {
 func (x *MsgUpdateParamsResponse) Reset() {
 	*x = MsgUpdateParamsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cheqd_did_v2_tx_proto_msgTypes[15]
+		mi := &file_cheqd_did_v2_tx_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11163,7 +16801,91 @@ func (*MsgUpdateParamsResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{15}
+	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{21}
+}
+
+// MsgPurchaseIdentityCredits prepays identity fees of the buyer account.
+// The post handler draws identity fees from the credits before charging the account.
+type MsgPurchaseIdentityCredits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Bech32 address of the account purchasing the credits
+	Buyer string `protobuf:"bytes,1,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// Credits to purchase, in ncheq
+	Amount *v1beta1.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *MsgPurchaseIdentityCredits) Reset() {
+	*x = MsgPurchaseIdentityCredits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cheqd_did_v2_tx_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgPurchaseIdentityCredits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgPurchaseIdentityCredits) ProtoMessage() {}
+
+// Deprecated: Use MsgPurchaseIdentityCredits.ProtoReflect.Descriptor instead.
+func (*MsgPurchaseIdentityCredits) Descriptor() ([]byte, []int) {
+	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MsgPurchaseIdentityCredits) GetBuyer() string {
+	if x != nil {
+		return x.Buyer
+	}
+	return ""
+}
+
+func (x *MsgPurchaseIdentityCredits) GetAmount() *v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// MsgPurchaseIdentityCreditsResponse defines the Msg/PurchaseIdentityCredits response type.
+type MsgPurchaseIdentityCreditsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Credit balance after the purchase
+	Balance *v1beta1.Coin `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (x *MsgPurchaseIdentityCreditsResponse) Reset() {
+	*x = MsgPurchaseIdentityCreditsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cheqd_did_v2_tx_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgPurchaseIdentityCreditsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgPurchaseIdentityCreditsResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgPurchaseIdentityCreditsResponse.ProtoReflect.Descriptor instead.
+func (*MsgPurchaseIdentityCreditsResponse) Descriptor() ([]byte, []int) {
+	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *MsgPurchaseIdentityCreditsResponse) GetBalance() *v1beta1.Coin {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
 }
 
 var File_cheqd_did_v2_tx_proto protoreflect.FileDescriptor
@@ -11209,172 +16931,293 @@ var file_cheqd_did_v2_tx_proto_rawDesc = []byte{
 	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
 	0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x69, 0x67,
 	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x73, 0x22, 0x5e, 0x0a, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x34, 0x0a,
+	0x73, 0x22, 0x7d, 0x0a, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x34, 0x0a,
 	0x16, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65,
 	0x74, 0x68, 0x6f, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x76,
 	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f,
 	0x64, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x22, 0x8b, 0x04, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44,
-	0x69, 0x64, 0x44, 0x6f, 0x63, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x51, 0x0a, 0x13, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e,
-	0x76, 0x32, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
-	0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x12, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x75, 0x74,
-	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d,
-	0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x73, 0x73,
-	0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x33, 0x0a, 0x15,
-	0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x63, 0x61, 0x70,
-	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x33, 0x0a, 0x15, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f,
-	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x14, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x44, 0x65, 0x6c, 0x65,
-	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x6b, 0x65, 0x79, 0x5f, 0x61, 0x67,
-	0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6b,
-	0x65, 0x79, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x07, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63,
-	0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0d,
-	0x61, 0x6c, 0x73, 0x6f, 0x5f, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x61, 0x73, 0x18, 0x0b, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x6c, 0x73, 0x6f, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x41, 0x73,
-	0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22,
-	0x51, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44,
-	0x6f, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x68, 0x65, 0x71,
-	0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x57,
-	0x69, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x22, 0x8b, 0x04, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a,
-	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x51, 0x0a, 0x13, 0x76, 0x65, 0x72, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64,
-	0x2e, 0x76, 0x32, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x12, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x75,
-	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x73,
-	0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x33, 0x0a,
-	0x15, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x6e, 0x76, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x63, 0x61,
-	0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x15, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
-	0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x14, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x44, 0x65, 0x6c,
-	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x6b, 0x65, 0x79, 0x5f, 0x61,
-	0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c,
-	0x6b, 0x65, 0x79, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x07,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x22, 0x0a,
-	0x0d, 0x61, 0x6c, 0x73, 0x6f, 0x5f, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x61, 0x73, 0x18, 0x0b,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x6c, 0x73, 0x6f, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x41,
-	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
-	0x22, 0x51, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64,
-	0x44, 0x6f, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x68, 0x65,
-	0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63,
-	0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x22, 0x4b, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
-	0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
-	0x22, 0x55, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x22, 0xef, 0x04, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69,
+	0x64, 0x44, 0x6f, 0x63, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x51, 0x0a, 0x13, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76,
+	0x32, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x52, 0x12, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x29, 0x0a, 0x10, 0x61, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x73, 0x73, 0x65,
+	0x72, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x33, 0x0a, 0x15, 0x63,
+	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x63, 0x61, 0x70, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x33, 0x0a, 0x15, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x14, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x6b, 0x65, 0x79, 0x5f, 0x61, 0x67, 0x72,
+	0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6b, 0x65,
+	0x79, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x07, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x68,
+	0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x61,
+	0x6c, 0x73, 0x6f, 0x5f, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x61, 0x73, 0x18, 0x0b, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0b, 0x61, 0x6c, 0x73, 0x6f, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x41, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2f,
+	0x0a, 0x13, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x31, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x22, 0x51, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44,
+	0x69, 0x64, 0x44, 0x6f, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63,
+	0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x64, 0x44,
+	0x6f, 0x63, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xab, 0x05, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x51, 0x0a, 0x13, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e,
+	0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x12, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x26, 0x0a,
+	0x0e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0f, 0x61, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x12, 0x33, 0x0a, 0x15, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x69,
+	0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x14, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x76, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x15, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x6b, 0x65,
+	0x79, 0x5f, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0c, 0x6b, 0x65, 0x79, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x2f, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x22, 0x0a, 0x0d, 0x61, 0x6c, 0x73, 0x6f, 0x5f, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x61,
+	0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x6c, 0x73, 0x6f, 0x4b, 0x6e, 0x6f,
+	0x77, 0x6e, 0x41, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x19, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x72, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x31, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x22, 0x51, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x64,
+	0x44, 0x6f, 0x63, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x10, 0x4d, 0x73, 0x67, 0x52, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x12, 0x3f, 0x0a, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63,
+	0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x36, 0x0a, 0x0a,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e,
+	0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x22, 0xfc, 0x03, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x51, 0x0a, 0x13, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52,
+	0x12, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x61,
+	0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x33, 0x0a, 0x15, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x49, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x15, 0x63,
+	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x63, 0x61, 0x70, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x23, 0x0a, 0x0d, 0x6b, 0x65, 0x79, 0x5f, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6b, 0x65, 0x79, 0x41, 0x67, 0x72, 0x65,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x68, 0x65,
+	0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x0b, 0x72, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x18, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x6e, 0x65, 0x78,
+	0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x22, 0x52, 0x0a, 0x18, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
 	0x36, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
 	0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69,
 	0x64, 0x44, 0x6f, 0x63, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xcb, 0x01, 0x0a, 0x07, 0x4d, 0x73, 0x67, 0x42,
-	0x75, 0x72, 0x6e, 0x12, 0x3b, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x68, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f,
-	0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
-	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0,
-	0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x19, 0x88, 0xa0, 0x1f, 0x00,
-	0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x11, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x42, 0x75, 0x72, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf0, 0x01, 0x0a, 0x07, 0x4d, 0x73, 0x67,
-	0x4d, 0x69, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d,
-	0x6c, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x52, 0x09, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x37, 0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
-	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x68, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde,
-	0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
-	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7,
-	0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x0e, 0x82, 0xe7, 0xb0,
-	0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x4d,
-	0x73, 0x67, 0x4d, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb0,
-	0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x4b, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x44, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x50, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x22, 0x55, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76,
+	0x32, 0x2e, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x95, 0x01, 0x0a, 0x15,
+	0x4d, 0x73, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69,
+	0x64, 0x44, 0x6f, 0x63, 0x73, 0x12, 0x44, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64,
+	0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x73, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x73, 0x50, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x36, 0x0a, 0x0a, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x53,
+	0x69, 0x67, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x22, 0x60, 0x0a, 0x1c, 0x4d, 0x73, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x73, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x12, 0x40, 0x0a, 0x08, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69,
+	0x64, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69,
+	0x64, 0x44, 0x6f, 0x63, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x08, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x73, 0x22, 0x59, 0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64,
+	0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x57, 0x69, 0x74, 0x68,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x22, 0xcb, 0x01, 0x0a, 0x07, 0x4d, 0x73, 0x67, 0x42, 0x75, 0x72, 0x6e, 0x12, 0x3b, 0x0a, 0x0c,
+	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x66, 0x72,
+	0x6f, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x68, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x3a, 0x19, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0,
+	0x2a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x11,
+	0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x42, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0xf0, 0x01, 0x0a, 0x07, 0x4d, 0x73, 0x67, 0x4d, 0x69, 0x6e, 0x74, 0x12, 0x32, 0x0a,
+	0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x14, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x37, 0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
-	0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x3a, 0x0a, 0x06, 0x70, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x65,
-	0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x46, 0x65, 0x65, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06,
-	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x3a, 0x29, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x16, 0x2f, 0x78, 0x2f, 0x64, 0x69,
-	0x64, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xec, 0x03, 0x0a,
-	0x03, 0x4d, 0x73, 0x67, 0x12, 0x54, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69,
-	0x64, 0x44, 0x6f, 0x63, 0x12, 0x1d, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64,
-	0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64,
-	0x44, 0x6f, 0x63, 0x1a, 0x25, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e,
-	0x76, 0x32, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44,
-	0x6f, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0c, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x12, 0x1d, 0x2e, 0x63, 0x68, 0x65,
-	0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x1a, 0x25, 0x2e, 0x63, 0x68, 0x65, 0x71,
-	0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x60, 0x0a, 0x10, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x44, 0x69,
-	0x64, 0x44, 0x6f, 0x63, 0x12, 0x21, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64,
-	0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x1a, 0x29, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e,
-	0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x3c, 0x0a, 0x04, 0x42, 0x75, 0x72, 0x6e, 0x12, 0x15, 0x2e, 0x63, 0x68, 0x65,
+	0x09, 0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x68, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x0e, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x4d, 0x69, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x3a, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e,
+	0x76, 0x32, 0x2e, 0x46, 0x65, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde,
+	0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x3a,
+	0x29, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a,
+	0xe7, 0xb0, 0x2a, 0x16, 0x2f, 0x78, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73,
+	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x96, 0x01, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x50, 0x75, 0x72,
+	0x63, 0x68, 0x61, 0x73, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x62,
+	0x75, 0x79, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x3a, 0x0a, 0x82, 0xe7, 0xb0, 0x2a, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x22, 0x64,
+	0x0a, 0x22, 0x4d, 0x73, 0x67, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x32, 0xa4, 0x06, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x54, 0x0a, 0x0c,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x12, 0x1d, 0x2e, 0x63,
+	0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x73, 0x67, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x1a, 0x25, 0x2e, 0x63, 0x68,
+	0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x54, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44,
+	0x6f, 0x63, 0x12, 0x1d, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76,
+	0x32, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f,
+	0x63, 0x1a, 0x25, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32,
+	0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x10, 0x44, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x12, 0x21, 0x2e, 0x63,
+	0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x73, 0x67, 0x44,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x1a,
+	0x29, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x4d,
+	0x73, 0x67, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44,
+	0x6f, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x12, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x73,
+	0x12, 0x23, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e,
+	0x4d, 0x73, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69,
+	0x64, 0x44, 0x6f, 0x63, 0x73, 0x1a, 0x2b, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69,
+	0x64, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x73, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x57, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x44, 0x69, 0x64,
+	0x44, 0x6f, 0x63, 0x12, 0x1e, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e,
+	0x76, 0x32, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x44, 0x69, 0x64,
+	0x44, 0x6f, 0x63, 0x1a, 0x26, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e,
+	0x76, 0x32, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x44, 0x69, 0x64,
+	0x44, 0x6f, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x04, 0x42,
+	0x75, 0x72, 0x6e, 0x12, 0x15, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e,
+	0x76, 0x32, 0x2e, 0x4d, 0x73, 0x67, 0x42, 0x75, 0x72, 0x6e, 0x1a, 0x1d, 0x2e, 0x63, 0x68, 0x65,
 	0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x73, 0x67, 0x42, 0x75, 0x72,
-	0x6e, 0x1a, 0x1d, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32,
-	0x2e, 0x4d, 0x73, 0x67, 0x42, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x3c, 0x0a, 0x04, 0x4d, 0x69, 0x6e, 0x74, 0x12, 0x15, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64,
-	0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x73, 0x67, 0x4d, 0x69, 0x6e, 0x74, 0x1a,
-	0x1d, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x4d,
-	0x73, 0x67, 0x4d, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54,
-	0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1d,
-	0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x73,
-	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x25, 0x2e,
-	0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x73, 0x67,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x04, 0x4d, 0x69, 0x6e,
+	0x74, 0x12, 0x15, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32,
+	0x2e, 0x4d, 0x73, 0x67, 0x4d, 0x69, 0x6e, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64,
+	0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x73, 0x67, 0x4d, 0x69, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1d, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e,
+	0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x25, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64,
+	0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a,
+	0x17, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x28, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64,
+	0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x73, 0x67, 0x50, 0x75, 0x72, 0x63, 0x68,
+	0x61, 0x73, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x73, 0x1a, 0x30, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76,
+	0x32, 0x2e, 0x4d, 0x73, 0x67, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
 	0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xa4, 0x01, 0x0a, 0x10,
 	0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32,
 	0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69, 0x74,
@@ -11401,64 +17244,89 @@ func file_cheqd_did_v2_tx_proto_rawDescGZIP() []byte {
 	return file_cheqd_did_v2_tx_proto_rawDescData
 }
 
-var file_cheqd_did_v2_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_cheqd_did_v2_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_cheqd_did_v2_tx_proto_goTypes = []interface{}{
-	(*MsgCreateDidDoc)(nil),             // 0: cheqd.did.v2.MsgCreateDidDoc
-	(*MsgUpdateDidDoc)(nil),             // 1: cheqd.did.v2.MsgUpdateDidDoc
-	(*MsgDeactivateDidDoc)(nil),         // 2: cheqd.did.v2.MsgDeactivateDidDoc
-	(*SignInfo)(nil),                    // 3: cheqd.did.v2.SignInfo
-	(*MsgCreateDidDocPayload)(nil),      // 4: cheqd.did.v2.MsgCreateDidDocPayload
-	(*MsgCreateDidDocResponse)(nil),     // 5: cheqd.did.v2.MsgCreateDidDocResponse
-	(*MsgUpdateDidDocPayload)(nil),      // 6: cheqd.did.v2.MsgUpdateDidDocPayload
-	(*MsgUpdateDidDocResponse)(nil),     // 7: cheqd.did.v2.MsgUpdateDidDocResponse
-	(*MsgDeactivateDidDocPayload)(nil),  // 8: cheqd.did.v2.MsgDeactivateDidDocPayload
-	(*MsgDeactivateDidDocResponse)(nil), // 9: cheqd.did.v2.MsgDeactivateDidDocResponse
-	(*MsgBurn)(nil),                     // 10: cheqd.did.v2.MsgBurn
-	(*MsgBurnResponse)(nil),             // 11: cheqd.did.v2.MsgBurnResponse
-	(*MsgMint)(nil),                     // 12: cheqd.did.v2.MsgMint
-	(*MsgMintResponse)(nil),             // 13: cheqd.did.v2.MsgMintResponse
-	(*MsgUpdateParams)(nil),             // 14: cheqd.did.v2.MsgUpdateParams
-	(*MsgUpdateParamsResponse)(nil),     // 15: cheqd.did.v2.MsgUpdateParamsResponse
-	(*VerificationMethod)(nil),          // 16: cheqd.did.v2.VerificationMethod
-	(*Service)(nil),                     // 17: cheqd.did.v2.Service
-	(*DidDocWithMetadata)(nil),          // 18: cheqd.did.v2.DidDocWithMetadata
-	(*v1beta1.Coin)(nil),                // 19: cosmos.base.v1beta1.Coin
-	(*FeeParams)(nil),                   // 20: cheqd.did.v2.FeeParams
+	(*MsgCreateDidDoc)(nil),                    // 0: cheqd.did.v2.MsgCreateDidDoc
+	(*MsgUpdateDidDoc)(nil),                    // 1: cheqd.did.v2.MsgUpdateDidDoc
+	(*MsgDeactivateDidDoc)(nil),                // 2: cheqd.did.v2.MsgDeactivateDidDoc
+	(*SignInfo)(nil),                           // 3: cheqd.did.v2.SignInfo
+	(*MsgCreateDidDocPayload)(nil),             // 4: cheqd.did.v2.MsgCreateDidDocPayload
+	(*MsgCreateDidDocResponse)(nil),            // 5: cheqd.did.v2.MsgCreateDidDocResponse
+	(*MsgUpdateDidDocPayload)(nil),             // 6: cheqd.did.v2.MsgUpdateDidDocPayload
+	(*MsgUpdateDidDocResponse)(nil),            // 7: cheqd.did.v2.MsgUpdateDidDocResponse
+	(*MsgRecoverDidDoc)(nil),                   // 8: cheqd.did.v2.MsgRecoverDidDoc
+	(*MsgRecoverDidDocPayload)(nil),            // 9: cheqd.did.v2.MsgRecoverDidDocPayload
+	(*MsgRecoverDidDocResponse)(nil),           // 10: cheqd.did.v2.MsgRecoverDidDocResponse
+	(*MsgDeactivateDidDocPayload)(nil),         // 11: cheqd.did.v2.MsgDeactivateDidDocPayload
+	(*MsgDeactivateDidDocResponse)(nil),        // 12: cheqd.did.v2.MsgDeactivateDidDocResponse
+	(*MsgBatchCreateDidDocs)(nil),              // 13: cheqd.did.v2.MsgBatchCreateDidDocs
+	(*MsgBatchCreateDidDocsPayload)(nil),       // 14: cheqd.did.v2.MsgBatchCreateDidDocsPayload
+	(*MsgBatchCreateDidDocsResponse)(nil),      // 15: cheqd.did.v2.MsgBatchCreateDidDocsResponse
+	(*MsgBurn)(nil),                            // 16: cheqd.did.v2.MsgBurn
+	(*MsgBurnResponse)(nil),                    // 17: cheqd.did.v2.MsgBurnResponse
+	(*MsgMint)(nil),                            // 18: cheqd.did.v2.MsgMint
+	(*MsgMintResponse)(nil),                    // 19: cheqd.did.v2.MsgMintResponse
+	(*MsgUpdateParams)(nil),                    // 20: cheqd.did.v2.MsgUpdateParams
+	(*MsgUpdateParamsResponse)(nil),            // 21: cheqd.did.v2.MsgUpdateParamsResponse
+	(*MsgPurchaseIdentityCredits)(nil),         // 22: cheqd.did.v2.MsgPurchaseIdentityCredits
+	(*MsgPurchaseIdentityCreditsResponse)(nil), // 23: cheqd.did.v2.MsgPurchaseIdentityCreditsResponse
+	(*VerificationMethod)(nil),                 // 24: cheqd.did.v2.VerificationMethod
+	(*Service)(nil),                            // 25: cheqd.did.v2.Service
+	(*DidDocWithMetadata)(nil),                 // 26: cheqd.did.v2.DidDocWithMetadata
+	(*v1beta1.Coin)(nil),                       // 27: cosmos.base.v1beta1.Coin
+	(*FeeParams)(nil),                          // 28: cheqd.did.v2.FeeParams
 }
 var file_cheqd_did_v2_tx_proto_depIdxs = []int32{
 	4,  // 0: cheqd.did.v2.MsgCreateDidDoc.payload:type_name -> cheqd.did.v2.MsgCreateDidDocPayload
 	3,  // 1: cheqd.did.v2.MsgCreateDidDoc.signatures:type_name -> cheqd.did.v2.SignInfo
 	6,  // 2: cheqd.did.v2.MsgUpdateDidDoc.payload:type_name -> cheqd.did.v2.MsgUpdateDidDocPayload
 	3,  // 3: cheqd.did.v2.MsgUpdateDidDoc.signatures:type_name -> cheqd.did.v2.SignInfo
-	8,  // 4: cheqd.did.v2.MsgDeactivateDidDoc.payload:type_name -> cheqd.did.v2.MsgDeactivateDidDocPayload
+	11, // 4: cheqd.did.v2.MsgDeactivateDidDoc.payload:type_name -> cheqd.did.v2.MsgDeactivateDidDocPayload
 	3,  // 5: cheqd.did.v2.MsgDeactivateDidDoc.signatures:type_name -> cheqd.did.v2.SignInfo
-	16, // 6: cheqd.did.v2.MsgCreateDidDocPayload.verification_method:type_name -> cheqd.did.v2.VerificationMethod
-	17, // 7: cheqd.did.v2.MsgCreateDidDocPayload.service:type_name -> cheqd.did.v2.Service
-	18, // 8: cheqd.did.v2.MsgCreateDidDocResponse.value:type_name -> cheqd.did.v2.DidDocWithMetadata
-	16, // 9: cheqd.did.v2.MsgUpdateDidDocPayload.verification_method:type_name -> cheqd.did.v2.VerificationMethod
-	17, // 10: cheqd.did.v2.MsgUpdateDidDocPayload.service:type_name -> cheqd.did.v2.Service
-	18, // 11: cheqd.did.v2.MsgUpdateDidDocResponse.value:type_name -> cheqd.did.v2.DidDocWithMetadata
-	18, // 12: cheqd.did.v2.MsgDeactivateDidDocResponse.value:type_name -> cheqd.did.v2.DidDocWithMetadata
-	19, // 13: cheqd.did.v2.MsgBurn.amount:type_name -> cosmos.base.v1beta1.Coin
-	19, // 14: cheqd.did.v2.MsgMint.amount:type_name -> cosmos.base.v1beta1.Coin
-	20, // 15: cheqd.did.v2.MsgUpdateParams.params:type_name -> cheqd.did.v2.FeeParams
-	0,  // 16: cheqd.did.v2.Msg.CreateDidDoc:input_type -> cheqd.did.v2.MsgCreateDidDoc
-	1,  // 17: cheqd.did.v2.Msg.UpdateDidDoc:input_type -> cheqd.did.v2.MsgUpdateDidDoc
-	2,  // 18: cheqd.did.v2.Msg.DeactivateDidDoc:input_type -> cheqd.did.v2.MsgDeactivateDidDoc
-	10, // 19: cheqd.did.v2.Msg.Burn:input_type -> cheqd.did.v2.MsgBurn
-	12, // 20: cheqd.did.v2.Msg.Mint:input_type -> cheqd.did.v2.MsgMint
-	14, // 21: cheqd.did.v2.Msg.UpdateParams:input_type -> cheqd.did.v2.MsgUpdateParams
-	5,  // 22: cheqd.did.v2.Msg.CreateDidDoc:output_type -> cheqd.did.v2.MsgCreateDidDocResponse
-	7,  // 23: cheqd.did.v2.Msg.UpdateDidDoc:output_type -> cheqd.did.v2.MsgUpdateDidDocResponse
-	9,  // 24: cheqd.did.v2.Msg.DeactivateDidDoc:output_type -> cheqd.did.v2.MsgDeactivateDidDocResponse
-	11, // 25: cheqd.did.v2.Msg.Burn:output_type -> cheqd.did.v2.MsgBurnResponse
-	13, // 26: cheqd.did.v2.Msg.Mint:output_type -> cheqd.did.v2.MsgMintResponse
-	15, // 27: cheqd.did.v2.Msg.UpdateParams:output_type -> cheqd.did.v2.MsgUpdateParamsResponse
-	22, // [22:28] is the sub-list for method output_type
-	16, // [16:22] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	24, // 6: cheqd.did.v2.MsgCreateDidDocPayload.verification_method:type_name -> cheqd.did.v2.VerificationMethod
+	25, // 7: cheqd.did.v2.MsgCreateDidDocPayload.service:type_name -> cheqd.did.v2.Service
+	26, // 8: cheqd.did.v2.MsgCreateDidDocResponse.value:type_name -> cheqd.did.v2.DidDocWithMetadata
+	24, // 9: cheqd.did.v2.MsgUpdateDidDocPayload.verification_method:type_name -> cheqd.did.v2.VerificationMethod
+	25, // 10: cheqd.did.v2.MsgUpdateDidDocPayload.service:type_name -> cheqd.did.v2.Service
+	26, // 11: cheqd.did.v2.MsgUpdateDidDocResponse.value:type_name -> cheqd.did.v2.DidDocWithMetadata
+	9,  // 12: cheqd.did.v2.MsgRecoverDidDoc.payload:type_name -> cheqd.did.v2.MsgRecoverDidDocPayload
+	3,  // 13: cheqd.did.v2.MsgRecoverDidDoc.signatures:type_name -> cheqd.did.v2.SignInfo
+	24, // 14: cheqd.did.v2.MsgRecoverDidDocPayload.verification_method:type_name -> cheqd.did.v2.VerificationMethod
+	24, // 15: cheqd.did.v2.MsgRecoverDidDocPayload.recovery_key:type_name -> cheqd.did.v2.VerificationMethod
+	26, // 16: cheqd.did.v2.MsgRecoverDidDocResponse.value:type_name -> cheqd.did.v2.DidDocWithMetadata
+	26, // 17: cheqd.did.v2.MsgDeactivateDidDocResponse.value:type_name -> cheqd.did.v2.DidDocWithMetadata
+	14, // 18: cheqd.did.v2.MsgBatchCreateDidDocs.payload:type_name -> cheqd.did.v2.MsgBatchCreateDidDocsPayload
+	3,  // 19: cheqd.did.v2.MsgBatchCreateDidDocs.signatures:type_name -> cheqd.did.v2.SignInfo
+	4,  // 20: cheqd.did.v2.MsgBatchCreateDidDocsPayload.payloads:type_name -> cheqd.did.v2.MsgCreateDidDocPayload
+	26, // 21: cheqd.did.v2.MsgBatchCreateDidDocsResponse.values:type_name -> cheqd.did.v2.DidDocWithMetadata
+	27, // 22: cheqd.did.v2.MsgBurn.amount:type_name -> cosmos.base.v1beta1.Coin
+	27, // 23: cheqd.did.v2.MsgMint.amount:type_name -> cosmos.base.v1beta1.Coin
+	28, // 24: cheqd.did.v2.MsgUpdateParams.params:type_name -> cheqd.did.v2.FeeParams
+	27, // 25: cheqd.did.v2.MsgPurchaseIdentityCredits.amount:type_name -> cosmos.base.v1beta1.Coin
+	27, // 26: cheqd.did.v2.MsgPurchaseIdentityCreditsResponse.balance:type_name -> cosmos.base.v1beta1.Coin
+	0,  // 27: cheqd.did.v2.Msg.CreateDidDoc:input_type -> cheqd.did.v2.MsgCreateDidDoc
+	1,  // 28: cheqd.did.v2.Msg.UpdateDidDoc:input_type -> cheqd.did.v2.MsgUpdateDidDoc
+	2,  // 29: cheqd.did.v2.Msg.DeactivateDidDoc:input_type -> cheqd.did.v2.MsgDeactivateDidDoc
+	13, // 30: cheqd.did.v2.Msg.BatchCreateDidDocs:input_type -> cheqd.did.v2.MsgBatchCreateDidDocs
+	8,  // 31: cheqd.did.v2.Msg.RecoverDidDoc:input_type -> cheqd.did.v2.MsgRecoverDidDoc
+	16, // 32: cheqd.did.v2.Msg.Burn:input_type -> cheqd.did.v2.MsgBurn
+	18, // 33: cheqd.did.v2.Msg.Mint:input_type -> cheqd.did.v2.MsgMint
+	20, // 34: cheqd.did.v2.Msg.UpdateParams:input_type -> cheqd.did.v2.MsgUpdateParams
+	22, // 35: cheqd.did.v2.Msg.PurchaseIdentityCredits:input_type -> cheqd.did.v2.MsgPurchaseIdentityCredits
+	5,  // 36: cheqd.did.v2.Msg.CreateDidDoc:output_type -> cheqd.did.v2.MsgCreateDidDocResponse
+	7,  // 37: cheqd.did.v2.Msg.UpdateDidDoc:output_type -> cheqd.did.v2.MsgUpdateDidDocResponse
+	12, // 38: cheqd.did.v2.Msg.DeactivateDidDoc:output_type -> cheqd.did.v2.MsgDeactivateDidDocResponse
+	15, // 39: cheqd.did.v2.Msg.BatchCreateDidDocs:output_type -> cheqd.did.v2.MsgBatchCreateDidDocsResponse
+	10, // 40: cheqd.did.v2.Msg.RecoverDidDoc:output_type -> cheqd.did.v2.MsgRecoverDidDocResponse
+	17, // 41: cheqd.did.v2.Msg.Burn:output_type -> cheqd.did.v2.MsgBurnResponse
+	19, // 42: cheqd.did.v2.Msg.Mint:output_type -> cheqd.did.v2.MsgMintResponse
+	21, // 43: cheqd.did.v2.Msg.UpdateParams:output_type -> cheqd.did.v2.MsgUpdateParamsResponse
+	23, // 44: cheqd.did.v2.Msg.PurchaseIdentityCredits:output_type -> cheqd.did.v2.MsgPurchaseIdentityCreditsResponse
+	36, // [36:45] is the sub-list for method output_type
+	27, // [27:36] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_cheqd_did_v2_tx_proto_init() }
@@ -11566,7 +17434,7 @@ func file_cheqd_did_v2_tx_proto_init() {
 			}
 		}
 		file_cheqd_did_v2_tx_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgDeactivateDidDocPayload); i {
+			switch v := v.(*MsgRecoverDidDoc); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11578,7 +17446,7 @@ func file_cheqd_did_v2_tx_proto_init() {
 			}
 		}
 		file_cheqd_did_v2_tx_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgDeactivateDidDocResponse); i {
+			switch v := v.(*MsgRecoverDidDocPayload); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11590,7 +17458,7 @@ func file_cheqd_did_v2_tx_proto_init() {
 			}
 		}
 		file_cheqd_did_v2_tx_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgBurn); i {
+			switch v := v.(*MsgRecoverDidDocResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11602,7 +17470,7 @@ func file_cheqd_did_v2_tx_proto_init() {
 			}
 		}
 		file_cheqd_did_v2_tx_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgBurnResponse); i {
+			switch v := v.(*MsgDeactivateDidDocPayload); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11614,7 +17482,7 @@ func file_cheqd_did_v2_tx_proto_init() {
 			}
 		}
 		file_cheqd_did_v2_tx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgMint); i {
+			switch v := v.(*MsgDeactivateDidDocResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11626,7 +17494,7 @@ func file_cheqd_did_v2_tx_proto_init() {
 			}
 		}
 		file_cheqd_did_v2_tx_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgMintResponse); i {
+			switch v := v.(*MsgBatchCreateDidDocs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11638,7 +17506,7 @@ func file_cheqd_did_v2_tx_proto_init() {
 			}
 		}
 		file_cheqd_did_v2_tx_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateParams); i {
+			switch v := v.(*MsgBatchCreateDidDocsPayload); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11650,6 +17518,78 @@ func file_cheqd_did_v2_tx_proto_init() {
 			}
 		}
 		file_cheqd_did_v2_tx_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgBatchCreateDidDocsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cheqd_did_v2_tx_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgBurn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cheqd_did_v2_tx_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgBurnResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cheqd_did_v2_tx_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgMint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cheqd_did_v2_tx_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgMintResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cheqd_did_v2_tx_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cheqd_did_v2_tx_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgUpdateParamsResponse); i {
 			case 0:
 				return &v.state
@@ -11661,6 +17601,30 @@ func file_cheqd_did_v2_tx_proto_init() {
 				return nil
 			}
 		}
+		file_cheqd_did_v2_tx_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgPurchaseIdentityCredits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cheqd_did_v2_tx_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgPurchaseIdentityCreditsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -11668,7 +17632,7 @@ func file_cheqd_did_v2_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cheqd_did_v2_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
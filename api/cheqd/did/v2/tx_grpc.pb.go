@@ -19,12 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_CreateDidDoc_FullMethodName     = "/cheqd.did.v2.Msg/CreateDidDoc"
-	Msg_UpdateDidDoc_FullMethodName     = "/cheqd.did.v2.Msg/UpdateDidDoc"
-	Msg_DeactivateDidDoc_FullMethodName = "/cheqd.did.v2.Msg/DeactivateDidDoc"
-	Msg_Burn_FullMethodName             = "/cheqd.did.v2.Msg/Burn"
-	Msg_Mint_FullMethodName             = "/cheqd.did.v2.Msg/Mint"
-	Msg_UpdateParams_FullMethodName     = "/cheqd.did.v2.Msg/UpdateParams"
+	Msg_CreateDidDoc_FullMethodName            = "/cheqd.did.v2.Msg/CreateDidDoc"
+	Msg_UpdateDidDoc_FullMethodName            = "/cheqd.did.v2.Msg/UpdateDidDoc"
+	Msg_DeactivateDidDoc_FullMethodName        = "/cheqd.did.v2.Msg/DeactivateDidDoc"
+	Msg_BatchCreateDidDocs_FullMethodName      = "/cheqd.did.v2.Msg/BatchCreateDidDocs"
+	Msg_RecoverDidDoc_FullMethodName           = "/cheqd.did.v2.Msg/RecoverDidDoc"
+	Msg_Burn_FullMethodName                    = "/cheqd.did.v2.Msg/Burn"
+	Msg_Mint_FullMethodName                    = "/cheqd.did.v2.Msg/Mint"
+	Msg_UpdateParams_FullMethodName            = "/cheqd.did.v2.Msg/UpdateParams"
+	Msg_PurchaseIdentityCredits_FullMethodName = "/cheqd.did.v2.Msg/PurchaseIdentityCredits"
 )
 
 // MsgClient is the client API for Msg service.
@@ -39,10 +42,16 @@ type MsgClient interface {
 	UpdateDidDoc(ctx context.Context, in *MsgUpdateDidDoc, opts ...grpc.CallOption) (*MsgUpdateDidDocResponse, error)
 	// DeactivateDidDoc defines a method for deactivating an existing DID document
 	DeactivateDidDoc(ctx context.Context, in *MsgDeactivateDidDoc, opts ...grpc.CallOption) (*MsgDeactivateDidDocResponse, error)
+	// BatchCreateDidDocs defines a method for atomically creating multiple new DID documents
+	BatchCreateDidDocs(ctx context.Context, in *MsgBatchCreateDidDocs, opts ...grpc.CallOption) (*MsgBatchCreateDidDocsResponse, error)
+	// RecoverDidDoc defines a method for replacing the verification methods of a DID document with its pre-rotated recovery key
+	RecoverDidDoc(ctx context.Context, in *MsgRecoverDidDoc, opts ...grpc.CallOption) (*MsgRecoverDidDocResponse, error)
 	Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error)
 	// Mint defines a method to mint tokens to the given address.
 	Mint(ctx context.Context, in *MsgMint, opts ...grpc.CallOption) (*MsgMintResponse, error)
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// PurchaseIdentityCredits defines a method for prepaying identity fees
+	PurchaseIdentityCredits(ctx context.Context, in *MsgPurchaseIdentityCredits, opts ...grpc.CallOption) (*MsgPurchaseIdentityCreditsResponse, error)
 }
 
 type msgClient struct {
@@ -83,6 +92,26 @@ func (c *msgClient) DeactivateDidDoc(ctx context.Context, in *MsgDeactivateDidDo
 	return out, nil
 }
 
+func (c *msgClient) BatchCreateDidDocs(ctx context.Context, in *MsgBatchCreateDidDocs, opts ...grpc.CallOption) (*MsgBatchCreateDidDocsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgBatchCreateDidDocsResponse)
+	err := c.cc.Invoke(ctx, Msg_BatchCreateDidDocs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RecoverDidDoc(ctx context.Context, in *MsgRecoverDidDoc, opts ...grpc.CallOption) (*MsgRecoverDidDocResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRecoverDidDocResponse)
+	err := c.cc.Invoke(ctx, Msg_RecoverDidDoc_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgBurnResponse)
@@ -113,6 +142,16 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) PurchaseIdentityCredits(ctx context.Context, in *MsgPurchaseIdentityCredits, opts ...grpc.CallOption) (*MsgPurchaseIdentityCreditsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgPurchaseIdentityCreditsResponse)
+	err := c.cc.Invoke(ctx, Msg_PurchaseIdentityCredits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -125,10 +164,16 @@ type MsgServer interface {
 	UpdateDidDoc(context.Context, *MsgUpdateDidDoc) (*MsgUpdateDidDocResponse, error)
 	// DeactivateDidDoc defines a method for deactivating an existing DID document
 	DeactivateDidDoc(context.Context, *MsgDeactivateDidDoc) (*MsgDeactivateDidDocResponse, error)
+	// BatchCreateDidDocs defines a method for atomically creating multiple new DID documents
+	BatchCreateDidDocs(context.Context, *MsgBatchCreateDidDocs) (*MsgBatchCreateDidDocsResponse, error)
+	// RecoverDidDoc defines a method for replacing the verification methods of a DID document with its pre-rotated recovery key
+	RecoverDidDoc(context.Context, *MsgRecoverDidDoc) (*MsgRecoverDidDocResponse, error)
 	Burn(context.Context, *MsgBurn) (*MsgBurnResponse, error)
 	// Mint defines a method to mint tokens to the given address.
 	Mint(context.Context, *MsgMint) (*MsgMintResponse, error)
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// PurchaseIdentityCredits defines a method for prepaying identity fees
+	PurchaseIdentityCredits(context.Context, *MsgPurchaseIdentityCredits) (*MsgPurchaseIdentityCreditsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -148,6 +193,12 @@ func (UnimplementedMsgServer) UpdateDidDoc(context.Context, *MsgUpdateDidDoc) (*
 func (UnimplementedMsgServer) DeactivateDidDoc(context.Context, *MsgDeactivateDidDoc) (*MsgDeactivateDidDocResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeactivateDidDoc not implemented")
 }
+func (UnimplementedMsgServer) BatchCreateDidDocs(context.Context, *MsgBatchCreateDidDocs) (*MsgBatchCreateDidDocsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateDidDocs not implemented")
+}
+func (UnimplementedMsgServer) RecoverDidDoc(context.Context, *MsgRecoverDidDoc) (*MsgRecoverDidDocResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverDidDoc not implemented")
+}
 func (UnimplementedMsgServer) Burn(context.Context, *MsgBurn) (*MsgBurnResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Burn not implemented")
 }
@@ -157,6 +208,9 @@ func (UnimplementedMsgServer) Mint(context.Context, *MsgMint) (*MsgMintResponse,
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) PurchaseIdentityCredits(context.Context, *MsgPurchaseIdentityCredits) (*MsgPurchaseIdentityCreditsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchaseIdentityCredits not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -232,6 +286,42 @@ func _Msg_DeactivateDidDoc_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_BatchCreateDidDocs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchCreateDidDocs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchCreateDidDocs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BatchCreateDidDocs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchCreateDidDocs(ctx, req.(*MsgBatchCreateDidDocs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RecoverDidDoc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecoverDidDoc)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RecoverDidDoc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RecoverDidDoc_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RecoverDidDoc(ctx, req.(*MsgRecoverDidDoc))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Burn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgBurn)
 	if err := dec(in); err != nil {
@@ -286,6 +376,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PurchaseIdentityCredits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPurchaseIdentityCredits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PurchaseIdentityCredits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PurchaseIdentityCredits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PurchaseIdentityCredits(ctx, req.(*MsgPurchaseIdentityCredits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -305,6 +413,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeactivateDidDoc",
 			Handler:    _Msg_DeactivateDidDoc_Handler,
 		},
+		{
+			MethodName: "BatchCreateDidDocs",
+			Handler:    _Msg_BatchCreateDidDocs_Handler,
+		},
+		{
+			MethodName: "RecoverDidDoc",
+			Handler:    _Msg_RecoverDidDoc_Handler,
+		},
 		{
 			MethodName: "Burn",
 			Handler:    _Msg_Burn_Handler,
@@ -317,6 +433,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "PurchaseIdentityCredits",
+			Handler:    _Msg_PurchaseIdentityCredits_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cheqd/did/v2/tx.proto",
@@ -4,11 +4,14 @@ package oraclev2
 import (
 	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
@@ -269,6 +272,57 @@ func (x *_Params_15_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Params_21_list)(nil)
+
+type _Params_21_list struct {
+	list *[]*durationpb.Duration
+}
+
+func (x *_Params_21_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_21_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_21_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*durationpb.Duration)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_21_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*durationpb.Duration)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_21_list) AppendMutable() protoreflect.Value {
+	v := new(durationpb.Duration)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_21_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_21_list) NewElement() protoreflect.Value {
+	v := new(durationpb.Duration)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_21_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Params                               protoreflect.MessageDescriptor
 	fd_Params_vote_period                   protoreflect.FieldDescriptor
@@ -289,6 +343,11 @@ var (
 	fd_Params_usdc_ibc_denom                protoreflect.FieldDescriptor
 	fd_Params_slashing_enabled              protoreflect.FieldDescriptor
 	fd_Params_averaging_window              protoreflect.FieldDescriptor
+	fd_Params_max_price_age                 protoreflect.FieldDescriptor
+	fd_Params_max_price_jump                protoreflect.FieldDescriptor
+	fd_Params_twap_lookbacks                protoreflect.FieldDescriptor
+	fd_Params_fee_price_source              protoreflect.FieldDescriptor
+	fd_Params_fee_twap_lookback             protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -312,6 +371,11 @@ func init() {
 	fd_Params_usdc_ibc_denom = md_Params.Fields().ByName("usdc_ibc_denom")
 	fd_Params_slashing_enabled = md_Params.Fields().ByName("slashing_enabled")
 	fd_Params_averaging_window = md_Params.Fields().ByName("averaging_window")
+	fd_Params_max_price_age = md_Params.Fields().ByName("max_price_age")
+	fd_Params_max_price_jump = md_Params.Fields().ByName("max_price_jump")
+	fd_Params_twap_lookbacks = md_Params.Fields().ByName("twap_lookbacks")
+	fd_Params_fee_price_source = md_Params.Fields().ByName("fee_price_source")
+	fd_Params_fee_twap_lookback = md_Params.Fields().ByName("fee_twap_lookback")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -487,6 +551,36 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.MaxPriceAge != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPriceAge)
+		if !f(fd_Params_max_price_age, value) {
+			return
+		}
+	}
+	if x.MaxPriceJump != "" {
+		value := protoreflect.ValueOfString(x.MaxPriceJump)
+		if !f(fd_Params_max_price_jump, value) {
+			return
+		}
+	}
+	if len(x.TwapLookbacks) != 0 {
+		value := protoreflect.ValueOfList(&_Params_21_list{list: &x.TwapLookbacks})
+		if !f(fd_Params_twap_lookbacks, value) {
+			return
+		}
+	}
+	if x.FeePriceSource != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.FeePriceSource))
+		if !f(fd_Params_fee_price_source, value) {
+			return
+		}
+	}
+	if x.FeeTwapLookback != nil {
+		value := protoreflect.ValueOfMessage(x.FeeTwapLookback.ProtoReflect())
+		if !f(fd_Params_fee_twap_lookback, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -538,6 +632,16 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.SlashingEnabled != false
 	case "cheqd.oracle.v2.Params.averaging_window":
 		return x.AveragingWindow != uint64(0)
+	case "cheqd.oracle.v2.Params.max_price_age":
+		return x.MaxPriceAge != uint64(0)
+	case "cheqd.oracle.v2.Params.max_price_jump":
+		return x.MaxPriceJump != ""
+	case "cheqd.oracle.v2.Params.twap_lookbacks":
+		return len(x.TwapLookbacks) != 0
+	case "cheqd.oracle.v2.Params.fee_price_source":
+		return x.FeePriceSource != 0
+	case "cheqd.oracle.v2.Params.fee_twap_lookback":
+		return x.FeeTwapLookback != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.Params"))
@@ -590,6 +694,16 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.SlashingEnabled = false
 	case "cheqd.oracle.v2.Params.averaging_window":
 		x.AveragingWindow = uint64(0)
+	case "cheqd.oracle.v2.Params.max_price_age":
+		x.MaxPriceAge = uint64(0)
+	case "cheqd.oracle.v2.Params.max_price_jump":
+		x.MaxPriceJump = ""
+	case "cheqd.oracle.v2.Params.twap_lookbacks":
+		x.TwapLookbacks = nil
+	case "cheqd.oracle.v2.Params.fee_price_source":
+		x.FeePriceSource = 0
+	case "cheqd.oracle.v2.Params.fee_twap_lookback":
+		x.FeeTwapLookback = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.Params"))
@@ -675,6 +789,24 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "cheqd.oracle.v2.Params.averaging_window":
 		value := x.AveragingWindow
 		return protoreflect.ValueOfUint64(value)
+	case "cheqd.oracle.v2.Params.max_price_age":
+		value := x.MaxPriceAge
+		return protoreflect.ValueOfUint64(value)
+	case "cheqd.oracle.v2.Params.max_price_jump":
+		value := x.MaxPriceJump
+		return protoreflect.ValueOfString(value)
+	case "cheqd.oracle.v2.Params.twap_lookbacks":
+		if len(x.TwapLookbacks) == 0 {
+			return protoreflect.ValueOfList(&_Params_21_list{})
+		}
+		listValue := &_Params_21_list{list: &x.TwapLookbacks}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.oracle.v2.Params.fee_price_source":
+		value := x.FeePriceSource
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "cheqd.oracle.v2.Params.fee_twap_lookback":
+		value := x.FeeTwapLookback
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.Params"))
@@ -741,6 +873,18 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.SlashingEnabled = value.Bool()
 	case "cheqd.oracle.v2.Params.averaging_window":
 		x.AveragingWindow = value.Uint()
+	case "cheqd.oracle.v2.Params.max_price_age":
+		x.MaxPriceAge = value.Uint()
+	case "cheqd.oracle.v2.Params.max_price_jump":
+		x.MaxPriceJump = value.Interface().(string)
+	case "cheqd.oracle.v2.Params.twap_lookbacks":
+		lv := value.List()
+		clv := lv.(*_Params_21_list)
+		x.TwapLookbacks = *clv.list
+	case "cheqd.oracle.v2.Params.fee_price_source":
+		x.FeePriceSource = (PriceSource)(value.Enum())
+	case "cheqd.oracle.v2.Params.fee_twap_lookback":
+		x.FeeTwapLookback = value.Message().Interface().(*durationpb.Duration)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.Params"))
@@ -791,6 +935,17 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_Params_15_list{list: &x.CurrencyDeviationThresholds}
 		return protoreflect.ValueOfList(value)
+	case "cheqd.oracle.v2.Params.twap_lookbacks":
+		if x.TwapLookbacks == nil {
+			x.TwapLookbacks = []*durationpb.Duration{}
+		}
+		value := &_Params_21_list{list: &x.TwapLookbacks}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.oracle.v2.Params.fee_twap_lookback":
+		if x.FeeTwapLookback == nil {
+			x.FeeTwapLookback = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.FeeTwapLookback.ProtoReflect())
 	case "cheqd.oracle.v2.Params.vote_period":
 		panic(fmt.Errorf("field vote_period of message cheqd.oracle.v2.Params is not mutable"))
 	case "cheqd.oracle.v2.Params.vote_threshold":
@@ -817,6 +972,12 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field slashing_enabled of message cheqd.oracle.v2.Params is not mutable"))
 	case "cheqd.oracle.v2.Params.averaging_window":
 		panic(fmt.Errorf("field averaging_window of message cheqd.oracle.v2.Params is not mutable"))
+	case "cheqd.oracle.v2.Params.max_price_age":
+		panic(fmt.Errorf("field max_price_age of message cheqd.oracle.v2.Params is not mutable"))
+	case "cheqd.oracle.v2.Params.max_price_jump":
+		panic(fmt.Errorf("field max_price_jump of message cheqd.oracle.v2.Params is not mutable"))
+	case "cheqd.oracle.v2.Params.fee_price_source":
+		panic(fmt.Errorf("field fee_price_source of message cheqd.oracle.v2.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.Params"))
@@ -871,6 +1032,18 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfBool(false)
 	case "cheqd.oracle.v2.Params.averaging_window":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "cheqd.oracle.v2.Params.max_price_age":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cheqd.oracle.v2.Params.max_price_jump":
+		return protoreflect.ValueOfString("")
+	case "cheqd.oracle.v2.Params.twap_lookbacks":
+		list := []*durationpb.Duration{}
+		return protoreflect.ValueOfList(&_Params_21_list{list: &list})
+	case "cheqd.oracle.v2.Params.fee_price_source":
+		return protoreflect.ValueOfEnum(0)
+	case "cheqd.oracle.v2.Params.fee_twap_lookback":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.Params"))
@@ -1013,6 +1186,26 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.AveragingWindow != 0 {
 			n += 2 + runtime.Sov(uint64(x.AveragingWindow))
 		}
+		if x.MaxPriceAge != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxPriceAge))
+		}
+		l = len(x.MaxPriceJump)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.TwapLookbacks) > 0 {
+			for _, e := range x.TwapLookbacks {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.FeePriceSource != 0 {
+			n += 2 + runtime.Sov(uint64(x.FeePriceSource))
+		}
+		if x.FeeTwapLookback != nil {
+			l = options.Size(x.FeeTwapLookback)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1042,6 +1235,63 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.FeeTwapLookback != nil {
+			encoded, err := options.Marshal(x.FeeTwapLookback)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+		if x.FeePriceSource != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FeePriceSource))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb0
+		}
+		if len(x.TwapLookbacks) > 0 {
+			for iNdEx := len(x.TwapLookbacks) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TwapLookbacks[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xaa
+			}
+		}
+		if len(x.MaxPriceJump) > 0 {
+			i -= len(x.MaxPriceJump)
+			copy(dAtA[i:], x.MaxPriceJump)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxPriceJump)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+		if x.MaxPriceAge != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPriceAge))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x98
+		}
 		if x.AveragingWindow != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.AveragingWindow))
 			i--
@@ -1725,6 +1975,146 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 19:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
+				}
+				x.MaxPriceAge = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPriceAge |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 20:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPriceJump", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxPriceJump = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 21:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TwapLookbacks", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TwapLookbacks = append(x.TwapLookbacks, &durationpb.Duration{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TwapLookbacks[len(x.TwapLookbacks)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 22:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeePriceSource", wireType)
+				}
+				x.FeePriceSource = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FeePriceSource |= PriceSource(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 23:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeTwapLookback", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FeeTwapLookback == nil {
+					x.FeeTwapLookback = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeTwapLookback); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1761,29 +2151,31 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Denom              protoreflect.MessageDescriptor
-	fd_Denom_base_denom   protoreflect.FieldDescriptor
-	fd_Denom_symbol_denom protoreflect.FieldDescriptor
-	fd_Denom_exponent     protoreflect.FieldDescriptor
+	md_TwapCheckpoint            protoreflect.MessageDescriptor
+	fd_TwapCheckpoint_price      protoreflect.FieldDescriptor
+	fd_TwapCheckpoint_cumulative protoreflect.FieldDescriptor
+	fd_TwapCheckpoint_height     protoreflect.FieldDescriptor
+	fd_TwapCheckpoint_time       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cheqd_oracle_v2_oracle_proto_init()
-	md_Denom = File_cheqd_oracle_v2_oracle_proto.Messages().ByName("Denom")
-	fd_Denom_base_denom = md_Denom.Fields().ByName("base_denom")
-	fd_Denom_symbol_denom = md_Denom.Fields().ByName("symbol_denom")
-	fd_Denom_exponent = md_Denom.Fields().ByName("exponent")
+	md_TwapCheckpoint = File_cheqd_oracle_v2_oracle_proto.Messages().ByName("TwapCheckpoint")
+	fd_TwapCheckpoint_price = md_TwapCheckpoint.Fields().ByName("price")
+	fd_TwapCheckpoint_cumulative = md_TwapCheckpoint.Fields().ByName("cumulative")
+	fd_TwapCheckpoint_height = md_TwapCheckpoint.Fields().ByName("height")
+	fd_TwapCheckpoint_time = md_TwapCheckpoint.Fields().ByName("time")
 }
 
-var _ protoreflect.Message = (*fastReflection_Denom)(nil)
+var _ protoreflect.Message = (*fastReflection_TwapCheckpoint)(nil)
 
-type fastReflection_Denom Denom
+type fastReflection_TwapCheckpoint TwapCheckpoint
 
-func (x *Denom) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Denom)(x)
+func (x *TwapCheckpoint) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TwapCheckpoint)(x)
 }
 
-func (x *Denom) slowProtoReflect() protoreflect.Message {
+func (x *TwapCheckpoint) slowProtoReflect() protoreflect.Message {
 	mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1795,43 +2187,43 @@ func (x *Denom) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Denom_messageType fastReflection_Denom_messageType
-var _ protoreflect.MessageType = fastReflection_Denom_messageType{}
+var _fastReflection_TwapCheckpoint_messageType fastReflection_TwapCheckpoint_messageType
+var _ protoreflect.MessageType = fastReflection_TwapCheckpoint_messageType{}
 
-type fastReflection_Denom_messageType struct{}
+type fastReflection_TwapCheckpoint_messageType struct{}
 
-func (x fastReflection_Denom_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Denom)(nil)
+func (x fastReflection_TwapCheckpoint_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TwapCheckpoint)(nil)
 }
-func (x fastReflection_Denom_messageType) New() protoreflect.Message {
-	return new(fastReflection_Denom)
+func (x fastReflection_TwapCheckpoint_messageType) New() protoreflect.Message {
+	return new(fastReflection_TwapCheckpoint)
 }
-func (x fastReflection_Denom_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Denom
+func (x fastReflection_TwapCheckpoint_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TwapCheckpoint
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Denom) Descriptor() protoreflect.MessageDescriptor {
-	return md_Denom
+func (x *fastReflection_TwapCheckpoint) Descriptor() protoreflect.MessageDescriptor {
+	return md_TwapCheckpoint
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Denom) Type() protoreflect.MessageType {
-	return _fastReflection_Denom_messageType
+func (x *fastReflection_TwapCheckpoint) Type() protoreflect.MessageType {
+	return _fastReflection_TwapCheckpoint_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Denom) New() protoreflect.Message {
-	return new(fastReflection_Denom)
+func (x *fastReflection_TwapCheckpoint) New() protoreflect.Message {
+	return new(fastReflection_TwapCheckpoint)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Denom) Interface() protoreflect.ProtoMessage {
-	return (*Denom)(x)
+func (x *fastReflection_TwapCheckpoint) Interface() protoreflect.ProtoMessage {
+	return (*TwapCheckpoint)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1839,22 +2231,28 @@ func (x *fastReflection_Denom) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Denom) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.BaseDenom != "" {
-		value := protoreflect.ValueOfString(x.BaseDenom)
-		if !f(fd_Denom_base_denom, value) {
+func (x *fastReflection_TwapCheckpoint) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Price != "" {
+		value := protoreflect.ValueOfString(x.Price)
+		if !f(fd_TwapCheckpoint_price, value) {
 			return
 		}
 	}
-	if x.SymbolDenom != "" {
-		value := protoreflect.ValueOfString(x.SymbolDenom)
-		if !f(fd_Denom_symbol_denom, value) {
+	if x.Cumulative != "" {
+		value := protoreflect.ValueOfString(x.Cumulative)
+		if !f(fd_TwapCheckpoint_cumulative, value) {
 			return
 		}
 	}
-	if x.Exponent != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.Exponent)
-		if !f(fd_Denom_exponent, value) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_TwapCheckpoint_height, value) {
+			return
+		}
+	}
+	if x.Time != nil {
+		value := protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+		if !f(fd_TwapCheckpoint_time, value) {
 			return
 		}
 	}
@@ -1871,19 +2269,21 @@ func (x *fastReflection_Denom) Range(f func(protoreflect.FieldDescriptor, protor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Denom) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TwapCheckpoint) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.Denom.base_denom":
-		return x.BaseDenom != ""
-	case "cheqd.oracle.v2.Denom.symbol_denom":
-		return x.SymbolDenom != ""
-	case "cheqd.oracle.v2.Denom.exponent":
-		return x.Exponent != uint32(0)
+	case "cheqd.oracle.v2.TwapCheckpoint.price":
+		return x.Price != ""
+	case "cheqd.oracle.v2.TwapCheckpoint.cumulative":
+		return x.Cumulative != ""
+	case "cheqd.oracle.v2.TwapCheckpoint.height":
+		return x.Height != int64(0)
+	case "cheqd.oracle.v2.TwapCheckpoint.time":
+		return x.Time != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.Denom"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.TwapCheckpoint"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.Denom does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.TwapCheckpoint does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1893,19 +2293,21 @@ func (x *fastReflection_Denom) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Denom) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TwapCheckpoint) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.Denom.base_denom":
-		x.BaseDenom = ""
-	case "cheqd.oracle.v2.Denom.symbol_denom":
-		x.SymbolDenom = ""
-	case "cheqd.oracle.v2.Denom.exponent":
-		x.Exponent = uint32(0)
+	case "cheqd.oracle.v2.TwapCheckpoint.price":
+		x.Price = ""
+	case "cheqd.oracle.v2.TwapCheckpoint.cumulative":
+		x.Cumulative = ""
+	case "cheqd.oracle.v2.TwapCheckpoint.height":
+		x.Height = int64(0)
+	case "cheqd.oracle.v2.TwapCheckpoint.time":
+		x.Time = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.Denom"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.TwapCheckpoint"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.Denom does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.TwapCheckpoint does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1915,22 +2317,25 @@ func (x *fastReflection_Denom) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Denom) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TwapCheckpoint) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cheqd.oracle.v2.Denom.base_denom":
-		value := x.BaseDenom
+	case "cheqd.oracle.v2.TwapCheckpoint.price":
+		value := x.Price
 		return protoreflect.ValueOfString(value)
-	case "cheqd.oracle.v2.Denom.symbol_denom":
-		value := x.SymbolDenom
+	case "cheqd.oracle.v2.TwapCheckpoint.cumulative":
+		value := x.Cumulative
 		return protoreflect.ValueOfString(value)
-	case "cheqd.oracle.v2.Denom.exponent":
-		value := x.Exponent
-		return protoreflect.ValueOfUint32(value)
+	case "cheqd.oracle.v2.TwapCheckpoint.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "cheqd.oracle.v2.TwapCheckpoint.time":
+		value := x.Time
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.Denom"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.TwapCheckpoint"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.Denom does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.TwapCheckpoint does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1944,19 +2349,21 @@ func (x *fastReflection_Denom) Get(descriptor protoreflect.FieldDescriptor) prot
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Denom) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TwapCheckpoint) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.Denom.base_denom":
-		x.BaseDenom = value.Interface().(string)
-	case "cheqd.oracle.v2.Denom.symbol_denom":
-		x.SymbolDenom = value.Interface().(string)
-	case "cheqd.oracle.v2.Denom.exponent":
-		x.Exponent = uint32(value.Uint())
+	case "cheqd.oracle.v2.TwapCheckpoint.price":
+		x.Price = value.Interface().(string)
+	case "cheqd.oracle.v2.TwapCheckpoint.cumulative":
+		x.Cumulative = value.Interface().(string)
+	case "cheqd.oracle.v2.TwapCheckpoint.height":
+		x.Height = value.Int()
+	case "cheqd.oracle.v2.TwapCheckpoint.time":
+		x.Time = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.Denom"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.TwapCheckpoint"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.Denom does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.TwapCheckpoint does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1970,48 +2377,56 @@ func (x *fastReflection_Denom) Set(fd protoreflect.FieldDescriptor, value protor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Denom) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TwapCheckpoint) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.Denom.base_denom":
-		panic(fmt.Errorf("field base_denom of message cheqd.oracle.v2.Denom is not mutable"))
-	case "cheqd.oracle.v2.Denom.symbol_denom":
-		panic(fmt.Errorf("field symbol_denom of message cheqd.oracle.v2.Denom is not mutable"))
-	case "cheqd.oracle.v2.Denom.exponent":
-		panic(fmt.Errorf("field exponent of message cheqd.oracle.v2.Denom is not mutable"))
+	case "cheqd.oracle.v2.TwapCheckpoint.time":
+		if x.Time == nil {
+			x.Time = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+	case "cheqd.oracle.v2.TwapCheckpoint.price":
+		panic(fmt.Errorf("field price of message cheqd.oracle.v2.TwapCheckpoint is not mutable"))
+	case "cheqd.oracle.v2.TwapCheckpoint.cumulative":
+		panic(fmt.Errorf("field cumulative of message cheqd.oracle.v2.TwapCheckpoint is not mutable"))
+	case "cheqd.oracle.v2.TwapCheckpoint.height":
+		panic(fmt.Errorf("field height of message cheqd.oracle.v2.TwapCheckpoint is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.Denom"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.TwapCheckpoint"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.Denom does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.TwapCheckpoint does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Denom) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TwapCheckpoint) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.Denom.base_denom":
+	case "cheqd.oracle.v2.TwapCheckpoint.price":
 		return protoreflect.ValueOfString("")
-	case "cheqd.oracle.v2.Denom.symbol_denom":
+	case "cheqd.oracle.v2.TwapCheckpoint.cumulative":
 		return protoreflect.ValueOfString("")
-	case "cheqd.oracle.v2.Denom.exponent":
-		return protoreflect.ValueOfUint32(uint32(0))
+	case "cheqd.oracle.v2.TwapCheckpoint.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cheqd.oracle.v2.TwapCheckpoint.time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.Denom"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.TwapCheckpoint"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.Denom does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.TwapCheckpoint does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Denom) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TwapCheckpoint) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cheqd.oracle.v2.Denom", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.oracle.v2.TwapCheckpoint", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2019,7 +2434,7 @@ func (x *fastReflection_Denom) WhichOneof(d protoreflect.OneofDescriptor) protor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Denom) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TwapCheckpoint) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2030,7 +2445,7 @@ func (x *fastReflection_Denom) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Denom) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TwapCheckpoint) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2042,7 +2457,7 @@ func (x *fastReflection_Denom) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Denom) IsValid() bool {
+func (x *fastReflection_TwapCheckpoint) IsValid() bool {
 	return x != nil
 }
 
@@ -2052,9 +2467,9 @@ func (x *fastReflection_Denom) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Denom) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TwapCheckpoint) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Denom)
+		x := input.Message.Interface().(*TwapCheckpoint)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2066,16 +2481,20 @@ func (x *fastReflection_Denom) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.BaseDenom)
+		l = len(x.Price)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.SymbolDenom)
+		l = len(x.Cumulative)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Exponent != 0 {
-			n += 1 + runtime.Sov(uint64(x.Exponent))
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Time != nil {
+			l = options.Size(x.Time)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2087,7 +2506,7 @@ func (x *fastReflection_Denom) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Denom)
+		x := input.Message.Interface().(*TwapCheckpoint)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2106,22 +2525,36 @@ func (x *fastReflection_Denom) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Exponent != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Exponent))
+		if x.Time != nil {
+			encoded, err := options.Marshal(x.Time)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
 			i--
 			dAtA[i] = 0x18
 		}
-		if len(x.SymbolDenom) > 0 {
-			i -= len(x.SymbolDenom)
-			copy(dAtA[i:], x.SymbolDenom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SymbolDenom)))
+		if len(x.Cumulative) > 0 {
+			i -= len(x.Cumulative)
+			copy(dAtA[i:], x.Cumulative)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Cumulative)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.BaseDenom) > 0 {
-			i -= len(x.BaseDenom)
-			copy(dAtA[i:], x.BaseDenom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseDenom)))
+		if len(x.Price) > 0 {
+			i -= len(x.Price)
+			copy(dAtA[i:], x.Price)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Price)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -2136,7 +2569,7 @@ func (x *fastReflection_Denom) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Denom)
+		x := input.Message.Interface().(*TwapCheckpoint)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2168,15 +2601,15 @@ func (x *fastReflection_Denom) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Denom: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TwapCheckpoint: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Denom: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TwapCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2204,11 +2637,11 @@ func (x *fastReflection_Denom) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BaseDenom = string(dAtA[iNdEx:postIndex])
+				x.Price = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SymbolDenom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Cumulative", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2236,13 +2669,13 @@ func (x *fastReflection_Denom) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.SymbolDenom = string(dAtA[iNdEx:postIndex])
+				x.Cumulative = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 				}
-				x.Exponent = 0
+				x.Height = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2252,11 +2685,47 @@ func (x *fastReflection_Denom) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Exponent |= uint32(b&0x7F) << shift
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Time == nil {
+					x.Time = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Time); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2293,27 +2762,29 @@ func (x *fastReflection_Denom) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_RewardBand              protoreflect.MessageDescriptor
-	fd_RewardBand_symbol_denom protoreflect.FieldDescriptor
-	fd_RewardBand_reward_band  protoreflect.FieldDescriptor
+	md_Denom              protoreflect.MessageDescriptor
+	fd_Denom_base_denom   protoreflect.FieldDescriptor
+	fd_Denom_symbol_denom protoreflect.FieldDescriptor
+	fd_Denom_exponent     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cheqd_oracle_v2_oracle_proto_init()
-	md_RewardBand = File_cheqd_oracle_v2_oracle_proto.Messages().ByName("RewardBand")
-	fd_RewardBand_symbol_denom = md_RewardBand.Fields().ByName("symbol_denom")
-	fd_RewardBand_reward_band = md_RewardBand.Fields().ByName("reward_band")
+	md_Denom = File_cheqd_oracle_v2_oracle_proto.Messages().ByName("Denom")
+	fd_Denom_base_denom = md_Denom.Fields().ByName("base_denom")
+	fd_Denom_symbol_denom = md_Denom.Fields().ByName("symbol_denom")
+	fd_Denom_exponent = md_Denom.Fields().ByName("exponent")
 }
 
-var _ protoreflect.Message = (*fastReflection_RewardBand)(nil)
+var _ protoreflect.Message = (*fastReflection_Denom)(nil)
 
-type fastReflection_RewardBand RewardBand
+type fastReflection_Denom Denom
 
-func (x *RewardBand) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RewardBand)(x)
+func (x *Denom) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Denom)(x)
 }
 
-func (x *RewardBand) slowProtoReflect() protoreflect.Message {
+func (x *Denom) slowProtoReflect() protoreflect.Message {
 	mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2325,43 +2796,43 @@ func (x *RewardBand) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_RewardBand_messageType fastReflection_RewardBand_messageType
-var _ protoreflect.MessageType = fastReflection_RewardBand_messageType{}
+var _fastReflection_Denom_messageType fastReflection_Denom_messageType
+var _ protoreflect.MessageType = fastReflection_Denom_messageType{}
 
-type fastReflection_RewardBand_messageType struct{}
+type fastReflection_Denom_messageType struct{}
 
-func (x fastReflection_RewardBand_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RewardBand)(nil)
+func (x fastReflection_Denom_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Denom)(nil)
 }
-func (x fastReflection_RewardBand_messageType) New() protoreflect.Message {
-	return new(fastReflection_RewardBand)
+func (x fastReflection_Denom_messageType) New() protoreflect.Message {
+	return new(fastReflection_Denom)
 }
-func (x fastReflection_RewardBand_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RewardBand
+func (x fastReflection_Denom_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Denom
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_RewardBand) Descriptor() protoreflect.MessageDescriptor {
-	return md_RewardBand
+func (x *fastReflection_Denom) Descriptor() protoreflect.MessageDescriptor {
+	return md_Denom
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RewardBand) Type() protoreflect.MessageType {
-	return _fastReflection_RewardBand_messageType
+func (x *fastReflection_Denom) Type() protoreflect.MessageType {
+	return _fastReflection_Denom_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RewardBand) New() protoreflect.Message {
-	return new(fastReflection_RewardBand)
+func (x *fastReflection_Denom) New() protoreflect.Message {
+	return new(fastReflection_Denom)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_RewardBand) Interface() protoreflect.ProtoMessage {
-	return (*RewardBand)(x)
+func (x *fastReflection_Denom) Interface() protoreflect.ProtoMessage {
+	return (*Denom)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2369,16 +2840,22 @@ func (x *fastReflection_RewardBand) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_RewardBand) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_Denom) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BaseDenom != "" {
+		value := protoreflect.ValueOfString(x.BaseDenom)
+		if !f(fd_Denom_base_denom, value) {
+			return
+		}
+	}
 	if x.SymbolDenom != "" {
 		value := protoreflect.ValueOfString(x.SymbolDenom)
-		if !f(fd_RewardBand_symbol_denom, value) {
+		if !f(fd_Denom_symbol_denom, value) {
 			return
 		}
 	}
-	if x.RewardBand != "" {
-		value := protoreflect.ValueOfString(x.RewardBand)
-		if !f(fd_RewardBand_reward_band, value) {
+	if x.Exponent != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Exponent)
+		if !f(fd_Denom_exponent, value) {
 			return
 		}
 	}
@@ -2395,17 +2872,19 @@ func (x *fastReflection_RewardBand) Range(f func(protoreflect.FieldDescriptor, p
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_RewardBand) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Denom) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.RewardBand.symbol_denom":
+	case "cheqd.oracle.v2.Denom.base_denom":
+		return x.BaseDenom != ""
+	case "cheqd.oracle.v2.Denom.symbol_denom":
 		return x.SymbolDenom != ""
-	case "cheqd.oracle.v2.RewardBand.reward_band":
-		return x.RewardBand != ""
+	case "cheqd.oracle.v2.Denom.exponent":
+		return x.Exponent != uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.RewardBand"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.Denom"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.RewardBand does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.Denom does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2415,17 +2894,19 @@ func (x *fastReflection_RewardBand) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RewardBand) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Denom) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.RewardBand.symbol_denom":
+	case "cheqd.oracle.v2.Denom.base_denom":
+		x.BaseDenom = ""
+	case "cheqd.oracle.v2.Denom.symbol_denom":
 		x.SymbolDenom = ""
-	case "cheqd.oracle.v2.RewardBand.reward_band":
-		x.RewardBand = ""
+	case "cheqd.oracle.v2.Denom.exponent":
+		x.Exponent = uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.RewardBand"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.Denom"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.RewardBand does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.Denom does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2435,19 +2916,22 @@ func (x *fastReflection_RewardBand) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_RewardBand) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Denom) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cheqd.oracle.v2.RewardBand.symbol_denom":
-		value := x.SymbolDenom
+	case "cheqd.oracle.v2.Denom.base_denom":
+		value := x.BaseDenom
 		return protoreflect.ValueOfString(value)
-	case "cheqd.oracle.v2.RewardBand.reward_band":
-		value := x.RewardBand
+	case "cheqd.oracle.v2.Denom.symbol_denom":
+		value := x.SymbolDenom
 		return protoreflect.ValueOfString(value)
+	case "cheqd.oracle.v2.Denom.exponent":
+		value := x.Exponent
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.RewardBand"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.Denom"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.RewardBand does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.Denom does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2461,17 +2945,19 @@ func (x *fastReflection_RewardBand) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RewardBand) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Denom) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.RewardBand.symbol_denom":
+	case "cheqd.oracle.v2.Denom.base_denom":
+		x.BaseDenom = value.Interface().(string)
+	case "cheqd.oracle.v2.Denom.symbol_denom":
 		x.SymbolDenom = value.Interface().(string)
-	case "cheqd.oracle.v2.RewardBand.reward_band":
-		x.RewardBand = value.Interface().(string)
+	case "cheqd.oracle.v2.Denom.exponent":
+		x.Exponent = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.RewardBand"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.Denom"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.RewardBand does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.Denom does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2485,44 +2971,48 @@ func (x *fastReflection_RewardBand) Set(fd protoreflect.FieldDescriptor, value p
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RewardBand) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Denom) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.RewardBand.symbol_denom":
-		panic(fmt.Errorf("field symbol_denom of message cheqd.oracle.v2.RewardBand is not mutable"))
-	case "cheqd.oracle.v2.RewardBand.reward_band":
-		panic(fmt.Errorf("field reward_band of message cheqd.oracle.v2.RewardBand is not mutable"))
+	case "cheqd.oracle.v2.Denom.base_denom":
+		panic(fmt.Errorf("field base_denom of message cheqd.oracle.v2.Denom is not mutable"))
+	case "cheqd.oracle.v2.Denom.symbol_denom":
+		panic(fmt.Errorf("field symbol_denom of message cheqd.oracle.v2.Denom is not mutable"))
+	case "cheqd.oracle.v2.Denom.exponent":
+		panic(fmt.Errorf("field exponent of message cheqd.oracle.v2.Denom is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.RewardBand"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.Denom"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.RewardBand does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.Denom does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_RewardBand) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Denom) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.RewardBand.symbol_denom":
+	case "cheqd.oracle.v2.Denom.base_denom":
 		return protoreflect.ValueOfString("")
-	case "cheqd.oracle.v2.RewardBand.reward_band":
+	case "cheqd.oracle.v2.Denom.symbol_denom":
 		return protoreflect.ValueOfString("")
+	case "cheqd.oracle.v2.Denom.exponent":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.RewardBand"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.Denom"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.RewardBand does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.Denom does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_RewardBand) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Denom) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cheqd.oracle.v2.RewardBand", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.oracle.v2.Denom", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2530,7 +3020,7 @@ func (x *fastReflection_RewardBand) WhichOneof(d protoreflect.OneofDescriptor) p
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_RewardBand) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Denom) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2541,7 +3031,7 @@ func (x *fastReflection_RewardBand) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RewardBand) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Denom) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2553,7 +3043,7 @@ func (x *fastReflection_RewardBand) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_RewardBand) IsValid() bool {
+func (x *fastReflection_Denom) IsValid() bool {
 	return x != nil
 }
 
@@ -2563,9 +3053,9 @@ func (x *fastReflection_RewardBand) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_RewardBand) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Denom) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*RewardBand)
+		x := input.Message.Interface().(*Denom)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2577,14 +3067,17 @@ func (x *fastReflection_RewardBand) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.SymbolDenom)
+		l = len(x.BaseDenom)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.RewardBand)
+		l = len(x.SymbolDenom)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Exponent != 0 {
+			n += 1 + runtime.Sov(uint64(x.Exponent))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2595,7 +3088,7 @@ func (x *fastReflection_RewardBand) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*RewardBand)
+		x := input.Message.Interface().(*Denom)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2614,18 +3107,23 @@ func (x *fastReflection_RewardBand) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.RewardBand) > 0 {
-			i -= len(x.RewardBand)
-			copy(dAtA[i:], x.RewardBand)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RewardBand)))
+		if x.Exponent != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Exponent))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x18
 		}
 		if len(x.SymbolDenom) > 0 {
 			i -= len(x.SymbolDenom)
 			copy(dAtA[i:], x.SymbolDenom)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SymbolDenom)))
 			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.BaseDenom) > 0 {
+			i -= len(x.BaseDenom)
+			copy(dAtA[i:], x.BaseDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseDenom)))
+			i--
 			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
@@ -2639,7 +3137,7 @@ func (x *fastReflection_RewardBand) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*RewardBand)
+		x := input.Message.Interface().(*Denom)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2671,15 +3169,15 @@ func (x *fastReflection_RewardBand) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RewardBand: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Denom: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RewardBand: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Denom: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SymbolDenom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2707,11 +3205,11 @@ func (x *fastReflection_RewardBand) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.SymbolDenom = string(dAtA[iNdEx:postIndex])
+				x.BaseDenom = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SymbolDenom", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2739,8 +3237,27 @@ func (x *fastReflection_RewardBand) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.RewardBand = string(dAtA[iNdEx:postIndex])
+				x.SymbolDenom = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+				}
+				x.Exponent = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Exponent |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2777,29 +3294,27 @@ func (x *fastReflection_RewardBand) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_AggregateExchangeRatePrevote              protoreflect.MessageDescriptor
-	fd_AggregateExchangeRatePrevote_hash         protoreflect.FieldDescriptor
-	fd_AggregateExchangeRatePrevote_voter        protoreflect.FieldDescriptor
-	fd_AggregateExchangeRatePrevote_submit_block protoreflect.FieldDescriptor
+	md_RewardBand              protoreflect.MessageDescriptor
+	fd_RewardBand_symbol_denom protoreflect.FieldDescriptor
+	fd_RewardBand_reward_band  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cheqd_oracle_v2_oracle_proto_init()
-	md_AggregateExchangeRatePrevote = File_cheqd_oracle_v2_oracle_proto.Messages().ByName("AggregateExchangeRatePrevote")
-	fd_AggregateExchangeRatePrevote_hash = md_AggregateExchangeRatePrevote.Fields().ByName("hash")
-	fd_AggregateExchangeRatePrevote_voter = md_AggregateExchangeRatePrevote.Fields().ByName("voter")
-	fd_AggregateExchangeRatePrevote_submit_block = md_AggregateExchangeRatePrevote.Fields().ByName("submit_block")
+	md_RewardBand = File_cheqd_oracle_v2_oracle_proto.Messages().ByName("RewardBand")
+	fd_RewardBand_symbol_denom = md_RewardBand.Fields().ByName("symbol_denom")
+	fd_RewardBand_reward_band = md_RewardBand.Fields().ByName("reward_band")
 }
 
-var _ protoreflect.Message = (*fastReflection_AggregateExchangeRatePrevote)(nil)
+var _ protoreflect.Message = (*fastReflection_RewardBand)(nil)
 
-type fastReflection_AggregateExchangeRatePrevote AggregateExchangeRatePrevote
+type fastReflection_RewardBand RewardBand
 
-func (x *AggregateExchangeRatePrevote) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_AggregateExchangeRatePrevote)(x)
+func (x *RewardBand) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RewardBand)(x)
 }
 
-func (x *AggregateExchangeRatePrevote) slowProtoReflect() protoreflect.Message {
+func (x *RewardBand) slowProtoReflect() protoreflect.Message {
 	mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2811,43 +3326,43 @@ func (x *AggregateExchangeRatePrevote) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_AggregateExchangeRatePrevote_messageType fastReflection_AggregateExchangeRatePrevote_messageType
-var _ protoreflect.MessageType = fastReflection_AggregateExchangeRatePrevote_messageType{}
+var _fastReflection_RewardBand_messageType fastReflection_RewardBand_messageType
+var _ protoreflect.MessageType = fastReflection_RewardBand_messageType{}
 
-type fastReflection_AggregateExchangeRatePrevote_messageType struct{}
+type fastReflection_RewardBand_messageType struct{}
 
-func (x fastReflection_AggregateExchangeRatePrevote_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_AggregateExchangeRatePrevote)(nil)
+func (x fastReflection_RewardBand_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RewardBand)(nil)
 }
-func (x fastReflection_AggregateExchangeRatePrevote_messageType) New() protoreflect.Message {
-	return new(fastReflection_AggregateExchangeRatePrevote)
+func (x fastReflection_RewardBand_messageType) New() protoreflect.Message {
+	return new(fastReflection_RewardBand)
 }
-func (x fastReflection_AggregateExchangeRatePrevote_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_AggregateExchangeRatePrevote
+func (x fastReflection_RewardBand_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RewardBand
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_AggregateExchangeRatePrevote) Descriptor() protoreflect.MessageDescriptor {
-	return md_AggregateExchangeRatePrevote
+func (x *fastReflection_RewardBand) Descriptor() protoreflect.MessageDescriptor {
+	return md_RewardBand
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_AggregateExchangeRatePrevote) Type() protoreflect.MessageType {
-	return _fastReflection_AggregateExchangeRatePrevote_messageType
+func (x *fastReflection_RewardBand) Type() protoreflect.MessageType {
+	return _fastReflection_RewardBand_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_AggregateExchangeRatePrevote) New() protoreflect.Message {
-	return new(fastReflection_AggregateExchangeRatePrevote)
+func (x *fastReflection_RewardBand) New() protoreflect.Message {
+	return new(fastReflection_RewardBand)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_AggregateExchangeRatePrevote) Interface() protoreflect.ProtoMessage {
-	return (*AggregateExchangeRatePrevote)(x)
+func (x *fastReflection_RewardBand) Interface() protoreflect.ProtoMessage {
+	return (*RewardBand)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2855,22 +3370,16 @@ func (x *fastReflection_AggregateExchangeRatePrevote) Interface() protoreflect.P
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_AggregateExchangeRatePrevote) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Hash != "" {
-		value := protoreflect.ValueOfString(x.Hash)
-		if !f(fd_AggregateExchangeRatePrevote_hash, value) {
-			return
-		}
-	}
-	if x.Voter != "" {
-		value := protoreflect.ValueOfString(x.Voter)
-		if !f(fd_AggregateExchangeRatePrevote_voter, value) {
+func (x *fastReflection_RewardBand) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SymbolDenom != "" {
+		value := protoreflect.ValueOfString(x.SymbolDenom)
+		if !f(fd_RewardBand_symbol_denom, value) {
 			return
 		}
 	}
-	if x.SubmitBlock != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.SubmitBlock)
-		if !f(fd_AggregateExchangeRatePrevote_submit_block, value) {
+	if x.RewardBand != "" {
+		value := protoreflect.ValueOfString(x.RewardBand)
+		if !f(fd_RewardBand_reward_band, value) {
 			return
 		}
 	}
@@ -2887,19 +3396,17 @@ func (x *fastReflection_AggregateExchangeRatePrevote) Range(f func(protoreflect.
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_AggregateExchangeRatePrevote) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_RewardBand) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.AggregateExchangeRatePrevote.hash":
-		return x.Hash != ""
-	case "cheqd.oracle.v2.AggregateExchangeRatePrevote.voter":
-		return x.Voter != ""
-	case "cheqd.oracle.v2.AggregateExchangeRatePrevote.submit_block":
-		return x.SubmitBlock != uint64(0)
+	case "cheqd.oracle.v2.RewardBand.symbol_denom":
+		return x.SymbolDenom != ""
+	case "cheqd.oracle.v2.RewardBand.reward_band":
+		return x.RewardBand != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.AggregateExchangeRatePrevote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.RewardBand"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.AggregateExchangeRatePrevote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.RewardBand does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2909,19 +3416,17 @@ func (x *fastReflection_AggregateExchangeRatePrevote) Has(fd protoreflect.FieldD
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AggregateExchangeRatePrevote) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_RewardBand) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.AggregateExchangeRatePrevote.hash":
-		x.Hash = ""
-	case "cheqd.oracle.v2.AggregateExchangeRatePrevote.voter":
-		x.Voter = ""
-	case "cheqd.oracle.v2.AggregateExchangeRatePrevote.submit_block":
-		x.SubmitBlock = uint64(0)
+	case "cheqd.oracle.v2.RewardBand.symbol_denom":
+		x.SymbolDenom = ""
+	case "cheqd.oracle.v2.RewardBand.reward_band":
+		x.RewardBand = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.AggregateExchangeRatePrevote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.RewardBand"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.AggregateExchangeRatePrevote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.RewardBand does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2931,22 +3436,19 @@ func (x *fastReflection_AggregateExchangeRatePrevote) Clear(fd protoreflect.Fiel
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_AggregateExchangeRatePrevote) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RewardBand) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cheqd.oracle.v2.AggregateExchangeRatePrevote.hash":
-		value := x.Hash
-		return protoreflect.ValueOfString(value)
-	case "cheqd.oracle.v2.AggregateExchangeRatePrevote.voter":
-		value := x.Voter
+	case "cheqd.oracle.v2.RewardBand.symbol_denom":
+		value := x.SymbolDenom
+		return protoreflect.ValueOfString(value)
+	case "cheqd.oracle.v2.RewardBand.reward_band":
+		value := x.RewardBand
 		return protoreflect.ValueOfString(value)
-	case "cheqd.oracle.v2.AggregateExchangeRatePrevote.submit_block":
-		value := x.SubmitBlock
-		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.AggregateExchangeRatePrevote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.RewardBand"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.AggregateExchangeRatePrevote does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.RewardBand does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2960,19 +3462,17 @@ func (x *fastReflection_AggregateExchangeRatePrevote) Get(descriptor protoreflec
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AggregateExchangeRatePrevote) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_RewardBand) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.AggregateExchangeRatePrevote.hash":
-		x.Hash = value.Interface().(string)
-	case "cheqd.oracle.v2.AggregateExchangeRatePrevote.voter":
-		x.Voter = value.Interface().(string)
-	case "cheqd.oracle.v2.AggregateExchangeRatePrevote.submit_block":
-		x.SubmitBlock = value.Uint()
+	case "cheqd.oracle.v2.RewardBand.symbol_denom":
+		x.SymbolDenom = value.Interface().(string)
+	case "cheqd.oracle.v2.RewardBand.reward_band":
+		x.RewardBand = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.AggregateExchangeRatePrevote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.RewardBand"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.AggregateExchangeRatePrevote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.RewardBand does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2986,48 +3486,44 @@ func (x *fastReflection_AggregateExchangeRatePrevote) Set(fd protoreflect.FieldD
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AggregateExchangeRatePrevote) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RewardBand) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.AggregateExchangeRatePrevote.hash":
-		panic(fmt.Errorf("field hash of message cheqd.oracle.v2.AggregateExchangeRatePrevote is not mutable"))
-	case "cheqd.oracle.v2.AggregateExchangeRatePrevote.voter":
-		panic(fmt.Errorf("field voter of message cheqd.oracle.v2.AggregateExchangeRatePrevote is not mutable"))
-	case "cheqd.oracle.v2.AggregateExchangeRatePrevote.submit_block":
-		panic(fmt.Errorf("field submit_block of message cheqd.oracle.v2.AggregateExchangeRatePrevote is not mutable"))
+	case "cheqd.oracle.v2.RewardBand.symbol_denom":
+		panic(fmt.Errorf("field symbol_denom of message cheqd.oracle.v2.RewardBand is not mutable"))
+	case "cheqd.oracle.v2.RewardBand.reward_band":
+		panic(fmt.Errorf("field reward_band of message cheqd.oracle.v2.RewardBand is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.AggregateExchangeRatePrevote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.RewardBand"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.AggregateExchangeRatePrevote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.RewardBand does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_AggregateExchangeRatePrevote) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RewardBand) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.AggregateExchangeRatePrevote.hash":
+	case "cheqd.oracle.v2.RewardBand.symbol_denom":
 		return protoreflect.ValueOfString("")
-	case "cheqd.oracle.v2.AggregateExchangeRatePrevote.voter":
+	case "cheqd.oracle.v2.RewardBand.reward_band":
 		return protoreflect.ValueOfString("")
-	case "cheqd.oracle.v2.AggregateExchangeRatePrevote.submit_block":
-		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.AggregateExchangeRatePrevote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.RewardBand"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.AggregateExchangeRatePrevote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.RewardBand does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_AggregateExchangeRatePrevote) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_RewardBand) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cheqd.oracle.v2.AggregateExchangeRatePrevote", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.oracle.v2.RewardBand", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3035,7 +3531,7 @@ func (x *fastReflection_AggregateExchangeRatePrevote) WhichOneof(d protoreflect.
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_AggregateExchangeRatePrevote) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_RewardBand) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3046,7 +3542,7 @@ func (x *fastReflection_AggregateExchangeRatePrevote) GetUnknown() protoreflect.
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AggregateExchangeRatePrevote) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_RewardBand) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3058,7 +3554,7 @@ func (x *fastReflection_AggregateExchangeRatePrevote) SetUnknown(fields protoref
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_AggregateExchangeRatePrevote) IsValid() bool {
+func (x *fastReflection_RewardBand) IsValid() bool {
 	return x != nil
 }
 
@@ -3068,9 +3564,9 @@ func (x *fastReflection_AggregateExchangeRatePrevote) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_AggregateExchangeRatePrevote) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_RewardBand) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*AggregateExchangeRatePrevote)
+		x := input.Message.Interface().(*RewardBand)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3082,17 +3578,14 @@ func (x *fastReflection_AggregateExchangeRatePrevote) ProtoMethods() *protoiface
 		var n int
 		var l int
 		_ = l
-		l = len(x.Hash)
+		l = len(x.SymbolDenom)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Voter)
+		l = len(x.RewardBand)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.SubmitBlock != 0 {
-			n += 1 + runtime.Sov(uint64(x.SubmitBlock))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3103,7 +3596,7 @@ func (x *fastReflection_AggregateExchangeRatePrevote) ProtoMethods() *protoiface
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*AggregateExchangeRatePrevote)
+		x := input.Message.Interface().(*RewardBand)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3122,22 +3615,17 @@ func (x *fastReflection_AggregateExchangeRatePrevote) ProtoMethods() *protoiface
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.SubmitBlock != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SubmitBlock))
-			i--
-			dAtA[i] = 0x18
-		}
-		if len(x.Voter) > 0 {
-			i -= len(x.Voter)
-			copy(dAtA[i:], x.Voter)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Voter)))
+		if len(x.RewardBand) > 0 {
+			i -= len(x.RewardBand)
+			copy(dAtA[i:], x.RewardBand)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RewardBand)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Hash) > 0 {
-			i -= len(x.Hash)
-			copy(dAtA[i:], x.Hash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
+		if len(x.SymbolDenom) > 0 {
+			i -= len(x.SymbolDenom)
+			copy(dAtA[i:], x.SymbolDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SymbolDenom)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -3152,7 +3640,7 @@ func (x *fastReflection_AggregateExchangeRatePrevote) ProtoMethods() *protoiface
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*AggregateExchangeRatePrevote)
+		x := input.Message.Interface().(*RewardBand)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3184,15 +3672,15 @@ func (x *fastReflection_AggregateExchangeRatePrevote) ProtoMethods() *protoiface
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AggregateExchangeRatePrevote: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RewardBand: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RewardBand: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SymbolDenom", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3220,11 +3708,11 @@ func (x *fastReflection_AggregateExchangeRatePrevote) ProtoMethods() *protoiface
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Hash = string(dAtA[iNdEx:postIndex])
+				x.SymbolDenom = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3252,27 +3740,8 @@ func (x *fastReflection_AggregateExchangeRatePrevote) ProtoMethods() *protoiface
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Voter = string(dAtA[iNdEx:postIndex])
+				x.RewardBand = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubmitBlock", wireType)
-				}
-				x.SubmitBlock = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.SubmitBlock |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3308,79 +3777,30 @@ func (x *fastReflection_AggregateExchangeRatePrevote) ProtoMethods() *protoiface
 	}
 }
 
-var _ protoreflect.List = (*_AggregateExchangeRateVote_1_list)(nil)
-
-type _AggregateExchangeRateVote_1_list struct {
-	list *[]*v1beta1.DecCoin
-}
-
-func (x *_AggregateExchangeRateVote_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_AggregateExchangeRateVote_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_AggregateExchangeRateVote_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_AggregateExchangeRateVote_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_AggregateExchangeRateVote_1_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.DecCoin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_AggregateExchangeRateVote_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_AggregateExchangeRateVote_1_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.DecCoin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_AggregateExchangeRateVote_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_AggregateExchangeRateVote                protoreflect.MessageDescriptor
-	fd_AggregateExchangeRateVote_exchange_rates protoreflect.FieldDescriptor
-	fd_AggregateExchangeRateVote_voter          protoreflect.FieldDescriptor
+	md_AggregateExchangeRatePrevote              protoreflect.MessageDescriptor
+	fd_AggregateExchangeRatePrevote_hash         protoreflect.FieldDescriptor
+	fd_AggregateExchangeRatePrevote_voter        protoreflect.FieldDescriptor
+	fd_AggregateExchangeRatePrevote_submit_block protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cheqd_oracle_v2_oracle_proto_init()
-	md_AggregateExchangeRateVote = File_cheqd_oracle_v2_oracle_proto.Messages().ByName("AggregateExchangeRateVote")
-	fd_AggregateExchangeRateVote_exchange_rates = md_AggregateExchangeRateVote.Fields().ByName("exchange_rates")
-	fd_AggregateExchangeRateVote_voter = md_AggregateExchangeRateVote.Fields().ByName("voter")
+	md_AggregateExchangeRatePrevote = File_cheqd_oracle_v2_oracle_proto.Messages().ByName("AggregateExchangeRatePrevote")
+	fd_AggregateExchangeRatePrevote_hash = md_AggregateExchangeRatePrevote.Fields().ByName("hash")
+	fd_AggregateExchangeRatePrevote_voter = md_AggregateExchangeRatePrevote.Fields().ByName("voter")
+	fd_AggregateExchangeRatePrevote_submit_block = md_AggregateExchangeRatePrevote.Fields().ByName("submit_block")
 }
 
-var _ protoreflect.Message = (*fastReflection_AggregateExchangeRateVote)(nil)
+var _ protoreflect.Message = (*fastReflection_AggregateExchangeRatePrevote)(nil)
 
-type fastReflection_AggregateExchangeRateVote AggregateExchangeRateVote
+type fastReflection_AggregateExchangeRatePrevote AggregateExchangeRatePrevote
 
-func (x *AggregateExchangeRateVote) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_AggregateExchangeRateVote)(x)
+func (x *AggregateExchangeRatePrevote) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AggregateExchangeRatePrevote)(x)
 }
 
-func (x *AggregateExchangeRateVote) slowProtoReflect() protoreflect.Message {
+func (x *AggregateExchangeRatePrevote) slowProtoReflect() protoreflect.Message {
 	mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3392,43 +3812,43 @@ func (x *AggregateExchangeRateVote) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_AggregateExchangeRateVote_messageType fastReflection_AggregateExchangeRateVote_messageType
-var _ protoreflect.MessageType = fastReflection_AggregateExchangeRateVote_messageType{}
+var _fastReflection_AggregateExchangeRatePrevote_messageType fastReflection_AggregateExchangeRatePrevote_messageType
+var _ protoreflect.MessageType = fastReflection_AggregateExchangeRatePrevote_messageType{}
 
-type fastReflection_AggregateExchangeRateVote_messageType struct{}
+type fastReflection_AggregateExchangeRatePrevote_messageType struct{}
 
-func (x fastReflection_AggregateExchangeRateVote_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_AggregateExchangeRateVote)(nil)
+func (x fastReflection_AggregateExchangeRatePrevote_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AggregateExchangeRatePrevote)(nil)
 }
-func (x fastReflection_AggregateExchangeRateVote_messageType) New() protoreflect.Message {
-	return new(fastReflection_AggregateExchangeRateVote)
+func (x fastReflection_AggregateExchangeRatePrevote_messageType) New() protoreflect.Message {
+	return new(fastReflection_AggregateExchangeRatePrevote)
 }
-func (x fastReflection_AggregateExchangeRateVote_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_AggregateExchangeRateVote
+func (x fastReflection_AggregateExchangeRatePrevote_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AggregateExchangeRatePrevote
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_AggregateExchangeRateVote) Descriptor() protoreflect.MessageDescriptor {
-	return md_AggregateExchangeRateVote
+func (x *fastReflection_AggregateExchangeRatePrevote) Descriptor() protoreflect.MessageDescriptor {
+	return md_AggregateExchangeRatePrevote
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_AggregateExchangeRateVote) Type() protoreflect.MessageType {
-	return _fastReflection_AggregateExchangeRateVote_messageType
+func (x *fastReflection_AggregateExchangeRatePrevote) Type() protoreflect.MessageType {
+	return _fastReflection_AggregateExchangeRatePrevote_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_AggregateExchangeRateVote) New() protoreflect.Message {
-	return new(fastReflection_AggregateExchangeRateVote)
+func (x *fastReflection_AggregateExchangeRatePrevote) New() protoreflect.Message {
+	return new(fastReflection_AggregateExchangeRatePrevote)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_AggregateExchangeRateVote) Interface() protoreflect.ProtoMessage {
-	return (*AggregateExchangeRateVote)(x)
+func (x *fastReflection_AggregateExchangeRatePrevote) Interface() protoreflect.ProtoMessage {
+	return (*AggregateExchangeRatePrevote)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3436,21 +3856,27 @@ func (x *fastReflection_AggregateExchangeRateVote) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_AggregateExchangeRateVote) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.ExchangeRates) != 0 {
-		value := protoreflect.ValueOfList(&_AggregateExchangeRateVote_1_list{list: &x.ExchangeRates})
-		if !f(fd_AggregateExchangeRateVote_exchange_rates, value) {
+func (x *fastReflection_AggregateExchangeRatePrevote) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Hash != "" {
+		value := protoreflect.ValueOfString(x.Hash)
+		if !f(fd_AggregateExchangeRatePrevote_hash, value) {
 			return
 		}
 	}
 	if x.Voter != "" {
 		value := protoreflect.ValueOfString(x.Voter)
-		if !f(fd_AggregateExchangeRateVote_voter, value) {
+		if !f(fd_AggregateExchangeRatePrevote_voter, value) {
 			return
 		}
 	}
-}
-
+	if x.SubmitBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SubmitBlock)
+		if !f(fd_AggregateExchangeRatePrevote_submit_block, value) {
+			return
+		}
+	}
+}
+
 // Has reports whether a field is populated.
 //
 // Some fields have the property of nullability where it is possible to
@@ -3462,17 +3888,19 @@ func (x *fastReflection_AggregateExchangeRateVote) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_AggregateExchangeRateVote) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_AggregateExchangeRatePrevote) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.AggregateExchangeRateVote.exchange_rates":
-		return len(x.ExchangeRates) != 0
-	case "cheqd.oracle.v2.AggregateExchangeRateVote.voter":
+	case "cheqd.oracle.v2.AggregateExchangeRatePrevote.hash":
+		return x.Hash != ""
+	case "cheqd.oracle.v2.AggregateExchangeRatePrevote.voter":
 		return x.Voter != ""
+	case "cheqd.oracle.v2.AggregateExchangeRatePrevote.submit_block":
+		return x.SubmitBlock != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.AggregateExchangeRateVote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.AggregateExchangeRatePrevote"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.AggregateExchangeRateVote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.AggregateExchangeRatePrevote does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3482,17 +3910,19 @@ func (x *fastReflection_AggregateExchangeRateVote) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AggregateExchangeRateVote) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_AggregateExchangeRatePrevote) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.AggregateExchangeRateVote.exchange_rates":
-		x.ExchangeRates = nil
-	case "cheqd.oracle.v2.AggregateExchangeRateVote.voter":
+	case "cheqd.oracle.v2.AggregateExchangeRatePrevote.hash":
+		x.Hash = ""
+	case "cheqd.oracle.v2.AggregateExchangeRatePrevote.voter":
 		x.Voter = ""
+	case "cheqd.oracle.v2.AggregateExchangeRatePrevote.submit_block":
+		x.SubmitBlock = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.AggregateExchangeRateVote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.AggregateExchangeRatePrevote"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.AggregateExchangeRateVote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.AggregateExchangeRatePrevote does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3502,22 +3932,22 @@ func (x *fastReflection_AggregateExchangeRateVote) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_AggregateExchangeRateVote) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AggregateExchangeRatePrevote) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cheqd.oracle.v2.AggregateExchangeRateVote.exchange_rates":
-		if len(x.ExchangeRates) == 0 {
-			return protoreflect.ValueOfList(&_AggregateExchangeRateVote_1_list{})
-		}
-		listValue := &_AggregateExchangeRateVote_1_list{list: &x.ExchangeRates}
-		return protoreflect.ValueOfList(listValue)
-	case "cheqd.oracle.v2.AggregateExchangeRateVote.voter":
+	case "cheqd.oracle.v2.AggregateExchangeRatePrevote.hash":
+		value := x.Hash
+		return protoreflect.ValueOfString(value)
+	case "cheqd.oracle.v2.AggregateExchangeRatePrevote.voter":
 		value := x.Voter
 		return protoreflect.ValueOfString(value)
+	case "cheqd.oracle.v2.AggregateExchangeRatePrevote.submit_block":
+		value := x.SubmitBlock
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.AggregateExchangeRateVote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.AggregateExchangeRatePrevote"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.AggregateExchangeRateVote does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.AggregateExchangeRatePrevote does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3531,19 +3961,19 @@ func (x *fastReflection_AggregateExchangeRateVote) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AggregateExchangeRateVote) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_AggregateExchangeRatePrevote) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.AggregateExchangeRateVote.exchange_rates":
-		lv := value.List()
-		clv := lv.(*_AggregateExchangeRateVote_1_list)
-		x.ExchangeRates = *clv.list
-	case "cheqd.oracle.v2.AggregateExchangeRateVote.voter":
+	case "cheqd.oracle.v2.AggregateExchangeRatePrevote.hash":
+		x.Hash = value.Interface().(string)
+	case "cheqd.oracle.v2.AggregateExchangeRatePrevote.voter":
 		x.Voter = value.Interface().(string)
+	case "cheqd.oracle.v2.AggregateExchangeRatePrevote.submit_block":
+		x.SubmitBlock = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.AggregateExchangeRateVote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.AggregateExchangeRatePrevote"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.AggregateExchangeRateVote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.AggregateExchangeRatePrevote does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3557,49 +3987,48 @@ func (x *fastReflection_AggregateExchangeRateVote) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AggregateExchangeRateVote) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AggregateExchangeRatePrevote) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.AggregateExchangeRateVote.exchange_rates":
-		if x.ExchangeRates == nil {
-			x.ExchangeRates = []*v1beta1.DecCoin{}
-		}
-		value := &_AggregateExchangeRateVote_1_list{list: &x.ExchangeRates}
-		return protoreflect.ValueOfList(value)
-	case "cheqd.oracle.v2.AggregateExchangeRateVote.voter":
-		panic(fmt.Errorf("field voter of message cheqd.oracle.v2.AggregateExchangeRateVote is not mutable"))
+	case "cheqd.oracle.v2.AggregateExchangeRatePrevote.hash":
+		panic(fmt.Errorf("field hash of message cheqd.oracle.v2.AggregateExchangeRatePrevote is not mutable"))
+	case "cheqd.oracle.v2.AggregateExchangeRatePrevote.voter":
+		panic(fmt.Errorf("field voter of message cheqd.oracle.v2.AggregateExchangeRatePrevote is not mutable"))
+	case "cheqd.oracle.v2.AggregateExchangeRatePrevote.submit_block":
+		panic(fmt.Errorf("field submit_block of message cheqd.oracle.v2.AggregateExchangeRatePrevote is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.AggregateExchangeRateVote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.AggregateExchangeRatePrevote"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.AggregateExchangeRateVote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.AggregateExchangeRatePrevote does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_AggregateExchangeRateVote) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AggregateExchangeRatePrevote) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.AggregateExchangeRateVote.exchange_rates":
-		list := []*v1beta1.DecCoin{}
-		return protoreflect.ValueOfList(&_AggregateExchangeRateVote_1_list{list: &list})
-	case "cheqd.oracle.v2.AggregateExchangeRateVote.voter":
+	case "cheqd.oracle.v2.AggregateExchangeRatePrevote.hash":
+		return protoreflect.ValueOfString("")
+	case "cheqd.oracle.v2.AggregateExchangeRatePrevote.voter":
 		return protoreflect.ValueOfString("")
+	case "cheqd.oracle.v2.AggregateExchangeRatePrevote.submit_block":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.AggregateExchangeRateVote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.AggregateExchangeRatePrevote"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.AggregateExchangeRateVote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.AggregateExchangeRatePrevote does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_AggregateExchangeRateVote) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_AggregateExchangeRatePrevote) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cheqd.oracle.v2.AggregateExchangeRateVote", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.oracle.v2.AggregateExchangeRatePrevote", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3607,7 +4036,7 @@ func (x *fastReflection_AggregateExchangeRateVote) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_AggregateExchangeRateVote) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_AggregateExchangeRatePrevote) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3618,7 +4047,7 @@ func (x *fastReflection_AggregateExchangeRateVote) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AggregateExchangeRateVote) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_AggregateExchangeRatePrevote) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3630,7 +4059,7 @@ func (x *fastReflection_AggregateExchangeRateVote) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_AggregateExchangeRateVote) IsValid() bool {
+func (x *fastReflection_AggregateExchangeRatePrevote) IsValid() bool {
 	return x != nil
 }
 
@@ -3640,9 +4069,9 @@ func (x *fastReflection_AggregateExchangeRateVote) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_AggregateExchangeRateVote) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_AggregateExchangeRatePrevote) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*AggregateExchangeRateVote)
+		x := input.Message.Interface().(*AggregateExchangeRatePrevote)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3654,16 +4083,17 @@ func (x *fastReflection_AggregateExchangeRateVote) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
-		if len(x.ExchangeRates) > 0 {
-			for _, e := range x.ExchangeRates {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.Hash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		l = len(x.Voter)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.SubmitBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.SubmitBlock))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3674,7 +4104,7 @@ func (x *fastReflection_AggregateExchangeRateVote) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*AggregateExchangeRateVote)
+		x := input.Message.Interface().(*AggregateExchangeRatePrevote)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3693,6 +4123,11 @@ func (x *fastReflection_AggregateExchangeRateVote) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.SubmitBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SubmitBlock))
+			i--
+			dAtA[i] = 0x18
+		}
 		if len(x.Voter) > 0 {
 			i -= len(x.Voter)
 			copy(dAtA[i:], x.Voter)
@@ -3700,21 +4135,12 @@ func (x *fastReflection_AggregateExchangeRateVote) ProtoMethods() *protoiface.Me
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.ExchangeRates) > 0 {
-			for iNdEx := len(x.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.ExchangeRates[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+		if len(x.Hash) > 0 {
+			i -= len(x.Hash)
+			copy(dAtA[i:], x.Hash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3727,7 +4153,7 @@ func (x *fastReflection_AggregateExchangeRateVote) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*AggregateExchangeRateVote)
+		x := input.Message.Interface().(*AggregateExchangeRatePrevote)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3759,17 +4185,17 @@ func (x *fastReflection_AggregateExchangeRateVote) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AggregateExchangeRatePrevote: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3779,25 +4205,23 @@ func (x *fastReflection_AggregateExchangeRateVote) ProtoMethods() *protoiface.Me
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ExchangeRates = append(x.ExchangeRates, &v1beta1.DecCoin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExchangeRates[len(x.ExchangeRates)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Hash = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
@@ -3831,6 +4255,25 @@ func (x *fastReflection_AggregateExchangeRateVote) ProtoMethods() *protoiface.Me
 				}
 				x.Voter = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubmitBlock", wireType)
+				}
+				x.SubmitBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SubmitBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3866,76 +4309,127 @@ func (x *fastReflection_AggregateExchangeRateVote) ProtoMethods() *protoiface.Me
 	}
 }
 
-var (
-	md_PriceStamp               protoreflect.MessageDescriptor
-	fd_PriceStamp_exchange_rate protoreflect.FieldDescriptor
-	fd_PriceStamp_block_num     protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_AggregateExchangeRateVote_1_list)(nil)
 
-func init() {
-	file_cheqd_oracle_v2_oracle_proto_init()
-	md_PriceStamp = File_cheqd_oracle_v2_oracle_proto.Messages().ByName("PriceStamp")
-	fd_PriceStamp_exchange_rate = md_PriceStamp.Fields().ByName("exchange_rate")
-	fd_PriceStamp_block_num = md_PriceStamp.Fields().ByName("block_num")
+type _AggregateExchangeRateVote_1_list struct {
+	list *[]*v1beta1.DecCoin
 }
 
-var _ protoreflect.Message = (*fastReflection_PriceStamp)(nil)
-
-type fastReflection_PriceStamp PriceStamp
-
-func (x *PriceStamp) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_PriceStamp)(x)
+func (x *_AggregateExchangeRateVote_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-func (x *PriceStamp) slowProtoReflect() protoreflect.Message {
-	mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *_AggregateExchangeRateVote_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-var _fastReflection_PriceStamp_messageType fastReflection_PriceStamp_messageType
-var _ protoreflect.MessageType = fastReflection_PriceStamp_messageType{}
+func (x *_AggregateExchangeRateVote_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	(*x.list)[i] = concreteValue
+}
 
-type fastReflection_PriceStamp_messageType struct{}
+func (x *_AggregateExchangeRateVote_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	*x.list = append(*x.list, concreteValue)
+}
 
-func (x fastReflection_PriceStamp_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_PriceStamp)(nil)
+func (x *_AggregateExchangeRateVote_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
-func (x fastReflection_PriceStamp_messageType) New() protoreflect.Message {
-	return new(fastReflection_PriceStamp)
+
+func (x *_AggregateExchangeRateVote_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
 }
-func (x fastReflection_PriceStamp_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_PriceStamp
+
+func (x *_AggregateExchangeRateVote_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_AggregateExchangeRateVote_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_AggregateExchangeRateVote                protoreflect.MessageDescriptor
+	fd_AggregateExchangeRateVote_exchange_rates protoreflect.FieldDescriptor
+	fd_AggregateExchangeRateVote_voter          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cheqd_oracle_v2_oracle_proto_init()
+	md_AggregateExchangeRateVote = File_cheqd_oracle_v2_oracle_proto.Messages().ByName("AggregateExchangeRateVote")
+	fd_AggregateExchangeRateVote_exchange_rates = md_AggregateExchangeRateVote.Fields().ByName("exchange_rates")
+	fd_AggregateExchangeRateVote_voter = md_AggregateExchangeRateVote.Fields().ByName("voter")
+}
+
+var _ protoreflect.Message = (*fastReflection_AggregateExchangeRateVote)(nil)
+
+type fastReflection_AggregateExchangeRateVote AggregateExchangeRateVote
+
+func (x *AggregateExchangeRateVote) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AggregateExchangeRateVote)(x)
+}
+
+func (x *AggregateExchangeRateVote) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AggregateExchangeRateVote_messageType fastReflection_AggregateExchangeRateVote_messageType
+var _ protoreflect.MessageType = fastReflection_AggregateExchangeRateVote_messageType{}
+
+type fastReflection_AggregateExchangeRateVote_messageType struct{}
+
+func (x fastReflection_AggregateExchangeRateVote_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AggregateExchangeRateVote)(nil)
+}
+func (x fastReflection_AggregateExchangeRateVote_messageType) New() protoreflect.Message {
+	return new(fastReflection_AggregateExchangeRateVote)
+}
+func (x fastReflection_AggregateExchangeRateVote_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AggregateExchangeRateVote
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_PriceStamp) Descriptor() protoreflect.MessageDescriptor {
-	return md_PriceStamp
+func (x *fastReflection_AggregateExchangeRateVote) Descriptor() protoreflect.MessageDescriptor {
+	return md_AggregateExchangeRateVote
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_PriceStamp) Type() protoreflect.MessageType {
-	return _fastReflection_PriceStamp_messageType
+func (x *fastReflection_AggregateExchangeRateVote) Type() protoreflect.MessageType {
+	return _fastReflection_AggregateExchangeRateVote_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_PriceStamp) New() protoreflect.Message {
-	return new(fastReflection_PriceStamp)
+func (x *fastReflection_AggregateExchangeRateVote) New() protoreflect.Message {
+	return new(fastReflection_AggregateExchangeRateVote)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_PriceStamp) Interface() protoreflect.ProtoMessage {
-	return (*PriceStamp)(x)
+func (x *fastReflection_AggregateExchangeRateVote) Interface() protoreflect.ProtoMessage {
+	return (*AggregateExchangeRateVote)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3943,16 +4437,16 @@ func (x *fastReflection_PriceStamp) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_PriceStamp) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ExchangeRate != nil {
-		value := protoreflect.ValueOfMessage(x.ExchangeRate.ProtoReflect())
-		if !f(fd_PriceStamp_exchange_rate, value) {
+func (x *fastReflection_AggregateExchangeRateVote) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.ExchangeRates) != 0 {
+		value := protoreflect.ValueOfList(&_AggregateExchangeRateVote_1_list{list: &x.ExchangeRates})
+		if !f(fd_AggregateExchangeRateVote_exchange_rates, value) {
 			return
 		}
 	}
-	if x.BlockNum != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.BlockNum)
-		if !f(fd_PriceStamp_block_num, value) {
+	if x.Voter != "" {
+		value := protoreflect.ValueOfString(x.Voter)
+		if !f(fd_AggregateExchangeRateVote_voter, value) {
 			return
 		}
 	}
@@ -3969,17 +4463,17 @@ func (x *fastReflection_PriceStamp) Range(f func(protoreflect.FieldDescriptor, p
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_PriceStamp) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_AggregateExchangeRateVote) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.PriceStamp.exchange_rate":
-		return x.ExchangeRate != nil
-	case "cheqd.oracle.v2.PriceStamp.block_num":
-		return x.BlockNum != uint64(0)
+	case "cheqd.oracle.v2.AggregateExchangeRateVote.exchange_rates":
+		return len(x.ExchangeRates) != 0
+	case "cheqd.oracle.v2.AggregateExchangeRateVote.voter":
+		return x.Voter != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.PriceStamp"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.AggregateExchangeRateVote"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.PriceStamp does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.AggregateExchangeRateVote does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3989,17 +4483,17 @@ func (x *fastReflection_PriceStamp) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PriceStamp) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_AggregateExchangeRateVote) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.PriceStamp.exchange_rate":
-		x.ExchangeRate = nil
-	case "cheqd.oracle.v2.PriceStamp.block_num":
-		x.BlockNum = uint64(0)
+	case "cheqd.oracle.v2.AggregateExchangeRateVote.exchange_rates":
+		x.ExchangeRates = nil
+	case "cheqd.oracle.v2.AggregateExchangeRateVote.voter":
+		x.Voter = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.PriceStamp"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.AggregateExchangeRateVote"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.PriceStamp does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.AggregateExchangeRateVote does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4009,19 +4503,22 @@ func (x *fastReflection_PriceStamp) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_PriceStamp) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AggregateExchangeRateVote) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cheqd.oracle.v2.PriceStamp.exchange_rate":
-		value := x.ExchangeRate
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cheqd.oracle.v2.PriceStamp.block_num":
-		value := x.BlockNum
-		return protoreflect.ValueOfUint64(value)
+	case "cheqd.oracle.v2.AggregateExchangeRateVote.exchange_rates":
+		if len(x.ExchangeRates) == 0 {
+			return protoreflect.ValueOfList(&_AggregateExchangeRateVote_1_list{})
+		}
+		listValue := &_AggregateExchangeRateVote_1_list{list: &x.ExchangeRates}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.oracle.v2.AggregateExchangeRateVote.voter":
+		value := x.Voter
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.PriceStamp"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.AggregateExchangeRateVote"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.PriceStamp does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.AggregateExchangeRateVote does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4035,17 +4532,19 @@ func (x *fastReflection_PriceStamp) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PriceStamp) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_AggregateExchangeRateVote) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.PriceStamp.exchange_rate":
-		x.ExchangeRate = value.Message().Interface().(*v1beta1.DecCoin)
-	case "cheqd.oracle.v2.PriceStamp.block_num":
-		x.BlockNum = value.Uint()
+	case "cheqd.oracle.v2.AggregateExchangeRateVote.exchange_rates":
+		lv := value.List()
+		clv := lv.(*_AggregateExchangeRateVote_1_list)
+		x.ExchangeRates = *clv.list
+	case "cheqd.oracle.v2.AggregateExchangeRateVote.voter":
+		x.Voter = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.PriceStamp"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.AggregateExchangeRateVote"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.PriceStamp does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.AggregateExchangeRateVote does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4059,48 +4558,49 @@ func (x *fastReflection_PriceStamp) Set(fd protoreflect.FieldDescriptor, value p
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PriceStamp) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AggregateExchangeRateVote) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.PriceStamp.exchange_rate":
-		if x.ExchangeRate == nil {
-			x.ExchangeRate = new(v1beta1.DecCoin)
+	case "cheqd.oracle.v2.AggregateExchangeRateVote.exchange_rates":
+		if x.ExchangeRates == nil {
+			x.ExchangeRates = []*v1beta1.DecCoin{}
 		}
-		return protoreflect.ValueOfMessage(x.ExchangeRate.ProtoReflect())
-	case "cheqd.oracle.v2.PriceStamp.block_num":
-		panic(fmt.Errorf("field block_num of message cheqd.oracle.v2.PriceStamp is not mutable"))
+		value := &_AggregateExchangeRateVote_1_list{list: &x.ExchangeRates}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.oracle.v2.AggregateExchangeRateVote.voter":
+		panic(fmt.Errorf("field voter of message cheqd.oracle.v2.AggregateExchangeRateVote is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.PriceStamp"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.AggregateExchangeRateVote"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.PriceStamp does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.AggregateExchangeRateVote does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_PriceStamp) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AggregateExchangeRateVote) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.PriceStamp.exchange_rate":
-		m := new(v1beta1.DecCoin)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cheqd.oracle.v2.PriceStamp.block_num":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "cheqd.oracle.v2.AggregateExchangeRateVote.exchange_rates":
+		list := []*v1beta1.DecCoin{}
+		return protoreflect.ValueOfList(&_AggregateExchangeRateVote_1_list{list: &list})
+	case "cheqd.oracle.v2.AggregateExchangeRateVote.voter":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.PriceStamp"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.AggregateExchangeRateVote"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.PriceStamp does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.AggregateExchangeRateVote does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_PriceStamp) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_AggregateExchangeRateVote) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cheqd.oracle.v2.PriceStamp", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.oracle.v2.AggregateExchangeRateVote", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4108,7 +4608,7 @@ func (x *fastReflection_PriceStamp) WhichOneof(d protoreflect.OneofDescriptor) p
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_PriceStamp) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_AggregateExchangeRateVote) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4119,7 +4619,7 @@ func (x *fastReflection_PriceStamp) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PriceStamp) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_AggregateExchangeRateVote) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4131,7 +4631,7 @@ func (x *fastReflection_PriceStamp) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_PriceStamp) IsValid() bool {
+func (x *fastReflection_AggregateExchangeRateVote) IsValid() bool {
 	return x != nil
 }
 
@@ -4141,9 +4641,9 @@ func (x *fastReflection_PriceStamp) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_PriceStamp) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_AggregateExchangeRateVote) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*PriceStamp)
+		x := input.Message.Interface().(*AggregateExchangeRateVote)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4155,12 +4655,15 @@ func (x *fastReflection_PriceStamp) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.ExchangeRate != nil {
-			l = options.Size(x.ExchangeRate)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.ExchangeRates) > 0 {
+			for _, e := range x.ExchangeRates {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		if x.BlockNum != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockNum))
+		l = len(x.Voter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4172,7 +4675,7 @@ func (x *fastReflection_PriceStamp) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*PriceStamp)
+		x := input.Message.Interface().(*AggregateExchangeRateVote)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4191,24 +4694,28 @@ func (x *fastReflection_PriceStamp) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.BlockNum != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNum))
+		if len(x.Voter) > 0 {
+			i -= len(x.Voter)
+			copy(dAtA[i:], x.Voter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Voter)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if x.ExchangeRate != nil {
-			encoded, err := options.Marshal(x.ExchangeRate)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.ExchangeRates) > 0 {
+			for iNdEx := len(x.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ExchangeRates[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4221,7 +4728,7 @@ func (x *fastReflection_PriceStamp) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*PriceStamp)
+		x := input.Message.Interface().(*AggregateExchangeRateVote)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4253,15 +4760,15 @@ func (x *fastReflection_PriceStamp) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceStamp: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceStamp: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4288,18 +4795,16 @@ func (x *fastReflection_PriceStamp) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.ExchangeRate == nil {
-					x.ExchangeRate = &v1beta1.DecCoin{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExchangeRate); err != nil {
+				x.ExchangeRates = append(x.ExchangeRates, &v1beta1.DecCoin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExchangeRates[len(x.ExchangeRates)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNum", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 				}
-				x.BlockNum = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4309,11 +4814,24 @@ func (x *fastReflection_PriceStamp) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.BlockNum |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Voter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4349,72 +4867,28 @@ func (x *fastReflection_PriceStamp) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_ValidatorRewardSet_1_list)(nil)
-
-type _ValidatorRewardSet_1_list struct {
-	list *[]string
-}
-
-func (x *_ValidatorRewardSet_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_ValidatorRewardSet_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_ValidatorRewardSet_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_ValidatorRewardSet_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_ValidatorRewardSet_1_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message ValidatorRewardSet at list field ValidatorSet as it is not of Message kind"))
-}
-
-func (x *_ValidatorRewardSet_1_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_ValidatorRewardSet_1_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_ValidatorRewardSet_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_ValidatorRewardSet               protoreflect.MessageDescriptor
-	fd_ValidatorRewardSet_validator_set protoreflect.FieldDescriptor
+	md_PriceStamp               protoreflect.MessageDescriptor
+	fd_PriceStamp_exchange_rate protoreflect.FieldDescriptor
+	fd_PriceStamp_block_num     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cheqd_oracle_v2_oracle_proto_init()
-	md_ValidatorRewardSet = File_cheqd_oracle_v2_oracle_proto.Messages().ByName("ValidatorRewardSet")
-	fd_ValidatorRewardSet_validator_set = md_ValidatorRewardSet.Fields().ByName("validator_set")
+	md_PriceStamp = File_cheqd_oracle_v2_oracle_proto.Messages().ByName("PriceStamp")
+	fd_PriceStamp_exchange_rate = md_PriceStamp.Fields().ByName("exchange_rate")
+	fd_PriceStamp_block_num = md_PriceStamp.Fields().ByName("block_num")
 }
 
-var _ protoreflect.Message = (*fastReflection_ValidatorRewardSet)(nil)
+var _ protoreflect.Message = (*fastReflection_PriceStamp)(nil)
 
-type fastReflection_ValidatorRewardSet ValidatorRewardSet
+type fastReflection_PriceStamp PriceStamp
 
-func (x *ValidatorRewardSet) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ValidatorRewardSet)(x)
+func (x *PriceStamp) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PriceStamp)(x)
 }
 
-func (x *ValidatorRewardSet) slowProtoReflect() protoreflect.Message {
+func (x *PriceStamp) slowProtoReflect() protoreflect.Message {
 	mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4426,43 +4900,43 @@ func (x *ValidatorRewardSet) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ValidatorRewardSet_messageType fastReflection_ValidatorRewardSet_messageType
-var _ protoreflect.MessageType = fastReflection_ValidatorRewardSet_messageType{}
+var _fastReflection_PriceStamp_messageType fastReflection_PriceStamp_messageType
+var _ protoreflect.MessageType = fastReflection_PriceStamp_messageType{}
 
-type fastReflection_ValidatorRewardSet_messageType struct{}
+type fastReflection_PriceStamp_messageType struct{}
 
-func (x fastReflection_ValidatorRewardSet_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ValidatorRewardSet)(nil)
+func (x fastReflection_PriceStamp_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PriceStamp)(nil)
 }
-func (x fastReflection_ValidatorRewardSet_messageType) New() protoreflect.Message {
-	return new(fastReflection_ValidatorRewardSet)
+func (x fastReflection_PriceStamp_messageType) New() protoreflect.Message {
+	return new(fastReflection_PriceStamp)
 }
-func (x fastReflection_ValidatorRewardSet_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorRewardSet
+func (x fastReflection_PriceStamp_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceStamp
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ValidatorRewardSet) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorRewardSet
+func (x *fastReflection_PriceStamp) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceStamp
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ValidatorRewardSet) Type() protoreflect.MessageType {
-	return _fastReflection_ValidatorRewardSet_messageType
+func (x *fastReflection_PriceStamp) Type() protoreflect.MessageType {
+	return _fastReflection_PriceStamp_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ValidatorRewardSet) New() protoreflect.Message {
-	return new(fastReflection_ValidatorRewardSet)
+func (x *fastReflection_PriceStamp) New() protoreflect.Message {
+	return new(fastReflection_PriceStamp)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ValidatorRewardSet) Interface() protoreflect.ProtoMessage {
-	return (*ValidatorRewardSet)(x)
+func (x *fastReflection_PriceStamp) Interface() protoreflect.ProtoMessage {
+	return (*PriceStamp)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4470,10 +4944,16 @@ func (x *fastReflection_ValidatorRewardSet) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ValidatorRewardSet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.ValidatorSet) != 0 {
-		value := protoreflect.ValueOfList(&_ValidatorRewardSet_1_list{list: &x.ValidatorSet})
-		if !f(fd_ValidatorRewardSet_validator_set, value) {
+func (x *fastReflection_PriceStamp) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ExchangeRate != nil {
+		value := protoreflect.ValueOfMessage(x.ExchangeRate.ProtoReflect())
+		if !f(fd_PriceStamp_exchange_rate, value) {
+			return
+		}
+	}
+	if x.BlockNum != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockNum)
+		if !f(fd_PriceStamp_block_num, value) {
 			return
 		}
 	}
@@ -4490,15 +4970,17 @@ func (x *fastReflection_ValidatorRewardSet) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ValidatorRewardSet) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_PriceStamp) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.ValidatorRewardSet.validator_set":
-		return len(x.ValidatorSet) != 0
+	case "cheqd.oracle.v2.PriceStamp.exchange_rate":
+		return x.ExchangeRate != nil
+	case "cheqd.oracle.v2.PriceStamp.block_num":
+		return x.BlockNum != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.ValidatorRewardSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.PriceStamp"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.ValidatorRewardSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.PriceStamp does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4508,15 +4990,17 @@ func (x *fastReflection_ValidatorRewardSet) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorRewardSet) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_PriceStamp) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.ValidatorRewardSet.validator_set":
-		x.ValidatorSet = nil
+	case "cheqd.oracle.v2.PriceStamp.exchange_rate":
+		x.ExchangeRate = nil
+	case "cheqd.oracle.v2.PriceStamp.block_num":
+		x.BlockNum = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.ValidatorRewardSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.PriceStamp"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.ValidatorRewardSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.PriceStamp does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4526,19 +5010,19 @@ func (x *fastReflection_ValidatorRewardSet) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ValidatorRewardSet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PriceStamp) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cheqd.oracle.v2.ValidatorRewardSet.validator_set":
-		if len(x.ValidatorSet) == 0 {
-			return protoreflect.ValueOfList(&_ValidatorRewardSet_1_list{})
-		}
-		listValue := &_ValidatorRewardSet_1_list{list: &x.ValidatorSet}
-		return protoreflect.ValueOfList(listValue)
+	case "cheqd.oracle.v2.PriceStamp.exchange_rate":
+		value := x.ExchangeRate
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cheqd.oracle.v2.PriceStamp.block_num":
+		value := x.BlockNum
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.ValidatorRewardSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.PriceStamp"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.ValidatorRewardSet does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.PriceStamp does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4552,17 +5036,17 @@ func (x *fastReflection_ValidatorRewardSet) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorRewardSet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_PriceStamp) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.ValidatorRewardSet.validator_set":
-		lv := value.List()
-		clv := lv.(*_ValidatorRewardSet_1_list)
-		x.ValidatorSet = *clv.list
+	case "cheqd.oracle.v2.PriceStamp.exchange_rate":
+		x.ExchangeRate = value.Message().Interface().(*v1beta1.DecCoin)
+	case "cheqd.oracle.v2.PriceStamp.block_num":
+		x.BlockNum = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.ValidatorRewardSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.PriceStamp"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.ValidatorRewardSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.PriceStamp does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4576,45 +5060,48 @@ func (x *fastReflection_ValidatorRewardSet) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorRewardSet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PriceStamp) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.ValidatorRewardSet.validator_set":
-		if x.ValidatorSet == nil {
-			x.ValidatorSet = []string{}
+	case "cheqd.oracle.v2.PriceStamp.exchange_rate":
+		if x.ExchangeRate == nil {
+			x.ExchangeRate = new(v1beta1.DecCoin)
 		}
-		value := &_ValidatorRewardSet_1_list{list: &x.ValidatorSet}
-		return protoreflect.ValueOfList(value)
+		return protoreflect.ValueOfMessage(x.ExchangeRate.ProtoReflect())
+	case "cheqd.oracle.v2.PriceStamp.block_num":
+		panic(fmt.Errorf("field block_num of message cheqd.oracle.v2.PriceStamp is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.ValidatorRewardSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.PriceStamp"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.ValidatorRewardSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.PriceStamp does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ValidatorRewardSet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PriceStamp) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.ValidatorRewardSet.validator_set":
-		list := []string{}
-		return protoreflect.ValueOfList(&_ValidatorRewardSet_1_list{list: &list})
+	case "cheqd.oracle.v2.PriceStamp.exchange_rate":
+		m := new(v1beta1.DecCoin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cheqd.oracle.v2.PriceStamp.block_num":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.ValidatorRewardSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.PriceStamp"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.ValidatorRewardSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.PriceStamp does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ValidatorRewardSet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_PriceStamp) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cheqd.oracle.v2.ValidatorRewardSet", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.oracle.v2.PriceStamp", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4622,7 +5109,7 @@ func (x *fastReflection_ValidatorRewardSet) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ValidatorRewardSet) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_PriceStamp) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4633,7 +5120,7 @@ func (x *fastReflection_ValidatorRewardSet) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorRewardSet) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_PriceStamp) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4645,7 +5132,7 @@ func (x *fastReflection_ValidatorRewardSet) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ValidatorRewardSet) IsValid() bool {
+func (x *fastReflection_PriceStamp) IsValid() bool {
 	return x != nil
 }
 
@@ -4655,9 +5142,9 @@ func (x *fastReflection_ValidatorRewardSet) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ValidatorRewardSet) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_PriceStamp) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ValidatorRewardSet)
+		x := input.Message.Interface().(*PriceStamp)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4669,11 +5156,12 @@ func (x *fastReflection_ValidatorRewardSet) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.ValidatorSet) > 0 {
-			for _, s := range x.ValidatorSet {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.ExchangeRate != nil {
+			l = options.Size(x.ExchangeRate)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockNum != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockNum))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4685,7 +5173,7 @@ func (x *fastReflection_ValidatorRewardSet) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorRewardSet)
+		x := input.Message.Interface().(*PriceStamp)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4704,14 +5192,24 @@ func (x *fastReflection_ValidatorRewardSet) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ValidatorSet) > 0 {
-			for iNdEx := len(x.ValidatorSet) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.ValidatorSet[iNdEx])
-				copy(dAtA[i:], x.ValidatorSet[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorSet[iNdEx])))
-				i--
-				dAtA[i] = 0xa
+		if x.BlockNum != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNum))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.ExchangeRate != nil {
+			encoded, err := options.Marshal(x.ExchangeRate)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4724,7 +5222,7 @@ func (x *fastReflection_ValidatorRewardSet) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorRewardSet)
+		x := input.Message.Interface().(*PriceStamp)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4756,17 +5254,17 @@ func (x *fastReflection_ValidatorRewardSet) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorRewardSet: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceStamp: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorRewardSet: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceStamp: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4776,24 +5274,47 @@ func (x *fastReflection_ValidatorRewardSet) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ValidatorSet = append(x.ValidatorSet, string(dAtA[iNdEx:postIndex]))
+				if x.ExchangeRate == nil {
+					x.ExchangeRate = &v1beta1.DecCoin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExchangeRate); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNum", wireType)
+				}
+				x.BlockNum = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockNum |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4829,129 +5350,72 @@ func (x *fastReflection_ValidatorRewardSet) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_CurrencyPairProviders_3_list)(nil)
-
-type _CurrencyPairProviders_3_list struct {
-	list *[]*PairAddressProvider
-}
-
-func (x *_CurrencyPairProviders_3_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_CurrencyPairProviders_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_CurrencyPairProviders_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*PairAddressProvider)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_CurrencyPairProviders_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*PairAddressProvider)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_CurrencyPairProviders_3_list) AppendMutable() protoreflect.Value {
-	v := new(PairAddressProvider)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_CurrencyPairProviders_3_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_CurrencyPairProviders_3_list) NewElement() protoreflect.Value {
-	v := new(PairAddressProvider)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_CurrencyPairProviders_3_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_CurrencyPairProviders_4_list)(nil)
+var _ protoreflect.List = (*_ValidatorRewardSet_1_list)(nil)
 
-type _CurrencyPairProviders_4_list struct {
+type _ValidatorRewardSet_1_list struct {
 	list *[]string
 }
 
-func (x *_CurrencyPairProviders_4_list) Len() int {
+func (x *_ValidatorRewardSet_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_CurrencyPairProviders_4_list) Get(i int) protoreflect.Value {
+func (x *_ValidatorRewardSet_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-func (x *_CurrencyPairProviders_4_list) Set(i int, value protoreflect.Value) {
+func (x *_ValidatorRewardSet_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.String()
 	concreteValue := valueUnwrapped
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_CurrencyPairProviders_4_list) Append(value protoreflect.Value) {
+func (x *_ValidatorRewardSet_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.String()
 	concreteValue := valueUnwrapped
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_CurrencyPairProviders_4_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message CurrencyPairProviders at list field Providers as it is not of Message kind"))
+func (x *_ValidatorRewardSet_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ValidatorRewardSet at list field ValidatorSet as it is not of Message kind"))
 }
 
-func (x *_CurrencyPairProviders_4_list) Truncate(n int) {
+func (x *_ValidatorRewardSet_1_list) Truncate(n int) {
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_CurrencyPairProviders_4_list) NewElement() protoreflect.Value {
+func (x *_ValidatorRewardSet_1_list) NewElement() protoreflect.Value {
 	v := ""
 	return protoreflect.ValueOfString(v)
 }
 
-func (x *_CurrencyPairProviders_4_list) IsValid() bool {
+func (x *_ValidatorRewardSet_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_CurrencyPairProviders              protoreflect.MessageDescriptor
-	fd_CurrencyPairProviders_base_denom   protoreflect.FieldDescriptor
-	fd_CurrencyPairProviders_quote_denom  protoreflect.FieldDescriptor
-	fd_CurrencyPairProviders_pair_address protoreflect.FieldDescriptor
-	fd_CurrencyPairProviders_providers    protoreflect.FieldDescriptor
+	md_ValidatorRewardSet               protoreflect.MessageDescriptor
+	fd_ValidatorRewardSet_validator_set protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cheqd_oracle_v2_oracle_proto_init()
-	md_CurrencyPairProviders = File_cheqd_oracle_v2_oracle_proto.Messages().ByName("CurrencyPairProviders")
-	fd_CurrencyPairProviders_base_denom = md_CurrencyPairProviders.Fields().ByName("base_denom")
-	fd_CurrencyPairProviders_quote_denom = md_CurrencyPairProviders.Fields().ByName("quote_denom")
-	fd_CurrencyPairProviders_pair_address = md_CurrencyPairProviders.Fields().ByName("pair_address")
-	fd_CurrencyPairProviders_providers = md_CurrencyPairProviders.Fields().ByName("providers")
+	md_ValidatorRewardSet = File_cheqd_oracle_v2_oracle_proto.Messages().ByName("ValidatorRewardSet")
+	fd_ValidatorRewardSet_validator_set = md_ValidatorRewardSet.Fields().ByName("validator_set")
 }
 
-var _ protoreflect.Message = (*fastReflection_CurrencyPairProviders)(nil)
+var _ protoreflect.Message = (*fastReflection_ValidatorRewardSet)(nil)
 
-type fastReflection_CurrencyPairProviders CurrencyPairProviders
+type fastReflection_ValidatorRewardSet ValidatorRewardSet
 
-func (x *CurrencyPairProviders) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_CurrencyPairProviders)(x)
+func (x *ValidatorRewardSet) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorRewardSet)(x)
 }
 
-func (x *CurrencyPairProviders) slowProtoReflect() protoreflect.Message {
+func (x *ValidatorRewardSet) slowProtoReflect() protoreflect.Message {
 	mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4963,43 +5427,43 @@ func (x *CurrencyPairProviders) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_CurrencyPairProviders_messageType fastReflection_CurrencyPairProviders_messageType
-var _ protoreflect.MessageType = fastReflection_CurrencyPairProviders_messageType{}
+var _fastReflection_ValidatorRewardSet_messageType fastReflection_ValidatorRewardSet_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorRewardSet_messageType{}
 
-type fastReflection_CurrencyPairProviders_messageType struct{}
+type fastReflection_ValidatorRewardSet_messageType struct{}
 
-func (x fastReflection_CurrencyPairProviders_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_CurrencyPairProviders)(nil)
+func (x fastReflection_ValidatorRewardSet_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorRewardSet)(nil)
 }
-func (x fastReflection_CurrencyPairProviders_messageType) New() protoreflect.Message {
-	return new(fastReflection_CurrencyPairProviders)
+func (x fastReflection_ValidatorRewardSet_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorRewardSet)
 }
-func (x fastReflection_CurrencyPairProviders_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_CurrencyPairProviders
+func (x fastReflection_ValidatorRewardSet_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorRewardSet
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_CurrencyPairProviders) Descriptor() protoreflect.MessageDescriptor {
-	return md_CurrencyPairProviders
+func (x *fastReflection_ValidatorRewardSet) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorRewardSet
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_CurrencyPairProviders) Type() protoreflect.MessageType {
-	return _fastReflection_CurrencyPairProviders_messageType
+func (x *fastReflection_ValidatorRewardSet) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorRewardSet_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_CurrencyPairProviders) New() protoreflect.Message {
-	return new(fastReflection_CurrencyPairProviders)
+func (x *fastReflection_ValidatorRewardSet) New() protoreflect.Message {
+	return new(fastReflection_ValidatorRewardSet)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_CurrencyPairProviders) Interface() protoreflect.ProtoMessage {
-	return (*CurrencyPairProviders)(x)
+func (x *fastReflection_ValidatorRewardSet) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorRewardSet)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5007,28 +5471,10 @@ func (x *fastReflection_CurrencyPairProviders) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_CurrencyPairProviders) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.BaseDenom != "" {
-		value := protoreflect.ValueOfString(x.BaseDenom)
-		if !f(fd_CurrencyPairProviders_base_denom, value) {
-			return
-		}
-	}
-	if x.QuoteDenom != "" {
-		value := protoreflect.ValueOfString(x.QuoteDenom)
-		if !f(fd_CurrencyPairProviders_quote_denom, value) {
-			return
-		}
-	}
-	if len(x.PairAddress) != 0 {
-		value := protoreflect.ValueOfList(&_CurrencyPairProviders_3_list{list: &x.PairAddress})
-		if !f(fd_CurrencyPairProviders_pair_address, value) {
-			return
-		}
-	}
-	if len(x.Providers) != 0 {
-		value := protoreflect.ValueOfList(&_CurrencyPairProviders_4_list{list: &x.Providers})
-		if !f(fd_CurrencyPairProviders_providers, value) {
+func (x *fastReflection_ValidatorRewardSet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.ValidatorSet) != 0 {
+		value := protoreflect.ValueOfList(&_ValidatorRewardSet_1_list{list: &x.ValidatorSet})
+		if !f(fd_ValidatorRewardSet_validator_set, value) {
 			return
 		}
 	}
@@ -5045,21 +5491,15 @@ func (x *fastReflection_CurrencyPairProviders) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_CurrencyPairProviders) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ValidatorRewardSet) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.CurrencyPairProviders.base_denom":
-		return x.BaseDenom != ""
-	case "cheqd.oracle.v2.CurrencyPairProviders.quote_denom":
-		return x.QuoteDenom != ""
-	case "cheqd.oracle.v2.CurrencyPairProviders.pair_address":
-		return len(x.PairAddress) != 0
-	case "cheqd.oracle.v2.CurrencyPairProviders.providers":
-		return len(x.Providers) != 0
+	case "cheqd.oracle.v2.ValidatorRewardSet.validator_set":
+		return len(x.ValidatorSet) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.CurrencyPairProviders"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.ValidatorRewardSet"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.CurrencyPairProviders does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.ValidatorRewardSet does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5069,21 +5509,15 @@ func (x *fastReflection_CurrencyPairProviders) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrencyPairProviders) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ValidatorRewardSet) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.CurrencyPairProviders.base_denom":
-		x.BaseDenom = ""
-	case "cheqd.oracle.v2.CurrencyPairProviders.quote_denom":
-		x.QuoteDenom = ""
-	case "cheqd.oracle.v2.CurrencyPairProviders.pair_address":
-		x.PairAddress = nil
-	case "cheqd.oracle.v2.CurrencyPairProviders.providers":
-		x.Providers = nil
+	case "cheqd.oracle.v2.ValidatorRewardSet.validator_set":
+		x.ValidatorSet = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.CurrencyPairProviders"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.ValidatorRewardSet"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.CurrencyPairProviders does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.ValidatorRewardSet does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5093,31 +5527,19 @@ func (x *fastReflection_CurrencyPairProviders) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_CurrencyPairProviders) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorRewardSet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cheqd.oracle.v2.CurrencyPairProviders.base_denom":
-		value := x.BaseDenom
-		return protoreflect.ValueOfString(value)
-	case "cheqd.oracle.v2.CurrencyPairProviders.quote_denom":
-		value := x.QuoteDenom
-		return protoreflect.ValueOfString(value)
-	case "cheqd.oracle.v2.CurrencyPairProviders.pair_address":
-		if len(x.PairAddress) == 0 {
-			return protoreflect.ValueOfList(&_CurrencyPairProviders_3_list{})
-		}
-		listValue := &_CurrencyPairProviders_3_list{list: &x.PairAddress}
-		return protoreflect.ValueOfList(listValue)
-	case "cheqd.oracle.v2.CurrencyPairProviders.providers":
-		if len(x.Providers) == 0 {
-			return protoreflect.ValueOfList(&_CurrencyPairProviders_4_list{})
+	case "cheqd.oracle.v2.ValidatorRewardSet.validator_set":
+		if len(x.ValidatorSet) == 0 {
+			return protoreflect.ValueOfList(&_ValidatorRewardSet_1_list{})
 		}
-		listValue := &_CurrencyPairProviders_4_list{list: &x.Providers}
+		listValue := &_ValidatorRewardSet_1_list{list: &x.ValidatorSet}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.CurrencyPairProviders"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.ValidatorRewardSet"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.CurrencyPairProviders does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.ValidatorRewardSet does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5131,25 +5553,17 @@ func (x *fastReflection_CurrencyPairProviders) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrencyPairProviders) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ValidatorRewardSet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.CurrencyPairProviders.base_denom":
-		x.BaseDenom = value.Interface().(string)
-	case "cheqd.oracle.v2.CurrencyPairProviders.quote_denom":
-		x.QuoteDenom = value.Interface().(string)
-	case "cheqd.oracle.v2.CurrencyPairProviders.pair_address":
-		lv := value.List()
-		clv := lv.(*_CurrencyPairProviders_3_list)
-		x.PairAddress = *clv.list
-	case "cheqd.oracle.v2.CurrencyPairProviders.providers":
+	case "cheqd.oracle.v2.ValidatorRewardSet.validator_set":
 		lv := value.List()
-		clv := lv.(*_CurrencyPairProviders_4_list)
-		x.Providers = *clv.list
+		clv := lv.(*_ValidatorRewardSet_1_list)
+		x.ValidatorSet = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.CurrencyPairProviders"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.ValidatorRewardSet"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.CurrencyPairProviders does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.ValidatorRewardSet does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5163,62 +5577,45 @@ func (x *fastReflection_CurrencyPairProviders) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrencyPairProviders) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorRewardSet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.CurrencyPairProviders.pair_address":
-		if x.PairAddress == nil {
-			x.PairAddress = []*PairAddressProvider{}
-		}
-		value := &_CurrencyPairProviders_3_list{list: &x.PairAddress}
-		return protoreflect.ValueOfList(value)
-	case "cheqd.oracle.v2.CurrencyPairProviders.providers":
-		if x.Providers == nil {
-			x.Providers = []string{}
+	case "cheqd.oracle.v2.ValidatorRewardSet.validator_set":
+		if x.ValidatorSet == nil {
+			x.ValidatorSet = []string{}
 		}
-		value := &_CurrencyPairProviders_4_list{list: &x.Providers}
+		value := &_ValidatorRewardSet_1_list{list: &x.ValidatorSet}
 		return protoreflect.ValueOfList(value)
-	case "cheqd.oracle.v2.CurrencyPairProviders.base_denom":
-		panic(fmt.Errorf("field base_denom of message cheqd.oracle.v2.CurrencyPairProviders is not mutable"))
-	case "cheqd.oracle.v2.CurrencyPairProviders.quote_denom":
-		panic(fmt.Errorf("field quote_denom of message cheqd.oracle.v2.CurrencyPairProviders is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.CurrencyPairProviders"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.ValidatorRewardSet"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.CurrencyPairProviders does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.ValidatorRewardSet does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_CurrencyPairProviders) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorRewardSet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.CurrencyPairProviders.base_denom":
-		return protoreflect.ValueOfString("")
-	case "cheqd.oracle.v2.CurrencyPairProviders.quote_denom":
-		return protoreflect.ValueOfString("")
-	case "cheqd.oracle.v2.CurrencyPairProviders.pair_address":
-		list := []*PairAddressProvider{}
-		return protoreflect.ValueOfList(&_CurrencyPairProviders_3_list{list: &list})
-	case "cheqd.oracle.v2.CurrencyPairProviders.providers":
+	case "cheqd.oracle.v2.ValidatorRewardSet.validator_set":
 		list := []string{}
-		return protoreflect.ValueOfList(&_CurrencyPairProviders_4_list{list: &list})
+		return protoreflect.ValueOfList(&_ValidatorRewardSet_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.CurrencyPairProviders"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.ValidatorRewardSet"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.CurrencyPairProviders does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.ValidatorRewardSet does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_CurrencyPairProviders) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ValidatorRewardSet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cheqd.oracle.v2.CurrencyPairProviders", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.oracle.v2.ValidatorRewardSet", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5226,7 +5623,7 @@ func (x *fastReflection_CurrencyPairProviders) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_CurrencyPairProviders) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ValidatorRewardSet) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5237,7 +5634,7 @@ func (x *fastReflection_CurrencyPairProviders) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrencyPairProviders) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ValidatorRewardSet) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5249,7 +5646,7 @@ func (x *fastReflection_CurrencyPairProviders) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_CurrencyPairProviders) IsValid() bool {
+func (x *fastReflection_ValidatorRewardSet) IsValid() bool {
 	return x != nil
 }
 
@@ -5259,9 +5656,9 @@ func (x *fastReflection_CurrencyPairProviders) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_CurrencyPairProviders) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ValidatorRewardSet) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*CurrencyPairProviders)
+		x := input.Message.Interface().(*ValidatorRewardSet)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5273,22 +5670,8 @@ func (x *fastReflection_CurrencyPairProviders) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		l = len(x.BaseDenom)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.QuoteDenom)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.PairAddress) > 0 {
-			for _, e := range x.PairAddress {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.Providers) > 0 {
-			for _, s := range x.Providers {
+		if len(x.ValidatorSet) > 0 {
+			for _, s := range x.ValidatorSet {
 				l = len(s)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -5303,7 +5686,7 @@ func (x *fastReflection_CurrencyPairProviders) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*CurrencyPairProviders)
+		x := input.Message.Interface().(*ValidatorRewardSet)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5322,45 +5705,15 @@ func (x *fastReflection_CurrencyPairProviders) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Providers) > 0 {
-			for iNdEx := len(x.Providers) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Providers[iNdEx])
-				copy(dAtA[i:], x.Providers[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Providers[iNdEx])))
-				i--
-				dAtA[i] = 0x22
-			}
-		}
-		if len(x.PairAddress) > 0 {
-			for iNdEx := len(x.PairAddress) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.PairAddress[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.ValidatorSet) > 0 {
+			for iNdEx := len(x.ValidatorSet) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ValidatorSet[iNdEx])
+				copy(dAtA[i:], x.ValidatorSet[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorSet[iNdEx])))
 				i--
-				dAtA[i] = 0x1a
+				dAtA[i] = 0xa
 			}
 		}
-		if len(x.QuoteDenom) > 0 {
-			i -= len(x.QuoteDenom)
-			copy(dAtA[i:], x.QuoteDenom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.QuoteDenom)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.BaseDenom) > 0 {
-			i -= len(x.BaseDenom)
-			copy(dAtA[i:], x.BaseDenom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseDenom)))
-			i--
-			dAtA[i] = 0xa
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -5372,7 +5725,7 @@ func (x *fastReflection_CurrencyPairProviders) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*CurrencyPairProviders)
+		x := input.Message.Interface().(*ValidatorRewardSet)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5404,47 +5757,15 @@ func (x *fastReflection_CurrencyPairProviders) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyPairProviders: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorRewardSet: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyPairProviders: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorRewardSet: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.BaseDenom = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5472,45 +5793,2792 @@ func (x *fastReflection_CurrencyPairProviders) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.QuoteDenom = string(dAtA[iNdEx:postIndex])
+				x.ValidatorSet = append(x.ValidatorSet, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PairAddress", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PairAddress = append(x.PairAddress, &PairAddressProvider{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PairAddress[len(x.PairAddress)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_CurrencyPairProviders_3_list)(nil)
+
+type _CurrencyPairProviders_3_list struct {
+	list *[]*PairAddressProvider
+}
+
+func (x *_CurrencyPairProviders_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_CurrencyPairProviders_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_CurrencyPairProviders_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PairAddressProvider)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_CurrencyPairProviders_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PairAddressProvider)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_CurrencyPairProviders_3_list) AppendMutable() protoreflect.Value {
+	v := new(PairAddressProvider)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_CurrencyPairProviders_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_CurrencyPairProviders_3_list) NewElement() protoreflect.Value {
+	v := new(PairAddressProvider)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_CurrencyPairProviders_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_CurrencyPairProviders_4_list)(nil)
+
+type _CurrencyPairProviders_4_list struct {
+	list *[]string
+}
+
+func (x *_CurrencyPairProviders_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_CurrencyPairProviders_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_CurrencyPairProviders_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_CurrencyPairProviders_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_CurrencyPairProviders_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message CurrencyPairProviders at list field Providers as it is not of Message kind"))
+}
+
+func (x *_CurrencyPairProviders_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_CurrencyPairProviders_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_CurrencyPairProviders_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_CurrencyPairProviders              protoreflect.MessageDescriptor
+	fd_CurrencyPairProviders_base_denom   protoreflect.FieldDescriptor
+	fd_CurrencyPairProviders_quote_denom  protoreflect.FieldDescriptor
+	fd_CurrencyPairProviders_pair_address protoreflect.FieldDescriptor
+	fd_CurrencyPairProviders_providers    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cheqd_oracle_v2_oracle_proto_init()
+	md_CurrencyPairProviders = File_cheqd_oracle_v2_oracle_proto.Messages().ByName("CurrencyPairProviders")
+	fd_CurrencyPairProviders_base_denom = md_CurrencyPairProviders.Fields().ByName("base_denom")
+	fd_CurrencyPairProviders_quote_denom = md_CurrencyPairProviders.Fields().ByName("quote_denom")
+	fd_CurrencyPairProviders_pair_address = md_CurrencyPairProviders.Fields().ByName("pair_address")
+	fd_CurrencyPairProviders_providers = md_CurrencyPairProviders.Fields().ByName("providers")
+}
+
+var _ protoreflect.Message = (*fastReflection_CurrencyPairProviders)(nil)
+
+type fastReflection_CurrencyPairProviders CurrencyPairProviders
+
+func (x *CurrencyPairProviders) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CurrencyPairProviders)(x)
+}
+
+func (x *CurrencyPairProviders) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CurrencyPairProviders_messageType fastReflection_CurrencyPairProviders_messageType
+var _ protoreflect.MessageType = fastReflection_CurrencyPairProviders_messageType{}
+
+type fastReflection_CurrencyPairProviders_messageType struct{}
+
+func (x fastReflection_CurrencyPairProviders_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CurrencyPairProviders)(nil)
+}
+func (x fastReflection_CurrencyPairProviders_messageType) New() protoreflect.Message {
+	return new(fastReflection_CurrencyPairProviders)
+}
+func (x fastReflection_CurrencyPairProviders_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CurrencyPairProviders
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CurrencyPairProviders) Descriptor() protoreflect.MessageDescriptor {
+	return md_CurrencyPairProviders
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CurrencyPairProviders) Type() protoreflect.MessageType {
+	return _fastReflection_CurrencyPairProviders_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CurrencyPairProviders) New() protoreflect.Message {
+	return new(fastReflection_CurrencyPairProviders)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CurrencyPairProviders) Interface() protoreflect.ProtoMessage {
+	return (*CurrencyPairProviders)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CurrencyPairProviders) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BaseDenom != "" {
+		value := protoreflect.ValueOfString(x.BaseDenom)
+		if !f(fd_CurrencyPairProviders_base_denom, value) {
+			return
+		}
+	}
+	if x.QuoteDenom != "" {
+		value := protoreflect.ValueOfString(x.QuoteDenom)
+		if !f(fd_CurrencyPairProviders_quote_denom, value) {
+			return
+		}
+	}
+	if len(x.PairAddress) != 0 {
+		value := protoreflect.ValueOfList(&_CurrencyPairProviders_3_list{list: &x.PairAddress})
+		if !f(fd_CurrencyPairProviders_pair_address, value) {
+			return
+		}
+	}
+	if len(x.Providers) != 0 {
+		value := protoreflect.ValueOfList(&_CurrencyPairProviders_4_list{list: &x.Providers})
+		if !f(fd_CurrencyPairProviders_providers, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CurrencyPairProviders) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cheqd.oracle.v2.CurrencyPairProviders.base_denom":
+		return x.BaseDenom != ""
+	case "cheqd.oracle.v2.CurrencyPairProviders.quote_denom":
+		return x.QuoteDenom != ""
+	case "cheqd.oracle.v2.CurrencyPairProviders.pair_address":
+		return len(x.PairAddress) != 0
+	case "cheqd.oracle.v2.CurrencyPairProviders.providers":
+		return len(x.Providers) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.CurrencyPairProviders"))
+		}
+		panic(fmt.Errorf("message cheqd.oracle.v2.CurrencyPairProviders does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyPairProviders) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cheqd.oracle.v2.CurrencyPairProviders.base_denom":
+		x.BaseDenom = ""
+	case "cheqd.oracle.v2.CurrencyPairProviders.quote_denom":
+		x.QuoteDenom = ""
+	case "cheqd.oracle.v2.CurrencyPairProviders.pair_address":
+		x.PairAddress = nil
+	case "cheqd.oracle.v2.CurrencyPairProviders.providers":
+		x.Providers = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.CurrencyPairProviders"))
+		}
+		panic(fmt.Errorf("message cheqd.oracle.v2.CurrencyPairProviders does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CurrencyPairProviders) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cheqd.oracle.v2.CurrencyPairProviders.base_denom":
+		value := x.BaseDenom
+		return protoreflect.ValueOfString(value)
+	case "cheqd.oracle.v2.CurrencyPairProviders.quote_denom":
+		value := x.QuoteDenom
+		return protoreflect.ValueOfString(value)
+	case "cheqd.oracle.v2.CurrencyPairProviders.pair_address":
+		if len(x.PairAddress) == 0 {
+			return protoreflect.ValueOfList(&_CurrencyPairProviders_3_list{})
+		}
+		listValue := &_CurrencyPairProviders_3_list{list: &x.PairAddress}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.oracle.v2.CurrencyPairProviders.providers":
+		if len(x.Providers) == 0 {
+			return protoreflect.ValueOfList(&_CurrencyPairProviders_4_list{})
+		}
+		listValue := &_CurrencyPairProviders_4_list{list: &x.Providers}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.CurrencyPairProviders"))
+		}
+		panic(fmt.Errorf("message cheqd.oracle.v2.CurrencyPairProviders does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyPairProviders) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cheqd.oracle.v2.CurrencyPairProviders.base_denom":
+		x.BaseDenom = value.Interface().(string)
+	case "cheqd.oracle.v2.CurrencyPairProviders.quote_denom":
+		x.QuoteDenom = value.Interface().(string)
+	case "cheqd.oracle.v2.CurrencyPairProviders.pair_address":
+		lv := value.List()
+		clv := lv.(*_CurrencyPairProviders_3_list)
+		x.PairAddress = *clv.list
+	case "cheqd.oracle.v2.CurrencyPairProviders.providers":
+		lv := value.List()
+		clv := lv.(*_CurrencyPairProviders_4_list)
+		x.Providers = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.CurrencyPairProviders"))
+		}
+		panic(fmt.Errorf("message cheqd.oracle.v2.CurrencyPairProviders does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyPairProviders) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.oracle.v2.CurrencyPairProviders.pair_address":
+		if x.PairAddress == nil {
+			x.PairAddress = []*PairAddressProvider{}
+		}
+		value := &_CurrencyPairProviders_3_list{list: &x.PairAddress}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.oracle.v2.CurrencyPairProviders.providers":
+		if x.Providers == nil {
+			x.Providers = []string{}
+		}
+		value := &_CurrencyPairProviders_4_list{list: &x.Providers}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.oracle.v2.CurrencyPairProviders.base_denom":
+		panic(fmt.Errorf("field base_denom of message cheqd.oracle.v2.CurrencyPairProviders is not mutable"))
+	case "cheqd.oracle.v2.CurrencyPairProviders.quote_denom":
+		panic(fmt.Errorf("field quote_denom of message cheqd.oracle.v2.CurrencyPairProviders is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.CurrencyPairProviders"))
+		}
+		panic(fmt.Errorf("message cheqd.oracle.v2.CurrencyPairProviders does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CurrencyPairProviders) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.oracle.v2.CurrencyPairProviders.base_denom":
+		return protoreflect.ValueOfString("")
+	case "cheqd.oracle.v2.CurrencyPairProviders.quote_denom":
+		return protoreflect.ValueOfString("")
+	case "cheqd.oracle.v2.CurrencyPairProviders.pair_address":
+		list := []*PairAddressProvider{}
+		return protoreflect.ValueOfList(&_CurrencyPairProviders_3_list{list: &list})
+	case "cheqd.oracle.v2.CurrencyPairProviders.providers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_CurrencyPairProviders_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.CurrencyPairProviders"))
+		}
+		panic(fmt.Errorf("message cheqd.oracle.v2.CurrencyPairProviders does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CurrencyPairProviders) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.oracle.v2.CurrencyPairProviders", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CurrencyPairProviders) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyPairProviders) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CurrencyPairProviders) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CurrencyPairProviders) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CurrencyPairProviders)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.BaseDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.QuoteDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.PairAddress) > 0 {
+			for _, e := range x.PairAddress {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Providers) > 0 {
+			for _, s := range x.Providers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CurrencyPairProviders)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Providers) > 0 {
+			for iNdEx := len(x.Providers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Providers[iNdEx])
+				copy(dAtA[i:], x.Providers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Providers[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.PairAddress) > 0 {
+			for iNdEx := len(x.PairAddress) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PairAddress[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.QuoteDenom) > 0 {
+			i -= len(x.QuoteDenom)
+			copy(dAtA[i:], x.QuoteDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.QuoteDenom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.BaseDenom) > 0 {
+			i -= len(x.BaseDenom)
+			copy(dAtA[i:], x.BaseDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseDenom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CurrencyPairProviders)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyPairProviders: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyPairProviders: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BaseDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.QuoteDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PairAddress", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PairAddress = append(x.PairAddress, &PairAddressProvider{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PairAddress[len(x.PairAddress)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Providers = append(x.Providers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PairAddressProvider                  protoreflect.MessageDescriptor
+	fd_PairAddressProvider_address          protoreflect.FieldDescriptor
+	fd_PairAddressProvider_address_provider protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cheqd_oracle_v2_oracle_proto_init()
+	md_PairAddressProvider = File_cheqd_oracle_v2_oracle_proto.Messages().ByName("PairAddressProvider")
+	fd_PairAddressProvider_address = md_PairAddressProvider.Fields().ByName("address")
+	fd_PairAddressProvider_address_provider = md_PairAddressProvider.Fields().ByName("address_provider")
+}
+
+var _ protoreflect.Message = (*fastReflection_PairAddressProvider)(nil)
+
+type fastReflection_PairAddressProvider PairAddressProvider
+
+func (x *PairAddressProvider) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PairAddressProvider)(x)
+}
+
+func (x *PairAddressProvider) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PairAddressProvider_messageType fastReflection_PairAddressProvider_messageType
+var _ protoreflect.MessageType = fastReflection_PairAddressProvider_messageType{}
+
+type fastReflection_PairAddressProvider_messageType struct{}
+
+func (x fastReflection_PairAddressProvider_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PairAddressProvider)(nil)
+}
+func (x fastReflection_PairAddressProvider_messageType) New() protoreflect.Message {
+	return new(fastReflection_PairAddressProvider)
+}
+func (x fastReflection_PairAddressProvider_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PairAddressProvider
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PairAddressProvider) Descriptor() protoreflect.MessageDescriptor {
+	return md_PairAddressProvider
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PairAddressProvider) Type() protoreflect.MessageType {
+	return _fastReflection_PairAddressProvider_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PairAddressProvider) New() protoreflect.Message {
+	return new(fastReflection_PairAddressProvider)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PairAddressProvider) Interface() protoreflect.ProtoMessage {
+	return (*PairAddressProvider)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PairAddressProvider) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_PairAddressProvider_address, value) {
+			return
+		}
+	}
+	if x.AddressProvider != "" {
+		value := protoreflect.ValueOfString(x.AddressProvider)
+		if !f(fd_PairAddressProvider_address_provider, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PairAddressProvider) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cheqd.oracle.v2.PairAddressProvider.address":
+		return x.Address != ""
+	case "cheqd.oracle.v2.PairAddressProvider.address_provider":
+		return x.AddressProvider != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.PairAddressProvider"))
+		}
+		panic(fmt.Errorf("message cheqd.oracle.v2.PairAddressProvider does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PairAddressProvider) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cheqd.oracle.v2.PairAddressProvider.address":
+		x.Address = ""
+	case "cheqd.oracle.v2.PairAddressProvider.address_provider":
+		x.AddressProvider = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.PairAddressProvider"))
+		}
+		panic(fmt.Errorf("message cheqd.oracle.v2.PairAddressProvider does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PairAddressProvider) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cheqd.oracle.v2.PairAddressProvider.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "cheqd.oracle.v2.PairAddressProvider.address_provider":
+		value := x.AddressProvider
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.PairAddressProvider"))
+		}
+		panic(fmt.Errorf("message cheqd.oracle.v2.PairAddressProvider does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PairAddressProvider) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cheqd.oracle.v2.PairAddressProvider.address":
+		x.Address = value.Interface().(string)
+	case "cheqd.oracle.v2.PairAddressProvider.address_provider":
+		x.AddressProvider = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.PairAddressProvider"))
+		}
+		panic(fmt.Errorf("message cheqd.oracle.v2.PairAddressProvider does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PairAddressProvider) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.oracle.v2.PairAddressProvider.address":
+		panic(fmt.Errorf("field address of message cheqd.oracle.v2.PairAddressProvider is not mutable"))
+	case "cheqd.oracle.v2.PairAddressProvider.address_provider":
+		panic(fmt.Errorf("field address_provider of message cheqd.oracle.v2.PairAddressProvider is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.PairAddressProvider"))
+		}
+		panic(fmt.Errorf("message cheqd.oracle.v2.PairAddressProvider does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PairAddressProvider) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.oracle.v2.PairAddressProvider.address":
+		return protoreflect.ValueOfString("")
+	case "cheqd.oracle.v2.PairAddressProvider.address_provider":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.PairAddressProvider"))
+		}
+		panic(fmt.Errorf("message cheqd.oracle.v2.PairAddressProvider does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PairAddressProvider) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.oracle.v2.PairAddressProvider", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PairAddressProvider) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PairAddressProvider) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PairAddressProvider) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PairAddressProvider) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PairAddressProvider)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AddressProvider)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PairAddressProvider)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.AddressProvider) > 0 {
+			i -= len(x.AddressProvider)
+			copy(dAtA[i:], x.AddressProvider)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AddressProvider)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PairAddressProvider)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PairAddressProvider: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PairAddressProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AddressProvider", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AddressProvider = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_CurrencyDeviationThreshold            protoreflect.MessageDescriptor
+	fd_CurrencyDeviationThreshold_base_denom protoreflect.FieldDescriptor
+	fd_CurrencyDeviationThreshold_threshold  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cheqd_oracle_v2_oracle_proto_init()
+	md_CurrencyDeviationThreshold = File_cheqd_oracle_v2_oracle_proto.Messages().ByName("CurrencyDeviationThreshold")
+	fd_CurrencyDeviationThreshold_base_denom = md_CurrencyDeviationThreshold.Fields().ByName("base_denom")
+	fd_CurrencyDeviationThreshold_threshold = md_CurrencyDeviationThreshold.Fields().ByName("threshold")
+}
+
+var _ protoreflect.Message = (*fastReflection_CurrencyDeviationThreshold)(nil)
+
+type fastReflection_CurrencyDeviationThreshold CurrencyDeviationThreshold
+
+func (x *CurrencyDeviationThreshold) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CurrencyDeviationThreshold)(x)
+}
+
+func (x *CurrencyDeviationThreshold) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CurrencyDeviationThreshold_messageType fastReflection_CurrencyDeviationThreshold_messageType
+var _ protoreflect.MessageType = fastReflection_CurrencyDeviationThreshold_messageType{}
+
+type fastReflection_CurrencyDeviationThreshold_messageType struct{}
+
+func (x fastReflection_CurrencyDeviationThreshold_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CurrencyDeviationThreshold)(nil)
+}
+func (x fastReflection_CurrencyDeviationThreshold_messageType) New() protoreflect.Message {
+	return new(fastReflection_CurrencyDeviationThreshold)
+}
+func (x fastReflection_CurrencyDeviationThreshold_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CurrencyDeviationThreshold
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CurrencyDeviationThreshold) Descriptor() protoreflect.MessageDescriptor {
+	return md_CurrencyDeviationThreshold
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CurrencyDeviationThreshold) Type() protoreflect.MessageType {
+	return _fastReflection_CurrencyDeviationThreshold_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CurrencyDeviationThreshold) New() protoreflect.Message {
+	return new(fastReflection_CurrencyDeviationThreshold)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CurrencyDeviationThreshold) Interface() protoreflect.ProtoMessage {
+	return (*CurrencyDeviationThreshold)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CurrencyDeviationThreshold) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BaseDenom != "" {
+		value := protoreflect.ValueOfString(x.BaseDenom)
+		if !f(fd_CurrencyDeviationThreshold_base_denom, value) {
+			return
+		}
+	}
+	if x.Threshold != "" {
+		value := protoreflect.ValueOfString(x.Threshold)
+		if !f(fd_CurrencyDeviationThreshold_threshold, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CurrencyDeviationThreshold) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cheqd.oracle.v2.CurrencyDeviationThreshold.base_denom":
+		return x.BaseDenom != ""
+	case "cheqd.oracle.v2.CurrencyDeviationThreshold.threshold":
+		return x.Threshold != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.CurrencyDeviationThreshold"))
+		}
+		panic(fmt.Errorf("message cheqd.oracle.v2.CurrencyDeviationThreshold does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyDeviationThreshold) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cheqd.oracle.v2.CurrencyDeviationThreshold.base_denom":
+		x.BaseDenom = ""
+	case "cheqd.oracle.v2.CurrencyDeviationThreshold.threshold":
+		x.Threshold = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.CurrencyDeviationThreshold"))
+		}
+		panic(fmt.Errorf("message cheqd.oracle.v2.CurrencyDeviationThreshold does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CurrencyDeviationThreshold) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cheqd.oracle.v2.CurrencyDeviationThreshold.base_denom":
+		value := x.BaseDenom
+		return protoreflect.ValueOfString(value)
+	case "cheqd.oracle.v2.CurrencyDeviationThreshold.threshold":
+		value := x.Threshold
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.CurrencyDeviationThreshold"))
+		}
+		panic(fmt.Errorf("message cheqd.oracle.v2.CurrencyDeviationThreshold does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyDeviationThreshold) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cheqd.oracle.v2.CurrencyDeviationThreshold.base_denom":
+		x.BaseDenom = value.Interface().(string)
+	case "cheqd.oracle.v2.CurrencyDeviationThreshold.threshold":
+		x.Threshold = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.CurrencyDeviationThreshold"))
+		}
+		panic(fmt.Errorf("message cheqd.oracle.v2.CurrencyDeviationThreshold does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyDeviationThreshold) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.oracle.v2.CurrencyDeviationThreshold.base_denom":
+		panic(fmt.Errorf("field base_denom of message cheqd.oracle.v2.CurrencyDeviationThreshold is not mutable"))
+	case "cheqd.oracle.v2.CurrencyDeviationThreshold.threshold":
+		panic(fmt.Errorf("field threshold of message cheqd.oracle.v2.CurrencyDeviationThreshold is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.CurrencyDeviationThreshold"))
+		}
+		panic(fmt.Errorf("message cheqd.oracle.v2.CurrencyDeviationThreshold does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CurrencyDeviationThreshold) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.oracle.v2.CurrencyDeviationThreshold.base_denom":
+		return protoreflect.ValueOfString("")
+	case "cheqd.oracle.v2.CurrencyDeviationThreshold.threshold":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.CurrencyDeviationThreshold"))
+		}
+		panic(fmt.Errorf("message cheqd.oracle.v2.CurrencyDeviationThreshold does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CurrencyDeviationThreshold) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.oracle.v2.CurrencyDeviationThreshold", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CurrencyDeviationThreshold) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyDeviationThreshold) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CurrencyDeviationThreshold) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CurrencyDeviationThreshold) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CurrencyDeviationThreshold)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.BaseDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Threshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CurrencyDeviationThreshold)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Threshold) > 0 {
+			i -= len(x.Threshold)
+			copy(dAtA[i:], x.Threshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Threshold)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.BaseDenom) > 0 {
+			i -= len(x.BaseDenom)
+			copy(dAtA[i:], x.BaseDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseDenom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CurrencyDeviationThreshold)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyDeviationThreshold: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyDeviationThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BaseDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Threshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ParamUpdatePlan_1_list)(nil)
+
+type _ParamUpdatePlan_1_list struct {
+	list *[]string
+}
+
+func (x *_ParamUpdatePlan_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ParamUpdatePlan_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ParamUpdatePlan_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ParamUpdatePlan_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ParamUpdatePlan_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ParamUpdatePlan at list field Keys as it is not of Message kind"))
+}
+
+func (x *_ParamUpdatePlan_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ParamUpdatePlan_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ParamUpdatePlan_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ParamUpdatePlan         protoreflect.MessageDescriptor
+	fd_ParamUpdatePlan_keys    protoreflect.FieldDescriptor
+	fd_ParamUpdatePlan_height  protoreflect.FieldDescriptor
+	fd_ParamUpdatePlan_changes protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cheqd_oracle_v2_oracle_proto_init()
+	md_ParamUpdatePlan = File_cheqd_oracle_v2_oracle_proto.Messages().ByName("ParamUpdatePlan")
+	fd_ParamUpdatePlan_keys = md_ParamUpdatePlan.Fields().ByName("keys")
+	fd_ParamUpdatePlan_height = md_ParamUpdatePlan.Fields().ByName("height")
+	fd_ParamUpdatePlan_changes = md_ParamUpdatePlan.Fields().ByName("changes")
+}
+
+var _ protoreflect.Message = (*fastReflection_ParamUpdatePlan)(nil)
+
+type fastReflection_ParamUpdatePlan ParamUpdatePlan
+
+func (x *ParamUpdatePlan) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ParamUpdatePlan)(x)
+}
+
+func (x *ParamUpdatePlan) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ParamUpdatePlan_messageType fastReflection_ParamUpdatePlan_messageType
+var _ protoreflect.MessageType = fastReflection_ParamUpdatePlan_messageType{}
+
+type fastReflection_ParamUpdatePlan_messageType struct{}
+
+func (x fastReflection_ParamUpdatePlan_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ParamUpdatePlan)(nil)
+}
+func (x fastReflection_ParamUpdatePlan_messageType) New() protoreflect.Message {
+	return new(fastReflection_ParamUpdatePlan)
+}
+func (x fastReflection_ParamUpdatePlan_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParamUpdatePlan
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ParamUpdatePlan) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParamUpdatePlan
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ParamUpdatePlan) Type() protoreflect.MessageType {
+	return _fastReflection_ParamUpdatePlan_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ParamUpdatePlan) New() protoreflect.Message {
+	return new(fastReflection_ParamUpdatePlan)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ParamUpdatePlan) Interface() protoreflect.ProtoMessage {
+	return (*ParamUpdatePlan)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ParamUpdatePlan) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Keys) != 0 {
+		value := protoreflect.ValueOfList(&_ParamUpdatePlan_1_list{list: &x.Keys})
+		if !f(fd_ParamUpdatePlan_keys, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_ParamUpdatePlan_height, value) {
+			return
+		}
+	}
+	if x.Changes != nil {
+		value := protoreflect.ValueOfMessage(x.Changes.ProtoReflect())
+		if !f(fd_ParamUpdatePlan_changes, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ParamUpdatePlan) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cheqd.oracle.v2.ParamUpdatePlan.keys":
+		return len(x.Keys) != 0
+	case "cheqd.oracle.v2.ParamUpdatePlan.height":
+		return x.Height != int64(0)
+	case "cheqd.oracle.v2.ParamUpdatePlan.changes":
+		return x.Changes != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.ParamUpdatePlan"))
+		}
+		panic(fmt.Errorf("message cheqd.oracle.v2.ParamUpdatePlan does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParamUpdatePlan) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cheqd.oracle.v2.ParamUpdatePlan.keys":
+		x.Keys = nil
+	case "cheqd.oracle.v2.ParamUpdatePlan.height":
+		x.Height = int64(0)
+	case "cheqd.oracle.v2.ParamUpdatePlan.changes":
+		x.Changes = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.ParamUpdatePlan"))
+		}
+		panic(fmt.Errorf("message cheqd.oracle.v2.ParamUpdatePlan does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ParamUpdatePlan) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cheqd.oracle.v2.ParamUpdatePlan.keys":
+		if len(x.Keys) == 0 {
+			return protoreflect.ValueOfList(&_ParamUpdatePlan_1_list{})
+		}
+		listValue := &_ParamUpdatePlan_1_list{list: &x.Keys}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.oracle.v2.ParamUpdatePlan.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "cheqd.oracle.v2.ParamUpdatePlan.changes":
+		value := x.Changes
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.ParamUpdatePlan"))
+		}
+		panic(fmt.Errorf("message cheqd.oracle.v2.ParamUpdatePlan does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParamUpdatePlan) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cheqd.oracle.v2.ParamUpdatePlan.keys":
+		lv := value.List()
+		clv := lv.(*_ParamUpdatePlan_1_list)
+		x.Keys = *clv.list
+	case "cheqd.oracle.v2.ParamUpdatePlan.height":
+		x.Height = value.Int()
+	case "cheqd.oracle.v2.ParamUpdatePlan.changes":
+		x.Changes = value.Message().Interface().(*Params)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.ParamUpdatePlan"))
+		}
+		panic(fmt.Errorf("message cheqd.oracle.v2.ParamUpdatePlan does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParamUpdatePlan) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.oracle.v2.ParamUpdatePlan.keys":
+		if x.Keys == nil {
+			x.Keys = []string{}
+		}
+		value := &_ParamUpdatePlan_1_list{list: &x.Keys}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.oracle.v2.ParamUpdatePlan.changes":
+		if x.Changes == nil {
+			x.Changes = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Changes.ProtoReflect())
+	case "cheqd.oracle.v2.ParamUpdatePlan.height":
+		panic(fmt.Errorf("field height of message cheqd.oracle.v2.ParamUpdatePlan is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.ParamUpdatePlan"))
+		}
+		panic(fmt.Errorf("message cheqd.oracle.v2.ParamUpdatePlan does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ParamUpdatePlan) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.oracle.v2.ParamUpdatePlan.keys":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ParamUpdatePlan_1_list{list: &list})
+	case "cheqd.oracle.v2.ParamUpdatePlan.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cheqd.oracle.v2.ParamUpdatePlan.changes":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.ParamUpdatePlan"))
+		}
+		panic(fmt.Errorf("message cheqd.oracle.v2.ParamUpdatePlan does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ParamUpdatePlan) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.oracle.v2.ParamUpdatePlan", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ParamUpdatePlan) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParamUpdatePlan) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ParamUpdatePlan) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ParamUpdatePlan) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ParamUpdatePlan)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Keys) > 0 {
+			for _, s := range x.Keys {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Changes != nil {
+			l = options.Size(x.Changes)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ParamUpdatePlan)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Changes != nil {
+			encoded, err := options.Marshal(x.Changes)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Keys) > 0 {
+			for iNdEx := len(x.Keys) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Keys[iNdEx])
+				copy(dAtA[i:], x.Keys[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Keys[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ParamUpdatePlan)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParamUpdatePlan: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParamUpdatePlan: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Keys = append(x.Keys, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Changes == nil {
+					x.Changes = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Changes); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PriceAverage                protoreflect.MessageDescriptor
+	fd_PriceAverage_value          protoreflect.FieldDescriptor
+	fd_PriceAverage_updated_height protoreflect.FieldDescriptor
+	fd_PriceAverage_updated_time   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cheqd_oracle_v2_oracle_proto_init()
+	md_PriceAverage = File_cheqd_oracle_v2_oracle_proto.Messages().ByName("PriceAverage")
+	fd_PriceAverage_value = md_PriceAverage.Fields().ByName("value")
+	fd_PriceAverage_updated_height = md_PriceAverage.Fields().ByName("updated_height")
+	fd_PriceAverage_updated_time = md_PriceAverage.Fields().ByName("updated_time")
+}
+
+var _ protoreflect.Message = (*fastReflection_PriceAverage)(nil)
+
+type fastReflection_PriceAverage PriceAverage
+
+func (x *PriceAverage) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PriceAverage)(x)
+}
+
+func (x *PriceAverage) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PriceAverage_messageType fastReflection_PriceAverage_messageType
+var _ protoreflect.MessageType = fastReflection_PriceAverage_messageType{}
+
+type fastReflection_PriceAverage_messageType struct{}
+
+func (x fastReflection_PriceAverage_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PriceAverage)(nil)
+}
+func (x fastReflection_PriceAverage_messageType) New() protoreflect.Message {
+	return new(fastReflection_PriceAverage)
+}
+func (x fastReflection_PriceAverage_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceAverage
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PriceAverage) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceAverage
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PriceAverage) Type() protoreflect.MessageType {
+	return _fastReflection_PriceAverage_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PriceAverage) New() protoreflect.Message {
+	return new(fastReflection_PriceAverage)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PriceAverage) Interface() protoreflect.ProtoMessage {
+	return (*PriceAverage)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PriceAverage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Value != "" {
+		value := protoreflect.ValueOfString(x.Value)
+		if !f(fd_PriceAverage_value, value) {
+			return
+		}
+	}
+	if x.UpdatedHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.UpdatedHeight)
+		if !f(fd_PriceAverage_updated_height, value) {
+			return
+		}
+	}
+	if x.UpdatedTime != nil {
+		value := protoreflect.ValueOfMessage(x.UpdatedTime.ProtoReflect())
+		if !f(fd_PriceAverage_updated_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PriceAverage) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cheqd.oracle.v2.PriceAverage.value":
+		return x.Value != ""
+	case "cheqd.oracle.v2.PriceAverage.updated_height":
+		return x.UpdatedHeight != int64(0)
+	case "cheqd.oracle.v2.PriceAverage.updated_time":
+		return x.UpdatedTime != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.PriceAverage"))
+		}
+		panic(fmt.Errorf("message cheqd.oracle.v2.PriceAverage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceAverage) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cheqd.oracle.v2.PriceAverage.value":
+		x.Value = ""
+	case "cheqd.oracle.v2.PriceAverage.updated_height":
+		x.UpdatedHeight = int64(0)
+	case "cheqd.oracle.v2.PriceAverage.updated_time":
+		x.UpdatedTime = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.PriceAverage"))
+		}
+		panic(fmt.Errorf("message cheqd.oracle.v2.PriceAverage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PriceAverage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cheqd.oracle.v2.PriceAverage.value":
+		value := x.Value
+		return protoreflect.ValueOfString(value)
+	case "cheqd.oracle.v2.PriceAverage.updated_height":
+		value := x.UpdatedHeight
+		return protoreflect.ValueOfInt64(value)
+	case "cheqd.oracle.v2.PriceAverage.updated_time":
+		value := x.UpdatedTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.PriceAverage"))
+		}
+		panic(fmt.Errorf("message cheqd.oracle.v2.PriceAverage does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceAverage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cheqd.oracle.v2.PriceAverage.value":
+		x.Value = value.Interface().(string)
+	case "cheqd.oracle.v2.PriceAverage.updated_height":
+		x.UpdatedHeight = value.Int()
+	case "cheqd.oracle.v2.PriceAverage.updated_time":
+		x.UpdatedTime = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.PriceAverage"))
+		}
+		panic(fmt.Errorf("message cheqd.oracle.v2.PriceAverage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceAverage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.oracle.v2.PriceAverage.updated_time":
+		if x.UpdatedTime == nil {
+			x.UpdatedTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.UpdatedTime.ProtoReflect())
+	case "cheqd.oracle.v2.PriceAverage.value":
+		panic(fmt.Errorf("field value of message cheqd.oracle.v2.PriceAverage is not mutable"))
+	case "cheqd.oracle.v2.PriceAverage.updated_height":
+		panic(fmt.Errorf("field updated_height of message cheqd.oracle.v2.PriceAverage is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.PriceAverage"))
+		}
+		panic(fmt.Errorf("message cheqd.oracle.v2.PriceAverage does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PriceAverage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.oracle.v2.PriceAverage.value":
+		return protoreflect.ValueOfString("")
+	case "cheqd.oracle.v2.PriceAverage.updated_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cheqd.oracle.v2.PriceAverage.updated_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.PriceAverage"))
+		}
+		panic(fmt.Errorf("message cheqd.oracle.v2.PriceAverage does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PriceAverage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.oracle.v2.PriceAverage", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PriceAverage) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceAverage) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PriceAverage) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PriceAverage) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PriceAverage)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Value)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.UpdatedHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.UpdatedHeight))
+		}
+		if x.UpdatedTime != nil {
+			l = options.Size(x.UpdatedTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PriceAverage)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.UpdatedTime != nil {
+			encoded, err := options.Marshal(x.UpdatedTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.UpdatedHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UpdatedHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Value) > 0 {
+			i -= len(x.Value)
+			copy(dAtA[i:], x.Value)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Value)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PriceAverage)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceAverage: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceAverage: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5538,7 +8606,62 @@ func (x *fastReflection_CurrencyPairProviders) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Providers = append(x.Providers, string(dAtA[iNdEx:postIndex]))
+				x.Value = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdatedHeight", wireType)
+				}
+				x.UpdatedHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UpdatedHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdatedTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.UpdatedTime == nil {
+					x.UpdatedTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UpdatedTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -5576,28 +8699,34 @@ func (x *fastReflection_CurrencyPairProviders) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_PairAddressProvider                  protoreflect.MessageDescriptor
-	fd_PairAddressProvider_address          protoreflect.FieldDescriptor
-	fd_PairAddressProvider_address_provider protoreflect.FieldDescriptor
+	md_PriceCircuitBreaker         protoreflect.MessageDescriptor
+	fd_PriceCircuitBreaker_denom   protoreflect.FieldDescriptor
+	fd_PriceCircuitBreaker_tripped protoreflect.FieldDescriptor
+	fd_PriceCircuitBreaker_reason  protoreflect.FieldDescriptor
+	fd_PriceCircuitBreaker_height  protoreflect.FieldDescriptor
+	fd_PriceCircuitBreaker_time    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cheqd_oracle_v2_oracle_proto_init()
-	md_PairAddressProvider = File_cheqd_oracle_v2_oracle_proto.Messages().ByName("PairAddressProvider")
-	fd_PairAddressProvider_address = md_PairAddressProvider.Fields().ByName("address")
-	fd_PairAddressProvider_address_provider = md_PairAddressProvider.Fields().ByName("address_provider")
+	md_PriceCircuitBreaker = File_cheqd_oracle_v2_oracle_proto.Messages().ByName("PriceCircuitBreaker")
+	fd_PriceCircuitBreaker_denom = md_PriceCircuitBreaker.Fields().ByName("denom")
+	fd_PriceCircuitBreaker_tripped = md_PriceCircuitBreaker.Fields().ByName("tripped")
+	fd_PriceCircuitBreaker_reason = md_PriceCircuitBreaker.Fields().ByName("reason")
+	fd_PriceCircuitBreaker_height = md_PriceCircuitBreaker.Fields().ByName("height")
+	fd_PriceCircuitBreaker_time = md_PriceCircuitBreaker.Fields().ByName("time")
 }
 
-var _ protoreflect.Message = (*fastReflection_PairAddressProvider)(nil)
-
-type fastReflection_PairAddressProvider PairAddressProvider
+var _ protoreflect.Message = (*fastReflection_PriceCircuitBreaker)(nil)
 
-func (x *PairAddressProvider) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_PairAddressProvider)(x)
+type fastReflection_PriceCircuitBreaker PriceCircuitBreaker
+
+func (x *PriceCircuitBreaker) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PriceCircuitBreaker)(x)
 }
 
-func (x *PairAddressProvider) slowProtoReflect() protoreflect.Message {
-	mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[8]
+func (x *PriceCircuitBreaker) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5608,43 +8737,43 @@ func (x *PairAddressProvider) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_PairAddressProvider_messageType fastReflection_PairAddressProvider_messageType
-var _ protoreflect.MessageType = fastReflection_PairAddressProvider_messageType{}
+var _fastReflection_PriceCircuitBreaker_messageType fastReflection_PriceCircuitBreaker_messageType
+var _ protoreflect.MessageType = fastReflection_PriceCircuitBreaker_messageType{}
 
-type fastReflection_PairAddressProvider_messageType struct{}
+type fastReflection_PriceCircuitBreaker_messageType struct{}
 
-func (x fastReflection_PairAddressProvider_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_PairAddressProvider)(nil)
+func (x fastReflection_PriceCircuitBreaker_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PriceCircuitBreaker)(nil)
 }
-func (x fastReflection_PairAddressProvider_messageType) New() protoreflect.Message {
-	return new(fastReflection_PairAddressProvider)
+func (x fastReflection_PriceCircuitBreaker_messageType) New() protoreflect.Message {
+	return new(fastReflection_PriceCircuitBreaker)
 }
-func (x fastReflection_PairAddressProvider_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_PairAddressProvider
+func (x fastReflection_PriceCircuitBreaker_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceCircuitBreaker
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_PairAddressProvider) Descriptor() protoreflect.MessageDescriptor {
-	return md_PairAddressProvider
+func (x *fastReflection_PriceCircuitBreaker) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceCircuitBreaker
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_PairAddressProvider) Type() protoreflect.MessageType {
-	return _fastReflection_PairAddressProvider_messageType
+func (x *fastReflection_PriceCircuitBreaker) Type() protoreflect.MessageType {
+	return _fastReflection_PriceCircuitBreaker_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_PairAddressProvider) New() protoreflect.Message {
-	return new(fastReflection_PairAddressProvider)
+func (x *fastReflection_PriceCircuitBreaker) New() protoreflect.Message {
+	return new(fastReflection_PriceCircuitBreaker)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_PairAddressProvider) Interface() protoreflect.ProtoMessage {
-	return (*PairAddressProvider)(x)
+func (x *fastReflection_PriceCircuitBreaker) Interface() protoreflect.ProtoMessage {
+	return (*PriceCircuitBreaker)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5652,16 +8781,34 @@ func (x *fastReflection_PairAddressProvider) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_PairAddressProvider) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_PairAddressProvider_address, value) {
+func (x *fastReflection_PriceCircuitBreaker) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_PriceCircuitBreaker_denom, value) {
 			return
 		}
 	}
-	if x.AddressProvider != "" {
-		value := protoreflect.ValueOfString(x.AddressProvider)
-		if !f(fd_PairAddressProvider_address_provider, value) {
+	if x.Tripped != false {
+		value := protoreflect.ValueOfBool(x.Tripped)
+		if !f(fd_PriceCircuitBreaker_tripped, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_PriceCircuitBreaker_reason, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_PriceCircuitBreaker_height, value) {
+			return
+		}
+	}
+	if x.Time != nil {
+		value := protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+		if !f(fd_PriceCircuitBreaker_time, value) {
 			return
 		}
 	}
@@ -5678,17 +8825,23 @@ func (x *fastReflection_PairAddressProvider) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_PairAddressProvider) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_PriceCircuitBreaker) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.PairAddressProvider.address":
-		return x.Address != ""
-	case "cheqd.oracle.v2.PairAddressProvider.address_provider":
-		return x.AddressProvider != ""
+	case "cheqd.oracle.v2.PriceCircuitBreaker.denom":
+		return x.Denom != ""
+	case "cheqd.oracle.v2.PriceCircuitBreaker.tripped":
+		return x.Tripped != false
+	case "cheqd.oracle.v2.PriceCircuitBreaker.reason":
+		return x.Reason != ""
+	case "cheqd.oracle.v2.PriceCircuitBreaker.height":
+		return x.Height != int64(0)
+	case "cheqd.oracle.v2.PriceCircuitBreaker.time":
+		return x.Time != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.PairAddressProvider"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.PriceCircuitBreaker"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.PairAddressProvider does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.PriceCircuitBreaker does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5698,17 +8851,23 @@ func (x *fastReflection_PairAddressProvider) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PairAddressProvider) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_PriceCircuitBreaker) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.PairAddressProvider.address":
-		x.Address = ""
-	case "cheqd.oracle.v2.PairAddressProvider.address_provider":
-		x.AddressProvider = ""
+	case "cheqd.oracle.v2.PriceCircuitBreaker.denom":
+		x.Denom = ""
+	case "cheqd.oracle.v2.PriceCircuitBreaker.tripped":
+		x.Tripped = false
+	case "cheqd.oracle.v2.PriceCircuitBreaker.reason":
+		x.Reason = ""
+	case "cheqd.oracle.v2.PriceCircuitBreaker.height":
+		x.Height = int64(0)
+	case "cheqd.oracle.v2.PriceCircuitBreaker.time":
+		x.Time = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.PairAddressProvider"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.PriceCircuitBreaker"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.PairAddressProvider does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.PriceCircuitBreaker does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5718,19 +8877,28 @@ func (x *fastReflection_PairAddressProvider) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_PairAddressProvider) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PriceCircuitBreaker) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cheqd.oracle.v2.PairAddressProvider.address":
-		value := x.Address
+	case "cheqd.oracle.v2.PriceCircuitBreaker.denom":
+		value := x.Denom
 		return protoreflect.ValueOfString(value)
-	case "cheqd.oracle.v2.PairAddressProvider.address_provider":
-		value := x.AddressProvider
+	case "cheqd.oracle.v2.PriceCircuitBreaker.tripped":
+		value := x.Tripped
+		return protoreflect.ValueOfBool(value)
+	case "cheqd.oracle.v2.PriceCircuitBreaker.reason":
+		value := x.Reason
 		return protoreflect.ValueOfString(value)
+	case "cheqd.oracle.v2.PriceCircuitBreaker.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "cheqd.oracle.v2.PriceCircuitBreaker.time":
+		value := x.Time
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.PairAddressProvider"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.PriceCircuitBreaker"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.PairAddressProvider does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.PriceCircuitBreaker does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5744,17 +8912,23 @@ func (x *fastReflection_PairAddressProvider) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PairAddressProvider) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_PriceCircuitBreaker) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.PairAddressProvider.address":
-		x.Address = value.Interface().(string)
-	case "cheqd.oracle.v2.PairAddressProvider.address_provider":
-		x.AddressProvider = value.Interface().(string)
+	case "cheqd.oracle.v2.PriceCircuitBreaker.denom":
+		x.Denom = value.Interface().(string)
+	case "cheqd.oracle.v2.PriceCircuitBreaker.tripped":
+		x.Tripped = value.Bool()
+	case "cheqd.oracle.v2.PriceCircuitBreaker.reason":
+		x.Reason = value.Interface().(string)
+	case "cheqd.oracle.v2.PriceCircuitBreaker.height":
+		x.Height = value.Int()
+	case "cheqd.oracle.v2.PriceCircuitBreaker.time":
+		x.Time = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.PairAddressProvider"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.PriceCircuitBreaker"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.PairAddressProvider does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.PriceCircuitBreaker does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5768,44 +8942,60 @@ func (x *fastReflection_PairAddressProvider) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PairAddressProvider) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PriceCircuitBreaker) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.PairAddressProvider.address":
-		panic(fmt.Errorf("field address of message cheqd.oracle.v2.PairAddressProvider is not mutable"))
-	case "cheqd.oracle.v2.PairAddressProvider.address_provider":
-		panic(fmt.Errorf("field address_provider of message cheqd.oracle.v2.PairAddressProvider is not mutable"))
+	case "cheqd.oracle.v2.PriceCircuitBreaker.time":
+		if x.Time == nil {
+			x.Time = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+	case "cheqd.oracle.v2.PriceCircuitBreaker.denom":
+		panic(fmt.Errorf("field denom of message cheqd.oracle.v2.PriceCircuitBreaker is not mutable"))
+	case "cheqd.oracle.v2.PriceCircuitBreaker.tripped":
+		panic(fmt.Errorf("field tripped of message cheqd.oracle.v2.PriceCircuitBreaker is not mutable"))
+	case "cheqd.oracle.v2.PriceCircuitBreaker.reason":
+		panic(fmt.Errorf("field reason of message cheqd.oracle.v2.PriceCircuitBreaker is not mutable"))
+	case "cheqd.oracle.v2.PriceCircuitBreaker.height":
+		panic(fmt.Errorf("field height of message cheqd.oracle.v2.PriceCircuitBreaker is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.PairAddressProvider"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.PriceCircuitBreaker"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.PairAddressProvider does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.PriceCircuitBreaker does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_PairAddressProvider) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PriceCircuitBreaker) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.PairAddressProvider.address":
+	case "cheqd.oracle.v2.PriceCircuitBreaker.denom":
 		return protoreflect.ValueOfString("")
-	case "cheqd.oracle.v2.PairAddressProvider.address_provider":
+	case "cheqd.oracle.v2.PriceCircuitBreaker.tripped":
+		return protoreflect.ValueOfBool(false)
+	case "cheqd.oracle.v2.PriceCircuitBreaker.reason":
 		return protoreflect.ValueOfString("")
+	case "cheqd.oracle.v2.PriceCircuitBreaker.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cheqd.oracle.v2.PriceCircuitBreaker.time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.PairAddressProvider"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.PriceCircuitBreaker"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.PairAddressProvider does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.PriceCircuitBreaker does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_PairAddressProvider) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_PriceCircuitBreaker) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cheqd.oracle.v2.PairAddressProvider", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.oracle.v2.PriceCircuitBreaker", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5813,7 +9003,7 @@ func (x *fastReflection_PairAddressProvider) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_PairAddressProvider) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_PriceCircuitBreaker) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5824,7 +9014,7 @@ func (x *fastReflection_PairAddressProvider) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PairAddressProvider) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_PriceCircuitBreaker) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5836,7 +9026,7 @@ func (x *fastReflection_PairAddressProvider) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_PairAddressProvider) IsValid() bool {
+func (x *fastReflection_PriceCircuitBreaker) IsValid() bool {
 	return x != nil
 }
 
@@ -5846,9 +9036,9 @@ func (x *fastReflection_PairAddressProvider) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_PairAddressProvider) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_PriceCircuitBreaker) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*PairAddressProvider)
+		x := input.Message.Interface().(*PriceCircuitBreaker)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5860,14 +9050,24 @@ func (x *fastReflection_PairAddressProvider) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		l = len(x.Address)
+		l = len(x.Denom)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.AddressProvider)
+		if x.Tripped {
+			n += 2
+		}
+		l = len(x.Reason)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Time != nil {
+			l = options.Size(x.Time)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5878,7 +9078,7 @@ func (x *fastReflection_PairAddressProvider) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*PairAddressProvider)
+		x := input.Message.Interface().(*PriceCircuitBreaker)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5897,17 +9097,46 @@ func (x *fastReflection_PairAddressProvider) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.AddressProvider) > 0 {
-			i -= len(x.AddressProvider)
-			copy(dAtA[i:], x.AddressProvider)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AddressProvider)))
+		if x.Time != nil {
+			encoded, err := options.Marshal(x.Time)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x2a
 		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
-			copy(dAtA[i:], x.Address)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Tripped {
+			i--
+			if x.Tripped {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -5922,7 +9151,7 @@ func (x *fastReflection_PairAddressProvider) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*PairAddressProvider)
+		x := input.Message.Interface().(*PriceCircuitBreaker)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5954,15 +9183,15 @@ func (x *fastReflection_PairAddressProvider) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PairAddressProvider: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceCircuitBreaker: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PairAddressProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceCircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5990,11 +9219,31 @@ func (x *fastReflection_PairAddressProvider) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Address = string(dAtA[iNdEx:postIndex])
+				x.Denom = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tripped", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Tripped = bool(v != 0)
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AddressProvider", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6022,7 +9271,62 @@ func (x *fastReflection_PairAddressProvider) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AddressProvider = string(dAtA[iNdEx:postIndex])
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Time == nil {
+					x.Time = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Time); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -6059,29 +9363,90 @@ func (x *fastReflection_PairAddressProvider) ProtoMethods() *protoiface.Methods
 	}
 }
 
+var _ protoreflect.List = (*_ValidatorOracleWindow_6_list)(nil)
+
+type _ValidatorOracleWindow_6_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_ValidatorOracleWindow_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ValidatorOracleWindow_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ValidatorOracleWindow_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ValidatorOracleWindow_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ValidatorOracleWindow_6_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ValidatorOracleWindow_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ValidatorOracleWindow_6_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ValidatorOracleWindow_6_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_CurrencyDeviationThreshold            protoreflect.MessageDescriptor
-	fd_CurrencyDeviationThreshold_base_denom protoreflect.FieldDescriptor
-	fd_CurrencyDeviationThreshold_threshold  protoreflect.FieldDescriptor
+	md_ValidatorOracleWindow                   protoreflect.MessageDescriptor
+	fd_ValidatorOracleWindow_validator_address protoreflect.FieldDescriptor
+	fd_ValidatorOracleWindow_window_end_height protoreflect.FieldDescriptor
+	fd_ValidatorOracleWindow_miss_count        protoreflect.FieldDescriptor
+	fd_ValidatorOracleWindow_possible_wins     protoreflect.FieldDescriptor
+	fd_ValidatorOracleWindow_valid_rate        protoreflect.FieldDescriptor
+	fd_ValidatorOracleWindow_rewards           protoreflect.FieldDescriptor
+	fd_ValidatorOracleWindow_slashed           protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cheqd_oracle_v2_oracle_proto_init()
-	md_CurrencyDeviationThreshold = File_cheqd_oracle_v2_oracle_proto.Messages().ByName("CurrencyDeviationThreshold")
-	fd_CurrencyDeviationThreshold_base_denom = md_CurrencyDeviationThreshold.Fields().ByName("base_denom")
-	fd_CurrencyDeviationThreshold_threshold = md_CurrencyDeviationThreshold.Fields().ByName("threshold")
+	md_ValidatorOracleWindow = File_cheqd_oracle_v2_oracle_proto.Messages().ByName("ValidatorOracleWindow")
+	fd_ValidatorOracleWindow_validator_address = md_ValidatorOracleWindow.Fields().ByName("validator_address")
+	fd_ValidatorOracleWindow_window_end_height = md_ValidatorOracleWindow.Fields().ByName("window_end_height")
+	fd_ValidatorOracleWindow_miss_count = md_ValidatorOracleWindow.Fields().ByName("miss_count")
+	fd_ValidatorOracleWindow_possible_wins = md_ValidatorOracleWindow.Fields().ByName("possible_wins")
+	fd_ValidatorOracleWindow_valid_rate = md_ValidatorOracleWindow.Fields().ByName("valid_rate")
+	fd_ValidatorOracleWindow_rewards = md_ValidatorOracleWindow.Fields().ByName("rewards")
+	fd_ValidatorOracleWindow_slashed = md_ValidatorOracleWindow.Fields().ByName("slashed")
 }
 
-var _ protoreflect.Message = (*fastReflection_CurrencyDeviationThreshold)(nil)
+var _ protoreflect.Message = (*fastReflection_ValidatorOracleWindow)(nil)
 
-type fastReflection_CurrencyDeviationThreshold CurrencyDeviationThreshold
+type fastReflection_ValidatorOracleWindow ValidatorOracleWindow
 
-func (x *CurrencyDeviationThreshold) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_CurrencyDeviationThreshold)(x)
+func (x *ValidatorOracleWindow) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorOracleWindow)(x)
 }
 
-func (x *CurrencyDeviationThreshold) slowProtoReflect() protoreflect.Message {
-	mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[9]
+func (x *ValidatorOracleWindow) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6092,43 +9457,43 @@ func (x *CurrencyDeviationThreshold) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_CurrencyDeviationThreshold_messageType fastReflection_CurrencyDeviationThreshold_messageType
-var _ protoreflect.MessageType = fastReflection_CurrencyDeviationThreshold_messageType{}
+var _fastReflection_ValidatorOracleWindow_messageType fastReflection_ValidatorOracleWindow_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorOracleWindow_messageType{}
 
-type fastReflection_CurrencyDeviationThreshold_messageType struct{}
+type fastReflection_ValidatorOracleWindow_messageType struct{}
 
-func (x fastReflection_CurrencyDeviationThreshold_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_CurrencyDeviationThreshold)(nil)
+func (x fastReflection_ValidatorOracleWindow_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorOracleWindow)(nil)
 }
-func (x fastReflection_CurrencyDeviationThreshold_messageType) New() protoreflect.Message {
-	return new(fastReflection_CurrencyDeviationThreshold)
+func (x fastReflection_ValidatorOracleWindow_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorOracleWindow)
 }
-func (x fastReflection_CurrencyDeviationThreshold_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_CurrencyDeviationThreshold
+func (x fastReflection_ValidatorOracleWindow_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorOracleWindow
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_CurrencyDeviationThreshold) Descriptor() protoreflect.MessageDescriptor {
-	return md_CurrencyDeviationThreshold
+func (x *fastReflection_ValidatorOracleWindow) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorOracleWindow
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_CurrencyDeviationThreshold) Type() protoreflect.MessageType {
-	return _fastReflection_CurrencyDeviationThreshold_messageType
+func (x *fastReflection_ValidatorOracleWindow) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorOracleWindow_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_CurrencyDeviationThreshold) New() protoreflect.Message {
-	return new(fastReflection_CurrencyDeviationThreshold)
+func (x *fastReflection_ValidatorOracleWindow) New() protoreflect.Message {
+	return new(fastReflection_ValidatorOracleWindow)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_CurrencyDeviationThreshold) Interface() protoreflect.ProtoMessage {
-	return (*CurrencyDeviationThreshold)(x)
+func (x *fastReflection_ValidatorOracleWindow) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorOracleWindow)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6136,16 +9501,46 @@ func (x *fastReflection_CurrencyDeviationThreshold) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_CurrencyDeviationThreshold) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.BaseDenom != "" {
-		value := protoreflect.ValueOfString(x.BaseDenom)
-		if !f(fd_CurrencyDeviationThreshold_base_denom, value) {
+func (x *fastReflection_ValidatorOracleWindow) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ValidatorAddress != "" {
+		value := protoreflect.ValueOfString(x.ValidatorAddress)
+		if !f(fd_ValidatorOracleWindow_validator_address, value) {
 			return
 		}
 	}
-	if x.Threshold != "" {
-		value := protoreflect.ValueOfString(x.Threshold)
-		if !f(fd_CurrencyDeviationThreshold_threshold, value) {
+	if x.WindowEndHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.WindowEndHeight)
+		if !f(fd_ValidatorOracleWindow_window_end_height, value) {
+			return
+		}
+	}
+	if x.MissCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MissCount)
+		if !f(fd_ValidatorOracleWindow_miss_count, value) {
+			return
+		}
+	}
+	if x.PossibleWins != int64(0) {
+		value := protoreflect.ValueOfInt64(x.PossibleWins)
+		if !f(fd_ValidatorOracleWindow_possible_wins, value) {
+			return
+		}
+	}
+	if x.ValidRate != "" {
+		value := protoreflect.ValueOfString(x.ValidRate)
+		if !f(fd_ValidatorOracleWindow_valid_rate, value) {
+			return
+		}
+	}
+	if len(x.Rewards) != 0 {
+		value := protoreflect.ValueOfList(&_ValidatorOracleWindow_6_list{list: &x.Rewards})
+		if !f(fd_ValidatorOracleWindow_rewards, value) {
+			return
+		}
+	}
+	if x.Slashed != false {
+		value := protoreflect.ValueOfBool(x.Slashed)
+		if !f(fd_ValidatorOracleWindow_slashed, value) {
 			return
 		}
 	}
@@ -6162,17 +9557,27 @@ func (x *fastReflection_CurrencyDeviationThreshold) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_CurrencyDeviationThreshold) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ValidatorOracleWindow) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.CurrencyDeviationThreshold.base_denom":
-		return x.BaseDenom != ""
-	case "cheqd.oracle.v2.CurrencyDeviationThreshold.threshold":
-		return x.Threshold != ""
+	case "cheqd.oracle.v2.ValidatorOracleWindow.validator_address":
+		return x.ValidatorAddress != ""
+	case "cheqd.oracle.v2.ValidatorOracleWindow.window_end_height":
+		return x.WindowEndHeight != uint64(0)
+	case "cheqd.oracle.v2.ValidatorOracleWindow.miss_count":
+		return x.MissCount != uint64(0)
+	case "cheqd.oracle.v2.ValidatorOracleWindow.possible_wins":
+		return x.PossibleWins != int64(0)
+	case "cheqd.oracle.v2.ValidatorOracleWindow.valid_rate":
+		return x.ValidRate != ""
+	case "cheqd.oracle.v2.ValidatorOracleWindow.rewards":
+		return len(x.Rewards) != 0
+	case "cheqd.oracle.v2.ValidatorOracleWindow.slashed":
+		return x.Slashed != false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.CurrencyDeviationThreshold"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.ValidatorOracleWindow"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.CurrencyDeviationThreshold does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.ValidatorOracleWindow does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6182,17 +9587,27 @@ func (x *fastReflection_CurrencyDeviationThreshold) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrencyDeviationThreshold) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ValidatorOracleWindow) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.CurrencyDeviationThreshold.base_denom":
-		x.BaseDenom = ""
-	case "cheqd.oracle.v2.CurrencyDeviationThreshold.threshold":
-		x.Threshold = ""
+	case "cheqd.oracle.v2.ValidatorOracleWindow.validator_address":
+		x.ValidatorAddress = ""
+	case "cheqd.oracle.v2.ValidatorOracleWindow.window_end_height":
+		x.WindowEndHeight = uint64(0)
+	case "cheqd.oracle.v2.ValidatorOracleWindow.miss_count":
+		x.MissCount = uint64(0)
+	case "cheqd.oracle.v2.ValidatorOracleWindow.possible_wins":
+		x.PossibleWins = int64(0)
+	case "cheqd.oracle.v2.ValidatorOracleWindow.valid_rate":
+		x.ValidRate = ""
+	case "cheqd.oracle.v2.ValidatorOracleWindow.rewards":
+		x.Rewards = nil
+	case "cheqd.oracle.v2.ValidatorOracleWindow.slashed":
+		x.Slashed = false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.CurrencyDeviationThreshold"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.ValidatorOracleWindow"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.CurrencyDeviationThreshold does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.ValidatorOracleWindow does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6202,19 +9617,37 @@ func (x *fastReflection_CurrencyDeviationThreshold) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_CurrencyDeviationThreshold) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorOracleWindow) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cheqd.oracle.v2.CurrencyDeviationThreshold.base_denom":
-		value := x.BaseDenom
+	case "cheqd.oracle.v2.ValidatorOracleWindow.validator_address":
+		value := x.ValidatorAddress
 		return protoreflect.ValueOfString(value)
-	case "cheqd.oracle.v2.CurrencyDeviationThreshold.threshold":
-		value := x.Threshold
+	case "cheqd.oracle.v2.ValidatorOracleWindow.window_end_height":
+		value := x.WindowEndHeight
+		return protoreflect.ValueOfUint64(value)
+	case "cheqd.oracle.v2.ValidatorOracleWindow.miss_count":
+		value := x.MissCount
+		return protoreflect.ValueOfUint64(value)
+	case "cheqd.oracle.v2.ValidatorOracleWindow.possible_wins":
+		value := x.PossibleWins
+		return protoreflect.ValueOfInt64(value)
+	case "cheqd.oracle.v2.ValidatorOracleWindow.valid_rate":
+		value := x.ValidRate
 		return protoreflect.ValueOfString(value)
+	case "cheqd.oracle.v2.ValidatorOracleWindow.rewards":
+		if len(x.Rewards) == 0 {
+			return protoreflect.ValueOfList(&_ValidatorOracleWindow_6_list{})
+		}
+		listValue := &_ValidatorOracleWindow_6_list{list: &x.Rewards}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.oracle.v2.ValidatorOracleWindow.slashed":
+		value := x.Slashed
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.CurrencyDeviationThreshold"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.ValidatorOracleWindow"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.CurrencyDeviationThreshold does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.ValidatorOracleWindow does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6228,17 +9661,29 @@ func (x *fastReflection_CurrencyDeviationThreshold) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrencyDeviationThreshold) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ValidatorOracleWindow) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.CurrencyDeviationThreshold.base_denom":
-		x.BaseDenom = value.Interface().(string)
-	case "cheqd.oracle.v2.CurrencyDeviationThreshold.threshold":
-		x.Threshold = value.Interface().(string)
+	case "cheqd.oracle.v2.ValidatorOracleWindow.validator_address":
+		x.ValidatorAddress = value.Interface().(string)
+	case "cheqd.oracle.v2.ValidatorOracleWindow.window_end_height":
+		x.WindowEndHeight = value.Uint()
+	case "cheqd.oracle.v2.ValidatorOracleWindow.miss_count":
+		x.MissCount = value.Uint()
+	case "cheqd.oracle.v2.ValidatorOracleWindow.possible_wins":
+		x.PossibleWins = value.Int()
+	case "cheqd.oracle.v2.ValidatorOracleWindow.valid_rate":
+		x.ValidRate = value.Interface().(string)
+	case "cheqd.oracle.v2.ValidatorOracleWindow.rewards":
+		lv := value.List()
+		clv := lv.(*_ValidatorOracleWindow_6_list)
+		x.Rewards = *clv.list
+	case "cheqd.oracle.v2.ValidatorOracleWindow.slashed":
+		x.Slashed = value.Bool()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.CurrencyDeviationThreshold"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.ValidatorOracleWindow"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.CurrencyDeviationThreshold does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.ValidatorOracleWindow does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6252,44 +9697,69 @@ func (x *fastReflection_CurrencyDeviationThreshold) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrencyDeviationThreshold) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorOracleWindow) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.CurrencyDeviationThreshold.base_denom":
-		panic(fmt.Errorf("field base_denom of message cheqd.oracle.v2.CurrencyDeviationThreshold is not mutable"))
-	case "cheqd.oracle.v2.CurrencyDeviationThreshold.threshold":
-		panic(fmt.Errorf("field threshold of message cheqd.oracle.v2.CurrencyDeviationThreshold is not mutable"))
+	case "cheqd.oracle.v2.ValidatorOracleWindow.rewards":
+		if x.Rewards == nil {
+			x.Rewards = []*v1beta1.Coin{}
+		}
+		value := &_ValidatorOracleWindow_6_list{list: &x.Rewards}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.oracle.v2.ValidatorOracleWindow.validator_address":
+		panic(fmt.Errorf("field validator_address of message cheqd.oracle.v2.ValidatorOracleWindow is not mutable"))
+	case "cheqd.oracle.v2.ValidatorOracleWindow.window_end_height":
+		panic(fmt.Errorf("field window_end_height of message cheqd.oracle.v2.ValidatorOracleWindow is not mutable"))
+	case "cheqd.oracle.v2.ValidatorOracleWindow.miss_count":
+		panic(fmt.Errorf("field miss_count of message cheqd.oracle.v2.ValidatorOracleWindow is not mutable"))
+	case "cheqd.oracle.v2.ValidatorOracleWindow.possible_wins":
+		panic(fmt.Errorf("field possible_wins of message cheqd.oracle.v2.ValidatorOracleWindow is not mutable"))
+	case "cheqd.oracle.v2.ValidatorOracleWindow.valid_rate":
+		panic(fmt.Errorf("field valid_rate of message cheqd.oracle.v2.ValidatorOracleWindow is not mutable"))
+	case "cheqd.oracle.v2.ValidatorOracleWindow.slashed":
+		panic(fmt.Errorf("field slashed of message cheqd.oracle.v2.ValidatorOracleWindow is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.CurrencyDeviationThreshold"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.ValidatorOracleWindow"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.CurrencyDeviationThreshold does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.ValidatorOracleWindow does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_CurrencyDeviationThreshold) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorOracleWindow) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.CurrencyDeviationThreshold.base_denom":
+	case "cheqd.oracle.v2.ValidatorOracleWindow.validator_address":
 		return protoreflect.ValueOfString("")
-	case "cheqd.oracle.v2.CurrencyDeviationThreshold.threshold":
+	case "cheqd.oracle.v2.ValidatorOracleWindow.window_end_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cheqd.oracle.v2.ValidatorOracleWindow.miss_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cheqd.oracle.v2.ValidatorOracleWindow.possible_wins":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cheqd.oracle.v2.ValidatorOracleWindow.valid_rate":
 		return protoreflect.ValueOfString("")
+	case "cheqd.oracle.v2.ValidatorOracleWindow.rewards":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_ValidatorOracleWindow_6_list{list: &list})
+	case "cheqd.oracle.v2.ValidatorOracleWindow.slashed":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.CurrencyDeviationThreshold"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.ValidatorOracleWindow"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.CurrencyDeviationThreshold does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.ValidatorOracleWindow does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_CurrencyDeviationThreshold) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ValidatorOracleWindow) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cheqd.oracle.v2.CurrencyDeviationThreshold", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.oracle.v2.ValidatorOracleWindow", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6297,7 +9767,7 @@ func (x *fastReflection_CurrencyDeviationThreshold) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_CurrencyDeviationThreshold) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ValidatorOracleWindow) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6308,7 +9778,7 @@ func (x *fastReflection_CurrencyDeviationThreshold) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrencyDeviationThreshold) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ValidatorOracleWindow) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6320,7 +9790,7 @@ func (x *fastReflection_CurrencyDeviationThreshold) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_CurrencyDeviationThreshold) IsValid() bool {
+func (x *fastReflection_ValidatorOracleWindow) IsValid() bool {
 	return x != nil
 }
 
@@ -6330,9 +9800,9 @@ func (x *fastReflection_CurrencyDeviationThreshold) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_CurrencyDeviationThreshold) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ValidatorOracleWindow) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*CurrencyDeviationThreshold)
+		x := input.Message.Interface().(*ValidatorOracleWindow)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6344,14 +9814,32 @@ func (x *fastReflection_CurrencyDeviationThreshold) ProtoMethods() *protoiface.M
 		var n int
 		var l int
 		_ = l
-		l = len(x.BaseDenom)
+		l = len(x.ValidatorAddress)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Threshold)
+		if x.WindowEndHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.WindowEndHeight))
+		}
+		if x.MissCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MissCount))
+		}
+		if x.PossibleWins != 0 {
+			n += 1 + runtime.Sov(uint64(x.PossibleWins))
+		}
+		l = len(x.ValidRate)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Rewards) > 0 {
+			for _, e := range x.Rewards {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Slashed {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6362,7 +9850,7 @@ func (x *fastReflection_CurrencyDeviationThreshold) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*CurrencyDeviationThreshold)
+		x := input.Message.Interface().(*ValidatorOracleWindow)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6381,17 +9869,58 @@ func (x *fastReflection_CurrencyDeviationThreshold) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Threshold) > 0 {
-			i -= len(x.Threshold)
-			copy(dAtA[i:], x.Threshold)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Threshold)))
+		if x.Slashed {
 			i--
-			dAtA[i] = 0x12
+			if x.Slashed {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x38
 		}
-		if len(x.BaseDenom) > 0 {
-			i -= len(x.BaseDenom)
-			copy(dAtA[i:], x.BaseDenom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseDenom)))
+		if len(x.Rewards) > 0 {
+			for iNdEx := len(x.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Rewards[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.ValidRate) > 0 {
+			i -= len(x.ValidRate)
+			copy(dAtA[i:], x.ValidRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidRate)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.PossibleWins != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PossibleWins))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.MissCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MissCount))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.WindowEndHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WindowEndHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.ValidatorAddress) > 0 {
+			i -= len(x.ValidatorAddress)
+			copy(dAtA[i:], x.ValidatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -6406,7 +9935,7 @@ func (x *fastReflection_CurrencyDeviationThreshold) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*CurrencyDeviationThreshold)
+		x := input.Message.Interface().(*ValidatorOracleWindow)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6438,15 +9967,15 @@ func (x *fastReflection_CurrencyDeviationThreshold) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyDeviationThreshold: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorOracleWindow: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyDeviationThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorOracleWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6474,11 +10003,68 @@ func (x *fastReflection_CurrencyDeviationThreshold) ProtoMethods() *protoiface.M
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BaseDenom = string(dAtA[iNdEx:postIndex])
+				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WindowEndHeight", wireType)
+				}
+				x.WindowEndHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WindowEndHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MissCount", wireType)
+				}
+				x.MissCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MissCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PossibleWins", wireType)
+				}
+				x.PossibleWins = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PossibleWins |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidRate", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6506,8 +10092,62 @@ func (x *fastReflection_CurrencyDeviationThreshold) ProtoMethods() *protoiface.M
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Threshold = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
+				x.ValidRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Rewards = append(x.Rewards, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Rewards[len(x.Rewards)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Slashed = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6543,77 +10183,94 @@ func (x *fastReflection_CurrencyDeviationThreshold) ProtoMethods() *protoiface.M
 	}
 }
 
-var _ protoreflect.List = (*_ParamUpdatePlan_1_list)(nil)
+var _ protoreflect.List = (*_SlashWindowSummary_9_list)(nil)
 
-type _ParamUpdatePlan_1_list struct {
-	list *[]string
+type _SlashWindowSummary_9_list struct {
+	list *[]*v1beta1.Coin
 }
 
-func (x *_ParamUpdatePlan_1_list) Len() int {
+func (x *_SlashWindowSummary_9_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_ParamUpdatePlan_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
+func (x *_SlashWindowSummary_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_ParamUpdatePlan_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
+func (x *_SlashWindowSummary_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_ParamUpdatePlan_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
+func (x *_SlashWindowSummary_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_ParamUpdatePlan_1_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message ParamUpdatePlan at list field Keys as it is not of Message kind"))
+func (x *_SlashWindowSummary_9_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_ParamUpdatePlan_1_list) Truncate(n int) {
+func (x *_SlashWindowSummary_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_ParamUpdatePlan_1_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
+func (x *_SlashWindowSummary_9_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_ParamUpdatePlan_1_list) IsValid() bool {
+func (x *_SlashWindowSummary_9_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_ParamUpdatePlan         protoreflect.MessageDescriptor
-	fd_ParamUpdatePlan_keys    protoreflect.FieldDescriptor
-	fd_ParamUpdatePlan_height  protoreflect.FieldDescriptor
-	fd_ParamUpdatePlan_changes protoreflect.FieldDescriptor
+	md_SlashWindowSummary                      protoreflect.MessageDescriptor
+	fd_SlashWindowSummary_window_start_height  protoreflect.FieldDescriptor
+	fd_SlashWindowSummary_window_end_height    protoreflect.FieldDescriptor
+	fd_SlashWindowSummary_possible_wins        protoreflect.FieldDescriptor
+	fd_SlashWindowSummary_min_valid_per_window protoreflect.FieldDescriptor
+	fd_SlashWindowSummary_slash_fraction       protoreflect.FieldDescriptor
+	fd_SlashWindowSummary_slashing_enabled     protoreflect.FieldDescriptor
+	fd_SlashWindowSummary_validators           protoreflect.FieldDescriptor
+	fd_SlashWindowSummary_slashed_validators   protoreflect.FieldDescriptor
+	fd_SlashWindowSummary_rewards              protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cheqd_oracle_v2_oracle_proto_init()
-	md_ParamUpdatePlan = File_cheqd_oracle_v2_oracle_proto.Messages().ByName("ParamUpdatePlan")
-	fd_ParamUpdatePlan_keys = md_ParamUpdatePlan.Fields().ByName("keys")
-	fd_ParamUpdatePlan_height = md_ParamUpdatePlan.Fields().ByName("height")
-	fd_ParamUpdatePlan_changes = md_ParamUpdatePlan.Fields().ByName("changes")
+	md_SlashWindowSummary = File_cheqd_oracle_v2_oracle_proto.Messages().ByName("SlashWindowSummary")
+	fd_SlashWindowSummary_window_start_height = md_SlashWindowSummary.Fields().ByName("window_start_height")
+	fd_SlashWindowSummary_window_end_height = md_SlashWindowSummary.Fields().ByName("window_end_height")
+	fd_SlashWindowSummary_possible_wins = md_SlashWindowSummary.Fields().ByName("possible_wins")
+	fd_SlashWindowSummary_min_valid_per_window = md_SlashWindowSummary.Fields().ByName("min_valid_per_window")
+	fd_SlashWindowSummary_slash_fraction = md_SlashWindowSummary.Fields().ByName("slash_fraction")
+	fd_SlashWindowSummary_slashing_enabled = md_SlashWindowSummary.Fields().ByName("slashing_enabled")
+	fd_SlashWindowSummary_validators = md_SlashWindowSummary.Fields().ByName("validators")
+	fd_SlashWindowSummary_slashed_validators = md_SlashWindowSummary.Fields().ByName("slashed_validators")
+	fd_SlashWindowSummary_rewards = md_SlashWindowSummary.Fields().ByName("rewards")
 }
 
-var _ protoreflect.Message = (*fastReflection_ParamUpdatePlan)(nil)
+var _ protoreflect.Message = (*fastReflection_SlashWindowSummary)(nil)
 
-type fastReflection_ParamUpdatePlan ParamUpdatePlan
+type fastReflection_SlashWindowSummary SlashWindowSummary
 
-func (x *ParamUpdatePlan) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ParamUpdatePlan)(x)
+func (x *SlashWindowSummary) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SlashWindowSummary)(x)
 }
 
-func (x *ParamUpdatePlan) slowProtoReflect() protoreflect.Message {
-	mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[10]
+func (x *SlashWindowSummary) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6624,43 +10281,43 @@ func (x *ParamUpdatePlan) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ParamUpdatePlan_messageType fastReflection_ParamUpdatePlan_messageType
-var _ protoreflect.MessageType = fastReflection_ParamUpdatePlan_messageType{}
+var _fastReflection_SlashWindowSummary_messageType fastReflection_SlashWindowSummary_messageType
+var _ protoreflect.MessageType = fastReflection_SlashWindowSummary_messageType{}
 
-type fastReflection_ParamUpdatePlan_messageType struct{}
+type fastReflection_SlashWindowSummary_messageType struct{}
 
-func (x fastReflection_ParamUpdatePlan_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ParamUpdatePlan)(nil)
+func (x fastReflection_SlashWindowSummary_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SlashWindowSummary)(nil)
 }
-func (x fastReflection_ParamUpdatePlan_messageType) New() protoreflect.Message {
-	return new(fastReflection_ParamUpdatePlan)
+func (x fastReflection_SlashWindowSummary_messageType) New() protoreflect.Message {
+	return new(fastReflection_SlashWindowSummary)
 }
-func (x fastReflection_ParamUpdatePlan_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ParamUpdatePlan
+func (x fastReflection_SlashWindowSummary_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SlashWindowSummary
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ParamUpdatePlan) Descriptor() protoreflect.MessageDescriptor {
-	return md_ParamUpdatePlan
+func (x *fastReflection_SlashWindowSummary) Descriptor() protoreflect.MessageDescriptor {
+	return md_SlashWindowSummary
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ParamUpdatePlan) Type() protoreflect.MessageType {
-	return _fastReflection_ParamUpdatePlan_messageType
+func (x *fastReflection_SlashWindowSummary) Type() protoreflect.MessageType {
+	return _fastReflection_SlashWindowSummary_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ParamUpdatePlan) New() protoreflect.Message {
-	return new(fastReflection_ParamUpdatePlan)
+func (x *fastReflection_SlashWindowSummary) New() protoreflect.Message {
+	return new(fastReflection_SlashWindowSummary)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ParamUpdatePlan) Interface() protoreflect.ProtoMessage {
-	return (*ParamUpdatePlan)(x)
+func (x *fastReflection_SlashWindowSummary) Interface() protoreflect.ProtoMessage {
+	return (*SlashWindowSummary)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6668,22 +10325,58 @@ func (x *fastReflection_ParamUpdatePlan) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ParamUpdatePlan) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Keys) != 0 {
-		value := protoreflect.ValueOfList(&_ParamUpdatePlan_1_list{list: &x.Keys})
-		if !f(fd_ParamUpdatePlan_keys, value) {
+func (x *fastReflection_SlashWindowSummary) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.WindowStartHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.WindowStartHeight)
+		if !f(fd_SlashWindowSummary_window_start_height, value) {
 			return
 		}
 	}
-	if x.Height != int64(0) {
-		value := protoreflect.ValueOfInt64(x.Height)
-		if !f(fd_ParamUpdatePlan_height, value) {
+	if x.WindowEndHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.WindowEndHeight)
+		if !f(fd_SlashWindowSummary_window_end_height, value) {
 			return
 		}
 	}
-	if x.Changes != nil {
-		value := protoreflect.ValueOfMessage(x.Changes.ProtoReflect())
-		if !f(fd_ParamUpdatePlan_changes, value) {
+	if x.PossibleWins != int64(0) {
+		value := protoreflect.ValueOfInt64(x.PossibleWins)
+		if !f(fd_SlashWindowSummary_possible_wins, value) {
+			return
+		}
+	}
+	if x.MinValidPerWindow != "" {
+		value := protoreflect.ValueOfString(x.MinValidPerWindow)
+		if !f(fd_SlashWindowSummary_min_valid_per_window, value) {
+			return
+		}
+	}
+	if x.SlashFraction != "" {
+		value := protoreflect.ValueOfString(x.SlashFraction)
+		if !f(fd_SlashWindowSummary_slash_fraction, value) {
+			return
+		}
+	}
+	if x.SlashingEnabled != false {
+		value := protoreflect.ValueOfBool(x.SlashingEnabled)
+		if !f(fd_SlashWindowSummary_slashing_enabled, value) {
+			return
+		}
+	}
+	if x.Validators != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Validators)
+		if !f(fd_SlashWindowSummary_validators, value) {
+			return
+		}
+	}
+	if x.SlashedValidators != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.SlashedValidators)
+		if !f(fd_SlashWindowSummary_slashed_validators, value) {
+			return
+		}
+	}
+	if len(x.Rewards) != 0 {
+		value := protoreflect.ValueOfList(&_SlashWindowSummary_9_list{list: &x.Rewards})
+		if !f(fd_SlashWindowSummary_rewards, value) {
 			return
 		}
 	}
@@ -6700,19 +10393,31 @@ func (x *fastReflection_ParamUpdatePlan) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ParamUpdatePlan) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SlashWindowSummary) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.ParamUpdatePlan.keys":
-		return len(x.Keys) != 0
-	case "cheqd.oracle.v2.ParamUpdatePlan.height":
-		return x.Height != int64(0)
-	case "cheqd.oracle.v2.ParamUpdatePlan.changes":
-		return x.Changes != nil
+	case "cheqd.oracle.v2.SlashWindowSummary.window_start_height":
+		return x.WindowStartHeight != uint64(0)
+	case "cheqd.oracle.v2.SlashWindowSummary.window_end_height":
+		return x.WindowEndHeight != uint64(0)
+	case "cheqd.oracle.v2.SlashWindowSummary.possible_wins":
+		return x.PossibleWins != int64(0)
+	case "cheqd.oracle.v2.SlashWindowSummary.min_valid_per_window":
+		return x.MinValidPerWindow != ""
+	case "cheqd.oracle.v2.SlashWindowSummary.slash_fraction":
+		return x.SlashFraction != ""
+	case "cheqd.oracle.v2.SlashWindowSummary.slashing_enabled":
+		return x.SlashingEnabled != false
+	case "cheqd.oracle.v2.SlashWindowSummary.validators":
+		return x.Validators != uint32(0)
+	case "cheqd.oracle.v2.SlashWindowSummary.slashed_validators":
+		return x.SlashedValidators != uint32(0)
+	case "cheqd.oracle.v2.SlashWindowSummary.rewards":
+		return len(x.Rewards) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.ParamUpdatePlan"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.SlashWindowSummary"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.ParamUpdatePlan does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.SlashWindowSummary does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6722,19 +10427,31 @@ func (x *fastReflection_ParamUpdatePlan) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ParamUpdatePlan) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SlashWindowSummary) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.ParamUpdatePlan.keys":
-		x.Keys = nil
-	case "cheqd.oracle.v2.ParamUpdatePlan.height":
-		x.Height = int64(0)
-	case "cheqd.oracle.v2.ParamUpdatePlan.changes":
-		x.Changes = nil
+	case "cheqd.oracle.v2.SlashWindowSummary.window_start_height":
+		x.WindowStartHeight = uint64(0)
+	case "cheqd.oracle.v2.SlashWindowSummary.window_end_height":
+		x.WindowEndHeight = uint64(0)
+	case "cheqd.oracle.v2.SlashWindowSummary.possible_wins":
+		x.PossibleWins = int64(0)
+	case "cheqd.oracle.v2.SlashWindowSummary.min_valid_per_window":
+		x.MinValidPerWindow = ""
+	case "cheqd.oracle.v2.SlashWindowSummary.slash_fraction":
+		x.SlashFraction = ""
+	case "cheqd.oracle.v2.SlashWindowSummary.slashing_enabled":
+		x.SlashingEnabled = false
+	case "cheqd.oracle.v2.SlashWindowSummary.validators":
+		x.Validators = uint32(0)
+	case "cheqd.oracle.v2.SlashWindowSummary.slashed_validators":
+		x.SlashedValidators = uint32(0)
+	case "cheqd.oracle.v2.SlashWindowSummary.rewards":
+		x.Rewards = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.ParamUpdatePlan"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.SlashWindowSummary"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.ParamUpdatePlan does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.SlashWindowSummary does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6744,25 +10461,43 @@ func (x *fastReflection_ParamUpdatePlan) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ParamUpdatePlan) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SlashWindowSummary) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cheqd.oracle.v2.ParamUpdatePlan.keys":
-		if len(x.Keys) == 0 {
-			return protoreflect.ValueOfList(&_ParamUpdatePlan_1_list{})
+	case "cheqd.oracle.v2.SlashWindowSummary.window_start_height":
+		value := x.WindowStartHeight
+		return protoreflect.ValueOfUint64(value)
+	case "cheqd.oracle.v2.SlashWindowSummary.window_end_height":
+		value := x.WindowEndHeight
+		return protoreflect.ValueOfUint64(value)
+	case "cheqd.oracle.v2.SlashWindowSummary.possible_wins":
+		value := x.PossibleWins
+		return protoreflect.ValueOfInt64(value)
+	case "cheqd.oracle.v2.SlashWindowSummary.min_valid_per_window":
+		value := x.MinValidPerWindow
+		return protoreflect.ValueOfString(value)
+	case "cheqd.oracle.v2.SlashWindowSummary.slash_fraction":
+		value := x.SlashFraction
+		return protoreflect.ValueOfString(value)
+	case "cheqd.oracle.v2.SlashWindowSummary.slashing_enabled":
+		value := x.SlashingEnabled
+		return protoreflect.ValueOfBool(value)
+	case "cheqd.oracle.v2.SlashWindowSummary.validators":
+		value := x.Validators
+		return protoreflect.ValueOfUint32(value)
+	case "cheqd.oracle.v2.SlashWindowSummary.slashed_validators":
+		value := x.SlashedValidators
+		return protoreflect.ValueOfUint32(value)
+	case "cheqd.oracle.v2.SlashWindowSummary.rewards":
+		if len(x.Rewards) == 0 {
+			return protoreflect.ValueOfList(&_SlashWindowSummary_9_list{})
 		}
-		listValue := &_ParamUpdatePlan_1_list{list: &x.Keys}
+		listValue := &_SlashWindowSummary_9_list{list: &x.Rewards}
 		return protoreflect.ValueOfList(listValue)
-	case "cheqd.oracle.v2.ParamUpdatePlan.height":
-		value := x.Height
-		return protoreflect.ValueOfInt64(value)
-	case "cheqd.oracle.v2.ParamUpdatePlan.changes":
-		value := x.Changes
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.ParamUpdatePlan"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.SlashWindowSummary"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.ParamUpdatePlan does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.SlashWindowSummary does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6776,21 +10511,33 @@ func (x *fastReflection_ParamUpdatePlan) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ParamUpdatePlan) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SlashWindowSummary) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.ParamUpdatePlan.keys":
+	case "cheqd.oracle.v2.SlashWindowSummary.window_start_height":
+		x.WindowStartHeight = value.Uint()
+	case "cheqd.oracle.v2.SlashWindowSummary.window_end_height":
+		x.WindowEndHeight = value.Uint()
+	case "cheqd.oracle.v2.SlashWindowSummary.possible_wins":
+		x.PossibleWins = value.Int()
+	case "cheqd.oracle.v2.SlashWindowSummary.min_valid_per_window":
+		x.MinValidPerWindow = value.Interface().(string)
+	case "cheqd.oracle.v2.SlashWindowSummary.slash_fraction":
+		x.SlashFraction = value.Interface().(string)
+	case "cheqd.oracle.v2.SlashWindowSummary.slashing_enabled":
+		x.SlashingEnabled = value.Bool()
+	case "cheqd.oracle.v2.SlashWindowSummary.validators":
+		x.Validators = uint32(value.Uint())
+	case "cheqd.oracle.v2.SlashWindowSummary.slashed_validators":
+		x.SlashedValidators = uint32(value.Uint())
+	case "cheqd.oracle.v2.SlashWindowSummary.rewards":
 		lv := value.List()
-		clv := lv.(*_ParamUpdatePlan_1_list)
-		x.Keys = *clv.list
-	case "cheqd.oracle.v2.ParamUpdatePlan.height":
-		x.Height = value.Int()
-	case "cheqd.oracle.v2.ParamUpdatePlan.changes":
-		x.Changes = value.Message().Interface().(*Params)
+		clv := lv.(*_SlashWindowSummary_9_list)
+		x.Rewards = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.ParamUpdatePlan"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.SlashWindowSummary"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.ParamUpdatePlan does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.SlashWindowSummary does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6804,57 +10551,77 @@ func (x *fastReflection_ParamUpdatePlan) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ParamUpdatePlan) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SlashWindowSummary) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.ParamUpdatePlan.keys":
-		if x.Keys == nil {
-			x.Keys = []string{}
+	case "cheqd.oracle.v2.SlashWindowSummary.rewards":
+		if x.Rewards == nil {
+			x.Rewards = []*v1beta1.Coin{}
 		}
-		value := &_ParamUpdatePlan_1_list{list: &x.Keys}
+		value := &_SlashWindowSummary_9_list{list: &x.Rewards}
 		return protoreflect.ValueOfList(value)
-	case "cheqd.oracle.v2.ParamUpdatePlan.changes":
-		if x.Changes == nil {
-			x.Changes = new(Params)
-		}
-		return protoreflect.ValueOfMessage(x.Changes.ProtoReflect())
-	case "cheqd.oracle.v2.ParamUpdatePlan.height":
-		panic(fmt.Errorf("field height of message cheqd.oracle.v2.ParamUpdatePlan is not mutable"))
+	case "cheqd.oracle.v2.SlashWindowSummary.window_start_height":
+		panic(fmt.Errorf("field window_start_height of message cheqd.oracle.v2.SlashWindowSummary is not mutable"))
+	case "cheqd.oracle.v2.SlashWindowSummary.window_end_height":
+		panic(fmt.Errorf("field window_end_height of message cheqd.oracle.v2.SlashWindowSummary is not mutable"))
+	case "cheqd.oracle.v2.SlashWindowSummary.possible_wins":
+		panic(fmt.Errorf("field possible_wins of message cheqd.oracle.v2.SlashWindowSummary is not mutable"))
+	case "cheqd.oracle.v2.SlashWindowSummary.min_valid_per_window":
+		panic(fmt.Errorf("field min_valid_per_window of message cheqd.oracle.v2.SlashWindowSummary is not mutable"))
+	case "cheqd.oracle.v2.SlashWindowSummary.slash_fraction":
+		panic(fmt.Errorf("field slash_fraction of message cheqd.oracle.v2.SlashWindowSummary is not mutable"))
+	case "cheqd.oracle.v2.SlashWindowSummary.slashing_enabled":
+		panic(fmt.Errorf("field slashing_enabled of message cheqd.oracle.v2.SlashWindowSummary is not mutable"))
+	case "cheqd.oracle.v2.SlashWindowSummary.validators":
+		panic(fmt.Errorf("field validators of message cheqd.oracle.v2.SlashWindowSummary is not mutable"))
+	case "cheqd.oracle.v2.SlashWindowSummary.slashed_validators":
+		panic(fmt.Errorf("field slashed_validators of message cheqd.oracle.v2.SlashWindowSummary is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.ParamUpdatePlan"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.SlashWindowSummary"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.ParamUpdatePlan does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.SlashWindowSummary does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ParamUpdatePlan) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SlashWindowSummary) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.oracle.v2.ParamUpdatePlan.keys":
-		list := []string{}
-		return protoreflect.ValueOfList(&_ParamUpdatePlan_1_list{list: &list})
-	case "cheqd.oracle.v2.ParamUpdatePlan.height":
+	case "cheqd.oracle.v2.SlashWindowSummary.window_start_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cheqd.oracle.v2.SlashWindowSummary.window_end_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cheqd.oracle.v2.SlashWindowSummary.possible_wins":
 		return protoreflect.ValueOfInt64(int64(0))
-	case "cheqd.oracle.v2.ParamUpdatePlan.changes":
-		m := new(Params)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cheqd.oracle.v2.SlashWindowSummary.min_valid_per_window":
+		return protoreflect.ValueOfString("")
+	case "cheqd.oracle.v2.SlashWindowSummary.slash_fraction":
+		return protoreflect.ValueOfString("")
+	case "cheqd.oracle.v2.SlashWindowSummary.slashing_enabled":
+		return protoreflect.ValueOfBool(false)
+	case "cheqd.oracle.v2.SlashWindowSummary.validators":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cheqd.oracle.v2.SlashWindowSummary.slashed_validators":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cheqd.oracle.v2.SlashWindowSummary.rewards":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_SlashWindowSummary_9_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.ParamUpdatePlan"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.oracle.v2.SlashWindowSummary"))
 		}
-		panic(fmt.Errorf("message cheqd.oracle.v2.ParamUpdatePlan does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.oracle.v2.SlashWindowSummary does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ParamUpdatePlan) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SlashWindowSummary) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cheqd.oracle.v2.ParamUpdatePlan", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.oracle.v2.SlashWindowSummary", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6862,7 +10629,7 @@ func (x *fastReflection_ParamUpdatePlan) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ParamUpdatePlan) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SlashWindowSummary) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6873,7 +10640,7 @@ func (x *fastReflection_ParamUpdatePlan) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ParamUpdatePlan) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SlashWindowSummary) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6885,7 +10652,7 @@ func (x *fastReflection_ParamUpdatePlan) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ParamUpdatePlan) IsValid() bool {
+func (x *fastReflection_SlashWindowSummary) IsValid() bool {
 	return x != nil
 }
 
@@ -6895,9 +10662,9 @@ func (x *fastReflection_ParamUpdatePlan) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ParamUpdatePlan) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SlashWindowSummary) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ParamUpdatePlan)
+		x := input.Message.Interface().(*SlashWindowSummary)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6909,19 +10676,38 @@ func (x *fastReflection_ParamUpdatePlan) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.Keys) > 0 {
-			for _, s := range x.Keys {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.WindowStartHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.WindowStartHeight))
 		}
-		if x.Height != 0 {
-			n += 1 + runtime.Sov(uint64(x.Height))
+		if x.WindowEndHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.WindowEndHeight))
 		}
-		if x.Changes != nil {
-			l = options.Size(x.Changes)
+		if x.PossibleWins != 0 {
+			n += 1 + runtime.Sov(uint64(x.PossibleWins))
+		}
+		l = len(x.MinValidPerWindow)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.SlashFraction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SlashingEnabled {
+			n += 2
+		}
+		if x.Validators != 0 {
+			n += 1 + runtime.Sov(uint64(x.Validators))
+		}
+		if x.SlashedValidators != 0 {
+			n += 1 + runtime.Sov(uint64(x.SlashedValidators))
+		}
+		if len(x.Rewards) > 0 {
+			for _, e := range x.Rewards {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6932,7 +10718,7 @@ func (x *fastReflection_ParamUpdatePlan) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ParamUpdatePlan)
+		x := input.Message.Interface().(*SlashWindowSummary)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6951,33 +10737,70 @@ func (x *fastReflection_ParamUpdatePlan) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Changes != nil {
-			encoded, err := options.Marshal(x.Changes)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.Rewards) > 0 {
+			for iNdEx := len(x.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Rewards[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if x.SlashedValidators != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SlashedValidators))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.Validators != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Validators))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.SlashingEnabled {
+			i--
+			if x.SlashingEnabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.SlashFraction) > 0 {
+			i -= len(x.SlashFraction)
+			copy(dAtA[i:], x.SlashFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SlashFraction)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.MinValidPerWindow) > 0 {
+			i -= len(x.MinValidPerWindow)
+			copy(dAtA[i:], x.MinValidPerWindow)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinValidPerWindow)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.PossibleWins != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PossibleWins))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x18
 		}
-		if x.Height != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+		if x.WindowEndHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WindowEndHeight))
 			i--
 			dAtA[i] = 0x10
 		}
-		if len(x.Keys) > 0 {
-			for iNdEx := len(x.Keys) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Keys[iNdEx])
-				copy(dAtA[i:], x.Keys[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Keys[iNdEx])))
-				i--
-				dAtA[i] = 0xa
-			}
+		if x.WindowStartHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WindowStartHeight))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -6990,7 +10813,7 @@ func (x *fastReflection_ParamUpdatePlan) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ParamUpdatePlan)
+		x := input.Message.Interface().(*SlashWindowSummary)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7022,15 +10845,72 @@ func (x *fastReflection_ParamUpdatePlan) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParamUpdatePlan: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SlashWindowSummary: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParamUpdatePlan: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SlashWindowSummary: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WindowStartHeight", wireType)
+				}
+				x.WindowStartHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WindowStartHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WindowEndHeight", wireType)
+				}
+				x.WindowEndHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WindowEndHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PossibleWins", wireType)
+				}
+				x.PossibleWins = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PossibleWins |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7058,13 +10938,45 @@ func (x *fastReflection_ParamUpdatePlan) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Keys = append(x.Keys, string(dAtA[iNdEx:postIndex]))
+				x.MinValidPerWindow = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SlashFraction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashingEnabled", wireType)
 				}
-				x.Height = 0
+				var v int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7074,14 +10986,53 @@ func (x *fastReflection_ParamUpdatePlan) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Height |= int64(b&0x7F) << shift
+					v |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 3:
+				x.SlashingEnabled = bool(v != 0)
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				}
+				x.Validators = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Validators |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashedValidators", wireType)
+				}
+				x.SlashedValidators = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SlashedValidators |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -7108,10 +11059,8 @@ func (x *fastReflection_ParamUpdatePlan) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Changes == nil {
-					x.Changes = &Params{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Changes); err != nil {
+				x.Rewards = append(x.Rewards, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Rewards[len(x.Rewards)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -7163,6 +11112,87 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// PriceSource defines the price a conversion is computed with
+type PriceSource int32
+
+const (
+	// PRICE_SOURCE_UNSPECIFIED defaults to the price source identity fees are converted with
+	PriceSource_PRICE_SOURCE_UNSPECIFIED PriceSource = 0
+	// PRICE_SOURCE_SPOT uses the exchange rate of the last vote period
+	PriceSource_PRICE_SOURCE_SPOT PriceSource = 1
+	// PRICE_SOURCE_SMA uses the simple moving average
+	PriceSource_PRICE_SOURCE_SMA PriceSource = 2
+	// PRICE_SOURCE_EMA uses the exponential moving average
+	PriceSource_PRICE_SOURCE_EMA PriceSource = 3
+	// PRICE_SOURCE_WMA_BALANCED uses the weighted moving average with balanced weights
+	PriceSource_PRICE_SOURCE_WMA_BALANCED PriceSource = 4
+	// PRICE_SOURCE_WMA_RECENT uses the weighted moving average favouring recent prices
+	PriceSource_PRICE_SOURCE_WMA_RECENT PriceSource = 5
+	// PRICE_SOURCE_WMA_OLDEST uses the weighted moving average favouring older prices
+	PriceSource_PRICE_SOURCE_WMA_OLDEST PriceSource = 6
+	// PRICE_SOURCE_WMA_CUSTOM uses the weighted moving average with custom weights
+	PriceSource_PRICE_SOURCE_WMA_CUSTOM PriceSource = 7
+	// PRICE_SOURCE_MEDIAN uses the median of the historic medians
+	PriceSource_PRICE_SOURCE_MEDIAN PriceSource = 8
+	// PRICE_SOURCE_TWAP uses the time-weighted average price over a lookback duration
+	PriceSource_PRICE_SOURCE_TWAP PriceSource = 9
+)
+
+// Enum value maps for PriceSource.
+var (
+	PriceSource_name = map[int32]string{
+		0: "PRICE_SOURCE_UNSPECIFIED",
+		1: "PRICE_SOURCE_SPOT",
+		2: "PRICE_SOURCE_SMA",
+		3: "PRICE_SOURCE_EMA",
+		4: "PRICE_SOURCE_WMA_BALANCED",
+		5: "PRICE_SOURCE_WMA_RECENT",
+		6: "PRICE_SOURCE_WMA_OLDEST",
+		7: "PRICE_SOURCE_WMA_CUSTOM",
+		8: "PRICE_SOURCE_MEDIAN",
+		9: "PRICE_SOURCE_TWAP",
+	}
+	PriceSource_value = map[string]int32{
+		"PRICE_SOURCE_UNSPECIFIED":  0,
+		"PRICE_SOURCE_SPOT":         1,
+		"PRICE_SOURCE_SMA":          2,
+		"PRICE_SOURCE_EMA":          3,
+		"PRICE_SOURCE_WMA_BALANCED": 4,
+		"PRICE_SOURCE_WMA_RECENT":   5,
+		"PRICE_SOURCE_WMA_OLDEST":   6,
+		"PRICE_SOURCE_WMA_CUSTOM":   7,
+		"PRICE_SOURCE_MEDIAN":       8,
+		"PRICE_SOURCE_TWAP":         9,
+	}
+)
+
+func (x PriceSource) Enum() *PriceSource {
+	p := new(PriceSource)
+	*p = x
+	return p
+}
+
+func (x PriceSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PriceSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_cheqd_oracle_v2_oracle_proto_enumTypes[0].Descriptor()
+}
+
+func (PriceSource) Type() protoreflect.EnumType {
+	return &file_cheqd_oracle_v2_oracle_proto_enumTypes[0]
+}
+
+func (x PriceSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PriceSource.Descriptor instead.
+func (PriceSource) EnumDescriptor() ([]byte, []int) {
+	return file_cheqd_oracle_v2_oracle_proto_rawDescGZIP(), []int{0}
+}
+
 // Params defines the parameters for the oracle module.
 type Params struct {
 	state         protoimpl.MessageState
@@ -7201,6 +11231,23 @@ type Params struct {
 	UsdcIbcDenom    string `protobuf:"bytes,16,opt,name=usdc_ibc_denom,json=usdcIbcDenom,proto3" json:"usdc_ibc_denom,omitempty"`
 	SlashingEnabled bool   `protobuf:"varint,17,opt,name=slashing_enabled,json=slashingEnabled,proto3" json:"slashing_enabled,omitempty"`
 	AveragingWindow uint64 `protobuf:"varint,18,opt,name=averaging_window,json=averagingWindow,proto3" json:"averaging_window,omitempty"`
+	// Max Price Age represents the amount of blocks after which the moving
+	// averages of a denom are considered stale and USD-denominated fee
+	// conversion is frozen. Zero disables the staleness guard.
+	MaxPriceAge uint64 `protobuf:"varint,19,opt,name=max_price_age,json=maxPriceAge,proto3" json:"max_price_age,omitempty"`
+	// Max Price Jump represents the maximum relative change of the fee price
+	// average of a denom between two averaging periods before USD-denominated fee
+	// conversion is frozen. Zero disables the price jump guard.
+	MaxPriceJump string `protobuf:"bytes,20,opt,name=max_price_jump,json=maxPriceJump,proto3" json:"max_price_jump,omitempty"`
+	// TWAP Lookbacks represents the durations the time-weighted average prices
+	// of each denom are computed over, alongside the other moving averages.
+	TwapLookbacks []*durationpb.Duration `protobuf:"bytes,21,rep,name=twap_lookbacks,json=twapLookbacks,proto3" json:"twap_lookbacks,omitempty"`
+	// Fee Price Source represents the moving average identity fees are
+	// converted between ncheq and USD with.
+	FeePriceSource PriceSource `protobuf:"varint,22,opt,name=fee_price_source,json=feePriceSource,proto3,enum=cheqd.oracle.v2.PriceSource" json:"fee_price_source,omitempty"`
+	// Fee TWAP Lookback represents the lookback duration of the time-weighted
+	// average price identity fees are converted with, if it is the fee price source.
+	FeeTwapLookback *durationpb.Duration `protobuf:"bytes,23,opt,name=fee_twap_lookback,json=feeTwapLookback,proto3" json:"fee_twap_lookback,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -7349,6 +11396,102 @@ func (x *Params) GetAveragingWindow() uint64 {
 	return 0
 }
 
+func (x *Params) GetMaxPriceAge() uint64 {
+	if x != nil {
+		return x.MaxPriceAge
+	}
+	return 0
+}
+
+func (x *Params) GetMaxPriceJump() string {
+	if x != nil {
+		return x.MaxPriceJump
+	}
+	return ""
+}
+
+func (x *Params) GetTwapLookbacks() []*durationpb.Duration {
+	if x != nil {
+		return x.TwapLookbacks
+	}
+	return nil
+}
+
+func (x *Params) GetFeePriceSource() PriceSource {
+	if x != nil {
+		return x.FeePriceSource
+	}
+	return PriceSource_PRICE_SOURCE_UNSPECIFIED
+}
+
+func (x *Params) GetFeeTwapLookback() *durationpb.Duration {
+	if x != nil {
+		return x.FeeTwapLookback
+	}
+	return nil
+}
+
+// TwapCheckpoint defines the cumulative price of a denom, in price-seconds,
+// at the time of one of its historic price stamps.
+type TwapCheckpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Price      string                 `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
+	Cumulative string                 `protobuf:"bytes,2,opt,name=cumulative,proto3" json:"cumulative,omitempty"`
+	Height     int64                  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Time       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *TwapCheckpoint) Reset() {
+	*x = TwapCheckpoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TwapCheckpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TwapCheckpoint) ProtoMessage() {}
+
+// Deprecated: Use TwapCheckpoint.ProtoReflect.Descriptor instead.
+func (*TwapCheckpoint) Descriptor() ([]byte, []int) {
+	return file_cheqd_oracle_v2_oracle_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TwapCheckpoint) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *TwapCheckpoint) GetCumulative() string {
+	if x != nil {
+		return x.Cumulative
+	}
+	return ""
+}
+
+func (x *TwapCheckpoint) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *TwapCheckpoint) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
 // Denom - the object to hold configurations of each denom
 type Denom struct {
 	state         protoimpl.MessageState
@@ -7363,7 +11506,7 @@ type Denom struct {
 func (x *Denom) Reset() {
 	*x = Denom{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[1]
+		mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7377,7 +11520,7 @@ func (*Denom) ProtoMessage() {}
 
 // Deprecated: Use Denom.ProtoReflect.Descriptor instead.
 func (*Denom) Descriptor() ([]byte, []int) {
-	return file_cheqd_oracle_v2_oracle_proto_rawDescGZIP(), []int{1}
+	return file_cheqd_oracle_v2_oracle_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Denom) GetBaseDenom() string {
@@ -7414,7 +11557,7 @@ type RewardBand struct {
 func (x *RewardBand) Reset() {
 	*x = RewardBand{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[2]
+		mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7428,7 +11571,7 @@ func (*RewardBand) ProtoMessage() {}
 
 // Deprecated: Use RewardBand.ProtoReflect.Descriptor instead.
 func (*RewardBand) Descriptor() ([]byte, []int) {
-	return file_cheqd_oracle_v2_oracle_proto_rawDescGZIP(), []int{2}
+	return file_cheqd_oracle_v2_oracle_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *RewardBand) GetSymbolDenom() string {
@@ -7463,7 +11606,7 @@ type AggregateExchangeRatePrevote struct {
 func (x *AggregateExchangeRatePrevote) Reset() {
 	*x = AggregateExchangeRatePrevote{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[3]
+		mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7477,7 +11620,7 @@ func (*AggregateExchangeRatePrevote) ProtoMessage() {}
 
 // Deprecated: Use AggregateExchangeRatePrevote.ProtoReflect.Descriptor instead.
 func (*AggregateExchangeRatePrevote) Descriptor() ([]byte, []int) {
-	return file_cheqd_oracle_v2_oracle_proto_rawDescGZIP(), []int{3}
+	return file_cheqd_oracle_v2_oracle_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *AggregateExchangeRatePrevote) GetHash() string {
@@ -7515,7 +11658,7 @@ type AggregateExchangeRateVote struct {
 func (x *AggregateExchangeRateVote) Reset() {
 	*x = AggregateExchangeRateVote{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[4]
+		mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7529,7 +11672,7 @@ func (*AggregateExchangeRateVote) ProtoMessage() {}
 
 // Deprecated: Use AggregateExchangeRateVote.ProtoReflect.Descriptor instead.
 func (*AggregateExchangeRateVote) Descriptor() ([]byte, []int) {
-	return file_cheqd_oracle_v2_oracle_proto_rawDescGZIP(), []int{4}
+	return file_cheqd_oracle_v2_oracle_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *AggregateExchangeRateVote) GetExchangeRates() []*v1beta1.DecCoin {
@@ -7560,7 +11703,7 @@ type PriceStamp struct {
 func (x *PriceStamp) Reset() {
 	*x = PriceStamp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[5]
+		mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7574,7 +11717,7 @@ func (*PriceStamp) ProtoMessage() {}
 
 // Deprecated: Use PriceStamp.ProtoReflect.Descriptor instead.
 func (*PriceStamp) Descriptor() ([]byte, []int) {
-	return file_cheqd_oracle_v2_oracle_proto_rawDescGZIP(), []int{5}
+	return file_cheqd_oracle_v2_oracle_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *PriceStamp) GetExchangeRate() *v1beta1.DecCoin {
@@ -7586,252 +11729,570 @@ func (x *PriceStamp) GetExchangeRate() *v1beta1.DecCoin {
 
 func (x *PriceStamp) GetBlockNum() uint64 {
 	if x != nil {
-		return x.BlockNum
+		return x.BlockNum
+	}
+	return 0
+}
+
+// ValidatorRewardSet is the set of validators that is earning
+// rewards for voting on exchange rates based on their misscounter
+// in a given Slash Window. This set gets updated every Slash Window.
+type ValidatorRewardSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ValidatorSet []string `protobuf:"bytes,1,rep,name=validator_set,json=validatorSet,proto3" json:"validator_set,omitempty"`
+}
+
+func (x *ValidatorRewardSet) Reset() {
+	*x = ValidatorRewardSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorRewardSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorRewardSet) ProtoMessage() {}
+
+// Deprecated: Use ValidatorRewardSet.ProtoReflect.Descriptor instead.
+func (*ValidatorRewardSet) Descriptor() ([]byte, []int) {
+	return file_cheqd_oracle_v2_oracle_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ValidatorRewardSet) GetValidatorSet() []string {
+	if x != nil {
+		return x.ValidatorSet
+	}
+	return nil
+}
+
+// CurrencyPairProviders defines a list of currency providers for the
+// currency pair defined by base and quote.
+type CurrencyPairProviders struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseDenom   string                 `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	QuoteDenom  string                 `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	PairAddress []*PairAddressProvider `protobuf:"bytes,3,rep,name=pair_address,json=pairAddress,proto3" json:"pair_address,omitempty"`
+	Providers   []string               `protobuf:"bytes,4,rep,name=providers,proto3" json:"providers,omitempty"`
+}
+
+func (x *CurrencyPairProviders) Reset() {
+	*x = CurrencyPairProviders{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CurrencyPairProviders) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyPairProviders) ProtoMessage() {}
+
+// Deprecated: Use CurrencyPairProviders.ProtoReflect.Descriptor instead.
+func (*CurrencyPairProviders) Descriptor() ([]byte, []int) {
+	return file_cheqd_oracle_v2_oracle_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CurrencyPairProviders) GetBaseDenom() string {
+	if x != nil {
+		return x.BaseDenom
+	}
+	return ""
+}
+
+func (x *CurrencyPairProviders) GetQuoteDenom() string {
+	if x != nil {
+		return x.QuoteDenom
+	}
+	return ""
+}
+
+func (x *CurrencyPairProviders) GetPairAddress() []*PairAddressProvider {
+	if x != nil {
+		return x.PairAddress
+	}
+	return nil
+}
+
+func (x *CurrencyPairProviders) GetProviders() []string {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+// PairAddressProvider defines the uniswap pair address and provider
+// for uniswap currency pair.
+type PairAddressProvider struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address         string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	AddressProvider string `protobuf:"bytes,2,opt,name=address_provider,json=addressProvider,proto3" json:"address_provider,omitempty"`
+}
+
+func (x *PairAddressProvider) Reset() {
+	*x = PairAddressProvider{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairAddressProvider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairAddressProvider) ProtoMessage() {}
+
+// Deprecated: Use PairAddressProvider.ProtoReflect.Descriptor instead.
+func (*PairAddressProvider) Descriptor() ([]byte, []int) {
+	return file_cheqd_oracle_v2_oracle_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PairAddressProvider) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PairAddressProvider) GetAddressProvider() string {
+	if x != nil {
+		return x.AddressProvider
+	}
+	return ""
+}
+
+// CurrencyDeviationThreshold defines a deviation theshold for a
+// base denom.
+type CurrencyDeviationThreshold struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseDenom string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	Threshold string `protobuf:"bytes,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (x *CurrencyDeviationThreshold) Reset() {
+	*x = CurrencyDeviationThreshold{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CurrencyDeviationThreshold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyDeviationThreshold) ProtoMessage() {}
+
+// Deprecated: Use CurrencyDeviationThreshold.ProtoReflect.Descriptor instead.
+func (*CurrencyDeviationThreshold) Descriptor() ([]byte, []int) {
+	return file_cheqd_oracle_v2_oracle_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CurrencyDeviationThreshold) GetBaseDenom() string {
+	if x != nil {
+		return x.BaseDenom
+	}
+	return ""
+}
+
+func (x *CurrencyDeviationThreshold) GetThreshold() string {
+	if x != nil {
+		return x.Threshold
+	}
+	return ""
+}
+
+// ParamUpdatePlan specifies updates to the Oracle module parameters
+// and at which block height they should occur.
+type ParamUpdatePlan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Store keys of the Oracle params getting updated.
+	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	// The height at which the param update must be performed.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Changes to the oracle parameters.
+	Changes *Params `protobuf:"bytes,3,opt,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *ParamUpdatePlan) Reset() {
+	*x = ParamUpdatePlan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParamUpdatePlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParamUpdatePlan) ProtoMessage() {}
+
+// Deprecated: Use ParamUpdatePlan.ProtoReflect.Descriptor instead.
+func (*ParamUpdatePlan) Descriptor() ([]byte, []int) {
+	return file_cheqd_oracle_v2_oracle_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ParamUpdatePlan) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *ParamUpdatePlan) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ParamUpdatePlan) GetChanges() *Params {
+	if x != nil {
+		return x.Changes
 	}
-	return 0
+	return nil
 }
 
-// ValidatorRewardSet is the set of validators that is earning
-// rewards for voting on exchange rates based on their misscounter
-// in a given Slash Window. This set gets updated every Slash Window.
-type ValidatorRewardSet struct {
+// PriceAverage defines a moving average of a denom's price along with the
+// block it was last computed in.
+type PriceAverage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ValidatorSet []string `protobuf:"bytes,1,rep,name=validator_set,json=validatorSet,proto3" json:"validator_set,omitempty"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	UpdatedHeight int64                  `protobuf:"varint,2,opt,name=updated_height,json=updatedHeight,proto3" json:"updated_height,omitempty"`
+	UpdatedTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_time,json=updatedTime,proto3" json:"updated_time,omitempty"`
 }
 
-func (x *ValidatorRewardSet) Reset() {
-	*x = ValidatorRewardSet{}
+func (x *PriceAverage) Reset() {
+	*x = PriceAverage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[6]
+		mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ValidatorRewardSet) String() string {
+func (x *PriceAverage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidatorRewardSet) ProtoMessage() {}
+func (*PriceAverage) ProtoMessage() {}
 
-// Deprecated: Use ValidatorRewardSet.ProtoReflect.Descriptor instead.
-func (*ValidatorRewardSet) Descriptor() ([]byte, []int) {
-	return file_cheqd_oracle_v2_oracle_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use PriceAverage.ProtoReflect.Descriptor instead.
+func (*PriceAverage) Descriptor() ([]byte, []int) {
+	return file_cheqd_oracle_v2_oracle_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ValidatorRewardSet) GetValidatorSet() []string {
+func (x *PriceAverage) GetValue() string {
 	if x != nil {
-		return x.ValidatorSet
+		return x.Value
+	}
+	return ""
+}
+
+func (x *PriceAverage) GetUpdatedHeight() int64 {
+	if x != nil {
+		return x.UpdatedHeight
+	}
+	return 0
+}
+
+func (x *PriceAverage) GetUpdatedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedTime
 	}
 	return nil
 }
 
-// CurrencyPairProviders defines a list of currency providers for the
-// currency pair defined by base and quote.
-type CurrencyPairProviders struct {
+// PriceCircuitBreaker defines the state of the circuit breaker freezing
+// USD-denominated fee conversion for a denom.
+type PriceCircuitBreaker struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BaseDenom   string                 `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
-	QuoteDenom  string                 `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
-	PairAddress []*PairAddressProvider `protobuf:"bytes,3,rep,name=pair_address,json=pairAddress,proto3" json:"pair_address,omitempty"`
-	Providers   []string               `protobuf:"bytes,4,rep,name=providers,proto3" json:"providers,omitempty"`
+	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Tripped bool   `protobuf:"varint,2,opt,name=tripped,proto3" json:"tripped,omitempty"`
+	// reason the breaker tripped, either "stale_price" or "price_jump"
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// height and time the breaker last tripped or reset at
+	Height int64                  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Time   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=time,proto3" json:"time,omitempty"`
 }
 
-func (x *CurrencyPairProviders) Reset() {
-	*x = CurrencyPairProviders{}
+func (x *PriceCircuitBreaker) Reset() {
+	*x = PriceCircuitBreaker{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[7]
+		mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CurrencyPairProviders) String() string {
+func (x *PriceCircuitBreaker) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CurrencyPairProviders) ProtoMessage() {}
+func (*PriceCircuitBreaker) ProtoMessage() {}
 
-// Deprecated: Use CurrencyPairProviders.ProtoReflect.Descriptor instead.
-func (*CurrencyPairProviders) Descriptor() ([]byte, []int) {
-	return file_cheqd_oracle_v2_oracle_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use PriceCircuitBreaker.ProtoReflect.Descriptor instead.
+func (*PriceCircuitBreaker) Descriptor() ([]byte, []int) {
+	return file_cheqd_oracle_v2_oracle_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CurrencyPairProviders) GetBaseDenom() string {
+func (x *PriceCircuitBreaker) GetDenom() string {
 	if x != nil {
-		return x.BaseDenom
+		return x.Denom
 	}
 	return ""
 }
 
-func (x *CurrencyPairProviders) GetQuoteDenom() string {
+func (x *PriceCircuitBreaker) GetTripped() bool {
 	if x != nil {
-		return x.QuoteDenom
+		return x.Tripped
+	}
+	return false
+}
+
+func (x *PriceCircuitBreaker) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *CurrencyPairProviders) GetPairAddress() []*PairAddressProvider {
+func (x *PriceCircuitBreaker) GetHeight() int64 {
 	if x != nil {
-		return x.PairAddress
+		return x.Height
 	}
-	return nil
+	return 0
 }
 
-func (x *CurrencyPairProviders) GetProviders() []string {
+func (x *PriceCircuitBreaker) GetTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Providers
+		return x.Time
 	}
 	return nil
 }
 
-// PairAddressProvider defines the uniswap pair address and provider
-// for uniswap currency pair.
-type PairAddressProvider struct {
+// ValidatorOracleWindow defines the oracle performance of a validator over a
+// slash window, and whether it was slashed at the end of it.
+type ValidatorOracleWindow struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address         string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	AddressProvider string `protobuf:"bytes,2,opt,name=address_provider,json=addressProvider,proto3" json:"address_provider,omitempty"`
-}
-
-func (x *PairAddressProvider) Reset() {
-	*x = PairAddressProvider{}
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// Last block of the slash window
+	WindowEndHeight uint64 `protobuf:"varint,2,opt,name=window_end_height,json=windowEndHeight,proto3" json:"window_end_height,omitempty"`
+	// Number of votes missed over the slash window
+	MissCount uint64 `protobuf:"varint,3,opt,name=miss_count,json=missCount,proto3" json:"miss_count,omitempty"`
+	// Number of votes which could be won over the slash window
+	PossibleWins int64 `protobuf:"varint,4,opt,name=possible_wins,json=possibleWins,proto3" json:"possible_wins,omitempty"`
+	// Share of the possible wins which were not missed
+	ValidRate string `protobuf:"bytes,5,opt,name=valid_rate,json=validRate,proto3" json:"valid_rate,omitempty"`
+	// Oracle rewards allocated to the validator over the slash window
+	Rewards []*v1beta1.Coin `protobuf:"bytes,6,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	// Whether the validator was slashed and jailed at the end of the slash window
+	Slashed bool `protobuf:"varint,7,opt,name=slashed,proto3" json:"slashed,omitempty"`
+}
+
+func (x *ValidatorOracleWindow) Reset() {
+	*x = ValidatorOracleWindow{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[8]
+		mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PairAddressProvider) String() string {
+func (x *ValidatorOracleWindow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PairAddressProvider) ProtoMessage() {}
+func (*ValidatorOracleWindow) ProtoMessage() {}
 
-// Deprecated: Use PairAddressProvider.ProtoReflect.Descriptor instead.
-func (*PairAddressProvider) Descriptor() ([]byte, []int) {
-	return file_cheqd_oracle_v2_oracle_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ValidatorOracleWindow.ProtoReflect.Descriptor instead.
+func (*ValidatorOracleWindow) Descriptor() ([]byte, []int) {
+	return file_cheqd_oracle_v2_oracle_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *PairAddressProvider) GetAddress() string {
+func (x *ValidatorOracleWindow) GetValidatorAddress() string {
 	if x != nil {
-		return x.Address
+		return x.ValidatorAddress
 	}
 	return ""
 }
 
-func (x *PairAddressProvider) GetAddressProvider() string {
+func (x *ValidatorOracleWindow) GetWindowEndHeight() uint64 {
 	if x != nil {
-		return x.AddressProvider
+		return x.WindowEndHeight
+	}
+	return 0
+}
+
+func (x *ValidatorOracleWindow) GetMissCount() uint64 {
+	if x != nil {
+		return x.MissCount
+	}
+	return 0
+}
+
+func (x *ValidatorOracleWindow) GetPossibleWins() int64 {
+	if x != nil {
+		return x.PossibleWins
+	}
+	return 0
+}
+
+func (x *ValidatorOracleWindow) GetValidRate() string {
+	if x != nil {
+		return x.ValidRate
 	}
 	return ""
 }
 
-// CurrencyDeviationThreshold defines a deviation theshold for a
-// base denom.
-type CurrencyDeviationThreshold struct {
+func (x *ValidatorOracleWindow) GetRewards() []*v1beta1.Coin {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+func (x *ValidatorOracleWindow) GetSlashed() bool {
+	if x != nil {
+		return x.Slashed
+	}
+	return false
+}
+
+// SlashWindowSummary defines the outcome of a slash window across all validators.
+type SlashWindowSummary struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BaseDenom string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
-	Threshold string `protobuf:"bytes,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
-}
-
-func (x *CurrencyDeviationThreshold) Reset() {
-	*x = CurrencyDeviationThreshold{}
+	WindowStartHeight uint64 `protobuf:"varint,1,opt,name=window_start_height,json=windowStartHeight,proto3" json:"window_start_height,omitempty"`
+	WindowEndHeight   uint64 `protobuf:"varint,2,opt,name=window_end_height,json=windowEndHeight,proto3" json:"window_end_height,omitempty"`
+	PossibleWins      int64  `protobuf:"varint,3,opt,name=possible_wins,json=possibleWins,proto3" json:"possible_wins,omitempty"`
+	// Valid rate below which validators are slashed, if slashing is enabled
+	MinValidPerWindow string `protobuf:"bytes,4,opt,name=min_valid_per_window,json=minValidPerWindow,proto3" json:"min_valid_per_window,omitempty"`
+	SlashFraction     string `protobuf:"bytes,5,opt,name=slash_fraction,json=slashFraction,proto3" json:"slash_fraction,omitempty"`
+	SlashingEnabled   bool   `protobuf:"varint,6,opt,name=slashing_enabled,json=slashingEnabled,proto3" json:"slashing_enabled,omitempty"`
+	// Number of validators which missed votes or were rewarded over the slash window
+	Validators        uint32 `protobuf:"varint,7,opt,name=validators,proto3" json:"validators,omitempty"`
+	SlashedValidators uint32 `protobuf:"varint,8,opt,name=slashed_validators,json=slashedValidators,proto3" json:"slashed_validators,omitempty"`
+	// Oracle rewards allocated to all validators over the slash window
+	Rewards []*v1beta1.Coin `protobuf:"bytes,9,rep,name=rewards,proto3" json:"rewards,omitempty"`
+}
+
+func (x *SlashWindowSummary) Reset() {
+	*x = SlashWindowSummary{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[9]
+		mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CurrencyDeviationThreshold) String() string {
+func (x *SlashWindowSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CurrencyDeviationThreshold) ProtoMessage() {}
+func (*SlashWindowSummary) ProtoMessage() {}
 
-// Deprecated: Use CurrencyDeviationThreshold.ProtoReflect.Descriptor instead.
-func (*CurrencyDeviationThreshold) Descriptor() ([]byte, []int) {
-	return file_cheqd_oracle_v2_oracle_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use SlashWindowSummary.ProtoReflect.Descriptor instead.
+func (*SlashWindowSummary) Descriptor() ([]byte, []int) {
+	return file_cheqd_oracle_v2_oracle_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *CurrencyDeviationThreshold) GetBaseDenom() string {
+func (x *SlashWindowSummary) GetWindowStartHeight() uint64 {
 	if x != nil {
-		return x.BaseDenom
+		return x.WindowStartHeight
 	}
-	return ""
+	return 0
 }
 
-func (x *CurrencyDeviationThreshold) GetThreshold() string {
+func (x *SlashWindowSummary) GetWindowEndHeight() uint64 {
 	if x != nil {
-		return x.Threshold
+		return x.WindowEndHeight
 	}
-	return ""
+	return 0
 }
 
-// ParamUpdatePlan specifies updates to the Oracle module parameters
-// and at which block height they should occur.
-type ParamUpdatePlan struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Store keys of the Oracle params getting updated.
-	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
-	// The height at which the param update must be performed.
-	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
-	// Changes to the oracle parameters.
-	Changes *Params `protobuf:"bytes,3,opt,name=changes,proto3" json:"changes,omitempty"`
+func (x *SlashWindowSummary) GetPossibleWins() int64 {
+	if x != nil {
+		return x.PossibleWins
+	}
+	return 0
 }
 
-func (x *ParamUpdatePlan) Reset() {
-	*x = ParamUpdatePlan{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cheqd_oracle_v2_oracle_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SlashWindowSummary) GetMinValidPerWindow() string {
+	if x != nil {
+		return x.MinValidPerWindow
 	}
+	return ""
 }
 
-func (x *ParamUpdatePlan) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SlashWindowSummary) GetSlashFraction() string {
+	if x != nil {
+		return x.SlashFraction
+	}
+	return ""
 }
 
-func (*ParamUpdatePlan) ProtoMessage() {}
-
-// Deprecated: Use ParamUpdatePlan.ProtoReflect.Descriptor instead.
-func (*ParamUpdatePlan) Descriptor() ([]byte, []int) {
-	return file_cheqd_oracle_v2_oracle_proto_rawDescGZIP(), []int{10}
+func (x *SlashWindowSummary) GetSlashingEnabled() bool {
+	if x != nil {
+		return x.SlashingEnabled
+	}
+	return false
 }
 
-func (x *ParamUpdatePlan) GetKeys() []string {
+func (x *SlashWindowSummary) GetValidators() uint32 {
 	if x != nil {
-		return x.Keys
+		return x.Validators
 	}
-	return nil
+	return 0
 }
 
-func (x *ParamUpdatePlan) GetHeight() int64 {
+func (x *SlashWindowSummary) GetSlashedValidators() uint32 {
 	if x != nil {
-		return x.Height
+		return x.SlashedValidators
 	}
 	return 0
 }
 
-func (x *ParamUpdatePlan) GetChanges() *Params {
+func (x *SlashWindowSummary) GetRewards() []*v1beta1.Coin {
 	if x != nil {
-		return x.Changes
+		return x.Rewards
 	}
 	return nil
 }
@@ -7844,223 +12305,371 @@ var file_cheqd_oracle_v2_oracle_proto_rawDesc = []byte{
 	0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x1a,
 	0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62,
 	0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x95, 0x0c, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x12, 0x37, 0x0a, 0x0b, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x16, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
-	0x22, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0x52, 0x0a, 0x76,
-	0x6f, 0x74, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x63, 0x0a, 0x0e, 0x76, 0x6f, 0x74,
-	0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67,
-	0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
-	0x76, 0x6f, 0x74, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x52,
-	0x0d, 0x76, 0x6f, 0x74, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x6d,
-	0x0a, 0x0c, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x62, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x6f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x61, 0x6e,
-	0x64, 0x42, 0x2d, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
-	0x22, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x62, 0x61, 0x6e, 0x64, 0x73, 0x22, 0xaa, 0xdf,
-	0x1f, 0x0e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x61, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x0b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x63, 0x0a,
-	0x1a, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x04, 0x42, 0x25, 0xf2, 0xde, 0x1f, 0x21, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x77,
+	0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xf7, 0x0e, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x37, 0x0a, 0x0b,
+	0x76, 0x6f, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x16, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x6f, 0x74,
+	0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0x52, 0x0a, 0x76, 0x6f, 0x74, 0x65, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x63, 0x0a, 0x0e, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x6f, 0x74, 0x65,
+	0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x52, 0x0d, 0x76, 0x6f, 0x74,
+	0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x6d, 0x0a, 0x0c, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x5f, 0x62, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x76, 0x32, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x61, 0x6e, 0x64, 0x42, 0x2d, 0xc8,
+	0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x5f, 0x62, 0x61, 0x6e, 0x64, 0x73, 0x22, 0xaa, 0xdf, 0x1f, 0x0e, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x42, 0x61, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0b, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x42, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x63, 0x0a, 0x1a, 0x72, 0x65, 0x77,
 	0x61, 0x72, 0x64, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x52, 0x18, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x64,
-	0x6f, 0x77, 0x12, 0x60, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x6c, 0x69, 0x73,
-	0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x42,
-	0x27, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61,
-	0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x22, 0xaa, 0xdf, 0x1f, 0x09, 0x44,
-	0x65, 0x6e, 0x6f, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x63, 0x0a, 0x0e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x66, 0x72,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde,
-	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
-	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
-	0x63, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x6c, 0x61, 0x73, 0x68,
-	0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x0d, 0x73, 0x6c, 0x61, 0x73,
-	0x68, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0c, 0x73, 0x6c, 0x61,
-	0x73, 0x68, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x42,
-	0x17, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x6c, 0x61, 0x73, 0x68,
-	0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x52, 0x0b, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x57,
-	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x73, 0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x42, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c,
-	0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x1b, 0x79, 0x61, 0x6d, 0x6c,
-	0x3a, 0x22, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f,
-	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x50, 0x65, 0x72, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x69, 0x0a, 0x0e, 0x6d, 0x61,
-	0x6e, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x09, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x42, 0x2a, 0xc8, 0xde, 0x1f, 0x00,
-	0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x22, 0xaa, 0xdf, 0x1f, 0x09, 0x44, 0x65, 0x6e,
-	0x6f, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0d, 0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69,
-	0x63, 0x5f, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x53, 0x74,
-	0x61, 0x6d, 0x70, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x65, 0x64,
-	0x69, 0x61, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x53, 0x74,
-	0x61, 0x6d, 0x70, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x61, 0x78,
-	0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d,
-	0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6d,
-	0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x5f, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x69,
-	0x6d, 0x75, 0x6d, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x12,
-	0xa3, 0x01, 0x0a, 0x17, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x61, 0x69,
-	0x72, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x26, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x2e, 0x76, 0x32, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x42, 0x43, 0xc8, 0xde, 0x1f, 0x00, 0xf2,
-	0xde, 0x1f, 0x1e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
-	0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
-	0x22, 0xaa, 0xdf, 0x1f, 0x19, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69,
-	0x72, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x15,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x50, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0xbf, 0x01, 0x0a, 0x1d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x68, 0x72,
-	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
-	0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e,
-	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x42, 0x4e, 0xc8, 0xde, 0x1f, 0x00,
-	0xf2, 0xde, 0x1f, 0x24, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x68, 0x72,
-	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x22, 0xaa, 0xdf, 0x1f, 0x1e, 0x43, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x63, 0x79, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x68, 0x72,
-	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x1b, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x63, 0x79, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x68, 0x72,
-	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x75, 0x73, 0x64, 0x63, 0x5f,
-	0x69, 0x62, 0x63, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x75, 0x73, 0x64, 0x63, 0x49, 0x62, 0x63, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x29, 0x0a,
-	0x10, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e,
-	0x67, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x76, 0x65, 0x72,
-	0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x12, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x6e,
-	0x64, 0x6f, 0x77, 0x3a, 0x08, 0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb8, 0x01,
-	0x0a, 0x05, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x34, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f,
-	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0xf2, 0xde, 0x1f,
-	0x11, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f,
-	0x6d, 0x22, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x3a, 0x0a,
-	0x0c, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x17, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73,
-	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x0b, 0x73, 0x79,
-	0x6d, 0x62, 0x6f, 0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x2f, 0x0a, 0x08, 0x65, 0x78, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x13, 0xf2, 0xde, 0x1f,
-	0x0f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x22,
-	0x52, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x3a, 0x0c, 0x88, 0xa0, 0x1f, 0x00,
-	0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xb2, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x42, 0x61, 0x6e, 0x64, 0x12, 0x3a, 0x0a, 0x0c, 0x73, 0x79, 0x6d, 0x62, 0x6f,
-	0x6c, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xf2,
-	0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f,
-	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x44, 0x65,
-	0x6e, 0x6f, 0x6d, 0x12, 0x5a, 0x0a, 0x0b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x62, 0x61,
-	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x39, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x25, 0xf2,
+	0xde, 0x1f, 0x21, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f,
+	0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x22, 0x52, 0x18, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x44, 0x69, 0x73, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x60,
+	0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x42, 0x27, 0xc8, 0xde, 0x1f,
+	0x00, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x22, 0xaa, 0xdf, 0x1f, 0x09, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x63, 0x0a, 0x0e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
 	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
 	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f,
-	0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x62, 0x61,
-	0x6e, 0x64, 0x22, 0x52, 0x0a, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x61, 0x6e, 0x64, 0x3a,
-	0x0c, 0x88, 0xa0, 0x1f, 0x00, 0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xb5, 0x01,
-	0x0a, 0x1c, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x45, 0x78, 0x63, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x12, 0x23,
-	0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xf2, 0xde,
-	0x1f, 0x0b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x68, 0x61, 0x73, 0x68, 0x22, 0x52, 0x04, 0x68,
-	0x61, 0x73, 0x68, 0x12, 0x26, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x10, 0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x6f,
-	0x74, 0x65, 0x72, 0x22, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x0c, 0x73,
-	0x75, 0x62, 0x6d, 0x69, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x42, 0x17, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x75, 0x62,
-	0x6d, 0x69, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d,
-	0x69, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x0c, 0x88, 0xa0, 0x1f, 0x00, 0x98, 0xa0, 0x1f,
-	0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xcb, 0x01, 0x0a, 0x19, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
-	0x61, 0x74, 0x65, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x56,
-	0x6f, 0x74, 0x65, 0x12, 0x78, 0x0a, 0x0e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f,
-	0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x33, 0xc8, 0xde, 0x1f, 0x00, 0xaa,
-	0xdf, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0d,
-	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x26, 0x0a,
-	0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xf2, 0xde,
-	0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x22, 0x52, 0x05,
-	0x76, 0x6f, 0x74, 0x65, 0x72, 0x3a, 0x0c, 0x88, 0xa0, 0x1f, 0x00, 0x98, 0xa0, 0x1f, 0x00, 0xe8,
-	0xa0, 0x1f, 0x00, 0x22, 0x6c, 0x0a, 0x0a, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x6d,
-	0x70, 0x12, 0x41, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61,
-	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44,
-	0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x52, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75,
-	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75,
-	0x6d, 0x22, 0x53, 0x0a, 0x12, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x53, 0x65, 0x74, 0x12, 0x3d, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18,
-	0xf2, 0xde, 0x1f, 0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x22, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x22, 0xae, 0x02, 0x0a, 0x15, 0x43, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
-	0x12, 0x34, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0xf2, 0xde, 0x1f, 0x11, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
-	0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x09, 0x62, 0x61, 0x73,
-	0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x37, 0x0a, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f,
-	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0xf2, 0xde, 0x1f,
-	0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x64, 0x65, 0x6e,
-	0x6f, 0x6d, 0x22, 0x52, 0x0a, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12,
-	0x64, 0x0a, 0x0c, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x6f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x42, 0x1b, 0xc8, 0xde, 0x1f,
-	0x00, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x61, 0x69, 0x72, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x52, 0x0b, 0x70, 0x61, 0x69, 0x72, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x14, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61,
-	0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x22, 0x52, 0x09,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x3a, 0x0c, 0x88, 0xa0, 0x1f, 0x00, 0x98,
-	0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x8b, 0x01, 0x0a, 0x13, 0x50, 0x61, 0x69, 0x72,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12,
-	0x2c, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x12, 0xf2, 0xde, 0x1f, 0x0e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x22, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x46, 0x0a,
-	0x10, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d,
-	0x6c, 0x3a, 0x22, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x22, 0x52, 0x0f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0x94, 0x01, 0x0a, 0x1a, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x73,
-	0x68, 0x6f, 0x6c, 0x64, 0x12, 0x34, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e,
-	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0xf2, 0xde, 0x1f, 0x11, 0x79, 0x61,
-	0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52,
-	0x09, 0x62, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x32, 0x0a, 0x09, 0x74, 0x68,
-	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xf2,
-	0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
-	0x6c, 0x64, 0x22, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x3a, 0x0c,
-	0x88, 0xa0, 0x1f, 0x00, 0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x84, 0x01, 0x0a,
-	0x0f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e,
-	0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
-	0x6b, 0x65, 0x79, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x37, 0x0a, 0x07,
-	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x66, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x0d, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x46, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0c, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x42, 0x17, 0xf2, 0xde, 0x1f,
+	0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x22, 0x52, 0x0b, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x12, 0x73, 0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x42, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x1b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x69,
+	0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x22, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x65, 0x72,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x69, 0x0a, 0x0e, 0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x32,
+	0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x42, 0x2a, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x15,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x22, 0xaa, 0xdf, 0x1f, 0x09, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x0d, 0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x32, 0x0a, 0x15, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x5f, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x13, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x5f,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x11, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d,
+	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x69, 0x6d,
+	0x75, 0x6d, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x4d,
+	0x65, 0x64, 0x69, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x12, 0xa3, 0x01, 0x0a, 0x17,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
 	0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x63, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x73, 0x3a, 0x0c, 0x88, 0xa0, 0x1f, 0x00, 0x98, 0xa0, 0x1f, 0x00, 0xe8,
-	0xa0, 0x1f, 0x01, 0x42, 0xc1, 0x01, 0xc8, 0xe1, 0x1e, 0x00, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e,
-	0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x42,
-	0x0b, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3b,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x68, 0x65, 0x71, 0x64,
-	0x2f, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2d, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x32, 0x2f, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f,
-	0x76, 0x32, 0x3b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x76, 0x32, 0xa2, 0x02, 0x03, 0x43, 0x4f,
-	0x58, 0xaa, 0x02, 0x0f, 0x43, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x2e, 0x56, 0x32, 0xca, 0x02, 0x0f, 0x43, 0x68, 0x65, 0x71, 0x64, 0x5c, 0x4f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x5c, 0x56, 0x32, 0xe2, 0x02, 0x1b, 0x43, 0x68, 0x65, 0x71, 0x64, 0x5c, 0x4f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x32, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x43, 0x68, 0x65, 0x71, 0x64, 0x3a, 0x3a, 0x4f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x73, 0x42, 0x43, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x1e, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x61,
+	0x69, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x22, 0xaa, 0xdf, 0x1f,
+	0x19, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x15, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x12, 0xbf, 0x01, 0x0a, 0x1d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x64,
+	0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x68, 0x65, 0x71,
+	0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x42, 0x4e, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x24,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x64,
+	0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x73, 0x22, 0xaa, 0xdf, 0x1f, 0x1e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x1b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x75, 0x73, 0x64, 0x63, 0x5f, 0x69, 0x62, 0x63, 0x5f,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x73, 0x64,
+	0x63, 0x49, 0x62, 0x63, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x6c, 0x61,
+	0x73, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x69, 0x6e,
+	0x67, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
+	0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12,
+	0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x67, 0x65,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x41, 0x67, 0x65, 0x12, 0x57, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x5f, 0x6a, 0x75, 0x6d, 0x70, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0c,
+	0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4a, 0x75, 0x6d, 0x70, 0x12, 0x4a, 0x0a, 0x0e,
+	0x74, 0x77, 0x61, 0x70, 0x5f, 0x6c, 0x6f, 0x6f, 0x6b, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x15,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x0d, 0x74, 0x77, 0x61, 0x70, 0x4c,
+	0x6f, 0x6f, 0x6b, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x46, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x16, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x0e, 0x66, 0x65, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x4f, 0x0a, 0x11, 0x66, 0x65, 0x65, 0x5f, 0x74, 0x77, 0x61, 0x70, 0x5f, 0x6c, 0x6f, 0x6f,
+	0x6b, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01,
+	0x52, 0x0f, 0x66, 0x65, 0x65, 0x54, 0x77, 0x61, 0x70, 0x4c, 0x6f, 0x6f, 0x6b, 0x62, 0x61, 0x63,
+	0x6b, 0x3a, 0x08, 0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xfe, 0x01, 0x0a, 0x0e,
+	0x54, 0x77, 0x61, 0x70, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x47,
+	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63,
+	0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x51, 0x0a, 0x0a, 0x63, 0x75, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0a,
+	0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x38, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde,
+	0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xb8, 0x01, 0x0a,
+	0x05, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x34, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0xf2, 0xde, 0x1f, 0x11,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x22, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x3a, 0x0a, 0x0c,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x17, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x0b, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x2f, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x13, 0xf2, 0xde, 0x1f, 0x0f,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x22, 0x52,
+	0x08, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x3a, 0x0c, 0x88, 0xa0, 0x1f, 0x00, 0x98,
+	0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xb2, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x42, 0x61, 0x6e, 0x64, 0x12, 0x3a, 0x0a, 0x0c, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xf2, 0xde,
+	0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x44, 0x65, 0x6e,
+	0x6f, 0x6d, 0x12, 0x5a, 0x0a, 0x0b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x62, 0x61, 0x6e,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x39, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x12,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x62, 0x61, 0x6e,
+	0x64, 0x22, 0x52, 0x0a, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x0c,
+	0x88, 0xa0, 0x1f, 0x00, 0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xb5, 0x01, 0x0a,
+	0x1c, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x12, 0x23, 0x0a,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xf2, 0xde, 0x1f,
+	0x0b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x68, 0x61, 0x73, 0x68, 0x22, 0x52, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x12, 0x26, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x10, 0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x6f, 0x74,
+	0x65, 0x72, 0x22, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x0c, 0x73, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x17, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x0c, 0x88, 0xa0, 0x1f, 0x00, 0x98, 0xa0, 0x1f, 0x00,
+	0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xcb, 0x01, 0x0a, 0x19, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x56, 0x6f,
+	0x74, 0x65, 0x12, 0x78, 0x0a, 0x0e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x33, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf,
+	0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0d, 0x65,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x05,
+	0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xf2, 0xde, 0x1f,
+	0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x22, 0x52, 0x05, 0x76,
+	0x6f, 0x74, 0x65, 0x72, 0x3a, 0x0c, 0x88, 0xa0, 0x1f, 0x00, 0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0,
+	0x1f, 0x00, 0x22, 0x6c, 0x0a, 0x0a, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x41, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65,
+	0x63, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d,
+	0x22, 0x53, 0x0a, 0x12, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x53, 0x65, 0x74, 0x12, 0x3d, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xf2,
+	0xde, 0x1f, 0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x22, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x53, 0x65, 0x74, 0x22, 0xae, 0x02, 0x0a, 0x15, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12,
+	0x34, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x15, 0xf2, 0xde, 0x1f, 0x11, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62,
+	0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65,
+	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x37, 0x0a, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0xf2, 0xde, 0x1f, 0x12,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x22, 0x52, 0x0a, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x64,
+	0x0a, 0x0c, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x42, 0x1b, 0xc8, 0xde, 0x1f, 0x00,
+	0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x52, 0x0b, 0x70, 0x61, 0x69, 0x72, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x14, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x22, 0x52, 0x09, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x3a, 0x0c, 0x88, 0xa0, 0x1f, 0x00, 0x98, 0xa0,
+	0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x8b, 0x01, 0x0a, 0x13, 0x50, 0x61, 0x69, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x2c,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x12, 0xf2, 0xde, 0x1f, 0x0e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x22, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x46, 0x0a, 0x10,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x22, 0x52, 0x0f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x22, 0x94, 0x01, 0x0a, 0x1a, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x12, 0x34, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0xf2, 0xde, 0x1f, 0x11, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x09,
+	0x62, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x32, 0x0a, 0x09, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xf2, 0xde,
+	0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x22, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x3a, 0x0c, 0x88,
+	0xa0, 0x1f, 0x00, 0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x84, 0x01, 0x0a, 0x0f,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b,
+	0x65, 0x79, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63,
+	0x68, 0x65, 0x71, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x3a, 0x0c, 0x88, 0xa0, 0x1f, 0x00, 0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0,
+	0x1f, 0x01, 0x22, 0xc7, 0x01, 0x0a, 0x0c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x76, 0x65, 0x72,
+	0x61, 0x67, 0x65, 0x12, 0x47, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x47, 0x0a, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52,
+	0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xaf, 0x01, 0x0a,
+	0x13, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65,
+	0x61, 0x6b, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72,
+	0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x74, 0x72, 0x69,
+	0x70, 0x70, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x38, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08,
+	0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xaa,
+	0x03, 0x0a, 0x15, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x4e, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x45, 0x6e, 0x64, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x69, 0x73, 0x73, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f,
+	0x77, 0x69, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x6f, 0x73, 0x73,
+	0x69, 0x62, 0x6c, 0x65, 0x57, 0x69, 0x6e, 0x73, 0x12, 0x50, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
+	0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52,
+	0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x61, 0x74, 0x65, 0x12, 0x65, 0x0a, 0x07, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x22, 0xb4, 0x04, 0x0a, 0x12,
+	0x53, 0x6c, 0x61, 0x73, 0x68, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x11, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x65, 0x6e, 0x64,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x45, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x70, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x57,
+	0x69, 0x6e, 0x73, 0x12, 0x62, 0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x65,
+	0x72, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x58, 0x0a, 0x0e, 0x73, 0x6c, 0x61, 0x73, 0x68,
+	0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
+	0x65, 0x63, 0x52, 0x0d, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x73, 0x6c, 0x61,
+	0x73, 0x68, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x2d, 0x0a, 0x12,
+	0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65,
+	0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x65, 0x0a, 0x07, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f,
+	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x73, 0x2a, 0x94, 0x02, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x4f, 0x55, 0x52,
+	0x43, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x15, 0x0a, 0x11, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
+	0x5f, 0x53, 0x50, 0x4f, 0x54, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x52, 0x49, 0x43, 0x45,
+	0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x53, 0x4d, 0x41, 0x10, 0x02, 0x12, 0x14, 0x0a,
+	0x10, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x45, 0x4d,
+	0x41, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x4f, 0x55,
+	0x52, 0x43, 0x45, 0x5f, 0x57, 0x4d, 0x41, 0x5f, 0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45, 0x44,
+	0x10, 0x04, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x4f, 0x55, 0x52,
+	0x43, 0x45, 0x5f, 0x57, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x4e, 0x54, 0x10, 0x05, 0x12,
+	0x1b, 0x0a, 0x17, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f,
+	0x57, 0x4d, 0x41, 0x5f, 0x4f, 0x4c, 0x44, 0x45, 0x53, 0x54, 0x10, 0x06, 0x12, 0x1b, 0x0a, 0x17,
+	0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x57, 0x4d, 0x41,
+	0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x10, 0x07, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x52, 0x49,
+	0x43, 0x45, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x4e,
+	0x10, 0x08, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x4f, 0x55, 0x52,
+	0x43, 0x45, 0x5f, 0x54, 0x57, 0x41, 0x50, 0x10, 0x09, 0x42, 0xc1, 0x01, 0xc8, 0xe1, 0x1e, 0x00,
+	0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x76, 0x32, 0x42, 0x0b, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2f, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2d, 0x6e, 0x6f, 0x64,
+	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2f, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x32, 0x3b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x76,
+	0x32, 0xa2, 0x02, 0x03, 0x43, 0x4f, 0x58, 0xaa, 0x02, 0x0f, 0x43, 0x68, 0x65, 0x71, 0x64, 0x2e,
+	0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x32, 0xca, 0x02, 0x0f, 0x43, 0x68, 0x65, 0x71,
+	0x64, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x32, 0xe2, 0x02, 0x1b, 0x43, 0x68,
+	0x65, 0x71, 0x64, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x32, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x43, 0x68, 0x65, 0x71,
+	0x64, 0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x32, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -8075,36 +12684,54 @@ func file_cheqd_oracle_v2_oracle_proto_rawDescGZIP() []byte {
 	return file_cheqd_oracle_v2_oracle_proto_rawDescData
 }
 
-var file_cheqd_oracle_v2_oracle_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_cheqd_oracle_v2_oracle_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cheqd_oracle_v2_oracle_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_cheqd_oracle_v2_oracle_proto_goTypes = []interface{}{
-	(*Params)(nil),                       // 0: cheqd.oracle.v2.Params
-	(*Denom)(nil),                        // 1: cheqd.oracle.v2.Denom
-	(*RewardBand)(nil),                   // 2: cheqd.oracle.v2.RewardBand
-	(*AggregateExchangeRatePrevote)(nil), // 3: cheqd.oracle.v2.AggregateExchangeRatePrevote
-	(*AggregateExchangeRateVote)(nil),    // 4: cheqd.oracle.v2.AggregateExchangeRateVote
-	(*PriceStamp)(nil),                   // 5: cheqd.oracle.v2.PriceStamp
-	(*ValidatorRewardSet)(nil),           // 6: cheqd.oracle.v2.ValidatorRewardSet
-	(*CurrencyPairProviders)(nil),        // 7: cheqd.oracle.v2.CurrencyPairProviders
-	(*PairAddressProvider)(nil),          // 8: cheqd.oracle.v2.PairAddressProvider
-	(*CurrencyDeviationThreshold)(nil),   // 9: cheqd.oracle.v2.CurrencyDeviationThreshold
-	(*ParamUpdatePlan)(nil),              // 10: cheqd.oracle.v2.ParamUpdatePlan
-	(*v1beta1.DecCoin)(nil),              // 11: cosmos.base.v1beta1.DecCoin
+	(PriceSource)(0),                     // 0: cheqd.oracle.v2.PriceSource
+	(*Params)(nil),                       // 1: cheqd.oracle.v2.Params
+	(*TwapCheckpoint)(nil),               // 2: cheqd.oracle.v2.TwapCheckpoint
+	(*Denom)(nil),                        // 3: cheqd.oracle.v2.Denom
+	(*RewardBand)(nil),                   // 4: cheqd.oracle.v2.RewardBand
+	(*AggregateExchangeRatePrevote)(nil), // 5: cheqd.oracle.v2.AggregateExchangeRatePrevote
+	(*AggregateExchangeRateVote)(nil),    // 6: cheqd.oracle.v2.AggregateExchangeRateVote
+	(*PriceStamp)(nil),                   // 7: cheqd.oracle.v2.PriceStamp
+	(*ValidatorRewardSet)(nil),           // 8: cheqd.oracle.v2.ValidatorRewardSet
+	(*CurrencyPairProviders)(nil),        // 9: cheqd.oracle.v2.CurrencyPairProviders
+	(*PairAddressProvider)(nil),          // 10: cheqd.oracle.v2.PairAddressProvider
+	(*CurrencyDeviationThreshold)(nil),   // 11: cheqd.oracle.v2.CurrencyDeviationThreshold
+	(*ParamUpdatePlan)(nil),              // 12: cheqd.oracle.v2.ParamUpdatePlan
+	(*PriceAverage)(nil),                 // 13: cheqd.oracle.v2.PriceAverage
+	(*PriceCircuitBreaker)(nil),          // 14: cheqd.oracle.v2.PriceCircuitBreaker
+	(*ValidatorOracleWindow)(nil),        // 15: cheqd.oracle.v2.ValidatorOracleWindow
+	(*SlashWindowSummary)(nil),           // 16: cheqd.oracle.v2.SlashWindowSummary
+	(*durationpb.Duration)(nil),          // 17: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),        // 18: google.protobuf.Timestamp
+	(*v1beta1.DecCoin)(nil),              // 19: cosmos.base.v1beta1.DecCoin
+	(*v1beta1.Coin)(nil),                 // 20: cosmos.base.v1beta1.Coin
 }
 var file_cheqd_oracle_v2_oracle_proto_depIdxs = []int32{
-	2,  // 0: cheqd.oracle.v2.Params.reward_bands:type_name -> cheqd.oracle.v2.RewardBand
-	1,  // 1: cheqd.oracle.v2.Params.accept_list:type_name -> cheqd.oracle.v2.Denom
-	1,  // 2: cheqd.oracle.v2.Params.mandatory_list:type_name -> cheqd.oracle.v2.Denom
-	7,  // 3: cheqd.oracle.v2.Params.currency_pair_providers:type_name -> cheqd.oracle.v2.CurrencyPairProviders
-	9,  // 4: cheqd.oracle.v2.Params.currency_deviation_thresholds:type_name -> cheqd.oracle.v2.CurrencyDeviationThreshold
-	11, // 5: cheqd.oracle.v2.AggregateExchangeRateVote.exchange_rates:type_name -> cosmos.base.v1beta1.DecCoin
-	11, // 6: cheqd.oracle.v2.PriceStamp.exchange_rate:type_name -> cosmos.base.v1beta1.DecCoin
-	8,  // 7: cheqd.oracle.v2.CurrencyPairProviders.pair_address:type_name -> cheqd.oracle.v2.PairAddressProvider
-	0,  // 8: cheqd.oracle.v2.ParamUpdatePlan.changes:type_name -> cheqd.oracle.v2.Params
-	9,  // [9:9] is the sub-list for method output_type
-	9,  // [9:9] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	4,  // 0: cheqd.oracle.v2.Params.reward_bands:type_name -> cheqd.oracle.v2.RewardBand
+	3,  // 1: cheqd.oracle.v2.Params.accept_list:type_name -> cheqd.oracle.v2.Denom
+	3,  // 2: cheqd.oracle.v2.Params.mandatory_list:type_name -> cheqd.oracle.v2.Denom
+	9,  // 3: cheqd.oracle.v2.Params.currency_pair_providers:type_name -> cheqd.oracle.v2.CurrencyPairProviders
+	11, // 4: cheqd.oracle.v2.Params.currency_deviation_thresholds:type_name -> cheqd.oracle.v2.CurrencyDeviationThreshold
+	17, // 5: cheqd.oracle.v2.Params.twap_lookbacks:type_name -> google.protobuf.Duration
+	0,  // 6: cheqd.oracle.v2.Params.fee_price_source:type_name -> cheqd.oracle.v2.PriceSource
+	17, // 7: cheqd.oracle.v2.Params.fee_twap_lookback:type_name -> google.protobuf.Duration
+	18, // 8: cheqd.oracle.v2.TwapCheckpoint.time:type_name -> google.protobuf.Timestamp
+	19, // 9: cheqd.oracle.v2.AggregateExchangeRateVote.exchange_rates:type_name -> cosmos.base.v1beta1.DecCoin
+	19, // 10: cheqd.oracle.v2.PriceStamp.exchange_rate:type_name -> cosmos.base.v1beta1.DecCoin
+	10, // 11: cheqd.oracle.v2.CurrencyPairProviders.pair_address:type_name -> cheqd.oracle.v2.PairAddressProvider
+	1,  // 12: cheqd.oracle.v2.ParamUpdatePlan.changes:type_name -> cheqd.oracle.v2.Params
+	18, // 13: cheqd.oracle.v2.PriceAverage.updated_time:type_name -> google.protobuf.Timestamp
+	18, // 14: cheqd.oracle.v2.PriceCircuitBreaker.time:type_name -> google.protobuf.Timestamp
+	20, // 15: cheqd.oracle.v2.ValidatorOracleWindow.rewards:type_name -> cosmos.base.v1beta1.Coin
+	20, // 16: cheqd.oracle.v2.SlashWindowSummary.rewards:type_name -> cosmos.base.v1beta1.Coin
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_cheqd_oracle_v2_oracle_proto_init() }
@@ -8126,7 +12753,7 @@ func file_cheqd_oracle_v2_oracle_proto_init() {
 			}
 		}
 		file_cheqd_oracle_v2_oracle_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Denom); i {
+			switch v := v.(*TwapCheckpoint); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8138,7 +12765,7 @@ func file_cheqd_oracle_v2_oracle_proto_init() {
 			}
 		}
 		file_cheqd_oracle_v2_oracle_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RewardBand); i {
+			switch v := v.(*Denom); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8150,7 +12777,7 @@ func file_cheqd_oracle_v2_oracle_proto_init() {
 			}
 		}
 		file_cheqd_oracle_v2_oracle_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AggregateExchangeRatePrevote); i {
+			switch v := v.(*RewardBand); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8162,7 +12789,7 @@ func file_cheqd_oracle_v2_oracle_proto_init() {
 			}
 		}
 		file_cheqd_oracle_v2_oracle_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AggregateExchangeRateVote); i {
+			switch v := v.(*AggregateExchangeRatePrevote); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8174,7 +12801,7 @@ func file_cheqd_oracle_v2_oracle_proto_init() {
 			}
 		}
 		file_cheqd_oracle_v2_oracle_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PriceStamp); i {
+			switch v := v.(*AggregateExchangeRateVote); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8186,7 +12813,7 @@ func file_cheqd_oracle_v2_oracle_proto_init() {
 			}
 		}
 		file_cheqd_oracle_v2_oracle_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorRewardSet); i {
+			switch v := v.(*PriceStamp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8198,7 +12825,7 @@ func file_cheqd_oracle_v2_oracle_proto_init() {
 			}
 		}
 		file_cheqd_oracle_v2_oracle_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CurrencyPairProviders); i {
+			switch v := v.(*ValidatorRewardSet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8210,7 +12837,7 @@ func file_cheqd_oracle_v2_oracle_proto_init() {
 			}
 		}
 		file_cheqd_oracle_v2_oracle_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PairAddressProvider); i {
+			switch v := v.(*CurrencyPairProviders); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8222,7 +12849,7 @@ func file_cheqd_oracle_v2_oracle_proto_init() {
 			}
 		}
 		file_cheqd_oracle_v2_oracle_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CurrencyDeviationThreshold); i {
+			switch v := v.(*PairAddressProvider); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8234,6 +12861,18 @@ func file_cheqd_oracle_v2_oracle_proto_init() {
 			}
 		}
 		file_cheqd_oracle_v2_oracle_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CurrencyDeviationThreshold); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cheqd_oracle_v2_oracle_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ParamUpdatePlan); i {
 			case 0:
 				return &v.state
@@ -8245,19 +12884,68 @@ func file_cheqd_oracle_v2_oracle_proto_init() {
 				return nil
 			}
 		}
+		file_cheqd_oracle_v2_oracle_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceAverage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cheqd_oracle_v2_oracle_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceCircuitBreaker); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cheqd_oracle_v2_oracle_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorOracleWindow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cheqd_oracle_v2_oracle_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlashWindowSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cheqd_oracle_v2_oracle_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      1,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_cheqd_oracle_v2_oracle_proto_goTypes,
 		DependencyIndexes: file_cheqd_oracle_v2_oracle_proto_depIdxs,
+		EnumInfos:         file_cheqd_oracle_v2_oracle_proto_enumTypes,
 		MessageInfos:      file_cheqd_oracle_v2_oracle_proto_msgTypes,
 	}.Build()
 	File_cheqd_oracle_v2_oracle_proto = out.File
@@ -19,23 +19,30 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_ExchangeRates_FullMethodName       = "/cheqd.oracle.v2.Query/ExchangeRates"
-	Query_ActiveExchangeRates_FullMethodName = "/cheqd.oracle.v2.Query/ActiveExchangeRates"
-	Query_FeederDelegation_FullMethodName    = "/cheqd.oracle.v2.Query/FeederDelegation"
-	Query_MissCounter_FullMethodName         = "/cheqd.oracle.v2.Query/MissCounter"
-	Query_SlashWindow_FullMethodName         = "/cheqd.oracle.v2.Query/SlashWindow"
-	Query_AggregatePrevote_FullMethodName    = "/cheqd.oracle.v2.Query/AggregatePrevote"
-	Query_AggregatePrevotes_FullMethodName   = "/cheqd.oracle.v2.Query/AggregatePrevotes"
-	Query_AggregateVote_FullMethodName       = "/cheqd.oracle.v2.Query/AggregateVote"
-	Query_AggregateVotes_FullMethodName      = "/cheqd.oracle.v2.Query/AggregateVotes"
-	Query_Params_FullMethodName              = "/cheqd.oracle.v2.Query/Params"
-	Query_Medians_FullMethodName             = "/cheqd.oracle.v2.Query/Medians"
-	Query_MedianDeviations_FullMethodName    = "/cheqd.oracle.v2.Query/MedianDeviations"
-	Query_ValidatorRewardSet_FullMethodName  = "/cheqd.oracle.v2.Query/ValidatorRewardSet"
-	Query_EMA_FullMethodName                 = "/cheqd.oracle.v2.Query/EMA"
-	Query_WMA_FullMethodName                 = "/cheqd.oracle.v2.Query/WMA"
-	Query_SMA_FullMethodName                 = "/cheqd.oracle.v2.Query/SMA"
-	Query_ConvertUSDCtoCHEQ_FullMethodName   = "/cheqd.oracle.v2.Query/ConvertUSDCtoCHEQ"
+	Query_ExchangeRates_FullMethodName          = "/cheqd.oracle.v2.Query/ExchangeRates"
+	Query_ActiveExchangeRates_FullMethodName    = "/cheqd.oracle.v2.Query/ActiveExchangeRates"
+	Query_FeederDelegation_FullMethodName       = "/cheqd.oracle.v2.Query/FeederDelegation"
+	Query_MissCounter_FullMethodName            = "/cheqd.oracle.v2.Query/MissCounter"
+	Query_SlashWindow_FullMethodName            = "/cheqd.oracle.v2.Query/SlashWindow"
+	Query_ValidatorOracleHistory_FullMethodName = "/cheqd.oracle.v2.Query/ValidatorOracleHistory"
+	Query_SlashWindowSummary_FullMethodName     = "/cheqd.oracle.v2.Query/SlashWindowSummary"
+	Query_AggregatePrevote_FullMethodName       = "/cheqd.oracle.v2.Query/AggregatePrevote"
+	Query_AggregatePrevotes_FullMethodName      = "/cheqd.oracle.v2.Query/AggregatePrevotes"
+	Query_AggregateVote_FullMethodName          = "/cheqd.oracle.v2.Query/AggregateVote"
+	Query_AggregateVotes_FullMethodName         = "/cheqd.oracle.v2.Query/AggregateVotes"
+	Query_Params_FullMethodName                 = "/cheqd.oracle.v2.Query/Params"
+	Query_Medians_FullMethodName                = "/cheqd.oracle.v2.Query/Medians"
+	Query_MedianDeviations_FullMethodName       = "/cheqd.oracle.v2.Query/MedianDeviations"
+	Query_ValidatorRewardSet_FullMethodName     = "/cheqd.oracle.v2.Query/ValidatorRewardSet"
+	Query_EMA_FullMethodName                    = "/cheqd.oracle.v2.Query/EMA"
+	Query_WMA_FullMethodName                    = "/cheqd.oracle.v2.Query/WMA"
+	Query_SMA_FullMethodName                    = "/cheqd.oracle.v2.Query/SMA"
+	Query_TWAP_FullMethodName                   = "/cheqd.oracle.v2.Query/TWAP"
+	Query_ConvertUSDCtoCHEQ_FullMethodName      = "/cheqd.oracle.v2.Query/ConvertUSDCtoCHEQ"
+	Query_Convert_FullMethodName                = "/cheqd.oracle.v2.Query/Convert"
+	Query_PriceHealth_FullMethodName            = "/cheqd.oracle.v2.Query/PriceHealth"
+	Query_PriceAt_FullMethodName                = "/cheqd.oracle.v2.Query/PriceAt"
+	Query_PriceRange_FullMethodName             = "/cheqd.oracle.v2.Query/PriceRange"
 )
 
 // QueryClient is the client API for Query service.
@@ -55,6 +62,12 @@ type QueryClient interface {
 	MissCounter(ctx context.Context, in *QueryMissCounter, opts ...grpc.CallOption) (*QueryMissCounterResponse, error)
 	// SlashWindow returns slash window information
 	SlashWindow(ctx context.Context, in *QuerySlashWindow, opts ...grpc.CallOption) (*QuerySlashWindowResponse, error)
+	// ValidatorOracleHistory returns the oracle performance of a validator over
+	// the current and the past slash windows.
+	ValidatorOracleHistory(ctx context.Context, in *QueryValidatorOracleHistory, opts ...grpc.CallOption) (*QueryValidatorOracleHistoryResponse, error)
+	// SlashWindowSummary returns the outcome of a past slash window, the latest
+	// one by default.
+	SlashWindowSummary(ctx context.Context, in *QuerySlashWindowSummary, opts ...grpc.CallOption) (*QuerySlashWindowSummaryResponse, error)
 	// AggregatePrevote returns an aggregate prevote of a validator
 	AggregatePrevote(ctx context.Context, in *QueryAggregatePrevote, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error)
 	// AggregatePrevotes returns aggregate prevotes of all validators
@@ -78,7 +91,23 @@ type QueryClient interface {
 	EMA(ctx context.Context, in *QueryEMARequest, opts ...grpc.CallOption) (*QueryEMAResponse, error)
 	WMA(ctx context.Context, in *QueryWMARequest, opts ...grpc.CallOption) (*QueryWMAResponse, error)
 	SMA(ctx context.Context, in *QuerySMARequest, opts ...grpc.CallOption) (*QuerySMAResponse, error)
+	// TWAP returns the time-weighted average prices of a denom over the
+	// configured lookback durations, or, if specified, a single lookback.
+	TWAP(ctx context.Context, in *QueryTWAPRequest, opts ...grpc.CallOption) (*QueryTWAPResponse, error)
+	// Deprecated: use Convert with the usd and ncheq denoms instead.
 	ConvertUSDCtoCHEQ(ctx context.Context, in *ConvertUSDCtoCHEQRequest, opts ...grpc.CallOption) (*ConvertUSDCtoCHEQResponse, error)
+	// Convert converts an amount between two denoms of the accept list, or usd,
+	// through their USD prices from a given price source.
+	Convert(ctx context.Context, in *QueryConvertRequest, opts ...grpc.CallOption) (*QueryConvertResponse, error)
+	// PriceHealth returns the freshness of the moving averages of a denom and
+	// the state of the circuit breaker guarding USD-denominated fees.
+	PriceHealth(ctx context.Context, in *QueryPriceHealthRequest, opts ...grpc.CallOption) (*QueryPriceHealthResponse, error)
+	// PriceAt returns the latest historic price stamp or median of a denom
+	// stamped at or before a block height or time.
+	PriceAt(ctx context.Context, in *QueryPriceAtRequest, opts ...grpc.CallOption) (*QueryPriceAtResponse, error)
+	// PriceRange returns the historic price stamps or medians of a denom
+	// stamped within a block or time range, optionally down-sampled.
+	PriceRange(ctx context.Context, in *QueryPriceRangeRequest, opts ...grpc.CallOption) (*QueryPriceRangeResponse, error)
 }
 
 type queryClient struct {
@@ -139,6 +168,26 @@ func (c *queryClient) SlashWindow(ctx context.Context, in *QuerySlashWindow, opt
 	return out, nil
 }
 
+func (c *queryClient) ValidatorOracleHistory(ctx context.Context, in *QueryValidatorOracleHistory, opts ...grpc.CallOption) (*QueryValidatorOracleHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryValidatorOracleHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorOracleHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SlashWindowSummary(ctx context.Context, in *QuerySlashWindowSummary, opts ...grpc.CallOption) (*QuerySlashWindowSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySlashWindowSummaryResponse)
+	err := c.cc.Invoke(ctx, Query_SlashWindowSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) AggregatePrevote(ctx context.Context, in *QueryAggregatePrevote, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryAggregatePrevoteResponse)
@@ -249,6 +298,16 @@ func (c *queryClient) SMA(ctx context.Context, in *QuerySMARequest, opts ...grpc
 	return out, nil
 }
 
+func (c *queryClient) TWAP(ctx context.Context, in *QueryTWAPRequest, opts ...grpc.CallOption) (*QueryTWAPResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTWAPResponse)
+	err := c.cc.Invoke(ctx, Query_TWAP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ConvertUSDCtoCHEQ(ctx context.Context, in *ConvertUSDCtoCHEQRequest, opts ...grpc.CallOption) (*ConvertUSDCtoCHEQResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ConvertUSDCtoCHEQResponse)
@@ -259,6 +318,46 @@ func (c *queryClient) ConvertUSDCtoCHEQ(ctx context.Context, in *ConvertUSDCtoCH
 	return out, nil
 }
 
+func (c *queryClient) Convert(ctx context.Context, in *QueryConvertRequest, opts ...grpc.CallOption) (*QueryConvertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryConvertResponse)
+	err := c.cc.Invoke(ctx, Query_Convert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceHealth(ctx context.Context, in *QueryPriceHealthRequest, opts ...grpc.CallOption) (*QueryPriceHealthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPriceHealthResponse)
+	err := c.cc.Invoke(ctx, Query_PriceHealth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceAt(ctx context.Context, in *QueryPriceAtRequest, opts ...grpc.CallOption) (*QueryPriceAtResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPriceAtResponse)
+	err := c.cc.Invoke(ctx, Query_PriceAt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceRange(ctx context.Context, in *QueryPriceRangeRequest, opts ...grpc.CallOption) (*QueryPriceRangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPriceRangeResponse)
+	err := c.cc.Invoke(ctx, Query_PriceRange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -276,6 +375,12 @@ type QueryServer interface {
 	MissCounter(context.Context, *QueryMissCounter) (*QueryMissCounterResponse, error)
 	// SlashWindow returns slash window information
 	SlashWindow(context.Context, *QuerySlashWindow) (*QuerySlashWindowResponse, error)
+	// ValidatorOracleHistory returns the oracle performance of a validator over
+	// the current and the past slash windows.
+	ValidatorOracleHistory(context.Context, *QueryValidatorOracleHistory) (*QueryValidatorOracleHistoryResponse, error)
+	// SlashWindowSummary returns the outcome of a past slash window, the latest
+	// one by default.
+	SlashWindowSummary(context.Context, *QuerySlashWindowSummary) (*QuerySlashWindowSummaryResponse, error)
 	// AggregatePrevote returns an aggregate prevote of a validator
 	AggregatePrevote(context.Context, *QueryAggregatePrevote) (*QueryAggregatePrevoteResponse, error)
 	// AggregatePrevotes returns aggregate prevotes of all validators
@@ -299,7 +404,23 @@ type QueryServer interface {
 	EMA(context.Context, *QueryEMARequest) (*QueryEMAResponse, error)
 	WMA(context.Context, *QueryWMARequest) (*QueryWMAResponse, error)
 	SMA(context.Context, *QuerySMARequest) (*QuerySMAResponse, error)
+	// TWAP returns the time-weighted average prices of a denom over the
+	// configured lookback durations, or, if specified, a single lookback.
+	TWAP(context.Context, *QueryTWAPRequest) (*QueryTWAPResponse, error)
+	// Deprecated: use Convert with the usd and ncheq denoms instead.
 	ConvertUSDCtoCHEQ(context.Context, *ConvertUSDCtoCHEQRequest) (*ConvertUSDCtoCHEQResponse, error)
+	// Convert converts an amount between two denoms of the accept list, or usd,
+	// through their USD prices from a given price source.
+	Convert(context.Context, *QueryConvertRequest) (*QueryConvertResponse, error)
+	// PriceHealth returns the freshness of the moving averages of a denom and
+	// the state of the circuit breaker guarding USD-denominated fees.
+	PriceHealth(context.Context, *QueryPriceHealthRequest) (*QueryPriceHealthResponse, error)
+	// PriceAt returns the latest historic price stamp or median of a denom
+	// stamped at or before a block height or time.
+	PriceAt(context.Context, *QueryPriceAtRequest) (*QueryPriceAtResponse, error)
+	// PriceRange returns the historic price stamps or medians of a denom
+	// stamped within a block or time range, optionally down-sampled.
+	PriceRange(context.Context, *QueryPriceRangeRequest) (*QueryPriceRangeResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -325,6 +446,12 @@ func (UnimplementedQueryServer) MissCounter(context.Context, *QueryMissCounter)
 func (UnimplementedQueryServer) SlashWindow(context.Context, *QuerySlashWindow) (*QuerySlashWindowResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SlashWindow not implemented")
 }
+func (UnimplementedQueryServer) ValidatorOracleHistory(context.Context, *QueryValidatorOracleHistory) (*QueryValidatorOracleHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorOracleHistory not implemented")
+}
+func (UnimplementedQueryServer) SlashWindowSummary(context.Context, *QuerySlashWindowSummary) (*QuerySlashWindowSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashWindowSummary not implemented")
+}
 func (UnimplementedQueryServer) AggregatePrevote(context.Context, *QueryAggregatePrevote) (*QueryAggregatePrevoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AggregatePrevote not implemented")
 }
@@ -358,9 +485,24 @@ func (UnimplementedQueryServer) WMA(context.Context, *QueryWMARequest) (*QueryWM
 func (UnimplementedQueryServer) SMA(context.Context, *QuerySMARequest) (*QuerySMAResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SMA not implemented")
 }
+func (UnimplementedQueryServer) TWAP(context.Context, *QueryTWAPRequest) (*QueryTWAPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TWAP not implemented")
+}
 func (UnimplementedQueryServer) ConvertUSDCtoCHEQ(context.Context, *ConvertUSDCtoCHEQRequest) (*ConvertUSDCtoCHEQResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConvertUSDCtoCHEQ not implemented")
 }
+func (UnimplementedQueryServer) Convert(context.Context, *QueryConvertRequest) (*QueryConvertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Convert not implemented")
+}
+func (UnimplementedQueryServer) PriceHealth(context.Context, *QueryPriceHealthRequest) (*QueryPriceHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceHealth not implemented")
+}
+func (UnimplementedQueryServer) PriceAt(context.Context, *QueryPriceAtRequest) (*QueryPriceAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceAt not implemented")
+}
+func (UnimplementedQueryServer) PriceRange(context.Context, *QueryPriceRangeRequest) (*QueryPriceRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceRange not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -472,6 +614,42 @@ func _Query_SlashWindow_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorOracleHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorOracleHistory)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorOracleHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorOracleHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorOracleHistory(ctx, req.(*QueryValidatorOracleHistory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SlashWindowSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashWindowSummary)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashWindowSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SlashWindowSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashWindowSummary(ctx, req.(*QuerySlashWindowSummary))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_AggregatePrevote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAggregatePrevote)
 	if err := dec(in); err != nil {
@@ -670,6 +848,24 @@ func _Query_SMA_Handler(srv interface{}, ctx context.Context, dec func(interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TWAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTWAPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TWAP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TWAP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TWAP(ctx, req.(*QueryTWAPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ConvertUSDCtoCHEQ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ConvertUSDCtoCHEQRequest)
 	if err := dec(in); err != nil {
@@ -688,6 +884,78 @@ func _Query_ConvertUSDCtoCHEQ_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Convert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConvertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Convert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Convert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Convert(ctx, req.(*QueryConvertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PriceHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceHealth(ctx, req.(*QueryPriceHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PriceAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceAt(ctx, req.(*QueryPriceAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PriceRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceRange(ctx, req.(*QueryPriceRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -715,6 +983,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SlashWindow",
 			Handler:    _Query_SlashWindow_Handler,
 		},
+		{
+			MethodName: "ValidatorOracleHistory",
+			Handler:    _Query_ValidatorOracleHistory_Handler,
+		},
+		{
+			MethodName: "SlashWindowSummary",
+			Handler:    _Query_SlashWindowSummary_Handler,
+		},
 		{
 			MethodName: "AggregatePrevote",
 			Handler:    _Query_AggregatePrevote_Handler,
@@ -759,10 +1035,30 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SMA",
 			Handler:    _Query_SMA_Handler,
 		},
+		{
+			MethodName: "TWAP",
+			Handler:    _Query_TWAP_Handler,
+		},
 		{
 			MethodName: "ConvertUSDCtoCHEQ",
 			Handler:    _Query_ConvertUSDCtoCHEQ_Handler,
 		},
+		{
+			MethodName: "Convert",
+			Handler:    _Query_Convert_Handler,
+		},
+		{
+			MethodName: "PriceHealth",
+			Handler:    _Query_PriceHealth_Handler,
+		},
+		{
+			MethodName: "PriceAt",
+			Handler:    _Query_PriceAt_Handler,
+		},
+		{
+			MethodName: "PriceRange",
+			Handler:    _Query_PriceRange_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cheqd/oracle/v2/query.proto",
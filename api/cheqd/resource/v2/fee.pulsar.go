@@ -11,6 +11,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
@@ -169,12 +170,176 @@ func (x *_FeeParams_3_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_FeeParams_5_list)(nil)
+
+type _FeeParams_5_list struct {
+	list *[]*v2.FeeRange
+}
+
+func (x *_FeeParams_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeeParams_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FeeParams_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v2.FeeRange)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeeParams_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v2.FeeRange)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeeParams_5_list) AppendMutable() protoreflect.Value {
+	v := new(v2.FeeRange)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeParams_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeeParams_5_list) NewElement() protoreflect.Value {
+	v := new(v2.FeeRange)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeParams_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_FeeParams_6_list)(nil)
+
+type _FeeParams_6_list struct {
+	list *[]*v2.FeeRange
+}
+
+func (x *_FeeParams_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeeParams_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FeeParams_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v2.FeeRange)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeeParams_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v2.FeeRange)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeeParams_6_list) AppendMutable() protoreflect.Value {
+	v := new(v2.FeeRange)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeParams_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeeParams_6_list) NewElement() protoreflect.Value {
+	v := new(v2.FeeRange)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeParams_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_FeeParams_9_list)(nil)
+
+type _FeeParams_9_list struct {
+	list *[]*v2.FeeRange
+}
+
+func (x *_FeeParams_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeeParams_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FeeParams_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v2.FeeRange)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeeParams_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v2.FeeRange)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeeParams_9_list) AppendMutable() protoreflect.Value {
+	v := new(v2.FeeRange)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeParams_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeeParams_9_list) NewElement() protoreflect.Value {
+	v := new(v2.FeeRange)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeParams_9_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_FeeParams             protoreflect.MessageDescriptor
-	fd_FeeParams_image       protoreflect.FieldDescriptor
-	fd_FeeParams_json        protoreflect.FieldDescriptor
-	fd_FeeParams_default     protoreflect.FieldDescriptor
-	fd_FeeParams_burn_factor protoreflect.FieldDescriptor
+	md_FeeParams                          protoreflect.MessageDescriptor
+	fd_FeeParams_image                    protoreflect.FieldDescriptor
+	fd_FeeParams_json                     protoreflect.FieldDescriptor
+	fd_FeeParams_default                  protoreflect.FieldDescriptor
+	fd_FeeParams_update_resource_metadata protoreflect.FieldDescriptor
+	fd_FeeParams_deprecate_resource       protoreflect.FieldDescriptor
+	fd_FeeParams_burn_factor              protoreflect.FieldDescriptor
+	fd_FeeParams_max_batch_size           protoreflect.FieldDescriptor
+	fd_FeeParams_max_batch_data_size      protoreflect.FieldDescriptor
+	fd_FeeParams_upload_per_mib           protoreflect.FieldDescriptor
+	fd_FeeParams_max_upload_size          protoreflect.FieldDescriptor
+	fd_FeeParams_max_chunk_size           protoreflect.FieldDescriptor
+	fd_FeeParams_upload_expiry            protoreflect.FieldDescriptor
+	fd_FeeParams_image_size_pricing       protoreflect.FieldDescriptor
+	fd_FeeParams_json_size_pricing        protoreflect.FieldDescriptor
+	fd_FeeParams_default_size_pricing     protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -183,7 +348,18 @@ func init() {
 	fd_FeeParams_image = md_FeeParams.Fields().ByName("image")
 	fd_FeeParams_json = md_FeeParams.Fields().ByName("json")
 	fd_FeeParams_default = md_FeeParams.Fields().ByName("default")
+	fd_FeeParams_update_resource_metadata = md_FeeParams.Fields().ByName("update_resource_metadata")
+	fd_FeeParams_deprecate_resource = md_FeeParams.Fields().ByName("deprecate_resource")
 	fd_FeeParams_burn_factor = md_FeeParams.Fields().ByName("burn_factor")
+	fd_FeeParams_max_batch_size = md_FeeParams.Fields().ByName("max_batch_size")
+	fd_FeeParams_max_batch_data_size = md_FeeParams.Fields().ByName("max_batch_data_size")
+	fd_FeeParams_upload_per_mib = md_FeeParams.Fields().ByName("upload_per_mib")
+	fd_FeeParams_max_upload_size = md_FeeParams.Fields().ByName("max_upload_size")
+	fd_FeeParams_max_chunk_size = md_FeeParams.Fields().ByName("max_chunk_size")
+	fd_FeeParams_upload_expiry = md_FeeParams.Fields().ByName("upload_expiry")
+	fd_FeeParams_image_size_pricing = md_FeeParams.Fields().ByName("image_size_pricing")
+	fd_FeeParams_json_size_pricing = md_FeeParams.Fields().ByName("json_size_pricing")
+	fd_FeeParams_default_size_pricing = md_FeeParams.Fields().ByName("default_size_pricing")
 }
 
 var _ protoreflect.Message = (*fastReflection_FeeParams)(nil)
@@ -269,12 +445,78 @@ func (x *fastReflection_FeeParams) Range(f func(protoreflect.FieldDescriptor, pr
 			return
 		}
 	}
+	if len(x.UpdateResourceMetadata) != 0 {
+		value := protoreflect.ValueOfList(&_FeeParams_5_list{list: &x.UpdateResourceMetadata})
+		if !f(fd_FeeParams_update_resource_metadata, value) {
+			return
+		}
+	}
+	if len(x.DeprecateResource) != 0 {
+		value := protoreflect.ValueOfList(&_FeeParams_6_list{list: &x.DeprecateResource})
+		if !f(fd_FeeParams_deprecate_resource, value) {
+			return
+		}
+	}
 	if x.BurnFactor != "" {
 		value := protoreflect.ValueOfString(x.BurnFactor)
 		if !f(fd_FeeParams_burn_factor, value) {
 			return
 		}
 	}
+	if x.MaxBatchSize != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxBatchSize)
+		if !f(fd_FeeParams_max_batch_size, value) {
+			return
+		}
+	}
+	if x.MaxBatchDataSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxBatchDataSize)
+		if !f(fd_FeeParams_max_batch_data_size, value) {
+			return
+		}
+	}
+	if len(x.UploadPerMib) != 0 {
+		value := protoreflect.ValueOfList(&_FeeParams_9_list{list: &x.UploadPerMib})
+		if !f(fd_FeeParams_upload_per_mib, value) {
+			return
+		}
+	}
+	if x.MaxUploadSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxUploadSize)
+		if !f(fd_FeeParams_max_upload_size, value) {
+			return
+		}
+	}
+	if x.MaxChunkSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxChunkSize)
+		if !f(fd_FeeParams_max_chunk_size, value) {
+			return
+		}
+	}
+	if x.UploadExpiry != nil {
+		value := protoreflect.ValueOfMessage(x.UploadExpiry.ProtoReflect())
+		if !f(fd_FeeParams_upload_expiry, value) {
+			return
+		}
+	}
+	if x.ImageSizePricing != nil {
+		value := protoreflect.ValueOfMessage(x.ImageSizePricing.ProtoReflect())
+		if !f(fd_FeeParams_image_size_pricing, value) {
+			return
+		}
+	}
+	if x.JsonSizePricing != nil {
+		value := protoreflect.ValueOfMessage(x.JsonSizePricing.ProtoReflect())
+		if !f(fd_FeeParams_json_size_pricing, value) {
+			return
+		}
+	}
+	if x.DefaultSizePricing != nil {
+		value := protoreflect.ValueOfMessage(x.DefaultSizePricing.ProtoReflect())
+		if !f(fd_FeeParams_default_size_pricing, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -296,8 +538,30 @@ func (x *fastReflection_FeeParams) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.Json) != 0
 	case "cheqd.resource.v2.FeeParams.default":
 		return len(x.Default) != 0
+	case "cheqd.resource.v2.FeeParams.update_resource_metadata":
+		return len(x.UpdateResourceMetadata) != 0
+	case "cheqd.resource.v2.FeeParams.deprecate_resource":
+		return len(x.DeprecateResource) != 0
 	case "cheqd.resource.v2.FeeParams.burn_factor":
 		return x.BurnFactor != ""
+	case "cheqd.resource.v2.FeeParams.max_batch_size":
+		return x.MaxBatchSize != uint32(0)
+	case "cheqd.resource.v2.FeeParams.max_batch_data_size":
+		return x.MaxBatchDataSize != uint64(0)
+	case "cheqd.resource.v2.FeeParams.upload_per_mib":
+		return len(x.UploadPerMib) != 0
+	case "cheqd.resource.v2.FeeParams.max_upload_size":
+		return x.MaxUploadSize != uint64(0)
+	case "cheqd.resource.v2.FeeParams.max_chunk_size":
+		return x.MaxChunkSize != uint64(0)
+	case "cheqd.resource.v2.FeeParams.upload_expiry":
+		return x.UploadExpiry != nil
+	case "cheqd.resource.v2.FeeParams.image_size_pricing":
+		return x.ImageSizePricing != nil
+	case "cheqd.resource.v2.FeeParams.json_size_pricing":
+		return x.JsonSizePricing != nil
+	case "cheqd.resource.v2.FeeParams.default_size_pricing":
+		return x.DefaultSizePricing != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.FeeParams"))
@@ -320,8 +584,30 @@ func (x *fastReflection_FeeParams) Clear(fd protoreflect.FieldDescriptor) {
 		x.Json = nil
 	case "cheqd.resource.v2.FeeParams.default":
 		x.Default = nil
+	case "cheqd.resource.v2.FeeParams.update_resource_metadata":
+		x.UpdateResourceMetadata = nil
+	case "cheqd.resource.v2.FeeParams.deprecate_resource":
+		x.DeprecateResource = nil
 	case "cheqd.resource.v2.FeeParams.burn_factor":
 		x.BurnFactor = ""
+	case "cheqd.resource.v2.FeeParams.max_batch_size":
+		x.MaxBatchSize = uint32(0)
+	case "cheqd.resource.v2.FeeParams.max_batch_data_size":
+		x.MaxBatchDataSize = uint64(0)
+	case "cheqd.resource.v2.FeeParams.upload_per_mib":
+		x.UploadPerMib = nil
+	case "cheqd.resource.v2.FeeParams.max_upload_size":
+		x.MaxUploadSize = uint64(0)
+	case "cheqd.resource.v2.FeeParams.max_chunk_size":
+		x.MaxChunkSize = uint64(0)
+	case "cheqd.resource.v2.FeeParams.upload_expiry":
+		x.UploadExpiry = nil
+	case "cheqd.resource.v2.FeeParams.image_size_pricing":
+		x.ImageSizePricing = nil
+	case "cheqd.resource.v2.FeeParams.json_size_pricing":
+		x.JsonSizePricing = nil
+	case "cheqd.resource.v2.FeeParams.default_size_pricing":
+		x.DefaultSizePricing = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.FeeParams"))
@@ -356,9 +642,51 @@ func (x *fastReflection_FeeParams) Get(descriptor protoreflect.FieldDescriptor)
 		}
 		listValue := &_FeeParams_3_list{list: &x.Default}
 		return protoreflect.ValueOfList(listValue)
+	case "cheqd.resource.v2.FeeParams.update_resource_metadata":
+		if len(x.UpdateResourceMetadata) == 0 {
+			return protoreflect.ValueOfList(&_FeeParams_5_list{})
+		}
+		listValue := &_FeeParams_5_list{list: &x.UpdateResourceMetadata}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.resource.v2.FeeParams.deprecate_resource":
+		if len(x.DeprecateResource) == 0 {
+			return protoreflect.ValueOfList(&_FeeParams_6_list{})
+		}
+		listValue := &_FeeParams_6_list{list: &x.DeprecateResource}
+		return protoreflect.ValueOfList(listValue)
 	case "cheqd.resource.v2.FeeParams.burn_factor":
 		value := x.BurnFactor
 		return protoreflect.ValueOfString(value)
+	case "cheqd.resource.v2.FeeParams.max_batch_size":
+		value := x.MaxBatchSize
+		return protoreflect.ValueOfUint32(value)
+	case "cheqd.resource.v2.FeeParams.max_batch_data_size":
+		value := x.MaxBatchDataSize
+		return protoreflect.ValueOfUint64(value)
+	case "cheqd.resource.v2.FeeParams.upload_per_mib":
+		if len(x.UploadPerMib) == 0 {
+			return protoreflect.ValueOfList(&_FeeParams_9_list{})
+		}
+		listValue := &_FeeParams_9_list{list: &x.UploadPerMib}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.resource.v2.FeeParams.max_upload_size":
+		value := x.MaxUploadSize
+		return protoreflect.ValueOfUint64(value)
+	case "cheqd.resource.v2.FeeParams.max_chunk_size":
+		value := x.MaxChunkSize
+		return protoreflect.ValueOfUint64(value)
+	case "cheqd.resource.v2.FeeParams.upload_expiry":
+		value := x.UploadExpiry
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cheqd.resource.v2.FeeParams.image_size_pricing":
+		value := x.ImageSizePricing
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cheqd.resource.v2.FeeParams.json_size_pricing":
+		value := x.JsonSizePricing
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cheqd.resource.v2.FeeParams.default_size_pricing":
+		value := x.DefaultSizePricing
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.FeeParams"))
@@ -391,8 +719,36 @@ func (x *fastReflection_FeeParams) Set(fd protoreflect.FieldDescriptor, value pr
 		lv := value.List()
 		clv := lv.(*_FeeParams_3_list)
 		x.Default = *clv.list
+	case "cheqd.resource.v2.FeeParams.update_resource_metadata":
+		lv := value.List()
+		clv := lv.(*_FeeParams_5_list)
+		x.UpdateResourceMetadata = *clv.list
+	case "cheqd.resource.v2.FeeParams.deprecate_resource":
+		lv := value.List()
+		clv := lv.(*_FeeParams_6_list)
+		x.DeprecateResource = *clv.list
 	case "cheqd.resource.v2.FeeParams.burn_factor":
 		x.BurnFactor = value.Interface().(string)
+	case "cheqd.resource.v2.FeeParams.max_batch_size":
+		x.MaxBatchSize = uint32(value.Uint())
+	case "cheqd.resource.v2.FeeParams.max_batch_data_size":
+		x.MaxBatchDataSize = value.Uint()
+	case "cheqd.resource.v2.FeeParams.upload_per_mib":
+		lv := value.List()
+		clv := lv.(*_FeeParams_9_list)
+		x.UploadPerMib = *clv.list
+	case "cheqd.resource.v2.FeeParams.max_upload_size":
+		x.MaxUploadSize = value.Uint()
+	case "cheqd.resource.v2.FeeParams.max_chunk_size":
+		x.MaxChunkSize = value.Uint()
+	case "cheqd.resource.v2.FeeParams.upload_expiry":
+		x.UploadExpiry = value.Message().Interface().(*durationpb.Duration)
+	case "cheqd.resource.v2.FeeParams.image_size_pricing":
+		x.ImageSizePricing = value.Message().Interface().(*SizePricing)
+	case "cheqd.resource.v2.FeeParams.json_size_pricing":
+		x.JsonSizePricing = value.Message().Interface().(*SizePricing)
+	case "cheqd.resource.v2.FeeParams.default_size_pricing":
+		x.DefaultSizePricing = value.Message().Interface().(*SizePricing)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.FeeParams"))
@@ -431,8 +787,54 @@ func (x *fastReflection_FeeParams) Mutable(fd protoreflect.FieldDescriptor) prot
 		}
 		value := &_FeeParams_3_list{list: &x.Default}
 		return protoreflect.ValueOfList(value)
+	case "cheqd.resource.v2.FeeParams.update_resource_metadata":
+		if x.UpdateResourceMetadata == nil {
+			x.UpdateResourceMetadata = []*v2.FeeRange{}
+		}
+		value := &_FeeParams_5_list{list: &x.UpdateResourceMetadata}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.resource.v2.FeeParams.deprecate_resource":
+		if x.DeprecateResource == nil {
+			x.DeprecateResource = []*v2.FeeRange{}
+		}
+		value := &_FeeParams_6_list{list: &x.DeprecateResource}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.resource.v2.FeeParams.upload_per_mib":
+		if x.UploadPerMib == nil {
+			x.UploadPerMib = []*v2.FeeRange{}
+		}
+		value := &_FeeParams_9_list{list: &x.UploadPerMib}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.resource.v2.FeeParams.upload_expiry":
+		if x.UploadExpiry == nil {
+			x.UploadExpiry = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.UploadExpiry.ProtoReflect())
+	case "cheqd.resource.v2.FeeParams.image_size_pricing":
+		if x.ImageSizePricing == nil {
+			x.ImageSizePricing = new(SizePricing)
+		}
+		return protoreflect.ValueOfMessage(x.ImageSizePricing.ProtoReflect())
+	case "cheqd.resource.v2.FeeParams.json_size_pricing":
+		if x.JsonSizePricing == nil {
+			x.JsonSizePricing = new(SizePricing)
+		}
+		return protoreflect.ValueOfMessage(x.JsonSizePricing.ProtoReflect())
+	case "cheqd.resource.v2.FeeParams.default_size_pricing":
+		if x.DefaultSizePricing == nil {
+			x.DefaultSizePricing = new(SizePricing)
+		}
+		return protoreflect.ValueOfMessage(x.DefaultSizePricing.ProtoReflect())
 	case "cheqd.resource.v2.FeeParams.burn_factor":
 		panic(fmt.Errorf("field burn_factor of message cheqd.resource.v2.FeeParams is not mutable"))
+	case "cheqd.resource.v2.FeeParams.max_batch_size":
+		panic(fmt.Errorf("field max_batch_size of message cheqd.resource.v2.FeeParams is not mutable"))
+	case "cheqd.resource.v2.FeeParams.max_batch_data_size":
+		panic(fmt.Errorf("field max_batch_data_size of message cheqd.resource.v2.FeeParams is not mutable"))
+	case "cheqd.resource.v2.FeeParams.max_upload_size":
+		panic(fmt.Errorf("field max_upload_size of message cheqd.resource.v2.FeeParams is not mutable"))
+	case "cheqd.resource.v2.FeeParams.max_chunk_size":
+		panic(fmt.Errorf("field max_chunk_size of message cheqd.resource.v2.FeeParams is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.FeeParams"))
@@ -455,8 +857,37 @@ func (x *fastReflection_FeeParams) NewField(fd protoreflect.FieldDescriptor) pro
 	case "cheqd.resource.v2.FeeParams.default":
 		list := []*v2.FeeRange{}
 		return protoreflect.ValueOfList(&_FeeParams_3_list{list: &list})
+	case "cheqd.resource.v2.FeeParams.update_resource_metadata":
+		list := []*v2.FeeRange{}
+		return protoreflect.ValueOfList(&_FeeParams_5_list{list: &list})
+	case "cheqd.resource.v2.FeeParams.deprecate_resource":
+		list := []*v2.FeeRange{}
+		return protoreflect.ValueOfList(&_FeeParams_6_list{list: &list})
 	case "cheqd.resource.v2.FeeParams.burn_factor":
 		return protoreflect.ValueOfString("")
+	case "cheqd.resource.v2.FeeParams.max_batch_size":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cheqd.resource.v2.FeeParams.max_batch_data_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cheqd.resource.v2.FeeParams.upload_per_mib":
+		list := []*v2.FeeRange{}
+		return protoreflect.ValueOfList(&_FeeParams_9_list{list: &list})
+	case "cheqd.resource.v2.FeeParams.max_upload_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cheqd.resource.v2.FeeParams.max_chunk_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cheqd.resource.v2.FeeParams.upload_expiry":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cheqd.resource.v2.FeeParams.image_size_pricing":
+		m := new(SizePricing)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cheqd.resource.v2.FeeParams.json_size_pricing":
+		m := new(SizePricing)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cheqd.resource.v2.FeeParams.default_size_pricing":
+		m := new(SizePricing)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.FeeParams"))
@@ -544,10 +975,56 @@ func (x *fastReflection_FeeParams) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.UpdateResourceMetadata) > 0 {
+			for _, e := range x.UpdateResourceMetadata {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DeprecateResource) > 0 {
+			for _, e := range x.DeprecateResource {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		l = len(x.BurnFactor)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.MaxBatchSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxBatchSize))
+		}
+		if x.MaxBatchDataSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxBatchDataSize))
+		}
+		if len(x.UploadPerMib) > 0 {
+			for _, e := range x.UploadPerMib {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxUploadSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxUploadSize))
+		}
+		if x.MaxChunkSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxChunkSize))
+		}
+		if x.UploadExpiry != nil {
+			l = options.Size(x.UploadExpiry)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ImageSizePricing != nil {
+			l = options.Size(x.ImageSizePricing)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.JsonSizePricing != nil {
+			l = options.Size(x.JsonSizePricing)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DefaultSizePricing != nil {
+			l = options.Size(x.DefaultSizePricing)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -577,16 +1054,75 @@ func (x *fastReflection_FeeParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.BurnFactor) > 0 {
-			i -= len(x.BurnFactor)
-			copy(dAtA[i:], x.BurnFactor)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BurnFactor)))
+		if x.DefaultSizePricing != nil {
+			encoded, err := options.Marshal(x.DefaultSizePricing)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x7a
 		}
-		if len(x.Default) > 0 {
-			for iNdEx := len(x.Default) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Default[iNdEx])
+		if x.JsonSizePricing != nil {
+			encoded, err := options.Marshal(x.JsonSizePricing)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if x.ImageSizePricing != nil {
+			encoded, err := options.Marshal(x.ImageSizePricing)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if x.UploadExpiry != nil {
+			encoded, err := options.Marshal(x.UploadExpiry)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.MaxChunkSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxChunkSize))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.MaxUploadSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxUploadSize))
+			i--
+			dAtA[i] = 0x50
+		}
+		if len(x.UploadPerMib) > 0 {
+			for iNdEx := len(x.UploadPerMib) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.UploadPerMib[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -597,12 +1133,22 @@ func (x *fastReflection_FeeParams) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x1a
+				dAtA[i] = 0x4a
 			}
 		}
-		if len(x.Json) > 0 {
-			for iNdEx := len(x.Json) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Json[iNdEx])
+		if x.MaxBatchDataSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxBatchDataSize))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.MaxBatchSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxBatchSize))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.DeprecateResource) > 0 {
+			for iNdEx := len(x.DeprecateResource) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DeprecateResource[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -613,12 +1159,12 @@ func (x *fastReflection_FeeParams) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x12
+				dAtA[i] = 0x32
 			}
 		}
-		if len(x.Image) > 0 {
-			for iNdEx := len(x.Image) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Image[iNdEx])
+		if len(x.UpdateResourceMetadata) > 0 {
+			for iNdEx := len(x.UpdateResourceMetadata) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.UpdateResourceMetadata[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -629,23 +1175,1098 @@ func (x *fastReflection_FeeParams) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0xa
+				dAtA[i] = 0x2a
 			}
 		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
+		if len(x.BurnFactor) > 0 {
+			i -= len(x.BurnFactor)
+			copy(dAtA[i:], x.BurnFactor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BurnFactor)))
+			i--
+			dAtA[i] = 0x22
 		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*FeeParams)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
+		if len(x.Default) > 0 {
+			for iNdEx := len(x.Default) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Default[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Json) > 0 {
+			for iNdEx := len(x.Json) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Json[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Image) > 0 {
+			for iNdEx := len(x.Image) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Image[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeeParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Image = append(x.Image, &v2.FeeRange{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Image[len(x.Image)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Json", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Json = append(x.Json, &v2.FeeRange{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Json[len(x.Json)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Default = append(x.Default, &v2.FeeRange{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Default[len(x.Default)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdateResourceMetadata", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UpdateResourceMetadata = append(x.UpdateResourceMetadata, &v2.FeeRange{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UpdateResourceMetadata[len(x.UpdateResourceMetadata)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeprecateResource", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DeprecateResource = append(x.DeprecateResource, &v2.FeeRange{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DeprecateResource[len(x.DeprecateResource)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnFactor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BurnFactor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBatchSize", wireType)
+				}
+				x.MaxBatchSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxBatchSize |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBatchDataSize", wireType)
+				}
+				x.MaxBatchDataSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxBatchDataSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UploadPerMib", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UploadPerMib = append(x.UploadPerMib, &v2.FeeRange{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UploadPerMib[len(x.UploadPerMib)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxUploadSize", wireType)
+				}
+				x.MaxUploadSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxUploadSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxChunkSize", wireType)
+				}
+				x.MaxChunkSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxChunkSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UploadExpiry", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.UploadExpiry == nil {
+					x.UploadExpiry = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UploadExpiry); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ImageSizePricing", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ImageSizePricing == nil {
+					x.ImageSizePricing = &SizePricing{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ImageSizePricing); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field JsonSizePricing", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.JsonSizePricing == nil {
+					x.JsonSizePricing = &SizePricing{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.JsonSizePricing); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DefaultSizePricing", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DefaultSizePricing == nil {
+					x.DefaultSizePricing = &SizePricing{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DefaultSizePricing); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_SizePricing_1_list)(nil)
+
+type _SizePricing_1_list struct {
+	list *[]*v2.FeeRange
+}
+
+func (x *_SizePricing_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SizePricing_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_SizePricing_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v2.FeeRange)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SizePricing_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v2.FeeRange)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SizePricing_1_list) AppendMutable() protoreflect.Value {
+	v := new(v2.FeeRange)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SizePricing_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SizePricing_1_list) NewElement() protoreflect.Value {
+	v := new(v2.FeeRange)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SizePricing_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_SizePricing_2_list)(nil)
+
+type _SizePricing_2_list struct {
+	list *[]*v2.FeeRange
+}
+
+func (x *_SizePricing_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SizePricing_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_SizePricing_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v2.FeeRange)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SizePricing_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v2.FeeRange)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SizePricing_2_list) AppendMutable() protoreflect.Value {
+	v := new(v2.FeeRange)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SizePricing_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SizePricing_2_list) NewElement() protoreflect.Value {
+	v := new(v2.FeeRange)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SizePricing_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_SizePricing         protoreflect.MessageDescriptor
+	fd_SizePricing_base    protoreflect.FieldDescriptor
+	fd_SizePricing_per_kib protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cheqd_resource_v2_fee_proto_init()
+	md_SizePricing = File_cheqd_resource_v2_fee_proto.Messages().ByName("SizePricing")
+	fd_SizePricing_base = md_SizePricing.Fields().ByName("base")
+	fd_SizePricing_per_kib = md_SizePricing.Fields().ByName("per_kib")
+}
+
+var _ protoreflect.Message = (*fastReflection_SizePricing)(nil)
+
+type fastReflection_SizePricing SizePricing
+
+func (x *SizePricing) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SizePricing)(x)
+}
+
+func (x *SizePricing) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_resource_v2_fee_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SizePricing_messageType fastReflection_SizePricing_messageType
+var _ protoreflect.MessageType = fastReflection_SizePricing_messageType{}
+
+type fastReflection_SizePricing_messageType struct{}
+
+func (x fastReflection_SizePricing_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SizePricing)(nil)
+}
+func (x fastReflection_SizePricing_messageType) New() protoreflect.Message {
+	return new(fastReflection_SizePricing)
+}
+func (x fastReflection_SizePricing_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SizePricing
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SizePricing) Descriptor() protoreflect.MessageDescriptor {
+	return md_SizePricing
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SizePricing) Type() protoreflect.MessageType {
+	return _fastReflection_SizePricing_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SizePricing) New() protoreflect.Message {
+	return new(fastReflection_SizePricing)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SizePricing) Interface() protoreflect.ProtoMessage {
+	return (*SizePricing)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SizePricing) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Base) != 0 {
+		value := protoreflect.ValueOfList(&_SizePricing_1_list{list: &x.Base})
+		if !f(fd_SizePricing_base, value) {
+			return
+		}
+	}
+	if len(x.PerKib) != 0 {
+		value := protoreflect.ValueOfList(&_SizePricing_2_list{list: &x.PerKib})
+		if !f(fd_SizePricing_per_kib, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SizePricing) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cheqd.resource.v2.SizePricing.base":
+		return len(x.Base) != 0
+	case "cheqd.resource.v2.SizePricing.per_kib":
+		return len(x.PerKib) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.SizePricing"))
+		}
+		panic(fmt.Errorf("message cheqd.resource.v2.SizePricing does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SizePricing) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cheqd.resource.v2.SizePricing.base":
+		x.Base = nil
+	case "cheqd.resource.v2.SizePricing.per_kib":
+		x.PerKib = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.SizePricing"))
+		}
+		panic(fmt.Errorf("message cheqd.resource.v2.SizePricing does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SizePricing) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cheqd.resource.v2.SizePricing.base":
+		if len(x.Base) == 0 {
+			return protoreflect.ValueOfList(&_SizePricing_1_list{})
+		}
+		listValue := &_SizePricing_1_list{list: &x.Base}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.resource.v2.SizePricing.per_kib":
+		if len(x.PerKib) == 0 {
+			return protoreflect.ValueOfList(&_SizePricing_2_list{})
+		}
+		listValue := &_SizePricing_2_list{list: &x.PerKib}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.SizePricing"))
+		}
+		panic(fmt.Errorf("message cheqd.resource.v2.SizePricing does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SizePricing) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cheqd.resource.v2.SizePricing.base":
+		lv := value.List()
+		clv := lv.(*_SizePricing_1_list)
+		x.Base = *clv.list
+	case "cheqd.resource.v2.SizePricing.per_kib":
+		lv := value.List()
+		clv := lv.(*_SizePricing_2_list)
+		x.PerKib = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.SizePricing"))
+		}
+		panic(fmt.Errorf("message cheqd.resource.v2.SizePricing does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SizePricing) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.resource.v2.SizePricing.base":
+		if x.Base == nil {
+			x.Base = []*v2.FeeRange{}
+		}
+		value := &_SizePricing_1_list{list: &x.Base}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.resource.v2.SizePricing.per_kib":
+		if x.PerKib == nil {
+			x.PerKib = []*v2.FeeRange{}
+		}
+		value := &_SizePricing_2_list{list: &x.PerKib}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.SizePricing"))
+		}
+		panic(fmt.Errorf("message cheqd.resource.v2.SizePricing does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SizePricing) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.resource.v2.SizePricing.base":
+		list := []*v2.FeeRange{}
+		return protoreflect.ValueOfList(&_SizePricing_1_list{list: &list})
+	case "cheqd.resource.v2.SizePricing.per_kib":
+		list := []*v2.FeeRange{}
+		return protoreflect.ValueOfList(&_SizePricing_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.SizePricing"))
+		}
+		panic(fmt.Errorf("message cheqd.resource.v2.SizePricing does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SizePricing) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.resource.v2.SizePricing", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SizePricing) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SizePricing) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SizePricing) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SizePricing) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SizePricing)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Base) > 0 {
+			for _, e := range x.Base {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PerKib) > 0 {
+			for _, e := range x.PerKib {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SizePricing)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.PerKib) > 0 {
+			for iNdEx := len(x.PerKib) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PerKib[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Base) > 0 {
+			for iNdEx := len(x.Base) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Base[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SizePricing)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
 				Flags:             input.Flags,
 			}, nil
@@ -675,15 +2296,15 @@ func (x *fastReflection_FeeParams) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeParams: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SizePricing: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SizePricing: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -710,48 +2331,14 @@ func (x *fastReflection_FeeParams) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Image = append(x.Image, &v2.FeeRange{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Image[len(x.Image)-1]); err != nil {
+				x.Base = append(x.Base, &v2.FeeRange{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Base[len(x.Base)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Json", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Json = append(x.Json, &v2.FeeRange{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Json[len(x.Json)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PerKib", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -778,43 +2365,11 @@ func (x *fastReflection_FeeParams) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Default = append(x.Default, &v2.FeeRange{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Default[len(x.Default)-1]); err != nil {
+				x.PerKib = append(x.PerKib, &v2.FeeRange{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PerKib[len(x.PerKib)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnFactor", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.BurnFactor = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -882,10 +2437,58 @@ type FeeParams struct {
 	//
 	// Default: 5 CHEQ or 5000000000ncheq
 	Default []*v2.FeeRange `protobuf:"bytes,3,rep,name=default,proto3" json:"default,omitempty"`
+	// Fixed fee for updating the metadata of a resource
+	//
+	// Default: 0.1 USD
+	UpdateResourceMetadata []*v2.FeeRange `protobuf:"bytes,5,rep,name=update_resource_metadata,json=updateResourceMetadata,proto3" json:"update_resource_metadata,omitempty"`
+	// Fixed fee for deprecating a resource
+	//
+	// Default: 0.1 USD
+	DeprecateResource []*v2.FeeRange `protobuf:"bytes,6,rep,name=deprecate_resource,json=deprecateResource,proto3" json:"deprecate_resource,omitempty"`
 	// Percentage of the fixed fee that will be burned
 	//
 	// Default: 0.5 (50%)
 	BurnFactor string `protobuf:"bytes,4,opt,name=burn_factor,json=burnFactor,proto3" json:"burn_factor,omitempty"`
+	// Maximum number of resources created by a single batch message.
+	// Zero disables batch creation.
+	//
+	// Default: 20
+	MaxBatchSize uint32 `protobuf:"varint,7,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
+	// Maximum total size in bytes of the data of resources created by a single batch message
+	//
+	// Default: 4 MiB or 4194304 bytes
+	MaxBatchDataSize uint64 `protobuf:"varint,8,opt,name=max_batch_data_size,json=maxBatchDataSize,proto3" json:"max_batch_data_size,omitempty"`
+	// Fee per started MiB of resource data uploaded in chunks, charged on Msg/BeginResourceUpload
+	//
+	// Default: 0.2 USD
+	UploadPerMib []*v2.FeeRange `protobuf:"bytes,9,rep,name=upload_per_mib,json=uploadPerMib,proto3" json:"upload_per_mib,omitempty"`
+	// Maximum total size in bytes of the data of a resource uploaded in chunks
+	//
+	// Default: 50 MiB or 52428800 bytes
+	MaxUploadSize uint64 `protobuf:"varint,10,opt,name=max_upload_size,json=maxUploadSize,proto3" json:"max_upload_size,omitempty"`
+	// Maximum size in bytes of a single chunk of a resource upload
+	//
+	// Default: 1 MiB or 1048576 bytes
+	MaxChunkSize uint64 `protobuf:"varint,11,opt,name=max_chunk_size,json=maxChunkSize,proto3" json:"max_chunk_size,omitempty"`
+	// Time after which an upload that has not been finalized expires and is pruned
+	//
+	// Default: 24h
+	UploadExpiry *durationpb.Duration `protobuf:"bytes,12,opt,name=upload_expiry,json=uploadExpiry,proto3" json:"upload_expiry,omitempty"`
+	// Optional size-tiered fee for creating a resource with media type 'image/*'.
+	// If set, it replaces the fixed 'image' fee.
+	//
+	// Default: not set
+	ImageSizePricing *SizePricing `protobuf:"bytes,13,opt,name=image_size_pricing,json=imageSizePricing,proto3" json:"image_size_pricing,omitempty"`
+	// Optional size-tiered fee for creating a resource with media type 'application/json'.
+	// If set, it replaces the fixed 'json' fee.
+	//
+	// Default: not set
+	JsonSizePricing *SizePricing `protobuf:"bytes,14,opt,name=json_size_pricing,json=jsonSizePricing,proto3" json:"json_size_pricing,omitempty"`
+	// Optional size-tiered fee for creating a resource with all other media types.
+	// If set, it replaces the fixed 'default' fee.
+	//
+	// Default: not set
+	DefaultSizePricing *SizePricing `protobuf:"bytes,15,opt,name=default_size_pricing,json=defaultSizePricing,proto3" json:"default_size_pricing,omitempty"`
 }
 
 func (x *FeeParams) Reset() {
@@ -929,6 +2532,20 @@ func (x *FeeParams) GetDefault() []*v2.FeeRange {
 	return nil
 }
 
+func (x *FeeParams) GetUpdateResourceMetadata() []*v2.FeeRange {
+	if x != nil {
+		return x.UpdateResourceMetadata
+	}
+	return nil
+}
+
+func (x *FeeParams) GetDeprecateResource() []*v2.FeeRange {
+	if x != nil {
+		return x.DeprecateResource
+	}
+	return nil
+}
+
 func (x *FeeParams) GetBurnFactor() string {
 	if x != nil {
 		return x.BurnFactor
@@ -936,6 +2553,117 @@ func (x *FeeParams) GetBurnFactor() string {
 	return ""
 }
 
+func (x *FeeParams) GetMaxBatchSize() uint32 {
+	if x != nil {
+		return x.MaxBatchSize
+	}
+	return 0
+}
+
+func (x *FeeParams) GetMaxBatchDataSize() uint64 {
+	if x != nil {
+		return x.MaxBatchDataSize
+	}
+	return 0
+}
+
+func (x *FeeParams) GetUploadPerMib() []*v2.FeeRange {
+	if x != nil {
+		return x.UploadPerMib
+	}
+	return nil
+}
+
+func (x *FeeParams) GetMaxUploadSize() uint64 {
+	if x != nil {
+		return x.MaxUploadSize
+	}
+	return 0
+}
+
+func (x *FeeParams) GetMaxChunkSize() uint64 {
+	if x != nil {
+		return x.MaxChunkSize
+	}
+	return 0
+}
+
+func (x *FeeParams) GetUploadExpiry() *durationpb.Duration {
+	if x != nil {
+		return x.UploadExpiry
+	}
+	return nil
+}
+
+func (x *FeeParams) GetImageSizePricing() *SizePricing {
+	if x != nil {
+		return x.ImageSizePricing
+	}
+	return nil
+}
+
+func (x *FeeParams) GetJsonSizePricing() *SizePricing {
+	if x != nil {
+		return x.JsonSizePricing
+	}
+	return nil
+}
+
+func (x *FeeParams) GetDefaultSizePricing() *SizePricing {
+	if x != nil {
+		return x.DefaultSizePricing
+	}
+	return nil
+}
+
+// SizePricing defines a size-tiered fee for creating a resource.
+// The fee is the base fee plus the fee per KiB for every started KiB of resource data.
+type SizePricing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Base fee charged for every resource regardless of its size
+	Base []*v2.FeeRange `protobuf:"bytes,1,rep,name=base,proto3" json:"base,omitempty"`
+	// Fee charged per started KiB of resource data.
+	// Every denom of the base fee must have a fee per KiB.
+	PerKib []*v2.FeeRange `protobuf:"bytes,2,rep,name=per_kib,json=perKib,proto3" json:"per_kib,omitempty"`
+}
+
+func (x *SizePricing) Reset() {
+	*x = SizePricing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cheqd_resource_v2_fee_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SizePricing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SizePricing) ProtoMessage() {}
+
+// Deprecated: Use SizePricing.ProtoReflect.Descriptor instead.
+func (*SizePricing) Descriptor() ([]byte, []int) {
+	return file_cheqd_resource_v2_fee_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SizePricing) GetBase() []*v2.FeeRange {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *SizePricing) GetPerKib() []*v2.FeeRange {
+	if x != nil {
+		return x.PerKib
+	}
+	return nil
+}
+
 var File_cheqd_resource_v2_fee_proto protoreflect.FileDescriptor
 
 var file_cheqd_resource_v2_fee_proto_rawDesc = []byte{
@@ -947,7 +2675,9 @@ var file_cheqd_resource_v2_fee_proto_rawDesc = []byte{
 	0x32, 0x2f, 0x66, 0x65, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x82, 0x02, 0x0a,
+	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc4, 0x07, 0x0a,
 	0x09, 0x46, 0x65, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x32, 0x0a, 0x05, 0x69, 0x6d,
 	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x65, 0x71,
 	0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x61, 0x6e, 0x67,
@@ -958,26 +2688,78 @@ var file_cheqd_resource_v2_fee_proto_rawDesc = []byte{
 	0x12, 0x36, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28,
 	0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32,
 	0x2e, 0x46, 0x65, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
-	0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x57, 0x0a, 0x0b, 0x62, 0x75, 0x72, 0x6e,
-	0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8,
-	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
-	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
-	0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63,
-	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x62, 0x75, 0x72, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x6f,
-	0x72, 0x42, 0xcc, 0x01, 0xa8, 0xe2, 0x1e, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x68,
-	0x65, 0x71, 0x64, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x32, 0x42,
-	0x08, 0x46, 0x65, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3f, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2f, 0x63, 0x68,
-	0x65, 0x71, 0x64, 0x2d, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f,
-	0x63, 0x68, 0x65, 0x71, 0x64, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2f, 0x76,
-	0x32, 0x3b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x76, 0x32, 0xa2, 0x02, 0x03, 0x43,
-	0x52, 0x58, 0xaa, 0x02, 0x11, 0x43, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x2e, 0x56, 0x32, 0xca, 0x02, 0x11, 0x43, 0x68, 0x65, 0x71, 0x64, 0x5c, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5c, 0x56, 0x32, 0xe2, 0x02, 0x1d, 0x43, 0x68, 0x65,
-	0x71, 0x64, 0x5c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5c, 0x56, 0x32, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x43, 0x68, 0x65,
-	0x71, 0x64, 0x3a, 0x3a, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x3a, 0x3a, 0x56, 0x32,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x56, 0x0a, 0x18, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x65,
+	0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x16, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x4b, 0x0a, 0x12, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63,
+	0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x46, 0x65, 0x65, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11, 0x64, 0x65, 0x70, 0x72,
+	0x65, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x57, 0x0a,
+	0x0b, 0x62, 0x75, 0x72, 0x6e, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x62, 0x75, 0x72, 0x6e,
+	0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c,
+	0x6d, 0x61, 0x78, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2d, 0x0a, 0x13,
+	0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x42, 0x0a, 0x0e, 0x75,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x69, 0x62, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e,
+	0x76, 0x32, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x0c, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x65, 0x72, 0x4d, 0x69, 0x62, 0x12,
+	0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x6d, 0x61, 0x78, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x48, 0x0a,
+	0x0d, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x0c, 0x75, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x4c, 0x0a, 0x12, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x69, 0x7a, 0x65, 0x50, 0x72, 0x69, 0x63,
+	0x69, 0x6e, 0x67, 0x52, 0x10, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x50, 0x72,
+	0x69, 0x63, 0x69, 0x6e, 0x67, 0x12, 0x4a, 0x0a, 0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x69, 0x7a, 0x65, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67,
+	0x52, 0x0f, 0x6a, 0x73, 0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e,
+	0x67, 0x12, 0x50, 0x0a, 0x14, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x76, 0x32, 0x2e, 0x53, 0x69, 0x7a, 0x65, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x52,
+	0x12, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x50, 0x72, 0x69, 0x63,
+	0x69, 0x6e, 0x67, 0x22, 0x76, 0x0a, 0x0b, 0x53, 0x69, 0x7a, 0x65, 0x50, 0x72, 0x69, 0x63, 0x69,
+	0x6e, 0x67, 0x12, 0x30, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e,
+	0x46, 0x65, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04,
+	0x62, 0x61, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x69, 0x62, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69,
+	0x64, 0x2e, 0x76, 0x32, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x65, 0x72, 0x4b, 0x69, 0x62, 0x42, 0xcc, 0x01, 0xa8, 0xe2,
+	0x1e, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x32, 0x42, 0x08, 0x46, 0x65, 0x65, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2f, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2d, 0x6e, 0x6f,
+	0x64, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2f,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2f, 0x76, 0x32, 0x3b, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x76, 0x32, 0xa2, 0x02, 0x03, 0x43, 0x52, 0x58, 0xaa, 0x02, 0x11, 0x43,
+	0x68, 0x65, 0x71, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x56, 0x32,
+	0xca, 0x02, 0x11, 0x43, 0x68, 0x65, 0x71, 0x64, 0x5c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5c, 0x56, 0x32, 0xe2, 0x02, 0x1d, 0x43, 0x68, 0x65, 0x71, 0x64, 0x5c, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5c, 0x56, 0x32, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x43, 0x68, 0x65, 0x71, 0x64, 0x3a, 0x3a, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x3a, 0x3a, 0x56, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -992,20 +2774,31 @@ func file_cheqd_resource_v2_fee_proto_rawDescGZIP() []byte {
 	return file_cheqd_resource_v2_fee_proto_rawDescData
 }
 
-var file_cheqd_resource_v2_fee_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cheqd_resource_v2_fee_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_cheqd_resource_v2_fee_proto_goTypes = []interface{}{
-	(*FeeParams)(nil),   // 0: cheqd.resource.v2.FeeParams
-	(*v2.FeeRange)(nil), // 1: cheqd.did.v2.FeeRange
+	(*FeeParams)(nil),           // 0: cheqd.resource.v2.FeeParams
+	(*SizePricing)(nil),         // 1: cheqd.resource.v2.SizePricing
+	(*v2.FeeRange)(nil),         // 2: cheqd.did.v2.FeeRange
+	(*durationpb.Duration)(nil), // 3: google.protobuf.Duration
 }
 var file_cheqd_resource_v2_fee_proto_depIdxs = []int32{
-	1, // 0: cheqd.resource.v2.FeeParams.image:type_name -> cheqd.did.v2.FeeRange
-	1, // 1: cheqd.resource.v2.FeeParams.json:type_name -> cheqd.did.v2.FeeRange
-	1, // 2: cheqd.resource.v2.FeeParams.default:type_name -> cheqd.did.v2.FeeRange
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	2,  // 0: cheqd.resource.v2.FeeParams.image:type_name -> cheqd.did.v2.FeeRange
+	2,  // 1: cheqd.resource.v2.FeeParams.json:type_name -> cheqd.did.v2.FeeRange
+	2,  // 2: cheqd.resource.v2.FeeParams.default:type_name -> cheqd.did.v2.FeeRange
+	2,  // 3: cheqd.resource.v2.FeeParams.update_resource_metadata:type_name -> cheqd.did.v2.FeeRange
+	2,  // 4: cheqd.resource.v2.FeeParams.deprecate_resource:type_name -> cheqd.did.v2.FeeRange
+	2,  // 5: cheqd.resource.v2.FeeParams.upload_per_mib:type_name -> cheqd.did.v2.FeeRange
+	3,  // 6: cheqd.resource.v2.FeeParams.upload_expiry:type_name -> google.protobuf.Duration
+	1,  // 7: cheqd.resource.v2.FeeParams.image_size_pricing:type_name -> cheqd.resource.v2.SizePricing
+	1,  // 8: cheqd.resource.v2.FeeParams.json_size_pricing:type_name -> cheqd.resource.v2.SizePricing
+	1,  // 9: cheqd.resource.v2.FeeParams.default_size_pricing:type_name -> cheqd.resource.v2.SizePricing
+	2,  // 10: cheqd.resource.v2.SizePricing.base:type_name -> cheqd.did.v2.FeeRange
+	2,  // 11: cheqd.resource.v2.SizePricing.per_kib:type_name -> cheqd.did.v2.FeeRange
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_cheqd_resource_v2_fee_proto_init() }
@@ -1026,6 +2819,18 @@ func file_cheqd_resource_v2_fee_proto_init() {
 				return nil
 			}
 		}
+		file_cheqd_resource_v2_fee_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SizePricing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1033,7 +2838,7 @@ func file_cheqd_resource_v2_fee_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cheqd_resource_v2_fee_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
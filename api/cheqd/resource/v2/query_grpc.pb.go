@@ -24,6 +24,11 @@ const (
 	Query_LatestResourceVersion_FullMethodName         = "/cheqd.resource.v2.Query/LatestResourceVersion"
 	Query_LatestResourceVersionMetadata_FullMethodName = "/cheqd.resource.v2.Query/LatestResourceVersionMetadata"
 	Query_CollectionResources_FullMethodName           = "/cheqd.resource.v2.Query/CollectionResources"
+	Query_ResourceVersions_FullMethodName              = "/cheqd.resource.v2.Query/ResourceVersions"
+	Query_ResourceAtTime_FullMethodName                = "/cheqd.resource.v2.Query/ResourceAtTime"
+	Query_AllResources_FullMethodName                  = "/cheqd.resource.v2.Query/AllResources"
+	Query_Stats_FullMethodName                         = "/cheqd.resource.v2.Query/Stats"
+	Query_EstimateResourceFee_FullMethodName           = "/cheqd.resource.v2.Query/EstimateResourceFee"
 	Query_Params_FullMethodName                        = "/cheqd.resource.v2.Query/Params"
 )
 
@@ -43,7 +48,17 @@ type QueryClient interface {
 	LatestResourceVersionMetadata(ctx context.Context, in *QueryLatestResourceVersionMetadataRequest, opts ...grpc.CallOption) (*QueryLatestResourceVersionMetadataResponse, error)
 	// Fetch metadata for all resources in a collection
 	CollectionResources(ctx context.Context, in *QueryCollectionResourcesRequest, opts ...grpc.CallOption) (*QueryCollectionResourcesResponse, error)
+	// Fetch the version chain for a specific resource, starting from the latest version
+	ResourceVersions(ctx context.Context, in *QueryResourceVersionsRequest, opts ...grpc.CallOption) (*QueryResourceVersionsResponse, error)
+	// Fetch the version of a specific resource that was the latest at a given time
+	ResourceAtTime(ctx context.Context, in *QueryResourceAtTimeRequest, opts ...grpc.CallOption) (*QueryResourceAtTimeResponse, error)
 	// Params queries params of the resource module.
+	// List the metadata of all resources across collections
+	AllResources(ctx context.Context, in *QueryAllResourcesRequest, opts ...grpc.CallOption) (*QueryAllResourcesResponse, error)
+	// Fetch the numbers of resources by media type and the total size of stored resource data
+	Stats(ctx context.Context, in *QueryStatsRequest, opts ...grpc.CallOption) (*QueryStatsResponse, error)
+	// Estimate the fee for creating a resource of a given media type and size
+	EstimateResourceFee(ctx context.Context, in *QueryEstimateResourceFeeRequest, opts ...grpc.CallOption) (*QueryEstimateResourceFeeResponse, error)
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
@@ -105,6 +120,56 @@ func (c *queryClient) CollectionResources(ctx context.Context, in *QueryCollecti
 	return out, nil
 }
 
+func (c *queryClient) ResourceVersions(ctx context.Context, in *QueryResourceVersionsRequest, opts ...grpc.CallOption) (*QueryResourceVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryResourceVersionsResponse)
+	err := c.cc.Invoke(ctx, Query_ResourceVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourceAtTime(ctx context.Context, in *QueryResourceAtTimeRequest, opts ...grpc.CallOption) (*QueryResourceAtTimeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryResourceAtTimeResponse)
+	err := c.cc.Invoke(ctx, Query_ResourceAtTime_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllResources(ctx context.Context, in *QueryAllResourcesRequest, opts ...grpc.CallOption) (*QueryAllResourcesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAllResourcesResponse)
+	err := c.cc.Invoke(ctx, Query_AllResources_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Stats(ctx context.Context, in *QueryStatsRequest, opts ...grpc.CallOption) (*QueryStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryStatsResponse)
+	err := c.cc.Invoke(ctx, Query_Stats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateResourceFee(ctx context.Context, in *QueryEstimateResourceFeeRequest, opts ...grpc.CallOption) (*QueryEstimateResourceFeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEstimateResourceFeeResponse)
+	err := c.cc.Invoke(ctx, Query_EstimateResourceFee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryParamsResponse)
@@ -131,7 +196,17 @@ type QueryServer interface {
 	LatestResourceVersionMetadata(context.Context, *QueryLatestResourceVersionMetadataRequest) (*QueryLatestResourceVersionMetadataResponse, error)
 	// Fetch metadata for all resources in a collection
 	CollectionResources(context.Context, *QueryCollectionResourcesRequest) (*QueryCollectionResourcesResponse, error)
+	// Fetch the version chain for a specific resource, starting from the latest version
+	ResourceVersions(context.Context, *QueryResourceVersionsRequest) (*QueryResourceVersionsResponse, error)
+	// Fetch the version of a specific resource that was the latest at a given time
+	ResourceAtTime(context.Context, *QueryResourceAtTimeRequest) (*QueryResourceAtTimeResponse, error)
 	// Params queries params of the resource module.
+	// List the metadata of all resources across collections
+	AllResources(context.Context, *QueryAllResourcesRequest) (*QueryAllResourcesResponse, error)
+	// Fetch the numbers of resources by media type and the total size of stored resource data
+	Stats(context.Context, *QueryStatsRequest) (*QueryStatsResponse, error)
+	// Estimate the fee for creating a resource of a given media type and size
+	EstimateResourceFee(context.Context, *QueryEstimateResourceFeeRequest) (*QueryEstimateResourceFeeResponse, error)
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
@@ -158,6 +233,21 @@ func (UnimplementedQueryServer) LatestResourceVersionMetadata(context.Context, *
 func (UnimplementedQueryServer) CollectionResources(context.Context, *QueryCollectionResourcesRequest) (*QueryCollectionResourcesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CollectionResources not implemented")
 }
+func (UnimplementedQueryServer) ResourceVersions(context.Context, *QueryResourceVersionsRequest) (*QueryResourceVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceVersions not implemented")
+}
+func (UnimplementedQueryServer) ResourceAtTime(context.Context, *QueryResourceAtTimeRequest) (*QueryResourceAtTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceAtTime not implemented")
+}
+func (UnimplementedQueryServer) AllResources(context.Context, *QueryAllResourcesRequest) (*QueryAllResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllResources not implemented")
+}
+func (UnimplementedQueryServer) Stats(context.Context, *QueryStatsRequest) (*QueryStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+}
+func (UnimplementedQueryServer) EstimateResourceFee(context.Context, *QueryEstimateResourceFeeRequest) (*QueryEstimateResourceFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateResourceFee not implemented")
+}
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -272,6 +362,96 @@ func _Query_CollectionResources_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ResourceVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResourceVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourceVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ResourceVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourceVersions(ctx, req.(*QueryResourceVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourceAtTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResourceAtTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourceAtTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ResourceAtTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourceAtTime(ctx, req.(*QueryResourceAtTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AllResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllResources(ctx, req.(*QueryAllResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Stats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Stats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Stats(ctx, req.(*QueryStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateResourceFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateResourceFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateResourceFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EstimateResourceFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateResourceFee(ctx, req.(*QueryEstimateResourceFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -317,6 +497,26 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CollectionResources",
 			Handler:    _Query_CollectionResources_Handler,
 		},
+		{
+			MethodName: "ResourceVersions",
+			Handler:    _Query_ResourceVersions_Handler,
+		},
+		{
+			MethodName: "ResourceAtTime",
+			Handler:    _Query_ResourceAtTime_Handler,
+		},
+		{
+			MethodName: "AllResources",
+			Handler:    _Query_AllResources_Handler,
+		},
+		{
+			MethodName: "Stats",
+			Handler:    _Query_Stats_Handler,
+		},
+		{
+			MethodName: "EstimateResourceFee",
+			Handler:    _Query_EstimateResourceFee_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
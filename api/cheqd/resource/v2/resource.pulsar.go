@@ -500,6 +500,7 @@ var (
 	fd_Metadata_checksum            protoreflect.FieldDescriptor
 	fd_Metadata_previous_version_id protoreflect.FieldDescriptor
 	fd_Metadata_next_version_id     protoreflect.FieldDescriptor
+	fd_Metadata_deprecated          protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -516,6 +517,7 @@ func init() {
 	fd_Metadata_checksum = md_Metadata.Fields().ByName("checksum")
 	fd_Metadata_previous_version_id = md_Metadata.Fields().ByName("previous_version_id")
 	fd_Metadata_next_version_id = md_Metadata.Fields().ByName("next_version_id")
+	fd_Metadata_deprecated = md_Metadata.Fields().ByName("deprecated")
 }
 
 var _ protoreflect.Message = (*fastReflection_Metadata)(nil)
@@ -649,6 +651,12 @@ func (x *fastReflection_Metadata) Range(f func(protoreflect.FieldDescriptor, pro
 			return
 		}
 	}
+	if x.Deprecated != false {
+		value := protoreflect.ValueOfBool(x.Deprecated)
+		if !f(fd_Metadata_deprecated, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -686,6 +694,8 @@ func (x *fastReflection_Metadata) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.PreviousVersionId != ""
 	case "cheqd.resource.v2.Metadata.next_version_id":
 		return x.NextVersionId != ""
+	case "cheqd.resource.v2.Metadata.deprecated":
+		return x.Deprecated != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.Metadata"))
@@ -724,6 +734,8 @@ func (x *fastReflection_Metadata) Clear(fd protoreflect.FieldDescriptor) {
 		x.PreviousVersionId = ""
 	case "cheqd.resource.v2.Metadata.next_version_id":
 		x.NextVersionId = ""
+	case "cheqd.resource.v2.Metadata.deprecated":
+		x.Deprecated = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.Metadata"))
@@ -776,6 +788,9 @@ func (x *fastReflection_Metadata) Get(descriptor protoreflect.FieldDescriptor) p
 	case "cheqd.resource.v2.Metadata.next_version_id":
 		value := x.NextVersionId
 		return protoreflect.ValueOfString(value)
+	case "cheqd.resource.v2.Metadata.deprecated":
+		value := x.Deprecated
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.Metadata"))
@@ -820,6 +835,8 @@ func (x *fastReflection_Metadata) Set(fd protoreflect.FieldDescriptor, value pro
 		x.PreviousVersionId = value.Interface().(string)
 	case "cheqd.resource.v2.Metadata.next_version_id":
 		x.NextVersionId = value.Interface().(string)
+	case "cheqd.resource.v2.Metadata.deprecated":
+		x.Deprecated = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.Metadata"))
@@ -869,6 +886,8 @@ func (x *fastReflection_Metadata) Mutable(fd protoreflect.FieldDescriptor) proto
 		panic(fmt.Errorf("field previous_version_id of message cheqd.resource.v2.Metadata is not mutable"))
 	case "cheqd.resource.v2.Metadata.next_version_id":
 		panic(fmt.Errorf("field next_version_id of message cheqd.resource.v2.Metadata is not mutable"))
+	case "cheqd.resource.v2.Metadata.deprecated":
+		panic(fmt.Errorf("field deprecated of message cheqd.resource.v2.Metadata is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.Metadata"))
@@ -906,6 +925,8 @@ func (x *fastReflection_Metadata) NewField(fd protoreflect.FieldDescriptor) prot
 		return protoreflect.ValueOfString("")
 	case "cheqd.resource.v2.Metadata.next_version_id":
 		return protoreflect.ValueOfString("")
+	case "cheqd.resource.v2.Metadata.deprecated":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.Metadata"))
@@ -1021,6 +1042,9 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Deprecated {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1050,6 +1074,16 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Deprecated {
+			i--
+			if x.Deprecated {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x60
+		}
 		if len(x.NextVersionId) > 0 {
 			i -= len(x.NextVersionId)
 			copy(dAtA[i:], x.NextVersionId)
@@ -1550,6 +1584,26 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 				}
 				x.NextVersionId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deprecated", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Deprecated = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2069,28 +2123,99 @@ func (x *fastReflection_AlternativeUri) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_ResourceUpload_6_list)(nil)
+
+type _ResourceUpload_6_list struct {
+	list *[]*AlternativeUri
+}
+
+func (x *_ResourceUpload_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ResourceUpload_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ResourceUpload_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AlternativeUri)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ResourceUpload_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AlternativeUri)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ResourceUpload_6_list) AppendMutable() protoreflect.Value {
+	v := new(AlternativeUri)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ResourceUpload_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ResourceUpload_6_list) NewElement() protoreflect.Value {
+	v := new(AlternativeUri)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ResourceUpload_6_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_ResourceWithMetadata          protoreflect.MessageDescriptor
-	fd_ResourceWithMetadata_resource protoreflect.FieldDescriptor
-	fd_ResourceWithMetadata_metadata protoreflect.FieldDescriptor
+	md_ResourceUpload               protoreflect.MessageDescriptor
+	fd_ResourceUpload_collection_id protoreflect.FieldDescriptor
+	fd_ResourceUpload_id            protoreflect.FieldDescriptor
+	fd_ResourceUpload_name          protoreflect.FieldDescriptor
+	fd_ResourceUpload_version       protoreflect.FieldDescriptor
+	fd_ResourceUpload_resource_type protoreflect.FieldDescriptor
+	fd_ResourceUpload_also_known_as protoreflect.FieldDescriptor
+	fd_ResourceUpload_size          protoreflect.FieldDescriptor
+	fd_ResourceUpload_checksum      protoreflect.FieldDescriptor
+	fd_ResourceUpload_received      protoreflect.FieldDescriptor
+	fd_ResourceUpload_chunk_count   protoreflect.FieldDescriptor
+	fd_ResourceUpload_expires       protoreflect.FieldDescriptor
+	fd_ResourceUpload_hash_state    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cheqd_resource_v2_resource_proto_init()
-	md_ResourceWithMetadata = File_cheqd_resource_v2_resource_proto.Messages().ByName("ResourceWithMetadata")
-	fd_ResourceWithMetadata_resource = md_ResourceWithMetadata.Fields().ByName("resource")
-	fd_ResourceWithMetadata_metadata = md_ResourceWithMetadata.Fields().ByName("metadata")
+	md_ResourceUpload = File_cheqd_resource_v2_resource_proto.Messages().ByName("ResourceUpload")
+	fd_ResourceUpload_collection_id = md_ResourceUpload.Fields().ByName("collection_id")
+	fd_ResourceUpload_id = md_ResourceUpload.Fields().ByName("id")
+	fd_ResourceUpload_name = md_ResourceUpload.Fields().ByName("name")
+	fd_ResourceUpload_version = md_ResourceUpload.Fields().ByName("version")
+	fd_ResourceUpload_resource_type = md_ResourceUpload.Fields().ByName("resource_type")
+	fd_ResourceUpload_also_known_as = md_ResourceUpload.Fields().ByName("also_known_as")
+	fd_ResourceUpload_size = md_ResourceUpload.Fields().ByName("size")
+	fd_ResourceUpload_checksum = md_ResourceUpload.Fields().ByName("checksum")
+	fd_ResourceUpload_received = md_ResourceUpload.Fields().ByName("received")
+	fd_ResourceUpload_chunk_count = md_ResourceUpload.Fields().ByName("chunk_count")
+	fd_ResourceUpload_expires = md_ResourceUpload.Fields().ByName("expires")
+	fd_ResourceUpload_hash_state = md_ResourceUpload.Fields().ByName("hash_state")
 }
 
-var _ protoreflect.Message = (*fastReflection_ResourceWithMetadata)(nil)
+var _ protoreflect.Message = (*fastReflection_ResourceUpload)(nil)
 
-type fastReflection_ResourceWithMetadata ResourceWithMetadata
+type fastReflection_ResourceUpload ResourceUpload
 
-func (x *ResourceWithMetadata) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ResourceWithMetadata)(x)
+func (x *ResourceUpload) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ResourceUpload)(x)
 }
 
-func (x *ResourceWithMetadata) slowProtoReflect() protoreflect.Message {
+func (x *ResourceUpload) slowProtoReflect() protoreflect.Message {
 	mi := &file_cheqd_resource_v2_resource_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2102,43 +2227,43 @@ func (x *ResourceWithMetadata) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ResourceWithMetadata_messageType fastReflection_ResourceWithMetadata_messageType
-var _ protoreflect.MessageType = fastReflection_ResourceWithMetadata_messageType{}
+var _fastReflection_ResourceUpload_messageType fastReflection_ResourceUpload_messageType
+var _ protoreflect.MessageType = fastReflection_ResourceUpload_messageType{}
 
-type fastReflection_ResourceWithMetadata_messageType struct{}
+type fastReflection_ResourceUpload_messageType struct{}
 
-func (x fastReflection_ResourceWithMetadata_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ResourceWithMetadata)(nil)
+func (x fastReflection_ResourceUpload_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ResourceUpload)(nil)
 }
-func (x fastReflection_ResourceWithMetadata_messageType) New() protoreflect.Message {
-	return new(fastReflection_ResourceWithMetadata)
+func (x fastReflection_ResourceUpload_messageType) New() protoreflect.Message {
+	return new(fastReflection_ResourceUpload)
 }
-func (x fastReflection_ResourceWithMetadata_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ResourceWithMetadata
+func (x fastReflection_ResourceUpload_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ResourceUpload
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ResourceWithMetadata) Descriptor() protoreflect.MessageDescriptor {
-	return md_ResourceWithMetadata
+func (x *fastReflection_ResourceUpload) Descriptor() protoreflect.MessageDescriptor {
+	return md_ResourceUpload
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ResourceWithMetadata) Type() protoreflect.MessageType {
-	return _fastReflection_ResourceWithMetadata_messageType
+func (x *fastReflection_ResourceUpload) Type() protoreflect.MessageType {
+	return _fastReflection_ResourceUpload_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ResourceWithMetadata) New() protoreflect.Message {
-	return new(fastReflection_ResourceWithMetadata)
+func (x *fastReflection_ResourceUpload) New() protoreflect.Message {
+	return new(fastReflection_ResourceUpload)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ResourceWithMetadata) Interface() protoreflect.ProtoMessage {
-	return (*ResourceWithMetadata)(x)
+func (x *fastReflection_ResourceUpload) Interface() protoreflect.ProtoMessage {
+	return (*ResourceUpload)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2146,16 +2271,76 @@ func (x *fastReflection_ResourceWithMetadata) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ResourceWithMetadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Resource != nil {
-		value := protoreflect.ValueOfMessage(x.Resource.ProtoReflect())
-		if !f(fd_ResourceWithMetadata_resource, value) {
+func (x *fastReflection_ResourceUpload) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CollectionId != "" {
+		value := protoreflect.ValueOfString(x.CollectionId)
+		if !f(fd_ResourceUpload_collection_id, value) {
 			return
 		}
 	}
-	if x.Metadata != nil {
-		value := protoreflect.ValueOfMessage(x.Metadata.ProtoReflect())
-		if !f(fd_ResourceWithMetadata_metadata, value) {
+	if x.Id != "" {
+		value := protoreflect.ValueOfString(x.Id)
+		if !f(fd_ResourceUpload_id, value) {
+			return
+		}
+	}
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_ResourceUpload_name, value) {
+			return
+		}
+	}
+	if x.Version != "" {
+		value := protoreflect.ValueOfString(x.Version)
+		if !f(fd_ResourceUpload_version, value) {
+			return
+		}
+	}
+	if x.ResourceType != "" {
+		value := protoreflect.ValueOfString(x.ResourceType)
+		if !f(fd_ResourceUpload_resource_type, value) {
+			return
+		}
+	}
+	if len(x.AlsoKnownAs) != 0 {
+		value := protoreflect.ValueOfList(&_ResourceUpload_6_list{list: &x.AlsoKnownAs})
+		if !f(fd_ResourceUpload_also_known_as, value) {
+			return
+		}
+	}
+	if x.Size != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Size)
+		if !f(fd_ResourceUpload_size, value) {
+			return
+		}
+	}
+	if x.Checksum != "" {
+		value := protoreflect.ValueOfString(x.Checksum)
+		if !f(fd_ResourceUpload_checksum, value) {
+			return
+		}
+	}
+	if x.Received != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Received)
+		if !f(fd_ResourceUpload_received, value) {
+			return
+		}
+	}
+	if x.ChunkCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ChunkCount)
+		if !f(fd_ResourceUpload_chunk_count, value) {
+			return
+		}
+	}
+	if x.Expires != nil {
+		value := protoreflect.ValueOfMessage(x.Expires.ProtoReflect())
+		if !f(fd_ResourceUpload_expires, value) {
+			return
+		}
+	}
+	if len(x.HashState) != 0 {
+		value := protoreflect.ValueOfBytes(x.HashState)
+		if !f(fd_ResourceUpload_hash_state, value) {
 			return
 		}
 	}
@@ -2172,17 +2357,37 @@ func (x *fastReflection_ResourceWithMetadata) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ResourceWithMetadata) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ResourceUpload) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cheqd.resource.v2.ResourceWithMetadata.resource":
-		return x.Resource != nil
-	case "cheqd.resource.v2.ResourceWithMetadata.metadata":
-		return x.Metadata != nil
+	case "cheqd.resource.v2.ResourceUpload.collection_id":
+		return x.CollectionId != ""
+	case "cheqd.resource.v2.ResourceUpload.id":
+		return x.Id != ""
+	case "cheqd.resource.v2.ResourceUpload.name":
+		return x.Name != ""
+	case "cheqd.resource.v2.ResourceUpload.version":
+		return x.Version != ""
+	case "cheqd.resource.v2.ResourceUpload.resource_type":
+		return x.ResourceType != ""
+	case "cheqd.resource.v2.ResourceUpload.also_known_as":
+		return len(x.AlsoKnownAs) != 0
+	case "cheqd.resource.v2.ResourceUpload.size":
+		return x.Size != uint64(0)
+	case "cheqd.resource.v2.ResourceUpload.checksum":
+		return x.Checksum != ""
+	case "cheqd.resource.v2.ResourceUpload.received":
+		return x.Received != uint64(0)
+	case "cheqd.resource.v2.ResourceUpload.chunk_count":
+		return x.ChunkCount != uint64(0)
+	case "cheqd.resource.v2.ResourceUpload.expires":
+		return x.Expires != nil
+	case "cheqd.resource.v2.ResourceUpload.hash_state":
+		return len(x.HashState) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.ResourceWithMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.ResourceUpload"))
 		}
-		panic(fmt.Errorf("message cheqd.resource.v2.ResourceWithMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.resource.v2.ResourceUpload does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2192,17 +2397,37 @@ func (x *fastReflection_ResourceWithMetadata) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ResourceWithMetadata) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ResourceUpload) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cheqd.resource.v2.ResourceWithMetadata.resource":
-		x.Resource = nil
-	case "cheqd.resource.v2.ResourceWithMetadata.metadata":
-		x.Metadata = nil
+	case "cheqd.resource.v2.ResourceUpload.collection_id":
+		x.CollectionId = ""
+	case "cheqd.resource.v2.ResourceUpload.id":
+		x.Id = ""
+	case "cheqd.resource.v2.ResourceUpload.name":
+		x.Name = ""
+	case "cheqd.resource.v2.ResourceUpload.version":
+		x.Version = ""
+	case "cheqd.resource.v2.ResourceUpload.resource_type":
+		x.ResourceType = ""
+	case "cheqd.resource.v2.ResourceUpload.also_known_as":
+		x.AlsoKnownAs = nil
+	case "cheqd.resource.v2.ResourceUpload.size":
+		x.Size = uint64(0)
+	case "cheqd.resource.v2.ResourceUpload.checksum":
+		x.Checksum = ""
+	case "cheqd.resource.v2.ResourceUpload.received":
+		x.Received = uint64(0)
+	case "cheqd.resource.v2.ResourceUpload.chunk_count":
+		x.ChunkCount = uint64(0)
+	case "cheqd.resource.v2.ResourceUpload.expires":
+		x.Expires = nil
+	case "cheqd.resource.v2.ResourceUpload.hash_state":
+		x.HashState = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.ResourceWithMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.ResourceUpload"))
 		}
-		panic(fmt.Errorf("message cheqd.resource.v2.ResourceWithMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.resource.v2.ResourceUpload does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2212,19 +2437,52 @@ func (x *fastReflection_ResourceWithMetadata) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ResourceWithMetadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ResourceUpload) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cheqd.resource.v2.ResourceWithMetadata.resource":
-		value := x.Resource
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cheqd.resource.v2.ResourceWithMetadata.metadata":
-		value := x.Metadata
+	case "cheqd.resource.v2.ResourceUpload.collection_id":
+		value := x.CollectionId
+		return protoreflect.ValueOfString(value)
+	case "cheqd.resource.v2.ResourceUpload.id":
+		value := x.Id
+		return protoreflect.ValueOfString(value)
+	case "cheqd.resource.v2.ResourceUpload.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "cheqd.resource.v2.ResourceUpload.version":
+		value := x.Version
+		return protoreflect.ValueOfString(value)
+	case "cheqd.resource.v2.ResourceUpload.resource_type":
+		value := x.ResourceType
+		return protoreflect.ValueOfString(value)
+	case "cheqd.resource.v2.ResourceUpload.also_known_as":
+		if len(x.AlsoKnownAs) == 0 {
+			return protoreflect.ValueOfList(&_ResourceUpload_6_list{})
+		}
+		listValue := &_ResourceUpload_6_list{list: &x.AlsoKnownAs}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.resource.v2.ResourceUpload.size":
+		value := x.Size
+		return protoreflect.ValueOfUint64(value)
+	case "cheqd.resource.v2.ResourceUpload.checksum":
+		value := x.Checksum
+		return protoreflect.ValueOfString(value)
+	case "cheqd.resource.v2.ResourceUpload.received":
+		value := x.Received
+		return protoreflect.ValueOfUint64(value)
+	case "cheqd.resource.v2.ResourceUpload.chunk_count":
+		value := x.ChunkCount
+		return protoreflect.ValueOfUint64(value)
+	case "cheqd.resource.v2.ResourceUpload.expires":
+		value := x.Expires
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cheqd.resource.v2.ResourceUpload.hash_state":
+		value := x.HashState
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.ResourceWithMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.ResourceUpload"))
 		}
-		panic(fmt.Errorf("message cheqd.resource.v2.ResourceWithMetadata does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cheqd.resource.v2.ResourceUpload does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2238,17 +2496,39 @@ func (x *fastReflection_ResourceWithMetadata) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ResourceWithMetadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ResourceUpload) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cheqd.resource.v2.ResourceWithMetadata.resource":
-		x.Resource = value.Message().Interface().(*Resource)
-	case "cheqd.resource.v2.ResourceWithMetadata.metadata":
-		x.Metadata = value.Message().Interface().(*Metadata)
+	case "cheqd.resource.v2.ResourceUpload.collection_id":
+		x.CollectionId = value.Interface().(string)
+	case "cheqd.resource.v2.ResourceUpload.id":
+		x.Id = value.Interface().(string)
+	case "cheqd.resource.v2.ResourceUpload.name":
+		x.Name = value.Interface().(string)
+	case "cheqd.resource.v2.ResourceUpload.version":
+		x.Version = value.Interface().(string)
+	case "cheqd.resource.v2.ResourceUpload.resource_type":
+		x.ResourceType = value.Interface().(string)
+	case "cheqd.resource.v2.ResourceUpload.also_known_as":
+		lv := value.List()
+		clv := lv.(*_ResourceUpload_6_list)
+		x.AlsoKnownAs = *clv.list
+	case "cheqd.resource.v2.ResourceUpload.size":
+		x.Size = value.Uint()
+	case "cheqd.resource.v2.ResourceUpload.checksum":
+		x.Checksum = value.Interface().(string)
+	case "cheqd.resource.v2.ResourceUpload.received":
+		x.Received = value.Uint()
+	case "cheqd.resource.v2.ResourceUpload.chunk_count":
+		x.ChunkCount = value.Uint()
+	case "cheqd.resource.v2.ResourceUpload.expires":
+		x.Expires = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cheqd.resource.v2.ResourceUpload.hash_state":
+		x.HashState = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.ResourceWithMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.ResourceUpload"))
 		}
-		panic(fmt.Errorf("message cheqd.resource.v2.ResourceWithMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.resource.v2.ResourceUpload does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2262,52 +2542,93 @@ func (x *fastReflection_ResourceWithMetadata) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ResourceWithMetadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ResourceUpload) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.resource.v2.ResourceWithMetadata.resource":
-		if x.Resource == nil {
-			x.Resource = new(Resource)
+	case "cheqd.resource.v2.ResourceUpload.also_known_as":
+		if x.AlsoKnownAs == nil {
+			x.AlsoKnownAs = []*AlternativeUri{}
 		}
-		return protoreflect.ValueOfMessage(x.Resource.ProtoReflect())
-	case "cheqd.resource.v2.ResourceWithMetadata.metadata":
-		if x.Metadata == nil {
-			x.Metadata = new(Metadata)
+		value := &_ResourceUpload_6_list{list: &x.AlsoKnownAs}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.resource.v2.ResourceUpload.expires":
+		if x.Expires == nil {
+			x.Expires = new(timestamppb.Timestamp)
 		}
-		return protoreflect.ValueOfMessage(x.Metadata.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Expires.ProtoReflect())
+	case "cheqd.resource.v2.ResourceUpload.collection_id":
+		panic(fmt.Errorf("field collection_id of message cheqd.resource.v2.ResourceUpload is not mutable"))
+	case "cheqd.resource.v2.ResourceUpload.id":
+		panic(fmt.Errorf("field id of message cheqd.resource.v2.ResourceUpload is not mutable"))
+	case "cheqd.resource.v2.ResourceUpload.name":
+		panic(fmt.Errorf("field name of message cheqd.resource.v2.ResourceUpload is not mutable"))
+	case "cheqd.resource.v2.ResourceUpload.version":
+		panic(fmt.Errorf("field version of message cheqd.resource.v2.ResourceUpload is not mutable"))
+	case "cheqd.resource.v2.ResourceUpload.resource_type":
+		panic(fmt.Errorf("field resource_type of message cheqd.resource.v2.ResourceUpload is not mutable"))
+	case "cheqd.resource.v2.ResourceUpload.size":
+		panic(fmt.Errorf("field size of message cheqd.resource.v2.ResourceUpload is not mutable"))
+	case "cheqd.resource.v2.ResourceUpload.checksum":
+		panic(fmt.Errorf("field checksum of message cheqd.resource.v2.ResourceUpload is not mutable"))
+	case "cheqd.resource.v2.ResourceUpload.received":
+		panic(fmt.Errorf("field received of message cheqd.resource.v2.ResourceUpload is not mutable"))
+	case "cheqd.resource.v2.ResourceUpload.chunk_count":
+		panic(fmt.Errorf("field chunk_count of message cheqd.resource.v2.ResourceUpload is not mutable"))
+	case "cheqd.resource.v2.ResourceUpload.hash_state":
+		panic(fmt.Errorf("field hash_state of message cheqd.resource.v2.ResourceUpload is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.ResourceWithMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.ResourceUpload"))
 		}
-		panic(fmt.Errorf("message cheqd.resource.v2.ResourceWithMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.resource.v2.ResourceUpload does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ResourceWithMetadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ResourceUpload) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.resource.v2.ResourceWithMetadata.resource":
-		m := new(Resource)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cheqd.resource.v2.ResourceWithMetadata.metadata":
-		m := new(Metadata)
+	case "cheqd.resource.v2.ResourceUpload.collection_id":
+		return protoreflect.ValueOfString("")
+	case "cheqd.resource.v2.ResourceUpload.id":
+		return protoreflect.ValueOfString("")
+	case "cheqd.resource.v2.ResourceUpload.name":
+		return protoreflect.ValueOfString("")
+	case "cheqd.resource.v2.ResourceUpload.version":
+		return protoreflect.ValueOfString("")
+	case "cheqd.resource.v2.ResourceUpload.resource_type":
+		return protoreflect.ValueOfString("")
+	case "cheqd.resource.v2.ResourceUpload.also_known_as":
+		list := []*AlternativeUri{}
+		return protoreflect.ValueOfList(&_ResourceUpload_6_list{list: &list})
+	case "cheqd.resource.v2.ResourceUpload.size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cheqd.resource.v2.ResourceUpload.checksum":
+		return protoreflect.ValueOfString("")
+	case "cheqd.resource.v2.ResourceUpload.received":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cheqd.resource.v2.ResourceUpload.chunk_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cheqd.resource.v2.ResourceUpload.expires":
+		m := new(timestamppb.Timestamp)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cheqd.resource.v2.ResourceUpload.hash_state":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.ResourceWithMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.ResourceUpload"))
 		}
-		panic(fmt.Errorf("message cheqd.resource.v2.ResourceWithMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.resource.v2.ResourceUpload does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ResourceWithMetadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ResourceUpload) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cheqd.resource.v2.ResourceWithMetadata", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.resource.v2.ResourceUpload", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2315,7 +2636,7 @@ func (x *fastReflection_ResourceWithMetadata) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ResourceWithMetadata) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ResourceUpload) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2326,7 +2647,7 @@ func (x *fastReflection_ResourceWithMetadata) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ResourceWithMetadata) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ResourceUpload) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2338,7 +2659,7 @@ func (x *fastReflection_ResourceWithMetadata) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ResourceWithMetadata) IsValid() bool {
+func (x *fastReflection_ResourceUpload) IsValid() bool {
 	return x != nil
 }
 
@@ -2348,9 +2669,9 @@ func (x *fastReflection_ResourceWithMetadata) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ResourceWithMetadata) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ResourceUpload) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ResourceWithMetadata)
+		x := input.Message.Interface().(*ResourceUpload)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2362,16 +2683,916 @@ func (x *fastReflection_ResourceWithMetadata) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.Resource != nil {
-			l = options.Size(x.Resource)
+		l = len(x.CollectionId)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Metadata != nil {
-			l = options.Size(x.Metadata)
+		l = len(x.Id)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Version)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ResourceType)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.AlsoKnownAs) > 0 {
+			for _, e := range x.AlsoKnownAs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Size != 0 {
+			n += 1 + runtime.Sov(uint64(x.Size))
+		}
+		l = len(x.Checksum)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Received != 0 {
+			n += 1 + runtime.Sov(uint64(x.Received))
+		}
+		if x.ChunkCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.ChunkCount))
+		}
+		if x.Expires != nil {
+			l = options.Size(x.Expires)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.HashState)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ResourceUpload)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.HashState) > 0 {
+			i -= len(x.HashState)
+			copy(dAtA[i:], x.HashState)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HashState)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.Expires != nil {
+			encoded, err := options.Marshal(x.Expires)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.ChunkCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChunkCount))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.Received != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Received))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.Checksum) > 0 {
+			i -= len(x.Checksum)
+			copy(dAtA[i:], x.Checksum)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Checksum)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.Size != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Size))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.AlsoKnownAs) > 0 {
+			for iNdEx := len(x.AlsoKnownAs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AlsoKnownAs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.ResourceType) > 0 {
+			i -= len(x.ResourceType)
+			copy(dAtA[i:], x.ResourceType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ResourceType)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Version) > 0 {
+			i -= len(x.Version)
+			copy(dAtA[i:], x.Version)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Version)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Id) > 0 {
+			i -= len(x.Id)
+			copy(dAtA[i:], x.Id)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.CollectionId) > 0 {
+			i -= len(x.CollectionId)
+			copy(dAtA[i:], x.CollectionId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CollectionId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ResourceUpload)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ResourceUpload: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ResourceUpload: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CollectionId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CollectionId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Id = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Version = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResourceType", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ResourceType = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AlsoKnownAs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AlsoKnownAs = append(x.AlsoKnownAs, &AlternativeUri{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AlsoKnownAs[len(x.AlsoKnownAs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Size", wireType)
+				}
+				x.Size = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Size |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Checksum = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+				}
+				x.Received = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Received |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChunkCount", wireType)
+				}
+				x.ChunkCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ChunkCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expires", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Expires == nil {
+					x.Expires = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expires); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HashState", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HashState = append(x.HashState[:0], dAtA[iNdEx:postIndex]...)
+				if x.HashState == nil {
+					x.HashState = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ResourceWithMetadata          protoreflect.MessageDescriptor
+	fd_ResourceWithMetadata_resource protoreflect.FieldDescriptor
+	fd_ResourceWithMetadata_metadata protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cheqd_resource_v2_resource_proto_init()
+	md_ResourceWithMetadata = File_cheqd_resource_v2_resource_proto.Messages().ByName("ResourceWithMetadata")
+	fd_ResourceWithMetadata_resource = md_ResourceWithMetadata.Fields().ByName("resource")
+	fd_ResourceWithMetadata_metadata = md_ResourceWithMetadata.Fields().ByName("metadata")
+}
+
+var _ protoreflect.Message = (*fastReflection_ResourceWithMetadata)(nil)
+
+type fastReflection_ResourceWithMetadata ResourceWithMetadata
+
+func (x *ResourceWithMetadata) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ResourceWithMetadata)(x)
+}
+
+func (x *ResourceWithMetadata) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_resource_v2_resource_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ResourceWithMetadata_messageType fastReflection_ResourceWithMetadata_messageType
+var _ protoreflect.MessageType = fastReflection_ResourceWithMetadata_messageType{}
+
+type fastReflection_ResourceWithMetadata_messageType struct{}
+
+func (x fastReflection_ResourceWithMetadata_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ResourceWithMetadata)(nil)
+}
+func (x fastReflection_ResourceWithMetadata_messageType) New() protoreflect.Message {
+	return new(fastReflection_ResourceWithMetadata)
+}
+func (x fastReflection_ResourceWithMetadata_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ResourceWithMetadata
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ResourceWithMetadata) Descriptor() protoreflect.MessageDescriptor {
+	return md_ResourceWithMetadata
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ResourceWithMetadata) Type() protoreflect.MessageType {
+	return _fastReflection_ResourceWithMetadata_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ResourceWithMetadata) New() protoreflect.Message {
+	return new(fastReflection_ResourceWithMetadata)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ResourceWithMetadata) Interface() protoreflect.ProtoMessage {
+	return (*ResourceWithMetadata)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ResourceWithMetadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Resource != nil {
+		value := protoreflect.ValueOfMessage(x.Resource.ProtoReflect())
+		if !f(fd_ResourceWithMetadata_resource, value) {
+			return
+		}
+	}
+	if x.Metadata != nil {
+		value := protoreflect.ValueOfMessage(x.Metadata.ProtoReflect())
+		if !f(fd_ResourceWithMetadata_metadata, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ResourceWithMetadata) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cheqd.resource.v2.ResourceWithMetadata.resource":
+		return x.Resource != nil
+	case "cheqd.resource.v2.ResourceWithMetadata.metadata":
+		return x.Metadata != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.ResourceWithMetadata"))
+		}
+		panic(fmt.Errorf("message cheqd.resource.v2.ResourceWithMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ResourceWithMetadata) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cheqd.resource.v2.ResourceWithMetadata.resource":
+		x.Resource = nil
+	case "cheqd.resource.v2.ResourceWithMetadata.metadata":
+		x.Metadata = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.ResourceWithMetadata"))
+		}
+		panic(fmt.Errorf("message cheqd.resource.v2.ResourceWithMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ResourceWithMetadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cheqd.resource.v2.ResourceWithMetadata.resource":
+		value := x.Resource
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cheqd.resource.v2.ResourceWithMetadata.metadata":
+		value := x.Metadata
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.ResourceWithMetadata"))
+		}
+		panic(fmt.Errorf("message cheqd.resource.v2.ResourceWithMetadata does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ResourceWithMetadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cheqd.resource.v2.ResourceWithMetadata.resource":
+		x.Resource = value.Message().Interface().(*Resource)
+	case "cheqd.resource.v2.ResourceWithMetadata.metadata":
+		x.Metadata = value.Message().Interface().(*Metadata)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.ResourceWithMetadata"))
+		}
+		panic(fmt.Errorf("message cheqd.resource.v2.ResourceWithMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ResourceWithMetadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.resource.v2.ResourceWithMetadata.resource":
+		if x.Resource == nil {
+			x.Resource = new(Resource)
+		}
+		return protoreflect.ValueOfMessage(x.Resource.ProtoReflect())
+	case "cheqd.resource.v2.ResourceWithMetadata.metadata":
+		if x.Metadata == nil {
+			x.Metadata = new(Metadata)
+		}
+		return protoreflect.ValueOfMessage(x.Metadata.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.ResourceWithMetadata"))
+		}
+		panic(fmt.Errorf("message cheqd.resource.v2.ResourceWithMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ResourceWithMetadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.resource.v2.ResourceWithMetadata.resource":
+		m := new(Resource)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cheqd.resource.v2.ResourceWithMetadata.metadata":
+		m := new(Metadata)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.ResourceWithMetadata"))
+		}
+		panic(fmt.Errorf("message cheqd.resource.v2.ResourceWithMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ResourceWithMetadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.resource.v2.ResourceWithMetadata", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ResourceWithMetadata) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ResourceWithMetadata) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ResourceWithMetadata) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ResourceWithMetadata) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ResourceWithMetadata)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Resource != nil {
+			l = options.Size(x.Resource)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Metadata != nil {
+			l = options.Size(x.Metadata)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
 		}
 		return protoiface.SizeOutput{
 			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2683,6 +3904,8 @@ type Metadata struct {
 	// This is based on the Resource's name and Resource type to determine whether it's the same Resource.
 	// Format: <uuid>
 	NextVersionId string `protobuf:"bytes,11,opt,name=next_version_id,json=nextVersionId,proto3" json:"next_version_id,omitempty"`
+	// deprecated signals that the Resource is obsolete and should no longer be used. Set by Msg/DeprecateResource.
+	Deprecated bool `protobuf:"varint,12,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
 }
 
 func (x *Metadata) Reset() {
@@ -2782,6 +4005,13 @@ func (x *Metadata) GetNextVersionId() string {
 	return ""
 }
 
+func (x *Metadata) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
 // AlternativeUri are alternative URIs that can be used to access the Resource.
 // By default, at least the DID URI equivalent of the Resource is populated.
 type AlternativeUri struct {
@@ -2839,6 +4069,145 @@ func (x *AlternativeUri) GetDescription() string {
 	return ""
 }
 
+// ResourceUpload stores the state of a pending chunked upload of a DID-Linked Resource
+type ResourceUpload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// collection_id is the ID of the collection that the Resource will belong to. Defined client-side.
+	// Format: <unique-identifier>
+	CollectionId string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	// id is the ID of the Resource. Defined client-side.
+	// Format: <uuid>
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// name is a human-readable name for the Resource. Defined client-side.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// version is a human-readable semantic version for the Resource. Defined client-side.
+	Version string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	// resource_type is a Resource type that identifies what the Resource is. Defined client-side.
+	ResourceType string `protobuf:"bytes,5,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	// List of alternative URIs for the SAME Resource. Defined client-side.
+	AlsoKnownAs []*AlternativeUri `protobuf:"bytes,6,rep,name=also_known_as,json=alsoKnownAs,proto3" json:"also_known_as,omitempty"`
+	// size is the declared total size in bytes of the Resource data. Defined client-side.
+	Size uint64 `protobuf:"varint,7,opt,name=size,proto3" json:"size,omitempty"`
+	// checksum is the declared SHA-256 checksum hash of the Resource data. Defined client-side.
+	Checksum string `protobuf:"bytes,8,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// received is the number of bytes received so far. Defined ledger-side.
+	Received uint64 `protobuf:"varint,9,opt,name=received,proto3" json:"received,omitempty"`
+	// chunk_count is the number of chunks received so far. Defined ledger-side.
+	ChunkCount uint64 `protobuf:"varint,10,opt,name=chunk_count,json=chunkCount,proto3" json:"chunk_count,omitempty"`
+	// expires is the time after which the upload is pruned if it has not been finalized. Defined ledger-side.
+	// Format: RFC3339
+	Expires *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=expires,proto3" json:"expires,omitempty"`
+	// hash_state is the intermediate state of the SHA-256 hash of the received chunks. Defined ledger-side.
+	HashState []byte `protobuf:"bytes,12,opt,name=hash_state,json=hashState,proto3" json:"hash_state,omitempty"`
+}
+
+func (x *ResourceUpload) Reset() {
+	*x = ResourceUpload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cheqd_resource_v2_resource_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourceUpload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceUpload) ProtoMessage() {}
+
+// Deprecated: Use ResourceUpload.ProtoReflect.Descriptor instead.
+func (*ResourceUpload) Descriptor() ([]byte, []int) {
+	return file_cheqd_resource_v2_resource_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ResourceUpload) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *ResourceUpload) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ResourceUpload) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResourceUpload) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ResourceUpload) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *ResourceUpload) GetAlsoKnownAs() []*AlternativeUri {
+	if x != nil {
+		return x.AlsoKnownAs
+	}
+	return nil
+}
+
+func (x *ResourceUpload) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ResourceUpload) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+func (x *ResourceUpload) GetReceived() uint64 {
+	if x != nil {
+		return x.Received
+	}
+	return 0
+}
+
+func (x *ResourceUpload) GetChunkCount() uint64 {
+	if x != nil {
+		return x.ChunkCount
+	}
+	return 0
+}
+
+func (x *ResourceUpload) GetExpires() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expires
+	}
+	return nil
+}
+
+func (x *ResourceUpload) GetHashState() []byte {
+	if x != nil {
+		return x.HashState
+	}
+	return nil
+}
+
 // ResourceWithMetadata describes the overall structure of a DID-Linked Resource
 type ResourceWithMetadata struct {
 	state         protoimpl.MessageState
@@ -2852,7 +4221,7 @@ type ResourceWithMetadata struct {
 func (x *ResourceWithMetadata) Reset() {
 	*x = ResourceWithMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cheqd_resource_v2_resource_proto_msgTypes[3]
+		mi := &file_cheqd_resource_v2_resource_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2866,7 +4235,7 @@ func (*ResourceWithMetadata) ProtoMessage() {}
 
 // Deprecated: Use ResourceWithMetadata.ProtoReflect.Descriptor instead.
 func (*ResourceWithMetadata) Descriptor() ([]byte, []int) {
-	return file_cheqd_resource_v2_resource_proto_rawDescGZIP(), []int{3}
+	return file_cheqd_resource_v2_resource_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ResourceWithMetadata) GetResource() *Resource {
@@ -2894,7 +4263,7 @@ var file_cheqd_resource_v2_resource_proto_rawDesc = []byte{
 	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d,
 	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x1e, 0x0a, 0x08,
 	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xbf, 0x04, 0x0a,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xdf, 0x04, 0x0a,
 	0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3d, 0x0a, 0x0d, 0x63, 0x6f, 0x6c,
 	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x18, 0xea, 0xde, 0x1f, 0x14, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f,
@@ -2930,37 +4299,74 @@ var file_cheqd_resource_v2_resource_proto_rawDesc = []byte{
 	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
 	0x12, 0x2c, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
 	0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x01, 0x52,
-	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x44,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0x44,
 	0x0a, 0x0e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x55, 0x72, 0x69,
 	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
 	0x72, 0x69, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
 	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb8, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x4b, 0x0a,
-	0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x12, 0xea, 0xde,
-	0x1f, 0x0e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x08, 0x6d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63,
-	0x68, 0x65, 0x71, 0x64, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x32,
-	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x1a, 0xea, 0xde, 0x1f, 0x16, 0x6c,
-	0x69, 0x6e, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42,
-	0xcd, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x32, 0x42, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2f, 0x63, 0x68, 0x65,
-	0x71, 0x64, 0x2d, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x63,
-	0x68, 0x65, 0x71, 0x64, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2f, 0x76, 0x32,
-	0x3b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x76, 0x32, 0xa2, 0x02, 0x03, 0x43, 0x52,
-	0x58, 0xaa, 0x02, 0x11, 0x43, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x2e, 0x56, 0x32, 0xca, 0x02, 0x11, 0x43, 0x68, 0x65, 0x71, 0x64, 0x5c, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5c, 0x56, 0x32, 0xe2, 0x02, 0x1d, 0x43, 0x68, 0x65, 0x71,
-	0x64, 0x5c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5c, 0x56, 0x32, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x43, 0x68, 0x65, 0x71,
-	0x64, 0x3a, 0x3a, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x3a, 0x3a, 0x56, 0x32, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb2, 0x04, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x3d, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xea, 0xde, 0x1f, 0x14, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0e, 0xea, 0xde, 0x1f, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x49, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xea, 0xde, 0x1f, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xc8,
+	0xde, 0x1f, 0x01, 0xea, 0xde, 0x1f, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x35, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xea, 0xde, 0x1f, 0x0c, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x65, 0x0a, 0x0d, 0x61, 0x6c, 0x73, 0x6f, 0x5f, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x61, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x76,
+	0x32, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x55, 0x72, 0x69,
+	0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x01, 0xea, 0xde, 0x1f, 0x16, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x55, 0x72, 0x69,
+	0x52, 0x0b, 0x61, 0x6c, 0x73, 0x6f, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x41, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x75,
+	0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x07, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f,
+	0x01, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x61,
+	0x73, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x68, 0x61, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0xb8, 0x01, 0x0a, 0x14, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x4b, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x42, 0x12, 0xea, 0xde, 0x1f, 0x0e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x53, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x1a,
+	0xea, 0xde, 0x1f, 0x16, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x42, 0xcd, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x68, 0x65,
+	0x71, 0x64, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x32, 0x42, 0x0d,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x68, 0x65, 0x71,
+	0x64, 0x2f, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2d, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x32, 0x2f, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2f, 0x76, 0x32, 0x3b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x76, 0x32,
+	0xa2, 0x02, 0x03, 0x43, 0x52, 0x58, 0xaa, 0x02, 0x11, 0x43, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x56, 0x32, 0xca, 0x02, 0x11, 0x43, 0x68, 0x65,
+	0x71, 0x64, 0x5c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5c, 0x56, 0x32, 0xe2, 0x02,
+	0x1d, 0x43, 0x68, 0x65, 0x71, 0x64, 0x5c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5c,
+	0x56, 0x32, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x13, 0x43, 0x68, 0x65, 0x71, 0x64, 0x3a, 0x3a, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x3a, 0x3a, 0x56, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2975,24 +4381,27 @@ func file_cheqd_resource_v2_resource_proto_rawDescGZIP() []byte {
 	return file_cheqd_resource_v2_resource_proto_rawDescData
 }
 
-var file_cheqd_resource_v2_resource_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_cheqd_resource_v2_resource_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_cheqd_resource_v2_resource_proto_goTypes = []interface{}{
 	(*Resource)(nil),              // 0: cheqd.resource.v2.Resource
 	(*Metadata)(nil),              // 1: cheqd.resource.v2.Metadata
 	(*AlternativeUri)(nil),        // 2: cheqd.resource.v2.AlternativeUri
-	(*ResourceWithMetadata)(nil),  // 3: cheqd.resource.v2.ResourceWithMetadata
-	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+	(*ResourceUpload)(nil),        // 3: cheqd.resource.v2.ResourceUpload
+	(*ResourceWithMetadata)(nil),  // 4: cheqd.resource.v2.ResourceWithMetadata
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
 }
 var file_cheqd_resource_v2_resource_proto_depIdxs = []int32{
 	2, // 0: cheqd.resource.v2.Metadata.also_known_as:type_name -> cheqd.resource.v2.AlternativeUri
-	4, // 1: cheqd.resource.v2.Metadata.created:type_name -> google.protobuf.Timestamp
-	0, // 2: cheqd.resource.v2.ResourceWithMetadata.resource:type_name -> cheqd.resource.v2.Resource
-	1, // 3: cheqd.resource.v2.ResourceWithMetadata.metadata:type_name -> cheqd.resource.v2.Metadata
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	5, // 1: cheqd.resource.v2.Metadata.created:type_name -> google.protobuf.Timestamp
+	2, // 2: cheqd.resource.v2.ResourceUpload.also_known_as:type_name -> cheqd.resource.v2.AlternativeUri
+	5, // 3: cheqd.resource.v2.ResourceUpload.expires:type_name -> google.protobuf.Timestamp
+	0, // 4: cheqd.resource.v2.ResourceWithMetadata.resource:type_name -> cheqd.resource.v2.Resource
+	1, // 5: cheqd.resource.v2.ResourceWithMetadata.metadata:type_name -> cheqd.resource.v2.Metadata
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_cheqd_resource_v2_resource_proto_init() }
@@ -3038,6 +4447,18 @@ func file_cheqd_resource_v2_resource_proto_init() {
 			}
 		}
 		file_cheqd_resource_v2_resource_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceUpload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cheqd_resource_v2_resource_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResourceWithMetadata); i {
 			case 0:
 				return &v.state
@@ -3056,7 +4477,7 @@ func file_cheqd_resource_v2_resource_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cheqd_resource_v2_resource_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -19,8 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_CreateResource_FullMethodName = "/cheqd.resource.v2.Msg/CreateResource"
-	Msg_UpdateParams_FullMethodName   = "/cheqd.resource.v2.Msg/UpdateParams"
+	Msg_CreateResource_FullMethodName         = "/cheqd.resource.v2.Msg/CreateResource"
+	Msg_BatchCreateResources_FullMethodName   = "/cheqd.resource.v2.Msg/BatchCreateResources"
+	Msg_UpdateResourceMetadata_FullMethodName = "/cheqd.resource.v2.Msg/UpdateResourceMetadata"
+	Msg_DeprecateResource_FullMethodName      = "/cheqd.resource.v2.Msg/DeprecateResource"
+	Msg_BeginResourceUpload_FullMethodName    = "/cheqd.resource.v2.Msg/BeginResourceUpload"
+	Msg_AppendResourceChunk_FullMethodName    = "/cheqd.resource.v2.Msg/AppendResourceChunk"
+	Msg_FinalizeResourceUpload_FullMethodName = "/cheqd.resource.v2.Msg/FinalizeResourceUpload"
+	Msg_UpdateParams_FullMethodName           = "/cheqd.resource.v2.Msg/UpdateParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -31,6 +37,18 @@ const (
 type MsgClient interface {
 	// CreateResource defines a method for creating a resource.
 	CreateResource(ctx context.Context, in *MsgCreateResource, opts ...grpc.CallOption) (*MsgCreateResourceResponse, error)
+	// BatchCreateResources defines a method for atomically creating multiple resources in a collection.
+	BatchCreateResources(ctx context.Context, in *MsgBatchCreateResources, opts ...grpc.CallOption) (*MsgBatchCreateResourcesResponse, error)
+	// UpdateResourceMetadata defines a method for updating the mutable metadata of a resource.
+	UpdateResourceMetadata(ctx context.Context, in *MsgUpdateResourceMetadata, opts ...grpc.CallOption) (*MsgUpdateResourceMetadataResponse, error)
+	// DeprecateResource defines a method for marking a resource as deprecated.
+	DeprecateResource(ctx context.Context, in *MsgDeprecateResource, opts ...grpc.CallOption) (*MsgDeprecateResourceResponse, error)
+	// BeginResourceUpload defines a method for starting a chunked upload of a large resource.
+	BeginResourceUpload(ctx context.Context, in *MsgBeginResourceUpload, opts ...grpc.CallOption) (*MsgBeginResourceUploadResponse, error)
+	// AppendResourceChunk defines a method for appending a chunk of data to a pending resource upload.
+	AppendResourceChunk(ctx context.Context, in *MsgAppendResourceChunk, opts ...grpc.CallOption) (*MsgAppendResourceChunkResponse, error)
+	// FinalizeResourceUpload defines a method for creating a resource from a completed upload.
+	FinalizeResourceUpload(ctx context.Context, in *MsgFinalizeResourceUpload, opts ...grpc.CallOption) (*MsgFinalizeResourceUploadResponse, error)
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
@@ -52,6 +70,66 @@ func (c *msgClient) CreateResource(ctx context.Context, in *MsgCreateResource, o
 	return out, nil
 }
 
+func (c *msgClient) BatchCreateResources(ctx context.Context, in *MsgBatchCreateResources, opts ...grpc.CallOption) (*MsgBatchCreateResourcesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgBatchCreateResourcesResponse)
+	err := c.cc.Invoke(ctx, Msg_BatchCreateResources_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateResourceMetadata(ctx context.Context, in *MsgUpdateResourceMetadata, opts ...grpc.CallOption) (*MsgUpdateResourceMetadataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateResourceMetadataResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateResourceMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeprecateResource(ctx context.Context, in *MsgDeprecateResource, opts ...grpc.CallOption) (*MsgDeprecateResourceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgDeprecateResourceResponse)
+	err := c.cc.Invoke(ctx, Msg_DeprecateResource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BeginResourceUpload(ctx context.Context, in *MsgBeginResourceUpload, opts ...grpc.CallOption) (*MsgBeginResourceUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgBeginResourceUploadResponse)
+	err := c.cc.Invoke(ctx, Msg_BeginResourceUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AppendResourceChunk(ctx context.Context, in *MsgAppendResourceChunk, opts ...grpc.CallOption) (*MsgAppendResourceChunkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgAppendResourceChunkResponse)
+	err := c.cc.Invoke(ctx, Msg_AppendResourceChunk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FinalizeResourceUpload(ctx context.Context, in *MsgFinalizeResourceUpload, opts ...grpc.CallOption) (*MsgFinalizeResourceUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgFinalizeResourceUploadResponse)
+	err := c.cc.Invoke(ctx, Msg_FinalizeResourceUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgUpdateParamsResponse)
@@ -70,6 +148,18 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 type MsgServer interface {
 	// CreateResource defines a method for creating a resource.
 	CreateResource(context.Context, *MsgCreateResource) (*MsgCreateResourceResponse, error)
+	// BatchCreateResources defines a method for atomically creating multiple resources in a collection.
+	BatchCreateResources(context.Context, *MsgBatchCreateResources) (*MsgBatchCreateResourcesResponse, error)
+	// UpdateResourceMetadata defines a method for updating the mutable metadata of a resource.
+	UpdateResourceMetadata(context.Context, *MsgUpdateResourceMetadata) (*MsgUpdateResourceMetadataResponse, error)
+	// DeprecateResource defines a method for marking a resource as deprecated.
+	DeprecateResource(context.Context, *MsgDeprecateResource) (*MsgDeprecateResourceResponse, error)
+	// BeginResourceUpload defines a method for starting a chunked upload of a large resource.
+	BeginResourceUpload(context.Context, *MsgBeginResourceUpload) (*MsgBeginResourceUploadResponse, error)
+	// AppendResourceChunk defines a method for appending a chunk of data to a pending resource upload.
+	AppendResourceChunk(context.Context, *MsgAppendResourceChunk) (*MsgAppendResourceChunkResponse, error)
+	// FinalizeResourceUpload defines a method for creating a resource from a completed upload.
+	FinalizeResourceUpload(context.Context, *MsgFinalizeResourceUpload) (*MsgFinalizeResourceUploadResponse, error)
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
@@ -84,6 +174,24 @@ type UnimplementedMsgServer struct{}
 func (UnimplementedMsgServer) CreateResource(context.Context, *MsgCreateResource) (*MsgCreateResourceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateResource not implemented")
 }
+func (UnimplementedMsgServer) BatchCreateResources(context.Context, *MsgBatchCreateResources) (*MsgBatchCreateResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateResources not implemented")
+}
+func (UnimplementedMsgServer) UpdateResourceMetadata(context.Context, *MsgUpdateResourceMetadata) (*MsgUpdateResourceMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateResourceMetadata not implemented")
+}
+func (UnimplementedMsgServer) DeprecateResource(context.Context, *MsgDeprecateResource) (*MsgDeprecateResourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeprecateResource not implemented")
+}
+func (UnimplementedMsgServer) BeginResourceUpload(context.Context, *MsgBeginResourceUpload) (*MsgBeginResourceUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginResourceUpload not implemented")
+}
+func (UnimplementedMsgServer) AppendResourceChunk(context.Context, *MsgAppendResourceChunk) (*MsgAppendResourceChunkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppendResourceChunk not implemented")
+}
+func (UnimplementedMsgServer) FinalizeResourceUpload(context.Context, *MsgFinalizeResourceUpload) (*MsgFinalizeResourceUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizeResourceUpload not implemented")
+}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -126,6 +234,114 @@ func _Msg_CreateResource_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_BatchCreateResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchCreateResources)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchCreateResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BatchCreateResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchCreateResources(ctx, req.(*MsgBatchCreateResources))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateResourceMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateResourceMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateResourceMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateResourceMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateResourceMetadata(ctx, req.(*MsgUpdateResourceMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeprecateResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeprecateResource)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeprecateResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DeprecateResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeprecateResource(ctx, req.(*MsgDeprecateResource))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BeginResourceUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBeginResourceUpload)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BeginResourceUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BeginResourceUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BeginResourceUpload(ctx, req.(*MsgBeginResourceUpload))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AppendResourceChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAppendResourceChunk)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AppendResourceChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AppendResourceChunk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AppendResourceChunk(ctx, req.(*MsgAppendResourceChunk))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FinalizeResourceUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFinalizeResourceUpload)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FinalizeResourceUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FinalizeResourceUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FinalizeResourceUpload(ctx, req.(*MsgFinalizeResourceUpload))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -155,6 +371,30 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateResource",
 			Handler:    _Msg_CreateResource_Handler,
 		},
+		{
+			MethodName: "BatchCreateResources",
+			Handler:    _Msg_BatchCreateResources_Handler,
+		},
+		{
+			MethodName: "UpdateResourceMetadata",
+			Handler:    _Msg_UpdateResourceMetadata_Handler,
+		},
+		{
+			MethodName: "DeprecateResource",
+			Handler:    _Msg_DeprecateResource_Handler,
+		},
+		{
+			MethodName: "BeginResourceUpload",
+			Handler:    _Msg_BeginResourceUpload_Handler,
+		},
+		{
+			MethodName: "AppendResourceChunk",
+			Handler:    _Msg_AppendResourceChunk_Handler,
+		},
+		{
+			MethodName: "FinalizeResourceUpload",
+			Handler:    _Msg_FinalizeResourceUpload_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -164,6 +164,7 @@ import (
 	_ "github.com/cheqd/cheqd-node/app/client/docs/statik"
 	upgradeV3 "github.com/cheqd/cheqd-node/app/upgrades/v3"
 	upgradeV4 "github.com/cheqd/cheqd-node/app/upgrades/v4"
+	upgradeV5 "github.com/cheqd/cheqd-node/app/upgrades/v5"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	protov2 "google.golang.org/protobuf/proto"
 
@@ -293,9 +294,17 @@ func New(
 		},
 	}
 	signopts.DefineCustomGetSigners(protov2.MessageName(&resourcev2.MsgCreateResource{}), resourcetypes.CreateGetSigners(&signopts))
+	signopts.DefineCustomGetSigners(protov2.MessageName(&resourcev2.MsgUpdateResourceMetadata{}), resourcetypes.CreateGetSigners(&signopts))
+	signopts.DefineCustomGetSigners(protov2.MessageName(&resourcev2.MsgDeprecateResource{}), resourcetypes.CreateGetSigners(&signopts))
 	signopts.DefineCustomGetSigners(protov2.MessageName(&didv2.MsgCreateDidDoc{}), didtypes.CreateGetSigners(&signopts))
 	signopts.DefineCustomGetSigners(protov2.MessageName(&didv2.MsgUpdateDidDoc{}), didtypes.CreateGetSigners(&signopts))
 	signopts.DefineCustomGetSigners(protov2.MessageName(&didv2.MsgDeactivateDidDoc{}), didtypes.CreateGetSigners(&signopts))
+	signopts.DefineCustomGetSigners(protov2.MessageName(&didv2.MsgBatchCreateDidDocs{}), didtypes.CreateGetSigners(&signopts))
+	signopts.DefineCustomGetSigners(protov2.MessageName(&didv2.MsgRecoverDidDoc{}), didtypes.CreateGetSigners(&signopts))
+	signopts.DefineCustomGetSigners(protov2.MessageName(&resourcev2.MsgBatchCreateResources{}), resourcetypes.CreateGetSigners(&signopts))
+	signopts.DefineCustomGetSigners(protov2.MessageName(&resourcev2.MsgBeginResourceUpload{}), resourcetypes.CreateGetSigners(&signopts))
+	signopts.DefineCustomGetSigners(protov2.MessageName(&resourcev2.MsgAppendResourceChunk{}), resourcetypes.CreateGetSigners(&signopts))
+	signopts.DefineCustomGetSigners(protov2.MessageName(&resourcev2.MsgFinalizeResourceUpload{}), resourcetypes.CreateGetSigners(&signopts))
 
 	interfaceRegistry, _ := types.NewInterfaceRegistryWithOptions(types.InterfaceRegistryOptions{
 		ProtoFiles:     proto.HybridResolver,
@@ -778,6 +787,13 @@ func New(
 		app.AccountKeeper, app.BankKeeper,
 		app.StakingKeeper, app.OracleKeeper, authority,
 	)
+	app.DidKeeper.SetLinkedResourcesProvider(app.ResourceKeeper)
+	feeEstimator := posthandler.NewFeeEstimator(
+		app.DidKeeper, app.ResourceKeeper, app.OracleKeeper, app.FeeabsKeeper,
+		app.OracleKeeper.PriceFeeder, txConfig.TxDecoder(), interfaceRegistry,
+	)
+	app.DidKeeper.SetFeeEstimator(feeEstimator)
+	app.ResourceKeeper.SetFeeEstimator(feeEstimator)
 
 	// NOTE: we may consider parsing `appOpts` inside module constructors. For the moment
 	// we prefer to be more strict in what arguments the modules expect.
@@ -1585,6 +1601,13 @@ func (app *App) RegisterUpgradeHandlers() {
 			return migrations, nil
 		},
 	)
+
+	app.UpgradeKeeper.SetUpgradeHandler(
+		upgradeV5.UpgradeName,
+		func(ctx context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			return app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM)
+		},
+	)
 }
 
 // configure store loader that checks if version == upgradeHeight and applies store upgrades
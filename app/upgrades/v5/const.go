@@ -0,0 +1,5 @@
+package v5
+
+const (
+	UpgradeName = "v5"
+)
@@ -3,6 +3,8 @@ package posthandler
 import (
 	"context"
 
+	"cosmossdk.io/math"
+	cheqdante "github.com/cheqd/cheqd-node/ante"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	feeabstypes "github.com/osmosis-labs/fee-abstraction/v8/x/feeabs/types"
@@ -37,6 +39,14 @@ type BankKeeper interface {
 	BurnCoins(ctx context.Context, name string, amt sdk.Coins) error
 }
 
+// DidKeeper defines the contract needed for identity fee discounts and prepaid identity credits.
+type DidKeeper interface {
+	cheqdante.DidKeeper
+	GetIdentityFeeDiscount(ctx context.Context, address string) (math.LegacyDec, error)
+	DrawIdentityCredits(ctx context.Context, address string, amount math.Int) (math.Int, error)
+	RecordIdentityFeePaid(ctx context.Context, address string) error
+}
+
 // FeeAbsKeeper defines the contract needed for fee abstraction related APIs.
 //
 //go:generate mockery --name FeeAbsKeeper --filename mock_feeabs_keeper.go
@@ -0,0 +1,233 @@
+package posthandler
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	cheqdante "github.com/cheqd/cheqd-node/ante"
+	"github.com/cheqd/cheqd-node/pricefeeder"
+	didtypes "github.com/cheqd/cheqd-node/x/did/types"
+	oracletypes "github.com/cheqd/cheqd-node/x/oracle/types"
+	resourcetypes "github.com/cheqd/cheqd-node/x/resource/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	feeabskeeper "github.com/osmosis-labs/fee-abstraction/v8/x/feeabs/keeper"
+	feeabstypes "github.com/osmosis-labs/fee-abstraction/v8/x/feeabs/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	PriceSourceOracle = "oracle"
+	PriceSourceICQ    = "icq"
+	// PriceSourceFrozen is reported while the price circuit breaker freezes USD-denominated fee conversion
+	PriceSourceFrozen = "frozen"
+)
+
+// FeeEstimator estimates identity fees with the same code path the ante and post handlers charge them with
+type FeeEstimator struct {
+	didKeeper         cheqdante.DidKeeper
+	resourceKeeper    cheqdante.ResourceKeeper
+	oracleKeeper      cheqdante.OracleKeeper
+	feeabsKeeper      feeabskeeper.Keeper
+	oraclePricefeeder *pricefeeder.PriceFeeder
+	txDecoder         sdk.TxDecoder
+	interfaceRegistry codectypes.InterfaceRegistry
+}
+
+var (
+	_ didtypes.FeeEstimator      = FeeEstimator{}
+	_ resourcetypes.FeeEstimator = FeeEstimator{}
+)
+
+// NewFeeEstimator returns a new FeeEstimator
+func NewFeeEstimator(dk cheqdante.DidKeeper, rk cheqdante.ResourceKeeper, ok cheqdante.OracleKeeper, fak feeabskeeper.Keeper, pf *pricefeeder.PriceFeeder, txDecoder sdk.TxDecoder, interfaceRegistry codectypes.InterfaceRegistry) FeeEstimator {
+	return FeeEstimator{
+		didKeeper:         dk,
+		resourceKeeper:    rk,
+		oracleKeeper:      ok,
+		feeabsKeeper:      fak,
+		oraclePricefeeder: pf,
+		txDecoder:         txDecoder,
+		interfaceRegistry: interfaceRegistry,
+	}
+}
+
+// EstimateIdentityFee returns the fee range, the burn and reward portions and the price used for the identity messages
+func (fe FeeEstimator) EstimateIdentityFee(goCtx context.Context, req *didtypes.QueryEstimateIdentityFeeRequest) (*didtypes.QueryEstimateIdentityFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	msgs, err := fe.getMsgs(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := fe.checkPriceAvailable(ctx); err != nil {
+		return nil, err
+	}
+
+	frozen := !fe.oracleKeeper.IsPriceHealthy(ctx, oracletypes.CheqdSymbol)
+	if frozen && req.FeeDenom == oracletypes.UsdDenom {
+		return nil, status.Error(codes.Unavailable, "CHEQ/USD price circuit breaker is tripped")
+	}
+
+	// fees are not paid in usd, so usd estimates are quoted from the ncheq fee
+	payDenom := req.FeeDenom
+	if payDenom == oracletypes.UsdDenom {
+		payDenom = oracletypes.CheqdDenom
+	}
+
+	estimate, err := cheqdante.EstimateTaxableMsgsFee(ctx, fe.didKeeper, fe.resourceKeeper, fe.oracleKeeper, fe.feeabsKeeper, fe.oraclePricefeeder, msgs, payDenom)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	minFee := estimate.MinFee
+	if payDenom != req.FeeDenom {
+		converted, err := fe.convertToFeeDenom(ctx, sdk.NewCoins(minFee), req.FeeDenom, estimate.CheqPrice)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		minFee = *converted
+	}
+
+	reward, err := ConvertToCheq(estimate.Reward, estimate.CheqPrice)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	burn, err := ConvertToCheq(estimate.Burn, estimate.CheqPrice)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var maxFee *sdk.Coin
+	if estimate.MaxFees != nil {
+		maxFee, err = fe.convertToFeeDenom(ctx, estimate.MaxFees, req.FeeDenom, estimate.CheqPrice)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		// rounding in the conversion must not report a maximum below the minimum
+		if maxFee.Amount.LT(minFee.Amount) {
+			maxFee = &minFee
+		}
+	}
+
+	priceSource := PriceSourceOracle
+	switch {
+	case frozen:
+		priceSource = PriceSourceFrozen
+	case !estimate.FromOracle:
+		priceSource = PriceSourceICQ
+	}
+
+	return &didtypes.QueryEstimateIdentityFeeResponse{
+		MinFee:      minFee,
+		MaxFee:      maxFee,
+		Reward:      reward,
+		Burn:        burn,
+		Price:       estimate.CheqPrice,
+		PriceSource: priceSource,
+	}, nil
+}
+
+// EstimateResourceFee returns the fee in ncheq for creating a resource of the media type and size, and the denoms it can be paid in
+func (fe FeeEstimator) EstimateResourceFee(goCtx context.Context, req *resourcetypes.QueryEstimateResourceFeeRequest) (*resourcetypes.QueryEstimateResourceFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := fe.checkPriceAvailable(ctx); err != nil {
+		return nil, err
+	}
+
+	params, err := fe.resourceKeeper.GetParams(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	feeRanges, sizeTiered := params.GetCreateResourceFee(req.MediaType, req.Size_)
+	fee, err := cheqdante.EstimateFeeRangesMinFee(ctx, fe.oracleKeeper, fe.feeabsKeeper, fe.oraclePricefeeder, feeRanges, oracletypes.CheqdDenom)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	acceptedDenoms := []string{oracletypes.CheqdDenom}
+	fe.feeabsKeeper.IterateHostZone(ctx, func(hostZoneConfig feeabstypes.HostChainFeeAbsConfig) bool {
+		if hostZoneConfig.Status != feeabstypes.HostChainFeeAbsStatus_FROZEN {
+			acceptedDenoms = append(acceptedDenoms, hostZoneConfig.IbcDenom)
+		}
+		return false
+	})
+
+	return &resourcetypes.QueryEstimateResourceFeeResponse{
+		Fee:            fee,
+		SizeTiered:     sizeTiered,
+		AcceptedDenoms: acceptedDenoms,
+	}, nil
+}
+
+// checkPriceAvailable fails if neither the oracle nor the ICQ fallback price of CHEQ is available.
+// The ICQ fallback price is only available with a running price feeder.
+func (fe FeeEstimator) checkPriceAvailable(ctx sdk.Context) error {
+	if _, err := fe.oracleKeeper.GetFeeConversionPrice(ctx, oracletypes.CheqdSymbol); err != nil &&
+		(fe.oraclePricefeeder == nil || fe.oraclePricefeeder.GetOracle() == nil) {
+		return status.Error(codes.Unavailable, "CHEQ price unavailable")
+	}
+
+	return nil
+}
+
+// getMsgs decodes the messages of the transaction, or resolves the single message type to estimate
+func (fe FeeEstimator) getMsgs(req *didtypes.QueryEstimateIdentityFeeRequest) ([]sdk.Msg, error) {
+	if len(req.TxBytes) > 0 {
+		tx, err := fe.txDecoder(req.TxBytes)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid tx: %s", err)
+		}
+		return tx.GetMsgs(), nil
+	}
+
+	protoMsg, err := fe.interfaceRegistry.Resolve(req.MsgTypeUrl)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown message type: %s", req.MsgTypeUrl)
+	}
+	msg, ok := protoMsg.(sdk.Msg)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "%s is not a message", req.MsgTypeUrl)
+	}
+
+	return []sdk.Msg{msg}, nil
+}
+
+// convertToFeeDenom converts the fees in ncheq or usd into a single coin of the fee denom
+func (fe FeeEstimator) convertToFeeDenom(ctx sdk.Context, fees sdk.Coins, feeDenom string, cheqPrice math.LegacyDec) (*sdk.Coin, error) {
+	if feeDenom == oracletypes.UsdDenom && fees.DenomsSubsetOf(sdk.NewCoins(sdk.NewCoin(oracletypes.UsdDenom, math.ZeroInt()))) {
+		fee := sdk.NewCoin(feeDenom, fees.AmountOf(oracletypes.UsdDenom))
+		return &fee, nil
+	}
+
+	ncheqFees, err := ConvertToCheq(fees, cheqPrice)
+	if err != nil {
+		return nil, err
+	}
+	ncheq := ncheqFees.AmountOf(oracletypes.CheqdDenom)
+
+	var amount math.Int
+	switch feeDenom {
+	case oracletypes.CheqdDenom:
+		amount = ncheq
+	case oracletypes.UsdDenom:
+		// Convert: ncheq (1e9) → CHEQ → USD (1e18)
+		amount, err = oracletypes.NcheqToUSD(ncheq, cheqPrice)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		twapRate, err := fe.feeabsKeeper.GetTwapRate(ctx, feeDenom)
+		if err != nil {
+			return nil, err
+		}
+		amount = ncheq.ToLegacyDec().Mul(twapRate).TruncateInt()
+	}
+
+	fee := sdk.NewCoin(feeDenom, amount)
+	return &fee, nil
+}
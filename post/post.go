@@ -14,7 +14,7 @@ type HandlerOptions struct {
 	AccountKeeper   ante.AccountKeeper
 	BankKeeper      BankKeeper
 	FeegrantKeeper  ante.FeegrantKeeper
-	DidKeeper       cheqdante.DidKeeper
+	DidKeeper       DidKeeper
 	ResourceKeeper  cheqdante.ResourceKeeper
 	FeeMarketKeeper FeeMarketKeeper
 	OracleKeeper    cheqdante.OracleKeeper
@@ -8,9 +8,7 @@ import (
 	"cosmossdk.io/math"
 	cheqdante "github.com/cheqd/cheqd-node/ante"
 	"github.com/cheqd/cheqd-node/pricefeeder"
-	"github.com/cheqd/cheqd-node/util"
 	didtypes "github.com/cheqd/cheqd-node/x/did/types"
-	oraclekeeper "github.com/cheqd/cheqd-node/x/oracle/keeper"
 	oracletypes "github.com/cheqd/cheqd-node/x/oracle/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -27,7 +25,7 @@ type TaxDecorator struct {
 	accountKeeper     ante.AccountKeeper
 	bankKeeper        BankKeeper
 	feegrantKeeper    ante.FeegrantKeeper
-	didKeeper         cheqdante.DidKeeper
+	didKeeper         DidKeeper
 	resourceKeeper    cheqdante.ResourceKeeper
 	feemarketKeeper   FeeMarketKeeper
 	oracleKeeper      cheqdante.OracleKeeper
@@ -37,7 +35,7 @@ type TaxDecorator struct {
 }
 
 // NewTaxDecorator returns a new taxDecorator
-func NewTaxDecorator(ak ante.AccountKeeper, bk BankKeeper, fk ante.FeegrantKeeper, dk cheqdante.DidKeeper, rk cheqdante.ResourceKeeper, fmk FeeMarketKeeper, ok cheqdante.OracleKeeper, fak feeabskeeper.Keeper, pf *pricefeeder.PriceFeeder, gfk *globalfeekeeper.Keeper) TaxDecorator {
+func NewTaxDecorator(ak ante.AccountKeeper, bk BankKeeper, fk ante.FeegrantKeeper, dk DidKeeper, rk cheqdante.ResourceKeeper, fmk FeeMarketKeeper, ok cheqdante.OracleKeeper, fak feeabskeeper.Keeper, pf *pricefeeder.PriceFeeder, gfk *globalfeekeeper.Keeper) TaxDecorator {
 	return TaxDecorator{
 		accountKeeper:     ak,
 		bankKeeper:        bk,
@@ -395,11 +393,6 @@ func (td *TaxDecorator) handleTaxableTransaction(
 	rewards, burn sdk.Coins,
 	tx sdk.Tx,
 ) error {
-	var (
-		convertedRewards sdk.Coins
-		convertedBurn    sdk.Coins
-	)
-
 	params, err := td.feemarketKeeper.GetParams(ctx)
 	if err != nil {
 		return err
@@ -407,17 +400,52 @@ func (td *TaxDecorator) handleTaxableTransaction(
 	nativeDenom := params.FeeDenom
 	onlyNativeDenom := td.isOnlyNativeDenom(feeTx.GetFee(), nativeDenom)
 
-	cheqPrice, found := td.oracleKeeper.GetWMA(ctx, oracletypes.CheqdSymbol, string(oraclekeeper.WmaStrategyBalanced))
-	if !found {
-		return err
+	cheqPrice, err := td.oracleKeeper.GetFeeConversionPrice(ctx, oracletypes.CheqdSymbol)
+	if err != nil {
+		// nothing can be converted without an oracle price
+		return nil
 	}
 	if onlyNativeDenom {
-		err := td.processNativeDenomTax(ctx, feeTx, simulate, rewards, burn, tx, &convertedRewards, &convertedBurn, cheqPrice)
-		if err != nil {
+		if err := td.validateTax(feeTx.GetFee(), simulate); err != nil {
 			return err
 		}
-	} else {
-		if err := td.handleNonNativeTax(ctx, feeTx, simulate, rewards, burn, tx, cheqPrice, &convertedRewards, &convertedBurn); err != nil {
+	}
+
+	convertedRewards, err := ConvertToCheq(rewards, cheqPrice.Price)
+	if err != nil {
+		return fmt.Errorf("failed to convert rewards to ncheq: %w", err)
+	}
+	convertedBurn, err := ConvertToCheq(burn, cheqPrice.Price)
+	if err != nil {
+		return fmt.Errorf("failed to convert burn to ncheq: %w", err)
+	}
+
+	// waive the discount of the account paying the fee, then draw what is left from its prepaid credits
+	feeAccount := identityFeeAccount(feeTx).String()
+	discount, err := td.didKeeper.GetIdentityFeeDiscount(ctx, feeAccount)
+	if err != nil {
+		return err
+	}
+	convertedRewards = didtypes.ApplyFeeDiscount(convertedRewards, discount)
+	convertedBurn = didtypes.ApplyFeeDiscount(convertedBurn, discount)
+
+	tax := convertedRewards.Add(convertedBurn...)
+	drawn, err := td.didKeeper.DrawIdentityCredits(ctx, feeAccount, tax.AmountOf(didtypes.BaseMinimalDenom))
+	if err != nil {
+		return err
+	}
+	credits := sdk.NewCoin(didtypes.BaseMinimalDenom, drawn)
+	if credits.IsPositive() {
+		tax = tax.Sub(credits)
+	}
+
+	if !tax.IsZero() {
+		if onlyNativeDenom {
+			err = td.processNativeDenomTax(ctx, feeTx, tax, tx)
+		} else {
+			err = td.handleNonNativeTax(ctx, feeTx, simulate, tax, tx)
+		}
+		if err != nil {
 			return err
 		}
 	}
@@ -428,31 +456,35 @@ func (td *TaxDecorator) handleTaxableTransaction(
 	if err := td.burnFees(ctx, convertedBurn); err != nil {
 		return err
 	}
+	if err := td.didKeeper.RecordIdentityFeePaid(ctx, feeAccount); err != nil {
+		return err
+	}
 
-	return nil
+	return ctx.EventManager().EmitTypedEvent(&didtypes.EventIdentityFeePaid{
+		FeePayer: feeAccount,
+		Reward:   convertedRewards,
+		Burn:     convertedBurn,
+		Discount: discount,
+		Credits:  credits,
+	})
+}
+
+// identityFeeAccount returns the account identity fees are charged to, the fee granter if present and the fee payer otherwise
+func identityFeeAccount(feeTx sdk.FeeTx) sdk.AccAddress {
+	if feeGranter := feeTx.FeeGranter(); feeGranter != nil {
+		return feeGranter
+	}
+
+	return feeTx.FeePayer()
 }
 
 func (td *TaxDecorator) handleNonNativeTax(
 	ctx sdk.Context,
 	feeTx sdk.FeeTx,
 	simulate bool,
-	rewards, burn sdk.Coins,
+	tax sdk.Coins,
 	tx sdk.Tx,
-	cheqPrice math.LegacyDec,
-	convertedRewards, convertedBurn *sdk.Coins,
 ) error {
-	var err error
-
-	*convertedRewards, err = ConvertToCheq(rewards, cheqPrice)
-	if err != nil {
-		return fmt.Errorf("failed to convert rewards to ncheq: %w", err)
-	}
-
-	*convertedBurn, err = ConvertToCheq(burn, cheqPrice)
-	if err != nil {
-		return fmt.Errorf("failed to convert burn to ncheq: %w", err)
-	}
-
 	userFee := feeTx.GetFee()
 	if userFee.IsZero() {
 		return fmt.Errorf("user fee is zero")
@@ -464,9 +496,7 @@ func (td *TaxDecorator) handleNonNativeTax(
 		return fmt.Errorf("host chain config not found for denom: %s", denom)
 	}
 
-	totalTax := convertedRewards.Add(*convertedBurn...)
-
-	return td.processNonNativeDenomTax(ctx, tx, simulate, feeTx, hostChainConfig, totalTax)
+	return td.processNonNativeDenomTax(ctx, tx, simulate, feeTx, hostChainConfig, tax)
 }
 
 func (td *TaxDecorator) isOnlyNativeDenom(fees sdk.Coins, nativeDenom string) bool {
@@ -481,25 +511,9 @@ func (td *TaxDecorator) isOnlyNativeDenom(fees sdk.Coins, nativeDenom string) bo
 func (td *TaxDecorator) processNativeDenomTax(
 	ctx sdk.Context,
 	feeTx sdk.FeeTx,
-	simulate bool,
-	rewards, burn sdk.Coins,
+	tax sdk.Coins,
 	tx sdk.Tx,
-	convertedRewards, convertedBurn *sdk.Coins,
-	cheqPrice math.LegacyDec,
 ) error {
-	if err := td.validateTax(feeTx.GetFee(), simulate); err != nil {
-		return err
-	}
-	var err error
-	*convertedRewards, err = ConvertToCheq(rewards, cheqPrice)
-	if err != nil {
-		return err
-	}
-	*convertedBurn, err = ConvertToCheq(sdk.NewCoins(burn...), cheqPrice)
-	if err != nil {
-		return err
-	}
-	tax := convertedRewards.Add(*convertedBurn...)
 	feePayer, err := td.getFeePayer(ctx, feeTx, tax, tx.GetMsgs())
 	if err != nil {
 		return err
@@ -630,8 +644,10 @@ func ConvertToCheq(coins sdk.Coins, cheqPrice math.LegacyDec) (sdk.Coins, error)
 			}
 
 			// Convert: USD (1e18) → CHEQ → ncheq (1e9)
-			usdAmount := coin.Amount.ToLegacyDec().Quo(math.LegacyNewDecFromInt(util.UsdExponent))
-			ncheqAmount := usdAmount.Quo(cheqPrice).MulInt64(util.CheqScale.Int64()).TruncateInt()
+			ncheqAmount, err := oracletypes.USDToNcheq(coin.Amount, cheqPrice)
+			if err != nil {
+				return nil, err
+			}
 
 			converted = converted.Add(sdk.NewCoin(oracletypes.CheqdDenom, ncheqAmount))
 
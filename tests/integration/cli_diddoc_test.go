@@ -1062,7 +1062,7 @@ var _ = Describe("cheqd cli - positive did", func() {
 				{
 					ID:              did + "#service-1",
 					Type:            "type-1",
-					ServiceEndpoint: []string{"endpoint-1"},
+					ServiceEndpoint: []string{"https://example.com/endpoint-1"},
 					RecipientKeys:   []string{"did:key:z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK"},
 					RoutingKeys:     []string{"did:key:z6MkiTBz1ymuepAQ4HEHYSF1H8quG5GLVVQR3djdX3mDooWp"},
 					Accept:          []string{"didcomm/v2"},
@@ -1071,7 +1071,7 @@ var _ = Describe("cheqd cli - positive did", func() {
 				{
 					ID:              did + "#service-2",
 					Type:            "type-1",
-					ServiceEndpoint: []string{"endpoint-2"},
+					ServiceEndpoint: []string{"https://example.com/endpoint-2"},
 					RecipientKeys:   []string{"did:key:z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK"},
 					RoutingKeys:     []string{},
 					Priority:        1,
@@ -41,6 +41,113 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "id"},
 					},
 				},
+				{
+					RpcMethod: "DidDocAtTime",
+					Use:       "did-at-time [id] [time]",
+					Short:     "Query the version of a DID Document active at a given time",
+					Long:      "Fetch the version of a DID Document that was active at a given time (RFC3339)",
+					Example:   "", // TODO: add the example,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+						{ProtoField: "time"},
+					},
+				},
+				{
+					RpcMethod: "Tombstone",
+					Use:       "tombstone [id]",
+					Short:     "Query the pruned versions of a DID Document",
+					Long:      "Fetch the hash chain of the versions of a DID Document that were pruned according to the version retention policy",
+					Example:   "", // TODO: add the example,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "DidsByController",
+					Use:       "dids-by-controller [controller]",
+					Short:     "Query the DIDs controlled by a DID",
+					Long:      "Fetch the DIDs whose latest DID Document versions list the given DID as controller",
+					Example:   "", // TODO: add the example,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "controller"},
+					},
+				},
+				{
+					RpcMethod: "DidsByKey",
+					Use:       "dids-by-key [verification-method-type] [verification-material]",
+					Short:     "Query the verification methods using a public key",
+					Long:      "Fetch the verification methods of the latest DID Document versions that use the given public key, in any of its supported representations",
+					Example:   "", // TODO: add the example,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "verification_method_type"},
+						{ProtoField: "verification_material"},
+					},
+				},
+				{
+					RpcMethod: "DidsByAlias",
+					Use:       "dids-by-alias [alias]",
+					Short:     "Query the DIDs known as an alias",
+					Long:      "Fetch the DIDs whose latest DID Document versions list the given URI in alsoKnownAs",
+					Example:   "", // TODO: add the example,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "alias"},
+					},
+				},
+				{
+					RpcMethod: "AllDids",
+					Use:       "all-dids",
+					Short:     "Query the latest versions of all DID Documents",
+					Long:      "Fetch the latest versions of all DID Documents with pagination. Use --deactivated to keep only active or deactivated DIDs, and --created-after or --created-before to filter by creation time.",
+					Example:   "", // TODO: add the example,
+				},
+				{
+					RpcMethod: "Stats",
+					Use:       "stats",
+					Short:     "Query the number of DIDs, DID Document versions and deactivated DIDs",
+				},
+				{
+					RpcMethod: "IdentityCredits",
+					Use:       "identity-credits [address]",
+					Short:     "Query the prepaid identity credits, volume and fee discount of an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "AllIdentityCredits",
+					Use:       "all-identity-credits",
+					Short:     "Query the prepaid identity credits and volumes of all accounts",
+				},
+				{
+					RpcMethod: "EstimateIdentityFee",
+					Use:       "estimate-fee [msg-type-url] [fee-denom]",
+					Short:     "Estimate the fee for an identity message paid in ncheq, usd or an IBC denom",
+					Long:      "Estimate the minimum and maximum fee, the burn and reward portions and the CHEQ price used for an identity message type. Use --tx-bytes to estimate the fee for the identity messages of a whole unsigned transaction instead.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "msg_type_url"},
+						{ProtoField: "fee_denom"},
+					},
+				},
+				{
+					RpcMethod: "ResolveDid",
+					Use:       "resolve [id]",
+					Short:     "Resolve a DID to a W3C DID Resolution result",
+					Long:      "Resolve a DID to a W3C DID Resolution result including linked resource metadata. Use --accept to choose between application/did+ld+json (default) and application/did+json, and --version-id or --version-time to resolve a specific version.",
+					Example:   "", // TODO: add the example,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "DereferenceDidUrl",
+					Use:       "dereference [did-url]",
+					Short:     "Dereference a DID URL",
+					Long:      "Dereference a DID URL into a DID Document, verification method, service or DID-Linked Resource. Supports /resources/<id> paths, the versionId, versionTime, resourceName, resourceType and resourceVersionTime query parameters and fragments.",
+					Example:   "", // TODO: add the example,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "did_url"},
+					},
+				},
 				{
 					RpcMethod: "Params",
 					Use:       "params",
@@ -61,6 +168,16 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "amount"},
 					},
 				},
+				{
+					RpcMethod: "PurchaseIdentityCredits",
+					Use:       "purchase-identity-credits [amount] [flags]",
+					Short:     "Prepay identity fees with ncheq",
+					Long:      "Purchase identity credits, which the fees of identity transactions paid by the signer account are drawn from before it is charged",
+					Example:   "",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "amount"},
+					},
+				},
 				{
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
@@ -81,6 +198,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "DeactivateDidDoc",
 					Skip:      true, // skipped as we have the custom command
 				},
+				{
+					RpcMethod: "BatchCreateDidDocs",
+					Skip:      true, // skipped as we have the custom command
+				},
+				{
+					RpcMethod: "RecoverDidDoc",
+					Skip:      true, // skipped as we have the custom command
+				},
 			},
 			EnhanceCustomCommand: true,
 		},
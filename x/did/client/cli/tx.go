@@ -18,7 +18,9 @@ import (
 )
 
 const (
-	FlagVersionID = "version-id"
+	FlagVersionID               = "version-id"
+	FlagRecoveryCommitment      = "recovery-commitment"
+	FlagClearRecoveryCommitment = "clear-recovery-commitment"
 )
 
 type DIDDocument struct {
@@ -33,6 +35,7 @@ type DIDDocument struct {
 	KeyAgreement         []string             `json:"keyAgreement,omitempty"`
 	Service              []Service            `json:"service,omitempty"`
 	AlsoKnownAs          []string             `json:"alsoKnownAs,omitempty"`
+	ControllerThreshold  uint32               `json:"controllerThreshold,omitempty"`
 }
 
 type VerificationMethod map[string]any
@@ -45,6 +48,51 @@ type Service struct {
 	RoutingKeys     []string `json:"routingKeys"`
 	Accept          []string `json:"accept"`
 	Priority        uint32   `json:"priority"`
+	// ServiceEndpointMaps are map-style endpoints (e.g. DIDComm v2), represented in serviceEndpoint following the string endpoints
+	ServiceEndpointMaps []*types.ServiceEndpointMap `json:"-"`
+}
+
+// MarshalJSON represents string and map-style endpoints in the serviceEndpoint property, as in the DID Core specification
+func (s Service) MarshalJSON() ([]byte, error) {
+	type alias Service
+
+	serviceEndpoint, err := types.MarshalServiceEndpoint(s.ServiceEndpoint, s.ServiceEndpointMaps)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(struct {
+		alias
+		ServiceEndpoint json.RawMessage `json:"serviceEndpoint"`
+	}{
+		alias:           alias(s),
+		ServiceEndpoint: serviceEndpoint,
+	})
+}
+
+// UnmarshalJSON accepts a string, a map or a list of strings and maps as serviceEndpoint, as in the DID Core specification
+func (s *Service) UnmarshalJSON(data []byte) error {
+	type alias Service
+
+	aux := struct {
+		*alias
+		ServiceEndpoint json.RawMessage `json:"serviceEndpoint"`
+	}{
+		alias: (*alias)(s),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	serviceEndpoint, serviceEndpointMaps, err := types.UnmarshalServiceEndpoint(aux.ServiceEndpoint)
+	if err != nil {
+		return err
+	}
+
+	s.ServiceEndpoint = serviceEndpoint
+	s.ServiceEndpointMaps = serviceEndpointMaps
+
+	return nil
 }
 
 type PayloadWithSignInputs struct {
@@ -55,6 +103,8 @@ type PayloadWithSignInputs struct {
 type SignInput struct {
 	VerificationMethodID string
 	PrivKey              ed25519.PrivateKey
+	// VersionID optionally binds the signature to a version of the DID Document, e.g. the updated one on key rotation
+	VersionID string
 }
 
 // AddTxFlagsToCmd adds common flags to a module tx command.
@@ -101,6 +151,8 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdCreateDidDoc())
 	cmd.AddCommand(CmdUpdateDidDoc())
 	cmd.AddCommand(CmdDeactivateDidDoc())
+	cmd.AddCommand(CmdBatchCreateDidDocs())
+	cmd.AddCommand(CmdRecoverDidDoc())
 	return cmd
 }
 
@@ -113,6 +165,7 @@ func SignWithSignInputs(signBytes []byte, signInputs []SignInput) []*types.SignI
 		signInfo := types.SignInfo{
 			VerificationMethodId: signInput.VerificationMethodID,
 			Signature:            signatureBytes,
+			VersionId:            signInput.VersionID,
 		}
 
 		signatures = append(signatures, &signInfo)
@@ -178,7 +231,7 @@ func GetFromSpecCompliantPayload(specPayload DIDDocument) ([]*types.Verification
 		}
 
 		switch verificationMethodType {
-		case "Ed25519VerificationKey2020":
+		case "Ed25519VerificationKey2020", "EcdsaSecp256k1VerificationKey2019", "Multikey":
 			_, ok := vm["publicKeyMultibase"]
 			if !ok {
 				return nil, nil, fmt.Errorf("%d: publicKeyMultibase is not specified", i)
@@ -202,6 +255,18 @@ func GetFromSpecCompliantPayload(specPayload DIDDocument) ([]*types.Verification
 				Controller:             vm["controller"].(string),
 				VerificationMaterial:   vm["publicKeyBase58"].(string),
 			})
+		case "EcdsaSecp256k1RecoveryMethod2020":
+			_, ok := vm["blockchainAccountId"]
+			if !ok {
+				return nil, nil, fmt.Errorf("%d: blockchainAccountId is not specified", i)
+			}
+
+			verificationMethod = append(verificationMethod, &types.VerificationMethod{
+				Id:                     vm["id"].(string),
+				VerificationMethodType: vm["type"].(string),
+				Controller:             vm["controller"].(string),
+				VerificationMaterial:   vm["blockchainAccountId"].(string),
+			})
 		case "JsonWebKey2020":
 			_, ok := vm["publicKeyJwk"]
 			if !ok {
@@ -234,6 +299,8 @@ func GetFromSpecCompliantPayload(specPayload DIDDocument) ([]*types.Verification
 			RecipientKeys:   s.RecipientKeys,
 			Accept:          s.Accept,
 			Priority:        s.Priority,
+
+			ServiceEndpointMaps: s.ServiceEndpointMaps,
 		})
 	}
 
@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"github.com/cheqd/cheqd-node/x/did/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+func CmdBatchCreateDidDocs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-create-did [payload-file]",
+		Short: "Create multiple new DIDs at once.",
+		Long: `Creates multiple new DIDs and their associated DID Documents in a single message. Either all of them are created or none.
+[payload-file] is JSON encoded MsgBatchCreateDidDocsPayload alongside with sign inputs.
+
+NOTES:
+1. Fee used for the transaction is the sum of the fixed fees for DID Document creation of all DID Documents in the batch.
+2. The number of DID Documents in a batch is limited by the 'max_batch_size' module parameter.
+3. Version IDs are optional. If not provided, random UUIDs will be used.
+4. Sign inputs must cover the controllers of all DID Documents in the batch. The whole batch is signed once.
+5. Private key provided in sign inputs is ONLY used locally to generate signature(s) and not sent to the ledger.
+
+Example payload file:
+{
+    "payload": {
+        "payloads": [
+            {
+                "context": [ "https://www.w3.org/ns/did/v1" ],
+                "id": "did:cheqd:<namespace>:<unique-identifier>",
+                "controller": [ "did:cheqd:<namespace>:<unique-identifier>" ],
+                "verificationMethod": [
+                    {
+                        "id": "did:cheqd:<namespace>:<unique-identifier>#<key-id>",
+                        "verificationMethodType": "<verification-method-type>",
+                        "controller": "did:cheqd:<namespace>:<unique-identifier>",
+                        "verificationMaterial": "<public-key-encoded-for-the-verification-method-type>"
+                    }
+                ],
+                "authentication": [ "did:cheqd:<namespace>:<unique-identifier>#<key-id>" ]
+            }
+        ]
+    },
+    "signInputs": [
+        {
+            "verificationMethodId": "did:cheqd:<namespace>:<unique-identifier>#<key-id>",
+            "privKey": "<private-key-bytes-encoded-to-base64>"
+        }
+    ]
+}
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			payloadJSON, signInputs, err := ReadPayloadWithSignInputsFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Build payload
+			payload := types.MsgBatchCreateDidDocsPayload{}
+			err = clientCtx.Codec.UnmarshalJSON(payloadJSON, &payload)
+			if err != nil {
+				return err
+			}
+
+			// Set random version ids where not provided
+			for _, didDoc := range payload.Payloads {
+				if didDoc.VersionId == "" {
+					didDoc.VersionId = uuid.NewString()
+				}
+			}
+
+			// Build identity message
+			signBytes := payload.GetSignBytes()
+			identitySignatures := SignWithSignInputs(signBytes, signInputs)
+
+			msg := types.MsgBatchCreateDidDocs{
+				Payload:    &payload,
+				Signatures: identitySignatures,
+			}
+
+			// Set fee-payer if not set
+			err = SetFeePayerFromSigner(&clientCtx)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	// add standard tx flags
+	AddTxFlagsToCmd(cmd)
+
+	// add custom / override flags
+	cmd.Flags().String(flags.FlagFees, "", "Sum of the fixed fees for DID creation of all DID Documents in the batch, e.g., 100000000000ncheq. Please check what the current fees by running 'cheqd-noded query params subspace cheqd feeparams'")
+
+	_ = cmd.MarkFlagRequired(flags.FlagFees)
+	_ = cmd.MarkFlagRequired(flags.FlagGas)
+	_ = cmd.MarkFlagRequired(flags.FlagGasAdjustment)
+
+	return cmd
+}
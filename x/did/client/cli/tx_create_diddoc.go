@@ -27,6 +27,8 @@ NOTES:
 1. Fee used for the transaction will ALWAYS take the fixed fee for DID Document creation, REGARDLESS of what value is passed in '--fees' flag.
 2. Payload file should be a JSON file containing properties specified in the DID Core Specification. Rules from DID Core spec are followed on which properties are mandatory and which ones are optional.
 3. Private key provided in sign inputs is ONLY used locally to generate signature(s) and not sent to the ledger.
+4. Optional 'controllerThreshold' property sets how many controllers must sign updates, deactivation and resources of the DID. All controllers are required if not set.
+5. Service endpoints must be absolute URIs using one of the schemes allowed by the module params. Map-style endpoints (e.g. DIDComm v2 '{"uri": "...", "accept": [...], "routingKeys": [...]}' or LinkedDomains '{"origins": [...]}') can be listed in 'serviceEndpoint' alongside string endpoints.
 
 Example payload file:
 {
@@ -98,6 +100,12 @@ Example payload file:
 				versionID = uuid.NewString()
 			}
 
+			// Read recovery-commitment flag
+			recoveryCommitment, err := cmd.Flags().GetString(FlagRecoveryCommitment)
+			if err != nil {
+				return err
+			}
+
 			payloadJSON, signInputs, err := ReadPayloadWithSignInputsFromFile(payloadFile)
 			if err != nil {
 				return err
@@ -129,7 +137,9 @@ Example payload file:
 				KeyAgreement:         specPayload.KeyAgreement,
 				Service:              service,
 				AlsoKnownAs:          specPayload.AlsoKnownAs,
+				ControllerThreshold:  specPayload.ControllerThreshold,
 				VersionId:            versionID,
+				RecoveryCommitment:   recoveryCommitment,
 			}
 
 			// Build identity message
@@ -156,6 +166,7 @@ Example payload file:
 
 	// add custom / override flags
 	cmd.Flags().String(FlagVersionID, "", "Version ID of the DID Document")
+	cmd.Flags().String(FlagRecoveryCommitment, "", "Commitment to a pre-rotated recovery key, i.e. hex encoded SHA-256 hash of '<verification-method-type>:<verification-material>'")
 	cmd.Flags().String(flags.FlagFees, sdk.NewCoin(types.BaseMinimalDenom, sdkmath.NewInt(types.DefaultCreateDidTxFee)).String(), "Fixed fee for DID creation, e.g., 50000000000ncheq. Please check what the current fees are by running 'cheqd-noded query params subspace cheqd feeparams'")
 
 	_ = cmd.MarkFlagRequired(flags.FlagFees)
@@ -0,0 +1,117 @@
+package cli
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/cheqd/cheqd-node/x/did/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+func CmdRecoverDidDoc() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recover-did [payload-file]",
+		Short: "Recovers a DID Document with its pre-rotated recovery key.",
+		Long: `Replaces the verification methods of a DID Document with new ones, proven by the pre-rotated recovery key
+committed to in the DID Document metadata. Signatures of the current controllers are not required.
+[payload-file] is JSON encoded MsgRecoverDidDocPayload alongside with sign inputs.
+
+NOTES:
+1. Fee used for the transaction will ALWAYS take the fixed fee for DID Document update, REGARDLESS of what value is passed in '--fees' flag.
+2. Controllers, controllerThreshold, context, services and alsoKnownAs of the current DID Document version are kept.
+3. Sign inputs must include the recovery key and the new verification methods. Version ID is optional, a random UUID is used if not provided.
+4. Set 'nextRecoveryCommitment' to the commitment to the next pre-rotated key, i.e. hex encoded SHA-256 hash of '<verification-method-type>:<verification-material>'.
+   Otherwise, the DID Document can't be recovered again until a new commitment is set by an update.
+5. Private key provided in sign inputs is ONLY used locally to generate signature(s) and not sent to the ledger.
+
+Example payload file:
+{
+    "payload": {
+        "id": "did:cheqd:<namespace>:<unique-identifier>",
+        "verificationMethod": [
+            {
+                "id": "did:cheqd:<namespace>:<unique-identifier>#<key-id>",
+                "verificationMethodType": "<verification-method-type>",
+                "controller": "did:cheqd:<namespace>:<unique-identifier>",
+                "verificationMaterial": "<public-key-encoded-for-the-verification-method-type>"
+            }
+        ],
+        "authentication": [ "did:cheqd:<namespace>:<unique-identifier>#<key-id>" ],
+        "recoveryKey": {
+            "id": "did:cheqd:<namespace>:<unique-identifier>#<recovery-key-id>",
+            "verificationMethodType": "<verification-method-type>",
+            "controller": "did:cheqd:<namespace>:<unique-identifier>",
+            "verificationMaterial": "<public-key-encoded-for-the-verification-method-type>"
+        },
+        "nextRecoveryCommitment": "<hex-encoded-sha256>"
+    },
+    "signInputs": [
+        {
+            "verificationMethodId": "did:cheqd:<namespace>:<unique-identifier>#<recovery-key-id>",
+            "privKey": "<private-key-bytes-encoded-to-base64>"
+        },
+        {
+            "verificationMethodId": "did:cheqd:<namespace>:<unique-identifier>#<key-id>",
+            "privKey": "<private-key-bytes-encoded-to-base64>"
+        }
+    ]
+}
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			payloadJSON, signInputs, err := ReadPayloadWithSignInputsFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Build payload
+			payload := types.MsgRecoverDidDocPayload{}
+			err = clientCtx.Codec.UnmarshalJSON(payloadJSON, &payload)
+			if err != nil {
+				return err
+			}
+
+			// Set random version id if not provided
+			if payload.VersionId == "" {
+				payload.VersionId = uuid.NewString()
+			}
+
+			// Build identity message
+			signBytes := payload.GetSignBytes()
+			identitySignatures := SignWithSignInputs(signBytes, signInputs)
+
+			msg := types.MsgRecoverDidDoc{
+				Payload:    &payload,
+				Signatures: identitySignatures,
+			}
+
+			// Set fee-payer if not set
+			err = SetFeePayerFromSigner(&clientCtx)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	// add standard tx flags
+	AddTxFlagsToCmd(cmd)
+
+	// add custom / override flags
+	cmd.Flags().String(flags.FlagFees, sdk.NewCoin(types.BaseMinimalDenom, sdkmath.NewInt(types.DefaultUpdateDidTxFee)).String(), "Fixed fee for DID recovery, e.g., 25000000000ncheq. Please check what the current fees by running 'cheqd-noded query params subspace cheqd feeparams'")
+
+	_ = cmd.MarkFlagRequired(flags.FlagFees)
+	_ = cmd.MarkFlagRequired(flags.FlagGas)
+	_ = cmd.MarkFlagRequired(flags.FlagGasAdjustment)
+
+	return cmd
+}
@@ -28,6 +28,11 @@ NOTES:
 2. DID update operations require the FULL new DID Document to be provided. Specifying just the changes/diff is not supported.
 3. Payload file should be a JSON file containing properties specified in the DID Core Specification. Rules from DID Core spec are followed on which properties are mandatory and which ones are optional.
 4. Private key provided in sign inputs is ONLY used locally to generate signature(s) and not sent to the ledger.
+5. Optional 'controllerThreshold' property sets how many controllers must sign updates, deactivation and resources of the DID. All controllers are required if not set.
+   Both the current and the new controller thresholds must be met by the signatures.
+6. Optional 'versionId' of a sign input binds the signature to the current or the new version of the DID Document, e.g. when rotating a key.
+   Sign inputs without 'versionId' are checked against both versions.
+7. Service endpoints must be absolute URIs using one of the schemes allowed by the module params. Map-style endpoints (e.g. DIDComm v2 or LinkedDomains origins) can be listed in 'serviceEndpoint' alongside string endpoints.
 
 Example payload file:
 {
@@ -95,6 +100,18 @@ Example payload file:
 				versionID = uuid.NewString()
 			}
 
+			// Read recovery-commitment flag
+			recoveryCommitment, err := cmd.Flags().GetString(FlagRecoveryCommitment)
+			if err != nil {
+				return err
+			}
+
+			// Read clear-recovery-commitment flag
+			clearRecoveryCommitment, err := cmd.Flags().GetBool(FlagClearRecoveryCommitment)
+			if err != nil {
+				return err
+			}
+
 			payloadJSON, signInputs, err := ReadPayloadWithSignInputsFromFile(payloadFile)
 			if err != nil {
 				return err
@@ -115,18 +132,21 @@ Example payload file:
 
 			// Construct MsgUpdateDidDocPayload
 			payload := types.MsgUpdateDidDocPayload{
-				Context:              specPayload.Context,
-				Id:                   specPayload.ID,
-				Controller:           specPayload.Controller,
-				VerificationMethod:   verificationMethod,
-				Authentication:       specPayload.Authentication,
-				AssertionMethod:      specPayload.AssertionMethod,
-				CapabilityInvocation: specPayload.CapabilityInvocation,
-				CapabilityDelegation: specPayload.CapabilityDelegation,
-				KeyAgreement:         specPayload.KeyAgreement,
-				Service:              service,
-				AlsoKnownAs:          specPayload.AlsoKnownAs,
-				VersionId:            versionID, // Set version id, from flag or random
+				Context:                 specPayload.Context,
+				Id:                      specPayload.ID,
+				Controller:              specPayload.Controller,
+				VerificationMethod:      verificationMethod,
+				Authentication:          specPayload.Authentication,
+				AssertionMethod:         specPayload.AssertionMethod,
+				CapabilityInvocation:    specPayload.CapabilityInvocation,
+				CapabilityDelegation:    specPayload.CapabilityDelegation,
+				KeyAgreement:            specPayload.KeyAgreement,
+				Service:                 service,
+				AlsoKnownAs:             specPayload.AlsoKnownAs,
+				ControllerThreshold:     specPayload.ControllerThreshold,
+				VersionId:               versionID, // Set version id, from flag or random
+				RecoveryCommitment:      recoveryCommitment,
+				ClearRecoveryCommitment: clearRecoveryCommitment,
 			}
 
 			// Build identity message
@@ -153,6 +173,8 @@ Example payload file:
 
 	// add custom / override flags
 	cmd.Flags().String(FlagVersionID, "", "Version ID of the DID Document")
+	cmd.Flags().String(FlagRecoveryCommitment, "", "Commitment to a pre-rotated recovery key, i.e. hex encoded SHA-256 hash of '<verification-method-type>:<verification-material>'. If not provided, the current commitment is kept")
+	cmd.Flags().Bool(FlagClearRecoveryCommitment, false, "Remove the current recovery commitment, so the DID Document can't be recovered anymore")
 	cmd.Flags().String(flags.FlagFees, sdk.NewCoin(types.BaseMinimalDenom, sdkmath.NewInt(types.DefaultUpdateDidTxFee)).String(), "Fixed fee for DID update, e.g., 25000000000ncheq. Please check what the current fees by running 'cheqd-noded query params subspace cheqd feeparams'")
 
 	_ = cmd.MarkFlagRequired(flags.FlagFees)
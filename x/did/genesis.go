@@ -23,6 +23,78 @@ func InitGenesis(ctx context.Context, k keeper.Keeper, genState *types.GenesisSt
 		if err != nil {
 			panic(err)
 		}
+
+		// Index the latest version by controller, public key and alias, and count it if it's deactivated
+		for _, didDoc := range versionSet.DidDocs {
+			if didDoc.Metadata.VersionId != versionSet.LatestVersion {
+				continue
+			}
+
+			err := k.IndexDidDoc(ctx, didDoc.DidDoc)
+			if err != nil {
+				panic(err)
+			}
+
+			if didDoc.Metadata.Deactivated {
+				err := k.IncrementDeactivatedDidCount(ctx)
+				if err != nil {
+					panic(err)
+				}
+			}
+		}
+
+		// Index versions by time once the whole version chain is stored
+		for _, didDoc := range versionSet.DidDocs {
+			err := k.SetDidDocVersionTime(ctx, didDoc)
+			if err != nil {
+				panic(err)
+			}
+		}
+
+		// Schedule pruning of intermediate versions
+		versions := make(map[string]*types.DidDocWithMetadata, len(versionSet.DidDocs))
+		for _, didDoc := range versionSet.DidDocs {
+			versions[didDoc.Metadata.VersionId] = didDoc
+		}
+		for _, didDoc := range versionSet.DidDocs {
+			if didDoc.Metadata.PreviousVersionId == "" {
+				continue
+			}
+
+			next, ok := versions[didDoc.Metadata.NextVersionId]
+			if !ok {
+				continue
+			}
+
+			err := k.EnqueueDidDocForPruning(ctx, next.Metadata.ActiveFrom(), didDoc.DidDoc.Id)
+			if err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	// Set tombstones
+	for _, tombstone := range genState.Tombstones {
+		err := k.SetDidDocTombstone(ctx, tombstone)
+		if err != nil {
+			panic(err)
+		}
+
+		// Index pruned versions by time, so that they are reported as pruned when requested by time
+		for _, pruned := range tombstone.PrunedVersions {
+			err := k.SetPrunedDidDocVersionTime(ctx, tombstone.Id, pruned)
+			if err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	// Set identity credit accounts
+	for _, account := range genState.IdentityCreditAccounts {
+		err := k.SetIdentityCreditAccount(ctx, account)
+		if err != nil {
+			panic(err)
+		}
 	}
 
 	// Set did namespace
@@ -52,10 +124,20 @@ func ExportGenesis(ctx context.Context, k keeper.Keeper) *types.GenesisState {
 	if err != nil {
 		panic(err)
 	}
+	tombstones, err := k.GetAllDidDocTombstones(ctx)
+	if err != nil {
+		panic(err)
+	}
+	identityCreditAccounts, err := k.GetAllIdentityCreditAccounts(ctx)
+	if err != nil {
+		panic(err)
+	}
 	genesis := types.GenesisState{
-		DidNamespace: DidNameSpace,
-		VersionSets:  didDocs,
-		FeeParams:    &feeParams,
+		DidNamespace:           DidNameSpace,
+		VersionSets:            didDocs,
+		FeeParams:              &feeParams,
+		Tombstones:             tombstones,
+		IdentityCreditAccounts: identityCreditAccounts,
 	}
 
 	return &genesis
@@ -29,6 +29,14 @@ func NewHandler(k Keeper) baseapp.MsgServiceHandler {
 			res, err := msgServer.DeactivateDidDoc(ctx, msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgBatchCreateDidDocs:
+			res, err := msgServer.BatchCreateDidDocs(ctx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgRecoverDidDoc:
+			res, err := msgServer.RecoverDidDoc(ctx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
 			return nil, errorsmod.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
@@ -24,30 +25,66 @@ type (
 		authority     string
 		Schema        collections.Schema
 
+		// linkedResourcesProvider lists DID-Linked Resources on resolution, set after construction as x/resource depends on x/did
+		linkedResourcesProvider types.LinkedResourcesProvider
+
+		// feeEstimator estimates identity fees for queries, set after construction as it depends on the ante and post handlers
+		feeEstimator types.FeeEstimator
+
 		DidNamespace     collections.Item[string]
 		DidCount         collections.Item[uint64]
 		LatestDidVersion collections.Map[string, string]
 		DidDocuments     collections.Map[collections.Pair[string, string], types.DidDocWithMetadata]
 		Paramstore       collections.Item[types.FeeParams]
+
+		// DidDocVersionTimes indexes versions by the time they became active (updated or created time)
+		DidDocVersionTimes collections.Map[collections.Pair[string, time.Time], string]
+
+		// DidDocTombstones keeps the hash chains of pruned versions
+		DidDocTombstones collections.Map[string, types.DidDocTombstone]
+
+		// DidDocPruneQueue indexes DIDs by the time one of their intermediate versions was superseded
+		DidDocPruneQueue collections.KeySet[collections.Pair[time.Time, string]]
+
+		// Reverse indexes of the latest diddoc versions
+		ControllerIndex collections.KeySet[collections.Pair[string, string]]
+		KeyIndex        collections.KeySet[collections.Pair[string, string]]
+		AliasIndex      collections.KeySet[collections.Pair[string, string]]
+
+		// Counters of stored versions and deactivated DIDs, complementing DidCount in the module stats
+		DidVersionCount     collections.Item[uint64]
+		DeactivatedDidCount collections.Item[uint64]
+
+		// Prepaid identity credits and volume discount windows of accounts paying identity fees
+		IdentityCreditAccounts collections.Map[string, types.IdentityCreditAccount]
 	}
 )
 
 func NewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService, paramSpace types.ParamSubspace, ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, ok types.OracleKeeper, authority string) *Keeper {
 	sb := collections.NewSchemaBuilder(storeService)
 	k := &Keeper{
-		cdc:              cdc,
-		storeService:     storeService,
-		paramSpace:       paramSpace,
-		accountKeeper:    ak,
-		bankkeeper:       bk,
-		stakingKeeper:    sk,
-		oracleKeeper:     ok,
-		authority:        authority,
-		DidNamespace:     collections.NewItem(sb, types.DidNamespaceKeyPrefix, "did_namespace", collections.StringValue),
-		DidCount:         collections.NewItem(sb, types.DidDocCountKeyPrefix, "did_count", collections.Uint64Value),
-		LatestDidVersion: collections.NewMap(sb, types.LatestDidDocVersionKeyPrefix, "latest_did", collections.StringKey, collections.StringValue),
-		DidDocuments:     collections.NewMap(sb, types.DidDocVersionKeyPrefix, "did_version", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.DidDocWithMetadata](cdc)),
-		Paramstore:       collections.NewItem(sb, types.ParamStoreKeyFeeParams, "params", codec.CollValue[types.FeeParams](cdc)),
+		cdc:                    cdc,
+		storeService:           storeService,
+		paramSpace:             paramSpace,
+		accountKeeper:          ak,
+		bankkeeper:             bk,
+		stakingKeeper:          sk,
+		oracleKeeper:           ok,
+		authority:              authority,
+		DidNamespace:           collections.NewItem(sb, types.DidNamespaceKeyPrefix, "did_namespace", collections.StringValue),
+		DidCount:               collections.NewItem(sb, types.DidDocCountKeyPrefix, "did_count", collections.Uint64Value),
+		LatestDidVersion:       collections.NewMap(sb, types.LatestDidDocVersionKeyPrefix, "latest_did", collections.StringKey, collections.StringValue),
+		DidDocuments:           collections.NewMap(sb, types.DidDocVersionKeyPrefix, "did_version", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.DidDocWithMetadata](cdc)),
+		Paramstore:             collections.NewItem(sb, types.ParamStoreKeyFeeParams, "params", codec.CollValue[types.FeeParams](cdc)),
+		DidDocVersionTimes:     collections.NewMap(sb, types.DidDocVersionTimeKeyPrefix, "did_version_time", collections.PairKeyCodec(collections.StringKey, sdk.TimeKey), collections.StringValue),
+		DidDocTombstones:       collections.NewMap(sb, types.DidDocTombstoneKeyPrefix, "did_tombstone", collections.StringKey, codec.CollValue[types.DidDocTombstone](cdc)),
+		DidDocPruneQueue:       collections.NewKeySet(sb, types.DidDocPruneQueueKeyPrefix, "did_prune_queue", collections.PairKeyCodec(sdk.TimeKey, collections.StringKey)),
+		ControllerIndex:        collections.NewKeySet(sb, types.DidsByControllerKeyPrefix, "dids_by_controller", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		KeyIndex:               collections.NewKeySet(sb, types.DidsByKeyKeyPrefix, "dids_by_key", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		AliasIndex:             collections.NewKeySet(sb, types.DidsByAliasKeyPrefix, "dids_by_alias", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		DidVersionCount:        collections.NewItem(sb, types.DidDocVersionCountKeyPrefix, "did_version_count", collections.Uint64Value),
+		DeactivatedDidCount:    collections.NewItem(sb, types.DeactivatedDidCountKeyPrefix, "deactivated_did_count", collections.Uint64Value),
+		IdentityCreditAccounts: collections.NewMap(sb, types.IdentityCreditsKeyPrefix, "identity_credits", collections.StringKey, codec.CollValue[types.IdentityCreditAccount](cdc)),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -62,6 +99,16 @@ func (k Keeper) Logger(ctx context.Context) log.Logger {
 	return sdkCtx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// SetLinkedResourcesProvider sets the provider used to list resources linked to DID Documents on resolution.
+func (k *Keeper) SetLinkedResourcesProvider(provider types.LinkedResourcesProvider) {
+	k.linkedResourcesProvider = provider
+}
+
+// SetFeeEstimator sets the estimator used to answer identity fee estimation queries.
+func (k *Keeper) SetFeeEstimator(estimator types.FeeEstimator) {
+	k.feeEstimator = estimator
+}
+
 func (k Keeper) GetAuthority() string {
 	return k.authority
 }
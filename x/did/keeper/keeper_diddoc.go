@@ -2,8 +2,10 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
 	"github.com/cheqd/cheqd-node/x/did/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -58,6 +60,20 @@ func (k Keeper) AddNewDidDocVersion(ctx context.Context, didDoc *types.DidDocWit
 			return err
 		}
 
+		// Count the DID as deactivated on its deactivation version
+		if didDoc.Metadata.Deactivated && !latestVersion.Metadata.Deactivated {
+			err = k.IncrementDeactivatedDidCount(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		// The previous version is replaced in the reverse indexes
+		err = k.UnindexDidDoc(ctx, latestVersion.DidDoc)
+		if err != nil {
+			return err
+		}
+
 		// Update version links
 		latestVersion.Metadata.NextVersionId = didDoc.Metadata.VersionId
 		didDoc.Metadata.PreviousVersionId = latestVersion.Metadata.VersionId
@@ -67,6 +83,14 @@ func (k Keeper) AddNewDidDocVersion(ctx context.Context, didDoc *types.DidDocWit
 		if err != nil {
 			return err
 		}
+
+		// The previous version becomes an intermediate one unless it's the first version
+		if latestVersion.Metadata.PreviousVersionId != "" {
+			err = k.EnqueueDidDocForPruning(ctx, didDoc.Metadata.ActiveFrom(), didDoc.DidDoc.Id)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	// Update latest version
@@ -76,7 +100,32 @@ func (k Keeper) AddNewDidDocVersion(ctx context.Context, didDoc *types.DidDocWit
 	}
 
 	// Write new version (no override)
-	return k.SetDidDocVersion(ctx, didDoc, false)
+	err = k.SetDidDocVersion(ctx, didDoc, false)
+	if err != nil {
+		return err
+	}
+
+	err = k.IndexDidDoc(ctx, didDoc.DidDoc)
+	if err != nil {
+		return err
+	}
+
+	return k.SetDidDocVersionTime(ctx, didDoc)
+}
+
+// ValidateServiceEndpointURIs checks the service endpoints of a new diddoc version against the allowed_service_endpoint_schemes param
+func (k Keeper) ValidateServiceEndpointURIs(ctx context.Context, didDoc types.DidDoc) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = didDoc.ValidateServiceEndpointURIs(params.AllowedServiceEndpointSchemes)
+	if err != nil {
+		return types.ErrBasicValidation.Wrap(err.Error())
+	}
+
+	return nil
 }
 
 func (k Keeper) GetLatestDidDoc(ctx context.Context, did string) (types.DidDocWithMetadata, error) {
@@ -104,6 +153,11 @@ func (k Keeper) SetDidDocVersion(ctx context.Context, value *types.DidDocWithMet
 		if hasdidVersion {
 			return types.ErrDidDocExists.Wrap("diddoc version already exists")
 		}
+
+		err = addToCounter(ctx, k.DidVersionCount, 1)
+		if err != nil {
+			return err
+		}
 	}
 
 	// Create the diddoc version
@@ -142,6 +196,88 @@ func (k Keeper) GetAllDidDocVersions(ctx context.Context, did string) ([]*types.
 	return metadataList, nil
 }
 
+// SetDidDocVersionTime indexes a diddoc version by the time it became active.
+// If several versions became active at the same time, the latest one in the version chain is kept.
+func (k Keeper) SetDidDocVersionTime(ctx context.Context, didDoc *types.DidDocWithMetadata) error {
+	activeFrom := didDoc.Metadata.ActiveFrom()
+	key := collections.Join(didDoc.DidDoc.Id, activeFrom)
+
+	existing, err := k.DidDocVersionTimes.Get(ctx, key)
+	if err != nil {
+		if !errors.IsOf(err, collections.ErrNotFound) {
+			return err
+		}
+
+		return k.DidDocVersionTimes.Set(ctx, key, didDoc.Metadata.VersionId)
+	}
+
+	// Follow the version chain from the indexed version while versions became active at the same time
+	for id := existing; id != didDoc.Metadata.VersionId; {
+		version, err := k.GetDidDocVersion(ctx, didDoc.DidDoc.Id, id)
+		if err != nil {
+			return err
+		}
+
+		id = version.Metadata.NextVersionId
+		if id == "" {
+			// The indexed version is later in the chain
+			return nil
+		}
+
+		if id != didDoc.Metadata.VersionId {
+			// Only the first version is followed by pruned versions, so the diddoc version is later in the chain
+			has, err := k.HasDidDocVersion(ctx, didDoc.DidDoc.Id, id)
+			if err != nil {
+				return err
+			}
+			if !has {
+				break
+			}
+
+			next, err := k.GetDidDocVersion(ctx, didDoc.DidDoc.Id, id)
+			if err != nil {
+				return err
+			}
+
+			if !next.Metadata.ActiveFrom().Equal(activeFrom) {
+				return nil
+			}
+		}
+	}
+
+	return k.DidDocVersionTimes.Set(ctx, key, didDoc.Metadata.VersionId)
+}
+
+// GetDidDocAtTime returns the diddoc version that was active at the given time
+func (k Keeper) GetDidDocAtTime(ctx context.Context, did string, at time.Time) (types.DidDocWithMetadata, error) {
+	rng := collections.NewPrefixedPairRange[string, time.Time](did).EndInclusive(at).Descending()
+
+	iter, err := k.DidDocVersionTimes.Iterate(ctx, rng)
+	if err != nil {
+		return types.DidDocWithMetadata{}, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return types.DidDocWithMetadata{}, sdkerrors.ErrNotFound.Wrapf("diddoc %s at %s", did, at.Format(time.RFC3339))
+	}
+
+	version, err := iter.Value()
+	if err != nil {
+		return types.DidDocWithMetadata{}, err
+	}
+
+	has, err := k.HasDidDocVersion(ctx, did, version)
+	if err != nil {
+		return types.DidDocWithMetadata{}, err
+	}
+	if !has {
+		return types.DidDocWithMetadata{}, sdkerrors.ErrNotFound.Wrapf("diddoc %s version %s active at %s was pruned", did, version, at.Format(time.RFC3339))
+	}
+
+	return k.GetDidDocVersion(ctx, did, version)
+}
+
 // SetLatestDidDocVersion sets the latest version id value for a diddoc
 func (k Keeper) SetLatestDidDocVersion(ctx context.Context, did, version string) error {
 	// Update counter. We use latest version as existence indicator.
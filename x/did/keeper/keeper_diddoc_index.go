@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/cheqd/cheqd-node/x/did/types"
+)
+
+// IndexDidDoc adds a diddoc to the reverse indexes by controller, public key and alias.
+// Only the latest version of a diddoc is expected to be indexed.
+func (k Keeper) IndexDidDoc(ctx context.Context, didDoc *types.DidDoc) error {
+	for _, controller := range didDoc.Controller {
+		if err := k.ControllerIndex.Set(ctx, collections.Join(controller, didDoc.Id)); err != nil {
+			return err
+		}
+	}
+
+	for _, vm := range didDoc.VerificationMethod {
+		fingerprint, err := types.GetPublicKeyFingerprint(*vm)
+		if err != nil {
+			// Verification methods without a public key are not indexed
+			continue
+		}
+
+		if err := k.KeyIndex.Set(ctx, collections.Join(fingerprint, vm.Id)); err != nil {
+			return err
+		}
+	}
+
+	for _, alias := range didDoc.AlsoKnownAs {
+		if err := k.AliasIndex.Set(ctx, collections.Join(alias, didDoc.Id)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnindexDidDoc removes a diddoc from the reverse indexes, e.g. when it's superseded by a new version
+func (k Keeper) UnindexDidDoc(ctx context.Context, didDoc *types.DidDoc) error {
+	for _, controller := range didDoc.Controller {
+		if err := k.ControllerIndex.Remove(ctx, collections.Join(controller, didDoc.Id)); err != nil {
+			return err
+		}
+	}
+
+	for _, vm := range didDoc.VerificationMethod {
+		fingerprint, err := types.GetPublicKeyFingerprint(*vm)
+		if err != nil {
+			continue
+		}
+
+		if err := k.KeyIndex.Remove(ctx, collections.Join(fingerprint, vm.Id)); err != nil {
+			return err
+		}
+	}
+
+	for _, alias := range didDoc.AlsoKnownAs {
+		if err := k.AliasIndex.Remove(ctx, collections.Join(alias, didDoc.Id)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,205 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	"github.com/cheqd/cheqd-node/x/did/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxPrunedDidDocsPerBlock limits the number of prune queue entries processed in a single block
+const MaxPrunedDidDocsPerBlock = 100
+
+// GetDidDocTombstone returns the tombstone of a diddoc. An empty tombstone is returned if no versions were pruned.
+func (k Keeper) GetDidDocTombstone(ctx context.Context, did string) (types.DidDocTombstone, error) {
+	tombstone, err := k.DidDocTombstones.Get(ctx, did)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return types.NewDidDocTombstone(did), nil
+		}
+		return types.DidDocTombstone{}, err
+	}
+
+	return tombstone, nil
+}
+
+func (k Keeper) SetDidDocTombstone(ctx context.Context, tombstone *types.DidDocTombstone) error {
+	return k.DidDocTombstones.Set(ctx, tombstone.Id, *tombstone)
+}
+
+// GetAllDidDocTombstones returns all tombstones
+// Loads all tombstones in memory. Use only for genesis export.
+func (k Keeper) GetAllDidDocTombstones(ctx context.Context) ([]*types.DidDocTombstone, error) {
+	var tombstones []*types.DidDocTombstone
+
+	err := k.DidDocTombstones.Walk(ctx, nil, func(_ string, tombstone types.DidDocTombstone) (bool, error) {
+		tombstones = append(tombstones, &tombstone)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tombstones, nil
+}
+
+// EnqueueDidDocForPruning schedules pruning of the intermediate versions of a diddoc that were superseded at the given time
+func (k Keeper) EnqueueDidDocForPruning(ctx context.Context, supersededAt time.Time, did string) error {
+	return k.DidDocPruneQueue.Set(ctx, collections.Join(supersededAt, did))
+}
+
+// PruneDidDocVersions prunes the intermediate versions of diddocs that were superseded longer than the version retention period ago.
+// The first and the latest version are always kept. The latest version of a deactivated diddoc is its deactivation version.
+func (k Keeper) PruneDidDocVersions(ctx context.Context, now time.Time) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Pruning is disabled, keep the queue for when it gets enabled
+	if params.VersionRetentionPeriod == 0 {
+		return nil
+	}
+
+	cutoff := now.Add(-params.VersionRetentionPeriod)
+
+	var queued []collections.Pair[time.Time, string]
+	rng := collections.NewPrefixUntilPairRange[time.Time, string](cutoff)
+	err = k.DidDocPruneQueue.Walk(ctx, rng, func(key collections.Pair[time.Time, string]) (bool, error) {
+		queued = append(queued, key)
+		return len(queued) >= MaxPrunedDidDocsPerBlock, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range queued {
+		if err := k.DidDocPruneQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		if err := k.pruneDidDocVersions(ctx, key.K2(), cutoff); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pruneDidDocVersions prunes the intermediate versions of a diddoc superseded not after the cutoff time and records them in its tombstone.
+func (k Keeper) pruneDidDocVersions(ctx context.Context, did string, cutoff time.Time) error {
+	latest, err := k.GetLatestDidDoc(ctx, did)
+	if err != nil {
+		return err
+	}
+
+	// Walk the version chain back from the latest version until the first or an already pruned version.
+	// Versions are collected from the newest one, with the time they were superseded at.
+	type supersededVersion struct {
+		version      types.DidDocWithMetadata
+		supersededAt time.Time
+	}
+
+	var intermediate []supersededVersion
+	next := latest
+	for next.Metadata.PreviousVersionId != "" {
+		has, err := k.HasDidDocVersion(ctx, did, next.Metadata.PreviousVersionId)
+		if err != nil {
+			return err
+		}
+		if !has {
+			break
+		}
+
+		version, err := k.GetDidDocVersion(ctx, did, next.Metadata.PreviousVersionId)
+		if err != nil {
+			return err
+		}
+
+		intermediate = append(intermediate, supersededVersion{version: version, supersededAt: next.Metadata.ActiveFrom()})
+		next = version
+	}
+
+	// The first version is kept
+	if len(intermediate) > 0 && intermediate[len(intermediate)-1].version.Metadata.PreviousVersionId == "" {
+		intermediate = intermediate[:len(intermediate)-1]
+	}
+
+	tombstone, err := k.GetDidDocTombstone(ctx, did)
+	if err != nil {
+		return err
+	}
+
+	// Prune in the order of the version chain, versions are superseded in the same order
+	var pruned []string
+	for i := len(intermediate) - 1; i >= 0; i-- {
+		version := intermediate[i]
+		if version.supersededAt.After(cutoff) {
+			break
+		}
+
+		if err := tombstone.AddPrunedVersion(version.version); err != nil {
+			return err
+		}
+
+		// The time index is kept, so that the version is reported as pruned when requested by time
+		if err := k.DidDocuments.Remove(ctx, collections.Join(did, version.version.Metadata.VersionId)); err != nil {
+			return err
+		}
+
+		pruned = append(pruned, version.version.Metadata.VersionId)
+	}
+
+	if len(pruned) == 0 {
+		return nil
+	}
+
+	if err := addToCounter(ctx, k.DidVersionCount, -int64(len(pruned))); err != nil {
+		return err
+	}
+
+	if err := k.SetDidDocTombstone(ctx, &tombstone); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventDidDocVersionsPruned{
+		Did:        did,
+		VersionIds: pruned,
+		ChainHash:  tombstone.ChainHash,
+	})
+}
+
+// SetPrunedDidDocVersionTime indexes a pruned version by the time it became active. Kept versions that became active
+// at the same time take precedence, except for the first version, which is earlier in the version chain.
+func (k Keeper) SetPrunedDidDocVersionTime(ctx context.Context, did string, pruned *types.PrunedDidDocVersion) error {
+	key := collections.Join(did, pruned.ActiveFrom)
+
+	existing, err := k.DidDocVersionTimes.Get(ctx, key)
+	if err != nil {
+		if !errors.IsOf(err, collections.ErrNotFound) {
+			return err
+		}
+
+		return k.DidDocVersionTimes.Set(ctx, key, pruned.VersionId)
+	}
+
+	has, err := k.HasDidDocVersion(ctx, did, existing)
+	if err != nil {
+		return err
+	}
+	if has {
+		version, err := k.GetDidDocVersion(ctx, did, existing)
+		if err != nil {
+			return err
+		}
+
+		if version.Metadata.PreviousVersionId != "" {
+			return nil
+		}
+	}
+
+	return k.DidDocVersionTimes.Set(ctx, key, pruned.VersionId)
+}
@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cheqd/cheqd-node/x/did/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetIdentityCreditAccount returns the identity credit account of an address. An empty account is returned if it holds no credits or identity transactions.
+func (k Keeper) GetIdentityCreditAccount(ctx context.Context, address string) (types.IdentityCreditAccount, error) {
+	account, err := k.IdentityCreditAccounts.Get(ctx, address)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return types.NewIdentityCreditAccount(address), nil
+		}
+		return types.IdentityCreditAccount{}, err
+	}
+
+	return account, nil
+}
+
+// SetIdentityCreditAccount stores an identity credit account, empty accounts are removed
+func (k Keeper) SetIdentityCreditAccount(ctx context.Context, account *types.IdentityCreditAccount) error {
+	if account.IsEmpty() {
+		return k.IdentityCreditAccounts.Remove(ctx, account.Address)
+	}
+
+	return k.IdentityCreditAccounts.Set(ctx, account.Address, *account)
+}
+
+// GetAllIdentityCreditAccounts returns all identity credit accounts
+// Loads all accounts in memory. Use only for genesis export.
+func (k Keeper) GetAllIdentityCreditAccounts(ctx context.Context) ([]*types.IdentityCreditAccount, error) {
+	var accounts []*types.IdentityCreditAccount
+
+	err := k.IdentityCreditAccounts.Walk(ctx, nil, func(_ string, account types.IdentityCreditAccount) (bool, error) {
+		accounts = append(accounts, &account)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return accounts, nil
+}
+
+// GetIdentityFeeDiscount returns the fraction of identity fees currently waived for an account
+func (k Keeper) GetIdentityFeeDiscount(ctx context.Context, address string) (sdkmath.LegacyDec, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+
+	account, err := k.GetIdentityCreditAccount(ctx, address)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+
+	windowTxs := account.TxsInWindow(sdk.UnwrapSDKContext(ctx).BlockTime(), params.VolumeDiscountWindow)
+
+	return params.GetFeeDiscount(address, windowTxs), nil
+}
+
+// AddIdentityCredits credits an account with ncheq already transferred to the module account and returns the new balance
+func (k Keeper) AddIdentityCredits(ctx context.Context, address string, amount sdkmath.Int) (sdkmath.Int, error) {
+	account, err := k.GetIdentityCreditAccount(ctx, address)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	account.Credits = account.Credits.Add(amount)
+
+	err = k.SetIdentityCreditAccount(ctx, &account)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	return account.Credits, nil
+}
+
+// DrawIdentityCredits draws up to amount ncheq from the credits of an account and returns the amount drawn.
+// The drawn ncheq is held by the module account, ready to be burnt or distributed.
+func (k Keeper) DrawIdentityCredits(ctx context.Context, address string, amount sdkmath.Int) (sdkmath.Int, error) {
+	account, err := k.GetIdentityCreditAccount(ctx, address)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	drawn := sdkmath.MinInt(account.Credits, amount)
+	if !drawn.IsPositive() {
+		return sdkmath.ZeroInt(), nil
+	}
+
+	account.Credits = account.Credits.Sub(drawn)
+
+	err = k.SetIdentityCreditAccount(ctx, &account)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	err = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventIdentityCreditsConsumed{
+		Address: address,
+		Amount:  sdk.NewCoin(types.BaseMinimalDenom, drawn),
+		Balance: sdk.NewCoin(types.BaseMinimalDenom, account.Credits),
+	})
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	return drawn, nil
+}
+
+// RecordIdentityFeePaid counts an identity transaction paid by an account towards its volume discount.
+// Transactions are not counted while volume discounts are disabled.
+func (k Keeper) RecordIdentityFeePaid(ctx context.Context, address string) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.VolumeDiscountWindow <= 0 || len(params.VolumeDiscountTiers) == 0 {
+		return nil
+	}
+
+	account, err := k.GetIdentityCreditAccount(ctx, address)
+	if err != nil {
+		return err
+	}
+
+	account.RecordTx(sdk.UnwrapSDKContext(ctx).BlockTime(), params.VolumeDiscountWindow)
+
+	return k.SetIdentityCreditAccount(ctx, &account)
+}
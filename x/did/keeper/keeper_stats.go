@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	"github.com/cheqd/cheqd-node/x/did/types"
+)
+
+// GetDidDocVersionCount returns the number of stored diddoc versions
+func (k Keeper) GetDidDocVersionCount(ctx context.Context) (uint64, error) {
+	return getCounter(ctx, k.DidVersionCount)
+}
+
+// GetDeactivatedDidCount returns the number of deactivated DIDs
+func (k Keeper) GetDeactivatedDidCount(ctx context.Context) (uint64, error) {
+	return getCounter(ctx, k.DeactivatedDidCount)
+}
+
+// IncrementDeactivatedDidCount counts a newly deactivated DID
+func (k Keeper) IncrementDeactivatedDidCount(ctx context.Context) error {
+	return addToCounter(ctx, k.DeactivatedDidCount, 1)
+}
+
+// getCounter returns the value of a counter, which is zero if it was never set
+func getCounter(ctx context.Context, counter collections.Item[uint64]) (uint64, error) {
+	count, err := counter.Get(ctx)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// addToCounter adds delta to a counter, a negative delta is subtracted
+func addToCounter(ctx context.Context, counter collections.Item[uint64], delta int64) error {
+	count, err := getCounter(ctx, counter)
+	if err != nil {
+		return err
+	}
+
+	if delta < 0 && uint64(-delta) > count {
+		return types.ErrInternal.Wrapf("counter underflow: %d - %d", count, -delta)
+	}
+
+	return counter.Set(ctx, uint64(int64(count)+delta))
+}
@@ -2,8 +2,14 @@ package keeper
 
 import (
 	"github.com/cheqd/cheqd-node/x/did/exported"
+	v10 "github.com/cheqd/cheqd-node/x/did/migrations/v10"
+	v11 "github.com/cheqd/cheqd-node/x/did/migrations/v11"
+	v12 "github.com/cheqd/cheqd-node/x/did/migrations/v12"
 	v5 "github.com/cheqd/cheqd-node/x/did/migrations/v5"
 	v6 "github.com/cheqd/cheqd-node/x/did/migrations/v6"
+	v7 "github.com/cheqd/cheqd-node/x/did/migrations/v7"
+	v8 "github.com/cheqd/cheqd-node/x/did/migrations/v8"
+	v9 "github.com/cheqd/cheqd-node/x/did/migrations/v9"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -28,3 +34,27 @@ func (m Migrator) Migrate5to6(ctx sdk.Context) error {
 	return v6.MigrateStore(ctx, m.keeper.storeService, m.legacySubspace, m.keeper.cdc,
 		m.keeper.DidCount, m.keeper.DidDocuments)
 }
+
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	return v7.MigrateStore(ctx, m.keeper.DidDocuments, m.keeper.SetDidDocVersionTime)
+}
+
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	return v8.MigrateStore(ctx, m.keeper.Paramstore)
+}
+
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	return v9.MigrateStore(ctx, m.keeper.LatestDidVersion, m.keeper.DidDocuments, m.keeper.EnqueueDidDocForPruning)
+}
+
+func (m Migrator) Migrate9to10(ctx sdk.Context) error {
+	return v10.MigrateStore(ctx, m.keeper.Paramstore)
+}
+
+func (m Migrator) Migrate10to11(ctx sdk.Context) error {
+	return v11.MigrateStore(ctx, m.keeper.LatestDidVersion, m.keeper.DidDocuments, m.keeper.IndexDidDoc)
+}
+
+func (m Migrator) Migrate11to12(ctx sdk.Context) error {
+	return v12.MigrateStore(ctx, m.keeper.LatestDidVersion, m.keeper.DidDocuments, m.keeper.DidVersionCount, m.keeper.DeactivatedDidCount)
+}
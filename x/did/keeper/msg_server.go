@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cheqd/cheqd-node/x/did/types"
@@ -101,6 +103,20 @@ func VerifySignature(k *Keeper, ctx context.Context, inMemoryDIDs map[string]typ
 		return err
 	}
 
+	// Signatures made for a specific version are only valid for that version
+	if signature.VersionId != "" {
+		did, _, _, _ := utils.MustSplitDIDUrl(signature.VerificationMethodId)
+		didDoc, err := MustFindDidDoc(k, ctx, inMemoryDIDs, did)
+		if err != nil {
+			return err
+		}
+
+		if didDoc.Metadata.VersionId != signature.VersionId {
+			return types.ErrInvalidSignature.Wrapf("method id: %s, version %s doesn't match version %s",
+				signature.VerificationMethodId, signature.VersionId, didDoc.Metadata.VersionId)
+		}
+	}
+
 	err = types.VerifySignature(verificationMethod, message, signature.Signature)
 	if err != nil {
 		return types.ErrInvalidSignature.Wrapf("method id: %s", signature.VerificationMethodId)
@@ -129,13 +145,13 @@ func VerifyAllSignersHaveAllValidSignatures(k *Keeper, ctx context.Context, inMe
 }
 
 // VerifyAllSignersHaveAtLeastOneValidSignature verifies that all signers have at least one valid signature.
-// Omit didToBeUpdated and updatedDID if not updating a DID. Otherwise those values will be used to better format error messages.
+// Omit updatedDID and versionLabel if not updating a DID. Otherwise those values will be used to better format error messages.
 func VerifyAllSignersHaveAtLeastOneValidSignature(k *Keeper, ctx context.Context, inMemoryDIDs map[string]types.DidDocWithMetadata,
-	message []byte, signers []string, signatures []*types.SignInfo, didToBeUpdated string, updatedDID string,
+	message []byte, signers []string, signatures []*types.SignInfo, updatedDID string, versionLabel string,
 ) error {
 	for _, signer := range signers {
 		signaturesBySigner := types.FindSignInfosBySigner(signatures, signer)
-		signerForErrorMessage := GetSignerIDForErrorMessage(signer, didToBeUpdated, updatedDID)
+		signerForErrorMessage := GetSignerIDForErrorMessage(signer, updatedDID, versionLabel)
 
 		if len(signaturesBySigner) == 0 {
 			return types.ErrSignatureNotFound.Wrapf("there should be at least one signature by %s", signerForErrorMessage)
@@ -158,6 +174,86 @@ func VerifyAllSignersHaveAtLeastOneValidSignature(k *Keeper, ctx context.Context
 	return nil
 }
 
+// VerifyThresholdOfSignersHaveAllValidSignatures verifies that at least threshold signers have signed and that all provided signatures are valid.
+// If all signers are required, it's equivalent to VerifyAllSignersHaveAllValidSignatures.
+func VerifyThresholdOfSignersHaveAllValidSignatures(k *Keeper, ctx context.Context, inMemoryDIDs map[string]types.DidDocWithMetadata,
+	message []byte, signers []string, threshold int, signatures []*types.SignInfo,
+) error {
+	if threshold >= len(signers) {
+		return VerifyAllSignersHaveAllValidSignatures(k, ctx, inMemoryDIDs, message, signers, signatures)
+	}
+
+	signed := 0
+	for _, signer := range signers {
+		signaturesBySigner := types.FindSignInfosBySigner(signatures, signer)
+		if len(signaturesBySigner) == 0 {
+			continue
+		}
+
+		for _, signature := range signaturesBySigner {
+			err := VerifySignature(k, ctx, inMemoryDIDs, message, signature)
+			if err != nil {
+				return err
+			}
+		}
+
+		signed++
+	}
+
+	if signed < threshold {
+		return types.ErrSignatureNotFound.Wrapf("%d of %s must sign, got %d", threshold, strings.Join(signers, ", "), signed)
+	}
+
+	return nil
+}
+
+// VerifyThresholdOfSignersHaveAtLeastOneValidSignature verifies that at least threshold signers have at least one valid signature.
+// If all signers are required, it's equivalent to VerifyAllSignersHaveAtLeastOneValidSignature.
+func VerifyThresholdOfSignersHaveAtLeastOneValidSignature(k *Keeper, ctx context.Context, inMemoryDIDs map[string]types.DidDocWithMetadata,
+	message []byte, signers []string, threshold int, signatures []*types.SignInfo, updatedDID string, versionLabel string,
+) error {
+	if threshold >= len(signers) {
+		return VerifyAllSignersHaveAtLeastOneValidSignature(k, ctx, inMemoryDIDs, message, signers, signatures, updatedDID, versionLabel)
+	}
+
+	signed := 0
+	signersForErrorMessage := make([]string, 0, len(signers))
+	for _, signer := range signers {
+		signersForErrorMessage = append(signersForErrorMessage, fmt.Sprint(GetSignerIDForErrorMessage(signer, updatedDID, versionLabel)))
+
+		for _, signature := range types.FindSignInfosBySigner(signatures, signer) {
+			err := VerifySignature(k, ctx, inMemoryDIDs, message, signature)
+			if err == nil {
+				signed++
+				break
+			}
+		}
+	}
+
+	if signed < threshold {
+		return types.ErrSignatureNotFound.Wrapf("there should be valid signatures by at least %d of %s, got %d",
+			threshold, strings.Join(signersForErrorMessage, ", "), signed)
+	}
+
+	return nil
+}
+
+// VerifyControllerPolicy verifies the signatures on behalf of a DID Document that is not changed, e.g. on deactivation.
+// Without a controller threshold, all controllers and controllers of verification methods must sign, like on creation.
+// With a controller threshold, at least the required number of controllers must sign and all their signatures must be valid.
+func VerifyControllerPolicy(k *Keeper, ctx context.Context, inMemoryDIDs map[string]types.DidDocWithMetadata,
+	message []byte, didDoc types.DidDoc, signatures []*types.SignInfo,
+) error {
+	controllers := didDoc.GetControllersOrSubject()
+	required := didDoc.RequiredControllerSignatures()
+	if required >= len(controllers) {
+		signers := GetSignerDIDsForDIDCreation(didDoc)
+		return VerifyAllSignersHaveAllValidSignatures(k, ctx, inMemoryDIDs, message, signers, signatures)
+	}
+
+	return VerifyThresholdOfSignersHaveAllValidSignatures(k, ctx, inMemoryDIDs, message, controllers, required, signatures)
+}
+
 func (k MsgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
 	if err := msg.ValidateBasic(); err != nil {
 		return nil, err
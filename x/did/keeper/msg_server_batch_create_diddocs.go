@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/did/types"
+	"github.com/cheqd/cheqd-node/x/did/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BatchCreateDidDocs creates all DID Documents of the batch or none of them.
+// The signatures are verified once over the whole payload, so every controller
+// of every DID Document in the batch has to sign the batch.
+func (k MsgServer) BatchCreateDidDocs(goCtx context.Context, msg *types.MsgBatchCreateDidDocs) (*types.MsgBatchCreateDidDocsResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// Get sign bytes before modifying payload
+	signBytes := msg.Payload.GetSignBytes()
+
+	// Normalize UUID identifiers
+	msg.Normalize()
+
+	// Validate batch size
+	params, err := k.GetParams(goCtx)
+	if err != nil {
+		return nil, err
+	}
+	err = msg.Payload.ValidateBatchSize(params.MaxBatchSize)
+	if err != nil {
+		return nil, types.ErrBasicValidation.Wrap(err.Error())
+	}
+
+	// Validate namespaces
+	namespace, err := k.GetDidNamespace(goCtx)
+	if err != nil {
+		return nil, err
+	}
+	err = msg.Validate([]string{namespace})
+	if err != nil {
+		return nil, types.ErrNamespaceValidation.Wrap(err.Error())
+	}
+
+	// Build metadata and stateValues, considering all dids of the batch during did resolutions
+	didDocs := make([]types.DidDocWithMetadata, 0, len(msg.Payload.Payloads))
+	inMemoryDids := make(map[string]types.DidDocWithMetadata, len(msg.Payload.Payloads))
+	for _, payload := range msg.Payload.Payloads {
+		// Validate DID doesn't exist
+		hasDidDoc, err := k.HasDidDoc(goCtx, payload.Id)
+		if err != nil {
+			return nil, err
+		}
+		if hasDidDoc {
+			return nil, types.ErrDidDocExists.Wrap(payload.Id)
+		}
+
+		didDoc := payload.ToDidDoc()
+		err = k.ValidateServiceEndpointURIs(goCtx, didDoc)
+		if err != nil {
+			return nil, err
+		}
+
+		metadata := types.NewMetadataFromContext(goCtx, payload.VersionId)
+		metadata.RecoveryCommitment = payload.RecoveryCommitment
+		didDocWithMetadata := types.NewDidDocWithMetadata(&didDoc, &metadata)
+
+		didDocs = append(didDocs, didDocWithMetadata)
+		inMemoryDids[didDoc.Id] = didDocWithMetadata
+	}
+
+	// Check controllers' existence
+	var signers []string
+	for _, didDoc := range didDocs {
+		for _, controller := range didDoc.DidDoc.AllControllerDids() {
+			_, err := MustFindDidDoc(&k.Keeper, goCtx, inMemoryDids, controller)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		signers = append(signers, GetSignerDIDsForDIDCreation(*didDoc.DidDoc)...)
+	}
+
+	// Verify signatures
+	err = VerifyAllSignersHaveAllValidSignatures(&k.Keeper, goCtx, inMemoryDids, signBytes, utils.UniqueSorted(signers), msg.Signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	// Save first DIDDoc versions
+	values := make([]*types.DidDocWithMetadata, 0, len(didDocs))
+	for i := range didDocs {
+		didDoc := &didDocs[i]
+
+		err = k.AddNewDidDocVersion(goCtx, didDoc)
+		if err != nil {
+			return nil, types.ErrInternal.Wrap(err.Error())
+		}
+
+		// Emit event
+		err = sdk.UnwrapSDKContext(goCtx).EventManager().EmitTypedEvent(&types.EventDidDocCreated{
+			Did:         didDoc.DidDoc.Id,
+			VersionId:   didDoc.Metadata.VersionId,
+			Controllers: didDoc.DidDoc.Controller,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, didDoc)
+	}
+
+	// Build and return response
+	return &types.MsgBatchCreateDidDocsResponse{
+		Values: values,
+	}, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/cheqd/cheqd-node/x/did/types"
 	"github.com/cheqd/cheqd-node/x/did/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func (k MsgServer) CreateDidDoc(goCtx context.Context, msg *types.MsgCreateDidDoc) (*types.MsgCreateDidDocResponse, error) {
@@ -37,9 +38,16 @@ func (k MsgServer) CreateDidDoc(goCtx context.Context, msg *types.MsgCreateDidDo
 		return nil, types.ErrNamespaceValidation.Wrap(err.Error())
 	}
 
-	// Build metadata and stateValue
+	// Validate service endpoint URIs
 	didDoc := msg.Payload.ToDidDoc()
+	err = k.ValidateServiceEndpointURIs(goCtx, didDoc)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build metadata and stateValue
 	metadata := types.NewMetadataFromContext(goCtx, msg.Payload.VersionId)
+	metadata.RecoveryCommitment = msg.Payload.RecoveryCommitment
 	didDocWithMetadata := types.NewDidDocWithMetadata(&didDoc, &metadata)
 
 	// Consider did that we are going to create during did resolutions
@@ -67,6 +75,16 @@ func (k MsgServer) CreateDidDoc(goCtx context.Context, msg *types.MsgCreateDidDo
 		return nil, types.ErrInternal.Wrap(err.Error())
 	}
 
+	// Emit event
+	err = sdk.UnwrapSDKContext(goCtx).EventManager().EmitTypedEvent(&types.EventDidDocCreated{
+		Did:         didDoc.Id,
+		VersionId:   metadata.VersionId,
+		Controllers: didDoc.Controller,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	// Build and return response
 	return &types.MsgCreateDidDocResponse{
 		Value: &didDocWithMetadata,
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/cheqd/cheqd-node/x/did/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func (k MsgServer) DeactivateDidDoc(goCtx context.Context, msg *types.MsgDeactivateDidDoc) (*types.MsgDeactivateDidDocResponse, error) {
@@ -50,8 +51,7 @@ func (k MsgServer) DeactivateDidDoc(goCtx context.Context, msg *types.MsgDeactiv
 	inMemoryDids := map[string]types.DidDocWithMetadata{}
 
 	// Verify signatures
-	signers := GetSignerDIDsForDIDCreation(*didDoc.DidDoc)
-	err = VerifyAllSignersHaveAllValidSignatures(&k.Keeper, goCtx, inMemoryDids, signBytes, signers, msg.Signatures)
+	err = VerifyControllerPolicy(&k.Keeper, goCtx, inMemoryDids, signBytes, *didDoc.DidDoc, msg.Signatures)
 	if err != nil {
 		return nil, err
 	}
@@ -84,6 +84,17 @@ func (k MsgServer) DeactivateDidDoc(goCtx context.Context, msg *types.MsgDeactiv
 		return nil, types.ErrInternal.Wrap(iterationErr.Error())
 	}
 
+	// Emit event
+	err = sdk.UnwrapSDKContext(goCtx).EventManager().EmitTypedEvent(&types.EventDidDocDeactivated{
+		Did:               didDoc.DidDoc.Id,
+		VersionId:         didDoc.Metadata.VersionId,
+		PreviousVersionId: didDoc.Metadata.PreviousVersionId,
+		Controllers:       didDoc.DidDoc.Controller,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	// Build and return response
 	return &types.MsgDeactivateDidDocResponse{
 		Value: &didDoc,
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/did/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k MsgServer) PurchaseIdentityCredits(goCtx context.Context, msg *types.MsgPurchaseIdentityCredits) (*types.MsgPurchaseIdentityCreditsResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	buyer, err := sdk.AccAddressFromBech32(msg.Buyer)
+	if err != nil {
+		return nil, err
+	}
+
+	// Hold the credits in the module account, from which the post handler burns and distributes identity fees
+	err = k.bankkeeper.SendCoinsFromAccountToModule(ctx, buyer, types.ModuleName, sdk.NewCoins(msg.Amount))
+	if err != nil {
+		return nil, err
+	}
+
+	credits, err := k.AddIdentityCredits(ctx, msg.Buyer, msg.Amount.Amount)
+	if err != nil {
+		return nil, err
+	}
+	balance := sdk.NewCoin(types.BaseMinimalDenom, credits)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventIdentityCreditsPurchased{
+		Buyer:   msg.Buyer,
+		Amount:  msg.Amount,
+		Balance: balance,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPurchaseIdentityCreditsResponse{
+		Balance: balance,
+	}, nil
+}
@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/did/types"
+	"github.com/cheqd/cheqd-node/x/did/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RecoverDidDoc replaces the verification methods of a DID Document with ones proven by its pre-rotated recovery key.
+// The controllers of the current version don't have to sign, which makes it possible to recover from lost keys.
+// Controllers and the controller threshold are kept, so recovery can't bypass the controller policy.
+func (k MsgServer) RecoverDidDoc(goCtx context.Context, msg *types.MsgRecoverDidDoc) (*types.MsgRecoverDidDocResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	// Get sign bytes before modifying payload
+	signBytes := msg.Payload.GetSignBytes()
+
+	// Normalize UUID identifiers
+	msg.Normalize()
+
+	// Validate namespaces
+	namespace, err := k.GetDidNamespace(goCtx)
+	if err != nil {
+		return nil, err
+	}
+	err = msg.Validate([]string{namespace})
+	if err != nil {
+		return nil, types.ErrNamespaceValidation.Wrap(err.Error())
+	}
+
+	// Check if DID exists and get latest version
+	existingDidDocWithMetadata, err := k.GetLatestDidDoc(goCtx, msg.Payload.Id)
+	if err != nil {
+		return nil, types.ErrDidDocNotFound.Wrap(err.Error())
+	}
+
+	// Validate DID is not deactivated
+	if existingDidDocWithMetadata.Metadata.Deactivated {
+		return nil, types.ErrDIDDocDeactivated.Wrap(msg.Payload.Id)
+	}
+
+	// Validate the revealed recovery key matches the commitment
+	commitment := existingDidDocWithMetadata.Metadata.RecoveryCommitment
+	if commitment == "" {
+		return nil, types.ErrRecoveryNotEnabled.Wrap(msg.Payload.Id)
+	}
+	recoveryKey := *msg.Payload.RecoveryKey
+	if types.NewRecoveryCommitment(recoveryKey) != commitment {
+		return nil, types.ErrRecoveryKeyMismatch.Wrapf("recovery key: %s", recoveryKey.Id)
+	}
+
+	// Verify the recovery key signature
+	recoverySignature, found := findSignInfoByID(msg.Signatures, recoveryKey.Id)
+	if !found {
+		return nil, types.ErrSignatureNotFound.Wrapf("recovery key: %s", recoveryKey.Id)
+	}
+	err = types.VerifySignature(recoveryKey, signBytes, recoverySignature.Signature)
+	if err != nil {
+		return nil, types.ErrInvalidSignature.Wrapf("recovery key: %s", recoveryKey.Id)
+	}
+
+	// Build the new version
+	recoveredDidDoc := msg.Payload.ToDidDoc(*existingDidDocWithMetadata.DidDoc)
+
+	recoveredMetadata := *existingDidDocWithMetadata.Metadata
+	recoveredMetadata.Update(goCtx, msg.Payload.VersionId)
+	recoveredMetadata.RecoveryCommitment = msg.Payload.NextRecoveryCommitment
+
+	recoveredDidDocWithMetadata := types.NewDidDocWithMetadata(&recoveredDidDoc, &recoveredMetadata)
+
+	// Consider the recovered version during did resolutions, so the old keys are not used
+	inMemoryDids := map[string]types.DidDocWithMetadata{recoveredDidDoc.Id: recoveredDidDocWithMetadata}
+
+	// Check controllers existence
+	controllers := recoveredDidDoc.AllControllerDids()
+	for _, controller := range controllers {
+		_, err := MustFindDidDoc(&k.Keeper, goCtx, inMemoryDids, controller)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Verify signatures of the controllers of the new keys, proving their possession.
+	// The recovery signature is only checked against the new version if the recovery key is kept as a verification method.
+	signatures := msg.Signatures
+	if !utils.Contains(types.GetVerificationMethodIds(recoveredDidDoc.VerificationMethod), recoveryKey.Id) {
+		signatures = removeSignInfoByID(signatures, recoveryKey.Id)
+	}
+	signers := utils.UniqueSorted(recoveredDidDoc.GetVerificationMethodControllers())
+	err = VerifyAllSignersHaveAllValidSignatures(&k.Keeper, goCtx, inMemoryDids, signBytes, signers, signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update state
+	err = k.AddNewDidDocVersion(goCtx, &recoveredDidDocWithMetadata)
+	if err != nil {
+		return nil, types.ErrInternal.Wrap(err.Error())
+	}
+
+	// Emit event
+	err = sdk.UnwrapSDKContext(goCtx).EventManager().EmitTypedEvent(&types.EventDidDocRecovered{
+		Did:               recoveredDidDoc.Id,
+		VersionId:         recoveredMetadata.VersionId,
+		PreviousVersionId: recoveredMetadata.PreviousVersionId,
+		Controllers:       recoveredDidDoc.Controller,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Build and return response
+	return &types.MsgRecoverDidDocResponse{
+		Value: &recoveredDidDocWithMetadata,
+	}, nil
+}
+
+func findSignInfoByID(signatures []*types.SignInfo, verificationMethodID string) (*types.SignInfo, bool) {
+	for _, signature := range signatures {
+		if signature.VerificationMethodId == verificationMethodID {
+			return signature, true
+		}
+	}
+
+	return nil, false
+}
+
+func removeSignInfoByID(signatures []*types.SignInfo, verificationMethodID string) []*types.SignInfo {
+	result := make([]*types.SignInfo, 0, len(signatures))
+
+	for _, signature := range signatures {
+		if signature.VerificationMethodId != verificationMethodID {
+			result = append(result, signature)
+		}
+	}
+
+	return result
+}
@@ -6,9 +6,13 @@ import (
 
 	"github.com/cheqd/cheqd-node/x/did/types"
 	"github.com/cheqd/cheqd-node/x/did/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-const UpdatedPostfix string = "-updated"
+const (
+	OldVersionLabel = "old version"
+	NewVersionLabel = "new version"
+)
 
 func (k MsgServer) UpdateDidDoc(goCtx context.Context, msg *types.MsgUpdateDidDoc) (*types.MsgUpdateDidDocResponse, error) {
 	if err := msg.ValidateBasic(); err != nil {
@@ -36,97 +40,138 @@ func (k MsgServer) UpdateDidDoc(goCtx context.Context, msg *types.MsgUpdateDidDo
 		return nil, types.ErrDidDocNotFound.Wrap(err.Error())
 	}
 
-	existingDidDoc := existingDidDocWithMetadata.DidDoc
-
 	// Validate DID is not deactivated
 	if existingDidDocWithMetadata.Metadata.Deactivated {
 		return nil, types.ErrDIDDocDeactivated.Wrap(msg.Payload.Id)
 	}
 
-	// Construct the new version of the DID and temporary rename it and its self references
-	// in order to consider old and new versions different DIDs during signatures validation
+	// Construct the new version of the DID
 	updatedDidDoc := msg.Payload.ToDidDoc()
-	updatedDidDoc.ReplaceDids(updatedDidDoc.Id, updatedDidDoc.Id+UpdatedPostfix)
+	err = k.ValidateServiceEndpointURIs(goCtx, updatedDidDoc)
+	if err != nil {
+		return nil, err
+	}
 
 	updatedMetadata := *existingDidDocWithMetadata.Metadata
 	updatedMetadata.Update(goCtx, msg.Payload.VersionId)
+	switch {
+	case msg.Payload.ClearRecoveryCommitment:
+		updatedMetadata.RecoveryCommitment = ""
+	case msg.Payload.RecoveryCommitment != "":
+		updatedMetadata.RecoveryCommitment = msg.Payload.RecoveryCommitment
+	}
 
 	updatedDidDocWithMetadata := types.NewDidDocWithMetadata(&updatedDidDoc, &updatedMetadata)
 
-	// Consider the new version of the DID a separate DID
-	inMemoryDids := map[string]types.DidDocWithMetadata{updatedDidDoc.Id: updatedDidDocWithMetadata}
-
-	// Check controllers existence
+	// Check controllers existence. The new version is considered for self references.
 	controllers := updatedDidDoc.AllControllerDids()
 	for _, controller := range controllers {
-		_, err := MustFindDidDoc(&k.Keeper, goCtx, inMemoryDids, controller)
+		_, err := MustFindDidDoc(&k.Keeper, goCtx, map[string]types.DidDocWithMetadata{updatedDidDoc.Id: updatedDidDocWithMetadata}, controller)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	// Verify signatures
-	// Duplicate signatures that reference the old version, make them reference a new (in memory) version
-	// We can't use VerifySignatures because we can't uniquely identify a verification method corresponding to a given signInfo.
-	// In other words if a signature belongs to the did being updated, there is no way to know which did version it belongs to: old or new.
-	// To eliminate this problem we have to add pubkey to the signInfo in future.
-	signers := GetSignerDIDsForDIDUpdate(*existingDidDoc, updatedDidDoc)
-	extendedSignatures := DuplicateSignatures(msg.Signatures, existingDidDocWithMetadata.DidDoc.Id, updatedDidDoc.Id)
-	err = VerifyAllSignersHaveAtLeastOneValidSignature(&k.Keeper, goCtx, inMemoryDids, signBytes, signers, extendedSignatures, existingDidDoc.Id, updatedDidDoc.Id)
+	// Verify signatures against the existing and the updated versions
+	err = VerifyControllerPolicyForDIDUpdate(&k.Keeper, goCtx, signBytes, existingDidDocWithMetadata, updatedDidDocWithMetadata, msg.Signatures)
 	if err != nil {
 		return nil, err
 	}
 
-	// Return original id
-	updatedDidDoc.ReplaceDids(updatedDidDoc.Id, existingDidDoc.Id)
-
 	// Update state
 	err = k.AddNewDidDocVersion(goCtx, &updatedDidDocWithMetadata)
 	if err != nil {
 		return nil, types.ErrInternal.Wrap(err.Error())
 	}
 
+	// Emit event
+	err = sdk.UnwrapSDKContext(goCtx).EventManager().EmitTypedEvent(&types.EventDidDocUpdated{
+		Did:               updatedDidDoc.Id,
+		VersionId:         updatedMetadata.VersionId,
+		PreviousVersionId: updatedMetadata.PreviousVersionId,
+		Controllers:       updatedDidDoc.Controller,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	// Build and return response
 	return &types.MsgUpdateDidDocResponse{
 		Value: &updatedDidDocWithMetadata,
 	}, nil
 }
 
-func GetSignerIDForErrorMessage(signerID string, existingVersionID string, updatedVersionID string) interface{} {
-	if signerID == existingVersionID { // oldDid->id
-		return existingVersionID + " (old version)"
-	}
+// VerifyControllerPolicyForDIDUpdate verifies the signatures of an update. Signers of the existing version are verified
+// against the version in state, signers of the updated version against the updated version. Signatures with a version ID
+// are only considered for that version, signatures without one for both versions.
+//
+// Both versions' controllers must have signed according to their controller thresholds. Controllers of changed
+// verification methods which are not controllers of either version must always sign.
+func VerifyControllerPolicyForDIDUpdate(k *Keeper, ctx context.Context, message []byte,
+	existing types.DidDocWithMetadata, updated types.DidDocWithMetadata, signatures []*types.SignInfo,
+) error {
+	existingDidDoc, updatedDidDoc := *existing.DidDoc, *updated.DidDoc
+	existingSigners, updatedSigners := GetSignerDIDsForDIDUpdate(existingDidDoc, updatedDidDoc)
+	did := existingDidDoc.Id
+
+	existingInMemoryDIDs := map[string]types.DidDocWithMetadata{did: existing}
+	updatedInMemoryDIDs := map[string]types.DidDocWithMetadata{did: updated}
+	existingSignatures := types.FilterSignInfosByVersion(signatures, did, existing.Metadata.VersionId)
+	updatedSignatures := types.FilterSignInfosByVersion(signatures, did, updated.Metadata.VersionId)
+
+	existingControllers := existingDidDoc.GetControllersOrSubject()
+	updatedControllers := updatedDidDoc.GetControllersOrSubject()
+	existingRequired := existingDidDoc.RequiredControllerSignatures()
+	updatedRequired := updatedDidDoc.RequiredControllerSignatures()
+
+	if existingRequired >= len(existingControllers) && updatedRequired >= len(updatedControllers) {
+		err := VerifyAllSignersHaveAtLeastOneValidSignature(k, ctx, existingInMemoryDIDs, message, existingSigners, existingSignatures, did, OldVersionLabel)
+		if err != nil {
+			return err
+		}
 
-	if signerID == updatedVersionID { // oldDid->id + UpdatedPrefix
-		return existingVersionID + " (new version)"
+		return VerifyAllSignersHaveAtLeastOneValidSignature(k, ctx, updatedInMemoryDIDs, message, updatedSigners, updatedSignatures, did, NewVersionLabel)
 	}
 
-	return signerID
-}
+	allControllers := append(utils.Unique(existingControllers), updatedControllers...)
 
-func DuplicateSignatures(signatures []*types.SignInfo, didToDuplicate string, newDid string) []*types.SignInfo {
-	result := make([]*types.SignInfo, 0, len(signatures))
+	err := VerifyAllSignersHaveAtLeastOneValidSignature(k, ctx, existingInMemoryDIDs, message,
+		utils.UniqueSorted(utils.Subtract(existingSigners, allControllers)), existingSignatures, did, OldVersionLabel)
+	if err != nil {
+		return err
+	}
 
-	for _, signature := range signatures {
-		result = append(result, signature)
+	err = VerifyThresholdOfSignersHaveAtLeastOneValidSignature(k, ctx, existingInMemoryDIDs, message,
+		existingControllers, existingRequired, existingSignatures, did, OldVersionLabel)
+	if err != nil {
+		return err
+	}
 
-		did, path, query, fragment := utils.MustSplitDIDUrl(signature.VerificationMethodId)
-		if did == didToDuplicate {
-			duplicate := types.SignInfo{
-				VerificationMethodId: utils.JoinDIDUrl(newDid, path, query, fragment),
-				Signature:            signature.Signature,
-			}
+	err = VerifyAllSignersHaveAtLeastOneValidSignature(k, ctx, updatedInMemoryDIDs, message,
+		utils.UniqueSorted(utils.Subtract(updatedSigners, allControllers)), updatedSignatures, did, NewVersionLabel)
+	if err != nil {
+		return err
+	}
 
-			result = append(result, &duplicate)
-		}
+	return VerifyThresholdOfSignersHaveAtLeastOneValidSignature(k, ctx, updatedInMemoryDIDs, message,
+		updatedControllers, updatedRequired, updatedSignatures, did, NewVersionLabel)
+}
+
+// GetSignerIDForErrorMessage marks the DID being updated with the version its signatures are verified against
+func GetSignerIDForErrorMessage(signerID string, updatedDID string, versionLabel string) interface{} {
+	if signerID == updatedDID && versionLabel != "" {
+		return signerID + " (" + versionLabel + ")"
 	}
 
-	return result
+	return signerID
 }
 
-func GetSignerDIDsForDIDUpdate(existingDidDoc types.DidDoc, updatedDidDoc types.DidDoc) []string {
-	signers := existingDidDoc.GetControllersOrSubject()
-	signers = append(signers, updatedDidDoc.GetControllersOrSubject()...)
+// GetSignerDIDsForDIDUpdate returns the DIDs that must sign an update on behalf of the existing version,
+// i.e. its controllers and the controllers of removed and changed verification methods, and on behalf of the updated version,
+// i.e. its controllers and the controllers of added and changed verification methods.
+func GetSignerDIDsForDIDUpdate(existingDidDoc types.DidDoc, updatedDidDoc types.DidDoc) (existingSigners []string, updatedSigners []string) {
+	existingSigners = append(existingSigners, existingDidDoc.GetControllersOrSubject()...)
+	updatedSigners = append(updatedSigners, updatedDidDoc.GetControllersOrSubject()...)
 
 	existingVMMap := types.VerificationMethodListToMapByFragment(existingDidDoc.VerificationMethod)
 	updatedVMMap := types.VerificationMethodListToMapByFragment(updatedDidDoc.VerificationMethod)
@@ -137,19 +182,14 @@ func GetSignerDIDsForDIDUpdate(existingDidDoc types.DidDoc, updatedDidDoc types.
 
 		// VM added
 		if !found {
-			signers = append(signers, updatedVM.Controller)
+			updatedSigners = append(updatedSigners, updatedVM.Controller)
 			continue
 		}
 
 		// VM updated
-		// We have to revert renaming before comparing veriifcation methods.
-		// Otherwise we will detect id and controller change
-		// for non changed VMs because of `-updated` postfix.
-		originalUpdatedVM := *updatedVM
-		originalUpdatedVM.ReplaceDids(updatedDidDoc.Id, existingDidDoc.Id)
-
-		if !reflect.DeepEqual(existingVM, originalUpdatedVM) {
-			signers = append(signers, existingVM.Controller, updatedVM.Controller)
+		if !reflect.DeepEqual(existingVM, *updatedVM) {
+			existingSigners = append(existingSigners, existingVM.Controller)
+			updatedSigners = append(updatedSigners, updatedVM.Controller)
 			continue
 		}
 
@@ -162,10 +202,10 @@ func GetSignerDIDsForDIDUpdate(existingDidDoc types.DidDoc, updatedDidDoc types.
 
 		// VM removed
 		if !found {
-			signers = append(signers, existingVM.Controller)
+			existingSigners = append(existingSigners, existingVM.Controller)
 			continue
 		}
 	}
 
-	return utils.UniqueSorted(signers)
+	return utils.UniqueSorted(existingSigners), utils.UniqueSorted(updatedSigners)
 }
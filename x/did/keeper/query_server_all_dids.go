@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/cheqd/cheqd-node/x/did/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) AllDids(ctx context.Context, req *types.QueryAllDidsRequest) (*types.QueryAllDidsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	// Get a page of filtered latest versions
+	didDocs, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		k.LatestDidVersion,
+		req.Pagination,
+		func(did string, versionID string) (bool, error) {
+			didDoc, err := k.DidDocuments.Get(ctx, collections.Join(did, versionID))
+			if err != nil {
+				return false, err
+			}
+
+			return req.Matches(didDoc), nil
+		},
+		func(did string, versionID string) (*types.DidDocWithMetadata, error) {
+			didDoc, err := k.DidDocuments.Get(ctx, collections.Join(did, versionID))
+			if err != nil {
+				return nil, err
+			}
+
+			return &didDoc, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryAllDidsResponse{
+		Dids:       didDocs,
+		Pagination: pageRes,
+	}, nil
+}
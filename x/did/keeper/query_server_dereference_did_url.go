@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cheqd/cheqd-node/x/did/types"
+	"github.com/cheqd/cheqd-node/x/did/utils"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) DereferenceDidUrl(ctx context.Context, req *types.QueryDereferenceDidUrlRequest) (*types.QueryDereferenceDidUrlResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	req.Normalize()
+
+	if !types.IsSupportedDidRepresentation(req.Accept) {
+		return nil, status.Errorf(codes.InvalidArgument, "representation not supported: %s", req.Accept)
+	}
+
+	params, err := types.ParseDidURL(req.DidUrl)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid did url: %s", err)
+	}
+
+	if params.ResourceID != "" || params.IsResourceQuery() {
+		return k.dereferenceLinkedResource(ctx, params)
+	}
+
+	result, err := k.resolveDid(ctx, params.Did, params.VersionID, params.VersionTime, req.Accept)
+	if err != nil {
+		return nil, err
+	}
+
+	var content interface{} = result.DidDocument
+	if params.Fragment != "" {
+		content, err = dereferenceFragment(result.DidDocument, params.Did+"#"+params.Fragment)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return newDereferenceDidUrlResponse(params.Did, req.Accept, content, result.DidDocumentMetadata)
+}
+
+func (k Keeper) dereferenceLinkedResource(ctx context.Context, params types.DidURLDereferencingParams) (*types.QueryDereferenceDidUrlResponse, error) {
+	if k.linkedResourcesProvider == nil {
+		return nil, status.Error(codes.Unavailable, "linked resources are not available")
+	}
+
+	// Check that the DID exists so that resources of unknown DIDs are not dereferenced
+	_, err := k.GetLatestDidDoc(ctx, params.Did)
+	if err != nil {
+		return nil, err
+	}
+
+	_, _, collectionID := utils.MustSplitDID(params.Did)
+
+	var resource types.LinkedResource
+	if params.ResourceID != "" {
+		resource, err = k.linkedResourcesProvider.GetLinkedResource(ctx, collectionID, params.ResourceID)
+	} else {
+		resource, err = k.linkedResourcesProvider.GetLinkedResourceByName(ctx, collectionID, params.ResourceName, params.ResourceType, params.ResourceVersionTime)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resource.Metadata.ResourceURI = params.Did + types.LinkedResourcePathPrefix + resource.Metadata.ResourceID
+
+	response, err := newDereferenceDidUrlResponse(params.Did, resource.Metadata.MediaType, nil, resource.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	response.ContentStream = resource.Data
+	return response, nil
+}
+
+// dereferenceFragment returns the verification method or service with the given id
+func dereferenceFragment(didDoc types.ResolvedDidDocument, id string) (interface{}, error) {
+	for _, vm := range didDoc.VerificationMethod {
+		if vm.ID == id {
+			return vm, nil
+		}
+	}
+
+	for _, service := range didDoc.Service {
+		if service.ID == id {
+			return service, nil
+		}
+	}
+
+	return nil, sdkerrors.ErrNotFound.Wrapf("verification method or service %s", id)
+}
+
+func newDereferenceDidUrlResponse(did, contentType string, content interface{}, contentMetadata interface{}) (*types.QueryDereferenceDidUrlResponse, error) {
+	var contentStream []byte
+	var err error
+	if content != nil {
+		contentStream, err = json.Marshal(content)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	contentMetadataBz, err := json.Marshal(contentMetadata)
+	if err != nil {
+		return nil, err
+	}
+
+	dereferencingMetadataBz, err := json.Marshal(types.DidDereferencingMetadata{
+		ContentType: contentType,
+		Did:         types.NewResolvedDidURL(did),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDereferenceDidUrlResponse{
+		ContentType:           contentType,
+		ContentStream:         contentStream,
+		ContentMetadata:       string(contentMetadataBz),
+		DereferencingMetadata: string(dereferencingMetadataBz),
+	}, nil
+}
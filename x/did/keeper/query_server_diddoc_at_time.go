@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/did/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) DidDocAtTime(ctx context.Context, req *types.QueryDidDocAtTimeRequest) (*types.QueryDidDocAtTimeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	req.Normalize()
+
+	didDoc, err := k.GetDidDocAtTime(ctx, req.Id, req.Time)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDidDocAtTimeResponse{Value: &didDoc}, nil
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/cheqd/cheqd-node/x/did/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) DidsByAlias(ctx context.Context, req *types.QueryDidsByAliasRequest) (*types.QueryDidsByAliasResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	dids, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.AliasIndex,
+		req.Pagination,
+		func(key collections.Pair[string, string], _ collections.NoValue) (string, error) {
+			return key.K2(), nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](req.Alias),
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryDidsByAliasResponse{
+		Dids:       dids,
+		Pagination: pageRes,
+	}, nil
+}
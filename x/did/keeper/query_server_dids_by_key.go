@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/cheqd/cheqd-node/x/did/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) DidsByKey(ctx context.Context, req *types.QueryDidsByKeyRequest) (*types.QueryDidsByKeyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	fingerprint, err := types.GetPublicKeyFingerprint(types.VerificationMethod{
+		VerificationMethodType: req.VerificationMethodType,
+		VerificationMaterial:   req.VerificationMaterial,
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	verificationMethodIDs, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.KeyIndex,
+		req.Pagination,
+		func(key collections.Pair[string, string], _ collections.NoValue) (string, error) {
+			return key.K2(), nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](fingerprint),
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryDidsByKeyResponse{
+		Fingerprint:           fingerprint,
+		VerificationMethodIds: verificationMethodIDs,
+		Pagination:            pageRes,
+	}, nil
+}
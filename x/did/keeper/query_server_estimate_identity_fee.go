@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/did/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) EstimateIdentityFee(ctx context.Context, req *types.QueryEstimateIdentityFeeRequest) (*types.QueryEstimateIdentityFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.MsgTypeUrl == "" && len(req.TxBytes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "either msg_type_url or tx_bytes must be set")
+	}
+
+	if req.FeeDenom == "" {
+		return nil, status.Error(codes.InvalidArgument, "fee_denom must be set")
+	}
+
+	if k.feeEstimator == nil {
+		return nil, status.Error(codes.Unimplemented, "fee estimation is not available")
+	}
+
+	return k.feeEstimator.EstimateIdentityFee(ctx, req)
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/did/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) IdentityCredits(ctx context.Context, req *types.QueryIdentityCreditsRequest) (*types.QueryIdentityCreditsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	account, err := k.GetIdentityCreditAccount(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	discount, err := k.GetIdentityFeeDiscount(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryIdentityCreditsResponse{
+		Account:  account,
+		Discount: discount,
+	}, nil
+}
+
+func (k Keeper) AllIdentityCredits(ctx context.Context, req *types.QueryAllIdentityCreditsRequest) (*types.QueryAllIdentityCreditsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	accounts, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.IdentityCreditAccounts,
+		req.Pagination,
+		func(_ string, account types.IdentityCreditAccount) (types.IdentityCreditAccount, error) {
+			return account, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryAllIdentityCreditsResponse{
+		Accounts:   accounts,
+		Pagination: pageRes,
+	}, nil
+}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/cheqd/cheqd-node/x/did/types"
+	"github.com/cheqd/cheqd-node/x/did/utils"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ResolveDid(ctx context.Context, req *types.QueryResolveDidRequest) (*types.QueryResolveDidResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	req.Normalize()
+
+	if !types.IsSupportedDidRepresentation(req.Accept) {
+		return nil, status.Errorf(codes.InvalidArgument, "representation not supported: %s", req.Accept)
+	}
+
+	result, err := k.resolveDid(ctx, req.Id, req.VersionId, req.VersionTime, req.Accept)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryResolveDidResponse{
+		ContentType:         req.Accept,
+		DidResolutionResult: string(bz),
+	}, nil
+}
+
+// resolveDid builds the DID Resolution result for the latest version or the version selected by id or time
+func (k Keeper) resolveDid(ctx context.Context, did, versionID string, versionTime *time.Time, accept string) (types.DidResolutionResult, error) {
+	if versionID != "" && versionTime != nil {
+		return types.DidResolutionResult{}, status.Error(codes.InvalidArgument, "version_id and version_time cannot be combined")
+	}
+
+	var didDoc types.DidDocWithMetadata
+	var err error
+	switch {
+	case versionID != "":
+		didDoc, err = k.GetDidDocVersion(ctx, did, versionID)
+	case versionTime != nil:
+		didDoc, err = k.GetDidDocAtTime(ctx, did, *versionTime)
+	default:
+		didDoc, err = k.GetLatestDidDoc(ctx, did)
+	}
+	if err != nil {
+		return types.DidResolutionResult{}, err
+	}
+
+	linkedResources, err := k.getLinkedResourcesMetadata(ctx, didDoc, versionTime)
+	if err != nil {
+		return types.DidResolutionResult{}, err
+	}
+
+	return types.NewDidResolutionResult(didDoc, linkedResources, accept)
+}
+
+// getDidDocVersionActiveFrom returns the time a diddoc version became active.
+// The next version of the first version may be pruned, its time is then taken from the tombstone.
+func (k Keeper) getDidDocVersionActiveFrom(ctx context.Context, did, versionID string) (time.Time, error) {
+	has, err := k.HasDidDocVersion(ctx, did, versionID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if has {
+		version, err := k.GetDidDocVersion(ctx, did, versionID)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		return version.Metadata.ActiveFrom(), nil
+	}
+
+	tombstone, err := k.GetDidDocTombstone(ctx, did)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	pruned, found := tombstone.GetPrunedVersion(versionID)
+	if !found {
+		return time.Time{}, sdkerrors.ErrNotFound.Wrap("diddoc version not found")
+	}
+
+	return pruned.ActiveFrom, nil
+}
+
+// getLinkedResourcesMetadata returns the resources linked to the diddoc that already existed at the resolved point in time.
+// For historical versions these are the resources created before the next version became active.
+func (k Keeper) getLinkedResourcesMetadata(ctx context.Context, didDoc types.DidDocWithMetadata, at *time.Time) ([]types.LinkedResourceMetadata, error) {
+	if k.linkedResourcesProvider == nil {
+		return nil, nil
+	}
+
+	existed := func(time.Time) bool { return true }
+	switch {
+	case at != nil:
+		existed = func(created time.Time) bool { return !created.After(*at) }
+	case didDoc.Metadata.NextVersionId != "":
+		nextActiveFrom, err := k.getDidDocVersionActiveFrom(ctx, didDoc.DidDoc.Id, didDoc.Metadata.NextVersionId)
+		if err != nil {
+			return nil, err
+		}
+
+		existed = func(created time.Time) bool { return created.Before(nextActiveFrom) }
+	}
+
+	_, _, collectionID := utils.MustSplitDID(didDoc.DidDoc.Id)
+	resources, err := k.linkedResourcesProvider.GetLinkedResourcesMetadata(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	var linkedResources []types.LinkedResourceMetadata
+	for _, resource := range resources {
+		if !existed(resource.Created) {
+			continue
+		}
+
+		resource.ResourceURI = didDoc.DidDoc.Id + types.LinkedResourcePathPrefix + resource.ResourceID
+		linkedResources = append(linkedResources, resource)
+	}
+
+	return linkedResources, nil
+}
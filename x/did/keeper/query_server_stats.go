@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/did/types"
+)
+
+func (k Keeper) Stats(ctx context.Context, _ *types.QueryStatsRequest) (*types.QueryStatsResponse, error) {
+	didCount, err := k.GetDidDocCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	versionCount, err := k.GetDidDocVersionCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deactivatedDidCount, err := k.GetDeactivatedDidCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryStatsResponse{
+		DidCount:            didCount,
+		VersionCount:        versionCount,
+		DeactivatedDidCount: deactivatedDidCount,
+	}, nil
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/did/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) Tombstone(ctx context.Context, req *types.QueryTombstoneRequest) (*types.QueryTombstoneResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	req.Normalize()
+
+	// Validate the DID Document exists
+	_, err := k.GetLatestDidDocVersion(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	tombstone, err := k.GetDidDocTombstone(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTombstoneResponse{Tombstone: &tombstone}, nil
+}
@@ -0,0 +1,21 @@
+package v10
+
+import (
+	"cosmossdk.io/collections"
+	"github.com/cheqd/cheqd-node/x/did/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateStore sets the default allow-list of service endpoint URI schemes
+func MigrateStore(ctx sdk.Context, paramsStore collections.Item[types.FeeParams]) error {
+	params, err := paramsStore.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(params.AllowedServiceEndpointSchemes) == 0 {
+		params.AllowedServiceEndpointSchemes = append([]string{}, types.DefaultAllowedServiceEndpointSchemes...)
+	}
+
+	return paramsStore.Set(ctx, params)
+}
@@ -0,0 +1,25 @@
+package v11
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/cheqd/cheqd-node/x/did/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateStore builds the reverse indexes by controller, public key and alias of the latest diddoc versions
+func MigrateStore(ctx sdk.Context,
+	latestVersions collections.Map[string, string],
+	docCollection collections.Map[collections.Pair[string, string], types.DidDocWithMetadata],
+	indexDidDoc func(ctx context.Context, didDoc *types.DidDoc) error,
+) error {
+	return latestVersions.Walk(ctx, nil, func(did string, versionID string) (bool, error) {
+		didDoc, err := docCollection.Get(ctx, collections.Join(did, versionID))
+		if err != nil {
+			return true, err
+		}
+
+		return false, indexDidDoc(ctx, didDoc.DidDoc)
+	})
+}
@@ -0,0 +1,47 @@
+package v12
+
+import (
+	"cosmossdk.io/collections"
+	"github.com/cheqd/cheqd-node/x/did/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateStore sets the counters of stored diddoc versions and deactivated DIDs used by the module stats
+func MigrateStore(ctx sdk.Context,
+	latestVersions collections.Map[string, string],
+	docCollection collections.Map[collections.Pair[string, string], types.DidDocWithMetadata],
+	versionCount collections.Item[uint64],
+	deactivatedCount collections.Item[uint64],
+) error {
+	var versions uint64
+	err := docCollection.Walk(ctx, nil, func(_ collections.Pair[string, string], _ types.DidDocWithMetadata) (bool, error) {
+		versions++
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// All versions of a deactivated diddoc are marked as deactivated, including the latest one
+	var deactivated uint64
+	err = latestVersions.Walk(ctx, nil, func(did string, versionID string) (bool, error) {
+		didDoc, err := docCollection.Get(ctx, collections.Join(did, versionID))
+		if err != nil {
+			return true, err
+		}
+
+		if didDoc.Metadata.Deactivated {
+			deactivated++
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := versionCount.Set(ctx, versions); err != nil {
+		return err
+	}
+
+	return deactivatedCount.Set(ctx, deactivated)
+}
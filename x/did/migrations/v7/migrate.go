@@ -0,0 +1,19 @@
+package v7
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/cheqd/cheqd-node/x/did/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateStore builds the time index of the existing diddoc versions
+func MigrateStore(ctx sdk.Context,
+	docCollection collections.Map[collections.Pair[string, string], types.DidDocWithMetadata],
+	setVersionTime func(ctx context.Context, didDoc *types.DidDocWithMetadata) error,
+) error {
+	return docCollection.Walk(ctx, nil, func(_ collections.Pair[string, string], didDoc types.DidDocWithMetadata) (bool, error) {
+		return false, setVersionTime(ctx, &didDoc)
+	})
+}
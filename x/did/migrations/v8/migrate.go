@@ -0,0 +1,21 @@
+package v8
+
+import (
+	"cosmossdk.io/collections"
+	"github.com/cheqd/cheqd-node/x/did/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateStore sets the default batch size limit of the batch creation message
+func MigrateStore(ctx sdk.Context, paramsStore collections.Item[types.FeeParams]) error {
+	params, err := paramsStore.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.MaxBatchSize == 0 {
+		params.MaxBatchSize = types.DefaultMaxBatchSize
+	}
+
+	return paramsStore.Set(ctx, params)
+}
@@ -0,0 +1,43 @@
+package v9
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+	"github.com/cheqd/cheqd-node/x/did/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateStore schedules pruning of the intermediate versions of the existing diddocs,
+// at the time each of them was superseded, as if they had been added after the upgrade
+func MigrateStore(ctx sdk.Context,
+	latestVersions collections.Map[string, string],
+	docCollection collections.Map[collections.Pair[string, string], types.DidDocWithMetadata],
+	enqueue func(ctx context.Context, supersededAt time.Time, did string) error,
+) error {
+	return latestVersions.Walk(ctx, nil, func(did string, versionID string) (bool, error) {
+		next, err := docCollection.Get(ctx, collections.Join(did, versionID))
+		if err != nil {
+			return true, err
+		}
+
+		// Walk the version chain back from the latest version, the first version is never pruned
+		for next.Metadata.PreviousVersionId != "" {
+			version, err := docCollection.Get(ctx, collections.Join(did, next.Metadata.PreviousVersionId))
+			if err != nil {
+				return true, err
+			}
+
+			if version.Metadata.PreviousVersionId != "" {
+				if err := enqueue(ctx, next.Metadata.ActiveFrom(), did); err != nil {
+					return true, err
+				}
+			}
+
+			next = version
+		}
+
+		return false, nil
+	})
+}
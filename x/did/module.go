@@ -129,7 +129,7 @@ func NewAppModule(cdc codec.Codec, keeper keeper.Keeper, subspace exported.Subsp
 // introduced by the module. To avoid wrong/empty versions, the initial version
 // should be set to 1.
 func (am AppModule) ConsensusVersion() uint64 {
-	return 6
+	return 12
 }
 
 // Name returns the cheqd module's name.
@@ -151,6 +151,24 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5to6); err != nil {
 		panic(fmt.Sprintf("failed to migrate x/did from version 5 to 6: %v", err))
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 6, m.Migrate6to7); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/did from version 6 to 7: %v", err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 7, m.Migrate7to8); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/did from version 7 to 8: %v", err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 8, m.Migrate8to9); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/did from version 8 to 9: %v", err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 9, m.Migrate9to10); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/did from version 9 to 10: %v", err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 10, m.Migrate10to11); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/did from version 10 to 11: %v", err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 11, m.Migrate11to12); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/did from version 11 to 12: %v", err))
+	}
 }
 
 // RegisterInvariants registers the cheqd module's invariants.
@@ -178,7 +196,12 @@ func (am AppModule) BeginBlock(_ context.Context) error {
 }
 
 // EndBlock executes all ABCI EndBlock logic respective to the cheqd module. It
-// returns no validator updates.
-func (am AppModule) EndBlock(_ context.Context) ([]abci.ValidatorUpdate, error) {
+// prunes DID Document versions past the retention period and returns no validator updates.
+func (am AppModule) EndBlock(ctx context.Context) ([]abci.ValidatorUpdate, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := am.keeper.PruneDidDocVersions(ctx, sdkCtx.BlockTime()); err != nil {
+		return nil, err
+	}
+
 	return []abci.ValidatorUpdate{}, nil
 }
@@ -0,0 +1,106 @@
+package tests
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	testsetup "github.com/cheqd/cheqd-node/x/did/tests/setup"
+	"github.com/cheqd/cheqd-node/x/did/types"
+)
+
+var _ = Describe("Batch create DID tests", func() {
+	var setup testsetup.TestSetup
+
+	BeforeEach(func() {
+		setup = testsetup.Setup()
+
+		err := setup.Keeper.SetParams(setup.StdCtx, *types.DefaultFeeParams())
+		Expect(err).To(BeNil())
+	})
+
+	It("Valid: Creates all DIDDocs of the batch", func() {
+		alice := setup.BuildSimpleDidDoc()
+		bob := setup.BuildSimpleDidDoc()
+
+		res, err := setup.BatchCreateDids(&types.MsgBatchCreateDidDocsPayload{
+			Payloads: []*types.MsgCreateDidDocPayload{alice.Msg, bob.Msg},
+		}, []testsetup.SignInput{alice.SignInput, bob.SignInput})
+		Expect(err).To(BeNil())
+		Expect(res.Values).To(HaveLen(2))
+
+		for _, info := range []testsetup.DidDocInfo{alice, bob} {
+			created, err := setup.QueryDidDoc(info.Did)
+			Expect(err).To(BeNil())
+			Expect(info.Msg.ToDidDoc()).To(Equal(*created.Value.DidDoc))
+		}
+	})
+
+	It("Valid: DIDDoc controlled by another DIDDoc of the same batch", func() {
+		alice := setup.BuildSimpleDidDoc()
+		bob := setup.BuildSimpleDidDoc()
+		bob.Msg.Controller = []string{alice.Did}
+
+		_, err := setup.BatchCreateDids(&types.MsgBatchCreateDidDocsPayload{
+			Payloads: []*types.MsgCreateDidDocPayload{alice.Msg, bob.Msg},
+		}, []testsetup.SignInput{alice.SignInput, bob.SignInput})
+		Expect(err).To(BeNil())
+
+		created, err := setup.QueryDidDoc(bob.Did)
+		Expect(err).To(BeNil())
+		Expect(created.Value.DidDoc.Controller).To(Equal([]string{alice.Did}))
+	})
+
+	It("Not Valid: Creates nothing if one signature is missing", func() {
+		alice := setup.BuildSimpleDidDoc()
+		bob := setup.BuildSimpleDidDoc()
+
+		_, err := setup.BatchCreateDids(&types.MsgBatchCreateDidDocsPayload{
+			Payloads: []*types.MsgCreateDidDocPayload{alice.Msg, bob.Msg},
+		}, []testsetup.SignInput{alice.SignInput})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("signature is required but not found"))
+
+		_, err = setup.QueryDidDoc(alice.Did)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Not Valid: Creates nothing if one DIDDoc already exists", func() {
+		alice := setup.CreateSimpleDid()
+		bob := setup.BuildSimpleDidDoc()
+
+		_, err := setup.BatchCreateDids(&types.MsgBatchCreateDidDocsPayload{
+			Payloads: []*types.MsgCreateDidDocPayload{bob.Msg, alice.Msg},
+		}, []testsetup.SignInput{alice.SignInput, bob.SignInput})
+		Expect(err).To(HaveOccurred())
+		Expect(err).To(MatchError(ContainSubstring(types.ErrDidDocExists.Error())))
+
+		_, err = setup.QueryDidDoc(bob.Did)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Not Valid: Duplicated DIDDoc in the batch", func() {
+		alice := setup.BuildSimpleDidDoc()
+
+		_, err := setup.BatchCreateDids(&types.MsgBatchCreateDidDocsPayload{
+			Payloads: []*types.MsgCreateDidDocPayload{alice.Msg, alice.Msg},
+		}, []testsetup.SignInput{alice.SignInput})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("duplicated DID Document id"))
+	})
+
+	It("Not Valid: Batch exceeds max_batch_size", func() {
+		params := *types.DefaultFeeParams()
+		params.MaxBatchSize = 1
+		err := setup.Keeper.SetParams(setup.StdCtx, params)
+		Expect(err).To(BeNil())
+
+		alice := setup.BuildSimpleDidDoc()
+		bob := setup.BuildSimpleDidDoc()
+
+		_, err = setup.BatchCreateDids(&types.MsgBatchCreateDidDocsPayload{
+			Payloads: []*types.MsgCreateDidDocPayload{alice.Msg, bob.Msg},
+		}, []testsetup.SignInput{alice.SignInput, bob.SignInput})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("at most 1 are allowed"))
+	})
+})
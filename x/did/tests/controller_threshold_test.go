@@ -0,0 +1,212 @@
+package tests
+
+import (
+	"github.com/google/uuid"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	testsetup "github.com/cheqd/cheqd-node/x/did/tests/setup"
+	"github.com/cheqd/cheqd-node/x/did/types"
+)
+
+var _ = Describe("Controller threshold", func() {
+	var setup testsetup.TestSetup
+	var alice, bob, carol testsetup.CreatedDidDocInfo
+	var org testsetup.CreatedDidDocInfo
+
+	BeforeEach(func() {
+		setup = testsetup.Setup()
+		alice = setup.CreateSimpleDid()
+		bob = setup.CreateSimpleDid()
+		carol = setup.CreateSimpleDid()
+	})
+
+	buildUpdate := func(didDoc testsetup.CreatedDidDocInfo, controllers []string, threshold uint32) *types.MsgUpdateDidDocPayload {
+		vm := *didDoc.Msg.VerificationMethod[0]
+		return &types.MsgUpdateDidDocPayload{
+			Id:                  didDoc.Did,
+			Controller:          controllers,
+			ControllerThreshold: threshold,
+			VerificationMethod:  []*types.VerificationMethod{&vm},
+			Authentication:      didDoc.Msg.Authentication,
+			AssertionMethod:     didDoc.Msg.Authentication,
+			VersionId:           uuid.NewString(),
+		}
+	}
+
+	Describe("Validation", func() {
+		It("Rejects a threshold above the number of controllers", func() {
+			didDoc := setup.BuildSimpleDidDoc()
+			didDoc.Msg.Controller = []string{alice.Did, bob.Did}
+			didDoc.Msg.ControllerThreshold = 3
+
+			_, err := setup.CreateDid(didDoc.Msg, []testsetup.SignInput{didDoc.SignInput, alice.SignInput, bob.SignInput})
+			Expect(err).To(MatchError(ContainSubstring("controller_threshold")))
+		})
+
+		It("Allows a threshold of one for a DID Document controlled by its subject", func() {
+			didDoc := setup.BuildSimpleDidDoc()
+			didDoc.Msg.ControllerThreshold = 1
+
+			_, err := setup.CreateDid(didDoc.Msg, []testsetup.SignInput{didDoc.SignInput})
+			Expect(err).To(BeNil())
+		})
+
+		It("Still requires all controllers to sign the creation", func() {
+			didDoc := setup.BuildSimpleDidDoc()
+			didDoc.Msg.Controller = []string{alice.Did, bob.Did}
+			didDoc.Msg.ControllerThreshold = 1
+
+			_, err := setup.CreateDid(didDoc.Msg, []testsetup.SignInput{didDoc.SignInput, alice.SignInput})
+			Expect(err).To(MatchError(ContainSubstring(types.ErrSignatureNotFound.Error())))
+		})
+	})
+
+	Describe("Update", func() {
+		BeforeEach(func() {
+			org = setup.CreateDidDocWithControllerThreshold([]string{alice.Did, bob.Did, carol.Did}, false, 2,
+				[]testsetup.SignInput{alice.SignInput, bob.SignInput, carol.SignInput})
+		})
+
+		It("Works with signatures of the threshold of controllers", func() {
+			msg := buildUpdate(org, org.Msg.Controller, 2)
+
+			res, err := setup.UpdateDidDoc(msg, []testsetup.SignInput{alice.SignInput, carol.SignInput})
+			Expect(err).To(BeNil())
+			Expect(res.Value.DidDoc.ControllerThreshold).To(Equal(uint32(2)))
+		})
+
+		It("Doesn't work below the threshold", func() {
+			msg := buildUpdate(org, org.Msg.Controller, 2)
+
+			_, err := setup.UpdateDidDoc(msg, []testsetup.SignInput{alice.SignInput})
+			Expect(err).To(MatchError(ContainSubstring("there should be valid signatures by at least 2")))
+		})
+
+		It("Doesn't count invalid signatures towards the threshold", func() {
+			msg := buildUpdate(org, org.Msg.Controller, 2)
+			forged := testsetup.SignInput{
+				VerificationMethodID: bob.KeyID,
+				Key:                  testsetup.GenerateKeyPair().Private,
+			}
+
+			_, err := setup.UpdateDidDoc(msg, []testsetup.SignInput{alice.SignInput, forged})
+			Expect(err).To(MatchError(ContainSubstring(types.ErrSignatureNotFound.Error())))
+		})
+
+		It("Requires the current threshold to lower it", func() {
+			msg := buildUpdate(org, org.Msg.Controller, 1)
+
+			_, err := setup.UpdateDidDoc(msg, []testsetup.SignInput{alice.SignInput})
+			Expect(err).To(MatchError(ContainSubstring("there should be valid signatures by at least 2")))
+
+			_, err = setup.UpdateDidDoc(buildUpdate(org, org.Msg.Controller, 1), []testsetup.SignInput{alice.SignInput, bob.SignInput})
+			Expect(err).To(BeNil())
+
+			_, err = setup.UpdateDidDoc(buildUpdate(org, org.Msg.Controller, 1), []testsetup.SignInput{carol.SignInput})
+			Expect(err).To(BeNil())
+		})
+
+		It("Doesn't require removed controllers to sign", func() {
+			msg := buildUpdate(org, []string{alice.Did, bob.Did}, 0)
+
+			_, err := setup.UpdateDidDoc(msg, []testsetup.SignInput{alice.SignInput, bob.SignInput})
+			Expect(err).To(BeNil())
+
+			// Without a threshold all remaining controllers are required again
+			_, err = setup.UpdateDidDoc(buildUpdate(org, []string{alice.Did, bob.Did}, 0), []testsetup.SignInput{alice.SignInput})
+			Expect(err).To(MatchError(ContainSubstring(bob.Did)))
+		})
+
+		It("Doesn't let removed controllers reach the threshold", func() {
+			_, err := setup.UpdateDidDoc(buildUpdate(org, []string{alice.Did, bob.Did}, 1), []testsetup.SignInput{alice.SignInput, carol.SignInput})
+			Expect(err).To(BeNil())
+
+			_, err = setup.UpdateDidDoc(buildUpdate(org, []string{alice.Did, bob.Did}, 1), []testsetup.SignInput{carol.SignInput})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("Requires the new threshold of added controllers", func() {
+			dave := setup.CreateSimpleDid()
+			msg := buildUpdate(org, []string{dave.Did}, 0)
+
+			_, err := setup.UpdateDidDoc(msg, []testsetup.SignInput{alice.SignInput, bob.SignInput})
+			Expect(err).To(MatchError(ContainSubstring(dave.Did)))
+
+			_, err = setup.UpdateDidDoc(buildUpdate(org, []string{dave.Did}, 0), []testsetup.SignInput{alice.SignInput, bob.SignInput, dave.SignInput})
+			Expect(err).To(BeNil())
+		})
+	})
+
+	Describe("Self-control", func() {
+		BeforeEach(func() {
+			org = setup.CreateDidDocWithControllerThreshold([]string{alice.Did}, true, 1, []testsetup.SignInput{alice.SignInput})
+		})
+
+		It("Lets another controller rotate the keys of the subject", func() {
+			newKeyPair := testsetup.GenerateKeyPair()
+			msg := buildUpdate(org, org.Msg.Controller, 1)
+			msg.VerificationMethod[0].VerificationMaterial = testsetup.GenerateEd25519VerificationKey2020VerificationMaterial(newKeyPair.Public)
+
+			_, err := setup.UpdateDidDoc(msg, []testsetup.SignInput{alice.SignInput})
+			Expect(err).To(BeNil())
+
+			updated, err := setup.QueryDidDoc(org.Did)
+			Expect(err).To(BeNil())
+			Expect(updated.Value.DidDoc.VerificationMethod[0].VerificationMaterial).To(Equal(msg.VerificationMethod[0].VerificationMaterial))
+		})
+
+		It("Lets the subject update alone", func() {
+			_, err := setup.UpdateDidDoc(buildUpdate(org, org.Msg.Controller, 1), []testsetup.SignInput{org.SignInput})
+			Expect(err).To(BeNil())
+		})
+
+		It("Requires a controller outside of both versions to sign for its verification method", func() {
+			msg := buildUpdate(org, org.Msg.Controller, 1)
+			msg.VerificationMethod = append(msg.VerificationMethod, &types.VerificationMethod{
+				Id:                     org.Did + "#key-2",
+				VerificationMethodType: types.Ed25519VerificationKey2020Type,
+				Controller:             bob.Did,
+				VerificationMaterial:   testsetup.GenerateEd25519VerificationKey2020VerificationMaterial(bob.KeyPair.Public),
+			})
+
+			_, err := setup.UpdateDidDoc(msg, []testsetup.SignInput{alice.SignInput})
+			Expect(err).To(MatchError(ContainSubstring(bob.Did)))
+		})
+	})
+
+	Describe("Deactivation", func() {
+		BeforeEach(func() {
+			org = setup.CreateDidDocWithControllerThreshold([]string{alice.Did, bob.Did, carol.Did}, false, 2,
+				[]testsetup.SignInput{alice.SignInput, bob.SignInput, carol.SignInput})
+		})
+
+		deactivate := func(signInputs []testsetup.SignInput) error {
+			_, err := setup.DeactivateDidDoc(&types.MsgDeactivateDidDocPayload{
+				Id:        org.Did,
+				VersionId: uuid.NewString(),
+			}, signInputs)
+			return err
+		}
+
+		It("Works with signatures of the threshold of controllers", func() {
+			Expect(deactivate([]testsetup.SignInput{bob.SignInput, carol.SignInput})).To(Succeed())
+		})
+
+		It("Doesn't work below the threshold", func() {
+			err := deactivate([]testsetup.SignInput{bob.SignInput})
+			Expect(err).To(MatchError(ContainSubstring(types.ErrSignatureNotFound.Error())))
+		})
+
+		It("Doesn't work with an invalid signature of a controller", func() {
+			forged := testsetup.SignInput{
+				VerificationMethodID: carol.KeyID,
+				Key:                  testsetup.GenerateKeyPair().Private,
+			}
+
+			err := deactivate([]testsetup.SignInput{alice.SignInput, bob.SignInput, forged})
+			Expect(err).To(MatchError(ContainSubstring(types.ErrInvalidSignature.Error())))
+		})
+	})
+})
@@ -1,8 +1,13 @@
 package tests
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
 	"fmt"
 
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"github.com/google/uuid"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -54,6 +59,19 @@ var _ = Describe("Create DID tests", func() {
 		Expect(msg.ToDidDoc()).To(Equal(*created.Value.DidDoc))
 	})
 
+	It("Valid: Emits EventDidDocCreated", func() {
+		alice := setup.CreateSimpleDid()
+		bob := setup.CreateDidDocWithExternalControllers([]string{alice.Did}, []testsetup.SignInput{alice.SignInput})
+
+		event, err := setup.LastTypedEvent(&types.EventDidDocCreated{})
+		Expect(err).To(BeNil())
+		Expect(event).To(Equal(&types.EventDidDocCreated{
+			Did:         bob.Did,
+			VersionId:   bob.VersionID,
+			Controllers: []string{alice.Did},
+		}))
+	})
+
 	It("Valid: Works for simple DIDDoc (JsonWebKey2020)", func() {
 		did := testsetup.GenerateDID(testsetup.Base58_16bytes)
 		keypair := testsetup.GenerateKeyPair()
@@ -124,6 +142,197 @@ var _ = Describe("Create DID tests", func() {
 		Expect(msg.ToDidDoc()).To(Equal(*created.Value.DidDoc))
 	})
 
+	It("Valid: Works for simple DIDDoc (EcdsaSecp256k1VerificationKey2019)", func() {
+		did := testsetup.GenerateDID(testsetup.Base58_16bytes)
+		privKey, err := secp256k1.GeneratePrivateKey()
+		Expect(err).To(BeNil())
+		keyID := did + "#key-1"
+
+		msg := &types.MsgCreateDidDocPayload{
+			Id:             did,
+			Authentication: []string{keyID},
+			VerificationMethod: []*types.VerificationMethod{
+				{
+					Id:                     keyID,
+					VerificationMethodType: types.EcdsaSecp256k1VerificationKey2019Type,
+					Controller:             did,
+					VerificationMaterial:   testsetup.GenerateEcdsaSecp256k1VerificationKey2019VerificationMaterial(privKey.PubKey()),
+				},
+			},
+			VersionId: uuid.NewString(),
+		}
+
+		signatures := []testsetup.SignInput{
+			{
+				VerificationMethodID: keyID,
+				Signer: func(message []byte) []byte {
+					return testsetup.SignSecp256k1(privKey, message)
+				},
+			},
+		}
+
+		_, err = setup.CreateDid(msg, signatures)
+		Expect(err).To(BeNil())
+
+		// check
+		created, err := setup.QueryDidDoc(did)
+		Expect(err).To(BeNil())
+		Expect(msg.ToDidDoc()).To(Equal(*created.Value.DidDoc))
+	})
+
+	It("Valid: Works for simple DIDDoc (EcdsaSecp256k1RecoveryMethod2020)", func() {
+		did := testsetup.GenerateDID(testsetup.Base58_16bytes)
+		privKey, err := secp256k1.GeneratePrivateKey()
+		Expect(err).To(BeNil())
+		keyID := did + "#key-1"
+
+		msg := &types.MsgCreateDidDocPayload{
+			Id:             did,
+			Authentication: []string{keyID},
+			VerificationMethod: []*types.VerificationMethod{
+				{
+					Id:                     keyID,
+					VerificationMethodType: types.EcdsaSecp256k1RecoveryMethod2020Type,
+					Controller:             did,
+					VerificationMaterial:   testsetup.GenerateEcdsaSecp256k1RecoveryMethod2020VerificationMaterial(privKey.PubKey()),
+				},
+			},
+			VersionId: uuid.NewString(),
+		}
+
+		signatures := []testsetup.SignInput{
+			{
+				VerificationMethodID: keyID,
+				Signer: func(message []byte) []byte {
+					return testsetup.SignSecp256k1Recoverable(privKey, message)
+				},
+			},
+		}
+
+		_, err = setup.CreateDid(msg, signatures)
+		Expect(err).To(BeNil())
+
+		// check
+		created, err := setup.QueryDidDoc(did)
+		Expect(err).To(BeNil())
+		Expect(msg.ToDidDoc()).To(Equal(*created.Value.DidDoc))
+	})
+
+	It("Valid: Works for simple DIDDoc (Multikey secp256k1)", func() {
+		did := testsetup.GenerateDID(testsetup.Base58_16bytes)
+		privKey, err := secp256k1.GeneratePrivateKey()
+		Expect(err).To(BeNil())
+		keyID := did + "#key-1"
+
+		msg := &types.MsgCreateDidDocPayload{
+			Id:             did,
+			Authentication: []string{keyID},
+			VerificationMethod: []*types.VerificationMethod{
+				{
+					Id:                     keyID,
+					VerificationMethodType: types.MultikeyType,
+					Controller:             did,
+					VerificationMaterial:   testsetup.GenerateSecp256k1MultikeyVerificationMaterial(privKey.PubKey()),
+				},
+			},
+			VersionId: uuid.NewString(),
+		}
+
+		signatures := []testsetup.SignInput{
+			{
+				VerificationMethodID: keyID,
+				Signer: func(message []byte) []byte {
+					return testsetup.SignSecp256k1(privKey, message)
+				},
+			},
+		}
+
+		_, err = setup.CreateDid(msg, signatures)
+		Expect(err).To(BeNil())
+
+		// check
+		created, err := setup.QueryDidDoc(did)
+		Expect(err).To(BeNil())
+		Expect(msg.ToDidDoc()).To(Equal(*created.Value.DidDoc))
+	})
+
+	It("Valid: Works for simple DIDDoc (Multikey P-256)", func() {
+		did := testsetup.GenerateDID(testsetup.Base58_16bytes)
+		privKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).To(BeNil())
+		keyID := did + "#key-1"
+
+		msg := &types.MsgCreateDidDocPayload{
+			Id:             did,
+			Authentication: []string{keyID},
+			VerificationMethod: []*types.VerificationMethod{
+				{
+					Id:                     keyID,
+					VerificationMethodType: types.MultikeyType,
+					Controller:             did,
+					VerificationMaterial:   testsetup.GenerateECDSAMultikeyVerificationMaterial(privKey.PublicKey),
+				},
+			},
+			VersionId: uuid.NewString(),
+		}
+
+		signatures := []testsetup.SignInput{
+			{
+				VerificationMethodID: keyID,
+				Signer: func(message []byte) []byte {
+					digest := sha256.Sum256(message)
+					signature, err := ecdsa.SignASN1(rand.Reader, privKey, digest[:])
+					Expect(err).To(BeNil())
+					return signature
+				},
+			},
+		}
+
+		_, err = setup.CreateDid(msg, signatures)
+		Expect(err).To(BeNil())
+
+		// check
+		created, err := setup.QueryDidDoc(did)
+		Expect(err).To(BeNil())
+		Expect(msg.ToDidDoc()).To(Equal(*created.Value.DidDoc))
+	})
+
+	It("Not Valid: Secp256k1 signature of another key", func() {
+		did := testsetup.GenerateDID(testsetup.Base58_16bytes)
+		privKey, err := secp256k1.GeneratePrivateKey()
+		Expect(err).To(BeNil())
+		otherPrivKey, err := secp256k1.GeneratePrivateKey()
+		Expect(err).To(BeNil())
+		keyID := did + "#key-1"
+
+		msg := &types.MsgCreateDidDocPayload{
+			Id:             did,
+			Authentication: []string{keyID},
+			VerificationMethod: []*types.VerificationMethod{
+				{
+					Id:                     keyID,
+					VerificationMethodType: types.EcdsaSecp256k1RecoveryMethod2020Type,
+					Controller:             did,
+					VerificationMaterial:   testsetup.GenerateEcdsaSecp256k1RecoveryMethod2020VerificationMaterial(privKey.PubKey()),
+				},
+			},
+			VersionId: uuid.NewString(),
+		}
+
+		signatures := []testsetup.SignInput{
+			{
+				VerificationMethodID: keyID,
+				Signer: func(message []byte) []byte {
+					return testsetup.SignSecp256k1Recoverable(otherPrivKey, message)
+				},
+			},
+		}
+
+		_, err = setup.CreateDid(msg, signatures)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("method id: %s: invalid signature detected", keyID)))
+	})
+
 	It("Valid: DID with external controllers", func() {
 		// Alice
 		alice := setup.CreateSimpleDid()
@@ -211,7 +420,7 @@ var _ = Describe("Create DID tests", func() {
 				{
 					Id:              did + "#service-1",
 					ServiceType:     "type-1",
-					ServiceEndpoint: []string{"endpoint-1"},
+					ServiceEndpoint: []string{"https://example.com/endpoint-1"},
 					RecipientKeys:   []string{keyID4, "did:key:z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK"},
 					RoutingKeys:     []string{"did:key:z6MkiTBz1ymuepAQ4HEHYSF1H8quG5GLVVQR3djdX3mDooWp"},
 					Accept:          []string{"didcomm/v2"},
@@ -220,7 +429,7 @@ var _ = Describe("Create DID tests", func() {
 				{
 					Id:              did + "#service-2",
 					ServiceType:     "type-1",
-					ServiceEndpoint: []string{"endpoint-2"},
+					ServiceEndpoint: []string{"https://example.com/endpoint-2"},
 					RecipientKeys:   []string{"did:key:z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK"},
 					RoutingKeys:     nil,
 					Accept:          nil,
@@ -300,7 +509,7 @@ var _ = Describe("Create DID tests", func() {
 				{
 					Id:              did + "#service-1",
 					ServiceType:     "type-1",
-					ServiceEndpoint: []string{"endpoint-1"},
+					ServiceEndpoint: []string{"https://example.com/endpoint-1"},
 				},
 			},
 			AlsoKnownAs: []string{"alias-1", "alias-2"},
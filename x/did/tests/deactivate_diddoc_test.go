@@ -40,6 +40,15 @@ var _ = Describe("Deactivate DID tests", func() {
 		for _, version := range versions.Versions {
 			Expect(version.Deactivated).To(BeTrue())
 		}
+
+		// Check that the deactivation event is emitted
+		event, err := setup.LastTypedEvent(&types.EventDidDocDeactivated{})
+		Expect(err).To(BeNil())
+		deactivated := event.(*types.EventDidDocDeactivated)
+		Expect(deactivated.Did).To(Equal(alice.Did))
+		Expect(deactivated.VersionId).To(Equal(msg.VersionId))
+		Expect(deactivated.PreviousVersionId).To(Equal(alice.VersionID))
+		Expect(deactivated.Controllers).To(BeEmpty())
 	})
 
 	When("DID is not found", func() {
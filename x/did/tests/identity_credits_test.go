@@ -0,0 +1,100 @@
+package tests
+
+import (
+	sdkmath "cosmossdk.io/math"
+	testsetup "github.com/cheqd/cheqd-node/x/did/tests/setup"
+	"github.com/cheqd/cheqd-node/x/did/types"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Identity credits", func() {
+	var setup testsetup.TestSetup
+	var buyer sdk.AccAddress
+
+	BeforeEach(func() {
+		setup = testsetup.Setup()
+
+		buyer = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+		err := testutil.FundAccount(setup.SdkCtx, setup.BankKeeper, buyer, sdk.NewCoins(sdk.NewInt64Coin(types.BaseMinimalDenom, 1000*1e9)))
+		Expect(err).To(BeNil())
+	})
+
+	purchase := func(amount int64) (*types.MsgPurchaseIdentityCreditsResponse, error) {
+		return setup.MsgServer.PurchaseIdentityCredits(setup.SdkCtx, types.NewMsgPurchaseIdentityCredits(buyer.String(), sdk.NewInt64Coin(types.BaseMinimalDenom, amount)))
+	}
+
+	It("credits purchases and holds them in the module account", func() {
+		_, err := purchase(100e9)
+		Expect(err).To(BeNil())
+		res, err := purchase(50e9)
+		Expect(err).To(BeNil())
+		Expect(res.Balance).To(Equal(sdk.NewInt64Coin(types.BaseMinimalDenom, 150e9)))
+
+		Expect(setup.BankKeeper.GetBalance(setup.SdkCtx, buyer, types.BaseMinimalDenom).Amount).To(Equal(sdkmath.NewInt(850e9)))
+
+		queryRes, err := setup.QueryServer.IdentityCredits(setup.StdCtx, &types.QueryIdentityCreditsRequest{Address: buyer.String()})
+		Expect(err).To(BeNil())
+		Expect(queryRes.Account.Credits).To(Equal(sdkmath.NewInt(150e9)))
+		Expect(queryRes.Discount.IsZero()).To(BeTrue())
+
+		allRes, err := setup.QueryServer.AllIdentityCredits(setup.StdCtx, &types.QueryAllIdentityCreditsRequest{Pagination: &query.PageRequest{}})
+		Expect(err).To(BeNil())
+		Expect(allRes.Accounts).To(HaveLen(1))
+		Expect(allRes.Accounts[0].Address).To(Equal(buyer.String()))
+	})
+
+	It("rejects purchases in other denoms", func() {
+		_, err := setup.MsgServer.PurchaseIdentityCredits(setup.SdkCtx, types.NewMsgPurchaseIdentityCredits(buyer.String(), sdk.NewInt64Coin("stake", 1)))
+		Expect(err).ToNot(BeNil())
+	})
+
+	It("draws at most the available credits and removes emptied accounts", func() {
+		_, err := purchase(10e9)
+		Expect(err).To(BeNil())
+
+		drawn, err := setup.Keeper.DrawIdentityCredits(setup.StdCtx, buyer.String(), sdkmath.NewInt(4e9))
+		Expect(err).To(BeNil())
+		Expect(drawn).To(Equal(sdkmath.NewInt(4e9)))
+
+		drawn, err = setup.Keeper.DrawIdentityCredits(setup.StdCtx, buyer.String(), sdkmath.NewInt(20e9))
+		Expect(err).To(BeNil())
+		Expect(drawn).To(Equal(sdkmath.NewInt(6e9)))
+
+		has, err := setup.Keeper.IdentityCreditAccounts.Has(setup.StdCtx, buyer.String())
+		Expect(err).To(BeNil())
+		Expect(has).To(BeFalse())
+	})
+
+	It("applies volume discounts once enough identity transactions are paid", func() {
+		params, err := setup.Keeper.GetParams(setup.StdCtx)
+		Expect(err).To(BeNil())
+		params.VolumeDiscountTiers = []types.VolumeDiscountTier{{MinTxs: 2, Discount: sdkmath.LegacyMustNewDecFromStr("0.25")}}
+		Expect(setup.Keeper.SetParams(setup.StdCtx, params)).To(Succeed())
+
+		Expect(setup.Keeper.RecordIdentityFeePaid(setup.StdCtx, buyer.String())).To(Succeed())
+		discount, err := setup.Keeper.GetIdentityFeeDiscount(setup.StdCtx, buyer.String())
+		Expect(err).To(BeNil())
+		Expect(discount.IsZero()).To(BeTrue())
+
+		Expect(setup.Keeper.RecordIdentityFeePaid(setup.StdCtx, buyer.String())).To(Succeed())
+		discount, err = setup.Keeper.GetIdentityFeeDiscount(setup.StdCtx, buyer.String())
+		Expect(err).To(BeNil())
+		Expect(discount).To(Equal(sdkmath.LegacyMustNewDecFromStr("0.25")))
+
+		// the window is over after the volume discount window
+		setup.SetBlockTime(setup.SdkCtx.BlockTime().Add(params.VolumeDiscountWindow))
+		discount, err = setup.Keeper.GetIdentityFeeDiscount(setup.StdCtx, buyer.String())
+		Expect(err).To(BeNil())
+		Expect(discount.IsZero()).To(BeTrue())
+	})
+
+	It("rejects queries for invalid addresses", func() {
+		_, err := setup.QueryServer.IdentityCredits(setup.StdCtx, &types.QueryIdentityCreditsRequest{Address: "invalid"})
+		Expect(err).ToNot(BeNil())
+	})
+})
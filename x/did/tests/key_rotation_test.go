@@ -0,0 +1,190 @@
+package tests
+
+import (
+	"fmt"
+
+	. "github.com/cheqd/cheqd-node/x/did/tests/setup"
+	"github.com/google/uuid"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/cheqd/cheqd-node/x/did/types"
+)
+
+var _ = Describe("DIDDoc key rotation", func() {
+	var setup TestSetup
+	var did CreatedDidDocInfo
+	var newKeyPair KeyPair
+	var msg *types.MsgUpdateDidDocPayload
+
+	BeforeEach(func() {
+		setup = Setup()
+		did = setup.CreateSimpleDid()
+		newKeyPair = GenerateKeyPair()
+
+		msg = &types.MsgUpdateDidDocPayload{
+			Id: did.Did,
+			VerificationMethod: []*types.VerificationMethod{
+				{
+					Id:                     did.KeyID,
+					VerificationMethodType: types.Ed25519VerificationKey2020Type,
+					Controller:             did.Did,
+					VerificationMaterial:   GenerateEd25519VerificationKey2020VerificationMaterial(newKeyPair.Public),
+				},
+			},
+			Authentication: []string{did.KeyID},
+			VersionId:      uuid.NewString(),
+		}
+	})
+
+	It("Works with signatures bound to the old and the new version", func() {
+		signatures := []SignInput{
+			{
+				VerificationMethodID: did.KeyID,
+				Key:                  did.KeyPair.Private,
+				VersionID:            did.VersionID,
+			},
+			{
+				VerificationMethodID: did.KeyID,
+				Key:                  newKeyPair.Private,
+				VersionID:            msg.VersionId,
+			},
+		}
+
+		_, err := setup.UpdateDidDoc(msg, signatures)
+		Expect(err).To(BeNil())
+
+		updated, err := setup.QueryDidDoc(did.Did)
+		Expect(err).To(BeNil())
+		Expect(*updated.Value.DidDoc).To(Equal(msg.ToDidDoc()))
+	})
+
+	It("Works with a mix of bound and old-style signatures", func() {
+		signatures := []SignInput{
+			did.SignInput,
+			{
+				VerificationMethodID: did.KeyID,
+				Key:                  newKeyPair.Private,
+				VersionID:            msg.VersionId,
+			},
+		}
+
+		_, err := setup.UpdateDidDoc(msg, signatures)
+		Expect(err).To(BeNil())
+	})
+
+	It("Allows rotating the key again with the new key only", func() {
+		_, err := setup.UpdateDidDoc(msg, []SignInput{
+			did.SignInput,
+			{
+				VerificationMethodID: did.KeyID,
+				Key:                  newKeyPair.Private,
+			},
+		})
+		Expect(err).To(BeNil())
+
+		thirdKeyPair := GenerateKeyPair()
+		next := &types.MsgUpdateDidDocPayload{
+			Id: did.Did,
+			VerificationMethod: []*types.VerificationMethod{
+				{
+					Id:                     did.KeyID,
+					VerificationMethodType: types.Ed25519VerificationKey2020Type,
+					Controller:             did.Did,
+					VerificationMaterial:   GenerateEd25519VerificationKey2020VerificationMaterial(thirdKeyPair.Public),
+				},
+			},
+			Authentication: []string{did.KeyID},
+			VersionId:      uuid.NewString(),
+		}
+
+		_, err = setup.UpdateDidDoc(next, []SignInput{
+			{
+				VerificationMethodID: did.KeyID,
+				Key:                  newKeyPair.Private,
+				VersionID:            msg.VersionId,
+			},
+			{
+				VerificationMethodID: did.KeyID,
+				Key:                  thirdKeyPair.Private,
+				VersionID:            next.VersionId,
+			},
+		})
+		Expect(err).To(BeNil())
+	})
+
+	It("Doesn't work if the old key is bound to the new version", func() {
+		signatures := []SignInput{
+			{
+				VerificationMethodID: did.KeyID,
+				Key:                  did.KeyPair.Private,
+				VersionID:            msg.VersionId,
+			},
+			{
+				VerificationMethodID: did.KeyID,
+				Key:                  newKeyPair.Private,
+				VersionID:            msg.VersionId,
+			},
+		}
+
+		_, err := setup.UpdateDidDoc(msg, signatures)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("%s (old version)", did.Did)))
+	})
+
+	It("Doesn't work if the new key is bound to the old version", func() {
+		signatures := []SignInput{
+			did.SignInput,
+			{
+				VerificationMethodID: did.KeyID,
+				Key:                  newKeyPair.Private,
+				VersionID:            did.VersionID,
+			},
+		}
+
+		_, err := setup.UpdateDidDoc(msg, signatures)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("%s (new version)", did.Did)))
+	})
+
+	It("Doesn't work if a controller signature is bound to an outdated version of the controller", func() {
+		bob := setup.CreateSimpleDid()
+		alice := setup.CreateDidDocWithExternalControllers([]string{bob.Did}, []SignInput{bob.SignInput})
+
+		// Update bob, so that its created version becomes outdated
+		_, err := setup.UpdateDidDoc(&types.MsgUpdateDidDocPayload{
+			Id: bob.Did,
+			VerificationMethod: []*types.VerificationMethod{
+				{
+					Id:                     bob.KeyID,
+					VerificationMethodType: types.Ed25519VerificationKey2020Type,
+					Controller:             bob.Did,
+					VerificationMaterial:   GenerateEd25519VerificationKey2020VerificationMaterial(bob.KeyPair.Public),
+				},
+			},
+			Authentication: []string{bob.KeyID},
+			VersionId:      uuid.NewString(),
+		}, []SignInput{bob.SignInput})
+		Expect(err).To(BeNil())
+
+		update := &types.MsgUpdateDidDocPayload{
+			Id:                 alice.Did,
+			Controller:         alice.Msg.Controller,
+			VerificationMethod: alice.Msg.VerificationMethod,
+			Authentication:     alice.Msg.Authentication,
+			VersionId:          uuid.NewString(),
+		}
+
+		_, err = setup.UpdateDidDoc(update, []SignInput{
+			alice.SignInput,
+			{
+				VerificationMethodID: bob.KeyID,
+				Key:                  bob.KeyPair.Private,
+				VersionID:            bob.VersionID,
+			},
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(types.ErrInvalidSignature.Error()))
+	})
+})
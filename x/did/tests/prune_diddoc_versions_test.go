@@ -0,0 +1,249 @@
+package tests
+
+import (
+	"time"
+
+	. "github.com/cheqd/cheqd-node/x/did/tests/setup"
+	"github.com/google/uuid"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	cheqd "github.com/cheqd/cheqd-node/x/did"
+	v9 "github.com/cheqd/cheqd-node/x/did/migrations/v9"
+	"github.com/cheqd/cheqd-node/x/did/types"
+)
+
+var _ = Describe("DIDDoc version pruning", func() {
+	var setup TestSetup
+	var alice CreatedDidDocInfo
+	var startTime time.Time
+	var versions []string
+
+	retention := 24 * time.Hour
+
+	update := func() string {
+		msg := &types.MsgUpdateDidDocPayload{
+			Id:                 alice.Did,
+			VerificationMethod: alice.Msg.VerificationMethod,
+			Authentication:     alice.Msg.Authentication,
+			VersionId:          uuid.NewString(),
+		}
+
+		_, err := setup.UpdateDidDoc(msg, []SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+
+		return msg.VersionId
+	}
+
+	setRetention := func(period time.Duration) {
+		params := *types.DefaultFeeParams()
+		params.VersionRetentionPeriod = period
+		err := setup.Keeper.SetParams(setup.StdCtx, params)
+		Expect(err).To(BeNil())
+	}
+
+	prune := func(at time.Time) {
+		setup.SetBlockTime(at)
+		err := setup.Keeper.PruneDidDocVersions(setup.StdCtx, at)
+		Expect(err).To(BeNil())
+	}
+
+	BeforeEach(func() {
+		setup = Setup()
+		setRetention(retention)
+		startTime = setup.SdkCtx.BlockTime()
+
+		alice = setup.CreateSimpleDid()
+		versions = []string{alice.VersionID}
+
+		for i := 1; i <= 3; i++ {
+			setup.SetBlockTime(startTime.Add(time.Duration(i) * time.Hour))
+			versions = append(versions, update())
+		}
+	})
+
+	It("Keeps intermediate versions within the retention period", func() {
+		prune(startTime.Add(retention))
+
+		metadata, err := setup.QueryAllDidDocVersionsMetadata(alice.Did)
+		Expect(err).To(BeNil())
+		Expect(metadata.Versions).To(HaveLen(4))
+
+		tombstone, err := setup.QueryTombstone(alice.Did)
+		Expect(err).To(BeNil())
+		Expect(tombstone.Tombstone.PrunedVersions).To(BeEmpty())
+	})
+
+	It("Prunes intermediate versions superseded before the retention period, keeping the first and the latest version", func() {
+		// The second version was superseded at startTime + 2h, the third one at startTime + 3h
+		prune(startTime.Add(2*time.Hour + retention))
+
+		_, err := setup.QueryDidDocVersion(alice.Did, versions[1])
+		Expect(err).To(HaveOccurred())
+		_, err = setup.QueryDidDocVersion(alice.Did, versions[2])
+		Expect(err).To(BeNil())
+
+		prune(startTime.Add(3*time.Hour + retention))
+
+		metadata, err := setup.QueryAllDidDocVersionsMetadata(alice.Did)
+		Expect(err).To(BeNil())
+		Expect(metadata.Versions).To(HaveLen(2))
+
+		for _, version := range []string{versions[0], versions[3]} {
+			_, err = setup.QueryDidDocVersion(alice.Did, version)
+			Expect(err).To(BeNil())
+		}
+
+		tombstone, err := setup.QueryTombstone(alice.Did)
+		Expect(err).To(BeNil())
+		Expect(tombstone.Tombstone.Id).To(Equal(alice.Did))
+		Expect(tombstone.Tombstone.PrunedVersions).To(HaveLen(2))
+		Expect(tombstone.Tombstone.PrunedVersions[0].VersionId).To(Equal(versions[1]))
+		Expect(tombstone.Tombstone.PrunedVersions[0].ActiveFrom).To(Equal(startTime.Add(time.Hour)))
+		Expect(tombstone.Tombstone.PrunedVersions[1].VersionId).To(Equal(versions[2]))
+		Expect(tombstone.Tombstone.ChainHash).To(Equal(tombstone.Tombstone.PrunedVersions[1].ChainHash))
+		Expect(tombstone.Tombstone.Validate()).To(Succeed())
+
+		// Kept versions are resolvable by time, pruned ones are reported as pruned
+		resp, err := setup.QueryDidDocAtTime(alice.Did, startTime.Add(30*time.Minute))
+		Expect(err).To(BeNil())
+		Expect(resp.Value.Metadata.VersionId).To(Equal(versions[0]))
+
+		_, err = setup.QueryDidDocAtTime(alice.Did, startTime.Add(90*time.Minute))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("was pruned"))
+	})
+
+	It("Prunes versions of diddocs that existed before the upgrade once the queue is seeded", func() {
+		Expect(setup.Keeper.DidDocPruneQueue.Clear(setup.SdkCtx, nil)).To(Succeed())
+
+		err := v9.MigrateStore(setup.SdkCtx, setup.Keeper.LatestDidVersion, setup.Keeper.DidDocuments, setup.Keeper.EnqueueDidDocForPruning)
+		Expect(err).To(BeNil())
+
+		prune(startTime.Add(2*time.Hour + retention))
+		_, err = setup.QueryDidDocVersion(alice.Did, versions[1])
+		Expect(err).To(HaveOccurred())
+		_, err = setup.QueryDidDocVersion(alice.Did, versions[2])
+		Expect(err).To(BeNil())
+
+		prune(startTime.Add(3*time.Hour + retention))
+		metadata, err := setup.QueryAllDidDocVersionsMetadata(alice.Did)
+		Expect(err).To(BeNil())
+		Expect(metadata.Versions).To(HaveLen(2))
+	})
+
+	It("Records the pruned versions in the hash chain", func() {
+		expected := types.NewDidDocTombstone(alice.Did)
+		for _, version := range versions[1:3] {
+			didDoc, err := setup.QueryDidDocVersion(alice.Did, version)
+			Expect(err).To(BeNil())
+			Expect(expected.AddPrunedVersion(*didDoc.Value)).To(Succeed())
+		}
+
+		prune(startTime.Add(3*time.Hour + retention))
+
+		tombstone, err := setup.QueryTombstone(alice.Did)
+		Expect(err).To(BeNil())
+		Expect(*tombstone.Tombstone).To(Equal(expected))
+	})
+
+	It("Keeps the deactivation version", func() {
+		setup.SetBlockTime(startTime.Add(4 * time.Hour))
+		deactivated, err := setup.DeactivateDidDoc(&types.MsgDeactivateDidDocPayload{
+			Id:        alice.Did,
+			VersionId: uuid.NewString(),
+		}, []SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+
+		prune(startTime.Add(4*time.Hour + retention))
+
+		metadata, err := setup.QueryAllDidDocVersionsMetadata(alice.Did)
+		Expect(err).To(BeNil())
+		Expect(metadata.Versions).To(HaveLen(2))
+
+		latest, err := setup.QueryDidDoc(alice.Did)
+		Expect(err).To(BeNil())
+		Expect(latest.Value.Metadata.VersionId).To(Equal(deactivated.Value.Metadata.VersionId))
+		Expect(latest.Value.Metadata.Deactivated).To(BeTrue())
+
+		// The DID can't be created again
+		_, err = setup.CreateDid(alice.Msg, []SignInput{alice.SignInput})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Doesn't prune if the retention period is zero", func() {
+		setRetention(0)
+		prune(startTime.Add(3*time.Hour + retention))
+
+		metadata, err := setup.QueryAllDidDocVersionsMetadata(alice.Did)
+		Expect(err).To(BeNil())
+		Expect(metadata.Versions).To(HaveLen(4))
+
+		// Pruning is scheduled once enabled
+		setRetention(retention)
+		prune(startTime.Add(3*time.Hour + retention))
+
+		metadata, err = setup.QueryAllDidDocVersionsMetadata(alice.Did)
+		Expect(err).To(BeNil())
+		Expect(metadata.Versions).To(HaveLen(2))
+	})
+
+	It("Returns error (not found) for the tombstone of an unknown DID", func() {
+		_, err := setup.QueryTombstone(GenerateDID(Base58_16bytes))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("not found"))
+	})
+
+	Describe("Genesis", func() {
+		BeforeEach(func() {
+			prune(startTime.Add(2*time.Hour + retention))
+		})
+
+		It("Exports and imports pruned state", func() {
+			genesis := cheqd.ExportGenesis(setup.StdCtx, setup.Keeper)
+			Expect(genesis.Validate()).To(Succeed())
+			Expect(genesis.Tombstones).To(HaveLen(1))
+			Expect(genesis.VersionSets[0].DidDocs).To(HaveLen(3))
+
+			imported := Setup()
+			cheqd.InitGenesis(imported.StdCtx, imported.Keeper, genesis)
+
+			tombstone, err := imported.QueryTombstone(alice.Did)
+			Expect(err).To(BeNil())
+			Expect(*tombstone.Tombstone).To(Equal(*genesis.Tombstones[0]))
+
+			_, err = imported.QueryDidDocAtTime(alice.Did, startTime.Add(90*time.Minute))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("was pruned"))
+
+			resp, err := imported.QueryDidDocAtTime(alice.Did, startTime.Add(150*time.Minute))
+			Expect(err).To(BeNil())
+			Expect(resp.Value.Metadata.VersionId).To(Equal(versions[2]))
+
+			// Pruning of the remaining intermediate version is scheduled again
+			imported.SetBlockTime(startTime.Add(3*time.Hour + retention))
+			err = imported.Keeper.PruneDidDocVersions(imported.StdCtx, imported.SdkCtx.BlockTime())
+			Expect(err).To(BeNil())
+
+			tombstone, err = imported.QueryTombstone(alice.Did)
+			Expect(err).To(BeNil())
+			Expect(tombstone.Tombstone.PrunedVersions).To(HaveLen(2))
+			Expect(tombstone.Tombstone.Validate()).To(Succeed())
+		})
+
+		It("Fails validation if a pruned version is part of the version set", func() {
+			genesis := cheqd.ExportGenesis(setup.StdCtx, setup.Keeper)
+			genesis.Tombstones[0].PrunedVersions[0].VersionId = versions[2]
+
+			Expect(genesis.Validate()).To(MatchError(ContainSubstring("found in version set")))
+		})
+
+		It("Fails validation if the hash chain is broken", func() {
+			genesis := cheqd.ExportGenesis(setup.StdCtx, setup.Keeper)
+			genesis.Tombstones[0].PrunedVersions[0].Checksum = "00"
+
+			Expect(genesis.Validate()).To(MatchError(ContainSubstring("invalid chain hash")))
+		})
+	})
+})
@@ -0,0 +1,133 @@
+package tests
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	. "github.com/cheqd/cheqd-node/x/did/tests/setup"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v12 "github.com/cheqd/cheqd-node/x/did/migrations/v12"
+	"github.com/cheqd/cheqd-node/x/did/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var _ = Describe("AllDids and Stats queries", func() {
+	var setup TestSetup
+	var alice CreatedDidDocInfo
+	var bob CreatedDidDocInfo
+	var start time.Time
+
+	BeforeEach(func() {
+		setup = Setup()
+		start = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		setup.SetBlockTime(start)
+		alice = setup.CreateSimpleDid()
+
+		setup.SetBlockTime(start.Add(time.Hour))
+		bob = setup.CreateSimpleDid()
+
+		_, err := setup.UpdateDidDoc(&types.MsgUpdateDidDocPayload{
+			Id:         bob.Did,
+			Controller: []string{bob.Did},
+			VerificationMethod: []*types.VerificationMethod{
+				{
+					Id:                     bob.KeyID,
+					VerificationMethodType: types.Ed25519VerificationKey2020Type,
+					Controller:             bob.Did,
+					VerificationMaterial:   GenerateEd25519VerificationKey2020VerificationMaterial(bob.KeyPair.Public),
+				},
+			},
+			Authentication:  []string{bob.KeyID},
+			AssertionMethod: []string{bob.KeyID},
+			VersionId:       uuid.NewString(),
+		}, []SignInput{bob.SignInput})
+		Expect(err).To(BeNil())
+
+		_, err = setup.DeactivateDidDoc(&types.MsgDeactivateDidDocPayload{
+			Id:        alice.Did,
+			VersionId: uuid.NewString(),
+		}, []SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+	})
+
+	queryAllDids := func(req *types.QueryAllDidsRequest) []string {
+		res, err := setup.QueryServer.AllDids(setup.StdCtx, req)
+		Expect(err).To(BeNil())
+
+		dids := make([]string, 0, len(res.Dids))
+		for _, didDoc := range res.Dids {
+			dids = append(dids, didDoc.DidDoc.Id)
+		}
+		return dids
+	}
+
+	It("Lists the latest versions of all DIDs", func() {
+		res, err := setup.QueryServer.AllDids(setup.StdCtx, &types.QueryAllDidsRequest{})
+		Expect(err).To(BeNil())
+		Expect(res.Dids).To(HaveLen(2))
+
+		for _, didDoc := range res.Dids {
+			latest, err := setup.QueryDidDoc(didDoc.DidDoc.Id)
+			Expect(err).To(BeNil())
+			Expect(*didDoc).To(Equal(*latest.Value))
+		}
+	})
+
+	It("Filters by deactivated state", func() {
+		Expect(queryAllDids(&types.QueryAllDidsRequest{Deactivated: types.DeactivatedFilter_DEACTIVATED_FILTER_ACTIVE})).To(Equal([]string{bob.Did}))
+		Expect(queryAllDids(&types.QueryAllDidsRequest{Deactivated: types.DeactivatedFilter_DEACTIVATED_FILTER_DEACTIVATED})).To(Equal([]string{alice.Did}))
+	})
+
+	It("Filters by created time range", func() {
+		after := start.Add(time.Minute)
+		Expect(queryAllDids(&types.QueryAllDidsRequest{CreatedAfter: &after})).To(Equal([]string{bob.Did}))
+		Expect(queryAllDids(&types.QueryAllDidsRequest{CreatedBefore: &after})).To(Equal([]string{alice.Did}))
+	})
+
+	It("Paginates the results", func() {
+		res, err := setup.QueryServer.AllDids(setup.StdCtx, &types.QueryAllDidsRequest{
+			Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+		})
+		Expect(err).To(BeNil())
+		Expect(res.Dids).To(HaveLen(1))
+		Expect(res.Pagination.Total).To(Equal(uint64(2)))
+
+		next, err := setup.QueryServer.AllDids(setup.StdCtx, &types.QueryAllDidsRequest{
+			Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1},
+		})
+		Expect(err).To(BeNil())
+		Expect(next.Dids).To(HaveLen(1))
+		Expect([]string{res.Dids[0].DidDoc.Id, next.Dids[0].DidDoc.Id}).To(ConsistOf(alice.Did, bob.Did))
+	})
+
+	It("Returns error (invalid argument) for a nil request", func() {
+		_, err := setup.QueryServer.AllDids(setup.StdCtx, nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Counts DIDs, versions and deactivated DIDs", func() {
+		res, err := setup.QueryServer.Stats(setup.StdCtx, &types.QueryStatsRequest{})
+		Expect(err).To(BeNil())
+		Expect(res.DidCount).To(Equal(uint64(2)))
+		Expect(res.VersionCount).To(Equal(uint64(4)))
+		Expect(res.DeactivatedDidCount).To(Equal(uint64(1)))
+	})
+
+	It("Restores the counters in the store migration", func() {
+		Expect(setup.Keeper.DidVersionCount.Remove(setup.StdCtx)).To(Succeed())
+		Expect(setup.Keeper.DeactivatedDidCount.Remove(setup.StdCtx)).To(Succeed())
+
+		err := v12.MigrateStore(setup.SdkCtx, setup.Keeper.LatestDidVersion, setup.Keeper.DidDocuments, setup.Keeper.DidVersionCount, setup.Keeper.DeactivatedDidCount)
+		Expect(err).To(BeNil())
+
+		res, err := setup.QueryServer.Stats(setup.StdCtx, &types.QueryStatsRequest{})
+		Expect(err).To(BeNil())
+		Expect(res.VersionCount).To(Equal(uint64(4)))
+		Expect(res.DeactivatedDidCount).To(Equal(uint64(1)))
+	})
+})
@@ -0,0 +1,130 @@
+package tests
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/cheqd/cheqd-node/x/did/tests/setup"
+	"github.com/google/uuid"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/cheqd/cheqd-node/x/did/types"
+)
+
+var _ = Describe("Query DereferenceDidUrl", func() {
+	var setup TestSetup
+	var alice CreatedDidDocInfo
+	var startTime time.Time
+	var serviceID string
+
+	BeforeEach(func() {
+		setup = Setup()
+		startTime = setup.SdkCtx.BlockTime()
+
+		didDoc := setup.BuildSimpleDidDoc()
+		serviceID = didDoc.Did + "#service-1"
+		didDoc.Msg.Service = []*types.Service{
+			{
+				Id:              serviceID,
+				ServiceType:     "LinkedDomains",
+				ServiceEndpoint: []string{"https://example.com"},
+			},
+		}
+
+		alice = setup.CreateCustomDidDoc(didDoc)
+	})
+
+	It("Dereferences a DID to its DID Document", func() {
+		res, err := setup.DereferenceDidUrl(alice.Did)
+		Expect(err).To(BeNil())
+		Expect(res.ContentType).To(Equal(types.DidLdJSONContentType))
+
+		var didDoc types.ResolvedDidDocument
+		Expect(json.Unmarshal(res.ContentStream, &didDoc)).To(Succeed())
+		Expect(didDoc.ID).To(Equal(alice.Did))
+		Expect(didDoc.Context).To(ContainElement(types.DidCoreContext))
+
+		var metadata types.ResolvedDidDocumentMetadata
+		Expect(json.Unmarshal([]byte(res.ContentMetadata), &metadata)).To(Succeed())
+		Expect(metadata.VersionID).To(Equal(alice.VersionID))
+
+		var dereferencingMetadata types.DidDereferencingMetadata
+		Expect(json.Unmarshal([]byte(res.DereferencingMetadata), &dereferencingMetadata)).To(Succeed())
+		Expect(dereferencingMetadata.ContentType).To(Equal(types.DidLdJSONContentType))
+		Expect(dereferencingMetadata.Did.DidString).To(Equal(alice.Did))
+	})
+
+	It("Dereferences a fragment to a verification method", func() {
+		res, err := setup.DereferenceDidUrl(alice.KeyID)
+		Expect(err).To(BeNil())
+
+		var vm types.ResolvedVerificationMethod
+		Expect(json.Unmarshal(res.ContentStream, &vm)).To(Succeed())
+		Expect(vm.ID).To(Equal(alice.KeyID))
+		Expect(vm.Type).To(Equal(types.Ed25519VerificationKey2020Type))
+		Expect(vm.PublicKeyMultibase).To(Equal(alice.Msg.VerificationMethod[0].VerificationMaterial))
+	})
+
+	It("Dereferences a fragment to a service", func() {
+		res, err := setup.DereferenceDidUrl(serviceID)
+		Expect(err).To(BeNil())
+
+		var service types.ResolvedService
+		Expect(json.Unmarshal(res.ContentStream, &service)).To(Succeed())
+		Expect(service.ID).To(Equal(serviceID))
+		Expect(service.ServiceEndpoint).To(Equal([]string{"https://example.com"}))
+	})
+
+	It("Dereferences a fragment in a specific version", func() {
+		setup.SetBlockTime(startTime.Add(time.Hour))
+
+		msg := &types.MsgUpdateDidDocPayload{
+			Id:                 alice.Did,
+			VerificationMethod: alice.Msg.VerificationMethod,
+			Authentication:     alice.Msg.Authentication,
+			VersionId:          uuid.NewString(),
+		}
+		_, err := setup.UpdateDidDoc(msg, []SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+
+		_, err = setup.DereferenceDidUrl(serviceID)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("not found"))
+
+		res, err := setup.DereferenceDidUrl(alice.Did + "?versionId=" + alice.VersionID + "#service-1")
+		Expect(err).To(BeNil())
+
+		var service types.ResolvedService
+		Expect(json.Unmarshal(res.ContentStream, &service)).To(Succeed())
+		Expect(service.ID).To(Equal(serviceID))
+
+		res, err = setup.DereferenceDidUrl(alice.Did + "?versionTime=" + startTime.Add(time.Minute).Format(time.RFC3339) + "#service-1")
+		Expect(err).To(BeNil())
+		Expect(json.Unmarshal(res.ContentStream, &service)).To(Succeed())
+		Expect(service.ID).To(Equal(serviceID))
+	})
+
+	It("Returns error (invalid argument) for unsupported paths and query parameters", func() {
+		for _, didURL := range []string{
+			alice.Did + "/some/path",
+			alice.Did + "?unknown=1",
+			alice.Did + "?versionTime=yesterday",
+			alice.Did + "?resourceName=name",
+			alice.Did + "?versionId=" + alice.VersionID + "&versionTime=" + startTime.Format(time.RFC3339),
+			alice.Did + "/resources/" + uuid.NewString() + "#key-1",
+			"did:other:" + alice.CollectionID,
+		} {
+			_, err := setup.DereferenceDidUrl(didURL)
+			Expect(err).To(HaveOccurred(), didURL)
+			Expect(err.Error()).To(ContainSubstring("invalid did url"), didURL)
+		}
+	})
+
+	It("Returns error (not found) for an unknown fragment", func() {
+		_, err := setup.DereferenceDidUrl(alice.Did + "#key-2")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("not found"))
+	})
+})
@@ -0,0 +1,100 @@
+package tests
+
+import (
+	"time"
+
+	. "github.com/cheqd/cheqd-node/x/did/tests/setup"
+	"github.com/google/uuid"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v7 "github.com/cheqd/cheqd-node/x/did/migrations/v7"
+	"github.com/cheqd/cheqd-node/x/did/types"
+)
+
+var _ = Describe("Query DidDocAtTime", func() {
+	var setup TestSetup
+	var alice CreatedDidDocInfo
+	var startTime time.Time
+	var versions []string
+
+	update := func() string {
+		msg := &types.MsgUpdateDidDocPayload{
+			Id:                 alice.Did,
+			VerificationMethod: alice.Msg.VerificationMethod,
+			Authentication:     alice.Msg.Authentication,
+			VersionId:          uuid.NewString(),
+		}
+
+		_, err := setup.UpdateDidDoc(msg, []SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+
+		return msg.VersionId
+	}
+
+	BeforeEach(func() {
+		setup = Setup()
+		startTime = setup.SdkCtx.BlockTime()
+
+		alice = setup.CreateSimpleDid()
+		versions = []string{alice.VersionID}
+
+		setup.SetBlockTime(startTime.Add(time.Hour))
+		versions = append(versions, update())
+
+		// Two updates in the same block
+		setup.SetBlockTime(startTime.Add(2 * time.Hour))
+		versions = append(versions, update(), update())
+	})
+
+	It("Returns the version active at the given time", func() {
+		resp, err := setup.QueryDidDocAtTime(alice.Did, startTime.Add(90*time.Minute))
+		Expect(err).To(BeNil())
+		Expect(resp.Value.Metadata.VersionId).To(Equal(versions[1]))
+	})
+
+	It("Includes the version created exactly at the given time", func() {
+		resp, err := setup.QueryDidDocAtTime(alice.Did, startTime)
+		Expect(err).To(BeNil())
+		Expect(resp.Value.Metadata.VersionId).To(Equal(versions[0]))
+	})
+
+	It("Returns the last version if several versions became active at the same time", func() {
+		resp, err := setup.QueryDidDocAtTime(alice.Did, startTime.Add(2*time.Hour))
+		Expect(err).To(BeNil())
+		Expect(resp.Value.Metadata.VersionId).To(Equal(versions[3]))
+
+		resp, err = setup.QueryDidDocAtTime(alice.Did, startTime.Add(24*time.Hour))
+		Expect(err).To(BeNil())
+		Expect(resp.Value.Metadata.VersionId).To(Equal(versions[3]))
+	})
+
+	It("Returns error (not found) for a time before the DID was created", func() {
+		_, err := setup.QueryDidDocAtTime(alice.Did, startTime.Add(-time.Second))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("not found"))
+	})
+
+	It("Returns error (not found) for another DID", func() {
+		_, err := setup.QueryDidDocAtTime(GenerateDID(Base58_16bytes), startTime.Add(time.Hour))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("not found"))
+	})
+
+	It("Rebuilds the index during the store migration", func() {
+		err := setup.Keeper.DidDocVersionTimes.Clear(setup.StdCtx, nil)
+		Expect(err).To(BeNil())
+
+		err = v7.MigrateStore(setup.SdkCtx, setup.Keeper.DidDocuments, setup.Keeper.SetDidDocVersionTime)
+		Expect(err).To(BeNil())
+
+		resp, err := setup.QueryDidDocAtTime(alice.Did, startTime.Add(2*time.Hour))
+		Expect(err).To(BeNil())
+		Expect(resp.Value.Metadata.VersionId).To(Equal(versions[3]))
+
+		resp, err = setup.QueryDidDocAtTime(alice.Did, startTime.Add(time.Hour))
+		Expect(err).To(BeNil())
+		Expect(resp.Value.Metadata.VersionId).To(Equal(versions[1]))
+	})
+})
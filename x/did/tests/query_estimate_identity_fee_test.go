@@ -0,0 +1,64 @@
+package tests
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	. "github.com/cheqd/cheqd-node/x/did/tests/setup"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cheqd/cheqd-node/x/did/types"
+)
+
+type staticFeeEstimator struct {
+	res *types.QueryEstimateIdentityFeeResponse
+}
+
+func (e staticFeeEstimator) EstimateIdentityFee(_ context.Context, _ *types.QueryEstimateIdentityFeeRequest) (*types.QueryEstimateIdentityFeeResponse, error) {
+	return e.res, nil
+}
+
+var _ = Describe("EstimateIdentityFee query", func() {
+	var setup TestSetup
+
+	BeforeEach(func() {
+		setup = Setup()
+	})
+
+	It("rejects requests without a message type or tx", func() {
+		_, err := setup.QueryServer.EstimateIdentityFee(setup.StdCtx, &types.QueryEstimateIdentityFeeRequest{FeeDenom: types.BaseMinimalDenom})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+
+	It("rejects requests without a fee denom", func() {
+		_, err := setup.QueryServer.EstimateIdentityFee(setup.StdCtx, &types.QueryEstimateIdentityFeeRequest{MsgTypeUrl: sdk.MsgTypeURL(&types.MsgCreateDidDoc{})})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+
+	It("is unimplemented without a fee estimator", func() {
+		_, err := setup.QueryServer.EstimateIdentityFee(setup.StdCtx, &types.QueryEstimateIdentityFeeRequest{
+			MsgTypeUrl: sdk.MsgTypeURL(&types.MsgCreateDidDoc{}),
+			FeeDenom:   types.BaseMinimalDenom,
+		})
+		Expect(status.Code(err)).To(Equal(codes.Unimplemented))
+	})
+
+	It("delegates to the fee estimator", func() {
+		expected := &types.QueryEstimateIdentityFeeResponse{
+			MinFee: sdk.NewCoin(types.BaseMinimalDenom, sdkmath.NewInt(50_000_000_000)),
+			Price:  sdkmath.LegacyMustNewDecFromStr("0.016"),
+		}
+		setup.Keeper.SetFeeEstimator(staticFeeEstimator{res: expected})
+
+		res, err := setup.Keeper.EstimateIdentityFee(setup.StdCtx, &types.QueryEstimateIdentityFeeRequest{
+			MsgTypeUrl: sdk.MsgTypeURL(&types.MsgCreateDidDoc{}),
+			FeeDenom:   types.BaseMinimalDenom,
+		})
+		Expect(err).To(BeNil())
+		Expect(res).To(Equal(expected))
+	})
+})
@@ -0,0 +1,138 @@
+package tests
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/cheqd/cheqd-node/x/did/tests/setup"
+	"github.com/google/uuid"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/cheqd/cheqd-node/x/did/types"
+)
+
+var _ = Describe("Query ResolveDid", func() {
+	var setup TestSetup
+	var alice CreatedDidDocInfo
+	var jwkKeyID string
+	var startTime time.Time
+
+	BeforeEach(func() {
+		setup = Setup()
+		startTime = setup.SdkCtx.BlockTime()
+
+		didDoc := setup.BuildSimpleDidDoc()
+		jwkKeyID = didDoc.Did + "#key-2"
+		didDoc.Msg.VerificationMethod = append(didDoc.Msg.VerificationMethod, &types.VerificationMethod{
+			Id:                     jwkKeyID,
+			VerificationMethodType: types.JSONWebKey2020Type,
+			Controller:             didDoc.Did,
+			VerificationMaterial:   GenerateJSONWebKey2020VerificationMaterial(GenerateKeyPair().Public),
+		})
+		didDoc.Msg.AssertionMethod = []string{jwkKeyID}
+		didDoc.Msg.Service = []*types.Service{
+			{
+				Id:              didDoc.Did + "#service-1",
+				ServiceType:     "LinkedDomains",
+				ServiceEndpoint: []string{"https://example.com"},
+			},
+		}
+
+		alice = setup.CreateCustomDidDoc(didDoc)
+	})
+
+	It("Resolves the latest version in the JSON-LD representation by default", func() {
+		res, result, err := setup.ResolveDid(&types.QueryResolveDidRequest{Id: alice.Did})
+		Expect(err).To(BeNil())
+
+		Expect(res.ContentType).To(Equal(types.DidLdJSONContentType))
+		Expect(result.Context).To(Equal(types.DidResolutionContext))
+		Expect(result.DidDocument.Context).To(ContainElements(
+			types.DidCoreContext,
+			types.VerificationMethodContexts[types.Ed25519VerificationKey2020Type],
+			types.VerificationMethodContexts[types.JSONWebKey2020Type],
+		))
+		Expect(result.DidDocument.ID).To(Equal(alice.Did))
+		Expect(result.DidDocument.Authentication).To(Equal([]string{alice.KeyID}))
+		Expect(result.DidDocument.AssertionMethod).To(Equal([]string{jwkKeyID}))
+
+		Expect(result.DidDocument.VerificationMethod).To(HaveLen(2))
+		Expect(result.DidDocument.VerificationMethod[0].PublicKeyMultibase).To(Equal(alice.Msg.VerificationMethod[0].VerificationMaterial))
+		Expect(result.DidDocument.VerificationMethod[1].PublicKeyMultibase).To(BeEmpty())
+
+		var jwk map[string]interface{}
+		Expect(json.Unmarshal(result.DidDocument.VerificationMethod[1].PublicKeyJwk, &jwk)).To(Succeed())
+		Expect(jwk).To(HaveKeyWithValue("kty", "OKP"))
+
+		Expect(result.DidDocument.Service).To(HaveLen(1))
+		Expect(result.DidDocument.Service[0].Type).To(Equal("LinkedDomains"))
+		Expect(result.DidDocument.Service[0].ServiceEndpoint).To(Equal([]string{"https://example.com"}))
+
+		Expect(result.DidDocumentMetadata.VersionID).To(Equal(alice.VersionID))
+		Expect(result.DidDocumentMetadata.Created).To(Equal(startTime))
+		Expect(result.DidResolutionMetadata.ContentType).To(Equal(types.DidLdJSONContentType))
+		Expect(result.DidResolutionMetadata.Did.Method).To(Equal(types.DidMethod))
+		Expect(result.DidResolutionMetadata.Did.MethodSpecificID).To(Equal(DidNamespace + ":" + alice.CollectionID))
+	})
+
+	It("Omits JSON-LD contexts in the JSON representation", func() {
+		res, err := setup.QueryServer.ResolveDid(setup.StdCtx, &types.QueryResolveDidRequest{
+			Id:     alice.Did,
+			Accept: types.DidJSONContentType,
+		})
+		Expect(err).To(BeNil())
+		Expect(res.ContentType).To(Equal(types.DidJSONContentType))
+		Expect(res.DidResolutionResult).NotTo(ContainSubstring("@context"))
+	})
+
+	It("Resolves a specific version by id and by time", func() {
+		setup.SetBlockTime(startTime.Add(time.Hour))
+
+		msg := &types.MsgUpdateDidDocPayload{
+			Id:                 alice.Did,
+			VerificationMethod: alice.Msg.VerificationMethod[:1],
+			Authentication:     alice.Msg.Authentication,
+			VersionId:          uuid.NewString(),
+		}
+		_, err := setup.UpdateDidDoc(msg, []SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+
+		_, result, err := setup.ResolveDid(&types.QueryResolveDidRequest{Id: alice.Did})
+		Expect(err).To(BeNil())
+		Expect(result.DidDocumentMetadata.VersionID).To(Equal(msg.VersionId))
+		Expect(result.DidDocumentMetadata.PreviousVersionID).To(Equal(alice.VersionID))
+		Expect(result.DidDocument.VerificationMethod).To(HaveLen(1))
+
+		_, result, err = setup.ResolveDid(&types.QueryResolveDidRequest{Id: alice.Did, VersionId: alice.VersionID})
+		Expect(err).To(BeNil())
+		Expect(result.DidDocumentMetadata.VersionID).To(Equal(alice.VersionID))
+		Expect(result.DidDocumentMetadata.NextVersionID).To(Equal(msg.VersionId))
+		Expect(result.DidDocument.VerificationMethod).To(HaveLen(2))
+
+		at := startTime.Add(30 * time.Minute)
+		_, result, err = setup.ResolveDid(&types.QueryResolveDidRequest{Id: alice.Did, VersionTime: &at})
+		Expect(err).To(BeNil())
+		Expect(result.DidDocumentMetadata.VersionID).To(Equal(alice.VersionID))
+	})
+
+	It("Returns error (invalid argument) for an unsupported representation", func() {
+		_, _, err := setup.ResolveDid(&types.QueryResolveDidRequest{Id: alice.Did, Accept: "application/json"})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("representation not supported"))
+	})
+
+	It("Returns error (invalid argument) if version id and version time are combined", func() {
+		at := startTime
+		_, _, err := setup.ResolveDid(&types.QueryResolveDidRequest{Id: alice.Did, VersionId: alice.VersionID, VersionTime: &at})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("cannot be combined"))
+	})
+
+	It("Returns error (not found) for an unknown DID", func() {
+		_, _, err := setup.ResolveDid(&types.QueryResolveDidRequest{Id: GenerateDID(Base58_16bytes)})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("not found"))
+	})
+})
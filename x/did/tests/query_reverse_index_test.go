@@ -0,0 +1,141 @@
+package tests
+
+import (
+	"github.com/google/uuid"
+
+	. "github.com/cheqd/cheqd-node/x/did/tests/setup"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v11 "github.com/cheqd/cheqd-node/x/did/migrations/v11"
+	"github.com/cheqd/cheqd-node/x/did/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var _ = Describe("Reverse index queries", func() {
+	var setup TestSetup
+	var alice CreatedDidDocInfo
+	var bob CreatedDidDocInfo
+
+	BeforeEach(func() {
+		setup = Setup()
+		alice = setup.CreateSimpleDid()
+
+		didDoc := setup.BuildSimpleDidDoc()
+		didDoc.Msg.Controller = []string{didDoc.Did, alice.Did}
+		didDoc.Msg.AlsoKnownAs = []string{"https://example.com"}
+		created, err := setup.CreateDid(didDoc.Msg, []SignInput{didDoc.SignInput, alice.SignInput})
+		Expect(err).To(BeNil())
+		bob = CreatedDidDocInfo{DidDocInfo: didDoc, VersionID: created.Value.Metadata.VersionId}
+	})
+
+	queryByKey := func(info CreatedDidDocInfo) []string {
+		res, err := setup.QueryServer.DidsByKey(setup.StdCtx, &types.QueryDidsByKeyRequest{
+			VerificationMethodType: types.Ed25519VerificationKey2018Type,
+			VerificationMaterial:   GenerateEd25519VerificationKey2018VerificationMaterial(info.KeyPair.Public),
+		})
+		Expect(err).To(BeNil())
+
+		return res.VerificationMethodIds
+	}
+
+	It("Lists the DIDs controlled by a DID", func() {
+		res, err := setup.QueryServer.DidsByController(setup.StdCtx, &types.QueryDidsByControllerRequest{Controller: alice.Did})
+		Expect(err).To(BeNil())
+		Expect(res.Dids).To(Equal([]string{bob.Did}))
+
+		res, err = setup.QueryServer.DidsByController(setup.StdCtx, &types.QueryDidsByControllerRequest{Controller: bob.Did})
+		Expect(err).To(BeNil())
+		Expect(res.Dids).To(Equal([]string{bob.Did}))
+	})
+
+	It("Lists the verification methods using a key in any of its representations", func() {
+		Expect(queryByKey(alice)).To(Equal([]string{alice.KeyID}))
+		Expect(queryByKey(bob)).To(Equal([]string{bob.KeyID}))
+	})
+
+	It("Lists the DIDs known as an alias", func() {
+		res, err := setup.QueryServer.DidsByAlias(setup.StdCtx, &types.QueryDidsByAliasRequest{Alias: "https://example.com"})
+		Expect(err).To(BeNil())
+		Expect(res.Dids).To(Equal([]string{bob.Did}))
+	})
+
+	It("Paginates the results", func() {
+		carol := setup.CreateDidDocWithExternalControllers([]string{alice.Did}, []SignInput{alice.SignInput})
+
+		res, err := setup.QueryServer.DidsByController(setup.StdCtx, &types.QueryDidsByControllerRequest{
+			Controller: alice.Did,
+			Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+		})
+		Expect(err).To(BeNil())
+		Expect(res.Dids).To(HaveLen(1))
+		Expect(res.Pagination.Total).To(Equal(uint64(2)))
+
+		next, err := setup.QueryServer.DidsByController(setup.StdCtx, &types.QueryDidsByControllerRequest{
+			Controller: alice.Did,
+			Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1},
+		})
+		Expect(err).To(BeNil())
+		Expect(append(res.Dids, next.Dids...)).To(ConsistOf(bob.Did, carol.Did))
+	})
+
+	It("Indexes only the latest version", func() {
+		newKeyPair := GenerateKeyPair()
+		newKeyID := bob.Did + "#key-2"
+
+		_, err := setup.UpdateDidDoc(&types.MsgUpdateDidDocPayload{
+			Id:         bob.Did,
+			Controller: []string{bob.Did},
+			VerificationMethod: []*types.VerificationMethod{
+				{
+					Id:                     newKeyID,
+					VerificationMethodType: types.Ed25519VerificationKey2020Type,
+					Controller:             bob.Did,
+					VerificationMaterial:   GenerateEd25519VerificationKey2020VerificationMaterial(newKeyPair.Public),
+				},
+			},
+			Authentication: []string{newKeyID},
+			VersionId:      uuid.NewString(),
+		}, []SignInput{bob.SignInput, alice.SignInput, {VerificationMethodID: newKeyID, Key: newKeyPair.Private}})
+		Expect(err).To(BeNil())
+
+		res, err := setup.QueryServer.DidsByController(setup.StdCtx, &types.QueryDidsByControllerRequest{Controller: alice.Did})
+		Expect(err).To(BeNil())
+		Expect(res.Dids).To(BeEmpty())
+
+		aliasRes, err := setup.QueryServer.DidsByAlias(setup.StdCtx, &types.QueryDidsByAliasRequest{Alias: "https://example.com"})
+		Expect(err).To(BeNil())
+		Expect(aliasRes.Dids).To(BeEmpty())
+
+		Expect(queryByKey(bob)).To(BeEmpty())
+		Expect(queryByKey(CreatedDidDocInfo{DidDocInfo: DidDocInfo{KeyPair: newKeyPair}})).To(Equal([]string{newKeyID}))
+	})
+
+	It("Returns error (invalid argument) for invalid key material", func() {
+		_, err := setup.QueryServer.DidsByKey(setup.StdCtx, &types.QueryDidsByKeyRequest{
+			VerificationMethodType: types.Ed25519VerificationKey2020Type,
+			VerificationMaterial:   "invalid",
+		})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Rebuilds the indexes in the store migration", func() {
+		Expect(setup.Keeper.ControllerIndex.Clear(setup.StdCtx, nil)).To(Succeed())
+		Expect(setup.Keeper.KeyIndex.Clear(setup.StdCtx, nil)).To(Succeed())
+		Expect(setup.Keeper.AliasIndex.Clear(setup.StdCtx, nil)).To(Succeed())
+		Expect(queryByKey(alice)).To(BeEmpty())
+
+		err := v11.MigrateStore(setup.SdkCtx, setup.Keeper.LatestDidVersion, setup.Keeper.DidDocuments, setup.Keeper.IndexDidDoc)
+		Expect(err).To(BeNil())
+
+		res, err := setup.QueryServer.DidsByController(setup.StdCtx, &types.QueryDidsByControllerRequest{Controller: alice.Did})
+		Expect(err).To(BeNil())
+		Expect(res.Dids).To(Equal([]string{bob.Did}))
+		Expect(queryByKey(alice)).To(Equal([]string{alice.KeyID}))
+
+		aliasRes, err := setup.QueryServer.DidsByAlias(setup.StdCtx, &types.QueryDidsByAliasRequest{Alias: "https://example.com"})
+		Expect(err).To(BeNil())
+		Expect(aliasRes.Dids).To(Equal([]string{bob.Did}))
+	})
+})
@@ -0,0 +1,230 @@
+package tests
+
+import (
+	"github.com/google/uuid"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	testsetup "github.com/cheqd/cheqd-node/x/did/tests/setup"
+	"github.com/cheqd/cheqd-node/x/did/types"
+)
+
+var _ = Describe("DIDDoc recovery", func() {
+	var setup testsetup.TestSetup
+	var alice testsetup.CreatedDidDocInfo
+	var recoveryKey testsetup.RecoveryKeyInfo
+	var nextRecoveryKey testsetup.RecoveryKeyInfo
+	var payload *types.MsgRecoverDidDocPayload
+	var newKey testsetup.SignInput
+
+	BeforeEach(func() {
+		setup = testsetup.Setup()
+		alice, recoveryKey = setup.CreateRecoverableDid()
+		nextRecoveryKey = setup.BuildRecoveryKey(alice.Did, "recovery-2")
+		payload, newKey = setup.BuildRecoveryPayload(alice.Did, recoveryKey, nextRecoveryKey.Commitment)
+	})
+
+	It("Valid: Stores the recovery commitment on creation", func() {
+		created, err := setup.QueryDidDoc(alice.Did)
+		Expect(err).To(BeNil())
+		Expect(created.Value.Metadata.RecoveryCommitment).To(Equal(recoveryKey.Commitment))
+	})
+
+	It("Valid: Replaces the verification methods without the lost controller key", func() {
+		res, err := setup.RecoverDidDoc(payload, []testsetup.SignInput{recoveryKey.SignInput, newKey})
+		Expect(err).To(BeNil())
+		Expect(res.Value.Metadata.RecoveryCommitment).To(Equal(nextRecoveryKey.Commitment))
+
+		recovered, err := setup.QueryDidDoc(alice.Did)
+		Expect(err).To(BeNil())
+		Expect(recovered.Value.DidDoc.VerificationMethod).To(Equal(payload.VerificationMethod))
+		Expect(recovered.Value.DidDoc.Authentication).To(Equal(payload.Authentication))
+		Expect(recovered.Value.Metadata.VersionId).To(Equal(payload.VersionId))
+		Expect(recovered.Value.Metadata.PreviousVersionId).To(Equal(alice.VersionID))
+
+		// The old key can't control the DID anymore, the new one can
+		buildUpdate := func() *types.MsgUpdateDidDocPayload {
+			vm := *payload.VerificationMethod[0]
+			return &types.MsgUpdateDidDocPayload{
+				Id:                 alice.Did,
+				VerificationMethod: []*types.VerificationMethod{&vm},
+				Authentication:     payload.Authentication,
+				AssertionMethod:    payload.Authentication,
+				VersionId:          uuid.NewString(),
+			}
+		}
+		_, err = setup.UpdateDidDoc(buildUpdate(), []testsetup.SignInput{alice.SignInput})
+		Expect(err).To(HaveOccurred())
+
+		_, err = setup.UpdateDidDoc(buildUpdate(), []testsetup.SignInput{newKey})
+		Expect(err).To(BeNil())
+	})
+
+	It("Valid: Emits EventDidDocRecovered", func() {
+		_, err := setup.RecoverDidDoc(payload, []testsetup.SignInput{recoveryKey.SignInput, newKey})
+		Expect(err).To(BeNil())
+
+		event, err := setup.LastTypedEvent(&types.EventDidDocRecovered{})
+		Expect(err).To(BeNil())
+		recovered, ok := event.(*types.EventDidDocRecovered)
+		Expect(ok).To(BeTrue())
+		Expect(recovered.Did).To(Equal(alice.Did))
+		Expect(recovered.VersionId).To(Equal(payload.VersionId))
+		Expect(recovered.PreviousVersionId).To(Equal(alice.VersionID))
+		Expect(recovered.Controllers).To(BeEmpty())
+	})
+
+	It("Valid: Keeps the controllers and the controller threshold", func() {
+		bob := setup.CreateSimpleDid()
+		org := setup.BuildSimpleDidDoc()
+		org.Msg.Controller = []string{org.Did, bob.Did}
+		org.Msg.ControllerThreshold = 2
+		orgRecoveryKey := setup.BuildRecoveryKey(org.Did, "recovery-1")
+		org.Msg.RecoveryCommitment = orgRecoveryKey.Commitment
+		_, err := setup.CreateDid(org.Msg, []testsetup.SignInput{org.SignInput, bob.SignInput})
+		Expect(err).To(BeNil())
+
+		// Bob doesn't have to sign the recovery
+		payload, newKey = setup.BuildRecoveryPayload(org.Did, orgRecoveryKey, "")
+		res, err := setup.RecoverDidDoc(payload, []testsetup.SignInput{orgRecoveryKey.SignInput, newKey})
+		Expect(err).To(BeNil())
+		Expect(res.Value.DidDoc.Controller).To(Equal(org.Msg.Controller))
+		Expect(res.Value.DidDoc.ControllerThreshold).To(Equal(uint32(2)))
+
+		// The recovered keys alone are still below the threshold
+		buildUpdate := func() *types.MsgUpdateDidDocPayload {
+			vm := *payload.VerificationMethod[0]
+			return &types.MsgUpdateDidDocPayload{
+				Id:                 org.Did,
+				Controller:         []string{payload.VerificationMethod[0].Controller},
+				VerificationMethod: []*types.VerificationMethod{&vm},
+				Authentication:     payload.Authentication,
+				VersionId:          uuid.NewString(),
+			}
+		}
+		_, err = setup.UpdateDidDoc(buildUpdate(), []testsetup.SignInput{newKey})
+		Expect(err).To(HaveOccurred())
+
+		_, err = setup.UpdateDidDoc(buildUpdate(), []testsetup.SignInput{newKey, bob.SignInput})
+		Expect(err).To(BeNil())
+	})
+
+	It("Valid: Keeps the recovery key as a verification method", func() {
+		payload.VerificationMethod = []*types.VerificationMethod{recoveryKey.VerificationMethod}
+		payload.Authentication = []string{recoveryKey.VerificationMethod.Id}
+
+		_, err := setup.RecoverDidDoc(payload, []testsetup.SignInput{recoveryKey.SignInput})
+		Expect(err).To(BeNil())
+	})
+
+	It("Valid: Keeps the commitment on updates without a new one", func() {
+		update := &types.MsgUpdateDidDocPayload{
+			Id:                 alice.Did,
+			VerificationMethod: alice.Msg.VerificationMethod,
+			Authentication:     alice.Msg.Authentication,
+			AssertionMethod:    []string{alice.KeyID},
+			VersionId:          uuid.NewString(),
+		}
+		res, err := setup.UpdateDidDoc(update, []testsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+		Expect(res.Value.Metadata.RecoveryCommitment).To(Equal(recoveryKey.Commitment))
+	})
+
+	It("Valid: Clears the commitment on updates", func() {
+		update := &types.MsgUpdateDidDocPayload{
+			Id:                      alice.Did,
+			VerificationMethod:      alice.Msg.VerificationMethod,
+			Authentication:          alice.Msg.Authentication,
+			VersionId:               uuid.NewString(),
+			ClearRecoveryCommitment: true,
+		}
+		res, err := setup.UpdateDidDoc(update, []testsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+		Expect(res.Value.Metadata.RecoveryCommitment).To(BeEmpty())
+
+		_, err = setup.RecoverDidDoc(payload, []testsetup.SignInput{recoveryKey.SignInput, newKey})
+		Expect(err).To(MatchError(ContainSubstring(types.ErrRecoveryNotEnabled.Error())))
+	})
+
+	It("Not Valid: Clearing the commitment on updates with a new one", func() {
+		update := &types.MsgUpdateDidDocPayload{
+			Id:                      alice.Did,
+			VerificationMethod:      alice.Msg.VerificationMethod,
+			Authentication:          alice.Msg.Authentication,
+			VersionId:               uuid.NewString(),
+			RecoveryCommitment:      nextRecoveryKey.Commitment,
+			ClearRecoveryCommitment: true,
+		}
+		_, err := setup.UpdateDidDoc(update, []testsetup.SignInput{alice.SignInput})
+		Expect(err).To(MatchError(ContainSubstring("clear_recovery_commitment")))
+	})
+
+	It("Not Valid: The used recovery key can't be reused after rotation", func() {
+		_, err := setup.RecoverDidDoc(payload, []testsetup.SignInput{recoveryKey.SignInput, newKey})
+		Expect(err).To(BeNil())
+
+		payload, newKey = setup.BuildRecoveryPayload(alice.Did, recoveryKey, "")
+		_, err = setup.RecoverDidDoc(payload, []testsetup.SignInput{recoveryKey.SignInput, newKey})
+		Expect(err).To(MatchError(ContainSubstring(types.ErrRecoveryKeyMismatch.Error())))
+
+		payload, newKey = setup.BuildRecoveryPayload(alice.Did, nextRecoveryKey, "")
+		_, err = setup.RecoverDidDoc(payload, []testsetup.SignInput{nextRecoveryKey.SignInput, newKey})
+		Expect(err).To(BeNil())
+	})
+
+	It("Not Valid: Recovery key doesn't match the commitment", func() {
+		otherKey := setup.BuildRecoveryKey(alice.Did, "recovery-1")
+		payload.RecoveryKey = otherKey.VerificationMethod
+
+		_, err := setup.RecoverDidDoc(payload, []testsetup.SignInput{otherKey.SignInput, newKey})
+		Expect(err).To(MatchError(ContainSubstring(types.ErrRecoveryKeyMismatch.Error())))
+	})
+
+	It("Not Valid: DIDDoc without recovery commitment", func() {
+		bob := setup.CreateSimpleDid()
+		bobRecoveryKey := setup.BuildRecoveryKey(bob.Did, "recovery-1")
+		payload, newKey = setup.BuildRecoveryPayload(bob.Did, bobRecoveryKey, "")
+
+		_, err := setup.RecoverDidDoc(payload, []testsetup.SignInput{bobRecoveryKey.SignInput, newKey})
+		Expect(err).To(MatchError(ContainSubstring(types.ErrRecoveryNotEnabled.Error())))
+	})
+
+	It("Not Valid: Missing recovery key signature", func() {
+		_, err := setup.RecoverDidDoc(payload, []testsetup.SignInput{newKey})
+		Expect(err).To(MatchError(ContainSubstring(types.ErrSignatureNotFound.Error())))
+	})
+
+	It("Not Valid: Recovery key signature made by another key", func() {
+		forged := testsetup.SignInput{
+			VerificationMethodID: recoveryKey.SignInput.VerificationMethodID,
+			Key:                  testsetup.GenerateKeyPair().Private,
+		}
+
+		_, err := setup.RecoverDidDoc(payload, []testsetup.SignInput{forged, newKey})
+		Expect(err).To(MatchError(ContainSubstring(types.ErrInvalidSignature.Error())))
+	})
+
+	It("Not Valid: Missing signature of the new verification method", func() {
+		_, err := setup.RecoverDidDoc(payload, []testsetup.SignInput{recoveryKey.SignInput})
+		Expect(err).To(MatchError(ContainSubstring(types.ErrSignatureNotFound.Error())))
+	})
+
+	It("Not Valid: Deactivated DIDDoc", func() {
+		_, err := setup.DeactivateDidDoc(&types.MsgDeactivateDidDocPayload{
+			Id:        alice.Did,
+			VersionId: uuid.NewString(),
+		}, []testsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+
+		_, err = setup.RecoverDidDoc(payload, []testsetup.SignInput{recoveryKey.SignInput, newKey})
+		Expect(err).To(MatchError(ContainSubstring(types.ErrDIDDocDeactivated.Error())))
+	})
+
+	It("Not Valid: Invalid next recovery commitment", func() {
+		payload.NextRecoveryCommitment = "not-a-commitment"
+
+		_, err := setup.RecoverDidDoc(payload, []testsetup.SignInput{recoveryKey.SignInput, newKey})
+		Expect(err).To(MatchError(ContainSubstring(types.ErrBasicValidation.Error())))
+	})
+})
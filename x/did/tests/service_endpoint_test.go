@@ -0,0 +1,147 @@
+package tests
+
+import (
+	"encoding/json"
+
+	. "github.com/cheqd/cheqd-node/x/did/tests/setup"
+	"github.com/google/uuid"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v10 "github.com/cheqd/cheqd-node/x/did/migrations/v10"
+	"github.com/cheqd/cheqd-node/x/did/types"
+)
+
+var _ = Describe("Service endpoints", func() {
+	var setup TestSetup
+
+	BeforeEach(func() {
+		setup = Setup()
+	})
+
+	buildDidDocWithService := func(service *types.Service) DidDocInfo {
+		didDoc := setup.BuildSimpleDidDoc()
+		service.Id = didDoc.Did + "#service-1"
+		didDoc.Msg.Service = []*types.Service{service}
+
+		return didDoc
+	}
+
+	It("Creates and resolves a DIDComm v2 map-style service endpoint", func() {
+		didDoc := buildDidDocWithService(&types.Service{
+			ServiceType: "DIDCommMessaging",
+			ServiceEndpointMaps: []*types.ServiceEndpointMap{
+				{
+					Uri:         "https://example.com/path",
+					Accept:      []string{"didcomm/v2"},
+					RoutingKeys: []string{"did:example:somemediator#somekey"},
+				},
+			},
+		})
+		alice := setup.CreateCustomDidDoc(didDoc)
+
+		res, err := setup.QueryServer.ResolveDid(setup.StdCtx, &types.QueryResolveDidRequest{
+			Id:     alice.Did,
+			Accept: types.DidJSONContentType,
+		})
+		Expect(err).To(BeNil())
+
+		var result struct {
+			DidDocument struct {
+				Service []struct {
+					ServiceEndpoint []map[string]any `json:"serviceEndpoint"`
+				} `json:"service"`
+			} `json:"didDocument"`
+		}
+		Expect(json.Unmarshal([]byte(res.DidResolutionResult), &result)).To(Succeed())
+		Expect(result.DidDocument.Service).To(HaveLen(1))
+		Expect(result.DidDocument.Service[0].ServiceEndpoint).To(Equal([]map[string]any{
+			{
+				"uri":         "https://example.com/path",
+				"accept":      []any{"didcomm/v2"},
+				"routingKeys": []any{"did:example:somemediator#somekey"},
+			},
+		}))
+	})
+
+	It("Doesn't allow service endpoint schemes outside of the allow-list", func() {
+		didDoc := buildDidDocWithService(&types.Service{
+			ServiceType:     "LinkedDomains",
+			ServiceEndpoint: []string{"ftp://example.com"},
+		})
+
+		_, err := setup.CreateDid(didDoc.Msg, []SignInput{didDoc.SignInput})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(types.ErrBasicValidation.Error()))
+		Expect(err.Error()).To(ContainSubstring("scheme must be one of"))
+	})
+
+	It("Doesn't allow relative service endpoints", func() {
+		didDoc := buildDidDocWithService(&types.Service{
+			ServiceType:     "LinkedDomains",
+			ServiceEndpoint: []string{"endpoint-1"},
+		})
+
+		_, err := setup.CreateDid(didDoc.Msg, []SignInput{didDoc.SignInput})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(types.ErrBasicValidation.Error()))
+		Expect(err.Error()).To(ContainSubstring("must be absolute"))
+	})
+
+	It("Doesn't allow origins with schemes outside of the allow-list on update", func() {
+		alice := setup.CreateSimpleDid()
+
+		payload := &types.MsgUpdateDidDocPayload{
+			Id:                 alice.Did,
+			Controller:         alice.Msg.Controller,
+			VerificationMethod: alice.Msg.VerificationMethod,
+			Authentication:     alice.Msg.Authentication,
+			Service: []*types.Service{
+				{
+					Id:                  alice.Did + "#service-1",
+					ServiceType:         "LinkedDomains",
+					ServiceEndpointMaps: []*types.ServiceEndpointMap{{Origins: []string{"ftp://example.com"}}},
+				},
+			},
+			VersionId: uuid.NewString(),
+		}
+
+		_, err := setup.UpdateDidDoc(payload, []SignInput{alice.SignInput})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(types.ErrBasicValidation.Error()))
+	})
+
+	It("Allows any scheme when the allow-list is empty", func() {
+		params := types.DefaultFeeParams()
+		params.AllowedServiceEndpointSchemes = []string{}
+		Expect(setup.Keeper.SetParams(setup.StdCtx, *params)).To(Succeed())
+
+		didDoc := buildDidDocWithService(&types.Service{
+			ServiceType:     "LinkedDomains",
+			ServiceEndpoint: []string{"ftp://example.com"},
+		})
+
+		_, err := setup.CreateDid(didDoc.Msg, []SignInput{didDoc.SignInput})
+		Expect(err).To(BeNil())
+	})
+
+	It("Sets the default allow-list on upgrade", func() {
+		params := types.DefaultFeeParams()
+		params.AllowedServiceEndpointSchemes = nil
+		Expect(setup.Keeper.SetParams(setup.StdCtx, *params)).To(Succeed())
+
+		Expect(v10.MigrateStore(setup.SdkCtx, setup.Keeper.Paramstore)).To(Succeed())
+
+		migrated, err := setup.Keeper.GetParams(setup.StdCtx)
+		Expect(err).To(BeNil())
+		Expect(migrated.AllowedServiceEndpointSchemes).To(Equal(types.DefaultAllowedServiceEndpointSchemes))
+
+		didDoc := buildDidDocWithService(&types.Service{
+			ServiceType:     "LinkedDomains",
+			ServiceEndpoint: []string{"ftp://example.com"},
+		})
+		_, err = setup.CreateDid(didDoc.Msg, []SignInput{didDoc.SignInput})
+		Expect(err).To(HaveOccurred())
+	})
+})
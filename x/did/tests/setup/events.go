@@ -0,0 +1,23 @@
+package setup
+
+import (
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// LastTypedEvent returns the most recent typed event of the same type as the given message
+func (s *TestSetup) LastTypedEvent(msg proto.Message) (proto.Message, error) {
+	eventType := proto.MessageName(msg)
+	events := s.SdkCtx.EventManager().Events()
+
+	for i := len(events) - 1; i >= 0; i-- {
+		if events[i].Type == eventType {
+			return sdk.ParseTypedEvent(abci.Event(events[i]))
+		}
+	}
+
+	return nil, fmt.Errorf("event %s not found", eventType)
+}
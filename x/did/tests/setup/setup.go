@@ -170,9 +170,19 @@ func Setup() TestSetup {
 	if err != nil {
 		panic(err)
 	}
+	err = setup.Keeper.SetParams(goCtx, *types.DefaultFeeParams())
+	if err != nil {
+		panic(err)
+	}
 	return setup
 }
 
+// SetBlockTime moves both contexts to the given block time
+func (s *TestSetup) SetBlockTime(blockTime time.Time) {
+	s.SdkCtx = s.SdkCtx.WithBlockTime(blockTime)
+	s.StdCtx = s.SdkCtx
+}
+
 func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino, key storetypes.StoreKey, tkey storetypes.StoreKey) paramskeeper.Keeper {
 	// create keeper
 	paramsKeeper := paramskeeper.NewKeeper(appCodec, legacyAmino, key, tkey)
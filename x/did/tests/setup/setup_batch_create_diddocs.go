@@ -0,0 +1,26 @@
+package setup
+
+import (
+	"github.com/cheqd/cheqd-node/x/did/types"
+)
+
+func (s *TestSetup) BatchCreateDids(payload *types.MsgBatchCreateDidDocsPayload, signInputs []SignInput) (*types.MsgBatchCreateDidDocsResponse, error) {
+	signBytes := payload.GetSignBytes()
+	signatures := make([]*types.SignInfo, 0, len(signInputs))
+
+	for _, input := range signInputs {
+		signature := input.Sign(signBytes)
+
+		signatures = append(signatures, &types.SignInfo{
+			VerificationMethodId: input.VerificationMethodID,
+			Signature:            signature,
+		})
+	}
+
+	msg := &types.MsgBatchCreateDidDocs{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+
+	return s.MsgServer.BatchCreateDidDocs(s.StdCtx, msg)
+}
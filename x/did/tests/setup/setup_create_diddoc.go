@@ -1,8 +1,6 @@
 package setup
 
 import (
-	"crypto/ed25519"
-
 	"github.com/cheqd/cheqd-node/x/did/types"
 	"github.com/cheqd/cheqd-node/x/did/utils"
 	"github.com/google/uuid"
@@ -13,7 +11,7 @@ func (s *TestSetup) CreateDid(payload *types.MsgCreateDidDocPayload, signInputs
 	signatures := make([]*types.SignInfo, 0, len(signInputs))
 
 	for _, input := range signInputs {
-		signature := ed25519.Sign(input.Key, signBytes)
+		signature := input.Sign(signBytes)
 
 		signatures = append(signatures, &types.SignInfo{
 			VerificationMethodId: input.VerificationMethodID,
@@ -105,3 +103,23 @@ func (s *TestSetup) CreateDidDocWithExternalControllers(controllers []string, si
 		VersionID:  created.Value.Metadata.VersionId,
 	}
 }
+
+// CreateDidDocWithControllerThreshold creates a DID Document controlled by the given controllers and, if selfControlled, by itself
+func (s *TestSetup) CreateDidDocWithControllerThreshold(controllers []string, selfControlled bool, threshold uint32, signInputs []SignInput) CreatedDidDocInfo {
+	did := s.BuildSimpleDidDoc()
+	if selfControlled {
+		did.Msg.Controller = append(did.Msg.Controller, did.Did)
+	}
+	did.Msg.Controller = append(did.Msg.Controller, controllers...)
+	did.Msg.ControllerThreshold = threshold
+
+	created, err := s.CreateDid(did.Msg, append(signInputs, did.SignInput))
+	if err != nil {
+		panic(err)
+	}
+
+	return CreatedDidDocInfo{
+		DidDocInfo: did,
+		VersionID:  created.Value.Metadata.VersionId,
+	}
+}
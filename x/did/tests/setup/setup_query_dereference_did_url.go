@@ -0,0 +1,13 @@
+package setup
+
+import (
+	"github.com/cheqd/cheqd-node/x/did/types"
+)
+
+func (s *TestSetup) DereferenceDidUrl(didURL string) (*types.QueryDereferenceDidUrlResponse, error) {
+	req := &types.QueryDereferenceDidUrlRequest{
+		DidUrl: didURL,
+	}
+
+	return s.QueryServer.DereferenceDidUrl(s.StdCtx, req)
+}
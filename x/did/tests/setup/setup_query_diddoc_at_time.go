@@ -0,0 +1,16 @@
+package setup
+
+import (
+	"time"
+
+	"github.com/cheqd/cheqd-node/x/did/types"
+)
+
+func (s *TestSetup) QueryDidDocAtTime(did string, at time.Time) (*types.QueryDidDocAtTimeResponse, error) {
+	req := &types.QueryDidDocAtTimeRequest{
+		Id:   did,
+		Time: at,
+	}
+
+	return s.QueryServer.DidDocAtTime(s.StdCtx, req)
+}
@@ -0,0 +1,19 @@
+package setup
+
+import (
+	"encoding/json"
+
+	"github.com/cheqd/cheqd-node/x/did/types"
+)
+
+func (s *TestSetup) ResolveDid(req *types.QueryResolveDidRequest) (*types.QueryResolveDidResponse, types.DidResolutionResult, error) {
+	res, err := s.QueryServer.ResolveDid(s.StdCtx, req)
+	if err != nil {
+		return nil, types.DidResolutionResult{}, err
+	}
+
+	var result types.DidResolutionResult
+	err = json.Unmarshal([]byte(res.DidResolutionResult), &result)
+
+	return res, result, err
+}
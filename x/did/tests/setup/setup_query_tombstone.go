@@ -0,0 +1,11 @@
+package setup
+
+import "github.com/cheqd/cheqd-node/x/did/types"
+
+func (s *TestSetup) QueryTombstone(did string) (*types.QueryTombstoneResponse, error) {
+	req := &types.QueryTombstoneRequest{
+		Id: did,
+	}
+
+	return s.QueryServer.Tombstone(s.StdCtx, req)
+}
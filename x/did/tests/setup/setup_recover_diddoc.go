@@ -0,0 +1,83 @@
+package setup
+
+import (
+	"github.com/cheqd/cheqd-node/x/did/types"
+	"github.com/google/uuid"
+)
+
+type RecoveryKeyInfo struct {
+	VerificationMethod *types.VerificationMethod
+	Commitment         string
+	SignInput          SignInput
+}
+
+func (s *TestSetup) RecoverDidDoc(payload *types.MsgRecoverDidDocPayload, signInputs []SignInput) (*types.MsgRecoverDidDocResponse, error) {
+	signBytes := payload.GetSignBytes()
+	signatures := make([]*types.SignInfo, 0, len(signInputs))
+
+	for _, input := range signInputs {
+		signature := input.Sign(signBytes)
+
+		signatures = append(signatures, &types.SignInfo{
+			VerificationMethodId: input.VerificationMethodID,
+			Signature:            signature,
+		})
+	}
+
+	msg := &types.MsgRecoverDidDoc{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+
+	return s.MsgServer.RecoverDidDoc(s.StdCtx, msg)
+}
+
+// BuildRecoveryKey generates a pre-rotated recovery key of the DID and its commitment
+func (s *TestSetup) BuildRecoveryKey(did string, fragment string) RecoveryKeyInfo {
+	keyPair := GenerateKeyPair()
+	keyID := did + "#" + fragment
+
+	vm := types.NewVerificationMethod(keyID, types.Ed25519VerificationKey2020Type, did,
+		GenerateEd25519VerificationKey2020VerificationMaterial(keyPair.Public))
+
+	return RecoveryKeyInfo{
+		VerificationMethod: vm,
+		Commitment:         types.NewRecoveryCommitment(*vm),
+		SignInput: SignInput{
+			VerificationMethodID: keyID,
+			Key:                  keyPair.Private,
+		},
+	}
+}
+
+// CreateRecoverableDid creates a simple DID committed to the returned recovery key
+func (s *TestSetup) CreateRecoverableDid() (CreatedDidDocInfo, RecoveryKeyInfo) {
+	did := s.BuildSimpleDidDoc()
+	recoveryKey := s.BuildRecoveryKey(did.Did, "recovery-1")
+	did.Msg.RecoveryCommitment = recoveryKey.Commitment
+
+	return s.CreateCustomDidDoc(did), recoveryKey
+}
+
+// BuildRecoveryPayload builds a payload replacing the keys of the DID with a single new key
+func (s *TestSetup) BuildRecoveryPayload(did string, recoveryKey RecoveryKeyInfo, nextCommitment string) (*types.MsgRecoverDidDocPayload, SignInput) {
+	keyPair := GenerateKeyPair()
+	keyID := did + "#key-2"
+
+	payload := &types.MsgRecoverDidDocPayload{
+		Id: did,
+		VerificationMethod: []*types.VerificationMethod{
+			types.NewVerificationMethod(keyID, types.Ed25519VerificationKey2020Type, did,
+				GenerateEd25519VerificationKey2020VerificationMaterial(keyPair.Public)),
+		},
+		Authentication:         []string{keyID},
+		VersionId:              uuid.NewString(),
+		RecoveryKey:            recoveryKey.VerificationMethod,
+		NextRecoveryCommitment: nextCommitment,
+	}
+
+	return payload, SignInput{
+		VerificationMethodID: keyID,
+		Key:                  keyPair.Private,
+	}
+}
@@ -1,8 +1,6 @@
 package setup
 
 import (
-	"crypto/ed25519"
-
 	"github.com/cheqd/cheqd-node/x/did/types"
 )
 
@@ -11,11 +9,12 @@ func (s *TestSetup) UpdateDidDoc(payload *types.MsgUpdateDidDocPayload, signInpu
 	signatures := make([]*types.SignInfo, 0, len(signInputs))
 
 	for _, input := range signInputs {
-		signature := ed25519.Sign(input.Key, signBytes)
+		signature := input.Sign(signBytes)
 
 		signatures = append(signatures, &types.SignInfo{
 			VerificationMethodId: input.VerificationMethodID,
 			Signature:            signature,
+			VersionId:            input.VersionID,
 		})
 	}
 
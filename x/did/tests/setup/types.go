@@ -14,6 +14,18 @@ type KeyPair struct {
 type SignInput struct {
 	VerificationMethodID string
 	Key                  ed25519.PrivateKey
+	// Signer overrides ed25519 signing with Key for non-ed25519 verification methods
+	Signer func(message []byte) []byte
+	// VersionID optionally binds the signature to a version of the DID Document
+	VersionID string
+}
+
+func (input SignInput) Sign(message []byte) []byte {
+	if input.Signer != nil {
+		return input.Signer(message)
+	}
+
+	return ed25519.Sign(input.Key, message)
 }
 
 type DidDocInfo struct {
@@ -1,14 +1,20 @@
 package setup
 
 import (
+	"crypto/ecdsa"
 	"crypto/ed25519"
+	"crypto/elliptic"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/json"
 	mathrand "math/rand"
 	"time"
 
 	"cosmossdk.io/math/unsafe"
 
+	"github.com/cheqd/cheqd-node/x/did/utils"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	secp256k1ecdsa "github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
 	"github.com/google/uuid"
 	"github.com/lestrrat-go/jwx/jwk"
 	"github.com/mr-tron/base58"
@@ -99,3 +105,38 @@ func GenerateJSONWebKey2020VerificationMaterial(publicKey ed25519.PublicKey) str
 func GenerateEd25519VerificationKey2018VerificationMaterial(publicKey ed25519.PublicKey) string {
 	return base58.Encode(publicKey)
 }
+
+func GenerateEcdsaSecp256k1VerificationKey2019VerificationMaterial(publicKey *secp256k1.PublicKey) string {
+	return utils.MustEncodeMultibaseBase58(publicKey.SerializeCompressed())
+}
+
+func GenerateEcdsaSecp256k1RecoveryMethod2020VerificationMaterial(publicKey *secp256k1.PublicKey) string {
+	return "eip155:1:" + utils.EthereumAddress(publicKey.SerializeUncompressed())
+}
+
+func GenerateSecp256k1MultikeyVerificationMaterial(publicKey *secp256k1.PublicKey) string {
+	return utils.MustEncodeMultibaseBase58(append(utils.MulticodecSecp256k1PubPrefix, publicKey.SerializeCompressed()...))
+}
+
+func GenerateECDSAMultikeyVerificationMaterial(publicKey ecdsa.PublicKey) string {
+	prefix := utils.MulticodecP256PubPrefix
+	if publicKey.Curve == elliptic.P384() {
+		prefix = utils.MulticodecP384PubPrefix
+	}
+
+	return utils.MustEncodeMultibaseBase58(append(prefix, elliptic.MarshalCompressed(publicKey.Curve, publicKey.X, publicKey.Y)...))
+}
+
+// SignSecp256k1 returns compact (r || s) signature of SHA256 digest of the message
+func SignSecp256k1(privateKey *secp256k1.PrivateKey, message []byte) []byte {
+	// Compact signature is v || r || s
+	return SignSecp256k1Recoverable(privateKey, message)[:64]
+}
+
+// SignSecp256k1Recoverable returns recoverable (r || s || v) signature of SHA256 digest of the message
+func SignSecp256k1Recoverable(privateKey *secp256k1.PrivateKey, message []byte) []byte {
+	digest := sha256.Sum256(message)
+	compact := secp256k1ecdsa.SignCompact(privateKey, digest[:], false)
+
+	return append(compact[1:], compact[0])
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	. "github.com/cheqd/cheqd-node/x/did/tests/setup"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"github.com/google/uuid"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -57,6 +58,22 @@ var _ = Describe("DIDDoc update", func() {
 			Expect(msg.ToDidDoc()).To(Equal(*created.Value.DidDoc))
 		})
 
+		It("Emits EventDidDocUpdated in case of success", func() {
+			signatures := []SignInput{alice.SignInput}
+
+			_, err := setup.UpdateDidDoc(msg, signatures)
+			Expect(err).To(BeNil())
+
+			event, err := setup.LastTypedEvent(&types.EventDidDocUpdated{})
+			Expect(err).To(BeNil())
+			Expect(event).To(Equal(&types.EventDidDocUpdated{
+				Did:               bob.Did,
+				VersionId:         msg.VersionId,
+				PreviousVersionId: bob.VersionID,
+				Controllers:       []string{alice.Did},
+			}))
+		})
+
 		It("Creates a new DIDDoc version in case of success", func() {
 			signatures := []SignInput{alice.SignInput}
 
@@ -313,6 +330,93 @@ var _ = Describe("DIDDoc update", func() {
 		})
 	})
 
+	Describe("Verification method: key update to secp256k1", func() {
+		var did CreatedDidDocInfo
+		var newPrivKey *secp256k1.PrivateKey
+		var msg *types.MsgUpdateDidDocPayload
+
+		BeforeEach(func() {
+			var err error
+			did = setup.CreateSimpleDid()
+			newPrivKey, err = secp256k1.GeneratePrivateKey()
+			Expect(err).To(BeNil())
+
+			msg = &types.MsgUpdateDidDocPayload{
+				Id: did.Did,
+				VerificationMethod: []*types.VerificationMethod{
+					{
+						Id:                     did.KeyID,
+						VerificationMethodType: types.EcdsaSecp256k1VerificationKey2019Type,
+						Controller:             did.Did,
+						VerificationMaterial:   GenerateEcdsaSecp256k1VerificationKey2019VerificationMaterial(newPrivKey.PubKey()),
+					},
+				},
+				VersionId: uuid.NewString(),
+			}
+		})
+
+		It("Works with old and new signatures", func() {
+			signatures := []SignInput{
+				did.SignInput, // Old signature
+				{
+					VerificationMethodID: did.KeyID, // New signature
+					Signer: func(message []byte) []byte {
+						return SignSecp256k1(newPrivKey, message)
+					},
+				},
+			}
+
+			_, err := setup.UpdateDidDoc(msg, signatures)
+			Expect(err).To(BeNil())
+
+			// check
+			created, err := setup.QueryDidDoc(did.Did)
+			Expect(err).To(BeNil())
+			Expect(msg.ToDidDoc()).To(Equal(*created.Value.DidDoc))
+		})
+
+		It("Allows further updates signed by secp256k1 key only", func() {
+			newSignInput := SignInput{
+				VerificationMethodID: did.KeyID,
+				Signer: func(message []byte) []byte {
+					return SignSecp256k1(newPrivKey, message)
+				},
+			}
+
+			_, err := setup.UpdateDidDoc(msg, []SignInput{did.SignInput, newSignInput})
+			Expect(err).To(BeNil())
+
+			nextMsg := &types.MsgUpdateDidDocPayload{
+				Id: did.Did,
+				VerificationMethod: []*types.VerificationMethod{
+					{
+						Id:                     did.KeyID,
+						VerificationMethodType: types.MultikeyType,
+						Controller:             did.Did,
+						VerificationMaterial:   GenerateSecp256k1MultikeyVerificationMaterial(newPrivKey.PubKey()),
+					},
+				},
+				Authentication: []string{did.KeyID},
+				VersionId:      uuid.NewString(),
+			}
+
+			_, err = setup.UpdateDidDoc(nextMsg, []SignInput{newSignInput})
+			Expect(err).To(BeNil())
+
+			// check
+			updated, err := setup.QueryDidDoc(did.Did)
+			Expect(err).To(BeNil())
+			Expect(nextMsg.ToDidDoc()).To(Equal(*updated.Value.DidDoc))
+		})
+
+		It("Doesn't work without new signature", func() {
+			signatures := []SignInput{did.SignInput}
+
+			_, err := setup.UpdateDidDoc(msg, signatures)
+			Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("there should be at least one valid signature by %s (new version): invalid signature detected", did.Did)))
+		})
+	})
+
 	Describe("Verification method: controller update", func() {
 		var alice CreatedDidDocInfo
 		var bob CreatedDidDocInfo
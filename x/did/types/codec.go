@@ -12,9 +12,12 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateDidDoc{}, "did/CreateDidDoc", nil)
 	cdc.RegisterConcrete(&MsgUpdateDidDoc{}, "did/UpdateDidDoc", nil)
 	cdc.RegisterConcrete(&MsgDeactivateDidDoc{}, "did/DeleteDidDoc", nil)
+	cdc.RegisterConcrete(&MsgBatchCreateDidDocs{}, "did/BatchCreateDidDocs", nil)
+	cdc.RegisterConcrete(&MsgRecoverDidDoc{}, "did/RecoverDidDoc", nil)
 	cdc.RegisterConcrete(&MsgBurn{}, "did/MsgBurn", nil)
 	cdc.RegisterConcrete(&MsgMint{}, "did/MsgMint", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "did/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgPurchaseIdentityCredits{}, "did/PurchaseIdentityCredits", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -23,9 +26,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCreateDidDoc{},
 		&MsgUpdateDidDoc{},
 		&MsgDeactivateDidDoc{},
+		&MsgBatchCreateDidDocs{},
+		&MsgRecoverDidDoc{},
 		&MsgBurn{},
 		&MsgMint{},
 		&MsgUpdateParams{},
+		&MsgPurchaseIdentityCredits{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
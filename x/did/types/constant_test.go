@@ -61,6 +61,21 @@ var (
 	// bytes in hex: 2c392158b9b3b3935a22ba9dc371211ab58939b39461dcf66aec6d5cd04b9e
 	InvalidEd25519VerificationKey2018VerificationMaterialBadLength = "g7T3moSG5mwFvazr5gi8AyUETXTkZ9E6PZxAZVhWN9"
 
+	ValidEcdsaSecp256k1VerificationKey2019VerificationMaterial = "zo429ctkFKEYUgsqKjHQeHQFA3npiHKgPx58ytevQKo5f"
+
+	// ed25519 key instead of a secp256k1 one
+	InvalidEcdsaSecp256k1VerificationKey2019VerificationMaterial = "zF1hVGXXK9rmx5HhMTpGnGQJiab9qrFJbQXBRhSmYjQWX"
+
+	ValidEcdsaSecp256k1RecoveryMethod2020VerificationMaterial   = "eip155:1:0x95ea63541496e559da49e682340e4950617cada2"
+	InvalidEcdsaSecp256k1RecoveryMethod2020VerificationMaterial = "0x95ea63541496e559da49e682340e4950617cada2"
+
+	ValidSecp256k1MultikeyVerificationMaterial = "zQ3shZ1BAU3DidsmMvyWk7NHh6zcbhZ1gZsivsztnBzbXYGwP"
+	ValidP256MultikeyVerificationMaterial      = "zDnaehpHQvAQfd9nSvoWj1KW1qMMwMtxp7n6BSZbubBqybmfb"
+	ValidP384MultikeyVerificationMaterial      = "z82LktcPgzkGuXbuR7mKuKYL6xUhPomoU6sf6c61C2dnSMKvzCdXFEGSQ4y7RtWdrULmBaS"
+
+	// bytes in hex: 020076a50fe5e0c3616c1b4d85a308c104a1c99d8d3d92c18c1f4e0179202d564c12
+	InvalidMultikeyVerificationMaterialBadPrefix = "z3dEYJrMxWigf9boyeJMTRN4Ern8DJMoCXaLK77pzQmxVjf"
+
 	ValidJWK2020VerificationMaterial   = string(ValidPublicKeyJWK)
 	InvalidJWK2020VerificationMaterial = string(InvalidPublicKeyJWK)
 )
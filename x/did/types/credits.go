@@ -0,0 +1,89 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewIdentityCreditAccount returns an account without credits or identity transactions
+func NewIdentityCreditAccount(address string) IdentityCreditAccount {
+	return IdentityCreditAccount{
+		Address: address,
+		Credits: sdkmath.ZeroInt(),
+	}
+}
+
+// TxsInWindow returns the number of identity transactions paid within the volume discount window active at the given time
+func (a IdentityCreditAccount) TxsInWindow(now time.Time, window time.Duration) uint64 {
+	if window <= 0 || !now.Before(a.WindowStart.Add(window)) {
+		return 0
+	}
+
+	return a.WindowTxs
+}
+
+// RecordTx counts an identity transaction paid at the given time, starting a new volume discount window if the current one is over
+func (a *IdentityCreditAccount) RecordTx(now time.Time, window time.Duration) {
+	if a.TxsInWindow(now, window) == 0 {
+		a.WindowStart = now
+		a.WindowTxs = 0
+	}
+
+	a.WindowTxs++
+}
+
+// IsEmpty returns true if the account holds neither credits nor identity transactions
+func (a IdentityCreditAccount) IsEmpty() bool {
+	return (a.Credits.IsNil() || a.Credits.IsZero()) && a.WindowTxs == 0
+}
+
+func (a IdentityCreditAccount) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(a.Address); err != nil {
+		return fmt.Errorf("invalid identity credit account address %s: %w", a.Address, err)
+	}
+
+	if a.Credits.IsNil() || a.Credits.IsNegative() {
+		return fmt.Errorf("identity credits of %s must not be negative: got %s", a.Address, a.Credits)
+	}
+
+	return nil
+}
+
+// GetFeeDiscount returns the fraction of identity fees waived for an account, given the identity transactions it paid within the volume discount window
+func (tfp *FeeParams) GetFeeDiscount(address string, windowTxs uint64) sdkmath.LegacyDec {
+	discount := sdkmath.LegacyZeroDec()
+
+	for _, issuer := range tfp.IssuerDiscounts {
+		if issuer.Address == address && issuer.Discount.GT(discount) {
+			discount = issuer.Discount
+		}
+	}
+
+	if tfp.VolumeDiscountWindow > 0 {
+		for _, tier := range tfp.VolumeDiscountTiers {
+			if windowTxs >= tier.MinTxs && tier.Discount.GT(discount) {
+				discount = tier.Discount
+			}
+		}
+	}
+
+	return discount
+}
+
+// ApplyFeeDiscount returns the fee left after waiving the discount, the waived amount is rounded down
+func ApplyFeeDiscount(fee sdk.Coins, discount sdkmath.LegacyDec) sdk.Coins {
+	if discount.IsZero() {
+		return fee
+	}
+
+	discounted := sdk.NewCoins()
+	for _, coin := range fee {
+		waived := discount.MulInt(coin.Amount).TruncateInt()
+		discounted = discounted.Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(waived)))
+	}
+
+	return discounted
+}
@@ -0,0 +1,473 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cheqd/did/v2/credits.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// IdentityCreditAccount holds the prepaid identity credits and the identity
+// transaction volume of an account paying identity fees
+type IdentityCreditAccount struct {
+	// Bech32 address of the account paying the identity fees
+	// (fee granter if present, fee payer otherwise)
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Prepaid credits in ncheq, drawn from before the account is charged identity fees
+	Credits cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=credits,proto3,customtype=cosmossdk.io/math.Int" json:"credits"`
+	// Start of the current volume discount window
+	WindowStart time.Time `protobuf:"bytes,3,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start"`
+	// Number of identity transactions paid within the current volume discount window
+	WindowTxs uint64 `protobuf:"varint,4,opt,name=window_txs,json=windowTxs,proto3" json:"window_txs,omitempty"`
+}
+
+func (m *IdentityCreditAccount) Reset()         { *m = IdentityCreditAccount{} }
+func (m *IdentityCreditAccount) String() string { return proto.CompactTextString(m) }
+func (*IdentityCreditAccount) ProtoMessage()    {}
+func (*IdentityCreditAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_760d2ea2ce2bd850, []int{0}
+}
+func (m *IdentityCreditAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IdentityCreditAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IdentityCreditAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IdentityCreditAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdentityCreditAccount.Merge(m, src)
+}
+func (m *IdentityCreditAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *IdentityCreditAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdentityCreditAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdentityCreditAccount proto.InternalMessageInfo
+
+func (m *IdentityCreditAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *IdentityCreditAccount) GetWindowStart() time.Time {
+	if m != nil {
+		return m.WindowStart
+	}
+	return time.Time{}
+}
+
+func (m *IdentityCreditAccount) GetWindowTxs() uint64 {
+	if m != nil {
+		return m.WindowTxs
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*IdentityCreditAccount)(nil), "cheqd.did.v2.IdentityCreditAccount")
+}
+
+func init() { proto.RegisterFile("cheqd/did/v2/credits.proto", fileDescriptor_760d2ea2ce2bd850) }
+
+var fileDescriptor_760d2ea2ce2bd850 = []byte{
+	// 343 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x34, 0x51, 0x4d, 0x6e, 0xf2, 0x30,
+	0x10, 0x8d, 0xbf, 0x0f, 0x7d, 0x7c, 0x18, 0x56, 0x11, 0x48, 0x69, 0xa4, 0x26, 0xa8, 0x9b, 0x22,
+	0x55, 0xd8, 0x52, 0x7a, 0x02, 0xa8, 0xaa, 0x8a, 0x6d, 0x60, 0xd5, 0x0d, 0x0a, 0xb1, 0x1b, 0xac,
+	0x36, 0x31, 0x8d, 0x87, 0xbf, 0x5b, 0x70, 0x18, 0x0e, 0xc1, 0x12, 0xb1, 0xaa, 0xba, 0xa0, 0x15,
+	0xdc, 0xa2, 0xab, 0x0a, 0x3b, 0xd9, 0x58, 0x9e, 0x79, 0xef, 0xcd, 0x9b, 0xa7, 0xc1, 0x6e, 0x3c,
+	0xe5, 0xef, 0x8c, 0x32, 0xc1, 0xe8, 0x22, 0xa0, 0x71, 0xce, 0x99, 0x00, 0x45, 0x66, 0xb9, 0x04,
+	0x69, 0x37, 0x34, 0x46, 0x98, 0x60, 0x64, 0x11, 0xb8, 0x57, 0xb1, 0x54, 0xa9, 0x54, 0x63, 0x8d,
+	0x51, 0x53, 0x18, 0xa2, 0xdb, 0x4c, 0x64, 0x22, 0x4d, 0xff, 0xf2, 0x2b, 0xba, 0x7e, 0x22, 0x65,
+	0xf2, 0xc6, 0xa9, 0xae, 0x26, 0xf3, 0x17, 0x0a, 0x22, 0xe5, 0x0a, 0xa2, 0x74, 0x66, 0x08, 0x37,
+	0x3f, 0x08, 0xb7, 0x06, 0x8c, 0x67, 0x20, 0x60, 0xfd, 0xa0, 0x9d, 0x7b, 0x71, 0x2c, 0xe7, 0x19,
+	0xd8, 0x01, 0xae, 0x46, 0x8c, 0xe5, 0x5c, 0x29, 0x07, 0xb5, 0x51, 0xa7, 0xd6, 0x77, 0x0e, 0xdb,
+	0x6e, 0xb3, 0xf0, 0xec, 0x19, 0x64, 0x08, 0xb9, 0xc8, 0x92, 0xb0, 0x24, 0xda, 0x8f, 0xb8, 0x5a,
+	0xac, 0xef, 0xfc, 0xd1, 0x9a, 0xbb, 0xdd, 0xd1, 0xb7, 0x3e, 0x8f, 0x7e, 0xcb, 0xe8, 0x14, 0x7b,
+	0x25, 0x42, 0xd2, 0x34, 0x82, 0x29, 0x19, 0x64, 0x70, 0xd8, 0x76, 0x71, 0x31, 0x70, 0x90, 0x41,
+	0x58, 0x6a, 0xed, 0x27, 0xdc, 0x58, 0x8a, 0x8c, 0xc9, 0xe5, 0x58, 0x41, 0x94, 0x83, 0xf3, 0xb7,
+	0x8d, 0x3a, 0xf5, 0xc0, 0x25, 0x26, 0x0c, 0x29, 0xc3, 0x90, 0x51, 0x19, 0xa6, 0xff, 0xff, 0xe2,
+	0xb3, 0xf9, 0xf2, 0x51, 0x58, 0x37, 0xca, 0xe1, 0x45, 0x68, 0x5f, 0x63, 0x5c, 0x0c, 0x82, 0x95,
+	0x72, 0x2a, 0x6d, 0xd4, 0xa9, 0x84, 0x35, 0xd3, 0x19, 0xad, 0x54, 0xbf, 0xb7, 0x3b, 0x79, 0x68,
+	0x7f, 0xf2, 0xd0, 0xf7, 0xc9, 0x43, 0x9b, 0xb3, 0x67, 0xed, 0xcf, 0x9e, 0xf5, 0x71, 0xf6, 0xac,
+	0xe7, 0xdb, 0x44, 0xc0, 0x74, 0x3e, 0x21, 0xb1, 0x4c, 0xa9, 0xb9, 0x8e, 0x7e, 0xbb, 0x99, 0x64,
+	0x9c, 0xae, 0xf4, 0xa9, 0x60, 0x3d, 0xe3, 0x6a, 0xf2, 0x4f, 0x2f, 0x73, 0xff, 0x3b, 0x00, 0x22,
+	0xd7, 0xad, 0x4c, 0xc4, 0x01, 0x00, 0x00,
+}
+
+func (m *IdentityCreditAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IdentityCreditAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdentityCreditAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowTxs != 0 {
+		i = encodeVarintCredits(dAtA, i, uint64(m.WindowTxs))
+		i--
+		dAtA[i] = 0x20
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.WindowStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintCredits(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Credits.Size()
+		i -= size
+		if _, err := m.Credits.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCredits(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintCredits(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCredits(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCredits(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IdentityCreditAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCredits(uint64(l))
+	}
+	l = m.Credits.Size()
+	n += 1 + l + sovCredits(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart)
+	n += 1 + l + sovCredits(uint64(l))
+	if m.WindowTxs != 0 {
+		n += 1 + sovCredits(uint64(m.WindowTxs))
+	}
+	return n
+}
+
+func sovCredits(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCredits(x uint64) (n int) {
+	return sovCredits(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IdentityCreditAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCredits
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdentityCreditAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdentityCreditAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredits
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredits
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Credits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCredits
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowTxs", wireType)
+			}
+			m.WindowTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCredits(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCredits
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCredits(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCredits
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCredits
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCredits
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCredits
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCredits
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCredits
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCredits        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCredits          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCredits = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,101 @@
+package types_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	. "github.com/cheqd/cheqd-node/x/did/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Identity credits tests", func() {
+	issuer := sdk.AccAddress("issuer______________").String()
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	window := 30 * 24 * time.Hour
+
+	Describe("IdentityCreditAccount", func() {
+		It("Counts transactions within the window", func() {
+			account := NewIdentityCreditAccount(issuer)
+			Expect(account.IsEmpty()).To(BeTrue())
+
+			account.RecordTx(start, window)
+			account.RecordTx(start.Add(time.Hour), window)
+			Expect(account.WindowStart).To(Equal(start))
+			Expect(account.TxsInWindow(start.Add(2*time.Hour), window)).To(BeEquivalentTo(2))
+			Expect(account.IsEmpty()).To(BeFalse())
+		})
+
+		It("Starts a new window once the current one is over", func() {
+			account := NewIdentityCreditAccount(issuer)
+			account.RecordTx(start, window)
+			account.RecordTx(start, window)
+
+			Expect(account.TxsInWindow(start.Add(window), window)).To(BeZero())
+
+			account.RecordTx(start.Add(window), window)
+			Expect(account.WindowStart).To(Equal(start.Add(window)))
+			Expect(account.WindowTxs).To(BeEquivalentTo(1))
+		})
+
+		It("Rejects negative credits", func() {
+			account := NewIdentityCreditAccount(issuer)
+			Expect(account.Validate()).To(Succeed())
+
+			account.Credits = sdkmath.NewInt(-1)
+			Expect(account.Validate()).ToNot(Succeed())
+		})
+	})
+
+	Describe("GetFeeDiscount", func() {
+		params := FeeParams{
+			VolumeDiscountTiers: []VolumeDiscountTier{
+				{MinTxs: 100, Discount: sdkmath.LegacyMustNewDecFromStr("0.1")},
+				{MinTxs: 1000, Discount: sdkmath.LegacyMustNewDecFromStr("0.3")},
+			},
+			IssuerDiscounts: []IssuerDiscount{
+				{Address: issuer, Discount: sdkmath.LegacyMustNewDecFromStr("0.2")},
+			},
+			VolumeDiscountWindow: window,
+		}
+
+		It("Applies the largest discount reached", func() {
+			Expect(params.GetFeeDiscount("other", 99)).To(Equal(sdkmath.LegacyZeroDec()))
+			Expect(params.GetFeeDiscount("other", 100)).To(Equal(sdkmath.LegacyMustNewDecFromStr("0.1")))
+			Expect(params.GetFeeDiscount(issuer, 100)).To(Equal(sdkmath.LegacyMustNewDecFromStr("0.2")))
+			Expect(params.GetFeeDiscount(issuer, 1000)).To(Equal(sdkmath.LegacyMustNewDecFromStr("0.3")))
+		})
+
+		It("Ignores volume tiers without a window", func() {
+			noWindow := params
+			noWindow.VolumeDiscountWindow = 0
+
+			Expect(noWindow.GetFeeDiscount("other", 1000)).To(Equal(sdkmath.LegacyZeroDec()))
+			Expect(noWindow.GetFeeDiscount(issuer, 1000)).To(Equal(sdkmath.LegacyMustNewDecFromStr("0.2")))
+		})
+	})
+
+	It("ApplyFeeDiscount rounds the waived amount down", func() {
+		fee := sdk.NewCoins(sdk.NewInt64Coin(BaseMinimalDenom, 101))
+
+		Expect(ApplyFeeDiscount(fee, sdkmath.LegacyMustNewDecFromStr("0.5"))).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(BaseMinimalDenom, 51))))
+		Expect(ApplyFeeDiscount(fee, sdkmath.LegacyZeroDec())).To(Equal(fee))
+	})
+
+	It("FeeParams validation rejects invalid discounts", func() {
+		params := DefaultFeeParams()
+		Expect(params.ValidateBasic()).To(Succeed())
+
+		params.VolumeDiscountTiers = []VolumeDiscountTier{{MinTxs: 10, Discount: sdkmath.LegacyOneDec()}}
+		Expect(params.ValidateBasic()).ToNot(Succeed())
+
+		params = DefaultFeeParams()
+		params.VolumeDiscountTiers = []VolumeDiscountTier{{MinTxs: 0, Discount: sdkmath.LegacyMustNewDecFromStr("0.1")}}
+		Expect(params.ValidateBasic()).ToNot(Succeed())
+
+		params = DefaultFeeParams()
+		params.IssuerDiscounts = []IssuerDiscount{{Address: "invalid", Discount: sdkmath.LegacyMustNewDecFromStr("0.1")}}
+		Expect(params.ValidateBasic()).ToNot(Succeed())
+	})
+})
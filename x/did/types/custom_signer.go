@@ -21,6 +21,12 @@ func CreateGetSigners(options *signing.Options) func(msg protov2.Message) ([][]b
 		case *didv2.MsgUpdateDidDoc:
 			return [][]byte{}, nil
 
+		case *didv2.MsgBatchCreateDidDocs:
+			return [][]byte{}, nil
+
+		case *didv2.MsgRecoverDidDoc:
+			return [][]byte{}, nil
+
 		default:
 			return nil, fmt.Errorf("unsupported message type: %T", msg)
 		}
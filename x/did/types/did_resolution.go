@@ -0,0 +1,314 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cheqd/cheqd-node/x/did/utils"
+)
+
+const (
+	DidLdJSONContentType = "application/did+ld+json"
+	DidJSONContentType   = "application/did+json"
+
+	DidResolutionContext = "https://w3id.org/did-resolution/v1"
+	DidCoreContext       = "https://www.w3.org/ns/did/v1"
+
+	LinkedResourcePathPrefix = "/resources/"
+)
+
+// VerificationMethodContexts maps verification method types to the JSON-LD contexts defining them
+var VerificationMethodContexts = map[string]string{
+	Ed25519VerificationKey2020Type:        "https://w3id.org/security/suites/ed25519-2020/v1",
+	Ed25519VerificationKey2018Type:        "https://w3id.org/security/suites/ed25519-2018/v1",
+	JSONWebKey2020Type:                    "https://w3id.org/security/suites/jws-2020/v1",
+	EcdsaSecp256k1VerificationKey2019Type: "https://w3id.org/security/suites/secp256k1-2019/v1",
+	EcdsaSecp256k1RecoveryMethod2020Type:  "https://w3id.org/security/suites/secp256k1recovery-2020/v2",
+	MultikeyType:                          "https://w3id.org/security/multikey/v1",
+}
+
+// LinkedResourceMetadata is the DID Core representation of a DID-Linked Resource
+type LinkedResourceMetadata struct {
+	ResourceURI          string    `json:"resourceURI"`
+	ResourceCollectionID string    `json:"resourceCollectionId"`
+	ResourceID           string    `json:"resourceId"`
+	ResourceName         string    `json:"resourceName"`
+	ResourceType         string    `json:"resourceType"`
+	MediaType            string    `json:"mediaType"`
+	ResourceVersion      string    `json:"resourceVersion,omitempty"`
+	Created              time.Time `json:"created"`
+	Checksum             string    `json:"checksum"`
+	PreviousVersionID    string    `json:"previousVersionId,omitempty"`
+	NextVersionID        string    `json:"nextVersionId,omitempty"`
+}
+
+// LinkedResource is a DID-Linked Resource with its data
+type LinkedResource struct {
+	Data     []byte
+	Metadata LinkedResourceMetadata
+}
+
+type ResolvedVerificationMethod struct {
+	ID                  string          `json:"id"`
+	Type                string          `json:"type"`
+	Controller          string          `json:"controller"`
+	PublicKeyMultibase  string          `json:"publicKeyMultibase,omitempty"`
+	PublicKeyBase58     string          `json:"publicKeyBase58,omitempty"`
+	PublicKeyJwk        json.RawMessage `json:"publicKeyJwk,omitempty"`
+	BlockchainAccountID string          `json:"blockchainAccountId,omitempty"`
+}
+
+type ResolvedService struct {
+	ID              string   `json:"id"`
+	Type            string   `json:"type"`
+	ServiceEndpoint []string `json:"serviceEndpoint"`
+	RecipientKeys   []string `json:"recipientKeys,omitempty"`
+	RoutingKeys     []string `json:"routingKeys,omitempty"`
+	Accept          []string `json:"accept,omitempty"`
+	Priority        uint32   `json:"priority,omitempty"`
+	// ServiceEndpointMaps are represented in serviceEndpoint, following the string endpoints
+	ServiceEndpointMaps []*ServiceEndpointMap `json:"-"`
+}
+
+// resolvedServiceJSON is the JSON representation of ResolvedService with the combined serviceEndpoint
+type resolvedServiceJSON struct {
+	ID              string          `json:"id"`
+	Type            string          `json:"type"`
+	ServiceEndpoint json.RawMessage `json:"serviceEndpoint"`
+	RecipientKeys   []string        `json:"recipientKeys,omitempty"`
+	RoutingKeys     []string        `json:"routingKeys,omitempty"`
+	Accept          []string        `json:"accept,omitempty"`
+	Priority        uint32          `json:"priority,omitempty"`
+}
+
+func (s ResolvedService) MarshalJSON() ([]byte, error) {
+	serviceEndpoint, err := MarshalServiceEndpoint(s.ServiceEndpoint, s.ServiceEndpointMaps)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(resolvedServiceJSON{
+		ID:              s.ID,
+		Type:            s.Type,
+		ServiceEndpoint: serviceEndpoint,
+		RecipientKeys:   s.RecipientKeys,
+		RoutingKeys:     s.RoutingKeys,
+		Accept:          s.Accept,
+		Priority:        s.Priority,
+	})
+}
+
+func (s *ResolvedService) UnmarshalJSON(data []byte) error {
+	var raw resolvedServiceJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	serviceEndpoint, serviceEndpointMaps, err := UnmarshalServiceEndpoint(raw.ServiceEndpoint)
+	if err != nil {
+		return err
+	}
+
+	*s = ResolvedService{
+		ID:                  raw.ID,
+		Type:                raw.Type,
+		ServiceEndpoint:     serviceEndpoint,
+		RecipientKeys:       raw.RecipientKeys,
+		RoutingKeys:         raw.RoutingKeys,
+		Accept:              raw.Accept,
+		Priority:            raw.Priority,
+		ServiceEndpointMaps: serviceEndpointMaps,
+	}
+
+	return nil
+}
+
+type ResolvedDidDocument struct {
+	Context              []string                     `json:"@context,omitempty"`
+	ID                   string                       `json:"id"`
+	Controller           []string                     `json:"controller,omitempty"`
+	VerificationMethod   []ResolvedVerificationMethod `json:"verificationMethod,omitempty"`
+	Authentication       []string                     `json:"authentication,omitempty"`
+	AssertionMethod      []string                     `json:"assertionMethod,omitempty"`
+	CapabilityInvocation []string                     `json:"capabilityInvocation,omitempty"`
+	CapabilityDelegation []string                     `json:"capabilityDelegation,omitempty"`
+	KeyAgreement         []string                     `json:"keyAgreement,omitempty"`
+	Service              []ResolvedService            `json:"service,omitempty"`
+	AlsoKnownAs          []string                     `json:"alsoKnownAs,omitempty"`
+	ControllerThreshold  uint32                       `json:"controllerThreshold,omitempty"`
+}
+
+type ResolvedDidDocumentMetadata struct {
+	Created                time.Time                `json:"created"`
+	Updated                *time.Time               `json:"updated,omitempty"`
+	Deactivated            bool                     `json:"deactivated,omitempty"`
+	VersionID              string                   `json:"versionId"`
+	NextVersionID          string                   `json:"nextVersionId,omitempty"`
+	PreviousVersionID      string                   `json:"previousVersionId,omitempty"`
+	LinkedResourceMetadata []LinkedResourceMetadata `json:"linkedResourceMetadata,omitempty"`
+}
+
+type DidResolutionMetadata struct {
+	ContentType string         `json:"contentType"`
+	Did         ResolvedDidURL `json:"did"`
+}
+
+type ResolvedDidURL struct {
+	DidString        string `json:"didString"`
+	MethodSpecificID string `json:"methodSpecificId"`
+	Method           string `json:"method"`
+}
+
+// DidDereferencingMetadata is the DID URL dereferencing metadata as defined in https://w3c-ccg.github.io/did-resolution/
+type DidDereferencingMetadata struct {
+	ContentType string         `json:"contentType"`
+	Did         ResolvedDidURL `json:"did"`
+}
+
+// DidResolutionResult is the DID Resolution result as defined in https://w3c-ccg.github.io/did-resolution/
+type DidResolutionResult struct {
+	Context               string                      `json:"@context,omitempty"`
+	DidDocument           ResolvedDidDocument         `json:"didDocument"`
+	DidDocumentMetadata   ResolvedDidDocumentMetadata `json:"didDocumentMetadata"`
+	DidResolutionMetadata DidResolutionMetadata       `json:"didResolutionMetadata"`
+}
+
+// IsSupportedDidRepresentation checks whether the content type is a supported DID Document representation
+func IsSupportedDidRepresentation(contentType string) bool {
+	return contentType == DidLdJSONContentType || contentType == DidJSONContentType
+}
+
+// NewDidResolutionResult converts a stored DID Document into the DID Resolution result for the given content type
+func NewDidResolutionResult(didDoc DidDocWithMetadata, linkedResources []LinkedResourceMetadata, contentType string) (DidResolutionResult, error) {
+	if !IsSupportedDidRepresentation(contentType) {
+		return DidResolutionResult{}, ErrBadRequest.Wrapf("representation not supported: %s", contentType)
+	}
+
+	document, err := NewResolvedDidDocument(*didDoc.DidDoc)
+	if err != nil {
+		return DidResolutionResult{}, err
+	}
+
+	result := DidResolutionResult{
+		DidDocument: document,
+		DidDocumentMetadata: ResolvedDidDocumentMetadata{
+			Created:                didDoc.Metadata.Created,
+			Updated:                didDoc.Metadata.Updated,
+			Deactivated:            didDoc.Metadata.Deactivated,
+			VersionID:              didDoc.Metadata.VersionId,
+			NextVersionID:          didDoc.Metadata.NextVersionId,
+			PreviousVersionID:      didDoc.Metadata.PreviousVersionId,
+			LinkedResourceMetadata: linkedResources,
+		},
+		DidResolutionMetadata: DidResolutionMetadata{
+			ContentType: contentType,
+			Did:         NewResolvedDidURL(didDoc.DidDoc.Id),
+		},
+	}
+
+	if contentType == DidLdJSONContentType {
+		result.Context = DidResolutionContext
+		result.DidDocument.Context = didDocumentContext(*didDoc.DidDoc)
+	}
+
+	return result, nil
+}
+
+// NewResolvedDidURL splits a DID into the parts reported in resolution and dereferencing metadata
+func NewResolvedDidURL(did string) ResolvedDidURL {
+	method, namespace, id := utils.MustSplitDID(did)
+	methodSpecificID := id
+	if namespace != "" {
+		methodSpecificID = namespace + ":" + id
+	}
+
+	return ResolvedDidURL{
+		DidString:        did,
+		MethodSpecificID: methodSpecificID,
+		Method:           method,
+	}
+}
+
+// NewResolvedDidDocument expands the verification material of a DID Document into the DID Core properties
+func NewResolvedDidDocument(didDoc DidDoc) (ResolvedDidDocument, error) {
+	document := ResolvedDidDocument{
+		ID:                   didDoc.Id,
+		Controller:           didDoc.Controller,
+		Authentication:       didDoc.Authentication,
+		AssertionMethod:      didDoc.AssertionMethod,
+		CapabilityInvocation: didDoc.CapabilityInvocation,
+		CapabilityDelegation: didDoc.CapabilityDelegation,
+		KeyAgreement:         didDoc.KeyAgreement,
+		AlsoKnownAs:          didDoc.AlsoKnownAs,
+		ControllerThreshold:  didDoc.ControllerThreshold,
+	}
+
+	for _, vm := range didDoc.VerificationMethod {
+		resolved := ResolvedVerificationMethod{
+			ID:         vm.Id,
+			Type:       vm.VerificationMethodType,
+			Controller: vm.Controller,
+		}
+
+		switch vm.VerificationMethodType {
+		case Ed25519VerificationKey2020Type, EcdsaSecp256k1VerificationKey2019Type, MultikeyType:
+			resolved.PublicKeyMultibase = vm.VerificationMaterial
+		case Ed25519VerificationKey2018Type:
+			resolved.PublicKeyBase58 = vm.VerificationMaterial
+		case JSONWebKey2020Type:
+			resolved.PublicKeyJwk = json.RawMessage(vm.VerificationMaterial)
+		case EcdsaSecp256k1RecoveryMethod2020Type:
+			resolved.BlockchainAccountID = vm.VerificationMaterial
+		default:
+			return ResolvedDidDocument{}, fmt.Errorf("unsupported verification method type: %s", vm.VerificationMethodType)
+		}
+
+		document.VerificationMethod = append(document.VerificationMethod, resolved)
+	}
+
+	for _, service := range didDoc.Service {
+		document.Service = append(document.Service, ResolvedService{
+			ID:              service.Id,
+			Type:            service.ServiceType,
+			ServiceEndpoint: service.ServiceEndpoint,
+			RecipientKeys:   service.RecipientKeys,
+			RoutingKeys:     service.RoutingKeys,
+			Accept:          service.Accept,
+			Priority:        service.Priority,
+
+			ServiceEndpointMaps: service.ServiceEndpointMaps,
+		})
+	}
+
+	return document, nil
+}
+
+// didDocumentContext returns the stored context extended with the DID Core context and the contexts of used verification method types
+func didDocumentContext(didDoc DidDoc) []string {
+	context := []string{DidCoreContext}
+	for _, c := range didDoc.Context {
+		if !utils.Contains(context, c) {
+			context = append(context, c)
+		}
+	}
+
+	for _, vm := range didDoc.VerificationMethod {
+		if c, ok := VerificationMethodContexts[vm.VerificationMethodType]; ok && !utils.Contains(context, c) {
+			context = append(context, c)
+		}
+	}
+
+	return context
+}
+
+// NormalizeDidRepresentation returns the default representation for an empty accept value
+func NormalizeDidRepresentation(accept string) string {
+	accept = strings.TrimSpace(accept)
+	if accept == "" {
+		return DidLdJSONContentType
+	}
+
+	return accept
+}
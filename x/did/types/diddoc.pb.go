@@ -60,6 +60,10 @@ type DidDoc struct {
 	Service []*Service `protobuf:"bytes,10,rep,name=service,proto3" json:"service,omitempty"`
 	// alsoKnownAs is a list of DIDs that are known to refer to the same DID subject.
 	AlsoKnownAs []string `protobuf:"bytes,11,rep,name=also_known_as,json=alsoKnownAs,proto3" json:"also_known_as,omitempty"`
+	// controllerThreshold is the minimum number of controllers that must sign on behalf of the DID subject
+	// to update or deactivate the DID Document and to create resources in its collection.
+	// Zero requires signatures of all controllers.
+	ControllerThreshold uint32 `protobuf:"varint,12,opt,name=controller_threshold,json=controllerThreshold,proto3" json:"controller_threshold,omitempty"`
 }
 
 func (m *DidDoc) Reset()         { *m = DidDoc{} }
@@ -172,6 +176,13 @@ func (m *DidDoc) GetAlsoKnownAs() []string {
 	return nil
 }
 
+func (m *DidDoc) GetControllerThreshold() uint32 {
+	if m != nil {
+		return m.ControllerThreshold
+	}
+	return 0
+}
+
 // VerificationMethod defines a verification method, as defined in the DID Core specification.
 // Documentation: https://www.w3.org/TR/did-core/#verification-methods
 type VerificationMethod struct {
@@ -185,7 +196,7 @@ type VerificationMethod struct {
 	// Format: did:cheqd:<namespace>:<unique-identifier>
 	Controller string `protobuf:"bytes,3,opt,name=controller,proto3" json:"controller,omitempty"`
 	// verification_material is the public key of the verification method.
-	// Commonly used verification material types: publicJwk, publicKeyBase58, publicKeyMultibase
+	// Commonly used verification material types: publicJwk, publicKeyBase58, publicKeyMultibase, blockchainAccountId
 	VerificationMaterial string `protobuf:"bytes,4,opt,name=verification_material,json=verificationMaterial,proto3" json:"verification_material,omitempty"`
 }
 
@@ -273,6 +284,10 @@ type Service struct {
 	Accept []string `protobuf:"bytes,6,rep,name=accept,proto3" json:"accept,omitempty"`
 	// priority: An integer defining the priority of this service entry.
 	Priority uint32 `protobuf:"varint,7,opt,name=priority,proto3" json:"priority,omitempty"`
+	// serviceEndpointMaps are map-style endpoints of the service, which are part of
+	// serviceEndpoint in the DID Core representation, following the string endpoints.
+	// Example: [{"uri": "https://example.com/didcomm", "accept": ["didcomm/v2"]}]
+	ServiceEndpointMaps []*ServiceEndpointMap `protobuf:"bytes,8,rep,name=service_endpoint_maps,json=serviceEndpointMaps,proto3" json:"serviceEndpointMaps,omitempty"`
 }
 
 func (m *Service) Reset()         { *m = Service{} }
@@ -357,6 +372,157 @@ func (m *Service) GetPriority() uint32 {
 	return 0
 }
 
+func (m *Service) GetServiceEndpointMaps() []*ServiceEndpointMap {
+	if m != nil {
+		return m.ServiceEndpointMaps
+	}
+	return nil
+}
+
+// ServiceEndpointMap defines a map-style service endpoint, as defined in the DID Core specification.
+// Documentation: https://www.w3.org/TR/did-core/#dfn-serviceendpoint
+type ServiceEndpointMap struct {
+	// uri is the URI of the endpoint, e.g. of a DIDComm v2 endpoint.
+	// Example: https://example.com/didcomm
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// accept is a list of MIME types or protocol formats the endpoint supports.
+	// Example: ["didcomm/v2"]
+	Accept []string `protobuf:"bytes,2,rep,name=accept,proto3" json:"accept,omitempty"`
+	// routingKeys is a list of mediator or relay keys of the endpoint.
+	// Format: did:cheqd:<namespace>:<unique-identifier>#<key-id> or did:key:<identifier>
+	RoutingKeys []string `protobuf:"bytes,3,rep,name=routing_keys,json=routingKeys,proto3" json:"routingKeys,omitempty"`
+	// origins is a list of web origins, e.g. of a LinkedDomains endpoint.
+	// Example: ["https://example.com"]
+	Origins []string `protobuf:"bytes,4,rep,name=origins,proto3" json:"origins,omitempty"`
+	// properties are other string properties of the endpoint, e.g. of a LinkedResourceMetadata endpoint.
+	// A list is used instead of a map to keep the encoding deterministic.
+	// Example: [{"key": "resourceType", "value": "String"}]
+	Properties []*ServiceEndpointProperty `protobuf:"bytes,5,rep,name=properties,proto3" json:"properties,omitempty"`
+}
+
+func (m *ServiceEndpointMap) Reset()         { *m = ServiceEndpointMap{} }
+func (m *ServiceEndpointMap) String() string { return proto.CompactTextString(m) }
+func (*ServiceEndpointMap) ProtoMessage()    {}
+func (*ServiceEndpointMap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7b058eff1719454, []int{3}
+}
+func (m *ServiceEndpointMap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServiceEndpointMap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ServiceEndpointMap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ServiceEndpointMap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceEndpointMap.Merge(m, src)
+}
+func (m *ServiceEndpointMap) XXX_Size() int {
+	return m.Size()
+}
+func (m *ServiceEndpointMap) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceEndpointMap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceEndpointMap proto.InternalMessageInfo
+
+func (m *ServiceEndpointMap) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+func (m *ServiceEndpointMap) GetAccept() []string {
+	if m != nil {
+		return m.Accept
+	}
+	return nil
+}
+
+func (m *ServiceEndpointMap) GetRoutingKeys() []string {
+	if m != nil {
+		return m.RoutingKeys
+	}
+	return nil
+}
+
+func (m *ServiceEndpointMap) GetOrigins() []string {
+	if m != nil {
+		return m.Origins
+	}
+	return nil
+}
+
+func (m *ServiceEndpointMap) GetProperties() []*ServiceEndpointProperty {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
+// ServiceEndpointProperty defines a string property of a map-style service endpoint.
+type ServiceEndpointProperty struct {
+	// key is the name of the property.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// value is the value of the property.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *ServiceEndpointProperty) Reset()         { *m = ServiceEndpointProperty{} }
+func (m *ServiceEndpointProperty) String() string { return proto.CompactTextString(m) }
+func (*ServiceEndpointProperty) ProtoMessage()    {}
+func (*ServiceEndpointProperty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7b058eff1719454, []int{4}
+}
+func (m *ServiceEndpointProperty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServiceEndpointProperty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ServiceEndpointProperty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ServiceEndpointProperty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceEndpointProperty.Merge(m, src)
+}
+func (m *ServiceEndpointProperty) XXX_Size() int {
+	return m.Size()
+}
+func (m *ServiceEndpointProperty) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceEndpointProperty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceEndpointProperty proto.InternalMessageInfo
+
+func (m *ServiceEndpointProperty) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ServiceEndpointProperty) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 // DidDocWithMetadata defines a DID Document with metadata, as defined in the DID Core specification.
 // Contains the DID Document, as well as DID Document metadata.
 type DidDocWithMetadata struct {
@@ -370,7 +536,7 @@ func (m *DidDocWithMetadata) Reset()         { *m = DidDocWithMetadata{} }
 func (m *DidDocWithMetadata) String() string { return proto.CompactTextString(m) }
 func (*DidDocWithMetadata) ProtoMessage()    {}
 func (*DidDocWithMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b7b058eff1719454, []int{3}
+	return fileDescriptor_b7b058eff1719454, []int{5}
 }
 func (m *DidDocWithMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -439,13 +605,18 @@ type Metadata struct {
 	// Format: UUID
 	// Example: 123e4567-e89b-12d3-a456-426655440000
 	PreviousVersionId string `protobuf:"bytes,6,opt,name=previous_version_id,json=previousVersionId,proto3" json:"previous_version_id,omitempty"`
+	// recovery_commitment is a commitment to a pre-rotated recovery key of the DID Document.
+	// It is the hex encoded SHA-256 hash of the recovery key type and verification material,
+	// which allows the DID Document to be recovered with Msg/RecoverDidDoc if its controller keys are lost.
+	// Format: <hex-encoded-sha256>
+	RecoveryCommitment string `protobuf:"bytes,7,opt,name=recovery_commitment,json=recoveryCommitment,proto3" json:"recovery_commitment,omitempty"`
 }
 
 func (m *Metadata) Reset()         { *m = Metadata{} }
 func (m *Metadata) String() string { return proto.CompactTextString(m) }
 func (*Metadata) ProtoMessage()    {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b7b058eff1719454, []int{4}
+	return fileDescriptor_b7b058eff1719454, []int{6}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -516,70 +687,245 @@ func (m *Metadata) GetPreviousVersionId() string {
 	return ""
 }
 
+func (m *Metadata) GetRecoveryCommitment() string {
+	if m != nil {
+		return m.RecoveryCommitment
+	}
+	return ""
+}
+
+// DidDocTombstone records the versions of a DID Document that were pruned according to the version retention policy.
+// The pruned versions form a hash chain, which allows auditing the version history against off-chain copies.
+type DidDocTombstone struct {
+	// id is the DID of the DID Document.
+	// Format: did:cheqd:<namespace>:<unique-identifier>
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// pruned_versions is the list of pruned versions in the order of the version chain.
+	PrunedVersions []*PrunedDidDocVersion `protobuf:"bytes,2,rep,name=pruned_versions,json=prunedVersions,proto3" json:"pruned_versions,omitempty"`
+	// chain_hash is the hash of the last pruned version in the hash chain.
+	// Format: <hex-encoded-sha256>
+	ChainHash string `protobuf:"bytes,3,opt,name=chain_hash,json=chainHash,proto3" json:"chain_hash,omitempty"`
+}
+
+func (m *DidDocTombstone) Reset()         { *m = DidDocTombstone{} }
+func (m *DidDocTombstone) String() string { return proto.CompactTextString(m) }
+func (*DidDocTombstone) ProtoMessage()    {}
+func (*DidDocTombstone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7b058eff1719454, []int{7}
+}
+func (m *DidDocTombstone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DidDocTombstone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DidDocTombstone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DidDocTombstone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DidDocTombstone.Merge(m, src)
+}
+func (m *DidDocTombstone) XXX_Size() int {
+	return m.Size()
+}
+func (m *DidDocTombstone) XXX_DiscardUnknown() {
+	xxx_messageInfo_DidDocTombstone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DidDocTombstone proto.InternalMessageInfo
+
+func (m *DidDocTombstone) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DidDocTombstone) GetPrunedVersions() []*PrunedDidDocVersion {
+	if m != nil {
+		return m.PrunedVersions
+	}
+	return nil
+}
+
+func (m *DidDocTombstone) GetChainHash() string {
+	if m != nil {
+		return m.ChainHash
+	}
+	return ""
+}
+
+// PrunedDidDocVersion defines a pruned version of a DID Document.
+type PrunedDidDocVersion struct {
+	// version_id is the version identifier of the pruned version.
+	// Format: UUID
+	VersionId string `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// active_from is the time the pruned version became active (created or updated time).
+	// Format: RFC3339
+	ActiveFrom time.Time `protobuf:"bytes,2,opt,name=active_from,json=activeFrom,proto3,stdtime" json:"active_from"`
+	// checksum is the SHA-256 hash of the protobuf encoded version (DidDocWithMetadata).
+	// Format: <hex-encoded-sha256>
+	Checksum string `protobuf:"bytes,3,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// chain_hash is the SHA-256 hash of the chain hash of the previous pruned version concatenated with the checksum.
+	// For the first pruned version the previous chain hash is empty.
+	// Format: <hex-encoded-sha256>
+	ChainHash string `protobuf:"bytes,4,opt,name=chain_hash,json=chainHash,proto3" json:"chain_hash,omitempty"`
+}
+
+func (m *PrunedDidDocVersion) Reset()         { *m = PrunedDidDocVersion{} }
+func (m *PrunedDidDocVersion) String() string { return proto.CompactTextString(m) }
+func (*PrunedDidDocVersion) ProtoMessage()    {}
+func (*PrunedDidDocVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7b058eff1719454, []int{8}
+}
+func (m *PrunedDidDocVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrunedDidDocVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrunedDidDocVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrunedDidDocVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrunedDidDocVersion.Merge(m, src)
+}
+func (m *PrunedDidDocVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrunedDidDocVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrunedDidDocVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrunedDidDocVersion proto.InternalMessageInfo
+
+func (m *PrunedDidDocVersion) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
+func (m *PrunedDidDocVersion) GetActiveFrom() time.Time {
+	if m != nil {
+		return m.ActiveFrom
+	}
+	return time.Time{}
+}
+
+func (m *PrunedDidDocVersion) GetChecksum() string {
+	if m != nil {
+		return m.Checksum
+	}
+	return ""
+}
+
+func (m *PrunedDidDocVersion) GetChainHash() string {
+	if m != nil {
+		return m.ChainHash
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*DidDoc)(nil), "cheqd.did.v2.DidDoc")
 	proto.RegisterType((*VerificationMethod)(nil), "cheqd.did.v2.VerificationMethod")
 	proto.RegisterType((*Service)(nil), "cheqd.did.v2.Service")
+	proto.RegisterType((*ServiceEndpointMap)(nil), "cheqd.did.v2.ServiceEndpointMap")
+	proto.RegisterType((*ServiceEndpointProperty)(nil), "cheqd.did.v2.ServiceEndpointProperty")
 	proto.RegisterType((*DidDocWithMetadata)(nil), "cheqd.did.v2.DidDocWithMetadata")
 	proto.RegisterType((*Metadata)(nil), "cheqd.did.v2.Metadata")
+	proto.RegisterType((*DidDocTombstone)(nil), "cheqd.did.v2.DidDocTombstone")
+	proto.RegisterType((*PrunedDidDocVersion)(nil), "cheqd.did.v2.PrunedDidDocVersion")
 }
 
 func init() { proto.RegisterFile("cheqd/did/v2/diddoc.proto", fileDescriptor_b7b058eff1719454) }
 
 var fileDescriptor_b7b058eff1719454 = []byte{
-	// 824 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcf, 0x6e, 0xe3, 0x44,
-	0x18, 0xaf, 0xd3, 0x6e, 0xfe, 0x7c, 0x6e, 0xda, 0x32, 0x4d, 0xbb, 0xde, 0x22, 0xe2, 0x28, 0x48,
-	0xd0, 0x95, 0x8a, 0x23, 0xa5, 0x70, 0x43, 0x48, 0xb5, 0xca, 0x61, 0x55, 0xf6, 0x80, 0x59, 0x2d,
-	0x12, 0x17, 0x6b, 0xea, 0xf9, 0x36, 0x19, 0x35, 0xf1, 0x18, 0x7b, 0x62, 0xea, 0xb7, 0xd8, 0x57,
-	0xe0, 0x09, 0x78, 0x0c, 0xf6, 0xb8, 0x47, 0x0e, 0x28, 0x8b, 0xda, 0x5b, 0x9e, 0x02, 0x79, 0x3c,
-	0x36, 0x4e, 0x82, 0x04, 0x97, 0xc4, 0xf3, 0xfb, 0xf3, 0xcd, 0xe8, 0xfb, 0x7e, 0x1e, 0xc3, 0xb3,
-	0x60, 0x8a, 0x3f, 0xb3, 0x11, 0xe3, 0x6c, 0x94, 0x8e, 0xf3, 0x3f, 0x26, 0x02, 0x27, 0x8a, 0x85,
-	0x14, 0x64, 0x5f, 0x51, 0x0e, 0xe3, 0xcc, 0x49, 0xc7, 0x67, 0xbd, 0x89, 0x98, 0x08, 0x45, 0x8c,
-	0xf2, 0xa7, 0x42, 0x73, 0x66, 0x4f, 0x84, 0x98, 0xcc, 0x70, 0xa4, 0x56, 0xb7, 0x8b, 0x37, 0x23,
-	0xc9, 0xe7, 0x98, 0x48, 0x3a, 0x8f, 0x0a, 0xc1, 0xf0, 0xcf, 0x5d, 0x68, 0x5e, 0x73, 0x76, 0x2d,
-	0x02, 0x62, 0x41, 0x2b, 0x10, 0xa1, 0xc4, 0x7b, 0x69, 0x19, 0x83, 0xdd, 0xf3, 0x8e, 0x57, 0x2e,
-	0xc9, 0x01, 0x34, 0x38, 0xb3, 0x1a, 0x03, 0xe3, 0xbc, 0xe3, 0x35, 0x38, 0x23, 0x7d, 0x80, 0x9c,
-	0x8a, 0xc5, 0x6c, 0x86, 0xb1, 0xb5, 0xab, 0xc4, 0x35, 0x84, 0x7c, 0x0f, 0xc7, 0x29, 0xc6, 0xfc,
-	0x0d, 0x0f, 0xa8, 0xe4, 0x22, 0xf4, 0xe7, 0x28, 0xa7, 0x82, 0x59, 0x7b, 0x83, 0xdd, 0x73, 0x73,
-	0x3c, 0x70, 0xea, 0xe7, 0x76, 0x5e, 0xd7, 0x84, 0x2f, 0x95, 0xce, 0x23, 0xe9, 0x16, 0x46, 0x3e,
-	0x83, 0x03, 0xba, 0x90, 0x53, 0x0c, 0xa5, 0xc6, 0xad, 0x27, 0x6a, 0xdb, 0x0d, 0x94, 0x3c, 0x87,
-	0x23, 0x9a, 0x24, 0x18, 0xd7, 0xf7, 0x6d, 0x2a, 0xe5, 0x61, 0x85, 0xeb, 0x92, 0x97, 0x70, 0x12,
-	0xd0, 0x88, 0xde, 0xf2, 0x19, 0x97, 0x99, 0xcf, 0xc3, 0x54, 0xe8, 0xca, 0x2d, 0xa5, 0xef, 0xfd,
-	0x43, 0xbe, 0xa8, 0xb8, 0x0d, 0x13, 0xc3, 0x19, 0x4e, 0x0a, 0x53, 0x7b, 0xd3, 0x74, 0x5d, 0x71,
-	0xe4, 0x53, 0xe8, 0xde, 0x61, 0xe6, 0xd3, 0x49, 0x8c, 0x38, 0xc7, 0x50, 0x5a, 0x1d, 0x25, 0xde,
-	0xbf, 0xc3, 0xec, 0xaa, 0xc4, 0xc8, 0x08, 0x5a, 0x09, 0xc6, 0x29, 0x0f, 0xd0, 0x02, 0xd5, 0xa8,
-	0x93, 0xf5, 0x46, 0xfd, 0x50, 0x90, 0x5e, 0xa9, 0x22, 0x43, 0xe8, 0xd2, 0x59, 0x22, 0xfc, 0xbb,
-	0x50, 0xfc, 0x12, 0xfa, 0x34, 0xb1, 0x4c, 0x55, 0xd5, 0xcc, 0xc1, 0x9b, 0x1c, 0xbb, 0x4a, 0x86,
-	0xbf, 0x1b, 0x40, 0xb6, 0x3b, 0xac, 0x07, 0x6a, 0x54, 0x03, 0xfd, 0x0e, 0xac, 0x7f, 0x19, 0x98,
-	0x2f, 0xb3, 0x08, 0x8b, 0xb1, 0xbb, 0x64, 0xb5, 0xb4, 0x0f, 0xf2, 0xf5, 0x85, 0x98, 0x73, 0x89,
-	0xf3, 0x48, 0x66, 0xde, 0xe9, 0xf6, 0x9c, 0x5e, 0x65, 0x11, 0x6e, 0xc5, 0xc3, 0xd8, 0x88, 0xc7,
-	0x25, 0x9c, 0xac, 0xef, 0x46, 0x25, 0xc6, 0x9c, 0xce, 0xac, 0x3d, 0x25, 0xed, 0xad, 0x95, 0xd5,
-	0xdc, 0xf0, 0x43, 0x03, 0x5a, 0xba, 0x05, 0x5b, 0xc7, 0xff, 0x0a, 0xf6, 0x75, 0x53, 0xfe, 0xeb,
-	0xc8, 0xa6, 0xd6, 0xa9, 0x73, 0x3e, 0x87, 0xa3, 0xd2, 0x86, 0x21, 0x8b, 0x04, 0x0f, 0xa5, 0x0e,
-	0xf3, 0xa1, 0xc6, 0xbf, 0xd5, 0x30, 0x71, 0xe1, 0x20, 0xc6, 0x80, 0x47, 0x1c, 0x43, 0xe9, 0xdf,
-	0x61, 0x96, 0xa8, 0x30, 0x77, 0xdc, 0x8f, 0x57, 0x4b, 0xfb, 0x69, 0xc5, 0xdc, 0x60, 0x96, 0xd4,
-	0x36, 0xeb, 0xae, 0x11, 0xe4, 0x6b, 0xd8, 0x8f, 0xc5, 0x42, 0xf2, 0x70, 0x52, 0x54, 0x50, 0x01,
-	0x76, 0x9f, 0xad, 0x96, 0xf6, 0x89, 0xc6, 0x37, 0xfc, 0x66, 0x0d, 0x26, 0x17, 0xd0, 0xa4, 0x41,
-	0x80, 0x91, 0x2c, 0xe2, 0xec, 0xf6, 0x56, 0x4b, 0xfb, 0xa8, 0x40, 0x6a, 0x16, 0xad, 0x21, 0x63,
-	0x68, 0x47, 0x31, 0x17, 0x31, 0x97, 0x99, 0xd5, 0x1a, 0x18, 0xe7, 0x5d, 0xf7, 0x74, 0xb5, 0xb4,
-	0x49, 0x89, 0xd5, 0x1c, 0x95, 0x6e, 0xf8, 0xab, 0x01, 0xa4, 0xb8, 0x0a, 0x7e, 0xe4, 0x72, 0xfa,
-	0x12, 0x25, 0x65, 0x54, 0x52, 0xf2, 0x0d, 0xb4, 0x18, 0x67, 0x3e, 0x13, 0x81, 0xea, 0xb8, 0x39,
-	0xee, 0xad, 0xe7, 0xb2, 0xb0, 0xb8, 0x87, 0xab, 0xa5, 0x6d, 0x32, 0xf5, 0xbc, 0xc8, 0xf3, 0xec,
-	0x35, 0x8b, 0x05, 0xb9, 0x81, 0xf6, 0x5c, 0xd7, 0x52, 0x83, 0x31, 0xc7, 0xa7, 0xeb, 0x05, 0xca,
-	0x9d, 0xdc, 0xa7, 0xab, 0xa5, 0x7d, 0x5c, 0x2b, 0x51, 0x12, 0x5e, 0x55, 0x60, 0xf8, 0x5b, 0x03,
-	0xda, 0xf5, 0x93, 0x05, 0x31, 0x52, 0x89, 0x4c, 0x9f, 0xec, 0xcc, 0x29, 0xae, 0x3b, 0xa7, 0xbc,
-	0xee, 0x9c, 0x57, 0xe5, 0x75, 0xe7, 0xb6, 0xdf, 0x2d, 0xed, 0x9d, 0xb7, 0x1f, 0x6c, 0xc3, 0x2b,
-	0x4d, 0xb9, 0x7f, 0x11, 0x31, 0xe5, 0x6f, 0xfc, 0x2f, 0xbf, 0x51, 0xf8, 0xb5, 0x89, 0x0c, 0xc0,
-	0x64, 0x48, 0x03, 0xc9, 0x53, 0x55, 0x23, 0x0f, 0x7a, 0xdb, 0xab, 0x43, 0xe4, 0x13, 0x80, 0x14,
-	0xe3, 0x24, 0x0f, 0x39, 0x67, 0x3a, 0xde, 0x1d, 0x8d, 0xbc, 0x60, 0xe4, 0x02, 0x0e, 0x43, 0xbc,
-	0x97, 0x7e, 0x4d, 0xf3, 0x44, 0x45, 0x77, 0x2f, 0xdf, 0xcc, 0xeb, 0xe6, 0xe4, 0xeb, 0x4a, 0xfd,
-	0x25, 0x1c, 0x47, 0x31, 0xa6, 0x5c, 0x2c, 0x92, 0xba, 0xa3, 0x59, 0x73, 0x7c, 0x54, 0x0a, 0x2a,
-	0x97, 0x7b, 0xf5, 0xee, 0xa1, 0x6f, 0xbc, 0x7f, 0xe8, 0x1b, 0x7f, 0x3d, 0xf4, 0x8d, 0xb7, 0x8f,
-	0xfd, 0x9d, 0xf7, 0x8f, 0xfd, 0x9d, 0x3f, 0x1e, 0xfb, 0x3b, 0x3f, 0x7d, 0x3e, 0xe1, 0x72, 0xba,
-	0xb8, 0x75, 0x02, 0x31, 0x1f, 0x15, 0x5f, 0x19, 0xf5, 0xfb, 0x45, 0x28, 0x18, 0x8e, 0xee, 0xd5,
-	0x27, 0x27, 0x7f, 0x7b, 0x92, 0xdb, 0xa6, 0x6a, 0xc7, 0xe5, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xff, 0x9f, 0x48, 0xd3, 0x8c, 0x06, 0x00, 0x00,
+	// 1135 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xd1, 0x8e, 0xd3, 0x46,
+	0x17, 0x5e, 0x27, 0xcb, 0x26, 0x7b, 0xbc, 0xbb, 0x59, 0x26, 0x09, 0x98, 0xfd, 0x45, 0x1c, 0x82,
+	0xfe, 0x76, 0x91, 0x68, 0xa2, 0x86, 0x72, 0x57, 0x55, 0x5a, 0x17, 0xaa, 0x52, 0x8a, 0x44, 0xcd,
+	0x8a, 0x4a, 0xbd, 0xb1, 0xbc, 0x9e, 0x21, 0x1e, 0x25, 0xf6, 0xb8, 0xe3, 0x71, 0xc0, 0xef, 0x50,
+	0xa9, 0xbc, 0x42, 0x5f, 0xa2, 0x7d, 0x84, 0x72, 0xc9, 0x25, 0x57, 0x6e, 0x05, 0x52, 0x2f, 0xf2,
+	0x14, 0x95, 0xc7, 0xe3, 0xac, 0x93, 0x6c, 0x5b, 0xb8, 0x01, 0xcf, 0x77, 0xbe, 0xef, 0x9b, 0xb3,
+	0xe7, 0x9c, 0x99, 0x09, 0x5c, 0xf3, 0x7c, 0xf2, 0x23, 0x1e, 0x61, 0x8a, 0x47, 0xf3, 0x71, 0xfe,
+	0x1f, 0x66, 0xde, 0x30, 0xe2, 0x4c, 0x30, 0xb4, 0x27, 0x43, 0x43, 0x4c, 0xf1, 0x70, 0x3e, 0x3e,
+	0xea, 0x4c, 0xd8, 0x84, 0xc9, 0xc0, 0x28, 0xff, 0x2a, 0x38, 0x47, 0xe6, 0x84, 0xb1, 0xc9, 0x8c,
+	0x8c, 0xe4, 0xea, 0x2c, 0x79, 0x36, 0x12, 0x34, 0x20, 0xb1, 0x70, 0x83, 0xa8, 0x20, 0x0c, 0x7e,
+	0xde, 0x86, 0x9d, 0x7b, 0x14, 0xdf, 0x63, 0x1e, 0x32, 0xa0, 0xe1, 0xb1, 0x50, 0x90, 0x17, 0xc2,
+	0xd0, 0xfa, 0xf5, 0xe3, 0x5d, 0xbb, 0x5c, 0xa2, 0x03, 0xa8, 0x51, 0x6c, 0xd4, 0xfa, 0xda, 0xf1,
+	0xae, 0x5d, 0xa3, 0x18, 0xf5, 0x00, 0xf2, 0x10, 0x67, 0xb3, 0x19, 0xe1, 0x46, 0x5d, 0x92, 0x2b,
+	0x08, 0xfa, 0x0e, 0xda, 0x73, 0xc2, 0xe9, 0x33, 0xea, 0xb9, 0x82, 0xb2, 0xd0, 0x09, 0x88, 0xf0,
+	0x19, 0x36, 0xb6, 0xfb, 0xf5, 0x63, 0x7d, 0xdc, 0x1f, 0x56, 0xf3, 0x1e, 0x3e, 0xad, 0x10, 0x1f,
+	0x49, 0x9e, 0x8d, 0xe6, 0x1b, 0x18, 0xfa, 0x08, 0x0e, 0xdc, 0x44, 0xf8, 0x24, 0x14, 0x0a, 0x37,
+	0x2e, 0xc9, 0x6d, 0xd7, 0x50, 0x74, 0x0b, 0x0e, 0xdd, 0x38, 0x26, 0xbc, 0xba, 0xef, 0x8e, 0x64,
+	0xb6, 0x96, 0xb8, 0xb2, 0xbc, 0x03, 0x5d, 0xcf, 0x8d, 0xdc, 0x33, 0x3a, 0xa3, 0x22, 0x75, 0x68,
+	0x38, 0x67, 0xca, 0xb9, 0x21, 0xf9, 0x9d, 0xf3, 0xe0, 0x83, 0x65, 0x6c, 0x4d, 0x84, 0xc9, 0x8c,
+	0x4c, 0x0a, 0x51, 0x73, 0x5d, 0x74, 0x6f, 0x19, 0x43, 0x37, 0x61, 0x7f, 0x4a, 0x52, 0xc7, 0x9d,
+	0x70, 0x42, 0x02, 0x12, 0x0a, 0x63, 0x57, 0x92, 0xf7, 0xa6, 0x24, 0x3d, 0x29, 0x31, 0x34, 0x82,
+	0x46, 0x4c, 0xf8, 0x9c, 0x7a, 0xc4, 0x00, 0x59, 0xa8, 0xee, 0x6a, 0xa1, 0x9e, 0x14, 0x41, 0xbb,
+	0x64, 0xa1, 0x01, 0xec, 0xbb, 0xb3, 0x98, 0x39, 0xd3, 0x90, 0x3d, 0x0f, 0x1d, 0x37, 0x36, 0x74,
+	0xe9, 0xaa, 0xe7, 0xe0, 0xc3, 0x1c, 0x3b, 0x89, 0xd1, 0xa7, 0xd0, 0x39, 0xef, 0x8b, 0x23, 0x7c,
+	0x4e, 0x62, 0x9f, 0xcd, 0xb0, 0xb1, 0xd7, 0xd7, 0x8e, 0xf7, 0xed, 0xf6, 0x79, 0xec, 0xb4, 0x0c,
+	0x0d, 0x7e, 0xd7, 0x00, 0x6d, 0x36, 0x45, 0xcd, 0x80, 0xb6, 0x9c, 0x81, 0x6f, 0xc1, 0xb8, 0xa0,
+	0xc7, 0x8e, 0x48, 0x23, 0x52, 0x4c, 0x8a, 0x85, 0x16, 0x99, 0x79, 0x90, 0xaf, 0x6f, 0xb3, 0x80,
+	0x0a, 0x12, 0x44, 0x22, 0xb5, 0xaf, 0x6c, 0xb6, 0xf6, 0x34, 0x8d, 0xc8, 0xc6, 0x44, 0x69, 0x6b,
+	0x13, 0x75, 0x07, 0xba, 0xab, 0xbb, 0xb9, 0x82, 0x70, 0xea, 0xce, 0x8c, 0x6d, 0x49, 0xed, 0xac,
+	0xd8, 0xaa, 0xd8, 0xe0, 0x4d, 0x1d, 0x1a, 0xaa, 0x6a, 0x1b, 0xe9, 0xdf, 0x85, 0x3d, 0x55, 0xc7,
+	0xff, 0x4a, 0x59, 0x57, 0x3c, 0x99, 0xe7, 0x2d, 0x38, 0x2c, 0x65, 0x24, 0xc4, 0x11, 0xa3, 0xa1,
+	0x50, 0xf3, 0xdf, 0x52, 0xf8, 0x7d, 0x05, 0x23, 0x0b, 0x0e, 0x38, 0xf1, 0x68, 0x44, 0x49, 0x28,
+	0x9c, 0x29, 0x49, 0x63, 0x39, 0xff, 0xbb, 0xd6, 0xff, 0x16, 0x99, 0x79, 0x75, 0x19, 0x79, 0x48,
+	0xd2, 0xb8, 0xb2, 0xd9, 0xfe, 0x4a, 0x00, 0x7d, 0x0e, 0x7b, 0x9c, 0x25, 0x82, 0x86, 0x93, 0xc2,
+	0x41, 0xce, 0xbc, 0x75, 0x6d, 0x91, 0x99, 0x5d, 0x85, 0xaf, 0xe9, 0xf5, 0x0a, 0x8c, 0x6e, 0xc3,
+	0x8e, 0xeb, 0x79, 0x24, 0x12, 0xc5, 0x09, 0xb0, 0x3a, 0x8b, 0xcc, 0x3c, 0x2c, 0x90, 0x8a, 0x44,
+	0x71, 0xd0, 0x18, 0x9a, 0x11, 0xa7, 0x8c, 0x53, 0x91, 0x1a, 0x8d, 0x7c, 0x3c, 0xac, 0x2b, 0x8b,
+	0xcc, 0x44, 0x25, 0x56, 0x51, 0x2c, 0x79, 0xe8, 0x39, 0x74, 0xd7, 0xcb, 0xe1, 0x04, 0x6e, 0x14,
+	0x1b, 0xcd, 0x8b, 0x8e, 0xfa, 0x93, 0xd5, 0x0a, 0x3d, 0x72, 0x23, 0xeb, 0xc6, 0x22, 0x33, 0xaf,
+	0xc7, 0x1b, 0x78, 0xf5, 0x4f, 0x6a, 0x5f, 0x10, 0x1e, 0xfc, 0x56, 0x03, 0xb4, 0x69, 0x87, 0x6e,
+	0x42, 0x3d, 0xe1, 0xb4, 0x68, 0xb3, 0x75, 0x79, 0x91, 0x99, 0xfb, 0x09, 0xa7, 0x15, 0xaf, 0x3c,
+	0x5a, 0x29, 0x4b, 0xed, 0x3d, 0xca, 0xb2, 0xde, 0x82, 0xfa, 0x07, 0xb5, 0x60, 0x04, 0x0d, 0xc6,
+	0xe9, 0x84, 0x86, 0x65, 0xf7, 0xbb, 0x8b, 0xcc, 0xbc, 0xac, 0xa0, 0x8a, 0xa8, 0x64, 0x21, 0x07,
+	0x20, 0xe2, 0x2c, 0xca, 0x2f, 0x2a, 0x52, 0xf4, 0x5b, 0x1f, 0xff, 0xff, 0x5f, 0xcb, 0xf8, 0xb8,
+	0xa0, 0xa7, 0x96, 0xb1, 0xc8, 0xcc, 0xce, 0xb9, 0xb8, 0xe2, 0x5e, 0xb1, 0x1c, 0x9c, 0xc0, 0xd5,
+	0x7f, 0x30, 0x40, 0x87, 0x50, 0x9f, 0x92, 0x54, 0x1d, 0x92, 0xfc, 0x13, 0x75, 0xe0, 0xd2, 0xdc,
+	0x9d, 0x25, 0xea, 0x78, 0xd8, 0xc5, 0x62, 0xf0, 0x8b, 0x06, 0xa8, 0x78, 0x33, 0xbe, 0xa7, 0xc2,
+	0x7f, 0x44, 0x84, 0x8b, 0x5d, 0xe1, 0xa2, 0x2f, 0xa0, 0x81, 0x29, 0x76, 0x30, 0xf3, 0xa4, 0x85,
+	0x3e, 0xee, 0xac, 0xe6, 0x5d, 0x48, 0xac, 0xd6, 0x22, 0x33, 0x75, 0x2c, 0xbf, 0x93, 0xfc, 0xe2,
+	0xb3, 0x77, 0x8a, 0x05, 0x7a, 0x08, 0xcd, 0x40, 0x79, 0xc9, 0xfd, 0xf4, 0xf1, 0x95, 0x55, 0x83,
+	0x72, 0x27, 0xeb, 0xea, 0x22, 0x33, 0xdb, 0x15, 0x8b, 0x32, 0x60, 0x2f, 0x0d, 0x06, 0x7f, 0xd5,
+	0xa0, 0x59, 0xcd, 0xcc, 0xe3, 0xc4, 0x15, 0x04, 0xab, 0xcc, 0x8e, 0x86, 0xc5, 0xbb, 0x38, 0x2c,
+	0xdf, 0xc5, 0xe1, 0x69, 0xf9, 0x2e, 0x5a, 0xcd, 0x57, 0x99, 0xb9, 0xf5, 0xf2, 0x0f, 0x53, 0xb3,
+	0x4b, 0x51, 0xae, 0x4f, 0x22, 0x2c, 0xf5, 0xb5, 0xf7, 0xd2, 0x6b, 0x85, 0x5e, 0x89, 0x50, 0x1f,
+	0x74, 0x4c, 0x5c, 0x4f, 0xd0, 0xb9, 0xf4, 0xc8, 0xaf, 0xb7, 0xa6, 0x5d, 0x85, 0xd0, 0x75, 0x80,
+	0x39, 0xe1, 0x71, 0x7e, 0xb5, 0x51, 0xac, 0x2e, 0xb5, 0x5d, 0x85, 0x3c, 0xc0, 0xe8, 0x36, 0xb4,
+	0x42, 0xf2, 0x42, 0x38, 0x15, 0xce, 0x25, 0x39, 0xe3, 0xdb, 0xf9, 0x66, 0xf6, 0x7e, 0x1e, 0x7c,
+	0xba, 0x64, 0x7f, 0x06, 0xed, 0x88, 0x93, 0x39, 0x65, 0x49, 0x5c, 0x55, 0xec, 0x54, 0x14, 0x97,
+	0x4b, 0xc2, 0xb9, 0xea, 0x2e, 0xb4, 0x39, 0xf1, 0xd8, 0x9c, 0xf0, 0xd4, 0xf1, 0x58, 0x10, 0x50,
+	0x21, 0x9f, 0xaa, 0x46, 0x45, 0x85, 0x4a, 0xc2, 0x97, 0xcb, 0xf8, 0xe0, 0x27, 0x0d, 0x5a, 0x45,
+	0x67, 0x4f, 0x59, 0x70, 0x16, 0x0b, 0x16, 0x6e, 0x5e, 0xb6, 0xdf, 0x40, 0x2b, 0xe2, 0x49, 0x48,
+	0x70, 0x99, 0x4e, 0x2c, 0x8f, 0x9e, 0x3e, 0xbe, 0xb1, 0xda, 0xe0, 0xc7, 0x92, 0x54, 0xb8, 0xa9,
+	0xc4, 0xec, 0x83, 0x42, 0xa9, 0x96, 0x71, 0x5e, 0x29, 0xcf, 0x77, 0x69, 0xe8, 0xf8, 0x6e, 0xec,
+	0xab, 0x97, 0x62, 0x57, 0x22, 0x5f, 0xbb, 0xb1, 0x3f, 0xf8, 0x55, 0x83, 0xf6, 0x05, 0x36, 0x6b,
+	0x05, 0xd6, 0xd6, 0x0b, 0x7c, 0x1f, 0x74, 0xd9, 0x0c, 0xe2, 0x3c, 0xe3, 0x2c, 0x30, 0x6a, 0x1f,
+	0x30, 0x25, 0x50, 0x08, 0xbf, 0xe2, 0x2c, 0x40, 0x47, 0xd0, 0xf4, 0x7c, 0xe2, 0x4d, 0xe3, 0x24,
+	0x50, 0xa9, 0x2d, 0xd7, 0x6b, 0x89, 0x6f, 0xaf, 0x25, 0x6e, 0x9d, 0xbc, 0x7a, 0xdb, 0xd3, 0x5e,
+	0xbf, 0xed, 0x69, 0x7f, 0xbe, 0xed, 0x69, 0x2f, 0xdf, 0xf5, 0xb6, 0x5e, 0xbf, 0xeb, 0x6d, 0xbd,
+	0x79, 0xd7, 0xdb, 0xfa, 0xe1, 0xe3, 0x09, 0x15, 0x7e, 0x72, 0x36, 0xf4, 0x58, 0x30, 0x2a, 0x7e,
+	0x0d, 0xca, 0x7f, 0x3f, 0x09, 0x19, 0x26, 0xa3, 0x17, 0xf2, 0xa7, 0x61, 0xfe, 0x64, 0xc5, 0x67,
+	0x3b, 0x32, 0xcf, 0x3b, 0x7f, 0x0f, 0x00, 0x63, 0x86, 0xe8, 0x76, 0x34, 0x0a, 0x00, 0x00,
 }
 
 func (m *DidDoc) Marshal() (dAtA []byte, err error) {
@@ -602,6 +948,11 @@ func (m *DidDoc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ControllerThreshold != 0 {
+		i = encodeVarintDiddoc(dAtA, i, uint64(m.ControllerThreshold))
+		i--
+		dAtA[i] = 0x60
+	}
 	if len(m.AlsoKnownAs) > 0 {
 		for iNdEx := len(m.AlsoKnownAs) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AlsoKnownAs[iNdEx])
@@ -783,6 +1134,20 @@ func (m *Service) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ServiceEndpointMaps) > 0 {
+		for iNdEx := len(m.ServiceEndpointMaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ServiceEndpointMaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDiddoc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.Priority != 0 {
 		i = encodeVarintDiddoc(dAtA, i, uint64(m.Priority))
 		i--
@@ -841,7 +1206,7 @@ func (m *Service) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DidDocWithMetadata) Marshal() (dAtA []byte, err error) {
+func (m *ServiceEndpointMap) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -851,44 +1216,68 @@ func (m *DidDocWithMetadata) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DidDocWithMetadata) MarshalTo(dAtA []byte) (int, error) {
+func (m *ServiceEndpointMap) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DidDocWithMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ServiceEndpointMap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Metadata != nil {
-		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Properties) > 0 {
+		for iNdEx := len(m.Properties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Properties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDiddoc(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintDiddoc(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x2a
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if m.DidDoc != nil {
-		{
-			size, err := m.DidDoc.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDiddoc(dAtA, i, uint64(size))
+	if len(m.Origins) > 0 {
+		for iNdEx := len(m.Origins) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Origins[iNdEx])
+			copy(dAtA[i:], m.Origins[iNdEx])
+			i = encodeVarintDiddoc(dAtA, i, uint64(len(m.Origins[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RoutingKeys) > 0 {
+		for iNdEx := len(m.RoutingKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RoutingKeys[iNdEx])
+			copy(dAtA[i:], m.RoutingKeys[iNdEx])
+			i = encodeVarintDiddoc(dAtA, i, uint64(len(m.RoutingKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Accept) > 0 {
+		for iNdEx := len(m.Accept) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Accept[iNdEx])
+			copy(dAtA[i:], m.Accept[iNdEx])
+			i = encodeVarintDiddoc(dAtA, i, uint64(len(m.Accept[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
+	}
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintDiddoc(dAtA, i, uint64(len(m.Uri)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Metadata) Marshal() (dAtA []byte, err error) {
+func (m *ServiceEndpointProperty) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -898,33 +1287,124 @@ func (m *Metadata) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
+func (m *ServiceEndpointProperty) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ServiceEndpointProperty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PreviousVersionId) > 0 {
-		i -= len(m.PreviousVersionId)
-		copy(dAtA[i:], m.PreviousVersionId)
-		i = encodeVarintDiddoc(dAtA, i, uint64(len(m.PreviousVersionId)))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintDiddoc(dAtA, i, uint64(len(m.Value)))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x12
 	}
-	if len(m.NextVersionId) > 0 {
-		i -= len(m.NextVersionId)
-		copy(dAtA[i:], m.NextVersionId)
-		i = encodeVarintDiddoc(dAtA, i, uint64(len(m.NextVersionId)))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintDiddoc(dAtA, i, uint64(len(m.Key)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0xa
 	}
-	if len(m.VersionId) > 0 {
-		i -= len(m.VersionId)
-		copy(dAtA[i:], m.VersionId)
+	return len(dAtA) - i, nil
+}
+
+func (m *DidDocWithMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DidDocWithMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DidDocWithMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDiddoc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DidDoc != nil {
+		{
+			size, err := m.DidDoc.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDiddoc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Metadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecoveryCommitment) > 0 {
+		i -= len(m.RecoveryCommitment)
+		copy(dAtA[i:], m.RecoveryCommitment)
+		i = encodeVarintDiddoc(dAtA, i, uint64(len(m.RecoveryCommitment)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.PreviousVersionId) > 0 {
+		i -= len(m.PreviousVersionId)
+		copy(dAtA[i:], m.PreviousVersionId)
+		i = encodeVarintDiddoc(dAtA, i, uint64(len(m.PreviousVersionId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.NextVersionId) > 0 {
+		i -= len(m.NextVersionId)
+		copy(dAtA[i:], m.NextVersionId)
+		i = encodeVarintDiddoc(dAtA, i, uint64(len(m.NextVersionId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.VersionId) > 0 {
+		i -= len(m.VersionId)
+		copy(dAtA[i:], m.VersionId)
 		i = encodeVarintDiddoc(dAtA, i, uint64(len(m.VersionId)))
 		i--
 		dAtA[i] = 0x22
@@ -960,6 +1440,109 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DidDocTombstone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DidDocTombstone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DidDocTombstone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainHash) > 0 {
+		i -= len(m.ChainHash)
+		copy(dAtA[i:], m.ChainHash)
+		i = encodeVarintDiddoc(dAtA, i, uint64(len(m.ChainHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PrunedVersions) > 0 {
+		for iNdEx := len(m.PrunedVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PrunedVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDiddoc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintDiddoc(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrunedDidDocVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrunedDidDocVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrunedDidDocVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainHash) > 0 {
+		i -= len(m.ChainHash)
+		copy(dAtA[i:], m.ChainHash)
+		i = encodeVarintDiddoc(dAtA, i, uint64(len(m.ChainHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintDiddoc(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ActiveFrom, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ActiveFrom):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintDiddoc(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	if len(m.VersionId) > 0 {
+		i -= len(m.VersionId)
+		copy(dAtA[i:], m.VersionId)
+		i = encodeVarintDiddoc(dAtA, i, uint64(len(m.VersionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDiddoc(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDiddoc(v)
 	base := offset
@@ -1041,6 +1624,9 @@ func (m *DidDoc) Size() (n int) {
 			n += 1 + l + sovDiddoc(uint64(l))
 		}
 	}
+	if m.ControllerThreshold != 0 {
+		n += 1 + sovDiddoc(uint64(m.ControllerThreshold))
+	}
 	return n
 }
 
@@ -1110,6 +1696,66 @@ func (m *Service) Size() (n int) {
 	if m.Priority != 0 {
 		n += 1 + sovDiddoc(uint64(m.Priority))
 	}
+	if len(m.ServiceEndpointMaps) > 0 {
+		for _, e := range m.ServiceEndpointMaps {
+			l = e.Size()
+			n += 1 + l + sovDiddoc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ServiceEndpointMap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovDiddoc(uint64(l))
+	}
+	if len(m.Accept) > 0 {
+		for _, s := range m.Accept {
+			l = len(s)
+			n += 1 + l + sovDiddoc(uint64(l))
+		}
+	}
+	if len(m.RoutingKeys) > 0 {
+		for _, s := range m.RoutingKeys {
+			l = len(s)
+			n += 1 + l + sovDiddoc(uint64(l))
+		}
+	}
+	if len(m.Origins) > 0 {
+		for _, s := range m.Origins {
+			l = len(s)
+			n += 1 + l + sovDiddoc(uint64(l))
+		}
+	}
+	if len(m.Properties) > 0 {
+		for _, e := range m.Properties {
+			l = e.Size()
+			n += 1 + l + sovDiddoc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ServiceEndpointProperty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDiddoc(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovDiddoc(uint64(l))
+	}
 	return n
 }
 
@@ -1157,6 +1803,56 @@ func (m *Metadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDiddoc(uint64(l))
 	}
+	l = len(m.RecoveryCommitment)
+	if l > 0 {
+		n += 1 + l + sovDiddoc(uint64(l))
+	}
+	return n
+}
+
+func (m *DidDocTombstone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovDiddoc(uint64(l))
+	}
+	if len(m.PrunedVersions) > 0 {
+		for _, e := range m.PrunedVersions {
+			l = e.Size()
+			n += 1 + l + sovDiddoc(uint64(l))
+		}
+	}
+	l = len(m.ChainHash)
+	if l > 0 {
+		n += 1 + l + sovDiddoc(uint64(l))
+	}
+	return n
+}
+
+func (m *PrunedDidDocVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VersionId)
+	if l > 0 {
+		n += 1 + l + sovDiddoc(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ActiveFrom)
+	n += 1 + l + sovDiddoc(uint64(l))
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovDiddoc(uint64(l))
+	}
+	l = len(m.ChainHash)
+	if l > 0 {
+		n += 1 + l + sovDiddoc(uint64(l))
+	}
 	return n
 }
 
@@ -1551,7 +2247,26 @@ func (m *DidDoc) Unmarshal(dAtA []byte) error {
 			}
 			m.AlsoKnownAs = append(m.AlsoKnownAs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerThreshold", wireType)
+			}
+			m.ControllerThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiddoc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ControllerThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
 			iNdEx = preIndex
 			skippy, err := skipDiddoc(dAtA[iNdEx:])
 			if err != nil {
@@ -1990,95 +2705,9 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDiddoc(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDiddoc
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DidDocWithMetadata) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDiddoc
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DidDocWithMetadata: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DidDocWithMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DidDoc", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDiddoc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthDiddoc
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDiddoc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DidDoc == nil {
-				m.DidDoc = &DidDoc{}
-			}
-			if err := m.DidDoc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceEndpointMaps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2105,10 +2734,8 @@ func (m *DidDocWithMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &Metadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ServiceEndpointMaps = append(m.ServiceEndpointMaps, &ServiceEndpointMap{})
+			if err := m.ServiceEndpointMaps[len(m.ServiceEndpointMaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2133,7 +2760,7 @@ func (m *DidDocWithMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Metadata) Unmarshal(dAtA []byte) error {
+func (m *ServiceEndpointMap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2156,17 +2783,17 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: ServiceEndpointMap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ServiceEndpointMap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDiddoc
@@ -2176,30 +2803,29 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDiddoc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDiddoc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Uri = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accept", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDiddoc
@@ -2209,33 +2835,29 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDiddoc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDiddoc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Updated == nil {
-				m.Updated = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Updated, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Accept = append(m.Accept, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deactivated", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutingKeys", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDiddoc
@@ -2245,15 +2867,27 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Deactivated = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoutingKeys = append(m.RoutingKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Origins", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2281,11 +2915,95 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VersionId = string(dAtA[iNdEx:postIndex])
+			m.Origins = append(m.Origins, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextVersionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Properties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiddoc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Properties = append(m.Properties, &ServiceEndpointProperty{})
+			if err := m.Properties[len(m.Properties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDiddoc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServiceEndpointProperty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDiddoc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceEndpointProperty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceEndpointProperty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2313,11 +3031,11 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextVersionId = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousVersionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2345,7 +3063,723 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousVersionId = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDiddoc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DidDocWithMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDiddoc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DidDocWithMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DidDocWithMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDoc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiddoc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DidDoc == nil {
+				m.DidDoc = &DidDoc{}
+			}
+			if err := m.DidDoc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiddoc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDiddoc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Metadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDiddoc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiddoc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiddoc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Updated == nil {
+				m.Updated = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Updated, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deactivated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiddoc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deactivated = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiddoc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextVersionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiddoc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextVersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousVersionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiddoc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousVersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryCommitment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiddoc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecoveryCommitment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDiddoc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DidDocTombstone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDiddoc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DidDocTombstone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DidDocTombstone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiddoc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrunedVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiddoc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrunedVersions = append(m.PrunedVersions, &PrunedDidDocVersion{})
+			if err := m.PrunedVersions[len(m.PrunedVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiddoc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDiddoc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrunedDidDocVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDiddoc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrunedDidDocVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrunedDidDocVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiddoc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiddoc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ActiveFrom, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiddoc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiddoc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
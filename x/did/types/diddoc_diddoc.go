@@ -72,6 +72,17 @@ func (didDoc *DidDoc) GetControllersOrSubject() []string {
 	return result
 }
 
+// RequiredControllerSignatures returns the number of controllers that must sign on behalf of the DID subject.
+// All controllers are required if the controller threshold is not set.
+func (didDoc *DidDoc) RequiredControllerSignatures() int {
+	controllers := len(didDoc.GetControllersOrSubject())
+	if didDoc.ControllerThreshold == 0 || int(didDoc.ControllerThreshold) > controllers {
+		return controllers
+	}
+
+	return int(didDoc.ControllerThreshold)
+}
+
 func (didDoc *DidDoc) GetVerificationMethodControllers() []string {
 	result := make([]string, 0, len(didDoc.VerificationMethod))
 
@@ -84,10 +95,22 @@ func (didDoc *DidDoc) GetVerificationMethodControllers() []string {
 
 // Validation
 
+// ValidateServiceEndpointURIs checks that the service endpoints are absolute URIs with the allowed schemes
+func (didDoc DidDoc) ValidateServiceEndpointURIs(allowedSchemes []string) error {
+	for _, service := range didDoc.Service {
+		if err := service.ValidateServiceEndpointURIs(allowedSchemes); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (didDoc DidDoc) Validate(allowedNamespaces []string) error {
 	return validation.ValidateStruct(&didDoc,
 		validation.Field(&didDoc.Id, validation.Required, IsDID(allowedNamespaces)),
 		validation.Field(&didDoc.Controller, IsUniqueStrList(), validation.Each(IsDID(allowedNamespaces))),
+		validation.Field(&didDoc.ControllerThreshold, validation.Max(uint32(len(didDoc.GetControllersOrSubject())))),
 		validation.Field(&didDoc.VerificationMethod,
 			IsUniqueVerificationMethodListByIDRule(), validation.Each(ValidVerificationMethodRule(didDoc.Id, allowedNamespaces)),
 		),
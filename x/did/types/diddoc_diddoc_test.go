@@ -468,4 +468,36 @@ var _ = DescribeTable("DIDDoc Validation tests", func(testCase DIDDocTestCase) {
 			isValid:  false,
 			errorMsg: "assertionMethod should be a unique inline key fragment definition",
 		}),
+	Entry(
+		"Controller threshold is within the number of controllers",
+		DIDDocTestCase{
+			didDoc: &DidDoc{
+				Id:                  ValidTestDID,
+				Controller:          []string{ValidTestDID, ValidTestDID2},
+				ControllerThreshold: 1,
+			},
+			isValid:  true,
+			errorMsg: "",
+		}),
+	Entry(
+		"Controller threshold exceeds the number of controllers",
+		DIDDocTestCase{
+			didDoc: &DidDoc{
+				Id:                  ValidTestDID,
+				Controller:          []string{ValidTestDID, ValidTestDID2},
+				ControllerThreshold: 3,
+			},
+			isValid:  false,
+			errorMsg: "controller_threshold: must be no greater than 2",
+		}),
+	Entry(
+		"Controller threshold exceeds the subject if there are no controllers",
+		DIDDocTestCase{
+			didDoc: &DidDoc{
+				Id:                  ValidTestDID,
+				ControllerThreshold: 2,
+			},
+			isValid:  false,
+			errorMsg: "controller_threshold: must be no greater than 1",
+		}),
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	context "context"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -19,3 +20,12 @@ func (m *Metadata) Update(ctx context.Context, version string) {
 	m.Updated = &updated
 	m.VersionId = version
 }
+
+// ActiveFrom returns the time the version became active, i.e. the updated time or the created time for the first version
+func (m Metadata) ActiveFrom() time.Time {
+	if m.Updated != nil {
+		return *m.Updated
+	}
+
+	return m.Created
+}
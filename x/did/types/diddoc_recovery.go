@@ -0,0 +1,35 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"regexp"
+)
+
+var recoveryCommitmentRegexp = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// NewRecoveryCommitment returns the commitment to a pre-rotated recovery key, which is the hex encoded
+// SHA-256 hash of "<verificationMethodType>:<verificationMaterial>". The id and controller of the key
+// are not committed to, so they can be chosen when the key is revealed.
+func NewRecoveryCommitment(vm VerificationMethod) string {
+	hash := sha256.Sum256([]byte(vm.VerificationMethodType + ":" + vm.VerificationMaterial))
+	return hex.EncodeToString(hash[:])
+}
+
+// Validation
+
+func IsRecoveryCommitment() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(string)
+		if !ok {
+			panic("IsRecoveryCommitment must be only applied on string properties")
+		}
+
+		if !recoveryCommitmentRegexp.MatchString(casted) {
+			return errors.New("recovery commitment must be a lowercase hex encoded SHA-256 hash")
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,45 @@
+package types_test
+
+import (
+	. "github.com/cheqd/cheqd-node/x/did/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Recovery commitment tests", func() {
+	recoveryKey := VerificationMethod{
+		Id:                     ValidTestDID + "#recovery",
+		VerificationMethodType: Ed25519VerificationKey2020Type,
+		Controller:             ValidTestDID,
+		VerificationMaterial:   ValidEd25519VerificationKey2020VerificationMaterial,
+	}
+
+	It("Commits to the key type and material only", func() {
+		renamed := recoveryKey
+		renamed.Id = ValidTestDID + "#other"
+
+		Expect(NewRecoveryCommitment(renamed)).To(Equal(NewRecoveryCommitment(recoveryKey)))
+		Expect(IsRecoveryCommitment().Validate(NewRecoveryCommitment(recoveryKey))).To(BeNil())
+	})
+
+	It("Changes with the key material", func() {
+		rotated := recoveryKey
+		rotated.VerificationMaterial = ValidEd25519VerificationKey2018VerificationMaterial
+
+		Expect(NewRecoveryCommitment(rotated)).ToNot(Equal(NewRecoveryCommitment(recoveryKey)))
+	})
+
+	DescribeTable("Commitment format validation", func(commitment string, valid bool) {
+		err := IsRecoveryCommitment().Validate(commitment)
+		if valid {
+			Expect(err).To(BeNil())
+		} else {
+			Expect(err).ToNot(BeNil())
+		}
+	},
+		Entry("SHA-256 hex", "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae", true),
+		Entry("Uppercase hex", "2C26B46B68FFC68FF99B453C1D30413413422D706483BFA0F98A5E886266E7AE", false),
+		Entry("Too short", "2c26b46b68ffc68f", false),
+		Entry("Not hex", "zc26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae", false),
+	)
+})
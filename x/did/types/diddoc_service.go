@@ -2,6 +2,8 @@ package types
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/cheqd/cheqd-node/x/did/utils"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
@@ -33,6 +35,16 @@ func (s *Service) ReplaceDids(old, new string) {
 	s.Id = utils.ReplaceDidInDidURL(s.Id, old, new)
 }
 
+// GetServiceEndpointURIs returns the URIs of string and map-style endpoints of the service
+func (s Service) GetServiceEndpointURIs() []string {
+	uris := append([]string{}, s.ServiceEndpoint...)
+	for _, m := range s.ServiceEndpointMaps {
+		uris = append(uris, m.GetURIs()...)
+	}
+
+	return uris
+}
+
 // Helpers
 
 func GetServiceIds(vms []*Service) []string {
@@ -52,9 +64,27 @@ func (s Service) Validate(baseDid string, allowedNamespaces []string) error {
 		validation.Field(&s.Id, validation.Required, IsDIDUrl(allowedNamespaces, Empty, Empty, Required), HasPrefix(baseDid)),
 		validation.Field(&s.ServiceType, validation.Required, validation.Length(1, 255)),
 		validation.Field(&s.ServiceEndpoint, validation.Each(validation.Required)),
+		validation.Field(&s.ServiceEndpointMaps, validation.Each(ValidServiceEndpointMapRule())),
 	)
 }
 
+// ValidateServiceEndpointURIs checks that all URIs of the service endpoints are absolute and use one of the
+// allowed schemes. An empty list allows any scheme. It's only applied on services of new payloads, so that
+// versions stored before service endpoint URIs were validated are still valid.
+func (s Service) ValidateServiceEndpointURIs(allowedSchemes []string) error {
+	for _, uri := range s.GetServiceEndpointURIs() {
+		if err := utils.ValidateAbsoluteURI(uri); err != nil {
+			return fmt.Errorf("%s: %w", s.Id, err)
+		}
+
+		if len(allowedSchemes) != 0 && !utils.Contains(allowedSchemes, utils.GetURIScheme(uri)) {
+			return fmt.Errorf("%s: service endpoint %s scheme must be one of: %s", s.Id, uri, strings.Join(allowedSchemes, ", "))
+		}
+	}
+
+	return nil
+}
+
 func ValidServiceRule(baseDid string, allowedNamespaces []string) *CustomErrorRule {
 	return NewCustomErrorRule(func(value interface{}) error {
 		casted, ok := value.(Service)
@@ -0,0 +1,261 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/cheqd/cheqd-node/x/did/utils"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// Properties of map-style service endpoints with dedicated fields
+const (
+	ServiceEndpointURIProperty         = "uri"
+	ServiceEndpointAcceptProperty      = "accept"
+	ServiceEndpointRoutingKeysProperty = "routingKeys"
+	ServiceEndpointOriginsProperty     = "origins"
+)
+
+var reservedServiceEndpointProperties = []string{
+	ServiceEndpointURIProperty,
+	ServiceEndpointAcceptProperty,
+	ServiceEndpointRoutingKeysProperty,
+	ServiceEndpointOriginsProperty,
+}
+
+// GetURIs returns the uri and the origins of the endpoint
+func (m ServiceEndpointMap) GetURIs() []string {
+	var uris []string
+	if m.Uri != "" {
+		uris = append(uris, m.Uri)
+	}
+
+	return append(uris, m.Origins...)
+}
+
+// ToSpecMap returns the DID Core representation of the endpoint, where properties are flattened into the map
+func (m ServiceEndpointMap) ToSpecMap() map[string]any {
+	res := make(map[string]any, len(m.Properties)+len(reservedServiceEndpointProperties))
+
+	for _, property := range m.Properties {
+		res[property.Key] = property.Value
+	}
+
+	if m.Uri != "" {
+		res[ServiceEndpointURIProperty] = m.Uri
+	}
+	if len(m.Accept) > 0 {
+		res[ServiceEndpointAcceptProperty] = m.Accept
+	}
+	if len(m.RoutingKeys) > 0 {
+		res[ServiceEndpointRoutingKeysProperty] = m.RoutingKeys
+	}
+	if len(m.Origins) > 0 {
+		res[ServiceEndpointOriginsProperty] = m.Origins
+	}
+
+	return res
+}
+
+// NewServiceEndpointMapFromSpec builds an endpoint from its DID Core representation.
+// Properties without dedicated fields must be strings and are kept sorted by key.
+func NewServiceEndpointMapFromSpec(spec map[string]any) (*ServiceEndpointMap, error) {
+	res := &ServiceEndpointMap{}
+
+	keys := make([]string, 0, len(spec))
+	for key := range spec {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		var err error
+
+		switch key {
+		case ServiceEndpointURIProperty:
+			var ok bool
+			res.Uri, ok = spec[key].(string)
+			if !ok {
+				err = errors.New("must be a string")
+			}
+		case ServiceEndpointAcceptProperty:
+			res.Accept, err = toStringList(spec[key])
+		case ServiceEndpointRoutingKeysProperty:
+			res.RoutingKeys, err = toStringList(spec[key])
+		case ServiceEndpointOriginsProperty:
+			res.Origins, err = toStringList(spec[key])
+		default:
+			value, ok := spec[key].(string)
+			if !ok {
+				err = errors.New("must be a string")
+			}
+			res.Properties = append(res.Properties, &ServiceEndpointProperty{Key: key, Value: value})
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("service endpoint property %s: %w", key, err)
+		}
+	}
+
+	return res, nil
+}
+
+func toStringList(value any) ([]string, error) {
+	list, ok := value.([]any)
+	if !ok {
+		return nil, errors.New("must be a list of strings")
+	}
+
+	res := make([]string, 0, len(list))
+	for _, item := range list {
+		str, ok := item.(string)
+		if !ok {
+			return nil, errors.New("must be a list of strings")
+		}
+		res = append(res, str)
+	}
+
+	return res, nil
+}
+
+// MarshalServiceEndpoint returns the DID Core representation of the serviceEndpoint property.
+// String endpoints are followed by map-style endpoints. Without map-style endpoints the list of strings is kept as is.
+func MarshalServiceEndpoint(uris []string, maps []*ServiceEndpointMap) ([]byte, error) {
+	if len(maps) == 0 {
+		return json.Marshal(uris)
+	}
+
+	endpoints := make([]any, 0, len(uris)+len(maps))
+	for _, uri := range uris {
+		endpoints = append(endpoints, uri)
+	}
+	for _, m := range maps {
+		endpoints = append(endpoints, m.ToSpecMap())
+	}
+
+	return json.Marshal(endpoints)
+}
+
+// UnmarshalServiceEndpoint parses the DID Core representation of the serviceEndpoint property,
+// which is a string, a map or a list of strings and maps.
+func UnmarshalServiceEndpoint(data []byte) ([]string, []*ServiceEndpointMap, error) {
+	if len(data) == 0 {
+		return nil, nil, nil
+	}
+
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, nil, err
+	}
+
+	var uris []string
+	var maps []*ServiceEndpointMap
+
+	add := func(endpoint any) error {
+		switch casted := endpoint.(type) {
+		case string:
+			uris = append(uris, casted)
+		case map[string]any:
+			m, err := NewServiceEndpointMapFromSpec(casted)
+			if err != nil {
+				return err
+			}
+			maps = append(maps, m)
+		default:
+			return fmt.Errorf("service endpoint must be a string or a map, got %T", endpoint)
+		}
+
+		return nil
+	}
+
+	switch casted := value.(type) {
+	case nil:
+	case []any:
+		for _, endpoint := range casted {
+			if err := add(endpoint); err != nil {
+				return nil, nil, err
+			}
+		}
+	default:
+		if err := add(casted); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return uris, maps, nil
+}
+
+// Validation
+
+func (m ServiceEndpointMap) Validate() error {
+	return validation.ValidateStruct(&m,
+		validation.Field(&m.Uri, validation.When(m.Uri != "", IsServiceEndpointURI()),
+			validation.When(len(m.Origins) == 0 && len(m.Properties) == 0, validation.Required.Error("uri, origins or properties must be set"))),
+		validation.Field(&m.Accept, validation.Each(validation.Required)),
+		validation.Field(&m.RoutingKeys, validation.Each(validation.Required)),
+		validation.Field(&m.Origins, validation.Each(validation.Required, IsServiceEndpointURI())),
+		validation.Field(&m.Properties, validation.Each(ValidServiceEndpointPropertyRule()), IsUniqueServiceEndpointPropertyListRule()),
+	)
+}
+
+func ValidServiceEndpointMapRule() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(ServiceEndpointMap)
+		if !ok {
+			panic("ValidServiceEndpointMapRule must be only applied on service endpoint maps")
+		}
+
+		return casted.Validate()
+	})
+}
+
+func (p ServiceEndpointProperty) Validate() error {
+	return validation.ValidateStruct(&p,
+		validation.Field(&p.Key, validation.Required, validation.NotIn(utils.ToInterfaces(reservedServiceEndpointProperties)...).Error("must not be a reserved property")),
+		validation.Field(&p.Value, validation.Required),
+	)
+}
+
+func ValidServiceEndpointPropertyRule() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(ServiceEndpointProperty)
+		if !ok {
+			panic("ValidServiceEndpointPropertyRule must be only applied on service endpoint properties")
+		}
+
+		return casted.Validate()
+	})
+}
+
+func IsUniqueServiceEndpointPropertyListRule() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.([]*ServiceEndpointProperty)
+		if !ok {
+			panic("IsUniqueServiceEndpointPropertyListRule must be only applied on service endpoint property lists")
+		}
+
+		keys := make([]string, len(casted))
+		for i, property := range casted {
+			keys[i] = property.Key
+		}
+
+		if !utils.IsUnique(keys) {
+			return errors.New("there are property duplicates")
+		}
+
+		return nil
+	})
+}
+
+func IsServiceEndpointURI() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(string)
+		if !ok {
+			panic("IsServiceEndpointURI must be only applied on string properties")
+		}
+
+		return utils.ValidateAbsoluteURI(casted)
+	})
+}
@@ -0,0 +1,65 @@
+package types_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/cheqd/cheqd-node/x/did/types"
+)
+
+var _ = Describe("Service endpoint JSON tests", func() {
+	It("keeps a list of strings when there are no map-style endpoints", func() {
+		bz, err := MarshalServiceEndpoint([]string{"https://example.com"}, nil)
+		Expect(err).To(BeNil())
+		Expect(string(bz)).To(Equal(`["https://example.com"]`))
+	})
+
+	It("round-trips string and map-style endpoints", func() {
+		uris := []string{"https://example.com"}
+		maps := []*ServiceEndpointMap{
+			{
+				Uri:         "https://example.com/didcomm",
+				Accept:      []string{"didcomm/v2"},
+				RoutingKeys: []string{"did:example:somemediator#somekey"},
+			},
+			{
+				Properties: []*ServiceEndpointProperty{
+					{Key: "resourceName", Value: "Example"},
+					{Key: "resourceType", Value: "String"},
+				},
+			},
+		}
+
+		bz, err := MarshalServiceEndpoint(uris, maps)
+		Expect(err).To(BeNil())
+
+		actualURIs, actualMaps, err := UnmarshalServiceEndpoint(bz)
+		Expect(err).To(BeNil())
+		Expect(actualURIs).To(Equal(uris))
+		Expect(actualMaps).To(Equal(maps))
+	})
+
+	DescribeTable("parses the DID Core representations",
+		func(json string, expectedURIs []string, expectedMaps []*ServiceEndpointMap) {
+			uris, maps, err := UnmarshalServiceEndpoint([]byte(json))
+			Expect(err).To(BeNil())
+			Expect(uris).To(Equal(expectedURIs))
+			Expect(maps).To(Equal(expectedMaps))
+		},
+
+		Entry("string", `"https://example.com"`, []string{"https://example.com"}, nil),
+		Entry("map", `{"origins": ["https://example.com"]}`, nil, []*ServiceEndpointMap{{Origins: []string{"https://example.com"}}}),
+		Entry("null", `null`, nil, nil),
+	)
+
+	DescribeTable("rejects invalid representations",
+		func(json string) {
+			_, _, err := UnmarshalServiceEndpoint([]byte(json))
+			Expect(err).To(HaveOccurred())
+		},
+
+		Entry("number", `1`),
+		Entry("non-string property", `{"uri": "https://example.com", "priority": 1}`),
+		Entry("non-list accept", `{"uri": "https://example.com", "accept": "didcomm/v2"}`),
+	)
+})
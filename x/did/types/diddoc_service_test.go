@@ -84,5 +84,119 @@ var _ = Describe("Service tests", func() {
 				isValid:           true,
 				errorMsg:          "",
 			}),
+
+		Entry(
+			"Valid DIDComm v2 map-style service endpoint",
+			TestCaseServiceStruct{
+				service: &Service{
+					Id:          "did:cheqd:aABCDEFG123456789abcd#service1",
+					ServiceType: "DIDCommMessaging",
+					ServiceEndpointMaps: []*ServiceEndpointMap{
+						{
+							Uri:         "https://example.com/path",
+							Accept:      []string{"didcomm/v2"},
+							RoutingKeys: []string{"did:example:somemediator#somekey"},
+						},
+					},
+				},
+				baseDid:           "did:cheqd:aABCDEFG123456789abcd",
+				allowedNamespaces: []string{""},
+				isValid:           true,
+				errorMsg:          "",
+			}),
+
+		Entry(
+			"Valid LinkedDomains origins service endpoint",
+			TestCaseServiceStruct{
+				service: &Service{
+					Id:          "did:cheqd:aABCDEFG123456789abcd#service1",
+					ServiceType: "LinkedDomains",
+					ServiceEndpointMaps: []*ServiceEndpointMap{
+						{
+							Origins: []string{"https://foo.example.com", "https://identity.foundation"},
+						},
+					},
+				},
+				baseDid:           "did:cheqd:aABCDEFG123456789abcd",
+				allowedNamespaces: []string{""},
+				isValid:           true,
+				errorMsg:          "",
+			}),
+
+		Entry(
+			"Map-style service endpoint without uri, origins or properties",
+			TestCaseServiceStruct{
+				service: &Service{
+					Id:          "did:cheqd:aABCDEFG123456789abcd#service1",
+					ServiceType: "DIDCommMessaging",
+					ServiceEndpointMaps: []*ServiceEndpointMap{
+						{
+							Accept: []string{"didcomm/v2"},
+						},
+					},
+				},
+				baseDid:           "did:cheqd:aABCDEFG123456789abcd",
+				allowedNamespaces: []string{""},
+				isValid:           false,
+				errorMsg:          "uri, origins or properties must be set",
+			}),
+
+		Entry(
+			"Map-style service endpoint with a reserved property",
+			TestCaseServiceStruct{
+				service: &Service{
+					Id:          "did:cheqd:aABCDEFG123456789abcd#service1",
+					ServiceType: "LinkedResourceMetadata",
+					ServiceEndpointMaps: []*ServiceEndpointMap{
+						{
+							Properties: []*ServiceEndpointProperty{{Key: "uri", Value: "https://example.com"}},
+						},
+					},
+				},
+				baseDid:           "did:cheqd:aABCDEFG123456789abcd",
+				allowedNamespaces: []string{""},
+				isValid:           false,
+				errorMsg:          "must not be a reserved property",
+			}),
+
+		Entry(
+			"Map-style service endpoint with duplicated properties",
+			TestCaseServiceStruct{
+				service: &Service{
+					Id:          "did:cheqd:aABCDEFG123456789abcd#service1",
+					ServiceType: "LinkedResourceMetadata",
+					ServiceEndpointMaps: []*ServiceEndpointMap{
+						{
+							Properties: []*ServiceEndpointProperty{
+								{Key: "resourceType", Value: "String"},
+								{Key: "resourceType", Value: "JSONSchema2020"},
+							},
+						},
+					},
+				},
+				baseDid:           "did:cheqd:aABCDEFG123456789abcd",
+				allowedNamespaces: []string{""},
+				isValid:           false,
+				errorMsg:          "there are property duplicates",
+			}),
+	)
+
+	DescribeTable("Service endpoint URI tests", func(service *Service, allowedSchemes []string, isValid bool) {
+		err := service.ValidateServiceEndpointURIs(allowedSchemes)
+
+		if isValid {
+			Expect(err).To(BeNil())
+		} else {
+			Expect(err).To(HaveOccurred())
+		}
+	},
+
+		Entry("Allowed scheme", &Service{ServiceEndpoint: []string{"https://example.com"}}, DefaultAllowedServiceEndpointSchemes, true),
+		Entry("Scheme is case insensitive", &Service{ServiceEndpoint: []string{"HTTPS://example.com"}}, DefaultAllowedServiceEndpointSchemes, true),
+		Entry("Not allowed scheme", &Service{ServiceEndpoint: []string{"ftp://example.com"}}, DefaultAllowedServiceEndpointSchemes, false),
+		Entry("Not allowed scheme of origins", &Service{ServiceEndpointMaps: []*ServiceEndpointMap{{Origins: []string{"ftp://example.com"}}}}, DefaultAllowedServiceEndpointSchemes, false),
+		Entry("Empty list allows any scheme", &Service{ServiceEndpoint: []string{"ftp://example.com"}}, []string{}, true),
+		Entry("Not absolute URI", &Service{ServiceEndpoint: []string{"endpoint"}}, []string{}, false),
+		Entry("Web URI without host", &Service{ServiceEndpoint: []string{"https:endpoint"}}, DefaultAllowedServiceEndpointSchemes, false),
 	)
 })
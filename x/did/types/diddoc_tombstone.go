@@ -0,0 +1,80 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+// NewDidDocTombstone returns an empty tombstone of a DID Document
+func NewDidDocTombstone(did string) DidDocTombstone {
+	return DidDocTombstone{Id: did}
+}
+
+// NextChainHash returns the hex encoded SHA-256 hash of "<previousChainHash><checksum>"
+func NextChainHash(previousChainHash, checksum string) string {
+	hash := sha256.Sum256([]byte(previousChainHash + checksum))
+	return hex.EncodeToString(hash[:])
+}
+
+// AddPrunedVersion appends a version to the hash chain of the tombstone. Versions must be added in the order of the version chain.
+func (t *DidDocTombstone) AddPrunedVersion(version DidDocWithMetadata) error {
+	bz, err := version.Marshal()
+	if err != nil {
+		return err
+	}
+
+	checksum := sha256.Sum256(bz)
+	pruned := PrunedDidDocVersion{
+		VersionId:  version.Metadata.VersionId,
+		ActiveFrom: version.Metadata.ActiveFrom(),
+		Checksum:   hex.EncodeToString(checksum[:]),
+	}
+	pruned.ChainHash = NextChainHash(t.ChainHash, pruned.Checksum)
+
+	t.PrunedVersions = append(t.PrunedVersions, &pruned)
+	t.ChainHash = pruned.ChainHash
+
+	return nil
+}
+
+// HasPrunedVersion returns true if the version is recorded in the tombstone
+func (t DidDocTombstone) HasPrunedVersion(versionID string) bool {
+	_, found := t.GetPrunedVersion(versionID)
+	return found
+}
+
+// GetPrunedVersion returns the pruned version recorded in the tombstone
+func (t DidDocTombstone) GetPrunedVersion(versionID string) (*PrunedDidDocVersion, bool) {
+	for _, pruned := range t.PrunedVersions {
+		if pruned.VersionId == versionID {
+			return pruned, true
+		}
+	}
+
+	return nil, false
+}
+
+// Validate checks that the tombstone forms a valid hash chain
+func (t DidDocTombstone) Validate() error {
+	chainHash := ""
+	versions := make(map[string]bool)
+
+	for _, pruned := range t.PrunedVersions {
+		if versions[pruned.VersionId] {
+			return fmt.Errorf("duplicated pruned version %s in tombstone of %s", pruned.VersionId, t.Id)
+		}
+		versions[pruned.VersionId] = true
+
+		chainHash = NextChainHash(chainHash, pruned.Checksum)
+		if pruned.ChainHash != chainHash {
+			return fmt.Errorf("invalid chain hash of pruned version %s in tombstone of %s", pruned.VersionId, t.Id)
+		}
+	}
+
+	if t.ChainHash != chainHash {
+		return fmt.Errorf("invalid chain hash in tombstone of %s", t.Id)
+	}
+
+	return nil
+}
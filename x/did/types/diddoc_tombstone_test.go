@@ -0,0 +1,50 @@
+package types_test
+
+import (
+	"time"
+
+	. "github.com/cheqd/cheqd-node/x/did/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Tombstone tests", func() {
+	version := func(id string) DidDocWithMetadata {
+		return DidDocWithMetadata{
+			DidDoc:   &DidDoc{Id: ValidTestDID},
+			Metadata: &Metadata{Created: time.Unix(0, 0).UTC(), VersionId: id},
+		}
+	}
+
+	var tombstone DidDocTombstone
+
+	BeforeEach(func() {
+		tombstone = NewDidDocTombstone(ValidTestDID)
+		Expect(tombstone.AddPrunedVersion(version("version-1"))).To(Succeed())
+		Expect(tombstone.AddPrunedVersion(version("version-2"))).To(Succeed())
+	})
+
+	It("Chains the checksums of the pruned versions", func() {
+		first := tombstone.PrunedVersions[0]
+		second := tombstone.PrunedVersions[1]
+
+		Expect(first.ChainHash).To(Equal(NextChainHash("", first.Checksum)))
+		Expect(second.ChainHash).To(Equal(NextChainHash(first.ChainHash, second.Checksum)))
+		Expect(tombstone.ChainHash).To(Equal(second.ChainHash))
+		Expect(tombstone.HasPrunedVersion("version-2")).To(BeTrue())
+		Expect(tombstone.HasPrunedVersion("version-3")).To(BeFalse())
+		Expect(tombstone.Validate()).To(Succeed())
+	})
+
+	It("Detects modified checksums", func() {
+		tombstone.PrunedVersions[0].Checksum = tombstone.PrunedVersions[1].Checksum
+
+		Expect(tombstone.Validate()).To(MatchError(ContainSubstring("invalid chain hash of pruned version version-1")))
+	})
+
+	It("Detects removed versions", func() {
+		tombstone.PrunedVersions = tombstone.PrunedVersions[1:]
+
+		Expect(tombstone.Validate()).To(HaveOccurred())
+	})
+})
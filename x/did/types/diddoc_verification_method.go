@@ -4,10 +4,13 @@ import (
 	"crypto/ecdsa"
 	"crypto/ed25519"
 	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 
 	"github.com/cheqd/cheqd-node/x/did/utils"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/lestrrat-go/jwx/jwk"
 	"github.com/mr-tron/base58"
@@ -15,15 +18,21 @@ import (
 )
 
 const (
-	JSONWebKey2020Type             = "JsonWebKey2020"
-	Ed25519VerificationKey2020Type = "Ed25519VerificationKey2020"
-	Ed25519VerificationKey2018Type = "Ed25519VerificationKey2018"
+	JSONWebKey2020Type                    = "JsonWebKey2020"
+	Ed25519VerificationKey2020Type        = "Ed25519VerificationKey2020"
+	Ed25519VerificationKey2018Type        = "Ed25519VerificationKey2018"
+	EcdsaSecp256k1VerificationKey2019Type = "EcdsaSecp256k1VerificationKey2019"
+	EcdsaSecp256k1RecoveryMethod2020Type  = "EcdsaSecp256k1RecoveryMethod2020"
+	MultikeyType                          = "Multikey"
 )
 
 var SupportedMethodTypes = []string{
 	JSONWebKey2020Type,
 	Ed25519VerificationKey2020Type,
 	Ed25519VerificationKey2018Type,
+	EcdsaSecp256k1VerificationKey2019Type,
+	EcdsaSecp256k1RecoveryMethod2020Type,
+	MultikeyType,
 }
 
 func NewVerificationMethod(id string, vmType string, controller string, verificationMaterial string) *VerificationMethod {
@@ -97,6 +106,25 @@ func VerifySignature(vm VerificationMethod, message []byte, signature []byte) er
 
 		verificationError = utils.VerifyED25519Signature(publicKeyBytes, message, signature)
 
+	case EcdsaSecp256k1VerificationKey2019Type:
+		_, publicKeyBytes, err := multibase.Decode(vm.VerificationMaterial)
+		if err != nil {
+			return err
+		}
+
+		verificationError = utils.VerifySecp256k1Signature(publicKeyBytes, message, signature)
+
+	case EcdsaSecp256k1RecoveryMethod2020Type:
+		verificationError = utils.VerifySecp256k1RecoverySignature(vm.VerificationMaterial, message, signature)
+
+	case MultikeyType:
+		_, multikeyBytes, err := multibase.Decode(vm.VerificationMaterial)
+		if err != nil {
+			return err
+		}
+
+		verificationError = utils.VerifyMultikeySignature(multikeyBytes, message, signature)
+
 	default:
 		panic("unsupported verification method type") // This should have also been checked during basic validation
 	}
@@ -145,6 +173,15 @@ func (vm VerificationMethod) Validate(baseDid string, allowedNamespaces []string
 		validation.Field(&vm.VerificationMaterial,
 			validation.When(vm.VerificationMethodType == JSONWebKey2020Type, validation.Required, IsJWK()),
 		),
+		validation.Field(&vm.VerificationMaterial,
+			validation.When(vm.VerificationMethodType == EcdsaSecp256k1VerificationKey2019Type, validation.Required, IsMultibaseSecp256k1VerificationKey2019()),
+		),
+		validation.Field(&vm.VerificationMaterial,
+			validation.When(vm.VerificationMethodType == EcdsaSecp256k1RecoveryMethod2020Type, validation.Required, IsBlockchainAccountID()),
+		),
+		validation.Field(&vm.VerificationMaterial,
+			validation.When(vm.VerificationMethodType == MultikeyType, validation.Required, IsMultikey()),
+		),
 	)
 }
 
@@ -174,3 +211,84 @@ func IsUniqueVerificationMethodListByIDRule() *CustomErrorRule {
 		return nil
 	})
 }
+
+// GetPublicKeyFingerprint returns the hex encoded SHA-256 hash of the multicodec encoded public key of the verification method.
+// The fingerprint is the same for all representations of a key, e.g. Ed25519VerificationKey2018 and Ed25519VerificationKey2020.
+// EcdsaSecp256k1RecoveryMethod2020 methods reference blockchain accounts instead of keys and have no fingerprint.
+func GetPublicKeyFingerprint(vm VerificationMethod) (string, error) {
+	var pubKey interface{}
+
+	switch vm.VerificationMethodType {
+	case Ed25519VerificationKey2020Type:
+		_, multibaseBytes, err := multibase.Decode(vm.VerificationMaterial)
+		if err != nil {
+			return "", err
+		}
+		if len(multibaseBytes) < 2 {
+			return "", fmt.Errorf("ed25519 public key is too short: %d", len(multibaseBytes))
+		}
+
+		pubKey = ed25519.PublicKey(utils.GetEd25519VerificationKey2020(multibaseBytes))
+
+	case JSONWebKey2020Type:
+		err := jwk.ParseRawKey([]byte(vm.VerificationMaterial), &pubKey)
+		if err != nil {
+			return "", fmt.Errorf("can't parse jwk: %s", err.Error())
+		}
+
+	case Ed25519VerificationKey2018Type:
+		publicKeyBytes, err := base58.Decode(vm.VerificationMaterial)
+		if err != nil {
+			return "", err
+		}
+
+		pubKey = ed25519.PublicKey(publicKeyBytes)
+
+	case EcdsaSecp256k1VerificationKey2019Type:
+		_, publicKeyBytes, err := multibase.Decode(vm.VerificationMaterial)
+		if err != nil {
+			return "", err
+		}
+
+		pubKey, err = secp256k1.ParsePubKey(publicKeyBytes)
+		if err != nil {
+			return "", err
+		}
+
+	case MultikeyType:
+		_, multikeyBytes, err := multibase.Decode(vm.VerificationMaterial)
+		if err != nil {
+			return "", err
+		}
+
+		prefix, publicKeyBytes, err := utils.SplitMulticodecPubKey(multikeyBytes)
+		if err != nil {
+			return "", err
+		}
+
+		// Only secp256k1 keys can be uncompressed, the rest are already in normalized form
+		if string(prefix) == string(utils.MulticodecSecp256k1PubPrefix) {
+			pubKey, err = secp256k1.ParsePubKey(publicKeyBytes)
+			if err != nil {
+				return "", err
+			}
+		} else {
+			return fingerprint(multikeyBytes), nil
+		}
+
+	default:
+		return "", fmt.Errorf("verification method type %s has no public key fingerprint", vm.VerificationMethodType)
+	}
+
+	multicodecBytes, err := utils.NormalizeMulticodecPubKey(pubKey)
+	if err != nil {
+		return "", err
+	}
+
+	return fingerprint(multicodecBytes), nil
+}
+
+func fingerprint(multicodecBytes []byte) string {
+	hash := sha256.Sum256(multicodecBytes)
+	return hex.EncodeToString(hash[:])
+}
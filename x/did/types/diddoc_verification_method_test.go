@@ -7,10 +7,15 @@ import (
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
 	"encoding/json"
 
 	testsetup "github.com/cheqd/cheqd-node/x/did/tests/setup"
 	didtypes "github.com/cheqd/cheqd-node/x/did/types"
+	"github.com/cheqd/cheqd-node/x/did/utils"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	secp256k1ecdsa "github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
 	"github.com/lestrrat-go/jwx/jwk"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -427,4 +432,347 @@ var _ = DescribeTable("Verification Method material validation tests", func(test
 			errorMsg: "ed25519: bad public key length: 31",
 		},
 	),
+	Entry(
+		"Valid: EcdsaSecp256k1VerificationKey2019 with multibase secp256k1 key",
+		VerificationMethodTestCase{
+			vm: didtypes.VerificationMethod{
+				Id:                     "did:cheqd:zABCDEFG123456789abcd#qwe",
+				VerificationMethodType: "EcdsaSecp256k1VerificationKey2019",
+				Controller:             "did:cheqd:zABCDEFG987654321abcd",
+				VerificationMaterial:   ValidEcdsaSecp256k1VerificationKey2019VerificationMaterial,
+			},
+			isValid:  true,
+			errorMsg: "",
+		},
+	),
+	Entry(
+		"Invalid: EcdsaSecp256k1VerificationKey2019 with non secp256k1 key",
+		VerificationMethodTestCase{
+			vm: didtypes.VerificationMethod{
+				Id:                     "did:cheqd:zABCDEFG123456789abcd#qwe",
+				VerificationMethodType: "EcdsaSecp256k1VerificationKey2019",
+				Controller:             "did:cheqd:zABCDEFG987654321abcd",
+				VerificationMaterial:   InvalidEcdsaSecp256k1VerificationKey2019VerificationMaterial,
+			},
+			isValid:  false,
+			errorMsg: "secp256k1: ",
+		},
+	),
+	Entry(
+		"Valid: EcdsaSecp256k1RecoveryMethod2020 with CAIP-10 account id",
+		VerificationMethodTestCase{
+			vm: didtypes.VerificationMethod{
+				Id:                     "did:cheqd:zABCDEFG123456789abcd#qwe",
+				VerificationMethodType: "EcdsaSecp256k1RecoveryMethod2020",
+				Controller:             "did:cheqd:zABCDEFG987654321abcd",
+				VerificationMaterial:   ValidEcdsaSecp256k1RecoveryMethod2020VerificationMaterial,
+			},
+			isValid:  true,
+			errorMsg: "",
+		},
+	),
+	Entry(
+		"Invalid: EcdsaSecp256k1RecoveryMethod2020 with bare ethereum address",
+		VerificationMethodTestCase{
+			vm: didtypes.VerificationMethod{
+				Id:                     "did:cheqd:zABCDEFG123456789abcd#qwe",
+				VerificationMethodType: "EcdsaSecp256k1RecoveryMethod2020",
+				Controller:             "did:cheqd:zABCDEFG987654321abcd",
+				VerificationMaterial:   InvalidEcdsaSecp256k1RecoveryMethod2020VerificationMaterial,
+			},
+			isValid:  false,
+			errorMsg: "unsupported blockchain account id",
+		},
+	),
+	Entry(
+		"Valid: Multikey with secp256k1 key",
+		VerificationMethodTestCase{
+			vm: didtypes.VerificationMethod{
+				Id:                     "did:cheqd:zABCDEFG123456789abcd#qwe",
+				VerificationMethodType: "Multikey",
+				Controller:             "did:cheqd:zABCDEFG987654321abcd",
+				VerificationMaterial:   ValidSecp256k1MultikeyVerificationMaterial,
+			},
+			isValid:  true,
+			errorMsg: "",
+		},
+	),
+	Entry(
+		"Valid: Multikey with P-256 key",
+		VerificationMethodTestCase{
+			vm: didtypes.VerificationMethod{
+				Id:                     "did:cheqd:zABCDEFG123456789abcd#qwe",
+				VerificationMethodType: "Multikey",
+				Controller:             "did:cheqd:zABCDEFG987654321abcd",
+				VerificationMaterial:   ValidP256MultikeyVerificationMaterial,
+			},
+			isValid:  true,
+			errorMsg: "",
+		},
+	),
+	Entry(
+		"Valid: Multikey with P-384 key",
+		VerificationMethodTestCase{
+			vm: didtypes.VerificationMethod{
+				Id:                     "did:cheqd:zABCDEFG123456789abcd#qwe",
+				VerificationMethodType: "Multikey",
+				Controller:             "did:cheqd:zABCDEFG987654321abcd",
+				VerificationMaterial:   ValidP384MultikeyVerificationMaterial,
+			},
+			isValid:  true,
+			errorMsg: "",
+		},
+	),
+	Entry(
+		"Valid: Multikey with ed25519 key",
+		VerificationMethodTestCase{
+			vm: didtypes.VerificationMethod{
+				Id:                     "did:cheqd:zABCDEFG123456789abcd#qwe",
+				VerificationMethodType: "Multikey",
+				Controller:             "did:cheqd:zABCDEFG987654321abcd",
+				VerificationMaterial:   ValidEd25519VerificationKey2020VerificationMaterial,
+			},
+			isValid:  true,
+			errorMsg: "",
+		},
+	),
+	Entry(
+		"Invalid: Multikey with unsupported multicodec prefix",
+		VerificationMethodTestCase{
+			vm: didtypes.VerificationMethod{
+				Id:                     "did:cheqd:zABCDEFG123456789abcd#qwe",
+				VerificationMethodType: "Multikey",
+				Controller:             "did:cheqd:zABCDEFG987654321abcd",
+				VerificationMaterial:   InvalidMultikeyVerificationMaterialBadPrefix,
+			},
+			isValid:  false,
+			errorMsg: "unsupported multicodec prefix for Multikey: 0x0200",
+		},
+	),
 )
+
+var _ = Describe("Validation secp256k1 Signature in verification method", func() {
+	message := "Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod " +
+		"tempor incididunt ut labore et dolore magna aliqua."
+	msgBytes := []byte(message)
+	msgDigest := sha256.Sum256(msgBytes)
+
+	privKey, err := secp256k1.GeneratePrivateKey()
+	Expect(err).To(BeNil())
+
+	Context("when EcdsaSecp256k1VerificationKey2019 representation is placed", func() {
+		It("is valid with DER signature", func() {
+			signature := secp256k1ecdsa.Sign(privKey, msgDigest[:]).Serialize()
+
+			vm := didtypes.VerificationMethod{
+				VerificationMethodType: "EcdsaSecp256k1VerificationKey2019",
+				VerificationMaterial:   utils.MustEncodeMultibaseBase58(privKey.PubKey().SerializeCompressed()),
+			}
+
+			err = didtypes.VerifySignature(vm, msgBytes, signature)
+			Expect(err).To(BeNil())
+		})
+
+		It("is valid with compact signature", func() {
+			signature := testsetup.SignSecp256k1(privKey, msgBytes)
+
+			vm := didtypes.VerificationMethod{
+				VerificationMethodType: "EcdsaSecp256k1VerificationKey2019",
+				VerificationMaterial:   utils.MustEncodeMultibaseBase58(privKey.PubKey().SerializeUncompressed()),
+			}
+
+			err = didtypes.VerifySignature(vm, msgBytes, signature)
+			Expect(err).To(BeNil())
+		})
+
+		It("is not valid for another message", func() {
+			signature := testsetup.SignSecp256k1(privKey, []byte("another message"))
+
+			vm := didtypes.VerificationMethod{
+				VerificationMethodType: "EcdsaSecp256k1VerificationKey2019",
+				VerificationMaterial:   utils.MustEncodeMultibaseBase58(privKey.PubKey().SerializeCompressed()),
+			}
+
+			err = didtypes.VerifySignature(vm, msgBytes, signature)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid secp256k1 signature"))
+		})
+
+		It("is not valid with high-S signature", func() {
+			signature := testsetup.SignSecp256k1(privKey, msgBytes)
+
+			// (r, N - s) verifies the same message, but is not canonical
+			var s secp256k1.ModNScalar
+			s.SetByteSlice(signature[32:])
+			highS := s.Negate().Bytes()
+			malleated := append(append([]byte{}, signature[:32]...), highS[:]...)
+
+			vm := didtypes.VerificationMethod{
+				VerificationMethodType: "EcdsaSecp256k1VerificationKey2019",
+				VerificationMaterial:   utils.MustEncodeMultibaseBase58(privKey.PubKey().SerializeCompressed()),
+			}
+
+			err = didtypes.VerifySignature(vm, msgBytes, malleated)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("lower half of the group order"))
+		})
+	})
+
+	Context("when EcdsaSecp256k1RecoveryMethod2020 representation is placed", func() {
+		It("is valid", func() {
+			vm := didtypes.VerificationMethod{
+				VerificationMethodType: "EcdsaSecp256k1RecoveryMethod2020",
+				VerificationMaterial:   testsetup.GenerateEcdsaSecp256k1RecoveryMethod2020VerificationMaterial(privKey.PubKey()),
+			}
+
+			err = didtypes.VerifySignature(vm, msgBytes, testsetup.SignSecp256k1Recoverable(privKey, msgBytes))
+			Expect(err).To(BeNil())
+		})
+
+		It("is not valid for another account", func() {
+			otherKey, err := secp256k1.GeneratePrivateKey()
+			Expect(err).To(BeNil())
+
+			vm := didtypes.VerificationMethod{
+				VerificationMethodType: "EcdsaSecp256k1RecoveryMethod2020",
+				VerificationMaterial:   testsetup.GenerateEcdsaSecp256k1RecoveryMethod2020VerificationMaterial(otherKey.PubKey()),
+			}
+
+			err = didtypes.VerifySignature(vm, msgBytes, testsetup.SignSecp256k1Recoverable(privKey, msgBytes))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("recovered address doesn't match blockchain account id"))
+		})
+	})
+
+	Context("when Multikey representation is placed", func() {
+		It("is valid", func() {
+			vm := didtypes.VerificationMethod{
+				VerificationMethodType: "Multikey",
+				VerificationMaterial:   testsetup.GenerateSecp256k1MultikeyVerificationMaterial(privKey.PubKey()),
+			}
+
+			err = didtypes.VerifySignature(vm, msgBytes, testsetup.SignSecp256k1(privKey, msgBytes))
+			Expect(err).To(BeNil())
+		})
+	})
+})
+
+var _ = Describe("Validation Multikey NIST curve Signature in verification method", func() {
+	message := "Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod " +
+		"tempor incididunt ut labore et dolore magna aliqua."
+	msgBytes := []byte(message)
+
+	Context("when P-256 key is placed", func() {
+		It("is valid", func() {
+			privKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).To(BeNil())
+
+			msgDigest := sha256.Sum256(msgBytes)
+			signature, err := ecdsa.SignASN1(rand.Reader, privKey, msgDigest[:])
+			Expect(err).To(BeNil())
+
+			vm := didtypes.VerificationMethod{
+				VerificationMethodType: "Multikey",
+				VerificationMaterial:   testsetup.GenerateECDSAMultikeyVerificationMaterial(privKey.PublicKey),
+			}
+
+			err = didtypes.VerifySignature(vm, msgBytes, signature)
+			Expect(err).To(BeNil())
+		})
+	})
+
+	Context("when P-384 key is placed", func() {
+		It("is valid", func() {
+			privKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+			Expect(err).To(BeNil())
+
+			msgDigest := sha512.Sum384(msgBytes)
+			signature, err := ecdsa.SignASN1(rand.Reader, privKey, msgDigest[:])
+			Expect(err).To(BeNil())
+
+			vm := didtypes.VerificationMethod{
+				VerificationMethodType: "Multikey",
+				VerificationMaterial:   testsetup.GenerateECDSAMultikeyVerificationMaterial(privKey.PublicKey),
+			}
+
+			err = didtypes.VerifySignature(vm, msgBytes, signature)
+			Expect(err).To(BeNil())
+		})
+	})
+})
+
+var _ = Describe("Public key fingerprint tests", func() {
+	It("is the same for all representations of an ed25519 key", func() {
+		keyPair := testsetup.GenerateKeyPair()
+
+		expected, err := didtypes.GetPublicKeyFingerprint(didtypes.VerificationMethod{
+			VerificationMethodType: didtypes.Ed25519VerificationKey2020Type,
+			VerificationMaterial:   testsetup.GenerateEd25519VerificationKey2020VerificationMaterial(keyPair.Public),
+		})
+		Expect(err).To(BeNil())
+
+		for _, vm := range []didtypes.VerificationMethod{
+			{
+				VerificationMethodType: didtypes.Ed25519VerificationKey2018Type,
+				VerificationMaterial:   testsetup.GenerateEd25519VerificationKey2018VerificationMaterial(keyPair.Public),
+			},
+			{
+				VerificationMethodType: didtypes.JSONWebKey2020Type,
+				VerificationMaterial:   testsetup.GenerateJSONWebKey2020VerificationMaterial(keyPair.Public),
+			},
+			{
+				VerificationMethodType: didtypes.MultikeyType,
+				VerificationMaterial:   testsetup.GenerateEd25519VerificationKey2020VerificationMaterial(keyPair.Public),
+			},
+		} {
+			actual, err := didtypes.GetPublicKeyFingerprint(vm)
+			Expect(err).To(BeNil())
+			Expect(actual).To(Equal(expected), vm.VerificationMethodType)
+		}
+	})
+
+	It("is the same for compressed and uncompressed secp256k1 keys", func() {
+		privKey, err := secp256k1.GeneratePrivateKey()
+		Expect(err).To(BeNil())
+		pubKey := privKey.PubKey()
+
+		compressed, err := didtypes.GetPublicKeyFingerprint(didtypes.VerificationMethod{
+			VerificationMethodType: didtypes.MultikeyType,
+			VerificationMaterial:   testsetup.GenerateSecp256k1MultikeyVerificationMaterial(pubKey),
+		})
+		Expect(err).To(BeNil())
+
+		uncompressed, err := didtypes.GetPublicKeyFingerprint(didtypes.VerificationMethod{
+			VerificationMethodType: didtypes.EcdsaSecp256k1VerificationKey2019Type,
+			VerificationMaterial:   utils.MustEncodeMultibaseBase58(pubKey.SerializeUncompressed()),
+		})
+		Expect(err).To(BeNil())
+		Expect(uncompressed).To(Equal(compressed))
+	})
+
+	It("is different for different keys", func() {
+		first, err := didtypes.GetPublicKeyFingerprint(didtypes.VerificationMethod{
+			VerificationMethodType: didtypes.Ed25519VerificationKey2020Type,
+			VerificationMaterial:   testsetup.GenerateEd25519VerificationKey2020VerificationMaterial(testsetup.GenerateKeyPair().Public),
+		})
+		Expect(err).To(BeNil())
+
+		second, err := didtypes.GetPublicKeyFingerprint(didtypes.VerificationMethod{
+			VerificationMethodType: didtypes.Ed25519VerificationKey2020Type,
+			VerificationMaterial:   testsetup.GenerateEd25519VerificationKey2020VerificationMaterial(testsetup.GenerateKeyPair().Public),
+		})
+		Expect(err).To(BeNil())
+		Expect(first).NotTo(Equal(second))
+	})
+
+	It("is not defined for blockchain account ids", func() {
+		privKey, err := secp256k1.GeneratePrivateKey()
+		Expect(err).To(BeNil())
+
+		_, err = didtypes.GetPublicKeyFingerprint(didtypes.VerificationMethod{
+			VerificationMethodType: didtypes.EcdsaSecp256k1RecoveryMethod2020Type,
+			VerificationMaterial:   testsetup.GenerateEcdsaSecp256k1RecoveryMethod2020VerificationMaterial(privKey.PubKey()),
+		})
+		Expect(err).To(HaveOccurred())
+	})
+})
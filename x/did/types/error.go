@@ -18,6 +18,8 @@ var (
 	ErrBasicValidation            = errorsmod.Register(ModuleName, 1205, "basic validation failed")
 	ErrNamespaceValidation        = errorsmod.Register(ModuleName, 1206, "DID namespace validation failed")
 	ErrDIDDocDeactivated          = errorsmod.Register(ModuleName, 1207, "DID Doc already deactivated")
+	ErrRecoveryNotEnabled         = errorsmod.Register(ModuleName, 1208, "DID Doc recovery not enabled")
+	ErrRecoveryKeyMismatch        = errorsmod.Register(ModuleName, 1209, "recovery key doesn't match the recovery commitment")
 	ErrUnpackStateValue           = errorsmod.Register(ModuleName, 1300, "invalid did state value")
 	ErrInternal                   = errorsmod.Register(ModuleName, 1500, "internal error")
 	ErrUnauthorized               = errorsmod.Register(ModuleName, 1, "unauthorized account")
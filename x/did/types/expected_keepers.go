@@ -2,8 +2,9 @@ package types
 
 import (
 	context "context"
+	"time"
 
-	sdkmath "cosmossdk.io/math"
+	oracletypes "github.com/cheqd/cheqd-node/x/oracle/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -41,5 +42,19 @@ type StakingKeeper interface {
 
 // OracleKeeper interface for price fetching
 type OracleKeeper interface {
-	GetWMA(ctx sdk.Context, denom string, strategy string) (sdkmath.LegacyDec, bool)
+	GetFeeConversionPrice(ctx sdk.Context, symbol string) (oracletypes.ConversionPrice, error)
+	IsPriceHealthy(ctx sdk.Context, denom string) bool
+}
+
+// LinkedResourcesProvider provides metadata of the resources linked to a DID Document
+type LinkedResourcesProvider interface {
+	GetLinkedResourcesMetadata(ctx context.Context, collectionID string) ([]LinkedResourceMetadata, error)
+	GetLinkedResource(ctx context.Context, collectionID, id string) (LinkedResource, error)
+	// GetLinkedResourceByName returns the latest version of the resource or the version active at the given time if set
+	GetLinkedResourceByName(ctx context.Context, collectionID, name, resourceType string, at *time.Time) (LinkedResource, error)
+}
+
+// FeeEstimator estimates the fee the ante and post handlers charge for identity messages
+type FeeEstimator interface {
+	EstimateIdentityFee(ctx context.Context, req *QueryEstimateIdentityFeeRequest) (*QueryEstimateIdentityFeeResponse, error)
 }
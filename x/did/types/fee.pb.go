@@ -10,15 +10,19 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	_ "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -86,6 +90,35 @@ type FeeParams struct {
 	//
 	// Default: 0.5 (50%)
 	BurnFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=burn_factor,json=burnFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"burn_factor"`
+	// Maximum number of DID Documents created by a single batch message.
+	// Zero disables batch creation.
+	//
+	// Default: 50
+	MaxBatchSize uint32 `protobuf:"varint,5,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
+	// Time intermediate versions of DID Documents are retained after being superseded by a newer version.
+	// Older intermediate versions are pruned, keeping the first, the latest and deactivation versions
+	// as well as a hash chain of the pruned versions in the tombstone of the DID Document.
+	// Zero disables pruning.
+	//
+	// Default: 0 (disabled)
+	VersionRetentionPeriod time.Duration `protobuf:"bytes,6,opt,name=version_retention_period,json=versionRetentionPeriod,proto3,stdduration" json:"version_retention_period"`
+	// URI schemes allowed in service endpoints of DID Documents, in lowercase.
+	// An empty list allows any scheme.
+	//
+	// Default: ["http", "https", "ws", "wss", "did"]
+	AllowedServiceEndpointSchemes []string `protobuf:"bytes,7,rep,name=allowed_service_endpoint_schemes,json=allowedServiceEndpointSchemes,proto3" json:"allowed_service_endpoint_schemes,omitempty"`
+	// Discount tiers for accounts paying identity fees, by the number of identity
+	// transactions they paid within the volume discount window.
+	// The largest discount of the tiers reached applies.
+	VolumeDiscountTiers []VolumeDiscountTier `protobuf:"bytes,8,rep,name=volume_discount_tiers,json=volumeDiscountTiers,proto3" json:"volume_discount_tiers"`
+	// Discounts for allow-listed issuer accounts.
+	// The larger of the issuer discount and the volume discount of an account applies.
+	IssuerDiscounts []IssuerDiscount `protobuf:"bytes,9,rep,name=issuer_discounts,json=issuerDiscounts,proto3" json:"issuer_discounts"`
+	// Window in which identity transactions are counted for volume discounts.
+	// Zero disables volume discounts.
+	//
+	// Default: 720h (30 days)
+	VolumeDiscountWindow time.Duration `protobuf:"bytes,10,opt,name=volume_discount_window,json=volumeDiscountWindow,proto3,stdduration" json:"volume_discount_window"`
 }
 
 func (m *FeeParams) Reset()         { *m = FeeParams{} }
@@ -142,41 +175,201 @@ func (m *FeeParams) GetDeactivateDid() []FeeRange {
 	return nil
 }
 
+func (m *FeeParams) GetMaxBatchSize() uint32 {
+	if m != nil {
+		return m.MaxBatchSize
+	}
+	return 0
+}
+
+func (m *FeeParams) GetVersionRetentionPeriod() time.Duration {
+	if m != nil {
+		return m.VersionRetentionPeriod
+	}
+	return 0
+}
+
+func (m *FeeParams) GetAllowedServiceEndpointSchemes() []string {
+	if m != nil {
+		return m.AllowedServiceEndpointSchemes
+	}
+	return nil
+}
+
+func (m *FeeParams) GetVolumeDiscountTiers() []VolumeDiscountTier {
+	if m != nil {
+		return m.VolumeDiscountTiers
+	}
+	return nil
+}
+
+func (m *FeeParams) GetIssuerDiscounts() []IssuerDiscount {
+	if m != nil {
+		return m.IssuerDiscounts
+	}
+	return nil
+}
+
+func (m *FeeParams) GetVolumeDiscountWindow() time.Duration {
+	if m != nil {
+		return m.VolumeDiscountWindow
+	}
+	return 0
+}
+
+// VolumeDiscountTier is a discount on identity fees for accounts with a minimum
+// number of identity transactions within the volume discount window
+type VolumeDiscountTier struct {
+	// Minimum number of identity transactions paid within the window
+	MinTxs uint64 `protobuf:"varint,1,opt,name=min_txs,json=minTxs,proto3" json:"min_txs,omitempty"`
+	// Fraction of the identity fee waived, positive and < 1
+	Discount cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=discount,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"discount"`
+}
+
+func (m *VolumeDiscountTier) Reset()         { *m = VolumeDiscountTier{} }
+func (m *VolumeDiscountTier) String() string { return proto.CompactTextString(m) }
+func (*VolumeDiscountTier) ProtoMessage()    {}
+func (*VolumeDiscountTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0cfbae270deaac7, []int{2}
+}
+func (m *VolumeDiscountTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VolumeDiscountTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VolumeDiscountTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VolumeDiscountTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VolumeDiscountTier.Merge(m, src)
+}
+func (m *VolumeDiscountTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *VolumeDiscountTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_VolumeDiscountTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VolumeDiscountTier proto.InternalMessageInfo
+
+func (m *VolumeDiscountTier) GetMinTxs() uint64 {
+	if m != nil {
+		return m.MinTxs
+	}
+	return 0
+}
+
+// IssuerDiscount is a discount on identity fees for an allow-listed issuer account
+type IssuerDiscount struct {
+	// Bech32 address of the account paying the identity fees
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Fraction of the identity fee waived, positive and < 1
+	Discount cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=discount,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"discount"`
+}
+
+func (m *IssuerDiscount) Reset()         { *m = IssuerDiscount{} }
+func (m *IssuerDiscount) String() string { return proto.CompactTextString(m) }
+func (*IssuerDiscount) ProtoMessage()    {}
+func (*IssuerDiscount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0cfbae270deaac7, []int{3}
+}
+func (m *IssuerDiscount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IssuerDiscount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IssuerDiscount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IssuerDiscount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IssuerDiscount.Merge(m, src)
+}
+func (m *IssuerDiscount) XXX_Size() int {
+	return m.Size()
+}
+func (m *IssuerDiscount) XXX_DiscardUnknown() {
+	xxx_messageInfo_IssuerDiscount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IssuerDiscount proto.InternalMessageInfo
+
+func (m *IssuerDiscount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*FeeRange)(nil), "cheqd.did.v2.FeeRange")
 	proto.RegisterType((*FeeParams)(nil), "cheqd.did.v2.FeeParams")
+	proto.RegisterType((*VolumeDiscountTier)(nil), "cheqd.did.v2.VolumeDiscountTier")
+	proto.RegisterType((*IssuerDiscount)(nil), "cheqd.did.v2.IssuerDiscount")
 }
 
 func init() { proto.RegisterFile("cheqd/did/v2/fee.proto", fileDescriptor_b0cfbae270deaac7) }
 
 var fileDescriptor_b0cfbae270deaac7 = []byte{
-	// 408 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x31, 0x8b, 0x14, 0x31,
-	0x14, 0xc7, 0x27, 0xbb, 0xa7, 0x38, 0x39, 0x15, 0x1c, 0xce, 0x63, 0x3c, 0x71, 0xe6, 0xb8, 0xc6,
-	0x43, 0xb8, 0x04, 0x56, 0xb0, 0xd1, 0xc6, 0x75, 0x5d, 0x10, 0x2c, 0x64, 0x1a, 0xc1, 0x66, 0xc8,
-	0x26, 0xef, 0x66, 0x83, 0x24, 0x59, 0x67, 0x32, 0xcb, 0xde, 0xb7, 0xf0, 0x03, 0xd8, 0xd9, 0x5c,
-	0x69, 0xe1, 0x87, 0xd8, 0xf2, 0xb0, 0x92, 0x2b, 0x06, 0x99, 0x2d, 0xfc, 0x1a, 0x32, 0xc9, 0x0e,
-	0x0a, 0x36, 0xdb, 0x3c, 0xf2, 0xde, 0xfb, 0xff, 0xfe, 0x21, 0x2f, 0x0f, 0x1f, 0xf2, 0x39, 0x7c,
-	0x12, 0x54, 0x48, 0x41, 0x97, 0x23, 0x7a, 0x0e, 0x40, 0x16, 0xa5, 0xb1, 0x26, 0xba, 0xed, 0xea,
-	0x44, 0x48, 0x41, 0x96, 0xa3, 0xa3, 0x7b, 0x4c, 0x49, 0x6d, 0xa8, 0x8b, 0x5e, 0x70, 0xf4, 0x80,
-	0x9b, 0x4a, 0x99, 0x2a, 0x77, 0x19, 0xf5, 0xc9, 0xb6, 0x75, 0x50, 0x98, 0xc2, 0xf8, 0x7a, 0x77,
-	0xf2, 0xd5, 0x93, 0x2f, 0x08, 0xdf, 0x9a, 0x02, 0x64, 0x4c, 0x17, 0x10, 0x1d, 0xe0, 0x1b, 0x02,
-	0xb4, 0x51, 0x31, 0x3a, 0x46, 0xa7, 0x61, 0xe6, 0x93, 0xe8, 0x05, 0xc6, 0x4a, 0xea, 0x9c, 0x29,
-	0x53, 0x6b, 0x1b, 0x0f, 0xba, 0xd6, 0xf8, 0xd1, 0xba, 0x49, 0xd1, 0x75, 0x93, 0xde, 0xf7, 0x57,
-	0x54, 0xe2, 0x23, 0x91, 0x86, 0x2a, 0x66, 0xe7, 0xe4, 0x8d, 0xb6, 0x59, 0xa8, 0xa4, 0x7e, 0xe9,
-	0xf4, 0x8e, 0x66, 0xab, 0x9e, 0x1e, 0xee, 0x46, 0xb3, 0x95, 0xa7, 0x4f, 0xbe, 0x0e, 0x70, 0x38,
-	0x05, 0x78, 0xc7, 0x4a, 0xa6, 0xaa, 0xe8, 0x39, 0xc6, 0xbc, 0x04, 0x66, 0x21, 0x17, 0x52, 0xc4,
-	0xe8, 0x78, 0x78, 0xba, 0x3f, 0x3a, 0x24, 0xff, 0xce, 0x84, 0xf4, 0x6f, 0x19, 0xef, 0xad, 0x9b,
-	0x34, 0xc8, 0x42, 0xaf, 0x9f, 0x48, 0xd1, 0xc1, 0xf5, 0x42, 0xf4, 0xf0, 0x60, 0x17, 0xd8, 0xeb,
-	0x3b, 0xf8, 0x15, 0xbe, 0x2b, 0x80, 0x71, 0x2b, 0x97, 0xbd, 0xc1, 0x70, 0x07, 0x83, 0x3b, 0x7f,
-	0x99, 0xce, 0xe4, 0x3d, 0xde, 0x9f, 0xd5, 0xa5, 0xce, 0xcf, 0x19, 0xb7, 0xa6, 0x8c, 0xf7, 0xdc,
-	0x2c, 0x9e, 0x75, 0xca, 0xeb, 0x26, 0x7d, 0xf8, 0xff, 0x2c, 0xde, 0x42, 0xc1, 0xf8, 0xc5, 0x04,
-	0xf8, 0x8f, 0xef, 0x67, 0x78, 0xfb, 0x97, 0x13, 0xe0, 0x97, 0xbf, 0xbf, 0x3d, 0x41, 0x19, 0xee,
-	0xac, 0xa6, 0xce, 0x69, 0xfc, 0xfa, 0xb2, 0x4d, 0xd0, 0xba, 0x4d, 0xd0, 0x55, 0x9b, 0xa0, 0x5f,
-	0x6d, 0x82, 0x3e, 0x6f, 0x92, 0xe0, 0x6a, 0x93, 0x04, 0x3f, 0x37, 0x49, 0xf0, 0xe1, 0x71, 0x21,
-	0xed, 0xbc, 0x9e, 0x11, 0x6e, 0x14, 0xf5, 0x7b, 0xe5, 0xe2, 0x99, 0x36, 0x02, 0xe8, 0xca, 0x2d,
-	0x99, 0xbd, 0x58, 0x40, 0x35, 0xbb, 0xe9, 0x56, 0xe2, 0xe9, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x30, 0xd6, 0x88, 0xd6, 0x7e, 0x02, 0x00, 0x00,
+	// 720 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4f, 0x6b, 0xdb, 0x48,
+	0x14, 0xb7, 0xe2, 0xfc, 0xb1, 0x27, 0x7f, 0x76, 0x57, 0xeb, 0x78, 0x95, 0xec, 0x46, 0x36, 0x66,
+	0x61, 0xcd, 0x42, 0x24, 0xf0, 0xc2, 0x5e, 0xda, 0x4b, 0x5c, 0x27, 0x25, 0xd0, 0x42, 0x90, 0x43,
+	0x43, 0x03, 0x45, 0x8c, 0x35, 0x2f, 0xf2, 0x50, 0x6b, 0xc6, 0xd5, 0x8c, 0x1c, 0x27, 0xb7, 0x7e,
+	0x83, 0x1e, 0x73, 0xe8, 0x07, 0xc8, 0xb1, 0x87, 0x7c, 0x88, 0x1c, 0x43, 0x4e, 0x25, 0x07, 0xb7,
+	0x38, 0x87, 0x7e, 0x84, 0x5e, 0x8b, 0x46, 0x52, 0x5a, 0x37, 0x17, 0x43, 0xe9, 0x65, 0xd0, 0x7b,
+	0xef, 0xf7, 0xfb, 0xe9, 0xcd, 0x6f, 0xde, 0x0c, 0x2a, 0x7b, 0x5d, 0x78, 0x45, 0x6c, 0x42, 0x89,
+	0x3d, 0x68, 0xd8, 0x47, 0x00, 0x56, 0x3f, 0xe4, 0x92, 0xeb, 0x4b, 0x2a, 0x6f, 0x11, 0x4a, 0xac,
+	0x41, 0x63, 0xfd, 0x37, 0x1c, 0x50, 0xc6, 0x6d, 0xb5, 0x26, 0x80, 0xf5, 0x35, 0x8f, 0x8b, 0x80,
+	0x0b, 0x57, 0x45, 0x76, 0x12, 0xa4, 0xa5, 0x92, 0xcf, 0x7d, 0x9e, 0xe4, 0xe3, 0xaf, 0x34, 0x6b,
+	0xfa, 0x9c, 0xfb, 0x3d, 0xb0, 0x55, 0xd4, 0x89, 0x8e, 0x6c, 0x12, 0x85, 0x58, 0x52, 0xce, 0x92,
+	0x7a, 0xed, 0xad, 0x86, 0x0a, 0x3b, 0x00, 0x0e, 0x66, 0x3e, 0xe8, 0x25, 0x34, 0x47, 0x80, 0xf1,
+	0xc0, 0xd0, 0xaa, 0x5a, 0xbd, 0xe8, 0x24, 0x81, 0xfe, 0x10, 0xa1, 0x80, 0x32, 0x17, 0x07, 0x3c,
+	0x62, 0xd2, 0x98, 0x89, 0x4b, 0xcd, 0x8d, 0xcb, 0x51, 0x45, 0xbb, 0x19, 0x55, 0x56, 0x93, 0x16,
+	0x04, 0x79, 0x69, 0x51, 0x6e, 0x07, 0x58, 0x76, 0xad, 0x5d, 0x26, 0x9d, 0x62, 0x40, 0xd9, 0x96,
+	0xc2, 0x2b, 0x36, 0x1e, 0x66, 0xec, 0xfc, 0x74, 0x6c, 0x3c, 0x4c, 0xd8, 0xb5, 0xcf, 0x73, 0xa8,
+	0xb8, 0x03, 0xb0, 0x87, 0x43, 0x1c, 0x08, 0xfd, 0x01, 0x42, 0x5e, 0x08, 0x58, 0x82, 0x4b, 0x28,
+	0x31, 0xb4, 0x6a, 0xbe, 0xbe, 0xd8, 0x28, 0x5b, 0xdf, 0x7a, 0x66, 0x65, 0x7b, 0x69, 0xce, 0x5e,
+	0x8e, 0x2a, 0x39, 0xa7, 0x98, 0xe0, 0x5b, 0x94, 0xc4, 0xe4, 0xa8, 0x4f, 0x32, 0xf2, 0xcc, 0x34,
+	0xe4, 0x04, 0x1f, 0x93, 0x1f, 0xa1, 0x15, 0x02, 0xd8, 0x93, 0x74, 0x90, 0x09, 0xe4, 0xa7, 0x10,
+	0x58, 0xfe, 0xca, 0x89, 0x45, 0x0e, 0xd0, 0x62, 0x27, 0x0a, 0x99, 0x7b, 0x84, 0x3d, 0xc9, 0x43,
+	0x63, 0x56, 0x79, 0xf1, 0x7f, 0x8c, 0xbc, 0x19, 0x55, 0xfe, 0xbc, 0xef, 0xc5, 0x13, 0xf0, 0xb1,
+	0x77, 0xd2, 0x02, 0xef, 0xfa, 0x62, 0x13, 0xa5, 0x67, 0xdd, 0x02, 0xef, 0xfc, 0xd3, 0xbb, 0x7f,
+	0x35, 0x07, 0xc5, 0x52, 0x3b, 0x4a, 0x49, 0xff, 0x1b, 0xad, 0xc4, 0x1e, 0x77, 0xb0, 0xf4, 0xba,
+	0xae, 0xa0, 0xa7, 0x60, 0xcc, 0x55, 0xb5, 0xfa, 0xb2, 0xb3, 0x14, 0xe0, 0x61, 0x33, 0x4e, 0xb6,
+	0xe9, 0x29, 0xe8, 0x2f, 0x90, 0x31, 0x80, 0x50, 0x50, 0xce, 0xdc, 0x10, 0x24, 0xb0, 0x78, 0x0a,
+	0xdc, 0x3e, 0x84, 0x94, 0x13, 0x63, 0xbe, 0xaa, 0xd5, 0x17, 0x1b, 0x6b, 0x56, 0x32, 0x2d, 0x56,
+	0x36, 0x2d, 0x56, 0x2b, 0x9d, 0x96, 0x66, 0x21, 0x6e, 0xf3, 0xec, 0x43, 0x45, 0x73, 0xca, 0xa9,
+	0x88, 0x93, 0x69, 0xec, 0x29, 0x09, 0xfd, 0x31, 0xaa, 0xe2, 0x5e, 0x8f, 0x1f, 0x03, 0x71, 0x05,
+	0x84, 0x03, 0xea, 0x81, 0x0b, 0x8c, 0xf4, 0x39, 0x65, 0xd2, 0x15, 0x5e, 0x17, 0x02, 0x10, 0xc6,
+	0x42, 0x35, 0x5f, 0x2f, 0x3a, 0x1b, 0x29, 0xae, 0x9d, 0xc0, 0xb6, 0x53, 0x54, 0x3b, 0x01, 0xe9,
+	0x87, 0x68, 0x75, 0xc0, 0x7b, 0x51, 0x10, 0xfb, 0x2c, 0xbc, 0x78, 0x0c, 0x5c, 0x49, 0x21, 0x14,
+	0x46, 0x41, 0x59, 0x5e, 0x9d, 0xb4, 0xfc, 0x99, 0x82, 0xb6, 0x52, 0xe4, 0x3e, 0x85, 0x30, 0x35,
+	0xff, 0xf7, 0xc1, 0xbd, 0x8a, 0xd0, 0x9f, 0xa2, 0x5f, 0xa9, 0x10, 0x11, 0x84, 0x77, 0xda, 0xc2,
+	0x28, 0x2a, 0xd9, 0xbf, 0x26, 0x65, 0x77, 0x15, 0x2a, 0x23, 0xa7, 0x92, 0xbf, 0xd0, 0x89, 0xac,
+	0xd0, 0x9f, 0xa3, 0xf2, 0xf7, 0xad, 0x1e, 0x53, 0x46, 0xf8, 0xb1, 0x81, 0xa6, 0x37, 0xb4, 0x34,
+	0xd9, 0xe8, 0x81, 0x12, 0xa8, 0xbd, 0xd6, 0x90, 0x7e, 0x7f, 0x6f, 0xfa, 0x1f, 0x68, 0x21, 0xbe,
+	0x8c, 0x72, 0x28, 0xd4, 0x25, 0x9d, 0x75, 0xe6, 0x03, 0xca, 0xf6, 0x87, 0x42, 0x77, 0x50, 0x21,
+	0xeb, 0xc1, 0x98, 0xf9, 0xa1, 0xc9, 0xba, 0xd3, 0xa9, 0x9d, 0x69, 0x68, 0x65, 0xd2, 0x08, 0xbd,
+	0x81, 0x16, 0x30, 0x21, 0x21, 0x88, 0xe4, 0xff, 0xc5, 0xa6, 0x71, 0x7d, 0xb1, 0x59, 0x4a, 0x25,
+	0xb6, 0x92, 0x4a, 0x5b, 0x86, 0x94, 0xf9, 0x4e, 0x06, 0xfc, 0x19, 0xad, 0x35, 0xb7, 0xcf, 0xc7,
+	0xa6, 0x76, 0x39, 0x36, 0xb5, 0xab, 0xb1, 0xa9, 0x7d, 0x1c, 0x9b, 0xda, 0x9b, 0x5b, 0x33, 0x77,
+	0x75, 0x6b, 0xe6, 0xde, 0xdf, 0x9a, 0xb9, 0xc3, 0x7f, 0x7c, 0x2a, 0xbb, 0x51, 0xc7, 0xf2, 0x78,
+	0x60, 0x27, 0x4f, 0xad, 0x5a, 0x37, 0x19, 0x27, 0x60, 0x0f, 0xd5, 0xbb, 0x2b, 0x4f, 0xfa, 0x20,
+	0x3a, 0xf3, 0xea, 0x60, 0xfe, 0xfb, 0x32, 0x00, 0x9b, 0x54, 0x55, 0x2a, 0x91, 0x05, 0x00, 0x00,
 }
 
 func (this *FeeRange) Equal(that interface{}) bool {
@@ -263,6 +456,93 @@ func (this *FeeParams) Equal(that interface{}) bool {
 	if !this.BurnFactor.Equal(that1.BurnFactor) {
 		return false
 	}
+	if this.MaxBatchSize != that1.MaxBatchSize {
+		return false
+	}
+	if this.VersionRetentionPeriod != that1.VersionRetentionPeriod {
+		return false
+	}
+	if len(this.AllowedServiceEndpointSchemes) != len(that1.AllowedServiceEndpointSchemes) {
+		return false
+	}
+	for i := range this.AllowedServiceEndpointSchemes {
+		if this.AllowedServiceEndpointSchemes[i] != that1.AllowedServiceEndpointSchemes[i] {
+			return false
+		}
+	}
+	if len(this.VolumeDiscountTiers) != len(that1.VolumeDiscountTiers) {
+		return false
+	}
+	for i := range this.VolumeDiscountTiers {
+		if !this.VolumeDiscountTiers[i].Equal(&that1.VolumeDiscountTiers[i]) {
+			return false
+		}
+	}
+	if len(this.IssuerDiscounts) != len(that1.IssuerDiscounts) {
+		return false
+	}
+	for i := range this.IssuerDiscounts {
+		if !this.IssuerDiscounts[i].Equal(&that1.IssuerDiscounts[i]) {
+			return false
+		}
+	}
+	if this.VolumeDiscountWindow != that1.VolumeDiscountWindow {
+		return false
+	}
+	return true
+}
+func (this *VolumeDiscountTier) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VolumeDiscountTier)
+	if !ok {
+		that2, ok := that.(VolumeDiscountTier)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MinTxs != that1.MinTxs {
+		return false
+	}
+	if !this.Discount.Equal(that1.Discount) {
+		return false
+	}
+	return true
+}
+func (this *IssuerDiscount) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IssuerDiscount)
+	if !ok {
+		that2, ok := that.(IssuerDiscount)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.Discount.Equal(that1.Discount) {
+		return false
+	}
 	return true
 }
 func (m *FeeRange) Marshal() (dAtA []byte, err error) {
@@ -339,6 +619,64 @@ func (m *FeeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VolumeDiscountWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VolumeDiscountWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintFee(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x52
+	if len(m.IssuerDiscounts) > 0 {
+		for iNdEx := len(m.IssuerDiscounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IssuerDiscounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.VolumeDiscountTiers) > 0 {
+		for iNdEx := len(m.VolumeDiscountTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VolumeDiscountTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.AllowedServiceEndpointSchemes) > 0 {
+		for iNdEx := len(m.AllowedServiceEndpointSchemes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedServiceEndpointSchemes[iNdEx])
+			copy(dAtA[i:], m.AllowedServiceEndpointSchemes[iNdEx])
+			i = encodeVarintFee(dAtA, i, uint64(len(m.AllowedServiceEndpointSchemes[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VersionRetentionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VersionRetentionPeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintFee(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
+	if m.MaxBatchSize != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.MaxBatchSize))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size := m.BurnFactor.Size()
 		i -= size
@@ -394,6 +732,84 @@ func (m *FeeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VolumeDiscountTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VolumeDiscountTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VolumeDiscountTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Discount.Size()
+		i -= size
+		if _, err := m.Discount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.MinTxs != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.MinTxs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IssuerDiscount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IssuerDiscount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IssuerDiscount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Discount.Size()
+		i -= size
+		if _, err := m.Discount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFee(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFee(v)
 	base := offset
@@ -452,16 +868,70 @@ func (m *FeeParams) Size() (n int) {
 	}
 	l = m.BurnFactor.Size()
 	n += 1 + l + sovFee(uint64(l))
+	if m.MaxBatchSize != 0 {
+		n += 1 + sovFee(uint64(m.MaxBatchSize))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VersionRetentionPeriod)
+	n += 1 + l + sovFee(uint64(l))
+	if len(m.AllowedServiceEndpointSchemes) > 0 {
+		for _, s := range m.AllowedServiceEndpointSchemes {
+			l = len(s)
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if len(m.VolumeDiscountTiers) > 0 {
+		for _, e := range m.VolumeDiscountTiers {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if len(m.IssuerDiscounts) > 0 {
+		for _, e := range m.IssuerDiscounts {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VolumeDiscountWindow)
+	n += 1 + l + sovFee(uint64(l))
 	return n
 }
 
-func sovFee(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozFee(x uint64) (n int) {
-	return sovFee(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *FeeRange) Unmarshal(dAtA []byte) error {
+func (m *VolumeDiscountTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinTxs != 0 {
+		n += 1 + sovFee(uint64(m.MinTxs))
+	}
+	l = m.Discount.Size()
+	n += 1 + l + sovFee(uint64(l))
+	return n
+}
+
+func (m *IssuerDiscount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	l = m.Discount.Size()
+	n += 1 + l + sovFee(uint64(l))
+	return n
+}
+
+func sovFee(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFee(x uint64) (n int) {
+	return sovFee(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FeeRange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -780,6 +1250,410 @@ func (m *FeeParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchSize", wireType)
+			}
+			m.MaxBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionRetentionPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.VersionRetentionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedServiceEndpointSchemes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedServiceEndpointSchemes = append(m.AllowedServiceEndpointSchemes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeDiscountTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VolumeDiscountTiers = append(m.VolumeDiscountTiers, VolumeDiscountTier{})
+			if err := m.VolumeDiscountTiers[len(m.VolumeDiscountTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerDiscounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssuerDiscounts = append(m.IssuerDiscounts, IssuerDiscount{})
+			if err := m.IssuerDiscounts[len(m.IssuerDiscounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeDiscountWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.VolumeDiscountWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VolumeDiscountTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VolumeDiscountTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VolumeDiscountTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTxs", wireType)
+			}
+			m.MinTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Discount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IssuerDiscount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IssuerDiscount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IssuerDiscount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Discount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFee(dAtA[iNdEx:])
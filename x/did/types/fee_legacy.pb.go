@@ -108,7 +108,7 @@ func init() {
 func init() { proto.RegisterFile("cheqd/did/v2/fee_legacy.proto", fileDescriptor_a0aa92f4c84aee98) }
 
 var fileDescriptor_a0aa92f4c84aee98 = []byte{
-	// 358 bytes of a gzipped FileDescriptorProto
+	// 355 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x3f, 0x4f, 0x3a, 0x31,
 	0x18, 0xc7, 0xaf, 0xfc, 0xc8, 0x2f, 0xa1, 0xf8, 0x27, 0x12, 0x07, 0xc0, 0x58, 0x88, 0x8b, 0xc4,
 	0x84, 0x36, 0x60, 0xe2, 0xe8, 0x80, 0xc8, 0xe4, 0x60, 0x58, 0x4c, 0x5c, 0x2e, 0xbd, 0xb6, 0x1c,
@@ -130,8 +130,8 @@ var fileDescriptor_a0aa92f4c84aee98 = []byte{
 	0x98, 0xa9, 0x06, 0xc6, 0xd4, 0xbb, 0x9e, 0xa7, 0x08, 0x2c, 0x52, 0x04, 0x96, 0x29, 0x02, 0xdf,
 	0x29, 0x02, 0x2f, 0x6b, 0xe4, 0x2c, 0xd7, 0xc8, 0xf9, 0x5c, 0x23, 0xe7, 0xfe, 0xd4, 0x97, 0x7a,
 	0x3c, 0xf5, 0x30, 0x53, 0x01, 0xb1, 0xeb, 0x36, 0x67, 0x3b, 0x54, 0x5c, 0x90, 0x67, 0xb3, 0x7b,
-	0x3d, 0x9b, 0x88, 0xd8, 0xfb, 0x6f, 0x56, 0x70, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x81, 0xe2,
-	0x29, 0x15, 0x15, 0x02, 0x00, 0x00,
+	0x3d, 0x9b, 0x88, 0xd8, 0xfb, 0x6f, 0x56, 0x70, 0xfe, 0x3b, 0x00, 0x81, 0xe2, 0x29, 0x15, 0x15,
+	0x02, 0x00, 0x00,
 }
 
 func (this *LegacyFeeParams) Equal(that interface{}) bool {
@@ -2,16 +2,22 @@ package types
 
 import (
 	"fmt"
+	"time"
 )
 
 const (
-	DefaultDidNamespace       = "testnet"
-	DefaultCreateDidTxFee     = 50e9                   // 50 CHEQ or 50000000000 ncheq
-	DefaultUpdateDidTxFee     = 25e9                   // 25 CHEQ or 25000000000 ncheq
-	DefaultDeactivateDidTxFee = 10e9                   // 10 CHEQ or 10000000000 ncheq
-	DefaultBurnFactor         = "0.500000000000000000" // 0.5 or 50%
+	DefaultDidNamespace         = "testnet"
+	DefaultCreateDidTxFee       = 50e9                   // 50 CHEQ or 50000000000 ncheq
+	DefaultUpdateDidTxFee       = 25e9                   // 25 CHEQ or 25000000000 ncheq
+	DefaultDeactivateDidTxFee   = 10e9                   // 10 CHEQ or 10000000000 ncheq
+	DefaultBurnFactor           = "0.500000000000000000" // 0.5 or 50%
+	DefaultMaxBatchSize         = 50                     // DID Documents per batch message
+	DefaultVolumeDiscountWindow = 30 * 24 * time.Hour    // 30 days
 )
 
+// DefaultAllowedServiceEndpointSchemes are the URI schemes allowed in service endpoints by default
+var DefaultAllowedServiceEndpointSchemes = []string{"http", "https", "ws", "wss", "did"}
+
 // DefaultGenesis returns the default `did` genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -34,11 +40,21 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	err = gs.ValidateTombstones()
+	if err != nil {
+		return err
+	}
+
 	err = gs.FeeParams.ValidateBasic()
 	if err != nil {
 		return err
 	}
 
+	err = gs.ValidateIdentityCreditAccounts()
+	if err != nil {
+		return err
+	}
+
 	return gs.ValidateBasic()
 }
 
@@ -89,6 +105,63 @@ func (gs GenesisState) ValidateVersionSets() error {
 	return nil
 }
 
+// ValidateTombstones checks that tombstones belong to version sets, form valid hash chains
+// and that pruned versions are not part of the version sets
+func (gs GenesisState) ValidateTombstones() error {
+	versionSets := make(map[string]*DidDocVersionSet, len(gs.VersionSets))
+	for _, versionSet := range gs.VersionSets {
+		versionSets[versionSet.DidDocs[0].DidDoc.Id] = versionSet
+	}
+
+	tombstoneCache := make(map[string]bool)
+
+	for _, tombstone := range gs.Tombstones {
+		if _, ok := tombstoneCache[tombstone.Id]; ok {
+			return fmt.Errorf("duplicated tombstone found with id %s", tombstone.Id)
+		}
+
+		tombstoneCache[tombstone.Id] = true
+
+		versionSet, ok := versionSets[tombstone.Id]
+		if !ok {
+			return fmt.Errorf("tombstone %s does not belong to a version set", tombstone.Id)
+		}
+
+		err := tombstone.Validate()
+		if err != nil {
+			return err
+		}
+
+		for _, didDoc := range versionSet.DidDocs {
+			if tombstone.HasPrunedVersion(didDoc.Metadata.VersionId) {
+				return fmt.Errorf("pruned version %s of %s found in version set", didDoc.Metadata.VersionId, tombstone.Id)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateIdentityCreditAccounts checks that identity credit accounts are valid and unique
+func (gs GenesisState) ValidateIdentityCreditAccounts() error {
+	accountCache := make(map[string]bool)
+
+	for _, account := range gs.IdentityCreditAccounts {
+		if _, ok := accountCache[account.Address]; ok {
+			return fmt.Errorf("duplicated identity credit account found with address %s", account.Address)
+		}
+
+		accountCache[account.Address] = true
+
+		err := account.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (gs GenesisState) ValidateBasic() error {
 	for _, versionSet := range gs.VersionSets {
 		for _, didDoc := range versionSet.DidDocs {
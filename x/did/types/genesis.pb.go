@@ -88,6 +88,11 @@ type GenesisState struct {
 	// Fee parameters for the DID module
 	// Defines fixed fees and burn percentage for each DID operation type (create, update, delete)
 	FeeParams *FeeParams `protobuf:"bytes,3,opt,name=fee_params,json=feeParams,proto3" json:"fee_params,omitempty"`
+	// Tombstones of DID Documents with pruned versions.
+	// Pruned versions are not part of the version sets.
+	Tombstones []*DidDocTombstone `protobuf:"bytes,4,rep,name=tombstones,proto3" json:"tombstones,omitempty"`
+	// Prepaid identity credits and volume discount windows of accounts paying identity fees
+	IdentityCreditAccounts []*IdentityCreditAccount `protobuf:"bytes,5,rep,name=identity_credit_accounts,json=identityCreditAccounts,proto3" json:"identity_credit_accounts,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -144,6 +149,20 @@ func (m *GenesisState) GetFeeParams() *FeeParams {
 	return nil
 }
 
+func (m *GenesisState) GetTombstones() []*DidDocTombstone {
+	if m != nil {
+		return m.Tombstones
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIdentityCreditAccounts() []*IdentityCreditAccount {
+	if m != nil {
+		return m.IdentityCreditAccounts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*DidDocVersionSet)(nil), "cheqd.did.v2.DidDocVersionSet")
 	proto.RegisterType((*GenesisState)(nil), "cheqd.did.v2.GenesisState")
@@ -152,28 +171,32 @@ func init() {
 func init() { proto.RegisterFile("cheqd/did/v2/genesis.proto", fileDescriptor_83613517e395af68) }
 
 var fileDescriptor_83613517e395af68 = []byte{
-	// 325 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x50, 0x41, 0x4b, 0xf3, 0x40,
-	0x10, 0xed, 0x7e, 0x85, 0x4f, 0xbb, 0x4d, 0x45, 0x72, 0xd0, 0xda, 0xc3, 0x12, 0x2a, 0x62, 0x2f,
-	0x26, 0x10, 0xc1, 0x8b, 0xa7, 0x4a, 0xd1, 0x93, 0x22, 0x29, 0x28, 0x78, 0x09, 0xdb, 0xcc, 0xb4,
-	0x5d, 0xb0, 0xd9, 0xd8, 0x1d, 0x83, 0xfe, 0x0b, 0x7f, 0x89, 0xbf, 0xc3, 0x63, 0x8f, 0x1e, 0xa5,
-	0xfd, 0x23, 0xe2, 0x6e, 0xab, 0x16, 0xbc, 0x0c, 0xc3, 0x7b, 0x8f, 0xf7, 0x66, 0x1e, 0x6f, 0x65,
-	0x63, 0x7c, 0x80, 0x08, 0x14, 0x44, 0x65, 0x1c, 0x8d, 0x30, 0x47, 0xa3, 0x4c, 0x58, 0x4c, 0x35,
-	0x69, 0xdf, 0xb3, 0x5c, 0x08, 0x0a, 0xc2, 0x32, 0x6e, 0xed, 0xad, 0x29, 0x41, 0x01, 0xe8, 0xcc,
-	0x09, 0x5b, 0x3b, 0x6b, 0xd4, 0x10, 0xd1, 0xe1, 0xed, 0x92, 0x6f, 0xf7, 0x14, 0xf4, 0x74, 0x76,
-	0x83, 0x53, 0xa3, 0x74, 0xde, 0x47, 0xf2, 0x0f, 0xf8, 0xd6, 0xbd, 0x24, 0x34, 0x94, 0x96, 0x0e,
-	0x6c, 0xb2, 0x80, 0x75, 0x6a, 0x49, 0xc3, 0xa1, 0x4b, 0xa5, 0x7f, 0xca, 0x37, 0x41, 0x41, 0x0a,
-	0x3a, 0x33, 0xcd, 0x7f, 0x41, 0xb5, 0x53, 0x8f, 0x83, 0xf0, 0xf7, 0x39, 0xa1, 0x33, 0xbe, 0x55,
-	0x34, 0xbe, 0x44, 0x92, 0x20, 0x49, 0x26, 0x1b, 0x60, 0x31, 0xd3, 0x7e, 0x65, 0xdc, 0xbb, 0x70,
-	0xaf, 0xf4, 0x49, 0x12, 0xfa, 0xfb, 0xbc, 0xf1, 0xe5, 0x96, 0xcb, 0x09, 0x9a, 0x42, 0x66, 0xb8,
-	0xcc, 0xf4, 0x40, 0xc1, 0xd5, 0x0a, 0xf3, 0xbb, 0xdc, 0x5b, 0x9e, 0x94, 0x1a, 0xa4, 0x55, 0xac,
-	0xf8, 0x2b, 0xf6, 0xe7, 0x9f, 0xa4, 0x5e, 0x7e, 0xef, 0xc6, 0x3f, 0xe1, 0x7c, 0x88, 0x98, 0x16,
-	0x72, 0x2a, 0x27, 0xa6, 0x59, 0x0d, 0x58, 0xa7, 0x1e, 0xef, 0xae, 0x1b, 0x9c, 0x23, 0x5e, 0x5b,
-	0x3a, 0xa9, 0x0d, 0x57, 0xeb, 0x59, 0xf7, 0x6d, 0x2e, 0xd8, 0x6c, 0x2e, 0xd8, 0xc7, 0x5c, 0xb0,
-	0x97, 0x85, 0xa8, 0xcc, 0x16, 0xa2, 0xf2, 0xbe, 0x10, 0x95, 0xbb, 0xc3, 0x91, 0xa2, 0xf1, 0xe3,
-	0x20, 0xcc, 0xf4, 0x24, 0x72, 0x2d, 0xdb, 0x79, 0x94, 0x6b, 0xc0, 0xe8, 0xc9, 0x56, 0x4e, 0xcf,
-	0x05, 0x9a, 0xc1, 0x7f, 0x5b, 0xf9, 0xf1, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0xed, 0xeb, 0xc7,
-	0x91, 0xd1, 0x01, 0x00, 0x00,
+	// 394 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x4f, 0xab, 0xd3, 0x40,
+	0x14, 0xc5, 0x9b, 0xf7, 0xfc, 0xf7, 0xa6, 0x79, 0x22, 0xb3, 0x78, 0xc6, 0x82, 0x21, 0xbc, 0x87,
+	0xd8, 0x8d, 0x09, 0x44, 0x70, 0x23, 0x2e, 0xaa, 0x45, 0x71, 0xa1, 0x48, 0x2a, 0x0a, 0x82, 0x84,
+	0xe9, 0xdc, 0xdb, 0x76, 0xc0, 0x64, 0x62, 0xe6, 0x36, 0xd8, 0x6f, 0xe1, 0xc7, 0x72, 0xe1, 0xa2,
+	0x4b, 0x97, 0xd2, 0x7e, 0x11, 0x71, 0x26, 0xd1, 0x06, 0xba, 0x09, 0xc3, 0x39, 0xbf, 0x9c, 0x33,
+	0x97, 0xb9, 0x6c, 0x24, 0x57, 0xf8, 0x15, 0x12, 0x50, 0x90, 0x34, 0x69, 0xb2, 0xc4, 0x12, 0x8d,
+	0x32, 0x71, 0x55, 0x6b, 0xd2, 0xdc, 0xb7, 0x5e, 0x0c, 0x0a, 0xe2, 0x26, 0x1d, 0xf5, 0x49, 0x59,
+	0x23, 0x28, 0x6a, 0xc9, 0xd1, 0xbd, 0x9e, 0x07, 0x0a, 0x40, 0xcb, 0xd6, 0xba, 0xe8, 0x59, 0x0b,
+	0x44, 0xa7, 0x5f, 0x36, 0xec, 0xce, 0x54, 0xc1, 0x54, 0xcb, 0x0f, 0x58, 0x1b, 0xa5, 0xcb, 0x19,
+	0x12, 0x7f, 0xc0, 0x6e, 0x7f, 0x11, 0x84, 0x86, 0xf2, 0xc6, 0x89, 0x81, 0x17, 0x79, 0xe3, 0xb3,
+	0xec, 0xdc, 0xa9, 0x2d, 0xc9, 0x9f, 0xb2, 0x5b, 0xa0, 0x20, 0x07, 0x2d, 0x4d, 0x70, 0x12, 0x9d,
+	0x8e, 0x87, 0x69, 0x14, 0x1f, 0x5e, 0x35, 0x76, 0xc1, 0x1f, 0x15, 0xad, 0xde, 0x20, 0x09, 0x10,
+	0x24, 0xb2, 0x9b, 0x60, 0x35, 0x73, 0xf9, 0xf3, 0x84, 0xf9, 0xaf, 0xdc, 0x98, 0x33, 0x12, 0x84,
+	0xfc, 0x8a, 0x9d, 0xff, 0x4d, 0x2b, 0x45, 0x81, 0xa6, 0x12, 0x12, 0xdb, 0x4e, 0x1f, 0x14, 0xbc,
+	0xed, 0x34, 0x3e, 0x61, 0x7e, 0x7b, 0xa5, 0xdc, 0x20, 0x75, 0xb5, 0xe1, 0xb1, 0xda, 0xff, 0xf3,
+	0x64, 0xc3, 0xe6, 0xdf, 0xd9, 0xf0, 0x27, 0x8c, 0x2d, 0x10, 0xf3, 0x4a, 0xd4, 0xa2, 0x30, 0xc1,
+	0x69, 0xe4, 0x8d, 0x87, 0xe9, 0xdd, 0x7e, 0xc0, 0x4b, 0xc4, 0x77, 0xd6, 0xce, 0xce, 0x16, 0xdd,
+	0x91, 0x3f, 0x63, 0x8c, 0x74, 0x31, 0x37, 0xa4, 0x4b, 0x34, 0xc1, 0x35, 0x5b, 0x7c, 0xff, 0x58,
+	0xf1, 0xfb, 0x8e, 0xca, 0x0e, 0x7e, 0xe0, 0x9f, 0x59, 0xa0, 0x00, 0x4b, 0x52, 0xb4, 0xc9, 0xdd,
+	0xa3, 0xe5, 0x42, 0x4a, 0xbd, 0x2e, 0xc9, 0x04, 0xd7, 0x6d, 0xd8, 0x55, 0x3f, 0xec, 0x75, 0x4b,
+	0xbf, 0xb0, 0xf0, 0xc4, 0xb1, 0xd9, 0x85, 0x3a, 0x26, 0x9b, 0xe7, 0x93, 0x1f, 0xbb, 0xd0, 0xdb,
+	0xee, 0x42, 0xef, 0xf7, 0x2e, 0xf4, 0xbe, 0xef, 0xc3, 0xc1, 0x76, 0x1f, 0x0e, 0x7e, 0xed, 0xc3,
+	0xc1, 0xa7, 0x87, 0x4b, 0x45, 0xab, 0xf5, 0x3c, 0x96, 0xba, 0x48, 0xdc, 0x0e, 0xd8, 0xef, 0xa3,
+	0x52, 0x03, 0x26, 0xdf, 0xec, 0x42, 0xd0, 0xa6, 0x42, 0x33, 0xbf, 0x61, 0x17, 0xe2, 0xf1, 0x9f,
+	0x01, 0x00, 0xe0, 0x1d, 0x12, 0x24, 0x8b, 0x02, 0x00, 0x00,
 }
 
 func (m *DidDocVersionSet) Marshal() (dAtA []byte, err error) {
@@ -240,6 +263,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IdentityCreditAccounts) > 0 {
+		for iNdEx := len(m.IdentityCreditAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IdentityCreditAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Tombstones) > 0 {
+		for iNdEx := len(m.Tombstones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tombstones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.FeeParams != nil {
 		{
 			size, err := m.FeeParams.MarshalToSizedBuffer(dAtA[:i])
@@ -326,6 +377,18 @@ func (m *GenesisState) Size() (n int) {
 		l = m.FeeParams.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.Tombstones) > 0 {
+		for _, e := range m.Tombstones {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IdentityCreditAccounts) > 0 {
+		for _, e := range m.IdentityCreditAccounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -582,6 +645,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tombstones = append(m.Tombstones, &DidDocTombstone{})
+			if err := m.Tombstones[len(m.Tombstones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentityCreditAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdentityCreditAccounts = append(m.IdentityCreditAccounts, &IdentityCreditAccount{})
+			if err := m.IdentityCreditAccounts[len(m.IdentityCreditAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -23,13 +23,31 @@ const (
 // State:
 // did-namespace: -> <did-namespace>
 // did-count: -> <did-count>
+// did-version-count: -> <did-version-count>
+// did-deactivated-count: -> <deactivated-did-count>
 // did-latest:<did> -> <latest-version>
 // did-version:<did>:<version> -> <did-doc>
+// did-version-time:<did>:<time> -> <version>
+// did-tombstone:<did> -> <tombstone>
+// did-prune-queue:<time>:<did> -> <none>
+// did-controller:<controller>:<did> -> <none>
+// did-key:<fingerprint>:<verification-method-id> -> <none>
+// did-alias:<alias>:<did> -> <none>
+// did-credits:<address> -> <identity-credit-account>
 
 var (
 	DidDocCountKeyPrefix         = collections.NewPrefix(DidDocCountKey)
 	DidNamespaceKeyPrefix        = collections.NewPrefix("did-namespace:")
+	DidDocVersionCountKeyPrefix  = collections.NewPrefix("did-version-count:")
+	DeactivatedDidCountKeyPrefix = collections.NewPrefix("did-deactivated-count:")
 	LatestDidDocVersionKeyPrefix = collections.NewPrefix("did-latest:")
 	DidDocVersionKeyPrefix       = collections.NewPrefix(DidDocVersionKey)
+	DidDocVersionTimeKeyPrefix   = collections.NewPrefix("did-version-time:")
 	ParamStoreKeyFeeParams       = collections.NewPrefix("feeparams")
+	DidDocTombstoneKeyPrefix     = collections.NewPrefix("did-tombstone:")
+	DidDocPruneQueueKeyPrefix    = collections.NewPrefix("did-prune-queue:")
+	DidsByControllerKeyPrefix    = collections.NewPrefix("did-controller:")
+	DidsByKeyKeyPrefix           = collections.NewPrefix("did-key:")
+	DidsByAliasKeyPrefix         = collections.NewPrefix("did-alias:")
+	IdentityCreditsKeyPrefix     = collections.NewPrefix("did-credits:")
 )
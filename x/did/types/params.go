@@ -3,9 +3,11 @@ package types
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/cheqd/cheqd-node/util"
+	"github.com/cheqd/cheqd-node/x/did/utils"
 	oracletypes "github.com/cheqd/cheqd-node/x/oracle/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -35,7 +37,10 @@ func DefaultFeeParams() *FeeParams {
 				MaxAmount: util.PtrInt(20000000000),
 			},
 		},
-		BurnFactor: sdkmath.LegacyMustNewDecFromStr(DefaultBurnFactor),
+		BurnFactor:                    sdkmath.LegacyMustNewDecFromStr(DefaultBurnFactor),
+		MaxBatchSize:                  DefaultMaxBatchSize,
+		AllowedServiceEndpointSchemes: append([]string{}, DefaultAllowedServiceEndpointSchemes...),
+		VolumeDiscountWindow:          DefaultVolumeDiscountWindow,
 	}
 }
 
@@ -64,7 +69,10 @@ func DefaultUSDParams() *FeeParams {
 				MaxAmount: util.PtrInt(400000000000000000),
 			},
 		},
-		BurnFactor: sdkmath.LegacyMustNewDecFromStr(DefaultBurnFactor),
+		BurnFactor:                    sdkmath.LegacyMustNewDecFromStr(DefaultBurnFactor),
+		MaxBatchSize:                  DefaultMaxBatchSize,
+		AllowedServiceEndpointSchemes: append([]string{}, DefaultAllowedServiceEndpointSchemes...),
+		VolumeDiscountWindow:          DefaultVolumeDiscountWindow,
 	}
 }
 
@@ -109,24 +117,26 @@ func convertFeeRangeToUSD(ctx context.Context, oracleKeeper OracleKeeper, fr Fee
 		}
 
 	case BaseMinimalDenom:
-		price, found := oracleKeeper.GetWMA(sdkCtx, oracletypes.CheqdSymbol, "BALANCED")
-		if !found || !price.IsPositive() {
+		if !oracleKeeper.IsPriceHealthy(sdkCtx, oracletypes.CheqdSymbol) {
+			return USDRange{}, fmt.Errorf("CHEQ/USD price circuit breaker is tripped")
+		}
+		price, err := oracleKeeper.GetFeeConversionPrice(sdkCtx, oracletypes.CheqdSymbol)
+		if err != nil || !price.Price.IsPositive() {
 			return USDRange{}, fmt.Errorf("invalid or missing CHEQ/USD price")
 		}
 
-		cheqDecimals := sdkmath.NewInt(1_000_000_000) // 9 decimals for ncheq
-		usdScaleFactor := sdkmath.NewInt(1_000_000)   // 6 decimals for USD
-
 		if fr.MinAmount != nil {
-			cheqDec := sdkmath.LegacyNewDecFromInt(*fr.MinAmount).QuoInt(cheqDecimals)
-			usdValue := cheqDec.Mul(price).MulInt(usdScaleFactor)
-			val := usdValue.TruncateInt()
+			val, err := oracletypes.NcheqToMicroUSD(*fr.MinAmount, price.Price)
+			if err != nil {
+				return USDRange{}, err
+			}
 			minUSD = &val
 		}
 		if fr.MaxAmount != nil {
-			cheqDec := sdkmath.LegacyNewDecFromInt(*fr.MaxAmount).QuoInt(cheqDecimals)
-			usdValue := cheqDec.Mul(price).MulInt(usdScaleFactor)
-			val := usdValue.TruncateInt()
+			val, err := oracletypes.NcheqToMicroUSD(*fr.MaxAmount, price.Price)
+			if err != nil {
+				return USDRange{}, err
+			}
 			maxUSD = &val
 		}
 
@@ -262,6 +272,61 @@ func validateDeactivateDid(i interface{}) error {
 	}
 }
 
+func validateServiceEndpointSchemes(schemes []string) error {
+	for i, scheme := range schemes {
+		if !utils.ValidURISchemeRegexp.MatchString(scheme) || scheme != strings.ToLower(scheme) {
+			return fmt.Errorf("invalid scheme in allowed_service_endpoint_schemes[%d]: got %s", i, scheme)
+		}
+	}
+	if !utils.IsUnique(schemes) {
+		return fmt.Errorf("allowed_service_endpoint_schemes must be unique")
+	}
+	return nil
+}
+
+func validateDiscount(name string, discount sdkmath.LegacyDec) error {
+	if discount.IsNil() || !discount.IsPositive() || discount.GTE(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("discount must be positive and < 1 in %s: got %s", name, discount)
+	}
+	return nil
+}
+
+func validateVolumeDiscountTiers(tiers []VolumeDiscountTier) error {
+	minTxs := make(map[uint64]bool, len(tiers))
+	for i, tier := range tiers {
+		if tier.MinTxs == 0 {
+			return fmt.Errorf("min_txs must be positive in volume_discount_tiers[%d]", i)
+		}
+		if minTxs[tier.MinTxs] {
+			return fmt.Errorf("duplicated min_txs in volume_discount_tiers[%d]: got %d", i, tier.MinTxs)
+		}
+		minTxs[tier.MinTxs] = true
+
+		if err := validateDiscount(fmt.Sprintf("volume_discount_tiers[%d]", i), tier.Discount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateIssuerDiscounts(issuers []IssuerDiscount) error {
+	addresses := make(map[string]bool, len(issuers))
+	for i, issuer := range issuers {
+		if _, err := sdk.AccAddressFromBech32(issuer.Address); err != nil {
+			return fmt.Errorf("invalid address in issuer_discounts[%d]: %w", i, err)
+		}
+		if addresses[issuer.Address] {
+			return fmt.Errorf("duplicated address in issuer_discounts[%d]: got %s", i, issuer.Address)
+		}
+		addresses[issuer.Address] = true
+
+		if err := validateDiscount(fmt.Sprintf("issuer_discounts[%d]", i), issuer.Discount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func validateBurnFactor(i interface{}) error {
 	v, ok := i.(sdkmath.LegacyDec)
 	if !ok {
@@ -287,6 +352,21 @@ func (tfp *FeeParams) ValidateBasic() error {
 	if err := validateBurnFactor(tfp.BurnFactor); err != nil {
 		return err
 	}
+	if tfp.VersionRetentionPeriod < 0 {
+		return fmt.Errorf("version retention period must not be negative: %s", tfp.VersionRetentionPeriod)
+	}
+	if err := validateServiceEndpointSchemes(tfp.AllowedServiceEndpointSchemes); err != nil {
+		return err
+	}
+	if err := validateVolumeDiscountTiers(tfp.VolumeDiscountTiers); err != nil {
+		return err
+	}
+	if err := validateIssuerDiscounts(tfp.IssuerDiscounts); err != nil {
+		return err
+	}
+	if tfp.VolumeDiscountWindow < 0 {
+		return fmt.Errorf("volume discount window must not be negative: %s", tfp.VolumeDiscountWindow)
+	}
 	return nil
 }
 
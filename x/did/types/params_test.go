@@ -224,5 +224,37 @@ var _ = Describe("MsgServer MsgUpdateParams", Ordered, func() {
 				expErr: false,
 			},
 		),
+
+		Entry("invalid params - service endpoint scheme is not lowercase",
+			TestCaseUpdateParams{
+				name: "invalid params - service endpoint scheme is not lowercase",
+				input: &didtypes.MsgUpdateParams{
+					Authority: "cheqd10d07y265gmmuvt4z0w9aw880jnsr700j5ql9az",
+					Params: didtypes.FeeParams{
+						CreateDid: []didtypes.FeeRange{
+							{
+								Denom:     didtypes.BaseMinimalDenom,
+								MinAmount: utils.PtrInt(50000000000),
+								MaxAmount: utils.PtrInt(100000000000),
+							},
+						},
+						UpdateDid: []didtypes.FeeRange{{
+							Denom:     didtypes.BaseMinimalDenom,
+							MinAmount: utils.PtrInt(25000000000),
+							MaxAmount: nil,
+						}},
+						DeactivateDid: []didtypes.FeeRange{{
+							Denom:     didtypes.BaseMinimalDenom,
+							MinAmount: utils.PtrInt(10000000000),
+							MaxAmount: utils.PtrInt(20000000000),
+						}},
+						BurnFactor:                    sdkmath.LegacyMustNewDecFromStr("0.5"),
+						AllowedServiceEndpointSchemes: []string{"https", "HTTP"},
+					},
+				},
+				expErr:    true,
+				expErrMsg: "invalid scheme in allowed_service_endpoint_schemes",
+			},
+		),
 	)
 })
@@ -5,12 +5,18 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	_ "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -18,12 +24,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -31,6 +39,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DeactivatedFilter selects DID Documents by their deactivation state
+type DeactivatedFilter int32
+
+const (
+	// DEACTIVATED_FILTER_UNSPECIFIED keeps both active and deactivated DID Documents
+	DeactivatedFilter_DEACTIVATED_FILTER_UNSPECIFIED DeactivatedFilter = 0
+	// DEACTIVATED_FILTER_ACTIVE keeps only active DID Documents
+	DeactivatedFilter_DEACTIVATED_FILTER_ACTIVE DeactivatedFilter = 1
+	// DEACTIVATED_FILTER_DEACTIVATED keeps only deactivated DID Documents
+	DeactivatedFilter_DEACTIVATED_FILTER_DEACTIVATED DeactivatedFilter = 2
+)
+
+var DeactivatedFilter_name = map[int32]string{
+	0: "DEACTIVATED_FILTER_UNSPECIFIED",
+	1: "DEACTIVATED_FILTER_ACTIVE",
+	2: "DEACTIVATED_FILTER_DEACTIVATED",
+}
+
+var DeactivatedFilter_value = map[string]int32{
+	"DEACTIVATED_FILTER_UNSPECIFIED": 0,
+	"DEACTIVATED_FILTER_ACTIVE":      1,
+	"DEACTIVATED_FILTER_DEACTIVATED": 2,
+}
+
+func (x DeactivatedFilter) String() string {
+	return proto.EnumName(DeactivatedFilter_name, int32(x))
+}
+
+func (DeactivatedFilter) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{0}
+}
+
 // QueryDidDocRequest is the request type for the Query/DidDoc method
 type QueryDidDocRequest struct {
 	// DID unique identifier of the DID Document to fetch.
@@ -366,22 +406,38 @@ func (m *QueryAllDidDocVersionsMetadataResponse) GetPagination() *query.PageResp
 	return nil
 }
 
-// QueryParamsRequest is the request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+// QueryDidDocAtTimeRequest is the request type for the Query/DidDocAtTime method
+type QueryDidDocAtTimeRequest struct {
+	// DID unique identifier of the DID Document to fetch.
+	// UUID-style DIDs as well as Indy-style DID are supported.
+	//
+	// Format: did:cheqd:<namespace>:<unique-identifier>
+	//
+	// Examples:
+	// - did:cheqd:mainnet:c82f2b02-bdab-4dd7-b833-3e143745d612
+	// - did:cheqd:testnet:wGHEXrZvJxR8vw5P3UWH1j
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// time is the point in time the DID Document version is requested for (DID Core versionTime).
+	// Returns the version with the latest created/updated time that is not after the requested time.
+	//
+	// Format: RFC3339
+	//
+	// Example: 2021-01-01T00:00:00Z
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryDidDocAtTimeRequest) Reset()         { *m = QueryDidDocAtTimeRequest{} }
+func (m *QueryDidDocAtTimeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDidDocAtTimeRequest) ProtoMessage()    {}
+func (*QueryDidDocAtTimeRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8d818263856d0dc9, []int{6}
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryDidDocAtTimeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDidDocAtTimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDidDocAtTimeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -391,36 +447,52 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryDidDocAtTimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDidDocAtTimeRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QueryDidDocAtTimeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QueryDidDocAtTimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDidDocAtTimeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryDidDocAtTimeRequest proto.InternalMessageInfo
 
-// QueryParamsResponse is the response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
-	// params defines the parameters of the module.
-	Params FeeParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+func (m *QueryDidDocAtTimeRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryDidDocAtTimeRequest) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// QueryDidDocAtTimeResponse is the response type for the Query/DidDocAtTime method
+type QueryDidDocAtTimeResponse struct {
+	// Successful resolution of the DID Document returns the following:
+	// - did_doc is the version of the DID Document that was active at the requested time
+	// - metadata is DID Document metadata associated with that version of the DID Document
+	Value *DidDocWithMetadata `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *QueryDidDocAtTimeResponse) Reset()         { *m = QueryDidDocAtTimeResponse{} }
+func (m *QueryDidDocAtTimeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDidDocAtTimeResponse) ProtoMessage()    {}
+func (*QueryDidDocAtTimeResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8d818263856d0dc9, []int{7}
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryDidDocAtTimeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDidDocAtTimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDidDocAtTimeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -430,690 +502,6953 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QueryDidDocAtTimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDidDocAtTimeResponse.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QueryDidDocAtTimeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QueryDidDocAtTimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDidDocAtTimeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryDidDocAtTimeResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() FeeParams {
+func (m *QueryDidDocAtTimeResponse) GetValue() *DidDocWithMetadata {
 	if m != nil {
-		return m.Params
+		return m.Value
 	}
-	return FeeParams{}
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryDidDocRequest)(nil), "cheqd.did.v2.QueryDidDocRequest")
-	proto.RegisterType((*QueryDidDocResponse)(nil), "cheqd.did.v2.QueryDidDocResponse")
-	proto.RegisterType((*QueryDidDocVersionRequest)(nil), "cheqd.did.v2.QueryDidDocVersionRequest")
-	proto.RegisterType((*QueryDidDocVersionResponse)(nil), "cheqd.did.v2.QueryDidDocVersionResponse")
-	proto.RegisterType((*QueryAllDidDocVersionsMetadataRequest)(nil), "cheqd.did.v2.QueryAllDidDocVersionsMetadataRequest")
-	proto.RegisterType((*QueryAllDidDocVersionsMetadataResponse)(nil), "cheqd.did.v2.QueryAllDidDocVersionsMetadataResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "cheqd.did.v2.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "cheqd.did.v2.QueryParamsResponse")
+// QueryTombstoneRequest is the request type for the Query/Tombstone method
+type QueryTombstoneRequest struct {
+	// DID unique identifier of the DID Document to fetch the tombstone for.
+	// UUID-style DIDs as well as Indy-style DID are supported.
+	//
+	// Format: did:cheqd:<namespace>:<unique-identifier>
+	//
+	// Examples:
+	// - did:cheqd:mainnet:c82f2b02-bdab-4dd7-b833-3e143745d612
+	// - did:cheqd:testnet:wGHEXrZvJxR8vw5P3UWH1j
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func init() { proto.RegisterFile("cheqd/did/v2/query.proto", fileDescriptor_8d818263856d0dc9) }
-
-var fileDescriptor_8d818263856d0dc9 = []byte{
-	// 601 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x41, 0x6b, 0x13, 0x41,
-	0x18, 0xcd, 0x46, 0x1b, 0xed, 0x54, 0x05, 0xc7, 0x50, 0x93, 0x55, 0xd7, 0xb8, 0xd6, 0x24, 0x14,
-	0xdd, 0xa1, 0x5b, 0xf1, 0xe0, 0xad, 0xa5, 0xd6, 0x53, 0xa1, 0x0d, 0xa2, 0xe0, 0x6d, 0x92, 0x19,
-	0x37, 0x03, 0xc9, 0xce, 0x26, 0x33, 0x09, 0x96, 0x52, 0x04, 0x7f, 0x41, 0xc1, 0xdf, 0x20, 0x0a,
-	0x5e, 0xfc, 0x19, 0x3d, 0x16, 0xbc, 0x78, 0x12, 0x49, 0x04, 0xff, 0x86, 0x64, 0x66, 0x1a, 0x33,
-	0x76, 0xd7, 0x20, 0x5e, 0x86, 0xc9, 0x37, 0xef, 0x7b, 0xdf, 0x9b, 0x79, 0x2f, 0x0b, 0x4a, 0xad,
-	0x36, 0xed, 0x11, 0x44, 0x18, 0x41, 0xc3, 0x10, 0xf5, 0x06, 0xb4, 0xbf, 0x1f, 0x24, 0x7d, 0x2e,
-	0x39, 0xbc, 0xa4, 0x4e, 0x02, 0xc2, 0x48, 0x30, 0x0c, 0xdd, 0xab, 0xb8, 0xcb, 0x62, 0x8e, 0xd4,
-	0xaa, 0x01, 0x6e, 0xd9, 0x6a, 0x25, 0x8c, 0x10, 0xde, 0x32, 0x47, 0xcb, 0xd6, 0xd1, 0x2b, 0x4a,
-	0x4d, 0x7d, 0xb5, 0xc5, 0x45, 0x97, 0x0b, 0xd4, 0xc4, 0x82, 0xea, 0x61, 0x68, 0xb8, 0xd6, 0xa4,
-	0x12, 0xaf, 0xa1, 0x04, 0x47, 0x2c, 0xc6, 0x92, 0xf1, 0xd8, 0x60, 0x8b, 0x11, 0x8f, 0xb8, 0xda,
-	0xa2, 0xc9, 0xce, 0x54, 0x6f, 0x46, 0x9c, 0x47, 0x1d, 0x8a, 0x70, 0xc2, 0x10, 0x8e, 0x63, 0x2e,
-	0x55, 0x8b, 0xd0, 0xa7, 0xfe, 0x0a, 0x80, 0x7b, 0x13, 0xd6, 0x2d, 0x46, 0xb6, 0x78, 0xab, 0x41,
-	0x7b, 0x03, 0x2a, 0x24, 0xbc, 0x02, 0xf2, 0x8c, 0x94, 0x9c, 0x8a, 0x53, 0x5f, 0x6c, 0xe4, 0x19,
-	0xf1, 0x77, 0xc0, 0x35, 0x0b, 0x25, 0x12, 0x1e, 0x0b, 0x0a, 0x1f, 0x81, 0x85, 0x21, 0xee, 0x0c,
-	0xa8, 0x42, 0x2e, 0x85, 0x95, 0x60, 0xf6, 0x01, 0x02, 0x0d, 0x7e, 0xc1, 0x64, 0x7b, 0x87, 0x4a,
-	0x4c, 0xb0, 0xc4, 0x0d, 0x0d, 0xf7, 0x9f, 0x80, 0xf2, 0x0c, 0xdd, 0x73, 0xda, 0x17, 0x8c, 0xc7,
-	0x19, 0xb3, 0x61, 0x09, 0x5c, 0x18, 0x6a, 0x44, 0x29, 0xaf, 0x8a, 0xa7, 0x3f, 0xfd, 0x67, 0xc0,
-	0x4d, 0xa3, 0xf9, 0x4f, 0x71, 0x6f, 0xc0, 0x3d, 0xc5, 0xba, 0xd1, 0xe9, 0x58, 0xc4, 0x62, 0x0a,
-	0xcc, 0x10, 0xba, 0x0d, 0xc0, 0x6f, 0x4b, 0x94, 0xd6, 0xa5, 0xb0, 0x1a, 0x68, 0xff, 0x82, 0x89,
-	0x7f, 0x81, 0x0e, 0x8b, 0xf1, 0x2f, 0xd8, 0xc5, 0x11, 0x35, 0x5c, 0x8d, 0x99, 0x4e, 0xff, 0xbd,
-	0x03, 0xaa, 0xf3, 0x14, 0x98, 0x3b, 0x86, 0xe0, 0xa2, 0x79, 0x0c, 0x51, 0x72, 0x2a, 0xe7, 0xea,
-	0x4b, 0xe1, 0xb2, 0x7d, 0xcd, 0x69, 0xc7, 0x14, 0x07, 0x9f, 0xa6, 0xc8, 0xac, 0xcd, 0x95, 0xa9,
-	0x07, 0x5a, 0x3a, 0x8b, 0x26, 0x3a, 0xbb, 0xb8, 0x8f, 0xbb, 0xc2, 0xdc, 0xc4, 0xdf, 0x33, 0x51,
-	0x39, 0xad, 0x1a, 0xa5, 0x8f, 0x41, 0x21, 0x51, 0x15, 0x63, 0xc7, 0x75, 0x5b, 0xe7, 0x36, 0xa5,
-	0xba, 0x61, 0x73, 0xf1, 0xf8, 0xdb, 0xed, 0xdc, 0xc7, 0x9f, 0x9f, 0x57, 0x9d, 0x86, 0xe9, 0x08,
-	0x3f, 0x9c, 0x07, 0x0b, 0x8a, 0x13, 0x32, 0x50, 0xd0, 0x2f, 0x02, 0xff, 0xb0, 0xf3, 0x6c, 0x86,
-	0xdd, 0x3b, 0x7f, 0x41, 0x68, 0x51, 0xbe, 0xfb, 0xf6, 0xcb, 0x8f, 0x77, 0xf9, 0x22, 0x84, 0xc8,
-	0xfa, 0xf7, 0x1d, 0x30, 0x72, 0x08, 0x8f, 0x1c, 0x70, 0xd9, 0x7a, 0x7d, 0x58, 0xcb, 0x24, 0xb4,
-	0x13, 0xec, 0xd6, 0xe7, 0x03, 0x8d, 0x80, 0xfb, 0x4a, 0x40, 0x15, 0xae, 0x9c, 0x15, 0x80, 0x8c,
-	0x61, 0xe8, 0xc0, 0x6c, 0x0e, 0xe1, 0x27, 0x07, 0x94, 0x33, 0x33, 0x01, 0xd7, 0x53, 0xa6, 0xce,
-	0xcb, 0xb0, 0xfb, 0xf0, 0xdf, 0x9a, 0x8c, 0xec, 0xbb, 0x4a, 0xf6, 0x2d, 0x78, 0x23, 0x5b, 0xb6,
-	0x80, 0x7d, 0x50, 0xd0, 0x96, 0xa6, 0x7a, 0x65, 0x85, 0x26, 0xd5, 0x2b, 0x3b, 0x40, 0x59, 0x33,
-	0xbb, 0x9c, 0x0c, 0x3a, 0x14, 0xe9, 0xa4, 0x6c, 0x6e, 0x1c, 0x8f, 0x3c, 0xe7, 0x64, 0xe4, 0x39,
-	0xdf, 0x47, 0x9e, 0x73, 0x34, 0xf6, 0x72, 0x27, 0x63, 0x2f, 0xf7, 0x75, 0xec, 0xe5, 0x5e, 0xd6,
-	0x22, 0x26, 0xdb, 0x83, 0x66, 0xd0, 0xe2, 0x5d, 0x43, 0xa0, 0xd6, 0x07, 0x31, 0x27, 0x14, 0xbd,
-	0x56, 0x6c, 0x72, 0x3f, 0xa1, 0xa2, 0x59, 0x50, 0xdf, 0xc5, 0xf5, 0x5f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xee, 0x55, 0x7e, 0x3a, 0xe7, 0x05, 0x00, 0x00,
+func (m *QueryTombstoneRequest) Reset()         { *m = QueryTombstoneRequest{} }
+func (m *QueryTombstoneRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTombstoneRequest) ProtoMessage()    {}
+func (*QueryTombstoneRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{8}
+}
+func (m *QueryTombstoneRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTombstoneRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTombstoneRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTombstoneRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTombstoneRequest.Merge(m, src)
+}
+func (m *QueryTombstoneRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTombstoneRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTombstoneRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryTombstoneRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryTombstoneRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Fetch latest version of a DID Document for a given DID
-	DidDoc(ctx context.Context, in *QueryDidDocRequest, opts ...grpc.CallOption) (*QueryDidDocResponse, error)
-	// Fetch specific version of a DID Document for a given DID
-	DidDocVersion(ctx context.Context, in *QueryDidDocVersionRequest, opts ...grpc.CallOption) (*QueryDidDocVersionResponse, error)
-	// Fetch list of all versions of DID Documents for a given DID
-	AllDidDocVersionsMetadata(ctx context.Context, in *QueryAllDidDocVersionsMetadataRequest, opts ...grpc.CallOption) (*QueryAllDidDocVersionsMetadataResponse, error)
-	// Params queries params of the did module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+// QueryTombstoneResponse is the response type for the Query/Tombstone method
+type QueryTombstoneResponse struct {
+	// tombstone is the hash chain of the pruned versions of the DID Document.
+	// It is empty if no versions of the DID Document were pruned.
+	Tombstone *DidDocTombstone `protobuf:"bytes,1,opt,name=tombstone,proto3" json:"tombstone,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryTombstoneResponse) Reset()         { *m = QueryTombstoneResponse{} }
+func (m *QueryTombstoneResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTombstoneResponse) ProtoMessage()    {}
+func (*QueryTombstoneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{9}
+}
+func (m *QueryTombstoneResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTombstoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTombstoneResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTombstoneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTombstoneResponse.Merge(m, src)
+}
+func (m *QueryTombstoneResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTombstoneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTombstoneResponse.DiscardUnknown(m)
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+var xxx_messageInfo_QueryTombstoneResponse proto.InternalMessageInfo
+
+func (m *QueryTombstoneResponse) GetTombstone() *DidDocTombstone {
+	if m != nil {
+		return m.Tombstone
+	}
+	return nil
 }
 
-func (c *queryClient) DidDoc(ctx context.Context, in *QueryDidDocRequest, opts ...grpc.CallOption) (*QueryDidDocResponse, error) {
-	out := new(QueryDidDocResponse)
-	err := c.cc.Invoke(ctx, "/cheqd.did.v2.Query/DidDoc", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryResolveDidRequest is the request type for the Query/ResolveDid method
+type QueryResolveDidRequest struct {
+	// DID unique identifier of the DID Document to resolve.
+	// UUID-style DIDs as well as Indy-style DID are supported.
+	//
+	// Format: did:cheqd:<namespace>:<unique-identifier>
+	//
+	// Examples:
+	// - did:cheqd:mainnet:c82f2b02-bdab-4dd7-b833-3e143745d612
+	// - did:cheqd:testnet:wGHEXrZvJxR8vw5P3UWH1j
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// accept is the requested representation of the DID Document.
+	// Supported: application/did+ld+json (default), application/did+json
+	Accept string `protobuf:"bytes,2,opt,name=accept,proto3" json:"accept,omitempty"`
+	// version_id is an optional version of the DID Document to resolve (DID Core versionId).
+	// Cannot be combined with version_time.
+	//
+	// Format: <uuid>
+	VersionId string `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// version_time is an optional point in time to resolve the DID Document at (DID Core versionTime).
+	// Cannot be combined with version_id.
+	//
+	// Format: RFC3339
+	VersionTime *time.Time `protobuf:"bytes,4,opt,name=version_time,json=versionTime,proto3,stdtime" json:"version_time,omitempty"`
+}
+
+func (m *QueryResolveDidRequest) Reset()         { *m = QueryResolveDidRequest{} }
+func (m *QueryResolveDidRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryResolveDidRequest) ProtoMessage()    {}
+func (*QueryResolveDidRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{10}
+}
+func (m *QueryResolveDidRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResolveDidRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResolveDidRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryResolveDidRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResolveDidRequest.Merge(m, src)
+}
+func (m *QueryResolveDidRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResolveDidRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResolveDidRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) DidDocVersion(ctx context.Context, in *QueryDidDocVersionRequest, opts ...grpc.CallOption) (*QueryDidDocVersionResponse, error) {
-	out := new(QueryDidDocVersionResponse)
-	err := c.cc.Invoke(ctx, "/cheqd.did.v2.Query/DidDocVersion", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryResolveDidRequest proto.InternalMessageInfo
+
+func (m *QueryResolveDidRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) AllDidDocVersionsMetadata(ctx context.Context, in *QueryAllDidDocVersionsMetadataRequest, opts ...grpc.CallOption) (*QueryAllDidDocVersionsMetadataResponse, error) {
-	out := new(QueryAllDidDocVersionsMetadataResponse)
-	err := c.cc.Invoke(ctx, "/cheqd.did.v2.Query/AllDidDocVersionsMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryResolveDidRequest) GetAccept() string {
+	if m != nil {
+		return m.Accept
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/cheqd.did.v2.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryResolveDidRequest) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Fetch latest version of a DID Document for a given DID
-	DidDoc(context.Context, *QueryDidDocRequest) (*QueryDidDocResponse, error)
-	// Fetch specific version of a DID Document for a given DID
-	DidDocVersion(context.Context, *QueryDidDocVersionRequest) (*QueryDidDocVersionResponse, error)
-	// Fetch list of all versions of DID Documents for a given DID
-	AllDidDocVersionsMetadata(context.Context, *QueryAllDidDocVersionsMetadataRequest) (*QueryAllDidDocVersionsMetadataResponse, error)
-	// Params queries params of the did module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+func (m *QueryResolveDidRequest) GetVersionTime() *time.Time {
+	if m != nil {
+		return m.VersionTime
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryResolveDidResponse is the response type for the Query/ResolveDid method
+type QueryResolveDidResponse struct {
+	// content_type is the media type of the DID Resolution result.
+	// Example: application/did+ld+json
+	ContentType string `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// did_resolution_result is the JSON encoded DID Resolution result containing
+	// didDocument, didDocumentMetadata (including linkedResourceMetadata) and didResolutionMetadata.
+	DidResolutionResult string `protobuf:"bytes,2,opt,name=did_resolution_result,json=didResolutionResult,proto3" json:"did_resolution_result,omitempty"`
 }
 
-func (*UnimplementedQueryServer) DidDoc(ctx context.Context, req *QueryDidDocRequest) (*QueryDidDocResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DidDoc not implemented")
+func (m *QueryResolveDidResponse) Reset()         { *m = QueryResolveDidResponse{} }
+func (m *QueryResolveDidResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResolveDidResponse) ProtoMessage()    {}
+func (*QueryResolveDidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{11}
 }
-func (*UnimplementedQueryServer) DidDocVersion(ctx context.Context, req *QueryDidDocVersionRequest) (*QueryDidDocVersionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DidDocVersion not implemented")
+func (m *QueryResolveDidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) AllDidDocVersionsMetadata(ctx context.Context, req *QueryAllDidDocVersionsMetadataRequest) (*QueryAllDidDocVersionsMetadataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllDidDocVersionsMetadata not implemented")
+func (m *QueryResolveDidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResolveDidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryResolveDidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResolveDidResponse.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryResolveDidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResolveDidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResolveDidResponse.DiscardUnknown(m)
 }
 
-func _Query_DidDoc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDidDocRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DidDoc(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cheqd.did.v2.Query/DidDoc",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DidDoc(ctx, req.(*QueryDidDocRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_QueryResolveDidResponse proto.InternalMessageInfo
 
-func _Query_DidDocVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDidDocVersionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DidDocVersion(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cheqd.did.v2.Query/DidDocVersion",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DidDocVersion(ctx, req.(*QueryDidDocVersionRequest))
+func (m *QueryResolveDidResponse) GetContentType() string {
+	if m != nil {
+		return m.ContentType
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_AllDidDocVersionsMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllDidDocVersionsMetadataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllDidDocVersionsMetadata(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cheqd.did.v2.Query/AllDidDocVersionsMetadata",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllDidDocVersionsMetadata(ctx, req.(*QueryAllDidDocVersionsMetadataRequest))
+func (m *QueryResolveDidResponse) GetDidResolutionResult() string {
+	if m != nil {
+		return m.DidResolutionResult
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cheqd.did.v2.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryDereferenceDidUrlRequest is the request type for the Query/DereferenceDidUrl method
+type QueryDereferenceDidUrlRequest struct {
+	// did_url is the DID URL to dereference.
+	//
+	// Supported forms:
+	// - <did>#<fragment> - verification method or service of the DID Document
+	// - <did>/resources/<resource-id> - DID-Linked Resource
+	// - <did>?resourceName=<name>&resourceType=<type>[&resourceVersionTime=<RFC3339>] - DID-Linked Resource selected by name and type
+	// - <did>?versionId=<uuid> or <did>?versionTime=<RFC3339> - specific version of the DID Document,
+	//   can be combined with a fragment
+	//
+	// Examples:
+	// - did:cheqd:mainnet:c82f2b02-bdab-4dd7-b833-3e143745d612#key-1
+	// - did:cheqd:testnet:wGHEXrZvJxR8vw5P3UWH1j/resources/9fbb1b86-91f8-4942-97b9-725b7714131c
+	DidUrl string `protobuf:"bytes,1,opt,name=did_url,json=didUrl,proto3" json:"did_url,omitempty"`
+	// accept is the requested representation of DID Documents, verification methods and services.
+	// Supported: application/did+ld+json (default), application/did+json
+	// Resources are always returned in their own media type.
+	Accept string `protobuf:"bytes,2,opt,name=accept,proto3" json:"accept,omitempty"`
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cheqd.did.v2.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "DidDoc",
-			Handler:    _Query_DidDoc_Handler,
-		},
-		{
-			MethodName: "DidDocVersion",
-			Handler:    _Query_DidDocVersion_Handler,
-		},
-		{
-			MethodName: "AllDidDocVersionsMetadata",
-			Handler:    _Query_AllDidDocVersionsMetadata_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cheqd/did/v2/query.proto",
+func (m *QueryDereferenceDidUrlRequest) Reset()         { *m = QueryDereferenceDidUrlRequest{} }
+func (m *QueryDereferenceDidUrlRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDereferenceDidUrlRequest) ProtoMessage()    {}
+func (*QueryDereferenceDidUrlRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{12}
 }
-
-func (m *QueryDidDocRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDereferenceDidUrlRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDereferenceDidUrlRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDereferenceDidUrlRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryDidDocRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDereferenceDidUrlRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDereferenceDidUrlRequest.Merge(m, src)
+}
+func (m *QueryDereferenceDidUrlRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDereferenceDidUrlRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDereferenceDidUrlRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDidDocRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryDereferenceDidUrlRequest proto.InternalMessageInfo
+
+func (m *QueryDereferenceDidUrlRequest) GetDidUrl() string {
+	if m != nil {
+		return m.DidUrl
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryDidDocResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDereferenceDidUrlRequest) GetAccept() string {
+	if m != nil {
+		return m.Accept
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryDidDocResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDereferenceDidUrlResponse is the response type for the Query/DereferenceDidUrl method
+type QueryDereferenceDidUrlResponse struct {
+	// content_type is the media type of content_stream.
+	// Example: application/did+ld+json, application/json
+	ContentType string `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// content_stream is the dereferenced content: a JSON encoded DID Document,
+	// verification method or service, or the raw data of a DID-Linked Resource.
+	ContentStream []byte `protobuf:"bytes,2,opt,name=content_stream,json=contentStream,proto3" json:"content_stream,omitempty"`
+	// content_metadata is the JSON encoded metadata of the content:
+	// didDocumentMetadata for DID Documents, verification methods and services,
+	// linkedResourceMetadata for DID-Linked Resources.
+	ContentMetadata string `protobuf:"bytes,3,opt,name=content_metadata,json=contentMetadata,proto3" json:"content_metadata,omitempty"`
+	// dereferencing_metadata is the JSON encoded DID URL dereferencing metadata.
+	DereferencingMetadata string `protobuf:"bytes,4,opt,name=dereferencing_metadata,json=dereferencingMetadata,proto3" json:"dereferencing_metadata,omitempty"`
 }
 
-func (m *QueryDidDocResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != nil {
-		{
-			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryDereferenceDidUrlResponse) Reset()         { *m = QueryDereferenceDidUrlResponse{} }
+func (m *QueryDereferenceDidUrlResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDereferenceDidUrlResponse) ProtoMessage()    {}
+func (*QueryDereferenceDidUrlResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{13}
+}
+func (m *QueryDereferenceDidUrlResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDereferenceDidUrlResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDereferenceDidUrlResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDereferenceDidUrlResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDereferenceDidUrlResponse.Merge(m, src)
+}
+func (m *QueryDereferenceDidUrlResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDereferenceDidUrlResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDereferenceDidUrlResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDidDocVersionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDereferenceDidUrlResponse proto.InternalMessageInfo
+
+func (m *QueryDereferenceDidUrlResponse) GetContentType() string {
+	if m != nil {
+		return m.ContentType
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryDidDocVersionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDereferenceDidUrlResponse) GetContentStream() []byte {
+	if m != nil {
+		return m.ContentStream
+	}
+	return nil
 }
 
-func (m *QueryDidDocVersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryDereferenceDidUrlResponse) GetContentMetadata() string {
+	if m != nil {
+		return m.ContentMetadata
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryDidDocVersionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDereferenceDidUrlResponse) GetDereferencingMetadata() string {
+	if m != nil {
+		return m.DereferencingMetadata
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryDidDocVersionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDidsByControllerRequest is the request type for the Query/DidsByController method
+type QueryDidsByControllerRequest struct {
+	// controller is the DID to list the controlled DIDs for.
+	// Only the latest versions of DID Documents are indexed, including deactivated ones.
+	//
+	// Format: did:cheqd:<namespace>:<unique-identifier>
+	//
+	// Example: did:cheqd:mainnet:c82f2b02-bdab-4dd7-b833-3e143745d612
+	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDidDocVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != nil {
-		{
-			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryDidsByControllerRequest) Reset()         { *m = QueryDidsByControllerRequest{} }
+func (m *QueryDidsByControllerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDidsByControllerRequest) ProtoMessage()    {}
+func (*QueryDidsByControllerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{14}
+}
+func (m *QueryDidsByControllerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDidsByControllerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDidsByControllerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDidsByControllerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDidsByControllerRequest.Merge(m, src)
+}
+func (m *QueryDidsByControllerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDidsByControllerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDidsByControllerRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllDidDocVersionsMetadataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDidsByControllerRequest proto.InternalMessageInfo
+
+func (m *QueryDidsByControllerRequest) GetController() string {
+	if m != nil {
+		return m.Controller
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllDidDocVersionsMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDidsByControllerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllDidDocVersionsMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+// QueryDidsByControllerResponse is the response type for the Query/DidsByController method
+type QueryDidsByControllerResponse struct {
+	// dids is the list of DIDs controlled by the requested DID, including the DID itself if it's self-controlled
+	Dids []string `protobuf:"bytes,1,rep,name=dids,proto3" json:"dids,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDidsByControllerResponse) Reset()         { *m = QueryDidsByControllerResponse{} }
+func (m *QueryDidsByControllerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDidsByControllerResponse) ProtoMessage()    {}
+func (*QueryDidsByControllerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{15}
+}
+func (m *QueryDidsByControllerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDidsByControllerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDidsByControllerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryDidsByControllerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDidsByControllerResponse.Merge(m, src)
+}
+func (m *QueryDidsByControllerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDidsByControllerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDidsByControllerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDidsByControllerResponse proto.InternalMessageInfo
+
+func (m *QueryDidsByControllerResponse) GetDids() []string {
+	if m != nil {
+		return m.Dids
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAllDidDocVersionsMetadataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDidsByControllerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllDidDocVersionsMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDidsByKeyRequest is the request type for the Query/DidsByKey method
+type QueryDidsByKeyRequest struct {
+	// verification_method_type is the type of the verification material, e.g. Ed25519VerificationKey2020.
+	// Keys are matched by their fingerprint, so a key can be found using any of its supported representations.
+	// EcdsaSecp256k1RecoveryMethod2020 methods reference blockchain accounts instead of keys and are not indexed.
+	VerificationMethodType string `protobuf:"bytes,1,opt,name=verification_method_type,json=verificationMethodType,proto3" json:"verification_method_type,omitempty"`
+	// verification_material is the public key in the representation of verification_method_type,
+	// e.g. a multibase encoded key or a JWK.
+	VerificationMaterial string `protobuf:"bytes,2,opt,name=verification_material,json=verificationMaterial,proto3" json:"verification_material,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllDidDocVersionsMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Versions) > 0 {
-		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Versions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryDidsByKeyRequest) Reset()         { *m = QueryDidsByKeyRequest{} }
+func (m *QueryDidsByKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDidsByKeyRequest) ProtoMessage()    {}
+func (*QueryDidsByKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{16}
+}
+func (m *QueryDidsByKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDidsByKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDidsByKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryDidsByKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDidsByKeyRequest.Merge(m, src)
+}
+func (m *QueryDidsByKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDidsByKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDidsByKeyRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryDidsByKeyRequest proto.InternalMessageInfo
+
+func (m *QueryDidsByKeyRequest) GetVerificationMethodType() string {
+	if m != nil {
+		return m.VerificationMethodType
+	}
+	return ""
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryDidsByKeyRequest) GetVerificationMaterial() string {
+	if m != nil {
+		return m.VerificationMaterial
+	}
+	return ""
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDidsByKeyRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDidsByKeyResponse is the response type for the Query/DidsByKey method
+type QueryDidsByKeyResponse struct {
+	// fingerprint is the hex encoded SHA-256 hash of the multicodec encoded public key
+	Fingerprint string `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	// verification_method_ids is the list of verification methods that use the requested key.
+	// Only the latest versions of DID Documents are indexed, including deactivated ones.
+	VerificationMethodIds []string `protobuf:"bytes,2,rep,name=verification_method_ids,json=verificationMethodIds,proto3" json:"verification_method_ids,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryDidsByKeyResponse) Reset()         { *m = QueryDidsByKeyResponse{} }
+func (m *QueryDidsByKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDidsByKeyResponse) ProtoMessage()    {}
+func (*QueryDidsByKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{17}
+}
+func (m *QueryDidsByKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDidsByKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDidsByKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryDidsByKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDidsByKeyResponse.Merge(m, src)
 }
-func (m *QueryDidDocRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryDidsByKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDidsByKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDidsByKeyResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDidDocResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Value != nil {
-		l = m.Value.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryDidsByKeyResponse proto.InternalMessageInfo
+
+func (m *QueryDidsByKeyResponse) GetFingerprint() string {
+	if m != nil {
+		return m.Fingerprint
 	}
-	return n
+	return ""
 }
 
-func (m *QueryDidDocVersionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryDidsByKeyResponse) GetVerificationMethodIds() []string {
+	if m != nil {
+		return m.VerificationMethodIds
 	}
-	return n
+	return nil
 }
 
-func (m *QueryDidDocVersionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Value != nil {
-		l = m.Value.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryDidsByKeyResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryAllDidDocVersionsMetadataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
+// QueryDidsByAliasRequest is the request type for the Query/DidsByAlias method
+type QueryDidsByAliasRequest struct {
+	// alias is the alsoKnownAs URI to list the DIDs for.
+	// Only the latest versions of DID Documents are indexed, including deactivated ones.
+	//
+	// Example: https://example.com
+	Alias string `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDidsByAliasRequest) Reset()         { *m = QueryDidsByAliasRequest{} }
+func (m *QueryDidsByAliasRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDidsByAliasRequest) ProtoMessage()    {}
+func (*QueryDidsByAliasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{18}
+}
+func (m *QueryDidsByAliasRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDidsByAliasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDidsByAliasRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDidsByAliasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDidsByAliasRequest.Merge(m, src)
+}
+func (m *QueryDidsByAliasRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDidsByAliasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDidsByAliasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDidsByAliasRequest proto.InternalMessageInfo
+
+func (m *QueryDidsByAliasRequest) GetAlias() string {
+	if m != nil {
+		return m.Alias
+	}
+	return ""
+}
+
+func (m *QueryDidsByAliasRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDidsByAliasResponse is the response type for the Query/DidsByAlias method
+type QueryDidsByAliasResponse struct {
+	// dids is the list of DIDs known as the requested alias
+	Dids []string `protobuf:"bytes,1,rep,name=dids,proto3" json:"dids,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDidsByAliasResponse) Reset()         { *m = QueryDidsByAliasResponse{} }
+func (m *QueryDidsByAliasResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDidsByAliasResponse) ProtoMessage()    {}
+func (*QueryDidsByAliasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{19}
+}
+func (m *QueryDidsByAliasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDidsByAliasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDidsByAliasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDidsByAliasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDidsByAliasResponse.Merge(m, src)
+}
+func (m *QueryDidsByAliasResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDidsByAliasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDidsByAliasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDidsByAliasResponse proto.InternalMessageInfo
+
+func (m *QueryDidsByAliasResponse) GetDids() []string {
+	if m != nil {
+		return m.Dids
+	}
+	return nil
+}
+
+func (m *QueryDidsByAliasResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllDidsRequest is the request type for the Query/AllDids method
+type QueryAllDidsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// deactivated is an optional filter by the deactivation state of the DID Documents.
+	Deactivated DeactivatedFilter `protobuf:"varint,2,opt,name=deactivated,proto3,enum=cheqd.did.v2.DeactivatedFilter" json:"deactivated,omitempty"`
+	// created_after is an optional filter that keeps only DIDs created at or after the given time.
+	// Format: RFC3339
+	// Example: 2021-01-01T00:00:00Z
+	CreatedAfter *time.Time `protobuf:"bytes,3,opt,name=created_after,json=createdAfter,proto3,stdtime" json:"created_after,omitempty"`
+	// created_before is an optional filter that keeps only DIDs created strictly before the given time.
+	// Format: RFC3339
+	// Example: 2021-01-01T00:00:00Z
+	CreatedBefore *time.Time `protobuf:"bytes,4,opt,name=created_before,json=createdBefore,proto3,stdtime" json:"created_before,omitempty"`
+}
+
+func (m *QueryAllDidsRequest) Reset()         { *m = QueryAllDidsRequest{} }
+func (m *QueryAllDidsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllDidsRequest) ProtoMessage()    {}
+func (*QueryAllDidsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{20}
+}
+func (m *QueryAllDidsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllDidsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllDidsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllDidsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllDidsRequest.Merge(m, src)
+}
+func (m *QueryAllDidsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllDidsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllDidsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllDidsRequest proto.InternalMessageInfo
+
+func (m *QueryAllDidsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryAllDidsRequest) GetDeactivated() DeactivatedFilter {
+	if m != nil {
+		return m.Deactivated
+	}
+	return DeactivatedFilter_DEACTIVATED_FILTER_UNSPECIFIED
+}
+
+func (m *QueryAllDidsRequest) GetCreatedAfter() *time.Time {
+	if m != nil {
+		return m.CreatedAfter
+	}
+	return nil
+}
+
+func (m *QueryAllDidsRequest) GetCreatedBefore() *time.Time {
+	if m != nil {
+		return m.CreatedBefore
+	}
+	return nil
+}
+
+// QueryAllDidsResponse is the response type for the Query/AllDids method
+type QueryAllDidsResponse struct {
+	// dids is the list of the latest versions of the DID Documents, ordered by DID
+	Dids []*DidDocWithMetadata `protobuf:"bytes,1,rep,name=dids,proto3" json:"dids,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllDidsResponse) Reset()         { *m = QueryAllDidsResponse{} }
+func (m *QueryAllDidsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllDidsResponse) ProtoMessage()    {}
+func (*QueryAllDidsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{21}
+}
+func (m *QueryAllDidsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllDidsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllDidsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllDidsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllDidsResponse.Merge(m, src)
+}
+func (m *QueryAllDidsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllDidsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllDidsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllDidsResponse proto.InternalMessageInfo
+
+func (m *QueryAllDidsResponse) GetDids() []*DidDocWithMetadata {
+	if m != nil {
+		return m.Dids
+	}
+	return nil
+}
+
+func (m *QueryAllDidsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryStatsRequest is the request type for the Query/Stats method
+type QueryStatsRequest struct {
+}
+
+func (m *QueryStatsRequest) Reset()         { *m = QueryStatsRequest{} }
+func (m *QueryStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStatsRequest) ProtoMessage()    {}
+func (*QueryStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{22}
+}
+func (m *QueryStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStatsRequest.Merge(m, src)
+}
+func (m *QueryStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStatsRequest proto.InternalMessageInfo
+
+// QueryStatsResponse is the response type for the Query/Stats method
+type QueryStatsResponse struct {
+	// did_count is the number of DIDs, including deactivated ones
+	DidCount uint64 `protobuf:"varint,1,opt,name=did_count,json=didCount,proto3" json:"did_count,omitempty"`
+	// version_count is the number of stored DID Document versions. Pruned versions are not counted.
+	VersionCount uint64 `protobuf:"varint,2,opt,name=version_count,json=versionCount,proto3" json:"version_count,omitempty"`
+	// deactivated_did_count is the number of deactivated DIDs
+	DeactivatedDidCount uint64 `protobuf:"varint,3,opt,name=deactivated_did_count,json=deactivatedDidCount,proto3" json:"deactivated_did_count,omitempty"`
+}
+
+func (m *QueryStatsResponse) Reset()         { *m = QueryStatsResponse{} }
+func (m *QueryStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStatsResponse) ProtoMessage()    {}
+func (*QueryStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{23}
+}
+func (m *QueryStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStatsResponse.Merge(m, src)
+}
+func (m *QueryStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStatsResponse proto.InternalMessageInfo
+
+func (m *QueryStatsResponse) GetDidCount() uint64 {
+	if m != nil {
+		return m.DidCount
+	}
+	return 0
+}
+
+func (m *QueryStatsResponse) GetVersionCount() uint64 {
+	if m != nil {
+		return m.VersionCount
+	}
+	return 0
+}
+
+func (m *QueryStatsResponse) GetDeactivatedDidCount() uint64 {
+	if m != nil {
+		return m.DeactivatedDidCount
+	}
+	return 0
+}
+
+// QueryEstimateIdentityFeeRequest is the request type for the Query/EstimateIdentityFee method
+type QueryEstimateIdentityFeeRequest struct {
+	// msg_type_url is the type URL of a single message with an empty payload.
+	// Use tx_bytes for messages whose fee depends on the payload, like resource creation.
+	// Example: /cheqd.did.v2.MsgCreateDidDoc
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// tx_bytes is an encoded transaction, which doesn't need to be signed.
+	// If set, the fee of all its messages is estimated and msg_type_url is ignored.
+	TxBytes []byte `protobuf:"bytes,2,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// fee_denom is the denom the fee is paid in: ncheq, usd or an IBC denom registered with fee abstraction
+	FeeDenom string `protobuf:"bytes,3,opt,name=fee_denom,json=feeDenom,proto3" json:"fee_denom,omitempty"`
+}
+
+func (m *QueryEstimateIdentityFeeRequest) Reset()         { *m = QueryEstimateIdentityFeeRequest{} }
+func (m *QueryEstimateIdentityFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateIdentityFeeRequest) ProtoMessage()    {}
+func (*QueryEstimateIdentityFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{24}
+}
+func (m *QueryEstimateIdentityFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateIdentityFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateIdentityFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateIdentityFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateIdentityFeeRequest.Merge(m, src)
+}
+func (m *QueryEstimateIdentityFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateIdentityFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateIdentityFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateIdentityFeeRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateIdentityFeeRequest) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *QueryEstimateIdentityFeeRequest) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
+	}
+	return nil
+}
+
+func (m *QueryEstimateIdentityFeeRequest) GetFeeDenom() string {
+	if m != nil {
+		return m.FeeDenom
+	}
+	return ""
+}
+
+// QueryEstimateIdentityFeeResponse is the response type for the Query/EstimateIdentityFee method
+type QueryEstimateIdentityFeeResponse struct {
+	// min_fee is the lowest fee accepted for the identity messages
+	MinFee types1.Coin `protobuf:"bytes,1,opt,name=min_fee,json=minFee,proto3" json:"min_fee"`
+	// max_fee is the highest fee charged in full for the identity messages.
+	// For a higher fee only the minimum is charged. Not set if the fee ranges are unbounded.
+	MaxFee *types1.Coin `protobuf:"bytes,2,opt,name=max_fee,json=maxFee,proto3" json:"max_fee,omitempty"`
+	// reward is the portion of the minimum fee distributed as rewards, in ncheq
+	Reward github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=reward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward"`
+	// burn is the portion of the minimum fee that is burnt, in ncheq
+	Burn github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=burn,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burn"`
+	// price is the CHEQ price in USD the fee is computed with
+	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+	// price_source is where the price comes from: "oracle" for the oracle WMA, "icq" for the fallback price,
+	// "frozen" while the price circuit breaker restricts fees to their ncheq amounts
+	PriceSource string `protobuf:"bytes,6,opt,name=price_source,json=priceSource,proto3" json:"price_source,omitempty"`
+}
+
+func (m *QueryEstimateIdentityFeeResponse) Reset()         { *m = QueryEstimateIdentityFeeResponse{} }
+func (m *QueryEstimateIdentityFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateIdentityFeeResponse) ProtoMessage()    {}
+func (*QueryEstimateIdentityFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{25}
+}
+func (m *QueryEstimateIdentityFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateIdentityFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateIdentityFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateIdentityFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateIdentityFeeResponse.Merge(m, src)
+}
+func (m *QueryEstimateIdentityFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateIdentityFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateIdentityFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateIdentityFeeResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateIdentityFeeResponse) GetMinFee() types1.Coin {
+	if m != nil {
+		return m.MinFee
+	}
+	return types1.Coin{}
+}
+
+func (m *QueryEstimateIdentityFeeResponse) GetMaxFee() *types1.Coin {
+	if m != nil {
+		return m.MaxFee
+	}
+	return nil
+}
+
+func (m *QueryEstimateIdentityFeeResponse) GetReward() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Reward
+	}
+	return nil
+}
+
+func (m *QueryEstimateIdentityFeeResponse) GetBurn() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burn
+	}
+	return nil
+}
+
+func (m *QueryEstimateIdentityFeeResponse) GetPriceSource() string {
+	if m != nil {
+		return m.PriceSource
+	}
+	return ""
+}
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{26}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params FeeParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{27}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() FeeParams {
+	if m != nil {
+		return m.Params
+	}
+	return FeeParams{}
+}
+
+// QueryIdentityCreditsRequest is the request type for the Query/IdentityCredits method
+type QueryIdentityCreditsRequest struct {
+	// Bech32 address of the account paying identity fees
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryIdentityCreditsRequest) Reset()         { *m = QueryIdentityCreditsRequest{} }
+func (m *QueryIdentityCreditsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIdentityCreditsRequest) ProtoMessage()    {}
+func (*QueryIdentityCreditsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{28}
+}
+func (m *QueryIdentityCreditsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIdentityCreditsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIdentityCreditsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIdentityCreditsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIdentityCreditsRequest.Merge(m, src)
+}
+func (m *QueryIdentityCreditsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIdentityCreditsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIdentityCreditsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIdentityCreditsRequest proto.InternalMessageInfo
+
+func (m *QueryIdentityCreditsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryIdentityCreditsResponse is the response type for the Query/IdentityCredits method
+type QueryIdentityCreditsResponse struct {
+	// Prepaid credits and volume discount window of the account
+	Account IdentityCreditAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account"`
+	// Fraction of identity fees currently waived for the account
+	Discount cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=discount,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"discount"`
+}
+
+func (m *QueryIdentityCreditsResponse) Reset()         { *m = QueryIdentityCreditsResponse{} }
+func (m *QueryIdentityCreditsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIdentityCreditsResponse) ProtoMessage()    {}
+func (*QueryIdentityCreditsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{29}
+}
+func (m *QueryIdentityCreditsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIdentityCreditsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIdentityCreditsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIdentityCreditsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIdentityCreditsResponse.Merge(m, src)
+}
+func (m *QueryIdentityCreditsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIdentityCreditsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIdentityCreditsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIdentityCreditsResponse proto.InternalMessageInfo
+
+func (m *QueryIdentityCreditsResponse) GetAccount() IdentityCreditAccount {
+	if m != nil {
+		return m.Account
+	}
+	return IdentityCreditAccount{}
+}
+
+// QueryAllIdentityCreditsRequest is the request type for the Query/AllIdentityCredits method
+type QueryAllIdentityCreditsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllIdentityCreditsRequest) Reset()         { *m = QueryAllIdentityCreditsRequest{} }
+func (m *QueryAllIdentityCreditsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllIdentityCreditsRequest) ProtoMessage()    {}
+func (*QueryAllIdentityCreditsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{30}
+}
+func (m *QueryAllIdentityCreditsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllIdentityCreditsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllIdentityCreditsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllIdentityCreditsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllIdentityCreditsRequest.Merge(m, src)
+}
+func (m *QueryAllIdentityCreditsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllIdentityCreditsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllIdentityCreditsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllIdentityCreditsRequest proto.InternalMessageInfo
+
+func (m *QueryAllIdentityCreditsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllIdentityCreditsResponse is the response type for the Query/AllIdentityCredits method
+type QueryAllIdentityCreditsResponse struct {
+	// accounts is the list of identity credit accounts, ordered by address
+	Accounts []IdentityCreditAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllIdentityCreditsResponse) Reset()         { *m = QueryAllIdentityCreditsResponse{} }
+func (m *QueryAllIdentityCreditsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllIdentityCreditsResponse) ProtoMessage()    {}
+func (*QueryAllIdentityCreditsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{31}
+}
+func (m *QueryAllIdentityCreditsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllIdentityCreditsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllIdentityCreditsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllIdentityCreditsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllIdentityCreditsResponse.Merge(m, src)
+}
+func (m *QueryAllIdentityCreditsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllIdentityCreditsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllIdentityCreditsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllIdentityCreditsResponse proto.InternalMessageInfo
+
+func (m *QueryAllIdentityCreditsResponse) GetAccounts() []IdentityCreditAccount {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *QueryAllIdentityCreditsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("cheqd.did.v2.DeactivatedFilter", DeactivatedFilter_name, DeactivatedFilter_value)
+	proto.RegisterType((*QueryDidDocRequest)(nil), "cheqd.did.v2.QueryDidDocRequest")
+	proto.RegisterType((*QueryDidDocResponse)(nil), "cheqd.did.v2.QueryDidDocResponse")
+	proto.RegisterType((*QueryDidDocVersionRequest)(nil), "cheqd.did.v2.QueryDidDocVersionRequest")
+	proto.RegisterType((*QueryDidDocVersionResponse)(nil), "cheqd.did.v2.QueryDidDocVersionResponse")
+	proto.RegisterType((*QueryAllDidDocVersionsMetadataRequest)(nil), "cheqd.did.v2.QueryAllDidDocVersionsMetadataRequest")
+	proto.RegisterType((*QueryAllDidDocVersionsMetadataResponse)(nil), "cheqd.did.v2.QueryAllDidDocVersionsMetadataResponse")
+	proto.RegisterType((*QueryDidDocAtTimeRequest)(nil), "cheqd.did.v2.QueryDidDocAtTimeRequest")
+	proto.RegisterType((*QueryDidDocAtTimeResponse)(nil), "cheqd.did.v2.QueryDidDocAtTimeResponse")
+	proto.RegisterType((*QueryTombstoneRequest)(nil), "cheqd.did.v2.QueryTombstoneRequest")
+	proto.RegisterType((*QueryTombstoneResponse)(nil), "cheqd.did.v2.QueryTombstoneResponse")
+	proto.RegisterType((*QueryResolveDidRequest)(nil), "cheqd.did.v2.QueryResolveDidRequest")
+	proto.RegisterType((*QueryResolveDidResponse)(nil), "cheqd.did.v2.QueryResolveDidResponse")
+	proto.RegisterType((*QueryDereferenceDidUrlRequest)(nil), "cheqd.did.v2.QueryDereferenceDidUrlRequest")
+	proto.RegisterType((*QueryDereferenceDidUrlResponse)(nil), "cheqd.did.v2.QueryDereferenceDidUrlResponse")
+	proto.RegisterType((*QueryDidsByControllerRequest)(nil), "cheqd.did.v2.QueryDidsByControllerRequest")
+	proto.RegisterType((*QueryDidsByControllerResponse)(nil), "cheqd.did.v2.QueryDidsByControllerResponse")
+	proto.RegisterType((*QueryDidsByKeyRequest)(nil), "cheqd.did.v2.QueryDidsByKeyRequest")
+	proto.RegisterType((*QueryDidsByKeyResponse)(nil), "cheqd.did.v2.QueryDidsByKeyResponse")
+	proto.RegisterType((*QueryDidsByAliasRequest)(nil), "cheqd.did.v2.QueryDidsByAliasRequest")
+	proto.RegisterType((*QueryDidsByAliasResponse)(nil), "cheqd.did.v2.QueryDidsByAliasResponse")
+	proto.RegisterType((*QueryAllDidsRequest)(nil), "cheqd.did.v2.QueryAllDidsRequest")
+	proto.RegisterType((*QueryAllDidsResponse)(nil), "cheqd.did.v2.QueryAllDidsResponse")
+	proto.RegisterType((*QueryStatsRequest)(nil), "cheqd.did.v2.QueryStatsRequest")
+	proto.RegisterType((*QueryStatsResponse)(nil), "cheqd.did.v2.QueryStatsResponse")
+	proto.RegisterType((*QueryEstimateIdentityFeeRequest)(nil), "cheqd.did.v2.QueryEstimateIdentityFeeRequest")
+	proto.RegisterType((*QueryEstimateIdentityFeeResponse)(nil), "cheqd.did.v2.QueryEstimateIdentityFeeResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "cheqd.did.v2.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cheqd.did.v2.QueryParamsResponse")
+	proto.RegisterType((*QueryIdentityCreditsRequest)(nil), "cheqd.did.v2.QueryIdentityCreditsRequest")
+	proto.RegisterType((*QueryIdentityCreditsResponse)(nil), "cheqd.did.v2.QueryIdentityCreditsResponse")
+	proto.RegisterType((*QueryAllIdentityCreditsRequest)(nil), "cheqd.did.v2.QueryAllIdentityCreditsRequest")
+	proto.RegisterType((*QueryAllIdentityCreditsResponse)(nil), "cheqd.did.v2.QueryAllIdentityCreditsResponse")
+}
+
+func init() { proto.RegisterFile("cheqd/did/v2/query.proto", fileDescriptor_8d818263856d0dc9) }
+
+var fileDescriptor_8d818263856d0dc9 = []byte{
+	// 2066 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcd, 0x6f, 0xdb, 0xd8,
+	0x11, 0x0f, 0xfd, 0x21, 0xdb, 0x63, 0x27, 0x9b, 0x3c, 0x7f, 0xc9, 0x4c, 0x2c, 0xdb, 0xf4, 0x67,
+	0xbc, 0xb1, 0x88, 0x28, 0xbb, 0x8b, 0x45, 0x8b, 0x1e, 0x64, 0x4b, 0x0e, 0x8c, 0x6e, 0x8a, 0x44,
+	0x76, 0xb6, 0x40, 0x2f, 0x02, 0xc5, 0xf7, 0x24, 0x3f, 0x44, 0x22, 0x15, 0xf2, 0xc9, 0xb1, 0x9a,
+	0xb8, 0x05, 0x0a, 0xb4, 0x87, 0x9e, 0x52, 0x14, 0x45, 0x8b, 0x5e, 0x7a, 0x69, 0x81, 0xa2, 0xbd,
+	0x6c, 0x8b, 0xbd, 0xf5, 0x1f, 0xd8, 0x43, 0x51, 0x6c, 0xb7, 0x97, 0xa2, 0x87, 0xdd, 0x22, 0x29,
+	0xd0, 0x7f, 0xa3, 0xe0, 0xe3, 0x50, 0x22, 0x25, 0x52, 0xf6, 0x66, 0xdd, 0xbd, 0x38, 0xe2, 0xbc,
+	0xdf, 0xcc, 0xfc, 0xde, 0xcc, 0xf0, 0xcd, 0x3c, 0x06, 0xd2, 0xe6, 0x31, 0x7b, 0x4a, 0x75, 0xca,
+	0xa9, 0x7e, 0x92, 0xd3, 0x9f, 0xb6, 0x98, 0xd3, 0xce, 0x36, 0x1d, 0x5b, 0xd8, 0x64, 0x4a, 0xae,
+	0x64, 0x29, 0xa7, 0xd9, 0x93, 0x9c, 0x7a, 0xc3, 0x68, 0x70, 0xcb, 0xd6, 0xe5, 0x5f, 0x1f, 0xa0,
+	0xaa, 0x11, 0x55, 0xd3, 0x61, 0x94, 0x0b, 0x17, 0xd7, 0x16, 0x22, 0x6b, 0x94, 0x53, 0x6a, 0x9b,
+	0xb8, 0x34, 0x17, 0x59, 0xaa, 0x32, 0x86, 0xf2, 0x6d, 0xd3, 0x76, 0x1b, 0xb6, 0xab, 0x57, 0x0c,
+	0x97, 0xf9, 0x44, 0xf4, 0x93, 0xbb, 0x15, 0x26, 0x8c, 0xbb, 0x7a, 0xd3, 0xa8, 0x71, 0xcb, 0x10,
+	0xdc, 0xb6, 0x10, 0x9b, 0x09, 0x63, 0x03, 0x94, 0x69, 0x73, 0xab, 0xe3, 0x5e, 0xae, 0x97, 0xe5,
+	0x93, 0xee, 0x3f, 0xe0, 0xd2, 0x4c, 0xcd, 0xae, 0xd9, 0xbe, 0xdc, 0xfb, 0x85, 0xd2, 0x5b, 0x35,
+	0xdb, 0xae, 0xd5, 0x99, 0x6e, 0x34, 0xb9, 0x6e, 0x58, 0x96, 0x2d, 0xa4, 0xb7, 0x40, 0x67, 0x09,
+	0x57, 0xe5, 0x53, 0xa5, 0x55, 0xd5, 0x05, 0x6f, 0x30, 0x57, 0x18, 0x8d, 0xa6, 0x0f, 0xd0, 0xd6,
+	0x80, 0x3c, 0xf2, 0x18, 0x17, 0x38, 0x2d, 0xd8, 0x66, 0x89, 0x3d, 0x6d, 0x31, 0x57, 0x90, 0x6b,
+	0x30, 0xc4, 0x69, 0x5a, 0x59, 0x56, 0xb6, 0x26, 0x4a, 0x43, 0x9c, 0x6a, 0x0f, 0x60, 0x3a, 0x82,
+	0x72, 0x9b, 0xb6, 0xe5, 0x32, 0xf2, 0x1e, 0x8c, 0x9e, 0x18, 0xf5, 0x16, 0x93, 0xc8, 0xc9, 0xdc,
+	0x72, 0x36, 0x1c, 0xf8, 0xac, 0x0f, 0xfe, 0x2e, 0x17, 0xc7, 0x0f, 0x98, 0x30, 0xa8, 0x21, 0x8c,
+	0x92, 0x0f, 0xd7, 0x8a, 0xb0, 0x10, 0x32, 0xf7, 0x21, 0x73, 0x5c, 0x6e, 0x5b, 0x09, 0xbe, 0x49,
+	0x1a, 0xc6, 0x4e, 0x7c, 0x44, 0x7a, 0x48, 0x0a, 0x83, 0x47, 0xed, 0x08, 0xd4, 0x38, 0x33, 0x5f,
+	0x91, 0xdc, 0x0f, 0x61, 0x5d, 0x5a, 0xcd, 0xd7, 0xeb, 0x11, 0xc3, 0x6e, 0x07, 0x98, 0x40, 0x74,
+	0x1f, 0xa0, 0x9b, 0x6e, 0xc9, 0x75, 0x32, 0xb7, 0x91, 0xc5, 0x14, 0x7a, 0xf9, 0xce, 0xfa, 0x45,
+	0x8a, 0x59, 0xcf, 0x3e, 0x34, 0x6a, 0x0c, 0x6d, 0x95, 0x42, 0x9a, 0xda, 0xef, 0x14, 0xd8, 0x38,
+	0x8f, 0x01, 0xee, 0x31, 0x07, 0xe3, 0x18, 0x0c, 0x37, 0xad, 0x2c, 0x0f, 0x6f, 0x4d, 0xe6, 0xe6,
+	0xa2, 0xdb, 0xec, 0x68, 0x74, 0x70, 0xe4, 0x7e, 0x0c, 0xcd, 0xcd, 0x73, 0x69, 0xfa, 0x0e, 0x23,
+	0x3c, 0x29, 0xa4, 0x43, 0xe1, 0xcf, 0x8b, 0x23, 0xde, 0x60, 0x49, 0xb1, 0x79, 0x1f, 0x46, 0xbc,
+	0xca, 0x43, 0x77, 0x6a, 0xd6, 0x2f, 0xcb, 0x6c, 0x50, 0x96, 0xd9, 0xa3, 0xa0, 0x2c, 0x77, 0xc7,
+	0x3f, 0xf9, 0x7c, 0xe9, 0xca, 0xcb, 0x2f, 0x96, 0x94, 0x92, 0xd4, 0xd0, 0x0e, 0x61, 0x21, 0xc6,
+	0xcb, 0x57, 0xcc, 0xf1, 0x26, 0xcc, 0x4a, 0xa3, 0x47, 0x76, 0xa3, 0xe2, 0x0a, 0xdb, 0x4a, 0xe2,
+	0xad, 0x3d, 0x86, 0xb9, 0x5e, 0x20, 0xba, 0xfe, 0x26, 0x4c, 0x88, 0x40, 0x88, 0xee, 0x17, 0xe3,
+	0xdc, 0x77, 0x35, 0xbb, 0x78, 0xed, 0xb7, 0x0a, 0xda, 0x2d, 0x31, 0xd7, 0xae, 0x9f, 0xb0, 0x02,
+	0xa7, 0x49, 0x91, 0x9b, 0x83, 0x94, 0x61, 0x9a, 0xac, 0x29, 0xb0, 0xfa, 0xf1, 0x89, 0x2c, 0x02,
+	0x60, 0x4a, 0xcb, 0x9c, 0xa6, 0x87, 0xe5, 0xda, 0x04, 0x4a, 0x0e, 0x28, 0xd9, 0x83, 0xa9, 0x60,
+	0x59, 0x06, 0x7e, 0xe4, 0xdc, 0xc0, 0x8f, 0xc8, 0xa0, 0x4f, 0xa2, 0x96, 0x27, 0xd7, 0x9a, 0x30,
+	0xdf, 0xc7, 0x12, 0xb7, 0xbf, 0x02, 0x53, 0xa6, 0x6d, 0x09, 0x66, 0x89, 0xb2, 0x68, 0x37, 0x19,
+	0x12, 0x9e, 0x44, 0xd9, 0x51, 0xbb, 0xe9, 0x15, 0xe7, 0x2c, 0xe5, 0xb4, 0xec, 0x78, 0xca, 0x2d,
+	0xaf, 0x62, 0xbc, 0x9f, 0xad, 0x7a, 0xb0, 0x91, 0x69, 0x2a, 0xcd, 0xe1, 0x5a, 0x49, 0x2e, 0x69,
+	0x0f, 0x61, 0xd1, 0xcf, 0x36, 0x73, 0x58, 0x95, 0x39, 0xcc, 0x32, 0x3d, 0xaf, 0x8f, 0x9d, 0x7a,
+	0x10, 0x9e, 0x79, 0x18, 0xf3, 0x8c, 0xb6, 0x9c, 0x3a, 0xba, 0x4c, 0x51, 0xb9, 0x9e, 0x14, 0x27,
+	0xed, 0xaf, 0x0a, 0x64, 0x92, 0x4c, 0x5e, 0x7c, 0x2f, 0xeb, 0x70, 0x2d, 0x80, 0xb8, 0xc2, 0x61,
+	0x46, 0x43, 0x7a, 0x99, 0x2a, 0x5d, 0x45, 0xe9, 0xa1, 0x14, 0x92, 0xdb, 0x70, 0x3d, 0x80, 0x35,
+	0xb0, 0xe4, 0x30, 0x35, 0x6f, 0xa1, 0x3c, 0xa8, 0x44, 0xf2, 0x2e, 0xcc, 0xd1, 0x0e, 0x23, 0x6e,
+	0xd5, 0xba, 0x0a, 0x23, 0x52, 0x61, 0x36, 0xb2, 0x1a, 0xa8, 0x69, 0x3f, 0x51, 0xe0, 0x56, 0xf0,
+	0x3e, 0xb8, 0xbb, 0xed, 0x3d, 0xdb, 0x12, 0x8e, 0x5d, 0xaf, 0x33, 0x27, 0x08, 0x50, 0x06, 0xc0,
+	0xec, 0x08, 0x71, 0x2b, 0x21, 0xc9, 0xa5, 0x9d, 0x52, 0x2f, 0x60, 0x31, 0x81, 0x07, 0x46, 0x95,
+	0xc0, 0x08, 0xe5, 0xd4, 0x3f, 0x97, 0x26, 0x4a, 0xf2, 0xf7, 0xe5, 0x9d, 0x3d, 0x7f, 0x53, 0x60,
+	0x36, 0xe4, 0xfe, 0xdb, 0xac, 0x1d, 0xec, 0xff, 0x7d, 0x48, 0x9f, 0x30, 0x87, 0x57, 0xb9, 0x29,
+	0x91, 0x5e, 0x58, 0x8f, 0x6d, 0x1a, 0x4e, 0xec, 0x5c, 0x78, 0xfd, 0x81, 0x5c, 0x96, 0x39, 0xbe,
+	0x07, 0xb3, 0x51, 0x4d, 0x43, 0x30, 0x87, 0x1b, 0x75, 0x2c, 0xa8, 0x99, 0x88, 0x1a, 0xae, 0xf5,
+	0x84, 0x73, 0xf8, 0x8d, 0xc3, 0xf9, 0x97, 0xe0, 0x44, 0x08, 0x6d, 0x08, 0x03, 0xb9, 0x0c, 0x93,
+	0x55, 0x6e, 0xd5, 0x98, 0xd3, 0x74, 0xb8, 0x25, 0x82, 0xea, 0x0c, 0x89, 0xc8, 0x7b, 0x30, 0x1f,
+	0xb7, 0x67, 0x2f, 0xfa, 0x43, 0x32, 0xfa, 0xb3, 0xfd, 0x5b, 0x3e, 0xe8, 0x4b, 0xc7, 0xf0, 0x9b,
+	0xa7, 0xe3, 0x19, 0xcc, 0x87, 0xc8, 0xe7, 0xeb, 0xdc, 0x70, 0x83, 0x7c, 0xcc, 0xc0, 0xa8, 0xe1,
+	0x3d, 0x23, 0x6f, 0xff, 0xe1, 0xd2, 0xaa, 0xf0, 0x19, 0xa4, 0xfb, 0x1d, 0x7f, 0x1d, 0x05, 0xf8,
+	0xd1, 0x10, 0x4c, 0x87, 0x9a, 0x74, 0x67, 0xbb, 0xd1, 0x8d, 0x29, 0x6f, 0xba, 0x31, 0x92, 0x87,
+	0x49, 0xca, 0x0c, 0x53, 0xf0, 0x13, 0x43, 0x30, 0x2a, 0x99, 0x5e, 0xcb, 0x2d, 0xf5, 0x34, 0x98,
+	0x2e, 0x60, 0x9f, 0xd7, 0x05, 0x73, 0x4a, 0x61, 0x1d, 0x52, 0x84, 0xab, 0xa6, 0xc3, 0xbc, 0x9f,
+	0x65, 0xa3, 0x2a, 0x98, 0x93, 0x1e, 0xbe, 0x60, 0x0f, 0x98, 0x42, 0xb5, 0xbc, 0xa7, 0x45, 0xee,
+	0xc3, 0xb5, 0xc0, 0x4c, 0x85, 0x55, 0x6d, 0xe7, 0xe2, 0xbd, 0x24, 0x70, 0xbf, 0x2b, 0xd5, 0xb4,
+	0x5f, 0x28, 0x30, 0x13, 0x0d, 0x19, 0x26, 0xea, 0x9d, 0x50, 0xa2, 0x2e, 0xd2, 0xc4, 0x2f, 0x39,
+	0x95, 0xd3, 0x70, 0x43, 0xd2, 0x3a, 0x14, 0x86, 0x08, 0xf2, 0xa8, 0xfd, 0x54, 0x01, 0x12, 0x96,
+	0x22, 0xd5, 0x9b, 0x30, 0xe1, 0xb5, 0x1f, 0xd3, 0x6e, 0xe1, 0x9b, 0x38, 0x52, 0x1a, 0xa7, 0x9c,
+	0xee, 0x79, 0xcf, 0x64, 0x15, 0xae, 0x06, 0x3d, 0xd7, 0x07, 0x0c, 0x49, 0x40, 0xd0, 0x88, 0x7d,
+	0x90, 0xd7, 0x15, 0xbb, 0x49, 0x2a, 0x77, 0xad, 0x0d, 0x4b, 0xf0, 0x74, 0x68, 0xb1, 0x80, 0x86,
+	0xb5, 0xe7, 0xb0, 0x24, 0xb9, 0x14, 0x5d, 0xc1, 0xbd, 0x53, 0xe9, 0x80, 0x32, 0x4b, 0x70, 0xd1,
+	0xde, 0x67, 0x9d, 0xc1, 0x65, 0x19, 0xa6, 0x1a, 0x6e, 0x4d, 0x1e, 0x73, 0xa1, 0xe6, 0x08, 0x0d,
+	0xb7, 0xe6, 0x9d, 0x6d, 0x5e, 0x83, 0x5c, 0x80, 0x71, 0x71, 0x5a, 0xae, 0xb4, 0x05, 0x73, 0xb1,
+	0x79, 0x8d, 0x89, 0xd3, 0x5d, 0xef, 0xd1, 0xdb, 0x55, 0x95, 0xb1, 0x32, 0x65, 0x96, 0xdd, 0xc0,
+	0x7e, 0x35, 0x5e, 0x65, 0xac, 0xe0, 0x3d, 0x6b, 0x7f, 0x1f, 0x86, 0xe5, 0x64, 0xef, 0x18, 0x97,
+	0x6f, 0xc1, 0x58, 0x83, 0x5b, 0xe5, 0x2a, 0x0b, 0x66, 0xa1, 0x85, 0x48, 0x26, 0x82, 0x1c, 0xec,
+	0xd9, 0xdc, 0xda, 0x9d, 0xf0, 0x26, 0xbc, 0xdf, 0xff, 0xf7, 0xa3, 0x6d, 0xa5, 0x94, 0x6a, 0x70,
+	0x6b, 0x9f, 0x79, 0xa3, 0xc2, 0x58, 0xc3, 0x38, 0x95, 0xea, 0x43, 0xe7, 0xa8, 0x97, 0x52, 0x0d,
+	0xe3, 0xd4, 0xd3, 0x39, 0x86, 0x94, 0xc3, 0x9e, 0x19, 0x8e, 0x37, 0xfc, 0x0c, 0x0f, 0xf6, 0xf8,
+	0xae, 0xe7, 0xf1, 0x0f, 0x5f, 0x2c, 0x6d, 0xd5, 0xb8, 0x38, 0x6e, 0x55, 0xb2, 0xa6, 0xdd, 0xc0,
+	0xab, 0x15, 0xfe, 0xb3, 0xe3, 0xd2, 0x27, 0xba, 0x17, 0x3b, 0x57, 0x2a, 0xb8, 0xc8, 0xce, 0xb7,
+	0x4f, 0x28, 0x8c, 0x54, 0x5a, 0x8e, 0x95, 0x1e, 0xf9, 0x3f, 0xf9, 0x91, 0xd6, 0xc9, 0x7d, 0x18,
+	0x6d, 0x3a, 0xdc, 0x64, 0xe9, 0x51, 0x2f, 0x01, 0xbb, 0x77, 0x3d, 0x5b, 0xff, 0xfa, 0x7c, 0xe9,
+	0xa6, 0xaf, 0xe9, 0xd2, 0x27, 0x59, 0x6e, 0xeb, 0x0d, 0x43, 0x1c, 0x67, 0x3f, 0x60, 0x35, 0xc3,
+	0x6c, 0x17, 0x98, 0xf9, 0xd9, 0xc7, 0x3b, 0x80, 0x64, 0x0a, 0xcc, 0x2c, 0xf9, 0xfa, 0xde, 0x38,
+	0x23, 0x7f, 0x94, 0x5d, 0xbb, 0xe5, 0x98, 0x2c, 0x9d, 0xf2, 0x1b, 0x86, 0x94, 0x1d, 0x4a, 0x91,
+	0x36, 0x83, 0xc5, 0xfd, 0xd0, 0x70, 0x8c, 0x46, 0xa7, 0xe6, 0x1f, 0xc1, 0x74, 0x44, 0x8a, 0xb9,
+	0xfd, 0x06, 0xa4, 0x9a, 0x52, 0x82, 0xa9, 0x9d, 0x8f, 0xbe, 0xa0, 0xfb, 0x8c, 0xf9, 0x0a, 0x91,
+	0xc4, 0xfa, 0x1a, 0xda, 0x23, 0xb8, 0x29, 0x4d, 0x06, 0x35, 0xb3, 0xe7, 0xdf, 0xb5, 0x83, 0xaa,
+	0xcd, 0xc1, 0x98, 0x41, 0xa9, 0xc3, 0x5c, 0x6c, 0x0f, 0xbb, 0xe9, 0xcf, 0x3e, 0xde, 0x99, 0xc1,
+	0x2d, 0xe5, 0xfd, 0x95, 0x43, 0xe1, 0x70, 0xab, 0x56, 0x0a, 0x80, 0xda, 0x9f, 0x83, 0x09, 0xa8,
+	0xcf, 0x26, 0xf2, 0xdd, 0x83, 0x31, 0xc3, 0xec, 0xbe, 0xa1, 0x93, 0xb9, 0xd5, 0x28, 0xe1, 0xa8,
+	0x5e, 0xde, 0x87, 0xee, 0x8e, 0x78, 0xe4, 0x4b, 0x81, 0x26, 0x79, 0x00, 0xe3, 0x94, 0xbb, 0xdd,
+	0xd7, 0xf8, 0x8d, 0x12, 0xd2, 0x31, 0xa1, 0x1d, 0xe3, 0x10, 0x9a, 0xaf, 0xd7, 0x13, 0x42, 0x71,
+	0x49, 0x8d, 0x43, 0xfb, 0x93, 0x02, 0x4b, 0x89, 0xae, 0x30, 0x42, 0x45, 0x18, 0xc7, 0x7d, 0x06,
+	0x87, 0xee, 0x97, 0x08, 0x51, 0x47, 0xf5, 0xd2, 0x4e, 0xe0, 0xed, 0xef, 0xc3, 0x8d, 0xbe, 0x5e,
+	0x46, 0x34, 0xc8, 0x14, 0x8a, 0xf9, 0xbd, 0xa3, 0x83, 0x0f, 0xf3, 0x47, 0xc5, 0x42, 0x79, 0xff,
+	0xe0, 0x83, 0xa3, 0x62, 0xa9, 0xfc, 0xf8, 0x3b, 0x87, 0x0f, 0x8b, 0x7b, 0x07, 0xfb, 0x07, 0xc5,
+	0xc2, 0xf5, 0x2b, 0x64, 0x11, 0x16, 0x62, 0x30, 0x52, 0x50, 0xbc, 0xae, 0x24, 0x98, 0x08, 0x89,
+	0xae, 0x0f, 0xe5, 0x7e, 0x7c, 0x03, 0x46, 0x65, 0xbc, 0x08, 0x87, 0x94, 0xdf, 0x6c, 0x48, 0x4f,
+	0x0b, 0xea, 0xff, 0x40, 0xa2, 0xae, 0x0c, 0x40, 0xf8, 0x1b, 0xd4, 0xd4, 0x1f, 0xfd, 0xe3, 0x3f,
+	0x3f, 0x1f, 0x9a, 0x21, 0x44, 0x8f, 0x7c, 0x36, 0x7a, 0xce, 0xe9, 0x19, 0x79, 0xa9, 0xc0, 0xd5,
+	0xc8, 0xd5, 0x9e, 0x6c, 0x26, 0x1a, 0x8c, 0x7e, 0x1e, 0x51, 0xb7, 0xce, 0x07, 0x22, 0x81, 0x3b,
+	0x92, 0xc0, 0x06, 0x59, 0xeb, 0x27, 0xa0, 0x63, 0x4b, 0xd2, 0x9f, 0xe3, 0x8f, 0x33, 0xf2, 0x47,
+	0x05, 0x16, 0x12, 0x3f, 0x38, 0x90, 0x7b, 0x31, 0x5e, 0xcf, 0xfb, 0x40, 0xa2, 0xbe, 0xf3, 0xe5,
+	0x94, 0x90, 0xf6, 0xaa, 0xa4, 0xbd, 0x48, 0x6e, 0x26, 0xd3, 0x76, 0xc9, 0x0f, 0x60, 0x2a, 0xfc,
+	0x41, 0x80, 0x6c, 0x24, 0x46, 0x25, 0xf2, 0x5d, 0x42, 0xdd, 0x3c, 0x17, 0x87, 0x2c, 0x16, 0x25,
+	0x8b, 0x79, 0x32, 0x1b, 0xc3, 0xc2, 0x10, 0xe4, 0x05, 0x4c, 0x74, 0x2e, 0xf6, 0x64, 0x35, 0xc6,
+	0x68, 0xef, 0x97, 0x05, 0x75, 0x6d, 0x30, 0x08, 0xdd, 0xae, 0x49, 0xb7, 0x19, 0x72, 0x2b, 0xc6,
+	0x6d, 0xe7, 0xf3, 0x01, 0x39, 0x03, 0xe8, 0x5e, 0xc9, 0x49, 0x9c, 0xe5, 0xbe, 0xef, 0x0a, 0xea,
+	0xfa, 0x39, 0x28, 0x24, 0xa0, 0x49, 0x02, 0xb7, 0x88, 0x1a, 0x43, 0xc0, 0xf1, 0xe1, 0xe4, 0x67,
+	0x8a, 0xf7, 0xbe, 0xf6, 0xdc, 0xa6, 0xc9, 0xdb, 0x71, 0xa1, 0x4d, 0xb8, 0xc6, 0xab, 0x77, 0x2e,
+	0x06, 0x46, 0x52, 0x2b, 0x92, 0xd4, 0x4d, 0xb2, 0x10, 0x25, 0xd5, 0xbd, 0x21, 0x33, 0xf2, 0x6b,
+	0x05, 0xae, 0xf7, 0x5e, 0x45, 0xc9, 0x76, 0x7c, 0xb6, 0xe3, 0xee, 0xcd, 0xea, 0xdb, 0x17, 0xc2,
+	0x22, 0xa1, 0x1d, 0x49, 0x68, 0x93, 0xac, 0xf7, 0x44, 0xa9, 0x7b, 0xcf, 0x3e, 0xd3, 0x3b, 0xbf,
+	0x29, 0x11, 0x30, 0xd1, 0xb9, 0xd6, 0xc5, 0x56, 0x4b, 0xef, 0x2d, 0x56, 0x5d, 0x1b, 0x0c, 0x42,
+	0x1a, 0xb7, 0x24, 0x8d, 0x39, 0x32, 0x13, 0xa5, 0x51, 0x69, 0xef, 0x3c, 0x61, 0x6d, 0xf2, 0x02,
+	0x26, 0x43, 0xd7, 0x22, 0xb2, 0x9e, 0x68, 0x32, 0x7c, 0x5f, 0x53, 0x37, 0xce, 0x83, 0xa1, 0xef,
+	0x8c, 0xf4, 0x9d, 0x26, 0x73, 0x7d, 0xbe, 0xfd, 0x1b, 0x9e, 0x0b, 0x63, 0x38, 0xe7, 0x93, 0x95,
+	0xc4, 0x73, 0xa0, 0xe3, 0x55, 0x1b, 0x04, 0x19, 0x5c, 0x05, 0x0d, 0x9b, 0xb6, 0xea, 0x4c, 0x97,
+	0x77, 0x02, 0x0b, 0x46, 0xe5, 0xbc, 0x4e, 0x96, 0x62, 0xec, 0x85, 0xe7, 0x7b, 0x75, 0x39, 0x19,
+	0x30, 0xf8, 0x4d, 0x40, 0x77, 0xae, 0x74, 0xf3, 0x1b, 0x05, 0xa6, 0x63, 0xc6, 0x62, 0xb2, 0x13,
+	0x63, 0x3d, 0x79, 0x78, 0x57, 0xb3, 0x17, 0x85, 0x23, 0xb5, 0xdb, 0x92, 0xda, 0x2a, 0x59, 0x89,
+	0xa5, 0xc6, 0x50, 0x73, 0xa7, 0xca, 0x18, 0xf9, 0x95, 0x02, 0x6f, 0xf5, 0x8c, 0x01, 0xe4, 0x76,
+	0x8c, 0xbb, 0xf8, 0xa9, 0x44, 0xdd, 0xbe, 0x08, 0x14, 0x59, 0x65, 0x25, 0xab, 0x2d, 0xb2, 0x11,
+	0xcb, 0x0a, 0xff, 0x97, 0x45, 0x7f, 0x8e, 0x73, 0xdc, 0x19, 0xf9, 0xa5, 0x02, 0xa4, 0x7f, 0x48,
+	0x21, 0x77, 0xe2, 0x4b, 0x21, 0x81, 0xe0, 0xce, 0x05, 0xd1, 0x83, 0xcf, 0xd7, 0x28, 0x47, 0xe2,
+	0x40, 0xca, 0x1f, 0x69, 0x63, 0x27, 0x81, 0xc8, 0xd0, 0xac, 0xae, 0x0c, 0x40, 0x0c, 0xee, 0x68,
+	0xe8, 0xd4, 0x9f, 0x94, 0x77, 0xf3, 0x9f, 0xbc, 0xca, 0x28, 0x9f, 0xbe, 0xca, 0x28, 0xff, 0x7e,
+	0x95, 0x51, 0x5e, 0xbe, 0xce, 0x5c, 0xf9, 0xf4, 0x75, 0xe6, 0xca, 0x3f, 0x5f, 0x67, 0xae, 0x7c,
+	0x6f, 0x33, 0x7c, 0x9b, 0x90, 0x06, 0xe4, 0xdf, 0x1d, 0xcb, 0xa6, 0x4c, 0x3f, 0x95, 0xd6, 0xe4,
+	0x95, 0xa2, 0x92, 0x92, 0x37, 0xf1, 0x7b, 0xff, 0x1b, 0x00, 0x78, 0xaf, 0x6d, 0x5d, 0x1a, 0x1b,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Fetch latest version of a DID Document for a given DID
+	DidDoc(ctx context.Context, in *QueryDidDocRequest, opts ...grpc.CallOption) (*QueryDidDocResponse, error)
+	// Fetch specific version of a DID Document for a given DID
+	DidDocVersion(ctx context.Context, in *QueryDidDocVersionRequest, opts ...grpc.CallOption) (*QueryDidDocVersionResponse, error)
+	// Fetch list of all versions of DID Documents for a given DID
+	AllDidDocVersionsMetadata(ctx context.Context, in *QueryAllDidDocVersionsMetadataRequest, opts ...grpc.CallOption) (*QueryAllDidDocVersionsMetadataResponse, error)
+	// Fetch the version of a DID Document that was active at a given time
+	DidDocAtTime(ctx context.Context, in *QueryDidDocAtTimeRequest, opts ...grpc.CallOption) (*QueryDidDocAtTimeResponse, error)
+	// Fetch the hash chain of pruned versions of a DID Document
+	Tombstone(ctx context.Context, in *QueryTombstoneRequest, opts ...grpc.CallOption) (*QueryTombstoneResponse, error)
+	// Resolve a DID into a W3C DID Resolution result
+	ResolveDid(ctx context.Context, in *QueryResolveDidRequest, opts ...grpc.CallOption) (*QueryResolveDidResponse, error)
+	// Dereference a DID URL into a verification method, service, DID Document or DID-Linked Resource
+	DereferenceDidUrl(ctx context.Context, in *QueryDereferenceDidUrlRequest, opts ...grpc.CallOption) (*QueryDereferenceDidUrlResponse, error)
+	// List DIDs whose DID Documents list a given DID as controller
+	DidsByController(ctx context.Context, in *QueryDidsByControllerRequest, opts ...grpc.CallOption) (*QueryDidsByControllerResponse, error)
+	// List verification methods of DID Documents that use a given public key
+	DidsByKey(ctx context.Context, in *QueryDidsByKeyRequest, opts ...grpc.CallOption) (*QueryDidsByKeyResponse, error)
+	// List DIDs whose DID Documents list a given alsoKnownAs alias
+	DidsByAlias(ctx context.Context, in *QueryDidsByAliasRequest, opts ...grpc.CallOption) (*QueryDidsByAliasResponse, error)
+	// List the latest versions of all DID Documents
+	AllDids(ctx context.Context, in *QueryAllDidsRequest, opts ...grpc.CallOption) (*QueryAllDidsResponse, error)
+	// Fetch the numbers of DIDs, DID Document versions and deactivated DIDs
+	Stats(ctx context.Context, in *QueryStatsRequest, opts ...grpc.CallOption) (*QueryStatsResponse, error)
+	// Estimate the fee charged for the identity messages of a transaction in a given fee denom
+	EstimateIdentityFee(ctx context.Context, in *QueryEstimateIdentityFeeRequest, opts ...grpc.CallOption) (*QueryEstimateIdentityFeeResponse, error)
+	// Fetch the prepaid identity credits, volume and fee discount of an account
+	IdentityCredits(ctx context.Context, in *QueryIdentityCreditsRequest, opts ...grpc.CallOption) (*QueryIdentityCreditsResponse, error)
+	// Fetch the identity credit accounts
+	AllIdentityCredits(ctx context.Context, in *QueryAllIdentityCreditsRequest, opts ...grpc.CallOption) (*QueryAllIdentityCreditsResponse, error)
+	// Params queries params of the did module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) DidDoc(ctx context.Context, in *QueryDidDocRequest, opts ...grpc.CallOption) (*QueryDidDocResponse, error) {
+	out := new(QueryDidDocResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.did.v2.Query/DidDoc", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DidDocVersion(ctx context.Context, in *QueryDidDocVersionRequest, opts ...grpc.CallOption) (*QueryDidDocVersionResponse, error) {
+	out := new(QueryDidDocVersionResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.did.v2.Query/DidDocVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllDidDocVersionsMetadata(ctx context.Context, in *QueryAllDidDocVersionsMetadataRequest, opts ...grpc.CallOption) (*QueryAllDidDocVersionsMetadataResponse, error) {
+	out := new(QueryAllDidDocVersionsMetadataResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.did.v2.Query/AllDidDocVersionsMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DidDocAtTime(ctx context.Context, in *QueryDidDocAtTimeRequest, opts ...grpc.CallOption) (*QueryDidDocAtTimeResponse, error) {
+	out := new(QueryDidDocAtTimeResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.did.v2.Query/DidDocAtTime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Tombstone(ctx context.Context, in *QueryTombstoneRequest, opts ...grpc.CallOption) (*QueryTombstoneResponse, error) {
+	out := new(QueryTombstoneResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.did.v2.Query/Tombstone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResolveDid(ctx context.Context, in *QueryResolveDidRequest, opts ...grpc.CallOption) (*QueryResolveDidResponse, error) {
+	out := new(QueryResolveDidResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.did.v2.Query/ResolveDid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DereferenceDidUrl(ctx context.Context, in *QueryDereferenceDidUrlRequest, opts ...grpc.CallOption) (*QueryDereferenceDidUrlResponse, error) {
+	out := new(QueryDereferenceDidUrlResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.did.v2.Query/DereferenceDidUrl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DidsByController(ctx context.Context, in *QueryDidsByControllerRequest, opts ...grpc.CallOption) (*QueryDidsByControllerResponse, error) {
+	out := new(QueryDidsByControllerResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.did.v2.Query/DidsByController", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DidsByKey(ctx context.Context, in *QueryDidsByKeyRequest, opts ...grpc.CallOption) (*QueryDidsByKeyResponse, error) {
+	out := new(QueryDidsByKeyResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.did.v2.Query/DidsByKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DidsByAlias(ctx context.Context, in *QueryDidsByAliasRequest, opts ...grpc.CallOption) (*QueryDidsByAliasResponse, error) {
+	out := new(QueryDidsByAliasResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.did.v2.Query/DidsByAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllDids(ctx context.Context, in *QueryAllDidsRequest, opts ...grpc.CallOption) (*QueryAllDidsResponse, error) {
+	out := new(QueryAllDidsResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.did.v2.Query/AllDids", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Stats(ctx context.Context, in *QueryStatsRequest, opts ...grpc.CallOption) (*QueryStatsResponse, error) {
+	out := new(QueryStatsResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.did.v2.Query/Stats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateIdentityFee(ctx context.Context, in *QueryEstimateIdentityFeeRequest, opts ...grpc.CallOption) (*QueryEstimateIdentityFeeResponse, error) {
+	out := new(QueryEstimateIdentityFeeResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.did.v2.Query/EstimateIdentityFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IdentityCredits(ctx context.Context, in *QueryIdentityCreditsRequest, opts ...grpc.CallOption) (*QueryIdentityCreditsResponse, error) {
+	out := new(QueryIdentityCreditsResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.did.v2.Query/IdentityCredits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllIdentityCredits(ctx context.Context, in *QueryAllIdentityCreditsRequest, opts ...grpc.CallOption) (*QueryAllIdentityCreditsResponse, error) {
+	out := new(QueryAllIdentityCreditsResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.did.v2.Query/AllIdentityCredits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.did.v2.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Fetch latest version of a DID Document for a given DID
+	DidDoc(context.Context, *QueryDidDocRequest) (*QueryDidDocResponse, error)
+	// Fetch specific version of a DID Document for a given DID
+	DidDocVersion(context.Context, *QueryDidDocVersionRequest) (*QueryDidDocVersionResponse, error)
+	// Fetch list of all versions of DID Documents for a given DID
+	AllDidDocVersionsMetadata(context.Context, *QueryAllDidDocVersionsMetadataRequest) (*QueryAllDidDocVersionsMetadataResponse, error)
+	// Fetch the version of a DID Document that was active at a given time
+	DidDocAtTime(context.Context, *QueryDidDocAtTimeRequest) (*QueryDidDocAtTimeResponse, error)
+	// Fetch the hash chain of pruned versions of a DID Document
+	Tombstone(context.Context, *QueryTombstoneRequest) (*QueryTombstoneResponse, error)
+	// Resolve a DID into a W3C DID Resolution result
+	ResolveDid(context.Context, *QueryResolveDidRequest) (*QueryResolveDidResponse, error)
+	// Dereference a DID URL into a verification method, service, DID Document or DID-Linked Resource
+	DereferenceDidUrl(context.Context, *QueryDereferenceDidUrlRequest) (*QueryDereferenceDidUrlResponse, error)
+	// List DIDs whose DID Documents list a given DID as controller
+	DidsByController(context.Context, *QueryDidsByControllerRequest) (*QueryDidsByControllerResponse, error)
+	// List verification methods of DID Documents that use a given public key
+	DidsByKey(context.Context, *QueryDidsByKeyRequest) (*QueryDidsByKeyResponse, error)
+	// List DIDs whose DID Documents list a given alsoKnownAs alias
+	DidsByAlias(context.Context, *QueryDidsByAliasRequest) (*QueryDidsByAliasResponse, error)
+	// List the latest versions of all DID Documents
+	AllDids(context.Context, *QueryAllDidsRequest) (*QueryAllDidsResponse, error)
+	// Fetch the numbers of DIDs, DID Document versions and deactivated DIDs
+	Stats(context.Context, *QueryStatsRequest) (*QueryStatsResponse, error)
+	// Estimate the fee charged for the identity messages of a transaction in a given fee denom
+	EstimateIdentityFee(context.Context, *QueryEstimateIdentityFeeRequest) (*QueryEstimateIdentityFeeResponse, error)
+	// Fetch the prepaid identity credits, volume and fee discount of an account
+	IdentityCredits(context.Context, *QueryIdentityCreditsRequest) (*QueryIdentityCreditsResponse, error)
+	// Fetch the identity credit accounts
+	AllIdentityCredits(context.Context, *QueryAllIdentityCreditsRequest) (*QueryAllIdentityCreditsResponse, error)
+	// Params queries params of the did module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) DidDoc(ctx context.Context, req *QueryDidDocRequest) (*QueryDidDocResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DidDoc not implemented")
+}
+func (*UnimplementedQueryServer) DidDocVersion(ctx context.Context, req *QueryDidDocVersionRequest) (*QueryDidDocVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DidDocVersion not implemented")
+}
+func (*UnimplementedQueryServer) AllDidDocVersionsMetadata(ctx context.Context, req *QueryAllDidDocVersionsMetadataRequest) (*QueryAllDidDocVersionsMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllDidDocVersionsMetadata not implemented")
+}
+func (*UnimplementedQueryServer) DidDocAtTime(ctx context.Context, req *QueryDidDocAtTimeRequest) (*QueryDidDocAtTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DidDocAtTime not implemented")
+}
+func (*UnimplementedQueryServer) Tombstone(ctx context.Context, req *QueryTombstoneRequest) (*QueryTombstoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tombstone not implemented")
+}
+func (*UnimplementedQueryServer) ResolveDid(ctx context.Context, req *QueryResolveDidRequest) (*QueryResolveDidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveDid not implemented")
+}
+func (*UnimplementedQueryServer) DereferenceDidUrl(ctx context.Context, req *QueryDereferenceDidUrlRequest) (*QueryDereferenceDidUrlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DereferenceDidUrl not implemented")
+}
+func (*UnimplementedQueryServer) DidsByController(ctx context.Context, req *QueryDidsByControllerRequest) (*QueryDidsByControllerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DidsByController not implemented")
+}
+func (*UnimplementedQueryServer) DidsByKey(ctx context.Context, req *QueryDidsByKeyRequest) (*QueryDidsByKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DidsByKey not implemented")
+}
+func (*UnimplementedQueryServer) DidsByAlias(ctx context.Context, req *QueryDidsByAliasRequest) (*QueryDidsByAliasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DidsByAlias not implemented")
+}
+func (*UnimplementedQueryServer) AllDids(ctx context.Context, req *QueryAllDidsRequest) (*QueryAllDidsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllDids not implemented")
+}
+func (*UnimplementedQueryServer) Stats(ctx context.Context, req *QueryStatsRequest) (*QueryStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+}
+func (*UnimplementedQueryServer) EstimateIdentityFee(ctx context.Context, req *QueryEstimateIdentityFeeRequest) (*QueryEstimateIdentityFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateIdentityFee not implemented")
+}
+func (*UnimplementedQueryServer) IdentityCredits(ctx context.Context, req *QueryIdentityCreditsRequest) (*QueryIdentityCreditsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IdentityCredits not implemented")
+}
+func (*UnimplementedQueryServer) AllIdentityCredits(ctx context.Context, req *QueryAllIdentityCreditsRequest) (*QueryAllIdentityCreditsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllIdentityCredits not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_DidDoc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDidDocRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DidDoc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.did.v2.Query/DidDoc",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DidDoc(ctx, req.(*QueryDidDocRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DidDocVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDidDocVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DidDocVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.did.v2.Query/DidDocVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DidDocVersion(ctx, req.(*QueryDidDocVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllDidDocVersionsMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllDidDocVersionsMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllDidDocVersionsMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.did.v2.Query/AllDidDocVersionsMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllDidDocVersionsMetadata(ctx, req.(*QueryAllDidDocVersionsMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DidDocAtTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDidDocAtTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DidDocAtTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.did.v2.Query/DidDocAtTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DidDocAtTime(ctx, req.(*QueryDidDocAtTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Tombstone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTombstoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Tombstone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.did.v2.Query/Tombstone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Tombstone(ctx, req.(*QueryTombstoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResolveDid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResolveDidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResolveDid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.did.v2.Query/ResolveDid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResolveDid(ctx, req.(*QueryResolveDidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DereferenceDidUrl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDereferenceDidUrlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DereferenceDidUrl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.did.v2.Query/DereferenceDidUrl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DereferenceDidUrl(ctx, req.(*QueryDereferenceDidUrlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DidsByController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDidsByControllerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DidsByController(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.did.v2.Query/DidsByController",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DidsByController(ctx, req.(*QueryDidsByControllerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DidsByKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDidsByKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DidsByKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.did.v2.Query/DidsByKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DidsByKey(ctx, req.(*QueryDidsByKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DidsByAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDidsByAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DidsByAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.did.v2.Query/DidsByAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DidsByAlias(ctx, req.(*QueryDidsByAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllDids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllDidsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllDids(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.did.v2.Query/AllDids",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllDids(ctx, req.(*QueryAllDidsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Stats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.did.v2.Query/Stats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Stats(ctx, req.(*QueryStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateIdentityFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateIdentityFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateIdentityFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.did.v2.Query/EstimateIdentityFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateIdentityFee(ctx, req.(*QueryEstimateIdentityFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IdentityCredits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIdentityCreditsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IdentityCredits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.did.v2.Query/IdentityCredits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IdentityCredits(ctx, req.(*QueryIdentityCreditsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllIdentityCredits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllIdentityCreditsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllIdentityCredits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.did.v2.Query/AllIdentityCredits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllIdentityCredits(ctx, req.(*QueryAllIdentityCreditsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.did.v2.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cheqd.did.v2.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DidDoc",
+			Handler:    _Query_DidDoc_Handler,
+		},
+		{
+			MethodName: "DidDocVersion",
+			Handler:    _Query_DidDocVersion_Handler,
+		},
+		{
+			MethodName: "AllDidDocVersionsMetadata",
+			Handler:    _Query_AllDidDocVersionsMetadata_Handler,
+		},
+		{
+			MethodName: "DidDocAtTime",
+			Handler:    _Query_DidDocAtTime_Handler,
+		},
+		{
+			MethodName: "Tombstone",
+			Handler:    _Query_Tombstone_Handler,
+		},
+		{
+			MethodName: "ResolveDid",
+			Handler:    _Query_ResolveDid_Handler,
+		},
+		{
+			MethodName: "DereferenceDidUrl",
+			Handler:    _Query_DereferenceDidUrl_Handler,
+		},
+		{
+			MethodName: "DidsByController",
+			Handler:    _Query_DidsByController_Handler,
+		},
+		{
+			MethodName: "DidsByKey",
+			Handler:    _Query_DidsByKey_Handler,
+		},
+		{
+			MethodName: "DidsByAlias",
+			Handler:    _Query_DidsByAlias_Handler,
+		},
+		{
+			MethodName: "AllDids",
+			Handler:    _Query_AllDids_Handler,
+		},
+		{
+			MethodName: "Stats",
+			Handler:    _Query_Stats_Handler,
+		},
+		{
+			MethodName: "EstimateIdentityFee",
+			Handler:    _Query_EstimateIdentityFee_Handler,
+		},
+		{
+			MethodName: "IdentityCredits",
+			Handler:    _Query_IdentityCredits_Handler,
+		},
+		{
+			MethodName: "AllIdentityCredits",
+			Handler:    _Query_AllIdentityCredits_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cheqd/did/v2/query.proto",
+}
+
+func (m *QueryDidDocRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDidDocRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDidDocRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDidDocResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDidDocResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDidDocResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDidDocVersionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDidDocVersionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDidDocVersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDidDocVersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDidDocVersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDidDocVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDidDocVersionsMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDidDocVersionsMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDidDocVersionsMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDidDocVersionsMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDidDocVersionsMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDidDocVersionsMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Versions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDidDocAtTimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDidDocAtTimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDidDocAtTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDidDocAtTimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDidDocAtTimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDidDocAtTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTombstoneRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTombstoneRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTombstoneRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTombstoneResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTombstoneResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTombstoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tombstone != nil {
+		{
+			size, err := m.Tombstone.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResolveDidRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResolveDidRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResolveDidRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VersionTime != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VersionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VersionTime):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintQuery(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.VersionId) > 0 {
+		i -= len(m.VersionId)
+		copy(dAtA[i:], m.VersionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VersionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Accept) > 0 {
+		i -= len(m.Accept)
+		copy(dAtA[i:], m.Accept)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Accept)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResolveDidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResolveDidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResolveDidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DidResolutionResult) > 0 {
+		i -= len(m.DidResolutionResult)
+		copy(dAtA[i:], m.DidResolutionResult)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DidResolutionResult)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDereferenceDidUrlRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDereferenceDidUrlRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDereferenceDidUrlRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accept) > 0 {
+		i -= len(m.Accept)
+		copy(dAtA[i:], m.Accept)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Accept)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DidUrl) > 0 {
+		i -= len(m.DidUrl)
+		copy(dAtA[i:], m.DidUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DidUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDereferenceDidUrlResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDereferenceDidUrlResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDereferenceDidUrlResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DereferencingMetadata) > 0 {
+		i -= len(m.DereferencingMetadata)
+		copy(dAtA[i:], m.DereferencingMetadata)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DereferencingMetadata)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ContentMetadata) > 0 {
+		i -= len(m.ContentMetadata)
+		copy(dAtA[i:], m.ContentMetadata)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContentMetadata)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContentStream) > 0 {
+		i -= len(m.ContentStream)
+		copy(dAtA[i:], m.ContentStream)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContentStream)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDidsByControllerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDidsByControllerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDidsByControllerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDidsByControllerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDidsByControllerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDidsByControllerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Dids) > 0 {
+		for iNdEx := len(m.Dids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Dids[iNdEx])
+			copy(dAtA[i:], m.Dids[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Dids[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDidsByKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDidsByKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDidsByKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VerificationMaterial) > 0 {
+		i -= len(m.VerificationMaterial)
+		copy(dAtA[i:], m.VerificationMaterial)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VerificationMaterial)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VerificationMethodType) > 0 {
+		i -= len(m.VerificationMethodType)
+		copy(dAtA[i:], m.VerificationMethodType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VerificationMethodType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDidsByKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDidsByKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDidsByKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VerificationMethodIds) > 0 {
+		for iNdEx := len(m.VerificationMethodIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VerificationMethodIds[iNdEx])
+			copy(dAtA[i:], m.VerificationMethodIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.VerificationMethodIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Fingerprint) > 0 {
+		i -= len(m.Fingerprint)
+		copy(dAtA[i:], m.Fingerprint)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fingerprint)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDidsByAliasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDidsByAliasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDidsByAliasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Alias) > 0 {
+		i -= len(m.Alias)
+		copy(dAtA[i:], m.Alias)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Alias)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDidsByAliasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDidsByAliasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDidsByAliasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Dids) > 0 {
+		for iNdEx := len(m.Dids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Dids[iNdEx])
+			copy(dAtA[i:], m.Dids[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Dids[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDidsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDidsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDidsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedBefore != nil {
+		n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.CreatedBefore, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CreatedBefore):])
+		if err15 != nil {
+			return 0, err15
+		}
+		i -= n15
+		i = encodeVarintQuery(dAtA, i, uint64(n15))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CreatedAfter != nil {
+		n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.CreatedAfter, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CreatedAfter):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintQuery(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Deactivated != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Deactivated))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDidsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDidsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDidsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Dids) > 0 {
+		for iNdEx := len(m.Dids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeactivatedDidCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DeactivatedDidCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VersionCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VersionCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DidCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DidCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateIdentityFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateIdentityFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateIdentityFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeDenom) > 0 {
+		i -= len(m.FeeDenom)
+		copy(dAtA[i:], m.FeeDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeeDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateIdentityFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateIdentityFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateIdentityFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PriceSource) > 0 {
+		i -= len(m.PriceSource)
+		copy(dAtA[i:], m.PriceSource)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PriceSource)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Burn) > 0 {
+		for iNdEx := len(m.Burn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Reward) > 0 {
+		for iNdEx := len(m.Reward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MaxFee != nil {
+		{
+			size, err := m.MaxFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.MinFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIdentityCreditsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIdentityCreditsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIdentityCreditsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIdentityCreditsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIdentityCreditsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIdentityCreditsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Discount.Size()
+		i -= size
+		if _, err := m.Discount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllIdentityCreditsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllIdentityCreditsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllIdentityCreditsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllIdentityCreditsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllIdentityCreditsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllIdentityCreditsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryDidDocRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDidDocResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDidDocVersionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDidDocVersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllDidDocVersionsMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllDidDocVersionsMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		for _, e := range m.Versions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDidDocAtTimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDidDocAtTimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTombstoneRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTombstoneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tombstone != nil {
+		l = m.Tombstone.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResolveDidRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Accept)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.VersionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.VersionTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VersionTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResolveDidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DidResolutionResult)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDereferenceDidUrlRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DidUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Accept)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDereferenceDidUrlResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ContentStream)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ContentMetadata)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DereferencingMetadata)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDidsByControllerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDidsByControllerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Dids) > 0 {
+		for _, s := range m.Dids {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDidsByKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VerificationMethodType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.VerificationMaterial)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDidsByKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Fingerprint)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.VerificationMethodIds) > 0 {
+		for _, s := range m.VerificationMethodIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDidsByAliasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Alias)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDidsByAliasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Dids) > 0 {
+		for _, s := range m.Dids {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllDidsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Deactivated != 0 {
+		n += 1 + sovQuery(uint64(m.Deactivated))
+	}
+	if m.CreatedAfter != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CreatedAfter)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CreatedBefore != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CreatedBefore)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllDidsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Dids) > 0 {
+		for _, e := range m.Dids {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DidCount != 0 {
+		n += 1 + sovQuery(uint64(m.DidCount))
+	}
+	if m.VersionCount != 0 {
+		n += 1 + sovQuery(uint64(m.VersionCount))
+	}
+	if m.DeactivatedDidCount != 0 {
+		n += 1 + sovQuery(uint64(m.DeactivatedDidCount))
+	}
+	return n
+}
+
+func (m *QueryEstimateIdentityFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.FeeDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEstimateIdentityFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.MaxFee != nil {
+		l = m.MaxFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Reward) > 0 {
+		for _, e := range m.Reward {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Burn) > 0 {
+		for _, e := range m.Burn {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.PriceSource)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIdentityCreditsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIdentityCreditsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Account.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Discount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllIdentityCreditsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllIdentityCreditsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	if m.Pagination != nil {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryDidDocRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDidDocRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDidDocRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDidDocResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDidDocResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDidDocResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &DidDocWithMetadata{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDidDocVersionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDidDocVersionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDidDocVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDidDocVersionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDidDocVersionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDidDocVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &DidDocWithMetadata{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllDidDocVersionsMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDidDocVersionsMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDidDocVersionsMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllDidDocVersionsMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDidDocVersionsMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDidDocVersionsMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Versions = append(m.Versions, &Metadata{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDidDocAtTimeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDidDocAtTimeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDidDocAtTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDidDocAtTimeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDidDocAtTimeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDidDocAtTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &DidDocWithMetadata{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTombstoneRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTombstoneRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTombstoneRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTombstoneResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTombstoneResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTombstoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstone", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tombstone == nil {
+				m.Tombstone = &DidDocTombstone{}
+			}
+			if err := m.Tombstone.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResolveDidRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResolveDidRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResolveDidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accept", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accept = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersionTime == nil {
+				m.VersionTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VersionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResolveDidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResolveDidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResolveDidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidResolutionResult", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidResolutionResult = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDereferenceDidUrlRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDereferenceDidUrlRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDereferenceDidUrlRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accept", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accept = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDereferenceDidUrlResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDereferenceDidUrlResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDereferenceDidUrlResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentStream", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentStream = append(m.ContentStream[:0], dAtA[iNdEx:postIndex]...)
+			if m.ContentStream == nil {
+				m.ContentStream = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentMetadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentMetadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DereferencingMetadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DereferencingMetadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDidsByControllerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDidsByControllerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDidsByControllerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDidsByControllerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDidsByControllerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDidsByControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dids = append(m.Dids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDidsByKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDidsByKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDidsByKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMethodType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationMethodType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMaterial", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationMaterial = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDidsByKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDidsByKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDidsByKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fingerprint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMethodIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationMethodIds = append(m.VerificationMethodIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDidsByAliasRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDidsByAliasRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDidsByAliasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Alias = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDidsByAliasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDidsByAliasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDidsByAliasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dids = append(m.Dids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllDidsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDidsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDidsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deactivated", wireType)
+			}
+			m.Deactivated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deactivated |= DeactivatedFilter(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAfter == nil {
+				m.CreatedAfter = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.CreatedAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedBefore == nil {
+				m.CreatedBefore = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.CreatedBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
+func (m *QueryAllDidsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDidsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDidsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dids = append(m.Dids, &DidDocWithMetadata{})
+			if err := m.Dids[len(m.Dids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryAllDidDocVersionsMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.Versions) > 0 {
-		for _, e := range m.Versions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidCount", wireType)
+			}
+			m.DidCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DidCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionCount", wireType)
+			}
+			m.VersionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VersionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeactivatedDidCount", wireType)
+			}
+			m.DeactivatedDidCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeactivatedDidCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryDidDocRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateIdentityFeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1136,15 +7471,15 @@ func (m *QueryDidDocRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDidDocRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimateIdentityFeeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDidDocRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimateIdentityFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1172,7 +7507,73 @@ func (m *QueryDidDocRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1195,7 +7596,7 @@ func (m *QueryDidDocRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDidDocResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateIdentityFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1214,21 +7615,192 @@ func (m *QueryDidDocResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDidDocResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDidDocResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateIdentityFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateIdentityFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxFee == nil {
+				m.MaxFee = &types1.Coin{}
+			}
+			if err := m.MaxFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reward = append(m.Reward, types1.Coin{})
+			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burn = append(m.Burn, types1.Coin{})
+			if err := m.Burn[len(m.Burn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSource", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1238,27 +7810,23 @@ func (m *QueryDidDocResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Value == nil {
-				m.Value = &DidDocWithMetadata{}
-			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PriceSource = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1281,7 +7849,7 @@ func (m *QueryDidDocResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDidDocVersionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1304,76 +7872,12 @@ func (m *QueryDidDocVersionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDidDocVersionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDidDocVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1395,7 +7899,7 @@ func (m *QueryDidDocVersionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDidDocVersionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1418,15 +7922,15 @@ func (m *QueryDidDocVersionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDidDocVersionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDidDocVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1453,10 +7957,7 @@ func (m *QueryDidDocVersionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Value == nil {
-				m.Value = &DidDocWithMetadata{}
-			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1481,7 +7982,7 @@ func (m *QueryDidDocVersionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllDidDocVersionsMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIdentityCreditsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1504,15 +8005,15 @@ func (m *QueryAllDidDocVersionsMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllDidDocVersionsMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIdentityCreditsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllDidDocVersionsMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIdentityCreditsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1540,43 +8041,7 @@ func (m *QueryAllDidDocVersionsMetadataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1599,7 +8064,7 @@ func (m *QueryAllDidDocVersionsMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllDidDocVersionsMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIdentityCreditsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1622,15 +8087,15 @@ func (m *QueryAllDidDocVersionsMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllDidDocVersionsMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIdentityCreditsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllDidDocVersionsMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIdentityCreditsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1657,16 +8122,15 @@ func (m *QueryAllDidDocVersionsMetadataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Versions = append(m.Versions, &Metadata{})
-			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1676,25 +8140,23 @@ func (m *QueryAllDidDocVersionsMetadataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Discount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1719,7 +8181,7 @@ func (m *QueryAllDidDocVersionsMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllIdentityCreditsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1742,12 +8204,48 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllIdentityCreditsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllIdentityCreditsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1769,7 +8267,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllIdentityCreditsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1792,15 +8290,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllIdentityCreditsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllIdentityCreditsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1827,7 +8325,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Accounts = append(m.Accounts, IdentityCreditAccount{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,25 @@
+package types
+
+// Matches checks whether the latest version of a diddoc satisfies all the filters set in the request
+func (query *QueryAllDidsRequest) Matches(didDoc DidDocWithMetadata) bool {
+	switch query.Deactivated {
+	case DeactivatedFilter_DEACTIVATED_FILTER_ACTIVE:
+		if didDoc.Metadata.Deactivated {
+			return false
+		}
+	case DeactivatedFilter_DEACTIVATED_FILTER_DEACTIVATED:
+		if !didDoc.Metadata.Deactivated {
+			return false
+		}
+	}
+
+	if query.CreatedAfter != nil && didDoc.Metadata.Created.Before(*query.CreatedAfter) {
+		return false
+	}
+
+	if query.CreatedBefore != nil && !didDoc.Metadata.Created.Before(*query.CreatedBefore) {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,125 @@
+package types
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cheqd/cheqd-node/x/did/utils"
+)
+
+// DID URL query parameters supported by dereferencing
+const (
+	VersionIDQueryParam           = "versionId"
+	VersionTimeQueryParam         = "versionTime"
+	ResourceNameQueryParam        = "resourceName"
+	ResourceTypeQueryParam        = "resourceType"
+	ResourceVersionTimeQueryParam = "resourceVersionTime"
+)
+
+// DidURLDereferencingParams is a DID URL split into the parts used for dereferencing
+type DidURLDereferencingParams struct {
+	Did      string
+	Fragment string
+
+	// ResourceID is set for /resources/<id> paths
+	ResourceID string
+
+	VersionID   string
+	VersionTime *time.Time
+
+	ResourceName        string
+	ResourceType        string
+	ResourceVersionTime *time.Time
+}
+
+func (query *QueryDereferenceDidUrlRequest) Normalize() {
+	query.DidUrl = strings.TrimSpace(query.DidUrl)
+	query.Accept = NormalizeDidRepresentation(query.Accept)
+}
+
+// ParseDidURL validates a DID URL and extracts the supported path, query parameters and fragment
+func ParseDidURL(didURL string) (DidURLDereferencingParams, error) {
+	err := utils.ValidateDIDUrl(didURL, DidMethod, nil)
+	if err != nil {
+		return DidURLDereferencingParams{}, err
+	}
+
+	did, path, query, fragment := utils.MustSplitDIDUrl(didURL)
+	params := DidURLDereferencingParams{
+		Did:      utils.NormalizeDID(did),
+		Fragment: fragment,
+	}
+
+	if path != "" {
+		resourceID, ok := strings.CutPrefix(path, LinkedResourcePathPrefix)
+		if !ok || resourceID == "" || strings.Contains(resourceID, "/") {
+			return DidURLDereferencingParams{}, fmt.Errorf("unsupported did url path: %s", path)
+		}
+
+		params.ResourceID = utils.NormalizeUUID(resourceID)
+	}
+
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return DidURLDereferencingParams{}, err
+	}
+
+	for key, value := range values {
+		if len(value) != 1 {
+			return DidURLDereferencingParams{}, fmt.Errorf("query parameter %s must be set once", key)
+		}
+
+		switch key {
+		case VersionIDQueryParam:
+			params.VersionID = utils.NormalizeUUID(value[0])
+		case VersionTimeQueryParam:
+			params.VersionTime, err = parseQueryTime(key, value[0])
+		case ResourceNameQueryParam:
+			params.ResourceName = value[0]
+		case ResourceTypeQueryParam:
+			params.ResourceType = value[0]
+		case ResourceVersionTimeQueryParam:
+			params.ResourceVersionTime, err = parseQueryTime(key, value[0])
+		default:
+			return DidURLDereferencingParams{}, fmt.Errorf("unsupported query parameter: %s", key)
+		}
+		if err != nil {
+			return DidURLDereferencingParams{}, err
+		}
+	}
+
+	return params, params.validate()
+}
+
+// IsResourceQuery checks whether the DID URL selects a resource by name and type
+func (p DidURLDereferencingParams) IsResourceQuery() bool {
+	return p.ResourceName != "" || p.ResourceType != "" || p.ResourceVersionTime != nil
+}
+
+func (p DidURLDereferencingParams) validate() error {
+	isVersionQuery := p.VersionID != "" || p.VersionTime != nil
+
+	switch {
+	case p.VersionID != "" && p.VersionTime != nil:
+		return fmt.Errorf("%s and %s cannot be combined", VersionIDQueryParam, VersionTimeQueryParam)
+	case p.ResourceID != "" && (isVersionQuery || p.IsResourceQuery() || p.Fragment != ""):
+		return fmt.Errorf("resource path cannot be combined with query parameters or fragment")
+	case p.IsResourceQuery() && (p.ResourceName == "" || p.ResourceType == ""):
+		return fmt.Errorf("%s and %s are both required to select a resource", ResourceNameQueryParam, ResourceTypeQueryParam)
+	case p.IsResourceQuery() && (isVersionQuery || p.Fragment != ""):
+		return fmt.Errorf("resource query parameters cannot be combined with version query parameters or fragment")
+	}
+
+	return nil
+}
+
+func parseQueryTime(key, value string) (*time.Time, error) {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("query parameter %s must be a RFC3339 time: %w", key, err)
+	}
+
+	return &t, nil
+}
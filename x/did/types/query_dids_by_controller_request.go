@@ -0,0 +1,9 @@
+package types
+
+import (
+	"github.com/cheqd/cheqd-node/x/did/utils"
+)
+
+func (query *QueryDidsByControllerRequest) Normalize() {
+	query.Controller = utils.NormalizeDID(query.Controller)
+}
@@ -0,0 +1,11 @@
+package types
+
+import (
+	"github.com/cheqd/cheqd-node/x/did/utils"
+)
+
+func (query *QueryResolveDidRequest) Normalize() {
+	query.Id = utils.NormalizeDID(query.Id)
+	query.Accept = NormalizeDidRepresentation(query.Accept)
+	query.VersionId = utils.NormalizeUUID(query.VersionId)
+}
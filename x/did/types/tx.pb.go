@@ -207,6 +207,11 @@ type SignInfo struct {
 	VerificationMethodId string `protobuf:"bytes,1,opt,name=verification_method_id,json=verificationMethodId,proto3" json:"verification_method_id,omitempty"`
 	// Signature of the DID Document controller
 	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	// Version ID of the DID Document the verification method belongs to. OPTIONAL.
+	// On DID Document updates it identifies whether the signature is made by the verification method
+	// of the existing or of the updated version. If not set, the signature is checked against both versions.
+	// For all other DID Documents it must match the latest version, if set.
+	VersionId string `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
 }
 
 func (m *SignInfo) Reset()         { *m = SignInfo{} }
@@ -256,6 +261,13 @@ func (m *SignInfo) GetSignature() []byte {
 	return nil
 }
 
+func (m *SignInfo) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
 // MsgCreateDidDocPayload defines the structure of the payload for creating a new DID document
 type MsgCreateDidDocPayload struct {
 	// context is a list of URIs used to identify the context of the DID document.
@@ -272,7 +284,7 @@ type MsgCreateDidDocPayload struct {
 	//
 	// Required fields:
 	// - id: A unique identifier for the verification method
-	// - type: A supported verification method type (supported: Ed25519VerificationKey2018, Ed25519VerificationKey2020, JsonWebKey2020)
+	// - type: A supported verification method type (supported: Ed25519VerificationKey2018, Ed25519VerificationKey2020, JsonWebKey2020, EcdsaSecp256k1VerificationKey2019, EcdsaSecp256k1RecoveryMethod2020, Multikey)
 	// - controller: DID of the controller of the verification method
 	// - verification_material: Public key of the verification method (supported: publicJwk, publicKeyBase58, publicKeyMultibase)
 	VerificationMethod []*VerificationMethod `protobuf:"bytes,4,rep,name=verification_method,json=verificationMethod,proto3" json:"verification_method,omitempty"`
@@ -305,6 +317,14 @@ type MsgCreateDidDocPayload struct {
 	//
 	// Format: <uuid>
 	VersionId string `protobuf:"bytes,12,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// recovery_commitment is a commitment to a pre-rotated recovery key. OPTIONAL.
+	// It is the hex encoded SHA-256 hash of the recovery key type and verification material.
+	//
+	// Format: <hex-encoded-sha256>
+	RecoveryCommitment string `protobuf:"bytes,13,opt,name=recovery_commitment,json=recoveryCommitment,proto3" json:"recovery_commitment,omitempty"`
+	// controllerThreshold is the minimum number of controllers that must sign on behalf of the DID subject. OPTIONAL.
+	// Zero requires signatures of all controllers.
+	ControllerThreshold uint32 `protobuf:"varint,14,opt,name=controller_threshold,json=controllerThreshold,proto3" json:"controller_threshold,omitempty"`
 }
 
 func (m *MsgCreateDidDocPayload) Reset()         { *m = MsgCreateDidDocPayload{} }
@@ -424,6 +444,20 @@ func (m *MsgCreateDidDocPayload) GetVersionId() string {
 	return ""
 }
 
+func (m *MsgCreateDidDocPayload) GetRecoveryCommitment() string {
+	if m != nil {
+		return m.RecoveryCommitment
+	}
+	return ""
+}
+
+func (m *MsgCreateDidDocPayload) GetControllerThreshold() uint32 {
+	if m != nil {
+		return m.ControllerThreshold
+	}
+	return 0
+}
+
 // MsgCreateDidDocResponse defines response type for Msg/CreateDidDoc.
 type MsgCreateDidDocResponse struct {
 	// Return the created DID Document with metadata
@@ -486,7 +520,7 @@ type MsgUpdateDidDocPayload struct {
 	//
 	// Required fields:
 	// - id: A unique identifier for the verification method
-	// - type: A supported verification method type (supported: Ed25519VerificationKey2018, Ed25519VerificationKey2020, JsonWebKey2020)
+	// - type: A supported verification method type (supported: Ed25519VerificationKey2018, Ed25519VerificationKey2020, JsonWebKey2020, EcdsaSecp256k1VerificationKey2019, EcdsaSecp256k1RecoveryMethod2020, Multikey)
 	// - controller: DID of the controller of the verification method
 	// - verification_material: Public key of the verification method (supported: publicJwk, publicKeyBase58, publicKeyMultibase)
 	VerificationMethod []*VerificationMethod `protobuf:"bytes,4,rep,name=verification_method,json=verificationMethod,proto3" json:"verification_method,omitempty"`
@@ -520,6 +554,18 @@ type MsgUpdateDidDocPayload struct {
 	//
 	// Format: <uuid>
 	VersionId string `protobuf:"bytes,12,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// recovery_commitment is a commitment to a pre-rotated recovery key. OPTIONAL.
+	// It is the hex encoded SHA-256 hash of the recovery key type and verification material.
+	// If not set, the current commitment is kept.
+	//
+	// Format: <hex-encoded-sha256>
+	RecoveryCommitment string `protobuf:"bytes,13,opt,name=recovery_commitment,json=recoveryCommitment,proto3" json:"recovery_commitment,omitempty"`
+	// clear_recovery_commitment removes the current recovery commitment, so the DID Document can't be recovered anymore.
+	// OPTIONAL. Can't be combined with recovery_commitment.
+	ClearRecoveryCommitment bool `protobuf:"varint,14,opt,name=clear_recovery_commitment,json=clearRecoveryCommitment,proto3" json:"clear_recovery_commitment,omitempty"`
+	// controllerThreshold is the minimum number of controllers that must sign on behalf of the DID subject. OPTIONAL.
+	// Zero requires signatures of all controllers.
+	ControllerThreshold uint32 `protobuf:"varint,15,opt,name=controller_threshold,json=controllerThreshold,proto3" json:"controller_threshold,omitempty"`
 }
 
 func (m *MsgUpdateDidDocPayload) Reset()         { *m = MsgUpdateDidDocPayload{} }
@@ -639,6 +685,27 @@ func (m *MsgUpdateDidDocPayload) GetVersionId() string {
 	return ""
 }
 
+func (m *MsgUpdateDidDocPayload) GetRecoveryCommitment() string {
+	if m != nil {
+		return m.RecoveryCommitment
+	}
+	return ""
+}
+
+func (m *MsgUpdateDidDocPayload) GetClearRecoveryCommitment() bool {
+	if m != nil {
+		return m.ClearRecoveryCommitment
+	}
+	return false
+}
+
+func (m *MsgUpdateDidDocPayload) GetControllerThreshold() uint32 {
+	if m != nil {
+		return m.ControllerThreshold
+	}
+	return 0
+}
+
 type MsgUpdateDidDocResponse struct {
 	// Return the updated DID Document with metadata
 	Value *DidDocWithMetadata `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
@@ -684,6 +751,248 @@ func (m *MsgUpdateDidDocResponse) GetValue() *DidDocWithMetadata {
 	return nil
 }
 
+// MsgRecoverDidDoc defines the Msg/RecoverDidDoc request type.
+// It describes the parameters of a request for recovering a DID document with its pre-rotated recovery key.
+type MsgRecoverDidDoc struct {
+	// Payload containing the new verification methods of the DID Document and the revealed recovery key
+	Payload *MsgRecoverDidDocPayload `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Signatures of the revealed recovery key and of the controllers of the new verification methods
+	Signatures []*SignInfo `protobuf:"bytes,2,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (m *MsgRecoverDidDoc) Reset()         { *m = MsgRecoverDidDoc{} }
+func (m *MsgRecoverDidDoc) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverDidDoc) ProtoMessage()    {}
+func (*MsgRecoverDidDoc) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e353aae8dd04717, []int{8}
+}
+func (m *MsgRecoverDidDoc) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecoverDidDoc) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverDidDoc.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecoverDidDoc) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverDidDoc.Merge(m, src)
+}
+func (m *MsgRecoverDidDoc) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverDidDoc) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverDidDoc.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecoverDidDoc proto.InternalMessageInfo
+
+func (m *MsgRecoverDidDoc) GetPayload() *MsgRecoverDidDocPayload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *MsgRecoverDidDoc) GetSignatures() []*SignInfo {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+// MsgRecoverDidDocPayload defines the structure of the payload for recovering a DID document.
+//
+// The recovery key must match the recovery_commitment stored in the DID Document metadata.
+// The controllers of the current version don't have to sign, so the DID Document can be recovered
+// if their keys are lost. Only the verification methods and verification relationships are replaced.
+// Controllers, controllerThreshold, context, services and alsoKnownAs of the current version are kept,
+// so recovery can't bypass the controller policy of the DID Document.
+type MsgRecoverDidDocPayload struct {
+	// id is the DID of the DID document to be recovered.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// verificationMethod is the list of verification methods replacing the current ones.
+	VerificationMethod []*VerificationMethod `protobuf:"bytes,2,rep,name=verification_method,json=verificationMethod,proto3" json:"verification_method,omitempty"`
+	// authentication is a list of verification methods used for authenticating the DID subject.
+	Authentication []string `protobuf:"bytes,3,rep,name=authentication,proto3" json:"authentication,omitempty"`
+	// assertionMethod is a list of verification methods used for asserting claims.
+	AssertionMethod []string `protobuf:"bytes,4,rep,name=assertion_method,json=assertionMethod,proto3" json:"assertion_method,omitempty"`
+	// capabilityInvocation is a list of verification methods used for invoking capabilities.
+	CapabilityInvocation []string `protobuf:"bytes,5,rep,name=capability_invocation,json=capabilityInvocation,proto3" json:"capability_invocation,omitempty"`
+	// capabilityDelegation is a list of verification methods used for delegating capabilities.
+	CapabilityDelegation []string `protobuf:"bytes,6,rep,name=capability_delegation,json=capabilityDelegation,proto3" json:"capability_delegation,omitempty"`
+	// keyAgreement is a list of verification methods used for key agreement.
+	KeyAgreement []string `protobuf:"bytes,7,rep,name=key_agreement,json=keyAgreement,proto3" json:"key_agreement,omitempty"`
+	// Updated version ID of the DID Document.
+	// Links to next/previous versions of the DID Document will be automatically updated.
+	//
+	// Format: <uuid>
+	VersionId string `protobuf:"bytes,8,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// recovery_key is the revealed pre-rotated key. Its id must be a DID URL of the DID being recovered
+	// and the request must be signed with it.
+	RecoveryKey *VerificationMethod `protobuf:"bytes,9,opt,name=recovery_key,json=recoveryKey,proto3" json:"recovery_key,omitempty"`
+	// next_recovery_commitment is a commitment to the next pre-rotated recovery key. OPTIONAL.
+	// If not set, the DID Document can't be recovered again until a new commitment is set by an update.
+	//
+	// Format: <hex-encoded-sha256>
+	NextRecoveryCommitment string `protobuf:"bytes,10,opt,name=next_recovery_commitment,json=nextRecoveryCommitment,proto3" json:"next_recovery_commitment,omitempty"`
+}
+
+func (m *MsgRecoverDidDocPayload) Reset()         { *m = MsgRecoverDidDocPayload{} }
+func (m *MsgRecoverDidDocPayload) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverDidDocPayload) ProtoMessage()    {}
+func (*MsgRecoverDidDocPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e353aae8dd04717, []int{9}
+}
+func (m *MsgRecoverDidDocPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecoverDidDocPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverDidDocPayload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecoverDidDocPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverDidDocPayload.Merge(m, src)
+}
+func (m *MsgRecoverDidDocPayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverDidDocPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverDidDocPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecoverDidDocPayload proto.InternalMessageInfo
+
+func (m *MsgRecoverDidDocPayload) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *MsgRecoverDidDocPayload) GetVerificationMethod() []*VerificationMethod {
+	if m != nil {
+		return m.VerificationMethod
+	}
+	return nil
+}
+
+func (m *MsgRecoverDidDocPayload) GetAuthentication() []string {
+	if m != nil {
+		return m.Authentication
+	}
+	return nil
+}
+
+func (m *MsgRecoverDidDocPayload) GetAssertionMethod() []string {
+	if m != nil {
+		return m.AssertionMethod
+	}
+	return nil
+}
+
+func (m *MsgRecoverDidDocPayload) GetCapabilityInvocation() []string {
+	if m != nil {
+		return m.CapabilityInvocation
+	}
+	return nil
+}
+
+func (m *MsgRecoverDidDocPayload) GetCapabilityDelegation() []string {
+	if m != nil {
+		return m.CapabilityDelegation
+	}
+	return nil
+}
+
+func (m *MsgRecoverDidDocPayload) GetKeyAgreement() []string {
+	if m != nil {
+		return m.KeyAgreement
+	}
+	return nil
+}
+
+func (m *MsgRecoverDidDocPayload) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
+func (m *MsgRecoverDidDocPayload) GetRecoveryKey() *VerificationMethod {
+	if m != nil {
+		return m.RecoveryKey
+	}
+	return nil
+}
+
+func (m *MsgRecoverDidDocPayload) GetNextRecoveryCommitment() string {
+	if m != nil {
+		return m.NextRecoveryCommitment
+	}
+	return ""
+}
+
+// MsgRecoverDidDocResponse defines response type for Msg/RecoverDidDoc.
+type MsgRecoverDidDocResponse struct {
+	// Return the recovered DID Document with metadata
+	Value *DidDocWithMetadata `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *MsgRecoverDidDocResponse) Reset()         { *m = MsgRecoverDidDocResponse{} }
+func (m *MsgRecoverDidDocResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverDidDocResponse) ProtoMessage()    {}
+func (*MsgRecoverDidDocResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e353aae8dd04717, []int{10}
+}
+func (m *MsgRecoverDidDocResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecoverDidDocResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverDidDocResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecoverDidDocResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverDidDocResponse.Merge(m, src)
+}
+func (m *MsgRecoverDidDocResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverDidDocResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverDidDocResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecoverDidDocResponse proto.InternalMessageInfo
+
+func (m *MsgRecoverDidDocResponse) GetValue() *DidDocWithMetadata {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
 // MsgDeactivateDidDocPayload defines the structure of the payload for deactivating an existing DID document
 type MsgDeactivateDidDocPayload struct {
 	// Unique identifier of the DID Document to be deactivated
@@ -697,7 +1006,7 @@ func (m *MsgDeactivateDidDocPayload) Reset()         { *m = MsgDeactivateDidDocP
 func (m *MsgDeactivateDidDocPayload) String() string { return proto.CompactTextString(m) }
 func (*MsgDeactivateDidDocPayload) ProtoMessage()    {}
 func (*MsgDeactivateDidDocPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e353aae8dd04717, []int{8}
+	return fileDescriptor_0e353aae8dd04717, []int{11}
 }
 func (m *MsgDeactivateDidDocPayload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -750,7 +1059,7 @@ func (m *MsgDeactivateDidDocResponse) Reset()         { *m = MsgDeactivateDidDoc
 func (m *MsgDeactivateDidDocResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeactivateDidDocResponse) ProtoMessage()    {}
 func (*MsgDeactivateDidDocResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e353aae8dd04717, []int{9}
+	return fileDescriptor_0e353aae8dd04717, []int{12}
 }
 func (m *MsgDeactivateDidDocResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -786,24 +1095,27 @@ func (m *MsgDeactivateDidDocResponse) GetValue() *DidDocWithMetadata {
 	return nil
 }
 
-// MsgBurn represents a message to burn coins from the message signer account
-type MsgBurn struct {
-	FromAddress string                                   `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
-	Amount      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+// MsgBatchCreateDidDocs defines the Msg/BatchCreateDidDocs request type.
+// It describes the parameters of a request for creating multiple new DID documents at once.
+type MsgBatchCreateDidDocs struct {
+	// Payload containing the DID Documents to be created
+	Payload *MsgBatchCreateDidDocsPayload `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Signatures of the controller(s) of all DID Documents over the whole payload
+	Signatures []*SignInfo `protobuf:"bytes,2,rep,name=signatures,proto3" json:"signatures,omitempty"`
 }
 
-func (m *MsgBurn) Reset()         { *m = MsgBurn{} }
-func (m *MsgBurn) String() string { return proto.CompactTextString(m) }
-func (*MsgBurn) ProtoMessage()    {}
-func (*MsgBurn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e353aae8dd04717, []int{10}
+func (m *MsgBatchCreateDidDocs) Reset()         { *m = MsgBatchCreateDidDocs{} }
+func (m *MsgBatchCreateDidDocs) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchCreateDidDocs) ProtoMessage()    {}
+func (*MsgBatchCreateDidDocs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e353aae8dd04717, []int{13}
 }
-func (m *MsgBurn) XXX_Unmarshal(b []byte) error {
+func (m *MsgBatchCreateDidDocs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgBurn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBatchCreateDidDocs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgBurn.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBatchCreateDidDocs.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -813,33 +1125,55 @@ func (m *MsgBurn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *MsgBurn) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBurn.Merge(m, src)
+func (m *MsgBatchCreateDidDocs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchCreateDidDocs.Merge(m, src)
 }
-func (m *MsgBurn) XXX_Size() int {
+func (m *MsgBatchCreateDidDocs) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgBurn) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBurn.DiscardUnknown(m)
+func (m *MsgBatchCreateDidDocs) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchCreateDidDocs.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgBurn proto.InternalMessageInfo
+var xxx_messageInfo_MsgBatchCreateDidDocs proto.InternalMessageInfo
 
-type MsgBurnResponse struct {
+func (m *MsgBatchCreateDidDocs) GetPayload() *MsgBatchCreateDidDocsPayload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
 }
 
-func (m *MsgBurnResponse) Reset()         { *m = MsgBurnResponse{} }
-func (m *MsgBurnResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgBurnResponse) ProtoMessage()    {}
-func (*MsgBurnResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e353aae8dd04717, []int{11}
+func (m *MsgBatchCreateDidDocs) GetSignatures() []*SignInfo {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
 }
-func (m *MsgBurnResponse) XXX_Unmarshal(b []byte) error {
+
+// MsgBatchCreateDidDocsPayload defines the structure of the payload for creating multiple new DID documents.
+//
+// Either all DID Documents are created or none of them. DID Documents in the batch
+// may be controlled by other DID Documents created in the same batch.
+//
+// The number of DID Documents is limited by the max_batch_size module parameter.
+type MsgBatchCreateDidDocsPayload struct {
+	// Payloads of the DID Documents to be created
+	Payloads []*MsgCreateDidDocPayload `protobuf:"bytes,1,rep,name=payloads,proto3" json:"payloads,omitempty"`
+}
+
+func (m *MsgBatchCreateDidDocsPayload) Reset()         { *m = MsgBatchCreateDidDocsPayload{} }
+func (m *MsgBatchCreateDidDocsPayload) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchCreateDidDocsPayload) ProtoMessage()    {}
+func (*MsgBatchCreateDidDocsPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e353aae8dd04717, []int{14}
+}
+func (m *MsgBatchCreateDidDocsPayload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgBurnResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBatchCreateDidDocsPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgBurnResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBatchCreateDidDocsPayload.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -849,38 +1183,166 @@ func (m *MsgBurnResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgBurnResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBurnResponse.Merge(m, src)
+func (m *MsgBatchCreateDidDocsPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchCreateDidDocsPayload.Merge(m, src)
 }
-func (m *MsgBurnResponse) XXX_Size() int {
+func (m *MsgBatchCreateDidDocsPayload) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgBurnResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBurnResponse.DiscardUnknown(m)
+func (m *MsgBatchCreateDidDocsPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchCreateDidDocsPayload.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgBurnResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgBatchCreateDidDocsPayload proto.InternalMessageInfo
 
-// MsgMint is the sdk.Msg type for allowing an admin account to mint
-// more of a token.
-// Only the admin of the token factory denom has permission to mint unless
-// the denom does not have any admin.
-type MsgMint struct {
-	Authority string                                   `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
-	ToAddress string                                   `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
-	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+func (m *MsgBatchCreateDidDocsPayload) GetPayloads() []*MsgCreateDidDocPayload {
+	if m != nil {
+		return m.Payloads
+	}
+	return nil
 }
 
-func (m *MsgMint) Reset()         { *m = MsgMint{} }
-func (m *MsgMint) String() string { return proto.CompactTextString(m) }
-func (*MsgMint) ProtoMessage()    {}
-func (*MsgMint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e353aae8dd04717, []int{12}
+// MsgBatchCreateDidDocsResponse defines response type for Msg/BatchCreateDidDocs.
+type MsgBatchCreateDidDocsResponse struct {
+	// Return the created DID Documents with metadata
+	Values []*DidDocWithMetadata `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
 }
-func (m *MsgMint) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgBatchCreateDidDocsResponse) Reset()         { *m = MsgBatchCreateDidDocsResponse{} }
+func (m *MsgBatchCreateDidDocsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchCreateDidDocsResponse) ProtoMessage()    {}
+func (*MsgBatchCreateDidDocsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e353aae8dd04717, []int{15}
+}
+func (m *MsgBatchCreateDidDocsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBatchCreateDidDocsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchCreateDidDocsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBatchCreateDidDocsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchCreateDidDocsResponse.Merge(m, src)
+}
+func (m *MsgBatchCreateDidDocsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchCreateDidDocsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchCreateDidDocsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBatchCreateDidDocsResponse proto.InternalMessageInfo
+
+func (m *MsgBatchCreateDidDocsResponse) GetValues() []*DidDocWithMetadata {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+// MsgBurn represents a message to burn coins from the message signer account
+type MsgBurn struct {
+	FromAddress string                                   `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	Amount      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgBurn) Reset()         { *m = MsgBurn{} }
+func (m *MsgBurn) String() string { return proto.CompactTextString(m) }
+func (*MsgBurn) ProtoMessage()    {}
+func (*MsgBurn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e353aae8dd04717, []int{16}
+}
+func (m *MsgBurn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBurn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBurn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBurn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBurn.Merge(m, src)
+}
+func (m *MsgBurn) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBurn) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBurn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBurn proto.InternalMessageInfo
+
+type MsgBurnResponse struct {
+}
+
+func (m *MsgBurnResponse) Reset()         { *m = MsgBurnResponse{} }
+func (m *MsgBurnResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBurnResponse) ProtoMessage()    {}
+func (*MsgBurnResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e353aae8dd04717, []int{17}
+}
+func (m *MsgBurnResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBurnResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBurnResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBurnResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBurnResponse.Merge(m, src)
+}
+func (m *MsgBurnResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBurnResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBurnResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBurnResponse proto.InternalMessageInfo
+
+// MsgMint is the sdk.Msg type for allowing an admin account to mint
+// more of a token.
+// Only the admin of the token factory denom has permission to mint unless
+// the denom does not have any admin.
+type MsgMint struct {
+	Authority string                                   `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	ToAddress string                                   `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgMint) Reset()         { *m = MsgMint{} }
+func (m *MsgMint) String() string { return proto.CompactTextString(m) }
+func (*MsgMint) ProtoMessage()    {}
+func (*MsgMint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e353aae8dd04717, []int{18}
+}
+func (m *MsgMint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
 		return xxx_messageInfo_MsgMint.Marshal(b, m, deterministic)
 	} else {
@@ -932,7 +1394,7 @@ func (m *MsgMintResponse) Reset()         { *m = MsgMintResponse{} }
 func (m *MsgMintResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMintResponse) ProtoMessage()    {}
 func (*MsgMintResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e353aae8dd04717, []int{13}
+	return fileDescriptor_0e353aae8dd04717, []int{19}
 }
 func (m *MsgMintResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -974,7 +1436,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e353aae8dd04717, []int{14}
+	return fileDescriptor_0e353aae8dd04717, []int{20}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1028,7 +1490,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e353aae8dd04717, []int{15}
+	return fileDescriptor_0e353aae8dd04717, []int{21}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1057,6 +1519,108 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgPurchaseIdentityCredits prepays identity fees of the buyer account.
+// The post handler draws identity fees from the credits before charging the account.
+type MsgPurchaseIdentityCredits struct {
+	// Bech32 address of the account purchasing the credits
+	Buyer string `protobuf:"bytes,1,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// Credits to purchase, in ncheq
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgPurchaseIdentityCredits) Reset()         { *m = MsgPurchaseIdentityCredits{} }
+func (m *MsgPurchaseIdentityCredits) String() string { return proto.CompactTextString(m) }
+func (*MsgPurchaseIdentityCredits) ProtoMessage()    {}
+func (*MsgPurchaseIdentityCredits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e353aae8dd04717, []int{22}
+}
+func (m *MsgPurchaseIdentityCredits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPurchaseIdentityCredits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPurchaseIdentityCredits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPurchaseIdentityCredits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPurchaseIdentityCredits.Merge(m, src)
+}
+func (m *MsgPurchaseIdentityCredits) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPurchaseIdentityCredits) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPurchaseIdentityCredits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPurchaseIdentityCredits proto.InternalMessageInfo
+
+func (m *MsgPurchaseIdentityCredits) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+func (m *MsgPurchaseIdentityCredits) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// MsgPurchaseIdentityCreditsResponse defines the Msg/PurchaseIdentityCredits response type.
+type MsgPurchaseIdentityCreditsResponse struct {
+	// Credit balance after the purchase
+	Balance types.Coin `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance"`
+}
+
+func (m *MsgPurchaseIdentityCreditsResponse) Reset()         { *m = MsgPurchaseIdentityCreditsResponse{} }
+func (m *MsgPurchaseIdentityCreditsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPurchaseIdentityCreditsResponse) ProtoMessage()    {}
+func (*MsgPurchaseIdentityCreditsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e353aae8dd04717, []int{23}
+}
+func (m *MsgPurchaseIdentityCreditsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPurchaseIdentityCreditsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPurchaseIdentityCreditsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPurchaseIdentityCreditsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPurchaseIdentityCreditsResponse.Merge(m, src)
+}
+func (m *MsgPurchaseIdentityCreditsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPurchaseIdentityCreditsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPurchaseIdentityCreditsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPurchaseIdentityCreditsResponse proto.InternalMessageInfo
+
+func (m *MsgPurchaseIdentityCreditsResponse) GetBalance() types.Coin {
+	if m != nil {
+		return m.Balance
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*MsgCreateDidDoc)(nil), "cheqd.did.v2.MsgCreateDidDoc")
 	proto.RegisterType((*MsgUpdateDidDoc)(nil), "cheqd.did.v2.MsgUpdateDidDoc")
@@ -1066,87 +1630,119 @@ func init() {
 	proto.RegisterType((*MsgCreateDidDocResponse)(nil), "cheqd.did.v2.MsgCreateDidDocResponse")
 	proto.RegisterType((*MsgUpdateDidDocPayload)(nil), "cheqd.did.v2.MsgUpdateDidDocPayload")
 	proto.RegisterType((*MsgUpdateDidDocResponse)(nil), "cheqd.did.v2.MsgUpdateDidDocResponse")
+	proto.RegisterType((*MsgRecoverDidDoc)(nil), "cheqd.did.v2.MsgRecoverDidDoc")
+	proto.RegisterType((*MsgRecoverDidDocPayload)(nil), "cheqd.did.v2.MsgRecoverDidDocPayload")
+	proto.RegisterType((*MsgRecoverDidDocResponse)(nil), "cheqd.did.v2.MsgRecoverDidDocResponse")
 	proto.RegisterType((*MsgDeactivateDidDocPayload)(nil), "cheqd.did.v2.MsgDeactivateDidDocPayload")
 	proto.RegisterType((*MsgDeactivateDidDocResponse)(nil), "cheqd.did.v2.MsgDeactivateDidDocResponse")
+	proto.RegisterType((*MsgBatchCreateDidDocs)(nil), "cheqd.did.v2.MsgBatchCreateDidDocs")
+	proto.RegisterType((*MsgBatchCreateDidDocsPayload)(nil), "cheqd.did.v2.MsgBatchCreateDidDocsPayload")
+	proto.RegisterType((*MsgBatchCreateDidDocsResponse)(nil), "cheqd.did.v2.MsgBatchCreateDidDocsResponse")
 	proto.RegisterType((*MsgBurn)(nil), "cheqd.did.v2.MsgBurn")
 	proto.RegisterType((*MsgBurnResponse)(nil), "cheqd.did.v2.MsgBurnResponse")
 	proto.RegisterType((*MsgMint)(nil), "cheqd.did.v2.MsgMint")
 	proto.RegisterType((*MsgMintResponse)(nil), "cheqd.did.v2.MsgMintResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "cheqd.did.v2.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cheqd.did.v2.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgPurchaseIdentityCredits)(nil), "cheqd.did.v2.MsgPurchaseIdentityCredits")
+	proto.RegisterType((*MsgPurchaseIdentityCreditsResponse)(nil), "cheqd.did.v2.MsgPurchaseIdentityCreditsResponse")
 }
 
 func init() { proto.RegisterFile("cheqd/did/v2/tx.proto", fileDescriptor_0e353aae8dd04717) }
 
 var fileDescriptor_0e353aae8dd04717 = []byte{
-	// 1070 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0x4d, 0x6f, 0xe3, 0xc4,
-	0x1b, 0x8f, 0x93, 0xbe, 0x6c, 0x26, 0xd9, 0x6e, 0x77, 0xb6, 0x2f, 0x6e, 0xfe, 0xff, 0xa6, 0x25,
-	0xbc, 0xa5, 0x95, 0x1a, 0xab, 0x59, 0x28, 0x52, 0x41, 0x48, 0xcd, 0x56, 0x48, 0xd5, 0x2a, 0xd2,
-	0xae, 0x77, 0x17, 0x24, 0x0e, 0x84, 0x89, 0x67, 0xea, 0x8c, 0x1a, 0x7b, 0x82, 0x67, 0x62, 0x9a,
-	0x1b, 0xe2, 0xc4, 0xcb, 0x05, 0xbe, 0xc1, 0x1e, 0x11, 0xa7, 0x1e, 0xf8, 0x10, 0x2b, 0x71, 0x59,
-	0x71, 0xe2, 0xb4, 0xa0, 0xf6, 0x50, 0x0e, 0x1c, 0x10, 0x9f, 0x00, 0x79, 0x3c, 0x71, 0x6c, 0x27,
-	0x6d, 0x85, 0x76, 0x39, 0xc1, 0x25, 0x89, 0x7f, 0xcf, 0xcb, 0xfc, 0x9e, 0x9f, 0x9f, 0x27, 0x7e,
-	0x0c, 0x16, 0xad, 0x0e, 0xf9, 0x04, 0x1b, 0x98, 0x62, 0xc3, 0xaf, 0x1b, 0xe2, 0xb8, 0xd6, 0xf3,
-	0x98, 0x60, 0xb0, 0x28, 0xe1, 0x1a, 0xa6, 0xb8, 0xe6, 0xd7, 0x4b, 0x37, 0x91, 0x43, 0x5d, 0x66,
-	0xc8, 0xcf, 0xd0, 0xa1, 0xb4, 0x92, 0x88, 0xc3, 0x14, 0x63, 0x66, 0x29, 0xd3, 0x52, 0xc2, 0x74,
-	0x48, 0x88, 0xc2, 0xcb, 0x16, 0xe3, 0x0e, 0xe3, 0x46, 0x1b, 0x71, 0x62, 0xf8, 0xdb, 0x6d, 0x22,
-	0xd0, 0xb6, 0x61, 0x31, 0xea, 0x2a, 0xfb, 0xb2, 0xb2, 0x3b, 0xdc, 0x36, 0xfc, 0xed, 0xe0, 0x2b,
-	0x3a, 0x4b, 0x1a, 0x5a, 0xf2, 0xca, 0x08, 0x2f, 0x94, 0x69, 0xc1, 0x66, 0x36, 0x0b, 0xf1, 0xe0,
-	0x57, 0x88, 0x56, 0xbe, 0xd4, 0xc0, 0x8d, 0x26, 0xb7, 0xef, 0x78, 0x04, 0x09, 0xb2, 0x4f, 0xf1,
-	0x3e, 0xb3, 0xe0, 0xbb, 0x60, 0xb6, 0x87, 0x06, 0x5d, 0x86, 0xb0, 0xae, 0xad, 0x6b, 0xd5, 0x42,
-	0xfd, 0x95, 0x5a, 0xbc, 0xc6, 0x5a, 0xca, 0xff, 0x5e, 0xe8, 0x6b, 0x0e, 0x83, 0xe0, 0x0e, 0x00,
-	0x9c, 0xda, 0x2e, 0x12, 0x7d, 0x8f, 0x70, 0x3d, 0xbb, 0x9e, 0xab, 0x16, 0xea, 0x4b, 0xc9, 0x14,
-	0x0f, 0xa8, 0xed, 0x1e, 0xb8, 0x87, 0xcc, 0x8c, 0x79, 0x0e, 0xb9, 0x3c, 0xea, 0xe1, 0xbf, 0xc5,
-	0x25, 0xee, 0xff, 0xc2, 0xb8, 0x7c, 0xab, 0x81, 0x5b, 0x4d, 0x6e, 0xef, 0x13, 0x64, 0x09, 0xea,
-	0x8f, 0xf8, 0x34, 0xd2, 0x7c, 0xaa, 0x63, 0x7c, 0xd2, 0x31, 0x2f, 0x8c, 0xd3, 0x47, 0xe0, 0xda,
-	0x10, 0x87, 0x6f, 0x80, 0x25, 0x9f, 0x78, 0xf4, 0x90, 0x5a, 0x48, 0x50, 0xe6, 0xb6, 0x1c, 0x22,
-	0x3a, 0x0c, 0xb7, 0x68, 0x48, 0x2b, 0x6f, 0x2e, 0xc4, 0xad, 0x4d, 0x69, 0x3c, 0xc0, 0xf0, 0xff,
-	0x20, 0x1f, 0xe5, 0xd3, 0xb3, 0xeb, 0x5a, 0xb5, 0x68, 0x8e, 0x80, 0xca, 0xd7, 0x53, 0x60, 0x69,
-	0xf2, 0xbd, 0x85, 0x3a, 0x98, 0xb5, 0x98, 0x2b, 0xc8, 0xb1, 0xd0, 0xb5, 0xf5, 0x5c, 0x35, 0x6f,
-	0x0e, 0x2f, 0xe1, 0x1c, 0xc8, 0x52, 0x2c, 0x73, 0xe5, 0xcd, 0x2c, 0xc5, 0xb0, 0x0c, 0x40, 0x60,
-	0xf2, 0x58, 0xb7, 0x4b, 0x3c, 0x3d, 0x27, 0x9d, 0x63, 0x08, 0xbc, 0x0f, 0x6e, 0x4d, 0x20, 0xae,
-	0x4f, 0x49, 0x15, 0xd6, 0x93, 0x2a, 0xbc, 0x3f, 0x56, 0x83, 0x09, 0xc7, 0xeb, 0x82, 0xaf, 0x81,
-	0x39, 0xd4, 0x17, 0x1d, 0xe2, 0x0a, 0x85, 0xeb, 0xd3, 0xf2, 0xd8, 0x14, 0x0a, 0x37, 0xc0, 0x3c,
-	0xe2, 0x9c, 0x78, 0xf1, 0x73, 0x67, 0xa4, 0xe7, 0x8d, 0x08, 0x57, 0x29, 0x6f, 0x83, 0x45, 0x0b,
-	0xf5, 0x50, 0x9b, 0x76, 0xa9, 0x18, 0xb4, 0xa8, 0xeb, 0x33, 0x95, 0x79, 0x56, 0xfa, 0x2f, 0x8c,
-	0x8c, 0x07, 0x91, 0x2d, 0x15, 0x84, 0x49, 0x97, 0xd8, 0x61, 0xd0, 0xb5, 0x74, 0xd0, 0x7e, 0x64,
-	0x83, 0x2f, 0x83, 0xeb, 0x47, 0x64, 0xd0, 0x42, 0xb6, 0x47, 0x88, 0x43, 0x5c, 0xa1, 0xe7, 0xa5,
-	0x73, 0xf1, 0x88, 0x0c, 0xf6, 0x86, 0x18, 0x34, 0xc0, 0x2c, 0x27, 0x9e, 0x4f, 0x2d, 0xa2, 0x03,
-	0x29, 0xd4, 0x62, 0xaa, 0x5d, 0x42, 0xa3, 0x39, 0xf4, 0x82, 0x15, 0x70, 0x1d, 0x75, 0x39, 0x6b,
-	0x1d, 0xb9, 0xec, 0x53, 0xb7, 0x85, 0xb8, 0x5e, 0x90, 0x59, 0x0b, 0x01, 0x78, 0x37, 0xc0, 0xf6,
-	0x38, 0x5c, 0x05, 0xc0, 0x27, 0x1e, 0x0f, 0xc4, 0xa0, 0x58, 0x2f, 0xca, 0x3b, 0x98, 0x57, 0xc8,
-	0x01, 0xae, 0xdc, 0x07, 0xcb, 0xa9, 0x66, 0x30, 0x09, 0xef, 0x31, 0x97, 0x13, 0xb8, 0x03, 0xa6,
-	0x7d, 0xd4, 0xed, 0x13, 0x35, 0x02, 0xa9, 0xbb, 0x16, 0x3a, 0x7f, 0x40, 0x45, 0xa7, 0x49, 0x04,
-	0xc2, 0x48, 0x20, 0x33, 0x74, 0x1f, 0x36, 0xd8, 0x84, 0x81, 0xfd, 0xaf, 0xc1, 0xfe, 0xad, 0x0d,
-	0x16, 0x6f, 0x86, 0xe7, 0x6e, 0xb0, 0xbb, 0xa0, 0x74, 0xf1, 0x1f, 0xb0, 0xea, 0x24, 0x2d, 0xea,
-	0xa4, 0x24, 0xbf, 0x6c, 0x9a, 0xdf, 0x23, 0xf0, 0xbf, 0x09, 0xc9, 0x9e, 0x9b, 0xe3, 0x8f, 0x1a,
-	0x98, 0x6d, 0x72, 0xbb, 0xd1, 0xf7, 0x5c, 0xf8, 0x36, 0x28, 0x1e, 0x7a, 0xcc, 0x69, 0x21, 0x8c,
-	0x3d, 0xc2, 0x79, 0xc8, 0xad, 0xa1, 0xff, 0xf4, 0xc3, 0xd6, 0x82, 0x7a, 0x76, 0xef, 0x85, 0x96,
-	0x07, 0xc2, 0xa3, 0xae, 0x6d, 0x16, 0x02, 0x6f, 0x05, 0xc1, 0x0e, 0x98, 0x41, 0x0e, 0xeb, 0xbb,
-	0x42, 0x3d, 0x42, 0x56, 0x6a, 0x2a, 0x26, 0xd8, 0x1a, 0x6a, 0x6a, 0x6b, 0xa8, 0xdd, 0x61, 0xd4,
-	0x6d, 0xbc, 0xf9, 0xe4, 0xd9, 0x5a, 0xe6, 0xfb, 0x5f, 0xd6, 0xaa, 0x36, 0x15, 0x9d, 0x7e, 0xbb,
-	0x66, 0x31, 0x47, 0x2d, 0x07, 0xea, 0x6b, 0x8b, 0xe3, 0x23, 0x43, 0x0c, 0x7a, 0x84, 0xcb, 0x00,
-	0xfe, 0xdd, 0xf9, 0xc9, 0xa6, 0x66, 0xaa, 0xfc, 0xbb, 0x2b, 0x5f, 0x3c, 0x5e, 0xcb, 0xfc, 0xf6,
-	0x78, 0x2d, 0xf3, 0xf9, 0xf9, 0xc9, 0x66, 0x82, 0x71, 0xe5, 0xa6, 0x7c, 0x64, 0x07, 0xc5, 0x0c,
-	0x85, 0xa9, 0xfc, 0x11, 0x16, 0xd8, 0xa4, 0xae, 0x80, 0x75, 0x90, 0x0f, 0x66, 0x84, 0x79, 0x54,
-	0x0c, 0x54, 0x75, 0x0b, 0x7f, 0x3e, 0x5b, 0x9b, 0x1f, 0x20, 0xa7, 0xbb, 0x5b, 0x89, 0x4c, 0x15,
-	0x73, 0xe4, 0x06, 0xdf, 0x02, 0x40, 0xb0, 0x48, 0x92, 0xec, 0x15, 0x92, 0xe4, 0x05, 0x1b, 0x17,
-	0x24, 0xf7, 0x0f, 0x0b, 0x32, 0x17, 0x08, 0x31, 0xa2, 0xac, 0x54, 0x08, 0x2a, 0x8e, 0x54, 0x38,
-	0x89, 0x2f, 0x33, 0xf7, 0x90, 0x87, 0x1c, 0x0e, 0x77, 0xc6, 0xd5, 0xb8, 0xa4, 0xb0, 0x91, 0x22,
-	0xbb, 0x60, 0xa6, 0x27, 0x33, 0x48, 0x35, 0x0a, 0xf5, 0xe5, 0x64, 0xaf, 0xbd, 0x47, 0xd4, 0x01,
-	0x8d, 0x7c, 0x50, 0x96, 0xa2, 0x1a, 0x46, 0xec, 0x6e, 0x24, 0xa9, 0x7e, 0x75, 0x7e, 0xb2, 0xb9,
-	0x64, 0x1c, 0xcb, 0x95, 0x33, 0x45, 0xaf, 0xb2, 0x12, 0x1b, 0xc8, 0x10, 0x1a, 0x56, 0x53, 0xff,
-	0x3d, 0x07, 0x72, 0x4d, 0x6e, 0xc3, 0x87, 0xa0, 0x98, 0x58, 0x15, 0x57, 0x2f, 0xdd, 0x0c, 0x4b,
-	0xaf, 0x5e, 0x6a, 0x8e, 0x46, 0xe9, 0x21, 0x28, 0x26, 0x96, 0xbe, 0xd5, 0x4b, 0x77, 0xbc, 0x09,
-	0x59, 0x27, 0xfe, 0x89, 0x7c, 0x0c, 0xe6, 0xc7, 0xd6, 0xb7, 0x97, 0xae, 0xdc, 0xd6, 0x4a, 0x1b,
-	0x57, 0xba, 0x44, 0x27, 0xbc, 0x03, 0xa6, 0xe4, 0x18, 0x2f, 0x8e, 0x85, 0x04, 0x70, 0x69, 0x75,
-	0x22, 0x1c, 0x8f, 0x96, 0x33, 0x32, 0x1e, 0x1d, 0xc0, 0x13, 0xa2, 0xe3, 0xfd, 0x35, 0xd2, 0x4c,
-	0xf5, 0xd6, 0x45, 0x9a, 0x85, 0xe6, 0x0b, 0x35, 0x4b, 0xde, 0xe7, 0xd2, 0xf4, 0x67, 0x41, 0xf3,
-	0x34, 0xf6, 0x9e, 0x9c, 0x96, 0xb5, 0xa7, 0xa7, 0x65, 0xed, 0xd7, 0xd3, 0xb2, 0xf6, 0xcd, 0x59,
-	0x39, 0xf3, 0xf4, 0xac, 0x9c, 0xf9, 0xf9, 0xac, 0x9c, 0xf9, 0xf0, 0xf5, 0xf8, 0xc0, 0xc8, 0x97,
-	0x17, 0xf9, 0xb9, 0xe5, 0x32, 0x4c, 0x54, 0x5b, 0xc9, 0xa9, 0x69, 0xcf, 0xc8, 0xf7, 0x8b, 0xdb,
-	0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x2f, 0x66, 0xba, 0xd8, 0x36, 0x0d, 0x00, 0x00,
+	// 1444 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcb, 0x6f, 0x1b, 0xc5,
+	0x1f, 0xcf, 0xc6, 0x79, 0xf9, 0x1b, 0xe7, 0xd1, 0xc9, 0x6b, 0xe3, 0x5f, 0xe3, 0xe4, 0xb7, 0x25,
+	0x25, 0x0d, 0xaa, 0x4d, 0x52, 0x28, 0x55, 0xa8, 0x0a, 0x79, 0x08, 0x29, 0xaa, 0x2c, 0xb5, 0xdb,
+	0x96, 0x0a, 0x2e, 0xee, 0x64, 0x77, 0x62, 0x8f, 0x62, 0xef, 0x84, 0x9d, 0xb1, 0x89, 0x0f, 0x48,
+	0x88, 0x13, 0x8f, 0x0b, 0x1c, 0xe0, 0xdc, 0x03, 0x07, 0x04, 0x97, 0x1c, 0xf8, 0x23, 0x2a, 0x71,
+	0xa9, 0x38, 0x71, 0x2a, 0xa8, 0x3d, 0x94, 0x1b, 0x88, 0x33, 0x07, 0xb4, 0xb3, 0xb3, 0x6b, 0x7b,
+	0xd7, 0xb1, 0x5d, 0x1a, 0x6e, 0xbd, 0xc4, 0xf1, 0xf7, 0xf3, 0x7d, 0xcf, 0xf7, 0x33, 0xe3, 0x19,
+	0x98, 0xb1, 0x4a, 0xe4, 0x03, 0x3b, 0x67, 0x53, 0x3b, 0x57, 0x5b, 0xcf, 0x89, 0xa3, 0xec, 0xa1,
+	0xcb, 0x04, 0x43, 0x29, 0x29, 0xce, 0xda, 0xd4, 0xce, 0xd6, 0xd6, 0xd3, 0x67, 0x70, 0x85, 0x3a,
+	0x2c, 0x27, 0xff, 0xfa, 0x0a, 0xe9, 0xf9, 0x16, 0x3b, 0x9b, 0xda, 0x36, 0xb3, 0x14, 0x34, 0xdb,
+	0x02, 0xed, 0x13, 0xa2, 0xe4, 0x19, 0x8b, 0xf1, 0x0a, 0xe3, 0xb9, 0x3d, 0xcc, 0x49, 0xae, 0xb6,
+	0xb6, 0x47, 0x04, 0x5e, 0xcb, 0x59, 0x8c, 0x3a, 0x0a, 0x9f, 0x53, 0x78, 0x85, 0x17, 0x73, 0xb5,
+	0x35, 0xef, 0x23, 0x8c, 0x25, 0x81, 0x82, 0xfc, 0x96, 0xf3, 0xbf, 0x28, 0x68, 0xba, 0xc8, 0x8a,
+	0xcc, 0x97, 0x7b, 0xff, 0xf9, 0x52, 0xe3, 0x33, 0x0d, 0x26, 0xf2, 0xbc, 0xb8, 0xed, 0x12, 0x2c,
+	0xc8, 0x0e, 0xb5, 0x77, 0x98, 0x85, 0xae, 0xc1, 0xf0, 0x21, 0xae, 0x97, 0x19, 0xb6, 0x75, 0x6d,
+	0x49, 0x5b, 0x19, 0x5d, 0x7f, 0x29, 0xdb, 0x5c, 0x63, 0x36, 0xa2, 0x7f, 0xc3, 0xd7, 0x35, 0x03,
+	0x23, 0x74, 0x19, 0x80, 0xd3, 0xa2, 0x83, 0x45, 0xd5, 0x25, 0x5c, 0xef, 0x5f, 0x4a, 0xac, 0x8c,
+	0xae, 0xcf, 0xb6, 0xba, 0xb8, 0x45, 0x8b, 0xce, 0xae, 0xb3, 0xcf, 0xcc, 0x26, 0xcd, 0x20, 0x97,
+	0x3b, 0x87, 0xf6, 0x33, 0xe5, 0xd2, 0xac, 0x7f, 0x6a, 0xb9, 0x7c, 0xa5, 0xc1, 0x54, 0x9e, 0x17,
+	0x77, 0x08, 0xb6, 0x04, 0xad, 0x35, 0xf2, 0xd9, 0x8a, 0xe6, 0xb3, 0x12, 0xcb, 0x27, 0x6a, 0x73,
+	0x6a, 0x39, 0x7d, 0x04, 0x23, 0x81, 0x1c, 0xbd, 0x06, 0xb3, 0x35, 0xe2, 0xd2, 0x7d, 0x6a, 0x61,
+	0x41, 0x99, 0x53, 0xa8, 0x10, 0x51, 0x62, 0x76, 0x81, 0xfa, 0x69, 0x25, 0xcd, 0xe9, 0x66, 0x34,
+	0x2f, 0xc1, 0x5d, 0x1b, 0x9d, 0x85, 0x64, 0xe8, 0x4f, 0xef, 0x5f, 0xd2, 0x56, 0x52, 0x66, 0x43,
+	0x80, 0x16, 0x00, 0x6a, 0xc4, 0xe5, 0x9e, 0x3b, 0x6a, 0xeb, 0x09, 0xe9, 0x27, 0xa9, 0x24, 0xbb,
+	0xb6, 0xf1, 0xc7, 0x00, 0xcc, 0xb6, 0x5f, 0x7a, 0xa4, 0xc3, 0xb0, 0xc5, 0x1c, 0x41, 0x8e, 0x84,
+	0xae, 0x2d, 0x25, 0x56, 0x92, 0x66, 0xf0, 0x15, 0x8d, 0x43, 0x3f, 0xb5, 0x65, 0xa8, 0xa4, 0xd9,
+	0x4f, 0x6d, 0x94, 0x01, 0xf0, 0x20, 0x97, 0x95, 0xcb, 0xc4, 0xd5, 0x13, 0x52, 0xb9, 0x49, 0x82,
+	0x6e, 0xc2, 0x54, 0x9b, 0xba, 0xf4, 0x01, 0xd9, 0xa4, 0xa5, 0xd6, 0x26, 0xbd, 0x1b, 0x2b, 0xd1,
+	0x44, 0xf1, 0xb2, 0xd1, 0x79, 0x18, 0xc7, 0x55, 0x51, 0x22, 0x8e, 0x50, 0x72, 0x7d, 0x50, 0x86,
+	0x8d, 0x48, 0xd1, 0x05, 0x98, 0xc4, 0x9c, 0x13, 0xb7, 0x39, 0xee, 0x90, 0xd4, 0x9c, 0x08, 0xe5,
+	0xca, 0xe5, 0x25, 0x98, 0xb1, 0xf0, 0x21, 0xde, 0xa3, 0x65, 0x2a, 0xea, 0x05, 0xea, 0xd4, 0x98,
+	0xf2, 0x3c, 0x2c, 0xf5, 0xa7, 0x1b, 0xe0, 0x6e, 0x88, 0x45, 0x8c, 0x6c, 0x52, 0x26, 0x45, 0xdf,
+	0x68, 0x24, 0x6a, 0xb4, 0x13, 0x62, 0xe8, 0x1c, 0x8c, 0x1d, 0x90, 0x7a, 0x01, 0x17, 0x5d, 0x42,
+	0x2a, 0xc4, 0x11, 0x7a, 0x52, 0x2a, 0xa7, 0x0e, 0x48, 0x7d, 0x33, 0x90, 0xa1, 0x1c, 0x0c, 0x73,
+	0xe2, 0xd6, 0xa8, 0x45, 0x74, 0x90, 0x8d, 0x9a, 0x89, 0x4c, 0x93, 0x0f, 0x9a, 0x81, 0x16, 0x32,
+	0x60, 0x0c, 0x97, 0x39, 0x2b, 0x1c, 0x38, 0xec, 0x43, 0xa7, 0x80, 0xb9, 0x3e, 0x2a, 0xbd, 0x8e,
+	0x7a, 0xc2, 0xeb, 0x9e, 0x6c, 0x93, 0x47, 0xa6, 0x21, 0x15, 0x99, 0x06, 0x94, 0x83, 0x29, 0x97,
+	0x58, 0xac, 0x46, 0xdc, 0x7a, 0xc1, 0x62, 0x95, 0x0a, 0x15, 0x32, 0xbd, 0x31, 0xa9, 0x87, 0x02,
+	0x68, 0x3b, 0x44, 0xd0, 0x1a, 0x4c, 0x37, 0xd6, 0xb9, 0x20, 0x4a, 0x2e, 0xe1, 0x25, 0x56, 0xb6,
+	0xf5, 0xf1, 0x25, 0x6d, 0x65, 0xcc, 0x9c, 0x6a, 0x60, 0xb7, 0x03, 0xc8, 0xb8, 0x09, 0x73, 0x91,
+	0x81, 0x33, 0x09, 0x3f, 0x64, 0x0e, 0x27, 0xe8, 0x32, 0x0c, 0xd6, 0x70, 0xb9, 0x4a, 0x14, 0x0b,
+	0x23, 0x93, 0xe1, 0x2b, 0xdf, 0xa5, 0xa2, 0x94, 0x27, 0x02, 0xdb, 0x58, 0x60, 0xd3, 0x57, 0x37,
+	0x7e, 0x18, 0x84, 0xd9, 0xf6, 0x7b, 0xc6, 0x8b, 0x21, 0x7e, 0x31, 0xc4, 0x5d, 0x87, 0x78, 0x03,
+	0xe6, 0xad, 0x32, 0xc1, 0x6e, 0xa1, 0x9d, 0x99, 0x37, 0xc9, 0x23, 0xe6, 0x9c, 0x54, 0x30, 0x7b,
+	0x27, 0xc0, 0x44, 0x37, 0x02, 0x34, 0x0f, 0xeb, 0x73, 0x13, 0xe0, 0x0b, 0x0d, 0x26, 0xf3, 0xbc,
+	0xa8, 0xf2, 0x53, 0xa7, 0xda, 0x5b, 0xd1, 0x53, 0x6d, 0x39, 0x76, 0xaa, 0xb5, 0x18, 0x9c, 0xda,
+	0x91, 0xf6, 0x77, 0x02, 0xe6, 0x4e, 0x70, 0xae, 0x58, 0xa7, 0x85, 0xac, 0x3b, 0x81, 0x55, 0xfd,
+	0xa7, 0xca, 0xaa, 0x44, 0xcf, 0xac, 0x1a, 0x78, 0x46, 0x56, 0x0d, 0xfe, 0x1b, 0x56, 0x0d, 0x3d,
+	0x0b, 0xab, 0x86, 0xdb, 0xb0, 0xaa, 0x95, 0x00, 0x23, 0x51, 0x02, 0x6c, 0x43, 0x2a, 0x9c, 0xe4,
+	0x03, 0x52, 0xd7, 0x93, 0x4b, 0x5a, 0x4f, 0xcd, 0x1c, 0x0d, 0xac, 0xae, 0x93, 0x3a, 0xba, 0x02,
+	0xba, 0x43, 0x8e, 0x44, 0x5b, 0x4e, 0x80, 0x8c, 0x38, 0xeb, 0xe1, 0x71, 0x4a, 0x18, 0x26, 0xe8,
+	0xd1, 0xd5, 0x7f, 0xee, 0x01, 0xbf, 0x0e, 0xe9, 0x93, 0x7f, 0x84, 0xc5, 0x86, 0xaa, 0xb5, 0x3f,
+	0xfd, 0xd1, 0xdf, 0x3c, 0x77, 0xe0, 0x7f, 0x6d, 0x9c, 0x3d, 0x77, 0x8e, 0x5f, 0x6b, 0x30, 0x93,
+	0xe7, 0xc5, 0x2d, 0x2c, 0xac, 0x52, 0xf3, 0xf1, 0xc6, 0xd1, 0x4e, 0x94, 0x89, 0xab, 0x31, 0x26,
+	0xc6, 0xad, 0x4e, 0x8d, 0x8e, 0xf7, 0xe0, 0x6c, 0xa7, 0x00, 0xe8, 0x6d, 0x18, 0x51, 0x21, 0xb8,
+	0x3c, 0x23, 0x7b, 0xbd, 0x1a, 0x84, 0x56, 0xc6, 0x7b, 0xb0, 0xd0, 0x36, 0x42, 0xd8, 0xd2, 0x2b,
+	0x30, 0x24, 0x7b, 0x14, 0x04, 0xe8, 0xde, 0x53, 0xa5, 0x6f, 0xfc, 0xa4, 0xc1, 0xb0, 0xe7, 0xbb,
+	0xea, 0x3a, 0xe8, 0x4d, 0x48, 0xed, 0xbb, 0xac, 0x52, 0xc0, 0xb6, 0xed, 0x12, 0xce, 0xfd, 0x05,
+	0xdf, 0xd2, 0x7f, 0xfe, 0xf1, 0xe2, 0xb4, 0xba, 0x14, 0x6d, 0xfa, 0xc8, 0x2d, 0xe1, 0x52, 0xa7,
+	0x68, 0x8e, 0x7a, 0xda, 0x4a, 0x84, 0x4a, 0x30, 0x84, 0x2b, 0xac, 0xea, 0x08, 0xd5, 0xb9, 0xf9,
+	0xac, 0xb2, 0xf1, 0xae, 0x63, 0x59, 0x75, 0x1d, 0xcb, 0x6e, 0x33, 0xea, 0x6c, 0xbd, 0xfe, 0xe0,
+	0xd1, 0x62, 0xdf, 0xf7, 0xbf, 0x2e, 0xae, 0x14, 0xa9, 0x28, 0x55, 0xf7, 0xb2, 0x16, 0xab, 0xa8,
+	0x5b, 0x97, 0xfa, 0xb8, 0xc8, 0xed, 0x83, 0x9c, 0xa8, 0x1f, 0x12, 0x2e, 0x0d, 0xf8, 0x77, 0x4f,
+	0x8f, 0x57, 0x35, 0x53, 0xf9, 0xdf, 0x98, 0xff, 0xf4, 0xfe, 0x62, 0xdf, 0xef, 0xf7, 0x17, 0xfb,
+	0x3e, 0x79, 0x7a, 0xbc, 0xda, 0x92, 0xb1, 0x71, 0x06, 0x26, 0x54, 0x31, 0x41, 0x6b, 0x8c, 0x3f,
+	0xfd, 0x02, 0xf3, 0xd4, 0x11, 0x68, 0x1d, 0x92, 0xde, 0x1e, 0xc5, 0x5c, 0x2a, 0xea, 0xaa, 0xba,
+	0xe9, 0xbf, 0x1e, 0x2d, 0x4e, 0xd6, 0x71, 0xa5, 0xbc, 0x61, 0x84, 0x90, 0x61, 0x36, 0xd4, 0xd0,
+	0x1b, 0x00, 0x82, 0x85, 0x2d, 0xe9, 0xef, 0xd2, 0x92, 0xa4, 0x60, 0xf1, 0x86, 0x24, 0xfe, 0xe3,
+	0x86, 0x8c, 0x7b, 0x8d, 0x68, 0xa4, 0xac, 0xba, 0xe0, 0x55, 0x1c, 0x76, 0xe1, 0xb8, 0xf9, 0x96,
+	0x78, 0x03, 0xbb, 0xb8, 0xc2, 0xd1, 0xe5, 0x78, 0x37, 0x3a, 0x14, 0xd6, 0xe8, 0xc8, 0x06, 0x0c,
+	0x1d, 0x4a, 0x0f, 0xb2, 0x1b, 0xa3, 0xeb, 0x73, 0xad, 0xc3, 0xf6, 0x0e, 0x51, 0x01, 0xb6, 0x92,
+	0x5e, 0x59, 0x2a, 0x55, 0xdf, 0x62, 0xe3, 0x42, 0x6b, 0xaa, 0x9f, 0x3f, 0x3d, 0x5e, 0x9d, 0xcd,
+	0x1d, 0xc9, 0xbb, 0x7c, 0x24, 0x3d, 0x63, 0x1e, 0xe6, 0x22, 0xa2, 0xb0, 0x9a, 0x6f, 0x34, 0xb9,
+	0x5d, 0xdd, 0xa8, 0xba, 0x56, 0x09, 0x73, 0xb2, 0x6b, 0x7b, 0xa7, 0x8e, 0xa8, 0x6f, 0xbb, 0xc4,
+	0xa6, 0x82, 0xa3, 0x2c, 0x0c, 0xee, 0x55, 0xeb, 0xc4, 0xed, 0x5a, 0x94, 0xaf, 0x86, 0xae, 0x36,
+	0x8d, 0xae, 0xd6, 0x79, 0xa5, 0x9a, 0x4b, 0x52, 0xdd, 0x07, 0xaf, 0x24, 0xdf, 0x93, 0x61, 0x83,
+	0x71, 0x72, 0x5e, 0x21, 0x5b, 0xaf, 0xc1, 0xf0, 0x1e, 0x2e, 0x63, 0xc7, 0x0a, 0xb6, 0xc0, 0xde,
+	0x02, 0x06, 0x46, 0xeb, 0xdf, 0x0e, 0x41, 0x22, 0xcf, 0x8b, 0xe8, 0x36, 0xa4, 0x5a, 0x9e, 0x20,
+	0x16, 0x3a, 0x6e, 0x2b, 0xe9, 0xe5, 0x8e, 0x70, 0x98, 0xdd, 0x6d, 0x48, 0xb5, 0x3c, 0x26, 0x2c,
+	0x74, 0x7c, 0x3b, 0x48, 0x2f, 0x77, 0x84, 0x43, 0xaf, 0xf7, 0x60, 0x32, 0xf6, 0x2c, 0xf0, 0xff,
+	0xae, 0xaf, 0x00, 0xe9, 0x0b, 0x5d, 0x55, 0xc2, 0x08, 0xfb, 0x80, 0xda, 0x1c, 0x0d, 0xe7, 0x7a,
+	0x38, 0x09, 0xd2, 0xaf, 0xf4, 0xa0, 0x14, 0xc6, 0xb9, 0x0b, 0x63, 0xad, 0xbf, 0x03, 0x33, 0x9d,
+	0x7f, 0xf6, 0xa5, 0xcf, 0x77, 0xc6, 0x43, 0xc7, 0x57, 0x61, 0x40, 0x6e, 0xc3, 0x33, 0xf1, 0x6c,
+	0xaa, 0xae, 0x93, 0x5e, 0x68, 0x2b, 0x6e, 0xb6, 0x96, 0x7b, 0x5c, 0xdc, 0xda, 0x13, 0xa7, 0x17,
+	0xda, 0x8a, 0xe3, 0x8b, 0xae, 0xf6, 0x86, 0x93, 0x16, 0xdd, 0x87, 0xd3, 0xcb, 0x1d, 0xe1, 0xd0,
+	0x6b, 0x15, 0xe6, 0x4e, 0xe2, 0x68, 0xfc, 0x05, 0xe8, 0x04, 0xcd, 0xf4, 0xab, 0xbd, 0x6a, 0x06,
+	0x61, 0xd3, 0x83, 0x1f, 0x7b, 0x7c, 0xd9, 0xda, 0x7c, 0xf0, 0x38, 0xa3, 0x3d, 0x7c, 0x9c, 0xd1,
+	0x7e, 0x7b, 0x9c, 0xd1, 0xbe, 0x7c, 0x92, 0xe9, 0x7b, 0xf8, 0x24, 0xd3, 0xf7, 0xcb, 0x93, 0x4c,
+	0xdf, 0xfb, 0x2f, 0x37, 0xef, 0xb3, 0xf2, 0x31, 0x51, 0xfe, 0xbd, 0xe8, 0x30, 0x9b, 0xa8, 0xdd,
+	0x48, 0x6e, 0xb6, 0x7b, 0x43, 0xf2, 0xbd, 0xef, 0xd2, 0x3f, 0x03, 0x00, 0x21, 0x72, 0x8d, 0x15,
+	0xc6, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1167,10 +1763,16 @@ type MsgClient interface {
 	UpdateDidDoc(ctx context.Context, in *MsgUpdateDidDoc, opts ...grpc.CallOption) (*MsgUpdateDidDocResponse, error)
 	// DeactivateDidDoc defines a method for deactivating an existing DID document
 	DeactivateDidDoc(ctx context.Context, in *MsgDeactivateDidDoc, opts ...grpc.CallOption) (*MsgDeactivateDidDocResponse, error)
+	// BatchCreateDidDocs defines a method for atomically creating multiple new DID documents
+	BatchCreateDidDocs(ctx context.Context, in *MsgBatchCreateDidDocs, opts ...grpc.CallOption) (*MsgBatchCreateDidDocsResponse, error)
+	// RecoverDidDoc defines a method for replacing the verification methods of a DID document with its pre-rotated recovery key
+	RecoverDidDoc(ctx context.Context, in *MsgRecoverDidDoc, opts ...grpc.CallOption) (*MsgRecoverDidDocResponse, error)
 	Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error)
 	// Mint defines a method to mint tokens to the given address.
 	Mint(ctx context.Context, in *MsgMint, opts ...grpc.CallOption) (*MsgMintResponse, error)
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// PurchaseIdentityCredits defines a method for prepaying identity fees
+	PurchaseIdentityCredits(ctx context.Context, in *MsgPurchaseIdentityCredits, opts ...grpc.CallOption) (*MsgPurchaseIdentityCreditsResponse, error)
 }
 
 type msgClient struct {
@@ -1208,6 +1810,24 @@ func (c *msgClient) DeactivateDidDoc(ctx context.Context, in *MsgDeactivateDidDo
 	return out, nil
 }
 
+func (c *msgClient) BatchCreateDidDocs(ctx context.Context, in *MsgBatchCreateDidDocs, opts ...grpc.CallOption) (*MsgBatchCreateDidDocsResponse, error) {
+	out := new(MsgBatchCreateDidDocsResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.did.v2.Msg/BatchCreateDidDocs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RecoverDidDoc(ctx context.Context, in *MsgRecoverDidDoc, opts ...grpc.CallOption) (*MsgRecoverDidDocResponse, error) {
+	out := new(MsgRecoverDidDocResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.did.v2.Msg/RecoverDidDoc", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error) {
 	out := new(MsgBurnResponse)
 	err := c.cc.Invoke(ctx, "/cheqd.did.v2.Msg/Burn", in, out, opts...)
@@ -1235,6 +1855,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) PurchaseIdentityCredits(ctx context.Context, in *MsgPurchaseIdentityCredits, opts ...grpc.CallOption) (*MsgPurchaseIdentityCreditsResponse, error) {
+	out := new(MsgPurchaseIdentityCreditsResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.did.v2.Msg/PurchaseIdentityCredits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateDidDoc defines a method for creating a new DID document
@@ -1243,10 +1872,16 @@ type MsgServer interface {
 	UpdateDidDoc(context.Context, *MsgUpdateDidDoc) (*MsgUpdateDidDocResponse, error)
 	// DeactivateDidDoc defines a method for deactivating an existing DID document
 	DeactivateDidDoc(context.Context, *MsgDeactivateDidDoc) (*MsgDeactivateDidDocResponse, error)
+	// BatchCreateDidDocs defines a method for atomically creating multiple new DID documents
+	BatchCreateDidDocs(context.Context, *MsgBatchCreateDidDocs) (*MsgBatchCreateDidDocsResponse, error)
+	// RecoverDidDoc defines a method for replacing the verification methods of a DID document with its pre-rotated recovery key
+	RecoverDidDoc(context.Context, *MsgRecoverDidDoc) (*MsgRecoverDidDocResponse, error)
 	Burn(context.Context, *MsgBurn) (*MsgBurnResponse, error)
 	// Mint defines a method to mint tokens to the given address.
 	Mint(context.Context, *MsgMint) (*MsgMintResponse, error)
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// PurchaseIdentityCredits defines a method for prepaying identity fees
+	PurchaseIdentityCredits(context.Context, *MsgPurchaseIdentityCredits) (*MsgPurchaseIdentityCreditsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1262,6 +1897,12 @@ func (*UnimplementedMsgServer) UpdateDidDoc(ctx context.Context, req *MsgUpdateD
 func (*UnimplementedMsgServer) DeactivateDidDoc(ctx context.Context, req *MsgDeactivateDidDoc) (*MsgDeactivateDidDocResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeactivateDidDoc not implemented")
 }
+func (*UnimplementedMsgServer) BatchCreateDidDocs(ctx context.Context, req *MsgBatchCreateDidDocs) (*MsgBatchCreateDidDocsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateDidDocs not implemented")
+}
+func (*UnimplementedMsgServer) RecoverDidDoc(ctx context.Context, req *MsgRecoverDidDoc) (*MsgRecoverDidDocResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverDidDoc not implemented")
+}
 func (*UnimplementedMsgServer) Burn(ctx context.Context, req *MsgBurn) (*MsgBurnResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Burn not implemented")
 }
@@ -1271,6 +1912,9 @@ func (*UnimplementedMsgServer) Mint(ctx context.Context, req *MsgMint) (*MsgMint
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) PurchaseIdentityCredits(ctx context.Context, req *MsgPurchaseIdentityCredits) (*MsgPurchaseIdentityCreditsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchaseIdentityCredits not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1330,6 +1974,42 @@ func _Msg_DeactivateDidDoc_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_BatchCreateDidDocs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchCreateDidDocs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchCreateDidDocs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.did.v2.Msg/BatchCreateDidDocs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchCreateDidDocs(ctx, req.(*MsgBatchCreateDidDocs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RecoverDidDoc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecoverDidDoc)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RecoverDidDoc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.did.v2.Msg/RecoverDidDoc",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RecoverDidDoc(ctx, req.(*MsgRecoverDidDoc))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Burn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgBurn)
 	if err := dec(in); err != nil {
@@ -1384,6 +2064,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PurchaseIdentityCredits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPurchaseIdentityCredits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PurchaseIdentityCredits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.did.v2.Msg/PurchaseIdentityCredits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PurchaseIdentityCredits(ctx, req.(*MsgPurchaseIdentityCredits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cheqd.did.v2.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1400,6 +2098,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeactivateDidDoc",
 			Handler:    _Msg_DeactivateDidDoc_Handler,
 		},
+		{
+			MethodName: "BatchCreateDidDocs",
+			Handler:    _Msg_BatchCreateDidDocs_Handler,
+		},
+		{
+			MethodName: "RecoverDidDoc",
+			Handler:    _Msg_RecoverDidDoc_Handler,
+		},
 		{
 			MethodName: "Burn",
 			Handler:    _Msg_Burn_Handler,
@@ -1412,6 +2118,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "PurchaseIdentityCredits",
+			Handler:    _Msg_PurchaseIdentityCredits_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cheqd/did/v2/tx.proto",
@@ -1584,6 +2294,13 @@ func (m *SignInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VersionId) > 0 {
+		i -= len(m.VersionId)
+		copy(dAtA[i:], m.VersionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VersionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Signature) > 0 {
 		i -= len(m.Signature)
 		copy(dAtA[i:], m.Signature)
@@ -1621,6 +2338,18 @@ func (m *MsgCreateDidDocPayload) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.ControllerThreshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ControllerThreshold))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.RecoveryCommitment) > 0 {
+		i -= len(m.RecoveryCommitment)
+		copy(dAtA[i:], m.RecoveryCommitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecoveryCommitment)))
+		i--
+		dAtA[i] = 0x6a
+	}
 	if len(m.VersionId) > 0 {
 		i -= len(m.VersionId)
 		copy(dAtA[i:], m.VersionId)
@@ -1793,6 +2522,28 @@ func (m *MsgUpdateDidDocPayload) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.ControllerThreshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ControllerThreshold))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.ClearRecoveryCommitment {
+		i--
+		if m.ClearRecoveryCommitment {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.RecoveryCommitment) > 0 {
+		i -= len(m.RecoveryCommitment)
+		copy(dAtA[i:], m.RecoveryCommitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecoveryCommitment)))
+		i--
+		dAtA[i] = 0x6a
+	}
 	if len(m.VersionId) > 0 {
 		i -= len(m.VersionId)
 		copy(dAtA[i:], m.VersionId)
@@ -1945,7 +2696,7 @@ func (m *MsgUpdateDidDocResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeactivateDidDocPayload) Marshal() (dAtA []byte, err error) {
+func (m *MsgRecoverDidDoc) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1955,22 +2706,149 @@ func (m *MsgDeactivateDidDocPayload) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeactivateDidDocPayload) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRecoverDidDoc) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeactivateDidDocPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRecoverDidDoc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Payload != nil {
+		{
+			size, err := m.Payload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecoverDidDocPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecoverDidDocPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecoverDidDocPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.NextRecoveryCommitment) > 0 {
+		i -= len(m.NextRecoveryCommitment)
+		copy(dAtA[i:], m.NextRecoveryCommitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NextRecoveryCommitment)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.RecoveryKey != nil {
+		{
+			size, err := m.RecoveryKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.VersionId) > 0 {
 		i -= len(m.VersionId)
 		copy(dAtA[i:], m.VersionId)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.VersionId)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x42
+	}
+	if len(m.KeyAgreement) > 0 {
+		for iNdEx := len(m.KeyAgreement) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.KeyAgreement[iNdEx])
+			copy(dAtA[i:], m.KeyAgreement[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.KeyAgreement[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.CapabilityDelegation) > 0 {
+		for iNdEx := len(m.CapabilityDelegation) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CapabilityDelegation[iNdEx])
+			copy(dAtA[i:], m.CapabilityDelegation[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.CapabilityDelegation[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.CapabilityInvocation) > 0 {
+		for iNdEx := len(m.CapabilityInvocation) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CapabilityInvocation[iNdEx])
+			copy(dAtA[i:], m.CapabilityInvocation[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.CapabilityInvocation[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AssertionMethod) > 0 {
+		for iNdEx := len(m.AssertionMethod) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AssertionMethod[iNdEx])
+			copy(dAtA[i:], m.AssertionMethod[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AssertionMethod[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Authentication) > 0 {
+		for iNdEx := len(m.Authentication) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Authentication[iNdEx])
+			copy(dAtA[i:], m.Authentication[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Authentication[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.VerificationMethod) > 0 {
+		for iNdEx := len(m.VerificationMethod) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VerificationMethod[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
 	if len(m.Id) > 0 {
 		i -= len(m.Id)
@@ -1982,7 +2860,7 @@ func (m *MsgDeactivateDidDocPayload) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeactivateDidDocResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgRecoverDidDocResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1992,12 +2870,12 @@ func (m *MsgDeactivateDidDocResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeactivateDidDocResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRecoverDidDocResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeactivateDidDocResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRecoverDidDocResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2017,7 +2895,7 @@ func (m *MsgDeactivateDidDocResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBurn) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeactivateDidDocPayload) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2027,41 +2905,34 @@ func (m *MsgBurn) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgBurn) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeactivateDidDocPayload) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeactivateDidDocPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if len(m.VersionId) > 0 {
+		i -= len(m.VersionId)
+		copy(dAtA[i:], m.VersionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VersionId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBurnResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeactivateDidDocResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2071,20 +2942,32 @@ func (m *MsgBurnResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgBurnResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeactivateDidDocResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBurnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeactivateDidDocResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgMint) Marshal() (dAtA []byte, err error) {
+func (m *MsgBatchCreateDidDocs) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2094,20 +2977,20 @@ func (m *MsgMint) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgMint) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBatchCreateDidDocs) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBatchCreateDidDocs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2115,27 +2998,25 @@ func (m *MsgMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintTx(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.ToAddress) > 0 {
-		i -= len(m.ToAddress)
-		copy(dAtA[i:], m.ToAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if m.Payload != nil {
+		{
+			size, err := m.Payload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgMintResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgBatchCreateDidDocsPayload) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2145,20 +3026,34 @@ func (m *MsgMintResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgMintResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBatchCreateDidDocsPayload) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBatchCreateDidDocsPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Payloads) > 0 {
+		for iNdEx := len(m.Payloads) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payloads[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgBatchCreateDidDocsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2168,37 +3063,34 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBatchCreateDidDocsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBatchCreateDidDocsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Values[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgBurn) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2208,20 +3100,274 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBurn) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMintResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMintResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPurchaseIdentityCredits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPurchaseIdentityCredits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPurchaseIdentityCredits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPurchaseIdentityCreditsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPurchaseIdentityCreditsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPurchaseIdentityCreditsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
 	for v >= 1<<7 {
@@ -2303,6 +3449,10 @@ func (m *SignInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.VersionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2380,6 +3530,13 @@ func (m *MsgCreateDidDocPayload) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.RecoveryCommitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ControllerThreshold != 0 {
+		n += 1 + sovTx(uint64(m.ControllerThreshold))
+	}
 	return n
 }
 
@@ -2470,9 +3627,19 @@ func (m *MsgUpdateDidDocPayload) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
-
+	l = len(m.RecoveryCommitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ClearRecoveryCommitment {
+		n += 2
+	}
+	if m.ControllerThreshold != 0 {
+		n += 1 + sovTx(uint64(m.ControllerThreshold))
+	}
+	return n
+}
+
 func (m *MsgUpdateDidDocResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2486,6 +3653,99 @@ func (m *MsgUpdateDidDocResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRecoverDidDoc) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Payload != nil {
+		l = m.Payload.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRecoverDidDocPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.VerificationMethod) > 0 {
+		for _, e := range m.VerificationMethod {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Authentication) > 0 {
+		for _, s := range m.Authentication {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.AssertionMethod) > 0 {
+		for _, s := range m.AssertionMethod {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.CapabilityInvocation) > 0 {
+		for _, s := range m.CapabilityInvocation {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.CapabilityDelegation) > 0 {
+		for _, s := range m.CapabilityDelegation {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.KeyAgreement) > 0 {
+		for _, s := range m.KeyAgreement {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.VersionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RecoveryKey != nil {
+		l = m.RecoveryKey.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NextRecoveryCommitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRecoverDidDocResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func (m *MsgDeactivateDidDocPayload) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2516,6 +3776,55 @@ func (m *MsgDeactivateDidDocResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgBatchCreateDidDocs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Payload != nil {
+		l = m.Payload.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBatchCreateDidDocsPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Payloads) > 0 {
+		for _, e := range m.Payloads {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBatchCreateDidDocsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *MsgBurn) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2600,6 +3909,32 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgPurchaseIdentityCredits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPurchaseIdentityCreditsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Balance.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3061,6 +4396,38 @@ func (m *SignInfo) Unmarshal(dAtA []byte) error {
 				m.Signature = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3499,6 +4866,57 @@ func (m *MsgCreateDidDocPayload) Unmarshal(dAtA []byte) error {
 			}
 			m.VersionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryCommitment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecoveryCommitment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerThreshold", wireType)
+			}
+			m.ControllerThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ControllerThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3986,16 +5404,1157 @@ func (m *MsgUpdateDidDocPayload) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AlsoKnownAs = append(m.AlsoKnownAs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryCommitment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecoveryCommitment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearRecoveryCommitment", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClearRecoveryCommitment = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerThreshold", wireType)
+			}
+			m.ControllerThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ControllerThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDidDocResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDidDocResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDidDocResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &DidDocWithMetadata{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecoverDidDoc) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecoverDidDoc: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecoverDidDoc: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Payload == nil {
+				m.Payload = &MsgRecoverDidDocPayload{}
+			}
+			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, &SignInfo{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecoverDidDocPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecoverDidDocPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecoverDidDocPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMethod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationMethod = append(m.VerificationMethod, &VerificationMethod{})
+			if err := m.VerificationMethod[len(m.VerificationMethod)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authentication", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authentication = append(m.Authentication, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssertionMethod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssertionMethod = append(m.AssertionMethod, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CapabilityInvocation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CapabilityInvocation = append(m.CapabilityInvocation, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CapabilityDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CapabilityDelegation = append(m.CapabilityDelegation, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyAgreement", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyAgreement = append(m.KeyAgreement, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RecoveryKey == nil {
+				m.RecoveryKey = &VerificationMethod{}
+			}
+			if err := m.RecoveryKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRecoveryCommitment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextRecoveryCommitment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecoverDidDocResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecoverDidDocResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecoverDidDocResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &DidDocWithMetadata{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeactivateDidDocPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeactivateDidDocPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeactivateDidDocPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeactivateDidDocResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeactivateDidDocResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeactivateDidDocResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &DidDocWithMetadata{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchCreateDidDocs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchCreateDidDocs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchCreateDidDocs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Payload == nil {
+				m.Payload = &MsgBatchCreateDidDocsPayload{}
+			}
+			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, &SignInfo{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchCreateDidDocsPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AlsoKnownAs = append(m.AlsoKnownAs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 12:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchCreateDidDocsPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchCreateDidDocsPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payloads", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4005,23 +6564,25 @@ func (m *MsgUpdateDidDocPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VersionId = string(dAtA[iNdEx:postIndex])
+			m.Payloads = append(m.Payloads, &MsgCreateDidDocPayload{})
+			if err := m.Payloads[len(m.Payloads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4044,7 +6605,7 @@ func (m *MsgUpdateDidDocPayload) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateDidDocResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchCreateDidDocsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4067,15 +6628,15 @@ func (m *MsgUpdateDidDocResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateDidDocResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBatchCreateDidDocsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateDidDocResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBatchCreateDidDocsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4102,10 +6663,8 @@ func (m *MsgUpdateDidDocResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Value == nil {
-				m.Value = &DidDocWithMetadata{}
-			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Values = append(m.Values, &DidDocWithMetadata{})
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4130,7 +6689,7 @@ func (m *MsgUpdateDidDocResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeactivateDidDocPayload) Unmarshal(dAtA []byte) error {
+func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4153,15 +6712,15 @@ func (m *MsgDeactivateDidDocPayload) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeactivateDidDocPayload: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBurn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeactivateDidDocPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBurn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4189,13 +6748,13 @@ func (m *MsgDeactivateDidDocPayload) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4205,23 +6764,25 @@ func (m *MsgDeactivateDidDocPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VersionId = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4244,7 +6805,7 @@ func (m *MsgDeactivateDidDocPayload) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeactivateDidDocResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4267,48 +6828,12 @@ func (m *MsgDeactivateDidDocResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeactivateDidDocResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeactivateDidDocResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Value == nil {
-				m.Value = &DidDocWithMetadata{}
-			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4330,7 +6855,7 @@ func (m *MsgDeactivateDidDocResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurn) Unmarshal(dAtA []byte) error {
+func (m *MsgMint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4353,15 +6878,15 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4389,9 +6914,41 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -4446,7 +7003,7 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4469,10 +7026,10 @@ func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMintResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4496,7 +7053,7 @@ func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMint) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4519,10 +7076,10 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMint: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4559,39 +7116,7 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4618,8 +7143,7 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4644,7 +7168,7 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4667,10 +7191,10 @@ func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4694,7 +7218,7 @@ func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgPurchaseIdentityCredits) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4717,15 +7241,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPurchaseIdentityCredits: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPurchaseIdentityCredits: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4753,11 +7277,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Buyer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4784,7 +7308,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4809,7 +7333,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPurchaseIdentityCreditsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4832,12 +7356,45 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPurchaseIdentityCreditsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPurchaseIdentityCreditsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,40 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ sdk.Msg = &MsgBatchCreateDidDocs{}
+
+func NewMsgBatchCreateDidDocs(payload *MsgBatchCreateDidDocsPayload, signatures []*SignInfo) *MsgBatchCreateDidDocs {
+	return &MsgBatchCreateDidDocs{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+}
+
+func (msg *MsgBatchCreateDidDocs) ValidateBasic() error {
+	err := msg.Validate(nil)
+	if err != nil {
+		return ErrBasicValidation.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// Validate
+
+func (msg MsgBatchCreateDidDocs) Validate(allowedNamespaces []string) error {
+	return validation.ValidateStruct(&msg,
+		validation.Field(&msg.Payload, validation.Required, ValidMsgBatchCreateDidDocsPayloadRule(allowedNamespaces)),
+		validation.Field(&msg.Signatures, IsUniqueSignInfoListByIDRule(), validation.Each(ValidSignInfoRule(allowedNamespaces))),
+	)
+}
+
+// Normalize
+
+func (msg *MsgBatchCreateDidDocs) Normalize() {
+	msg.Payload.Normalize()
+	NormalizeSignInfoList(msg.Signatures)
+}
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ IdentityMsg = &MsgBatchCreateDidDocsPayload{}
+
+func (msg *MsgBatchCreateDidDocsPayload) GetSignBytes() []byte {
+	bytes, err := msg.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return bytes
+}
+
+// Validation
+
+func (msg MsgBatchCreateDidDocsPayload) Validate(allowedNamespaces []string) error {
+	return validation.ValidateStruct(&msg,
+		validation.Field(&msg.Payloads, validation.Required, IsUniqueMsgCreateDidPayloadListByIDRule(), validation.Each(ValidBatchedMsgCreateDidPayloadRule(allowedNamespaces))),
+	)
+}
+
+// ValidateBatchSize checks the number of DID Documents against the max_batch_size module parameter
+func (msg MsgBatchCreateDidDocsPayload) ValidateBatchSize(maxBatchSize uint32) error {
+	if maxBatchSize == 0 {
+		return errors.New("batch creation is disabled")
+	}
+
+	if len(msg.Payloads) > int(maxBatchSize) {
+		return fmt.Errorf("batch contains %d DID Documents, at most %d are allowed", len(msg.Payloads), maxBatchSize)
+	}
+
+	return nil
+}
+
+func ValidMsgBatchCreateDidDocsPayloadRule(allowedNamespaces []string) *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(*MsgBatchCreateDidDocsPayload)
+		if !ok {
+			panic("ValidMsgBatchCreateDidDocsPayloadRule must be only applied on MsgBatchCreateDidDocsPayload properties")
+		}
+
+		return casted.Validate(allowedNamespaces)
+	})
+}
+
+// ValidBatchedMsgCreateDidPayloadRule validates a single DID Document payload of a batch
+func ValidBatchedMsgCreateDidPayloadRule(allowedNamespaces []string) *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(MsgCreateDidDocPayload)
+		if !ok {
+			panic("ValidBatchedMsgCreateDidPayloadRule must be only applied on MsgCreateDidDocPayload list items")
+		}
+
+		return casted.Validate(allowedNamespaces)
+	})
+}
+
+func IsUniqueMsgCreateDidPayloadListByIDRule() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.([]*MsgCreateDidDocPayload)
+		if !ok {
+			panic("IsUniqueMsgCreateDidPayloadListByIDRule must be only applied on MsgCreateDidDocPayload lists")
+		}
+
+		ids := make(map[string]bool, len(casted))
+		for _, payload := range casted {
+			if ids[payload.Id] {
+				return fmt.Errorf("duplicated DID Document id: %s", payload.Id)
+			}
+
+			ids[payload.Id] = true
+		}
+
+		return nil
+	})
+}
+
+// Normalize
+
+func (msg *MsgBatchCreateDidDocsPayload) Normalize() {
+	for _, payload := range msg.Payloads {
+		payload.Normalize()
+	}
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"strings"
+
 	"github.com/cheqd/cheqd-node/x/did/utils"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
@@ -27,6 +29,7 @@ func (msg *MsgCreateDidDocPayload) ToDidDoc() DidDoc {
 		CapabilityInvocation: msg.CapabilityInvocation,
 		CapabilityDelegation: msg.CapabilityDelegation,
 		KeyAgreement:         msg.KeyAgreement,
+		ControllerThreshold:  msg.ControllerThreshold,
 		AlsoKnownAs:          msg.AlsoKnownAs,
 		Service:              msg.Service,
 	}
@@ -42,6 +45,7 @@ func (msg MsgCreateDidDocPayload) Validate(allowedNamespaces []string) error {
 
 	return validation.ValidateStruct(&msg,
 		validation.Field(&msg.VersionId, validation.Required),
+		validation.Field(&msg.RecoveryCommitment, validation.When(msg.RecoveryCommitment != "", IsRecoveryCommitment())),
 	)
 }
 
@@ -75,4 +79,5 @@ func (msg *MsgCreateDidDocPayload) Normalize() {
 	msg.KeyAgreement = utils.NormalizeDIDUrlList(msg.KeyAgreement, false)
 
 	msg.VersionId = utils.NormalizeUUID(msg.VersionId)
+	msg.RecoveryCommitment = strings.ToLower(msg.RecoveryCommitment)
 }
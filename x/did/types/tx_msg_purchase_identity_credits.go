@@ -0,0 +1,34 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgPurchaseIdentityCredits{}
+
+// NewMsgPurchaseIdentityCredits creates a message to prepay identity fees
+func NewMsgPurchaseIdentityCredits(buyer string, amount sdk.Coin) *MsgPurchaseIdentityCredits {
+	return &MsgPurchaseIdentityCredits{
+		Buyer:  buyer,
+		Amount: amount,
+	}
+}
+
+func (m MsgPurchaseIdentityCredits) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Buyer)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid buyer address (%s)", err)
+	}
+
+	if !m.Amount.IsValid() || !m.Amount.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
+	}
+
+	if m.Amount.Denom != BaseMinimalDenom {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "identity credits must be purchased in %s: got %s", BaseMinimalDenom, m.Amount)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ sdk.Msg = &MsgRecoverDidDoc{}
+
+func NewMsgRecoverDidDoc(payload *MsgRecoverDidDocPayload, signatures []*SignInfo) *MsgRecoverDidDoc {
+	return &MsgRecoverDidDoc{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+}
+
+func (msg *MsgRecoverDidDoc) ValidateBasic() error {
+	err := msg.Validate(nil)
+	if err != nil {
+		return ErrBasicValidation.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// Validate
+
+func (msg MsgRecoverDidDoc) Validate(allowedNamespaces []string) error {
+	return validation.ValidateStruct(&msg,
+		validation.Field(&msg.Payload, validation.Required, ValidMsgRecoverDidDocPayloadRule(allowedNamespaces)),
+		validation.Field(&msg.Signatures, IsUniqueSignInfoListRule(), validation.Each(ValidSignInfoRule(allowedNamespaces))),
+	)
+}
+
+// Normalize
+
+func (msg *MsgRecoverDidDoc) Normalize() {
+	msg.Payload.Normalize()
+	NormalizeSignInfoList(msg.Signatures)
+}
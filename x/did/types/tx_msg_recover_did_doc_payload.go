@@ -0,0 +1,105 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/cheqd/cheqd-node/x/did/utils"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ IdentityMsg = &MsgRecoverDidDocPayload{}
+
+func (msg *MsgRecoverDidDocPayload) GetSignBytes() []byte {
+	bytes, err := msg.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return bytes
+}
+
+// ToDidDoc builds the recovered DID Document. Only verification methods and relationships are replaced,
+// controllers, controller threshold, context, services and alsoKnownAs are kept from the existing version.
+func (msg *MsgRecoverDidDocPayload) ToDidDoc(existing DidDoc) DidDoc {
+	return DidDoc{
+		Context:              existing.Context,
+		Id:                   msg.Id,
+		Controller:           existing.Controller,
+		VerificationMethod:   msg.VerificationMethod,
+		Authentication:       msg.Authentication,
+		AssertionMethod:      msg.AssertionMethod,
+		CapabilityInvocation: msg.CapabilityInvocation,
+		CapabilityDelegation: msg.CapabilityDelegation,
+		KeyAgreement:         msg.KeyAgreement,
+		ControllerThreshold:  existing.ControllerThreshold,
+		AlsoKnownAs:          existing.AlsoKnownAs,
+		Service:              existing.Service,
+	}
+}
+
+// Validation
+
+func (msg MsgRecoverDidDocPayload) Validate(allowedNamespaces []string) error {
+	// Controllers and services of the existing version are validated when it's created or updated
+	err := msg.ToDidDoc(DidDoc{}).Validate(allowedNamespaces)
+	if err != nil {
+		return err
+	}
+
+	return validation.ValidateStruct(&msg,
+		validation.Field(&msg.VersionId, validation.Required),
+		validation.Field(&msg.RecoveryKey, validation.Required, ValidRecoveryKeyRule(msg.Id, allowedNamespaces)),
+		validation.Field(&msg.NextRecoveryCommitment, validation.When(msg.NextRecoveryCommitment != "", IsRecoveryCommitment())),
+	)
+}
+
+func ValidMsgRecoverDidDocPayloadRule(allowedNamespaces []string) *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(*MsgRecoverDidDocPayload)
+		if !ok {
+			panic("ValidMsgRecoverDidDocPayloadRule must be only applied on MsgRecoverDidDocPayload properties")
+		}
+
+		return casted.Validate(allowedNamespaces)
+	})
+}
+
+func ValidRecoveryKeyRule(did string, allowedNamespaces []string) *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(*VerificationMethod)
+		if !ok {
+			panic("ValidRecoveryKeyRule must be only applied on verification method properties")
+		}
+
+		err := casted.Validate(did, allowedNamespaces)
+		if err != nil {
+			return err
+		}
+
+		return validation.ValidateStruct(casted,
+			validation.Field(&casted.Controller, validation.In(did).Error("must be the DID being recovered")),
+		)
+	})
+}
+
+// Normalize
+
+func (msg *MsgRecoverDidDocPayload) Normalize() {
+	msg.Id = utils.NormalizeDID(msg.Id)
+	for _, vm := range msg.VerificationMethod {
+		vm.Controller = utils.NormalizeDID(vm.Controller)
+		vm.Id = utils.NormalizeDIDUrl(vm.Id)
+	}
+	if msg.RecoveryKey != nil {
+		msg.RecoveryKey.Controller = utils.NormalizeDID(msg.RecoveryKey.Controller)
+		msg.RecoveryKey.Id = utils.NormalizeDIDUrl(msg.RecoveryKey.Id)
+	}
+	msg.Authentication = utils.NormalizeDIDUrlList(msg.Authentication, false)
+	msg.AssertionMethod = utils.NormalizeDIDUrlList(msg.AssertionMethod, true)
+	msg.CapabilityInvocation = utils.NormalizeDIDUrlList(msg.CapabilityInvocation, false)
+	msg.CapabilityDelegation = utils.NormalizeDIDUrlList(msg.CapabilityDelegation, false)
+	msg.KeyAgreement = utils.NormalizeDIDUrlList(msg.KeyAgreement, false)
+
+	msg.VersionId = utils.NormalizeUUID(msg.VersionId)
+	msg.NextRecoveryCommitment = strings.ToLower(msg.NextRecoveryCommitment)
+}
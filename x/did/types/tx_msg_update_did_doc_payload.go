@@ -1,6 +1,8 @@
 package types
 
 import (
+	"strings"
+
 	"github.com/cheqd/cheqd-node/x/did/utils"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
@@ -27,6 +29,7 @@ func (msg *MsgUpdateDidDocPayload) ToDidDoc() DidDoc {
 		CapabilityInvocation: msg.CapabilityInvocation,
 		CapabilityDelegation: msg.CapabilityDelegation,
 		KeyAgreement:         msg.KeyAgreement,
+		ControllerThreshold:  msg.ControllerThreshold,
 		AlsoKnownAs:          msg.AlsoKnownAs,
 		Service:              msg.Service,
 	}
@@ -42,6 +45,8 @@ func (msg MsgUpdateDidDocPayload) Validate(allowedNamespaces []string) error {
 
 	return validation.ValidateStruct(&msg,
 		validation.Field(&msg.VersionId, validation.Required),
+		validation.Field(&msg.RecoveryCommitment, validation.When(msg.RecoveryCommitment != "", IsRecoveryCommitment())),
+		validation.Field(&msg.ClearRecoveryCommitment, validation.When(msg.RecoveryCommitment != "", validation.Empty.Error("can't be combined with a new recovery commitment"))),
 	)
 }
 
@@ -75,4 +80,5 @@ func (msg *MsgUpdateDidDocPayload) Normalize() {
 	msg.KeyAgreement = utils.NormalizeDIDUrlList(msg.KeyAgreement, false)
 
 	msg.VersionId = utils.NormalizeUUID(msg.VersionId)
+	msg.RecoveryCommitment = strings.ToLower(msg.RecoveryCommitment)
 }
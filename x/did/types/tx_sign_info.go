@@ -26,7 +26,7 @@ func GetSignInfoIds(infos []*SignInfo) []string {
 
 func IsUniqueSignInfoList(infos []*SignInfo) bool {
 	hash := func(si *SignInfo) string {
-		return si.VerificationMethodId + ":" + base58.Encode(si.Signature)
+		return si.VerificationMethodId + ":" + si.VersionId + ":" + base58.Encode(si.Signature)
 	}
 
 	tmp := map[string]bool{}
@@ -58,6 +58,22 @@ func FindSignInfosBySigner(infos []*SignInfo, signer string) []SignInfo {
 	return result
 }
 
+// FilterSignInfosByVersion returns the sign infos that can be verified against the provided version of the DID Document.
+// Sign infos of other DIDs and sign infos without a version ID are always kept.
+func FilterSignInfosByVersion(infos []*SignInfo, did string, versionID string) []*SignInfo {
+	result := make([]*SignInfo, 0, len(infos))
+
+	for _, info := range infos {
+		infoDid, _, _, _ := utils.MustSplitDIDUrl(info.VerificationMethodId)
+
+		if infoDid != did || info.VersionId == "" || info.VersionId == versionID {
+			result = append(result, info)
+		}
+	}
+
+	return result
+}
+
 // FindSignInfoBySigner returns the first sign info that corresponds to the provided signer's did
 func FindSignInfoBySigner(infos []*SignInfo, signer string) (info SignInfo, found bool) {
 	infosBS := FindSignInfosBySigner(infos, signer)
@@ -124,6 +140,7 @@ func IsUniqueSignInfoListRule() *CustomErrorRule {
 
 func (si *SignInfo) Normalize() {
 	si.VerificationMethodId = utils.NormalizeDIDUrl(si.VerificationMethodId)
+	si.VersionId = utils.NormalizeUUID(si.VersionId)
 }
 
 func NormalizeSignInfoList(signatures []*SignInfo) {
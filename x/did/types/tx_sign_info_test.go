@@ -131,3 +131,36 @@ var _ = Describe("Full SignInfo duplicates tests", func() {
 			}),
 	)
 })
+
+var _ = Describe("SignInfo version filter tests", func() {
+	did := "did:cheqd:zABCDEFG123456789abcd"
+	signInfos := []*SignInfo{
+		{
+			VerificationMethodId: did + "#method1",
+			Signature:            []byte("aaa="),
+		},
+		{
+			VerificationMethodId: did + "#method1",
+			Signature:            []byte("bbb="),
+			VersionId:            "version-1",
+		},
+		{
+			VerificationMethodId: did + "#method1",
+			Signature:            []byte("ccc="),
+			VersionId:            "version-2",
+		},
+		{
+			VerificationMethodId: "did:cheqd:zABCDEFG987654321abcd#method1",
+			Signature:            []byte("ddd="),
+			VersionId:            "version-3",
+		},
+	}
+
+	It("Keeps sign infos without version, of the requested version and of other DIDs", func() {
+		filtered := FilterSignInfosByVersion(signInfos, did, "version-1")
+		Expect(filtered).To(Equal([]*SignInfo{signInfos[0], signInfos[1], signInfos[3]}))
+
+		filtered = FilterSignInfosByVersion(signInfos, did, "version-2")
+		Expect(filtered).To(Equal([]*SignInfo{signInfos[0], signInfos[2], signInfos[3]}))
+	})
+})
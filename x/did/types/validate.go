@@ -224,6 +224,39 @@ func IsMultibaseEncodedEd25519PubKey() *CustomErrorRule {
 	})
 }
 
+func IsMultibaseSecp256k1VerificationKey2019() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(string)
+		if !ok {
+			panic("IsMultibaseSecp256k1VerificationKey2019 must be only applied on string properties")
+		}
+
+		return utils.ValidateMultibaseSecp256k1VerificationKey2019(casted)
+	})
+}
+
+func IsMultikey() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(string)
+		if !ok {
+			panic("IsMultikey must be only applied on string properties")
+		}
+
+		return utils.ValidateMultibaseMultikey(casted)
+	})
+}
+
+func IsBlockchainAccountID() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(string)
+		if !ok {
+			panic("IsBlockchainAccountID must be only applied on string properties")
+		}
+
+		return utils.ValidateBlockchainAccountID(casted)
+	})
+}
+
 func IsJWK() *CustomErrorRule {
 	return NewCustomErrorRule(func(value interface{}) error {
 		casted, ok := value.(string)
@@ -1,19 +1,42 @@
 package utils
 
 import (
+	"bytes"
 	"crypto"
 	"crypto/ecdsa"
 	"crypto/ed25519"
+	"crypto/elliptic"
 	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"reflect"
+	"regexp"
+	"strings"
 
 	"filippo.io/edwards25519"
-
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	secp256k1ecdsa "github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
 	"github.com/lestrrat-go/jwx/jwk"
+	"golang.org/x/crypto/sha3"
+)
+
+// Multicodec prefixes (unsigned varint encoded) of the public keys supported by Multikey
+var (
+	MulticodecEd25519PubPrefix   = []byte{0xed, 0x01}
+	MulticodecSecp256k1PubPrefix = []byte{0xe7, 0x01}
+	MulticodecP256PubPrefix      = []byte{0x80, 0x24}
+	MulticodecP384PubPrefix      = []byte{0x81, 0x24}
+	MulticodecP521PubPrefix      = []byte{0x82, 0x24}
+	MulticodecRSAPubPrefix       = []byte{0x85, 0x24}
 )
 
+// BlockchainAccountIDRegexp matches CAIP-10 account ids of Ethereum accounts, e.g. eip155:1:0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb
+var BlockchainAccountIDRegexp = regexp.MustCompile(`^eip155:[0-9]{1,32}:0x[0-9a-fA-F]{40}$`)
+
 func ValidateJWK(jwkString string) error {
 	var raw interface{}
 	err := jwk.ParseRawKey([]byte(jwkString), &raw)
@@ -88,3 +111,213 @@ func VerifyECDSASignature(pubKey ecdsa.PublicKey, message []byte, signature []by
 func GetEd25519VerificationKey2020(keyBytes []byte) []byte {
 	return keyBytes[2:]
 }
+
+func ValidateSecp256k1PubKey(keyBytes []byte) error {
+	_, err := secp256k1.ParsePubKey(keyBytes)
+	if err != nil {
+		return fmt.Errorf("secp256k1: %s", err.Error())
+	}
+
+	return nil
+}
+
+// VerifySecp256k1Signature uses SHA256 to calculate message digest.
+// Both compact (r || s, 64 bytes) and ASN1 DER encoded signatures are accepted.
+func VerifySecp256k1Signature(pubKeyBytes []byte, message []byte, signature []byte) error {
+	pubKey, err := secp256k1.ParsePubKey(pubKeyBytes)
+	if err != nil {
+		return err
+	}
+
+	var sig *secp256k1ecdsa.Signature
+	if len(signature) == 64 {
+		var r, s secp256k1.ModNScalar
+		if r.SetByteSlice(signature[:32]) || s.SetByteSlice(signature[32:]) {
+			return errors.New("invalid secp256k1 signature: r or s overflows the group order")
+		}
+		sig = secp256k1ecdsa.NewSignature(&r, &s)
+	} else {
+		sig, err = secp256k1ecdsa.ParseDERSignature(signature)
+		if err != nil {
+			return err
+		}
+	}
+
+	// reject non-canonical high-S signatures, which are malleable, like the SDK does
+	if s := sig.S(); s.IsOverHalfOrder() {
+		return errors.New("invalid secp256k1 signature: s is not in the lower half of the group order")
+	}
+
+	digest := sha256.Sum256(message)
+	if !sig.Verify(digest[:], pubKey) {
+		return errors.New("invalid secp256k1 signature")
+	}
+
+	return nil
+}
+
+func ValidateBlockchainAccountID(accountID string) error {
+	if !BlockchainAccountIDRegexp.MatchString(accountID) {
+		return fmt.Errorf("unsupported blockchain account id: %s. supported format is eip155:<chain id>:<0x-prefixed ethereum address>", accountID)
+	}
+
+	return nil
+}
+
+// VerifySecp256k1RecoverySignature recovers the public key from a recoverable signature (r || s || v, 65 bytes)
+// over the SHA256 digest of the message and checks that it corresponds to the ethereum address of the blockchain account id.
+func VerifySecp256k1RecoverySignature(accountID string, message []byte, signature []byte) error {
+	if err := ValidateBlockchainAccountID(accountID); err != nil {
+		return err
+	}
+
+	if len(signature) != 65 {
+		return fmt.Errorf("invalid recoverable secp256k1 signature length: %d", len(signature))
+	}
+
+	// Convert ethereum style r || s || v to compact v || r || s
+	recoveryID := signature[64]
+	if recoveryID >= 27 {
+		recoveryID -= 27
+	}
+	if recoveryID > 1 {
+		return fmt.Errorf("invalid recoverable secp256k1 signature recovery id: %d", signature[64])
+	}
+
+	compact := make([]byte, 0, 65)
+	compact = append(compact, 27+recoveryID)
+	compact = append(compact, signature[:64]...)
+
+	digest := sha256.Sum256(message)
+	pubKey, _, err := secp256k1ecdsa.RecoverCompact(compact, digest[:])
+	if err != nil {
+		return err
+	}
+
+	parts := strings.Split(accountID, ":")
+	if !strings.EqualFold(parts[2], EthereumAddress(pubKey.SerializeUncompressed())) {
+		return errors.New("invalid secp256k1 recovery signature: recovered address doesn't match blockchain account id")
+	}
+
+	return nil
+}
+
+// EthereumAddress returns 0x-prefixed ethereum address of an uncompressed secp256k1 public key
+func EthereumAddress(uncompressedPubKey []byte) string {
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(uncompressedPubKey[1:])
+	digest := hasher.Sum(nil)
+
+	return "0x" + hex.EncodeToString(digest[12:])
+}
+
+func ValidateMultikey(keyBytes []byte) error {
+	prefix, pubKey, err := SplitMulticodecPubKey(keyBytes)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case bytes.Equal(prefix, MulticodecEd25519PubPrefix):
+		return ValidateEd25519PubKey(pubKey)
+	case bytes.Equal(prefix, MulticodecSecp256k1PubPrefix):
+		return ValidateSecp256k1PubKey(pubKey)
+	case bytes.Equal(prefix, MulticodecP256PubPrefix):
+		_, err := UnmarshalCompressedECDSAPubKey(elliptic.P256(), pubKey)
+		return err
+	default:
+		_, err := UnmarshalCompressedECDSAPubKey(elliptic.P384(), pubKey)
+		return err
+	}
+}
+
+// VerifyMultikeySignature dispatches signature verification based on the multicodec prefix of the key.
+// SHA256 is used as message digest for secp256k1 and P-256 keys, SHA384 for P-384 keys.
+func VerifyMultikeySignature(keyBytes []byte, message []byte, signature []byte) error {
+	prefix, pubKey, err := SplitMulticodecPubKey(keyBytes)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case bytes.Equal(prefix, MulticodecEd25519PubPrefix):
+		return VerifyED25519Signature(pubKey, message, signature)
+	case bytes.Equal(prefix, MulticodecSecp256k1PubPrefix):
+		return VerifySecp256k1Signature(pubKey, message, signature)
+	case bytes.Equal(prefix, MulticodecP256PubPrefix):
+		ecdsaPubKey, err := UnmarshalCompressedECDSAPubKey(elliptic.P256(), pubKey)
+		if err != nil {
+			return err
+		}
+
+		return VerifyECDSASignature(*ecdsaPubKey, message, signature)
+	default:
+		ecdsaPubKey, err := UnmarshalCompressedECDSAPubKey(elliptic.P384(), pubKey)
+		if err != nil {
+			return err
+		}
+
+		digest := sha512.Sum384(message)
+		if !ecdsa.VerifyASN1(ecdsaPubKey, digest[:], signature) {
+			return errors.New("invalid ecdsa signature")
+		}
+
+		return nil
+	}
+}
+
+// SplitMulticodecPubKey splits a multicodec encoded public key into the two-byte prefix and the raw key
+func SplitMulticodecPubKey(keyBytes []byte) ([]byte, []byte, error) {
+	if len(keyBytes) < 2 {
+		return nil, nil, fmt.Errorf("multicodec public key is too short: %d", len(keyBytes))
+	}
+
+	prefix := keyBytes[:2]
+	for _, supported := range [][]byte{MulticodecEd25519PubPrefix, MulticodecSecp256k1PubPrefix, MulticodecP256PubPrefix, MulticodecP384PubPrefix} {
+		if bytes.Equal(prefix, supported) {
+			return prefix, keyBytes[2:], nil
+		}
+	}
+
+	return nil, nil, fmt.Errorf("unsupported multicodec prefix for Multikey: %s. supported prefixes are: 0xed01 (ed25519), 0xe701 (secp256k1), 0x8024 (P-256), 0x8124 (P-384)",
+		fmt.Sprintf("0x%02x%02x", keyBytes[0], keyBytes[1]))
+}
+
+// NormalizeMulticodecPubKey returns the multicodec encoded form of a public key, which is the same for all of its representations.
+// Ed25519, secp256k1 and NIST curve keys are prefixed in compressed form, RSA keys are prefixed in PKCS #1 DER form.
+func NormalizeMulticodecPubKey(pubKey interface{}) ([]byte, error) {
+	switch key := pubKey.(type) {
+	case ed25519.PublicKey:
+		return append(append([]byte{}, MulticodecEd25519PubPrefix...), key...), nil
+	case *secp256k1.PublicKey:
+		return append(append([]byte{}, MulticodecSecp256k1PubPrefix...), key.SerializeCompressed()...), nil
+	case *ecdsa.PublicKey:
+		var prefix []byte
+		switch key.Curve {
+		case elliptic.P256():
+			prefix = MulticodecP256PubPrefix
+		case elliptic.P384():
+			prefix = MulticodecP384PubPrefix
+		case elliptic.P521():
+			prefix = MulticodecP521PubPrefix
+		default:
+			return nil, fmt.Errorf("unsupported ecdsa curve: %s", key.Curve.Params().Name)
+		}
+
+		return append(append([]byte{}, prefix...), elliptic.MarshalCompressed(key.Curve, key.X, key.Y)...), nil
+	case *rsa.PublicKey:
+		return append(append([]byte{}, MulticodecRSAPubPrefix...), x509.MarshalPKCS1PublicKey(key)...), nil
+	default:
+		return nil, fmt.Errorf("unsupported public key type: %T", pubKey)
+	}
+}
+
+// UnmarshalCompressedECDSAPubKey parses a compressed NIST curve point
+func UnmarshalCompressedECDSAPubKey(curve elliptic.Curve, keyBytes []byte) (*ecdsa.PublicKey, error) {
+	x, y := elliptic.UnmarshalCompressed(curve, keyBytes)
+	if x == nil {
+		return nil, fmt.Errorf("%s: invalid compressed public key", curve.Params().Name)
+	}
+
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+}
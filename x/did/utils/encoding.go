@@ -90,6 +90,34 @@ func ValidateBase58Ed25519VerificationKey2018(data string) error {
 	return ValidateEd25519PubKey(pubKey)
 }
 
+func ValidateMultibaseSecp256k1VerificationKey2019(data string) error {
+	encoding, keyBytes, err := multibase.Decode(data)
+	if err != nil {
+		return err
+	}
+
+	if encoding != multibase.Base58BTC {
+		return fmt.Errorf("invalid encoding for EcdsaSecp256k1VerificationKey2019. expected: %s actual: %s",
+			multibase.EncodingToStr[multibase.Base58BTC], multibase.EncodingToStr[encoding])
+	}
+
+	return ValidateSecp256k1PubKey(keyBytes)
+}
+
+func ValidateMultibaseMultikey(data string) error {
+	encoding, keyBytes, err := multibase.Decode(data)
+	if err != nil {
+		return err
+	}
+
+	if encoding != multibase.Base58BTC {
+		return fmt.Errorf("invalid encoding for Multikey. expected: %s actual: %s",
+			multibase.EncodingToStr[multibase.Base58BTC], multibase.EncodingToStr[encoding])
+	}
+
+	return ValidateMultikey(keyBytes)
+}
+
 func IsJSONEscapedString(value interface{}) bool {
 	casted, ok := value.(string)
 	if !ok {
@@ -2,13 +2,22 @@ package utils
 
 import (
 	"fmt"
+	"net/url"
 	"regexp"
+	"strings"
 )
 
 // ValidURIRegexp ...
 // Goes from RFC: https://www.rfc-editor.org/rfc/rfc3986#appendix-B
 var ValidURIRegexp = regexp.MustCompile(`^(([^:\/?#]+):)?(\/\/([^\/?#]*))?([^?#]*)(\?([^#]*))?(#(.*))?`)
 
+// ValidURISchemeRegexp ...
+// Goes from RFC: https://www.rfc-editor.org/rfc/rfc3986#section-3.1
+var ValidURISchemeRegexp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+.\-]*$`)
+
+// Schemes of URIs that must have an authority (host)
+var schemesWithHost = []string{"http", "https", "ws", "wss"}
+
 func ValidateURI(uri string) error {
 	// Match with Regexp from RFC
 	if !ValidURIRegexp.MatchString(uri) {
@@ -17,3 +26,31 @@ func ValidateURI(uri string) error {
 
 	return nil
 }
+
+// ValidateAbsoluteURI checks that the URI is absolute, i.e. it has a scheme. URIs with web schemes must also have a host.
+func ValidateAbsoluteURI(uri string) error {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return fmt.Errorf("URI: %s is not valid: %w", uri, err)
+	}
+
+	if !ValidURISchemeRegexp.MatchString(parsed.Scheme) {
+		return fmt.Errorf("URI: %s must be absolute", uri)
+	}
+
+	if Contains(schemesWithHost, strings.ToLower(parsed.Scheme)) && parsed.Host == "" {
+		return fmt.Errorf("URI: %s must have a host", uri)
+	}
+
+	return nil
+}
+
+// GetURIScheme returns the lowercase scheme of the URI or an empty string if the URI is relative or invalid
+func GetURIScheme(uri string) string {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return ""
+	}
+
+	return strings.ToLower(parsed.Scheme)
+}
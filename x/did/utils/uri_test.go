@@ -22,4 +22,37 @@ var _ = Describe("URI validation", func() {
 		Entry("Valid: General https URI path", true, "https://a.com/a/b/c/d/?query=123#fragment=another_part"),
 		Entry("Valid: only alphabet symbols", true, "SomeAnotherPath"),
 	)
+
+	DescribeTable("ValidateAbsoluteURI",
+
+		func(isValid bool, uri string) {
+			err := ValidateAbsoluteURI(uri)
+			if isValid {
+				Expect(err).To(BeNil())
+			} else {
+				Expect(err).ToNot(BeNil())
+			}
+		},
+
+		Entry("Valid: https URI", true, "https://a.com/a/b?query=123#fragment"),
+		Entry("Valid: wss URI", true, "wss://a.com/ws"),
+		Entry("Valid: DID URL", true, "did:cheqd:testnet:zABCDEFG123456789abcd/resources/id"),
+		Entry("Valid: mailto URI", true, "mailto:alice@example.com"),
+		Entry("Invalid: relative path", false, "SomeAnotherPath"),
+		Entry("Invalid: https without host", false, "https:///path"),
+		Entry("Invalid: https with opaque part", false, "https:example.com"),
+		Entry("Invalid: malformed escape", false, "https://a.com/%zz"),
+		Entry("Invalid: empty", false, ""),
+	)
+
+	DescribeTable("GetURIScheme",
+
+		func(uri string, scheme string) {
+			Expect(GetURIScheme(uri)).To(Equal(scheme))
+		},
+
+		Entry("Lowercases the scheme", "HTTPS://a.com", "https"),
+		Entry("Returns the scheme of opaque URIs", "did:cheqd:testnet:zABCDEFG123456789abcd", "did"),
+		Entry("Returns empty scheme for relative URIs", "path", ""),
+	)
 })
@@ -56,6 +56,11 @@ func EndBlocker(ctx context.Context, k keeper.Keeper, feeabsk types.FeeAbskeeper
 		if err := UpdateOraclePrices(sdkCtx, params, k); err != nil {
 			return err
 		}
+
+		// Record the block time of the price stamps once for all denoms
+		if k.IsPeriodLastBlock(sdkCtx, params.HistoricStampPeriod) || k.IsPeriodLastBlock(sdkCtx, params.MedianStampPeriod) {
+			k.SetStampTime(sdkCtx)
+		}
 	}
 	if k.IsPeriodLastBlock(sdkCtx, params.HistoricStampPeriod*params.AveragingWindow) {
 		if err := ComputeAllAverages(sdkCtx, params, k); err != nil {
@@ -63,6 +68,11 @@ func EndBlocker(ctx context.Context, k keeper.Keeper, feeabsk types.FeeAbskeeper
 		}
 	}
 
+	// Freeze USD-denominated fee conversion once the moving averages went stale
+	if err := k.CheckPriceStaleness(sdkCtx, params); err != nil {
+		return err
+	}
+
 	// Slash oracle providers who missed voting over the threshold and reset
 	// miss counters of all validators at the last block of slash window.
 	if k.IsPeriodLastBlock(sdkCtx, params.SlashWindow) {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -33,10 +34,17 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryFeederDelegation(),
 		GetCmdQueryMissCounter(),
 		GetCmdQuerySlashWindow(),
+		GetCmdQueryValidatorOracleHistory(),
+		GetCmdQuerySlashWindowSummary(),
 		CmdQueryEMA(),
 		CmdQueryWMA(),
 		CmdQuerySMA(),
+		CmdQueryTWAP(),
 		CmdConvertUSDCtoCHEQ(),
+		CmdQueryConvert(),
+		CmdQueryPriceHealth(),
+		CmdQueryPriceAt(),
+		CmdQueryPriceRange(),
 	)
 
 	return cmd
@@ -283,6 +291,66 @@ func GetCmdQuerySlashWindow() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryValidatorOracleHistory implements the validator oracle history query command.
+func GetCmdQueryValidatorOracleHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-oracle-history [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the oracle performance and rewards of a validator over the current and past slash windows",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if _, err = sdk.ValAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorOracleHistory(cmd.Context(), &types.QueryValidatorOracleHistory{
+				ValidatorAddr: args[0],
+				Pagination:    pageReq,
+			})
+			return cli.PrintOrErr(res, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validator-oracle-history")
+	return cmd
+}
+
+// GetCmdQuerySlashWindowSummary implements the slash window summary query command.
+func GetCmdQuerySlashWindowSummary() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slash-window-summary",
+		Args:  cobra.NoArgs,
+		Short: "Query the summary of a past slash window, the latest one by default",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			windowEnd, _ := cmd.Flags().GetUint64("window-end")
+			res, err := queryClient.SlashWindowSummary(cmd.Context(), &types.QuerySlashWindowSummary{
+				WindowEndHeight: windowEnd,
+			})
+			return cli.PrintOrErr(res, err, clientCtx)
+		},
+	}
+
+	cmd.Flags().Uint64("window-end", 0, "Last block of the slash window (defaults to the latest slash window)")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdQueryEMA() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "ema [denom]",
@@ -371,10 +439,43 @@ func CmdQuerySMA() *cobra.Command {
 	return cmd
 }
 
+func CmdQueryTWAP() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the time-weighted average prices of the given denom",
+		Long: strings.TrimSpace(`
+Query the time-weighted average prices of a denom over the TWAP lookbacks of the params,
+or over a single one of them with --lookback.
+
+Examples:
+  cheqd-noded query oracle twap CHEQ
+  cheqd-noded query oracle twap CHEQ --lookback 24h
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			lookback, _ := cmd.Flags().GetDuration("lookback")
+
+			queryClient := types.NewQueryClient(clientCtx)
+			denom := strings.ToUpper(args[0]) // Convert denom to uppercase
+			res, err := queryClient.TWAP(cmd.Context(), &types.QueryTWAPRequest{Denom: denom, Lookback: lookback})
+			return cli.PrintOrErr(res, err, clientCtx)
+		},
+	}
+
+	cmd.Flags().Duration("lookback", 0, "Lookback of the TWAP, one of the TWAP lookbacks of the params (e.g. 24h)")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdConvertUSDCtoCHEQ() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "convert-usdc-to-cheq [amount] [ma_type] [wma_strategy] [custom_weights]",
-		Short: "Convert USD (1e18 scale) to ncheq using MA (SMA, EMA, or WMA)",
+		Short: "Convert USD (1e18 scale) to ncheq using MA (SMA, EMA, or WMA), deprecated in favour of convert",
 		Long: strings.TrimSpace(`
 Convert a USD amount (in 1e18 scale) to ncheq using a selected moving average type (sma, ema, or wma).
 
@@ -438,3 +539,199 @@ Examples:
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdQueryConvert() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert [amount] [to_denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Convert an amount between two denoms of the accept list, or usd, through their USD prices",
+		Long: strings.TrimSpace(`
+Convert an amount between two denoms of the accept list, or usd (1e18 scale), through their
+USD prices from a price source: spot, sma, ema, wma_balanced, wma_recent, wma_oldest,
+wma_custom, median or twap. Defaults to the fee price source of the params, the price
+identity fees are converted with.
+
+Examples:
+  cheqd-noded query oracle convert 1000000000000000000usd ncheq
+  cheqd-noded query oracle convert 1000000000ncheq usd --price-source median
+  cheqd-noded query oracle convert 1000000000ncheq usd --price-source wma_custom --weights 1,2,3
+  cheqd-noded query oracle convert 1000000000ncheq usd --price-source twap --twap-lookback 24h
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sourceName, _ := cmd.Flags().GetString("price-source")
+			source, err := types.ParsePriceSource(sourceName)
+			if err != nil {
+				return err
+			}
+			weights, _ := cmd.Flags().GetInt32Slice("weights")
+			lookback, _ := cmd.Flags().GetDuration("twap-lookback")
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Convert(cmd.Context(), &types.QueryConvertRequest{
+				Amount:        args[0],
+				ToDenom:       args[1],
+				PriceSource:   source,
+				CustomWeights: weights,
+				TwapLookback:  lookback,
+			})
+			return cli.PrintOrErr(res, err, clientCtx)
+		},
+	}
+
+	cmd.Flags().String("price-source", "", "Price source: spot | sma | ema | wma_balanced | wma_recent | wma_oldest | wma_custom | median | twap")
+	cmd.Flags().Int32Slice("weights", nil, "Custom weights for wma_custom (comma-separated, e.g. 1,2,3)")
+	cmd.Flags().Duration("twap-lookback", 0, "Lookback of the TWAP for twap, one of the TWAP lookbacks of the params (e.g. 1h)")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryPriceHealth() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-health [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the freshness of the moving averages of the given denom and its price circuit breaker",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			denom := strings.ToUpper(args[0]) // Convert denom to uppercase
+			res, err := queryClient.PriceHealth(cmd.Context(), &types.QueryPriceHealthRequest{Denom: denom})
+			return cli.PrintOrErr(res, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryPriceAt() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-at [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the latest historic price or median of the given denom stamped at or before a block height or time",
+		Long: strings.TrimSpace(`
+Query the latest historic price stamp (or median, with --series median) of a denom
+stamped at or before a block height or time.
+
+Examples:
+  cheqd-noded query oracle price-at CHEQ --block 1000000
+  cheqd-noded query oracle price-at CHEQ --time 2025-01-01T00:00:00Z --series median
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, _ := cmd.Flags().GetUint64("block")
+			series, _ := cmd.Flags().GetString("series")
+			at, err := parseTimeFlag(cmd, "time")
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			denom := strings.ToUpper(args[0]) // Convert denom to uppercase
+			res, err := queryClient.PriceAt(cmd.Context(), &types.QueryPriceAtRequest{
+				Denom:  denom,
+				Height: height,
+				Time:   at,
+				Series: series,
+			})
+			return cli.PrintOrErr(res, err, clientCtx)
+		},
+	}
+
+	cmd.Flags().Uint64("block", 0, "Block height to query the price at")
+	cmd.Flags().String("time", "", "Time to query the price at, in RFC3339 format")
+	cmd.Flags().String("series", types.PriceSeriesHistoric, "Price series: historic | median")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryPriceRange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-range [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the historic prices or medians of the given denom stamped within a block or time range",
+		Long: strings.TrimSpace(`
+Query the historic price stamps (or medians, with --series median) of a denom stamped
+within a block or time range. Use --step to down-sample the series to the first price
+of every step blocks. Only prices within the pruning period of the oracle are available.
+
+Examples:
+  cheqd-noded query oracle price-range CHEQ --start-height 1000000 --end-height 1100000 --step 300
+  cheqd-noded query oracle price-range CHEQ --start-time 2025-01-01T00:00:00Z --end-time 2025-01-02T00:00:00Z --series median
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			startHeight, _ := cmd.Flags().GetUint64("start-height")
+			endHeight, _ := cmd.Flags().GetUint64("end-height")
+			step, _ := cmd.Flags().GetUint64("step")
+			series, _ := cmd.Flags().GetString("series")
+			startTime, err := parseTimeFlag(cmd, "start-time")
+			if err != nil {
+				return err
+			}
+			endTime, err := parseTimeFlag(cmd, "end-time")
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			denom := strings.ToUpper(args[0]) // Convert denom to uppercase
+			res, err := queryClient.PriceRange(cmd.Context(), &types.QueryPriceRangeRequest{
+				Denom:       denom,
+				StartHeight: startHeight,
+				EndHeight:   endHeight,
+				StartTime:   startTime,
+				EndTime:     endTime,
+				Series:      series,
+				Step:        step,
+				Pagination:  pageReq,
+			})
+			return cli.PrintOrErr(res, err, clientCtx)
+		},
+	}
+
+	cmd.Flags().Uint64("start-height", 0, "First block height of the range")
+	cmd.Flags().Uint64("end-height", 0, "Last block height of the range, defaults to the latest block")
+	cmd.Flags().String("start-time", "", "Start time of the range, in RFC3339 format")
+	cmd.Flags().String("end-time", "", "End time of the range, in RFC3339 format")
+	cmd.Flags().Uint64("step", 0, "Return only the first price of every step blocks")
+	cmd.Flags().String("series", types.PriceSeriesHistoric, "Price series: historic | median")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "price-range")
+	return cmd
+}
+
+// parseTimeFlag parses an optional RFC3339 time flag, returning nil if it is not set.
+func parseTimeFlag(cmd *cobra.Command, name string) (*time.Time, error) {
+	value, _ := cmd.Flags().GetString(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", name, err)
+	}
+	return &t, nil
+}
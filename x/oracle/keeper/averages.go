@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/math"
 	cosmosstore "cosmossdk.io/store/types"
@@ -24,6 +25,9 @@ func KeyEMA(denom string) []byte { return []byte(fmt.Sprintf("ema:%s", denom)) }
 func KeyWMAWithStrategy(denom, strategy string) []byte {
 	return []byte(fmt.Sprintf("wma:%s:%s", denom, strategy))
 }
+func KeyTWAP(denom string, lookback time.Duration) []byte {
+	return []byte(fmt.Sprintf("twap:%s:%s", denom, lookback))
+}
 
 func (k Keeper) ComputeAverages(ctx sdk.Context, denom string) error {
 	store := ctx.KVStore(k.storeKey)
@@ -43,6 +47,9 @@ func (k Keeper) ComputeAverages(ctx sdk.Context, denom string) error {
 	sma := CalculateSMA(prices)
 	k.SetAverage(ctx, KeySMA(denom), sma)
 
+	// Keep the previous fee price average to guard fees against price jumps
+	prevFeePrice, prevFound := k.GetFeePriceAverage(ctx, denom)
+
 	// Calculate WMA for all strategies
 	strategies := []string{string(WmaStrategyBalanced), string(WmaStrategyOldest), string(WmaStrategyRecent)}
 
@@ -59,7 +66,16 @@ func (k Keeper) ComputeAverages(ctx sdk.Context, denom string) error {
 
 	ema := CalculateEMA(prevEMA, present, prices)
 	k.SetAverage(ctx, KeyEMA(denom), ema)
-	return nil
+
+	// 4. TWAP over each lookback, weighted by the time between historic prices
+	for _, lookback := range k.TwapLookbacks(ctx) {
+		if twap, found := k.CalculateTWAP(ctx, denom, lookback); found {
+			k.SetAverage(ctx, KeyTWAP(denom, lookback), twap)
+		}
+	}
+
+	feePrice, _ := k.GetFeePriceAverage(ctx, denom)
+	return k.UpdatePriceCircuitBreaker(ctx, denom, prevFeePrice.Value, prevFound, feePrice.Value)
 }
 
 func CalculateHistoricPrices(ctx sdk.Context, store cosmosstore.KVStore, denom string, currentBlock uint64, k Keeper) ([]math.LegacyDec, error) {
@@ -108,8 +124,13 @@ func CalculateEMA(previousEMA math.LegacyDec, present bool, prices []math.Legacy
 	return ema
 }
 
+// SetAverage stores a moving average along with the block it is computed in
 func (k Keeper) SetAverage(ctx sdk.Context, key []byte, value math.LegacyDec) {
-	bz := k.cdc.MustMarshal(&sdk.DecProto{Dec: value})
+	bz := k.cdc.MustMarshal(&types.PriceAverage{
+		Value:         value,
+		UpdatedHeight: ctx.BlockHeight(),
+		UpdatedTime:   ctx.BlockTime(),
+	})
 	ctx.KVStore(k.storeKey).Set(key, bz)
 }
 
@@ -126,13 +147,23 @@ func (k Keeper) GetWMA(ctx sdk.Context, denom string, strategy string) (math.Leg
 }
 
 func (k Keeper) GetAverage(ctx sdk.Context, key []byte) (math.LegacyDec, bool) {
+	average, found := k.GetPriceAverage(ctx, key)
+	if !found {
+		return math.LegacyZeroDec(), false
+	}
+	return average.Value, true
+}
+
+// GetPriceAverage returns a moving average along with the block it was last computed in.
+// Averages stored before the block was recorded have a zero height.
+func (k Keeper) GetPriceAverage(ctx sdk.Context, key []byte) (types.PriceAverage, bool) {
 	bz := ctx.KVStore(k.storeKey).Get(key)
 	if bz == nil {
-		return math.LegacyZeroDec(), false
+		return types.PriceAverage{Value: math.LegacyZeroDec()}, false
 	}
-	var proto sdk.DecProto
-	k.cdc.MustUnmarshal(bz, &proto)
-	return proto.Dec, true
+	var average types.PriceAverage
+	k.cdc.MustUnmarshal(bz, &average)
+	return average, true
 }
 
 func CalculateSMA(prices []math.LegacyDec) math.LegacyDec {
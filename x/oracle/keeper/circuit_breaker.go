@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cheqd/cheqd-node/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetPriceCircuitBreaker returns the circuit breaker of a denom. A breaker which never tripped is returned if none is stored.
+func (k Keeper) GetPriceCircuitBreaker(ctx sdk.Context, denom string) types.PriceCircuitBreaker {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyPriceCircuitBreaker(denom))
+	if bz == nil {
+		return types.PriceCircuitBreaker{Denom: denom}
+	}
+
+	var breaker types.PriceCircuitBreaker
+	k.cdc.MustUnmarshal(bz, &breaker)
+	return breaker
+}
+
+// SetPriceCircuitBreaker stores the circuit breaker of a denom
+func (k Keeper) SetPriceCircuitBreaker(ctx sdk.Context, breaker types.PriceCircuitBreaker) {
+	bz := k.cdc.MustMarshal(&breaker)
+	ctx.KVStore(k.storeKey).Set(types.KeyPriceCircuitBreaker(breaker.Denom), bz)
+}
+
+// GetFeePriceAverage returns the moving average of a denom identity fees are converted with
+func (k Keeper) GetFeePriceAverage(ctx sdk.Context, denom string) (types.PriceAverage, bool) {
+	return k.GetPriceAverage(ctx, averageKey(denom, k.FeePriceSource(ctx), k.FeeTwapLookback(ctx)))
+}
+
+// PriceAge returns the number of blocks since a moving average was last computed
+func PriceAge(ctx sdk.Context, average types.PriceAverage) uint64 {
+	return blocksSince(ctx, average.UpdatedHeight)
+}
+
+// IsPriceStale returns true if the moving averages are older than the max price age
+func IsPriceStale(ctx sdk.Context, params types.Params, average types.PriceAverage) bool {
+	return params.MaxPriceAge != 0 && PriceAge(ctx, average) > params.MaxPriceAge
+}
+
+// IsPriceJump returns true if the fee price average moved by more than the max price jump between two averaging periods
+func IsPriceJump(params types.Params, prev, cur math.LegacyDec) bool {
+	if !params.MaxPriceJump.IsPositive() || !prev.IsPositive() {
+		return false
+	}
+	return cur.Sub(prev).Abs().Quo(prev).GT(params.MaxPriceJump)
+}
+
+// IsPriceHealthy returns false if USD-denominated fee conversion is frozen for a denom,
+// either because its circuit breaker tripped or because its moving averages went stale since the last check.
+// Denoms without moving averages are left to the fallbacks of the callers.
+func (k Keeper) IsPriceHealthy(ctx sdk.Context, denom string) bool {
+	if k.GetPriceCircuitBreaker(ctx, denom).Tripped {
+		return false
+	}
+
+	average, found := k.GetFeePriceAverage(ctx, denom)
+	if !found {
+		return true
+	}
+
+	return !IsPriceStale(ctx, k.GetParams(ctx), average)
+}
+
+// UpdatePriceCircuitBreaker trips the circuit breaker of a denom if its fee price average jumped
+// since the previous averaging period, and resets it otherwise as the averages are fresh again.
+func (k Keeper) UpdatePriceCircuitBreaker(ctx sdk.Context, denom string, prevPrice math.LegacyDec, prevFound bool, price math.LegacyDec) error {
+	if prevFound && IsPriceJump(k.GetParams(ctx), prevPrice, price) {
+		return k.tripPriceCircuitBreaker(ctx, denom, types.CircuitBreakerReasonPriceJump)
+	}
+
+	return k.resetPriceCircuitBreaker(ctx, denom)
+}
+
+// CheckPriceStaleness trips the circuit breaker of every accepted denom whose moving averages went stale
+func (k Keeper) CheckPriceStaleness(ctx sdk.Context, params types.Params) error {
+	if params.MaxPriceAge == 0 {
+		return nil
+	}
+
+	for _, denom := range params.AcceptList {
+		average, found := k.GetFeePriceAverage(ctx, denom.SymbolDenom)
+		if !found || !IsPriceStale(ctx, params, average) {
+			continue
+		}
+
+		if err := k.tripPriceCircuitBreaker(ctx, denom.SymbolDenom, types.CircuitBreakerReasonStalePrice); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) tripPriceCircuitBreaker(ctx sdk.Context, denom, reason string) error {
+	breaker := k.GetPriceCircuitBreaker(ctx, denom)
+	if breaker.Tripped && breaker.Reason == reason {
+		return nil
+	}
+
+	k.SetPriceCircuitBreaker(ctx, types.PriceCircuitBreaker{
+		Denom:   denom,
+		Tripped: true,
+		Reason:  reason,
+		Height:  ctx.BlockHeight(),
+		Time:    ctx.BlockTime(),
+	})
+
+	average, _ := k.GetFeePriceAverage(ctx, denom)
+	ctx.Logger().Error("Price circuit breaker tripped, USD-denominated fee conversion is frozen", "denom", denom, "reason", reason)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventPriceCircuitBreakerTripped{
+		Denom:       denom,
+		Reason:      reason,
+		Price:       average.Value,
+		PriceHeight: average.UpdatedHeight,
+	})
+}
+
+func (k Keeper) resetPriceCircuitBreaker(ctx sdk.Context, denom string) error {
+	breaker := k.GetPriceCircuitBreaker(ctx, denom)
+	if !breaker.Tripped {
+		return nil
+	}
+
+	k.SetPriceCircuitBreaker(ctx, types.PriceCircuitBreaker{
+		Denom:  denom,
+		Height: ctx.BlockHeight(),
+		Time:   ctx.BlockTime(),
+	})
+
+	average, _ := k.GetFeePriceAverage(ctx, denom)
+	ctx.Logger().Info("Price circuit breaker reset, USD-denominated fee conversion resumed", "denom", denom)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventPriceCircuitBreakerReset{
+		Denom: denom,
+		Price: average.Value,
+	})
+}
@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cheqd/cheqd-node/x/oracle/keeper"
+	"github.com/cheqd/cheqd-node/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *IntegrationTestSuite) TestPriceCircuitBreakerStalePrice() {
+	app, ctx := s.app, s.ctx
+
+	price := math.LegacyMustNewDecFromStr("0.02")
+	app.OracleKeeper.SetAverage(ctx, keeper.KeyWMAWithStrategy(displayDenom, string(keeper.WmaStrategyBalanced)), price)
+	setHeight := ctx.BlockHeight()
+	maxAge := app.OracleKeeper.MaxPriceAge(ctx)
+	s.Require().Equal(uint64(types.DefaultMaxPriceAge), maxAge)
+
+	// averages are fresh up to the max price age
+	ctx = ctx.WithBlockHeight(setHeight + int64(maxAge))
+	s.Require().True(app.OracleKeeper.IsPriceHealthy(ctx, displayDenom))
+	s.Require().NoError(app.OracleKeeper.CheckPriceStaleness(ctx, app.OracleKeeper.GetParams(ctx)))
+	s.Require().False(app.OracleKeeper.GetPriceCircuitBreaker(ctx, displayDenom).Tripped)
+
+	// a stale price freezes fees before the end blocker trips the breaker
+	ctx = ctx.WithBlockHeight(setHeight + int64(maxAge) + 1).WithEventManager(sdk.NewEventManager())
+	s.Require().False(app.OracleKeeper.IsPriceHealthy(ctx, displayDenom))
+
+	s.Require().NoError(app.OracleKeeper.CheckPriceStaleness(ctx, app.OracleKeeper.GetParams(ctx)))
+	breaker := app.OracleKeeper.GetPriceCircuitBreaker(ctx, displayDenom)
+	s.Require().True(breaker.Tripped)
+	s.Require().Equal(types.CircuitBreakerReasonStalePrice, breaker.Reason)
+	s.Require().Equal(ctx.BlockHeight(), breaker.Height)
+	s.Require().Len(ctx.EventManager().Events(), 1)
+	s.Require().Equal("cheqd.oracle.v2.EventPriceCircuitBreakerTripped", ctx.EventManager().Events()[0].Type)
+
+	// tripping again does not emit another event
+	s.Require().NoError(app.OracleKeeper.CheckPriceStaleness(ctx, app.OracleKeeper.GetParams(ctx)))
+	s.Require().Len(ctx.EventManager().Events(), 1)
+
+	res, err := keeper.NewQuerier(app.OracleKeeper).PriceHealth(ctx, &types.QueryPriceHealthRequest{Denom: displayDenom})
+	s.Require().NoError(err)
+	s.Require().Equal(price, res.Price)
+	s.Require().Equal(setHeight, res.UpdatedHeight)
+	s.Require().Equal(maxAge+1, res.Age)
+	s.Require().True(res.CircuitBreaker.Tripped)
+	s.Require().False(res.Healthy)
+
+	// fresh averages within the max price jump reset the breaker
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	app.OracleKeeper.SetAverage(ctx, keeper.KeyWMAWithStrategy(displayDenom, string(keeper.WmaStrategyBalanced)), price)
+	s.Require().NoError(app.OracleKeeper.UpdatePriceCircuitBreaker(ctx, displayDenom, price, true, price))
+	s.Require().False(app.OracleKeeper.GetPriceCircuitBreaker(ctx, displayDenom).Tripped)
+	s.Require().True(app.OracleKeeper.IsPriceHealthy(ctx, displayDenom))
+	s.Require().Len(ctx.EventManager().Events(), 1)
+	s.Require().Equal("cheqd.oracle.v2.EventPriceCircuitBreakerReset", ctx.EventManager().Events()[0].Type)
+}
+
+func (s *IntegrationTestSuite) TestPriceCircuitBreakerPriceJump() {
+	app, ctx := s.app, s.ctx
+
+	prev := math.LegacyMustNewDecFromStr("0.02")
+	app.OracleKeeper.SetAverage(ctx, keeper.KeyWMAWithStrategy(displayDenom, string(keeper.WmaStrategyBalanced)), prev)
+
+	// a move up to the max price jump is accepted
+	s.Require().NoError(app.OracleKeeper.UpdatePriceCircuitBreaker(ctx, displayDenom, prev, true, math.LegacyMustNewDecFromStr("0.03")))
+	s.Require().True(app.OracleKeeper.IsPriceHealthy(ctx, displayDenom))
+
+	// a larger move trips the breaker, in either direction
+	s.Require().NoError(app.OracleKeeper.UpdatePriceCircuitBreaker(ctx, displayDenom, prev, true, math.LegacyMustNewDecFromStr("0.0099")))
+	breaker := app.OracleKeeper.GetPriceCircuitBreaker(ctx, displayDenom)
+	s.Require().True(breaker.Tripped)
+	s.Require().Equal(types.CircuitBreakerReasonPriceJump, breaker.Reason)
+	s.Require().False(app.OracleKeeper.IsPriceHealthy(ctx, displayDenom))
+
+	// the first averages have nothing to jump from
+	s.Require().NoError(app.OracleKeeper.UpdatePriceCircuitBreaker(ctx, displayDenom, math.LegacyZeroDec(), false, prev))
+	s.Require().True(app.OracleKeeper.IsPriceHealthy(ctx, displayDenom))
+
+	// a zero max price jump disables the guard
+	app.OracleKeeper.SetMaxPriceJump(ctx, math.LegacyZeroDec())
+	s.Require().NoError(app.OracleKeeper.UpdatePriceCircuitBreaker(ctx, displayDenom, prev, true, prev.MulInt64(10)))
+	s.Require().True(app.OracleKeeper.IsPriceHealthy(ctx, displayDenom))
+}
+
+func (s *IntegrationTestSuite) TestMigrate1to2StampsAverages() {
+	app, ctx := s.app, s.ctx
+
+	// averages used to be stored as plain decimals
+	price := math.LegacyMustNewDecFromStr("0.02")
+	key := keeper.KeySMA(displayDenom)
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	store.Set(key, app.AppCodec().MustMarshal(&sdk.DecProto{Dec: price}))
+
+	legacy, found := app.OracleKeeper.GetPriceAverage(ctx, key)
+	s.Require().True(found)
+	s.Require().Equal(price, legacy.Value)
+	s.Require().Zero(legacy.UpdatedHeight)
+
+	ctx = ctx.WithBlockHeight(100)
+	s.Require().NoError(keeper.NewMigrator(&app.OracleKeeper).Migrate1to2(ctx))
+
+	average, found := app.OracleKeeper.GetPriceAverage(ctx, key)
+	s.Require().True(found)
+	s.Require().Equal(price, average.Value)
+	s.Require().Equal(int64(100), average.UpdatedHeight)
+	s.Require().Equal(types.DefaultMaxPriceJump, app.OracleKeeper.MaxPriceJump(ctx))
+}
@@ -3,14 +3,22 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cheqd/cheqd-node/util"
+	"github.com/cheqd/cheqd-node/util/decmath"
 	"github.com/cheqd/cheqd-node/x/oracle/types"
 )
 
+// ConvertUSDCtoCHEQ converts usd to ncheq with a moving average.
+//
+// Deprecated: use Convert, which this is a shorthand for.
 func (q Querier) ConvertUSDCtoCHEQ(
 	ctx context.Context,
 	req *types.ConvertUSDCtoCHEQRequest,
@@ -20,37 +28,27 @@ func (q Querier) ConvertUSDCtoCHEQ(
 	if err != nil {
 		return nil, fmt.Errorf("invalid amount format: %w", err)
 	}
+	if coin.Denom != types.UsdDenom {
+		return nil, fmt.Errorf("expected denom to be 'usd', got: %s", coin.Denom)
+	}
 
-	Sdkctx := sdk.UnwrapSDKContext(ctx)
-
-	// Step 2: Get price using selected moving average
-	var price sdkmath.LegacyDec
+	// Step 2: Map the selected moving average to its price source
+	var source types.PriceSource
 	switch req.MaType {
 	case "sma":
-		var found bool
-		price, found = q.GetSMA(Sdkctx, types.CheqdSymbol)
-		if !found {
-			return nil, fmt.Errorf("no SMA found for %s", types.CheqdSymbol)
-		}
+		source = types.PriceSource_PRICE_SOURCE_SMA
 	case "ema":
-		var found bool
-		price, found = q.GetEMA(Sdkctx, types.CheqdSymbol)
-		if !found {
-			return nil, fmt.Errorf("no EMA found for %s", types.CheqdSymbol)
-		}
+		source = types.PriceSource_PRICE_SOURCE_EMA
 	case "wma":
 		switch req.WmaStrategy {
-		case string(WmaStrategyRecent), string(WmaStrategyOldest), string(WmaStrategyBalanced):
-			var found bool
-			price, found = q.GetWMA(Sdkctx, types.CheqdSymbol, req.WmaStrategy)
-			if !found {
-				return nil, fmt.Errorf("missing WMA for strategy: %s", req.WmaStrategy)
-			}
+		case string(WmaStrategyBalanced):
+			source = types.PriceSource_PRICE_SOURCE_WMA_BALANCED
+		case string(WmaStrategyRecent):
+			source = types.PriceSource_PRICE_SOURCE_WMA_RECENT
+		case string(WmaStrategyOldest):
+			source = types.PriceSource_PRICE_SOURCE_WMA_OLDEST
 		case string(WmaStrategyCustom):
-			price, err = q.GetCustomWMA(Sdkctx, types.CheqdSymbol, req.CustomWeights)
-			if err != nil {
-				return nil, fmt.Errorf("failed to compute custom WMA: %w", err)
-			}
+			source = types.PriceSource_PRICE_SOURCE_WMA_CUSTOM
 		default:
 			return nil, fmt.Errorf("invalid WMA strategy: %s", req.WmaStrategy)
 		}
@@ -58,11 +56,14 @@ func (q Querier) ConvertUSDCtoCHEQ(
 		return nil, fmt.Errorf("invalid MA type: %s", req.MaType)
 	}
 
-	// Step 3: Convert USD to ncheq using helper
-	ncheq, err := q.ConvertUSDToNcheq(coin, price)
+	// Step 3: Convert USD to ncheq
+	ncheq, _, _, err := q.ConvertCoin(sdk.UnwrapSDKContext(ctx), coin, types.CheqdDenom, source, req.CustomWeights, 0)
 	if err != nil {
 		return nil, fmt.Errorf("conversion error: %w", err)
 	}
+	if ncheq.IsZero() {
+		return nil, fmt.Errorf("converted amount is zero")
+	}
 
 	// Step 4: Return response
 	return &types.ConvertUSDCtoCHEQResponse{
@@ -70,23 +71,50 @@ func (q Querier) ConvertUSDCtoCHEQ(
 	}, nil
 }
 
-func (q Querier) ConvertUSDToNcheq(usd sdk.Coin, price sdkmath.LegacyDec) (sdk.Coin, error) {
-	if usd.Denom != types.UsdDenom {
-		return sdk.Coin{}, fmt.Errorf("expected denom to be 'usd', got: %s", usd.Denom)
+// Convert converts an amount between two denoms through their USD prices from a price source.
+func (q Querier) Convert(goCtx context.Context, req *types.QueryConvertRequest) (*types.QueryConvertResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	if price.IsZero() {
-		return sdk.Coin{}, fmt.Errorf("cannot convert: price is zero")
+	coin, err := sdk.ParseCoinNormalized(req.Amount)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid amount: %s", err)
+	}
+	if req.ToDenom == "" {
+		return nil, status.Error(codes.InvalidArgument, "to denom cannot be empty")
 	}
 
-	// 1. Convert USD (1e18) → float USD (1.0, 2.5, etc.)
-	usdRaw := usd.Amount.ToLegacyDec().Quo(sdkmath.LegacyNewDecFromInt(util.UsdExponent))
+	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// 2. Divide USD / CHEQ/USD → CHEQ
-	cheq := usdRaw.Quo(price)
+	converted, fromPrice, toPrice, err := q.ConvertCoin(ctx, coin, req.ToDenom, req.PriceSource, req.CustomWeights, req.TwapLookback)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
-	// 3. Scale to ncheq (1e9)
-	ncheq := cheq.Mul(sdkmath.LegacyNewDecFromInt(util.CheqScale)).TruncateInt()
+	price, err := types.ConvertPrice(fromPrice.Price, toPrice.Price)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryConvertResponse{
+		Amount:    converted,
+		Price:     price,
+		Age:       max(fromPrice.Age, toPrice.Age),
+		FromPrice: fromPrice,
+		ToPrice:   toPrice,
+	}, nil
+}
+
+func (q Querier) ConvertUSDToNcheq(usd sdk.Coin, price sdkmath.LegacyDec) (sdk.Coin, error) {
+	if usd.Denom != types.UsdDenom {
+		return sdk.Coin{}, fmt.Errorf("expected denom to be 'usd', got: %s", usd.Denom)
+	}
+
+	ncheq, err := types.USDToNcheq(usd.Amount, price)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
 
 	if ncheq.IsZero() {
 		return sdk.Coin{}, fmt.Errorf("converted amount is zero")
@@ -94,3 +122,177 @@ func (q Querier) ConvertUSDToNcheq(usd sdk.Coin, price sdkmath.LegacyDec) (sdk.C
 
 	return sdk.NewCoin(types.CheqdDenom, ncheq), nil
 }
+
+// ConvertCoin converts a coin into another denom of the accept list, or usd, through
+// the USD prices of both denoms from a price source. It returns the converted coin
+// along with the prices it was converted with.
+func (k Keeper) ConvertCoin(
+	ctx sdk.Context,
+	coin sdk.Coin,
+	toDenom string,
+	source types.PriceSource,
+	customWeights []int32,
+	twapLookback time.Duration,
+) (sdk.Coin, types.ConversionPrice, types.ConversionPrice, error) {
+	fromSymbol, fromExponent, err := k.conversionDenom(ctx, coin.Denom)
+	if err != nil {
+		return sdk.Coin{}, types.ConversionPrice{}, types.ConversionPrice{}, err
+	}
+	toSymbol, toExponent, err := k.conversionDenom(ctx, toDenom)
+	if err != nil {
+		return sdk.Coin{}, types.ConversionPrice{}, types.ConversionPrice{}, err
+	}
+
+	fromPrice, err := k.GetConversionPrice(ctx, fromSymbol, source, customWeights, twapLookback)
+	if err != nil {
+		return sdk.Coin{}, types.ConversionPrice{}, types.ConversionPrice{}, err
+	}
+	toPrice, err := k.GetConversionPrice(ctx, toSymbol, source, customWeights, twapLookback)
+	if err != nil {
+		return sdk.Coin{}, types.ConversionPrice{}, types.ConversionPrice{}, err
+	}
+
+	amount, err := types.ConvertAmount(coin.Amount, fromPrice.Price, fromExponent, toPrice.Price, toExponent)
+	if err != nil {
+		return sdk.Coin{}, types.ConversionPrice{}, types.ConversionPrice{}, err
+	}
+
+	return sdk.NewCoin(toDenom, amount), fromPrice, toPrice, nil
+}
+
+// GetFeeConversionPrice returns the USD price of a symbol denom identity fees are converted with,
+// from the fee price source of the params.
+func (k Keeper) GetFeeConversionPrice(ctx sdk.Context, symbol string) (types.ConversionPrice, error) {
+	return k.GetConversionPrice(ctx, symbol, types.PriceSource_PRICE_SOURCE_UNSPECIFIED, nil, 0)
+}
+
+// GetConversionPrice returns the USD price of a symbol denom from a price source, along with
+// the block height it was computed at. The USD symbol is always priced at one.
+// The unspecified price source is the fee price source of the params, along with its TWAP lookback.
+func (k Keeper) GetConversionPrice(
+	ctx sdk.Context,
+	symbol string,
+	source types.PriceSource,
+	customWeights []int32,
+	twapLookback time.Duration,
+) (types.ConversionPrice, error) {
+	if symbol == types.USDSymbol {
+		return types.ConversionPrice{Denom: symbol, Price: sdkmath.LegacyOneDec(), Height: ctx.BlockHeight()}, nil
+	}
+
+	if source == types.PriceSource_PRICE_SOURCE_UNSPECIFIED {
+		source, twapLookback = k.FeePriceSource(ctx), k.FeeTwapLookback(ctx)
+	}
+
+	var (
+		price  sdkmath.LegacyDec
+		height int64
+	)
+	switch source {
+	case types.PriceSource_PRICE_SOURCE_SPOT:
+		rate, err := k.GetExchangeRate(ctx, symbol)
+		if err != nil {
+			return types.ConversionPrice{}, types.ErrNoConversionPrice.Wrapf("no exchange rate for %s", symbol)
+		}
+		price, height = rate, k.lastVotePeriodBlock(ctx)
+
+	case types.PriceSource_PRICE_SOURCE_SMA,
+		types.PriceSource_PRICE_SOURCE_EMA,
+		types.PriceSource_PRICE_SOURCE_WMA_BALANCED,
+		types.PriceSource_PRICE_SOURCE_WMA_RECENT,
+		types.PriceSource_PRICE_SOURCE_WMA_OLDEST:
+		average, found := k.GetPriceAverage(ctx, averageKey(symbol, source, 0))
+		if !found {
+			return types.ConversionPrice{}, types.ErrNoConversionPrice.Wrapf("no %s for %s", source, symbol)
+		}
+		price, height = average.Value, average.UpdatedHeight
+
+	case types.PriceSource_PRICE_SOURCE_TWAP:
+		// only the TWAPs of the configured lookbacks are stored
+		average, found := k.GetPriceAverage(ctx, averageKey(symbol, source, twapLookback))
+		if !found {
+			return types.ConversionPrice{}, types.ErrNoConversionPrice.Wrapf("no TWAP over %s for %s", twapLookback, symbol)
+		}
+		price, height = average.Value, average.UpdatedHeight
+
+	case types.PriceSource_PRICE_SOURCE_WMA_CUSTOM:
+		wma, err := k.GetCustomWMA(ctx, symbol, customWeights)
+		if err != nil {
+			return types.ConversionPrice{}, types.ErrNoConversionPrice.Wrapf("failed to compute custom WMA: %s", err)
+		}
+		block, _ := k.LastHistoricPriceBlock(ctx, symbol)
+		price, height = wma, util.SafeUint64ToInt64(block)
+
+	case types.PriceSource_PRICE_SOURCE_MEDIAN:
+		medians := k.HistoricMedians(ctx, symbol, k.MaximumMedianStamps(ctx))
+		if len(medians) == 0 {
+			return types.ConversionPrice{}, types.ErrNoConversionPrice.Wrapf("no historic medians for %s", symbol)
+		}
+		median, err := decmath.Median(medians.Decs())
+		if err != nil {
+			return types.ConversionPrice{}, err
+		}
+		price, height = median, util.SafeUint64ToInt64(medians.NewestBlockNum())
+
+	default:
+		return types.ConversionPrice{}, types.ErrInvalidPriceSource.Wrap(source.String())
+	}
+
+	return types.ConversionPrice{
+		Denom:  symbol,
+		Price:  price,
+		Height: height,
+		Age:    blocksSince(ctx, height),
+	}, nil
+}
+
+// conversionDenom returns the symbol and exponent of a base denom of the accept list, or of usd
+func (k Keeper) conversionDenom(ctx sdk.Context, denom string) (string, uint32, error) {
+	if denom == types.UsdDenom {
+		return types.USDSymbol, types.UsdExponent, nil
+	}
+
+	for _, accepted := range k.AcceptList(ctx) {
+		if strings.EqualFold(accepted.BaseDenom, denom) {
+			return accepted.SymbolDenom, accepted.Exponent, nil
+		}
+	}
+
+	return "", 0, types.ErrUnknownDenom.Wrap(denom)
+}
+
+// lastVotePeriodBlock returns the last block of the latest vote period, at which the exchange rates were set
+func (k Keeper) lastVotePeriodBlock(ctx sdk.Context) int64 {
+	votePeriod := util.SafeUint64ToInt64(k.VotePeriod(ctx))
+	if votePeriod == 0 {
+		return ctx.BlockHeight()
+	}
+	return ctx.BlockHeight() - (ctx.BlockHeight()+1)%votePeriod
+}
+
+// averageKey returns the store key of the moving average of a price source,
+// the lookback is only used by the TWAP
+func averageKey(symbol string, source types.PriceSource, twapLookback time.Duration) []byte {
+	switch source {
+	case types.PriceSource_PRICE_SOURCE_SMA:
+		return KeySMA(symbol)
+	case types.PriceSource_PRICE_SOURCE_EMA:
+		return KeyEMA(symbol)
+	case types.PriceSource_PRICE_SOURCE_WMA_RECENT:
+		return KeyWMAWithStrategy(symbol, string(WmaStrategyRecent))
+	case types.PriceSource_PRICE_SOURCE_WMA_OLDEST:
+		return KeyWMAWithStrategy(symbol, string(WmaStrategyOldest))
+	case types.PriceSource_PRICE_SOURCE_TWAP:
+		return KeyTWAP(symbol, twapLookback)
+	default:
+		return KeyWMAWithStrategy(symbol, string(WmaStrategyBalanced))
+	}
+}
+
+// blocksSince returns the number of blocks since a given height
+func blocksSince(ctx sdk.Context, height int64) uint64 {
+	if ctx.BlockHeight() <= height {
+		return 0
+	}
+	return util.SafeInt64ToUint64(ctx.BlockHeight() - height)
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cheqd/cheqd-node/x/oracle/keeper"
+	"github.com/cheqd/cheqd-node/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *IntegrationTestSuite) TestConvert() {
+	app, ctx := s.app, s.ctx
+	querier := keeper.NewQuerier(app.OracleKeeper)
+
+	cheqPrice := math.LegacyMustNewDecFromStr("0.02")
+	app.OracleKeeper.SetAverage(ctx, keeper.KeyWMAWithStrategy(displayDenom, string(keeper.WmaStrategyBalanced)), cheqPrice)
+	app.OracleKeeper.SetAverage(ctx, keeper.KeyWMAWithStrategy(types.USDCSymbol, string(keeper.WmaStrategyBalanced)), math.LegacyOneDec())
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+
+	// between two accepted denoms, with the balanced WMA by default
+	res, err := querier.Convert(ctx, &types.QueryConvertRequest{Amount: "1000000000ncheq", ToDenom: types.USDCDenom})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(types.USDCDenom, 20_000), res.Amount)
+	s.Require().Equal(cheqPrice, res.Price)
+	s.Require().Equal(uint64(5), res.Age)
+	s.Require().Equal(displayDenom, res.FromPrice.Denom)
+	s.Require().Equal(types.USDCSymbol, res.ToPrice.Denom)
+
+	// usd is always priced at one
+	res, err = querier.Convert(ctx, &types.QueryConvertRequest{Amount: "1000000000000000000usd", ToDenom: types.CheqdDenom})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(types.CheqdDenom, 50_000_000_000), res.Amount)
+	s.Require().Equal(math.LegacyMustNewDecFromStr("50"), res.Price)
+
+	// the deprecated query converts the same way
+	legacy, err := querier.ConvertUSDCtoCHEQ(ctx, &types.ConvertUSDCtoCHEQRequest{Amount: "1000000000000000000usd", MaType: "wma", WmaStrategy: "BALANCED"})
+	s.Require().NoError(err)
+	s.Require().Equal(res.Amount.String(), legacy.Amount)
+
+	// spot exchange rates
+	app.OracleKeeper.SetExchangeRate(ctx, displayDenom, math.LegacyMustNewDecFromStr("0.04"))
+	res, err = querier.Convert(ctx, &types.QueryConvertRequest{
+		Amount:      "1000000000000000000usd",
+		ToDenom:     types.CheqdDenom,
+		PriceSource: types.PriceSource_PRICE_SOURCE_SPOT,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(types.CheqdDenom, 25_000_000_000), res.Amount)
+
+	// median of the historic medians
+	app.OracleKeeper.SetHistoricMedian(ctx, displayDenom, 5, math.LegacyMustNewDecFromStr("0.01"))
+	app.OracleKeeper.SetHistoricMedian(ctx, displayDenom, 10, math.LegacyMustNewDecFromStr("0.025"))
+	app.OracleKeeper.SetHistoricMedian(ctx, displayDenom, 15, math.LegacyMustNewDecFromStr("0.02"))
+	res, err = querier.Convert(ctx, &types.QueryConvertRequest{
+		Amount:      "1000000000ncheq",
+		ToDenom:     types.UsdDenom,
+		PriceSource: types.PriceSource_PRICE_SOURCE_MEDIAN,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoin(types.UsdDenom, math.NewIntWithDecimal(2, 16)), res.Amount)
+	s.Require().Equal(int64(15), res.FromPrice.Height)
+
+	// missing prices and unknown denoms
+	_, err = querier.Convert(ctx, &types.QueryConvertRequest{
+		Amount:      "1000000000ncheq",
+		ToDenom:     types.UsdDenom,
+		PriceSource: types.PriceSource_PRICE_SOURCE_SMA,
+	})
+	s.Require().ErrorContains(err, types.ErrNoConversionPrice.Error())
+
+	_, err = querier.Convert(ctx, &types.QueryConvertRequest{Amount: "1000000000ncheq", ToDenom: "uatom"})
+	s.Require().Error(err)
+}
@@ -1,12 +1,14 @@
 package keeper
 
 import (
+	"time"
+
 	"github.com/cheqd/cheqd-node/util"
 	"github.com/cheqd/cheqd-node/util/metrics"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// PruneAllPrices deletes all historic prices, medians, and median deviations
+// PruneAllPrices deletes all historic prices, medians, median deviations, their stamp times and TWAP checkpoints
 // outside pruning period determined by the stamp period multiplied by the maximum stamps.
 func (k *Keeper) PruneAllPrices(ctx sdk.Context) {
 	params := k.GetParams(ctx)
@@ -25,6 +27,21 @@ func (k *Keeper) PruneAllPrices(ctx sdk.Context) {
 				k.PruneMedianDeviationsBeforeBlock(ctx, blockHeight-pruneMedianPeriod)
 			}
 		}
+
+		// stamp times are kept for as long as either historic prices or medians are
+		pruneStampTimePeriod := max(pruneHistoricPeriod, params.MedianStampPeriod*params.MaximumMedianStamps)
+		if pruneStampTimePeriod < blockHeight {
+			k.PruneStampTimesBeforeBlock(ctx, blockHeight-pruneStampTimePeriod)
+		}
+
+		// TWAP checkpoints are kept for the longest lookback
+		var maxLookback time.Duration
+		for _, lookback := range params.TwapLookbacks {
+			maxLookback = max(maxLookback, lookback)
+		}
+		for _, denom := range params.AcceptList {
+			k.PruneTwapCheckpointsBefore(ctx, denom.SymbolDenom, ctx.BlockTime().Add(-maxLookback))
+		}
 	}
 }
 
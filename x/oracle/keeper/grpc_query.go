@@ -3,9 +3,12 @@ package keeper
 import (
 	"context"
 	"errors"
+	"time"
 
 	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -157,6 +160,72 @@ func (q Querier) SlashWindow(
 	}, nil
 }
 
+// ValidatorOracleHistory queries the oracle performance and rewards of a validator over
+// the slash window in progress and its past slash windows.
+func (q Querier) ValidatorOracleHistory(
+	goCtx context.Context,
+	req *types.QueryValidatorOracleHistory,
+) (*types.QueryValidatorOracleHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.KeyValidatorOracleHistoryPrefix(valAddr))
+
+	history := []types.ValidatorOracleWindow{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var window types.ValidatorOracleWindow
+		if err := q.cdc.Unmarshal(value, &window); err != nil {
+			return err
+		}
+		history = append(history, window)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryValidatorOracleHistoryResponse{
+		Current:    q.CurrentValidatorOracleWindow(ctx, valAddr),
+		History:    history,
+		Pagination: pageRes,
+	}, nil
+}
+
+// SlashWindowSummary queries the summary of a past slash window, or of the latest
+// one if no window end height is given.
+func (q Querier) SlashWindowSummary(
+	goCtx context.Context,
+	req *types.QuerySlashWindowSummary,
+) (*types.QuerySlashWindowSummaryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var (
+		summary types.SlashWindowSummary
+		found   bool
+	)
+	if req.WindowEndHeight == 0 {
+		summary, found = q.GetLatestSlashWindowSummary(ctx)
+	} else {
+		summary, found = q.GetSlashWindowSummary(ctx, req.WindowEndHeight)
+	}
+	if !found {
+		return nil, status.Error(codes.NotFound, "slash window summary not found")
+	}
+
+	return &types.QuerySlashWindowSummaryResponse{Summary: summary}, nil
+}
+
 // AggregatePrevote queries an aggregate prevote of a validator.
 func (q Querier) AggregatePrevote(
 	goCtx context.Context,
@@ -395,3 +464,223 @@ func (q Querier) SMA(ctx context.Context, req *types.QuerySMARequest) (*types.Qu
 		Price: price,
 	}, nil
 }
+
+// TWAP queries the time-weighted average prices of a denom over the configured lookbacks,
+// or over a single one of them.
+func (q Querier) TWAP(goCtx context.Context, req *types.QueryTWAPRequest) (*types.QueryTWAPResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	lookbacks := q.TwapLookbacks(ctx)
+	if req.Lookback != 0 {
+		if !types.ContainsLookback(lookbacks, req.Lookback) {
+			return nil, status.Errorf(codes.InvalidArgument, "no TWAP is computed over %s", req.Lookback)
+		}
+		lookbacks = []time.Duration{req.Lookback}
+	}
+
+	prices := []types.TwapPrice{}
+	for _, lookback := range lookbacks {
+		average, found := q.GetPriceAverage(ctx, KeyTWAP(req.Denom, lookback))
+		if !found {
+			continue
+		}
+		prices = append(prices, types.TwapPrice{
+			Lookback:      lookback,
+			Price:         average.Value,
+			UpdatedHeight: average.UpdatedHeight,
+			UpdatedTime:   average.UpdatedTime,
+		})
+	}
+	if len(prices) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no TWAP found for denom: %s", req.Denom)
+	}
+
+	return &types.QueryTWAPResponse{Prices: prices}, nil
+}
+
+// PriceHealth queries the freshness of the moving averages of a denom and the
+// state of its price circuit breaker.
+func (q Querier) PriceHealth(goCtx context.Context, req *types.QueryPriceHealthRequest) (*types.QueryPriceHealthResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	average, found := q.GetFeePriceAverage(ctx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no moving averages found for denom: %s", req.Denom)
+	}
+
+	return &types.QueryPriceHealthResponse{
+		Price:          average.Value,
+		UpdatedHeight:  average.UpdatedHeight,
+		UpdatedTime:    average.UpdatedTime,
+		Age:            PriceAge(ctx, average),
+		CircuitBreaker: q.GetPriceCircuitBreaker(ctx, req.Denom),
+		Healthy:        q.IsPriceHealthy(ctx, req.Denom),
+	}, nil
+}
+
+// PriceAt queries the latest historic price stamp or median of a denom stamped
+// at or before a given block height or time.
+func (q Querier) PriceAt(goCtx context.Context, req *types.QueryPriceAtRequest) (*types.QueryPriceAtResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	series := req.Series
+	if series == "" {
+		series = types.PriceSeriesHistoric
+	}
+	if (req.Height == 0) == (req.Time == nil) {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of height and time must be set")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	height := req.Height
+	if req.Time != nil {
+		block, found := q.LastStampBlockBefore(ctx, *req.Time)
+		if !found {
+			return nil, status.Errorf(codes.NotFound, "no price stamped at or before %s", req.Time)
+		}
+		height = block
+	}
+
+	prices, err := q.PriceSeries(ctx, req.Denom, series)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	for i := len(prices) - 1; i >= 0; i-- {
+		if prices[i].BlockNum <= height {
+			return &types.QueryPriceAtResponse{Price: q.PricePoint(ctx, prices[i])}, nil
+		}
+	}
+
+	return nil, status.Errorf(codes.NotFound, "no %s price of denom %s stamped at or before block %d", series, req.Denom, height)
+}
+
+// PriceRange queries the historic price stamps or medians of a denom stamped
+// within a block or time range, down-sampled to the first stamp of every step blocks.
+func (q Querier) PriceRange(goCtx context.Context, req *types.QueryPriceRangeRequest) (*types.QueryPriceRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	series := req.Series
+	if series == "" {
+		series = types.PriceSeriesHistoric
+	}
+	byTime := req.StartTime != nil || req.EndTime != nil
+	if byTime && (req.StartHeight != 0 || req.EndHeight != 0) {
+		return nil, status.Error(codes.InvalidArgument, "a block range cannot be combined with a time range")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	startHeight, endHeight := req.StartHeight, req.EndHeight
+	if endHeight == 0 {
+		endHeight = util.SafeInt64ToUint64(ctx.BlockHeight())
+	}
+	if byTime {
+		if req.StartTime != nil && req.EndTime != nil && req.StartTime.After(*req.EndTime) {
+			return nil, status.Error(codes.InvalidArgument, "start time must not be after end time")
+		}
+
+		if req.StartTime != nil {
+			block, found := q.FirstStampBlockAfter(ctx, *req.StartTime)
+			if !found {
+				return &types.QueryPriceRangeResponse{Pagination: &query.PageResponse{}}, nil
+			}
+			startHeight = block
+		}
+		if req.EndTime != nil {
+			block, found := q.LastStampBlockBefore(ctx, *req.EndTime)
+			if !found {
+				return &types.QueryPriceRangeResponse{Pagination: &query.PageResponse{}}, nil
+			}
+			endHeight = block
+		}
+	}
+	if startHeight > endHeight {
+		return nil, status.Error(codes.InvalidArgument, "start height must not be after end height")
+	}
+
+	prices, err := q.PriceSeries(ctx, req.Denom, series)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	inRange := types.PriceStamps{}
+	for _, price := range prices {
+		if price.BlockNum >= startHeight && price.BlockNum <= endHeight {
+			inRange = append(inRange, price)
+		}
+	}
+
+	points := []types.PricePoint{}
+	for _, price := range *inRange.DownSample(req.Step) {
+		points = append(points, q.PricePoint(ctx, price))
+	}
+
+	page, pageRes, err := paginatePricePoints(points, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryPriceRangeResponse{Prices: page, Pagination: pageRes}, nil
+}
+
+// paginatePricePoints paginates price points sorted by block number. The next key
+// of a page is the big endian block number of the first point of the next page.
+func paginatePricePoints(points []types.PricePoint, pageReq *query.PageRequest) ([]types.PricePoint, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return nil, nil, errors.New("invalid request, either offset or key is expected, got both")
+	}
+
+	if pageReq.Reverse {
+		reversed := make([]types.PricePoint, 0, len(points))
+		for i := len(points) - 1; i >= 0; i-- {
+			reversed = append(reversed, points[i])
+		}
+		points = reversed
+	}
+
+	total := util.SafeIntToUint64(len(points))
+	start := int(util.SafeUint64ToInt64(min(pageReq.Offset, total)))
+	if pageReq.Key != nil {
+		keyBlock := sdk.BigEndianToUint64(pageReq.Key)
+		start = len(points)
+		for i, point := range points {
+			if (!pageReq.Reverse && point.BlockNum >= keyBlock) || (pageReq.Reverse && point.BlockNum <= keyBlock) {
+				start = i
+				break
+			}
+		}
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	end := int(util.SafeUint64ToInt64(min(util.SafeIntToUint64(start)+limit, total)))
+
+	pageRes := &query.PageResponse{}
+	if end < len(points) {
+		pageRes.NextKey = sdk.Uint64ToBigEndian(points[end].BlockNum)
+	}
+	if pageReq.CountTotal {
+		pageRes.Total = total
+	}
+
+	return points[start:end], pageRes, nil
+}
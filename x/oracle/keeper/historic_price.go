@@ -337,6 +337,7 @@ func (k Keeper) AddHistoricPrice(
 ) {
 	block := util.SafeInt64ToUint64(ctx.BlockHeight())
 	k.SetHistoricPrice(ctx, denom, block, exchangeRate)
+	k.RecordTwapCheckpoint(ctx, denom, exchangeRate)
 }
 
 func (k Keeper) SetHistoricPrice(
@@ -354,6 +355,15 @@ func (k Keeper) SetHistoricPrice(
 	store.Set(types.KeyLastHistoricPriceBlock(denom), lastBlockBz)
 }
 
+// LastHistoricPriceBlock returns the block the latest historic price of a denom was stamped at.
+func (k Keeper) LastHistoricPriceBlock(ctx sdk.Context, denom string) (uint64, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyLastHistoricPriceBlock(denom))
+	if bz == nil {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
 // DeleteHistoricPrice deletes the historic price of a denom at a
 // given block.
 func (k Keeper) DeleteHistoricPrice(
@@ -15,6 +15,39 @@ func NewMigrator(keeper *Keeper) Migrator {
 	return Migrator{keeper: keeper}
 }
 
+// Migrate1to2 sets the price circuit breaker parameters and records the current
+// block in the stored moving averages, which are otherwise considered stale.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.SetMaxPriceAge(ctx, types.DefaultMaxPriceAge)
+	m.keeper.SetMaxPriceJump(ctx, types.DefaultMaxPriceJump)
+
+	for _, denom := range m.keeper.AcceptList(ctx) {
+		keys := [][]byte{KeySMA(denom.SymbolDenom), KeyEMA(denom.SymbolDenom)}
+		for _, strategy := range []WmaStrategy{WmaStrategyBalanced, WmaStrategyOldest, WmaStrategyRecent} {
+			keys = append(keys, KeyWMAWithStrategy(denom.SymbolDenom, string(strategy)))
+		}
+
+		for _, key := range keys {
+			if average, found := m.keeper.GetAverage(ctx, key); found {
+				m.keeper.SetAverage(ctx, key, average)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Migrate2to3 sets the TWAP and fee price source parameters. TWAP checkpoints are not seeded,
+// as historic prices stamped before the upgrade carry no block time, so TWAPs start empty and
+// cover the time since the first prices stamped after the upgrade until they reach their lookback.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.SetTwapLookbacks(ctx, types.DefaultTwapLookbacks)
+	m.keeper.SetFeePriceSource(ctx, types.DefaultFeePriceSource)
+	m.keeper.SetFeeTwapLookback(ctx, types.DefaultFeeTwapLookback)
+
+	return nil
+}
+
 // MigrateValidatorSet fixes the validator set being stored as map
 // causing non determinism by storing it as a list.
 func (m Migrator) MigrateValidatorSet(ctx sdk.Context) error {
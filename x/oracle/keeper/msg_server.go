@@ -256,6 +256,44 @@ func (ms msgServer) LegacyGovUpdateParams(
 		case string(types.KeyAveragingWindow):
 			ms.SetAveragingWindow(ctx, msg.Changes.AveragingWindow)
 
+		case string(types.KeyMaxPriceAge):
+			if msg.Changes.MaxPriceAge != 0 &&
+				msg.Changes.MaxPriceAge < ms.Keeper.HistoricStampPeriod(ctx)*ms.Keeper.GetParams(ctx).AveragingWindow {
+				return nil, fmt.Errorf("oracle parameter MaxPriceAge must be 0 or greater than or equal with HistoricStampPeriod * AveragingWindow")
+			}
+			ms.SetMaxPriceAge(ctx, msg.Changes.MaxPriceAge)
+
+		case string(types.KeyMaxPriceJump):
+			if msg.Changes.MaxPriceJump.IsNil() || msg.Changes.MaxPriceJump.IsNegative() {
+				return nil, fmt.Errorf("oracle parameter MaxPriceJump must be >= 0")
+			}
+			ms.SetMaxPriceJump(ctx, msg.Changes.MaxPriceJump)
+
+		case string(types.KeyTwapLookbacks):
+			// the fee TWAP lookback must remain one of the lookbacks
+			params := ms.GetParams(ctx)
+			params.TwapLookbacks = msg.Changes.TwapLookbacks
+			if err := params.Validate(); err != nil {
+				return nil, err
+			}
+			ms.SetTwapLookbacks(ctx, msg.Changes.TwapLookbacks)
+
+		case string(types.KeyFeePriceSource):
+			params := ms.GetParams(ctx)
+			params.FeePriceSource = msg.Changes.FeePriceSource
+			if err := params.Validate(); err != nil {
+				return nil, err
+			}
+			ms.SetFeePriceSource(ctx, msg.Changes.FeePriceSource)
+
+		case string(types.KeyFeeTwapLookback):
+			params := ms.GetParams(ctx)
+			params.FeeTwapLookback = msg.Changes.FeeTwapLookback
+			if err := params.Validate(); err != nil {
+				return nil, err
+			}
+			ms.SetFeeTwapLookback(ctx, msg.Changes.FeeTwapLookback)
+
 		default:
 			return nil, fmt.Errorf("%s is not an existing oracle param key", key)
 		}
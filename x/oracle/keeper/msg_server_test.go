@@ -4,6 +4,7 @@ import (
 	"crypto/rand"
 	"encoding/hex"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -342,6 +343,78 @@ func (s *IntegrationTestSuite) TestMsgServer_UpdateGovParams() {
 			true,
 			"oracle parameter SlashWindow must be greater than or equal with VotePeriod",
 		},
+		{
+			"valid max price jump",
+			&types.MsgGovUpdateParams{
+				Authority:   govAccAddr,
+				Title:       "test",
+				Description: "test",
+				Plan: types.ParamUpdatePlan{
+					Keys:   []string{"MaxPriceAge", "MaxPriceJump"},
+					Height: 11,
+					Changes: types.Params{
+						MaxPriceAge:  types.DefaultMaxPriceAge,
+						MaxPriceJump: math.LegacyNewDecWithPrec(25, 2),
+					},
+				},
+			},
+			false,
+			"",
+		},
+		{
+			"invalid max price jump",
+			&types.MsgGovUpdateParams{
+				Authority:   govAccAddr,
+				Title:       "test",
+				Description: "test",
+				Plan: types.ParamUpdatePlan{
+					Keys:   []string{"MaxPriceJump"},
+					Height: 11,
+					Changes: types.Params{
+						MaxPriceJump: math.LegacyNewDecWithPrec(-25, 2),
+					},
+				},
+			},
+			true,
+			"oracle parameter MaxPriceJump must be >= 0",
+		},
+		{
+			"valid fee price source",
+			&types.MsgGovUpdateParams{
+				Authority:   govAccAddr,
+				Title:       "test",
+				Description: "test",
+				Plan: types.ParamUpdatePlan{
+					Keys:   []string{"TwapLookbacks", "FeePriceSource", "FeeTwapLookback"},
+					Height: 11,
+					Changes: types.Params{
+						TwapLookbacks:   []time.Duration{time.Hour, 4 * time.Hour},
+						FeePriceSource:  types.PriceSource_PRICE_SOURCE_TWAP,
+						FeeTwapLookback: 4 * time.Hour,
+					},
+				},
+			},
+			false,
+			"",
+		},
+		{
+			"invalid fee twap lookback",
+			&types.MsgGovUpdateParams{
+				Authority:   govAccAddr,
+				Title:       "test",
+				Description: "test",
+				Plan: types.ParamUpdatePlan{
+					Keys:   []string{"FeePriceSource", "FeeTwapLookback"},
+					Height: 11,
+					Changes: types.Params{
+						FeePriceSource:  types.PriceSource_PRICE_SOURCE_TWAP,
+						FeeTwapLookback: 2 * time.Hour,
+					},
+				},
+			},
+			true,
+			"oracle parameter FeeTwapLookback must be one of TwapLookbacks",
+		},
 		{
 			"invalid key",
 			&types.MsgGovUpdateParams{
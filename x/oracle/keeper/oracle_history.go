@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cheqd/cheqd-node/util"
+	"github.com/cheqd/cheqd-node/x/oracle/types"
+)
+
+// GetValidatorWindowReward returns the oracle rewards allocated to a validator in the current slash window.
+func (k Keeper) GetValidatorWindowReward(ctx sdk.Context, operator sdk.ValAddress) sdk.Coins {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyValidatorWindowReward(operator))
+	if bz == nil {
+		return sdk.Coins{}
+	}
+
+	var window types.ValidatorOracleWindow
+	k.cdc.MustUnmarshal(bz, &window)
+	return window.Rewards
+}
+
+// AddValidatorWindowReward adds to the oracle rewards allocated to a validator in the current slash window.
+func (k Keeper) AddValidatorWindowReward(ctx sdk.Context, operator sdk.ValAddress, rewards sdk.Coins) {
+	bz := k.cdc.MustMarshal(&types.ValidatorOracleWindow{
+		ValidatorAddress: operator.String(),
+		Rewards:          k.GetValidatorWindowReward(ctx, operator).Add(rewards...),
+	})
+	ctx.KVStore(k.storeKey).Set(types.KeyValidatorWindowReward(operator), bz)
+}
+
+// DeleteValidatorWindowReward removes the oracle rewards of a validator in the current slash window.
+func (k Keeper) DeleteValidatorWindowReward(ctx sdk.Context, operator sdk.ValAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.KeyValidatorWindowReward(operator))
+}
+
+// IterateValidatorWindowRewards iterates over the oracle rewards of the validators in the current slash window.
+// Iterator stops when exhausting the source, or when the handler returns `true`.
+func (k Keeper) IterateValidatorWindowRewards(ctx sdk.Context, handler func(sdk.ValAddress, sdk.Coins) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := storetypes.KVStorePrefixIterator(store, types.KeyPrefixValidatorWindowReward)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		operator := sdk.ValAddress(iter.Key()[2:])
+
+		var window types.ValidatorOracleWindow
+		k.cdc.MustUnmarshal(iter.Value(), &window)
+
+		if handler(operator, window.Rewards) {
+			break
+		}
+	}
+}
+
+// SetValidatorOracleWindow stores the oracle performance of a validator over a past slash window,
+// keeping only the latest MaxOracleHistoryWindows of them.
+func (k Keeper) SetValidatorOracleWindow(ctx sdk.Context, operator sdk.ValAddress, window types.ValidatorOracleWindow) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&window)
+	store.Set(types.KeyValidatorOracleHistory(operator, window.WindowEndHeight), bz)
+
+	k.pruneOldest(ctx, types.KeyValidatorOracleHistoryPrefix(operator), types.MaxOracleHistoryWindows)
+}
+
+// GetValidatorOracleWindow returns the oracle performance of a validator over a past slash window.
+func (k Keeper) GetValidatorOracleWindow(ctx sdk.Context, operator sdk.ValAddress, windowEndHeight uint64) (types.ValidatorOracleWindow, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyValidatorOracleHistory(operator, windowEndHeight))
+	if bz == nil {
+		return types.ValidatorOracleWindow{}, false
+	}
+
+	var window types.ValidatorOracleWindow
+	k.cdc.MustUnmarshal(bz, &window)
+	return window, true
+}
+
+// CurrentValidatorOracleWindow returns the oracle performance of a validator over the slash window in progress.
+// The valid rate assumes that no more votes are missed until the end of the slash window.
+func (k Keeper) CurrentValidatorOracleWindow(ctx sdk.Context, operator sdk.ValAddress) types.ValidatorOracleWindow {
+	missCount := k.GetMissCounter(ctx, operator)
+	possibleWins := k.PossibleWinsPerSlashWindow(ctx)
+
+	return types.ValidatorOracleWindow{
+		ValidatorAddress: operator.String(),
+		WindowEndHeight:  k.CurrentSlashWindowEnd(ctx),
+		MissCount:        missCount,
+		PossibleWins:     possibleWins,
+		ValidRate:        types.ValidRate(possibleWins, missCount),
+		Rewards:          k.GetValidatorWindowReward(ctx, operator),
+	}
+}
+
+// SetSlashWindowSummary stores the summary of a past slash window, keeping only the latest
+// MaxOracleHistoryWindows of them.
+func (k Keeper) SetSlashWindowSummary(ctx sdk.Context, summary types.SlashWindowSummary) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&summary)
+	store.Set(types.KeySlashWindowSummary(summary.WindowEndHeight), bz)
+
+	k.pruneOldest(ctx, types.KeyPrefixSlashWindowSummary, types.MaxOracleHistoryWindows)
+}
+
+// GetSlashWindowSummary returns the summary of a past slash window.
+func (k Keeper) GetSlashWindowSummary(ctx sdk.Context, windowEndHeight uint64) (types.SlashWindowSummary, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeySlashWindowSummary(windowEndHeight))
+	if bz == nil {
+		return types.SlashWindowSummary{}, false
+	}
+
+	var summary types.SlashWindowSummary
+	k.cdc.MustUnmarshal(bz, &summary)
+	return summary, true
+}
+
+// GetLatestSlashWindowSummary returns the summary of the latest past slash window.
+func (k Keeper) GetLatestSlashWindowSummary(ctx sdk.Context) (types.SlashWindowSummary, bool) {
+	iter := storetypes.KVStoreReversePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixSlashWindowSummary)
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return types.SlashWindowSummary{}, false
+	}
+
+	var summary types.SlashWindowSummary
+	k.cdc.MustUnmarshal(iter.Value(), &summary)
+	return summary, true
+}
+
+// CurrentSlashWindowEnd returns the last block of the slash window in progress.
+func (k Keeper) CurrentSlashWindowEnd(ctx sdk.Context) uint64 {
+	slashWindow := k.SlashWindow(ctx)
+	height := util.SafeInt64ToUint64(ctx.BlockHeight())
+	return (height/slashWindow+1)*slashWindow - 1
+}
+
+// pruneOldest deletes the oldest entries under a prefix, keeping only the latest max of them.
+func (k Keeper) pruneOldest(ctx sdk.Context, prefix []byte, maxEntries int) {
+	store := ctx.KVStore(k.storeKey)
+	iter := storetypes.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	keys := [][]byte{}
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+
+	for i := 0; i < len(keys)-maxEntries; i++ {
+		store.Delete(keys[i])
+	}
+}
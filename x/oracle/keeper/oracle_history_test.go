@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cheqd/cheqd-node/x/oracle/keeper"
+	"github.com/cheqd/cheqd-node/x/oracle/types"
+)
+
+func (s *IntegrationTestSuite) TestValidatorOracleHistory() {
+	app := s.app
+	querier := keeper.NewQuerier(app.OracleKeeper)
+	p := app.OracleKeeper.GetParams(s.ctx)
+	p.SlashingEnabled = true
+	app.OracleKeeper.SetParams(s.ctx, p)
+
+	slashWindow := app.OracleKeeper.SlashWindow(s.ctx)
+	possibleWins := app.OracleKeeper.PossibleWinsPerSlashWindow(s.ctx)
+	ctx := s.ctx.WithBlockHeight(int64(slashWindow - 1)).WithEventManager(sdk.NewEventManager())
+
+	// valAddr misses every vote and is slashed, valAddr2 misses none and is rewarded
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(types.CheqdDenom, 1000))
+	app.OracleKeeper.SetMissCounter(ctx, valAddr, uint64(possibleWins))
+	app.OracleKeeper.AddValidatorWindowReward(ctx, valAddr2, rewards)
+	app.OracleKeeper.AddValidatorWindowReward(ctx, valAddr2, rewards)
+
+	current, err := querier.ValidatorOracleHistory(ctx, &types.QueryValidatorOracleHistory{ValidatorAddr: valAddr.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(slashWindow-1, current.Current.WindowEndHeight)
+	s.Require().Equal(uint64(possibleWins), current.Current.MissCount)
+	s.Require().Equal(math.LegacyZeroDec(), current.Current.ValidRate)
+	s.Require().Empty(current.History)
+
+	app.OracleKeeper.SlashAndResetMissCounters(ctx)
+
+	slashed := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "cheqd.oracle.v2.EventValidatorSlashed" {
+			slashed++
+		}
+	}
+	s.Require().Equal(1, slashed)
+
+	res, err := querier.ValidatorOracleHistory(ctx, &types.QueryValidatorOracleHistory{ValidatorAddr: valAddr.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.History, 1)
+	s.Require().True(res.History[0].Slashed)
+	s.Require().Equal(math.LegacyZeroDec(), res.History[0].ValidRate)
+	s.Require().Zero(res.Current.MissCount)
+
+	res, err = querier.ValidatorOracleHistory(ctx, &types.QueryValidatorOracleHistory{ValidatorAddr: valAddr2.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.History, 1)
+	s.Require().False(res.History[0].Slashed)
+	s.Require().Equal(math.LegacyOneDec(), res.History[0].ValidRate)
+	s.Require().Equal(rewards.Add(rewards...), res.History[0].Rewards)
+	s.Require().True(res.Current.Rewards.IsZero())
+
+	summary, err := querier.SlashWindowSummary(ctx, &types.QuerySlashWindowSummary{})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(0), summary.Summary.WindowStartHeight)
+	s.Require().Equal(slashWindow-1, summary.Summary.WindowEndHeight)
+	s.Require().Equal(uint32(2), summary.Summary.Validators)
+	s.Require().Equal(uint32(1), summary.Summary.SlashedValidators)
+	s.Require().Equal(rewards.Add(rewards...), summary.Summary.Rewards)
+
+	_, err = querier.SlashWindowSummary(ctx, &types.QuerySlashWindowSummary{WindowEndHeight: slashWindow})
+	s.Require().Error(err)
+
+	_, err = querier.ValidatorOracleHistory(ctx, &types.QueryValidatorOracleHistory{ValidatorAddr: "invalid"})
+	s.Require().Error(err)
+}
+
+func (s *IntegrationTestSuite) TestValidatorOracleHistoryPruning() {
+	app, ctx := s.app, s.ctx
+	querier := keeper.NewQuerier(app.OracleKeeper)
+
+	windows := types.MaxOracleHistoryWindows + 3
+	for i := 1; i <= windows; i++ {
+		app.OracleKeeper.SetValidatorOracleWindow(ctx, valAddr, types.ValidatorOracleWindow{
+			ValidatorAddress: valAddr.String(),
+			WindowEndHeight:  uint64(i),
+			ValidRate:        math.LegacyOneDec(),
+		})
+		app.OracleKeeper.SetSlashWindowSummary(ctx, types.SlashWindowSummary{
+			WindowEndHeight:   uint64(i),
+			MinValidPerWindow: math.LegacyOneDec(),
+			SlashFraction:     math.LegacyZeroDec(),
+		})
+	}
+
+	_, found := app.OracleKeeper.GetValidatorOracleWindow(ctx, valAddr, 3)
+	s.Require().False(found)
+	_, found = app.OracleKeeper.GetValidatorOracleWindow(ctx, valAddr, 4)
+	s.Require().True(found)
+	_, found = app.OracleKeeper.GetSlashWindowSummary(ctx, 3)
+	s.Require().False(found)
+
+	latest, found := app.OracleKeeper.GetLatestSlashWindowSummary(ctx)
+	s.Require().True(found)
+	s.Require().Equal(uint64(windows), latest.WindowEndHeight)
+
+	res, err := querier.ValidatorOracleHistory(ctx, &types.QueryValidatorOracleHistory{
+		ValidatorAddr: valAddr.String(),
+		Pagination:    &query.PageRequest{Limit: 10, Reverse: true, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.History, 10)
+	s.Require().Equal(uint64(windows), res.History[0].WindowEndHeight)
+	s.Require().Equal(uint64(types.MaxOracleHistoryWindows), res.Pagination.Total)
+}
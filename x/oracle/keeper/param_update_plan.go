@@ -135,6 +135,21 @@ func (k Keeper) ValidateParamChanges(ctx sdk.Context, keys []string, changes typ
 		case string(types.KeyAveragingWindow):
 			params.AveragingWindow = changes.AveragingWindow
 
+		case string(types.KeyMaxPriceAge):
+			params.MaxPriceAge = changes.MaxPriceAge
+
+		case string(types.KeyMaxPriceJump):
+			params.MaxPriceJump = changes.MaxPriceJump
+
+		case string(types.KeyTwapLookbacks):
+			params.TwapLookbacks = changes.TwapLookbacks
+
+		case string(types.KeyFeePriceSource):
+			params.FeePriceSource = changes.FeePriceSource
+
+		case string(types.KeyFeeTwapLookback):
+			params.FeeTwapLookback = changes.FeeTwapLookback
+
 		}
 	}
 
@@ -199,6 +214,21 @@ func (k Keeper) ExecuteParamUpdatePlan(ctx sdk.Context, plan types.ParamUpdatePl
 
 		case string(types.KeyAveragingWindow):
 			k.SetAveragingWindow(ctx, plan.Changes.AveragingWindow)
+
+		case string(types.KeyMaxPriceAge):
+			k.SetMaxPriceAge(ctx, plan.Changes.MaxPriceAge)
+
+		case string(types.KeyMaxPriceJump):
+			k.SetMaxPriceJump(ctx, plan.Changes.MaxPriceJump)
+
+		case string(types.KeyTwapLookbacks):
+			k.SetTwapLookbacks(ctx, plan.Changes.TwapLookbacks)
+
+		case string(types.KeyFeePriceSource):
+			k.SetFeePriceSource(ctx, plan.Changes.FeePriceSource)
+
+		case string(types.KeyFeeTwapLookback):
+			k.SetFeeTwapLookback(ctx, plan.Changes.FeeTwapLookback)
 		}
 	}
 
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/cheqd/cheqd-node/x/oracle/types"
 
@@ -254,3 +255,58 @@ func (k Keeper) AveragingWindow(ctx sdk.Context) (res bool) {
 	k.paramSpace.Get(ctx, types.KeyAveragingWindow, &res)
 	return
 }
+
+// MaxPriceAge returns the number of blocks after which moving averages are considered stale.
+func (k Keeper) MaxPriceAge(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyMaxPriceAge, &res)
+	return
+}
+
+// SetMaxPriceAge updates the number of blocks after which moving averages are considered stale.
+func (k Keeper) SetMaxPriceAge(ctx sdk.Context, value uint64) {
+	k.paramSpace.Set(ctx, types.KeyMaxPriceAge, value)
+}
+
+// MaxPriceJump returns the maximum relative change of the fee price average between two averaging periods.
+func (k Keeper) MaxPriceJump(ctx sdk.Context) (res math.LegacyDec) {
+	k.paramSpace.Get(ctx, types.KeyMaxPriceJump, &res)
+	return
+}
+
+// SetMaxPriceJump updates the maximum relative change of the fee price average between two averaging periods.
+func (k Keeper) SetMaxPriceJump(ctx sdk.Context, value math.LegacyDec) {
+	k.paramSpace.Set(ctx, types.KeyMaxPriceJump, value)
+}
+
+// TwapLookbacks returns the durations the time-weighted average prices are computed over.
+func (k Keeper) TwapLookbacks(ctx sdk.Context) (res []time.Duration) {
+	k.paramSpace.Get(ctx, types.KeyTwapLookbacks, &res)
+	return
+}
+
+// SetTwapLookbacks updates the durations the time-weighted average prices are computed over.
+func (k Keeper) SetTwapLookbacks(ctx sdk.Context, value []time.Duration) {
+	k.paramSpace.Set(ctx, types.KeyTwapLookbacks, value)
+}
+
+// FeePriceSource returns the moving average identity fees are converted with.
+func (k Keeper) FeePriceSource(ctx sdk.Context) (res types.PriceSource) {
+	k.paramSpace.Get(ctx, types.KeyFeePriceSource, &res)
+	return
+}
+
+// SetFeePriceSource updates the moving average identity fees are converted with.
+func (k Keeper) SetFeePriceSource(ctx sdk.Context, value types.PriceSource) {
+	k.paramSpace.Set(ctx, types.KeyFeePriceSource, value)
+}
+
+// FeeTwapLookback returns the lookback of the TWAP identity fees are converted with.
+func (k Keeper) FeeTwapLookback(ctx sdk.Context) (res time.Duration) {
+	k.paramSpace.Get(ctx, types.KeyFeeTwapLookback, &res)
+	return
+}
+
+// SetFeeTwapLookback updates the lookback of the TWAP identity fees are converted with.
+func (k Keeper) SetFeeTwapLookback(ctx sdk.Context, value time.Duration) {
+	k.paramSpace.Set(ctx, types.KeyFeeTwapLookback, value)
+}
@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cheqd/cheqd-node/util"
+	"github.com/cheqd/cheqd-node/x/oracle/types"
+)
+
+// SetStampTime records the block time of the price stamps of the current block,
+// so that historic prices and medians can be looked up by time. It's called once
+// per stamped block by the EndBlocker.
+func (k Keeper) SetStampTime(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	block := util.SafeInt64ToUint64(ctx.BlockHeight())
+	store.Set(types.KeyStampTime(block), sdk.FormatTimeBytes(ctx.BlockTime()))
+}
+
+// GetStampTime returns the block time of the price stamps of a given block.
+func (k Keeper) GetStampTime(ctx sdk.Context, blockNum uint64) (time.Time, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyStampTime(blockNum))
+	if bz == nil {
+		return time.Time{}, false
+	}
+
+	stampTime, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return stampTime, true
+}
+
+// IterateStampTimes iterates over the block times of price stamps in block order.
+// Iterator stops when exhausting the source, or when the handler returns `true`.
+func (k Keeper) IterateStampTimes(ctx sdk.Context, handler func(blockNum uint64, stampTime time.Time) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := storetypes.KVStorePrefixIterator(store, types.KeyPrefixStampTime)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		stampTime, err := sdk.ParseTimeBytes(iter.Value())
+		if err != nil {
+			continue
+		}
+		if handler(sdk.BigEndianToUint64(iter.Key()[len(types.KeyPrefixStampTime):]), stampTime) {
+			break
+		}
+	}
+}
+
+// PruneStampTimesBeforeBlock deletes the block times of price stamps up to a given block.
+func (k Keeper) PruneStampTimesBeforeBlock(ctx sdk.Context, blockNum uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iter := storetypes.KVStorePrefixIterator(store, types.KeyPrefixStampTime)
+	defer iter.Close()
+
+	keys := [][]byte{}
+	for ; iter.Valid(); iter.Next() {
+		if sdk.BigEndianToUint64(iter.Key()[len(types.KeyPrefixStampTime):]) > blockNum {
+			break
+		}
+		keys = append(keys, iter.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// LastStampBlockBefore returns the last block stamped at or before a given time.
+func (k Keeper) LastStampBlockBefore(ctx sdk.Context, t time.Time) (uint64, bool) {
+	var (
+		block uint64
+		found bool
+	)
+	k.IterateStampTimes(ctx, func(blockNum uint64, stampTime time.Time) bool {
+		if stampTime.After(t) {
+			return true
+		}
+		block, found = blockNum, true
+		return false
+	})
+	return block, found
+}
+
+// FirstStampBlockAfter returns the first block stamped at or after a given time.
+func (k Keeper) FirstStampBlockAfter(ctx sdk.Context, t time.Time) (uint64, bool) {
+	var (
+		block uint64
+		found bool
+	)
+	k.IterateStampTimes(ctx, func(blockNum uint64, stampTime time.Time) bool {
+		if stampTime.Before(t) {
+			return false
+		}
+		block, found = blockNum, true
+		return true
+	})
+	return block, found
+}
+
+// PriceSeries returns all the stored historic prices or medians of a given denom,
+// sorted by block number. The series is limited to the pruning period of its stamps.
+func (k Keeper) PriceSeries(ctx sdk.Context, denom, series string) (types.PriceStamps, error) {
+	if err := types.ValidatePriceSeries(series); err != nil {
+		return nil, err
+	}
+
+	prefix := types.KeyPrefixHistoricPrice
+	if series == types.PriceSeriesMedian {
+		prefix = types.KeyPrefixMedian
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	// make sure we have one zero byte to correctly separate denoms
+	iter := storetypes.KVStorePrefixIterator(store, util.ConcatBytes(1, prefix, []byte(denom)))
+	defer iter.Close()
+
+	prices := types.PriceStamps{}
+	for ; iter.Valid(); iter.Next() {
+		_, block := types.ParseDenomAndBlockFromKey(iter.Key(), prefix)
+		decProto := sdk.DecProto{}
+		k.cdc.MustUnmarshal(iter.Value(), &decProto)
+		prices = append(prices, *types.NewPriceStamp(decProto.Dec, denom, block))
+	}
+
+	// blocks are little endian in the keys, so the store is not in block order
+	return *prices.Sort(), nil
+}
+
+// PricePoint returns a price stamp along with the block time it was stamped at.
+func (k Keeper) PricePoint(ctx sdk.Context, price types.PriceStamp) types.PricePoint {
+	stampTime, _ := k.GetStampTime(ctx, price.BlockNum)
+	return types.PricePoint{
+		Price:    price.ExchangeRate.Amount,
+		BlockNum: price.BlockNum,
+		Time:     stampTime,
+	}
+}
@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cheqd/cheqd-node/x/oracle/abci"
+	"github.com/cheqd/cheqd-node/x/oracle/keeper"
+	"github.com/cheqd/cheqd-node/x/oracle/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func (s *IntegrationTestSuite) TestPriceAtAndPriceRange() {
+	app, ctx := s.app, s.ctx
+	querier := keeper.NewQuerier(app.OracleKeeper)
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// stamp prices every 10 blocks across block 256, where the little endian
+	// block keys stop iterating in block order
+	exchangeRates := []string{"1.0", "1.1", "1.2", "1.3", "1.4", "1.5"}
+	for i, exchangeRate := range exchangeRates {
+		ctx = ctx.WithBlockHeight(int64(250 + i*10)).WithBlockTime(start.Add(time.Duration(i) * time.Minute))
+		app.OracleKeeper.AddHistoricPrice(ctx, displayDenom, math.LegacyMustNewDecFromStr(exchangeRate))
+		app.OracleKeeper.AddHistoricPrice(ctx, displayDenom+"test", math.LegacyOneDec())
+		app.OracleKeeper.SetStampTime(ctx)
+	}
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// by block height
+	res, err := querier.PriceAt(ctx, &types.QueryPriceAtRequest{Denom: displayDenom, Height: 265})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(260), res.Price.BlockNum)
+	s.Require().Equal(math.LegacyMustNewDecFromStr("1.1"), res.Price.Price)
+	s.Require().Equal(start.Add(time.Minute), res.Price.Time)
+
+	// by time
+	at := start.Add(3*time.Minute + 30*time.Second)
+	res, err = querier.PriceAt(ctx, &types.QueryPriceAtRequest{Denom: displayDenom, Time: &at})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(280), res.Price.BlockNum)
+
+	_, err = querier.PriceAt(ctx, &types.QueryPriceAtRequest{Denom: displayDenom, Height: 249})
+	s.Require().Error(err)
+	_, err = querier.PriceAt(ctx, &types.QueryPriceAtRequest{Denom: displayDenom, Height: 265, Time: &at})
+	s.Require().Error(err)
+	_, err = querier.PriceAt(ctx, &types.QueryPriceAtRequest{Denom: displayDenom, Height: 265, Series: "invalid"})
+	s.Require().Error(err)
+
+	// block range in order and down-sampled
+	rangeRes, err := querier.PriceRange(ctx, &types.QueryPriceRangeRequest{Denom: displayDenom, StartHeight: 255, EndHeight: 300})
+	s.Require().NoError(err)
+	s.Require().Len(rangeRes.Prices, 5)
+	for i, price := range rangeRes.Prices {
+		s.Require().Equal(uint64(260+i*10), price.BlockNum)
+	}
+
+	rangeRes, err = querier.PriceRange(ctx, &types.QueryPriceRangeRequest{Denom: displayDenom, Step: 20})
+	s.Require().NoError(err)
+	s.Require().Len(rangeRes.Prices, 3)
+	s.Require().Equal(uint64(250), rangeRes.Prices[0].BlockNum)
+	s.Require().Equal(uint64(270), rangeRes.Prices[1].BlockNum)
+	s.Require().Equal(uint64(290), rangeRes.Prices[2].BlockNum)
+
+	// time range
+	startTime, endTime := start.Add(90*time.Second), start.Add(4*time.Minute)
+	rangeRes, err = querier.PriceRange(ctx, &types.QueryPriceRangeRequest{Denom: displayDenom, StartTime: &startTime, EndTime: &endTime})
+	s.Require().NoError(err)
+	s.Require().Len(rangeRes.Prices, 3)
+	s.Require().Equal(uint64(270), rangeRes.Prices[0].BlockNum)
+	s.Require().Equal(uint64(290), rangeRes.Prices[2].BlockNum)
+
+	_, err = querier.PriceRange(ctx, &types.QueryPriceRangeRequest{Denom: displayDenom, StartHeight: 250, EndTime: &endTime})
+	s.Require().Error(err)
+
+	// paginated by key
+	rangeRes, err = querier.PriceRange(ctx, &types.QueryPriceRangeRequest{
+		Denom:      displayDenom,
+		Pagination: &query.PageRequest{Limit: 4, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(rangeRes.Prices, 4)
+	s.Require().Equal(uint64(6), rangeRes.Pagination.Total)
+	s.Require().NotNil(rangeRes.Pagination.NextKey)
+
+	rangeRes, err = querier.PriceRange(ctx, &types.QueryPriceRangeRequest{
+		Denom:      displayDenom,
+		Pagination: &query.PageRequest{Key: rangeRes.Pagination.NextKey, Limit: 4},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(rangeRes.Prices, 2)
+	s.Require().Equal(uint64(290), rangeRes.Prices[0].BlockNum)
+	s.Require().Nil(rangeRes.Pagination.NextKey)
+}
+
+func (s *IntegrationTestSuite) TestEndBlockerSetsStampTime() {
+	app := s.app
+	params := app.OracleKeeper.GetParams(s.ctx)
+	stampTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// the last block of a historic stamp period is also the last block of a vote period
+	block := params.HistoricStampPeriod*2 - 1
+	ctx := s.ctx.WithBlockHeight(int64(block)).WithBlockTime(stampTime)
+	s.Require().NoError(abci.EndBlocker(ctx, app.OracleKeeper, app.FeeabsKeeper))
+
+	recorded, found := app.OracleKeeper.GetStampTime(ctx, block)
+	s.Require().True(found)
+	s.Require().Equal(stampTime, recorded)
+
+	ctx = ctx.WithBlockHeight(int64(block + 1))
+	s.Require().NoError(abci.EndBlocker(ctx, app.OracleKeeper, app.FeeabsKeeper))
+	_, found = app.OracleKeeper.GetStampTime(ctx, block+1)
+	s.Require().False(found)
+}
+
+func (s *IntegrationTestSuite) TestPruneStampTimes() {
+	app, ctx := s.app, s.ctx
+
+	for _, block := range []int64{100, 200, 300} {
+		app.OracleKeeper.SetStampTime(ctx.WithBlockHeight(block))
+	}
+
+	app.OracleKeeper.PruneStampTimesBeforeBlock(ctx, 200)
+	_, found := app.OracleKeeper.GetStampTime(ctx, 200)
+	s.Require().False(found)
+	_, found = app.OracleKeeper.GetStampTime(ctx, 300)
+	s.Require().True(found)
+}
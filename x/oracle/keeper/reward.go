@@ -106,6 +106,14 @@ func (k Keeper) RewardBallotWinners(
 			return
 		}
 		distributedReward = distributedReward.Add(rewardCoins...)
+
+		k.AddValidatorWindowReward(ctx, winner.Recipient, rewardCoins)
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventValidatorRewarded{
+			Validator: winner.Recipient.String(),
+			Rewards:   rewardCoins,
+		}); err != nil {
+			k.Logger(ctx).With(err).Error("failed to emit validator rewarded event")
+		}
 	}
 
 	// move distributed reward to distribution module
@@ -14,6 +14,9 @@ import (
 //
 // If the valid vote rate is below the minValidPerWindow, the validator will be
 // slashed and jailed.
+//
+// The oracle performance and rewards of every validator over the slash window are
+// recorded in its oracle history, along with a summary of the slash window.
 func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context) {
 	possibleWins := k.PossibleWinsPerSlashWindow(ctx)
 	minValidRate := k.MinValidPerWindow(ctx)
@@ -22,13 +25,61 @@ func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context) {
 	slashFraction := k.SlashFraction(ctx)
 	powerReduction := k.StakingKeeper.PowerReduction(ctx)
 
+	windowEnd := util.SafeInt64ToUint64(ctx.BlockHeight())
+	summary := types.SlashWindowSummary{
+		WindowEndHeight:   windowEnd,
+		PossibleWins:      possibleWins,
+		MinValidPerWindow: minValidRate,
+		SlashFraction:     slashFraction,
+		SlashingEnabled:   k.GetParams(ctx).SlashingEnabled,
+		Rewards:           sdk.Coins{},
+	}
+	if slashWindow := k.SlashWindow(ctx); windowEnd+1 >= slashWindow {
+		summary.WindowStartHeight = windowEnd + 1 - slashWindow
+	}
+
+	recordWindow := func(operator sdk.ValAddress, missCount uint64, validRate math.LegacyDec, slashed bool) {
+		rewards := k.GetValidatorWindowReward(ctx, operator)
+		k.SetValidatorOracleWindow(ctx, operator, types.ValidatorOracleWindow{
+			ValidatorAddress: operator.String(),
+			WindowEndHeight:  windowEnd,
+			MissCount:        missCount,
+			PossibleWins:     possibleWins,
+			ValidRate:        validRate,
+			Rewards:          rewards,
+			Slashed:          slashed,
+		})
+		k.DeleteValidatorWindowReward(ctx, operator)
+
+		summary.Validators++
+		if slashed {
+			summary.SlashedValidators++
+		}
+		summary.Rewards = summary.Rewards.Add(rewards...)
+	}
+
 	k.IterateMissCounters(ctx, func(operator sdk.ValAddress, missCount uint64) bool {
-		k.evaluateAndSlashIfNeeded(ctx, operator, missCount, possibleWins, minValidRate, distributionHeight, slashFraction, powerReduction)
+		validRate, slashed := k.evaluateAndSlashIfNeeded(ctx, operator, missCount, possibleWins, minValidRate, distributionHeight, slashFraction, powerReduction)
+		recordWindow(operator, missCount, validRate, slashed)
 		k.DeleteMissCounter(ctx, operator)
 		return false
 	})
+
+	// the validators left with rewards did not miss any vote in the slash window
+	var operators []sdk.ValAddress
+	k.IterateValidatorWindowRewards(ctx, func(operator sdk.ValAddress, _ sdk.Coins) bool {
+		operators = append(operators, operator)
+		return false
+	})
+	for _, operator := range operators {
+		recordWindow(operator, 0, math.LegacyOneDec(), false)
+	}
+
+	k.SetSlashWindowSummary(ctx, summary)
 }
 
+// evaluateAndSlashIfNeeded returns the valid vote rate of a validator over the slash
+// window, and whether it was slashed and jailed for it.
 func (k Keeper) evaluateAndSlashIfNeeded(
 	ctx sdk.Context,
 	operator sdk.ValAddress,
@@ -38,19 +89,18 @@ func (k Keeper) evaluateAndSlashIfNeeded(
 	distributionHeight int64,
 	slashFraction math.LegacyDec,
 	powerReduction math.Int,
-) {
-	validVotes := math.NewInt(possibleWinsPerSlashWindow - util.SafeUint64ToInt64(missCount))
-	validRate := math.LegacyNewDecFromInt(validVotes).QuoInt64(possibleWinsPerSlashWindow)
+) (math.LegacyDec, bool) {
+	validRate := types.ValidRate(possibleWinsPerSlashWindow, missCount)
 
 	if !validRate.LT(minValidRate) {
-		return // Validator is safe
+		return validRate, false // Validator is safe
 	}
 
 	oracleParams := k.GetParams(ctx)
 
 	validator, err := k.StakingKeeper.Validator(ctx, operator)
 	if err != nil || !validator.IsBonded() || validator.IsJailed() || !oracleParams.SlashingEnabled {
-		return // Cannot slash or jail this validator
+		return validRate, false // Cannot slash or jail this validator
 	}
 
 	consAddr, err := validator.GetConsAddr()
@@ -58,19 +108,33 @@ func (k Keeper) evaluateAndSlashIfNeeded(
 		panic(err)
 	}
 
-	if _, err := k.StakingKeeper.Slash(
+	slashedAmount, err := k.StakingKeeper.Slash(
 		ctx,
 		consAddr,
 		distributionHeight,
 		validator.GetConsensusPower(powerReduction),
 		slashFraction,
-	); err != nil {
+	)
+	if err != nil {
 		panic(err)
 	}
 
 	if err := k.StakingKeeper.Jail(ctx, consAddr); err != nil {
 		panic(err)
 	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventValidatorSlashed{
+		Validator:       operator.String(),
+		WindowEndHeight: util.SafeInt64ToUint64(ctx.BlockHeight()),
+		MissCount:       missCount,
+		ValidRate:       validRate,
+		SlashFraction:   slashFraction,
+		SlashedAmount:   slashedAmount,
+	}); err != nil {
+		k.Logger(ctx).With(err).Error("failed to emit validator slashed event")
+	}
+
+	return validRate, true
 }
 
 // PossibleWinsPerSlashWindow returns the total number of possible correct votes
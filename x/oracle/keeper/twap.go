@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cheqd/cheqd-node/x/oracle/types"
+)
+
+// RecordTwapCheckpoint accumulates the time-weighted price of a denom up to the current block time
+// and records its new historic price. The previous price is weighted by the seconds it was held for.
+func (k Keeper) RecordTwapCheckpoint(ctx sdk.Context, denom string, price math.LegacyDec) {
+	cumulative := math.LegacyZeroDec()
+	if latest, found := k.GetLatestTwapCheckpoint(ctx, denom); found {
+		cumulative = latest.CumulativeAt(ctx.BlockTime())
+	}
+
+	k.SetTwapCheckpoint(ctx, denom, types.TwapCheckpoint{
+		Price:      price,
+		Cumulative: cumulative,
+		Height:     ctx.BlockHeight(),
+		Time:       ctx.BlockTime(),
+	})
+}
+
+// SetTwapCheckpoint stores a TWAP checkpoint of a denom
+func (k Keeper) SetTwapCheckpoint(ctx sdk.Context, denom string, checkpoint types.TwapCheckpoint) {
+	bz := k.cdc.MustMarshal(&checkpoint)
+	ctx.KVStore(k.storeKey).Set(types.KeyTwapCheckpoint(denom, checkpoint.Time), bz)
+}
+
+// GetLatestTwapCheckpoint returns the latest TWAP checkpoint of a denom
+func (k Keeper) GetLatestTwapCheckpoint(ctx sdk.Context, denom string) (types.TwapCheckpoint, bool) {
+	prefix := types.KeyTwapCheckpointPrefix(denom)
+	return k.lastTwapCheckpointBefore(ctx, prefix, storetypes.PrefixEndBytes(prefix))
+}
+
+// IterateTwapCheckpoints iterates over the TWAP checkpoints of a denom in time order.
+// Iterator stops when exhausting the source, or when the handler returns `true`.
+func (k Keeper) IterateTwapCheckpoints(ctx sdk.Context, denom string, handler func(types.TwapCheckpoint) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := storetypes.KVStorePrefixIterator(store, types.KeyTwapCheckpointPrefix(denom))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var checkpoint types.TwapCheckpoint
+		k.cdc.MustUnmarshal(iter.Value(), &checkpoint)
+		if handler(checkpoint) {
+			break
+		}
+	}
+}
+
+// CalculateTWAP returns the time-weighted average price of a denom over a lookback duration
+// ending at the current block time. If the checkpoints do not go back as far as the lookback,
+// the average is taken since the first checkpoint. Prices are weighted by time rather than by
+// volume, as exchange rate votes carry no volume.
+func (k Keeper) CalculateTWAP(ctx sdk.Context, denom string, lookback time.Duration) (math.LegacyDec, bool) {
+	latest, found := k.GetLatestTwapCheckpoint(ctx, denom)
+	if !found {
+		return math.LegacyZeroDec(), false
+	}
+
+	now := ctx.BlockTime()
+	start := now.Add(-lookback)
+
+	prefix := types.KeyTwapCheckpointPrefix(denom)
+	first, found := k.lastTwapCheckpointBefore(ctx, prefix, storetypes.PrefixEndBytes(types.KeyTwapCheckpoint(denom, start)))
+	if !found {
+		k.IterateTwapCheckpoints(ctx, denom, func(checkpoint types.TwapCheckpoint) bool {
+			first = checkpoint
+			return true
+		})
+		start = first.Time
+	}
+
+	elapsed := now.Sub(start)
+	if elapsed <= 0 {
+		return latest.Price, true
+	}
+
+	cumulative := latest.CumulativeAt(now).Sub(first.CumulativeAt(start))
+	return cumulative.Quo(types.DurationSeconds(elapsed)), true
+}
+
+// PruneTwapCheckpointsBefore deletes the TWAP checkpoints of a denom at or before a given time, except
+// for the latest of them, which the cumulative price at that time is extrapolated from.
+func (k Keeper) PruneTwapCheckpointsBefore(ctx sdk.Context, denom string, t time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.KeyTwapCheckpointPrefix(denom)
+	iter := store.Iterator(prefix, storetypes.PrefixEndBytes(types.KeyTwapCheckpoint(denom, t)))
+	defer iter.Close()
+
+	keys := [][]byte{}
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+
+	if len(keys) < 2 {
+		return
+	}
+	for _, key := range keys[:len(keys)-1] {
+		store.Delete(key)
+	}
+}
+
+// lastTwapCheckpointBefore returns the last TWAP checkpoint stored under a key in [start, end)
+func (k Keeper) lastTwapCheckpointBefore(ctx sdk.Context, start, end []byte) (types.TwapCheckpoint, bool) {
+	iter := ctx.KVStore(k.storeKey).ReverseIterator(start, end)
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return types.TwapCheckpoint{}, false
+	}
+
+	var checkpoint types.TwapCheckpoint
+	k.cdc.MustUnmarshal(iter.Value(), &checkpoint)
+	return checkpoint, true
+}
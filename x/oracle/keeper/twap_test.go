@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cheqd/cheqd-node/x/oracle/keeper"
+	"github.com/cheqd/cheqd-node/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *IntegrationTestSuite) TestCalculateTWAP() {
+	app, ctx := s.app, s.ctx
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// prices are held for 30, 15 and 15 minutes
+	app.OracleKeeper.RecordTwapCheckpoint(ctx.WithBlockHeight(100).WithBlockTime(start), displayDenom, math.LegacyMustNewDecFromStr("1"))
+	app.OracleKeeper.RecordTwapCheckpoint(ctx.WithBlockHeight(400).WithBlockTime(start.Add(30*time.Minute)), displayDenom, math.LegacyMustNewDecFromStr("2"))
+	app.OracleKeeper.RecordTwapCheckpoint(ctx.WithBlockHeight(550).WithBlockTime(start.Add(45*time.Minute)), displayDenom, math.LegacyMustNewDecFromStr("4"))
+	ctx = ctx.WithBlockHeight(700).WithBlockTime(start.Add(time.Hour))
+
+	latest, found := app.OracleKeeper.GetLatestTwapCheckpoint(ctx, displayDenom)
+	s.Require().True(found)
+	s.Require().Equal(math.LegacyNewDec(3600), latest.Cumulative)
+
+	for lookback, expected := range map[time.Duration]string{
+		time.Hour:        "2",
+		30 * time.Minute: "3",
+		20 * time.Minute: "3.5",
+		// checkpoints do not go back a day, so the average is taken since the first one
+		24 * time.Hour: "2",
+	} {
+		twap, found := app.OracleKeeper.CalculateTWAP(ctx, displayDenom, lookback)
+		s.Require().True(found)
+		s.Require().Equal(math.LegacyMustNewDecFromStr(expected), twap, lookback)
+	}
+
+	_, found = app.OracleKeeper.CalculateTWAP(ctx, displayDenom+"test", time.Hour)
+	s.Require().False(found)
+
+	// the checkpoint the cumulative price is extrapolated from is kept
+	app.OracleKeeper.PruneTwapCheckpointsBefore(ctx, displayDenom, start.Add(40*time.Minute))
+	checkpoints := 0
+	app.OracleKeeper.IterateTwapCheckpoints(ctx, displayDenom, func(types.TwapCheckpoint) bool {
+		checkpoints++
+		return false
+	})
+	s.Require().Equal(2, checkpoints)
+
+	twap, _ := app.OracleKeeper.CalculateTWAP(ctx, displayDenom, 20*time.Minute)
+	s.Require().Equal(math.LegacyMustNewDecFromStr("3.5"), twap)
+}
+
+func (s *IntegrationTestSuite) TestTWAPFeePriceSource() {
+	app, ctx := s.app, s.ctx
+	querier := keeper.NewQuerier(app.OracleKeeper)
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	period := types.DefaultHistoricStampPeriod
+
+	// stamp a price every historic stamp period of 3 minutes
+	for i, price := range []string{"0.01", "0.02", "0.03"} {
+		ctx = ctx.WithBlockHeight(int64(period * uint64(i+1))).WithBlockTime(start.Add(time.Duration(i) * 3 * time.Minute))
+		app.OracleKeeper.AddHistoricPrice(ctx, displayDenom, math.LegacyMustNewDecFromStr(price))
+	}
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(3 * time.Minute)).WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(app.OracleKeeper.ComputeAverages(ctx, displayDenom))
+
+	res, err := querier.TWAP(ctx, &types.QueryTWAPRequest{Denom: displayDenom})
+	s.Require().NoError(err)
+	s.Require().Len(res.Prices, len(types.DefaultTwapLookbacks))
+	for _, price := range res.Prices {
+		s.Require().Equal(math.LegacyMustNewDecFromStr("0.02"), price.Price)
+		s.Require().Equal(ctx.BlockHeight(), price.UpdatedHeight)
+	}
+
+	res, err = querier.TWAP(ctx, &types.QueryTWAPRequest{Denom: displayDenom, Lookback: 24 * time.Hour})
+	s.Require().NoError(err)
+	s.Require().Len(res.Prices, 1)
+	_, err = querier.TWAP(ctx, &types.QueryTWAPRequest{Denom: displayDenom, Lookback: 2 * time.Hour})
+	s.Require().Error(err)
+
+	// fees are converted with the balanced WMA by default
+	wma, _ := app.OracleKeeper.GetWMA(ctx, displayDenom, string(keeper.WmaStrategyBalanced))
+	price, err := app.OracleKeeper.GetFeeConversionPrice(ctx, displayDenom)
+	s.Require().NoError(err)
+	s.Require().Equal(wma, price.Price)
+
+	// and with the TWAP once it is the fee price source
+	app.OracleKeeper.SetFeePriceSource(ctx, types.PriceSource_PRICE_SOURCE_TWAP)
+	price, err = app.OracleKeeper.GetFeeConversionPrice(ctx, displayDenom)
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyMustNewDecFromStr("0.02"), price.Price)
+
+	feePrice, found := app.OracleKeeper.GetFeePriceAverage(ctx, displayDenom)
+	s.Require().True(found)
+	s.Require().Equal(price.Price, feePrice.Value)
+
+	convertRes, err := querier.Convert(ctx, &types.QueryConvertRequest{
+		Amount:       "1000000000ncheq",
+		ToDenom:      types.UsdDenom,
+		PriceSource:  types.PriceSource_PRICE_SOURCE_TWAP,
+		TwapLookback: 7 * 24 * time.Hour,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoin(types.UsdDenom, math.NewIntWithDecimal(2, 16)), convertRes.Amount)
+
+	_, err = querier.Convert(ctx, &types.QueryConvertRequest{
+		Amount:       "1000000000ncheq",
+		ToDenom:      types.UsdDenom,
+		PriceSource:  types.PriceSource_PRICE_SOURCE_TWAP,
+		TwapLookback: 2 * time.Hour,
+	})
+	s.Require().ErrorContains(err, types.ErrNoConversionPrice.Error())
+}
+
+func (s *IntegrationTestSuite) TestMigrate2to3() {
+	app, ctx := s.app, s.ctx
+
+	stampCtx := ctx.WithBlockHeight(100)
+	app.OracleKeeper.SetHistoricPrice(stampCtx, displayDenom, 100, math.LegacyOneDec())
+	app.OracleKeeper.SetFeePriceSource(ctx, types.PriceSource_PRICE_SOURCE_EMA)
+
+	s.Require().NoError(keeper.NewMigrator(&app.OracleKeeper).Migrate2to3(ctx))
+	s.Require().Equal(types.DefaultFeePriceSource, app.OracleKeeper.FeePriceSource(ctx))
+	s.Require().Equal(types.DefaultTwapLookbacks, app.OracleKeeper.TwapLookbacks(ctx))
+	s.Require().Equal(types.DefaultFeeTwapLookback, app.OracleKeeper.FeeTwapLookback(ctx))
+
+	// Historic prices stamped before the upgrade have no block time, so TWAPs start empty
+	_, found := app.OracleKeeper.GetLatestTwapCheckpoint(ctx, displayDenom)
+	s.Require().False(found)
+}
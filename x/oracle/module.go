@@ -51,7 +51,7 @@ func (AppModuleBasic) Name() string {
 }
 
 func (AppModuleBasic) ConsensusVersion() uint64 {
-	return 1
+	return 3
 }
 
 // RegisterInterfaces registers the x/oracle module's interface types.
@@ -144,6 +144,14 @@ func (AppModule) QuerierRoute() string { return types.QuerierRoute }
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQuerier(am.keeper))
+
+	m := keeper.NewMigrator(&am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/oracle from version 1 to 2: %v", err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/oracle from version 2 to 3: %v", err))
+	}
 }
 
 // RegisterInvariants registers the x/oracle module's invariants.
@@ -113,7 +113,7 @@ func init() {
 func init() { proto.RegisterFile("cheqd/oracle/v2/abci.proto", fileDescriptor_32435aac9d9034f6) }
 
 var fileDescriptor_32435aac9d9034f6 = []byte{
-	// 383 bytes of a gzipped FileDescriptorProto
+	// 380 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xcd, 0x8e, 0xd3, 0x30,
 	0x10, 0xc7, 0xe3, 0x5d, 0xb4, 0x87, 0xf0, 0x25, 0xb2, 0x2b, 0xa8, 0xaa, 0x95, 0xb7, 0xda, 0x53,
 	0xb5, 0x68, 0x6d, 0xb6, 0x7d, 0x02, 0x5a, 0x90, 0xe8, 0x09, 0x29, 0x42, 0x1c, 0xb8, 0x84, 0xc4,
@@ -137,7 +137,7 @@ var fileDescriptor_32435aac9d9034f6 = []byte{
 	0x16, 0x7a, 0x89, 0xb3, 0x17, 0xeb, 0x3f, 0x34, 0x58, 0x6f, 0x29, 0xd9, 0x6c, 0x29, 0xf9, 0xbd,
 	0xa5, 0xe4, 0xcb, 0x8e, 0x06, 0x9b, 0x1d, 0x0d, 0x7e, 0xec, 0x68, 0xf0, 0xe6, 0xea, 0xff, 0x49,
 	0xf8, 0xb5, 0xfa, 0xf3, 0x5a, 0x63, 0x0e, 0xbc, 0xe9, 0x77, 0xec, 0x27, 0x92, 0x9d, 0xf8, 0x55,
-	0x4d, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x48, 0x4a, 0x31, 0x38, 0x2d, 0x02, 0x00, 0x00,
+	0x4d, 0xff, 0x0e, 0x00, 0x48, 0x4a, 0x31, 0x38, 0x2d, 0x02, 0x00, 0x00,
 }
 
 func (m *OracleVoteExtension) Marshal() (dAtA []byte, err error) {
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cheqd/cheqd-node/util"
+)
+
+const (
+	// UsdExponent is the number of decimals of the usd denom
+	UsdExponent = uint32(18)
+	// MicroUsdExponent is the number of decimals identity fees are compared in
+	MicroUsdExponent = uint32(util.UsdScaleExponent)
+)
+
+// ConvertAmount converts an amount between two denoms given their USD prices and decimal exponents.
+// The result is truncated to the base unit of the target denom.
+func ConvertAmount(amount sdkmath.Int, fromPrice sdkmath.LegacyDec, fromExponent uint32, toPrice sdkmath.LegacyDec, toExponent uint32) (sdkmath.Int, error) {
+	if !fromPrice.IsPositive() || !toPrice.IsPositive() {
+		return sdkmath.Int{}, fmt.Errorf("cannot convert: price is not positive")
+	}
+
+	// multiply before dividing to keep the precision of small amounts
+	value := sdkmath.LegacyNewDecFromInt(amount).Mul(fromPrice).MulInt(sdkmath.NewIntWithDecimal(1, int(toExponent)))
+	return value.Quo(toPrice.MulInt(sdkmath.NewIntWithDecimal(1, int(fromExponent)))).TruncateInt(), nil
+}
+
+// ConvertPrice returns the price of one unit of a denom in units of another given their USD prices
+func ConvertPrice(fromPrice, toPrice sdkmath.LegacyDec) (sdkmath.LegacyDec, error) {
+	if !toPrice.IsPositive() {
+		return sdkmath.LegacyDec{}, fmt.Errorf("cannot convert: price is not positive")
+	}
+	return fromPrice.Quo(toPrice), nil
+}
+
+// NcheqToMicroUSD converts an ncheq amount to µUSD with a CHEQ/USD price
+func NcheqToMicroUSD(ncheq sdkmath.Int, cheqPrice sdkmath.LegacyDec) (sdkmath.Int, error) {
+	return ConvertAmount(ncheq, cheqPrice, CheqdExponent, sdkmath.LegacyOneDec(), MicroUsdExponent)
+}
+
+// MicroUSDToNcheq converts a µUSD amount to ncheq with a CHEQ/USD price
+func MicroUSDToNcheq(microUsd sdkmath.Int, cheqPrice sdkmath.LegacyDec) (sdkmath.Int, error) {
+	return ConvertAmount(microUsd, sdkmath.LegacyOneDec(), MicroUsdExponent, cheqPrice, CheqdExponent)
+}
+
+// USDToNcheq converts a usd amount (1e18) to ncheq with a CHEQ/USD price
+func USDToNcheq(usd sdkmath.Int, cheqPrice sdkmath.LegacyDec) (sdkmath.Int, error) {
+	return ConvertAmount(usd, sdkmath.LegacyOneDec(), UsdExponent, cheqPrice, CheqdExponent)
+}
+
+// NcheqToUSD converts an ncheq amount to usd (1e18) with a CHEQ/USD price
+func NcheqToUSD(ncheq sdkmath.Int, cheqPrice sdkmath.LegacyDec) (sdkmath.Int, error) {
+	return ConvertAmount(ncheq, cheqPrice, CheqdExponent, sdkmath.LegacyOneDec(), UsdExponent)
+}
+
+// ParsePriceSource parses a price source from its name, with or without the PRICE_SOURCE_ prefix,
+// e.g. "wma_balanced" or "PRICE_SOURCE_WMA_BALANCED". An empty name is the unspecified price source.
+func ParsePriceSource(name string) (PriceSource, error) {
+	if name == "" {
+		return PriceSource_PRICE_SOURCE_UNSPECIFIED, nil
+	}
+
+	name = strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
+	if !strings.HasPrefix(name, "PRICE_SOURCE_") {
+		name = "PRICE_SOURCE_" + name
+	}
+
+	source, ok := PriceSource_value[name]
+	if !ok {
+		return PriceSource_PRICE_SOURCE_UNSPECIFIED, ErrInvalidPriceSource.Wrap(name)
+	}
+	return PriceSource(source), nil
+}
@@ -0,0 +1,56 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cheqd/cheqd-node/x/oracle/types"
+)
+
+func TestConvertAmount(t *testing.T) {
+	price := sdkmath.LegacyMustNewDecFromStr("0.02")
+
+	// 1 usd buys 50 CHEQ at 0.02 USD
+	ncheq, err := types.USDToNcheq(sdkmath.NewIntWithDecimal(1, 18), price)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(50_000_000_000), ncheq)
+
+	usd, err := types.NcheqToUSD(ncheq, price)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewIntWithDecimal(1, 18), usd)
+
+	microUsd, err := types.NcheqToMicroUSD(sdkmath.NewInt(1_234_567_891), price)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(24_691), microUsd)
+
+	ncheq, err = types.MicroUSDToNcheq(microUsd, price)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(1_234_550_000), ncheq)
+
+	// between two priced denoms with different exponents
+	atom, err := types.ConvertAmount(sdkmath.NewInt(1_000_000_000), price, 9, sdkmath.LegacyMustNewDecFromStr("4"), 6)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(5_000), atom)
+
+	_, err = types.ConvertAmount(sdkmath.OneInt(), sdkmath.LegacyZeroDec(), 9, price, 6)
+	require.Error(t, err)
+}
+
+func TestParsePriceSource(t *testing.T) {
+	for name, expected := range map[string]types.PriceSource{
+		"":                          types.PriceSource_PRICE_SOURCE_UNSPECIFIED,
+		"spot":                      types.PriceSource_PRICE_SOURCE_SPOT,
+		"wma-recent":                types.PriceSource_PRICE_SOURCE_WMA_RECENT,
+		"PRICE_SOURCE_WMA_BALANCED": types.PriceSource_PRICE_SOURCE_WMA_BALANCED,
+		"Median":                    types.PriceSource_PRICE_SOURCE_MEDIAN,
+	} {
+		source, err := types.ParsePriceSource(name)
+		require.NoError(t, err, name)
+		require.Equal(t, expected, source, name)
+	}
+
+	_, err := types.ParsePriceSource("vwap")
+	require.Error(t, err)
+}
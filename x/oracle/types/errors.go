@@ -39,4 +39,6 @@ var (
 	ErrNonEqualInjVotesRates = errors.Register(ModuleName, 29, "injected exchange rate votes and generated exchange votes are not equal")                //nolint: lll
 	ErrNoCommitInfo          = errors.Register(ModuleName, 30, "no commit info in process proposal request")
 	ErrInvalidWmaStrategy    = errors.Register(ModuleName, 31, "invalid WMA strategy")
+	ErrNoConversionPrice     = errors.Register(ModuleName, 32, "no price found for conversion")
+	ErrInvalidPriceSource    = errors.Register(ModuleName, 33, "invalid price source")
 )
@@ -6,3 +6,9 @@ const (
 
 	AttributeKeyNotifyPriceFeeder = "notify_price_feeder"
 )
+
+// reasons for the price circuit breaker to trip
+const (
+	CircuitBreakerReasonStalePrice = "stale_price"
+	CircuitBreakerReasonPriceJump  = "price_jump"
+)
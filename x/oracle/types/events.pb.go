@@ -7,6 +7,8 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -107,35 +109,231 @@ func (m *EventSetFxRate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EventSetFxRate proto.InternalMessageInfo
 
+// EventPriceCircuitBreakerTripped is emitted when USD-denominated fee
+// conversion is frozen for a denom
+type EventPriceCircuitBreakerTripped struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// reason the breaker tripped, either "stale_price" or "price_jump"
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Last fee price average of the denom
+	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+	// Block height the last moving averages were computed at
+	PriceHeight int64 `protobuf:"varint,4,opt,name=price_height,json=priceHeight,proto3" json:"price_height,omitempty"`
+}
+
+func (m *EventPriceCircuitBreakerTripped) Reset()         { *m = EventPriceCircuitBreakerTripped{} }
+func (m *EventPriceCircuitBreakerTripped) String() string { return proto.CompactTextString(m) }
+func (*EventPriceCircuitBreakerTripped) ProtoMessage()    {}
+func (*EventPriceCircuitBreakerTripped) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f44978344e15da43, []int{2}
+}
+func (m *EventPriceCircuitBreakerTripped) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPriceCircuitBreakerTripped) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPriceCircuitBreakerTripped.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPriceCircuitBreakerTripped) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPriceCircuitBreakerTripped.Merge(m, src)
+}
+func (m *EventPriceCircuitBreakerTripped) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPriceCircuitBreakerTripped) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPriceCircuitBreakerTripped.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPriceCircuitBreakerTripped proto.InternalMessageInfo
+
+// EventPriceCircuitBreakerReset is emitted when USD-denominated fee
+// conversion resumes for a denom
+type EventPriceCircuitBreakerReset struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Fee price average of the denom
+	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+}
+
+func (m *EventPriceCircuitBreakerReset) Reset()         { *m = EventPriceCircuitBreakerReset{} }
+func (m *EventPriceCircuitBreakerReset) String() string { return proto.CompactTextString(m) }
+func (*EventPriceCircuitBreakerReset) ProtoMessage()    {}
+func (*EventPriceCircuitBreakerReset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f44978344e15da43, []int{3}
+}
+func (m *EventPriceCircuitBreakerReset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPriceCircuitBreakerReset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPriceCircuitBreakerReset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPriceCircuitBreakerReset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPriceCircuitBreakerReset.Merge(m, src)
+}
+func (m *EventPriceCircuitBreakerReset) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPriceCircuitBreakerReset) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPriceCircuitBreakerReset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPriceCircuitBreakerReset proto.InternalMessageInfo
+
+// EventValidatorRewarded is emitted when oracle rewards are allocated to a
+// validator at the end of a vote period
+type EventValidatorRewarded struct {
+	Validator string                                   `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Rewards   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *EventValidatorRewarded) Reset()         { *m = EventValidatorRewarded{} }
+func (m *EventValidatorRewarded) String() string { return proto.CompactTextString(m) }
+func (*EventValidatorRewarded) ProtoMessage()    {}
+func (*EventValidatorRewarded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f44978344e15da43, []int{4}
+}
+func (m *EventValidatorRewarded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventValidatorRewarded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventValidatorRewarded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventValidatorRewarded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventValidatorRewarded.Merge(m, src)
+}
+func (m *EventValidatorRewarded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventValidatorRewarded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventValidatorRewarded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventValidatorRewarded proto.InternalMessageInfo
+
+// EventValidatorSlashed is emitted when a validator is slashed and jailed for
+// missing too many votes over a slash window
+type EventValidatorSlashed struct {
+	Validator       string                      `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	WindowEndHeight uint64                      `protobuf:"varint,2,opt,name=window_end_height,json=windowEndHeight,proto3" json:"window_end_height,omitempty"`
+	MissCount       uint64                      `protobuf:"varint,3,opt,name=miss_count,json=missCount,proto3" json:"miss_count,omitempty"`
+	ValidRate       cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=valid_rate,json=validRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"valid_rate"`
+	SlashFraction   cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=slash_fraction,json=slashFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction"`
+	// Amount of tokens burned
+	SlashedAmount cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=slashed_amount,json=slashedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"slashed_amount"`
+}
+
+func (m *EventValidatorSlashed) Reset()         { *m = EventValidatorSlashed{} }
+func (m *EventValidatorSlashed) String() string { return proto.CompactTextString(m) }
+func (*EventValidatorSlashed) ProtoMessage()    {}
+func (*EventValidatorSlashed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f44978344e15da43, []int{5}
+}
+func (m *EventValidatorSlashed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventValidatorSlashed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventValidatorSlashed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventValidatorSlashed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventValidatorSlashed.Merge(m, src)
+}
+func (m *EventValidatorSlashed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventValidatorSlashed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventValidatorSlashed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventValidatorSlashed proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*EventDelegateFeedConsent)(nil), "cheqd.oracle.v2.EventDelegateFeedConsent")
 	proto.RegisterType((*EventSetFxRate)(nil), "cheqd.oracle.v2.EventSetFxRate")
+	proto.RegisterType((*EventPriceCircuitBreakerTripped)(nil), "cheqd.oracle.v2.EventPriceCircuitBreakerTripped")
+	proto.RegisterType((*EventPriceCircuitBreakerReset)(nil), "cheqd.oracle.v2.EventPriceCircuitBreakerReset")
+	proto.RegisterType((*EventValidatorRewarded)(nil), "cheqd.oracle.v2.EventValidatorRewarded")
+	proto.RegisterType((*EventValidatorSlashed)(nil), "cheqd.oracle.v2.EventValidatorSlashed")
 }
 
 func init() { proto.RegisterFile("cheqd/oracle/v2/events.proto", fileDescriptor_f44978344e15da43) }
 
 var fileDescriptor_f44978344e15da43 = []byte{
-	// 315 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xc1, 0x4e, 0x02, 0x31,
-	0x10, 0x86, 0x77, 0x8d, 0x1a, 0xed, 0x41, 0x93, 0x0d, 0x87, 0x15, 0x4d, 0x31, 0x9c, 0x8c, 0x09,
-	0xdb, 0x88, 0xbe, 0x80, 0x08, 0xc4, 0x83, 0xa7, 0xe5, 0xe6, 0xc5, 0x2c, 0xed, 0xa4, 0x10, 0xd9,
-	0x0e, 0xb6, 0x95, 0xc0, 0x0b, 0x78, 0xf6, 0x61, 0x78, 0x08, 0x8e, 0x84, 0x93, 0xf1, 0x40, 0x14,
-	0x5e, 0xc4, 0xd0, 0x2e, 0x7a, 0xf4, 0xd2, 0x74, 0xe6, 0xff, 0xff, 0x6f, 0x9a, 0x0e, 0x39, 0xe3,
-	0x3d, 0x78, 0x11, 0x0c, 0x75, 0xc6, 0x07, 0xc0, 0x46, 0x75, 0x06, 0x23, 0x50, 0xd6, 0x24, 0x43,
-	0x8d, 0x16, 0xa3, 0x63, 0xa7, 0x26, 0x5e, 0x4d, 0x46, 0xf5, 0xf2, 0x09, 0x47, 0x93, 0xa3, 0x79,
-	0x72, 0x32, 0xf3, 0x85, 0xf7, 0x96, 0x4b, 0x12, 0x25, 0xfa, 0xfe, 0xe6, 0xe6, 0xbb, 0xd5, 0xb7,
-	0x90, 0xc4, 0xad, 0x0d, 0xb2, 0x09, 0x03, 0x90, 0x99, 0x85, 0x36, 0x80, 0xb8, 0x43, 0x65, 0x40,
-	0xd9, 0xe8, 0x86, 0x1c, 0xe0, 0x10, 0x74, 0x66, 0x51, 0xc7, 0xe1, 0x79, 0x78, 0x71, 0xd8, 0x88,
-	0x17, 0xd3, 0x5a, 0xa9, 0xc0, 0xde, 0x0a, 0xa1, 0xc1, 0x98, 0x8e, 0xd5, 0x7d, 0x25, 0xd3, 0x5f,
-	0xe7, 0x26, 0x25, 0x0a, 0x58, 0xbc, 0xf3, 0x5f, 0x6a, 0xeb, 0xac, 0xe6, 0xe4, 0xc8, 0xbd, 0xa3,
-	0x03, 0xb6, 0x3d, 0x4e, 0x33, 0x0b, 0x51, 0x89, 0xec, 0x09, 0x50, 0x98, 0xfb, 0xd1, 0xa9, 0x2f,
-	0xa2, 0x16, 0xd9, 0xd5, 0x7f, 0xe4, 0xab, 0xd9, 0xb2, 0x12, 0x7c, 0x2e, 0x2b, 0xa7, 0x9e, 0x6e,
-	0xc4, 0x73, 0xd2, 0x47, 0x96, 0x67, 0xb6, 0x97, 0x3c, 0x80, 0xcc, 0xf8, 0xa4, 0x09, 0x7c, 0x31,
-	0xad, 0x91, 0x62, 0x78, 0x13, 0x78, 0xea, 0xe2, 0x8d, 0xfb, 0xd9, 0x37, 0x0d, 0x66, 0x2b, 0x1a,
-	0xce, 0x57, 0x34, 0xfc, 0x5a, 0xd1, 0xf0, 0x7d, 0x4d, 0x83, 0xf9, 0x9a, 0x06, 0x1f, 0x6b, 0x1a,
-	0x3c, 0x5e, 0xca, 0xbe, 0xed, 0xbd, 0x76, 0x13, 0x8e, 0x39, 0xf3, 0x0b, 0x70, 0x67, 0x4d, 0xa1,
-	0x00, 0x36, 0xde, 0x6e, 0xc3, 0x4e, 0x86, 0x60, 0xba, 0xfb, 0xee, 0x23, 0xaf, 0x7f, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0x29, 0x7e, 0x71, 0x86, 0xaa, 0x01, 0x00, 0x00,
+	// 649 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0xc7, 0xbb, 0xb4, 0xf0, 0xfb, 0x31, 0x28, 0xc4, 0x0d, 0x90, 0x05, 0x65, 0x0b, 0x3d, 0x35,
+	0x98, 0xee, 0x0a, 0x7a, 0x37, 0xb4, 0x80, 0x90, 0x78, 0x20, 0x8b, 0x31, 0xc6, 0xcb, 0x66, 0x3a,
+	0xf3, 0xb8, 0x9d, 0xd0, 0x9d, 0xa9, 0x33, 0x43, 0x81, 0x8b, 0x47, 0xcf, 0xbe, 0x07, 0x6f, 0x9e,
+	0xb9, 0x79, 0xf1, 0xc8, 0x91, 0x70, 0x32, 0x1e, 0x50, 0xe1, 0x8d, 0x98, 0x9d, 0x99, 0xf2, 0x27,
+	0x86, 0x98, 0xa0, 0x97, 0x76, 0xe7, 0x79, 0xf6, 0xfb, 0x79, 0xbe, 0x4f, 0x9f, 0xa7, 0x83, 0x1e,
+	0x90, 0x0e, 0xbc, 0xa5, 0xb1, 0x90, 0x98, 0x74, 0x21, 0xee, 0x2f, 0xc7, 0xd0, 0x07, 0xae, 0x55,
+	0xd4, 0x93, 0x42, 0x0b, 0x7f, 0xc2, 0x64, 0x23, 0x9b, 0x8d, 0xfa, 0xcb, 0xb3, 0x21, 0x11, 0x2a,
+	0x17, 0x2a, 0x6e, 0x63, 0x05, 0x71, 0x7f, 0xa9, 0x0d, 0x1a, 0x2f, 0xc5, 0x44, 0x30, 0x6e, 0x05,
+	0xb3, 0x33, 0x36, 0x9f, 0x9a, 0x53, 0x6c, 0x0f, 0x2e, 0x35, 0x99, 0x89, 0x4c, 0xd8, 0x78, 0xf1,
+	0x64, 0xa3, 0xb5, 0xf7, 0x1e, 0x0a, 0xd6, 0x8a, 0x92, 0xab, 0xd0, 0x85, 0x0c, 0x6b, 0x58, 0x07,
+	0xa0, 0x2d, 0xc1, 0x15, 0x70, 0xed, 0x3f, 0x41, 0xff, 0x8b, 0x1e, 0x48, 0xac, 0x85, 0x0c, 0xbc,
+	0x79, 0xaf, 0x3e, 0xda, 0x0c, 0x4e, 0x0e, 0x1b, 0x93, 0x0e, 0xbb, 0x42, 0xa9, 0x04, 0xa5, 0xb6,
+	0xb5, 0x64, 0x3c, 0x4b, 0x2e, 0xde, 0x2c, 0x54, 0xd4, 0xc1, 0x82, 0xa1, 0x3f, 0xa9, 0x06, 0x6f,
+	0xd6, 0x72, 0x34, 0x6e, 0x7c, 0x6c, 0x83, 0x5e, 0xdf, 0x4f, 0xb0, 0x06, 0x7f, 0x12, 0x0d, 0x53,
+	0xe0, 0x22, 0xb7, 0xa5, 0x13, 0x7b, 0xf0, 0xd7, 0x50, 0x45, 0x5e, 0x92, 0x97, 0x8e, 0x4e, 0xab,
+	0xa5, 0x6f, 0xa7, 0xd5, 0xfb, 0x96, 0xae, 0xe8, 0x4e, 0xc4, 0x44, 0x9c, 0x63, 0xdd, 0x89, 0x9e,
+	0x43, 0x86, 0xc9, 0xc1, 0x2a, 0x90, 0x93, 0xc3, 0x06, 0x72, 0xc5, 0x57, 0x81, 0x24, 0x46, 0x5e,
+	0xfb, 0xec, 0xa1, 0xaa, 0xa9, 0xb7, 0x25, 0x19, 0x81, 0x16, 0x93, 0x64, 0x97, 0xe9, 0xa6, 0x04,
+	0xbc, 0x03, 0xf2, 0x85, 0x64, 0xbd, 0x1e, 0xd0, 0x1b, 0x0c, 0x4c, 0xa3, 0x11, 0x09, 0x58, 0x09,
+	0x6e, 0x2d, 0x24, 0xee, 0xe4, 0x3f, 0x43, 0xc3, 0xbd, 0x82, 0x15, 0x94, 0x6f, 0xeb, 0xcc, 0xea,
+	0xfd, 0x05, 0x74, 0xc7, 0x3c, 0xa4, 0x1d, 0x60, 0x59, 0x47, 0x07, 0x95, 0x79, 0xaf, 0x5e, 0x4e,
+	0xc6, 0x4c, 0x6c, 0xc3, 0x84, 0x6a, 0xef, 0xd0, 0xdc, 0x4d, 0xe6, 0x13, 0x50, 0xa0, 0x6f, 0xb0,
+	0x7e, 0x61, 0x71, 0xe8, 0xef, 0x2c, 0xd6, 0xbe, 0x78, 0x68, 0xda, 0x18, 0x78, 0x89, 0xbb, 0x8c,
+	0x16, 0x53, 0x4f, 0x60, 0x0f, 0x4b, 0x0a, 0xd4, 0x7f, 0x8a, 0x46, 0xfb, 0x83, 0xa0, 0x5b, 0x9a,
+	0x85, 0x93, 0xc3, 0xc6, 0x9c, 0x83, 0x5c, 0x08, 0xae, 0xef, 0xc1, 0xa5, 0xc6, 0x07, 0xf4, 0x9f,
+	0x34, 0x30, 0x15, 0x0c, 0xcd, 0x97, 0xeb, 0x63, 0xcb, 0x33, 0x91, 0xd3, 0x16, 0x4b, 0x1f, 0xb9,
+	0xa5, 0x8f, 0x5a, 0x82, 0xf1, 0xe6, 0xa3, 0xa2, 0x83, 0x4f, 0xdf, 0xab, 0xf5, 0x8c, 0xe9, 0xce,
+	0x6e, 0x3b, 0x22, 0x22, 0x77, 0x4b, 0xef, 0xbe, 0x1a, 0x8a, 0xee, 0xc4, 0xfa, 0xa0, 0x07, 0xca,
+	0x08, 0x54, 0x32, 0x60, 0xd7, 0x3e, 0x96, 0xd1, 0xd4, 0xf5, 0x16, 0xb6, 0xbb, 0x58, 0x75, 0xfe,
+	0x45, 0x07, 0x8b, 0xe8, 0xde, 0x1e, 0xe3, 0x54, 0xec, 0xa5, 0xc0, 0xe9, 0x60, 0x8a, 0xc5, 0x4f,
+	0x5e, 0x49, 0x26, 0x6c, 0x62, 0x8d, 0x53, 0x3b, 0x49, 0x7f, 0x0e, 0xa1, 0x9c, 0x29, 0x95, 0x12,
+	0xb1, 0xcb, 0xb5, 0x59, 0x9d, 0x4a, 0x32, 0x5a, 0x44, 0x5a, 0x45, 0xc0, 0xdf, 0x42, 0xc8, 0x70,
+	0x53, 0xb3, 0xf3, 0x95, 0xdb, 0x8e, 0xcd, 0x9a, 0x33, 0xff, 0xaa, 0x57, 0x68, 0x5c, 0x15, 0x8d,
+	0xa6, 0x6f, 0x24, 0x26, 0x9a, 0x09, 0x1e, 0x0c, 0xdf, 0x96, 0x7a, 0xd7, 0x80, 0xd6, 0x1d, 0xc7,
+	0x4f, 0x1c, 0x19, 0x68, 0x8a, 0x73, 0xd3, 0xce, 0x88, 0x21, 0x3f, 0x74, 0xe4, 0xa9, 0xdf, 0xc9,
+	0x9b, 0x5c, 0x5f, 0x61, 0x6e, 0x72, 0xed, 0x98, 0x40, 0x57, 0x0c, 0xa1, 0xb9, 0x71, 0xf4, 0x33,
+	0x2c, 0x1d, 0x9d, 0x85, 0xde, 0xf1, 0x59, 0xe8, 0xfd, 0x38, 0x0b, 0xbd, 0x0f, 0xe7, 0x61, 0xe9,
+	0xf8, 0x3c, 0x2c, 0x7d, 0x3d, 0x0f, 0x4b, 0xaf, 0x17, 0xaf, 0x8e, 0xdd, 0xdc, 0xa3, 0xe6, 0xb3,
+	0xc1, 0x05, 0x85, 0x78, 0x7f, 0x70, 0xa9, 0x9a, 0xf1, 0xb7, 0x47, 0xcc, 0x7d, 0xf7, 0xf8, 0xd7,
+	0x00, 0xfc, 0x37, 0x37, 0xf7, 0x71, 0x05, 0x00, 0x00,
 }
 
 func (m *EventDelegateFeedConsent) Marshal() (dAtA []byte, err error) {
@@ -215,6 +413,212 @@ func (m *EventSetFxRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventPriceCircuitBreakerTripped) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPriceCircuitBreakerTripped) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPriceCircuitBreakerTripped) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PriceHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PriceHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPriceCircuitBreakerReset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPriceCircuitBreakerReset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPriceCircuitBreakerReset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventValidatorRewarded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventValidatorRewarded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventValidatorRewarded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventValidatorSlashed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventValidatorSlashed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventValidatorSlashed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SlashedAmount.Size()
+		i -= size
+		if _, err := m.SlashedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ValidRate.Size()
+		i -= size
+		if _, err := m.ValidRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.MissCount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MissCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WindowEndHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.WindowEndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -240,31 +644,509 @@ func (m *EventDelegateFeedConsent) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *EventSetFxRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventPriceCircuitBreakerTripped) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.PriceHeight != 0 {
+		n += 1 + sovEvents(uint64(m.PriceHeight))
+	}
+	return n
+}
+
+func (m *EventPriceCircuitBreakerReset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventValidatorRewarded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventValidatorSlashed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.WindowEndHeight != 0 {
+		n += 1 + sovEvents(uint64(m.WindowEndHeight))
+	}
+	if m.MissCount != 0 {
+		n += 1 + sovEvents(uint64(m.MissCount))
+	}
+	l = m.ValidRate.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.SlashedAmount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventDelegateFeedConsent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDelegateFeedConsent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDelegateFeedConsent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSetFxRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSetFxRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSetFxRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPriceCircuitBreakerTripped) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPriceCircuitBreakerTripped: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPriceCircuitBreakerTripped: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHeight", wireType)
+			}
+			m.PriceHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *EventSetFxRate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Rate.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventDelegateFeedConsent) Unmarshal(dAtA []byte) error {
+func (m *EventPriceCircuitBreakerReset) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -287,15 +1169,15 @@ func (m *EventDelegateFeedConsent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDelegateFeedConsent: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPriceCircuitBreakerReset: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDelegateFeedConsent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPriceCircuitBreakerReset: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -323,11 +1205,11 @@ func (m *EventDelegateFeedConsent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -355,7 +1237,9 @@ func (m *EventDelegateFeedConsent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegate = string(dAtA[iNdEx:postIndex])
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -378,7 +1262,7 @@ func (m *EventDelegateFeedConsent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSetFxRate) Unmarshal(dAtA []byte) error {
+func (m *EventValidatorRewarded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -401,15 +1285,15 @@ func (m *EventSetFxRate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSetFxRate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventValidatorRewarded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSetFxRate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventValidatorRewarded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -437,11 +1321,95 @@ func (m *EventSetFxRate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventValidatorSlashed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventValidatorSlashed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventValidatorSlashed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -469,7 +1437,145 @@ func (m *EventSetFxRate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowEndHeight", wireType)
+			}
+			m.WindowEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowEndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissCount", wireType)
+			}
+			m.MissCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
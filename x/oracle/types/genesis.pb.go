@@ -161,7 +161,7 @@ func init() {
 func init() { proto.RegisterFile("cheqd/oracle/v2/genesis.proto", fileDescriptor_ee6515e43afad623) }
 
 var fileDescriptor_ee6515e43afad623 = []byte{
-	// 576 bytes of a gzipped FileDescriptorProto
+	// 573 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcb, 0x6a, 0xdb, 0x4c,
 	0x14, 0xb6, 0x92, 0xfc, 0xce, 0x9f, 0x71, 0xae, 0x43, 0xa1, 0x22, 0x4d, 0x94, 0x0b, 0x14, 0x42,
 	0x42, 0x24, 0xe2, 0xd0, 0x55, 0x57, 0xb9, 0xb4, 0x29, 0x85, 0x96, 0xe0, 0x40, 0x16, 0x85, 0x22,
@@ -197,7 +197,7 @@ var fileDescriptor_ee6515e43afad623 = []byte{
 	0xf4, 0x55, 0xf7, 0xb7, 0x53, 0xe9, 0xf6, 0x1c, 0xeb, 0xae, 0xe7, 0x58, 0xbf, 0x7a, 0x8e, 0xf5,
 	0xa5, 0xef, 0x54, 0xee, 0xfa, 0x4e, 0xe5, 0x7b, 0xdf, 0xa9, 0xbc, 0xdb, 0x1f, 0x3d, 0x35, 0xea,
 	0xca, 0x51, 0xbf, 0x87, 0x89, 0x08, 0xc1, 0xeb, 0x94, 0xf7, 0x8f, 0x3a, 0x3d, 0xcd, 0xaa, 0xba,
-	0x46, 0x8e, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x3c, 0x29, 0x52, 0xf3, 0xcc, 0x04, 0x00, 0x00,
+	0x46, 0x8e, 0xff, 0x0c, 0x00, 0x3c, 0x29, 0x52, 0xf3, 0xcc, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -74,7 +74,7 @@ func TestGetGenesisStateFromAppState(t *testing.T) {
 	}
 	encodingConfig := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, staking.AppModuleBasic{})
 
-	bz, err := json.Marshal(emptyGenesis)
+	bz, err := encodingConfig.Codec.MarshalJSON(&emptyGenesis)
 	require.Nil(t, err)
 
 	require.NotNil(t, GetGenesisStateFromAppState(encodingConfig.Codec, map[string]json.RawMessage{
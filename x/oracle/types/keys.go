@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/binary"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -36,6 +37,12 @@ var (
 	KeyPrefixValidatorRewardSet           = []byte{0x09} // prefix for each key to a validator reward set
 	KeyPrefixParamUpdatePlan              = []byte{0x10} // prefix for each key to a param update plan
 	KeyPrefixLastHistoricPriceBlock       = []byte{0x11} // prefix for last block number per denom
+	KeyPrefixPriceCircuitBreaker          = []byte{0x12} // prefix for each key to a price circuit breaker
+	KeyPrefixStampTime                    = []byte{0x13} // prefix for each key to the block time of a price stamp
+	KeyPrefixTwapCheckpoint               = []byte{0x14} // prefix for each key to a TWAP checkpoint
+	KeyPrefixValidatorWindowReward        = []byte{0x15} // prefix for each key to the oracle rewards of a validator in the current slash window
+	KeyPrefixValidatorOracleHistory       = []byte{0x16} // prefix for each key to the oracle performance of a validator over a past slash window
+	KeyPrefixSlashWindowSummary           = []byte{0x17} // prefix for each key to the summary of a past slash window
 
 )
 
@@ -105,3 +112,45 @@ func ParseDenomAndBlockFromKey(key []byte, prefix []byte) (string, uint64) {
 func KeyLastHistoricPriceBlock(denom string) []byte {
 	return util.ConcatBytes(0, KeyPrefixLastHistoricPriceBlock, []byte(denom))
 }
+
+// KeyPriceCircuitBreaker - stored by *denom*
+func KeyPriceCircuitBreaker(denom string) []byte {
+	return util.ConcatBytes(0, KeyPrefixPriceCircuitBreaker, []byte(denom))
+}
+
+// KeyStampTime - stored by *block*, big endian so that stamp times iterate in block order
+func KeyStampTime(blockNum uint64) []byte {
+	return util.ConcatBytes(0, KeyPrefixStampTime, sdk.Uint64ToBigEndian(blockNum))
+}
+
+// KeyTwapCheckpoint - stored by *denom* and *time*, so that the checkpoints of a denom iterate in time order
+func KeyTwapCheckpoint(denom string, t time.Time) []byte {
+	return util.ConcatBytes(0, KeyTwapCheckpointPrefix(denom), sdk.FormatTimeBytes(t))
+}
+
+// KeyTwapCheckpointPrefix - prefix of the TWAP checkpoints of a *denom*
+func KeyTwapCheckpointPrefix(denom string) []byte {
+	// make sure we have one zero byte to correctly separate denoms
+	return util.ConcatBytes(1, KeyPrefixTwapCheckpoint, []byte(denom))
+}
+
+// KeyValidatorWindowReward - stored by *Validator* address
+func KeyValidatorWindowReward(v sdk.ValAddress) []byte {
+	return util.ConcatBytes(0, KeyPrefixValidatorWindowReward, address.MustLengthPrefix(v))
+}
+
+// KeyValidatorOracleHistoryPrefix - prefix of the oracle history of a *Validator* address
+func KeyValidatorOracleHistoryPrefix(v sdk.ValAddress) []byte {
+	return util.ConcatBytes(0, KeyPrefixValidatorOracleHistory, address.MustLengthPrefix(v))
+}
+
+// KeyValidatorOracleHistory - stored by *Validator* address and *window end height*, big endian so that
+// the history of a validator iterates in window order
+func KeyValidatorOracleHistory(v sdk.ValAddress, windowEndHeight uint64) []byte {
+	return util.ConcatBytes(0, KeyValidatorOracleHistoryPrefix(v), sdk.Uint64ToBigEndian(windowEndHeight))
+}
+
+// KeySlashWindowSummary - stored by *window end height*, big endian so that summaries iterate in window order
+func KeySlashWindowSummary(windowEndHeight uint64) []byte {
+	return util.ConcatBytes(0, KeyPrefixSlashWindowSummary, sdk.Uint64ToBigEndian(windowEndHeight))
+}
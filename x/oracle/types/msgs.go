@@ -272,6 +272,31 @@ func (msg MsgLegacyGovUpdateParams) ValidateBasic() error {
 				return err
 			}
 
+		case string(KeyMaxPriceAge):
+			if err := validateMaxPriceAge(msg.Changes.MaxPriceAge); err != nil {
+				return err
+			}
+
+		case string(KeyMaxPriceJump):
+			if err := validateMaxPriceJump(msg.Changes.MaxPriceJump); err != nil {
+				return err
+			}
+
+		case string(KeyTwapLookbacks):
+			if err := validateTwapLookbacks(msg.Changes.TwapLookbacks); err != nil {
+				return err
+			}
+
+		case string(KeyFeePriceSource):
+			if err := validateFeePriceSource(msg.Changes.FeePriceSource); err != nil {
+				return err
+			}
+
+		case string(KeyFeeTwapLookback):
+			if err := validateTwapLookback(msg.Changes.FeeTwapLookback); err != nil {
+				return err
+			}
+
 		default:
 			return fmt.Errorf("%s is not an existing oracle param key", key)
 		}
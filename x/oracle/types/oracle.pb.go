@@ -6,19 +6,24 @@ package types
 import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	_ "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,6 +31,66 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PriceSource defines the price a conversion is computed with
+type PriceSource int32
+
+const (
+	// PRICE_SOURCE_UNSPECIFIED defaults to the price source identity fees are converted with
+	PriceSource_PRICE_SOURCE_UNSPECIFIED PriceSource = 0
+	// PRICE_SOURCE_SPOT uses the exchange rate of the last vote period
+	PriceSource_PRICE_SOURCE_SPOT PriceSource = 1
+	// PRICE_SOURCE_SMA uses the simple moving average
+	PriceSource_PRICE_SOURCE_SMA PriceSource = 2
+	// PRICE_SOURCE_EMA uses the exponential moving average
+	PriceSource_PRICE_SOURCE_EMA PriceSource = 3
+	// PRICE_SOURCE_WMA_BALANCED uses the weighted moving average with balanced weights
+	PriceSource_PRICE_SOURCE_WMA_BALANCED PriceSource = 4
+	// PRICE_SOURCE_WMA_RECENT uses the weighted moving average favouring recent prices
+	PriceSource_PRICE_SOURCE_WMA_RECENT PriceSource = 5
+	// PRICE_SOURCE_WMA_OLDEST uses the weighted moving average favouring older prices
+	PriceSource_PRICE_SOURCE_WMA_OLDEST PriceSource = 6
+	// PRICE_SOURCE_WMA_CUSTOM uses the weighted moving average with custom weights
+	PriceSource_PRICE_SOURCE_WMA_CUSTOM PriceSource = 7
+	// PRICE_SOURCE_MEDIAN uses the median of the historic medians
+	PriceSource_PRICE_SOURCE_MEDIAN PriceSource = 8
+	// PRICE_SOURCE_TWAP uses the time-weighted average price over a lookback duration
+	PriceSource_PRICE_SOURCE_TWAP PriceSource = 9
+)
+
+var PriceSource_name = map[int32]string{
+	0: "PRICE_SOURCE_UNSPECIFIED",
+	1: "PRICE_SOURCE_SPOT",
+	2: "PRICE_SOURCE_SMA",
+	3: "PRICE_SOURCE_EMA",
+	4: "PRICE_SOURCE_WMA_BALANCED",
+	5: "PRICE_SOURCE_WMA_RECENT",
+	6: "PRICE_SOURCE_WMA_OLDEST",
+	7: "PRICE_SOURCE_WMA_CUSTOM",
+	8: "PRICE_SOURCE_MEDIAN",
+	9: "PRICE_SOURCE_TWAP",
+}
+
+var PriceSource_value = map[string]int32{
+	"PRICE_SOURCE_UNSPECIFIED":  0,
+	"PRICE_SOURCE_SPOT":         1,
+	"PRICE_SOURCE_SMA":          2,
+	"PRICE_SOURCE_EMA":          3,
+	"PRICE_SOURCE_WMA_BALANCED": 4,
+	"PRICE_SOURCE_WMA_RECENT":   5,
+	"PRICE_SOURCE_WMA_OLDEST":   6,
+	"PRICE_SOURCE_WMA_CUSTOM":   7,
+	"PRICE_SOURCE_MEDIAN":       8,
+	"PRICE_SOURCE_TWAP":         9,
+}
+
+func (x PriceSource) String() string {
+	return proto.EnumName(PriceSource_name, int32(x))
+}
+
+func (PriceSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_50c28bc8f22390bf, []int{0}
+}
+
 // Params defines the parameters for the oracle module.
 type Params struct {
 	VotePeriod               uint64                      `protobuf:"varint,1,opt,name=vote_period,json=votePeriod,proto3" json:"vote_period,omitempty" yaml:"vote_period"`
@@ -60,6 +125,23 @@ type Params struct {
 	UsdcIbcDenom    string `protobuf:"bytes,16,opt,name=usdc_ibc_denom,json=usdcIbcDenom,proto3" json:"usdc_ibc_denom,omitempty"`
 	SlashingEnabled bool   `protobuf:"varint,17,opt,name=slashing_enabled,json=slashingEnabled,proto3" json:"slashing_enabled,omitempty"`
 	AveragingWindow uint64 `protobuf:"varint,18,opt,name=averaging_window,json=averagingWindow,proto3" json:"averaging_window,omitempty"`
+	// Max Price Age represents the amount of blocks after which the moving
+	// averages of a denom are considered stale and USD-denominated fee
+	// conversion is frozen. Zero disables the staleness guard.
+	MaxPriceAge uint64 `protobuf:"varint,19,opt,name=max_price_age,json=maxPriceAge,proto3" json:"max_price_age,omitempty"`
+	// Max Price Jump represents the maximum relative change of the fee price
+	// average of a denom between two averaging periods before USD-denominated fee
+	// conversion is frozen. Zero disables the price jump guard.
+	MaxPriceJump cosmossdk_io_math.LegacyDec `protobuf:"bytes,20,opt,name=max_price_jump,json=maxPriceJump,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_price_jump"`
+	// TWAP Lookbacks represents the durations the time-weighted average prices
+	// of each denom are computed over, alongside the other moving averages.
+	TwapLookbacks []time.Duration `protobuf:"bytes,21,rep,name=twap_lookbacks,json=twapLookbacks,proto3,stdduration" json:"twap_lookbacks"`
+	// Fee Price Source represents the moving average identity fees are
+	// converted between ncheq and USD with.
+	FeePriceSource PriceSource `protobuf:"varint,22,opt,name=fee_price_source,json=feePriceSource,proto3,enum=cheqd.oracle.v2.PriceSource" json:"fee_price_source,omitempty"`
+	// Fee TWAP Lookback represents the lookback duration of the time-weighted
+	// average price identity fees are converted with, if it is the fee price source.
+	FeeTwapLookback time.Duration `protobuf:"bytes,23,opt,name=fee_twap_lookback,json=feeTwapLookback,proto3,stdduration" json:"fee_twap_lookback"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -94,6 +176,48 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// TwapCheckpoint defines the cumulative price of a denom, in price-seconds,
+// at the time of one of its historic price stamps.
+type TwapCheckpoint struct {
+	Price      cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+	Cumulative cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=cumulative,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"cumulative"`
+	Height     int64                       `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Time       time.Time                   `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *TwapCheckpoint) Reset()         { *m = TwapCheckpoint{} }
+func (m *TwapCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*TwapCheckpoint) ProtoMessage()    {}
+func (*TwapCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50c28bc8f22390bf, []int{1}
+}
+func (m *TwapCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwapCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TwapCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapCheckpoint.Merge(m, src)
+}
+func (m *TwapCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwapCheckpoint proto.InternalMessageInfo
+
 // Denom - the object to hold configurations of each denom
 type Denom struct {
 	BaseDenom   string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty" yaml:"base_denom"`
@@ -104,7 +228,7 @@ type Denom struct {
 func (m *Denom) Reset()      { *m = Denom{} }
 func (*Denom) ProtoMessage() {}
 func (*Denom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_50c28bc8f22390bf, []int{1}
+	return fileDescriptor_50c28bc8f22390bf, []int{2}
 }
 func (m *Denom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -142,7 +266,7 @@ type RewardBand struct {
 func (m *RewardBand) Reset()      { *m = RewardBand{} }
 func (*RewardBand) ProtoMessage() {}
 func (*RewardBand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_50c28bc8f22390bf, []int{2}
+	return fileDescriptor_50c28bc8f22390bf, []int{3}
 }
 func (m *RewardBand) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -185,7 +309,7 @@ type AggregateExchangeRatePrevote struct {
 func (m *AggregateExchangeRatePrevote) Reset()      { *m = AggregateExchangeRatePrevote{} }
 func (*AggregateExchangeRatePrevote) ProtoMessage() {}
 func (*AggregateExchangeRatePrevote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_50c28bc8f22390bf, []int{3}
+	return fileDescriptor_50c28bc8f22390bf, []int{4}
 }
 func (m *AggregateExchangeRatePrevote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -224,7 +348,7 @@ type AggregateExchangeRateVote struct {
 func (m *AggregateExchangeRateVote) Reset()      { *m = AggregateExchangeRateVote{} }
 func (*AggregateExchangeRateVote) ProtoMessage() {}
 func (*AggregateExchangeRateVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_50c28bc8f22390bf, []int{4}
+	return fileDescriptor_50c28bc8f22390bf, []int{5}
 }
 func (m *AggregateExchangeRateVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -256,15 +380,15 @@ var xxx_messageInfo_AggregateExchangeRateVote proto.InternalMessageInfo
 // PriceStamp defines a stamp of a denom's exchange rate
 // at the block number it was calculated in.
 type PriceStamp struct {
-	ExchangeRate *types.DecCoin `protobuf:"bytes,1,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
-	BlockNum     uint64         `protobuf:"varint,2,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	ExchangeRate *types1.DecCoin `protobuf:"bytes,1,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	BlockNum     uint64          `protobuf:"varint,2,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
 }
 
 func (m *PriceStamp) Reset()         { *m = PriceStamp{} }
 func (m *PriceStamp) String() string { return proto.CompactTextString(m) }
 func (*PriceStamp) ProtoMessage()    {}
 func (*PriceStamp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_50c28bc8f22390bf, []int{5}
+	return fileDescriptor_50c28bc8f22390bf, []int{6}
 }
 func (m *PriceStamp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -304,7 +428,7 @@ func (m *ValidatorRewardSet) Reset()         { *m = ValidatorRewardSet{} }
 func (m *ValidatorRewardSet) String() string { return proto.CompactTextString(m) }
 func (*ValidatorRewardSet) ProtoMessage()    {}
 func (*ValidatorRewardSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_50c28bc8f22390bf, []int{6}
+	return fileDescriptor_50c28bc8f22390bf, []int{7}
 }
 func (m *ValidatorRewardSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -345,7 +469,7 @@ type CurrencyPairProviders struct {
 func (m *CurrencyPairProviders) Reset()      { *m = CurrencyPairProviders{} }
 func (*CurrencyPairProviders) ProtoMessage() {}
 func (*CurrencyPairProviders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_50c28bc8f22390bf, []int{7}
+	return fileDescriptor_50c28bc8f22390bf, []int{8}
 }
 func (m *CurrencyPairProviders) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -385,7 +509,7 @@ func (m *PairAddressProvider) Reset()         { *m = PairAddressProvider{} }
 func (m *PairAddressProvider) String() string { return proto.CompactTextString(m) }
 func (*PairAddressProvider) ProtoMessage()    {}
 func (*PairAddressProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_50c28bc8f22390bf, []int{8}
+	return fileDescriptor_50c28bc8f22390bf, []int{9}
 }
 func (m *PairAddressProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -424,7 +548,7 @@ type CurrencyDeviationThreshold struct {
 func (m *CurrencyDeviationThreshold) Reset()      { *m = CurrencyDeviationThreshold{} }
 func (*CurrencyDeviationThreshold) ProtoMessage() {}
 func (*CurrencyDeviationThreshold) Descriptor() ([]byte, []int) {
-	return fileDescriptor_50c28bc8f22390bf, []int{9}
+	return fileDescriptor_50c28bc8f22390bf, []int{10}
 }
 func (m *CurrencyDeviationThreshold) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -467,7 +591,7 @@ type ParamUpdatePlan struct {
 func (m *ParamUpdatePlan) Reset()      { *m = ParamUpdatePlan{} }
 func (*ParamUpdatePlan) ProtoMessage() {}
 func (*ParamUpdatePlan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_50c28bc8f22390bf, []int{10}
+	return fileDescriptor_50c28bc8f22390bf, []int{11}
 }
 func (m *ParamUpdatePlan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -496,8 +620,196 @@ func (m *ParamUpdatePlan) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ParamUpdatePlan proto.InternalMessageInfo
 
+// PriceAverage defines a moving average of a denom's price along with the
+// block it was last computed in.
+type PriceAverage struct {
+	Value         cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=value,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"value"`
+	UpdatedHeight int64                       `protobuf:"varint,2,opt,name=updated_height,json=updatedHeight,proto3" json:"updated_height,omitempty"`
+	UpdatedTime   time.Time                   `protobuf:"bytes,3,opt,name=updated_time,json=updatedTime,proto3,stdtime" json:"updated_time"`
+}
+
+func (m *PriceAverage) Reset()         { *m = PriceAverage{} }
+func (m *PriceAverage) String() string { return proto.CompactTextString(m) }
+func (*PriceAverage) ProtoMessage()    {}
+func (*PriceAverage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50c28bc8f22390bf, []int{12}
+}
+func (m *PriceAverage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceAverage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceAverage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceAverage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceAverage.Merge(m, src)
+}
+func (m *PriceAverage) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceAverage) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceAverage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceAverage proto.InternalMessageInfo
+
+// PriceCircuitBreaker defines the state of the circuit breaker freezing
+// USD-denominated fee conversion for a denom.
+type PriceCircuitBreaker struct {
+	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Tripped bool   `protobuf:"varint,2,opt,name=tripped,proto3" json:"tripped,omitempty"`
+	// reason the breaker tripped, either "stale_price" or "price_jump"
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// height and time the breaker last tripped or reset at
+	Height int64     `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Time   time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *PriceCircuitBreaker) Reset()         { *m = PriceCircuitBreaker{} }
+func (m *PriceCircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*PriceCircuitBreaker) ProtoMessage()    {}
+func (*PriceCircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50c28bc8f22390bf, []int{13}
+}
+func (m *PriceCircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceCircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceCircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceCircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceCircuitBreaker.Merge(m, src)
+}
+func (m *PriceCircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceCircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceCircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceCircuitBreaker proto.InternalMessageInfo
+
+// ValidatorOracleWindow defines the oracle performance of a validator over a
+// slash window, and whether it was slashed at the end of it.
+type ValidatorOracleWindow struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// Last block of the slash window
+	WindowEndHeight uint64 `protobuf:"varint,2,opt,name=window_end_height,json=windowEndHeight,proto3" json:"window_end_height,omitempty"`
+	// Number of votes missed over the slash window
+	MissCount uint64 `protobuf:"varint,3,opt,name=miss_count,json=missCount,proto3" json:"miss_count,omitempty"`
+	// Number of votes which could be won over the slash window
+	PossibleWins int64 `protobuf:"varint,4,opt,name=possible_wins,json=possibleWins,proto3" json:"possible_wins,omitempty"`
+	// Share of the possible wins which were not missed
+	ValidRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=valid_rate,json=validRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"valid_rate"`
+	// Oracle rewards allocated to the validator over the slash window
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+	// Whether the validator was slashed and jailed at the end of the slash window
+	Slashed bool `protobuf:"varint,7,opt,name=slashed,proto3" json:"slashed,omitempty"`
+}
+
+func (m *ValidatorOracleWindow) Reset()         { *m = ValidatorOracleWindow{} }
+func (m *ValidatorOracleWindow) String() string { return proto.CompactTextString(m) }
+func (*ValidatorOracleWindow) ProtoMessage()    {}
+func (*ValidatorOracleWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50c28bc8f22390bf, []int{14}
+}
+func (m *ValidatorOracleWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorOracleWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorOracleWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorOracleWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorOracleWindow.Merge(m, src)
+}
+func (m *ValidatorOracleWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorOracleWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorOracleWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorOracleWindow proto.InternalMessageInfo
+
+// SlashWindowSummary defines the outcome of a slash window across all validators.
+type SlashWindowSummary struct {
+	WindowStartHeight uint64 `protobuf:"varint,1,opt,name=window_start_height,json=windowStartHeight,proto3" json:"window_start_height,omitempty"`
+	WindowEndHeight   uint64 `protobuf:"varint,2,opt,name=window_end_height,json=windowEndHeight,proto3" json:"window_end_height,omitempty"`
+	PossibleWins      int64  `protobuf:"varint,3,opt,name=possible_wins,json=possibleWins,proto3" json:"possible_wins,omitempty"`
+	// Valid rate below which validators are slashed, if slashing is enabled
+	MinValidPerWindow cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=min_valid_per_window,json=minValidPerWindow,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_valid_per_window"`
+	SlashFraction     cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=slash_fraction,json=slashFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction"`
+	SlashingEnabled   bool                        `protobuf:"varint,6,opt,name=slashing_enabled,json=slashingEnabled,proto3" json:"slashing_enabled,omitempty"`
+	// Number of validators which missed votes or were rewarded over the slash window
+	Validators        uint32 `protobuf:"varint,7,opt,name=validators,proto3" json:"validators,omitempty"`
+	SlashedValidators uint32 `protobuf:"varint,8,opt,name=slashed_validators,json=slashedValidators,proto3" json:"slashed_validators,omitempty"`
+	// Oracle rewards allocated to all validators over the slash window
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *SlashWindowSummary) Reset()         { *m = SlashWindowSummary{} }
+func (m *SlashWindowSummary) String() string { return proto.CompactTextString(m) }
+func (*SlashWindowSummary) ProtoMessage()    {}
+func (*SlashWindowSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50c28bc8f22390bf, []int{15}
+}
+func (m *SlashWindowSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashWindowSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashWindowSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashWindowSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashWindowSummary.Merge(m, src)
+}
+func (m *SlashWindowSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashWindowSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashWindowSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashWindowSummary proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("cheqd.oracle.v2.PriceSource", PriceSource_name, PriceSource_value)
 	proto.RegisterType((*Params)(nil), "cheqd.oracle.v2.Params")
+	proto.RegisterType((*TwapCheckpoint)(nil), "cheqd.oracle.v2.TwapCheckpoint")
 	proto.RegisterType((*Denom)(nil), "cheqd.oracle.v2.Denom")
 	proto.RegisterType((*RewardBand)(nil), "cheqd.oracle.v2.RewardBand")
 	proto.RegisterType((*AggregateExchangeRatePrevote)(nil), "cheqd.oracle.v2.AggregateExchangeRatePrevote")
@@ -508,101 +820,152 @@ func init() {
 	proto.RegisterType((*PairAddressProvider)(nil), "cheqd.oracle.v2.PairAddressProvider")
 	proto.RegisterType((*CurrencyDeviationThreshold)(nil), "cheqd.oracle.v2.CurrencyDeviationThreshold")
 	proto.RegisterType((*ParamUpdatePlan)(nil), "cheqd.oracle.v2.ParamUpdatePlan")
+	proto.RegisterType((*PriceAverage)(nil), "cheqd.oracle.v2.PriceAverage")
+	proto.RegisterType((*PriceCircuitBreaker)(nil), "cheqd.oracle.v2.PriceCircuitBreaker")
+	proto.RegisterType((*ValidatorOracleWindow)(nil), "cheqd.oracle.v2.ValidatorOracleWindow")
+	proto.RegisterType((*SlashWindowSummary)(nil), "cheqd.oracle.v2.SlashWindowSummary")
 }
 
 func init() { proto.RegisterFile("cheqd/oracle/v2/oracle.proto", fileDescriptor_50c28bc8f22390bf) }
 
 var fileDescriptor_50c28bc8f22390bf = []byte{
-	// 1418 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xf7, 0x36, 0x69, 0x1a, 0x8f, 0xed, 0x38, 0x99, 0x24, 0xcd, 0x36, 0xee, 0xd7, 0x9b, 0xef,
-	0x52, 0x4a, 0x68, 0xa9, 0x4d, 0x5d, 0xa4, 0x8a, 0x08, 0x0e, 0xdd, 0xa6, 0x15, 0x48, 0xa5, 0xb2,
-	0x26, 0x50, 0xa4, 0x5e, 0x96, 0xf1, 0xee, 0x60, 0x8f, 0xe2, 0xdd, 0x75, 0x67, 0xd6, 0x6e, 0x7c,
-	0xe7, 0x80, 0xc4, 0x85, 0x03, 0x48, 0x1c, 0x2b, 0x71, 0xeb, 0x81, 0x03, 0x12, 0x12, 0x37, 0xae,
-	0x95, 0xb8, 0xf4, 0x88, 0x38, 0x6c, 0xa1, 0xbd, 0x70, 0xf6, 0x5f, 0x80, 0xe6, 0xc7, 0xda, 0x6b,
-	0xc7, 0x81, 0xd2, 0x8b, 0xbd, 0xf3, 0x3e, 0xef, 0xcd, 0x7c, 0xe6, 0x33, 0xfb, 0xde, 0x9b, 0x05,
-	0xe7, 0xbd, 0x0e, 0x79, 0xe0, 0xd7, 0x23, 0x86, 0xbd, 0x2e, 0xa9, 0x0f, 0x1a, 0xfa, 0xa9, 0xd6,
-	0x63, 0x51, 0x1c, 0xc1, 0xb2, 0x44, 0x6b, 0xda, 0x36, 0x68, 0x6c, 0x57, 0xbd, 0x88, 0x07, 0x11,
-	0xaf, 0xb7, 0x30, 0x27, 0xf5, 0xc1, 0xd5, 0x16, 0x89, 0xf1, 0xd5, 0xba, 0x17, 0xd1, 0x50, 0x05,
-	0x6c, 0x6f, 0xb4, 0xa3, 0x76, 0x24, 0x1f, 0xeb, 0xe2, 0x49, 0x59, 0xed, 0x6f, 0x8b, 0x60, 0xa9,
-	0x89, 0x19, 0x0e, 0x38, 0xbc, 0x0e, 0x0a, 0x83, 0x28, 0x26, 0x6e, 0x8f, 0x30, 0x1a, 0xf9, 0xa6,
-	0xb1, 0x63, 0xec, 0x2e, 0x3a, 0x67, 0x47, 0x89, 0x05, 0x87, 0x38, 0xe8, 0xee, 0xd9, 0x19, 0xd0,
-	0x46, 0x40, 0x8c, 0x9a, 0x72, 0x00, 0x3d, 0xb0, 0x22, 0xb1, 0xb8, 0xc3, 0x08, 0xef, 0x44, 0x5d,
-	0xdf, 0x3c, 0xb5, 0x63, 0xec, 0xe6, 0x9d, 0xf7, 0x9e, 0x24, 0x56, 0xee, 0xf7, 0xc4, 0xaa, 0x28,
-	0x66, 0xdc, 0x3f, 0xac, 0xd1, 0xa8, 0x1e, 0xe0, 0xb8, 0x53, 0xbb, 0x43, 0xda, 0xd8, 0x1b, 0xee,
-	0x13, 0x6f, 0x94, 0x58, 0x9b, 0x99, 0xe9, 0xc7, 0x53, 0xd8, 0xa8, 0x24, 0x0c, 0x1f, 0xa7, 0x63,
-	0x18, 0x80, 0x22, 0x23, 0x0f, 0x31, 0xf3, 0xdd, 0x16, 0x0e, 0x7d, 0x6e, 0x2e, 0xec, 0x2c, 0xec,
-	0x16, 0x1a, 0x95, 0xda, 0x8c, 0x0c, 0x35, 0x24, 0x9d, 0x1c, 0x1c, 0xfa, 0xce, 0x15, 0xb1, 0xfe,
-	0x28, 0xb1, 0xd6, 0xd5, 0x02, 0xd9, 0x70, 0xfb, 0xf1, 0x33, 0x6b, 0x65, 0xe2, 0x7a, 0x87, 0xf2,
-	0x18, 0x15, 0xd8, 0x78, 0xcc, 0xa1, 0x07, 0xb6, 0xb5, 0xbf, 0x4f, 0x79, 0xcc, 0x68, 0xab, 0x1f,
-	0xd3, 0x28, 0x74, 0x1f, 0xd2, 0xd0, 0x8f, 0x1e, 0x9a, 0x8b, 0x52, 0x9b, 0xd7, 0x47, 0x89, 0xf5,
-	0xff, 0xa9, 0xb9, 0xe7, 0xf8, 0xda, 0xc8, 0x54, 0xe0, 0x7e, 0x06, 0xfb, 0x54, 0x42, 0xf0, 0x33,
-	0x50, 0xc0, 0x9e, 0x47, 0x7a, 0xb1, 0xdb, 0xa5, 0x3c, 0x36, 0x4f, 0xcb, 0x2d, 0x9d, 0x3d, 0xb6,
-	0xa5, 0x7d, 0x12, 0x46, 0x81, 0xf3, 0x86, 0xde, 0x8d, 0x3e, 0x8d, 0x4c, 0xa0, 0xd8, 0x4c, 0x5e,
-	0x3a, 0xc9, 0x7d, 0x00, 0x05, 0x89, 0x67, 0x71, 0x34, 0xbc, 0x8b, 0x79, 0xc7, 0xfd, 0x9c, 0x61,
-	0x4f, 0xac, 0x6c, 0x2e, 0xbd, 0xc2, 0xd1, 0x4c, 0x4f, 0x61, 0xa3, 0x92, 0x34, 0xdc, 0xd6, 0x63,
-	0xb8, 0x07, 0x8a, 0xca, 0x43, 0xab, 0x73, 0x46, 0xaa, 0xb3, 0x35, 0x51, 0x3e, 0x8b, 0xda, 0xa8,
-	0x20, 0x87, 0x5a, 0x02, 0x0e, 0x36, 0x02, 0x1a, 0xba, 0x03, 0xdc, 0xa5, 0xbe, 0x78, 0xb9, 0xd2,
-	0x39, 0x96, 0x25, 0x4d, 0xe7, 0xe5, 0x68, 0x56, 0xd4, 0x32, 0xf3, 0x26, 0xb2, 0xd1, 0x5a, 0x40,
-	0xc3, 0x7b, 0xc2, 0xda, 0x24, 0x4c, 0x2f, 0x4a, 0xc1, 0x4a, 0x80, 0x43, 0x1f, 0xc7, 0x11, 0x1b,
-	0x2a, 0xe9, 0xf3, 0xff, 0x28, 0xfd, 0x25, 0x2d, 0xbd, 0x96, 0x63, 0x3a, 0x76, 0x46, 0xfd, 0xd2,
-	0x18, 0x95, 0x07, 0xd0, 0x00, 0x9b, 0x1d, 0xca, 0xe3, 0x88, 0x51, 0xcf, 0xe5, 0x31, 0x0e, 0x7a,
-	0x69, 0x7a, 0x01, 0x21, 0x12, 0x5a, 0x4f, 0xc1, 0x03, 0x81, 0xe9, 0x7c, 0xaa, 0x81, 0xf5, 0x80,
-	0xf8, 0x14, 0x87, 0xd3, 0x11, 0x05, 0x19, 0xb1, 0xa6, 0xa0, 0xac, 0xff, 0xdb, 0x60, 0x23, 0xc0,
-	0x47, 0x34, 0xe8, 0x07, 0x6e, 0x8f, 0x51, 0x8f, 0xa8, 0x30, 0x6e, 0x16, 0x65, 0x00, 0xd4, 0x58,
-	0x53, 0x40, 0x32, 0x8c, 0x0b, 0x56, 0x69, 0x44, 0x76, 0x25, 0x6e, 0x96, 0x14, 0x2b, 0x0d, 0x7e,
-	0x34, 0x59, 0x8a, 0xc3, 0xef, 0x0d, 0xb0, 0xe5, 0xf5, 0x19, 0x23, 0xa1, 0x37, 0x74, 0x7b, 0x98,
-	0x32, 0xb7, 0xc7, 0xa2, 0x01, 0xf5, 0x09, 0xe3, 0xe6, 0x8a, 0x94, 0xef, 0xe2, 0x31, 0xf9, 0x6e,
-	0x6a, 0xff, 0x26, 0xa6, 0xac, 0x99, 0x7a, 0x3b, 0x37, 0xb5, 0x9c, 0x55, 0x25, 0xe7, 0x09, 0x93,
-	0x0a, 0x5d, 0xcf, 0xcd, 0x9d, 0x40, 0xea, 0xbc, 0xe9, 0xcd, 0x83, 0xe0, 0x2f, 0x06, 0xf8, 0xdf,
-	0x78, 0x42, 0x9f, 0x0c, 0x28, 0x96, 0xb9, 0x38, 0xae, 0x2b, 0xdc, 0x2c, 0x4b, 0xae, 0x97, 0x4f,
-	0xe4, 0xba, 0x9f, 0x06, 0x8d, 0x6b, 0x8f, 0x73, 0x57, 0x13, 0xbe, 0x30, 0x43, 0x78, 0xde, 0xfc,
-	0x82, 0x76, 0xf5, 0xe4, 0xb9, 0x24, 0xf7, 0x8a, 0x77, 0x22, 0xce, 0xe1, 0x05, 0xb0, 0xd2, 0xe7,
-	0xbe, 0xe7, 0xd2, 0x96, 0xe7, 0xfa, 0xe2, 0xb5, 0x32, 0x57, 0x45, 0x2e, 0xa0, 0xa2, 0xb0, 0x7e,
-	0xd8, 0xf2, 0xe4, 0xab, 0x06, 0xdf, 0x04, 0xab, 0x32, 0x8d, 0x68, 0xd8, 0x76, 0x49, 0x88, 0x5b,
-	0x5d, 0xe2, 0x9b, 0x6b, 0x3b, 0xc6, 0xee, 0x32, 0x2a, 0xa7, 0xf6, 0x5b, 0xca, 0x2c, 0x5c, 0xf1,
-	0x80, 0x30, 0xdc, 0x16, 0xbe, 0x3a, 0xbd, 0xa0, 0x3c, 0xe7, 0xf2, 0xd8, 0xae, 0x12, 0x63, 0x6f,
-	0xf9, 0xbb, 0x47, 0x56, 0xee, 0xaf, 0x47, 0x96, 0x61, 0xff, 0x6c, 0x80, 0xd3, 0x6a, 0xa5, 0x77,
-	0x00, 0x10, 0x2d, 0x45, 0x73, 0x31, 0x64, 0x5e, 0x6e, 0x8e, 0x12, 0x6b, 0x4d, 0x89, 0x31, 0xc1,
-	0x6c, 0x94, 0x17, 0x03, 0x15, 0x25, 0x6a, 0xc2, 0x30, 0x68, 0x45, 0x5d, 0x1d, 0xa7, 0x3a, 0x42,
-	0xb6, 0x26, 0x64, 0x50, 0x51, 0x13, 0xe4, 0x50, 0xc5, 0xd6, 0xc1, 0x32, 0x39, 0xea, 0x45, 0x21,
-	0x09, 0x63, 0x73, 0x61, 0xc7, 0xd8, 0x2d, 0x39, 0xeb, 0xa3, 0xc4, 0x2a, 0xab, 0xb8, 0x14, 0xb1,
-	0xd1, 0xd8, 0x69, 0xaf, 0xf8, 0xe5, 0x23, 0x2b, 0xa7, 0xa9, 0xe7, 0xec, 0x1f, 0x0d, 0x00, 0x26,
-	0xa5, 0xfd, 0x18, 0x13, 0xe3, 0x3f, 0x30, 0xb9, 0x0f, 0x0a, 0x99, 0xae, 0xa1, 0x37, 0xf1, 0xee,
-	0xcb, 0x15, 0x25, 0x78, 0xac, 0xeb, 0xd8, 0x08, 0x4c, 0x5a, 0xcc, 0x0c, 0xe9, 0x9f, 0x0c, 0x70,
-	0xfe, 0x46, 0xbb, 0xcd, 0x48, 0x1b, 0xc7, 0xe4, 0xd6, 0x91, 0xd7, 0xc1, 0x61, 0x9b, 0x20, 0x1c,
-	0x93, 0x26, 0x23, 0xa2, 0x11, 0xc2, 0xd7, 0xc0, 0x62, 0x07, 0xf3, 0x8e, 0xa6, 0x5f, 0x1e, 0x25,
-	0x56, 0x41, 0x2d, 0x20, 0xac, 0x36, 0x92, 0x20, 0xbc, 0x08, 0x4e, 0x0b, 0x67, 0xa6, 0x99, 0xae,
-	0x8e, 0x12, 0xab, 0x38, 0xe9, 0xae, 0xcc, 0x46, 0x0a, 0x96, 0x9a, 0xf4, 0x5b, 0x01, 0x8d, 0xdd,
-	0x56, 0x37, 0xf2, 0x0e, 0xa5, 0xca, 0xd3, 0x15, 0x3b, 0x83, 0x0a, 0x4d, 0xe4, 0xd0, 0x11, 0xa3,
-	0x19, 0xde, 0xbf, 0x1a, 0xe0, 0xdc, 0x5c, 0xde, 0xf7, 0x04, 0xe9, 0x23, 0xb0, 0x42, 0xb4, 0xcd,
-	0x65, 0x38, 0x26, 0xdc, 0x34, 0x64, 0xf6, 0x9d, 0xaf, 0x29, 0xed, 0x6a, 0xe2, 0x85, 0xa9, 0xe9,
-	0xcb, 0x4a, 0x6d, 0x9f, 0x78, 0x37, 0x23, 0x1a, 0x3a, 0xd7, 0x84, 0xc0, 0x8f, 0x9f, 0x59, 0x97,
-	0xdb, 0x34, 0xee, 0xf4, 0x5b, 0x35, 0x2f, 0x0a, 0xea, 0xfa, 0x72, 0xa3, 0xfe, 0xae, 0x70, 0xff,
-	0xb0, 0x1e, 0x0f, 0x7b, 0x84, 0xa7, 0x31, 0x1c, 0x95, 0x48, 0x66, 0x71, 0xfe, 0xb2, 0x4a, 0xcc,
-	0xec, 0xa6, 0x0b, 0xc0, 0xa4, 0x4c, 0xc2, 0x1b, 0xa0, 0x34, 0xc5, 0x5e, 0x6a, 0xff, 0x2f, 0xe4,
-	0x51, 0x31, 0xcb, 0x03, 0x56, 0x40, 0x5e, 0x6a, 0xe8, 0x86, 0x7d, 0x95, 0x03, 0x8b, 0x68, 0x59,
-	0x1a, 0xee, 0xf6, 0x03, 0xfb, 0x00, 0x40, 0xd9, 0x98, 0x44, 0xb7, 0x50, 0x2f, 0xec, 0x01, 0x89,
-	0xe1, 0xfb, 0xa0, 0x34, 0x48, 0xad, 0x2e, 0x27, 0xb1, 0x94, 0x2c, 0xef, 0x98, 0xa3, 0xc4, 0xda,
-	0xd0, 0x3b, 0xc8, 0xc2, 0x36, 0x2a, 0x8e, 0xc7, 0x07, 0x24, 0xb6, 0x7f, 0x38, 0x05, 0x36, 0xe7,
-	0x56, 0xcd, 0x57, 0x4c, 0xe4, 0xeb, 0xa0, 0xf0, 0xa0, 0x2f, 0xae, 0x66, 0xd9, 0x3c, 0xce, 0xdc,
-	0x0a, 0x33, 0xa0, 0x8d, 0x80, 0x1c, 0xa9, 0x40, 0x1f, 0x14, 0x65, 0x41, 0xc7, 0xbe, 0xcf, 0x08,
-	0x4f, 0x2f, 0x6c, 0x17, 0x8e, 0xd5, 0x5d, 0x41, 0xf2, 0x86, 0xf2, 0x49, 0xb9, 0x3a, 0x95, 0xe9,
-	0x9b, 0x5b, 0x76, 0x1e, 0x1b, 0x15, 0x7a, 0x93, 0x08, 0xd8, 0x00, 0xf9, 0x49, 0x1b, 0x5a, 0x94,
-	0x4a, 0x6d, 0x8c, 0x12, 0x6b, 0x55, 0x07, 0x8e, 0x9b, 0x09, 0x9a, 0xb8, 0xcd, 0x9c, 0xf9, 0x57,
-	0x06, 0x58, 0x9f, 0xc3, 0x01, 0xbe, 0x05, 0xce, 0xa4, 0xd4, 0x95, 0x56, 0x70, 0x94, 0x58, 0x2b,
-	0xfa, 0xf2, 0x95, 0x72, 0x49, 0x5d, 0xe0, 0x6d, 0xb0, 0xaa, 0x1f, 0xc7, 0x1d, 0x4c, 0x6b, 0x55,
-	0x19, 0x25, 0xd6, 0xd6, 0x54, 0xd8, 0xd8, 0xc3, 0x46, 0x65, 0x3c, 0xbd, 0xaa, 0xfd, 0x8d, 0x01,
-	0xb6, 0x4f, 0xee, 0x1e, 0xaf, 0x78, 0x86, 0x0d, 0x90, 0x9f, 0xbd, 0x9b, 0x67, 0x44, 0xca, 0xdc,
-	0xb9, 0x27, 0x6e, 0x33, 0x22, 0x7d, 0x61, 0x80, 0xb2, 0xfc, 0x4c, 0xf8, 0xa4, 0xe7, 0x8b, 0xa2,
-	0xd4, 0xc5, 0x21, 0x84, 0x60, 0xf1, 0x90, 0x0c, 0x55, 0x4a, 0xe7, 0x91, 0x7c, 0x86, 0x67, 0xc1,
-	0x52, 0x87, 0xd0, 0x76, 0x27, 0x96, 0xcb, 0x2c, 0x20, 0x3d, 0x82, 0xd7, 0xc1, 0x19, 0x95, 0x15,
-	0x5c, 0xd6, 0x9a, 0x42, 0x63, 0x6b, 0xce, 0x7b, 0x20, 0xbe, 0x42, 0x9c, 0x45, 0x71, 0xf4, 0x28,
-	0xf5, 0x9e, 0xa2, 0x61, 0x38, 0x1f, 0x3c, 0xf9, 0xb3, 0x9a, 0x7b, 0xf2, 0xbc, 0x6a, 0x3c, 0x7d,
-	0x5e, 0x35, 0xfe, 0x78, 0x5e, 0x35, 0xbe, 0x7e, 0x51, 0xcd, 0x3d, 0x7d, 0x51, 0xcd, 0xfd, 0xf6,
-	0xa2, 0x9a, 0xbb, 0x7f, 0x29, 0x5b, 0x2f, 0xe4, 0xb7, 0x93, 0xfc, 0xbd, 0x12, 0x46, 0x3e, 0xa9,
-	0x1f, 0xa5, 0x1f, 0x52, 0xb2, 0x6e, 0xb4, 0x96, 0xe4, 0xe7, 0xcf, 0xb5, 0xbf, 0x03, 0x00, 0x00,
-	0xff, 0xff, 0x69, 0x78, 0x12, 0xe5, 0x65, 0x0d, 0x00, 0x00,
+	// 2171 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x3d, 0x6c, 0x1b, 0xc9,
+	0x15, 0xd6, 0x4a, 0xd4, 0x0f, 0x1f, 0x7f, 0x44, 0x8d, 0x24, 0x6b, 0x6d, 0xd9, 0xa4, 0x6e, 0xef,
+	0x27, 0x8a, 0x2f, 0x22, 0xef, 0x74, 0x01, 0x2e, 0x31, 0x92, 0x82, 0xa4, 0xe8, 0x3b, 0x1f, 0x2c,
+	0x89, 0x59, 0xca, 0x76, 0x70, 0xcd, 0x66, 0xb8, 0x3b, 0x26, 0x37, 0xe2, 0xee, 0xf2, 0x76, 0x76,
+	0x69, 0xa9, 0x4f, 0x11, 0x20, 0xcd, 0x15, 0x57, 0x5c, 0x69, 0x20, 0x9d, 0x8b, 0x04, 0x08, 0x1c,
+	0x20, 0x5d, 0xca, 0x18, 0x48, 0x73, 0xb8, 0x2a, 0x48, 0x41, 0x27, 0x76, 0x93, 0x9a, 0x4d, 0xba,
+	0x20, 0x98, 0x9f, 0x25, 0x97, 0x3f, 0x4a, 0x64, 0x05, 0x69, 0x24, 0xbe, 0xf7, 0xbd, 0x37, 0xf3,
+	0xe6, 0x7b, 0x33, 0xf3, 0xde, 0x2c, 0xdc, 0x34, 0xdb, 0xe4, 0x0b, 0xab, 0xe4, 0xf9, 0xd8, 0xec,
+	0x90, 0x52, 0x6f, 0x5f, 0xfe, 0x2a, 0x76, 0x7d, 0x2f, 0xf0, 0xd0, 0x2a, 0x47, 0x8b, 0x52, 0xd7,
+	0xdb, 0xbf, 0x91, 0x37, 0x3d, 0xea, 0x78, 0xb4, 0xd4, 0xc4, 0x94, 0x94, 0x7a, 0x1f, 0x36, 0x49,
+	0x80, 0x3f, 0x2c, 0x99, 0x9e, 0xed, 0x0a, 0x87, 0x1b, 0xd7, 0x05, 0x6e, 0x70, 0xa9, 0x24, 0x04,
+	0x09, 0x6d, 0xb4, 0xbc, 0x96, 0x27, 0xf4, 0xec, 0x97, 0xd4, 0xe6, 0x5b, 0x9e, 0xd7, 0xea, 0x90,
+	0x12, 0x97, 0x9a, 0xe1, 0xe3, 0x92, 0x15, 0xfa, 0x38, 0xb0, 0xbd, 0x68, 0xc0, 0xc2, 0x24, 0x1e,
+	0xd8, 0x0e, 0xa1, 0x01, 0x76, 0xba, 0xc2, 0x40, 0xfb, 0x67, 0x16, 0x96, 0xea, 0xd8, 0xc7, 0x0e,
+	0x45, 0x1f, 0x43, 0xaa, 0xe7, 0x05, 0xc4, 0xe8, 0x12, 0xdf, 0xf6, 0x2c, 0x55, 0xd9, 0x51, 0x76,
+	0x13, 0x95, 0x6b, 0x83, 0x7e, 0x01, 0x9d, 0x63, 0xa7, 0x73, 0x47, 0x8b, 0x81, 0x9a, 0x0e, 0x4c,
+	0xaa, 0x73, 0x01, 0x99, 0x90, 0xe5, 0x58, 0xd0, 0xf6, 0x09, 0x6d, 0x7b, 0x1d, 0x4b, 0x9d, 0xdf,
+	0x51, 0x76, 0x93, 0x95, 0x1f, 0xbd, 0xe8, 0x17, 0xe6, 0xfe, 0xda, 0x2f, 0x6c, 0x8b, 0x85, 0x50,
+	0xeb, 0xb4, 0x68, 0x7b, 0x25, 0x07, 0x07, 0xed, 0xe2, 0x7d, 0xd2, 0xc2, 0xe6, 0xf9, 0x01, 0x31,
+	0x07, 0xfd, 0xc2, 0x66, 0x6c, 0xf8, 0xe1, 0x10, 0x9a, 0x9e, 0x61, 0x8a, 0x93, 0x48, 0x46, 0x0e,
+	0xa4, 0x7d, 0xf2, 0x04, 0xfb, 0x96, 0xd1, 0xc4, 0xae, 0x45, 0xd5, 0x85, 0x9d, 0x85, 0xdd, 0xd4,
+	0xfe, 0x76, 0x71, 0x82, 0xe2, 0xa2, 0xce, 0x8d, 0x2a, 0xd8, 0xb5, 0x2a, 0x7b, 0x6c, 0xfe, 0x41,
+	0xbf, 0xb0, 0x2e, 0x26, 0x88, 0xbb, 0x6b, 0xcf, 0x5e, 0x16, 0xb2, 0x23, 0xd3, 0xfb, 0x36, 0x0d,
+	0xf4, 0x94, 0x3f, 0x94, 0x29, 0x32, 0xe1, 0x86, 0xb4, 0xb7, 0x6c, 0x1a, 0xf8, 0x76, 0x33, 0x64,
+	0xac, 0x1a, 0x4f, 0x6c, 0xd7, 0xf2, 0x9e, 0xa8, 0x09, 0xce, 0xcd, 0xbb, 0x83, 0x7e, 0xe1, 0xad,
+	0xb1, 0xb1, 0x67, 0xd8, 0x6a, 0xba, 0x2a, 0xc0, 0x83, 0x18, 0xf6, 0x88, 0x43, 0xe8, 0x67, 0x90,
+	0xc2, 0xa6, 0x49, 0xba, 0x81, 0xd1, 0xb1, 0x69, 0xa0, 0x2e, 0xf2, 0x25, 0x5d, 0x9b, 0x5a, 0xd2,
+	0x01, 0x71, 0x3d, 0xa7, 0xf2, 0x1d, 0xb9, 0x1a, 0x99, 0x8d, 0x98, 0x23, 0x5b, 0x4c, 0x92, 0x1b,
+	0xf1, 0x75, 0x80, 0x80, 0xd8, 0x6f, 0x96, 0x1a, 0xda, 0xc1, 0xb4, 0x6d, 0x3c, 0xf6, 0xb1, 0xc9,
+	0x66, 0x56, 0x97, 0xae, 0x90, 0x9a, 0xf1, 0x21, 0x34, 0x3d, 0xc3, 0x15, 0x77, 0xa5, 0x8c, 0xee,
+	0x40, 0x5a, 0x58, 0x48, 0x76, 0x96, 0x39, 0x3b, 0x5b, 0x23, 0xe6, 0xe3, 0xa8, 0xa6, 0xa7, 0xb8,
+	0x28, 0x29, 0xa0, 0xb0, 0xe1, 0xd8, 0xae, 0xd1, 0xc3, 0x1d, 0xdb, 0x62, 0x9b, 0x2b, 0x1a, 0x63,
+	0x85, 0x87, 0x59, 0xb9, 0x5c, 0x98, 0xdb, 0x62, 0x9a, 0x59, 0x03, 0x69, 0xfa, 0x9a, 0x63, 0xbb,
+	0x0f, 0x99, 0xb6, 0x4e, 0x7c, 0x39, 0xa9, 0x0d, 0x59, 0x07, 0xbb, 0x16, 0x0e, 0x3c, 0xff, 0x5c,
+	0x50, 0x9f, 0xfc, 0x8f, 0xd4, 0xdf, 0x96, 0xd4, 0x4b, 0x3a, 0xc6, 0x7d, 0x27, 0xd8, 0xcf, 0x0c,
+	0x51, 0x9e, 0x80, 0x7d, 0xd8, 0x6c, 0xdb, 0x34, 0xf0, 0x7c, 0xdb, 0x34, 0xf8, 0xb9, 0x8b, 0x8e,
+	0x17, 0x30, 0x92, 0xf4, 0xf5, 0x08, 0x6c, 0x30, 0x4c, 0x9e, 0xa7, 0x22, 0xac, 0x3b, 0xc4, 0xb2,
+	0xb1, 0x3b, 0xee, 0x91, 0xe2, 0x1e, 0x6b, 0x02, 0x8a, 0xdb, 0x7f, 0x00, 0x1b, 0x0e, 0x3e, 0xb3,
+	0x9d, 0xd0, 0x31, 0xba, 0xbe, 0x6d, 0x12, 0xe1, 0x46, 0xd5, 0x34, 0x77, 0x40, 0x12, 0xab, 0x33,
+	0x88, 0xbb, 0x51, 0x16, 0x55, 0xe4, 0x11, 0x9f, 0x89, 0xaa, 0x19, 0x11, 0x95, 0x04, 0x0f, 0x47,
+	0x53, 0x51, 0xf4, 0x6b, 0x05, 0xb6, 0xcc, 0xd0, 0xf7, 0x89, 0x6b, 0x9e, 0x1b, 0x5d, 0x6c, 0xfb,
+	0xec, 0x96, 0xea, 0xd9, 0x16, 0xf1, 0xa9, 0x9a, 0xe5, 0xf4, 0xbd, 0x37, 0x45, 0x5f, 0x55, 0xda,
+	0xd7, 0xb1, 0xed, 0xd7, 0x23, 0xeb, 0x4a, 0x55, 0xd2, 0x99, 0x17, 0x74, 0x5e, 0x30, 0x28, 0xe3,
+	0xf5, 0xfa, 0xcc, 0x01, 0x38, 0xcf, 0x9b, 0xe6, 0x2c, 0x08, 0xfd, 0x51, 0x81, 0x5b, 0xc3, 0x01,
+	0x2d, 0xd2, 0xb3, 0xf9, 0x6d, 0x38, 0xba, 0x57, 0xa8, 0xba, 0xca, 0x63, 0x7d, 0xff, 0xc2, 0x58,
+	0x0f, 0x22, 0xa7, 0xe1, 0xdd, 0x53, 0x39, 0x92, 0x01, 0xbf, 0x33, 0x11, 0xf0, 0xac, 0xf1, 0x59,
+	0xd8, 0xf9, 0x8b, 0xc7, 0xe2, 0xb1, 0x6f, 0x9b, 0x17, 0xe2, 0x14, 0xbd, 0x03, 0xd9, 0x90, 0x5a,
+	0xa6, 0x61, 0x37, 0x4d, 0xc3, 0x62, 0xdb, 0x4a, 0xcd, 0xb1, 0xb3, 0xa0, 0xa7, 0x99, 0xf6, 0x5e,
+	0xd3, 0xe4, 0x5b, 0x0d, 0x7d, 0x17, 0x72, 0xfc, 0x18, 0xd9, 0x6e, 0xcb, 0x20, 0x2e, 0x6e, 0x76,
+	0x88, 0xa5, 0xae, 0xed, 0x28, 0xbb, 0x2b, 0xfa, 0x6a, 0xa4, 0xaf, 0x09, 0x35, 0x33, 0xc5, 0x3d,
+	0xe2, 0xe3, 0x16, 0xb3, 0x95, 0xc7, 0x0b, 0xf1, 0x3c, 0xaf, 0x0e, 0xf5, 0xf2, 0x60, 0x68, 0x90,
+	0x71, 0xf0, 0x99, 0xdc, 0x45, 0xb8, 0x45, 0xd4, 0x75, 0x6e, 0x97, 0x72, 0xf0, 0x19, 0xdf, 0x3e,
+	0xe5, 0x16, 0x41, 0x8f, 0x20, 0x3b, 0xb2, 0xf9, 0x79, 0xe8, 0x74, 0xd5, 0x0d, 0x7e, 0x56, 0x3f,
+	0xbc, 0xc4, 0x59, 0xfd, 0xf6, 0xf9, 0x1e, 0x08, 0xb8, 0x78, 0x40, 0x4c, 0x3d, 0x1d, 0x8d, 0xfb,
+	0x59, 0xe8, 0x74, 0xd1, 0x67, 0x90, 0x0d, 0x9e, 0xe0, 0xae, 0xd1, 0xf1, 0xbc, 0xd3, 0x26, 0x36,
+	0x4f, 0xa9, 0xba, 0xc9, 0x53, 0x75, 0xbd, 0x28, 0x8a, 0x58, 0x31, 0x2a, 0x62, 0xc5, 0x03, 0x59,
+	0xe4, 0x2a, 0x2b, 0x6c, 0xce, 0xaf, 0x5f, 0x16, 0x14, 0x3d, 0xc3, 0x5c, 0xef, 0x47, 0x9e, 0xe8,
+	0x2e, 0xe4, 0x1e, 0x13, 0x12, 0x1d, 0x07, 0x2f, 0xf4, 0x4d, 0xa2, 0x5e, 0xdb, 0x51, 0x76, 0xb3,
+	0xfb, 0x37, 0xa7, 0x12, 0x2f, 0x0e, 0x06, 0xb7, 0xd1, 0xb3, 0x8f, 0x09, 0x89, 0xc9, 0xe8, 0x18,
+	0xd6, 0xd8, 0x38, 0x63, 0x71, 0xa9, 0x5b, 0x3b, 0xca, 0x65, 0xc3, 0x5a, 0x7d, 0x4c, 0xc8, 0x49,
+	0x2c, 0xb2, 0x3b, 0x2b, 0x5f, 0x3f, 0x2d, 0xcc, 0xfd, 0xe3, 0x69, 0x41, 0xd1, 0xfe, 0xa5, 0x40,
+	0x96, 0x41, 0xd5, 0x36, 0x31, 0x4f, 0xbb, 0x9e, 0xed, 0x06, 0xe8, 0x13, 0x58, 0xe4, 0x11, 0xab,
+	0xca, 0x55, 0x19, 0x15, 0xfe, 0xe8, 0x27, 0x00, 0x66, 0xe8, 0x84, 0x1d, 0x1c, 0xd8, 0x3d, 0xa2,
+	0xce, 0x5f, 0x75, 0xb4, 0xd8, 0x20, 0xe8, 0x1a, 0x2c, 0xb5, 0x89, 0xdd, 0x6a, 0x07, 0xea, 0xc2,
+	0x8e, 0xb2, 0xbb, 0xa0, 0x4b, 0x09, 0xfd, 0x00, 0x12, 0xac, 0xa7, 0xe0, 0x25, 0x31, 0xb5, 0x7f,
+	0x63, 0x8a, 0x94, 0x93, 0xa8, 0xe1, 0x10, 0xac, 0x7c, 0xc9, 0x58, 0xe1, 0x1e, 0xda, 0x1f, 0x14,
+	0x58, 0x14, 0x9b, 0xf9, 0xfb, 0x00, 0xac, 0x23, 0x92, 0xdb, 0x5d, 0x2c, 0x7e, 0x73, 0xd0, 0x2f,
+	0xac, 0x89, 0xf3, 0x36, 0xc2, 0x34, 0x3d, 0xc9, 0x04, 0xe1, 0xc5, 0xca, 0xce, 0xb9, 0xd3, 0xf4,
+	0x3a, 0xd2, 0x4f, 0x2c, 0x33, 0x5e, 0x76, 0x62, 0x28, 0x2b, 0x3b, 0x5c, 0x14, 0xbe, 0x25, 0x58,
+	0x21, 0x67, 0x5d, 0xcf, 0x25, 0xae, 0x58, 0x4f, 0xa6, 0xb2, 0x3e, 0xe8, 0x17, 0x56, 0x85, 0x5f,
+	0x84, 0x68, 0xfa, 0xd0, 0xe8, 0x4e, 0xfa, 0x97, 0x4f, 0x0b, 0x73, 0x32, 0x77, 0x73, 0xda, 0xef,
+	0x14, 0x80, 0x51, 0xf7, 0x30, 0x15, 0x89, 0xf2, 0x06, 0x91, 0x7c, 0x0e, 0xa9, 0x58, 0x63, 0x22,
+	0x17, 0xf1, 0xc3, 0xcb, 0xd5, 0x3d, 0x34, 0xd5, 0xd8, 0x68, 0x3a, 0x8c, 0xba, 0x98, 0x89, 0xa0,
+	0x7f, 0xaf, 0xc0, 0xcd, 0x72, 0xab, 0xe5, 0x93, 0x16, 0x0e, 0x48, 0xed, 0xcc, 0x6c, 0x63, 0xb7,
+	0x45, 0x74, 0x1c, 0x90, 0xba, 0x4f, 0x58, 0xaf, 0x85, 0xde, 0x86, 0x44, 0x1b, 0xd3, 0xb6, 0x0c,
+	0x7f, 0x75, 0xd0, 0x2f, 0xa4, 0xc4, 0x04, 0x4c, 0xab, 0xe9, 0x1c, 0x44, 0xef, 0xc1, 0x22, 0x33,
+	0xf6, 0x65, 0xa4, 0xb9, 0x41, 0xbf, 0x90, 0x1e, 0x35, 0x70, 0xbe, 0xa6, 0x0b, 0x98, 0x73, 0x12,
+	0x36, 0x1d, 0x3b, 0x30, 0x9a, 0x1d, 0xcf, 0x3c, 0x55, 0x17, 0xa6, 0x9a, 0x82, 0x18, 0xca, 0x38,
+	0xe1, 0x62, 0x85, 0x49, 0x13, 0x71, 0xff, 0x59, 0x81, 0xeb, 0x33, 0xe3, 0x7e, 0xc8, 0x82, 0x3e,
+	0x83, 0x2c, 0x91, 0x3a, 0xc3, 0xc7, 0x01, 0xa1, 0xaa, 0xc2, 0x6f, 0x8d, 0x9b, 0x45, 0xb9, 0x91,
+	0xd9, 0x86, 0x29, 0xca, 0x5e, 0x9b, 0xed, 0xea, 0xaa, 0x67, 0xbb, 0x95, 0x8f, 0x18, 0xc1, 0xcf,
+	0x5e, 0x16, 0xde, 0x6f, 0xd9, 0x41, 0x3b, 0x6c, 0x16, 0x4d, 0xcf, 0x91, 0xed, 0xb6, 0xfc, 0xb7,
+	0x47, 0xad, 0xd3, 0x52, 0x70, 0xde, 0x25, 0x34, 0xf2, 0xa1, 0x7a, 0x86, 0xc4, 0x26, 0xa7, 0x97,
+	0x65, 0x62, 0x62, 0x35, 0x1d, 0x80, 0x51, 0x25, 0x46, 0x65, 0xc8, 0x8c, 0x45, 0xcf, 0xb9, 0xff,
+	0x2f, 0xc1, 0xeb, 0xe9, 0x78, 0x1c, 0x68, 0x1b, 0x92, 0x9c, 0x43, 0xc3, 0x0d, 0xc5, 0x19, 0x48,
+	0xe8, 0x2b, 0x5c, 0x71, 0x14, 0x3a, 0x5a, 0x03, 0x10, 0xef, 0x7d, 0x58, 0x43, 0x22, 0x36, 0x6c,
+	0x83, 0x04, 0xe8, 0xc7, 0x90, 0xe9, 0x45, 0x5a, 0x83, 0x92, 0x80, 0x53, 0x96, 0xac, 0xa8, 0x83,
+	0x7e, 0x61, 0x43, 0xae, 0x20, 0x0e, 0x6b, 0x7a, 0x7a, 0x28, 0x37, 0x48, 0xa0, 0xfd, 0x66, 0x1e,
+	0x36, 0x67, 0x16, 0xe6, 0x2b, 0x1e, 0xe4, 0x8f, 0x21, 0xf5, 0x45, 0xe8, 0x05, 0x12, 0x92, 0x74,
+	0xc6, 0x1e, 0x1e, 0x31, 0x50, 0xd3, 0x81, 0x4b, 0xc2, 0xd1, 0x82, 0x34, 0xef, 0x19, 0xb0, 0x65,
+	0xf9, 0x84, 0x46, 0x6f, 0x82, 0x77, 0xa6, 0x6f, 0x78, 0x6c, 0xfb, 0x65, 0x61, 0x13, 0xc5, 0x5a,
+	0xd9, 0x1e, 0x7f, 0x1c, 0xc4, 0xc7, 0xd1, 0xf4, 0x54, 0x77, 0xe4, 0x81, 0xf6, 0x21, 0x39, 0xea,
+	0x74, 0x12, 0x9c, 0xa9, 0x8d, 0x41, 0xbf, 0x90, 0x93, 0x8e, 0x11, 0xa4, 0xe9, 0x23, 0xb3, 0x89,
+	0x9c, 0xff, 0x4a, 0x81, 0xf5, 0x19, 0x31, 0xa0, 0xef, 0xc1, 0x72, 0x14, 0xba, 0xe0, 0x0a, 0x0d,
+	0xfa, 0x85, 0xac, 0x18, 0x77, 0x18, 0x4b, 0x64, 0xc2, 0x6a, 0x9a, 0xfc, 0x39, 0x6c, 0x92, 0x24,
+	0x57, 0xdb, 0x83, 0x7e, 0x61, 0x6b, 0xcc, 0x6d, 0x68, 0xa1, 0xe9, 0xab, 0x78, 0x7c, 0x56, 0xed,
+	0x2b, 0x05, 0x6e, 0x5c, 0xdc, 0xa0, 0x5c, 0x31, 0x87, 0xfb, 0x90, 0x9c, 0x7c, 0xfe, 0xc5, 0x48,
+	0x8a, 0x3d, 0xeb, 0x46, 0x66, 0x13, 0x24, 0xfd, 0x42, 0x81, 0x55, 0xfe, 0x12, 0x7d, 0xd0, 0xb5,
+	0xd8, 0xa5, 0xd4, 0xc1, 0x2e, 0x42, 0x90, 0x38, 0x25, 0xe7, 0xe2, 0x48, 0x27, 0x75, 0xfe, 0x3b,
+	0x56, 0x88, 0xe6, 0xc7, 0x0a, 0xd1, 0xc7, 0xb0, 0x2c, 0x4e, 0x05, 0xe5, 0x77, 0x4d, 0x6a, 0x7f,
+	0x6b, 0xc6, 0x3e, 0x60, 0x0f, 0xdd, 0x4a, 0x82, 0xa5, 0x5e, 0x8f, 0xac, 0xc7, 0xc2, 0x50, 0xb4,
+	0x3f, 0x29, 0x90, 0x16, 0xbd, 0x0e, 0xef, 0x8d, 0x08, 0x2b, 0xca, 0x3d, 0xdc, 0x09, 0xff, 0x97,
+	0xa2, 0xcc, 0xfd, 0xd1, 0xbb, 0x90, 0x0d, 0xf9, 0xd2, 0x2c, 0x63, 0x6c, 0x01, 0x19, 0xa9, 0xfd,
+	0x54, 0xac, 0xe3, 0x13, 0x48, 0x47, 0x66, 0xbc, 0xb0, 0x2e, 0xbc, 0x41, 0x61, 0x4d, 0x49, 0x4f,
+	0x86, 0x69, 0xbf, 0x65, 0xbb, 0x8e, 0xad, 0xa4, 0x6a, 0xfb, 0x66, 0x68, 0x07, 0x15, 0x9f, 0xe0,
+	0x53, 0xe2, 0xa3, 0x0d, 0x58, 0x8c, 0xe5, 0x56, 0x17, 0x02, 0x52, 0x61, 0x39, 0xf0, 0xed, 0x6e,
+	0x97, 0x88, 0xf4, 0xad, 0xe8, 0x91, 0xc8, 0x08, 0xf7, 0x09, 0xa6, 0x9e, 0xcb, 0x43, 0x49, 0xea,
+	0x52, 0x8a, 0x25, 0x22, 0x31, 0xb3, 0x23, 0x58, 0x7c, 0xe3, 0x8e, 0xe0, 0xd9, 0x02, 0x6c, 0x0e,
+	0xaf, 0xab, 0x63, 0x9e, 0x36, 0xd9, 0x98, 0x1e, 0xc1, 0xda, 0xe8, 0x4a, 0x1a, 0x3f, 0x33, 0x6f,
+	0x7d, 0xfb, 0x7c, 0xef, 0x96, 0x64, 0x7b, 0xe8, 0x2c, 0x4f, 0x5a, 0x23, 0xf0, 0x6d, 0xb7, 0xa5,
+	0xe7, 0x7a, 0x13, 0x7a, 0x74, 0x1b, 0xd6, 0x44, 0x27, 0x6c, 0x10, 0x77, 0x2c, 0x1d, 0x09, 0x7d,
+	0x55, 0x00, 0x35, 0x37, 0x4a, 0xc8, 0x2d, 0x00, 0xc7, 0xa6, 0xd4, 0x30, 0xbd, 0x50, 0x76, 0x0b,
+	0x09, 0x3d, 0xc9, 0x34, 0x55, 0xa6, 0x40, 0x6f, 0x43, 0xa6, 0xeb, 0x51, 0x6a, 0x37, 0x3b, 0x84,
+	0x75, 0xd7, 0x54, 0xb2, 0x91, 0x8e, 0x94, 0x8f, 0x6c, 0x97, 0xa2, 0x3a, 0x80, 0x78, 0x99, 0xf2,
+	0x4b, 0x7e, 0xf1, 0xaa, 0x3b, 0x29, 0xc9, 0x07, 0xe1, 0xd7, 0x3e, 0x81, 0x65, 0x51, 0xe9, 0xa9,
+	0xba, 0x24, 0xdb, 0xe4, 0x59, 0x35, 0x83, 0x57, 0xbb, 0x0f, 0x64, 0xb5, 0xdb, 0xbd, 0x44, 0xb5,
+	0x13, 0xa5, 0x2e, 0x1a, 0x9b, 0x6d, 0x0b, 0xfe, 0x9e, 0x20, 0x16, 0x7f, 0xd6, 0xaf, 0xe8, 0x91,
+	0xa8, 0x3d, 0x4f, 0x00, 0x6a, 0x8c, 0x5e, 0xf2, 0x8d, 0xd0, 0x71, 0xb0, 0x7f, 0xce, 0x1e, 0xaf,
+	0x92, 0x59, 0x1a, 0x60, 0x3f, 0x88, 0xb8, 0x55, 0xc4, 0xe3, 0x55, 0x40, 0x0d, 0x86, 0x48, 0x76,
+	0xdf, 0x24, 0x13, 0x53, 0x54, 0x2f, 0xcc, 0xa0, 0xba, 0x79, 0xc1, 0x17, 0x85, 0xc4, 0x55, 0x49,
+	0x9f, 0xf1, 0x01, 0xe1, 0xa7, 0x53, 0x9f, 0x55, 0xae, 0x9c, 0xd2, 0x89, 0x6f, 0x29, 0xb3, 0xde,
+	0x75, 0x4b, 0xb3, 0xdf, 0x75, 0x79, 0x80, 0xe1, 0xbe, 0xa6, 0x3c, 0x3b, 0x19, 0x3d, 0xa6, 0x41,
+	0x7b, 0x80, 0x64, 0xae, 0x8c, 0x98, 0xdd, 0x0a, 0xb7, 0x5b, 0x93, 0xc8, 0xc3, 0x91, 0x79, 0x6c,
+	0x43, 0x25, 0xff, 0x7f, 0x1b, 0xea, 0xf6, 0x57, 0xf3, 0x90, 0x8a, 0xbf, 0xb0, 0x6e, 0x82, 0x5a,
+	0xd7, 0xef, 0x55, 0x6b, 0x46, 0xe3, 0xf8, 0x81, 0x5e, 0xad, 0x19, 0x0f, 0x8e, 0x1a, 0xf5, 0x5a,
+	0xf5, 0xde, 0xdd, 0x7b, 0xb5, 0x83, 0xdc, 0x1c, 0xda, 0x84, 0xb5, 0x31, 0xb4, 0x51, 0x3f, 0x3e,
+	0xc9, 0x29, 0x68, 0x03, 0x72, 0xe3, 0xea, 0xc3, 0x72, 0x6e, 0x7e, 0x4a, 0x5b, 0x3b, 0x2c, 0xe7,
+	0x16, 0xd0, 0x2d, 0xb8, 0x3e, 0xa6, 0x7d, 0x74, 0x58, 0x36, 0x2a, 0xe5, 0xfb, 0xe5, 0xa3, 0x6a,
+	0xed, 0x20, 0x97, 0x40, 0xdb, 0xb0, 0x35, 0x05, 0xeb, 0xb5, 0x6a, 0xed, 0xe8, 0x24, 0xb7, 0x38,
+	0x13, 0x3c, 0xbe, 0x7f, 0x50, 0x6b, 0x9c, 0xe4, 0x96, 0x66, 0x82, 0xd5, 0x07, 0x8d, 0x93, 0xe3,
+	0xc3, 0xdc, 0x32, 0xda, 0x82, 0xf5, 0x31, 0xf0, 0xb0, 0x76, 0x70, 0xaf, 0x7c, 0x94, 0x5b, 0x99,
+	0x5a, 0xd1, 0xc9, 0xa3, 0x72, 0x3d, 0x97, 0xac, 0x7c, 0xfa, 0xe2, 0xef, 0xf9, 0xb9, 0x17, 0xaf,
+	0xf2, 0xca, 0x37, 0xaf, 0xf2, 0xca, 0xdf, 0x5e, 0xe5, 0x95, 0x2f, 0x5f, 0xe7, 0xe7, 0xbe, 0x79,
+	0x9d, 0x9f, 0xfb, 0xcb, 0xeb, 0xfc, 0xdc, 0xe7, 0xb7, 0xe3, 0x3c, 0xf3, 0x2f, 0xce, 0xfc, 0xef,
+	0x9e, 0xeb, 0x59, 0xa4, 0x74, 0x16, 0x7d, 0x7e, 0xe6, 0x7c, 0x37, 0x97, 0xf8, 0x45, 0xfb, 0xd1,
+	0xbf, 0x07, 0x00, 0xf9, 0x5f, 0x2c, 0xa6, 0x9b, 0x16, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -703,6 +1066,26 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.AveragingWindow != that1.AveragingWindow {
 		return false
 	}
+	if this.MaxPriceAge != that1.MaxPriceAge {
+		return false
+	}
+	if !this.MaxPriceJump.Equal(that1.MaxPriceJump) {
+		return false
+	}
+	if len(this.TwapLookbacks) != len(that1.TwapLookbacks) {
+		return false
+	}
+	for i := range this.TwapLookbacks {
+		if this.TwapLookbacks[i] != that1.TwapLookbacks[i] {
+			return false
+		}
+	}
+	if this.FeePriceSource != that1.FeePriceSource {
+		return false
+	}
+	if this.FeeTwapLookback != that1.FeeTwapLookback {
+		return false
+	}
 	return true
 }
 func (this *ParamUpdatePlan) Equal(that interface{}) bool {
@@ -760,6 +1143,56 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.FeeTwapLookback, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.FeeTwapLookback):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintOracle(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	if m.FeePriceSource != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.FeePriceSource))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if len(m.TwapLookbacks) > 0 {
+		for iNdEx := len(m.TwapLookbacks) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TwapLookbacks[iNdEx], dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapLookbacks[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintOracle(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	{
+		size := m.MaxPriceJump.Size()
+		i -= size
+		if _, err := m.MaxPriceJump.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	if m.MaxPriceAge != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxPriceAge))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
 	if m.AveragingWindow != 0 {
 		i = encodeVarintOracle(dAtA, i, uint64(m.AveragingWindow))
 		i--
@@ -926,6 +1359,62 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TwapCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TwapCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwapCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintOracle(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Cumulative.Size()
+		i -= size
+		if _, err := m.Cumulative.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Denom) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1347,36 +1836,313 @@ func (m *ParamUpdatePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOracle(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PriceAverage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *PriceAverage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceAverage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.VotePeriod != 0 {
-		n += 1 + sovOracle(uint64(m.VotePeriod))
-	}
-	l = m.VoteThreshold.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if len(m.RewardBands) > 0 {
-		for _, e := range m.RewardBands {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
-		}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdatedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedTime):])
+	if err5 != nil {
+		return 0, err5
 	}
-	if m.RewardDistributionWindow != 0 {
-		n += 1 + sovOracle(uint64(m.RewardDistributionWindow))
+	i -= n5
+	i = encodeVarintOracle(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if m.UpdatedHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.UpdatedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceCircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceCircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceCircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintOracle(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Tripped {
+		i--
+		if m.Tripped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorOracleWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorOracleWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorOracleWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Slashed {
+		i--
+		if m.Slashed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.ValidRate.Size()
+		i -= size
+		if _, err := m.ValidRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.PossibleWins != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PossibleWins))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MissCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MissCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WindowEndHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.WindowEndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashWindowSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashWindowSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashWindowSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.SlashedValidators != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.SlashedValidators))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Validators != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Validators))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SlashingEnabled {
+		i--
+		if m.SlashingEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinValidPerWindow.Size()
+		i -= size
+		if _, err := m.MinValidPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.PossibleWins != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PossibleWins))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WindowEndHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.WindowEndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.WindowStartHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.WindowStartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOracle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotePeriod != 0 {
+		n += 1 + sovOracle(uint64(m.VotePeriod))
+	}
+	l = m.VoteThreshold.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if len(m.RewardBands) > 0 {
+		for _, e := range m.RewardBands {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.RewardDistributionWindow != 0 {
+		n += 1 + sovOracle(uint64(m.RewardDistributionWindow))
 	}
 	if len(m.AcceptList) > 0 {
 		for _, e := range m.AcceptList {
@@ -1431,6 +2197,40 @@ func (m *Params) Size() (n int) {
 	if m.AveragingWindow != 0 {
 		n += 2 + sovOracle(uint64(m.AveragingWindow))
 	}
+	if m.MaxPriceAge != 0 {
+		n += 2 + sovOracle(uint64(m.MaxPriceAge))
+	}
+	l = m.MaxPriceJump.Size()
+	n += 2 + l + sovOracle(uint64(l))
+	if len(m.TwapLookbacks) > 0 {
+		for _, e := range m.TwapLookbacks {
+			l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(e)
+			n += 2 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.FeePriceSource != 0 {
+		n += 2 + sovOracle(uint64(m.FeePriceSource))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.FeeTwapLookback)
+	n += 2 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *TwapCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.Cumulative.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovOracle(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovOracle(uint64(l))
 	return n
 }
 
@@ -1622,22 +2422,133 @@ func (m *ParamUpdatePlan) Size() (n int) {
 	return n
 }
 
-func sovOracle(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozOracle(x uint64) (n int) {
-	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *PriceAverage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Value.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.UpdatedHeight != 0 {
+		n += 1 + sovOracle(uint64(m.UpdatedHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedTime)
+	n += 1 + l + sovOracle(uint64(l))
+	return n
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
-			}
+
+func (m *PriceCircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Tripped {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovOracle(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *ValidatorOracleWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.WindowEndHeight != 0 {
+		n += 1 + sovOracle(uint64(m.WindowEndHeight))
+	}
+	if m.MissCount != 0 {
+		n += 1 + sovOracle(uint64(m.MissCount))
+	}
+	if m.PossibleWins != 0 {
+		n += 1 + sovOracle(uint64(m.PossibleWins))
+	}
+	l = m.ValidRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.Slashed {
+		n += 2
+	}
+	return n
+}
+
+func (m *SlashWindowSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WindowStartHeight != 0 {
+		n += 1 + sovOracle(uint64(m.WindowStartHeight))
+	}
+	if m.WindowEndHeight != 0 {
+		n += 1 + sovOracle(uint64(m.WindowEndHeight))
+	}
+	if m.PossibleWins != 0 {
+		n += 1 + sovOracle(uint64(m.PossibleWins))
+	}
+	l = m.MinValidPerWindow.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.SlashingEnabled {
+		n += 2
+	}
+	if m.Validators != 0 {
+		n += 1 + sovOracle(uint64(m.Validators))
+	}
+	if m.SlashedValidators != 0 {
+		n += 1 + sovOracle(uint64(m.SlashedValidators))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovOracle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOracle(x uint64) (n int) {
+	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
@@ -1941,7 +2852,1247 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MedianStampPeriod", wireType)
 			}
-			m.MedianStampPeriod = 0
+			m.MedianStampPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MedianStampPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPriceStamps", wireType)
+			}
+			m.MaximumPriceStamps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaximumPriceStamps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumMedianStamps", wireType)
+			}
+			m.MaximumMedianStamps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaximumMedianStamps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairProviders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPairProviders = append(m.CurrencyPairProviders, CurrencyPairProviders{})
+			if err := m.CurrencyPairProviders[len(m.CurrencyPairProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyDeviationThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyDeviationThresholds = append(m.CurrencyDeviationThresholds, CurrencyDeviationThreshold{})
+			if err := m.CurrencyDeviationThresholds[len(m.CurrencyDeviationThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdcIbcDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsdcIbcDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SlashingEnabled = bool(v != 0)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AveragingWindow", wireType)
+			}
+			m.AveragingWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AveragingWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
+			}
+			m.MaxPriceAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceJump", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceJump.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapLookbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TwapLookbacks = append(m.TwapLookbacks, time.Duration(0))
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&(m.TwapLookbacks[len(m.TwapLookbacks)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePriceSource", wireType)
+			}
+			m.FeePriceSource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeePriceSource |= PriceSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTwapLookback", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.FeeTwapLookback, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TwapCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwapCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwapCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cumulative", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cumulative.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Denom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Denom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Denom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbolDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbolDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			}
+			m.Exponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardBand) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardBand: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardBand: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbolDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbolDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateExchangeRatePrevote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitBlock", wireType)
+			}
+			m.SubmitBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRates = append(m.ExchangeRates, types1.DecCoin{})
+			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceStamp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceStamp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceStamp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExchangeRate == nil {
+				m.ExchangeRate = &types1.DecCoin{}
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNum", wireType)
+			}
+			m.BlockNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorRewardSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorRewardSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorRewardSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSet = append(m.ValidatorSet, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CurrencyPairProviders) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CurrencyPairProviders: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CurrencyPairProviders: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1951,35 +4102,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MedianStampPeriod |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPriceStamps", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
 			}
-			m.MaximumPriceStamps = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaximumPriceStamps |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
 			}
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaximumMedianStamps", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.MaximumMedianStamps = 0
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1989,14 +4134,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaximumMedianStamps |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairProviders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PairAddress", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2023,16 +4181,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrencyPairProviders = append(m.CurrencyPairProviders, CurrencyPairProviders{})
-			if err := m.CurrencyPairProviders[len(m.CurrencyPairProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PairAddress = append(m.PairAddress, PairAddressProvider{})
+			if err := m.PairAddress[len(m.PairAddress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyDeviationThresholds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2042,29 +4200,77 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrencyDeviationThresholds = append(m.CurrencyDeviationThresholds, CurrencyDeviationThreshold{})
-			if err := m.CurrencyDeviationThresholds[len(m.CurrencyDeviationThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Providers = append(m.Providers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 16:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairAddressProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairAddressProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairAddressProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsdcIbcDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2092,13 +4298,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UsdcIbcDenom = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashingEnabled", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressProvider", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2108,31 +4314,24 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SlashingEnabled = bool(v != 0)
-		case 18:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AveragingWindow", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
 			}
-			m.AveragingWindow = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AveragingWindow |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.AddressProvider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -2154,7 +4353,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Denom) Unmarshal(dAtA []byte) error {
+func (m *CurrencyDeviationThreshold) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2177,10 +4376,10 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Denom: wiretype end group for non-group")
+			return fmt.Errorf("proto: CurrencyDeviationThreshold: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Denom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CurrencyDeviationThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2217,7 +4416,7 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SymbolDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2245,27 +4444,8 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SymbolDenom = string(dAtA[iNdEx:postIndex])
+			m.Threshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
-			}
-			m.Exponent = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Exponent |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -2287,7 +4467,7 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RewardBand) Unmarshal(dAtA []byte) error {
+func (m *ParamUpdatePlan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2310,15 +4490,15 @@ func (m *RewardBand) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RewardBand: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParamUpdatePlan: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RewardBand: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParamUpdatePlan: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SymbolDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2346,13 +4526,32 @@ func (m *RewardBand) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SymbolDenom = string(dAtA[iNdEx:postIndex])
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2362,23 +4561,22 @@ func (m *RewardBand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Changes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2403,7 +4601,7 @@ func (m *RewardBand) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+func (m *PriceAverage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2426,15 +4624,15 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateExchangeRatePrevote: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceAverage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceAverage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2462,13 +4660,15 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedHeight", wireType)
 			}
-			var stringLen uint64
+			m.UpdatedHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2478,29 +4678,16 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.UpdatedHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitBlock", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedTime", wireType)
 			}
-			m.SubmitBlock = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2510,11 +4697,25 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SubmitBlock |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdatedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -2536,7 +4737,7 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
+func (m *PriceCircuitBreaker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2559,17 +4760,17 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceCircuitBreaker: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceCircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2579,29 +4780,47 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExchangeRates = append(m.ExchangeRates, types.DecCoin{})
-			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tripped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tripped = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2629,61 +4848,30 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PriceStamp) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PriceStamp: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriceStamp: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2710,32 +4898,10 @@ func (m *PriceStamp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExchangeRate == nil {
-				m.ExchangeRate = &types.DecCoin{}
-			}
-			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockNum", wireType)
-			}
-			m.BlockNum = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockNum |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -2757,7 +4923,7 @@ func (m *PriceStamp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorRewardSet) Unmarshal(dAtA []byte) error {
+func (m *ValidatorOracleWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2780,15 +4946,15 @@ func (m *ValidatorRewardSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorRewardSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorOracleWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorRewardSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorOracleWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2816,63 +4982,51 @@ func (m *ValidatorRewardSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSet = append(m.ValidatorSet, string(dAtA[iNdEx:postIndex]))
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowEndHeight", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CurrencyPairProviders) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
+			m.WindowEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowEndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissCount", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.MissCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CurrencyPairProviders: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CurrencyPairProviders: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PossibleWins", wireType)
 			}
-			var stringLen uint64
+			m.PossibleWins = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2882,27 +5036,14 @@ func (m *CurrencyPairProviders) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PossibleWins |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2930,11 +5071,13 @@ func (m *CurrencyPairProviders) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.ValidRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2961,16 +5104,16 @@ func (m *CurrencyPairProviders) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PairAddress = append(m.PairAddress, PairAddressProvider{})
-			if err := m.PairAddress[len(m.PairAddress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, types1.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2980,24 +5123,12 @@ func (m *CurrencyPairProviders) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Providers = append(m.Providers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.Slashed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -3019,7 +5150,7 @@ func (m *CurrencyPairProviders) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PairAddressProvider) Unmarshal(dAtA []byte) error {
+func (m *SlashWindowSummary) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3042,17 +5173,17 @@ func (m *PairAddressProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PairAddressProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlashWindowSummary: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PairAddressProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlashWindowSummary: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStartHeight", wireType)
 			}
-			var stringLen uint64
+			m.WindowStartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -3062,29 +5193,16 @@ func (m *PairAddressProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.WindowStartHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddressProvider", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowEndHeight", wireType)
 			}
-			var stringLen uint64
+			m.WindowEndHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -3094,77 +5212,33 @@ func (m *PairAddressProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.WindowEndHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AddressProvider = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CurrencyDeviationThreshold) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PossibleWins", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PossibleWins = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PossibleWins |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CurrencyDeviationThreshold: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CurrencyDeviationThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3192,11 +5266,13 @@ func (m *CurrencyDeviationThreshold) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.MinValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3224,63 +5300,15 @@ func (m *CurrencyDeviationThreshold) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Threshold = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ParamUpdatePlan) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ParamUpdatePlan: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamUpdatePlan: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingEnabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -3290,29 +5318,36 @@ func (m *ParamUpdatePlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
+			m.SlashingEnabled = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Validators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Validators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedValidators", wireType)
 			}
-			m.Height = 0
+			m.SlashedValidators = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -3322,14 +5357,14 @@ func (m *ParamUpdatePlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				m.SlashedValidators |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3356,7 +5391,8 @@ func (m *ParamUpdatePlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Changes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, types1.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,20 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+
+	"github.com/cheqd/cheqd-node/util"
+)
+
+// MaxOracleHistoryWindows is the number of past slash windows the oracle history of each
+// validator, and the slash window summaries, are kept for: a year of the default weekly window.
+const MaxOracleHistoryWindows = 52
+
+// ValidRate returns the share of the possible wins of a slash window which were not missed
+func ValidRate(possibleWins int64, missCount uint64) math.LegacyDec {
+	if possibleWins <= 0 {
+		return math.LegacyOneDec()
+	}
+	validVotes := math.NewInt(possibleWins - util.SafeUint64ToInt64(missCount))
+	return math.LegacyNewDecFromInt(validVotes).QuoInt64(possibleWins)
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	fmt "fmt"
+	"time"
 
 	"cosmossdk.io/math"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -39,6 +40,11 @@ var (
 	KeyUsdcIbcDenom                = []byte("UsdcIbcDenom")
 	KeySlashingEnabled             = []byte("SlashingEnabled")
 	KeyAveragingWindow             = []byte("KeyAveragingWindow")
+	KeyMaxPriceAge                 = []byte("MaxPriceAge")
+	KeyMaxPriceJump                = []byte("MaxPriceJump")
+	KeyTwapLookbacks               = []byte("TwapLookbacks")
+	KeyFeePriceSource              = []byte("FeePriceSource")
+	KeyFeeTwapLookback             = []byte("FeeTwapLookback")
 )
 
 // Default parameter values
@@ -51,6 +57,15 @@ const (
 	DefaultMedianStampPeriod        = BlocksPerHour * 3   // window for 3 hours
 	DefaultMaximumMedianStamps      = 24                  // retain for 3 days
 	DefaultAveragingWindow          = 3
+	DefaultMaxPriceAge              = BlocksPerHour // freeze after an hour without new averages
+	DefaultFeeTwapLookback          = time.Hour
+	DefaultFeePriceSource           = PriceSource_PRICE_SOURCE_WMA_BALANCED
+
+	// MaxTwapLookback is the longest lookback a TWAP can be computed over,
+	// as its checkpoints are retained for the longest lookback
+	MaxTwapLookback = 30 * 24 * time.Hour
+	// MaxTwapLookbacks is the maximum number of TWAP lookbacks
+	MaxTwapLookbacks = 8
 )
 
 // Default parameter values
@@ -94,6 +109,8 @@ var (
 	DefaultSlashFraction     = math.LegacyNewDecWithPrec(1, 4) // 0.01%
 	DefaultMinValidPerWindow = math.LegacyNewDecWithPrec(5, 2) // 5%
 	defaultRewardBand        = math.LegacyNewDecWithPrec(2, 2) // 0.02
+	DefaultMaxPriceJump      = math.LegacyNewDecWithPrec(5, 1) // 50%
+	DefaultTwapLookbacks     = []time.Duration{time.Hour, 24 * time.Hour, 7 * 24 * time.Hour}
 
 	DefaultCurrencyPairProviders = CurrencyPairProvidersList{
 		CurrencyPairProviders{
@@ -209,6 +226,11 @@ func DefaultParams() Params {
 		UsdcIbcDenom:                "ibc/F5FABF52B54E65064B57BF6DBD8E5FAD22CEE9F4B8A57ADBB20CCD0173AA72A4",
 		SlashingEnabled:             false,
 		AveragingWindow:             DefaultAveragingWindow,
+		MaxPriceAge:                 DefaultMaxPriceAge,
+		MaxPriceJump:                DefaultMaxPriceJump,
+		TwapLookbacks:               DefaultTwapLookbacks,
+		FeePriceSource:              DefaultFeePriceSource,
+		FeeTwapLookback:             DefaultFeeTwapLookback,
 	}
 }
 
@@ -311,6 +333,31 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 			&p.AveragingWindow,
 			validateAveragingWindow,
 		),
+		paramstypes.NewParamSetPair(
+			KeyMaxPriceAge,
+			&p.MaxPriceAge,
+			validateMaxPriceAge,
+		),
+		paramstypes.NewParamSetPair(
+			KeyMaxPriceJump,
+			&p.MaxPriceJump,
+			validateMaxPriceJump,
+		),
+		paramstypes.NewParamSetPair(
+			KeyTwapLookbacks,
+			&p.TwapLookbacks,
+			validateTwapLookbacks,
+		),
+		paramstypes.NewParamSetPair(
+			KeyFeePriceSource,
+			&p.FeePriceSource,
+			validateFeePriceSource,
+		),
+		paramstypes.NewParamSetPair(
+			KeyFeeTwapLookback,
+			&p.FeeTwapLookback,
+			validateTwapLookback,
+		),
 	}
 }
 
@@ -384,6 +431,30 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	// averages must be given the chance to be recomputed before they are considered stale
+	if p.MaxPriceAge != 0 && p.MaxPriceAge < p.HistoricStampPeriod*p.AveragingWindow {
+		return ErrInvalidParamValue.Wrap(
+			"oracle parameter MaxPriceAge must be 0 or greater than or equal with HistoricStampPeriod * AveragingWindow",
+		)
+	}
+
+	if err := validateMaxPriceJump(p.MaxPriceJump); err != nil {
+		return err
+	}
+
+	if err := validateTwapLookbacks(p.TwapLookbacks); err != nil {
+		return err
+	}
+
+	if err := validateFeePriceSource(p.FeePriceSource); err != nil {
+		return err
+	}
+
+	// the fee TWAP is only stored for the configured lookbacks
+	if p.FeePriceSource == PriceSource_PRICE_SOURCE_TWAP && !ContainsLookback(p.TwapLookbacks, p.FeeTwapLookback) {
+		return ErrInvalidParamValue.Wrap("oracle parameter FeeTwapLookback must be one of TwapLookbacks")
+	}
+
 	return nil
 }
 
@@ -650,3 +721,90 @@ func validateAveragingWindow(i interface{}) error {
 
 	return nil
 }
+
+func validateMaxPriceAge(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return ErrInvalidParamValue.Wrapf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxPriceJump(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return ErrInvalidParamValue.Wrapf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return ErrInvalidParamValue.Wrap("oracle parameter MaxPriceJump must be >= 0")
+	}
+
+	return nil
+}
+
+func validateTwapLookback(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return ErrInvalidParamValue.Wrapf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 || v > MaxTwapLookback {
+		return ErrInvalidParamValue.Wrapf("oracle parameter TWAP lookback must be between (0, %s]", MaxTwapLookback)
+	}
+
+	return nil
+}
+
+func validateTwapLookbacks(i interface{}) error {
+	v, ok := i.([]time.Duration)
+	if !ok {
+		return ErrInvalidParamValue.Wrapf("invalid parameter type: %T", i)
+	}
+
+	if len(v) > MaxTwapLookbacks {
+		return ErrInvalidParamValue.Wrapf("oracle parameter TwapLookbacks must have at most %d lookbacks", MaxTwapLookbacks)
+	}
+
+	for i, lookback := range v {
+		if err := validateTwapLookback(lookback); err != nil {
+			return err
+		}
+		if ContainsLookback(v[:i], lookback) {
+			return ErrInvalidParamValue.Wrapf("oracle parameter TwapLookbacks has duplicate lookback %s", lookback)
+		}
+	}
+
+	return nil
+}
+
+func validateFeePriceSource(i interface{}) error {
+	v, ok := i.(PriceSource)
+	if !ok {
+		return ErrInvalidParamValue.Wrapf("invalid parameter type: %T", i)
+	}
+
+	// fees can only be converted with the moving averages stored every historic stamp period
+	switch v {
+	case PriceSource_PRICE_SOURCE_SMA,
+		PriceSource_PRICE_SOURCE_EMA,
+		PriceSource_PRICE_SOURCE_WMA_BALANCED,
+		PriceSource_PRICE_SOURCE_WMA_RECENT,
+		PriceSource_PRICE_SOURCE_WMA_OLDEST,
+		PriceSource_PRICE_SOURCE_TWAP:
+		return nil
+	default:
+		return ErrInvalidParamValue.Wrapf("oracle parameter FeePriceSource cannot be %s", v)
+	}
+}
+
+// ContainsLookback returns whether a list of TWAP lookbacks contains a given lookback
+func ContainsLookback(lookbacks []time.Duration, lookback time.Duration) bool {
+	for _, l := range lookbacks {
+		if l == lookback {
+			return true
+		}
+	}
+	return false
+}
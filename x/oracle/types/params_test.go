@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -320,3 +321,40 @@ func TestValidateAveragingWindow(t *testing.T) {
 	err = validateSlashWindow(uint64(10))
 	require.Nil(t, err)
 }
+
+func TestValidateTwapLookbacks(t *testing.T) {
+	err := validateTwapLookbacks("invalidDurations")
+	require.ErrorContains(t, err, "invalid parameter type: string")
+
+	err = validateTwapLookbacks([]time.Duration{time.Hour, 0})
+	require.ErrorContains(t, err, "oracle parameter TWAP lookback must be between")
+
+	err = validateTwapLookbacks([]time.Duration{MaxTwapLookback + time.Hour})
+	require.ErrorContains(t, err, "oracle parameter TWAP lookback must be between")
+
+	err = validateTwapLookbacks([]time.Duration{time.Hour, time.Hour})
+	require.ErrorContains(t, err, "oracle parameter TwapLookbacks has duplicate lookback 1h0m0s")
+
+	err = validateTwapLookbacks(DefaultTwapLookbacks)
+	require.Nil(t, err)
+}
+
+func TestValidateFeePriceSource(t *testing.T) {
+	err := validateFeePriceSource("invalidPriceSource")
+	require.ErrorContains(t, err, "invalid parameter type: string")
+
+	err = validateFeePriceSource(PriceSource_PRICE_SOURCE_SPOT)
+	require.ErrorContains(t, err, "oracle parameter FeePriceSource cannot be PRICE_SOURCE_SPOT")
+
+	err = validateFeePriceSource(PriceSource_PRICE_SOURCE_TWAP)
+	require.Nil(t, err)
+
+	// the fee TWAP must be one of the computed TWAPs
+	p := DefaultParams()
+	p.AcceptList, p.MandatoryList = DenomList{}, DenomList{}
+	p.FeePriceSource = PriceSource_PRICE_SOURCE_TWAP
+	require.Nil(t, p.Validate())
+
+	p.FeeTwapLookback = 2 * time.Hour
+	require.ErrorContains(t, p.Validate(), "oracle parameter FeeTwapLookback must be one of TwapLookbacks")
+}
@@ -102,6 +102,31 @@ func (p ParamUpdatePlan) ValidateBasic() error {
 				return err
 			}
 
+		case string(KeyMaxPriceAge):
+			if err := validateMaxPriceAge(p.Changes.MaxPriceAge); err != nil {
+				return err
+			}
+
+		case string(KeyMaxPriceJump):
+			if err := validateMaxPriceJump(p.Changes.MaxPriceJump); err != nil {
+				return err
+			}
+
+		case string(KeyTwapLookbacks):
+			if err := validateTwapLookbacks(p.Changes.TwapLookbacks); err != nil {
+				return err
+			}
+
+		case string(KeyFeePriceSource):
+			if err := validateFeePriceSource(p.Changes.FeePriceSource); err != nil {
+				return err
+			}
+
+		case string(KeyFeeTwapLookback):
+			if err := validateTwapLookback(p.Changes.FeeTwapLookback); err != nil {
+				return err
+			}
+
 		default:
 			return fmt.Errorf("%s is not an existing oracle param key", key)
 		}
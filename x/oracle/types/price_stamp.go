@@ -1,12 +1,20 @@
 package types
 
 import (
+	"fmt"
 	"sort"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+const (
+	// PriceSeriesHistoric is the series of historic price stamps, stamped every historic stamp period
+	PriceSeriesHistoric = "historic"
+	// PriceSeriesMedian is the series of medians of the historic prices, stamped every median stamp period
+	PriceSeriesMedian = "median"
+)
+
 type PriceStamps []PriceStamp
 
 func NewPriceStamp(exchangeRate math.LegacyDec, denom string, blockNum uint64) *PriceStamp {
@@ -81,3 +89,32 @@ func (p *PriceStamps) NewestBlockNum() uint64 {
 	}
 	return blockNum
 }
+
+// DownSample returns the first of the PriceStamps, sorted by block number, within every step blocks.
+// A zero step returns all the PriceStamps.
+func (p *PriceStamps) DownSample(step uint64) *PriceStamps {
+	if step == 0 {
+		return p
+	}
+
+	priceStamps := PriceStamps{}
+	nextBlock := uint64(0)
+	for _, priceStamp := range *p {
+		if len(priceStamps) > 0 && priceStamp.BlockNum < nextBlock {
+			continue
+		}
+		priceStamps = append(priceStamps, priceStamp)
+		nextBlock = priceStamp.BlockNum + step
+	}
+	return &priceStamps
+}
+
+// ValidatePriceSeries returns an error if the series is neither the historic prices nor the medians
+func ValidatePriceSeries(series string) error {
+	switch series {
+	case PriceSeriesHistoric, PriceSeriesMedian:
+		return nil
+	default:
+		return fmt.Errorf("invalid price series %s, expected %s or %s", series, PriceSeriesHistoric, PriceSeriesMedian)
+	}
+}
@@ -10,9 +10,12 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	_ "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -20,12 +23,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -432,6 +437,172 @@ func (m *QuerySlashWindowResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QuerySlashWindowResponse proto.InternalMessageInfo
 
+// QueryValidatorOracleHistory is the request type for the
+// Query/ValidatorOracleHistory RPC method.
+type QueryValidatorOracleHistory struct {
+	// validator defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	// pagination defines an optional pagination for the past slash windows,
+	// oldest first
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorOracleHistory) Reset()         { *m = QueryValidatorOracleHistory{} }
+func (m *QueryValidatorOracleHistory) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorOracleHistory) ProtoMessage()    {}
+func (*QueryValidatorOracleHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90255b46abbbaf03, []int{10}
+}
+func (m *QueryValidatorOracleHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorOracleHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorOracleHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorOracleHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorOracleHistory.Merge(m, src)
+}
+func (m *QueryValidatorOracleHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorOracleHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorOracleHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorOracleHistory proto.InternalMessageInfo
+
+// QueryValidatorOracleHistoryResponse is the response type for the
+// Query/ValidatorOracleHistory RPC method.
+type QueryValidatorOracleHistoryResponse struct {
+	// current defines the performance over the slash window in progress
+	Current ValidatorOracleWindow `protobuf:"bytes,1,opt,name=current,proto3" json:"current"`
+	// history defines the performance over the past slash windows
+	History    []ValidatorOracleWindow `protobuf:"bytes,2,rep,name=history,proto3" json:"history"`
+	Pagination *query.PageResponse     `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorOracleHistoryResponse) Reset()         { *m = QueryValidatorOracleHistoryResponse{} }
+func (m *QueryValidatorOracleHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorOracleHistoryResponse) ProtoMessage()    {}
+func (*QueryValidatorOracleHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90255b46abbbaf03, []int{11}
+}
+func (m *QueryValidatorOracleHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorOracleHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorOracleHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorOracleHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorOracleHistoryResponse.Merge(m, src)
+}
+func (m *QueryValidatorOracleHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorOracleHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorOracleHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorOracleHistoryResponse proto.InternalMessageInfo
+
+// QuerySlashWindowSummary is the request type for the
+// Query/SlashWindowSummary RPC method.
+type QuerySlashWindowSummary struct {
+	// window_end_height defines the last block of the slash window to query for,
+	// the latest slash window if unset
+	WindowEndHeight uint64 `protobuf:"varint,1,opt,name=window_end_height,json=windowEndHeight,proto3" json:"window_end_height,omitempty"`
+}
+
+func (m *QuerySlashWindowSummary) Reset()         { *m = QuerySlashWindowSummary{} }
+func (m *QuerySlashWindowSummary) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashWindowSummary) ProtoMessage()    {}
+func (*QuerySlashWindowSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90255b46abbbaf03, []int{12}
+}
+func (m *QuerySlashWindowSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashWindowSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashWindowSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashWindowSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashWindowSummary.Merge(m, src)
+}
+func (m *QuerySlashWindowSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashWindowSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashWindowSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashWindowSummary proto.InternalMessageInfo
+
+// QuerySlashWindowSummaryResponse is the response type for the
+// Query/SlashWindowSummary RPC method.
+type QuerySlashWindowSummaryResponse struct {
+	Summary SlashWindowSummary `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary"`
+}
+
+func (m *QuerySlashWindowSummaryResponse) Reset()         { *m = QuerySlashWindowSummaryResponse{} }
+func (m *QuerySlashWindowSummaryResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashWindowSummaryResponse) ProtoMessage()    {}
+func (*QuerySlashWindowSummaryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90255b46abbbaf03, []int{13}
+}
+func (m *QuerySlashWindowSummaryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashWindowSummaryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashWindowSummaryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashWindowSummaryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashWindowSummaryResponse.Merge(m, src)
+}
+func (m *QuerySlashWindowSummaryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashWindowSummaryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashWindowSummaryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashWindowSummaryResponse proto.InternalMessageInfo
+
 // QueryAggregatePrevote is the request type for the
 // Query/AggregatePrevote RPC method.
 type QueryAggregatePrevote struct {
@@ -443,7 +614,7 @@ func (m *QueryAggregatePrevote) Reset()         { *m = QueryAggregatePrevote{} }
 func (m *QueryAggregatePrevote) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevote) ProtoMessage()    {}
 func (*QueryAggregatePrevote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90255b46abbbaf03, []int{10}
+	return fileDescriptor_90255b46abbbaf03, []int{14}
 }
 func (m *QueryAggregatePrevote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -484,7 +655,7 @@ func (m *QueryAggregatePrevoteResponse) Reset()         { *m = QueryAggregatePre
 func (m *QueryAggregatePrevoteResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevoteResponse) ProtoMessage()    {}
 func (*QueryAggregatePrevoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90255b46abbbaf03, []int{11}
+	return fileDescriptor_90255b46abbbaf03, []int{15}
 }
 func (m *QueryAggregatePrevoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -522,7 +693,7 @@ func (m *QueryAggregatePrevotes) Reset()         { *m = QueryAggregatePrevotes{}
 func (m *QueryAggregatePrevotes) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevotes) ProtoMessage()    {}
 func (*QueryAggregatePrevotes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90255b46abbbaf03, []int{12}
+	return fileDescriptor_90255b46abbbaf03, []int{16}
 }
 func (m *QueryAggregatePrevotes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -563,7 +734,7 @@ func (m *QueryAggregatePrevotesResponse) Reset()         { *m = QueryAggregatePr
 func (m *QueryAggregatePrevotesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevotesResponse) ProtoMessage()    {}
 func (*QueryAggregatePrevotesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90255b46abbbaf03, []int{13}
+	return fileDescriptor_90255b46abbbaf03, []int{17}
 }
 func (m *QueryAggregatePrevotesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -603,7 +774,7 @@ func (m *QueryAggregateVote) Reset()         { *m = QueryAggregateVote{} }
 func (m *QueryAggregateVote) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVote) ProtoMessage()    {}
 func (*QueryAggregateVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90255b46abbbaf03, []int{14}
+	return fileDescriptor_90255b46abbbaf03, []int{18}
 }
 func (m *QueryAggregateVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -644,7 +815,7 @@ func (m *QueryAggregateVoteResponse) Reset()         { *m = QueryAggregateVoteRe
 func (m *QueryAggregateVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVoteResponse) ProtoMessage()    {}
 func (*QueryAggregateVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90255b46abbbaf03, []int{15}
+	return fileDescriptor_90255b46abbbaf03, []int{19}
 }
 func (m *QueryAggregateVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -682,7 +853,7 @@ func (m *QueryAggregateVotes) Reset()         { *m = QueryAggregateVotes{} }
 func (m *QueryAggregateVotes) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVotes) ProtoMessage()    {}
 func (*QueryAggregateVotes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90255b46abbbaf03, []int{16}
+	return fileDescriptor_90255b46abbbaf03, []int{20}
 }
 func (m *QueryAggregateVotes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -723,7 +894,7 @@ func (m *QueryAggregateVotesResponse) Reset()         { *m = QueryAggregateVotes
 func (m *QueryAggregateVotesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVotesResponse) ProtoMessage()    {}
 func (*QueryAggregateVotesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90255b46abbbaf03, []int{17}
+	return fileDescriptor_90255b46abbbaf03, []int{21}
 }
 func (m *QueryAggregateVotesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -760,7 +931,7 @@ func (m *QueryParams) Reset()         { *m = QueryParams{} }
 func (m *QueryParams) String() string { return proto.CompactTextString(m) }
 func (*QueryParams) ProtoMessage()    {}
 func (*QueryParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90255b46abbbaf03, []int{18}
+	return fileDescriptor_90255b46abbbaf03, []int{22}
 }
 func (m *QueryParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -799,7 +970,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90255b46abbbaf03, []int{19}
+	return fileDescriptor_90255b46abbbaf03, []int{23}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -841,7 +1012,7 @@ func (m *QueryMedians) Reset()         { *m = QueryMedians{} }
 func (m *QueryMedians) String() string { return proto.CompactTextString(m) }
 func (*QueryMedians) ProtoMessage()    {}
 func (*QueryMedians) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90255b46abbbaf03, []int{20}
+	return fileDescriptor_90255b46abbbaf03, []int{24}
 }
 func (m *QueryMedians) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -881,7 +1052,7 @@ func (m *QueryMediansResponse) Reset()         { *m = QueryMediansResponse{} }
 func (m *QueryMediansResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMediansResponse) ProtoMessage()    {}
 func (*QueryMediansResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90255b46abbbaf03, []int{21}
+	return fileDescriptor_90255b46abbbaf03, []int{25}
 }
 func (m *QueryMediansResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -921,7 +1092,7 @@ func (m *QueryMedianDeviations) Reset()         { *m = QueryMedianDeviations{} }
 func (m *QueryMedianDeviations) String() string { return proto.CompactTextString(m) }
 func (*QueryMedianDeviations) ProtoMessage()    {}
 func (*QueryMedianDeviations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90255b46abbbaf03, []int{22}
+	return fileDescriptor_90255b46abbbaf03, []int{26}
 }
 func (m *QueryMedianDeviations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -961,7 +1132,7 @@ func (m *QueryMedianDeviationsResponse) Reset()         { *m = QueryMedianDeviat
 func (m *QueryMedianDeviationsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMedianDeviationsResponse) ProtoMessage()    {}
 func (*QueryMedianDeviationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90255b46abbbaf03, []int{23}
+	return fileDescriptor_90255b46abbbaf03, []int{27}
 }
 func (m *QueryMedianDeviationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -998,7 +1169,7 @@ func (m *QueryValidatorRewardSet) Reset()         { *m = QueryValidatorRewardSet
 func (m *QueryValidatorRewardSet) String() string { return proto.CompactTextString(m) }
 func (*QueryValidatorRewardSet) ProtoMessage()    {}
 func (*QueryValidatorRewardSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90255b46abbbaf03, []int{24}
+	return fileDescriptor_90255b46abbbaf03, []int{28}
 }
 func (m *QueryValidatorRewardSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1036,7 +1207,7 @@ func (m *QueryValidatorRewardSetResponse) Reset()         { *m = QueryValidatorR
 func (m *QueryValidatorRewardSetResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryValidatorRewardSetResponse) ProtoMessage()    {}
 func (*QueryValidatorRewardSetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90255b46abbbaf03, []int{25}
+	return fileDescriptor_90255b46abbbaf03, []int{29}
 }
 func (m *QueryValidatorRewardSetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1073,7 +1244,7 @@ func (m *QueryEMARequest) Reset()         { *m = QueryEMARequest{} }
 func (m *QueryEMARequest) String() string { return proto.CompactTextString(m) }
 func (*QueryEMARequest) ProtoMessage()    {}
 func (*QueryEMARequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90255b46abbbaf03, []int{26}
+	return fileDescriptor_90255b46abbbaf03, []int{30}
 }
 func (m *QueryEMARequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1110,7 +1281,7 @@ func (m *QueryEMAResponse) Reset()         { *m = QueryEMAResponse{} }
 func (m *QueryEMAResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryEMAResponse) ProtoMessage()    {}
 func (*QueryEMAResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90255b46abbbaf03, []int{27}
+	return fileDescriptor_90255b46abbbaf03, []int{31}
 }
 func (m *QueryEMAResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1149,7 +1320,7 @@ func (m *QueryWMARequest) Reset()         { *m = QueryWMARequest{} }
 func (m *QueryWMARequest) String() string { return proto.CompactTextString(m) }
 func (*QueryWMARequest) ProtoMessage()    {}
 func (*QueryWMARequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90255b46abbbaf03, []int{28}
+	return fileDescriptor_90255b46abbbaf03, []int{32}
 }
 func (m *QueryWMARequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1186,7 +1357,7 @@ func (m *QueryWMAResponse) Reset()         { *m = QueryWMAResponse{} }
 func (m *QueryWMAResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryWMAResponse) ProtoMessage()    {}
 func (*QueryWMAResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90255b46abbbaf03, []int{29}
+	return fileDescriptor_90255b46abbbaf03, []int{33}
 }
 func (m *QueryWMAResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1223,7 +1394,7 @@ func (m *QuerySMARequest) Reset()         { *m = QuerySMARequest{} }
 func (m *QuerySMARequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySMARequest) ProtoMessage()    {}
 func (*QuerySMARequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90255b46abbbaf03, []int{30}
+	return fileDescriptor_90255b46abbbaf03, []int{34}
 }
 func (m *QuerySMARequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1260,7 +1431,7 @@ func (m *QuerySMAResponse) Reset()         { *m = QuerySMAResponse{} }
 func (m *QuerySMAResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySMAResponse) ProtoMessage()    {}
 func (*QuerySMAResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90255b46abbbaf03, []int{31}
+	return fileDescriptor_90255b46abbbaf03, []int{35}
 }
 func (m *QuerySMAResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1289,6 +1460,125 @@ func (m *QuerySMAResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QuerySMAResponse proto.InternalMessageInfo
 
+type QueryTWAPRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// lookback duration of the time-weighted average price, all configured
+	// lookbacks are returned if unset
+	Lookback time.Duration `protobuf:"bytes,2,opt,name=lookback,proto3,stdduration" json:"lookback"`
+}
+
+func (m *QueryTWAPRequest) Reset()         { *m = QueryTWAPRequest{} }
+func (m *QueryTWAPRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTWAPRequest) ProtoMessage()    {}
+func (*QueryTWAPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90255b46abbbaf03, []int{36}
+}
+func (m *QueryTWAPRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTWAPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTWAPRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTWAPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTWAPRequest.Merge(m, src)
+}
+func (m *QueryTWAPRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTWAPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTWAPRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTWAPRequest proto.InternalMessageInfo
+
+// TwapPrice defines the time-weighted average price of a denom over a lookback duration
+type TwapPrice struct {
+	Lookback time.Duration               `protobuf:"bytes,1,opt,name=lookback,proto3,stdduration" json:"lookback"`
+	Price    cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+	// Block height and time the average was last computed at
+	UpdatedHeight int64     `protobuf:"varint,3,opt,name=updated_height,json=updatedHeight,proto3" json:"updated_height,omitempty"`
+	UpdatedTime   time.Time `protobuf:"bytes,4,opt,name=updated_time,json=updatedTime,proto3,stdtime" json:"updated_time"`
+}
+
+func (m *TwapPrice) Reset()         { *m = TwapPrice{} }
+func (m *TwapPrice) String() string { return proto.CompactTextString(m) }
+func (*TwapPrice) ProtoMessage()    {}
+func (*TwapPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90255b46abbbaf03, []int{37}
+}
+func (m *TwapPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwapPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TwapPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapPrice.Merge(m, src)
+}
+func (m *TwapPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwapPrice proto.InternalMessageInfo
+
+type QueryTWAPResponse struct {
+	Prices []TwapPrice `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices"`
+}
+
+func (m *QueryTWAPResponse) Reset()         { *m = QueryTWAPResponse{} }
+func (m *QueryTWAPResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTWAPResponse) ProtoMessage()    {}
+func (*QueryTWAPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90255b46abbbaf03, []int{38}
+}
+func (m *QueryTWAPResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTWAPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTWAPResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTWAPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTWAPResponse.Merge(m, src)
+}
+func (m *QueryTWAPResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTWAPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTWAPResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTWAPResponse proto.InternalMessageInfo
+
 type ConvertUSDCtoCHEQRequest struct {
 	Amount        string  `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
 	MaType        string  `protobuf:"bytes,2,opt,name=ma_type,json=maType,proto3" json:"ma_type,omitempty"`
@@ -1300,7 +1590,7 @@ func (m *ConvertUSDCtoCHEQRequest) Reset()         { *m = ConvertUSDCtoCHEQReque
 func (m *ConvertUSDCtoCHEQRequest) String() string { return proto.CompactTextString(m) }
 func (*ConvertUSDCtoCHEQRequest) ProtoMessage()    {}
 func (*ConvertUSDCtoCHEQRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90255b46abbbaf03, []int{32}
+	return fileDescriptor_90255b46abbbaf03, []int{39}
 }
 func (m *ConvertUSDCtoCHEQRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1337,7 +1627,7 @@ func (m *ConvertUSDCtoCHEQResponse) Reset()         { *m = ConvertUSDCtoCHEQResp
 func (m *ConvertUSDCtoCHEQResponse) String() string { return proto.CompactTextString(m) }
 func (*ConvertUSDCtoCHEQResponse) ProtoMessage()    {}
 func (*ConvertUSDCtoCHEQResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90255b46abbbaf03, []int{33}
+	return fileDescriptor_90255b46abbbaf03, []int{40}
 }
 func (m *ConvertUSDCtoCHEQResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1366,149 +1656,659 @@ func (m *ConvertUSDCtoCHEQResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ConvertUSDCtoCHEQResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryExchangeRates)(nil), "cheqd.oracle.v2.QueryExchangeRates")
-	proto.RegisterType((*QueryExchangeRatesResponse)(nil), "cheqd.oracle.v2.QueryExchangeRatesResponse")
-	proto.RegisterType((*QueryActiveExchangeRates)(nil), "cheqd.oracle.v2.QueryActiveExchangeRates")
-	proto.RegisterType((*QueryActiveExchangeRatesResponse)(nil), "cheqd.oracle.v2.QueryActiveExchangeRatesResponse")
-	proto.RegisterType((*QueryFeederDelegation)(nil), "cheqd.oracle.v2.QueryFeederDelegation")
-	proto.RegisterType((*QueryFeederDelegationResponse)(nil), "cheqd.oracle.v2.QueryFeederDelegationResponse")
-	proto.RegisterType((*QueryMissCounter)(nil), "cheqd.oracle.v2.QueryMissCounter")
-	proto.RegisterType((*QueryMissCounterResponse)(nil), "cheqd.oracle.v2.QueryMissCounterResponse")
-	proto.RegisterType((*QuerySlashWindow)(nil), "cheqd.oracle.v2.QuerySlashWindow")
-	proto.RegisterType((*QuerySlashWindowResponse)(nil), "cheqd.oracle.v2.QuerySlashWindowResponse")
-	proto.RegisterType((*QueryAggregatePrevote)(nil), "cheqd.oracle.v2.QueryAggregatePrevote")
-	proto.RegisterType((*QueryAggregatePrevoteResponse)(nil), "cheqd.oracle.v2.QueryAggregatePrevoteResponse")
-	proto.RegisterType((*QueryAggregatePrevotes)(nil), "cheqd.oracle.v2.QueryAggregatePrevotes")
-	proto.RegisterType((*QueryAggregatePrevotesResponse)(nil), "cheqd.oracle.v2.QueryAggregatePrevotesResponse")
-	proto.RegisterType((*QueryAggregateVote)(nil), "cheqd.oracle.v2.QueryAggregateVote")
-	proto.RegisterType((*QueryAggregateVoteResponse)(nil), "cheqd.oracle.v2.QueryAggregateVoteResponse")
-	proto.RegisterType((*QueryAggregateVotes)(nil), "cheqd.oracle.v2.QueryAggregateVotes")
-	proto.RegisterType((*QueryAggregateVotesResponse)(nil), "cheqd.oracle.v2.QueryAggregateVotesResponse")
-	proto.RegisterType((*QueryParams)(nil), "cheqd.oracle.v2.QueryParams")
-	proto.RegisterType((*QueryParamsResponse)(nil), "cheqd.oracle.v2.QueryParamsResponse")
-	proto.RegisterType((*QueryMedians)(nil), "cheqd.oracle.v2.QueryMedians")
-	proto.RegisterType((*QueryMediansResponse)(nil), "cheqd.oracle.v2.QueryMediansResponse")
-	proto.RegisterType((*QueryMedianDeviations)(nil), "cheqd.oracle.v2.QueryMedianDeviations")
-	proto.RegisterType((*QueryMedianDeviationsResponse)(nil), "cheqd.oracle.v2.QueryMedianDeviationsResponse")
-	proto.RegisterType((*QueryValidatorRewardSet)(nil), "cheqd.oracle.v2.QueryValidatorRewardSet")
-	proto.RegisterType((*QueryValidatorRewardSetResponse)(nil), "cheqd.oracle.v2.QueryValidatorRewardSetResponse")
-	proto.RegisterType((*QueryEMARequest)(nil), "cheqd.oracle.v2.QueryEMARequest")
-	proto.RegisterType((*QueryEMAResponse)(nil), "cheqd.oracle.v2.QueryEMAResponse")
-	proto.RegisterType((*QueryWMARequest)(nil), "cheqd.oracle.v2.QueryWMARequest")
-	proto.RegisterType((*QueryWMAResponse)(nil), "cheqd.oracle.v2.QueryWMAResponse")
-	proto.RegisterType((*QuerySMARequest)(nil), "cheqd.oracle.v2.QuerySMARequest")
-	proto.RegisterType((*QuerySMAResponse)(nil), "cheqd.oracle.v2.QuerySMAResponse")
-	proto.RegisterType((*ConvertUSDCtoCHEQRequest)(nil), "cheqd.oracle.v2.ConvertUSDCtoCHEQRequest")
-	proto.RegisterType((*ConvertUSDCtoCHEQResponse)(nil), "cheqd.oracle.v2.ConvertUSDCtoCHEQResponse")
+type QueryConvertRequest struct {
+	// amount to convert, e.g. "1000000000000000000usd" or "1000000000ncheq"
+	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	// base denom to convert to, e.g. "ncheq" or "usd"
+	ToDenom string `protobuf:"bytes,2,opt,name=to_denom,json=toDenom,proto3" json:"to_denom,omitempty"`
+	// price source the USD prices of both denoms are taken from
+	PriceSource PriceSource `protobuf:"varint,3,opt,name=price_source,json=priceSource,proto3,enum=cheqd.oracle.v2.PriceSource" json:"price_source,omitempty"`
+	// weights of the historic prices, only used with PRICE_SOURCE_WMA_CUSTOM
+	CustomWeights []int32 `protobuf:"varint,4,rep,packed,name=custom_weights,json=customWeights,proto3" json:"custom_weights,omitempty"`
+	// lookback duration of the time-weighted average price, only used with PRICE_SOURCE_TWAP
+	TwapLookback time.Duration `protobuf:"bytes,5,opt,name=twap_lookback,json=twapLookback,proto3,stdduration" json:"twap_lookback"`
 }
 
-func init() { proto.RegisterFile("cheqd/oracle/v2/query.proto", fileDescriptor_90255b46abbbaf03) }
+func (m *QueryConvertRequest) Reset()         { *m = QueryConvertRequest{} }
+func (m *QueryConvertRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConvertRequest) ProtoMessage()    {}
+func (*QueryConvertRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90255b46abbbaf03, []int{41}
+}
+func (m *QueryConvertRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConvertRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConvertRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConvertRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConvertRequest.Merge(m, src)
+}
+func (m *QueryConvertRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConvertRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConvertRequest.DiscardUnknown(m)
+}
 
-var fileDescriptor_90255b46abbbaf03 = []byte{
-	// 1582 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0x4d, 0x6f, 0xd4, 0x56,
-	0x17, 0xc7, 0x63, 0x86, 0x04, 0x72, 0x86, 0xc9, 0xcb, 0xe5, 0x25, 0x89, 0x93, 0xcc, 0x04, 0x43,
-	0x48, 0x20, 0x4f, 0xc6, 0x24, 0x79, 0xe8, 0x1b, 0xa2, 0x22, 0x99, 0x84, 0x52, 0x89, 0x54, 0x30,
-	0xd3, 0x12, 0xb5, 0x5d, 0xb8, 0x37, 0xf6, 0xed, 0xc4, 0x25, 0xb6, 0x07, 0x5f, 0xcf, 0x24, 0x51,
-	0xca, 0xa2, 0xa8, 0x8b, 0x2e, 0x2b, 0x55, 0x45, 0x62, 0x47, 0x17, 0x6d, 0x25, 0x96, 0x55, 0x3f,
-	0x04, 0x4b, 0xd4, 0x6e, 0xaa, 0x2e, 0x68, 0x0b, 0x5d, 0xf4, 0x0b, 0x74, 0x5f, 0xf9, 0xde, 0x3b,
-	0x8e, 0x67, 0x6c, 0x67, 0x1c, 0x24, 0x36, 0x10, 0x9f, 0x73, 0xee, 0x39, 0xbf, 0x73, 0x7c, 0x7d,
-	0xef, 0x5f, 0x03, 0xa3, 0xfa, 0x06, 0xb9, 0x6b, 0xa8, 0x8e, 0x8b, 0xf5, 0x4d, 0xa2, 0x36, 0xe6,
-	0xd5, 0xbb, 0x75, 0xe2, 0xee, 0x14, 0x6b, 0xae, 0xe3, 0x39, 0xa8, 0x9f, 0x39, 0x8b, 0xdc, 0x59,
-	0x6c, 0xcc, 0xcb, 0x63, 0xed, 0xd1, 0xc2, 0xc5, 0xc2, 0xe5, 0xbc, 0xee, 0x50, 0xcb, 0xa1, 0xea,
-	0x3a, 0xa6, 0x44, 0x6d, 0xcc, 0xad, 0x13, 0x0f, 0xcf, 0xa9, 0xba, 0x63, 0xda, 0xc2, 0x3f, 0xc2,
-	0xfd, 0x1a, 0x7b, 0x52, 0xf9, 0x83, 0x70, 0x9d, 0xa8, 0x3a, 0x55, 0x87, 0xdb, 0xfd, 0xbf, 0x84,
-	0x75, 0xac, 0xea, 0x38, 0xd5, 0x4d, 0xa2, 0xe2, 0x9a, 0xa9, 0x62, 0xdb, 0x76, 0x3c, 0xec, 0x99,
-	0x8e, 0x2d, 0xd6, 0x28, 0xff, 0x07, 0x74, 0xcb, 0x87, 0x5d, 0xd9, 0xd6, 0x37, 0xb0, 0x5d, 0x25,
-	0x65, 0xec, 0x11, 0x8a, 0x4e, 0x40, 0xb7, 0x41, 0x6c, 0xc7, 0x1a, 0x96, 0x26, 0xa4, 0xe9, 0xde,
-	0x32, 0x7f, 0x78, 0xeb, 0xe8, 0x57, 0x8f, 0x0a, 0x5d, 0xff, 0x3c, 0x2a, 0x74, 0x29, 0x0f, 0x24,
-	0x90, 0xa3, 0xcb, 0xca, 0x84, 0xd6, 0x1c, 0x9b, 0x12, 0xb4, 0x0d, 0x7d, 0x44, 0x38, 0x34, 0xd7,
-	0xf7, 0x0c, 0x4b, 0x13, 0x99, 0xe9, 0xec, 0xfc, 0x58, 0x51, 0xf0, 0xfa, 0xcd, 0x15, 0x45, 0x73,
-	0xc5, 0x65, 0xa2, 0x97, 0x1c, 0xd3, 0x5e, 0x5a, 0x78, 0xf2, 0xac, 0xd0, 0xf5, 0xf8, 0x8f, 0xc2,
-	0x4c, 0xd5, 0xf4, 0x36, 0xea, 0xeb, 0x45, 0xdd, 0xb1, 0x44, 0x7f, 0xe2, 0xbf, 0x59, 0x6a, 0xdc,
-	0x51, 0xbd, 0x9d, 0x1a, 0xa1, 0xcd, 0x35, 0xb4, 0x9c, 0x23, 0x61, 0x02, 0x45, 0x86, 0x61, 0xc6,
-	0xb5, 0xa8, 0x7b, 0x66, 0x83, 0xb4, 0xd0, 0x29, 0x2b, 0x30, 0x91, 0xe4, 0x0b, 0xc8, 0x4f, 0xc3,
-	0x31, 0xcc, 0xdc, 0x21, 0xee, 0xde, 0x72, 0x96, 0xdb, 0x78, 0x9a, 0xeb, 0x70, 0x92, 0xa5, 0xb9,
-	0x46, 0x88, 0x41, 0xdc, 0x65, 0xb2, 0x49, 0xaa, 0x6c, 0xa2, 0x68, 0x12, 0xfa, 0x1a, 0x78, 0xd3,
-	0x34, 0xb0, 0xe7, 0xb8, 0x1a, 0x36, 0x0c, 0x57, 0x4c, 0x2f, 0x17, 0x58, 0x17, 0x0d, 0xc3, 0x0d,
-	0x4d, 0xf1, 0x2a, 0x8c, 0xc7, 0x66, 0x0a, 0x68, 0x0a, 0x90, 0xfd, 0x94, 0xf9, 0xc2, 0xe9, 0x80,
-	0x9b, 0xfc, 0x5c, 0x4a, 0x09, 0x06, 0x58, 0x86, 0x55, 0x93, 0xd2, 0x92, 0x53, 0xb7, 0x3d, 0xe2,
-	0x1e, 0x1c, 0xe3, 0x8a, 0x98, 0x59, 0x28, 0x49, 0x78, 0x1e, 0x96, 0x49, 0xa9, 0xa6, 0x73, 0x3b,
-	0x4b, 0x75, 0xb8, 0x9c, 0xb5, 0xf6, 0x42, 0x15, 0x24, 0x18, 0x2a, 0x9b, 0x98, 0x6e, 0xac, 0x99,
-	0xb6, 0xe1, 0x6c, 0x29, 0x25, 0x91, 0x32, 0x64, 0x0b, 0x52, 0x4e, 0x41, 0xff, 0x16, 0xb3, 0xf8,
-	0x5b, 0xb8, 0xea, 0x12, 0x4a, 0x45, 0xd6, 0x3e, 0x6e, 0xbe, 0x29, 0xac, 0xc1, 0xa0, 0x17, 0xab,
-	0x55, 0xd7, 0x9f, 0x0c, 0xb9, 0xe9, 0x92, 0x86, 0xe3, 0x91, 0x83, 0x77, 0xf8, 0x85, 0x24, 0x26,
-	0xdd, 0x9e, 0x2a, 0x80, 0xfa, 0x04, 0x06, 0x71, 0xd3, 0xa7, 0xd5, 0xb8, 0x93, 0x65, 0xcd, 0xce,
-	0xcf, 0x16, 0xdb, 0x3e, 0xe0, 0x62, 0x90, 0x25, 0xbc, 0x87, 0x44, 0xc6, 0xa5, 0xc3, 0xfe, 0x2e,
-	0x2e, 0x0f, 0xe0, 0xb6, 0x4a, 0xca, 0x30, 0x9c, 0x8a, 0x45, 0xa0, 0xca, 0x97, 0x12, 0xe4, 0xe3,
-	0x5d, 0x01, 0xde, 0x3a, 0xa0, 0x08, 0x5e, 0xf3, 0xa3, 0x7a, 0x29, 0xbe, 0x41, 0x1c, 0xc1, 0x58,
-	0x11, 0x27, 0x41, 0xb0, 0xfa, 0xf6, 0x4b, 0xcd, 0xba, 0x2e, 0x4e, 0x86, 0x96, 0x34, 0x41, 0x23,
-	0x6b, 0xd0, 0xb7, 0xd7, 0x48, 0x68, 0xc8, 0x17, 0xd2, 0x35, 0x71, 0x7b, 0xaf, 0x83, 0x1c, 0x0e,
-	0x17, 0x50, 0x4e, 0xc2, 0xf1, 0x68, 0x59, 0xaa, 0x6c, 0xc3, 0x68, 0x8c, 0x39, 0xc0, 0xf9, 0x10,
-	0xfa, 0x5b, 0x71, 0x9a, 0x43, 0x3d, 0x38, 0x4f, 0x1f, 0x6e, 0xad, 0x9c, 0x83, 0x2c, 0xab, 0x7c,
-	0x13, 0xbb, 0xd8, 0xa2, 0xca, 0x0d, 0xc1, 0xc7, 0x1f, 0x03, 0x80, 0x4b, 0xd0, 0x53, 0x63, 0x16,
-	0x31, 0x87, 0xa1, 0x48, 0x5d, 0xbe, 0x40, 0x14, 0x11, 0xc1, 0xca, 0x2a, 0x1c, 0xe3, 0x9f, 0x2c,
-	0x31, 0x4c, 0x6c, 0x27, 0x9c, 0xd7, 0x68, 0x1c, 0xc0, 0xae, 0x5b, 0x1a, 0xf5, 0xb0, 0x55, 0xa3,
-	0xc3, 0x87, 0x26, 0xa4, 0xe9, 0x5c, 0xb9, 0xd7, 0xae, 0x5b, 0x15, 0x66, 0x08, 0xbd, 0xb3, 0x0a,
-	0x9c, 0x08, 0xa7, 0x0b, 0xe8, 0x2e, 0xc3, 0x11, 0x8b, 0x9b, 0xc4, 0x58, 0x46, 0xa3, 0x78, 0xae,
-	0xa9, 0x13, 0x96, 0x50, 0x20, 0x36, 0x57, 0x28, 0xaf, 0x8b, 0xcf, 0x97, 0x27, 0x5d, 0x26, 0x0d,
-	0x93, 0x5f, 0x3c, 0x1d, 0x2f, 0x17, 0x47, 0x7c, 0xac, 0xed, 0x0b, 0x03, 0xac, 0xf7, 0x60, 0x90,
-	0x17, 0xd1, 0x8c, 0xc0, 0x99, 0x1e, 0x70, 0xc0, 0x6a, 0xcb, 0xab, 0x8c, 0xc0, 0x10, 0x2b, 0x78,
-	0xbb, 0xb9, 0xa5, 0xcb, 0x64, 0x0b, 0xbb, 0x46, 0x85, 0x78, 0xca, 0x26, 0x14, 0x12, 0x5c, 0x01,
-	0xcd, 0xbb, 0x00, 0xc1, 0xb7, 0xd0, 0x7c, 0x8d, 0x67, 0x22, 0x18, 0xd1, 0x04, 0x02, 0x27, 0xb4,
-	0x58, 0x99, 0x82, 0x7e, 0x7e, 0xab, 0xae, 0x2e, 0x96, 0xc9, 0xdd, 0x3a, 0xa1, 0x5e, 0xfc, 0xb0,
-	0x94, 0x8f, 0xc5, 0x99, 0xcb, 0x02, 0x05, 0xc7, 0x3b, 0xd0, 0x5d, 0xf3, 0x7b, 0xe5, 0x91, 0x4b,
-	0x73, 0x7e, 0xf6, 0xdf, 0x9f, 0x15, 0x46, 0xf9, 0xdd, 0x49, 0x8d, 0x3b, 0x45, 0xd3, 0x51, 0x2d,
-	0xec, 0x6d, 0x14, 0x6f, 0x90, 0x2a, 0xd6, 0x77, 0x96, 0x89, 0xfe, 0xcb, 0xcf, 0xb3, 0x20, 0x6e,
-	0xe4, 0x65, 0xa2, 0x97, 0xf9, 0x7a, 0xe5, 0x33, 0x41, 0xb1, 0xd6, 0x81, 0x02, 0xc9, 0x70, 0x94,
-	0x7a, 0xfe, 0x3d, 0x59, 0xdd, 0x61, 0xbb, 0xab, 0xb7, 0x1c, 0x3c, 0xfb, 0xe7, 0x86, 0x5e, 0xa7,
-	0x9e, 0x63, 0x69, 0x5b, 0xc4, 0xac, 0x6e, 0x78, 0x74, 0x38, 0x33, 0x91, 0x99, 0xce, 0x94, 0x73,
-	0xdc, 0xba, 0xc6, 0x8d, 0x41, 0x23, 0x6b, 0xaf, 0xa2, 0x91, 0xe6, 0x38, 0x2b, 0x69, 0xc7, 0x59,
-	0x79, 0x15, 0x14, 0xdf, 0x4a, 0x30, 0x5c, 0x72, 0xec, 0x06, 0x71, 0xbd, 0x0f, 0x2a, 0xcb, 0x25,
-	0xcf, 0x29, 0x5d, 0x5f, 0xb9, 0xd5, 0xe4, 0x39, 0x05, 0x3d, 0xd8, 0xf2, 0x2f, 0x52, 0x01, 0x24,
-	0x9e, 0xd0, 0x10, 0x1c, 0xb1, 0xb0, 0xe6, 0x6b, 0x1d, 0x31, 0xd9, 0x1e, 0x0b, 0xbf, 0xbf, 0x53,
-	0x63, 0x17, 0xf2, 0x96, 0x85, 0xb5, 0x60, 0xee, 0x19, 0xe6, 0xcd, 0x6e, 0x59, 0xb8, 0x92, 0x3c,
-	0xfa, 0xc3, 0x13, 0x99, 0xe9, 0xee, 0xf6, 0xd1, 0x2f, 0xc0, 0x48, 0x0c, 0x96, 0xe8, 0x3e, 0x81,
-	0x6b, 0xfe, 0xdf, 0xe3, 0xd0, 0xcd, 0x46, 0x85, 0x1e, 0x4a, 0x90, 0x6b, 0x15, 0x8d, 0xd1, 0x4d,
-	0x1f, 0x95, 0x88, 0xf2, 0x4c, 0x8a, 0xa0, 0x26, 0x85, 0xf2, 0xda, 0xfd, 0x5f, 0xff, 0xfe, 0xe6,
-	0xd0, 0x45, 0x54, 0x54, 0xdb, 0x25, 0x33, 0x7b, 0x6f, 0x54, 0x6d, 0x55, 0x99, 0xea, 0x2e, 0x33,
-	0xdf, 0x43, 0x8f, 0x25, 0x38, 0x1e, 0xa3, 0xf2, 0xd0, 0xf9, 0xf8, 0xe2, 0x31, 0xa1, 0xf2, 0x5c,
-	0xea, 0xd0, 0x80, 0xf6, 0x12, 0xa3, 0x55, 0xd1, 0x6c, 0x12, 0xad, 0x50, 0x96, 0xad, 0xd0, 0xe8,
-	0x47, 0x09, 0x06, 0x22, 0x5a, 0xf2, 0x5c, 0x7c, 0xf9, 0xf6, 0x38, 0xb9, 0x98, 0x2e, 0x2e, 0x60,
-	0xbc, 0xcc, 0x18, 0x2f, 0xa1, 0x85, 0x08, 0xe3, 0xde, 0x31, 0xa4, 0xee, 0xb6, 0xde, 0xf8, 0xf7,
-	0x54, 0xae, 0x38, 0xd1, 0x03, 0x09, 0xb2, 0x61, 0xa5, 0x79, 0x3a, 0xbe, 0x78, 0x28, 0x44, 0x3e,
-	0xdf, 0x31, 0x24, 0x40, 0x7b, 0x93, 0xa1, 0x2d, 0xa0, 0xb9, 0x03, 0xa1, 0xf9, 0x4a, 0x14, 0xdd,
-	0x97, 0x20, 0x1b, 0x92, 0x9a, 0x49, 0x60, 0xa1, 0x90, 0x24, 0xb0, 0x18, 0xc1, 0xaa, 0x4c, 0x32,
-	0xb0, 0x02, 0x1a, 0x8f, 0x80, 0x51, 0x3f, 0x5a, 0xe3, 0xb2, 0x15, 0xfd, 0x24, 0xc1, 0x40, 0x44,
-	0xaa, 0x26, 0xbc, 0xc7, 0xf6, 0xb8, 0xa4, 0xf7, 0x98, 0xa4, 0x57, 0x95, 0x6b, 0x8c, 0xe9, 0x2a,
-	0x7a, 0xfb, 0x40, 0xc3, 0x8a, 0x68, 0x48, 0xf4, 0xbd, 0x04, 0x83, 0x11, 0xd9, 0x89, 0xa6, 0xd2,
-	0xd1, 0x50, 0x59, 0x4d, 0x19, 0x98, 0xe2, 0x8b, 0x0e, 0x71, 0x47, 0xa5, 0x2e, 0xfa, 0x41, 0x82,
-	0x5c, 0xab, 0x30, 0x3d, 0xd3, 0xa1, 0xb4, 0x1f, 0x94, 0x74, 0xda, 0xc4, 0x6a, 0x53, 0xa5, 0xc4,
-	0xd8, 0xae, 0xa0, 0xcb, 0x71, 0x6c, 0x86, 0xd9, 0x71, 0xa6, 0x6c, 0xa0, 0x0f, 0x25, 0xe8, 0x6b,
-	0x15, 0x9b, 0xe8, 0x6c, 0x0a, 0x08, 0x2a, 0xff, 0x2f, 0x4d, 0x54, 0xc0, 0xba, 0xc0, 0x58, 0x67,
-	0xd1, 0x4c, 0xba, 0x39, 0xf2, 0x21, 0x5a, 0xd0, 0xc3, 0xd5, 0x24, 0x1a, 0x8b, 0x2f, 0xc6, 0xbd,
-	0xf2, 0xd9, 0xfd, 0xbc, 0x01, 0x42, 0x81, 0x21, 0x8c, 0xa0, 0xa1, 0x08, 0x02, 0x17, 0xa9, 0xe8,
-	0x73, 0x38, 0xd2, 0xd4, 0xa7, 0xe3, 0x09, 0xc7, 0x00, 0x77, 0xcb, 0x93, 0xfb, 0xba, 0x83, 0x8a,
-	0x33, 0xac, 0xe2, 0x24, 0x3a, 0x23, 0x2a, 0x6e, 0x98, 0xd4, 0x8b, 0x1c, 0xb2, 0x42, 0x7e, 0xa2,
-	0xef, 0x24, 0x18, 0x88, 0x48, 0xcf, 0x73, 0xfb, 0x15, 0xda, 0x8b, 0x4b, 0xfa, 0x1c, 0x93, 0x14,
-	0x69, 0xe4, 0xe8, 0xdf, 0x87, 0x2c, 0xa4, 0x59, 0xfd, 0x7b, 0x0a, 0x45, 0x85, 0x21, 0x9a, 0x8e,
-	0xaf, 0x1e, 0x8d, 0x94, 0x2f, 0xa6, 0x8d, 0x0c, 0x48, 0xdf, 0x60, 0xa4, 0xf3, 0xe8, 0x62, 0xdb,
-	0x5b, 0x9b, 0x0b, 0x6f, 0xf2, 0xbd, 0x3d, 0xee, 0xb2, 0x04, 0x1a, 0x25, 0x1e, 0xaa, 0x41, 0x66,
-	0x65, 0x75, 0x11, 0x4d, 0x24, 0x5c, 0xe0, 0x81, 0xc6, 0x92, 0x4f, 0xef, 0x13, 0x21, 0x28, 0xce,
-	0x32, 0x8a, 0x3c, 0x1a, 0x8b, 0xec, 0x1d, 0x62, 0xe1, 0xe0, 0x1a, 0xdf, 0x85, 0xcc, 0x5a, 0x72,
-	0xc5, 0xb5, 0x8e, 0x15, 0x43, 0xa2, 0x52, 0x51, 0x59, 0xc5, 0xf3, 0x68, 0x2a, 0x52, 0x71, 0x6b,
-	0xaf, 0xa2, 0xba, 0xdb, 0xd4, 0x55, 0xf7, 0xfc, 0x76, 0x2b, 0xc9, 0xc5, 0x2b, 0x1d, 0x8b, 0x57,
-	0x52, 0xb5, 0x4b, 0x43, 0xed, 0x3e, 0x94, 0x60, 0x30, 0xa2, 0xc8, 0x62, 0x34, 0x4b, 0x92, 0x98,
-	0x94, 0x2f, 0xa4, 0x09, 0x15, 0x48, 0xb3, 0x0c, 0x69, 0x0a, 0x4d, 0x46, 0x90, 0x74, 0xbe, 0x46,
-	0xab, 0x53, 0x43, 0xd7, 0x3c, 0x47, 0xf3, 0xfd, 0x4b, 0xd7, 0x9f, 0xfc, 0x95, 0xef, 0x7a, 0xf2,
-	0x3c, 0x2f, 0x3d, 0x7d, 0x9e, 0x97, 0xfe, 0x7c, 0x9e, 0x97, 0xbe, 0x7e, 0x91, 0xef, 0x7a, 0xfa,
-	0x22, 0xdf, 0xf5, 0xdb, 0x8b, 0x7c, 0xd7, 0x47, 0x17, 0xc2, 0xbf, 0xd8, 0xb1, 0x74, 0xec, 0xdf,
-	0x59, 0xdb, 0x31, 0x88, 0xba, 0xdd, 0xcc, 0xcd, 0x7e, 0xb9, 0x5b, 0xef, 0x61, 0xbf, 0x3b, 0x2e,
-	0xfc, 0x17, 0x00, 0x00, 0xff, 0xff, 0x93, 0x87, 0x56, 0x23, 0x34, 0x15, 0x00, 0x00,
+var xxx_messageInfo_QueryConvertRequest proto.InternalMessageInfo
+
+// ConversionPrice defines the USD price of a denom used in a conversion
+type ConversionPrice struct {
+	// symbol denom the price is for, e.g. "CHEQ"
+	Denom string                      `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+	// block height the price was computed at
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// number of blocks since the price was computed
+	Age uint64 `protobuf:"varint,4,opt,name=age,proto3" json:"age,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
+func (m *ConversionPrice) Reset()         { *m = ConversionPrice{} }
+func (m *ConversionPrice) String() string { return proto.CompactTextString(m) }
+func (*ConversionPrice) ProtoMessage()    {}
+func (*ConversionPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90255b46abbbaf03, []int{42}
+}
+func (m *ConversionPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConversionPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConversionPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConversionPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConversionPrice.Merge(m, src)
+}
+func (m *ConversionPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConversionPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConversionPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConversionPrice proto.InternalMessageInfo
+
+type QueryConvertResponse struct {
+	// converted amount, truncated to the base unit of the target denom
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+	// price of one unit of the source denom in units of the target denom
+	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+	// age of the older of the two USD prices, in blocks
+	Age uint64 `protobuf:"varint,3,opt,name=age,proto3" json:"age,omitempty"`
+	// USD prices of the source and target denoms
+	FromPrice ConversionPrice `protobuf:"bytes,4,opt,name=from_price,json=fromPrice,proto3" json:"from_price"`
+	ToPrice   ConversionPrice `protobuf:"bytes,5,opt,name=to_price,json=toPrice,proto3" json:"to_price"`
+}
+
+func (m *QueryConvertResponse) Reset()         { *m = QueryConvertResponse{} }
+func (m *QueryConvertResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConvertResponse) ProtoMessage()    {}
+func (*QueryConvertResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90255b46abbbaf03, []int{43}
+}
+func (m *QueryConvertResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConvertResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConvertResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConvertResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConvertResponse.Merge(m, src)
+}
+func (m *QueryConvertResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConvertResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConvertResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConvertResponse proto.InternalMessageInfo
+
+type QueryPriceHealthRequest struct {
+	// denom defines the symbol denom to query for, e.g. "CHEQ".
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryPriceHealthRequest) Reset()         { *m = QueryPriceHealthRequest{} }
+func (m *QueryPriceHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceHealthRequest) ProtoMessage()    {}
+func (*QueryPriceHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90255b46abbbaf03, []int{44}
+}
+func (m *QueryPriceHealthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceHealthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceHealthRequest.Merge(m, src)
+}
+func (m *QueryPriceHealthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceHealthRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceHealthRequest proto.InternalMessageInfo
+
+type QueryPriceHealthResponse struct {
+	// Moving average of the denom identity fees are converted with
+	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+	// Block height and time the moving averages were last computed at
+	UpdatedHeight int64     `protobuf:"varint,2,opt,name=updated_height,json=updatedHeight,proto3" json:"updated_height,omitempty"`
+	UpdatedTime   time.Time `protobuf:"bytes,3,opt,name=updated_time,json=updatedTime,proto3,stdtime" json:"updated_time"`
+	// Number of blocks since the moving averages were last computed
+	Age            uint64              `protobuf:"varint,4,opt,name=age,proto3" json:"age,omitempty"`
+	CircuitBreaker PriceCircuitBreaker `protobuf:"bytes,5,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker"`
+	// Whether the price may be used for USD-denominated fee conversion
+	Healthy bool `protobuf:"varint,6,opt,name=healthy,proto3" json:"healthy,omitempty"`
+}
+
+func (m *QueryPriceHealthResponse) Reset()         { *m = QueryPriceHealthResponse{} }
+func (m *QueryPriceHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceHealthResponse) ProtoMessage()    {}
+func (*QueryPriceHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90255b46abbbaf03, []int{45}
+}
+func (m *QueryPriceHealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceHealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceHealthResponse.Merge(m, src)
+}
+func (m *QueryPriceHealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceHealthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceHealthResponse proto.InternalMessageInfo
+
+// PricePoint defines a historic price stamp or median of a denom at the block
+// it was stamped in.
+type PricePoint struct {
+	Price    cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+	BlockNum uint64                      `protobuf:"varint,2,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	// time of the block, unset for stamps recorded before block times were indexed
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *PricePoint) Reset()         { *m = PricePoint{} }
+func (m *PricePoint) String() string { return proto.CompactTextString(m) }
+func (*PricePoint) ProtoMessage()    {}
+func (*PricePoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90255b46abbbaf03, []int{46}
+}
+func (m *PricePoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PricePoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PricePoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PricePoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PricePoint.Merge(m, src)
+}
+func (m *PricePoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *PricePoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_PricePoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PricePoint proto.InternalMessageInfo
+
+type QueryPriceAtRequest struct {
+	// denom defines the symbol denom to query for, e.g. "CHEQ".
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// block height to query the price at. Exactly one of height and time must be set.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// time to query the price at. Only stamps recorded after block times were indexed
+	// can be found by time, earlier stamps must be queried by height.
+	Time *time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time,omitempty"`
+	// series to query: "historic" (default) for the historic price stamps, or "median" for the medians
+	Series string `protobuf:"bytes,4,opt,name=series,proto3" json:"series,omitempty"`
+}
+
+func (m *QueryPriceAtRequest) Reset()         { *m = QueryPriceAtRequest{} }
+func (m *QueryPriceAtRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceAtRequest) ProtoMessage()    {}
+func (*QueryPriceAtRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90255b46abbbaf03, []int{47}
+}
+func (m *QueryPriceAtRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceAtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceAtRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceAtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceAtRequest.Merge(m, src)
+}
+func (m *QueryPriceAtRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceAtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceAtRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceAtRequest proto.InternalMessageInfo
+
+type QueryPriceAtResponse struct {
+	Price PricePoint `protobuf:"bytes,1,opt,name=price,proto3" json:"price"`
+}
+
+func (m *QueryPriceAtResponse) Reset()         { *m = QueryPriceAtResponse{} }
+func (m *QueryPriceAtResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceAtResponse) ProtoMessage()    {}
+func (*QueryPriceAtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90255b46abbbaf03, []int{48}
+}
+func (m *QueryPriceAtResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceAtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceAtResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceAtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceAtResponse.Merge(m, src)
+}
+func (m *QueryPriceAtResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceAtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceAtResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceAtResponse proto.InternalMessageInfo
+
+type QueryPriceRangeRequest struct {
+	// denom defines the symbol denom to query for, e.g. "CHEQ".
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// block range to query, both inclusive. A zero end height defaults to the current block.
+	StartHeight uint64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight   uint64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// time range to query, both inclusive. Cannot be combined with a block range.
+	// Only stamps recorded after block times were indexed can be found by time,
+	// earlier stamps must be queried by height.
+	StartTime *time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	EndTime   *time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
+	// series to query: "historic" (default) for the historic price stamps, or "median" for the medians
+	Series string `protobuf:"bytes,6,opt,name=series,proto3" json:"series,omitempty"`
+	// step down-samples the series to the first stamp of every step blocks. Zero returns every stamp.
+	Step       uint64             `protobuf:"varint,7,opt,name=step,proto3" json:"step,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,8,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPriceRangeRequest) Reset()         { *m = QueryPriceRangeRequest{} }
+func (m *QueryPriceRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceRangeRequest) ProtoMessage()    {}
+func (*QueryPriceRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90255b46abbbaf03, []int{49}
+}
+func (m *QueryPriceRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceRangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceRangeRequest.Merge(m, src)
+}
+func (m *QueryPriceRangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceRangeRequest proto.InternalMessageInfo
+
+type QueryPriceRangeResponse struct {
+	Prices     []PricePoint        `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPriceRangeResponse) Reset()         { *m = QueryPriceRangeResponse{} }
+func (m *QueryPriceRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceRangeResponse) ProtoMessage()    {}
+func (*QueryPriceRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90255b46abbbaf03, []int{50}
+}
+func (m *QueryPriceRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceRangeResponse.Merge(m, src)
+}
+func (m *QueryPriceRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceRangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceRangeResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*QueryExchangeRates)(nil), "cheqd.oracle.v2.QueryExchangeRates")
+	proto.RegisterType((*QueryExchangeRatesResponse)(nil), "cheqd.oracle.v2.QueryExchangeRatesResponse")
+	proto.RegisterType((*QueryActiveExchangeRates)(nil), "cheqd.oracle.v2.QueryActiveExchangeRates")
+	proto.RegisterType((*QueryActiveExchangeRatesResponse)(nil), "cheqd.oracle.v2.QueryActiveExchangeRatesResponse")
+	proto.RegisterType((*QueryFeederDelegation)(nil), "cheqd.oracle.v2.QueryFeederDelegation")
+	proto.RegisterType((*QueryFeederDelegationResponse)(nil), "cheqd.oracle.v2.QueryFeederDelegationResponse")
+	proto.RegisterType((*QueryMissCounter)(nil), "cheqd.oracle.v2.QueryMissCounter")
+	proto.RegisterType((*QueryMissCounterResponse)(nil), "cheqd.oracle.v2.QueryMissCounterResponse")
+	proto.RegisterType((*QuerySlashWindow)(nil), "cheqd.oracle.v2.QuerySlashWindow")
+	proto.RegisterType((*QuerySlashWindowResponse)(nil), "cheqd.oracle.v2.QuerySlashWindowResponse")
+	proto.RegisterType((*QueryValidatorOracleHistory)(nil), "cheqd.oracle.v2.QueryValidatorOracleHistory")
+	proto.RegisterType((*QueryValidatorOracleHistoryResponse)(nil), "cheqd.oracle.v2.QueryValidatorOracleHistoryResponse")
+	proto.RegisterType((*QuerySlashWindowSummary)(nil), "cheqd.oracle.v2.QuerySlashWindowSummary")
+	proto.RegisterType((*QuerySlashWindowSummaryResponse)(nil), "cheqd.oracle.v2.QuerySlashWindowSummaryResponse")
+	proto.RegisterType((*QueryAggregatePrevote)(nil), "cheqd.oracle.v2.QueryAggregatePrevote")
+	proto.RegisterType((*QueryAggregatePrevoteResponse)(nil), "cheqd.oracle.v2.QueryAggregatePrevoteResponse")
+	proto.RegisterType((*QueryAggregatePrevotes)(nil), "cheqd.oracle.v2.QueryAggregatePrevotes")
+	proto.RegisterType((*QueryAggregatePrevotesResponse)(nil), "cheqd.oracle.v2.QueryAggregatePrevotesResponse")
+	proto.RegisterType((*QueryAggregateVote)(nil), "cheqd.oracle.v2.QueryAggregateVote")
+	proto.RegisterType((*QueryAggregateVoteResponse)(nil), "cheqd.oracle.v2.QueryAggregateVoteResponse")
+	proto.RegisterType((*QueryAggregateVotes)(nil), "cheqd.oracle.v2.QueryAggregateVotes")
+	proto.RegisterType((*QueryAggregateVotesResponse)(nil), "cheqd.oracle.v2.QueryAggregateVotesResponse")
+	proto.RegisterType((*QueryParams)(nil), "cheqd.oracle.v2.QueryParams")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cheqd.oracle.v2.QueryParamsResponse")
+	proto.RegisterType((*QueryMedians)(nil), "cheqd.oracle.v2.QueryMedians")
+	proto.RegisterType((*QueryMediansResponse)(nil), "cheqd.oracle.v2.QueryMediansResponse")
+	proto.RegisterType((*QueryMedianDeviations)(nil), "cheqd.oracle.v2.QueryMedianDeviations")
+	proto.RegisterType((*QueryMedianDeviationsResponse)(nil), "cheqd.oracle.v2.QueryMedianDeviationsResponse")
+	proto.RegisterType((*QueryValidatorRewardSet)(nil), "cheqd.oracle.v2.QueryValidatorRewardSet")
+	proto.RegisterType((*QueryValidatorRewardSetResponse)(nil), "cheqd.oracle.v2.QueryValidatorRewardSetResponse")
+	proto.RegisterType((*QueryEMARequest)(nil), "cheqd.oracle.v2.QueryEMARequest")
+	proto.RegisterType((*QueryEMAResponse)(nil), "cheqd.oracle.v2.QueryEMAResponse")
+	proto.RegisterType((*QueryWMARequest)(nil), "cheqd.oracle.v2.QueryWMARequest")
+	proto.RegisterType((*QueryWMAResponse)(nil), "cheqd.oracle.v2.QueryWMAResponse")
+	proto.RegisterType((*QuerySMARequest)(nil), "cheqd.oracle.v2.QuerySMARequest")
+	proto.RegisterType((*QuerySMAResponse)(nil), "cheqd.oracle.v2.QuerySMAResponse")
+	proto.RegisterType((*QueryTWAPRequest)(nil), "cheqd.oracle.v2.QueryTWAPRequest")
+	proto.RegisterType((*TwapPrice)(nil), "cheqd.oracle.v2.TwapPrice")
+	proto.RegisterType((*QueryTWAPResponse)(nil), "cheqd.oracle.v2.QueryTWAPResponse")
+	proto.RegisterType((*ConvertUSDCtoCHEQRequest)(nil), "cheqd.oracle.v2.ConvertUSDCtoCHEQRequest")
+	proto.RegisterType((*ConvertUSDCtoCHEQResponse)(nil), "cheqd.oracle.v2.ConvertUSDCtoCHEQResponse")
+	proto.RegisterType((*QueryConvertRequest)(nil), "cheqd.oracle.v2.QueryConvertRequest")
+	proto.RegisterType((*ConversionPrice)(nil), "cheqd.oracle.v2.ConversionPrice")
+	proto.RegisterType((*QueryConvertResponse)(nil), "cheqd.oracle.v2.QueryConvertResponse")
+	proto.RegisterType((*QueryPriceHealthRequest)(nil), "cheqd.oracle.v2.QueryPriceHealthRequest")
+	proto.RegisterType((*QueryPriceHealthResponse)(nil), "cheqd.oracle.v2.QueryPriceHealthResponse")
+	proto.RegisterType((*PricePoint)(nil), "cheqd.oracle.v2.PricePoint")
+	proto.RegisterType((*QueryPriceAtRequest)(nil), "cheqd.oracle.v2.QueryPriceAtRequest")
+	proto.RegisterType((*QueryPriceAtResponse)(nil), "cheqd.oracle.v2.QueryPriceAtResponse")
+	proto.RegisterType((*QueryPriceRangeRequest)(nil), "cheqd.oracle.v2.QueryPriceRangeRequest")
+	proto.RegisterType((*QueryPriceRangeResponse)(nil), "cheqd.oracle.v2.QueryPriceRangeResponse")
+}
+
+func init() { proto.RegisterFile("cheqd/oracle/v2/query.proto", fileDescriptor_90255b46abbbaf03) }
+
+var fileDescriptor_90255b46abbbaf03 = []byte{
+	// 2559 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x8a, 0x92, 0x28, 0x3d, 0xea, 0x73, 0xfc, 0x45, 0xad, 0x3e, 0x48, 0xaf, 0x2d, 0x4b,
+	0xb2, 0x2d, 0xae, 0x25, 0xd9, 0x8d, 0x53, 0x23, 0x4d, 0xf4, 0x65, 0xab, 0x80, 0x9d, 0x28, 0xa4,
+	0x6b, 0xa1, 0xed, 0x61, 0x3b, 0x22, 0xc7, 0xd4, 0x56, 0x5a, 0x2e, 0xbd, 0xb3, 0x94, 0x2c, 0xb8,
+	0x3e, 0x24, 0x68, 0x81, 0x1e, 0x5a, 0x20, 0x41, 0xd1, 0xa0, 0x2e, 0x50, 0x34, 0x3d, 0xb4, 0x05,
+	0x82, 0x9e, 0x8a, 0xfc, 0x03, 0xbd, 0xf9, 0x18, 0xb4, 0x3d, 0x14, 0x3d, 0x24, 0xad, 0xdd, 0x43,
+	0xef, 0xbd, 0xf5, 0x50, 0x14, 0x3b, 0x33, 0x3b, 0x5c, 0x72, 0xb9, 0xe4, 0x4a, 0x71, 0x2e, 0x36,
+	0x67, 0xde, 0xef, 0xbd, 0xf7, 0x7b, 0x6f, 0x66, 0xde, 0xce, 0x3c, 0x08, 0x26, 0x8a, 0xbb, 0xe4,
+	0x51, 0x49, 0xb7, 0x1d, 0x5c, 0xdc, 0x27, 0xfa, 0xc1, 0x92, 0xfe, 0xa8, 0x46, 0x9c, 0xa3, 0x5c,
+	0xd5, 0xb1, 0x5d, 0x1b, 0x8d, 0x30, 0x61, 0x8e, 0x0b, 0x73, 0x07, 0x4b, 0xea, 0x64, 0x33, 0x5a,
+	0x88, 0x18, 0x5c, 0xbd, 0x5c, 0xb4, 0xa9, 0x65, 0x53, 0x7d, 0x07, 0x53, 0xc2, 0xed, 0xe8, 0x07,
+	0x8b, 0x3b, 0xc4, 0xc5, 0x8b, 0x7a, 0x15, 0x97, 0xcd, 0x0a, 0x76, 0x4d, 0xbb, 0x22, 0xb0, 0xd3,
+	0x41, 0xac, 0x8f, 0x2a, 0xda, 0xa6, 0x2f, 0x1f, 0xe7, 0x72, 0x83, 0x8d, 0x74, 0x3e, 0x10, 0xa2,
+	0xd3, 0x65, 0xbb, 0x6c, 0xf3, 0x79, 0xef, 0x97, 0x98, 0x9d, 0x2c, 0xdb, 0x76, 0x79, 0x9f, 0xe8,
+	0xb8, 0x6a, 0xea, 0xb8, 0x52, 0xb1, 0x5d, 0xe6, 0xcd, 0xd7, 0x99, 0x16, 0x52, 0x36, 0xda, 0xa9,
+	0x3d, 0xd4, 0x4b, 0x35, 0x27, 0x48, 0x27, 0xd3, 0x2c, 0x77, 0x4d, 0x8b, 0x50, 0x17, 0x5b, 0x55,
+	0x0e, 0xd0, 0xae, 0x03, 0x7a, 0xd7, 0x8b, 0x68, 0xe3, 0x71, 0x71, 0x17, 0x57, 0xca, 0x24, 0x8f,
+	0x5d, 0x42, 0xd1, 0x69, 0xe8, 0x2d, 0x91, 0x8a, 0x6d, 0xa5, 0x95, 0xac, 0x32, 0x37, 0x90, 0xe7,
+	0x83, 0xaf, 0xf7, 0xff, 0xf8, 0xe3, 0x4c, 0xd7, 0xbf, 0x3f, 0xce, 0x74, 0x69, 0x1f, 0x29, 0xa0,
+	0x86, 0xd5, 0xf2, 0x84, 0x56, 0xed, 0x0a, 0x25, 0xe8, 0x31, 0x0c, 0x13, 0x21, 0x30, 0x1c, 0x4f,
+	0x92, 0x56, 0xb2, 0x89, 0xb9, 0xd4, 0xd2, 0x64, 0x4e, 0x04, 0xec, 0x65, 0x27, 0x27, 0xb2, 0x93,
+	0x5b, 0x27, 0xc5, 0x35, 0xdb, 0xac, 0xac, 0x2e, 0x3f, 0xff, 0x3c, 0xd3, 0xf5, 0xc9, 0x17, 0x99,
+	0x2b, 0x65, 0xd3, 0xdd, 0xad, 0xed, 0xe4, 0x8a, 0xb6, 0x25, 0x12, 0x24, 0xfe, 0x5b, 0xa0, 0xa5,
+	0x3d, 0xdd, 0x3d, 0xaa, 0x12, 0xea, 0xeb, 0xd0, 0xfc, 0x10, 0x09, 0x32, 0xd0, 0x54, 0x48, 0x33,
+	0x5e, 0x2b, 0x45, 0xd7, 0x3c, 0x20, 0x0d, 0xec, 0xb4, 0x0d, 0xc8, 0x46, 0xc9, 0x24, 0xf3, 0xf3,
+	0x30, 0x88, 0x99, 0x38, 0xc0, 0x7b, 0x20, 0x9f, 0xe2, 0x73, 0xdc, 0xcc, 0x26, 0x9c, 0x61, 0x66,
+	0x6e, 0x13, 0x52, 0x22, 0xce, 0x3a, 0xd9, 0x27, 0x65, 0x96, 0x71, 0x34, 0x03, 0xc3, 0x07, 0x78,
+	0xdf, 0x2c, 0x61, 0xd7, 0x76, 0x0c, 0x5c, 0x2a, 0x39, 0x22, 0x7b, 0x43, 0x72, 0x76, 0xa5, 0x54,
+	0x72, 0x02, 0x59, 0x7c, 0x0b, 0xa6, 0x5a, 0x5a, 0x92, 0x6c, 0x32, 0x90, 0x7a, 0xc8, 0x64, 0x41,
+	0x73, 0xc0, 0xa7, 0x3c, 0x5b, 0xda, 0x1a, 0x8c, 0x32, 0x0b, 0xf7, 0x4c, 0x4a, 0xd7, 0xec, 0x5a,
+	0xc5, 0x25, 0xce, 0xf1, 0x69, 0xbc, 0x01, 0xe9, 0x66, 0x23, 0xc1, 0x7c, 0x58, 0x26, 0xa5, 0x46,
+	0x91, 0xcf, 0x33, 0x53, 0x3d, 0xf9, 0x94, 0x55, 0x87, 0x6a, 0x48, 0x70, 0x28, 0xec, 0x63, 0xba,
+	0xbb, 0x6d, 0x56, 0x4a, 0xf6, 0xa1, 0xb6, 0x06, 0xe9, 0xe6, 0x39, 0x69, 0x72, 0x16, 0x46, 0x0e,
+	0xd9, 0x8c, 0x77, 0x06, 0xca, 0x0e, 0xa1, 0x54, 0x58, 0x1d, 0xe6, 0xd3, 0x5b, 0x62, 0x56, 0xfb,
+	0x89, 0x02, 0x13, 0xcc, 0xca, 0x03, 0x9f, 0xf8, 0x3b, 0xec, 0x54, 0x6e, 0x9a, 0xd4, 0xb5, 0x9d,
+	0xa3, 0x98, 0x81, 0xa2, 0xdb, 0x00, 0xf5, 0x53, 0x9a, 0xee, 0xce, 0x2a, 0x73, 0xa9, 0xa5, 0x4b,
+	0x0d, 0x1b, 0x91, 0x97, 0x06, 0x7f, 0x3b, 0x6e, 0xe1, 0x32, 0xc9, 0x93, 0x47, 0x35, 0x42, 0xdd,
+	0x7c, 0x40, 0x53, 0xfb, 0x9f, 0x02, 0x17, 0xda, 0xd0, 0x91, 0xf1, 0xdd, 0x86, 0x64, 0xb1, 0xe6,
+	0x38, 0xa4, 0xe2, 0xa6, 0x15, 0xdf, 0x59, 0x63, 0xb9, 0xc9, 0x35, 0x59, 0xe0, 0x09, 0x5a, 0xed,
+	0xf1, 0xf6, 0x7f, 0xde, 0x57, 0xf6, 0xec, 0xec, 0x72, 0xd3, 0xe9, 0xee, 0x6c, 0xe2, 0xf8, 0x76,
+	0x84, 0x32, 0xba, 0xd3, 0x10, 0x7f, 0x82, 0x51, 0x9a, 0xed, 0x18, 0x3f, 0x0f, 0xa6, 0x21, 0x01,
+	0x1b, 0x70, 0xae, 0x79, 0x51, 0x0b, 0x35, 0xcb, 0xc2, 0xce, 0x11, 0xba, 0x0c, 0x63, 0x62, 0x4d,
+	0x49, 0xa5, 0x64, 0xec, 0x12, 0xb3, 0xbc, 0xeb, 0x8a, 0x55, 0x15, 0x8b, 0xbd, 0x51, 0x29, 0x6d,
+	0xb2, 0x69, 0xed, 0x21, 0x64, 0x22, 0xcc, 0xc8, 0x14, 0xae, 0x41, 0x92, 0xf2, 0x29, 0x91, 0xc2,
+	0x0b, 0xa1, 0xd0, 0xc3, 0xda, 0x7e, 0xdc, 0x42, 0x53, 0x9e, 0xd3, 0x95, 0x72, 0xd9, 0xf1, 0x0e,
+	0x16, 0xd9, 0x72, 0xc8, 0x81, 0xed, 0x92, 0xe3, 0x1f, 0x90, 0xf7, 0x14, 0x98, 0x6a, 0x69, 0x4a,
+	0x12, 0xfe, 0x1e, 0x8c, 0x61, 0x5f, 0x66, 0x54, 0xb9, 0x50, 0x50, 0x5f, 0x08, 0x51, 0x97, 0x56,
+	0x82, 0x25, 0x48, 0x58, 0x14, 0x41, 0x8c, 0xe2, 0x26, 0x4f, 0x5a, 0x1a, 0xce, 0xb6, 0xa4, 0x40,
+	0xb5, 0x1f, 0x2a, 0x30, 0xdd, 0x5a, 0x24, 0xe9, 0xed, 0x00, 0x0a, 0xd1, 0xf3, 0x6b, 0xf2, 0x89,
+	0xf8, 0x8d, 0xe1, 0x10, 0x8d, 0x0d, 0xf1, 0x21, 0x91, 0xda, 0x0f, 0x4e, 0x94, 0xeb, 0x1a, 0xa8,
+	0x61, 0x33, 0x32, 0x90, 0x6d, 0x18, 0xae, 0x07, 0x12, 0x48, 0xf2, 0xe5, 0x78, 0x41, 0x3c, 0xa8,
+	0x47, 0x30, 0x84, 0x83, 0x0e, 0xb4, 0x33, 0x70, 0x2a, 0xec, 0x96, 0x6a, 0x8f, 0x61, 0xa2, 0xc5,
+	0xb4, 0xa4, 0xf3, 0x6d, 0x18, 0x69, 0xa4, 0xe3, 0x27, 0xf5, 0xf8, 0x7c, 0x86, 0x71, 0xa3, 0xe7,
+	0x21, 0x48, 0x31, 0xcf, 0x5b, 0xd8, 0xc1, 0x16, 0xd5, 0xee, 0xc2, 0xa9, 0xc0, 0x50, 0x12, 0xb8,
+	0x01, 0x7d, 0x55, 0x36, 0x23, 0xf2, 0x70, 0x2e, 0xe4, 0x97, 0x2b, 0x08, 0x27, 0x02, 0xac, 0xdd,
+	0x83, 0x41, 0x5e, 0xf1, 0x49, 0xc9, 0xc4, 0x95, 0x88, 0xcf, 0x3d, 0x9a, 0x02, 0xa8, 0xd4, 0x2c,
+	0x83, 0xdd, 0x16, 0x28, 0x2b, 0x9c, 0x43, 0xf9, 0x81, 0x4a, 0xcd, 0x2a, 0xb0, 0x89, 0xc0, 0x9a,
+	0x15, 0xe0, 0x74, 0xd0, 0x9c, 0x64, 0x77, 0x0b, 0x92, 0x16, 0x9f, 0x12, 0x69, 0x99, 0x08, 0xd3,
+	0x73, 0xcc, 0x22, 0x61, 0x06, 0xfd, 0xe3, 0x2b, 0x34, 0xb4, 0xd7, 0xe0, 0x4c, 0xc0, 0xe8, 0x3a,
+	0x39, 0x30, 0xf9, 0xc5, 0xa7, 0xe3, 0xdd, 0xc4, 0x86, 0xa9, 0x96, 0x8a, 0x92, 0xd6, 0xdb, 0x30,
+	0xc6, 0x9d, 0x18, 0x25, 0x29, 0x8c, 0x4f, 0x70, 0xd4, 0x6a, 0xb2, 0xab, 0x8d, 0x8b, 0xba, 0x28,
+	0xab, 0x71, 0x9e, 0x1c, 0x62, 0xa7, 0x54, 0x20, 0xae, 0xb6, 0x0f, 0x99, 0x08, 0x91, 0x64, 0xf3,
+	0x4d, 0x00, 0x79, 0x16, 0x68, 0x64, 0xb9, 0x0b, 0x1b, 0x10, 0x74, 0x02, 0xca, 0xda, 0x2c, 0x8c,
+	0xf0, 0x4b, 0xd9, 0xbd, 0x15, 0xf1, 0x01, 0x6b, 0x9d, 0x2c, 0xed, 0xbb, 0x30, 0x5a, 0x07, 0x0a,
+	0x1e, 0x77, 0xa0, 0xb7, 0xea, 0xc5, 0xca, 0x91, 0xab, 0x8b, 0x9e, 0xf5, 0xbf, 0x7f, 0x9e, 0x99,
+	0xe0, 0x1f, 0x0a, 0x5a, 0xda, 0xcb, 0x99, 0xb6, 0x6e, 0x61, 0x77, 0x37, 0x77, 0x97, 0x94, 0x71,
+	0xf1, 0x68, 0x9d, 0x14, 0xff, 0xfc, 0xe9, 0x02, 0x70, 0xb1, 0x77, 0x1f, 0xcb, 0x73, 0x7d, 0xed,
+	0xfb, 0x82, 0xc5, 0x76, 0x07, 0x16, 0x48, 0x85, 0x7e, 0xea, 0x3a, 0xd8, 0x25, 0xe5, 0x23, 0xb6,
+	0xbb, 0x06, 0xf2, 0x72, 0xec, 0xd5, 0x8d, 0x62, 0x8d, 0xba, 0xb6, 0x65, 0x1c, 0xb2, 0xaf, 0x06,
+	0x4d, 0x27, 0xb2, 0x89, 0xb9, 0x44, 0x7e, 0x88, 0xcf, 0x6e, 0xf3, 0x49, 0x19, 0xc8, 0xf6, 0x57,
+	0x11, 0x88, 0x9f, 0xce, 0x42, 0xdc, 0x74, 0x16, 0xbe, 0x0a, 0x16, 0xa6, 0x30, 0x7e, 0x7f, 0x7b,
+	0x65, 0xab, 0x7d, 0x3e, 0xdf, 0x84, 0xfe, 0x7d, 0xdb, 0xde, 0xdb, 0xc1, 0xc5, 0x3d, 0x71, 0xcd,
+	0x19, 0xcf, 0xf1, 0xeb, 0x7f, 0xce, 0xbf, 0xfe, 0xe7, 0xd6, 0xc5, 0xf3, 0x60, 0xb5, 0xdf, 0x23,
+	0xf4, 0x8b, 0x2f, 0x32, 0x4a, 0x5e, 0x2a, 0x69, 0x3f, 0xea, 0x86, 0x81, 0xfb, 0x87, 0xb8, 0xca,
+	0xf6, 0x7c, 0x83, 0x39, 0xe5, 0x04, 0xe6, 0xea, 0x29, 0xe8, 0xfe, 0x72, 0x29, 0xf0, 0x36, 0x43,
+	0xad, 0x5a, 0xc2, 0x2e, 0x91, 0x57, 0x0b, 0xef, 0x16, 0x93, 0xc8, 0x0f, 0x89, 0x59, 0x7e, 0xb1,
+	0x40, 0x77, 0x60, 0xd0, 0x87, 0x79, 0xaf, 0x9c, 0x74, 0x0f, 0x23, 0xad, 0x86, 0x48, 0xdf, 0xf7,
+	0x9f, 0x40, 0x9c, 0xf5, 0x07, 0x1e, 0xeb, 0x94, 0xd0, 0xf4, 0x64, 0xda, 0x3d, 0x18, 0x0b, 0xa4,
+	0x5c, 0x2c, 0xe8, 0x4d, 0xe8, 0x63, 0x6c, 0xfc, 0x52, 0xa1, 0x86, 0xce, 0xa8, 0x4c, 0x9d, 0xac,
+	0xb6, 0x0c, 0xaf, 0xfd, 0x5c, 0x81, 0xf4, 0x9a, 0x5d, 0x39, 0x20, 0x8e, 0xfb, 0xad, 0xc2, 0xfa,
+	0x9a, 0x6b, 0xaf, 0x6d, 0x6e, 0xbc, 0xeb, 0x2f, 0xe5, 0x59, 0xe8, 0xc3, 0x96, 0x77, 0x93, 0x16,
+	0x6b, 0x29, 0x46, 0xe8, 0x1c, 0x24, 0x2d, 0x6c, 0x78, 0x8f, 0x1d, 0x71, 0x36, 0xfa, 0x2c, 0x7c,
+	0xff, 0xa8, 0xca, 0x6e, 0xe4, 0x87, 0x16, 0x36, 0xe4, 0xc9, 0x49, 0x30, 0x69, 0xea, 0xd0, 0xc2,
+	0x85, 0xe8, 0xc3, 0xd3, 0x93, 0x4d, 0xcc, 0xf5, 0x36, 0x1f, 0x9e, 0x65, 0x18, 0x6f, 0x41, 0x4b,
+	0x84, 0x1b, 0xc1, 0x4b, 0xfb, 0xaf, 0x22, 0xbe, 0x44, 0x42, 0xb5, 0x53, 0x1c, 0xe3, 0xd0, 0xef,
+	0xda, 0x06, 0xdf, 0xad, 0x3c, 0x90, 0xa4, 0x6b, 0xaf, 0x8b, 0xfd, 0x3a, 0xc8, 0x32, 0x64, 0x50,
+	0xbb, 0xe6, 0x14, 0x09, 0x8b, 0x64, 0x78, 0x69, 0x32, 0x94, 0x57, 0x5e, 0x82, 0x19, 0x26, 0x9f,
+	0xaa, 0xd6, 0x07, 0x31, 0xe3, 0x44, 0x9b, 0x30, 0xe4, 0x1e, 0xe2, 0xaa, 0x21, 0x77, 0x73, 0x6f,
+	0xfc, 0xdd, 0x3c, 0xe8, 0x69, 0xde, 0xf5, 0x0f, 0xc8, 0x2f, 0x15, 0x18, 0xe1, 0x71, 0x53, 0xd3,
+	0xae, 0xf0, 0x63, 0xd2, 0xfa, 0x2c, 0xbe, 0xb2, 0xbd, 0x7f, 0x16, 0xfa, 0x1a, 0xf6, 0xbc, 0x18,
+	0xa1, 0x51, 0x48, 0xe0, 0x32, 0xdf, 0xe3, 0x3d, 0x79, 0xef, 0xa7, 0xf6, 0x69, 0xb7, 0xf8, 0x0c,
+	0xcb, 0x95, 0x11, 0x4b, 0xf9, 0x5a, 0xc3, 0xd2, 0x78, 0x81, 0xb7, 0x7a, 0x85, 0xb3, 0x27, 0xb8,
+	0xd8, 0xb8, 0x62, 0xed, 0x5e, 0x59, 0x10, 0x82, 0x6c, 0x42, 0x92, 0x45, 0x1b, 0x00, 0x0f, 0x1d,
+	0xdb, 0x32, 0xb8, 0x7d, 0x7e, 0x52, 0xb3, 0xa1, 0x95, 0x6f, 0xca, 0xb5, 0xa0, 0x37, 0xe0, 0x69,
+	0xf2, 0xe4, 0xaf, 0xb0, 0xdd, 0xc5, 0x8d, 0xf4, 0x1e, 0xcb, 0x48, 0xd2, 0xb5, 0xd9, 0x50, 0xd3,
+	0xc5, 0xd7, 0x9b, 0x8d, 0x36, 0x09, 0xde, 0x77, 0x77, 0xdb, 0x57, 0xfb, 0xbf, 0x76, 0x43, 0x3a,
+	0xac, 0xf1, 0x8a, 0xcb, 0x7e, 0x8b, 0x9a, 0xd7, 0x1d, 0xa7, 0xe6, 0x25, 0x4e, 0x58, 0xf3, 0xc2,
+	0xfb, 0x09, 0x15, 0x60, 0xa4, 0x68, 0x3a, 0xc5, 0x9a, 0xe9, 0x1a, 0x3b, 0x0e, 0xc1, 0x7b, 0xc4,
+	0x11, 0x29, 0xbe, 0xd8, 0xfa, 0x84, 0xae, 0x71, 0xf0, 0x2a, 0xc7, 0xfa, 0xd7, 0xda, 0x62, 0xc3,
+	0x2c, 0x4a, 0x43, 0x72, 0x97, 0x65, 0xec, 0x28, 0xdd, 0x97, 0x55, 0xe6, 0xfa, 0xf3, 0xfe, 0x50,
+	0xfb, 0x83, 0x02, 0xc0, 0xec, 0x6c, 0xd9, 0x66, 0xc5, 0x7d, 0x75, 0x89, 0x9c, 0x80, 0x81, 0x9d,
+	0x7d, 0xbb, 0xb8, 0x67, 0x54, 0x6a, 0xbc, 0x02, 0xf5, 0xe4, 0xfb, 0xd9, 0xc4, 0xdb, 0x35, 0x0b,
+	0xdd, 0x84, 0x9e, 0x63, 0xa7, 0x8d, 0x69, 0x68, 0x1f, 0xfa, 0x75, 0x90, 0x71, 0x5e, 0x71, 0xdb,
+	0x7f, 0x9a, 0xeb, 0xa7, 0x98, 0x33, 0x10, 0x23, 0x74, 0x3d, 0xb6, 0xff, 0x9e, 0xba, 0x6f, 0xcf,
+	0x1a, 0x25, 0x8e, 0x49, 0x28, 0x5b, 0xae, 0x81, 0xbc, 0x18, 0x69, 0xef, 0xc0, 0xe9, 0x46, 0x4a,
+	0xb2, 0x00, 0x04, 0x72, 0x19, 0x79, 0xc9, 0x65, 0x79, 0x17, 0xcb, 0x26, 0xee, 0x1e, 0x2f, 0xba,
+	0xc5, 0xab, 0x93, 0x01, 0xf2, 0xec, 0xd9, 0xd2, 0x36, 0xce, 0xf3, 0x30, 0x48, 0x5d, 0xec, 0xb8,
+	0x46, 0x43, 0xb4, 0x29, 0x36, 0x27, 0x76, 0xec, 0x14, 0x40, 0xa0, 0x47, 0xc0, 0x4b, 0xc2, 0x00,
+	0xf1, 0xbb, 0x03, 0xe8, 0x4d, 0x00, 0x6e, 0x21, 0xe6, 0x27, 0x9c, 0xe7, 0x65, 0x80, 0xe9, 0xb0,
+	0x8d, 0x7c, 0x0b, 0xfa, 0x3d, 0xfb, 0x4c, 0xbd, 0x37, 0xa6, 0x7a, 0x92, 0x54, 0x4a, 0xf7, 0x1b,
+	0x33, 0xdb, 0x17, 0xcc, 0x2c, 0x42, 0xd0, 0x43, 0x5d, 0x52, 0x4d, 0x27, 0x19, 0x5d, 0xf6, 0xbb,
+	0xa9, 0xaf, 0xd4, 0x7f, 0xe2, 0xbe, 0xd2, 0xaf, 0x14, 0x38, 0x17, 0x4a, 0xb2, 0x58, 0xb9, 0xd7,
+	0x9b, 0x2e, 0x1d, 0x31, 0x96, 0x4e, 0x28, 0x34, 0xb5, 0x7d, 0xba, 0x4f, 0xdc, 0xf6, 0x59, 0xfa,
+	0xcf, 0x04, 0xf4, 0x32, 0x7e, 0xe8, 0x99, 0x02, 0x43, 0x8d, 0x7d, 0xe2, 0xf0, 0x43, 0x25, 0xdc,
+	0x15, 0x56, 0xaf, 0xc4, 0x00, 0xf9, 0x9e, 0xb5, 0xaf, 0xbd, 0xff, 0x97, 0x7f, 0xfd, 0xac, 0xfb,
+	0x1a, 0xca, 0xe9, 0xcd, 0x2d, 0x79, 0xb6, 0xc3, 0xa8, 0xde, 0xd8, 0x58, 0xd6, 0x9f, 0xb0, 0xe9,
+	0xa7, 0xe8, 0x13, 0x05, 0x4e, 0xb5, 0x68, 0xec, 0xa2, 0xf9, 0xd6, 0xce, 0x5b, 0x40, 0xd5, 0xc5,
+	0xd8, 0x50, 0xc9, 0xf6, 0x06, 0x63, 0xab, 0xa3, 0x85, 0x28, 0xb6, 0xa2, 0x99, 0xdc, 0x48, 0x1a,
+	0xfd, 0x5e, 0x81, 0xd1, 0x50, 0xfb, 0xf8, 0x52, 0x6b, 0xf7, 0xcd, 0x38, 0x35, 0x17, 0x0f, 0x27,
+	0x39, 0xde, 0x62, 0x1c, 0x6f, 0xa0, 0xe5, 0x10, 0xc7, 0xfa, 0xd3, 0x51, 0x7f, 0xd2, 0xd8, 0xa5,
+	0x79, 0xaa, 0xf3, 0x26, 0x33, 0xfa, 0x48, 0x81, 0x54, 0xb0, 0xb9, 0x7c, 0xbe, 0xb5, 0xf3, 0x00,
+	0x44, 0x9d, 0xef, 0x08, 0x91, 0xd4, 0x5e, 0x67, 0xd4, 0x96, 0xd1, 0xe2, 0xb1, 0xa8, 0x79, 0xcd,
+	0x67, 0xf4, 0xbe, 0x02, 0xa9, 0x40, 0x0f, 0x30, 0x8a, 0x58, 0x00, 0xa2, 0xce, 0x77, 0x84, 0x48,
+	0x62, 0x33, 0x8c, 0x58, 0x06, 0x4d, 0x85, 0x88, 0x51, 0x0f, 0x6d, 0xf0, 0x9e, 0x26, 0xfa, 0x93,
+	0x02, 0x67, 0x23, 0x9a, 0xd3, 0x57, 0x5b, 0x3b, 0x6b, 0x8d, 0x56, 0xaf, 0x1f, 0x07, 0x2d, 0x59,
+	0xae, 0x31, 0x96, 0x6f, 0xa0, 0x5b, 0xc7, 0x4a, 0x1f, 0x47, 0x19, 0x7e, 0x7b, 0xf8, 0xd7, 0x0a,
+	0xa0, 0x16, 0x1d, 0xdd, 0xb9, 0x8e, 0xc9, 0x12, 0x48, 0xf5, 0x5a, 0x5c, 0xa4, 0xe4, 0xbd, 0xc0,
+	0x78, 0xcf, 0xa2, 0x99, 0xb6, 0xd9, 0x35, 0x44, 0x23, 0x17, 0xfd, 0x51, 0x81, 0xd1, 0x50, 0x13,
+	0x37, 0xe2, 0xb4, 0x34, 0xe3, 0xd4, 0x5c, 0x3c, 0x9c, 0xe4, 0x76, 0x9b, 0x71, 0x7b, 0x0b, 0x7d,
+	0xe3, 0x58, 0x39, 0x0d, 0x75, 0x57, 0xd1, 0x6f, 0x15, 0x18, 0x6b, 0x76, 0x42, 0xd1, 0x6c, 0x3c,
+	0x36, 0x54, 0xd5, 0x63, 0x02, 0x63, 0xd4, 0xcd, 0x00, 0xef, 0x10, 0x4d, 0x8a, 0x7e, 0xa7, 0xc0,
+	0x50, 0x63, 0xcb, 0xf6, 0x42, 0x07, 0xd7, 0x1e, 0x48, 0xbd, 0x12, 0x03, 0x14, 0x6f, 0x9f, 0x96,
+	0xcc, 0x8e, 0x39, 0x65, 0x09, 0x7d, 0xa6, 0xc0, 0x70, 0x83, 0x79, 0x8a, 0x2e, 0xc6, 0x20, 0x41,
+	0xd5, 0xab, 0x71, 0x50, 0x92, 0xeb, 0x32, 0xe3, 0xba, 0x80, 0xae, 0xc4, 0xcb, 0x23, 0x4f, 0xa2,
+	0x05, 0x7d, 0xbc, 0xcf, 0x8a, 0x26, 0x5b, 0x3b, 0xe3, 0x52, 0xf5, 0x62, 0x3b, 0xa9, 0xa4, 0x90,
+	0x61, 0x14, 0xc6, 0xd1, 0xb9, 0x10, 0x05, 0xde, 0xbe, 0x45, 0x3f, 0x80, 0xa4, 0xdf, 0xb9, 0x9d,
+	0x8a, 0x28, 0xb6, 0x5c, 0xac, 0xce, 0xb4, 0x15, 0x4b, 0x8f, 0x57, 0x98, 0xc7, 0x19, 0x74, 0x41,
+	0x78, 0xe4, 0xb5, 0xa1, 0xe9, 0x53, 0x26, 0x1a, 0xb3, 0xe8, 0x37, 0x0a, 0x8c, 0x86, 0x9a, 0xb2,
+	0x97, 0xda, 0x39, 0xaa, 0xe3, 0xd4, 0x5c, 0x3c, 0x5c, 0xe4, 0x07, 0xb6, 0x0d, 0xb3, 0x40, 0x37,
+	0xd7, 0xbb, 0x0d, 0xa0, 0x70, 0xcb, 0x34, 0xaa, 0xa8, 0x85, 0x91, 0xea, 0xb5, 0xb8, 0x48, 0xc9,
+	0xf4, 0x26, 0x63, 0xba, 0x84, 0xae, 0x35, 0xad, 0xda, 0x62, 0x70, 0x93, 0xd7, 0xf7, 0xb8, 0xc3,
+	0x0c, 0x18, 0x94, 0xb8, 0xa8, 0x0a, 0x89, 0x8d, 0x7b, 0x2b, 0x28, 0x1b, 0x71, 0x4d, 0x92, 0xdd,
+	0x47, 0xf5, 0x7c, 0x1b, 0x84, 0x60, 0x71, 0x91, 0xb1, 0x98, 0x46, 0x93, 0xa1, 0xbd, 0x43, 0x2c,
+	0x2c, 0x2f, 0x4b, 0x4f, 0x20, 0xb1, 0x1d, 0xed, 0x71, 0xbb, 0xa3, 0xc7, 0x40, 0xbb, 0x55, 0xd3,
+	0x99, 0xc7, 0x79, 0x34, 0x1b, 0xf2, 0x78, 0x58, 0xf7, 0xa8, 0x3f, 0xf1, 0xfb, 0x55, 0x4f, 0xbd,
+	0x70, 0x0b, 0xd1, 0xce, 0x0b, 0x1d, 0x9d, 0x17, 0x62, 0x85, 0x4b, 0x03, 0xe1, 0xba, 0xd0, 0xe3,
+	0xb5, 0xf2, 0xa2, 0xee, 0x08, 0x81, 0xce, 0xaa, 0xaa, 0xb5, 0x83, 0x74, 0xbc, 0x1c, 0x78, 0xcd,
+	0x22, 0xe9, 0xf5, 0x99, 0x02, 0x63, 0xa1, 0xfe, 0x5a, 0x8b, 0xfb, 0x68, 0x54, 0x6b, 0x50, 0xbd,
+	0x1c, 0x07, 0xda, 0xf1, 0x93, 0x5a, 0xe4, 0x3a, 0x46, 0x8d, 0x96, 0x8a, 0x86, 0x6b, 0x1b, 0x9e,
+	0x1c, 0x3d, 0x86, 0xa4, 0xb0, 0x15, 0x55, 0x44, 0x1b, 0xdb, 0x7b, 0xea, 0x4c, 0x07, 0x94, 0xa0,
+	0x91, 0x65, 0x34, 0x54, 0x94, 0x8e, 0xa2, 0x81, 0x3e, 0x54, 0x20, 0x15, 0x68, 0x9c, 0x44, 0x1d,
+	0xc9, 0x70, 0x37, 0x46, 0x9d, 0x8f, 0x81, 0xec, 0x98, 0x0d, 0xde, 0x70, 0xe4, 0x3d, 0x07, 0xb9,
+	0x52, 0xef, 0x29, 0x90, 0x14, 0x6f, 0xe6, 0xa8, 0x74, 0x34, 0xbe, 0xf2, 0xd5, 0x99, 0x0e, 0x28,
+	0xc1, 0x63, 0x9e, 0xf1, 0xb8, 0x80, 0xce, 0x47, 0xf0, 0xc0, 0xae, 0xe4, 0xf0, 0x53, 0xbf, 0xfd,
+	0xc1, 0x1e, 0x80, 0x51, 0x17, 0x85, 0xd0, 0x3b, 0x5c, 0x9d, 0xeb, 0x0c, 0x14, 0x64, 0xae, 0x32,
+	0x32, 0x97, 0xd0, 0xc5, 0x08, 0x32, 0x8e, 0x87, 0xf6, 0xf9, 0xac, 0x6e, 0x3e, 0xff, 0xe7, 0x74,
+	0xd7, 0xf3, 0x17, 0xd3, 0xca, 0x67, 0x2f, 0xa6, 0x95, 0x7f, 0xbc, 0x98, 0x56, 0x3e, 0x78, 0x39,
+	0xdd, 0xf5, 0xd9, 0xcb, 0xe9, 0xae, 0xbf, 0xbd, 0x9c, 0xee, 0xfa, 0xce, 0xe5, 0xe0, 0x9f, 0xe8,
+	0x30, 0x6b, 0xec, 0xdf, 0x85, 0x8a, 0x5d, 0x22, 0xfa, 0x63, 0xdf, 0x34, 0xfb, 0x53, 0x9d, 0x9d,
+	0x3e, 0xf6, 0xec, 0x5e, 0xfe, 0xff, 0x00, 0x7c, 0x95, 0x8c, 0xda, 0x92, 0x25, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
 var _ grpc.ClientConn
 
@@ -1531,6 +2331,12 @@ type QueryClient interface {
 	MissCounter(ctx context.Context, in *QueryMissCounter, opts ...grpc.CallOption) (*QueryMissCounterResponse, error)
 	// SlashWindow returns slash window information
 	SlashWindow(ctx context.Context, in *QuerySlashWindow, opts ...grpc.CallOption) (*QuerySlashWindowResponse, error)
+	// ValidatorOracleHistory returns the oracle performance of a validator over
+	// the current and the past slash windows.
+	ValidatorOracleHistory(ctx context.Context, in *QueryValidatorOracleHistory, opts ...grpc.CallOption) (*QueryValidatorOracleHistoryResponse, error)
+	// SlashWindowSummary returns the outcome of a past slash window, the latest
+	// one by default.
+	SlashWindowSummary(ctx context.Context, in *QuerySlashWindowSummary, opts ...grpc.CallOption) (*QuerySlashWindowSummaryResponse, error)
 	// AggregatePrevote returns an aggregate prevote of a validator
 	AggregatePrevote(ctx context.Context, in *QueryAggregatePrevote, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error)
 	// AggregatePrevotes returns aggregate prevotes of all validators
@@ -1554,7 +2360,23 @@ type QueryClient interface {
 	EMA(ctx context.Context, in *QueryEMARequest, opts ...grpc.CallOption) (*QueryEMAResponse, error)
 	WMA(ctx context.Context, in *QueryWMARequest, opts ...grpc.CallOption) (*QueryWMAResponse, error)
 	SMA(ctx context.Context, in *QuerySMARequest, opts ...grpc.CallOption) (*QuerySMAResponse, error)
+	// TWAP returns the time-weighted average prices of a denom over the
+	// configured lookback durations, or, if specified, a single lookback.
+	TWAP(ctx context.Context, in *QueryTWAPRequest, opts ...grpc.CallOption) (*QueryTWAPResponse, error)
+	// Deprecated: use Convert with the usd and ncheq denoms instead.
 	ConvertUSDCtoCHEQ(ctx context.Context, in *ConvertUSDCtoCHEQRequest, opts ...grpc.CallOption) (*ConvertUSDCtoCHEQResponse, error)
+	// Convert converts an amount between two denoms of the accept list, or usd,
+	// through their USD prices from a given price source.
+	Convert(ctx context.Context, in *QueryConvertRequest, opts ...grpc.CallOption) (*QueryConvertResponse, error)
+	// PriceHealth returns the freshness of the moving averages of a denom and
+	// the state of the circuit breaker guarding USD-denominated fees.
+	PriceHealth(ctx context.Context, in *QueryPriceHealthRequest, opts ...grpc.CallOption) (*QueryPriceHealthResponse, error)
+	// PriceAt returns the latest historic price stamp or median of a denom
+	// stamped at or before a block height or time.
+	PriceAt(ctx context.Context, in *QueryPriceAtRequest, opts ...grpc.CallOption) (*QueryPriceAtResponse, error)
+	// PriceRange returns the historic price stamps or medians of a denom
+	// stamped within a block or time range, optionally down-sampled.
+	PriceRange(ctx context.Context, in *QueryPriceRangeRequest, opts ...grpc.CallOption) (*QueryPriceRangeResponse, error)
 }
 
 type queryClient struct {
@@ -1610,6 +2432,24 @@ func (c *queryClient) SlashWindow(ctx context.Context, in *QuerySlashWindow, opt
 	return out, nil
 }
 
+func (c *queryClient) ValidatorOracleHistory(ctx context.Context, in *QueryValidatorOracleHistory, opts ...grpc.CallOption) (*QueryValidatorOracleHistoryResponse, error) {
+	out := new(QueryValidatorOracleHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.oracle.v2.Query/ValidatorOracleHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SlashWindowSummary(ctx context.Context, in *QuerySlashWindowSummary, opts ...grpc.CallOption) (*QuerySlashWindowSummaryResponse, error) {
+	out := new(QuerySlashWindowSummaryResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.oracle.v2.Query/SlashWindowSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) AggregatePrevote(ctx context.Context, in *QueryAggregatePrevote, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error) {
 	out := new(QueryAggregatePrevoteResponse)
 	err := c.cc.Invoke(ctx, "/cheqd.oracle.v2.Query/AggregatePrevote", in, out, opts...)
@@ -1709,6 +2549,15 @@ func (c *queryClient) SMA(ctx context.Context, in *QuerySMARequest, opts ...grpc
 	return out, nil
 }
 
+func (c *queryClient) TWAP(ctx context.Context, in *QueryTWAPRequest, opts ...grpc.CallOption) (*QueryTWAPResponse, error) {
+	out := new(QueryTWAPResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.oracle.v2.Query/TWAP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ConvertUSDCtoCHEQ(ctx context.Context, in *ConvertUSDCtoCHEQRequest, opts ...grpc.CallOption) (*ConvertUSDCtoCHEQResponse, error) {
 	out := new(ConvertUSDCtoCHEQResponse)
 	err := c.cc.Invoke(ctx, "/cheqd.oracle.v2.Query/ConvertUSDCtoCHEQ", in, out, opts...)
@@ -1718,19 +2567,61 @@ func (c *queryClient) ConvertUSDCtoCHEQ(ctx context.Context, in *ConvertUSDCtoCH
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// ExchangeRates returns exchange rates of all denoms,
-	// or, if specified, returns a single denom
-	ExchangeRates(context.Context, *QueryExchangeRates) (*QueryExchangeRatesResponse, error)
-	// ActiveExchangeRates returns all active denoms
-	ActiveExchangeRates(context.Context, *QueryActiveExchangeRates) (*QueryActiveExchangeRatesResponse, error)
-	// FeederDelegation returns feeder delegation of a validator
-	FeederDelegation(context.Context, *QueryFeederDelegation) (*QueryFeederDelegationResponse, error)
-	// MissCounter returns oracle miss counter of a validator
-	MissCounter(context.Context, *QueryMissCounter) (*QueryMissCounterResponse, error)
-	// SlashWindow returns slash window information
-	SlashWindow(context.Context, *QuerySlashWindow) (*QuerySlashWindowResponse, error)
+func (c *queryClient) Convert(ctx context.Context, in *QueryConvertRequest, opts ...grpc.CallOption) (*QueryConvertResponse, error) {
+	out := new(QueryConvertResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.oracle.v2.Query/Convert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceHealth(ctx context.Context, in *QueryPriceHealthRequest, opts ...grpc.CallOption) (*QueryPriceHealthResponse, error) {
+	out := new(QueryPriceHealthResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.oracle.v2.Query/PriceHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceAt(ctx context.Context, in *QueryPriceAtRequest, opts ...grpc.CallOption) (*QueryPriceAtResponse, error) {
+	out := new(QueryPriceAtResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.oracle.v2.Query/PriceAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceRange(ctx context.Context, in *QueryPriceRangeRequest, opts ...grpc.CallOption) (*QueryPriceRangeResponse, error) {
+	out := new(QueryPriceRangeResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.oracle.v2.Query/PriceRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ExchangeRates returns exchange rates of all denoms,
+	// or, if specified, returns a single denom
+	ExchangeRates(context.Context, *QueryExchangeRates) (*QueryExchangeRatesResponse, error)
+	// ActiveExchangeRates returns all active denoms
+	ActiveExchangeRates(context.Context, *QueryActiveExchangeRates) (*QueryActiveExchangeRatesResponse, error)
+	// FeederDelegation returns feeder delegation of a validator
+	FeederDelegation(context.Context, *QueryFeederDelegation) (*QueryFeederDelegationResponse, error)
+	// MissCounter returns oracle miss counter of a validator
+	MissCounter(context.Context, *QueryMissCounter) (*QueryMissCounterResponse, error)
+	// SlashWindow returns slash window information
+	SlashWindow(context.Context, *QuerySlashWindow) (*QuerySlashWindowResponse, error)
+	// ValidatorOracleHistory returns the oracle performance of a validator over
+	// the current and the past slash windows.
+	ValidatorOracleHistory(context.Context, *QueryValidatorOracleHistory) (*QueryValidatorOracleHistoryResponse, error)
+	// SlashWindowSummary returns the outcome of a past slash window, the latest
+	// one by default.
+	SlashWindowSummary(context.Context, *QuerySlashWindowSummary) (*QuerySlashWindowSummaryResponse, error)
 	// AggregatePrevote returns an aggregate prevote of a validator
 	AggregatePrevote(context.Context, *QueryAggregatePrevote) (*QueryAggregatePrevoteResponse, error)
 	// AggregatePrevotes returns aggregate prevotes of all validators
@@ -1754,7 +2645,23 @@ type QueryServer interface {
 	EMA(context.Context, *QueryEMARequest) (*QueryEMAResponse, error)
 	WMA(context.Context, *QueryWMARequest) (*QueryWMAResponse, error)
 	SMA(context.Context, *QuerySMARequest) (*QuerySMAResponse, error)
+	// TWAP returns the time-weighted average prices of a denom over the
+	// configured lookback durations, or, if specified, a single lookback.
+	TWAP(context.Context, *QueryTWAPRequest) (*QueryTWAPResponse, error)
+	// Deprecated: use Convert with the usd and ncheq denoms instead.
 	ConvertUSDCtoCHEQ(context.Context, *ConvertUSDCtoCHEQRequest) (*ConvertUSDCtoCHEQResponse, error)
+	// Convert converts an amount between two denoms of the accept list, or usd,
+	// through their USD prices from a given price source.
+	Convert(context.Context, *QueryConvertRequest) (*QueryConvertResponse, error)
+	// PriceHealth returns the freshness of the moving averages of a denom and
+	// the state of the circuit breaker guarding USD-denominated fees.
+	PriceHealth(context.Context, *QueryPriceHealthRequest) (*QueryPriceHealthResponse, error)
+	// PriceAt returns the latest historic price stamp or median of a denom
+	// stamped at or before a block height or time.
+	PriceAt(context.Context, *QueryPriceAtRequest) (*QueryPriceAtResponse, error)
+	// PriceRange returns the historic price stamps or medians of a denom
+	// stamped within a block or time range, optionally down-sampled.
+	PriceRange(context.Context, *QueryPriceRangeRequest) (*QueryPriceRangeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1776,6 +2683,12 @@ func (*UnimplementedQueryServer) MissCounter(ctx context.Context, req *QueryMiss
 func (*UnimplementedQueryServer) SlashWindow(ctx context.Context, req *QuerySlashWindow) (*QuerySlashWindowResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SlashWindow not implemented")
 }
+func (*UnimplementedQueryServer) ValidatorOracleHistory(ctx context.Context, req *QueryValidatorOracleHistory) (*QueryValidatorOracleHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorOracleHistory not implemented")
+}
+func (*UnimplementedQueryServer) SlashWindowSummary(ctx context.Context, req *QuerySlashWindowSummary) (*QuerySlashWindowSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashWindowSummary not implemented")
+}
 func (*UnimplementedQueryServer) AggregatePrevote(ctx context.Context, req *QueryAggregatePrevote) (*QueryAggregatePrevoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AggregatePrevote not implemented")
 }
@@ -1809,9 +2722,24 @@ func (*UnimplementedQueryServer) WMA(ctx context.Context, req *QueryWMARequest)
 func (*UnimplementedQueryServer) SMA(ctx context.Context, req *QuerySMARequest) (*QuerySMAResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SMA not implemented")
 }
+func (*UnimplementedQueryServer) TWAP(ctx context.Context, req *QueryTWAPRequest) (*QueryTWAPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TWAP not implemented")
+}
 func (*UnimplementedQueryServer) ConvertUSDCtoCHEQ(ctx context.Context, req *ConvertUSDCtoCHEQRequest) (*ConvertUSDCtoCHEQResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConvertUSDCtoCHEQ not implemented")
 }
+func (*UnimplementedQueryServer) Convert(ctx context.Context, req *QueryConvertRequest) (*QueryConvertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Convert not implemented")
+}
+func (*UnimplementedQueryServer) PriceHealth(ctx context.Context, req *QueryPriceHealthRequest) (*QueryPriceHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceHealth not implemented")
+}
+func (*UnimplementedQueryServer) PriceAt(ctx context.Context, req *QueryPriceAtRequest) (*QueryPriceAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceAt not implemented")
+}
+func (*UnimplementedQueryServer) PriceRange(ctx context.Context, req *QueryPriceRangeRequest) (*QueryPriceRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceRange not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1907,6 +2835,42 @@ func _Query_SlashWindow_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorOracleHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorOracleHistory)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorOracleHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.oracle.v2.Query/ValidatorOracleHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorOracleHistory(ctx, req.(*QueryValidatorOracleHistory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SlashWindowSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashWindowSummary)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashWindowSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.oracle.v2.Query/SlashWindowSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashWindowSummary(ctx, req.(*QuerySlashWindowSummary))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_AggregatePrevote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAggregatePrevote)
 	if err := dec(in); err != nil {
@@ -2105,6 +3069,24 @@ func _Query_SMA_Handler(srv interface{}, ctx context.Context, dec func(interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TWAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTWAPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TWAP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.oracle.v2.Query/TWAP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TWAP(ctx, req.(*QueryTWAPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ConvertUSDCtoCHEQ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ConvertUSDCtoCHEQRequest)
 	if err := dec(in); err != nil {
@@ -2123,6 +3105,78 @@ func _Query_ConvertUSDCtoCHEQ_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Convert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConvertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Convert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.oracle.v2.Query/Convert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Convert(ctx, req.(*QueryConvertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.oracle.v2.Query/PriceHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceHealth(ctx, req.(*QueryPriceHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.oracle.v2.Query/PriceAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceAt(ctx, req.(*QueryPriceAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.oracle.v2.Query/PriceRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceRange(ctx, req.(*QueryPriceRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cheqd.oracle.v2.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2147,6 +3201,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SlashWindow",
 			Handler:    _Query_SlashWindow_Handler,
 		},
+		{
+			MethodName: "ValidatorOracleHistory",
+			Handler:    _Query_ValidatorOracleHistory_Handler,
+		},
+		{
+			MethodName: "SlashWindowSummary",
+			Handler:    _Query_SlashWindowSummary_Handler,
+		},
 		{
 			MethodName: "AggregatePrevote",
 			Handler:    _Query_AggregatePrevote_Handler,
@@ -2191,10 +3253,30 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SMA",
 			Handler:    _Query_SMA_Handler,
 		},
+		{
+			MethodName: "TWAP",
+			Handler:    _Query_TWAP_Handler,
+		},
 		{
 			MethodName: "ConvertUSDCtoCHEQ",
 			Handler:    _Query_ConvertUSDCtoCHEQ_Handler,
 		},
+		{
+			MethodName: "Convert",
+			Handler:    _Query_Convert_Handler,
+		},
+		{
+			MethodName: "PriceHealth",
+			Handler:    _Query_PriceHealth_Handler,
+		},
+		{
+			MethodName: "PriceAt",
+			Handler:    _Query_PriceAt_Handler,
+		},
+		{
+			MethodName: "PriceRange",
+			Handler:    _Query_PriceRange_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cheqd/oracle/v2/query.proto",
@@ -2491,7 +3573,7 @@ func (m *QuerySlashWindowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAggregatePrevote) Marshal() (dAtA []byte, err error) {
+func (m *QueryValidatorOracleHistory) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2501,16 +3583,28 @@ func (m *QueryAggregatePrevote) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAggregatePrevote) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryValidatorOracleHistory) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAggregatePrevote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryValidatorOracleHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.ValidatorAddr) > 0 {
 		i -= len(m.ValidatorAddr)
 		copy(dAtA[i:], m.ValidatorAddr)
@@ -2521,7 +3615,7 @@ func (m *QueryAggregatePrevote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAggregatePrevoteResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryValidatorOracleHistoryResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2531,18 +3625,44 @@ func (m *QueryAggregatePrevoteResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAggregatePrevoteResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryValidatorOracleHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAggregatePrevoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryValidatorOracleHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
-		size, err := m.AggregatePrevote.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Current.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2554,7 +3674,7 @@ func (m *QueryAggregatePrevoteResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAggregatePrevotes) Marshal() (dAtA []byte, err error) {
+func (m *QuerySlashWindowSummary) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2564,20 +3684,25 @@ func (m *QueryAggregatePrevotes) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAggregatePrevotes) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySlashWindowSummary) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAggregatePrevotes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySlashWindowSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.WindowEndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowEndHeight))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAggregatePrevotesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QuerySlashWindowSummaryResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2587,34 +3712,30 @@ func (m *QueryAggregatePrevotesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAggregatePrevotesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySlashWindowSummaryResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAggregatePrevotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySlashWindowSummaryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.AggregatePrevotes) > 0 {
-		for iNdEx := len(m.AggregatePrevotes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AggregatePrevotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.Summary.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAggregateVote) Marshal() (dAtA []byte, err error) {
+func (m *QueryAggregatePrevote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2624,12 +3745,12 @@ func (m *QueryAggregateVote) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAggregateVote) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevote) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAggregateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2644,7 +3765,7 @@ func (m *QueryAggregateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAggregateVoteResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAggregatePrevoteResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2654,18 +3775,18 @@ func (m *QueryAggregateVoteResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAggregateVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevoteResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAggregateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.AggregateVote.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.AggregatePrevote.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2677,7 +3798,7 @@ func (m *QueryAggregateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAggregateVotes) Marshal() (dAtA []byte, err error) {
+func (m *QueryAggregatePrevotes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2687,12 +3808,12 @@ func (m *QueryAggregateVotes) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAggregateVotes) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevotes) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAggregateVotes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevotes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2700,7 +3821,7 @@ func (m *QueryAggregateVotes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAggregateVotesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAggregatePrevotesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2710,20 +3831,20 @@ func (m *QueryAggregateVotesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAggregateVotesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevotesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAggregateVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.AggregateVotes) > 0 {
-		for iNdEx := len(m.AggregateVotes) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.AggregatePrevotes) > 0 {
+		for iNdEx := len(m.AggregatePrevotes) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AggregateVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.AggregatePrevotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2737,7 +3858,7 @@ func (m *QueryAggregateVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParams) Marshal() (dAtA []byte, err error) {
+func (m *QueryAggregateVote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2747,20 +3868,27 @@ func (m *QueryParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAggregateVote) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAggregateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAggregateVoteResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2770,7 +3898,123 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAggregateVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AggregateVote.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregateVotes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregateVotes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregateVotes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregateVotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregateVotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregateVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AggregateVotes) > 0 {
+		for iNdEx := len(m.AggregateVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AggregateVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -3072,21 +4316,21 @@ func (m *QueryWMARequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.CustomWeights) > 0 {
-		dAtA6 := make([]byte, len(m.CustomWeights)*10)
-		var j5 int
+		dAtA10 := make([]byte, len(m.CustomWeights)*10)
+		var j9 int
 		for _, num1 := range m.CustomWeights {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j5++
+				j9++
 			}
-			dAtA6[j5] = uint8(num)
-			j5++
+			dAtA10[j9] = uint8(num)
+			j9++
 		}
-		i -= j5
-		copy(dAtA[i:], dAtA6[:j5])
-		i = encodeVarintQuery(dAtA, i, uint64(j5))
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintQuery(dAtA, i, uint64(j9))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -3203,6 +4447,135 @@ func (m *QuerySMAResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryTWAPRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTWAPRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTWAPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Lookback, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Lookback):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintQuery(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TwapPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TwapPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwapPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdatedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintQuery(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x22
+	if m.UpdatedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UpdatedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Lookback, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Lookback):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintQuery(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTWAPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTWAPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTWAPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ConvertUSDCtoCHEQRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3224,21 +4597,21 @@ func (m *ConvertUSDCtoCHEQRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 	var l int
 	_ = l
 	if len(m.CustomWeights) > 0 {
-		dAtA8 := make([]byte, len(m.CustomWeights)*10)
-		var j7 int
+		dAtA15 := make([]byte, len(m.CustomWeights)*10)
+		var j14 int
 		for _, num1 := range m.CustomWeights {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j7++
+				j14++
 			}
-			dAtA8[j7] = uint8(num)
-			j7++
+			dAtA15[j14] = uint8(num)
+			j14++
 		}
-		i -= j7
-		copy(dAtA[i:], dAtA8[:j7])
-		i = encodeVarintQuery(dAtA, i, uint64(j7))
+		i -= j14
+		copy(dAtA[i:], dAtA15[:j14])
+		i = encodeVarintQuery(dAtA, i, uint64(j14))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -3296,289 +4669,570 @@ func (m *ConvertUSDCtoCHEQResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryConvertRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryExchangeRates) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryConvertRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryExchangeRatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryConvertRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ExchangeRates) > 0 {
-		for _, e := range m.ExchangeRates {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	n16, err16 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TwapLookback, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapLookback):])
+	if err16 != nil {
+		return 0, err16
 	}
-	return n
-}
-
-func (m *QueryActiveExchangeRates) Size() (n int) {
-	if m == nil {
-		return 0
+	i -= n16
+	i = encodeVarintQuery(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.CustomWeights) > 0 {
+		dAtA18 := make([]byte, len(m.CustomWeights)*10)
+		var j17 int
+		for _, num1 := range m.CustomWeights {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA18[j17] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j17++
+			}
+			dAtA18[j17] = uint8(num)
+			j17++
+		}
+		i -= j17
+		copy(dAtA[i:], dAtA18[:j17])
+		i = encodeVarintQuery(dAtA, i, uint64(j17))
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryActiveExchangeRatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.PriceSource != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PriceSource))
+		i--
+		dAtA[i] = 0x18
 	}
-	var l int
-	_ = l
-	if len(m.ActiveRates) > 0 {
-		for _, s := range m.ActiveRates {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if len(m.ToDenom) > 0 {
+		i -= len(m.ToDenom)
+		copy(dAtA[i:], m.ToDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ToDenom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFeederDelegation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *ConversionPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryFeederDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FeederAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *ConversionPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryMissCounter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ConversionPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Age != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Age))
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
-}
-
-func (m *QueryMissCounterResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
 	}
-	var l int
-	_ = l
-	if m.MissCounter != 0 {
-		n += 1 + sovQuery(uint64(m.MissCounter))
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySlashWindow) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryConvertResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySlashWindowResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.WindowProgress != 0 {
-		n += 1 + sovQuery(uint64(m.WindowProgress))
-	}
-	return n
+func (m *QueryConvertResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAggregatePrevote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryConvertResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.ToPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.FromPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Age != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Age))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAggregatePrevoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPriceHealthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.AggregatePrevote.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAggregatePrevotes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryPriceHealthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAggregatePrevotesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPriceHealthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.AggregatePrevotes) > 0 {
-		for _, e := range m.AggregatePrevotes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAggregateVote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPriceHealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAggregateVoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.AggregateVote.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryPriceHealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAggregateVotes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPriceHealthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
-}
-
-func (m *QueryAggregateVotesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Healthy {
+		i--
+		if m.Healthy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.CircuitBreaker.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Age != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Age))
+		i--
+		dAtA[i] = 0x20
+	}
+	n23, err23 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdatedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedTime):])
+	if err23 != nil {
+		return 0, err23
+	}
+	i -= n23
+	i = encodeVarintQuery(dAtA, i, uint64(n23))
+	i--
+	dAtA[i] = 0x1a
+	if m.UpdatedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UpdatedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PricePoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PricePoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PricePoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.AggregateVotes) > 0 {
-		for _, e := range m.AggregateVotes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	n24, err24 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err24 != nil {
+		return 0, err24
+	}
+	i -= n24
+	i = encodeVarintQuery(dAtA, i, uint64(n24))
+	i--
+	dAtA[i] = 0x1a
+	if m.BlockNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNum))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPriceAtRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceAtRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceAtRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.Series) > 0 {
+		i -= len(m.Series)
+		copy(dAtA[i:], m.Series)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Series)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Time != nil {
+		n25, err25 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time):])
+		if err25 != nil {
+			return 0, err25
+		}
+		i -= n25
+		i = encodeVarintQuery(dAtA, i, uint64(n25))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPriceAtResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceAtResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceAtResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryMedians) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPriceRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.NumStamps != 0 {
-		n += 1 + sovQuery(uint64(m.NumStamps))
+	if m.Step != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Step))
+		i--
+		dAtA[i] = 0x38
 	}
-	return n
+	if len(m.Series) > 0 {
+		i -= len(m.Series)
+		copy(dAtA[i:], m.Series)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Series)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.EndTime != nil {
+		n28, err28 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err28 != nil {
+			return 0, err28
+		}
+		i -= n28
+		i = encodeVarintQuery(dAtA, i, uint64(n28))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.StartTime != nil {
+		n29, err29 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
+		if err29 != nil {
+			return 0, err29
+		}
+		i -= n29
+		i = encodeVarintQuery(dAtA, i, uint64(n29))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryMediansResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPriceRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Medians) > 0 {
-		for _, e := range m.Medians {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryMedianDeviations) Size() (n int) {
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryExchangeRates) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3591,14 +5245,14 @@ func (m *QueryMedianDeviations) Size() (n int) {
 	return n
 }
 
-func (m *QueryMedianDeviationsResponse) Size() (n int) {
+func (m *QueryExchangeRatesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.MedianDeviations) > 0 {
-		for _, e := range m.MedianDeviations {
+	if len(m.ExchangeRates) > 0 {
+		for _, e := range m.ExchangeRates {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -3606,7 +5260,7 @@ func (m *QueryMedianDeviationsResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryValidatorRewardSet) Size() (n int) {
+func (m *QueryActiveExchangeRates) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3615,148 +5269,2814 @@ func (m *QueryValidatorRewardSet) Size() (n int) {
 	return n
 }
 
-func (m *QueryValidatorRewardSetResponse) Size() (n int) {
+func (m *QueryActiveExchangeRatesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Validators.Size()
-	n += 1 + l + sovQuery(uint64(l))
+	if len(m.ActiveRates) > 0 {
+		for _, s := range m.ActiveRates {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *QueryEMARequest) Size() (n int) {
+func (m *QueryFeederDelegation) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Denom)
+	l = len(m.ValidatorAddr)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryEMAResponse) Size() (n int) {
+func (m *QueryFeederDelegationResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Price.Size()
-	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.FeederAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryWMARequest) Size() (n int) {
+func (m *QueryMissCounter) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Denom)
+	l = len(m.ValidatorAddr)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.Strategy)
+	return n
+}
+
+func (m *QueryMissCounterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MissCounter != 0 {
+		n += 1 + sovQuery(uint64(m.MissCounter))
+	}
+	return n
+}
+
+func (m *QuerySlashWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySlashWindowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WindowProgress != 0 {
+		n += 1 + sovQuery(uint64(m.WindowProgress))
+	}
+	return n
+}
+
+func (m *QueryValidatorOracleHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if len(m.CustomWeights) > 0 {
-		l = 0
-		for _, e := range m.CustomWeights {
-			l += sovQuery(uint64(e))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorOracleHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Current.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
 		}
-		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryWMAResponse) Size() (n int) {
+func (m *QuerySlashWindowSummary) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Price.Size()
+	if m.WindowEndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.WindowEndHeight))
+	}
+	return n
+}
+
+func (m *QuerySlashWindowSummaryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Summary.Size()
 	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QuerySMARequest) Size() (n int) {
+func (m *QueryAggregatePrevote) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Denom)
+	l = len(m.ValidatorAddr)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QuerySMAResponse) Size() (n int) {
+func (m *QueryAggregatePrevoteResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Price.Size()
+	l = m.AggregatePrevote.Size()
 	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *ConvertUSDCtoCHEQRequest) Size() (n int) {
+func (m *QueryAggregatePrevotes) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Amount)
+	return n
+}
+
+func (m *QueryAggregatePrevotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AggregatePrevotes) > 0 {
+		for _, e := range m.AggregatePrevotes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAggregateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.MaType)
+	return n
+}
+
+func (m *QueryAggregateVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AggregateVote.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAggregateVotes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAggregateVotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AggregateVotes) > 0 {
+		for _, e := range m.AggregateVotes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMedians) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.WmaStrategy)
+	if m.NumStamps != 0 {
+		n += 1 + sovQuery(uint64(m.NumStamps))
+	}
+	return n
+}
+
+func (m *QueryMediansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Medians) > 0 {
+		for _, e := range m.Medians {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMedianDeviations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if len(m.CustomWeights) > 0 {
-		l = 0
-		for _, e := range m.CustomWeights {
-			l += sovQuery(uint64(e))
+	return n
+}
+
+func (m *QueryMedianDeviationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MedianDeviations) > 0 {
+		for _, e := range m.MedianDeviations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidatorRewardSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryValidatorRewardSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Validators.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEMARequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEMAResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryWMARequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Strategy)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.CustomWeights) > 0 {
+		l = 0
+		for _, e := range m.CustomWeights {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryWMAResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySMARequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySMAResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTWAPRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Lookback)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *TwapPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Lookback)
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.UpdatedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.UpdatedHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTWAPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConvertUSDCtoCHEQRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MaType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.WmaStrategy)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.CustomWeights) > 0 {
+		l = 0
+		for _, e := range m.CustomWeights {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *ConvertUSDCtoCHEQResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConvertRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ToDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PriceSource != 0 {
+		n += 1 + sovQuery(uint64(m.PriceSource))
+	}
+	if len(m.CustomWeights) > 0 {
+		l = 0
+		for _, e := range m.CustomWeights {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapLookback)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ConversionPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Age != 0 {
+		n += 1 + sovQuery(uint64(m.Age))
+	}
+	return n
+}
+
+func (m *QueryConvertResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Age != 0 {
+		n += 1 + sovQuery(uint64(m.Age))
+	}
+	l = m.FromPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ToPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPriceHealthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPriceHealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.UpdatedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.UpdatedHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Age != 0 {
+		n += 1 + sovQuery(uint64(m.Age))
+	}
+	l = m.CircuitBreaker.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Healthy {
+		n += 2
+	}
+	return n
+}
+
+func (m *PricePoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.BlockNum != 0 {
+		n += 1 + sovQuery(uint64(m.BlockNum))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPriceAtRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Time != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Series)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPriceAtResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPriceRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	if m.StartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Series)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Step != 0 {
+		n += 1 + sovQuery(uint64(m.Step))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPriceRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryExchangeRates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRates = append(m.ExchangeRates, types.DecCoin{})
+			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveExchangeRates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveExchangeRates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveExchangeRates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveExchangeRatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveRates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveRates = append(m.ActiveRates, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeederDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeederDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeederDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeederDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeederDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeederAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeederAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMissCounter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMissCounter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMissCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMissCounterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMissCounterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissCounter", wireType)
+			}
+			m.MissCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashWindowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashWindowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashWindowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowProgress", wireType)
+			}
+			m.WindowProgress = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowProgress |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorOracleHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorOracleHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorOracleHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorOracleHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorOracleHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorOracleHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Current.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, ValidatorOracleWindow{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashWindowSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashWindowSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashWindowSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowEndHeight", wireType)
+			}
+			m.WindowEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowEndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashWindowSummaryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashWindowSummaryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashWindowSummaryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Summary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregatePrevote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregatePrevote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AggregatePrevote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregatePrevotes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregatePrevotes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregatePrevotes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregatePrevotesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregatePrevotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregatePrevotes = append(m.AggregatePrevotes, AggregateExchangeRatePrevote{})
+			if err := m.AggregatePrevotes[len(m.AggregatePrevotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregateVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregateVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregateVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateVote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AggregateVote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregateVotes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregateVotes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregateVotes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregateVotesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregateVotesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregateVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregateVotes = append(m.AggregateVotes, AggregateExchangeRateVote{})
+			if err := m.AggregateVotes[len(m.AggregateVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMedians) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMedians: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMedians: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumStamps", wireType)
+			}
+			m.NumStamps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumStamps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMediansResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMediansResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMediansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Medians", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Medians = append(m.Medians, PriceStamp{})
+			if err := m.Medians[len(m.Medians)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		n += 1 + sovQuery(uint64(l)) + l
 	}
-	return n
-}
 
-func (m *ConvertUSDCtoCHEQResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryExchangeRates) Unmarshal(dAtA []byte) error {
+func (m *QueryMedianDeviations) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3779,10 +8099,10 @@ func (m *QueryExchangeRates) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRates: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMedianDeviations: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRates: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMedianDeviations: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3838,7 +8158,7 @@ func (m *QueryExchangeRates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMedianDeviationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3861,15 +8181,15 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMedianDeviationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMedianDeviationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MedianDeviations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3896,8 +8216,8 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExchangeRates = append(m.ExchangeRates, types.DecCoin{})
-			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MedianDeviations = append(m.MedianDeviations, PriceStamp{})
+			if err := m.MedianDeviations[len(m.MedianDeviations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3922,7 +8242,7 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActiveExchangeRates) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorRewardSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3945,10 +8265,10 @@ func (m *QueryActiveExchangeRates) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActiveExchangeRates: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorRewardSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActiveExchangeRates: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorRewardSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3972,7 +8292,7 @@ func (m *QueryActiveExchangeRates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActiveExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorRewardSetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3995,17 +8315,17 @@ func (m *QueryActiveExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActiveExchangeRatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorRewardSetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActiveExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorRewardSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4015,23 +8335,24 @@ func (m *QueryActiveExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActiveRates = append(m.ActiveRates, string(dAtA[iNdEx:postIndex]))
+			if err := m.Validators.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4054,7 +8375,7 @@ func (m *QueryActiveExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeederDelegation) Unmarshal(dAtA []byte) error {
+func (m *QueryEMARequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4077,15 +8398,15 @@ func (m *QueryFeederDelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeederDelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEMARequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeederDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEMARequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4113,7 +8434,7 @@ func (m *QueryFeederDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4136,7 +8457,7 @@ func (m *QueryFeederDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEMAResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4159,15 +8480,15 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeederDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEMAResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeederDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEMAResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeederAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4195,7 +8516,9 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeederAddr = string(dAtA[iNdEx:postIndex])
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4218,7 +8541,7 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMissCounter) Unmarshal(dAtA []byte) error {
+func (m *QueryWMARequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4241,15 +8564,15 @@ func (m *QueryMissCounter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMissCounter: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWMARequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMissCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWMARequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4277,63 +8600,13 @@ func (m *QueryMissCounter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMissCounterResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMissCounterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissCounter", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
 			}
-			m.MissCounter = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4343,11 +8616,100 @@ func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MissCounter |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Strategy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CustomWeights = append(m.CustomWeights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CustomWeights) == 0 {
+					m.CustomWeights = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CustomWeights = append(m.CustomWeights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomWeights", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4369,7 +8731,7 @@ func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySlashWindow) Unmarshal(dAtA []byte) error {
+func (m *QueryWMAResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4392,12 +8754,46 @@ func (m *QuerySlashWindow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySlashWindow: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWMAResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySlashWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWMAResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4419,7 +8815,7 @@ func (m *QuerySlashWindow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySlashWindowResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySMARequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4442,17 +8838,17 @@ func (m *QuerySlashWindowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySlashWindowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySMARequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySlashWindowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySMARequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WindowProgress", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.WindowProgress = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4462,11 +8858,24 @@ func (m *QuerySlashWindowResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WindowProgress |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4488,7 +8897,7 @@ func (m *QuerySlashWindowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregatePrevote) Unmarshal(dAtA []byte) error {
+func (m *QuerySMAResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4511,15 +8920,15 @@ func (m *QueryAggregatePrevote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregatePrevote: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySMAResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySMAResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4547,7 +8956,9 @@ func (m *QueryAggregatePrevote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4570,7 +8981,7 @@ func (m *QueryAggregatePrevote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTWAPRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4593,17 +9004,17 @@ func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTWAPRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTWAPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4613,75 +9024,57 @@ func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AggregatePrevote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lookback", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAggregatePrevotes) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Lookback, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregatePrevotes: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregatePrevotes: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4703,7 +9096,7 @@ func (m *QueryAggregatePrevotes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
+func (m *TwapPrice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4726,15 +9119,15 @@ func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregatePrevotesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TwapPrice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregatePrevotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TwapPrice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevotes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lookback", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4761,66 +9154,68 @@ func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AggregatePrevotes = append(m.AggregatePrevotes, AggregateExchangeRatePrevote{})
-			if err := m.AggregatePrevotes[len(m.AggregatePrevotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Lookback, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAggregateVote) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregateVote: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregateVote: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedHeight", wireType)
+			}
+			m.UpdatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4830,23 +9225,24 @@ func (m *QueryAggregateVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdatedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4869,7 +9265,7 @@ func (m *QueryAggregateVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTWAPResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4892,15 +9288,15 @@ func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregateVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTWAPResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTWAPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregateVote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4927,7 +9323,8 @@ func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AggregateVote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Prices = append(m.Prices, TwapPrice{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4952,7 +9349,7 @@ func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregateVotes) Unmarshal(dAtA []byte) error {
+func (m *ConvertUSDCtoCHEQRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4975,67 +9372,49 @@ func (m *QueryAggregateVotes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregateVotes: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConvertUSDCtoCHEQRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregateVotes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConvertUSDCtoCHEQRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAggregateVotesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregateVotesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregateVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregateVotes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5045,76 +9424,132 @@ func (m *QueryAggregateVotesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AggregateVotes = append(m.AggregateVotes, AggregateExchangeRateVote{})
-			if err := m.AggregateVotes[len(m.AggregateVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MaType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WmaStrategy", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.WmaStrategy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CustomWeights = append(m.CustomWeights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CustomWeights) == 0 {
+					m.CustomWeights = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CustomWeights = append(m.CustomWeights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomWeights", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5136,7 +9571,7 @@ func (m *QueryParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *ConvertUSDCtoCHEQResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5159,17 +9594,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConvertUSDCtoCHEQResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConvertUSDCtoCHEQResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5179,24 +9614,23 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5219,7 +9653,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMedians) Unmarshal(dAtA []byte) error {
+func (m *QueryConvertRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5242,15 +9676,15 @@ func (m *QueryMedians) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMedians: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConvertRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMedians: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConvertRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5278,13 +9712,13 @@ func (m *QueryMedians) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumStamps", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToDenom", wireType)
 			}
-			m.NumStamps = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5294,64 +9728,122 @@ func (m *QueryMedians) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumStamps |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryMediansResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.ToDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSource", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.PriceSource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceSource |= PriceSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 4:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CustomWeights = append(m.CustomWeights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CustomWeights) == 0 {
+					m.CustomWeights = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CustomWeights = append(m.CustomWeights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomWeights", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMediansResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMediansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Medians", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapLookback", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5378,8 +9870,7 @@ func (m *QueryMediansResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Medians = append(m.Medians, PriceStamp{})
-			if err := m.Medians[len(m.Medians)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TwapLookback, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5404,7 +9895,7 @@ func (m *QueryMediansResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMedianDeviations) Unmarshal(dAtA []byte) error {
+func (m *ConversionPrice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5427,10 +9918,10 @@ func (m *QueryMedianDeviations) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMedianDeviations: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConversionPrice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMedianDeviations: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConversionPrice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5463,8 +9954,80 @@ func (m *QueryMedianDeviations) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Age", wireType)
+			}
+			m.Age = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Age |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5486,7 +10049,7 @@ func (m *QueryMedianDeviations) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMedianDeviationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConvertResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5509,15 +10072,15 @@ func (m *QueryMedianDeviationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMedianDeviationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConvertResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMedianDeviationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConvertResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MedianDeviations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5544,61 +10107,129 @@ func (m *QueryMedianDeviationsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MedianDeviations = append(m.MedianDeviations, PriceStamp{})
-			if err := m.MedianDeviations[len(m.MedianDeviations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Age", wireType)
+			}
+			m.Age = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Age |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryValidatorRewardSet) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.FromPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.ToPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorRewardSet: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorRewardSet: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5620,7 +10251,7 @@ func (m *QueryValidatorRewardSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorRewardSetResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceHealthRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5643,17 +10274,17 @@ func (m *QueryValidatorRewardSetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorRewardSetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceHealthRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorRewardSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceHealthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5663,24 +10294,23 @@ func (m *QueryValidatorRewardSetResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Validators.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5703,7 +10333,7 @@ func (m *QueryValidatorRewardSetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEMARequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceHealthResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5726,15 +10356,15 @@ func (m *QueryEMARequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEMARequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceHealthResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEMARequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5762,63 +10392,86 @@ func (m *QueryEMARequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedHeight", wireType)
+			}
+			m.UpdatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryEMAResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdatedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Age", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Age = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Age |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEMAResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEMAResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5828,26 +10481,45 @@ func (m *QueryEMAResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Healthy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Healthy = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5869,7 +10541,7 @@ func (m *QueryEMAResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWMARequest) Unmarshal(dAtA []byte) error {
+func (m *PricePoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5892,15 +10564,15 @@ func (m *QueryWMARequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWMARequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PricePoint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWMARequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PricePoint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5928,13 +10600,34 @@ func (m *QueryWMARequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNum", wireType)
+			}
+			m.BlockNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5944,100 +10637,25 @@ func (m *QueryWMARequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Strategy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.CustomWeights = append(m.CustomWeights, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.CustomWeights) == 0 {
-					m.CustomWeights = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.CustomWeights = append(m.CustomWeights, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field CustomWeights", wireType)
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6059,7 +10677,7 @@ func (m *QueryWMARequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWMAResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceAtRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6082,15 +10700,15 @@ func (m *QueryWMAResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWMAResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceAtRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWMAResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceAtRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6118,63 +10736,66 @@ func (m *QueryWMAResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QuerySMARequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Time == nil {
+				m.Time = new(time.Time)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySMARequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySMARequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Series", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6202,7 +10823,7 @@ func (m *QuerySMARequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Series = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6225,7 +10846,7 @@ func (m *QuerySMARequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySMAResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceAtResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6248,17 +10869,17 @@ func (m *QuerySMAResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySMAResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceAtResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySMAResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceAtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6268,16 +10889,15 @@ func (m *QuerySMAResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
@@ -6309,7 +10929,7 @@ func (m *QuerySMAResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConvertUSDCtoCHEQRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceRangeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6332,15 +10952,15 @@ func (m *ConvertUSDCtoCHEQRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConvertUSDCtoCHEQRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceRangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConvertUSDCtoCHEQRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6368,13 +10988,51 @@ func (m *ConvertUSDCtoCHEQRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6384,27 +11042,67 @@ func (m *ConvertUSDCtoCHEQRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaType = string(dAtA[iNdEx:postIndex])
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WmaStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Series", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6432,84 +11130,63 @@ func (m *ConvertUSDCtoCHEQRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WmaStrategy = string(dAtA[iNdEx:postIndex])
+			m.Series = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			m.Step = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				m.CustomWeights = append(m.CustomWeights, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Step |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.CustomWeights) == 0 {
-					m.CustomWeights = make([]int32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.CustomWeights = append(m.CustomWeights, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field CustomWeights", wireType)
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6531,7 +11208,7 @@ func (m *ConvertUSDCtoCHEQRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConvertUSDCtoCHEQResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceRangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6554,17 +11231,17 @@ func (m *ConvertUSDCtoCHEQResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConvertUSDCtoCHEQResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceRangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConvertUSDCtoCHEQResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6574,23 +11251,61 @@ func (m *ConvertUSDCtoCHEQResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.Prices = append(m.Prices, PricePoint{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
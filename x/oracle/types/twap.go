@@ -0,0 +1,21 @@
+package types
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// CumulativeAt returns the cumulative price of a checkpoint extrapolated to a given time,
+// assuming its price is held until then. Times before the checkpoint are not extrapolated.
+func (c TwapCheckpoint) CumulativeAt(t time.Time) math.LegacyDec {
+	if !t.After(c.Time) {
+		return c.Cumulative
+	}
+	return c.Cumulative.Add(c.Price.Mul(DurationSeconds(t.Sub(c.Time))))
+}
+
+// DurationSeconds returns a duration in seconds, with nanosecond precision
+func DurationSeconds(d time.Duration) math.LegacyDec {
+	return math.LegacyNewDecWithPrec(d.Nanoseconds(), 9)
+}
@@ -808,82 +808,82 @@ func init() {
 func init() { proto.RegisterFile("cheqd/oracle/v2/tx.proto", fileDescriptor_f0d2b29fceaeeab5) }
 
 var fileDescriptor_f0d2b29fceaeeab5 = []byte{
-	// 1193 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4f, 0x6c, 0x1b, 0xc5,
-	0x17, 0xf6, 0xd4, 0x76, 0x1a, 0x3f, 0xff, 0x7e, 0x44, 0x6c, 0x9b, 0x64, 0xb3, 0x49, 0xd7, 0xee,
-	0x26, 0xb4, 0x4e, 0x42, 0xec, 0xc6, 0xfc, 0xab, 0x2c, 0x10, 0x8d, 0x13, 0xa0, 0x87, 0x06, 0x45,
-	0x0b, 0xf4, 0x50, 0x09, 0x59, 0x93, 0xdd, 0x61, 0xbd, 0xaa, 0xbd, 0x6b, 0x76, 0x26, 0x26, 0xbe,
-	0x20, 0xe0, 0x84, 0x90, 0x90, 0x7a, 0xe4, 0x58, 0xc1, 0xad, 0x12, 0x52, 0x0f, 0xbd, 0x23, 0x6e,
-	0x39, 0xa1, 0xaa, 0x27, 0x84, 0x90, 0x0b, 0x09, 0x52, 0xb9, 0x70, 0x09, 0x77, 0x84, 0x76, 0x76,
-	0xbd, 0x76, 0xec, 0x5d, 0xc7, 0xa9, 0x90, 0x9a, 0x4b, 0xe2, 0x99, 0xf7, 0xbd, 0x37, 0xdf, 0xf7,
-	0xbd, 0xd9, 0xd9, 0xd1, 0x82, 0xa8, 0x55, 0xc9, 0xc7, 0x7a, 0xc1, 0x76, 0xb0, 0x56, 0x23, 0x85,
-	0x66, 0xb1, 0xc0, 0x76, 0xf3, 0x0d, 0xc7, 0x66, 0xb6, 0x30, 0xc1, 0x23, 0x79, 0x2f, 0x92, 0x6f,
-	0x16, 0xa5, 0xb9, 0x7e, 0xa8, 0x1f, 0xe2, 0x70, 0x69, 0x5a, 0xb3, 0x69, 0xdd, 0xa6, 0x85, 0x3a,
-	0x35, 0x0a, 0xcd, 0x55, 0xf7, 0x9f, 0x1f, 0x98, 0xf1, 0x02, 0x15, 0x3e, 0x2a, 0x78, 0x03, 0x3f,
-	0x74, 0xde, 0xb0, 0x0d, 0xdb, 0x9b, 0x77, 0x7f, 0x79, 0xb3, 0xca, 0x4f, 0x08, 0x32, 0x9b, 0xd4,
-	0x58, 0x33, 0x0c, 0x87, 0x18, 0x98, 0x91, 0xb7, 0x76, 0xb5, 0x2a, 0xb6, 0x0c, 0xa2, 0x62, 0x46,
-	0xb6, 0x1c, 0xd2, 0xb4, 0x19, 0x11, 0xe6, 0x21, 0x51, 0xc5, 0xb4, 0x2a, 0xa2, 0x2c, 0xca, 0xa5,
-	0xca, 0x13, 0x87, 0xed, 0x4c, 0xba, 0x85, 0xeb, 0xb5, 0x92, 0xe2, 0xce, 0x2a, 0x2a, 0x0f, 0x0a,
-	0x57, 0x60, 0xec, 0x23, 0x42, 0x74, 0xe2, 0x88, 0x67, 0x38, 0x4c, 0x7c, 0xf4, 0x60, 0xe5, 0xbc,
-	0x4f, 0x60, 0x4d, 0xd7, 0x1d, 0x42, 0xe9, 0x7b, 0xcc, 0x31, 0x2d, 0x43, 0xf5, 0x71, 0xc2, 0x9b,
-	0x90, 0x6a, 0xe2, 0x9a, 0xa9, 0x63, 0x66, 0x3b, 0x62, 0x9c, 0x27, 0x5d, 0x7c, 0xf4, 0x60, 0xe5,
-	0x82, 0x9f, 0x74, 0xb3, 0x13, 0x3b, 0x9a, 0xdd, 0xcd, 0x29, 0x9d, 0xfb, 0xf2, 0x6e, 0x26, 0xf6,
-	0xe7, 0xdd, 0x4c, 0xec, 0x8b, 0x27, 0xf7, 0x97, 0xfc, 0xaa, 0xca, 0x22, 0x5c, 0x3e, 0x46, 0x8f,
-	0x4a, 0x68, 0xc3, 0xb6, 0x28, 0x51, 0xbe, 0x3e, 0x03, 0x73, 0x51, 0xd8, 0x9b, 0xbe, 0x70, 0x8a,
-	0x6b, 0x6c, 0x50, 0xb8, 0x3b, 0xab, 0xa8, 0x3c, 0x28, 0x5c, 0x83, 0xe7, 0x88, 0x9f, 0x58, 0x71,
-	0x30, 0x23, 0xd4, 0x37, 0x60, 0xe6, 0xb0, 0x9d, 0x99, 0xf4, 0xe0, 0x47, 0xe3, 0x8a, 0xfa, 0x7f,
-	0xd2, 0xb3, 0x12, 0xed, 0xb1, 0x2e, 0xfe, 0x34, 0xd6, 0x25, 0xfe, 0x2b, 0xeb, 0x2e, 0xc1, 0xc2,
-	0x30, 0x3b, 0x02, 0xdf, 0xbe, 0x47, 0x30, 0xb5, 0x49, 0x8d, 0x0d, 0x52, 0xe3, 0xb8, 0xb7, 0x09,
-	0xd1, 0xd7, 0xdd, 0x80, 0xc5, 0x84, 0x37, 0x60, 0xdc, 0x6e, 0x10, 0x87, 0xf3, 0x42, 0xa3, 0xf2,
-	0x0a, 0x52, 0xdc, 0x74, 0xdd, 0xaf, 0xea, 0xbb, 0x38, 0x4a, 0x7a, 0x27, 0xa5, 0x34, 0xd9, 0xab,
-	0x2a, 0xa8, 0xaa, 0x64, 0x41, 0x0e, 0xa7, 0x1b, 0x28, 0xfa, 0x1b, 0x81, 0xb8, 0x49, 0x8d, 0x1b,
-	0xc4, 0xc0, 0x5a, 0xeb, 0x1d, 0xbb, 0xf9, 0x41, 0x43, 0x77, 0xf7, 0x0b, 0x76, 0x70, 0x9d, 0x0a,
-	0xaf, 0x42, 0x0a, 0xef, 0xb0, 0xaa, 0xed, 0x98, 0xac, 0xe5, 0x8b, 0x8a, 0xee, 0x50, 0x17, 0x2a,
-	0x9c, 0x87, 0x24, 0x33, 0x59, 0xcd, 0x57, 0xa2, 0x7a, 0x03, 0x21, 0x0b, 0x69, 0x9d, 0x50, 0xcd,
-	0x31, 0x1b, 0xcc, 0xb4, 0x2d, 0xaf, 0xe3, 0x6a, 0xef, 0x94, 0x20, 0x40, 0xe2, 0x36, 0x69, 0x51,
-	0x31, 0x91, 0x8d, 0xe7, 0x52, 0x2a, 0xff, 0x2d, 0xbc, 0x06, 0x67, 0xbd, 0x66, 0x50, 0x31, 0x99,
-	0x45, 0xb9, 0x74, 0x71, 0x3a, 0xdf, 0x77, 0x62, 0xe4, 0x3d, 0xb6, 0xe5, 0xc4, 0x5e, 0x3b, 0x13,
-	0x53, 0x3b, 0xe8, 0x92, 0xe4, 0x5a, 0xf2, 0x8d, 0x67, 0x0b, 0x72, 0x6d, 0xe9, 0x12, 0x54, 0x14,
-	0xc8, 0x46, 0x89, 0x0e, 0x9c, 0xf9, 0x15, 0x81, 0xb0, 0x49, 0x8d, 0x67, 0xed, 0x49, 0x09, 0x12,
-	0x8d, 0x1a, 0xb6, 0xf8, 0x5e, 0x4f, 0x17, 0xb3, 0xe1, 0xe2, 0x7d, 0x86, 0x35, 0x6c, 0xf9, 0x2e,
-	0xf0, 0x9c, 0xa1, 0x16, 0xcc, 0x81, 0x34, 0xa8, 0x2e, 0x10, 0xff, 0x47, 0x12, 0x26, 0xbc, 0xf0,
-	0x9a, 0xae, 0x6f, 0x10, 0xcb, 0x7e, 0x06, 0xca, 0xa7, 0x60, 0xac, 0x4a, 0x4c, 0xa3, 0xca, 0xb8,
-	0xf6, 0xb8, 0xea, 0x8f, 0x84, 0xeb, 0x00, 0xba, 0xcb, 0xa8, 0x52, 0x33, 0x29, 0x13, 0x93, 0xd9,
-	0x78, 0x2e, 0x5d, 0x9c, 0x1a, 0xf0, 0x85, 0x93, 0x2e, 0x3f, 0xef, 0xba, 0x71, 0xef, 0x71, 0x26,
-	0xc5, 0x87, 0x37, 0x4c, 0xca, 0xd4, 0x94, 0xde, 0xf9, 0x29, 0xcc, 0x41, 0xaa, 0x8e, 0x2d, 0xfe,
-	0x60, 0xb5, 0xc4, 0xb1, 0x2c, 0xca, 0x8d, 0xab, 0xdd, 0x09, 0xe1, 0x43, 0x48, 0x3b, 0xe4, 0x13,
-	0xec, 0xe8, 0x95, 0x6d, 0x6c, 0xe9, 0xe2, 0x59, 0xae, 0xf8, 0xf5, 0xbd, 0x76, 0x06, 0xfd, 0xd2,
-	0xce, 0xcc, 0x7a, 0xaa, 0xa9, 0x7e, 0x3b, 0x6f, 0xda, 0x85, 0x3a, 0x66, 0xd5, 0xbc, 0xb7, 0x9d,
-	0x36, 0x88, 0xd6, 0x3d, 0xfe, 0xdc, 0x43, 0xb7, 0xc2, 0xaa, 0x0e, 0xa1, 0x55, 0xbb, 0xa6, 0x2b,
-	0x2a, 0x78, 0x05, 0xcb, 0xd8, 0xd2, 0x85, 0xef, 0x10, 0x4c, 0x6b, 0x3b, 0x8e, 0x43, 0x2c, 0xad,
-	0x55, 0x69, 0x60, 0xd3, 0x71, 0x5f, 0x5d, 0x4d, 0x53, 0x27, 0x0e, 0x15, 0xc7, 0xb9, 0xa8, 0x4b,
-	0x03, 0xa2, 0xd6, 0x7d, 0xfc, 0x16, 0x36, 0x9d, 0xad, 0x0e, 0xba, 0xbc, 0xee, 0x8a, 0x3c, 0x6c,
-	0x67, 0x64, 0x6f, 0xd1, 0x88, 0xa2, 0xca, 0xbd, 0xc7, 0x99, 0x99, 0xd0, 0x02, 0xdc, 0x96, 0x49,
-	0x2d, 0x2c, 0x24, 0xfc, 0x80, 0xe0, 0x42, 0x50, 0x50, 0x27, 0x4d, 0x13, 0xbb, 0xbd, 0xe9, 0x6a,
-	0xa2, 0x62, 0x8a, 0x73, 0x5d, 0x8e, 0xe4, 0xba, 0xd1, 0x49, 0x7a, 0xbf, 0x93, 0x53, 0x7e, 0xd7,
-	0x27, 0xbc, 0xd0, 0x47, 0x38, 0xac, 0xbe, 0x4b, 0x5b, 0x8e, 0xae, 0xc5, 0xb9, 0xcf, 0x6a, 0x91,
-	0xf1, 0xe1, 0xe7, 0xc0, 0x0c, 0x4c, 0xf7, 0xed, 0xf2, 0xe0, 0x09, 0xf8, 0xeb, 0x0c, 0x5c, 0xf4,
-	0x62, 0x2a, 0xa9, 0xdb, 0x4d, 0x12, 0xea, 0xdc, 0xa9, 0x79, 0x26, 0x86, 0x6d, 0xa6, 0xe4, 0x69,
-	0xd9, 0x4c, 0x43, 0x5b, 0xb1, 0x0c, 0x8b, 0xc7, 0xda, 0xdd, 0x6d, 0x0e, 0xe2, 0x77, 0x9d, 0x01,
-	0x74, 0x48, 0xff, 0x4f, 0x4d, 0x8b, 0x64, 0x00, 0xdf, 0x15, 0x93, 0x78, 0x4d, 0x49, 0xa9, 0x3d,
-	0x33, 0x43, 0xcd, 0x59, 0x85, 0xc2, 0x88, 0x72, 0x03, 0x8b, 0x7e, 0x44, 0x30, 0xeb, 0xe5, 0xac,
-	0x63, 0x4b, 0x23, 0xb5, 0x9e, 0x63, 0xde, 0x7d, 0x4f, 0x9c, 0x16, 0x5b, 0x86, 0xca, 0x7e, 0x01,
-	0xe6, 0x87, 0x48, 0xe8, 0x48, 0x2d, 0xfe, 0x33, 0x0e, 0xf1, 0x4d, 0x6a, 0x08, 0x5f, 0x21, 0x98,
-	0x1b, 0x7a, 0x9d, 0xbf, 0x32, 0xf0, 0x0c, 0x1c, 0x73, 0x61, 0x96, 0xae, 0x9e, 0x34, 0xa3, 0x43,
-	0x4a, 0xf8, 0x1c, 0xc1, 0x4c, 0xf4, 0xfd, 0x7a, 0x65, 0xe4, 0xba, 0x2e, 0x5c, 0x7a, 0xe5, 0x44,
-	0xf0, 0x80, 0x83, 0x0d, 0xe7, 0xc2, 0xae, 0xaa, 0x97, 0xc3, 0xaa, 0x85, 0x00, 0xa5, 0xc2, 0x88,
-	0xc0, 0x60, 0xc1, 0x1d, 0x98, 0x0c, 0xbf, 0x49, 0x2e, 0x86, 0x55, 0x0a, 0x85, 0x4a, 0xab, 0x23,
-	0x43, 0x83, 0x65, 0x35, 0x98, 0xe8, 0x5f, 0x70, 0x3e, 0xac, 0x4a, 0xff, 0x52, 0xcb, 0x23, 0x80,
-	0x82, 0x45, 0x6e, 0xc1, 0xff, 0x8e, 0x5c, 0x87, 0xb2, 0x11, 0xc9, 0x01, 0x42, 0xca, 0x1d, 0x87,
-	0x08, 0x6a, 0xdf, 0x41, 0x20, 0x1f, 0xf3, 0xa6, 0x29, 0x46, 0x14, 0x1b, 0x92, 0x23, 0x95, 0x4e,
-	0x9e, 0x13, 0x50, 0xfa, 0x16, 0xc1, 0xc2, 0x48, 0xe7, 0xeb, 0xd5, 0x91, 0x16, 0x09, 0xc9, 0x94,
-	0xae, 0x3d, 0x6d, 0x66, 0x40, 0xf2, 0x53, 0x10, 0x23, 0x0f, 0xb8, 0x17, 0x23, 0xaa, 0x87, 0xa2,
-	0xa5, 0x97, 0x4f, 0x82, 0xee, 0xac, 0x2f, 0x25, 0x3f, 0x7b, 0x72, 0x7f, 0x09, 0x95, 0xaf, 0xef,
-	0xfd, 0x2e, 0xc7, 0xf6, 0xf6, 0x65, 0xf4, 0x70, 0x5f, 0x46, 0xbf, 0xed, 0xcb, 0xe8, 0xce, 0x81,
-	0x1c, 0x7b, 0x78, 0x20, 0xc7, 0x7e, 0x3e, 0x90, 0x63, 0xb7, 0x96, 0x0c, 0x93, 0x55, 0x77, 0xb6,
-	0xf3, 0x9a, 0x5d, 0x2f, 0x78, 0xdf, 0x36, 0xf8, 0xdf, 0x15, 0xcb, 0xd6, 0x49, 0x61, 0xb7, 0xf3,
-	0xa1, 0x83, 0xb5, 0x1a, 0x84, 0x6e, 0x8f, 0xf1, 0x6f, 0x13, 0x2f, 0xfd, 0x1b, 0x00, 0x00, 0xff,
-	0xff, 0xa4, 0x4d, 0xc9, 0xae, 0x30, 0x11, 0x00, 0x00,
+	// 1187 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4d, 0x6c, 0x1b, 0x45,
+	0x14, 0xf6, 0xc4, 0x76, 0x1a, 0x3f, 0x03, 0x11, 0xdb, 0xfc, 0x6c, 0x36, 0xe9, 0xda, 0xdd, 0x84,
+	0xc6, 0x49, 0x88, 0xdd, 0x98, 0xbf, 0xca, 0x02, 0xd1, 0x38, 0x01, 0x7a, 0x68, 0x50, 0xb4, 0x40,
+	0x0f, 0x95, 0x90, 0x35, 0xd9, 0x1d, 0xd6, 0xab, 0xda, 0xbb, 0x66, 0x67, 0x62, 0xe2, 0x0b, 0x02,
+	0x4e, 0x08, 0x09, 0xa9, 0x47, 0x8e, 0x15, 0xdc, 0x2a, 0x21, 0xf5, 0xd0, 0x3b, 0xe2, 0x96, 0x13,
+	0xaa, 0x7a, 0x42, 0x08, 0xb9, 0x90, 0x20, 0x95, 0x0b, 0x97, 0x70, 0x47, 0x68, 0x67, 0xd7, 0x6b,
+	0xc7, 0x59, 0xff, 0xa4, 0xaa, 0xd4, 0x5c, 0x12, 0xcf, 0xbc, 0xef, 0xbd, 0xf9, 0xbe, 0xef, 0xcd,
+	0xce, 0x8e, 0x16, 0x44, 0xad, 0x4c, 0x3e, 0xd5, 0x73, 0xb6, 0x83, 0xb5, 0x0a, 0xc9, 0xd5, 0xf3,
+	0x39, 0xb6, 0x97, 0xad, 0x39, 0x36, 0xb3, 0x85, 0x71, 0x1e, 0xc9, 0x7a, 0x91, 0x6c, 0x3d, 0x2f,
+	0xcd, 0x75, 0x43, 0xfd, 0x10, 0x87, 0x4b, 0xd3, 0x9a, 0x4d, 0xab, 0x36, 0xcd, 0x55, 0xa9, 0x91,
+	0xab, 0xaf, 0xb9, 0xff, 0xfc, 0xc0, 0x8c, 0x17, 0x28, 0xf1, 0x51, 0xce, 0x1b, 0xf8, 0xa1, 0x09,
+	0xc3, 0x36, 0x6c, 0x6f, 0xde, 0xfd, 0xe5, 0xcd, 0x2a, 0xbf, 0x20, 0x48, 0x6d, 0x51, 0x63, 0xdd,
+	0x30, 0x1c, 0x62, 0x60, 0x46, 0xde, 0xd9, 0xd3, 0xca, 0xd8, 0x32, 0x88, 0x8a, 0x19, 0xd9, 0x76,
+	0x48, 0xdd, 0x66, 0x44, 0x98, 0x87, 0x58, 0x19, 0xd3, 0xb2, 0x88, 0xd2, 0x28, 0x93, 0x28, 0x8e,
+	0x1f, 0x35, 0x53, 0xc9, 0x06, 0xae, 0x56, 0x0a, 0x8a, 0x3b, 0xab, 0xa8, 0x3c, 0x28, 0x5c, 0x86,
+	0xd1, 0x4f, 0x08, 0xd1, 0x89, 0x23, 0x8e, 0x70, 0x98, 0xf8, 0xf0, 0xfe, 0xea, 0x84, 0x4f, 0x60,
+	0x5d, 0xd7, 0x1d, 0x42, 0xe9, 0x07, 0xcc, 0x31, 0x2d, 0x43, 0xf5, 0x71, 0xc2, 0xdb, 0x90, 0xa8,
+	0xe3, 0x8a, 0xa9, 0x63, 0x66, 0x3b, 0x62, 0x94, 0x27, 0x5d, 0x7c, 0x78, 0x7f, 0xf5, 0x82, 0x9f,
+	0x74, 0xa3, 0x15, 0x3b, 0x9e, 0xdd, 0xce, 0x29, 0x9c, 0xff, 0xfa, 0x4e, 0x2a, 0xf2, 0xf7, 0x9d,
+	0x54, 0xe4, 0xab, 0xc7, 0xf7, 0x96, 0xfd, 0xaa, 0xca, 0x12, 0x2c, 0x0e, 0xd0, 0xa3, 0x12, 0x5a,
+	0xb3, 0x2d, 0x4a, 0x94, 0x6f, 0x47, 0x60, 0xae, 0x17, 0xf6, 0x86, 0x2f, 0x9c, 0xe2, 0x0a, 0x3b,
+	0x29, 0xdc, 0x9d, 0x55, 0x54, 0x1e, 0x14, 0xae, 0xc2, 0x0b, 0xc4, 0x4f, 0x2c, 0x39, 0x98, 0x11,
+	0xea, 0x1b, 0x30, 0x73, 0xd4, 0x4c, 0x4d, 0x7a, 0xf0, 0xe3, 0x71, 0x45, 0x7d, 0x9e, 0x74, 0xac,
+	0x44, 0x3b, 0xac, 0x8b, 0x3e, 0x89, 0x75, 0xb1, 0xa7, 0x65, 0xdd, 0x25, 0x58, 0xe8, 0x67, 0x47,
+	0xe0, 0xdb, 0x8f, 0x08, 0xa6, 0xb6, 0xa8, 0xb1, 0x49, 0x2a, 0x1c, 0xf7, 0x2e, 0x21, 0xfa, 0x86,
+	0x1b, 0xb0, 0x98, 0xf0, 0x16, 0x8c, 0xd9, 0x35, 0xe2, 0x70, 0x5e, 0x68, 0x58, 0x5e, 0x41, 0x8a,
+	0x9b, 0xae, 0xfb, 0x55, 0xc5, 0x91, 0xa1, 0xd3, 0x5b, 0x29, 0x85, 0xc9, 0x4e, 0x55, 0x41, 0x55,
+	0x25, 0x0d, 0x72, 0x38, 0xdd, 0x40, 0xd1, 0xbf, 0x08, 0xc4, 0x2d, 0x6a, 0x5c, 0x27, 0x06, 0xd6,
+	0x1a, 0xef, 0xd9, 0xf5, 0x8f, 0x6a, 0xba, 0xbb, 0x5f, 0xb0, 0x83, 0xab, 0x54, 0x78, 0x1d, 0x12,
+	0x78, 0x97, 0x95, 0x6d, 0xc7, 0x64, 0x0d, 0x11, 0x0d, 0xe8, 0x50, 0x1b, 0x2a, 0x4c, 0x40, 0x9c,
+	0x99, 0xac, 0xe2, 0x2b, 0x51, 0xbd, 0x81, 0x90, 0x86, 0xa4, 0x4e, 0xa8, 0xe6, 0x98, 0x35, 0x66,
+	0xda, 0x96, 0xd7, 0x71, 0xb5, 0x73, 0x4a, 0x10, 0x20, 0x76, 0x8b, 0x34, 0xa8, 0x18, 0x4b, 0x47,
+	0x33, 0x09, 0x95, 0xff, 0x16, 0xde, 0x80, 0x73, 0x5e, 0x33, 0xa8, 0x18, 0x4f, 0xa3, 0x4c, 0x32,
+	0x3f, 0x9d, 0xed, 0x3a, 0x31, 0xb2, 0x1e, 0xdb, 0x62, 0x6c, 0xbf, 0x99, 0x8a, 0xa8, 0x2d, 0x74,
+	0x41, 0x72, 0x2d, 0xf9, 0xce, 0xb3, 0x05, 0xb9, 0xb6, 0xb4, 0x09, 0x2a, 0x0a, 0xa4, 0x7b, 0x89,
+	0x0e, 0x9c, 0xf9, 0x1d, 0x81, 0xb0, 0x45, 0x8d, 0x67, 0xed, 0x49, 0x01, 0x62, 0xb5, 0x0a, 0xb6,
+	0xf8, 0x5e, 0x4f, 0xe6, 0xd3, 0xe1, 0xe2, 0x7d, 0x86, 0x15, 0x6c, 0xf9, 0x2e, 0xf0, 0x9c, 0xbe,
+	0x16, 0xcc, 0x81, 0x74, 0x52, 0x5d, 0x20, 0xfe, 0xaf, 0x38, 0x8c, 0x7b, 0xe1, 0x75, 0x5d, 0xdf,
+	0x24, 0x96, 0xfd, 0x0c, 0x94, 0x4f, 0xc1, 0x68, 0x99, 0x98, 0x46, 0x99, 0x71, 0xed, 0x51, 0xd5,
+	0x1f, 0x09, 0xd7, 0x00, 0x74, 0x97, 0x51, 0xa9, 0x62, 0x52, 0x26, 0xc6, 0xd3, 0xd1, 0x4c, 0x32,
+	0x3f, 0x75, 0xc2, 0x17, 0x4e, 0xba, 0xf8, 0xa2, 0xeb, 0xc6, 0xdd, 0x47, 0xa9, 0x04, 0x1f, 0x5e,
+	0x37, 0x29, 0x53, 0x13, 0x7a, 0xeb, 0xa7, 0x30, 0x07, 0x89, 0x2a, 0xb6, 0xf8, 0x83, 0xd5, 0x10,
+	0x47, 0xd3, 0x28, 0x33, 0xa6, 0xb6, 0x27, 0x84, 0x8f, 0x21, 0xe9, 0x90, 0xcf, 0xb0, 0xa3, 0x97,
+	0x76, 0xb0, 0xa5, 0x8b, 0xe7, 0xb8, 0xe2, 0x37, 0xf7, 0x9b, 0x29, 0xf4, 0x5b, 0x33, 0x35, 0xeb,
+	0xa9, 0xa6, 0xfa, 0xad, 0xac, 0x69, 0xe7, 0xaa, 0x98, 0x95, 0xb3, 0xde, 0x76, 0xda, 0x24, 0x5a,
+	0xfb, 0xf8, 0x73, 0x0f, 0xdd, 0x12, 0x2b, 0x3b, 0x84, 0x96, 0xed, 0x8a, 0xae, 0xa8, 0xe0, 0x15,
+	0x2c, 0x62, 0x4b, 0x17, 0x7e, 0x40, 0x30, 0xad, 0xed, 0x3a, 0x0e, 0xb1, 0xb4, 0x46, 0xa9, 0x86,
+	0x4d, 0xc7, 0x7d, 0x75, 0xd5, 0x4d, 0x9d, 0x38, 0x54, 0x1c, 0xe3, 0xa2, 0x2e, 0x9d, 0x10, 0xb5,
+	0xe1, 0xe3, 0xb7, 0xb1, 0xe9, 0x6c, 0xb7, 0xd0, 0xc5, 0x0d, 0x57, 0xe4, 0x51, 0x33, 0x25, 0x7b,
+	0x8b, 0xf6, 0x28, 0xaa, 0xdc, 0x7d, 0x94, 0x9a, 0x09, 0x2d, 0xc0, 0x6d, 0x99, 0xd4, 0xc2, 0x42,
+	0xc2, 0x4f, 0x08, 0x2e, 0x04, 0x05, 0x75, 0x52, 0x37, 0xb1, 0xdb, 0x9b, 0xb6, 0x26, 0x2a, 0x26,
+	0x38, 0xd7, 0x95, 0x9e, 0x5c, 0x37, 0x5b, 0x49, 0x1f, 0xb6, 0x72, 0x8a, 0xef, 0xfb, 0x84, 0x17,
+	0xba, 0x08, 0x87, 0xd5, 0x77, 0x69, 0xcb, 0xbd, 0x6b, 0x71, 0xee, 0xb3, 0x5a, 0xcf, 0x78, 0xff,
+	0x73, 0x60, 0x06, 0xa6, 0xbb, 0x76, 0x79, 0xf0, 0x04, 0xfc, 0x33, 0x02, 0x17, 0xbd, 0x98, 0x4a,
+	0xaa, 0x76, 0x9d, 0x84, 0x3a, 0x77, 0x66, 0x9e, 0x89, 0x7e, 0x9b, 0x29, 0x7e, 0x56, 0x36, 0x53,
+	0xdf, 0x56, 0xac, 0xc0, 0xd2, 0x40, 0xbb, 0xdb, 0xcd, 0x41, 0xb0, 0x18, 0x86, 0x0e, 0xe9, 0xff,
+	0x99, 0x69, 0x91, 0x0c, 0xe0, 0xbb, 0x62, 0x12, 0xaf, 0x29, 0x09, 0xb5, 0x63, 0xa6, 0xaf, 0x39,
+	0x6b, 0x90, 0x1b, 0x52, 0x6e, 0x60, 0xd1, 0xcf, 0x08, 0x66, 0xbd, 0x9c, 0x0d, 0x6c, 0x69, 0xa4,
+	0xd2, 0x71, 0xcc, 0xbb, 0xef, 0x89, 0xb3, 0x62, 0x4b, 0x5f, 0xd9, 0x2f, 0xc1, 0x7c, 0x1f, 0x09,
+	0x2d, 0xa9, 0xf9, 0xff, 0xc6, 0x20, 0xba, 0x45, 0x0d, 0xe1, 0x1b, 0x04, 0x73, 0x7d, 0xaf, 0xf3,
+	0x97, 0x4f, 0x3c, 0x03, 0x03, 0x2e, 0xcc, 0xd2, 0x95, 0xd3, 0x66, 0xb4, 0x48, 0x09, 0x5f, 0x22,
+	0x98, 0xe9, 0x7d, 0xbf, 0x5e, 0x1d, 0xba, 0xae, 0x0b, 0x97, 0x5e, 0x3b, 0x15, 0x3c, 0xe0, 0x60,
+	0xc3, 0xf9, 0xb0, 0xab, 0xea, 0x62, 0x58, 0xb5, 0x10, 0xa0, 0x94, 0x1b, 0x12, 0x18, 0x2c, 0xb8,
+	0x0b, 0x93, 0xe1, 0x37, 0xc9, 0xa5, 0xb0, 0x4a, 0xa1, 0x50, 0x69, 0x6d, 0x68, 0x68, 0xb0, 0xac,
+	0x06, 0xe3, 0xdd, 0x0b, 0xce, 0x87, 0x55, 0xe9, 0x5e, 0x6a, 0x65, 0x08, 0x50, 0xb0, 0xc8, 0x4d,
+	0x78, 0xee, 0xd8, 0x75, 0x28, 0xdd, 0x23, 0x39, 0x40, 0x48, 0x99, 0x41, 0x88, 0xa0, 0xf6, 0x6d,
+	0x04, 0xf2, 0x80, 0x37, 0x4d, 0xbe, 0x47, 0xb1, 0x3e, 0x39, 0x52, 0xe1, 0xf4, 0x39, 0x01, 0xa5,
+	0xef, 0x11, 0x2c, 0x0c, 0x75, 0xbe, 0x5e, 0x19, 0x6a, 0x91, 0x90, 0x4c, 0xe9, 0xea, 0x93, 0x66,
+	0x06, 0x24, 0x3f, 0x07, 0xb1, 0xe7, 0x01, 0xf7, 0x72, 0x8f, 0xea, 0xa1, 0x68, 0xe9, 0xd5, 0xd3,
+	0xa0, 0x5b, 0xeb, 0x4b, 0xf1, 0x2f, 0x1e, 0xdf, 0x5b, 0x46, 0xc5, 0x6b, 0xfb, 0x7f, 0xca, 0x91,
+	0xfd, 0x03, 0x19, 0x3d, 0x38, 0x90, 0xd1, 0x1f, 0x07, 0x32, 0xba, 0x7d, 0x28, 0x47, 0x1e, 0x1c,
+	0xca, 0x91, 0x5f, 0x0f, 0xe5, 0xc8, 0xcd, 0x65, 0xc3, 0x64, 0xe5, 0xdd, 0x9d, 0xac, 0x66, 0x57,
+	0x73, 0xde, 0xb7, 0x0d, 0xfe, 0x77, 0xd5, 0xb2, 0x75, 0x92, 0xdb, 0x6b, 0x7d, 0xe8, 0x60, 0x8d,
+	0x1a, 0xa1, 0x3b, 0xa3, 0xfc, 0xdb, 0xc4, 0x2b, 0xff, 0x0f, 0x00, 0xa4, 0x4d, 0xc9, 0xae, 0x30,
+	0x11, 0x00, 0x00,
 }
 
 func (this *MsgLegacyGovUpdateParams) Equal(that interface{}) bool {
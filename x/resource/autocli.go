@@ -44,6 +44,50 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "id"},
 					},
 				},
+				{
+					RpcMethod: "ResourceVersions",
+					Use:       "resource-versions [collection-id] [resource-name] [resource-type]",
+					Short:     "Query the version chain of a resource",
+					Example:   fmt.Sprintf("%s query resource resource-versions c82f2b02-bdab-4dd7-b833-3e143745d612 PassportSchema AnonCredsSchema", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "collection_id"},
+						{ProtoField: "name"},
+						{ProtoField: "resource_type"},
+					},
+				},
+				{
+					RpcMethod: "ResourceAtTime",
+					Use:       "resource-at-time [collection-id] [resource-name] [resource-type] [time]",
+					Short:     "Query the version of a resource that was the latest at a given time",
+					Example:   fmt.Sprintf("%s query resource resource-at-time c82f2b02-bdab-4dd7-b833-3e143745d612 PassportSchema AnonCredsSchema 2021-01-01T00:00:00Z", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "collection_id"},
+						{ProtoField: "name"},
+						{ProtoField: "resource_type"},
+						{ProtoField: "time"},
+					},
+				},
+				{
+					RpcMethod: "AllResources",
+					Use:       "all-resources",
+					Short:     "Query metadata of all resources across collections",
+					Example:   fmt.Sprintf("%s query resource all-resources --media-type application/json --created-after 2021-01-01T00:00:00Z", version.AppName),
+				},
+				{
+					RpcMethod: "Stats",
+					Use:       "stats",
+					Short:     "Query the number of resources by media type and the total size of stored resource data",
+				},
+				{
+					RpcMethod: "EstimateResourceFee",
+					Use:       "estimate-fee [media-type] [size]",
+					Short:     "Estimate the fee for creating a resource of a given media type and size in bytes",
+					Example:   fmt.Sprintf("%s query resource estimate-fee image/png 204800", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "media_type"},
+						{ProtoField: "size"},
+					},
+				},
 				{
 					RpcMethod: "Params",
 					Use:       "params",
@@ -64,6 +108,30 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "CreateResource",
 					Skip:      true,
 				},
+				{
+					RpcMethod: "UpdateResourceMetadata",
+					Skip:      true, // skipped as we have the custom command
+				},
+				{
+					RpcMethod: "DeprecateResource",
+					Skip:      true, // skipped as we have the custom command
+				},
+				{
+					RpcMethod: "BatchCreateResources",
+					Skip:      true, // skipped as we have the custom command
+				},
+				{
+					RpcMethod: "BeginResourceUpload",
+					Skip:      true, // skipped as we have the custom command
+				},
+				{
+					RpcMethod: "AppendResourceChunk",
+					Skip:      true, // skipped as we have the custom command
+				},
+				{
+					RpcMethod: "FinalizeResourceUpload",
+					Skip:      true, // skipped as we have the custom command
+				},
 			},
 		},
 	}
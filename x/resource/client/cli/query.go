@@ -21,7 +21,9 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdGetResource(),
 		CmdGetResourceMetadata(),
-		CmdGetCollectionResources())
+		CmdGetCollectionResources(),
+		CmdGetResourceVersions(),
+		CmdGetResourceAtTime())
 
 	return cmd
 }
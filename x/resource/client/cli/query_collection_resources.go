@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"time"
 
 	"github.com/cheqd/cheqd-node/x/resource/types"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -9,6 +10,14 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	FlagResourceName  = "resource-name"
+	FlagResourceType  = "resource-type"
+	FlagMediaType     = "media-type"
+	FlagCreatedAfter  = "created-after"
+	FlagCreatedBefore = "created-before"
+)
+
 func CmdGetCollectionResources() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "collection-metadata [collection-id]",
@@ -16,7 +25,10 @@ func CmdGetCollectionResources() *cobra.Command {
 		Long: `Query metadata for an entire Collection by Collection ID. This will return the metadata for all Resources in the Collection.
 		
 		Collection ID is the UNIQUE IDENTIFIER part of the DID the resource is linked to.
-		Example: c82f2b02-bdab-4dd7-b833-3e143745d612, wGHEXrZvJxR8vw5P3UWH1j, etc.`,
+		Example: c82f2b02-bdab-4dd7-b833-3e143745d612, wGHEXrZvJxR8vw5P3UWH1j, etc.
+
+		Results are paginated and can be filtered by resource name, resource type, media type and creation time (RFC3339).
+		Example: --resource-type StatusList2021 --created-after 2021-01-01T00:00:00Z --limit 50`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
@@ -25,8 +37,44 @@ func CmdGetCollectionResources() *cobra.Command {
 
 			collectionID := args[0]
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			resourceName, err := cmd.Flags().GetString(FlagResourceName)
+			if err != nil {
+				return err
+			}
+
+			resourceType, err := cmd.Flags().GetString(FlagResourceType)
+			if err != nil {
+				return err
+			}
+
+			mediaType, err := cmd.Flags().GetString(FlagMediaType)
+			if err != nil {
+				return err
+			}
+
+			createdAfter, err := readTimeFlag(cmd, FlagCreatedAfter)
+			if err != nil {
+				return err
+			}
+
+			createdBefore, err := readTimeFlag(cmd, FlagCreatedBefore)
+			if err != nil {
+				return err
+			}
+
 			params := &types.QueryCollectionResourcesRequest{
-				CollectionId: collectionID,
+				CollectionId:  collectionID,
+				Pagination:    pageReq,
+				ResourceName:  resourceName,
+				ResourceType:  resourceType,
+				MediaType:     mediaType,
+				CreatedAfter:  createdAfter,
+				CreatedBefore: createdBefore,
 			}
 
 			resp, err := queryClient.CollectionResources(context.Background(), params)
@@ -38,7 +86,29 @@ func CmdGetCollectionResources() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagResourceName, "", "Filter resources by name")
+	cmd.Flags().String(FlagResourceType, "", "Filter resources by resource type")
+	cmd.Flags().String(FlagMediaType, "", "Filter resources by media type")
+	cmd.Flags().String(FlagCreatedAfter, "", "Filter resources created at or after the given time (RFC3339)")
+	cmd.Flags().String(FlagCreatedBefore, "", "Filter resources created before the given time (RFC3339)")
+
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "collection-metadata")
 
 	return cmd
 }
+
+// readTimeFlag parses an optional RFC3339 time flag
+func readTimeFlag(cmd *cobra.Command, flag string) (*time.Time, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil || value == "" {
+		return nil, err
+	}
+
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &parsed, nil
+}
@@ -9,6 +9,11 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	FlagOffset = "offset"
+	FlagLength = "length"
+)
+
 func CmdGetResource() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "specific-resource [collection-id] [resource-id]",
@@ -19,7 +24,9 @@ func CmdGetResource() *cobra.Command {
 		Example: c82f2b02-bdab-4dd7-b833-3e143745d612, wGHEXrZvJxR8vw5P3UWH1j, etc.
 
 		Resource ID is the UUID of the specific resource.
-		Example: 6e8bc430-9c3a-11d9-9669-0800200c9a66, 6e8bc430-9c3a-11d9-9669-0800200c9a67, etc.`,
+		Example: 6e8bc430-9c3a-11d9-9669-0800200c9a66, 6e8bc430-9c3a-11d9-9669-0800200c9a67, etc.
+
+		Large resources can be fetched in parts using the --offset and --length flags.`,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
@@ -29,9 +36,21 @@ func CmdGetResource() *cobra.Command {
 			collectionID := args[0]
 			id := args[1]
 
+			offset, err := cmd.Flags().GetUint64(FlagOffset)
+			if err != nil {
+				return err
+			}
+
+			length, err := cmd.Flags().GetUint64(FlagLength)
+			if err != nil {
+				return err
+			}
+
 			params := &types.QueryResourceRequest{
 				CollectionId: collectionID,
 				Id:           id,
+				Offset:       offset,
+				Length:       length,
 			}
 
 			resp, err := queryClient.Resource(context.Background(), params)
@@ -43,6 +62,8 @@ func CmdGetResource() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(FlagOffset, 0, "Position of the first byte of the resource data to return")
+	cmd.Flags().Uint64(FlagLength, 0, "Maximum number of bytes of the resource data to return, until the end if not set")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,108 @@
+package cli
+
+import (
+	"context"
+	"time"
+
+	"github.com/cheqd/cheqd-node/x/resource/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+const FlagIncludeData = "include-data"
+
+func CmdGetResourceVersions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resource-versions [collection-id] [resource-name] [resource-type]",
+		Short: "Query the version chain of a resource",
+		Long: `Query all versions of a resource by Collection ID, Resource Name and Resource Type, starting from the latest version.
+
+		Collection ID is the UNIQUE IDENTIFIER part of the DID the resource is linked to.
+		Example: c82f2b02-bdab-4dd7-b833-3e143745d612, wGHEXrZvJxR8vw5P3UWH1j, etc.
+
+		Resource Name and Resource Type are the values defined client-side when the resource was created.
+		Example: PassportSchema AnonCredsSchema`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			includeData, err := cmd.Flags().GetBool(FlagIncludeData)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryResourceVersionsRequest{
+				CollectionId: args[0],
+				Name:         args[1],
+				ResourceType: args[2],
+				IncludeData:  includeData,
+				Pagination:   pageReq,
+			}
+
+			resp, err := queryClient.ResourceVersions(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	cmd.Flags().Bool(FlagIncludeData, false, "Return resource data along with the metadata")
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "resource-versions")
+
+	return cmd
+}
+
+func CmdGetResourceAtTime() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resource-at-time [collection-id] [resource-name] [resource-type] [time]",
+		Short: "Query the version of a resource that was the latest at a given time",
+		Long: `Query the version of a resource by Collection ID, Resource Name and Resource Type that was the latest one at a given time.
+
+		Collection ID is the UNIQUE IDENTIFIER part of the DID the resource is linked to.
+		Example: c82f2b02-bdab-4dd7-b833-3e143745d612, wGHEXrZvJxR8vw5P3UWH1j, etc.
+
+		Time is in RFC3339 format.
+		Example: 2021-01-01T00:00:00Z`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			at, err := time.Parse(time.RFC3339, args[3])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryResourceAtTimeRequest{
+				CollectionId: args[0],
+				Name:         args[1],
+				ResourceType: args[2],
+				Time:         at,
+			}
+
+			resp, err := queryClient.ResourceAtTime(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -51,7 +51,15 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(CmdCreateResource())
+	cmd.AddCommand(
+		CmdCreateResource(),
+		CmdUpdateResourceMetadata(),
+		CmdDeprecateResource(),
+		CmdBatchCreateResources(),
+		CmdBeginResourceUpload(),
+		CmdAppendResourceChunk(),
+		CmdFinalizeResourceUpload(),
+	)
 
 	return cmd
 }
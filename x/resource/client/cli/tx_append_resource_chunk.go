@@ -0,0 +1,114 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	didcli "github.com/cheqd/cheqd-node/x/did/client/cli"
+	"github.com/cheqd/cheqd-node/x/resource/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+const FlagChunkSize = "chunk-size"
+
+func CmdAppendResourceChunk() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "append-chunk [payload-file] [resource-data-file]",
+		Short: "Append a chunk of data to a pending Resource upload.",
+		Long: `Append the next chunk of data to a Resource upload started with 'begin-upload'.
+[payload-file] is JSON encoded MsgAppendResourceChunkPayload alongside with sign inputs.
+[resource-data-file] is a path to the whole Resource data file. The chunk with the index given in the payload is sliced from it using '--chunk-size'.
+
+NOTES:
+1. Chunks must be appended in order, starting from index 0.
+2. The size of a chunk is limited by the 'max_chunk_size' module parameter.
+3. Private key provided in sign inputs is ONLY used locally to generate signature(s) and not sent to the ledger.
+
+Example payload file:
+{
+    "payload": {
+        "collectionId": "<did-unique-identifier>",
+        "id": "<uuid>",
+        "index": "0"
+    },
+    "signInputs": [
+        {
+            "verificationMethodId": "did:cheqd:<namespace>:<unique-identifier>#<key-id>",
+            "privKey": "<private-key-bytes-encoded-to-base64>"
+        }
+    ]
+}
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chunkSize, err := cmd.Flags().GetUint64(FlagChunkSize)
+			if err != nil {
+				return err
+			}
+			if chunkSize == 0 {
+				return fmt.Errorf("--%s must be positive", FlagChunkSize)
+			}
+
+			payloadJSON, signInputs, err := didcli.ReadPayloadWithSignInputsFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Unmarshal payload
+			var payload types.MsgAppendResourceChunkPayload
+			err = clientCtx.Codec.UnmarshalJSON(payloadJSON, &payload)
+			if err != nil {
+				return err
+			}
+
+			// Read data file
+			data, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			// Prepare payload
+			start := payload.Index * chunkSize
+			if start >= uint64(len(data)) {
+				return fmt.Errorf("chunk %d is beyond the end of the resource data", payload.Index)
+			}
+			payload.Data = data[start:min(start+chunkSize, uint64(len(data)))]
+
+			// Build identity message
+			signBytes := payload.GetSignBytes()
+			identitySignatures := didcli.SignWithSignInputs(signBytes, signInputs)
+
+			msg := types.MsgAppendResourceChunk{
+				Payload:    &payload,
+				Signatures: identitySignatures,
+			}
+
+			// Set fee-payer if not set
+			err = didcli.SetFeePayerFromSigner(&clientCtx)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	// add standard tx flags
+	AddTxFlagsToCmd(cmd)
+
+	// add custom / override flags
+	cmd.Flags().Uint64(FlagChunkSize, types.DefaultMaxChunkSize, "Size in bytes of the chunks the Resource data file is split into")
+
+	_ = cmd.MarkFlagRequired(flags.FlagGas)
+	_ = cmd.MarkFlagRequired(flags.FlagGasAdjustment)
+
+	return cmd
+}
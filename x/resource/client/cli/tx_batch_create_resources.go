@@ -0,0 +1,97 @@
+package cli
+
+import (
+	didcli "github.com/cheqd/cheqd-node/x/did/client/cli"
+	"github.com/cheqd/cheqd-node/x/resource/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+func CmdBatchCreateResources() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-create [payload-file]",
+		Short: "Create multiple Resources at once.",
+		Long: `Create multiple Resources within a DID Resource Collection in a single message. Either all of them are created or none.
+[payload-file] is JSON encoded MsgBatchCreateResourcesPayload alongside with sign inputs.
+
+NOTES:
+1. Fee used for the transaction is the sum of the fixed fees for Resource creation of all Resources in the batch, each by its media type.
+2. The number of Resources and their total data size are limited by the 'max_batch_size' and 'max_batch_data_size' module parameters.
+3. Resource data is base64-encoded. Resources are created in the given order.
+4. Private key provided in sign inputs is ONLY used locally to generate signature(s) and not sent to the ledger.
+
+Example payload file:
+{
+    "payload": {
+        "collectionId": "<did-unique-identifier>",
+        "resources": [
+            {
+                "resourceCollectionId": "<did-unique-identifier>",
+                "resourceId": "<uuid>",
+                "resourceName": "<human-readable resource name>",
+                "resourceVersion": "<human-readable version number>",
+                "resourceType": "<resource-type>",
+                "data": "<resource-data-encoded-to-base64>"
+            }
+        ]
+    },
+    "signInputs": [
+        {
+            "verificationMethodId": "did:cheqd:<namespace>:<unique-identifier>#<key-id>",
+            "privKey": "<private-key-bytes-encoded-to-base64>"
+        }
+    ]
+}
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			payloadJSON, signInputs, err := didcli.ReadPayloadWithSignInputsFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Unmarshal payload
+			var payload types.MsgBatchCreateResourcesPayload
+			err = clientCtx.Codec.UnmarshalJSON(payloadJSON, &payload)
+			if err != nil {
+				return err
+			}
+
+			// Build identity message
+			signBytes := payload.GetSignBytes()
+			identitySignatures := didcli.SignWithSignInputs(signBytes, signInputs)
+
+			msg := types.MsgBatchCreateResources{
+				Payload:    &payload,
+				Signatures: identitySignatures,
+			}
+
+			// Set fee-payer if not set
+			err = didcli.SetFeePayerFromSigner(&clientCtx)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	// add standard tx flags
+	AddTxFlagsToCmd(cmd)
+
+	// add custom / override flags
+	cmd.Flags().String(flags.FlagFees, "", "Sum of the fixed fees for Resource creation of all Resources in the batch, e.g., 10000000000ncheq. Please check what the current fees by running 'cheqd-noded query params subspace resource feeparams'")
+
+	_ = cmd.MarkFlagRequired(flags.FlagFees)
+	_ = cmd.MarkFlagRequired(flags.FlagGas)
+	_ = cmd.MarkFlagRequired(flags.FlagGasAdjustment)
+
+	return cmd
+}
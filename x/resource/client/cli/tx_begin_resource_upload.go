@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+
+	didcli "github.com/cheqd/cheqd-node/x/did/client/cli"
+	"github.com/cheqd/cheqd-node/x/resource/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+func CmdBeginResourceUpload() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "begin-upload [payload-file] [resource-data-file]",
+		Short: "Start a chunked upload of a large Resource.",
+		Long: `Start a chunked upload of a Resource within a DID Resource Collection.
+[payload-file] is JSON encoded MsgBeginResourceUploadPayload alongside with sign inputs.
+[resource-data-file] is a path to the Resource data file. It is only used to compute the size and the checksum of the data.
+
+NOTES:
+1. Fee used for the transaction is the fee per MiB of uploaded data multiplied by the number of started MiBs of the Resource data file.
+2. The size of the Resource data file is limited by the 'max_upload_size' module parameter.
+3. The data is then uploaded with 'append-chunk' and the Resource is created with 'finalize-upload'. Uploads that are not finalized within the 'upload_expiry' module parameter are pruned.
+4. Private key provided in sign inputs is ONLY used locally to generate signature(s) and not sent to the ledger.
+
+Example payload file:
+{
+    "payload": {
+        "collectionId": "<did-unique-identifier>",
+        "id": "<uuid>",
+        "name": "<human-readable resource name>",
+        "version": "<human-readable version number>",
+        "resourceType": "<resource-type>"
+    },
+    "signInputs": [
+        {
+            "verificationMethodId": "did:cheqd:<namespace>:<unique-identifier>#<key-id>",
+            "privKey": "<private-key-bytes-encoded-to-base64>"
+        }
+    ]
+}
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			payloadJSON, signInputs, err := didcli.ReadPayloadWithSignInputsFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Unmarshal payload
+			var payload types.MsgBeginResourceUploadPayload
+			err = clientCtx.Codec.UnmarshalJSON(payloadJSON, &payload)
+			if err != nil {
+				return err
+			}
+
+			// Read data file
+			data, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			// Prepare payload
+			checksum := sha256.Sum256(data)
+			payload.Size_ = uint64(len(data))
+			payload.Checksum = hex.EncodeToString(checksum[:])
+
+			// Populate resource id if not set
+			if payload.Id == "" {
+				payload.Id = uuid.NewString()
+			}
+
+			// Build identity message
+			signBytes := payload.GetSignBytes()
+			identitySignatures := didcli.SignWithSignInputs(signBytes, signInputs)
+
+			msg := types.MsgBeginResourceUpload{
+				Payload:    &payload,
+				Signatures: identitySignatures,
+			}
+
+			// Set fee-payer if not set
+			err = didcli.SetFeePayerFromSigner(&clientCtx)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	// add standard tx flags
+	AddTxFlagsToCmd(cmd)
+
+	// add custom / override flags
+	cmd.Flags().String(flags.FlagFees, "", "Fee for the upload, e.g., 60000000000ncheq for 10 MiB. Please check what the current fees by running 'cheqd-noded query params subspace resource feeparams'")
+
+	_ = cmd.MarkFlagRequired(flags.FlagFees)
+	_ = cmd.MarkFlagRequired(flags.FlagGas)
+	_ = cmd.MarkFlagRequired(flags.FlagGasAdjustment)
+
+	return cmd
+}
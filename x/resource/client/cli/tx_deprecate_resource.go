@@ -0,0 +1,89 @@
+package cli
+
+import (
+	sdkmath "cosmossdk.io/math"
+	didcli "github.com/cheqd/cheqd-node/x/did/client/cli"
+	"github.com/cheqd/cheqd-node/x/resource/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdDeprecateResource() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deprecate [payload-file]",
+		Short: "Deprecate a Resource.",
+		Long: `Mark a Resource within a DID Resource Collection as deprecated.
+[payload-file] is JSON encoded MsgDeprecateResourcePayload alongside with sign inputs.
+
+NOTES:
+1. Fee used for the transaction will ALWAYS take the fixed fee for Resource deprecation, REGARDLESS of what value is passed in '--fees' flag.
+2. Deprecation is final. A new version of the Resource can still be created.
+3. Private key provided in sign inputs is ONLY used locally to generate signature(s) and not sent to the ledger.
+
+Example payload file:
+{
+    "payload": {
+        "collectionId": "<did-unique-identifier>",
+        "id": "<uuid>"
+    },
+    "signInputs": [
+        {
+            "verificationMethodId": "did:cheqd:<namespace>:<unique-identifier>#<key-id>",
+            "privKey": "<private-key-bytes-encoded-to-base64>"
+        }
+    ]
+}
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			payloadJSON, signInputs, err := didcli.ReadPayloadWithSignInputsFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Unmarshal payload
+			var payload types.MsgDeprecateResourcePayload
+			err = clientCtx.Codec.UnmarshalJSON(payloadJSON, &payload)
+			if err != nil {
+				return err
+			}
+
+			// Build identity message
+			signBytes := payload.GetSignBytes()
+			identitySignatures := didcli.SignWithSignInputs(signBytes, signInputs)
+
+			msg := types.MsgDeprecateResource{
+				Payload:    &payload,
+				Signatures: identitySignatures,
+			}
+
+			// Set fee-payer if not set
+			err = didcli.SetFeePayerFromSigner(&clientCtx)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	// add standard tx flags
+	AddTxFlagsToCmd(cmd)
+
+	// add custom / override flags
+	cmd.Flags().String(flags.FlagFees, sdk.NewCoin(types.BaseMinimalDenom, sdkmath.NewInt(types.DefaultDeprecateResourceFee)).String(), "Fixed fee for Resource deprecation, e.g., 1000000000ncheq. Please check what the current fees by running 'cheqd-noded query params subspace resource feeparams'")
+
+	_ = cmd.MarkFlagRequired(flags.FlagFees)
+	_ = cmd.MarkFlagRequired(flags.FlagGas)
+	_ = cmd.MarkFlagRequired(flags.FlagGasAdjustment)
+
+	return cmd
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/collections"
+
 	"github.com/cheqd/cheqd-node/x/resource/keeper"
 	"github.com/cheqd/cheqd-node/x/resource/types"
 )
@@ -17,6 +19,14 @@ func InitGenesis(ctx context.Context, k keeper.Keeper, genState *types.GenesisSt
 		}
 	}
 
+	// Index the imported version chains by creation time
+	err := k.LatestResourceVersion.Walk(ctx, nil, func(key collections.Triple[string, string, string], latestID string) (bool, error) {
+		return false, k.IndexResourceVersionTimes(ctx, key.K1(), latestID)
+	})
+	if err != nil {
+		panic(fmt.Sprintf("Cannot index resource versions: %s", err.Error()))
+	}
+
 	// set fee params
 	if err := k.SetParams(ctx, *genState.FeeParams); err != nil {
 		panic(err)
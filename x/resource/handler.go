@@ -25,6 +25,30 @@ func NewHandler(k keeper.Keeper, cheqdKeeper didkeeper.Keeper, oracleKeeper orac
 			res, err := msgServer.CreateResource(ctx, msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgUpdateResourceMetadata:
+			res, err := msgServer.UpdateResourceMetadata(ctx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgDeprecateResource:
+			res, err := msgServer.DeprecateResource(ctx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgBatchCreateResources:
+			res, err := msgServer.BatchCreateResources(ctx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgBeginResourceUpload:
+			res, err := msgServer.BeginResourceUpload(ctx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgAppendResourceChunk:
+			res, err := msgServer.AppendResourceChunk(ctx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgFinalizeResourceUpload:
+			res, err := msgServer.FinalizeResourceUpload(ctx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
 			return nil, errorsmod.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/collections"
 	store "cosmossdk.io/core/store"
@@ -23,6 +24,9 @@ type Keeper struct {
 	scopedKeeper exported.ScopedKeeper
 	Schema       collections.Schema
 
+	// feeEstimator estimates resource fees for queries, set after construction as it depends on the ante and post handlers
+	feeEstimator types.FeeEstimator
+
 	Port collections.Item[string]
 	// ResourceCount stores the total number of resources
 	ResourceCount collections.Item[uint64]
@@ -36,6 +40,24 @@ type Keeper struct {
 	// ResourceID of the latest resource version
 	LatestResourceVersion collections.Map[collections.Triple[string, string, string], string]
 
+	// ResourceVersionTimes indexes resource versions by collection ID, name, resource type and creation time
+	ResourceVersionTimes collections.Map[collections.Pair[collections.Triple[string, string, string], time.Time], string]
+
+	// ResourceUploads stores pending chunked uploads by collection ID and resource ID
+	ResourceUploads collections.Map[collections.Pair[string, string], types.ResourceUpload]
+
+	// ResourceUploadExpiries indexes pending uploads by expiry time, collection ID and resource ID
+	ResourceUploadExpiries collections.KeySet[collections.Triple[time.Time, string, string]]
+
+	// ResourceChunks stores the data of chunked resources by collection ID, resource ID and chunk index
+	ResourceChunks collections.Map[collections.Triple[string, string, uint64], []byte]
+
+	// ResourceMediaTypeCounts stores the number of resources by media type
+	ResourceMediaTypeCounts collections.Map[string, uint64]
+
+	// ResourceTotalBytes stores the total size of stored resource data
+	ResourceTotalBytes collections.Item[uint64]
+
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/resource module account.
 	authority string
@@ -91,6 +113,46 @@ func NewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService, paramSp
 			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.StringKey),
 			collections.StringValue,
 		),
+		ResourceVersionTimes: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.ResourceVersionTimeKey),
+			"resource_version_time",
+			collections.PairKeyCodec(collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.StringKey), sdk.TimeKey),
+			collections.StringValue,
+		),
+		ResourceUploads: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.ResourceUploadKey),
+			"resource_upload",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[types.ResourceUpload](cdc),
+		),
+		ResourceUploadExpiries: collections.NewKeySet(
+			sb,
+			collections.NewPrefix(types.ResourceUploadExpiryKey),
+			"resource_upload_expiry",
+			collections.TripleKeyCodec(sdk.TimeKey, collections.StringKey, collections.StringKey),
+		),
+		ResourceChunks: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.ResourceChunkKey),
+			"resource_chunk",
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key),
+			collections.BytesValue,
+		),
+		ResourceMediaTypeCounts: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.ResourceMediaTypeKey),
+			"resource_media_type_count",
+			collections.StringKey,
+			collections.Uint64Value,
+		),
+		ResourceTotalBytes: collections.NewItem(
+			sb,
+			collections.NewPrefix(types.ResourceTotalBytesKey),
+			"resource_total_bytes",
+			collections.Uint64Value,
+		),
 		ParamsStore: collections.NewItem(
 			sb,
 			collections.NewPrefix(types.ParamStoreKeyFeeParams),
@@ -113,6 +175,11 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// SetFeeEstimator sets the estimator used to answer resource fee estimation queries.
+func (k *Keeper) SetFeeEstimator(estimator types.FeeEstimator) {
+	k.feeEstimator = estimator
+}
+
 func (k Keeper) GetAuthority() string {
 	return k.authority
 }
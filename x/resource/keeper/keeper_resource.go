@@ -2,11 +2,14 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
+	didtypes "github.com/cheqd/cheqd-node/x/did/types"
 	"github.com/cheqd/cheqd-node/x/resource/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 // GetResourceCount get the total number of resource
@@ -45,7 +48,30 @@ func (k Keeper) AddNewResourceVersion(ctx context.Context, resource *types.Resou
 		}
 	}
 
-	return k.SetResource(ctx, resource)
+	if err := k.SetResource(ctx, resource); err != nil {
+		return err
+	}
+
+	// The new version is the latest one, so it replaces any version created at the same time in the time index
+	return k.ResourceVersionTimes.Set(ctx, resourceVersionTimeKey(resource.Metadata), resource.Metadata.Id)
+}
+
+// IndexResourceVersionTimes indexes the versions of a resource by their creation time, walking the version chain
+// back from the latest version. If several versions were created at the same time, the latest one is kept.
+func (k Keeper) IndexResourceVersionTimes(ctx context.Context, collectionID, latestID string) error {
+	return k.WalkResourceVersions(ctx, collectionID, latestID, false, func(metadata types.Metadata) (bool, error) {
+		key := resourceVersionTimeKey(&metadata)
+		has, err := k.ResourceVersionTimes.Has(ctx, key)
+		if err != nil || has {
+			return false, err
+		}
+
+		return false, k.ResourceVersionTimes.Set(ctx, key, metadata.Id)
+	})
+}
+
+func resourceVersionTimeKey(metadata *types.Metadata) collections.Pair[collections.Triple[string, string, string], time.Time] {
+	return collections.Join(collections.Join3(metadata.CollectionId, metadata.Name, metadata.ResourceType), metadata.Created)
 }
 
 // SetResource create or update a specific resource in the store
@@ -76,10 +102,106 @@ func (k Keeper) SetResource(ctx context.Context, resource *types.ResourceWithMet
 		return err
 	}
 
+	// Data of resources uploaded in chunks is already stored in chunks
+	if resource.Resource == nil {
+		if hasResource {
+			return nil
+		}
+
+		size, err := k.GetResourceDataSize(ctx, resource.Metadata.CollectionId, resource.Metadata.Id)
+		if err != nil {
+			return err
+		}
+
+		return k.countResourceStats(ctx, resource.Metadata.MediaType, size)
+	}
+
+	if !hasResource {
+		if err := k.countResourceStats(ctx, resource.Metadata.MediaType, uint64(len(resource.Resource.Data))); err != nil {
+			return err
+		}
+	}
+
 	// Set data
 	return k.ResourceData.Set(ctx, collections.Join(resource.Metadata.CollectionId, resource.Metadata.Id), resource.Resource.Data)
 }
 
+// GetResourceData returns the data of a resource, reassembling it from chunks if it was uploaded in chunks
+func (k Keeper) GetResourceData(ctx context.Context, collectionID string, id string) ([]byte, error) {
+	data, err := k.ResourceData.Get(ctx, collections.Join(collectionID, id))
+	if err == nil || !errors.IsOf(err, collections.ErrNotFound) {
+		return data, err
+	}
+
+	found := false
+	err = k.WalkResourceChunks(ctx, collectionID, id, func(_ uint64, chunk []byte) (bool, error) {
+		found = true
+		data = append(data, chunk...)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, sdkerrors.ErrNotFound.Wrap("resource data " + collectionID + ":" + id)
+	}
+
+	return data, nil
+}
+
+// GetResourceDataRange returns at most length bytes of the data of a resource starting from offset.
+// A zero length means until the end of the data. Only the chunks overlapping the range are read.
+func (k Keeper) GetResourceDataRange(ctx context.Context, collectionID string, id string, offset, length uint64) ([]byte, error) {
+	// A range overflowing uint64 is not bounded
+	if offset+length < offset {
+		length = 0
+	}
+
+	data, err := k.ResourceData.Get(ctx, collections.Join(collectionID, id))
+	if err == nil {
+		return sliceRange(data, 0, offset, length), nil
+	}
+	if !errors.IsOf(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	found := false
+	var result []byte
+	var position uint64
+	err = k.WalkResourceChunks(ctx, collectionID, id, func(_ uint64, chunk []byte) (bool, error) {
+		found = true
+		if length != 0 && position >= offset+length {
+			return true, nil
+		}
+		result = append(result, sliceRange(chunk, position, offset, length)...)
+		position += uint64(len(chunk))
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, sdkerrors.ErrNotFound.Wrap("resource data " + collectionID + ":" + id)
+	}
+
+	return result, nil
+}
+
+// sliceRange returns the part of data, starting at position start, that overlaps the range [offset, offset+length).
+// A zero length means an unbounded range.
+func sliceRange(data []byte, start, offset, length uint64) []byte {
+	end := start + uint64(len(data))
+	from, to := max(offset, start), end
+	if length != 0 {
+		to = min(offset+length, end)
+	}
+	if from >= to {
+		return nil
+	}
+
+	return data[from-start : to-start]
+}
+
 // GetResource returns a resource from its id
 func (k Keeper) GetResource(ctx context.Context, collectionID string, id string) (types.ResourceWithMetadata, error) {
 	hasResource, err := k.ResourceMetadata.Has(ctx, collections.Join(collectionID, id))
@@ -97,7 +219,7 @@ func (k Keeper) GetResource(ctx context.Context, collectionID string, id string)
 	}
 
 	// Get data
-	data, err := k.ResourceData.Get(ctx, collections.Join(collectionID, id))
+	data, err := k.GetResourceData(ctx, collectionID, id)
 	if err != nil {
 		return types.ResourceWithMetadata{}, err
 	}
@@ -175,6 +297,177 @@ func (k Keeper) GetLatestResourceVersion(ctx context.Context, collectionID, name
 	return latestResource, nil
 }
 
+// WalkResourceVersions iterates over the version chain of a resource starting from the given version.
+// The chain is walked towards older versions, or towards newer ones if forward is set.
+func (k Keeper) WalkResourceVersions(ctx context.Context, collectionID, startID string, forward bool, callback func(metadata types.Metadata) (stop bool, err error)) error {
+	id := startID
+	for id != "" {
+		metadata, err := k.ResourceMetadata.Get(ctx, collections.Join(collectionID, id))
+		if err != nil {
+			return err
+		}
+
+		stop, err := callback(metadata)
+		if err != nil || stop {
+			return err
+		}
+
+		if forward {
+			id = metadata.NextVersionId
+		} else {
+			id = metadata.PreviousVersionId
+		}
+	}
+
+	return nil
+}
+
+// GetResourceVersions returns a page of the version chain of a resource.
+// Versions are ordered from the latest to the oldest one, or the other way round if the page request is reversed.
+func (k Keeper) GetResourceVersions(ctx context.Context, collectionID, name, resourceType string, includeData bool, pageReq *query.PageRequest) ([]*types.ResourceWithMetadata, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return nil, nil, sdkerrors.ErrInvalidRequest.Wrap("either offset or key is expected, got both")
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	latestID, err := k.LatestResourceVersion.Get(ctx, collections.Join3(collectionID, name, resourceType))
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, nil, sdkerrors.ErrNotFound.Wrap("resource " + collectionID + ":" + name + ":" + resourceType)
+		}
+		return nil, nil, err
+	}
+
+	// Find the version to start from
+	startID := latestID
+	switch {
+	case pageReq.Key != nil:
+		startID = string(pageReq.Key)
+		metadata, err := k.GetResourceMetadata(ctx, collectionID, startID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if metadata.Name != name || metadata.ResourceType != resourceType {
+			return nil, nil, sdkerrors.ErrInvalidRequest.Wrapf("pagination key %s doesn't belong to the resource version chain", startID)
+		}
+	case pageReq.Reverse:
+		startID, err = k.getFirstResourceVersion(ctx, collectionID, name, resourceType)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var resources []*types.ResourceWithMetadata
+	var nextKey []byte
+	var count uint64
+
+	err = k.WalkResourceVersions(ctx, collectionID, startID, pageReq.Reverse, func(metadata types.Metadata) (bool, error) {
+		count++
+		if count <= pageReq.Offset {
+			return false, nil
+		}
+
+		if uint64(len(resources)) == limit {
+			if nextKey == nil {
+				nextKey = []byte(metadata.Id)
+			}
+			return !pageReq.CountTotal, nil
+		}
+
+		resource := types.ResourceWithMetadata{Metadata: &metadata}
+		if includeData {
+			data, err := k.GetResourceData(ctx, collectionID, metadata.Id)
+			if err != nil {
+				return true, err
+			}
+			resource.Resource = &types.Resource{Data: data}
+		}
+
+		resources = append(resources, &resource)
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if pageReq.CountTotal && pageReq.Key == nil {
+		pageRes.Total = count
+	}
+
+	return resources, pageRes, nil
+}
+
+// GetResourceAtTime returns the version of a resource that was the latest one at the given time
+func (k Keeper) GetResourceAtTime(ctx context.Context, collectionID, name, resourceType string, at time.Time) (types.ResourceWithMetadata, error) {
+	has, err := k.LatestResourceVersion.Has(ctx, collections.Join3(collectionID, name, resourceType))
+	if err != nil {
+		return types.ResourceWithMetadata{}, err
+	}
+	if !has {
+		return types.ResourceWithMetadata{}, sdkerrors.ErrNotFound.Wrap("resource " + collectionID + ":" + name + ":" + resourceType)
+	}
+
+	rng := collections.NewPrefixedPairRange[collections.Triple[string, string, string], time.Time](
+		collections.Join3(collectionID, name, resourceType),
+	).EndInclusive(at).Descending()
+
+	iter, err := k.ResourceVersionTimes.Iterate(ctx, rng)
+	if err != nil {
+		return types.ResourceWithMetadata{}, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return types.ResourceWithMetadata{}, sdkerrors.ErrNotFound.Wrapf("resource %s:%s:%s at %s", collectionID, name, resourceType, at.Format(time.RFC3339))
+	}
+
+	id, err := iter.Value()
+	if err != nil {
+		return types.ResourceWithMetadata{}, err
+	}
+
+	return k.GetResource(ctx, collectionID, id)
+}
+
+// getFirstResourceVersion returns the id of the first version of a resource. The time index holds the latest of
+// the versions created at the earliest time, so the chain is only walked back over versions created in the same block.
+func (k Keeper) getFirstResourceVersion(ctx context.Context, collectionID, name, resourceType string) (string, error) {
+	rng := collections.NewPrefixedPairRange[collections.Triple[string, string, string], time.Time](
+		collections.Join3(collectionID, name, resourceType),
+	)
+
+	iter, err := k.ResourceVersionTimes.Iterate(ctx, rng)
+	if err != nil {
+		return "", err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return "", sdkerrors.ErrNotFound.Wrap("resource " + collectionID + ":" + name + ":" + resourceType)
+	}
+
+	firstID, err := iter.Value()
+	if err != nil {
+		return "", err
+	}
+
+	err = k.WalkResourceVersions(ctx, collectionID, firstID, false, func(metadata types.Metadata) (bool, error) {
+		firstID = metadata.Id
+		return false, nil
+	})
+
+	return firstID, err
+}
+
 // UpdateResourceMetadata update the metadata of a resource. Returns an error if the resource doesn't exist
 func (k Keeper) UpdateResourceMetadata(ctx context.Context, metadata *types.Metadata) error {
 	hasResource, err := k.ResourceMetadata.Has(ctx, collections.Join(metadata.CollectionId, metadata.Id))
@@ -228,3 +521,67 @@ func (k Keeper) GetAllResources(ctx context.Context) (list []*types.ResourceWith
 
 	return resources, nil
 }
+
+// GetLinkedResourcesMetadata returns the metadata of all resources in the collection in the DID Core representation.
+// The resource URI is left empty as it depends on the DID the collection is resolved for.
+func (k Keeper) GetLinkedResourcesMetadata(ctx context.Context, collectionID string) ([]didtypes.LinkedResourceMetadata, error) {
+	resources, err := k.GetResourceCollection(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	linkedResources := make([]didtypes.LinkedResourceMetadata, 0, len(resources))
+	for _, metadata := range resources {
+		linkedResources = append(linkedResources, newLinkedResourceMetadata(*metadata))
+	}
+
+	return linkedResources, nil
+}
+
+// GetLinkedResource returns the resource with its metadata in the DID Core representation
+func (k Keeper) GetLinkedResource(ctx context.Context, collectionID, id string) (didtypes.LinkedResource, error) {
+	resource, err := k.GetResource(ctx, collectionID, id)
+	if err != nil {
+		return didtypes.LinkedResource{}, err
+	}
+
+	return newLinkedResource(resource), nil
+}
+
+// GetLinkedResourceByName returns the latest version of the resource or the version active at the given time
+func (k Keeper) GetLinkedResourceByName(ctx context.Context, collectionID, name, resourceType string, at *time.Time) (didtypes.LinkedResource, error) {
+	var resource types.ResourceWithMetadata
+	var err error
+	if at != nil {
+		resource, err = k.GetResourceAtTime(ctx, collectionID, name, resourceType, *at)
+	} else {
+		resource, err = k.GetLatestResourceVersion(ctx, collectionID, name, resourceType)
+	}
+	if err != nil {
+		return didtypes.LinkedResource{}, err
+	}
+
+	return newLinkedResource(resource), nil
+}
+
+func newLinkedResource(resource types.ResourceWithMetadata) didtypes.LinkedResource {
+	return didtypes.LinkedResource{
+		Data:     resource.Resource.Data,
+		Metadata: newLinkedResourceMetadata(*resource.Metadata),
+	}
+}
+
+func newLinkedResourceMetadata(metadata types.Metadata) didtypes.LinkedResourceMetadata {
+	return didtypes.LinkedResourceMetadata{
+		ResourceCollectionID: metadata.CollectionId,
+		ResourceID:           metadata.Id,
+		ResourceName:         metadata.Name,
+		ResourceType:         metadata.ResourceType,
+		MediaType:            metadata.MediaType,
+		ResourceVersion:      metadata.Version,
+		Created:              metadata.Created,
+		Checksum:             metadata.Checksum,
+		PreviousVersionID:    metadata.PreviousVersionId,
+		NextVersionID:        metadata.NextVersionId,
+	}
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	"github.com/cheqd/cheqd-node/x/resource/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetResourceTotalBytes returns the total size of stored resource data
+func (k Keeper) GetResourceTotalBytes(ctx context.Context) (uint64, error) {
+	total, err := k.ResourceTotalBytes.Get(ctx)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// GetResourceMediaTypeCounts returns the number of resources by media type, ordered by media type
+func (k Keeper) GetResourceMediaTypeCounts(ctx context.Context) ([]*types.MediaTypeCount, error) {
+	var counts []*types.MediaTypeCount
+
+	err := k.ResourceMediaTypeCounts.Walk(ctx, nil, func(mediaType string, count uint64) (bool, error) {
+		counts = append(counts, &types.MediaTypeCount{MediaType: mediaType, Count: count})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+// GetResourceDataSize returns the size of the data of a resource, summing up its chunks if it was uploaded in chunks
+func (k Keeper) GetResourceDataSize(ctx context.Context, collectionID string, id string) (uint64, error) {
+	data, err := k.ResourceData.Get(ctx, collections.Join(collectionID, id))
+	if err == nil {
+		return uint64(len(data)), nil
+	}
+	if !errors.IsOf(err, collections.ErrNotFound) {
+		return 0, err
+	}
+
+	found := false
+	var size uint64
+	err = k.WalkResourceChunks(ctx, collectionID, id, func(_ uint64, chunk []byte) (bool, error) {
+		found = true
+		size += uint64(len(chunk))
+		return false, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	if !found {
+		return 0, sdkerrors.ErrNotFound.Wrap("resource data " + collectionID + ":" + id)
+	}
+
+	return size, nil
+}
+
+// countResourceStats counts a new resource by its media type and adds its size to the total size of stored data
+func (k Keeper) countResourceStats(ctx context.Context, mediaType string, size uint64) error {
+	count, err := k.ResourceMediaTypeCounts.Get(ctx, mediaType)
+	if err != nil && !errors.IsOf(err, collections.ErrNotFound) {
+		return err
+	}
+	if err := k.ResourceMediaTypeCounts.Set(ctx, mediaType, count+1); err != nil {
+		return err
+	}
+
+	total, err := k.GetResourceTotalBytes(ctx)
+	if err != nil {
+		return err
+	}
+
+	return k.ResourceTotalBytes.Set(ctx, total+size)
+}
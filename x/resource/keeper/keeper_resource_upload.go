@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	"github.com/cheqd/cheqd-node/x/resource/types"
+)
+
+// HasResourceUpload checks if a pending upload exists in the store
+func (k Keeper) HasResourceUpload(ctx context.Context, collectionID string, id string) (bool, error) {
+	return k.ResourceUploads.Has(ctx, collections.Join(collectionID, id))
+}
+
+// GetResourceUpload returns a pending upload from its resource id
+func (k Keeper) GetResourceUpload(ctx context.Context, collectionID string, id string) (types.ResourceUpload, error) {
+	upload, err := k.ResourceUploads.Get(ctx, collections.Join(collectionID, id))
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return types.ResourceUpload{}, types.ErrUploadNotFound.Wrap(collectionID + ":" + id)
+		}
+		return types.ResourceUpload{}, err
+	}
+
+	return upload, nil
+}
+
+// SetResourceUpload creates or updates a pending upload and indexes it by its expiry time
+func (k Keeper) SetResourceUpload(ctx context.Context, upload *types.ResourceUpload) error {
+	if err := k.ResourceUploads.Set(ctx, collections.Join(upload.CollectionId, upload.Id), *upload); err != nil {
+		return err
+	}
+
+	return k.ResourceUploadExpiries.Set(ctx, collections.Join3(upload.Expires, upload.CollectionId, upload.Id))
+}
+
+// RemoveResourceUpload removes a pending upload, keeping its chunks
+func (k Keeper) RemoveResourceUpload(ctx context.Context, upload *types.ResourceUpload) error {
+	if err := k.ResourceUploads.Remove(ctx, collections.Join(upload.CollectionId, upload.Id)); err != nil {
+		return err
+	}
+
+	return k.ResourceUploadExpiries.Remove(ctx, collections.Join3(upload.Expires, upload.CollectionId, upload.Id))
+}
+
+// DeleteResourceUpload removes a pending upload together with its chunks
+func (k Keeper) DeleteResourceUpload(ctx context.Context, upload *types.ResourceUpload) error {
+	if err := k.RemoveResourceUpload(ctx, upload); err != nil {
+		return err
+	}
+
+	chunks := collections.NewSuperPrefixedTripleRange[string, string, uint64](upload.CollectionId, upload.Id)
+	return k.ResourceChunks.Clear(ctx, chunks)
+}
+
+// SetResourceChunk stores a chunk of the data of a resource
+func (k Keeper) SetResourceChunk(ctx context.Context, collectionID string, id string, index uint64, data []byte) error {
+	return k.ResourceChunks.Set(ctx, collections.Join3(collectionID, id, index), data)
+}
+
+// WalkResourceChunks iterates over the chunks of the data of a resource in order
+func (k Keeper) WalkResourceChunks(ctx context.Context, collectionID string, id string, callback func(index uint64, data []byte) (stop bool, err error)) error {
+	rng := collections.NewSuperPrefixedTripleRange[string, string, uint64](collectionID, id)
+	return k.ResourceChunks.Walk(ctx, rng, func(key collections.Triple[string, string, uint64], data []byte) (bool, error) {
+		return callback(key.K3(), data)
+	})
+}
+
+// MaxPrunedResourceUploadsPerBlock limits the number of expired uploads pruned in a single block
+const MaxPrunedResourceUploadsPerBlock = 10
+
+// PruneExpiredResourceUploads removes the uploads that expired before the given time together with their chunks.
+// At most MaxPrunedResourceUploadsPerBlock uploads are pruned, the rest are left for the next blocks.
+func (k Keeper) PruneExpiredResourceUploads(ctx context.Context, now time.Time) error {
+	var expired []collections.Triple[time.Time, string, string]
+
+	rng := collections.NewPrefixUntilTripleRange[time.Time, string, string](now)
+	err := k.ResourceUploadExpiries.Walk(ctx, rng, func(key collections.Triple[time.Time, string, string]) (bool, error) {
+		expired = append(expired, key)
+		return len(expired) >= MaxPrunedResourceUploadsPerBlock, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		if err := k.ResourceUploadExpiries.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		uploadKey := collections.Join(key.K2(), key.K3())
+		upload, err := k.ResourceUploads.Get(ctx, uploadKey)
+		if err != nil {
+			if errors.IsOf(err, collections.ErrNotFound) {
+				continue
+			}
+			return err
+		}
+
+		// The upload may have been started again after the expiry index was written
+		if !upload.Expires.Equal(key.K1()) {
+			continue
+		}
+
+		if err := k.DeleteResourceUpload(ctx, &upload); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
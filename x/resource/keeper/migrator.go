@@ -2,8 +2,13 @@ package keeper
 
 import (
 	"github.com/cheqd/cheqd-node/x/resource/exported"
+	v10 "github.com/cheqd/cheqd-node/x/resource/migration/v10"
 	v4 "github.com/cheqd/cheqd-node/x/resource/migration/v4"
 	v5 "github.com/cheqd/cheqd-node/x/resource/migration/v5"
+	v6 "github.com/cheqd/cheqd-node/x/resource/migration/v6"
+	v7 "github.com/cheqd/cheqd-node/x/resource/migration/v7"
+	v8 "github.com/cheqd/cheqd-node/x/resource/migration/v8"
+	v9 "github.com/cheqd/cheqd-node/x/resource/migration/v9"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -30,3 +35,23 @@ func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 func (m Migrator) Migrate4to5(ctx sdk.Context) error {
 	return v5.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
 }
+
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v6.MigrateStore(ctx, m.keeper.LatestResourceVersion, m.keeper.IndexResourceVersionTimes)
+}
+
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	return v7.MigrateStore(ctx, m.keeper.ParamsStore)
+}
+
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	return v8.MigrateStore(ctx, m.keeper.ParamsStore)
+}
+
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	return v9.MigrateStore(ctx, m.keeper.ParamsStore)
+}
+
+func (m Migrator) Migrate9to10(ctx sdk.Context) error {
+	return v10.MigrateStore(ctx, m.keeper.ResourceMetadata, m.keeper.ResourceMediaTypeCounts, m.keeper.ResourceTotalBytes, m.keeper.GetResourceDataSize)
+}
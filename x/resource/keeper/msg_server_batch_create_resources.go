@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cheqd/cheqd-node/x/resource/types"
+)
+
+// BatchCreateResources creates all resources of the batch in the given order or none of them.
+// The signatures are verified once over the whole payload.
+func (k msgServer) BatchCreateResources(goCtx context.Context, msg *types.MsgBatchCreateResources) (*types.MsgBatchCreateResourcesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Remember bytes before modifying payload
+	signBytes := msg.Payload.GetSignBytes()
+
+	msg.Normalize()
+
+	// Validate batch size
+	params, err := k.GetParams(goCtx)
+	if err != nil {
+		return nil, err
+	}
+	err = msg.Payload.ValidateBatchSize(params.MaxBatchSize, params.MaxBatchDataSize)
+	if err != nil {
+		return nil, types.ErrBasicValidation.Wrap(err.Error())
+	}
+
+	namespace, err := k.verifyCollectionController(goCtx, msg.Payload.CollectionId, msg.Validate, signBytes, msg.Signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate Resources don't exist
+	for _, payload := range msg.Payload.Resources {
+		if k.HasResource(goCtx, payload.CollectionId, payload.Id) {
+			return nil, types.ErrResourceExists.Wrap(payload.Id)
+		}
+	}
+
+	resources := make([]*types.Metadata, 0, len(msg.Payload.Resources))
+	for _, payload := range msg.Payload.Resources {
+		resource, err := k.createResource(ctx, namespace, payload)
+		if err != nil {
+			return nil, err
+		}
+
+		resources = append(resources, resource)
+	}
+
+	// Build and return response
+	return &types.MsgBatchCreateResourcesResponse{
+		Resources: resources,
+	}, nil
+}
@@ -28,63 +28,105 @@ func (k msgServer) CreateResource(goCtx context.Context, msg *types.MsgCreateRes
 
 	msg.Normalize()
 
-	// Validate corresponding DIDDoc exists
-	namespace, err := k.didKeeper.GetDidNamespace(goCtx)
+	namespace, err := k.verifyCollectionController(goCtx, msg.Payload.CollectionId, msg.Validate, signBytes, msg.Signatures)
 	if err != nil {
 		return nil, err
 	}
-	did := didutils.JoinDID(didtypes.DidMethod, namespace, msg.Payload.CollectionId)
-	didDoc, err := k.didKeeper.GetLatestDidDoc(goCtx, did)
+
+	// Validate Resource doesn't exist
+	if k.HasResource(goCtx, msg.Payload.CollectionId, msg.Payload.Id) {
+		return nil, types.ErrResourceExists.Wrap(msg.Payload.Id)
+	}
+
+	resource, err := k.createResource(ctx, namespace, msg.Payload)
 	if err != nil {
 		return nil, err
 	}
 
+	// Build and return response
+	return &types.MsgCreateResourceResponse{
+		Resource: resource,
+	}, nil
+}
+
+// verifyCollectionController validates the message against the collection namespace, checks that the collection DID is active
+// and that the message is signed by the controllers of the collection DID. It returns the namespace.
+func (k msgServer) verifyCollectionController(ctx context.Context, collectionID string, validate func(allowedNamespaces []string) error,
+	signBytes []byte, signatures []*didtypes.SignInfo,
+) (string, error) {
+	// Validate corresponding DIDDoc exists
+	namespace, err := k.didKeeper.GetDidNamespace(ctx)
+	if err != nil {
+		return "", err
+	}
+	did := didutils.JoinDID(didtypes.DidMethod, namespace, collectionID)
+	didDoc, err := k.didKeeper.GetLatestDidDoc(ctx, did)
+	if err != nil {
+		return "", err
+	}
+
 	// Validate namespaces
-	err = msg.Validate([]string{namespace})
+	err = validate([]string{namespace})
 	if err != nil {
-		return nil, didtypes.ErrNamespaceValidation.Wrap(err.Error())
+		return "", didtypes.ErrNamespaceValidation.Wrap(err.Error())
 	}
 
 	// Validate DID is not deactivated
 	if didDoc.Metadata.Deactivated {
-		return nil, didtypes.ErrDIDDocDeactivated.Wrap(did)
+		return "", didtypes.ErrDIDDocDeactivated.Wrap(did)
 	}
 
-	// Validate Resource doesn't exist
-	if k.HasResource(goCtx, msg.Payload.CollectionId, msg.Payload.Id) {
-		return nil, types.ErrResourceExists.Wrap(msg.Payload.Id)
-	}
-
-	// We can use the same signers as for DID creation because didDoc stays the same
-	signers := didkeeper.GetSignerDIDsForDIDCreation(*didDoc.DidDoc)
-	err = didkeeper.VerifyAllSignersHaveAllValidSignatures(&k.didKeeper, goCtx, map[string]didtypes.DidDocWithMetadata{},
-		signBytes, signers, msg.Signatures)
+	// Resources are controlled by the controllers of the collection DID, according to its controller threshold
+	err = didkeeper.VerifyControllerPolicy(&k.didKeeper, ctx, map[string]didtypes.DidDocWithMetadata{},
+		signBytes, *didDoc.DidDoc, signatures)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
+	return namespace, nil
+}
+
+// createResource builds the resource with its ledger-side metadata, persists it as the latest version and emits the creation event
+func (k msgServer) createResource(ctx sdk.Context, namespace string, payload *types.MsgCreateResourcePayload) (*types.Metadata, error) {
 	// Build Resource
-	resource := msg.Payload.ToResource()
+	resource := payload.ToResource()
 	checksum := sha256.Sum256(resource.Resource.Data)
 	resource.Metadata.Checksum = hex.EncodeToString(checksum[:])
 	resource.Metadata.Created = ctx.BlockTime()
 	resource.Metadata.MediaType = utils.DetectMediaType(resource.Resource.Data)
 
+	return k.persistResource(ctx, namespace, &resource)
+}
+
+// persistResource adds the default alternative url to the resource, persists it as the latest version and emits the creation event
+func (k msgServer) persistResource(ctx sdk.Context, namespace string, resource *types.ResourceWithMetadata) (*types.Metadata, error) {
 	// Add default resource alternative url
 	defaultAlternativeURL := types.AlternativeUri{
-		Uri:         fmt.Sprintf(DefaultAlternativeURITemplate, namespace, msg.Payload.CollectionId, msg.Payload.Id),
+		Uri:         fmt.Sprintf(DefaultAlternativeURITemplate, namespace, resource.Metadata.CollectionId, resource.Metadata.Id),
 		Description: DefaultAlternaticeURIDescription,
 	}
 	resource.Metadata.AlsoKnownAs = append(resource.Metadata.AlsoKnownAs, &defaultAlternativeURL)
 
 	// Persist resource
-	err = k.AddNewResourceVersion(goCtx, &resource)
+	err := k.AddNewResourceVersion(ctx, resource)
 	if err != nil {
 		return nil, types.ErrInternal.Wrap(err.Error())
 	}
 
-	// Build and return response
-	return &types.MsgCreateResourceResponse{
-		Resource: resource.Metadata,
-	}, nil
+	// Emit event
+	err = ctx.EventManager().EmitTypedEvent(&types.EventResourceCreated{
+		CollectionId:      resource.Metadata.CollectionId,
+		Id:                resource.Metadata.Id,
+		Name:              resource.Metadata.Name,
+		Version:           resource.Metadata.Version,
+		ResourceType:      resource.Metadata.ResourceType,
+		MediaType:         resource.Metadata.MediaType,
+		Checksum:          resource.Metadata.Checksum,
+		PreviousVersionId: resource.Metadata.PreviousVersionId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resource.Metadata, nil
 }
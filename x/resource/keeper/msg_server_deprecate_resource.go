@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cheqd/cheqd-node/x/resource/types"
+)
+
+func (k msgServer) DeprecateResource(goCtx context.Context, msg *types.MsgDeprecateResource) (*types.MsgDeprecateResourceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Remember bytes before modifying payload
+	signBytes := msg.Payload.GetSignBytes()
+
+	msg.Normalize()
+
+	_, metadata, err := k.getMutableResourceMetadata(goCtx, msg.Payload.CollectionId, msg.Payload.Id, msg.Validate, signBytes, msg.Signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata.Deprecated = true
+
+	err = k.Keeper.UpdateResourceMetadata(goCtx, &metadata)
+	if err != nil {
+		return nil, types.ErrInternal.Wrap(err.Error())
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventResourceDeprecated{
+		CollectionId: metadata.CollectionId,
+		Id:           metadata.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeprecateResourceResponse{
+		Resource: &metadata,
+	}, nil
+}
@@ -0,0 +1,238 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding"
+	"encoding/hex"
+	"hash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cheqd/cheqd-node/x/resource/types"
+	"github.com/cheqd/cheqd-node/x/resource/utils"
+)
+
+// BeginResourceUpload starts a chunked upload of a resource. The upload expires after the upload_expiry module parameter.
+func (k msgServer) BeginResourceUpload(goCtx context.Context, msg *types.MsgBeginResourceUpload) (*types.MsgBeginResourceUploadResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Remember bytes before modifying payload
+	signBytes := msg.Payload.GetSignBytes()
+
+	msg.Normalize()
+
+	// Validate upload size
+	params, err := k.GetParams(goCtx)
+	if err != nil {
+		return nil, err
+	}
+	err = msg.Payload.ValidateUploadSize(params.MaxUploadSize)
+	if err != nil {
+		return nil, types.ErrBasicValidation.Wrap(err.Error())
+	}
+
+	_, err = k.verifyCollectionController(goCtx, msg.Payload.CollectionId, msg.Validate, signBytes, msg.Signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate neither the Resource nor an upload of it exists
+	if k.HasResource(goCtx, msg.Payload.CollectionId, msg.Payload.Id) {
+		return nil, types.ErrResourceExists.Wrap(msg.Payload.Id)
+	}
+	hasUpload, err := k.HasResourceUpload(goCtx, msg.Payload.CollectionId, msg.Payload.Id)
+	if err != nil {
+		return nil, err
+	}
+	if hasUpload {
+		existing, err := k.GetResourceUpload(goCtx, msg.Payload.CollectionId, msg.Payload.Id)
+		if err != nil {
+			return nil, err
+		}
+		if ctx.BlockTime().Before(existing.Expires) {
+			return nil, types.ErrResourceExists.Wrapf("upload of %s is already in progress", msg.Payload.Id)
+		}
+
+		// The upload expired but is not pruned yet, discard it so that it can be started again
+		err = k.DeleteResourceUpload(goCtx, &existing)
+		if err != nil {
+			return nil, types.ErrInternal.Wrap(err.Error())
+		}
+	}
+
+	upload := msg.Payload.ToUpload(ctx.BlockTime().Add(params.UploadExpiry))
+	err = k.SetResourceUpload(goCtx, &upload)
+	if err != nil {
+		return nil, types.ErrInternal.Wrap(err.Error())
+	}
+
+	return &types.MsgBeginResourceUploadResponse{
+		Upload: &upload,
+	}, nil
+}
+
+// AppendResourceChunk appends the next chunk to a pending upload and updates the running checksum
+func (k msgServer) AppendResourceChunk(goCtx context.Context, msg *types.MsgAppendResourceChunk) (*types.MsgAppendResourceChunkResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Remember bytes before modifying payload
+	signBytes := msg.Payload.GetSignBytes()
+
+	msg.Normalize()
+
+	params, err := k.GetParams(goCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = k.verifyCollectionController(goCtx, msg.Payload.CollectionId, msg.Validate, signBytes, msg.Signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	upload, err := k.getActiveResourceUpload(ctx, msg.Payload.CollectionId, msg.Payload.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate chunk. Requiring the index of the next chunk also prevents appending the same chunk twice.
+	chunkSize := uint64(len(msg.Payload.Data))
+	if msg.Payload.Index != upload.ChunkCount {
+		return nil, types.ErrInvalidChunk.Wrapf("expected chunk %d, got %d", upload.ChunkCount, msg.Payload.Index)
+	}
+	if chunkSize > params.MaxChunkSize {
+		return nil, types.ErrInvalidChunk.Wrapf("chunk size is %d bytes, at most %d are allowed", chunkSize, params.MaxChunkSize)
+	}
+	if chunkSize > upload.Size_-upload.Received {
+		return nil, types.ErrInvalidChunk.Wrapf("chunk exceeds the declared size of %d bytes", upload.Size_)
+	}
+
+	// Update checksum
+	hasher, err := restoreHash(upload.HashState)
+	if err != nil {
+		return nil, types.ErrInternal.Wrap(err.Error())
+	}
+	hasher.Write(msg.Payload.Data)
+	upload.HashState, err = hasher.(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		return nil, types.ErrInternal.Wrap(err.Error())
+	}
+
+	err = k.SetResourceChunk(goCtx, upload.CollectionId, upload.Id, upload.ChunkCount, msg.Payload.Data)
+	if err != nil {
+		return nil, types.ErrInternal.Wrap(err.Error())
+	}
+
+	upload.Received += chunkSize
+	upload.ChunkCount++
+	err = k.SetResourceUpload(goCtx, &upload)
+	if err != nil {
+		return nil, types.ErrInternal.Wrap(err.Error())
+	}
+
+	return &types.MsgAppendResourceChunkResponse{
+		Upload: &upload,
+	}, nil
+}
+
+// FinalizeResourceUpload creates the resource from a completed upload. The uploaded chunks are kept as the resource data.
+func (k msgServer) FinalizeResourceUpload(goCtx context.Context, msg *types.MsgFinalizeResourceUpload) (*types.MsgFinalizeResourceUploadResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Remember bytes before modifying payload
+	signBytes := msg.Payload.GetSignBytes()
+
+	msg.Normalize()
+
+	namespace, err := k.verifyCollectionController(goCtx, msg.Payload.CollectionId, msg.Validate, signBytes, msg.Signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	upload, err := k.getActiveResourceUpload(ctx, msg.Payload.CollectionId, msg.Payload.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate all data has been received and matches the declared checksum
+	if upload.Received != upload.Size_ {
+		return nil, types.ErrInvalidChunk.Wrapf("received %d of %d bytes", upload.Received, upload.Size_)
+	}
+	hasher, err := restoreHash(upload.HashState)
+	if err != nil {
+		return nil, types.ErrInternal.Wrap(err.Error())
+	}
+	if checksum := hex.EncodeToString(hasher.Sum(nil)); checksum != upload.Checksum {
+		return nil, types.ErrChecksumMismatch.Wrapf("expected %s, got %s", upload.Checksum, checksum)
+	}
+
+	// Validate Resource doesn't exist
+	if k.HasResource(goCtx, upload.CollectionId, upload.Id) {
+		return nil, types.ErrResourceExists.Wrap(upload.Id)
+	}
+
+	// Media type is detected from the reassembled data, as for resources created in a single message
+	data, err := k.GetResourceData(goCtx, upload.CollectionId, upload.Id)
+	if err != nil {
+		return nil, types.ErrInternal.Wrap(err.Error())
+	}
+
+	// Data is already stored in chunks
+	resource := types.ResourceWithMetadata{
+		Metadata: &types.Metadata{
+			CollectionId: upload.CollectionId,
+			Id:           upload.Id,
+			Name:         upload.Name,
+			Version:      upload.Version,
+			ResourceType: upload.ResourceType,
+			AlsoKnownAs:  upload.AlsoKnownAs,
+			MediaType:    utils.DetectMediaType(data),
+			Created:      ctx.BlockTime(),
+			Checksum:     upload.Checksum,
+		},
+	}
+
+	metadata, err := k.persistResource(ctx, namespace, &resource)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.RemoveResourceUpload(goCtx, &upload)
+	if err != nil {
+		return nil, types.ErrInternal.Wrap(err.Error())
+	}
+
+	return &types.MsgFinalizeResourceUploadResponse{
+		Resource: metadata,
+	}, nil
+}
+
+// getActiveResourceUpload returns a pending upload, treating expired uploads that are not pruned yet as missing
+func (k msgServer) getActiveResourceUpload(ctx sdk.Context, collectionID, id string) (types.ResourceUpload, error) {
+	upload, err := k.GetResourceUpload(ctx, collectionID, id)
+	if err != nil {
+		return types.ResourceUpload{}, err
+	}
+
+	if !ctx.BlockTime().Before(upload.Expires) {
+		return types.ResourceUpload{}, types.ErrUploadNotFound.Wrapf("upload of %s expired at %s", id, upload.Expires)
+	}
+
+	return upload, nil
+}
+
+// restoreHash returns a SHA-256 hash resumed from its marshaled state, or a new one if the state is empty
+func restoreHash(state []byte) (hash.Hash, error) {
+	hasher := sha256.New()
+	if len(state) == 0 {
+		return hasher, nil
+	}
+
+	err := hasher.(encoding.BinaryUnmarshaler).UnmarshalBinary(state)
+	if err != nil {
+		return nil, err
+	}
+
+	return hasher, nil
+}
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	didtypes "github.com/cheqd/cheqd-node/x/did/types"
+	"github.com/cheqd/cheqd-node/x/resource/types"
+)
+
+func (k msgServer) UpdateResourceMetadata(goCtx context.Context, msg *types.MsgUpdateResourceMetadata) (*types.MsgUpdateResourceMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Remember bytes before modifying payload
+	signBytes := msg.Payload.GetSignBytes()
+
+	msg.Normalize()
+
+	namespace, metadata, err := k.getMutableResourceMetadata(goCtx, msg.Payload.CollectionId, msg.Payload.Id, msg.Validate, signBytes, msg.Signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update mutable fields, keeping the default resource alternative url
+	metadata.AlsoKnownAs = msg.Payload.AlsoKnownAs
+	defaultAlternativeURL := types.AlternativeUri{
+		Uri:         fmt.Sprintf(DefaultAlternativeURITemplate, namespace, metadata.CollectionId, metadata.Id),
+		Description: DefaultAlternaticeURIDescription,
+	}
+	if !hasAlternativeURI(metadata.AlsoKnownAs, defaultAlternativeURL.Uri) {
+		metadata.AlsoKnownAs = append(metadata.AlsoKnownAs, &defaultAlternativeURL)
+	}
+
+	err = k.Keeper.UpdateResourceMetadata(goCtx, &metadata)
+	if err != nil {
+		return nil, types.ErrInternal.Wrap(err.Error())
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventResourceMetadataUpdated{
+		CollectionId: metadata.CollectionId,
+		Id:           metadata.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateResourceMetadataResponse{
+		Resource: &metadata,
+	}, nil
+}
+
+// getMutableResourceMetadata verifies the message against the collection DID and checks that the resource exists and is not deprecated.
+// It returns the namespace and the current metadata of the resource.
+func (k msgServer) getMutableResourceMetadata(ctx context.Context, collectionID, id string, validate func(allowedNamespaces []string) error,
+	signBytes []byte, signatures []*didtypes.SignInfo,
+) (string, types.Metadata, error) {
+	namespace, err := k.verifyCollectionController(ctx, collectionID, validate, signBytes, signatures)
+	if err != nil {
+		return "", types.Metadata{}, err
+	}
+
+	// Validate Resource exists and is not deprecated
+	metadata, err := k.GetResourceMetadata(ctx, collectionID, id)
+	if err != nil {
+		if errors.IsOf(err, sdkerrors.ErrNotFound) {
+			return "", types.Metadata{}, types.ErrResourceNotFound.Wrap(id)
+		}
+		return "", types.Metadata{}, err
+	}
+	if metadata.Deprecated {
+		return "", types.Metadata{}, types.ErrDeprecated.Wrap(id)
+	}
+
+	return namespace, metadata, nil
+}
+
+func hasAlternativeURI(alternativeURIs []*types.AlternativeUri, uri string) bool {
+	for _, alternativeURI := range alternativeURIs {
+		if alternativeURI.Uri == uri {
+			return true
+		}
+	}
+
+	return false
+}
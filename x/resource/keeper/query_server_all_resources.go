@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/cheqd/cheqd-node/x/resource/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) AllResources(ctx context.Context, req *types.QueryAllResourcesRequest) (*types.QueryAllResourcesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	// Get a page of filtered resources across all collections
+	resources, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.Keeper.ResourceMetadata,
+		req.Pagination,
+		func(_ collections.Pair[string, string], metadata types.Metadata) (bool, error) {
+			return req.Matches(metadata), nil
+		},
+		func(_ collections.Pair[string, string], metadata types.Metadata) (*types.Metadata, error) {
+			return &metadata, nil
+		},
+	)
+	if err != nil {
+		return nil, types.ErrResourceNotAvail.Wrap(err.Error())
+	}
+
+	return &types.QueryAllResourcesResponse{
+		Resources:  resources,
+		Pagination: pageRes,
+	}, nil
+}
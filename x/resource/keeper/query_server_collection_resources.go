@@ -3,9 +3,11 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
 	didtypes "github.com/cheqd/cheqd-node/x/did/types"
 	didutils "github.com/cheqd/cheqd-node/x/did/utils"
 	"github.com/cheqd/cheqd-node/x/resource/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -30,13 +32,26 @@ func (q queryServer) CollectionResources(ctx context.Context, req *types.QueryCo
 	if !hasDidDoc {
 		return nil, didtypes.ErrDidDocNotFound.Wrap(did)
 	}
-	// Get all resources
-	resources, err := q.GetResourceCollection(ctx, req.CollectionId)
+
+	// Get a page of filtered resources
+	resources, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.Keeper.ResourceMetadata,
+		req.Pagination,
+		func(_ collections.Pair[string, string], metadata types.Metadata) (bool, error) {
+			return req.Matches(metadata), nil
+		},
+		func(_ collections.Pair[string, string], metadata types.Metadata) (*types.Metadata, error) {
+			return &metadata, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](req.CollectionId),
+	)
 	if err != nil {
 		return nil, types.ErrResourceNotAvail.Wrap(err.Error())
 	}
 
 	return &types.QueryCollectionResourcesResponse{
-		Resources: resources,
+		Resources:  resources,
+		Pagination: pageRes,
 	}, nil
 }
@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/resource/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) EstimateResourceFee(ctx context.Context, req *types.QueryEstimateResourceFeeRequest) (*types.QueryEstimateResourceFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if q.feeEstimator == nil {
+		return nil, status.Error(codes.Unimplemented, "fee estimation is not available")
+	}
+
+	return q.feeEstimator.EstimateResourceFee(ctx, req)
+}
@@ -32,13 +32,32 @@ func (q queryServer) Resource(c context.Context, req *types.QueryResourceRequest
 		return nil, didtypes.ErrDidDocNotFound.Wrap(did)
 	}
 
-	resource, err := q.GetResource(c, req.CollectionId, req.Id)
+	if req.Offset == 0 && req.Length == 0 {
+		resource, err := q.GetResource(c, req.CollectionId, req.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.QueryResourceResponse{
+			Resource: &resource,
+		}, nil
+	}
+
+	// Return only the requested byte range of the data
+	metadata, err := q.GetResourceMetadata(c, req.CollectionId, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	data, err := q.GetResourceDataRange(c, req.CollectionId, req.Id, req.Offset, req.Length)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.QueryResourceResponse{
-		Resource: &resource,
+		Resource: &types.ResourceWithMetadata{
+			Metadata: &metadata,
+			Resource: &types.Resource{Data: data},
+		},
 	}, nil
 }
 
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+
+	didtypes "github.com/cheqd/cheqd-node/x/did/types"
+	didutils "github.com/cheqd/cheqd-node/x/did/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cheqd/cheqd-node/x/resource/types"
+)
+
+func (q queryServer) ResourceVersions(c context.Context, req *types.QueryResourceVersionsRequest) (*types.QueryResourceVersionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	req.Normalize()
+
+	// Validate corresponding DIDDoc exists
+	namespace, err := q.didKeeper.GetDidNamespace(c)
+	if err != nil {
+		return nil, err
+	}
+	did := didutils.JoinDID(didtypes.DidMethod, namespace, req.CollectionId)
+	hasDidDoc, err := q.didKeeper.HasDidDoc(c, did)
+	if err != nil {
+		return nil, err
+	}
+	if !hasDidDoc {
+		return nil, didtypes.ErrDidDocNotFound.Wrap(did)
+	}
+
+	resources, pageRes, err := q.GetResourceVersions(c, req.CollectionId, req.Name, req.ResourceType, req.IncludeData, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryResourceVersionsResponse{
+		Resources:  resources,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q queryServer) ResourceAtTime(c context.Context, req *types.QueryResourceAtTimeRequest) (*types.QueryResourceAtTimeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	req.Normalize()
+
+	// Validate corresponding DIDDoc exists
+	namespace, err := q.didKeeper.GetDidNamespace(c)
+	if err != nil {
+		return nil, err
+	}
+	did := didutils.JoinDID(didtypes.DidMethod, namespace, req.CollectionId)
+	hasDidDoc, err := q.didKeeper.HasDidDoc(c, did)
+	if err != nil {
+		return nil, err
+	}
+	if !hasDidDoc {
+		return nil, didtypes.ErrDidDocNotFound.Wrap(did)
+	}
+
+	resource, err := q.GetResourceAtTime(c, req.CollectionId, req.Name, req.ResourceType, req.Time)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryResourceAtTimeResponse{
+		Resource: &resource,
+	}, nil
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/resource/types"
+)
+
+func (q queryServer) Stats(ctx context.Context, _ *types.QueryStatsRequest) (*types.QueryStatsResponse, error) {
+	resourceCount, err := q.GetResourceCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mediaTypes, err := q.GetResourceMediaTypeCounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	totalBytes, err := q.GetResourceTotalBytes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryStatsResponse{
+		ResourceCount: resourceCount,
+		MediaTypes:    mediaTypes,
+		TotalBytes:    totalBytes,
+	}, nil
+}
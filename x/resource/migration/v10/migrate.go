@@ -0,0 +1,43 @@
+package v10
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/cheqd/cheqd-node/x/resource/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateStore counts the existing resources by media type and sums up the size of their data for the module stats
+func MigrateStore(ctx sdk.Context,
+	metadataCollection collections.Map[collections.Pair[string, string], types.Metadata],
+	mediaTypeCounts collections.Map[string, uint64],
+	totalBytes collections.Item[uint64],
+	getDataSize func(ctx context.Context, collectionID string, id string) (uint64, error),
+) error {
+	counts := make(map[string]uint64)
+	var total uint64
+
+	err := metadataCollection.Walk(ctx, nil, func(_ collections.Pair[string, string], metadata types.Metadata) (bool, error) {
+		size, err := getDataSize(ctx, metadata.CollectionId, metadata.Id)
+		if err != nil {
+			return true, err
+		}
+
+		counts[metadata.MediaType]++
+		total += size
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Each media type is set once, the order doesn't matter
+	for mediaType, count := range counts {
+		if err := mediaTypeCounts.Set(ctx, mediaType, count); err != nil {
+			return err
+		}
+	}
+
+	return totalBytes.Set(ctx, total)
+}
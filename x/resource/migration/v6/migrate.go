@@ -0,0 +1,18 @@
+package v6
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateStore builds the time index of the existing resource versions
+func MigrateStore(ctx sdk.Context,
+	latestVersions collections.Map[collections.Triple[string, string, string], string],
+	indexVersionTimes func(ctx context.Context, collectionID, latestID string) error,
+) error {
+	return latestVersions.Walk(ctx, nil, func(key collections.Triple[string, string, string], latestID string) (bool, error) {
+		return false, indexVersionTimes(ctx, key.K1(), latestID)
+	})
+}
@@ -0,0 +1,25 @@
+package v7
+
+import (
+	"cosmossdk.io/collections"
+	"github.com/cheqd/cheqd-node/x/resource/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateStore sets the default fees of the resource metadata update and deprecation messages
+func MigrateStore(ctx sdk.Context, paramsStore collections.Item[types.FeeParams]) error {
+	params, err := paramsStore.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	usdParams := types.DefaultUSDParams()
+	if len(params.UpdateResourceMetadata) == 0 {
+		params.UpdateResourceMetadata = usdParams.UpdateResourceMetadata
+	}
+	if len(params.DeprecateResource) == 0 {
+		params.DeprecateResource = usdParams.DeprecateResource
+	}
+
+	return paramsStore.Set(ctx, params)
+}
@@ -0,0 +1,46 @@
+package v7_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cheqd/cheqd-node/x/resource"
+	v7 "github.com/cheqd/cheqd-node/x/resource/migration/v7"
+	"github.com/cheqd/cheqd-node/x/resource/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(resource.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := sdktestutil.DefaultContext(storeKey, tKey)
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(storeKey))
+	paramsStore := collections.NewItem(
+		sb,
+		collections.NewPrefix(types.ParamStoreKeyFeeParams),
+		"params",
+		codec.CollValue[types.FeeParams](cdc),
+	)
+
+	// set params without the metadata update and deprecation fees
+	params := types.DefaultUSDParams()
+	params.UpdateResourceMetadata = nil
+	params.DeprecateResource = nil
+	require.NoError(t, paramsStore.Set(ctx, *params))
+
+	require.NoError(t, v7.MigrateStore(ctx, paramsStore))
+
+	migrated, err := paramsStore.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, *types.DefaultUSDParams(), migrated)
+}
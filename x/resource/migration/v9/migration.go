@@ -0,0 +1,30 @@
+package v9
+
+import (
+	"cosmossdk.io/collections"
+	"github.com/cheqd/cheqd-node/x/resource/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateStore sets the default fee and limits of chunked resource uploads
+func MigrateStore(ctx sdk.Context, paramsStore collections.Item[types.FeeParams]) error {
+	params, err := paramsStore.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(params.UploadPerMib) == 0 {
+		params.UploadPerMib = types.DefaultUSDParams().UploadPerMib
+	}
+	if params.MaxUploadSize == 0 {
+		params.MaxUploadSize = types.DefaultMaxUploadSize
+	}
+	if params.MaxChunkSize == 0 {
+		params.MaxChunkSize = types.DefaultMaxChunkSize
+	}
+	if params.UploadExpiry == 0 {
+		params.UploadExpiry = types.DefaultUploadExpiry
+	}
+
+	return paramsStore.Set(ctx, params)
+}
@@ -0,0 +1,102 @@
+package tests
+
+import (
+	. "github.com/cheqd/cheqd-node/x/resource/tests/setup"
+
+	didsetup "github.com/cheqd/cheqd-node/x/did/tests/setup"
+	"github.com/cheqd/cheqd-node/x/resource/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Batch Create Resources", func() {
+	var setup TestSetup
+	var alice didsetup.CreatedDidDocInfo
+
+	BeforeEach(func() {
+		setup = Setup()
+		alice = setup.CreateSimpleDid()
+
+		err := setup.ResourceKeeper.SetParams(setup.StdCtx, *types.DefaultFeeParams())
+		Expect(err).To(BeNil())
+	})
+
+	It("Creates all resources of the batch in the given order", func() {
+		first := setup.BuildSimpleResource(alice.CollectionID, SchemaData, "Resource 1", CLSchemaType)
+		second := setup.BuildSimpleResource(alice.CollectionID, SchemaData, "Resource 1", CLSchemaType)
+
+		res, err := setup.BatchCreateResources(&types.MsgBatchCreateResourcesPayload{
+			CollectionId: alice.CollectionID,
+			Resources:    []*types.MsgCreateResourcePayload{&first, &second},
+		}, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+		Expect(res.Resources).To(HaveLen(2))
+
+		latest, err := setup.QueryResourceMetadata(alice.CollectionID, first.Id)
+		Expect(err).To(BeNil())
+		Expect(latest.Resource.NextVersionId).To(Equal(second.Id))
+
+		created, err := setup.QueryResourceMetadata(alice.CollectionID, second.Id)
+		Expect(err).To(BeNil())
+		Expect(created.Resource.PreviousVersionId).To(Equal(first.Id))
+	})
+
+	It("Fails without a valid signature of the collection DID controller", func() {
+		bob := setup.CreateSimpleDid()
+		resource := setup.BuildSimpleResource(alice.CollectionID, SchemaData, "Resource 1", CLSchemaType)
+
+		_, err := setup.BatchCreateResources(&types.MsgBatchCreateResourcesPayload{
+			CollectionId: alice.CollectionID,
+			Resources:    []*types.MsgCreateResourcePayload{&resource},
+		}, []didsetup.SignInput{bob.SignInput})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("signature is required but not found"))
+	})
+
+	It("Creates nothing if one resource already exists", func() {
+		existing := setup.CreateSimpleResource(alice.CollectionID, SchemaData, "Resource 1", CLSchemaType, []didsetup.SignInput{alice.SignInput})
+		resource := setup.BuildSimpleResource(alice.CollectionID, SchemaData, "Resource 2", CLSchemaType)
+		duplicate := setup.BuildSimpleResource(alice.CollectionID, SchemaData, "Resource 1", CLSchemaType)
+		duplicate.Id = existing.Resource.Id
+
+		_, err := setup.BatchCreateResources(&types.MsgBatchCreateResourcesPayload{
+			CollectionId: alice.CollectionID,
+			Resources:    []*types.MsgCreateResourcePayload{&resource, &duplicate},
+		}, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(HaveOccurred())
+		Expect(err).To(MatchError(ContainSubstring(types.ErrResourceExists.Error())))
+
+		_, err = setup.QueryResourceMetadata(alice.CollectionID, resource.Id)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Fails for a resource of another collection", func() {
+		bob := setup.CreateSimpleDid()
+		resource := setup.BuildSimpleResource(bob.CollectionID, SchemaData, "Resource 1", CLSchemaType)
+
+		_, err := setup.BatchCreateResources(&types.MsgBatchCreateResourcesPayload{
+			CollectionId: alice.CollectionID,
+			Resources:    []*types.MsgCreateResourcePayload{&resource},
+		}, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("does not belong to collection"))
+	})
+
+	It("Fails if the batch exceeds max_batch_data_size", func() {
+		params := *types.DefaultFeeParams()
+		params.MaxBatchDataSize = uint64(len(SchemaData))
+		err := setup.ResourceKeeper.SetParams(setup.StdCtx, params)
+		Expect(err).To(BeNil())
+
+		first := setup.BuildSimpleResource(alice.CollectionID, SchemaData, "Resource 1", CLSchemaType)
+		second := setup.BuildSimpleResource(alice.CollectionID, SchemaData, "Resource 2", CLSchemaType)
+
+		_, err = setup.BatchCreateResources(&types.MsgBatchCreateResourcesPayload{
+			CollectionId: alice.CollectionID,
+			Resources:    []*types.MsgCreateResourcePayload{&first, &second},
+		}, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("bytes of resource data"))
+	})
+})
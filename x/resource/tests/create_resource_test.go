@@ -128,6 +128,19 @@ var _ = Describe("Create Resource Tests", func() {
 
 			ExpectPayloadToMatchResource(&msg, created.Resource)
 			Expect(created.Resource.Metadata.PreviousVersionId).To(Equal(existingResource.Resource.Id))
+
+			// check event
+			event, err := setup.LastTypedEvent(&resourcetypes.EventResourceCreated{})
+			Expect(err).To(BeNil())
+			Expect(event).To(Equal(&resourcetypes.EventResourceCreated{
+				CollectionId:      alice.CollectionID,
+				Id:                msg.Id,
+				Name:              msg.Name,
+				ResourceType:      msg.ResourceType,
+				MediaType:         created.Resource.Metadata.MediaType,
+				Checksum:          created.Resource.Metadata.Checksum,
+				PreviousVersionId: existingResource.Resource.Id,
+			}))
 		})
 	})
 
@@ -166,6 +179,37 @@ var _ = Describe("Create Resource Tests", func() {
 		})
 	})
 
+	Describe("Resource for DID with controller threshold", func() {
+		var bob, carol didsetup.CreatedDidDocInfo
+		var org didsetup.CreatedDidDocInfo
+		var msg *resourcetypes.MsgCreateResourcePayload
+
+		BeforeEach(func() {
+			bob = setup.CreateSimpleDid()
+			carol = setup.CreateSimpleDid()
+			org = setup.CreateDidDocWithControllerThreshold([]string{alice.Did, bob.Did, carol.Did}, false, 2,
+				[]didsetup.SignInput{alice.SignInput, bob.SignInput, carol.SignInput})
+
+			msg = &resourcetypes.MsgCreateResourcePayload{
+				CollectionId: org.CollectionID,
+				Id:           uuid.NewString(),
+				Name:         "Test Resource Name",
+				ResourceType: CLSchemaType,
+				Data:         []byte(SchemaData),
+			}
+		})
+
+		It("Can be created with signatures of the threshold of controllers", func() {
+			_, err := setup.CreateResource(msg, []didsetup.SignInput{alice.SignInput, carol.SignInput})
+			Expect(err).To(BeNil())
+		})
+
+		It("Can't be created below the threshold", func() {
+			_, err := setup.CreateResource(msg, []didsetup.SignInput{alice.SignInput, org.SignInput})
+			Expect(err.Error()).To(ContainSubstring("signature is required but not found"))
+		})
+	})
+
 	Describe("UUID with capital letters", func() {
 		It("Should work even for UUID with capital letters", func() {
 			msg := resourcetypes.MsgCreateResourcePayload{
@@ -0,0 +1,114 @@
+package tests
+
+import (
+	"bytes"
+
+	. "github.com/cheqd/cheqd-node/x/resource/tests/setup"
+
+	didsetup "github.com/cheqd/cheqd-node/x/did/tests/setup"
+	v10 "github.com/cheqd/cheqd-node/x/resource/migration/v10"
+	"github.com/cheqd/cheqd-node/x/resource/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Query All Resources and Stats", func() {
+	var setup TestSetup
+	var alice didsetup.CreatedDidDocInfo
+	var bob didsetup.CreatedDidDocInfo
+	var uploaded []byte
+
+	var res1 *types.MsgCreateResourceResponse
+	var res2 *types.MsgCreateResourceResponse
+	var uploadID string
+
+	BeforeEach(func() {
+		setup = Setup()
+
+		alice = setup.CreateSimpleDid()
+		bob = setup.CreateSimpleDid()
+
+		res1 = setup.CreateSimpleResource(alice.CollectionID, SchemaData, "Resource 1", CLSchemaType, []didsetup.SignInput{alice.SignInput})
+		res2 = setup.CreateSimpleResource(bob.CollectionID, SchemaData, "Resource 2", CLSchemaType, []didsetup.SignInput{bob.SignInput})
+
+		err := setup.ResourceKeeper.SetParams(setup.StdCtx, *types.DefaultFeeParams())
+		Expect(err).To(BeNil())
+
+		uploaded = bytes.Repeat([]byte("0123456789"), 100)
+		payload := setup.BuildResourceUpload(alice.CollectionID, uploaded, "Resource 3", CLSchemaType)
+		uploadID = payload.Id
+
+		_, err = setup.BeginResourceUpload(&payload, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+		err = setup.UploadResourceChunks(alice.CollectionID, payload.Id, uploaded, 300, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+		_, err = setup.FinalizeResourceUpload(&types.MsgFinalizeResourceUploadPayload{
+			CollectionId: alice.CollectionID,
+			Id:           payload.Id,
+		}, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+	})
+
+	queryAllResources := func(req *types.QueryAllResourcesRequest) []string {
+		res, err := setup.ResourceQueryServer.AllResources(setup.StdCtx, req)
+		Expect(err).To(BeNil())
+
+		ids := make([]string, 0, len(res.Resources))
+		for _, metadata := range res.Resources {
+			ids = append(ids, metadata.Id)
+		}
+		return ids
+	}
+
+	It("Lists the resources of all collections", func() {
+		Expect(queryAllResources(&types.QueryAllResourcesRequest{})).To(ConsistOf(res1.Resource.Id, res2.Resource.Id, uploadID))
+	})
+
+	It("Filters by media type", func() {
+		Expect(queryAllResources(&types.QueryAllResourcesRequest{MediaType: res1.Resource.MediaType})).To(ConsistOf(res1.Resource.Id, res2.Resource.Id))
+		Expect(queryAllResources(&types.QueryAllResourcesRequest{MediaType: "image/png"})).To(BeEmpty())
+	})
+
+	It("Paginates the results", func() {
+		res, err := setup.ResourceQueryServer.AllResources(setup.StdCtx, &types.QueryAllResourcesRequest{
+			Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+		})
+		Expect(err).To(BeNil())
+		Expect(res.Resources).To(HaveLen(2))
+		Expect(res.Pagination.Total).To(Equal(uint64(3)))
+
+		next, err := setup.ResourceQueryServer.AllResources(setup.StdCtx, &types.QueryAllResourcesRequest{
+			Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+		})
+		Expect(err).To(BeNil())
+		Expect(next.Resources).To(HaveLen(1))
+	})
+
+	It("Counts resources by media type and the stored bytes", func() {
+		res, err := setup.ResourceQueryServer.Stats(setup.StdCtx, &types.QueryStatsRequest{})
+		Expect(err).To(BeNil())
+		Expect(res.ResourceCount).To(Equal(uint64(3)))
+		Expect(res.TotalBytes).To(Equal(uint64(2*len(SchemaData) + len(uploaded))))
+		Expect(res.MediaTypes).To(ConsistOf(
+			&types.MediaTypeCount{MediaType: res1.Resource.MediaType, Count: 2},
+			&types.MediaTypeCount{MediaType: "text/plain; charset=utf-8", Count: 1},
+		))
+	})
+
+	It("Restores the stats in the store migration", func() {
+		expected, err := setup.ResourceQueryServer.Stats(setup.StdCtx, &types.QueryStatsRequest{})
+		Expect(err).To(BeNil())
+
+		Expect(setup.ResourceKeeper.ResourceMediaTypeCounts.Clear(setup.StdCtx, nil)).To(Succeed())
+		Expect(setup.ResourceKeeper.ResourceTotalBytes.Remove(setup.StdCtx)).To(Succeed())
+
+		err = v10.MigrateStore(setup.SdkCtx, setup.ResourceKeeper.ResourceMetadata, setup.ResourceKeeper.ResourceMediaTypeCounts, setup.ResourceKeeper.ResourceTotalBytes, setup.ResourceKeeper.GetResourceDataSize)
+		Expect(err).To(BeNil())
+
+		res, err := setup.ResourceQueryServer.Stats(setup.StdCtx, &types.QueryStatsRequest{})
+		Expect(err).To(BeNil())
+		Expect(res).To(Equal(expected))
+	})
+})
@@ -1,11 +1,14 @@
 package tests
 
 import (
+	"time"
+
 	. "github.com/cheqd/cheqd-node/x/resource/tests/setup"
 
 	didsetup "github.com/cheqd/cheqd-node/x/did/tests/setup"
 	didutils "github.com/cheqd/cheqd-node/x/did/utils"
 	"github.com/cheqd/cheqd-node/x/resource/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -67,4 +70,79 @@ var _ = Describe("Query Collection Resources", func() {
 
 		Expect(ids).To(ContainElement(resUUID.Resource.Id))
 	})
+	It("Should paginate by key", func() {
+		firstPage, err := setup.CollectionResourcesWithRequest(&types.QueryCollectionResourcesRequest{
+			CollectionId: alice.CollectionID,
+			Pagination:   &query.PageRequest{Limit: 2, CountTotal: true},
+		})
+		Expect(err).To(BeNil())
+		Expect(firstPage.Resources).To(HaveLen(2))
+		Expect(firstPage.Pagination.Total).To(Equal(uint64(3)))
+		Expect(firstPage.Pagination.NextKey).ToNot(BeEmpty())
+
+		secondPage, err := setup.CollectionResourcesWithRequest(&types.QueryCollectionResourcesRequest{
+			CollectionId: alice.CollectionID,
+			Pagination:   &query.PageRequest{Key: firstPage.Pagination.NextKey, Limit: 2},
+		})
+		Expect(err).To(BeNil())
+		Expect(secondPage.Resources).To(HaveLen(1))
+		Expect(secondPage.Pagination.NextKey).To(BeEmpty())
+
+		ids := []string{firstPage.Resources[0].Id, firstPage.Resources[1].Id, secondPage.Resources[0].Id}
+
+		Expect(ids).To(ConsistOf(res1v1.Resource.Id, res1v2.Resource.Id, res2v1.Resource.Id))
+	})
+
+	It("Should filter by resource name", func() {
+		versions, err := setup.CollectionResourcesWithRequest(&types.QueryCollectionResourcesRequest{
+			CollectionId: alice.CollectionID,
+			ResourceName: "Resource 1",
+		})
+		Expect(err).To(BeNil())
+		Expect(versions.Resources).To(HaveLen(2))
+
+		ids := []string{versions.Resources[0].Id, versions.Resources[1].Id}
+
+		Expect(ids).To(ConsistOf(res1v1.Resource.Id, res1v2.Resource.Id))
+	})
+
+	It("Should filter by resource type and media type", func() {
+		textResource := setup.CreateSimpleResource(alice.CollectionID, "plain text", "Resource 3", "Text", []didsetup.SignInput{alice.SignInput})
+
+		byType, err := setup.CollectionResourcesWithRequest(&types.QueryCollectionResourcesRequest{
+			CollectionId: alice.CollectionID,
+			ResourceType: "Text",
+		})
+		Expect(err).To(BeNil())
+		Expect(byType.Resources).To(HaveLen(1))
+		Expect(byType.Resources[0].Id).To(Equal(textResource.Resource.Id))
+
+		byMediaType, err := setup.CollectionResourcesWithRequest(&types.QueryCollectionResourcesRequest{
+			CollectionId: alice.CollectionID,
+			MediaType:    "application/json",
+		})
+		Expect(err).To(BeNil())
+		Expect(byMediaType.Resources).To(HaveLen(3))
+	})
+
+	It("Should filter by created time range", func() {
+		createdAt := setup.SdkCtx.BlockTime().Add(time.Hour)
+		setup.SetBlockTime(createdAt)
+		res2v2 := setup.CreateSimpleResource(alice.CollectionID, SchemaData, "Resource 2", CLSchemaType, []didsetup.SignInput{alice.SignInput})
+
+		after, err := setup.CollectionResourcesWithRequest(&types.QueryCollectionResourcesRequest{
+			CollectionId: alice.CollectionID,
+			CreatedAfter: &createdAt,
+		})
+		Expect(err).To(BeNil())
+		Expect(after.Resources).To(HaveLen(1))
+		Expect(after.Resources[0].Id).To(Equal(res2v2.Resource.Id))
+
+		before, err := setup.CollectionResourcesWithRequest(&types.QueryCollectionResourcesRequest{
+			CollectionId:  alice.CollectionID,
+			CreatedBefore: &createdAt,
+		})
+		Expect(err).To(BeNil())
+		Expect(before.Resources).To(HaveLen(3))
+	})
 })
@@ -0,0 +1,83 @@
+package tests
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/cheqd/cheqd-node/x/resource/tests/setup"
+	"github.com/google/uuid"
+
+	didsetup "github.com/cheqd/cheqd-node/x/did/tests/setup"
+	didtypes "github.com/cheqd/cheqd-node/x/did/types"
+	"github.com/cheqd/cheqd-node/x/resource/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Query DereferenceDidUrl resources", func() {
+	var setup TestSetup
+	var alice didsetup.CreatedDidDocInfo
+	var startTime time.Time
+
+	var v1 *types.MsgCreateResourceResponse
+	var v2 *types.MsgCreateResourceResponse
+
+	BeforeEach(func() {
+		setup = Setup()
+		alice = setup.CreateSimpleDid()
+		startTime = setup.SdkCtx.BlockTime()
+
+		v1 = setup.CreateSimpleResource(alice.CollectionID, SchemaData, "Resource 1", CLSchemaType, []didsetup.SignInput{alice.SignInput})
+		setup.SetBlockTime(startTime.Add(time.Hour))
+		v2 = setup.CreateSimpleResource(alice.CollectionID, SchemaData, "Resource 1", CLSchemaType, []didsetup.SignInput{alice.SignInput})
+	})
+
+	It("Dereferences a resource path", func() {
+		res, err := setup.DereferenceDidUrl(alice.Did + "/resources/" + v1.Resource.Id)
+		Expect(err).To(BeNil())
+		Expect(res.ContentType).To(Equal(v1.Resource.MediaType))
+		Expect(res.ContentStream).To(Equal([]byte(SchemaData)))
+
+		var metadata didtypes.LinkedResourceMetadata
+		Expect(json.Unmarshal([]byte(res.ContentMetadata), &metadata)).To(Succeed())
+		Expect(metadata.ResourceURI).To(Equal(alice.Did + "/resources/" + v1.Resource.Id))
+		Expect(metadata.ResourceID).To(Equal(v1.Resource.Id))
+		Expect(metadata.NextVersionID).To(Equal(v2.Resource.Id))
+	})
+
+	It("Dereferences the latest resource version by name and type", func() {
+		res, err := setup.DereferenceDidUrl(alice.Did + "?resourceName=Resource%201&resourceType=" + CLSchemaType)
+		Expect(err).To(BeNil())
+
+		var metadata didtypes.LinkedResourceMetadata
+		Expect(json.Unmarshal([]byte(res.ContentMetadata), &metadata)).To(Succeed())
+		Expect(metadata.ResourceID).To(Equal(v2.Resource.Id))
+	})
+
+	It("Dereferences the resource version active at the given time", func() {
+		at := startTime.Add(30 * time.Minute).Format(time.RFC3339)
+		res, err := setup.DereferenceDidUrl(alice.Did + "?resourceName=Resource%201&resourceType=" + CLSchemaType + "&resourceVersionTime=" + at)
+		Expect(err).To(BeNil())
+
+		var metadata didtypes.LinkedResourceMetadata
+		Expect(json.Unmarshal([]byte(res.ContentMetadata), &metadata)).To(Succeed())
+		Expect(metadata.ResourceID).To(Equal(v1.Resource.Id))
+	})
+
+	It("Returns error (not found) for an unknown resource", func() {
+		_, err := setup.DereferenceDidUrl(alice.Did + "/resources/" + uuid.NewString())
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("not found"))
+
+		_, err = setup.DereferenceDidUrl(alice.Did + "?resourceName=Unknown&resourceType=" + CLSchemaType)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("not found"))
+	})
+
+	It("Returns error (not found) for resources of an unknown DID", func() {
+		_, err := setup.DereferenceDidUrl(didsetup.GenerateDID(didsetup.Base58_16bytes) + "/resources/" + v1.Resource.Id)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("not found"))
+	})
+})
@@ -0,0 +1,62 @@
+package tests
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	. "github.com/cheqd/cheqd-node/x/resource/tests/setup"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cheqd/cheqd-node/x/resource/keeper"
+	"github.com/cheqd/cheqd-node/x/resource/types"
+)
+
+type staticFeeEstimator struct {
+	res *types.QueryEstimateResourceFeeResponse
+}
+
+func (e staticFeeEstimator) EstimateResourceFee(_ context.Context, _ *types.QueryEstimateResourceFeeRequest) (*types.QueryEstimateResourceFeeResponse, error) {
+	return e.res, nil
+}
+
+var _ = Describe("Query Estimate Resource Fee", func() {
+	var setup TestSetup
+
+	BeforeEach(func() {
+		setup = Setup()
+	})
+
+	It("Returns error (invalid argument) for a nil request", func() {
+		_, err := setup.ResourceQueryServer.EstimateResourceFee(setup.StdCtx, nil)
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+
+	It("Is unimplemented without a fee estimator", func() {
+		_, err := setup.ResourceQueryServer.EstimateResourceFee(setup.StdCtx, &types.QueryEstimateResourceFeeRequest{
+			MediaType: "image/png",
+			Size_:     200 * types.KiB,
+		})
+		Expect(status.Code(err)).To(Equal(codes.Unimplemented))
+	})
+
+	It("Delegates to the fee estimator", func() {
+		expected := &types.QueryEstimateResourceFeeResponse{
+			Fee:            sdk.NewCoin(types.BaseMinimalDenom, sdkmath.NewInt(3e9)),
+			SizeTiered:     true,
+			AcceptedDenoms: []string{types.BaseMinimalDenom},
+		}
+		setup.ResourceKeeper.SetFeeEstimator(staticFeeEstimator{res: expected})
+		queryServer := keeper.NewQueryServer(setup.ResourceKeeper, setup.Keeper)
+
+		res, err := queryServer.EstimateResourceFee(setup.StdCtx, &types.QueryEstimateResourceFeeRequest{
+			MediaType: "image/png",
+			Size_:     200 * types.KiB,
+		})
+		Expect(err).To(BeNil())
+		Expect(res).To(Equal(expected))
+	})
+})
@@ -0,0 +1,124 @@
+package tests
+
+import (
+	"time"
+
+	. "github.com/cheqd/cheqd-node/x/resource/tests/setup"
+	"github.com/google/uuid"
+
+	didsetup "github.com/cheqd/cheqd-node/x/did/tests/setup"
+	didtypes "github.com/cheqd/cheqd-node/x/did/types"
+	"github.com/cheqd/cheqd-node/x/resource/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Query ResolveDid linked resources", func() {
+	var setup TestSetup
+	var alice didsetup.CreatedDidDocInfo
+	var startTime time.Time
+	var updatedVersionID string
+
+	var r1 *types.MsgCreateResourceResponse
+	var r2 *types.MsgCreateResourceResponse
+
+	BeforeEach(func() {
+		setup = Setup()
+		alice = setup.CreateSimpleDid()
+		startTime = setup.SdkCtx.BlockTime()
+
+		r1 = setup.CreateSimpleResource(alice.CollectionID, SchemaData, "Resource 1", CLSchemaType, []didsetup.SignInput{alice.SignInput})
+
+		setup.SetBlockTime(startTime.Add(time.Hour))
+		msg := &didtypes.MsgUpdateDidDocPayload{
+			Id:                 alice.Did,
+			VerificationMethod: alice.Msg.VerificationMethod,
+			Authentication:     alice.Msg.Authentication,
+			VersionId:          uuid.NewString(),
+		}
+		_, err := setup.UpdateDidDoc(msg, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+		updatedVersionID = msg.VersionId
+
+		setup.SetBlockTime(startTime.Add(2 * time.Hour))
+		r2 = setup.CreateSimpleResource(alice.CollectionID, SchemaData, "Resource 2", CLSchemaType, []didsetup.SignInput{alice.SignInput})
+	})
+
+	It("Includes all linked resources for the latest version", func() {
+		_, result, err := setup.ResolveDid(&didtypes.QueryResolveDidRequest{Id: alice.Did})
+		Expect(err).To(BeNil())
+		Expect(result.DidDocumentMetadata.VersionID).To(Equal(updatedVersionID))
+
+		resources := result.DidDocumentMetadata.LinkedResourceMetadata
+		Expect(resources).To(HaveLen(2))
+
+		ids := []string{resources[0].ResourceID, resources[1].ResourceID}
+		Expect(ids).To(ConsistOf(r1.Resource.Id, r2.Resource.Id))
+
+		for _, resource := range resources {
+			Expect(resource.ResourceURI).To(Equal(alice.Did + "/resources/" + resource.ResourceID))
+			Expect(resource.ResourceCollectionID).To(Equal(alice.CollectionID))
+			Expect(resource.ResourceType).To(Equal(CLSchemaType))
+		}
+	})
+
+	It("Includes only the resources created before the next version for a historical version", func() {
+		_, result, err := setup.ResolveDid(&didtypes.QueryResolveDidRequest{Id: alice.Did, VersionId: alice.VersionID})
+		Expect(err).To(BeNil())
+
+		resources := result.DidDocumentMetadata.LinkedResourceMetadata
+		Expect(resources).To(HaveLen(1))
+		Expect(resources[0].ResourceID).To(Equal(r1.Resource.Id))
+		Expect(resources[0].ResourceName).To(Equal("Resource 1"))
+		Expect(resources[0].Checksum).To(Equal(r1.Resource.Checksum))
+	})
+
+	It("Includes only the resources created before the pruned next version for the first version", func() {
+		params := *didtypes.DefaultFeeParams()
+		params.VersionRetentionPeriod = time.Hour
+		Expect(setup.Keeper.SetParams(setup.StdCtx, params)).To(Succeed())
+
+		setup.SetBlockTime(startTime.Add(3 * time.Hour))
+		msg := &didtypes.MsgUpdateDidDocPayload{
+			Id:                 alice.Did,
+			VerificationMethod: alice.Msg.VerificationMethod,
+			Authentication:     alice.Msg.Authentication,
+			VersionId:          uuid.NewString(),
+		}
+		_, err := setup.UpdateDidDoc(msg, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+
+		pruneAt := startTime.Add(5 * time.Hour)
+		setup.SetBlockTime(pruneAt)
+		Expect(setup.Keeper.PruneDidDocVersions(setup.StdCtx, pruneAt)).To(Succeed())
+
+		tombstone, err := setup.QueryTombstone(alice.Did)
+		Expect(err).To(BeNil())
+		Expect(tombstone.Tombstone.PrunedVersions).To(HaveLen(1))
+		Expect(tombstone.Tombstone.PrunedVersions[0].VersionId).To(Equal(updatedVersionID))
+
+		_, result, err := setup.ResolveDid(&didtypes.QueryResolveDidRequest{Id: alice.Did, VersionId: alice.VersionID})
+		Expect(err).To(BeNil())
+		Expect(result.DidDocumentMetadata.NextVersionID).To(Equal(updatedVersionID))
+
+		resources := result.DidDocumentMetadata.LinkedResourceMetadata
+		Expect(resources).To(HaveLen(1))
+		Expect(resources[0].ResourceID).To(Equal(r1.Resource.Id))
+
+		res, err := setup.DereferenceDidUrl(alice.Did + "?versionId=" + alice.VersionID)
+		Expect(err).To(BeNil())
+		Expect(res.ContentStream).NotTo(BeEmpty())
+	})
+
+	It("Includes only the resources created until the version time", func() {
+		at := startTime.Add(90 * time.Minute)
+		_, result, err := setup.ResolveDid(&didtypes.QueryResolveDidRequest{Id: alice.Did, VersionTime: &at})
+		Expect(err).To(BeNil())
+		Expect(result.DidDocumentMetadata.VersionID).To(Equal(updatedVersionID))
+
+		resources := result.DidDocumentMetadata.LinkedResourceMetadata
+		Expect(resources).To(HaveLen(1))
+		Expect(resources[0].ResourceID).To(Equal(r1.Resource.Id))
+	})
+})
@@ -0,0 +1,158 @@
+package tests
+
+import (
+	"time"
+
+	. "github.com/cheqd/cheqd-node/x/resource/tests/setup"
+
+	didsetup "github.com/cheqd/cheqd-node/x/did/tests/setup"
+	"github.com/cheqd/cheqd-node/x/resource/keeper"
+	"github.com/cheqd/cheqd-node/x/resource/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Query ResourceVersions and ResourceAtTime", func() {
+	var setup TestSetup
+	var alice didsetup.CreatedDidDocInfo
+	var startTime time.Time
+
+	var v1 *types.MsgCreateResourceResponse
+	var v2 *types.MsgCreateResourceResponse
+	var v3 *types.MsgCreateResourceResponse
+
+	BeforeEach(func() {
+		setup = Setup()
+		alice = setup.CreateSimpleDid()
+		startTime = setup.SdkCtx.BlockTime()
+
+		v1 = setup.CreateSimpleResource(alice.CollectionID, SchemaData, "Resource 1", CLSchemaType, []didsetup.SignInput{alice.SignInput})
+		setup.SetBlockTime(startTime.Add(time.Hour))
+		v2 = setup.CreateSimpleResource(alice.CollectionID, SchemaData, "Resource 1", CLSchemaType, []didsetup.SignInput{alice.SignInput})
+		setup.SetBlockTime(startTime.Add(2 * time.Hour))
+		v3 = setup.CreateSimpleResource(alice.CollectionID, SchemaData, "Resource 1", CLSchemaType, []didsetup.SignInput{alice.SignInput})
+
+		// Another resource in the same collection shouldn't be a part of the chain
+		_ = setup.CreateSimpleResource(alice.CollectionID, SchemaData, "Resource 2", CLSchemaType, []didsetup.SignInput{alice.SignInput})
+	})
+
+	Describe("ResourceVersions", func() {
+		It("Returns the version chain from the latest to the oldest version", func() {
+			resp, err := setup.QueryResourceVersions(alice.CollectionID, "Resource 1", CLSchemaType, false, nil)
+			Expect(err).To(BeNil())
+			Expect(resp.Resources).To(HaveLen(3))
+			Expect(resp.Resources[0].Metadata.Id).To(Equal(v3.Resource.Id))
+			Expect(resp.Resources[1].Metadata.Id).To(Equal(v2.Resource.Id))
+			Expect(resp.Resources[2].Metadata.Id).To(Equal(v1.Resource.Id))
+			Expect(resp.Resources[0].Resource).To(BeNil())
+			Expect(resp.Pagination.NextKey).To(BeEmpty())
+		})
+
+		It("Returns data if requested", func() {
+			resp, err := setup.QueryResourceVersions(alice.CollectionID, "Resource 1", CLSchemaType, true, nil)
+			Expect(err).To(BeNil())
+			Expect(resp.Resources).To(HaveLen(3))
+			Expect(resp.Resources[0].Resource.Data).To(Equal([]byte(SchemaData)))
+		})
+
+		It("Paginates by key", func() {
+			firstPage, err := setup.QueryResourceVersions(alice.CollectionID, "Resource 1", CLSchemaType, false, &query.PageRequest{Limit: 2, CountTotal: true})
+			Expect(err).To(BeNil())
+			Expect(firstPage.Resources).To(HaveLen(2))
+			Expect(firstPage.Pagination.Total).To(Equal(uint64(3)))
+			Expect(firstPage.Pagination.NextKey).To(Equal([]byte(v1.Resource.Id)))
+
+			secondPage, err := setup.QueryResourceVersions(alice.CollectionID, "Resource 1", CLSchemaType, false, &query.PageRequest{Key: firstPage.Pagination.NextKey, Limit: 2})
+			Expect(err).To(BeNil())
+			Expect(secondPage.Resources).To(HaveLen(1))
+			Expect(secondPage.Resources[0].Metadata.Id).To(Equal(v1.Resource.Id))
+			Expect(secondPage.Pagination.NextKey).To(BeEmpty())
+		})
+
+		It("Paginates by offset in reverse order", func() {
+			resp, err := setup.QueryResourceVersions(alice.CollectionID, "Resource 1", CLSchemaType, false, &query.PageRequest{Offset: 1, Limit: 1, Reverse: true})
+			Expect(err).To(BeNil())
+			Expect(resp.Resources).To(HaveLen(1))
+			Expect(resp.Resources[0].Metadata.Id).To(Equal(v2.Resource.Id))
+			Expect(resp.Pagination.NextKey).To(Equal([]byte(v3.Resource.Id)))
+		})
+
+		It("Fails if pagination key belongs to another resource", func() {
+			other, err := setup.QueryLatestResourceVersion(alice.CollectionID, "Resource 2", CLSchemaType)
+			Expect(err).To(BeNil())
+
+			_, err = setup.QueryResourceVersions(alice.CollectionID, "Resource 1", CLSchemaType, false, &query.PageRequest{Key: []byte(other.Resource.Metadata.Id)})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("doesn't belong to the resource version chain"))
+		})
+
+		It("Returns error (not found) if resource does not exist", func() {
+			_, err := setup.QueryResourceVersions(alice.CollectionID, "Resource 3", CLSchemaType, false, nil)
+			Expect(err.Error()).To(ContainSubstring("not found"))
+		})
+	})
+
+	Describe("ResourceAtTime", func() {
+		It("Returns the version that was the latest at the given time", func() {
+			resp, err := setup.QueryResourceAtTime(alice.CollectionID, "Resource 1", CLSchemaType, startTime.Add(90*time.Minute))
+			Expect(err).To(BeNil())
+			Expect(resp.Resource.Metadata.Id).To(Equal(v2.Resource.Id))
+			Expect(resp.Resource.Resource.Data).To(Equal([]byte(SchemaData)))
+		})
+
+		It("Includes the version created exactly at the given time", func() {
+			resp, err := setup.QueryResourceAtTime(alice.CollectionID, "Resource 1", CLSchemaType, startTime)
+			Expect(err).To(BeNil())
+			Expect(resp.Resource.Metadata.Id).To(Equal(v1.Resource.Id))
+		})
+
+		It("Returns the latest version for a time in the future", func() {
+			resp, err := setup.QueryResourceAtTime(alice.CollectionID, "Resource 1", CLSchemaType, startTime.Add(24*time.Hour))
+			Expect(err).To(BeNil())
+			Expect(resp.Resource.Metadata.Id).To(Equal(v3.Resource.Id))
+		})
+
+		It("Returns error (not found) for a time before the first version", func() {
+			_, err := setup.QueryResourceAtTime(alice.CollectionID, "Resource 1", CLSchemaType, startTime.Add(-time.Second))
+			Expect(err.Error()).To(ContainSubstring("not found"))
+		})
+
+		It("Returns the latest of the versions created in the same block", func() {
+			v4 := setup.CreateSimpleResource(alice.CollectionID, SchemaData, "Resource 1", CLSchemaType, []didsetup.SignInput{alice.SignInput})
+
+			resp, err := setup.QueryResourceAtTime(alice.CollectionID, "Resource 1", CLSchemaType, startTime.Add(2*time.Hour))
+			Expect(err).To(BeNil())
+			Expect(resp.Resource.Metadata.Id).To(Equal(v4.Resource.Id))
+		})
+	})
+
+	Describe("Version time index", func() {
+		It("Starts reverse pages from the first of the versions created in the same block", func() {
+			setup.SetBlockTime(startTime)
+			_ = setup.CreateSimpleResource(alice.CollectionID, SchemaData, "Resource 3", CLSchemaType, []didsetup.SignInput{alice.SignInput})
+			second := setup.CreateSimpleResource(alice.CollectionID, SchemaData, "Resource 3", CLSchemaType, []didsetup.SignInput{alice.SignInput})
+
+			resp, err := setup.QueryResourceVersions(alice.CollectionID, "Resource 3", CLSchemaType, false, &query.PageRequest{Offset: 1, Reverse: true})
+			Expect(err).To(BeNil())
+			Expect(resp.Resources).To(HaveLen(1))
+			Expect(resp.Resources[0].Metadata.Id).To(Equal(second.Resource.Id))
+		})
+
+		It("Is rebuilt by the store migration", func() {
+			Expect(setup.ResourceKeeper.ResourceVersionTimes.Clear(setup.SdkCtx, nil)).To(Succeed())
+
+			migrator := keeper.NewMigrator(setup.ResourceKeeper, nil)
+			Expect(migrator.Migrate5to6(setup.SdkCtx)).To(Succeed())
+
+			resp, err := setup.QueryResourceAtTime(alice.CollectionID, "Resource 1", CLSchemaType, startTime.Add(90*time.Minute))
+			Expect(err).To(BeNil())
+			Expect(resp.Resource.Metadata.Id).To(Equal(v2.Resource.Id))
+
+			versions, err := setup.QueryResourceVersions(alice.CollectionID, "Resource 1", CLSchemaType, false, &query.PageRequest{Limit: 1, Reverse: true})
+			Expect(err).To(BeNil())
+			Expect(versions.Resources[0].Metadata.Id).To(Equal(v1.Resource.Id))
+		})
+	})
+})
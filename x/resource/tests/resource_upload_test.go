@@ -0,0 +1,290 @@
+package tests
+
+import (
+	"bytes"
+	"strings"
+	"time"
+
+	"cosmossdk.io/collections"
+	. "github.com/cheqd/cheqd-node/x/resource/tests/setup"
+
+	didsetup "github.com/cheqd/cheqd-node/x/did/tests/setup"
+	"github.com/cheqd/cheqd-node/x/resource/keeper"
+	"github.com/cheqd/cheqd-node/x/resource/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Chunked Resource Upload", func() {
+	var setup TestSetup
+	var alice didsetup.CreatedDidDocInfo
+	var data []byte
+	var payload types.MsgBeginResourceUploadPayload
+
+	BeforeEach(func() {
+		setup = Setup()
+		alice = setup.CreateSimpleDid()
+
+		err := setup.ResourceKeeper.SetParams(setup.StdCtx, *types.DefaultFeeParams())
+		Expect(err).To(BeNil())
+
+		data = append([]byte(SchemaData), bytes.Repeat([]byte("0123456789"), 100)...)
+		payload = setup.BuildResourceUpload(alice.CollectionID, data, "Resource 1", CLSchemaType)
+	})
+
+	It("Creates the resource from chunks and serves the reassembled data", func() {
+		res, err := setup.BeginResourceUpload(&payload, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+		Expect(res.Upload.Expires).To(Equal(setup.SdkCtx.BlockTime().Add(types.DefaultUploadExpiry)))
+
+		err = setup.UploadResourceChunks(alice.CollectionID, payload.Id, data, 300, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+
+		created, err := setup.FinalizeResourceUpload(&types.MsgFinalizeResourceUploadPayload{
+			CollectionId: alice.CollectionID,
+			Id:           payload.Id,
+		}, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+		Expect(created.Resource.Checksum).To(Equal(payload.Checksum))
+		Expect(created.Resource.MediaType).To(Equal("text/plain; charset=utf-8"))
+
+		resource, err := setup.QueryResource(alice.CollectionID, payload.Id)
+		Expect(err).To(BeNil())
+		Expect(resource.Resource.Resource.Data).To(Equal(data))
+		Expect(resource.Resource.Metadata.Name).To(Equal(payload.Name))
+
+		hasUpload, err := setup.ResourceKeeper.HasResourceUpload(setup.StdCtx, alice.CollectionID, payload.Id)
+		Expect(err).To(BeNil())
+		Expect(hasUpload).To(BeFalse())
+	})
+
+	It("Detects the media type of the reassembled data", func() {
+		data = []byte(`{"items":["` + strings.Repeat("0123456789", 100) + `"]}`)
+		payload = setup.BuildResourceUpload(alice.CollectionID, data, "Resource 1", CLSchemaType)
+
+		_, err := setup.BeginResourceUpload(&payload, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+		err = setup.UploadResourceChunks(alice.CollectionID, payload.Id, data, 300, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+
+		// The first chunk alone is not a valid JSON document
+		created, err := setup.FinalizeResourceUpload(&types.MsgFinalizeResourceUploadPayload{
+			CollectionId: alice.CollectionID,
+			Id:           payload.Id,
+		}, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+		Expect(created.Resource.MediaType).To(Equal("application/json"))
+	})
+
+	It("Serves byte ranges of the data across chunks", func() {
+		_, err := setup.BeginResourceUpload(&payload, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+		err = setup.UploadResourceChunks(alice.CollectionID, payload.Id, data, 300, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+		_, err = setup.FinalizeResourceUpload(&types.MsgFinalizeResourceUploadPayload{
+			CollectionId: alice.CollectionID,
+			Id:           payload.Id,
+		}, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+
+		resource, err := setup.QueryResourceRange(alice.CollectionID, payload.Id, 250, 100)
+		Expect(err).To(BeNil())
+		Expect(resource.Resource.Resource.Data).To(Equal(data[250:350]))
+
+		resource, err = setup.QueryResourceRange(alice.CollectionID, payload.Id, 1000, 500)
+		Expect(err).To(BeNil())
+		Expect(resource.Resource.Resource.Data).To(Equal(data[1000:]))
+
+		resource, err = setup.QueryResourceRange(alice.CollectionID, payload.Id, uint64(len(data)), 0)
+		Expect(err).To(BeNil())
+		Expect(resource.Resource.Resource.Data).To(BeEmpty())
+	})
+
+	It("Serves byte ranges of resources created at once", func() {
+		created := setup.CreateSimpleResource(alice.CollectionID, SchemaData, "Resource 2", CLSchemaType, []didsetup.SignInput{alice.SignInput})
+
+		resource, err := setup.QueryResourceRange(alice.CollectionID, created.Resource.Id, 2, 4)
+		Expect(err).To(BeNil())
+		Expect(resource.Resource.Resource.Data).To(Equal([]byte(SchemaData)[2:6]))
+	})
+
+	It("Fails to finalize if the checksum doesn't match", func() {
+		payload.Checksum = "0000000000000000000000000000000000000000000000000000000000000000"
+		_, err := setup.BeginResourceUpload(&payload, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+		err = setup.UploadResourceChunks(alice.CollectionID, payload.Id, data, 300, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+
+		_, err = setup.FinalizeResourceUpload(&types.MsgFinalizeResourceUploadPayload{
+			CollectionId: alice.CollectionID,
+			Id:           payload.Id,
+		}, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(MatchError(ContainSubstring(types.ErrChecksumMismatch.Error())))
+	})
+
+	It("Fails to finalize before all data is received", func() {
+		_, err := setup.BeginResourceUpload(&payload, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+		err = setup.UploadResourceChunks(alice.CollectionID, payload.Id, data[:300], 300, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+
+		_, err = setup.FinalizeResourceUpload(&types.MsgFinalizeResourceUploadPayload{
+			CollectionId: alice.CollectionID,
+			Id:           payload.Id,
+		}, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(MatchError(ContainSubstring(types.ErrInvalidChunk.Error())))
+	})
+
+	It("Rejects out of order and oversized chunks", func() {
+		_, err := setup.BeginResourceUpload(&payload, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+
+		_, err = setup.AppendResourceChunk(&types.MsgAppendResourceChunkPayload{
+			CollectionId: alice.CollectionID,
+			Id:           payload.Id,
+			Index:        1,
+			Data:         data[:300],
+		}, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(MatchError(ContainSubstring(types.ErrInvalidChunk.Error())))
+
+		_, err = setup.AppendResourceChunk(&types.MsgAppendResourceChunkPayload{
+			CollectionId: alice.CollectionID,
+			Id:           payload.Id,
+			Index:        0,
+			Data:         append(data, 0),
+		}, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(MatchError(ContainSubstring(types.ErrInvalidChunk.Error())))
+	})
+
+	It("Fails without a valid signature of the collection DID controller", func() {
+		bob := setup.CreateSimpleDid()
+
+		_, err := setup.BeginResourceUpload(&payload, []didsetup.SignInput{bob.SignInput})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("signature is required but not found"))
+	})
+
+	It("Fails if the upload is larger than allowed", func() {
+		payload.Size_ = types.DefaultMaxUploadSize + 1
+
+		_, err := setup.BeginResourceUpload(&payload, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(MatchError(ContainSubstring(types.ErrBasicValidation.Error())))
+	})
+
+	It("Fails if an upload of the resource is already in progress", func() {
+		_, err := setup.BeginResourceUpload(&payload, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+
+		_, err = setup.BeginResourceUpload(&payload, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(MatchError(ContainSubstring(types.ErrResourceExists.Error())))
+	})
+
+	It("Rejects chunks of expired uploads and prunes them", func() {
+		_, err := setup.BeginResourceUpload(&payload, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+		err = setup.UploadResourceChunks(alice.CollectionID, payload.Id, data[:300], 300, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+
+		setup.SetBlockTime(setup.SdkCtx.BlockTime().Add(types.DefaultUploadExpiry))
+
+		_, err = setup.AppendResourceChunk(&types.MsgAppendResourceChunkPayload{
+			CollectionId: alice.CollectionID,
+			Id:           payload.Id,
+			Index:        1,
+			Data:         data[300:600],
+		}, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(MatchError(ContainSubstring(types.ErrUploadNotFound.Error())))
+
+		err = setup.ResourceKeeper.PruneExpiredResourceUploads(setup.StdCtx, setup.SdkCtx.BlockTime())
+		Expect(err).To(BeNil())
+
+		hasUpload, err := setup.ResourceKeeper.HasResourceUpload(setup.StdCtx, alice.CollectionID, payload.Id)
+		Expect(err).To(BeNil())
+		Expect(hasUpload).To(BeFalse())
+
+		chunks := 0
+		err = setup.ResourceKeeper.WalkResourceChunks(setup.StdCtx, alice.CollectionID, payload.Id, func(_ uint64, _ []byte) (bool, error) {
+			chunks++
+			return false, nil
+		})
+		Expect(err).To(BeNil())
+		Expect(chunks).To(BeZero())
+
+		// The resource can be uploaded again
+		_, err = setup.BeginResourceUpload(&payload, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+	})
+
+	It("Restarts an upload that expired but is not pruned yet", func() {
+		_, err := setup.BeginResourceUpload(&payload, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+		err = setup.UploadResourceChunks(alice.CollectionID, payload.Id, data[:300], 300, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+
+		setup.SetBlockTime(setup.SdkCtx.BlockTime().Add(types.DefaultUploadExpiry))
+
+		res, err := setup.BeginResourceUpload(&payload, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+		Expect(res.Upload.Received).To(BeZero())
+		Expect(res.Upload.Expires).To(Equal(setup.SdkCtx.BlockTime().Add(types.DefaultUploadExpiry)))
+
+		// Chunks of the expired upload are discarded, the new upload starts from the first chunk
+		err = setup.UploadResourceChunks(alice.CollectionID, payload.Id, data, 300, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+
+		// Pruning the expiry of the discarded upload keeps the new one
+		err = setup.ResourceKeeper.PruneExpiredResourceUploads(setup.StdCtx, setup.SdkCtx.BlockTime())
+		Expect(err).To(BeNil())
+
+		created, err := setup.FinalizeResourceUpload(&types.MsgFinalizeResourceUploadPayload{
+			CollectionId: alice.CollectionID,
+			Id:           payload.Id,
+		}, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+		Expect(created.Resource.Checksum).To(Equal(payload.Checksum))
+
+		resource, err := setup.QueryResource(alice.CollectionID, payload.Id)
+		Expect(err).To(BeNil())
+		Expect(resource.Resource.Resource.Data).To(Equal(data))
+	})
+
+	It("Prunes a limited number of expired uploads per block", func() {
+		for i := 0; i <= keeper.MaxPrunedResourceUploadsPerBlock; i++ {
+			upload := setup.BuildResourceUpload(alice.CollectionID, data, "Resource 1", CLSchemaType)
+			_, err := setup.BeginResourceUpload(&upload, []didsetup.SignInput{alice.SignInput})
+			Expect(err).To(BeNil())
+		}
+
+		expired := setup.SdkCtx.BlockTime().Add(types.DefaultUploadExpiry)
+		err := setup.ResourceKeeper.PruneExpiredResourceUploads(setup.StdCtx, expired)
+		Expect(err).To(BeNil())
+
+		countUploads := func() int {
+			uploads := 0
+			err := setup.ResourceKeeper.ResourceUploads.Walk(setup.StdCtx, nil, func(_ collections.Pair[string, string], _ types.ResourceUpload) (bool, error) {
+				uploads++
+				return false, nil
+			})
+			Expect(err).To(BeNil())
+			return uploads
+		}
+		Expect(countUploads()).To(Equal(1))
+
+		err = setup.ResourceKeeper.PruneExpiredResourceUploads(setup.StdCtx, expired)
+		Expect(err).To(BeNil())
+		Expect(countUploads()).To(BeZero())
+	})
+
+	It("Keeps uploads that have not expired yet", func() {
+		_, err := setup.BeginResourceUpload(&payload, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+
+		err = setup.ResourceKeeper.PruneExpiredResourceUploads(setup.StdCtx, setup.SdkCtx.BlockTime().Add(time.Hour))
+		Expect(err).To(BeNil())
+
+		hasUpload, err := setup.ResourceKeeper.HasResourceUpload(setup.StdCtx, alice.CollectionID, payload.Id)
+		Expect(err).To(BeNil())
+		Expect(hasUpload).To(BeTrue())
+	})
+})
@@ -159,6 +159,7 @@ func Setup() TestSetup {
 		getSubspace(types.ModuleName, paramsKeeper),
 		&portKeeper,
 		scopedResourceKeeper, authority, OracleKeeper)
+	didKeeper.SetLinkedResourcesProvider(*resourceKeeper)
 
 	ibcModule := resource.NewIBCModule(*resourceKeeper)
 
@@ -204,6 +205,10 @@ func Setup() TestSetup {
 	if err != nil {
 		panic(err)
 	}
+	err = setup.Keeper.SetParams(ctx, *didtypes.DefaultFeeParams())
+	if err != nil {
+		panic(err)
+	}
 
 	return setup
 }
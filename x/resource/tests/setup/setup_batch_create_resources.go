@@ -0,0 +1,15 @@
+package setup
+
+import (
+	"github.com/cheqd/cheqd-node/x/did/tests/setup"
+	"github.com/cheqd/cheqd-node/x/resource/types"
+)
+
+func (s *TestSetup) BatchCreateResources(payload *types.MsgBatchCreateResourcesPayload, signInputs []setup.SignInput) (*types.MsgBatchCreateResourcesResponse, error) {
+	msg := &types.MsgBatchCreateResources{
+		Payload:    payload,
+		Signatures: signResourcePayload(payload.GetSignBytes(), signInputs),
+	}
+
+	return s.ResourceMsgServer.BatchCreateResources(s.StdCtx, msg)
+}
@@ -7,5 +7,9 @@ func (s *TestSetup) CollectionResources(collectionID string) (*types.QueryCollec
 		CollectionId: collectionID,
 	}
 
+	return s.CollectionResourcesWithRequest(req)
+}
+
+func (s *TestSetup) CollectionResourcesWithRequest(req *types.QueryCollectionResourcesRequest) (*types.QueryCollectionResourcesResponse, error) {
 	return s.ResourceQueryServer.CollectionResources(s.StdCtx, req)
 }
@@ -10,3 +10,14 @@ func (s *TestSetup) QueryResource(collectionID, resourceID string) (*types.Query
 
 	return s.ResourceQueryServer.Resource(s.StdCtx, req)
 }
+
+func (s *TestSetup) QueryResourceRange(collectionID, resourceID string, offset, length uint64) (*types.QueryResourceResponse, error) {
+	req := &types.QueryResourceRequest{
+		CollectionId: collectionID,
+		Id:           resourceID,
+		Offset:       offset,
+		Length:       length,
+	}
+
+	return s.ResourceQueryServer.Resource(s.StdCtx, req)
+}
@@ -0,0 +1,31 @@
+package setup
+
+import (
+	"time"
+
+	"github.com/cheqd/cheqd-node/x/resource/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func (s *TestSetup) QueryResourceVersions(collectionID, name, resourceType string, includeData bool, pagination *query.PageRequest) (*types.QueryResourceVersionsResponse, error) {
+	req := &types.QueryResourceVersionsRequest{
+		CollectionId: collectionID,
+		Name:         name,
+		ResourceType: resourceType,
+		IncludeData:  includeData,
+		Pagination:   pagination,
+	}
+
+	return s.ResourceQueryServer.ResourceVersions(s.StdCtx, req)
+}
+
+func (s *TestSetup) QueryResourceAtTime(collectionID, name, resourceType string, at time.Time) (*types.QueryResourceAtTimeResponse, error) {
+	req := &types.QueryResourceAtTimeRequest{
+		CollectionId: collectionID,
+		Name:         name,
+		ResourceType: resourceType,
+		Time:         at,
+	}
+
+	return s.ResourceQueryServer.ResourceAtTime(s.StdCtx, req)
+}
@@ -0,0 +1,67 @@
+package setup
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/cheqd/cheqd-node/x/did/tests/setup"
+	"github.com/cheqd/cheqd-node/x/resource/types"
+	"github.com/google/uuid"
+)
+
+func (s *TestSetup) BuildResourceUpload(collectionID string, data []byte, name, _type string) types.MsgBeginResourceUploadPayload {
+	checksum := sha256.Sum256(data)
+
+	return types.MsgBeginResourceUploadPayload{
+		CollectionId: collectionID,
+		Id:           uuid.NewString(),
+		Name:         name,
+		ResourceType: _type,
+		Size_:        uint64(len(data)),
+		Checksum:     hex.EncodeToString(checksum[:]),
+	}
+}
+
+func (s *TestSetup) BeginResourceUpload(payload *types.MsgBeginResourceUploadPayload, signInputs []setup.SignInput) (*types.MsgBeginResourceUploadResponse, error) {
+	msg := &types.MsgBeginResourceUpload{
+		Payload:    payload,
+		Signatures: signResourcePayload(payload.GetSignBytes(), signInputs),
+	}
+
+	return s.ResourceMsgServer.BeginResourceUpload(s.StdCtx, msg)
+}
+
+func (s *TestSetup) AppendResourceChunk(payload *types.MsgAppendResourceChunkPayload, signInputs []setup.SignInput) (*types.MsgAppendResourceChunkResponse, error) {
+	msg := &types.MsgAppendResourceChunk{
+		Payload:    payload,
+		Signatures: signResourcePayload(payload.GetSignBytes(), signInputs),
+	}
+
+	return s.ResourceMsgServer.AppendResourceChunk(s.StdCtx, msg)
+}
+
+func (s *TestSetup) FinalizeResourceUpload(payload *types.MsgFinalizeResourceUploadPayload, signInputs []setup.SignInput) (*types.MsgFinalizeResourceUploadResponse, error) {
+	msg := &types.MsgFinalizeResourceUpload{
+		Payload:    payload,
+		Signatures: signResourcePayload(payload.GetSignBytes(), signInputs),
+	}
+
+	return s.ResourceMsgServer.FinalizeResourceUpload(s.StdCtx, msg)
+}
+
+// UploadResourceChunks appends the data in chunks of the given size to a pending upload
+func (s *TestSetup) UploadResourceChunks(collectionID, id string, data []byte, chunkSize int, signInputs []setup.SignInput) error {
+	for index := 0; index*chunkSize < len(data); index++ {
+		_, err := s.AppendResourceChunk(&types.MsgAppendResourceChunkPayload{
+			CollectionId: collectionID,
+			Id:           id,
+			Index:        uint64(index),
+			Data:         data[index*chunkSize : min((index+1)*chunkSize, len(data))],
+		}, signInputs)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package setup
+
+import (
+	"github.com/cheqd/cheqd-node/x/did/tests/setup"
+	didtypes "github.com/cheqd/cheqd-node/x/did/types"
+	"github.com/cheqd/cheqd-node/x/resource/types"
+)
+
+func (s *TestSetup) UpdateResourceMetadata(payload *types.MsgUpdateResourceMetadataPayload, signInputs []setup.SignInput) (*types.MsgUpdateResourceMetadataResponse, error) {
+	msg := &types.MsgUpdateResourceMetadata{
+		Payload:    payload,
+		Signatures: signResourcePayload(payload.GetSignBytes(), signInputs),
+	}
+
+	return s.ResourceMsgServer.UpdateResourceMetadata(s.StdCtx, msg)
+}
+
+func (s *TestSetup) DeprecateResource(payload *types.MsgDeprecateResourcePayload, signInputs []setup.SignInput) (*types.MsgDeprecateResourceResponse, error) {
+	msg := &types.MsgDeprecateResource{
+		Payload:    payload,
+		Signatures: signResourcePayload(payload.GetSignBytes(), signInputs),
+	}
+
+	return s.ResourceMsgServer.DeprecateResource(s.StdCtx, msg)
+}
+
+func signResourcePayload(signBytes []byte, signInputs []setup.SignInput) []*didtypes.SignInfo {
+	signatures := make([]*didtypes.SignInfo, 0, len(signInputs))
+
+	for _, input := range signInputs {
+		signatures = append(signatures, &didtypes.SignInfo{
+			VerificationMethodId: input.VerificationMethodID,
+			Signature:            input.Sign(signBytes),
+		})
+	}
+
+	return signatures
+}
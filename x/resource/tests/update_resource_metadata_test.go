@@ -0,0 +1,150 @@
+package tests
+
+import (
+	. "github.com/cheqd/cheqd-node/x/resource/tests/setup"
+	"github.com/google/uuid"
+
+	didsetup "github.com/cheqd/cheqd-node/x/did/tests/setup"
+	didtypes "github.com/cheqd/cheqd-node/x/did/types"
+	"github.com/cheqd/cheqd-node/x/resource/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Update Resource Metadata and Deprecate Resource", func() {
+	var setup TestSetup
+	var alice didsetup.CreatedDidDocInfo
+	var resource *types.MsgCreateResourceResponse
+	var defaultAlternativeURI *types.AlternativeUri
+
+	BeforeEach(func() {
+		setup = Setup()
+		alice = setup.CreateSimpleDid()
+		resource = setup.CreateSimpleResource(alice.CollectionID, SchemaData, "Resource 1", CLSchemaType, []didsetup.SignInput{alice.SignInput})
+		defaultAlternativeURI = resource.Resource.AlsoKnownAs[0]
+	})
+
+	Describe("UpdateResourceMetadata", func() {
+		It("Replaces alternative URIs and keeps the default one", func() {
+			alternativeURI := &types.AlternativeUri{Uri: "https://example.com/alternative-uri", Description: "http-url"}
+			res, err := setup.UpdateResourceMetadata(&types.MsgUpdateResourceMetadataPayload{
+				CollectionId: alice.CollectionID,
+				Id:           resource.Resource.Id,
+				AlsoKnownAs:  []*types.AlternativeUri{alternativeURI},
+			}, []didsetup.SignInput{alice.SignInput})
+			Expect(err).To(BeNil())
+			Expect(res.Resource.AlsoKnownAs).To(Equal([]*types.AlternativeUri{alternativeURI, defaultAlternativeURI}))
+
+			metadata, err := setup.QueryResourceMetadata(alice.CollectionID, resource.Resource.Id)
+			Expect(err).To(BeNil())
+			Expect(metadata.Resource.AlsoKnownAs).To(Equal([]*types.AlternativeUri{alternativeURI, defaultAlternativeURI}))
+			Expect(metadata.Resource.Checksum).To(Equal(resource.Resource.Checksum))
+			Expect(metadata.Resource.Created).To(Equal(resource.Resource.Created))
+
+			event, err := setup.LastTypedEvent(&types.EventResourceMetadataUpdated{})
+			Expect(err).To(BeNil())
+			Expect(event).To(Equal(&types.EventResourceMetadataUpdated{
+				CollectionId: alice.CollectionID,
+				Id:           resource.Resource.Id,
+			}))
+		})
+
+		It("Fails without a valid signature of the collection DID controller", func() {
+			bob := setup.CreateSimpleDid()
+
+			_, err := setup.UpdateResourceMetadata(&types.MsgUpdateResourceMetadataPayload{
+				CollectionId: alice.CollectionID,
+				Id:           resource.Resource.Id,
+			}, []didsetup.SignInput{bob.SignInput})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("signature is required but not found"))
+		})
+
+		It("Fails for an unknown resource", func() {
+			_, err := setup.UpdateResourceMetadata(&types.MsgUpdateResourceMetadataPayload{
+				CollectionId: alice.CollectionID,
+				Id:           uuid.NewString(),
+			}, []didsetup.SignInput{alice.SignInput})
+			Expect(err).To(HaveOccurred())
+			Expect(err).To(MatchError(ContainSubstring(types.ErrResourceNotFound.Error())))
+		})
+
+		It("Fails for a deprecated resource", func() {
+			_, err := setup.DeprecateResource(&types.MsgDeprecateResourcePayload{
+				CollectionId: alice.CollectionID,
+				Id:           resource.Resource.Id,
+			}, []didsetup.SignInput{alice.SignInput})
+			Expect(err).To(BeNil())
+
+			_, err = setup.UpdateResourceMetadata(&types.MsgUpdateResourceMetadataPayload{
+				CollectionId: alice.CollectionID,
+				Id:           resource.Resource.Id,
+			}, []didsetup.SignInput{alice.SignInput})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(types.ErrDeprecated.Error()))
+		})
+	})
+
+	Describe("DeprecateResource", func() {
+		It("Marks the resource as deprecated", func() {
+			res, err := setup.DeprecateResource(&types.MsgDeprecateResourcePayload{
+				CollectionId: alice.CollectionID,
+				Id:           resource.Resource.Id,
+			}, []didsetup.SignInput{alice.SignInput})
+			Expect(err).To(BeNil())
+			Expect(res.Resource.Deprecated).To(BeTrue())
+
+			metadata, err := setup.QueryResourceMetadata(alice.CollectionID, resource.Resource.Id)
+			Expect(err).To(BeNil())
+			Expect(metadata.Resource.Deprecated).To(BeTrue())
+
+			event, err := setup.LastTypedEvent(&types.EventResourceDeprecated{})
+			Expect(err).To(BeNil())
+			Expect(event).To(Equal(&types.EventResourceDeprecated{
+				CollectionId: alice.CollectionID,
+				Id:           resource.Resource.Id,
+			}))
+		})
+
+		It("Cannot deprecate a resource twice", func() {
+			payload := &types.MsgDeprecateResourcePayload{
+				CollectionId: alice.CollectionID,
+				Id:           resource.Resource.Id,
+			}
+			_, err := setup.DeprecateResource(payload, []didsetup.SignInput{alice.SignInput})
+			Expect(err).To(BeNil())
+
+			_, err = setup.DeprecateResource(payload, []didsetup.SignInput{alice.SignInput})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(types.ErrDeprecated.Error()))
+		})
+
+		It("Allows creating a new version of a deprecated resource", func() {
+			_, err := setup.DeprecateResource(&types.MsgDeprecateResourcePayload{
+				CollectionId: alice.CollectionID,
+				Id:           resource.Resource.Id,
+			}, []didsetup.SignInput{alice.SignInput})
+			Expect(err).To(BeNil())
+
+			next := setup.CreateSimpleResource(alice.CollectionID, SchemaData, "Resource 1", CLSchemaType, []didsetup.SignInput{alice.SignInput})
+			Expect(next.Resource.PreviousVersionId).To(Equal(resource.Resource.Id))
+			Expect(next.Resource.Deprecated).To(BeFalse())
+		})
+
+		It("Fails for a deactivated collection DID", func() {
+			_, err := setup.DeactivateDidDoc(&didtypes.MsgDeactivateDidDocPayload{
+				Id:        alice.Did,
+				VersionId: uuid.NewString(),
+			}, []didsetup.SignInput{alice.SignInput})
+			Expect(err).To(BeNil())
+
+			_, err = setup.DeprecateResource(&types.MsgDeprecateResourcePayload{
+				CollectionId: alice.CollectionID,
+				Id:           resource.Resource.Id,
+			}, []didsetup.SignInput{alice.SignInput})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(didtypes.ErrDIDDocDeactivated.Error()))
+		})
+	})
+})
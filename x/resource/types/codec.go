@@ -10,6 +10,12 @@ import (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	// Sdk messages
 	cdc.RegisterConcrete(&MsgCreateResource{}, "resource/CreateResource", nil)
+	cdc.RegisterConcrete(&MsgUpdateResourceMetadata{}, "resource/UpdateResourceMetadata", nil)
+	cdc.RegisterConcrete(&MsgDeprecateResource{}, "resource/DeprecateResource", nil)
+	cdc.RegisterConcrete(&MsgBatchCreateResources{}, "resource/BatchCreateResources", nil)
+	cdc.RegisterConcrete(&MsgBeginResourceUpload{}, "resource/BeginResourceUpload", nil)
+	cdc.RegisterConcrete(&MsgAppendResourceChunk{}, "resource/AppendResourceChunk", nil)
+	cdc.RegisterConcrete(&MsgFinalizeResourceUpload{}, "resource/FinalizeResourceUpload", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "resource/MsgUpdateParams", nil)
 }
 
@@ -17,6 +23,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	// Sdk messages
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreateResource{},
+		&MsgUpdateResourceMetadata{},
+		&MsgDeprecateResource{},
+		&MsgBatchCreateResources{},
+		&MsgBeginResourceUpload{},
+		&MsgAppendResourceChunk{},
+		&MsgFinalizeResourceUpload{},
 		&MsgUpdateParams{},
 	)
 
@@ -15,6 +15,24 @@ func CreateGetSigners(options *signing.Options) func(msg protov2.Message) ([][]b
 		case *resourcev2.MsgCreateResource:
 			return [][]byte{}, nil
 
+		case *resourcev2.MsgUpdateResourceMetadata:
+			return [][]byte{}, nil
+
+		case *resourcev2.MsgDeprecateResource:
+			return [][]byte{}, nil
+
+		case *resourcev2.MsgBatchCreateResources:
+			return [][]byte{}, nil
+
+		case *resourcev2.MsgBeginResourceUpload:
+			return [][]byte{}, nil
+
+		case *resourcev2.MsgAppendResourceChunk:
+			return [][]byte{}, nil
+
+		case *resourcev2.MsgFinalizeResourceUpload:
+			return [][]byte{}, nil
+
 		default:
 			return nil, fmt.Errorf("unsupported message type: %T", msg)
 		}
@@ -10,7 +10,12 @@ import (
 var (
 	ErrBadRequest       = errorsmod.Register(ModuleName, 2000, "bad request")
 	ErrResourceExists   = errorsmod.Register(ModuleName, 2200, "Resource exists")
+	ErrResourceNotFound = errorsmod.Register(ModuleName, 2201, "Resource not found")
+	ErrDeprecated       = errorsmod.Register(ModuleName, 2202, "Resource deprecated")
+	ErrUploadNotFound   = errorsmod.Register(ModuleName, 2203, "Resource upload not found")
+	ErrInvalidChunk     = errorsmod.Register(ModuleName, 2204, "invalid resource chunk")
 	ErrBasicValidation  = errorsmod.Register(ModuleName, 2205, "basic validation failed")
+	ErrChecksumMismatch = errorsmod.Register(ModuleName, 2206, "checksum mismatch")
 	ErrInternal         = errorsmod.Register(ModuleName, 2500, "internal error")
 	ErrInvalidVersion   = errorsmod.Register(ModuleName, 2505, "invalid ibc version")
 	ErrUnexpectedAck    = errorsmod.Register(ModuleName, 2510, "resource module never sends packets")
@@ -3,7 +3,7 @@ package types
 import (
 	context "context"
 
-	sdkmath "cosmossdk.io/math"
+	oracletypes "github.com/cheqd/cheqd-node/x/oracle/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
@@ -34,5 +34,11 @@ type ChannelKeeper interface {
 
 // OracleKeeper defines the expected oracle keeper interface
 type OracleKeeper interface {
-	GetWMA(ctx sdk.Context, symbol, strategy string) (sdkmath.LegacyDec, bool)
+	GetFeeConversionPrice(ctx sdk.Context, symbol string) (oracletypes.ConversionPrice, error)
+	IsPriceHealthy(ctx sdk.Context, symbol string) bool
+}
+
+// FeeEstimator estimates the fee the ante handler charges for creating resources
+type FeeEstimator interface {
+	EstimateResourceFee(ctx context.Context, req *QueryEstimateResourceFeeRequest) (*QueryEstimateResourceFeeResponse, error)
 }
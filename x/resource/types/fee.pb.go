@@ -11,15 +11,19 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	_ "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -42,10 +46,58 @@ type FeeParams struct {
 	//
 	// Default: 5 CHEQ or 5000000000ncheq
 	Default []types.FeeRange `protobuf:"bytes,3,rep,name=default,proto3" json:"default"`
+	// Fixed fee for updating the metadata of a resource
+	//
+	// Default: 0.1 USD
+	UpdateResourceMetadata []types.FeeRange `protobuf:"bytes,5,rep,name=update_resource_metadata,json=updateResourceMetadata,proto3" json:"update_resource_metadata"`
+	// Fixed fee for deprecating a resource
+	//
+	// Default: 0.1 USD
+	DeprecateResource []types.FeeRange `protobuf:"bytes,6,rep,name=deprecate_resource,json=deprecateResource,proto3" json:"deprecate_resource"`
 	// Percentage of the fixed fee that will be burned
 	//
 	// Default: 0.5 (50%)
 	BurnFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=burn_factor,json=burnFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"burn_factor"`
+	// Maximum number of resources created by a single batch message.
+	// Zero disables batch creation.
+	//
+	// Default: 20
+	MaxBatchSize uint32 `protobuf:"varint,7,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
+	// Maximum total size in bytes of the data of resources created by a single batch message
+	//
+	// Default: 4 MiB or 4194304 bytes
+	MaxBatchDataSize uint64 `protobuf:"varint,8,opt,name=max_batch_data_size,json=maxBatchDataSize,proto3" json:"max_batch_data_size,omitempty"`
+	// Fee per started MiB of resource data uploaded in chunks, charged on Msg/BeginResourceUpload
+	//
+	// Default: 0.2 USD
+	UploadPerMib []types.FeeRange `protobuf:"bytes,9,rep,name=upload_per_mib,json=uploadPerMib,proto3" json:"upload_per_mib"`
+	// Maximum total size in bytes of the data of a resource uploaded in chunks
+	//
+	// Default: 50 MiB or 52428800 bytes
+	MaxUploadSize uint64 `protobuf:"varint,10,opt,name=max_upload_size,json=maxUploadSize,proto3" json:"max_upload_size,omitempty"`
+	// Maximum size in bytes of a single chunk of a resource upload
+	//
+	// Default: 1 MiB or 1048576 bytes
+	MaxChunkSize uint64 `protobuf:"varint,11,opt,name=max_chunk_size,json=maxChunkSize,proto3" json:"max_chunk_size,omitempty"`
+	// Time after which an upload that has not been finalized expires and is pruned
+	//
+	// Default: 24h
+	UploadExpiry time.Duration `protobuf:"bytes,12,opt,name=upload_expiry,json=uploadExpiry,proto3,stdduration" json:"upload_expiry"`
+	// Optional size-tiered fee for creating a resource with media type 'image/*'.
+	// If set, it replaces the fixed 'image' fee.
+	//
+	// Default: not set
+	ImageSizePricing *SizePricing `protobuf:"bytes,13,opt,name=image_size_pricing,json=imageSizePricing,proto3" json:"image_size_pricing,omitempty"`
+	// Optional size-tiered fee for creating a resource with media type 'application/json'.
+	// If set, it replaces the fixed 'json' fee.
+	//
+	// Default: not set
+	JsonSizePricing *SizePricing `protobuf:"bytes,14,opt,name=json_size_pricing,json=jsonSizePricing,proto3" json:"json_size_pricing,omitempty"`
+	// Optional size-tiered fee for creating a resource with all other media types.
+	// If set, it replaces the fixed 'default' fee.
+	//
+	// Default: not set
+	DefaultSizePricing *SizePricing `protobuf:"bytes,15,opt,name=default_size_pricing,json=defaultSizePricing,proto3" json:"default_size_pricing,omitempty"`
 }
 
 func (m *FeeParams) Reset()         { *m = FeeParams{} }
@@ -102,36 +154,190 @@ func (m *FeeParams) GetDefault() []types.FeeRange {
 	return nil
 }
 
+func (m *FeeParams) GetUpdateResourceMetadata() []types.FeeRange {
+	if m != nil {
+		return m.UpdateResourceMetadata
+	}
+	return nil
+}
+
+func (m *FeeParams) GetDeprecateResource() []types.FeeRange {
+	if m != nil {
+		return m.DeprecateResource
+	}
+	return nil
+}
+
+func (m *FeeParams) GetMaxBatchSize() uint32 {
+	if m != nil {
+		return m.MaxBatchSize
+	}
+	return 0
+}
+
+func (m *FeeParams) GetMaxBatchDataSize() uint64 {
+	if m != nil {
+		return m.MaxBatchDataSize
+	}
+	return 0
+}
+
+func (m *FeeParams) GetUploadPerMib() []types.FeeRange {
+	if m != nil {
+		return m.UploadPerMib
+	}
+	return nil
+}
+
+func (m *FeeParams) GetMaxUploadSize() uint64 {
+	if m != nil {
+		return m.MaxUploadSize
+	}
+	return 0
+}
+
+func (m *FeeParams) GetMaxChunkSize() uint64 {
+	if m != nil {
+		return m.MaxChunkSize
+	}
+	return 0
+}
+
+func (m *FeeParams) GetUploadExpiry() time.Duration {
+	if m != nil {
+		return m.UploadExpiry
+	}
+	return 0
+}
+
+func (m *FeeParams) GetImageSizePricing() *SizePricing {
+	if m != nil {
+		return m.ImageSizePricing
+	}
+	return nil
+}
+
+func (m *FeeParams) GetJsonSizePricing() *SizePricing {
+	if m != nil {
+		return m.JsonSizePricing
+	}
+	return nil
+}
+
+func (m *FeeParams) GetDefaultSizePricing() *SizePricing {
+	if m != nil {
+		return m.DefaultSizePricing
+	}
+	return nil
+}
+
+// SizePricing defines a size-tiered fee for creating a resource.
+// The fee is the base fee plus the fee per KiB for every started KiB of resource data.
+type SizePricing struct {
+	// Base fee charged for every resource regardless of its size
+	Base []types.FeeRange `protobuf:"bytes,1,rep,name=base,proto3" json:"base"`
+	// Fee charged per started KiB of resource data.
+	// Every denom of the base fee must have a fee per KiB.
+	PerKib []types.FeeRange `protobuf:"bytes,2,rep,name=per_kib,json=perKib,proto3" json:"per_kib"`
+}
+
+func (m *SizePricing) Reset()         { *m = SizePricing{} }
+func (m *SizePricing) String() string { return proto.CompactTextString(m) }
+func (*SizePricing) ProtoMessage()    {}
+func (*SizePricing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_133abe56c2e24f1e, []int{1}
+}
+func (m *SizePricing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SizePricing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SizePricing.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SizePricing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SizePricing.Merge(m, src)
+}
+func (m *SizePricing) XXX_Size() int {
+	return m.Size()
+}
+func (m *SizePricing) XXX_DiscardUnknown() {
+	xxx_messageInfo_SizePricing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SizePricing proto.InternalMessageInfo
+
+func (m *SizePricing) GetBase() []types.FeeRange {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *SizePricing) GetPerKib() []types.FeeRange {
+	if m != nil {
+		return m.PerKib
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*FeeParams)(nil), "cheqd.resource.v2.FeeParams")
+	proto.RegisterType((*SizePricing)(nil), "cheqd.resource.v2.SizePricing")
 }
 
 func init() { proto.RegisterFile("cheqd/resource/v2/fee.proto", fileDescriptor_133abe56c2e24f1e) }
 
 var fileDescriptor_133abe56c2e24f1e = []byte{
-	// 341 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xb1, 0x4e, 0x02, 0x31,
-	0x18, 0xc7, 0xef, 0x00, 0x35, 0x94, 0x89, 0x8b, 0x21, 0x27, 0x24, 0x85, 0x38, 0x11, 0x23, 0xad,
-	0x39, 0x13, 0x1e, 0x80, 0x10, 0x06, 0xe3, 0x60, 0x58, 0x4c, 0x5c, 0x48, 0x69, 0x3f, 0x8e, 0x53,
-	0xef, 0x8a, 0xd7, 0x3b, 0x22, 0xab, 0x4f, 0xe0, 0x63, 0x30, 0x3a, 0xf8, 0x10, 0x8c, 0xc4, 0xc9,
-	0x38, 0x10, 0x73, 0x0c, 0xbe, 0x86, 0xa1, 0x85, 0x18, 0x37, 0x96, 0x2f, 0xfd, 0xbe, 0xef, 0xf7,
-	0xff, 0xa7, 0xfd, 0x17, 0xd5, 0xf8, 0x18, 0x9e, 0x04, 0x8d, 0x41, 0xc9, 0x34, 0xe6, 0x40, 0xa7,
-	0x1e, 0x1d, 0x01, 0x90, 0x49, 0x2c, 0x13, 0xe9, 0x94, 0xf5, 0x92, 0xec, 0x96, 0x64, 0xea, 0x55,
-	0xcb, 0x2c, 0x0c, 0x22, 0x49, 0x75, 0x35, 0x54, 0xb5, 0x62, 0x2c, 0x44, 0x20, 0xfe, 0xa9, 0xab,
-	0x27, 0x5c, 0xaa, 0x50, 0xaa, 0x81, 0xee, 0xa8, 0x69, 0xb6, 0xab, 0x63, 0x5f, 0xfa, 0xd2, 0xcc,
-	0x37, 0x27, 0x33, 0x3d, 0x7d, 0xc9, 0xa1, 0x62, 0x0f, 0xe0, 0x86, 0xc5, 0x2c, 0x54, 0x8e, 0x87,
-	0x0e, 0x82, 0x90, 0xf9, 0xe0, 0xda, 0x8d, 0x7c, 0xb3, 0xe4, 0x55, 0x88, 0xb9, 0x8c, 0x08, 0x04,
-	0x99, 0x7a, 0xa4, 0x07, 0xd0, 0x67, 0x91, 0x0f, 0x9d, 0xc2, 0x62, 0x55, 0xb7, 0xfa, 0x06, 0x75,
-	0x2e, 0x50, 0xe1, 0x5e, 0xc9, 0xc8, 0xcd, 0xed, 0x21, 0xd1, 0xa4, 0xd3, 0x46, 0x47, 0x02, 0x46,
-	0x2c, 0x7d, 0x4c, 0xdc, 0xfc, 0x1e, 0xa2, 0x1d, 0xec, 0xdc, 0xa2, 0xd2, 0x30, 0x8d, 0xa3, 0xc1,
-	0x88, 0xf1, 0x44, 0xc6, 0x6e, 0xa1, 0x61, 0x37, 0x8b, 0x9d, 0xf6, 0x86, 0xf9, 0x5a, 0xd5, 0x6b,
-	0xe6, 0xb1, 0x4a, 0x3c, 0x90, 0x40, 0xd2, 0x90, 0x25, 0x63, 0x72, 0x0d, 0x3e, 0xe3, 0xb3, 0x2e,
-	0xf0, 0x8f, 0xf7, 0x16, 0xda, 0x66, 0xd1, 0x05, 0x3e, 0xff, 0x79, 0x3b, 0xb3, 0xfb, 0x68, 0x63,
-	0xd5, 0xd3, 0x4e, 0x9d, 0xab, 0x79, 0x86, 0xed, 0x45, 0x86, 0xed, 0x65, 0x86, 0xed, 0xef, 0x0c,
-	0xdb, 0xaf, 0x6b, 0x6c, 0x2d, 0xd7, 0xd8, 0xfa, 0x5c, 0x63, 0xeb, 0xee, 0xdc, 0x0f, 0x92, 0x71,
-	0x3a, 0x24, 0x5c, 0x86, 0xd4, 0xc4, 0xae, 0x6b, 0x2b, 0x92, 0x02, 0xe8, 0xf3, 0xdf, 0x37, 0x26,
-	0xb3, 0x09, 0xa8, 0xe1, 0xa1, 0xce, 0xf5, 0xf2, 0x37, 0x00, 0x00, 0xff, 0xff, 0x50, 0x1a, 0xfd,
-	0xc0, 0xe5, 0x01, 0x00, 0x00,
+	// 656 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0x63, 0x9a, 0x36, 0xed, 0xa6, 0x69, 0x9b, 0xa5, 0xaa, 0xdc, 0x56, 0x72, 0xa3, 0x0a,
+	0xa1, 0x08, 0xd1, 0x75, 0x15, 0x44, 0x1f, 0x20, 0x84, 0x0a, 0xf5, 0x43, 0x8a, 0x8c, 0x00, 0x89,
+	0x8b, 0xb5, 0xb6, 0x27, 0xce, 0xd2, 0xda, 0x6b, 0xfc, 0x11, 0xa5, 0x7d, 0x0a, 0x8e, 0x3c, 0x42,
+	0x8f, 0x1c, 0x78, 0x04, 0x0e, 0x3d, 0x56, 0x9c, 0x10, 0x87, 0x82, 0xd2, 0x03, 0xaf, 0x81, 0x76,
+	0xd7, 0xa6, 0x89, 0xb8, 0xf8, 0x62, 0x65, 0x67, 0xfe, 0xff, 0xdf, 0xec, 0x64, 0x67, 0x17, 0x6d,
+	0xbb, 0x43, 0xf8, 0xe8, 0x99, 0x31, 0x24, 0x3c, 0x8b, 0x5d, 0x30, 0x47, 0x1d, 0x73, 0x00, 0x40,
+	0xa2, 0x98, 0xa7, 0x1c, 0x37, 0x65, 0x92, 0x14, 0x49, 0x32, 0xea, 0x6c, 0x35, 0x69, 0xc0, 0x42,
+	0x6e, 0xca, 0xaf, 0x52, 0x6d, 0x6d, 0x28, 0x84, 0xc7, 0xbc, 0x19, 0xf7, 0xd6, 0xa6, 0xcb, 0x93,
+	0x80, 0x27, 0xb6, 0x5c, 0x99, 0x6a, 0x91, 0xa7, 0xd6, 0x7d, 0xee, 0x73, 0x15, 0x17, 0xbf, 0xf2,
+	0xa8, 0xe1, 0x73, 0xee, 0x9f, 0x83, 0x29, 0x57, 0x4e, 0x36, 0x30, 0xbd, 0x2c, 0xa6, 0x29, 0xe3,
+	0xa1, 0xca, 0xef, 0x7e, 0xab, 0xa1, 0xa5, 0x43, 0x80, 0x3e, 0x8d, 0x69, 0x90, 0xe0, 0x0e, 0x9a,
+	0x67, 0x01, 0xf5, 0x41, 0xd7, 0x5a, 0x73, 0xed, 0x7a, 0x67, 0x83, 0xa8, 0xcd, 0x7a, 0xcc, 0x23,
+	0xa3, 0x0e, 0x39, 0x04, 0xb0, 0x68, 0xe8, 0x43, 0xb7, 0x7a, 0x7d, 0xbb, 0x53, 0xb1, 0x94, 0x14,
+	0xef, 0xa3, 0xea, 0x87, 0x84, 0x87, 0xfa, 0x83, 0x12, 0x16, 0xa9, 0xc4, 0x07, 0xa8, 0xe6, 0xc1,
+	0x80, 0x66, 0xe7, 0xa9, 0x3e, 0x57, 0xc2, 0x54, 0x88, 0xf1, 0x5b, 0xa4, 0x67, 0x91, 0x47, 0x53,
+	0xb0, 0x8b, 0x7f, 0xcf, 0x0e, 0x20, 0xa5, 0x1e, 0x4d, 0xa9, 0x3e, 0x5f, 0x02, 0xb4, 0xa1, 0xdc,
+	0x56, 0x6e, 0x3e, 0xcd, 0xbd, 0xf8, 0x18, 0x61, 0x0f, 0xa2, 0x18, 0xdc, 0x69, 0xb4, 0xbe, 0x50,
+	0x82, 0xd8, 0xfc, 0xe7, 0x2b, 0xa0, 0xf8, 0x1d, 0xaa, 0x3b, 0x59, 0x1c, 0xda, 0x03, 0xea, 0xa6,
+	0x3c, 0xd6, 0xab, 0x2d, 0xad, 0xbd, 0xd4, 0x3d, 0x10, 0xea, 0x9f, 0xb7, 0x3b, 0xdb, 0xea, 0xc4,
+	0x12, 0xef, 0x8c, 0x30, 0x6e, 0x06, 0x34, 0x1d, 0x92, 0x13, 0xf0, 0xa9, 0x7b, 0xd1, 0x03, 0xf7,
+	0xfb, 0xd7, 0x3d, 0x94, 0x1f, 0x68, 0x0f, 0xdc, 0xab, 0x3f, 0x5f, 0x9e, 0x68, 0x16, 0x12, 0xa8,
+	0x43, 0x49, 0xc2, 0x8f, 0xd0, 0x4a, 0x40, 0xc7, 0xb6, 0x43, 0x53, 0x77, 0x68, 0x27, 0xec, 0x12,
+	0xf4, 0x5a, 0x4b, 0x6b, 0x37, 0xac, 0xe5, 0x80, 0x8e, 0xbb, 0x22, 0xf8, 0x9a, 0x5d, 0x02, 0xde,
+	0x43, 0x0f, 0xef, 0x55, 0xa2, 0x3b, 0x25, 0x5d, 0x6c, 0x69, 0xed, 0xaa, 0xb5, 0x56, 0x48, 0x7b,
+	0x34, 0xa5, 0x52, 0xde, 0x45, 0x2b, 0x59, 0x74, 0xce, 0xa9, 0x67, 0x47, 0x10, 0xdb, 0x01, 0x73,
+	0xf4, 0xa5, 0x12, 0x6d, 0x2f, 0x2b, 0x4f, 0x1f, 0xe2, 0x53, 0xe6, 0xe0, 0xc7, 0x68, 0x55, 0x94,
+	0xcc, 0x39, 0xb2, 0x1c, 0x92, 0xe5, 0x1a, 0x01, 0x1d, 0xbf, 0x91, 0x51, 0x59, 0x2b, 0x6f, 0xc0,
+	0x1d, 0x66, 0xe1, 0x99, 0x92, 0xd5, 0xa5, 0x4c, 0x34, 0xf0, 0x42, 0x04, 0xa5, 0xea, 0x15, 0x6a,
+	0xe4, 0x24, 0x18, 0x47, 0x2c, 0xbe, 0xd0, 0x97, 0x5b, 0x5a, 0xbb, 0xde, 0xd9, 0x24, 0x6a, 0x90,
+	0x49, 0x31, 0xc8, 0xa4, 0x97, 0x0f, 0x72, 0x77, 0x51, 0xec, 0xe9, 0xf3, 0xaf, 0x1d, 0xad, 0xd8,
+	0xd7, 0x4b, 0x69, 0xc4, 0x27, 0x08, 0xcb, 0x09, 0x95, 0xb5, 0xec, 0x28, 0x66, 0x2e, 0x0b, 0x7d,
+	0xbd, 0x21, 0x71, 0x06, 0xf9, 0xef, 0x1a, 0x12, 0x51, 0xbe, 0xaf, 0x54, 0xd6, 0x9a, 0x74, 0x4e,
+	0x45, 0xf0, 0x11, 0x6a, 0x8a, 0xe1, 0x9d, 0x85, 0xad, 0x94, 0x82, 0xad, 0x0a, 0xe3, 0x34, 0xab,
+	0x8f, 0xd6, 0xf3, 0x99, 0x9e, 0xc5, 0xad, 0x96, 0xc2, 0xe1, 0xdc, 0x3b, 0x15, 0xdb, 0x1d, 0xa1,
+	0xfa, 0x74, 0x81, 0x7d, 0x54, 0x75, 0x68, 0x52, 0xee, 0x1a, 0x4b, 0x25, 0x7e, 0x8e, 0x6a, 0x62,
+	0x02, 0xce, 0x98, 0x53, 0xea, 0x22, 0x2f, 0x44, 0x10, 0x1f, 0x33, 0xa7, 0x7b, 0x74, 0x35, 0x31,
+	0xb4, 0xeb, 0x89, 0xa1, 0xdd, 0x4c, 0x0c, 0xed, 0xf7, 0xc4, 0xd0, 0x3e, 0xdd, 0x19, 0x95, 0x9b,
+	0x3b, 0xa3, 0xf2, 0xe3, 0xce, 0xa8, 0xbc, 0x7f, 0xea, 0xb3, 0x74, 0x98, 0x39, 0xc4, 0xe5, 0x81,
+	0xa9, 0x1e, 0x34, 0xf9, 0xdd, 0x0b, 0xb9, 0x07, 0xe6, 0xf8, 0xfe, 0x81, 0x4c, 0x2f, 0x22, 0x48,
+	0x9c, 0x05, 0x79, 0xb4, 0xcf, 0xfe, 0x0e, 0x00, 0x0b, 0x95, 0xfd, 0x50, 0x3f, 0x05, 0x00, 0x00,
 }
 
 func (this *FeeParams) Equal(that interface{}) bool {
@@ -177,9 +383,94 @@ func (this *FeeParams) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.UpdateResourceMetadata) != len(that1.UpdateResourceMetadata) {
+		return false
+	}
+	for i := range this.UpdateResourceMetadata {
+		if !this.UpdateResourceMetadata[i].Equal(&that1.UpdateResourceMetadata[i]) {
+			return false
+		}
+	}
+	if len(this.DeprecateResource) != len(that1.DeprecateResource) {
+		return false
+	}
+	for i := range this.DeprecateResource {
+		if !this.DeprecateResource[i].Equal(&that1.DeprecateResource[i]) {
+			return false
+		}
+	}
 	if !this.BurnFactor.Equal(that1.BurnFactor) {
 		return false
 	}
+	if this.MaxBatchSize != that1.MaxBatchSize {
+		return false
+	}
+	if this.MaxBatchDataSize != that1.MaxBatchDataSize {
+		return false
+	}
+	if len(this.UploadPerMib) != len(that1.UploadPerMib) {
+		return false
+	}
+	for i := range this.UploadPerMib {
+		if !this.UploadPerMib[i].Equal(&that1.UploadPerMib[i]) {
+			return false
+		}
+	}
+	if this.MaxUploadSize != that1.MaxUploadSize {
+		return false
+	}
+	if this.MaxChunkSize != that1.MaxChunkSize {
+		return false
+	}
+	if this.UploadExpiry != that1.UploadExpiry {
+		return false
+	}
+	if !this.ImageSizePricing.Equal(that1.ImageSizePricing) {
+		return false
+	}
+	if !this.JsonSizePricing.Equal(that1.JsonSizePricing) {
+		return false
+	}
+	if !this.DefaultSizePricing.Equal(that1.DefaultSizePricing) {
+		return false
+	}
+	return true
+}
+func (this *SizePricing) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SizePricing)
+	if !ok {
+		that2, ok := that.(SizePricing)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Base) != len(that1.Base) {
+		return false
+	}
+	for i := range this.Base {
+		if !this.Base[i].Equal(&that1.Base[i]) {
+			return false
+		}
+	}
+	if len(this.PerKib) != len(that1.PerKib) {
+		return false
+	}
+	for i := range this.PerKib {
+		if !this.PerKib[i].Equal(&that1.PerKib[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *FeeParams) Marshal() (dAtA []byte, err error) {
@@ -202,6 +493,112 @@ func (m *FeeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DefaultSizePricing != nil {
+		{
+			size, err := m.DefaultSizePricing.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFee(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.JsonSizePricing != nil {
+		{
+			size, err := m.JsonSizePricing.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFee(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.ImageSizePricing != nil {
+		{
+			size, err := m.ImageSizePricing.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFee(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UploadExpiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UploadExpiry):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintFee(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x62
+	if m.MaxChunkSize != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.MaxChunkSize))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxUploadSize != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.MaxUploadSize))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.UploadPerMib) > 0 {
+		for iNdEx := len(m.UploadPerMib) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UploadPerMib[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.MaxBatchDataSize != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.MaxBatchDataSize))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxBatchSize != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.MaxBatchSize))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.DeprecateResource) > 0 {
+		for iNdEx := len(m.DeprecateResource) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeprecateResource[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.UpdateResourceMetadata) > 0 {
+		for iNdEx := len(m.UpdateResourceMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpdateResourceMetadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size := m.BurnFactor.Size()
 		i -= size
@@ -257,6 +654,57 @@ func (m *FeeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SizePricing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SizePricing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SizePricing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PerKib) > 0 {
+		for iNdEx := len(m.PerKib) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PerKib[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Base) > 0 {
+		for iNdEx := len(m.Base) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Base[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFee(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFee(v)
 	base := offset
@@ -294,21 +742,86 @@ func (m *FeeParams) Size() (n int) {
 	}
 	l = m.BurnFactor.Size()
 	n += 1 + l + sovFee(uint64(l))
-	return n
-}
-
-func sovFee(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozFee(x uint64) (n int) {
-	return sovFee(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *FeeParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+	if len(m.UpdateResourceMetadata) > 0 {
+		for _, e := range m.UpdateResourceMetadata {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if len(m.DeprecateResource) > 0 {
+		for _, e := range m.DeprecateResource {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if m.MaxBatchSize != 0 {
+		n += 1 + sovFee(uint64(m.MaxBatchSize))
+	}
+	if m.MaxBatchDataSize != 0 {
+		n += 1 + sovFee(uint64(m.MaxBatchDataSize))
+	}
+	if len(m.UploadPerMib) > 0 {
+		for _, e := range m.UploadPerMib {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if m.MaxUploadSize != 0 {
+		n += 1 + sovFee(uint64(m.MaxUploadSize))
+	}
+	if m.MaxChunkSize != 0 {
+		n += 1 + sovFee(uint64(m.MaxChunkSize))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UploadExpiry)
+	n += 1 + l + sovFee(uint64(l))
+	if m.ImageSizePricing != nil {
+		l = m.ImageSizePricing.Size()
+		n += 1 + l + sovFee(uint64(l))
+	}
+	if m.JsonSizePricing != nil {
+		l = m.JsonSizePricing.Size()
+		n += 1 + l + sovFee(uint64(l))
+	}
+	if m.DefaultSizePricing != nil {
+		l = m.DefaultSizePricing.Size()
+		n += 1 + l + sovFee(uint64(l))
+	}
+	return n
+}
+
+func (m *SizePricing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Base) > 0 {
+		for _, e := range m.Base {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if len(m.PerKib) > 0 {
+		for _, e := range m.PerKib {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovFee(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFee(x uint64) (n int) {
+	return sovFee(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FeeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowFee
@@ -468,6 +981,443 @@ func (m *FeeParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateResourceMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateResourceMetadata = append(m.UpdateResourceMetadata, types.FeeRange{})
+			if err := m.UpdateResourceMetadata[len(m.UpdateResourceMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecateResource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeprecateResource = append(m.DeprecateResource, types.FeeRange{})
+			if err := m.DeprecateResource[len(m.DeprecateResource)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchSize", wireType)
+			}
+			m.MaxBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchDataSize", wireType)
+			}
+			m.MaxBatchDataSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchDataSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadPerMib", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UploadPerMib = append(m.UploadPerMib, types.FeeRange{})
+			if err := m.UploadPerMib[len(m.UploadPerMib)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUploadSize", wireType)
+			}
+			m.MaxUploadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUploadSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChunkSize", wireType)
+			}
+			m.MaxChunkSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxChunkSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadExpiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UploadExpiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageSizePricing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ImageSizePricing == nil {
+				m.ImageSizePricing = &SizePricing{}
+			}
+			if err := m.ImageSizePricing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonSizePricing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JsonSizePricing == nil {
+				m.JsonSizePricing = &SizePricing{}
+			}
+			if err := m.JsonSizePricing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultSizePricing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DefaultSizePricing == nil {
+				m.DefaultSizePricing = &SizePricing{}
+			}
+			if err := m.DefaultSizePricing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SizePricing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SizePricing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SizePricing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = append(m.Base, types.FeeRange{})
+			if err := m.Base[len(m.Base)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerKib", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PerKib = append(m.PerKib, types.FeeRange{})
+			if err := m.PerKib[len(m.PerKib)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFee(dAtA[iNdEx:])
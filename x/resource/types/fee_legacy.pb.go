@@ -111,7 +111,7 @@ func init() {
 }
 
 var fileDescriptor_54045fcfe9ce1ae6 = []byte{
-	// 357 bytes of a gzipped FileDescriptorProto
+	// 354 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x31, 0x6f, 0xe2, 0x30,
 	0x14, 0xc7, 0x63, 0x8e, 0xbb, 0xd3, 0x99, 0xe1, 0x44, 0x74, 0x43, 0xe0, 0x24, 0x83, 0x98, 0xd0,
 	0xe9, 0xb0, 0x05, 0xe8, 0x4e, 0xea, 0x4a, 0x11, 0x43, 0xd5, 0xa1, 0x62, 0xa9, 0xd4, 0x05, 0x39,
@@ -133,8 +133,8 @@ var fileDescriptor_54045fcfe9ce1ae6 = []byte{
 	0x46, 0x5a, 0x69, 0x70, 0xb6, 0xce, 0x08, 0xda, 0x64, 0x04, 0x6d, 0x33, 0x82, 0xde, 0x32, 0x82,
 	0xee, 0xf7, 0xc4, 0xda, 0xee, 0x89, 0xf5, 0xb2, 0x27, 0xd6, 0xd5, 0x5f, 0x3f, 0x48, 0x66, 0xa9,
 	0x4b, 0x85, 0x0c, 0x99, 0xd9, 0xab, 0x8e, 0x9d, 0x48, 0x7a, 0xc0, 0xee, 0x3e, 0x97, 0x9c, 0xac,
-	0x16, 0xa0, 0xdc, 0x6f, 0xfa, 0x9b, 0xfb, 0x1f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x58, 0x43, 0x25,
-	0x0c, 0x03, 0x02, 0x00, 0x00,
+	0x16, 0xa0, 0xdc, 0x6f, 0xfa, 0x9b, 0xfb, 0x1f, 0x03, 0x00, 0x58, 0x43, 0x25, 0x0c, 0x03, 0x02,
+	0x00, 0x00,
 }
 
 func (this *LegacyFeeParams) Equal(that interface{}) bool {
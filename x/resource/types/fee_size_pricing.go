@@ -0,0 +1,96 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	didtypes "github.com/cheqd/cheqd-node/x/did/types"
+)
+
+// GetCreateResourceFee returns the fee for creating a resource with the given media type and data size,
+// and whether it comes from the size-tiered pricing of the media type
+func (tfp *FeeParams) GetCreateResourceFee(mediaType string, size uint64) ([]didtypes.FeeRange, bool) {
+	var fixed []didtypes.FeeRange
+	var sizePricing *SizePricing
+
+	switch {
+	case strings.HasPrefix(mediaType, "image/"):
+		fixed, sizePricing = tfp.Image, tfp.ImageSizePricing
+	case strings.HasPrefix(mediaType, "application/json"):
+		fixed, sizePricing = tfp.Json, tfp.JsonSizePricing
+	default:
+		fixed, sizePricing = tfp.Default, tfp.DefaultSizePricing
+	}
+
+	if sizePricing == nil {
+		return fixed, false
+	}
+
+	return sizePricing.GetFee(size), true
+}
+
+// GetFee returns the base fee plus the fee per KiB for every started KiB of the given size.
+// Only denoms with both a base fee and a fee per KiB are kept, and an unbounded side stays unbounded.
+func (sp *SizePricing) GetFee(size uint64) []didtypes.FeeRange {
+	kibibytes := sdkmath.NewIntFromUint64(size / KiB)
+	if size%KiB != 0 {
+		kibibytes = kibibytes.AddRaw(1)
+	}
+
+	fee := make([]didtypes.FeeRange, 0, len(sp.Base))
+	for _, base := range sp.Base {
+		for _, perKib := range sp.PerKib {
+			if perKib.Denom != base.Denom {
+				continue
+			}
+
+			fee = append(fee, didtypes.FeeRange{
+				Denom:     base.Denom,
+				MinAmount: addScaledFeeBound(base.MinAmount, perKib.MinAmount, kibibytes),
+				MaxAmount: addScaledFeeBound(base.MaxAmount, perKib.MaxAmount, kibibytes),
+			})
+			break
+		}
+	}
+
+	return fee
+}
+
+// ValidateBasic performs basic validation of the size-tiered pricing
+func (sp *SizePricing) ValidateBasic(name string) error {
+	if len(sp.Base) == 0 {
+		return fmt.Errorf("%s.base must not be empty", name)
+	}
+	if err := validateFeeRangeList(name+".base", sp.Base); err != nil {
+		return err
+	}
+	if err := validateFeeRangeList(name+".per_kib", sp.PerKib); err != nil {
+		return err
+	}
+
+	for i, base := range sp.Base {
+		found := false
+		for _, perKib := range sp.PerKib {
+			if perKib.Denom == base.Denom {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("missing per_kib fee for denom of %s.base[%d]: %s", name, i, base.Denom)
+		}
+	}
+
+	return nil
+}
+
+// addScaledFeeBound returns base + perUnit * units, or nil if either bound is unbounded
+func addScaledFeeBound(base, perUnit *sdkmath.Int, units sdkmath.Int) *sdkmath.Int {
+	if base == nil || perUnit == nil {
+		return nil
+	}
+
+	sum := base.Add(perUnit.Mul(units))
+	return &sum
+}
@@ -0,0 +1,69 @@
+package types_test
+
+import (
+	"github.com/cheqd/cheqd-node/util"
+	didtypes "github.com/cheqd/cheqd-node/x/did/types"
+	resourcetypes "github.com/cheqd/cheqd-node/x/resource/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SizePricing", func() {
+	var params *resourcetypes.FeeParams
+
+	BeforeEach(func() {
+		params = resourcetypes.DefaultFeeParams()
+		params.ImageSizePricing = &resourcetypes.SizePricing{
+			Base: []didtypes.FeeRange{
+				{Denom: resourcetypes.BaseMinimalDenom, MinAmount: util.PtrInt(1e9), MaxAmount: util.PtrInt(2e9)},
+				{Denom: "usd", MinAmount: util.PtrInt(1e17)},
+			},
+			PerKib: []didtypes.FeeRange{
+				{Denom: resourcetypes.BaseMinimalDenom, MinAmount: util.PtrInt(1e7), MaxAmount: util.PtrInt(1e7)},
+				{Denom: "usd", MinAmount: util.PtrInt(1e15), MaxAmount: util.PtrInt(1e15)},
+			},
+		}
+	})
+
+	Describe("GetCreateResourceFee", func() {
+		It("should charge the base fee plus the fee per started KiB", func() {
+			fee, sizeTiered := params.GetCreateResourceFee("image/png", 200*resourcetypes.KiB+1)
+			Expect(sizeTiered).To(BeTrue())
+			Expect(fee).To(Equal([]didtypes.FeeRange{
+				{Denom: resourcetypes.BaseMinimalDenom, MinAmount: util.PtrInt(1e9 + 201*1e7), MaxAmount: util.PtrInt(2e9 + 201*1e7)},
+				{Denom: "usd", MinAmount: util.PtrInt(1e17 + 201*1e15)},
+			}))
+		})
+
+		It("should charge the fixed fee for media types without size-tiered pricing", func() {
+			fee, sizeTiered := params.GetCreateResourceFee("application/json", 200*resourcetypes.KiB)
+			Expect(sizeTiered).To(BeFalse())
+			Expect(fee).To(Equal(params.Json))
+
+			fee, sizeTiered = params.GetCreateResourceFee("text/plain", 200*resourcetypes.KiB)
+			Expect(sizeTiered).To(BeFalse())
+			Expect(fee).To(Equal(params.Default))
+		})
+	})
+
+	Describe("ValidateBasic", func() {
+		It("should accept valid size-tiered pricing", func() {
+			Expect(params.ValidateBasic()).To(BeNil())
+		})
+
+		It("should reject an empty base fee", func() {
+			params.ImageSizePricing.Base = nil
+			Expect(params.ValidateBasic()).To(MatchError("image_size_pricing.base must not be empty"))
+		})
+
+		It("should reject a base fee denom without a fee per KiB", func() {
+			params.ImageSizePricing.PerKib = params.ImageSizePricing.PerKib[:1]
+			Expect(params.ValidateBasic()).To(MatchError("missing per_kib fee for denom of image_size_pricing.base[1]: usd"))
+		})
+
+		It("should reject invalid fee ranges", func() {
+			params.ImageSizePricing.PerKib[0].Denom = "stake"
+			Expect(params.ValidateBasic()).To(MatchError("invalid denom in image_size_pricing.per_kib[0]: got stake"))
+		})
+	})
+})
@@ -2,13 +2,27 @@ package types
 
 import (
 	"fmt"
+	"time"
 )
 
+// MiB is the unit the fee of chunked uploads is charged per
+const MiB = 1024 * 1024
+
+// KiB is the unit the size-tiered fee of resource creation is charged per
+const KiB = 1024
+
 const (
-	DefaultCreateResourceImageFee   = 10e9                   // 10 CHEQ or 10000000000 ncheq
-	DefaultCreateResourceJSONFee    = 25e8                   // 2.5 CHEQ or 2500000000 ncheq
-	DefaultCreateResourceDefaultFee = 5e9                    // 5 CHEQ or 5000000000 ncheq
-	DefaultBurnFactor               = "0.500000000000000000" // 0.5 or 50%
+	DefaultCreateResourceImageFee    = 10e9                   // 10 CHEQ or 10000000000 ncheq
+	DefaultCreateResourceJSONFee     = 25e8                   // 2.5 CHEQ or 2500000000 ncheq
+	DefaultCreateResourceDefaultFee  = 5e9                    // 5 CHEQ or 5000000000 ncheq
+	DefaultUpdateResourceMetadataFee = 1e9                    // 1 CHEQ or 1000000000 ncheq
+	DefaultDeprecateResourceFee      = 1e9                    // 1 CHEQ or 1000000000 ncheq
+	DefaultBurnFactor                = "0.500000000000000000" // 0.5 or 50%
+	DefaultMaxBatchSize              = 20                     // resources per batch message
+	DefaultMaxBatchDataSize          = 4 * 1024 * 1024        // 4 MiB of resource data per batch message
+	DefaultMaxUploadSize             = 50 * MiB               // resource data per chunked upload
+	DefaultMaxChunkSize              = MiB                    // resource data per chunk
+	DefaultUploadExpiry              = 24 * time.Hour         // time to finalize a chunked upload
 )
 
 // DefaultGenesis returns the default `resource` genesis state
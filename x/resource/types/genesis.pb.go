@@ -85,7 +85,7 @@ func init() {
 func init() { proto.RegisterFile("cheqd/resource/v2/genesis.proto", fileDescriptor_dd2827e54652b885) }
 
 var fileDescriptor_dd2827e54652b885 = []byte{
-	// 233 bytes of a gzipped FileDescriptorProto
+	// 230 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x48, 0x2d,
 	0x4c, 0xd1, 0x2f, 0x4a, 0x2d, 0xce, 0x2f, 0x2d, 0x4a, 0x4e, 0xd5, 0x2f, 0x33, 0xd2, 0x4f, 0x4f,
 	0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x04, 0x2b, 0xd0,
@@ -99,8 +99,8 @@ var fileDescriptor_dd2827e54652b885 = []byte{
 	0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b,
 	0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x49, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2,
 	0x4b, 0xce, 0xcf, 0xd5, 0x87, 0xf8, 0x0d, 0x4c, 0xea, 0xe6, 0xe5, 0xa7, 0xa4, 0xea, 0x57, 0x20,
-	0x3c, 0x5a, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xf6, 0xa3, 0x31, 0x20, 0x00, 0x00, 0xff,
-	0xff, 0x4b, 0x68, 0x7f, 0xdb, 0x58, 0x01, 0x00, 0x00,
+	0x3c, 0x5a, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xf6, 0xa3, 0x31, 0x60, 0x00, 0x4b, 0x68,
+	0x7f, 0xdb, 0x58, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -23,4 +23,10 @@ const (
 	ResourceCountKey         = "resource-count:"
 	ResourcePortIDKey        = "resource-port-id:"
 	ResourceLatestVersionKey = "resource-latest-version:"
+	ResourceUploadKey        = "resource-upload:"
+	ResourceUploadExpiryKey  = "resource-upload-expiry:"
+	ResourceChunkKey         = "resource-chunk:"
+	ResourceMediaTypeKey     = "resource-media-type-count:"
+	ResourceTotalBytesKey    = "resource-total-bytes:"
+	ResourceVersionTimeKey   = "resource-version-time:"
 )
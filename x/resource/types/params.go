@@ -35,7 +35,33 @@ func DefaultFeeParams() *FeeParams {
 				MaxAmount: util.PtrInt(20000000000),
 			},
 		},
-		BurnFactor: sdkmath.LegacyMustNewDecFromStr(DefaultBurnFactor),
+		UpdateResourceMetadata: []didtypes.FeeRange{
+			{
+				Denom:     BaseMinimalDenom,
+				MinAmount: util.PtrInt(1000000000),
+				MaxAmount: util.PtrInt(2000000000),
+			},
+		},
+		DeprecateResource: []didtypes.FeeRange{
+			{
+				Denom:     BaseMinimalDenom,
+				MinAmount: util.PtrInt(1000000000),
+				MaxAmount: util.PtrInt(2000000000),
+			},
+		},
+		UploadPerMib: []didtypes.FeeRange{
+			{
+				Denom:     BaseMinimalDenom,
+				MinAmount: util.PtrInt(6000000000),
+				MaxAmount: util.PtrInt(20000000000),
+			},
+		},
+		BurnFactor:       sdkmath.LegacyMustNewDecFromStr(DefaultBurnFactor),
+		MaxBatchSize:     DefaultMaxBatchSize,
+		MaxBatchDataSize: DefaultMaxBatchDataSize,
+		MaxUploadSize:    DefaultMaxUploadSize,
+		MaxChunkSize:     DefaultMaxChunkSize,
+		UploadExpiry:     DefaultUploadExpiry,
 	}
 }
 
@@ -63,7 +89,33 @@ func DefaultUSDParams() *FeeParams {
 				MaxAmount: util.PtrInt(200000000000000000),
 			},
 		},
-		BurnFactor: sdkmath.LegacyMustNewDecFromStr(DefaultBurnFactor),
+		UpdateResourceMetadata: []didtypes.FeeRange{
+			{
+				Denom:     oracletypes.UsdDenom,
+				MinAmount: util.PtrInt(100000000000000000),
+				MaxAmount: util.PtrInt(100000000000000000),
+			},
+		},
+		DeprecateResource: []didtypes.FeeRange{
+			{
+				Denom:     oracletypes.UsdDenom,
+				MinAmount: util.PtrInt(100000000000000000),
+				MaxAmount: util.PtrInt(100000000000000000),
+			},
+		},
+		UploadPerMib: []didtypes.FeeRange{
+			{
+				Denom:     oracletypes.UsdDenom,
+				MinAmount: util.PtrInt(200000000000000000),
+				MaxAmount: util.PtrInt(200000000000000000),
+			},
+		},
+		BurnFactor:       sdkmath.LegacyMustNewDecFromStr(DefaultBurnFactor),
+		MaxBatchSize:     DefaultMaxBatchSize,
+		MaxBatchDataSize: DefaultMaxBatchDataSize,
+		MaxUploadSize:    DefaultMaxUploadSize,
+		MaxChunkSize:     DefaultMaxChunkSize,
+		UploadExpiry:     DefaultUploadExpiry,
 	}
 }
 
@@ -107,24 +159,26 @@ func convertFeeRangeToUSD(ctx context.Context, oracleKeeper OracleKeeper, fr did
 		}
 
 	case BaseMinimalDenom:
-		price, found := oracleKeeper.GetWMA(sdkCtx, oracletypes.CheqdSymbol, "BALANCED")
-		if !found || !price.IsPositive() {
+		if !oracleKeeper.IsPriceHealthy(sdkCtx, oracletypes.CheqdSymbol) {
+			return USDRange{}, fmt.Errorf("CHEQ/USD price circuit breaker is tripped")
+		}
+		price, err := oracleKeeper.GetFeeConversionPrice(sdkCtx, oracletypes.CheqdSymbol)
+		if err != nil || !price.Price.IsPositive() {
 			return USDRange{}, fmt.Errorf("invalid or missing CHEQ/USD price")
 		}
 
-		cheqDecimals := sdkmath.NewInt(1_000_000_000) // 9 decimals for ncheq
-		usdScaleFactor := sdkmath.NewInt(1_000_000)   // 6 decimals for USD
-
 		if fr.MinAmount != nil {
-			cheqDec := sdkmath.LegacyNewDecFromInt(*fr.MinAmount).QuoInt(cheqDecimals)
-			usdValue := cheqDec.Mul(price).MulInt(usdScaleFactor)
-			val := usdValue.TruncateInt()
+			val, err := oracletypes.NcheqToMicroUSD(*fr.MinAmount, price.Price)
+			if err != nil {
+				return USDRange{}, err
+			}
 			minUSD = &val
 		}
 		if fr.MaxAmount != nil {
-			cheqDec := sdkmath.LegacyNewDecFromInt(*fr.MaxAmount).QuoInt(cheqDecimals)
-			usdValue := cheqDec.Mul(price).MulInt(usdScaleFactor)
-			val := usdValue.TruncateInt()
+			val, err := oracletypes.NcheqToMicroUSD(*fr.MaxAmount, price.Price)
+			if err != nil {
+				return USDRange{}, err
+			}
 			maxUSD = &val
 		}
 
@@ -215,6 +269,29 @@ func (tfp *FeeParams) ValidateBasic() error {
 	if err := validateFeeRangeList("default", tfp.Default); err != nil {
 		return err
 	}
+	if err := validateFeeRangeList("update_resource_metadata", tfp.UpdateResourceMetadata); err != nil {
+		return err
+	}
+	if err := validateFeeRangeList("deprecate_resource", tfp.DeprecateResource); err != nil {
+		return err
+	}
+	if err := validateFeeRangeList("upload_per_mib", tfp.UploadPerMib); err != nil {
+		return err
+	}
+	for _, p := range tfp.sizePricings() {
+		if p.sizePricing == nil {
+			continue
+		}
+		if err := p.sizePricing.ValidateBasic(p.name); err != nil {
+			return err
+		}
+	}
+	if tfp.MaxChunkSize > tfp.MaxUploadSize {
+		return fmt.Errorf("max_chunk_size must be <= max_upload_size: got %d > %d", tfp.MaxChunkSize, tfp.MaxUploadSize)
+	}
+	if tfp.UploadExpiry < 0 {
+		return fmt.Errorf("upload_expiry must not be negative: got %s", tfp.UploadExpiry)
+	}
 	if err := validateBurnFactor(tfp.BurnFactor); err != nil {
 		return err
 	}
@@ -241,9 +318,47 @@ func (tfp *FeeParams) ValidateWithOracle(ctx context.Context, oracleKeeper Oracl
 		return err
 	}
 
+	if err := validateFeeRangeOverlap(ctx, oracleKeeper, "update_resource_metadata", tfp.UpdateResourceMetadata); err != nil {
+		return err
+	}
+
+	if err := validateFeeRangeOverlap(ctx, oracleKeeper, "deprecate_resource", tfp.DeprecateResource); err != nil {
+		return err
+	}
+
+	if err := validateFeeRangeOverlap(ctx, oracleKeeper, "upload_per_mib", tfp.UploadPerMib); err != nil {
+		return err
+	}
+
+	for _, p := range tfp.sizePricings() {
+		if p.sizePricing == nil {
+			continue
+		}
+		if err := validateFeeRangeOverlap(ctx, oracleKeeper, p.name+".base", p.sizePricing.Base); err != nil {
+			return err
+		}
+		if err := validateFeeRangeOverlap(ctx, oracleKeeper, p.name+".per_kib", p.sizePricing.PerKib); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+type namedSizePricing struct {
+	name        string
+	sizePricing *SizePricing
+}
+
+// sizePricings returns the optional size-tiered pricings with their parameter names, in a fixed order
+func (tfp *FeeParams) sizePricings() []namedSizePricing {
+	return []namedSizePricing{
+		{"image_size_pricing", tfp.ImageSizePricing},
+		{"json_size_pricing", tfp.JsonSizePricing},
+		{"default_size_pricing", tfp.DefaultSizePricing},
+	}
+}
+
 func validateImage(i interface{}) error {
 	switch v := i.(type) {
 	case []didtypes.FeeRange:
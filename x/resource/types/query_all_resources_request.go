@@ -0,0 +1,18 @@
+package types
+
+// Matches checks whether the resource metadata satisfies all the filters set in the request
+func (query *QueryAllResourcesRequest) Matches(metadata Metadata) bool {
+	if query.MediaType != "" && metadata.MediaType != query.MediaType {
+		return false
+	}
+
+	if query.CreatedAfter != nil && metadata.Created.Before(*query.CreatedAfter) {
+		return false
+	}
+
+	if query.CreatedBefore != nil && !metadata.Created.Before(*query.CreatedBefore) {
+		return false
+	}
+
+	return true
+}
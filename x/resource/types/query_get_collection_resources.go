@@ -7,3 +7,28 @@ import (
 func (query *QueryCollectionResourcesRequest) Normalize() {
 	query.CollectionId = utils.NormalizeID(query.CollectionId)
 }
+
+// Matches checks whether the resource metadata satisfies all the filters set in the request
+func (query *QueryCollectionResourcesRequest) Matches(metadata Metadata) bool {
+	if query.ResourceName != "" && metadata.Name != query.ResourceName {
+		return false
+	}
+
+	if query.ResourceType != "" && metadata.ResourceType != query.ResourceType {
+		return false
+	}
+
+	if query.MediaType != "" && metadata.MediaType != query.MediaType {
+		return false
+	}
+
+	if query.CreatedAfter != nil && metadata.Created.Before(*query.CreatedAfter) {
+		return false
+	}
+
+	if query.CreatedBefore != nil && !metadata.Created.Before(*query.CreatedBefore) {
+		return false
+	}
+
+	return true
+}
@@ -12,3 +12,11 @@ func (query *QueryResourceRequest) Normalize() {
 func (query *QueryLatestResourceVersionRequest) Normalize() {
 	query.CollectionId = utils.NormalizeID(query.CollectionId)
 }
+
+func (query *QueryResourceVersionsRequest) Normalize() {
+	query.CollectionId = utils.NormalizeID(query.CollectionId)
+}
+
+func (query *QueryResourceAtTimeRequest) Normalize() {
+	query.CollectionId = utils.NormalizeID(query.CollectionId)
+}
@@ -119,6 +119,8 @@ type Metadata struct {
 	// This is based on the Resource's name and Resource type to determine whether it's the same Resource.
 	// Format: <uuid>
 	NextVersionId string `protobuf:"bytes,11,opt,name=next_version_id,json=nextVersionId,proto3" json:"next_version_id,omitempty"`
+	// deprecated signals that the Resource is obsolete and should no longer be used. Set by Msg/DeprecateResource.
+	Deprecated bool `protobuf:"varint,12,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
 }
 
 func (m *Metadata) Reset()         { *m = Metadata{} }
@@ -231,6 +233,13 @@ func (m *Metadata) GetNextVersionId() string {
 	return ""
 }
 
+func (m *Metadata) GetDeprecated() bool {
+	if m != nil {
+		return m.Deprecated
+	}
+	return false
+}
+
 // AlternativeUri are alternative URIs that can be used to access the Resource.
 // By default, at least the DID URI equivalent of the Resource is populated.
 type AlternativeUri struct {
@@ -297,6 +306,154 @@ func (m *AlternativeUri) GetDescription() string {
 	return ""
 }
 
+// ResourceUpload stores the state of a pending chunked upload of a DID-Linked Resource
+type ResourceUpload struct {
+	// collection_id is the ID of the collection that the Resource will belong to. Defined client-side.
+	// Format: <unique-identifier>
+	CollectionId string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"resourceCollectionId"`
+	// id is the ID of the Resource. Defined client-side.
+	// Format: <uuid>
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"resourceId"`
+	// name is a human-readable name for the Resource. Defined client-side.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"resourceName"`
+	// version is a human-readable semantic version for the Resource. Defined client-side.
+	Version string `protobuf:"bytes,4,opt,name=version,proto3" json:"resourceVersion"`
+	// resource_type is a Resource type that identifies what the Resource is. Defined client-side.
+	ResourceType string `protobuf:"bytes,5,opt,name=resource_type,json=resourceType,proto3" json:"resourceType"`
+	// List of alternative URIs for the SAME Resource. Defined client-side.
+	AlsoKnownAs []*AlternativeUri `protobuf:"bytes,6,rep,name=also_known_as,json=alsoKnownAs,proto3" json:"resourceAlternativeUri"`
+	// size is the declared total size in bytes of the Resource data. Defined client-side.
+	Size_ uint64 `protobuf:"varint,7,opt,name=size,proto3" json:"size,omitempty"`
+	// checksum is the declared SHA-256 checksum hash of the Resource data. Defined client-side.
+	Checksum string `protobuf:"bytes,8,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// received is the number of bytes received so far. Defined ledger-side.
+	Received uint64 `protobuf:"varint,9,opt,name=received,proto3" json:"received,omitempty"`
+	// chunk_count is the number of chunks received so far. Defined ledger-side.
+	ChunkCount uint64 `protobuf:"varint,10,opt,name=chunk_count,json=chunkCount,proto3" json:"chunk_count,omitempty"`
+	// expires is the time after which the upload is pruned if it has not been finalized. Defined ledger-side.
+	// Format: RFC3339
+	Expires time.Time `protobuf:"bytes,11,opt,name=expires,proto3,stdtime" json:"expires"`
+	// hash_state is the intermediate state of the SHA-256 hash of the received chunks. Defined ledger-side.
+	HashState []byte `protobuf:"bytes,12,opt,name=hash_state,json=hashState,proto3" json:"hash_state,omitempty"`
+}
+
+func (m *ResourceUpload) Reset()         { *m = ResourceUpload{} }
+func (m *ResourceUpload) String() string { return proto.CompactTextString(m) }
+func (*ResourceUpload) ProtoMessage()    {}
+func (*ResourceUpload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_abfe0b32f2a40f67, []int{3}
+}
+func (m *ResourceUpload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceUpload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceUpload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResourceUpload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceUpload.Merge(m, src)
+}
+func (m *ResourceUpload) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceUpload) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceUpload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceUpload proto.InternalMessageInfo
+
+func (m *ResourceUpload) GetCollectionId() string {
+	if m != nil {
+		return m.CollectionId
+	}
+	return ""
+}
+
+func (m *ResourceUpload) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ResourceUpload) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ResourceUpload) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *ResourceUpload) GetResourceType() string {
+	if m != nil {
+		return m.ResourceType
+	}
+	return ""
+}
+
+func (m *ResourceUpload) GetAlsoKnownAs() []*AlternativeUri {
+	if m != nil {
+		return m.AlsoKnownAs
+	}
+	return nil
+}
+
+func (m *ResourceUpload) GetSize_() uint64 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
+}
+
+func (m *ResourceUpload) GetChecksum() string {
+	if m != nil {
+		return m.Checksum
+	}
+	return ""
+}
+
+func (m *ResourceUpload) GetReceived() uint64 {
+	if m != nil {
+		return m.Received
+	}
+	return 0
+}
+
+func (m *ResourceUpload) GetChunkCount() uint64 {
+	if m != nil {
+		return m.ChunkCount
+	}
+	return 0
+}
+
+func (m *ResourceUpload) GetExpires() time.Time {
+	if m != nil {
+		return m.Expires
+	}
+	return time.Time{}
+}
+
+func (m *ResourceUpload) GetHashState() []byte {
+	if m != nil {
+		return m.HashState
+	}
+	return nil
+}
+
 // ResourceWithMetadata describes the overall structure of a DID-Linked Resource
 type ResourceWithMetadata struct {
 	Resource *Resource `protobuf:"bytes,1,opt,name=resource,proto3" json:"linkedResource"`
@@ -307,7 +464,7 @@ func (m *ResourceWithMetadata) Reset()         { *m = ResourceWithMetadata{} }
 func (m *ResourceWithMetadata) String() string { return proto.CompactTextString(m) }
 func (*ResourceWithMetadata) ProtoMessage()    {}
 func (*ResourceWithMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_abfe0b32f2a40f67, []int{3}
+	return fileDescriptor_abfe0b32f2a40f67, []int{4}
 }
 func (m *ResourceWithMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -354,51 +511,59 @@ func init() {
 	proto.RegisterType((*Resource)(nil), "cheqd.resource.v2.Resource")
 	proto.RegisterType((*Metadata)(nil), "cheqd.resource.v2.Metadata")
 	proto.RegisterType((*AlternativeUri)(nil), "cheqd.resource.v2.AlternativeUri")
+	proto.RegisterType((*ResourceUpload)(nil), "cheqd.resource.v2.ResourceUpload")
 	proto.RegisterType((*ResourceWithMetadata)(nil), "cheqd.resource.v2.ResourceWithMetadata")
 }
 
 func init() { proto.RegisterFile("cheqd/resource/v2/resource.proto", fileDescriptor_abfe0b32f2a40f67) }
 
 var fileDescriptor_abfe0b32f2a40f67 = []byte{
-	// 595 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0x4f, 0x6f, 0xd3, 0x4e,
-	0x10, 0x8d, 0xdb, 0xfc, 0x5a, 0x77, 0xd2, 0xf4, 0xcf, 0xfe, 0x2a, 0xb0, 0x82, 0xb0, 0x43, 0xc4,
-	0xa1, 0x87, 0x62, 0x8b, 0x00, 0x47, 0x90, 0x1a, 0x10, 0x52, 0x55, 0xc1, 0x61, 0x29, 0x20, 0x71,
-	0x89, 0x5c, 0xef, 0x90, 0xac, 0x1a, 0x7b, 0x83, 0xbd, 0x0e, 0xed, 0xb7, 0xe8, 0xc7, 0xe1, 0xc6,
-	0xb5, 0xc7, 0x1e, 0x39, 0x19, 0xd4, 0xdc, 0xf2, 0x29, 0xd0, 0xae, 0xb3, 0x26, 0x51, 0x7b, 0x89,
-	0x66, 0xe6, 0xbd, 0x79, 0x1e, 0xcf, 0x9b, 0x18, 0xda, 0xd1, 0x10, 0xbf, 0xb1, 0x20, 0xc5, 0x4c,
-	0xe4, 0x69, 0x84, 0xc1, 0xa4, 0x5b, 0xc5, 0xfe, 0x38, 0x15, 0x52, 0x90, 0x5d, 0xcd, 0xf0, 0xab,
-	0xea, 0xa4, 0xdb, 0xda, 0x1b, 0x88, 0x81, 0xd0, 0x68, 0xa0, 0xa2, 0x92, 0xd8, 0xf2, 0x06, 0x42,
-	0x0c, 0x46, 0x18, 0xe8, 0xec, 0x34, 0xff, 0x1a, 0x48, 0x1e, 0x63, 0x26, 0xc3, 0x78, 0x5c, 0x12,
-	0x3a, 0x2e, 0xd8, 0x74, 0xae, 0x42, 0x08, 0xd4, 0x59, 0x28, 0x43, 0xc7, 0x6a, 0x5b, 0xfb, 0x9b,
-	0x54, 0xc7, 0x9d, 0x9f, 0x75, 0xb0, 0xdf, 0xa1, 0x0c, 0x55, 0x42, 0x5e, 0x42, 0x33, 0x12, 0xa3,
-	0x11, 0x46, 0x92, 0x8b, 0xa4, 0xcf, 0x99, 0x66, 0x6e, 0xf4, 0x9c, 0x59, 0xe1, 0xed, 0x99, 0x59,
-	0x5e, 0x57, 0x84, 0x23, 0x46, 0x37, 0xa3, 0x85, 0x8c, 0xb8, 0xb0, 0xc2, 0x99, 0xb3, 0xa2, 0x7b,
-	0xb6, 0x66, 0x85, 0x07, 0xa6, 0xe7, 0x88, 0xd1, 0x15, 0xce, 0xc8, 0x63, 0xa8, 0x27, 0x61, 0x8c,
-	0xce, 0xaa, 0x66, 0xec, 0xcc, 0x0a, 0x6f, 0xd3, 0x30, 0xde, 0x87, 0x31, 0x52, 0x8d, 0x92, 0xa7,
-	0xb0, 0x3e, 0xc1, 0x34, 0xe3, 0x22, 0x71, 0xea, 0x9a, 0x78, 0xff, 0xaa, 0xf0, 0xac, 0x59, 0xe1,
-	0x6d, 0x1b, 0xf2, 0xa7, 0x12, 0xa6, 0x86, 0x47, 0x5e, 0x40, 0xd3, 0x60, 0x7d, 0x79, 0x31, 0x46,
-	0xe7, 0xbf, 0xdb, 0x4f, 0x38, 0xb9, 0x18, 0x23, 0x5d, 0xca, 0x08, 0x42, 0x33, 0x1c, 0x65, 0xa2,
-	0x7f, 0x96, 0x88, 0xef, 0x49, 0x3f, 0xcc, 0x9c, 0xb5, 0xf6, 0xea, 0x7e, 0xa3, 0xfb, 0xc8, 0xbf,
-	0xb5, 0x7d, 0xff, 0x70, 0x24, 0x31, 0x4d, 0x42, 0xc9, 0x27, 0xf8, 0x31, 0xe5, 0x3d, 0x77, 0x3e,
-	0xd2, 0x3d, 0xc3, 0x59, 0xc6, 0x69, 0x43, 0xe9, 0x1e, 0x2b, 0xd9, 0xc3, 0x8c, 0x3c, 0x04, 0x88,
-	0x91, 0xf1, 0xb0, 0x1c, 0x6d, 0x5d, 0x8d, 0x46, 0x37, 0x74, 0x45, 0x4f, 0xf1, 0x0a, 0xd6, 0xa3,
-	0x14, 0x43, 0x89, 0xcc, 0xb1, 0xdb, 0xd6, 0x7e, 0xa3, 0xdb, 0xf2, 0x4b, 0x53, 0x7d, 0x63, 0xaa,
-	0x7f, 0x62, 0x4c, 0xed, 0xd9, 0x57, 0x85, 0x57, 0xbb, 0xfc, 0xed, 0x59, 0xd4, 0x34, 0x91, 0x16,
-	0xd8, 0xd1, 0x10, 0xa3, 0xb3, 0x2c, 0x8f, 0x9d, 0x0d, 0x2d, 0x5e, 0xe5, 0xe4, 0x39, 0xfc, 0x3f,
-	0x4e, 0x71, 0xc2, 0x45, 0x9e, 0xf5, 0xe7, 0xcb, 0x52, 0xb6, 0x82, 0x5e, 0x4f, 0x5d, 0xbd, 0x04,
-	0xdd, 0x35, 0x84, 0xf9, 0x56, 0x8f, 0x18, 0x39, 0x80, 0xed, 0x04, 0xcf, 0xe5, 0x62, 0x47, 0x63,
-	0xa1, 0xa3, 0xa9, 0xc0, 0x8a, 0xdd, 0x79, 0x03, 0x5b, 0xcb, 0x6f, 0x4f, 0x76, 0x60, 0x35, 0x4f,
-	0x79, 0x79, 0x3c, 0x54, 0x85, 0xa4, 0x0d, 0x0d, 0x86, 0x59, 0x94, 0xf2, 0xb1, 0x3a, 0x95, 0xf2,
-	0x44, 0xe8, 0x62, 0xa9, 0xf3, 0xc3, 0x82, 0x3d, 0x73, 0xa8, 0x9f, 0xb9, 0x1c, 0x56, 0x37, 0x79,
-	0x0c, 0xb6, 0x59, 0xb2, 0x56, 0x6c, 0x74, 0x1f, 0xdc, 0xe1, 0x8f, 0x69, 0xed, 0x91, 0x59, 0xe1,
-	0x6d, 0x8d, 0x78, 0x72, 0x86, 0xcc, 0xd4, 0x68, 0x25, 0x40, 0x3e, 0x80, 0x1d, 0xcf, 0x85, 0xf5,
-	0x10, 0x77, 0x8b, 0x99, 0x67, 0xf7, 0x5a, 0xca, 0xe2, 0x65, 0x31, 0x83, 0xd1, 0x4a, 0xa8, 0xf7,
-	0xf6, 0xea, 0xc6, 0xb5, 0xae, 0x6f, 0x5c, 0xeb, 0xcf, 0x8d, 0x6b, 0x5d, 0x4e, 0xdd, 0xda, 0xf5,
-	0xd4, 0xad, 0xfd, 0x9a, 0xba, 0xb5, 0x2f, 0x07, 0x03, 0x2e, 0x87, 0xf9, 0xa9, 0x1f, 0x89, 0x38,
-	0x28, 0xff, 0xf3, 0xfa, 0xf7, 0x49, 0x22, 0x18, 0x06, 0xe7, 0xff, 0x3e, 0x00, 0xea, 0x30, 0xb2,
-	0xd3, 0x35, 0xed, 0xf7, 0xb3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xcb, 0xb4, 0xc8, 0xa0, 0x1f,
-	0x04, 0x00, 0x00,
+	// 712 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0xc1, 0x6e, 0xdb, 0x46,
+	0x10, 0x15, 0x65, 0xd6, 0xa6, 0x96, 0x92, 0x6c, 0x6f, 0x8d, 0x96, 0x50, 0x51, 0x52, 0x15, 0x7a,
+	0xd0, 0xc1, 0xa5, 0x50, 0xb5, 0x3d, 0xb6, 0x80, 0xe5, 0xa2, 0x80, 0x61, 0x34, 0x87, 0xb5, 0x9d,
+	0x00, 0xb9, 0x08, 0x34, 0x77, 0x22, 0x2d, 0x24, 0x72, 0x19, 0x72, 0xa9, 0xd8, 0xf9, 0x0a, 0x7f,
+	0x4b, 0x4e, 0xf9, 0x04, 0x1f, 0x7d, 0xcc, 0x49, 0x0e, 0xec, 0x9b, 0xbe, 0x22, 0xd8, 0xa5, 0x96,
+	0x96, 0xe2, 0xe4, 0x90, 0x43, 0x6e, 0xb9, 0x18, 0x33, 0x6f, 0xde, 0x3c, 0x0e, 0x67, 0x9e, 0x45,
+	0xd4, 0x0e, 0xc7, 0xf0, 0x92, 0xf6, 0x52, 0xc8, 0x78, 0x9e, 0x86, 0xd0, 0x9b, 0xf5, 0xcb, 0xd8,
+	0x4f, 0x52, 0x2e, 0x38, 0xde, 0x55, 0x0c, 0xbf, 0x44, 0x67, 0xfd, 0xd6, 0xde, 0x88, 0x8f, 0xb8,
+	0xaa, 0xf6, 0x64, 0x54, 0x10, 0x5b, 0xde, 0x88, 0xf3, 0xd1, 0x14, 0x7a, 0x2a, 0x3b, 0xcf, 0x5f,
+	0xf4, 0x04, 0x8b, 0x20, 0x13, 0x41, 0x94, 0x14, 0x84, 0x8e, 0x8b, 0x2c, 0xb2, 0x54, 0xc1, 0x18,
+	0x99, 0x34, 0x10, 0x81, 0x63, 0xb4, 0x8d, 0x6e, 0x9d, 0xa8, 0xb8, 0x73, 0x6b, 0x22, 0xeb, 0x7f,
+	0x10, 0x81, 0x4c, 0xf0, 0xdf, 0xa8, 0x11, 0xf2, 0xe9, 0x14, 0x42, 0xc1, 0x78, 0x3c, 0x64, 0x54,
+	0x31, 0x6b, 0x03, 0x67, 0x31, 0xf7, 0xf6, 0xf4, 0x2c, 0x87, 0x25, 0xe1, 0x88, 0x92, 0x7a, 0xb8,
+	0x92, 0x61, 0x17, 0x55, 0x19, 0x75, 0xaa, 0xaa, 0xa7, 0xb9, 0x98, 0x7b, 0x48, 0xf7, 0x1c, 0x51,
+	0x52, 0x65, 0x14, 0xff, 0x8a, 0xcc, 0x38, 0x88, 0xc0, 0xd9, 0x50, 0x8c, 0x9d, 0xc5, 0xdc, 0xab,
+	0x6b, 0xc6, 0x93, 0x20, 0x02, 0xa2, 0xaa, 0xf8, 0x77, 0xb4, 0x35, 0x83, 0x34, 0x63, 0x3c, 0x76,
+	0x4c, 0x45, 0xfc, 0xf1, 0x7a, 0xee, 0x19, 0x8b, 0xb9, 0xb7, 0xad, 0xc9, 0x4f, 0x8b, 0x32, 0xd1,
+	0x3c, 0xfc, 0x17, 0x6a, 0xe8, 0xda, 0x50, 0x5c, 0x26, 0xe0, 0x7c, 0xf7, 0xf8, 0x09, 0xa7, 0x97,
+	0x09, 0x90, 0xb5, 0x0c, 0x03, 0x6a, 0x04, 0xd3, 0x8c, 0x0f, 0x27, 0x31, 0x7f, 0x15, 0x0f, 0x83,
+	0xcc, 0xd9, 0x6c, 0x6f, 0x74, 0xed, 0xfe, 0x2f, 0xfe, 0xa3, 0xed, 0xfb, 0x07, 0x53, 0x01, 0x69,
+	0x1c, 0x08, 0x36, 0x83, 0xb3, 0x94, 0x0d, 0xdc, 0xe5, 0x48, 0x3f, 0x68, 0xce, 0x7a, 0x9d, 0xd8,
+	0x52, 0xf7, 0x58, 0xca, 0x1e, 0x64, 0xf8, 0x67, 0x84, 0x22, 0xa0, 0x2c, 0x28, 0x46, 0xdb, 0x92,
+	0xa3, 0x91, 0x9a, 0x42, 0xd4, 0x14, 0xff, 0xa0, 0xad, 0x30, 0x85, 0x40, 0x00, 0x75, 0xac, 0xb6,
+	0xd1, 0xb5, 0xfb, 0x2d, 0xbf, 0x38, 0xaa, 0xaf, 0x8f, 0xea, 0x9f, 0xea, 0xa3, 0x0e, 0xac, 0xeb,
+	0xb9, 0x57, 0xb9, 0xba, 0xf5, 0x0c, 0xa2, 0x9b, 0x70, 0x0b, 0x59, 0xe1, 0x18, 0xc2, 0x49, 0x96,
+	0x47, 0x4e, 0x4d, 0x89, 0x97, 0x39, 0xfe, 0x13, 0x7d, 0x9f, 0xa4, 0x30, 0x63, 0x3c, 0xcf, 0x86,
+	0xcb, 0x65, 0xc9, 0xb3, 0x22, 0xb5, 0x1e, 0x53, 0xbe, 0x04, 0xd9, 0xd5, 0x84, 0xe5, 0x56, 0x8f,
+	0x28, 0xde, 0x47, 0xdb, 0x31, 0x5c, 0x88, 0xd5, 0x0e, 0x7b, 0xa5, 0xa3, 0x21, 0x8b, 0x0f, 0x6c,
+	0x17, 0x21, 0x0a, 0x49, 0x0a, 0xa1, 0x7a, 0x85, 0x7a, 0xdb, 0xe8, 0x5a, 0x64, 0x05, 0xe9, 0xfc,
+	0x8b, 0x9a, 0xeb, 0xdb, 0xc1, 0x3b, 0x68, 0x23, 0x4f, 0x59, 0x61, 0x2e, 0x22, 0x43, 0xdc, 0x46,
+	0x36, 0x85, 0x2c, 0x4c, 0x59, 0x22, 0xad, 0x54, 0x58, 0x88, 0xac, 0x42, 0x9d, 0x37, 0x26, 0x6a,
+	0x6a, 0x23, 0x9f, 0x25, 0x53, 0x1e, 0xd0, 0x6f, 0x6e, 0xfd, 0x2a, 0x6e, 0xc5, 0xc8, 0xcc, 0xd8,
+	0xeb, 0xc2, 0xa7, 0x26, 0x51, 0xf1, 0x9a, 0xc5, 0xac, 0x8f, 0x2c, 0xd6, 0x42, 0x56, 0x0a, 0x21,
+	0xb0, 0x19, 0x50, 0x65, 0x3f, 0x93, 0x94, 0x39, 0xf6, 0x90, 0x1d, 0x8e, 0xf3, 0x78, 0x32, 0x0c,
+	0x79, 0x1e, 0x0b, 0x65, 0x3b, 0x93, 0x20, 0x05, 0x1d, 0x4a, 0x44, 0x7a, 0x1f, 0x2e, 0x12, 0x96,
+	0x42, 0xe6, 0xd8, 0x5f, 0xe2, 0xfd, 0x65, 0x93, 0xfc, 0xd7, 0x1a, 0x07, 0xd9, 0x78, 0x98, 0x89,
+	0x40, 0x80, 0xf2, 0x5e, 0x9d, 0xd4, 0x24, 0x72, 0x22, 0x81, 0xce, 0x5b, 0x03, 0xed, 0x69, 0xd3,
+	0x3c, 0x63, 0x62, 0x5c, 0xfe, 0xd0, 0x1d, 0xcb, 0xa1, 0x0b, 0x5c, 0xb9, 0xc6, 0xee, 0xff, 0xf4,
+	0x89, 0x35, 0xea, 0xd6, 0x01, 0x5e, 0xcc, 0xbd, 0xe6, 0x94, 0xc5, 0x13, 0xa0, 0x1a, 0x23, 0xa5,
+	0x00, 0x3e, 0x41, 0x56, 0xb4, 0x14, 0x76, 0xaa, 0x9f, 0x15, 0xd3, 0xcf, 0x1e, 0xb4, 0xe4, 0x25,
+	0xd6, 0xc5, 0x74, 0x8d, 0x94, 0x42, 0x83, 0xff, 0xae, 0xef, 0x5c, 0xe3, 0xe6, 0xce, 0x35, 0xde,
+	0xdf, 0xb9, 0xc6, 0xd5, 0xbd, 0x5b, 0xb9, 0xb9, 0x77, 0x2b, 0xef, 0xee, 0xdd, 0xca, 0xf3, 0xfd,
+	0x11, 0x13, 0xe3, 0xfc, 0xdc, 0x0f, 0x79, 0xd4, 0x2b, 0x3e, 0x24, 0xea, 0xef, 0x6f, 0x31, 0xa7,
+	0xd0, 0xbb, 0x78, 0xf8, 0xaa, 0x48, 0x6b, 0x65, 0xe7, 0x9b, 0x6a, 0x91, 0x7f, 0x7c, 0x18, 0x00,
+	0x6e, 0xff, 0xe2, 0xb7, 0x74, 0x06, 0x00, 0x00,
 }
 
 func (m *Resource) Marshal() (dAtA []byte, err error) {
@@ -451,6 +616,16 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Deprecated {
+		i--
+		if m.Deprecated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
 	if len(m.NextVersionId) > 0 {
 		i -= len(m.NextVersionId)
 		copy(dAtA[i:], m.NextVersionId)
@@ -576,6 +751,115 @@ func (m *AlternativeUri) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ResourceUpload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceUpload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceUpload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HashState) > 0 {
+		i -= len(m.HashState)
+		copy(dAtA[i:], m.HashState)
+		i = encodeVarintResource(dAtA, i, uint64(len(m.HashState)))
+		i--
+		dAtA[i] = 0x62
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expires, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expires):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintResource(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x5a
+	if m.ChunkCount != 0 {
+		i = encodeVarintResource(dAtA, i, uint64(m.ChunkCount))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Received != 0 {
+		i = encodeVarintResource(dAtA, i, uint64(m.Received))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintResource(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Size_ != 0 {
+		i = encodeVarintResource(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.AlsoKnownAs) > 0 {
+		for iNdEx := len(m.AlsoKnownAs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AlsoKnownAs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintResource(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ResourceType) > 0 {
+		i -= len(m.ResourceType)
+		copy(dAtA[i:], m.ResourceType)
+		i = encodeVarintResource(dAtA, i, uint64(len(m.ResourceType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintResource(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintResource(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintResource(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CollectionId) > 0 {
+		i -= len(m.CollectionId)
+		copy(dAtA[i:], m.CollectionId)
+		i = encodeVarintResource(dAtA, i, uint64(len(m.CollectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ResourceWithMetadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -697,6 +981,9 @@ func (m *Metadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovResource(uint64(l))
 	}
+	if m.Deprecated {
+		n += 2
+	}
 	return n
 }
 
@@ -717,6 +1004,60 @@ func (m *AlternativeUri) Size() (n int) {
 	return n
 }
 
+func (m *ResourceUpload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollectionId)
+	if l > 0 {
+		n += 1 + l + sovResource(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovResource(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovResource(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovResource(uint64(l))
+	}
+	l = len(m.ResourceType)
+	if l > 0 {
+		n += 1 + l + sovResource(uint64(l))
+	}
+	if len(m.AlsoKnownAs) > 0 {
+		for _, e := range m.AlsoKnownAs {
+			l = e.Size()
+			n += 1 + l + sovResource(uint64(l))
+		}
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovResource(uint64(m.Size_))
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovResource(uint64(l))
+	}
+	if m.Received != 0 {
+		n += 1 + sovResource(uint64(m.Received))
+	}
+	if m.ChunkCount != 0 {
+		n += 1 + sovResource(uint64(m.ChunkCount))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expires)
+	n += 1 + l + sovResource(uint64(l))
+	l = len(m.HashState)
+	if l > 0 {
+		n += 1 + l + sovResource(uint64(l))
+	}
+	return n
+}
+
 func (m *ResourceWithMetadata) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1208,6 +1549,26 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			}
 			m.NextVersionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deprecated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deprecated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipResource(dAtA[iNdEx:])
@@ -1343,6 +1704,406 @@ func (m *AlternativeUri) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ResourceUpload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResource
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceUpload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceUpload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlsoKnownAs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AlsoKnownAs = append(m.AlsoKnownAs, &AlternativeUri{})
+			if err := m.AlsoKnownAs[len(m.AlsoKnownAs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+			}
+			m.Received = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Received |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkCount", wireType)
+			}
+			m.ChunkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expires", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expires, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashState", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HashState = append(m.HashState[:0], dAtA[iNdEx:postIndex]...)
+			if m.HashState == nil {
+				m.HashState = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResource(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResource
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ResourceWithMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
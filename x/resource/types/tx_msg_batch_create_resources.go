@@ -0,0 +1,36 @@
+package types
+
+import (
+	didtypes "github.com/cheqd/cheqd-node/x/did/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ sdk.Msg = &MsgBatchCreateResources{}
+
+func NewMsgBatchCreateResources(payload *MsgBatchCreateResourcesPayload, signatures []*didtypes.SignInfo) *MsgBatchCreateResources {
+	return &MsgBatchCreateResources{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+}
+
+func (msg *MsgBatchCreateResources) Type() string {
+	return "MsgBatchCreateResources"
+}
+
+// Validate
+
+func (msg MsgBatchCreateResources) Validate(allowedNamespaces []string) error {
+	return validation.ValidateStruct(&msg,
+		validation.Field(&msg.Payload, validation.Required, ValidMsgBatchCreateResourcesPayload()),
+		validation.Field(&msg.Signatures, didtypes.IsUniqueSignInfoListRule(), validation.Each(didtypes.ValidSignInfoRule(allowedNamespaces))),
+	)
+}
+
+// Normalize
+
+func (msg *MsgBatchCreateResources) Normalize() {
+	msg.Payload.Normalize()
+	didtypes.NormalizeSignInfoList(msg.Signatures)
+}
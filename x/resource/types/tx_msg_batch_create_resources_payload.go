@@ -0,0 +1,112 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	didtypes "github.com/cheqd/cheqd-node/x/did/types"
+	didutils "github.com/cheqd/cheqd-node/x/did/utils"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ didtypes.IdentityMsg = &MsgBatchCreateResourcesPayload{}
+
+func (msg *MsgBatchCreateResourcesPayload) GetSignBytes() []byte {
+	bytes, err := msg.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return bytes
+}
+
+// DataSize returns the total size of the data of all resources in the batch
+func (msg *MsgBatchCreateResourcesPayload) DataSize() uint64 {
+	var size uint64
+	for _, resource := range msg.Resources {
+		size += uint64(len(resource.Data))
+	}
+
+	return size
+}
+
+// Validation
+
+func (msg MsgBatchCreateResourcesPayload) Validate() error {
+	return validation.ValidateStruct(&msg,
+		validation.Field(&msg.CollectionId, validation.Required, didtypes.IsID()),
+		validation.Field(&msg.Resources, validation.Required, IsUniqueResourcePayloadListByIDRule(), validation.Each(IsInCollectionRule(msg.CollectionId))),
+	)
+}
+
+// ValidateBatchSize checks the number of resources and their total data size against the module parameters
+func (msg MsgBatchCreateResourcesPayload) ValidateBatchSize(maxBatchSize uint32, maxBatchDataSize uint64) error {
+	if maxBatchSize == 0 {
+		return errors.New("batch creation is disabled")
+	}
+
+	if len(msg.Resources) > int(maxBatchSize) {
+		return fmt.Errorf("batch contains %d resources, at most %d are allowed", len(msg.Resources), maxBatchSize)
+	}
+
+	if size := msg.DataSize(); size > maxBatchDataSize {
+		return fmt.Errorf("batch contains %d bytes of resource data, at most %d are allowed", size, maxBatchDataSize)
+	}
+
+	return nil
+}
+
+func ValidMsgBatchCreateResourcesPayload() *didtypes.CustomErrorRule {
+	return didtypes.NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(*MsgBatchCreateResourcesPayload)
+		if !ok {
+			panic("ValidMsgBatchCreateResourcesPayload must be only applied on MsgBatchCreateResourcesPayload properties")
+		}
+
+		return casted.Validate()
+	})
+}
+
+func IsUniqueResourcePayloadListByIDRule() *didtypes.CustomErrorRule {
+	return didtypes.NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.([]*MsgCreateResourcePayload)
+		if !ok {
+			panic("IsUniqueResourcePayloadListByIDRule must be only applied on MsgCreateResourcePayload lists")
+		}
+
+		ids := make(map[string]bool, len(casted))
+		for _, resource := range casted {
+			if ids[resource.Id] {
+				return fmt.Errorf("duplicated resource id: %s", resource.Id)
+			}
+
+			ids[resource.Id] = true
+		}
+
+		return nil
+	})
+}
+
+func IsInCollectionRule(collectionID string) *didtypes.CustomErrorRule {
+	return didtypes.NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(MsgCreateResourcePayload)
+		if !ok {
+			panic("IsInCollectionRule must be only applied on MsgCreateResourcePayload list items")
+		}
+
+		if casted.CollectionId != collectionID {
+			return fmt.Errorf("resource %s does not belong to collection %s", casted.Id, collectionID)
+		}
+
+		return nil
+	})
+}
+
+// Normalize
+
+func (msg *MsgBatchCreateResourcesPayload) Normalize() {
+	msg.CollectionId = didutils.NormalizeID(msg.CollectionId)
+	for _, resource := range msg.Resources {
+		resource.Normalize()
+	}
+}
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	didtypes "github.com/cheqd/cheqd-node/x/did/types"
+	didutils "github.com/cheqd/cheqd-node/x/did/utils"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ didtypes.IdentityMsg = &MsgBeginResourceUploadPayload{}
+
+var sha256ChecksumRegexp = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+func (msg *MsgBeginResourceUploadPayload) GetSignBytes() []byte {
+	bytes, err := msg.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return bytes
+}
+
+func (msg *MsgBeginResourceUploadPayload) ToUpload(expires time.Time) ResourceUpload {
+	return ResourceUpload{
+		CollectionId: msg.CollectionId,
+		Id:           msg.Id,
+		Name:         msg.Name,
+		Version:      msg.Version,
+		ResourceType: msg.ResourceType,
+		AlsoKnownAs:  msg.AlsoKnownAs,
+		Size_:        msg.Size_,
+		Checksum:     msg.Checksum,
+		Expires:      expires,
+	}
+}
+
+// Validation
+
+func (msg MsgBeginResourceUploadPayload) Validate() error {
+	return validation.ValidateStruct(&msg,
+		validation.Field(&msg.CollectionId, validation.Required, didtypes.IsID()),
+		validation.Field(&msg.Id, validation.Required, didtypes.IsUUID()),
+		validation.Field(&msg.Name, validation.Required, validation.Length(1, 64)),
+		validation.Field(&msg.Version, validation.Length(1, 64)),
+		validation.Field(&msg.ResourceType, validation.Required, validation.Length(1, 64)),
+		validation.Field(&msg.AlsoKnownAs, validation.Each(ValidAlternativeURI())),
+		validation.Field(&msg.Size_, validation.Required),
+		validation.Field(&msg.Checksum, validation.Required, validation.Match(sha256ChecksumRegexp).Error("must be a hex encoded SHA-256 hash")),
+	)
+}
+
+// ValidateUploadSize checks the declared size of the resource data against the module parameters
+func (msg MsgBeginResourceUploadPayload) ValidateUploadSize(maxUploadSize uint64) error {
+	if maxUploadSize == 0 {
+		return errors.New("chunked uploads are disabled")
+	}
+
+	if msg.Size_ > maxUploadSize {
+		return fmt.Errorf("upload size is %d bytes, at most %d are allowed", msg.Size_, maxUploadSize)
+	}
+
+	return nil
+}
+
+func ValidMsgBeginResourceUploadPayload() *didtypes.CustomErrorRule {
+	return didtypes.NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(*MsgBeginResourceUploadPayload)
+		if !ok {
+			panic("ValidMsgBeginResourceUploadPayload must be only applied on MsgBeginResourceUploadPayload properties")
+		}
+
+		return casted.Validate()
+	})
+}
+
+// Normalize
+
+func (msg *MsgBeginResourceUploadPayload) Normalize() {
+	msg.CollectionId = didutils.NormalizeID(msg.CollectionId)
+	msg.Id = didutils.NormalizeUUID(msg.Id)
+	msg.Checksum = strings.ToLower(msg.Checksum)
+}
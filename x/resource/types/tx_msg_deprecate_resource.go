@@ -0,0 +1,36 @@
+package types
+
+import (
+	didtypes "github.com/cheqd/cheqd-node/x/did/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ sdk.Msg = &MsgDeprecateResource{}
+
+func NewMsgDeprecateResource(payload *MsgDeprecateResourcePayload, signatures []*didtypes.SignInfo) *MsgDeprecateResource {
+	return &MsgDeprecateResource{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+}
+
+func (msg *MsgDeprecateResource) Type() string {
+	return "MsgDeprecateResource"
+}
+
+// Validate
+
+func (msg MsgDeprecateResource) Validate(allowedNamespaces []string) error {
+	return validation.ValidateStruct(&msg,
+		validation.Field(&msg.Payload, validation.Required, ValidMsgDeprecateResourcePayload()),
+		validation.Field(&msg.Signatures, didtypes.IsUniqueSignInfoListRule(), validation.Each(didtypes.ValidSignInfoRule(allowedNamespaces))),
+	)
+}
+
+// Normalize
+
+func (msg *MsgDeprecateResource) Normalize() {
+	msg.Payload.Normalize()
+	didtypes.NormalizeSignInfoList(msg.Signatures)
+}
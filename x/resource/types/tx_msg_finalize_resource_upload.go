@@ -0,0 +1,36 @@
+package types
+
+import (
+	didtypes "github.com/cheqd/cheqd-node/x/did/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ sdk.Msg = &MsgFinalizeResourceUpload{}
+
+func NewMsgFinalizeResourceUpload(payload *MsgFinalizeResourceUploadPayload, signatures []*didtypes.SignInfo) *MsgFinalizeResourceUpload {
+	return &MsgFinalizeResourceUpload{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+}
+
+func (msg *MsgFinalizeResourceUpload) Type() string {
+	return "MsgFinalizeResourceUpload"
+}
+
+// Validate
+
+func (msg MsgFinalizeResourceUpload) Validate(allowedNamespaces []string) error {
+	return validation.ValidateStruct(&msg,
+		validation.Field(&msg.Payload, validation.Required, ValidMsgFinalizeResourceUploadPayload()),
+		validation.Field(&msg.Signatures, didtypes.IsUniqueSignInfoListRule(), validation.Each(didtypes.ValidSignInfoRule(allowedNamespaces))),
+	)
+}
+
+// Normalize
+
+func (msg *MsgFinalizeResourceUpload) Normalize() {
+	msg.Payload.Normalize()
+	didtypes.NormalizeSignInfoList(msg.Signatures)
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	didtypes "github.com/cheqd/cheqd-node/x/did/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ sdk.Msg = &MsgUpdateResourceMetadata{}
+
+func NewMsgUpdateResourceMetadata(payload *MsgUpdateResourceMetadataPayload, signatures []*didtypes.SignInfo) *MsgUpdateResourceMetadata {
+	return &MsgUpdateResourceMetadata{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+}
+
+func (msg *MsgUpdateResourceMetadata) Type() string {
+	return "MsgUpdateResourceMetadata"
+}
+
+// Validate
+
+func (msg MsgUpdateResourceMetadata) Validate(allowedNamespaces []string) error {
+	return validation.ValidateStruct(&msg,
+		validation.Field(&msg.Payload, validation.Required, ValidMsgUpdateResourceMetadataPayload()),
+		validation.Field(&msg.Signatures, didtypes.IsUniqueSignInfoListRule(), validation.Each(didtypes.ValidSignInfoRule(allowedNamespaces))),
+	)
+}
+
+// Normalize
+
+func (msg *MsgUpdateResourceMetadata) Normalize() {
+	msg.Payload.Normalize()
+	didtypes.NormalizeSignInfoList(msg.Signatures)
+}